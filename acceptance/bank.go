@@ -0,0 +1,218 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package acceptance
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// A BankWorkload has concurrent workers transfer amounts between
+// accounts, stored under keys of their own, and read the balances of
+// all accounts, each in a transaction of its own. Were transactions
+// not serializable, transfers could create or destroy money, or
+// overdraw accounts, and reads could observe a transfer half done.
+type BankWorkload struct {
+	Accounts int
+	// Balance is the initial balance of each account.
+	Balance int64
+	Workers int
+}
+
+var bankEndKey = proto.Key("bank.")
+
+func bankKey(account int) proto.Key {
+	return proto.Key(fmt.Sprintf("bank-%03d", account))
+}
+
+// Total returns the sum of the balances of all accounts.
+func (w *BankWorkload) Total() int64 {
+	return int64(w.Accounts) * w.Balance
+}
+
+// Setup creates the accounts.
+func (w *BankWorkload) Setup(kv *client.KV) error {
+	return kv.RunTransaction(nil, func(txn *client.Txn) error {
+		for i := 0; i < w.Accounts; i++ {
+			txn.Prepare(client.PutCall(bankKey(i), encodeBalance(w.Balance)))
+		}
+		return nil
+	})
+}
+
+// Run runs the workload against the cluster until stop is closed and
+// returns the balances read, one slice per read. Transactions in
+// progress when stop is closed are waited for, so Run may not return
+// until faults injected into the cluster have been recovered from.
+func (w *BankWorkload) Run(c *LocalCluster, rng *rand.Rand, stop <-chan struct{}) (
+	[][]int64, error) {
+	var reads [][]int64
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(chan error, w.Workers)
+	for i := 0; i < w.Workers; i++ {
+		kv, err := c.KV(i % c.NumNodes())
+		if err != nil {
+			return nil, err
+		}
+		workerRand := rand.New(rand.NewSource(rng.Int63()))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				var err error
+				if workerRand.Intn(2) == 0 {
+					err = w.transfer(kv, workerRand)
+				} else {
+					var balances []int64
+					if balances, err = w.read(kv); err == nil {
+						mu.Lock()
+						reads = append(reads, balances)
+						mu.Unlock()
+					}
+				}
+				// Transactions failing under the injected faults are of
+				// no concern, but invalid balances are.
+				if _, ok := err.(*invalidBalanceError); ok {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+		return reads, nil
+	}
+}
+
+// transfer moves a random amount between two random accounts, unless
+// the source account's balance falls short of it.
+func (w *BankWorkload) transfer(kv *client.KV, rng *rand.Rand) error {
+	from, to := rng.Intn(w.Accounts), rng.Intn(w.Accounts)
+	if from == to {
+		return nil
+	}
+	amount := rng.Int63n(w.Balance) + 1
+	return kv.RunTransaction(nil, func(txn *client.Txn) error {
+		fromCall, toCall := client.GetCall(bankKey(from)), client.GetCall(bankKey(to))
+		if err := txn.Run(fromCall, toCall); err != nil {
+			return err
+		}
+		fromBalance, err := decodeBalance(fromCall)
+		if err != nil {
+			return err
+		}
+		toBalance, err := decodeBalance(toCall)
+		if err != nil {
+			return err
+		}
+		if fromBalance < amount {
+			return nil
+		}
+		txn.Prepare(
+			client.PutCall(bankKey(from), encodeBalance(fromBalance-amount)),
+			client.PutCall(bankKey(to), encodeBalance(toBalance+amount)),
+		)
+		return nil
+	})
+}
+
+// read returns the balances of all accounts.
+func (w *BankWorkload) read(kv *client.KV) ([]int64, error) {
+	var balances []int64
+	err := kv.RunTransaction(nil, func(txn *client.Txn) error {
+		balances = nil
+		call := client.ScanCall(bankKey(0), bankEndKey, int64(w.Accounts+1))
+		if err := txn.Run(call); err != nil {
+			return err
+		}
+		for _, row := range call.Reply.(*proto.ScanResponse).Rows {
+			balance, err := strconv.ParseInt(string(row.Value.Bytes), 10, 64)
+			if err != nil {
+				return &invalidBalanceError{key: row.Key, value: row.Value.Bytes}
+			}
+			balances = append(balances, balance)
+		}
+		return nil
+	})
+	return balances, err
+}
+
+// An invalidBalanceError reports an account holding a value which is
+// not a balance.
+type invalidBalanceError struct {
+	key   proto.Key
+	value []byte
+}
+
+func (e *invalidBalanceError) Error() string {
+	return fmt.Sprintf("invalid balance of %s: %q", e.key, e.value)
+}
+
+func encodeBalance(balance int64) []byte {
+	return []byte(strconv.FormatInt(balance, 10))
+}
+
+func decodeBalance(call client.Call) (int64, error) {
+	reply := call.Reply.(*proto.GetResponse)
+	if reply.Value == nil {
+		key := call.Args.Header().Key
+		return 0, &invalidBalanceError{key: key}
+	}
+	balance, err := strconv.ParseInt(string(reply.Value.Bytes), 10, 64)
+	if err != nil {
+		return 0, &invalidBalanceError{key: call.Args.Header().Key, value: reply.Value.Bytes}
+	}
+	return balance, nil
+}
+
+// CheckBank returns an error unless each read found every one of the
+// accounts, none of them overdrawn, holding total between them.
+func CheckBank(reads [][]int64, accounts int, total int64) error {
+	for i, balances := range reads {
+		if len(balances) != accounts {
+			return util.Errorf("read %d found %d accounts; expected %d", i, len(balances), accounts)
+		}
+		var sum int64
+		for j, balance := range balances {
+			if balance < 0 {
+				return util.Errorf("read %d found account %d overdrawn: %v", i, j, balances)
+			}
+			sum += balance
+		}
+		if sum != total {
+			return util.Errorf("read %d found a total of %d; expected %d: %v", i, sum, total, balances)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package acceptance
+
+import "testing"
+
+func TestCheckLinearizable(t *testing.T) {
+	testCases := []struct {
+		history      []RegisterOp
+		linearizable bool
+	}{
+		// An empty history.
+		{nil, true},
+		// Reading an absent register.
+		{[]RegisterOp{{Kind: Read, Value: 0, Invoke: 1, Complete: 2}}, true},
+		// Sequential writes and reads.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 2},
+			{Kind: Read, Value: 1, Invoke: 3, Complete: 4},
+			{Kind: Write, Value: 2, Invoke: 5, Complete: 6},
+			{Kind: Read, Value: 2, Invoke: 7, Complete: 8},
+		}, true},
+		// A stale read.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 2},
+			{Kind: Write, Value: 2, Invoke: 3, Complete: 4},
+			{Kind: Read, Value: 1, Invoke: 5, Complete: 6},
+		}, false},
+		// Reads concurrent with a write may observe either value, but
+		// not the old value after the new one.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 10},
+			{Kind: Read, Value: 0, Invoke: 2, Complete: 3},
+			{Kind: Read, Value: 1, Invoke: 4, Complete: 5},
+		}, true},
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 10},
+			{Kind: Read, Value: 1, Invoke: 2, Complete: 3},
+			{Kind: Read, Value: 0, Invoke: 4, Complete: 5},
+		}, false},
+		// Compare-and-set.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 2},
+			{Kind: CAS, Expected: 1, Value: 2, Invoke: 3, Complete: 4},
+			{Kind: Read, Value: 2, Invoke: 5, Complete: 6},
+		}, true},
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 2},
+			{Kind: CAS, Expected: 3, Value: 2, Invoke: 3, Complete: 4},
+		}, false},
+		// Failed operations are ignored.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Invoke: 1, Complete: 2},
+			{Kind: CAS, Expected: 3, Value: 2, Outcome: Failed, Invoke: 3, Complete: 4},
+			{Kind: Read, Value: 1, Invoke: 5, Complete: 6},
+		}, true},
+		// A write of unknown outcome may take effect at any later point.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Outcome: Unknown, Invoke: 1, Complete: 2},
+			{Kind: Read, Value: 0, Invoke: 3, Complete: 4},
+			{Kind: Read, Value: 1, Invoke: 5, Complete: 6},
+		}, true},
+		// ...or not at all.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Outcome: Unknown, Invoke: 1, Complete: 2},
+			{Kind: Read, Value: 0, Invoke: 3, Complete: 4},
+		}, true},
+		// ...but not before its invocation.
+		{[]RegisterOp{
+			{Kind: Read, Value: 1, Invoke: 1, Complete: 2},
+			{Kind: Write, Value: 1, Outcome: Unknown, Invoke: 3, Complete: 4},
+		}, false},
+		// ...and only once.
+		{[]RegisterOp{
+			{Kind: Write, Value: 1, Outcome: Unknown, Invoke: 1, Complete: 2},
+			{Kind: Read, Value: 1, Invoke: 3, Complete: 4},
+			{Kind: Write, Value: 2, Invoke: 5, Complete: 6},
+			{Kind: Read, Value: 1, Invoke: 7, Complete: 8},
+		}, false},
+	}
+	for i, test := range testCases {
+		err := CheckLinearizable(test.history)
+		if linearizable := err == nil; linearizable != test.linearizable {
+			t.Errorf("%d: expected linearizable=%t; got error %v", i, test.linearizable, err)
+		}
+	}
+}
+
+func TestCheckBank(t *testing.T) {
+	testCases := []struct {
+		reads [][]int64
+		ok    bool
+	}{
+		{nil, true},
+		{[][]int64{{10, 10, 10}, {5, 15, 10}, {0, 0, 30}}, true},
+		// Money created.
+		{[][]int64{{10, 10, 10}, {10, 15, 10}}, false},
+		// An account overdrawn.
+		{[][]int64{{-5, 25, 10}}, false},
+		// An account missing.
+		{[][]int64{{20, 10}}, false},
+	}
+	for i, test := range testCases {
+		if err := CheckBank(test.reads, 3, 30); (err == nil) != test.ok {
+			t.Errorf("%d: expected ok=%t; got error %v", i, test.ok, err)
+		}
+	}
+}
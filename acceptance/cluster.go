@@ -0,0 +1,332 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// Package acceptance runs multi-node cockroach clusters as local
+// processes, injects faults into them (see Nemesis) while workloads
+// run against them, and checks the histories the workloads record for
+// violations of the consistency guarantees of the database. The tests
+// exercising it are built with the "acceptance" tag; see the
+// "acceptance-nemesis" Makefile target.
+package acceptance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// healthPath and faultsPath are the endpoints of a node reporting
+	// its health and injecting faults into it.
+	healthPath = "/_admin/health"
+	faultsPath = "/_debug/faults"
+	// startTimeout bounds the wait for a started node to report itself
+	// healthy.
+	startTimeout = 30 * time.Second
+)
+
+// Faults are the faults injected into a node. They are applied through
+// the node's fault injection endpoint, whose settings they mirror.
+type Faults struct {
+	// DropRPCs drops every RPC the node receives, cutting it off from
+	// the requests of its peers.
+	DropRPCs bool `json:"dropRPCs"`
+	// ClockOffset is added to the node's wall time.
+	ClockOffset time.Duration `json:"clockOffset"`
+	// WriteDelay delays each write to the node's store.
+	WriteDelay time.Duration `json:"writeDelay"`
+}
+
+// A node is a cockroach process of a LocalCluster.
+type node struct {
+	addr   string
+	pgAddr string
+	dir    string
+	cmd    *exec.Cmd
+	// exited is closed once the process has exited.
+	exited chan struct{}
+	faults Faults
+}
+
+// A LocalCluster is a cluster of cockroach processes on the local
+// machine. Each node has a store of its own in a temporary directory
+// and listens on a free port of localhost; all of them bootstrap their
+// gossip from the first.
+type LocalCluster struct {
+	binary string
+	dir    string
+	ctx    *base.Context
+
+	mu    sync.Mutex
+	nodes []*node
+}
+
+// NewLocalCluster returns a cluster of numNodes nodes running the
+// supplied cockroach binary. The cluster must be started before use.
+func NewLocalCluster(binary string, numNodes int) *LocalCluster {
+	c := &LocalCluster{binary: binary}
+	for i := 0; i < numNodes; i++ {
+		c.nodes = append(c.nodes, &node{})
+	}
+	return c
+}
+
+// NumNodes returns the number of nodes in the cluster.
+func (c *LocalCluster) NumNodes() int {
+	return len(c.nodes)
+}
+
+// Start creates the certificates and stores of the cluster, bootstraps
+// it on the first node's store and starts every node, returning once
+// all of them are healthy.
+func (c *LocalCluster) Start() error {
+	dir, err := ioutil.TempDir("", "cockroach-acceptance")
+	if err != nil {
+		return err
+	}
+	c.dir = dir
+	certs := filepath.Join(dir, "certs")
+	c.ctx = &base.Context{Certs: certs}
+	if err := c.run("create-ca-cert", "-certs="+certs); err != nil {
+		return err
+	}
+	if err := c.run("create-node-cert", "-certs="+certs, "localhost", "127.0.0.1"); err != nil {
+		return err
+	}
+	for i, n := range c.nodes {
+		n.dir = filepath.Join(dir, fmt.Sprintf("node%d", i+1))
+		if err := os.MkdirAll(n.dir, 0755); err != nil {
+			return err
+		}
+		if n.addr, err = freeAddr(); err != nil {
+			return err
+		}
+		if n.pgAddr, err = freeAddr(); err != nil {
+			return err
+		}
+	}
+	if err := c.run("init", filepath.Join(c.nodes[0].dir, "data")); err != nil {
+		return err
+	}
+	for i := range c.nodes {
+		if err := c.Restart(i); err != nil {
+			return err
+		}
+	}
+	log.Infof("started %d node cluster in %s", len(c.nodes), dir)
+	return nil
+}
+
+// Stop kills the cluster's nodes and removes its directory, unless the
+// KEEP_CLUSTER environment variable is set to aid debugging.
+func (c *LocalCluster) Stop() {
+	for i := range c.nodes {
+		if err := c.Kill(i); err != nil {
+			log.Warning(err)
+		}
+	}
+	if c.dir == "" {
+		return
+	}
+	if os.Getenv("KEEP_CLUSTER") != "" {
+		log.Infof("leaving cluster directory %s", c.dir)
+		return
+	}
+	if err := os.RemoveAll(c.dir); err != nil {
+		log.Warning(err)
+	}
+}
+
+// Kill kills the process of node i without giving it a chance to shut
+// down. Killing a stopped node is a no-op.
+func (c *LocalCluster) Kill(i int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := c.nodes[i]
+	if n.cmd == nil {
+		return nil
+	}
+	select {
+	case <-n.exited:
+	default:
+		if err := n.cmd.Process.Kill(); err != nil {
+			return util.Errorf("unable to kill node %d: %s", i+1, err)
+		}
+		<-n.exited
+	}
+	n.cmd = nil
+	// The faults injected into the process died with it.
+	n.faults = Faults{}
+	return nil
+}
+
+// Restart starts node i anew on its existing store, returning once it
+// is healthy. The node is killed first if it is running.
+func (c *LocalCluster) Restart(i int) error {
+	if err := c.Kill(i); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	n := c.nodes[i]
+	logFile, err := os.OpenFile(filepath.Join(n.dir, "cockroach.log"),
+		os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		c.mu.Unlock()
+		return err
+	}
+	defer logFile.Close()
+	cmd := exec.Command(c.binary, "start",
+		"-gossip="+c.nodes[0].addr,
+		"-stores=ssd="+filepath.Join(n.dir, "data"),
+		"-addr="+n.addr,
+		"-pgaddr="+n.pgAddr,
+		"-certs="+c.ctx.Certs,
+		"-enable-fault-injection",
+		"-logtostderr")
+	cmd.Stdout, cmd.Stderr = logFile, logFile
+	if err := cmd.Start(); err != nil {
+		c.mu.Unlock()
+		return util.Errorf("unable to start node %d: %s", i+1, err)
+	}
+	n.cmd = cmd
+	n.exited = make(chan struct{})
+	go func(exited chan struct{}) {
+		// The process exits with an error when it is killed.
+		_ = cmd.Wait()
+		close(exited)
+	}(n.exited)
+	c.mu.Unlock()
+	return c.waitHealthy(i)
+}
+
+// Alive returns whether the process of node i is running. A node
+// exits of its own accord if, for instance, its clock is found to be
+// offset from its peers' by more than the maximum offset.
+func (c *LocalCluster) Alive(i int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := c.nodes[i]
+	if n.cmd == nil {
+		return false
+	}
+	select {
+	case <-n.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// Faults returns the faults injected into node i.
+func (c *LocalCluster) Faults(i int) Faults {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nodes[i].faults
+}
+
+// SetFaults replaces the faults injected into node i, which must be
+// running.
+func (c *LocalCluster) SetFaults(i int, faults Faults) error {
+	body, err := json.Marshal(faults)
+	if err != nil {
+		return err
+	}
+	httpClient, err := c.ctx.GetHTTPClient()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := c.nodes[i]
+	resp, err := httpClient.Post("https://"+n.addr+faultsPath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return util.Errorf("unable to inject faults into node %d: %s", i+1, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return util.Errorf("unable to inject faults into node %d: %s", i+1, resp.Status)
+	}
+	n.faults = faults
+	return nil
+}
+
+// KV returns a client sending its requests to node i.
+func (c *LocalCluster) KV(i int) (*client.KV, error) {
+	httpClient, err := c.ctx.GetHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	kv := client.NewKV(nil, client.NewHTTPSender(c.nodes[i].addr, httpClient))
+	kv.User = "root"
+	return kv, nil
+}
+
+// run runs the cockroach binary with the supplied arguments to
+// completion.
+func (c *LocalCluster) run(args ...string) error {
+	if out, err := exec.Command(c.binary, args...).CombinedOutput(); err != nil {
+		return util.Errorf("%s %v failed: %s\n%s", c.binary, args, err, out)
+	}
+	return nil
+}
+
+// waitHealthy waits for node i to respond to health checks.
+func (c *LocalCluster) waitHealthy(i int) error {
+	httpClient, err := c.ctx.GetHTTPClient()
+	if err != nil {
+		return err
+	}
+	url := "https://" + c.nodes[i].addr + healthPath
+	for deadline := time.Now().Add(startTimeout); time.Now().Before(deadline); {
+		if resp, err := httpClient.Get(url); err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		if !c.Alive(i) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return util.Errorf("node %d did not become healthy; see %s", i+1,
+		filepath.Join(c.nodes[i].dir, "cockroach.log"))
+}
+
+// freeAddr returns a localhost address with a port which is currently
+// free.
+func freeAddr() (string, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer ln.Close()
+	return ln.Addr().String(), nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package acceptance
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// A Nemesis injects a fault into a cluster and later recovers from it.
+// Nemeses are invoked one at a time; Recover is called once after each
+// successful Invoke.
+type Nemesis interface {
+	// Invoke injects the fault, choosing its victims using rng.
+	Invoke(c *LocalCluster, rng *rand.Rand) error
+	// Recover undoes the fault injected by the last call to Invoke.
+	Recover(c *LocalCluster) error
+	fmt.Stringer
+}
+
+// RunNemeses alternately invokes a nemesis chosen at random and
+// recovers from it, keeping each state for period, until stop is
+// closed. The cluster is recovered before it returns.
+func RunNemeses(c *LocalCluster, nemeses []Nemesis, period time.Duration, rng *rand.Rand,
+	stop <-chan struct{}) error {
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(period):
+		}
+		nemesis := nemeses[rng.Intn(len(nemeses))]
+		log.Infof("invoking nemesis %s", nemesis)
+		if err := nemesis.Invoke(c, rng); err != nil {
+			return err
+		}
+		select {
+		case <-stop:
+		case <-time.After(period):
+		}
+		log.Infof("recovering from nemesis %s", nemesis)
+		if err := nemesis.Recover(c); err != nil {
+			return err
+		}
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+	}
+}
+
+// minority returns a random set of fewer than half of the cluster's
+// nodes, containing at least one node.
+func minority(c *LocalCluster, rng *rand.Rand) []int {
+	n := (c.NumNodes() - 1) / 2
+	if n == 0 {
+		n = 1
+	}
+	return rng.Perm(c.NumNodes())[:n]
+}
+
+// updateFaults changes the faults injected into each of the nodes.
+func updateFaults(c *LocalCluster, nodes []int, update func(*Faults)) error {
+	for _, i := range nodes {
+		faults := c.Faults(i)
+		update(&faults)
+		if err := c.SetFaults(i, faults); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KillNemesis kills a minority of the nodes and restarts them on
+// recovery.
+type KillNemesis struct {
+	killed []int
+}
+
+// Invoke implements Nemesis.
+func (n *KillNemesis) Invoke(c *LocalCluster, rng *rand.Rand) error {
+	n.killed = minority(c, rng)
+	for _, i := range n.killed {
+		if err := c.Kill(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Recover implements Nemesis.
+func (n *KillNemesis) Recover(c *LocalCluster) error {
+	for _, i := range n.killed {
+		if err := c.Restart(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *KillNemesis) String() string { return fmt.Sprintf("kill %v", n.killed) }
+
+// PartitionNemesis cuts a minority of the nodes off from the rest by
+// dropping the RPCs they receive. Their own RPCs still reach the
+// majority, whose replies are dropped, so the partition is complete
+// for Raft and for requests served by the majority; the isolated nodes
+// can still forward requests of their clients to the majority.
+type PartitionNemesis struct {
+	isolated []int
+}
+
+// Invoke implements Nemesis.
+func (n *PartitionNemesis) Invoke(c *LocalCluster, rng *rand.Rand) error {
+	n.isolated = minority(c, rng)
+	return updateFaults(c, n.isolated, func(f *Faults) { f.DropRPCs = true })
+}
+
+// Recover implements Nemesis.
+func (n *PartitionNemesis) Recover(c *LocalCluster) error {
+	return updateFaults(c, n.isolated, func(f *Faults) { f.DropRPCs = false })
+}
+
+func (n *PartitionNemesis) String() string { return fmt.Sprintf("partition %v", n.isolated) }
+
+// ClockSkewNemesis offsets the clock of every node by a random amount
+// of up to MaxSkew in either direction. A MaxSkew of more than half of
+// the cluster's maximum clock offset may cause nodes to exit.
+type ClockSkewNemesis struct {
+	MaxSkew time.Duration
+}
+
+// Invoke implements Nemesis.
+func (n *ClockSkewNemesis) Invoke(c *LocalCluster, rng *rand.Rand) error {
+	for i := 0; i < c.NumNodes(); i++ {
+		skew := time.Duration(rng.Int63n(int64(2*n.MaxSkew)+1)) - n.MaxSkew
+		if err := updateFaults(c, []int{i}, func(f *Faults) { f.ClockOffset = skew }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Recover implements Nemesis.
+func (n *ClockSkewNemesis) Recover(c *LocalCluster) error {
+	for i := 0; i < c.NumNodes(); i++ {
+		if err := updateFaults(c, []int{i}, func(f *Faults) { f.ClockOffset = 0 }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *ClockSkewNemesis) String() string { return fmt.Sprintf("clock skew up to %s", n.MaxSkew) }
+
+// SlowDiskNemesis delays each write to the stores of a minority of the
+// nodes by Delay.
+type SlowDiskNemesis struct {
+	Delay time.Duration
+	slow  []int
+}
+
+// Invoke implements Nemesis.
+func (n *SlowDiskNemesis) Invoke(c *LocalCluster, rng *rand.Rand) error {
+	n.slow = minority(c, rng)
+	return updateFaults(c, n.slow, func(f *Faults) { f.WriteDelay = n.Delay })
+}
+
+// Recover implements Nemesis.
+func (n *SlowDiskNemesis) Recover(c *LocalCluster) error {
+	return updateFaults(c, n.slow, func(f *Faults) { f.WriteDelay = 0 })
+}
+
+func (n *SlowDiskNemesis) String() string { return fmt.Sprintf("slow disk %v by %s", n.slow, n.Delay) }
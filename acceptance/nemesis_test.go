@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// +build acceptance
+
+package acceptance
+
+import (
+	"flag"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	binary    = flag.String("binary", "../cockroach", "the cockroach binary to test")
+	numNodes  = flag.Int("nodes", 5, "the number of nodes in the cluster")
+	duration  = flag.Duration("d", time.Minute, "how long to run each workload")
+	period    = flag.Duration("period", 5*time.Second, "how long each nemesis lasts")
+	nemesisFl = flag.String("nemeses", "kill,partition,skew,slow",
+		"the comma-separated nemeses to invoke")
+	seed = flag.Int64("seed", 0, "the random seed; chosen from the time if 0")
+)
+
+// allNemeses are the nemeses which may be selected with -nemeses. The
+// clock skew stays below half of the default maximum offset, which
+// nodes are trusted not to exceed.
+var allNemeses = map[string]Nemesis{
+	"kill":      &KillNemesis{},
+	"partition": &PartitionNemesis{},
+	"skew":      &ClockSkewNemesis{MaxSkew: 100 * time.Millisecond},
+	"slow":      &SlowDiskNemesis{Delay: 50 * time.Millisecond},
+}
+
+func selectNemeses(t *testing.T) []Nemesis {
+	var nemeses []Nemesis
+	for _, name := range strings.Split(*nemesisFl, ",") {
+		if name == "" {
+			continue
+		}
+		nemesis, ok := allNemeses[name]
+		if !ok {
+			t.Fatalf("unknown nemesis %q", name)
+		}
+		nemeses = append(nemeses, nemesis)
+	}
+	return nemeses
+}
+
+// startCluster starts a cluster and returns it with the random source
+// of the test.
+func startCluster(t *testing.T) (*LocalCluster, *rand.Rand) {
+	s := *seed
+	if s == 0 {
+		s = time.Now().UnixNano()
+	}
+	t.Logf("random seed: %d", s)
+	c := NewLocalCluster(*binary, *numNodes)
+	if err := c.Start(); err != nil {
+		c.Stop()
+		t.Fatal(err)
+	}
+	return c, rand.New(rand.NewSource(s))
+}
+
+// runWithNemeses runs the workload while nemeses are invoked on the
+// cluster, for the duration of the test.
+func runWithNemeses(t *testing.T, c *LocalCluster, rng *rand.Rand, workload func(stop <-chan struct{})) {
+	nemeses := selectNemeses(t)
+	stop := make(chan struct{})
+	nemesisErr := make(chan error, 1)
+	nemesisRand := rand.New(rand.NewSource(rng.Int63()))
+	go func() {
+		if len(nemeses) == 0 {
+			<-stop
+			nemesisErr <- nil
+			return
+		}
+		nemesisErr <- RunNemeses(c, nemeses, *period, nemesisRand, stop)
+	}()
+	time.AfterFunc(*duration, func() { close(stop) })
+	workload(stop)
+	if err := <-nemesisErr; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRegisterLinearizable checks that operations on single keys are
+// linearizable under faults.
+func TestRegisterLinearizable(t *testing.T) {
+	c, rng := startCluster(t)
+	defer c.Stop()
+
+	w := &RegisterWorkload{Registers: 5, Workers: 10, Timeout: 2 * time.Second}
+	var histories [][]RegisterOp
+	var err error
+	runWithNemeses(t, c, rng, func(stop <-chan struct{}) {
+		histories, err = w.Run(c, rng, stop)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, history := range histories {
+		t.Logf("register %d: %d operations", i, len(history))
+		if err := CheckLinearizable(history); err != nil {
+			t.Errorf("register %d: %s", i, err)
+		}
+	}
+}
+
+// TestBankSerializable checks that transactions are serializable under
+// faults.
+func TestBankSerializable(t *testing.T) {
+	c, rng := startCluster(t)
+	defer c.Stop()
+
+	w := &BankWorkload{Accounts: 10, Balance: 100, Workers: 10}
+	kv, err := c.KV(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Setup(kv); err != nil {
+		t.Fatal(err)
+	}
+	var reads [][]int64
+	runWithNemeses(t, c, rng, func(stop <-chan struct{}) {
+		reads, err = w.Run(c, rng, stop)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%d reads", len(reads))
+	if err := CheckBank(reads, w.Accounts, w.Total()); err != nil {
+		t.Error(err)
+	}
+}
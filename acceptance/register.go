@@ -0,0 +1,356 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package acceptance
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"golang.org/x/net/context"
+)
+
+// An Outcome is what the client of an operation knows of its effect.
+type Outcome int
+
+const (
+	// OK operations took effect.
+	OK Outcome = iota
+	// Failed operations definitely did not take effect.
+	Failed
+	// Unknown operations, such as those which timed out, may or may not
+	// have taken effect.
+	Unknown
+)
+
+// A RegisterOpKind is the kind of an operation on a register.
+type RegisterOpKind int
+
+const (
+	// Read reads the value of the register.
+	Read RegisterOpKind = iota
+	// Write sets the value of the register.
+	Write
+	// CAS sets the value of the register if it holds an expected value.
+	CAS
+)
+
+// A RegisterOp is an operation on a register, as recorded by its
+// client. Registers hold integers, and zero stands for an absent value.
+type RegisterOp struct {
+	Kind RegisterOpKind
+	// Value is the value read or written.
+	Value int64
+	// Expected is the value a CAS expects the register to hold.
+	Expected int64
+	Outcome  Outcome
+	// Invoke and Complete are the points in logical time at which the
+	// operation was invoked and completed. Complete is not meaningful
+	// for operations of Unknown outcome.
+	Invoke, Complete int64
+}
+
+func (op RegisterOp) String() string {
+	var s string
+	switch op.Kind {
+	case Read:
+		s = fmt.Sprintf("read %d", op.Value)
+	case Write:
+		s = fmt.Sprintf("write %d", op.Value)
+	case CAS:
+		s = fmt.Sprintf("cas %d->%d", op.Expected, op.Value)
+	}
+	switch op.Outcome {
+	case Failed:
+		s += " (failed)"
+	case Unknown:
+		s += " (unknown)"
+	}
+	return fmt.Sprintf("[%d,%d] %s", op.Invoke, op.Complete, s)
+}
+
+// complete returns the point at which the operation completed, which
+// is infinitely late for operations of Unknown outcome.
+func (op RegisterOp) complete() int64 {
+	if op.Outcome == Unknown {
+		return math.MaxInt64
+	}
+	return op.Complete
+}
+
+// apply applies the operation to a register holding value, returning
+// the register's new value and whether the operation is consistent
+// with the value.
+func (op RegisterOp) apply(value int64) (int64, bool) {
+	switch op.Kind {
+	case Read:
+		return value, op.Value == value
+	case Write:
+		return op.Value, true
+	default:
+		return op.Value, op.Expected == value
+	}
+}
+
+type registerOps []RegisterOp
+
+func (ops registerOps) Len() int           { return len(ops) }
+func (ops registerOps) Swap(i, j int)      { ops[i], ops[j] = ops[j], ops[i] }
+func (ops registerOps) Less(i, j int) bool { return ops[i].Invoke < ops[j].Invoke }
+
+// CheckLinearizable returns an error unless the history of operations
+// on a single register, initially absent, is linearizable: unless each
+// operation can be taken to occur at a single point between its
+// invocation and completion such that every read observes the latest
+// write. Failed operations, and reads of Unknown outcome, place no
+// constraint on the history; the other operations of Unknown outcome
+// may be taken to occur at any point after their invocation, or not at
+// all.
+//
+// The search for a linearization is that of Wing and Gong, pruned by
+// remembering the configurations already visited, as proposed by Lowe.
+func CheckLinearizable(history []RegisterOp) error {
+	var ops registerOps
+	for _, op := range history {
+		if op.Outcome == Failed || (op.Outcome == Unknown && op.Kind == Read) {
+			continue
+		}
+		ops = append(ops, op)
+	}
+	sort.Sort(ops)
+	l := &linearizer{
+		ops:     ops,
+		done:    make([]bool, len(ops)),
+		visited: map[string]struct{}{},
+	}
+	pending := 0
+	for _, op := range ops {
+		if op.Outcome == OK {
+			pending++
+		}
+	}
+	if !l.search(0, pending) {
+		return util.Errorf("history of %d operations is not linearizable: %v", len(ops), ops)
+	}
+	return nil
+}
+
+// A linearizer searches for a linearization of operations sorted by
+// their invocation.
+type linearizer struct {
+	ops     registerOps
+	done    []bool
+	visited map[string]struct{}
+}
+
+// search returns whether the operations not yet done can be linearized
+// starting from a register holding value. pending is the number of
+// those operations which completed; the others need not be linearized.
+func (l *linearizer) search(value int64, pending int) bool {
+	if pending == 0 {
+		return true
+	}
+	key := l.configuration(value)
+	if _, ok := l.visited[key]; ok {
+		return false
+	}
+	l.visited[key] = struct{}{}
+
+	// An operation may be linearized next only if it was invoked
+	// before every outstanding operation completed.
+	first := int64(math.MaxInt64)
+	for i, op := range l.ops {
+		if !l.done[i] && op.complete() < first {
+			first = op.complete()
+		}
+	}
+	for i, op := range l.ops {
+		if op.Invoke > first {
+			break
+		}
+		if l.done[i] {
+			continue
+		}
+		next, ok := op.apply(value)
+		if !ok {
+			continue
+		}
+		remaining := pending
+		if op.Outcome == OK {
+			remaining--
+		}
+		l.done[i] = true
+		if l.search(next, remaining) {
+			return true
+		}
+		l.done[i] = false
+	}
+	return false
+}
+
+// configuration returns a key identifying the operations done and the
+// value of the register.
+func (l *linearizer) configuration(value int64) string {
+	b := make([]byte, (len(l.done)+7)/8, (len(l.done)+7)/8+8)
+	for i, done := range l.done {
+		if done {
+			b[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return string(strconv.AppendInt(b, value, 10))
+}
+
+// A RegisterWorkload has concurrent workers read, write and
+// compare-and-set a number of registers, each stored under a key of
+// its own, through the nodes of a cluster.
+type RegisterWorkload struct {
+	Registers int
+	Workers   int
+	// Timeout bounds each operation, whose outcome is Unknown if it
+	// expires.
+	Timeout time.Duration
+
+	clock  int64 // logical time; accessed atomically
+	values int64 // last value written; accessed atomically
+}
+
+// now returns the next point of the workload's logical time.
+func (w *RegisterWorkload) now() int64 {
+	return atomic.AddInt64(&w.clock, 1)
+}
+
+// Run runs the workload against the cluster until stop is closed and
+// returns the history of each register.
+func (w *RegisterWorkload) Run(c *LocalCluster, rng *rand.Rand, stop <-chan struct{}) (
+	[][]RegisterOp, error) {
+	histories := make([][]RegisterOp, w.Registers)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(chan error, w.Workers)
+	for i := 0; i < w.Workers; i++ {
+		kv, err := c.KV(i % c.NumNodes())
+		if err != nil {
+			return nil, err
+		}
+		workerRand := rand.New(rand.NewSource(rng.Int63()))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				register := workerRand.Intn(w.Registers)
+				op, err := w.runOp(kv, register, workerRand)
+				if err != nil {
+					errs <- err
+					return
+				}
+				mu.Lock()
+				histories[register] = append(histories[register], op)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	select {
+	case err := <-errs:
+		return nil, err
+	default:
+		return histories, nil
+	}
+}
+
+// runOp runs a random operation on the register.
+func (w *RegisterWorkload) runOp(kv *client.KV, register int, rng *rand.Rand) (RegisterOp, error) {
+	key := proto.Key(fmt.Sprintf("register-%03d", register))
+	var op RegisterOp
+	var call client.Call
+	switch rng.Intn(3) {
+	case 0:
+		op.Kind = Read
+		call = client.GetCall(key)
+	case 1:
+		op.Kind = Write
+		op.Value = atomic.AddInt64(&w.values, 1)
+		call = client.PutCall(key, encodeRegisterValue(op.Value))
+	default:
+		op.Kind = CAS
+		op.Value = atomic.AddInt64(&w.values, 1)
+		// Expect one of the recently written values.
+		if last := atomic.LoadInt64(&w.values); last > 1 {
+			op.Expected = last - 1 - rng.Int63n(minInt64(last-1, 5))
+		}
+		value := proto.Value{Bytes: encodeRegisterValue(op.Value)}
+		value.InitChecksum(key)
+		args := &proto.ConditionalPutRequest{
+			RequestHeader: proto.RequestHeader{Key: key},
+			Value:         value,
+		}
+		if op.Expected != 0 {
+			args.ExpValue = &proto.Value{Bytes: encodeRegisterValue(op.Expected)}
+		}
+		call = client.Call{Args: args, Reply: &proto.ConditionalPutResponse{}}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.Timeout)
+	defer cancel()
+	op.Invoke = w.now()
+	err := kv.RunWithContext(ctx, call)
+	op.Complete = w.now()
+	switch err.(type) {
+	case nil:
+		op.Outcome = OK
+	case *proto.ConditionFailedError:
+		op.Outcome = Failed
+	default:
+		op.Outcome = Unknown
+	}
+	if op.Kind == Read && op.Outcome == OK {
+		reply := call.Reply.(*proto.GetResponse)
+		if reply.Value != nil {
+			v, err := strconv.ParseInt(string(reply.Value.Bytes), 10, 64)
+			if err != nil {
+				return op, util.Errorf("invalid value of %s: %q", key, reply.Value.Bytes)
+			}
+			op.Value = v
+		}
+	}
+	return op, nil
+}
+
+func encodeRegisterValue(v int64) []byte {
+	return []byte(strconv.FormatInt(v, 10))
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
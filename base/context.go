@@ -39,6 +39,12 @@ type Context struct {
 	// Certs specifies a directory containing RSA key and x509 certs.
 	Certs string
 
+	// User, if set, is the user whose client certificate (client.<user>.crt
+	// in the Certs directory, or node.crt for security.NodeUser) is
+	// presented by client connections, and so the user client requests
+	// are authenticated as.
+	User string
+
 	// clientTLSConfig is the loaded client tlsConfig. It is initialized lazily.
 	clientTLSConfig *tls.Config
 	// serverTLSConfig is the loaded server tlsConfig. It is initialized lazily.
@@ -75,7 +81,7 @@ func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 		ctx.clientTLSConfig = security.LoadInsecureClientTLSConfig()
 	} else {
 		log.V(1).Infof("setting up TLS from certificates directory: %s", ctx.Certs)
-		cfg, err := security.LoadClientTLSConfigFromDir(ctx.Certs)
+		cfg, err := ctx.loadClientTLSConfig()
 		if err != nil {
 			return nil, util.Errorf("error setting up client TLS config: %s", err)
 		}
@@ -85,6 +91,15 @@ func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 	return ctx.clientTLSConfig, nil
 }
 
+// loadClientTLSConfig loads the client TLS config from the Certs
+// directory, including the client certificate of User if set.
+func (ctx *Context) loadClientTLSConfig() (*tls.Config, error) {
+	if ctx.User != "" {
+		return security.LoadUserClientTLSConfigFromDir(ctx.Certs, ctx.User)
+	}
+	return security.LoadClientTLSConfigFromDir(ctx.Certs)
+}
+
 // GetServerTLSConfig returns the context server TLS config, initializing it
 // if needed. It uses the context Certs field.
 // If Certs is empty, load insecure configs.
@@ -111,6 +126,36 @@ func (ctx *Context) GetServerTLSConfig() (*tls.Config, error) {
 	return ctx.serverTLSConfig, nil
 }
 
+// ReloadTLSConfigs reloads the client and server TLS configs from the
+// context Certs field, so that rotated certificates can be picked up
+// without a restart. The previously loaded configs are kept if either
+// fails to load. The http client is recreated on its next use.
+func (ctx *Context) ReloadTLSConfigs() error {
+	var client, server *tls.Config
+	var err error
+	if ctx.Certs == "" {
+		client, server = security.LoadInsecureClientTLSConfig(), security.LoadInsecureTLSConfig()
+	} else {
+		log.Infof("reloading TLS from certificates directory: %s", ctx.Certs)
+		if client, err = ctx.loadClientTLSConfig(); err != nil {
+			return util.Errorf("error reloading client TLS config: %s", err)
+		}
+		if server, err = security.LoadTLSConfigFromDir(ctx.Certs); err != nil {
+			return util.Errorf("error reloading server TLS config: %s", err)
+		}
+	}
+
+	ctx.tlsConfigMu.Lock()
+	ctx.clientTLSConfig, ctx.serverTLSConfig = client, server
+	ctx.tlsConfigMu.Unlock()
+
+	ctx.httpClientMu.Lock()
+	ctx.httpClient = nil
+	ctx.httpClientMu.Unlock()
+
+	return nil
+}
+
 // GetHTTPClient returns the context http client, initializing it
 // if needed. It uses the context client TLS config.
 func (ctx *Context) GetHTTPClient() (*http.Client, error) {
@@ -19,9 +19,11 @@ package client
 
 import (
 	"math/rand"
+	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // A Call is a pending database API call.
@@ -31,16 +33,47 @@ type Call struct {
 	Err   error          // Error during call creation
 }
 
-// resetClientCmdID sets the client command ID if the call is for a
-// read-write method. The client command ID provides idempotency
-// protection in conjunction with the server.
-func (c *Call) resetClientCmdID(clock Clock) {
-	c.Args.Header().CmdID = proto.ClientCmdID{
-		WallTime: clock.Now(),
-		Random:   rand.Int63(),
+// initClientCmdID sets the client command ID if the call doesn't
+// already have one. The client command ID provides idempotency
+// protection in conjunction with the server: the ID is retained when
+// the same Call is run again, so that a write retried after an
+// ambiguous failure (e.g. an RPC error after the command was sent) is
+// answered from the replica's response cache rather than applied a
+// second time. Callers wanting a command to execute anew must create
+// a new Call.
+func (c *Call) initClientCmdID(clock Clock) {
+	if c.Args.Header().CmdID.IsEmpty() {
+		c.Args.Header().CmdID = proto.ClientCmdID{
+			WallTime: clock.Now(),
+			Random:   rand.Int63(),
+		}
 	}
 }
 
+// SetTimeout sets the timeout in the request header from the deadline
+// of ctx, if it has one. Senders invoke it immediately before
+// forwarding a request to another node. A deadline which has already
+// passed yields the minimal timeout, as zero means none.
+func SetTimeout(ctx context.Context, args proto.Request) {
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := deadline.Sub(time.Now())
+		if timeout <= 0 {
+			timeout = 1
+		}
+		args.Header().Timeout = int64(timeout)
+	}
+}
+
+// RequestContext returns a context for executing the request which is
+// done once the timeout in the request header, if any, expires. The
+// returned cancel function must be called once the request completes.
+func RequestContext(args proto.Request) (context.Context, context.CancelFunc) {
+	if timeout := args.Header().Timeout; timeout > 0 {
+		return context.WithTimeout(context.Background(), time.Duration(timeout))
+	}
+	return context.WithCancel(context.Background())
+}
+
 // Method returns the method of the database command for the call.
 func (c *Call) Method() proto.Method {
 	return c.Args.Method()
@@ -88,6 +121,23 @@ func PutCall(key proto.Key, valueBytes []byte) Call {
 	}
 }
 
+// InitPutCall returns a Call object initialized to put value as a
+// byte slice at key, provided the key doesn't exist or already holds
+// the same value.
+func InitPutCall(key proto.Key, valueBytes []byte) Call {
+	value := proto.Value{Bytes: valueBytes}
+	value.InitChecksum(key)
+	return Call{
+		Args: &proto.InitPutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key: key,
+			},
+			Value: value,
+		},
+		Reply: &proto.InitPutResponse{},
+	}
+}
+
 // PutProtoCall returns a Call object initialized to put the proto
 // message as a byte slice at key.
 func PutProtoCall(key proto.Key, msg gogoproto.Message) Call {
@@ -135,6 +185,21 @@ func DeleteRangeCall(startKey, endKey proto.Key) Call {
 	}
 }
 
+// ClearRangeCall returns a Call object initialized to remove all
+// values, including historical versions, in the given key range
+// (excluding the endpoint).
+func ClearRangeCall(startKey, endKey proto.Key) Call {
+	return Call{
+		Args: &proto.ClearRangeRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:    startKey,
+				EndKey: endKey,
+			},
+		},
+		Reply: &proto.ClearRangeResponse{},
+	}
+}
+
 // ScanCall returns a Call object initialized to scan from start to
 // end keys with max results.
 func ScanCall(key, endKey proto.Key, maxResults int64) Call {
@@ -149,3 +214,18 @@ func ScanCall(key, endKey proto.Key, maxResults int64) Call {
 		Reply: &proto.ScanResponse{},
 	}
 }
+
+// AggregateCall returns a Call object initialized to count and sum the
+// integer values of up to maxResults keys from start to end keys.
+func AggregateCall(key, endKey proto.Key, maxResults int64) Call {
+	return Call{
+		Args: &proto.AggregateRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:    key,
+				EndKey: endKey,
+			},
+			MaxResults: maxResults,
+		},
+		Reply: &proto.AggregateResponse{},
+	}
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // notifyingSender is a sender which can set up a notification channel
@@ -60,8 +61,8 @@ func (ss *notifyingSender) wait() {
 	ss.waiter = nil
 }
 
-func (ss *notifyingSender) Send(call client.Call) {
-	ss.wrapped.Send(call)
+func (ss *notifyingSender) Send(ctx context.Context, call client.Call) {
+	ss.wrapped.Send(ctx, call)
 	if ss.waiter != nil {
 		ss.waiter.Done()
 	}
@@ -440,6 +441,56 @@ func TestKVClientBatch(t *testing.T) {
 	}
 }
 
+// TestKVClientScanPages verifies that ScanPages returns all rows of
+// the span in order, in pages of the requested size.
+func TestKVClientScanPages(t *testing.T) {
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	kvClient := createTestNotifyClient(s.ServingAddr())
+	kvClient.User = storage.UserRoot
+
+	calls := []client.Call{}
+	for i := 0; i < 10; i++ {
+		calls = append(calls, client.IncrementCall(proto.Key(fmt.Sprintf("key %02d", i)), int64(i)))
+	}
+	if err := kvClient.Run(calls...); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		maxResults, targetBytes int64
+		expPages                int
+	}{
+		{0, 0, 1},
+		{3, 0, 4},
+		{5, 0, 2},
+		{0, 1, 10},
+	}
+	for i, test := range testCases {
+		var pages int
+		var rows []proto.KeyValue
+		if err := kvClient.ScanPages(proto.Key("key 00"), proto.Key("key 10"), test.maxResults, test.targetBytes,
+			func(page []proto.KeyValue) error {
+				pages++
+				rows = append(rows, page...)
+				return nil
+			}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if pages != test.expPages {
+			t.Errorf("%d: expected %d pages; got %d", i, test.expPages, pages)
+		}
+		if len(rows) != 10 {
+			t.Fatalf("%d: expected 10 rows; got %d", i, len(rows))
+		}
+		for j, row := range rows {
+			if val := row.Value.GetInteger(); val != int64(j) {
+				t.Errorf("%d: expected row %d to be %d; got %d", i, j, j, val)
+			}
+		}
+	}
+}
+
 // This is an example for using the Run() method to Put and then Get
 // a value for a given key.
 func ExampleKV_Run1() {
@@ -738,7 +789,7 @@ func setupClientBenchData(numVersions, numKeys int, b *testing.B) (*server.TestS
 	s.Ctx = server.NewTestContext()
 	s.Ctx.ExperimentalRPCServer = true
 	s.SkipBootstrap = exists
-	s.Engine = engine.NewRocksDB(proto.Attributes{Attrs: []string{"ssd"}}, loc, cacheSize)
+	s.Engine = engine.NewRocksDB(proto.Attributes{Attrs: []string{"ssd"}}, loc, engine.RocksDBOptions{CacheSize: cacheSize})
 	if err := s.Start(); err != nil {
 		b.Fatalf("Could not start server: %v", err)
 	}
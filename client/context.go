@@ -44,6 +44,14 @@ var (
 		Constant:    2,
 		MaxAttempts: 0, // retry indefinitely
 	}
+	// DefaultRetryOptions are the standard retry options used for
+	// calls which fail with transient errors.
+	DefaultRetryOptions = util.RetryOptions{
+		Backoff:    50 * time.Millisecond,
+		MaxBackoff: 1 * time.Second,
+		Constant:   2,
+		Timeout:    10 * time.Second,
+	}
 	DefaultClock = systemClock{}
 )
 
@@ -52,6 +60,7 @@ type Context struct {
 	User            string
 	UserPriority    int32
 	TxnRetryOptions util.RetryOptions
+	RetryOptions    util.RetryOptions
 	Clock           Clock
 }
 
@@ -60,5 +69,6 @@ func NewContext() *Context {
 	return &Context{
 		Clock:           DefaultClock,
 		TxnRetryOptions: DefaultTxnRetryOptions,
+		RetryOptions:    DefaultRetryOptions,
 	}
 }
@@ -112,6 +112,14 @@ expect retries as a matter of course. This is why the transaction
 functionality is exposed through a retryable function. The retryable
 function should have no side effects which are not idempotent.
 
+Transactions are SERIALIZABLE unless TransactionOptions.Isolation
+selects SNAPSHOT. Both read from a snapshot fixed at the transaction's
+start. A SNAPSHOT transaction may commit even if its writes were pushed
+to a later timestamp by concurrent readers, and restarts only when it
+writes a key which another transaction committed since its snapshot
+was taken. This means fewer retries under contention, at the cost of
+allowing write skew anomalies.
+
 Transactions should endeavor to write using KV.Prepare calls. This
 allows writes to the same range to be batched together. In cases where
 the entire transaction affects only a single range, transactions can
@@ -19,11 +19,15 @@ package client
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"code.google.com/p/snappy-go/snappy"
@@ -31,6 +35,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -39,6 +44,12 @@ const (
 	KVDBEndpoint = "/kv/db/"
 	// KVDBScheme is the scheme for connecting to the kvdb endpoint.
 	KVDBScheme = "https"
+	// HealthEndpoint is the URL path which answers GET requests with
+	// "ok" if the node is serving.
+	HealthEndpoint = "/_admin/health"
+	// NodesEndpoint is the URL path which lists the nodes of the
+	// cluster, as known via gossip, in JSON.
+	NodesEndpoint = "/_status/nodes/"
 	// StatusTooManyRequests indicates client should retry due to
 	// server having too many requests.
 	StatusTooManyRequests = 429
@@ -59,21 +70,185 @@ var HTTPRetryOptions = util.RetryOptions{
 	MaxAttempts: 0, // retry indefinitely
 }
 
+var (
+	// HTTPHealthCheckInterval is how long a node which failed to
+	// serve a request is avoided before it's health-checked again.
+	HTTPHealthCheckInterval = 5 * time.Second
+	// HTTPNodeRefreshInterval is how often the list of nodes is
+	// updated from the node descriptors gossiped in the cluster.
+	HTTPNodeRefreshInterval = 1 * time.Minute
+)
+
+// httpServer is the state of a node known to an HTTPSender.
+type httpServer struct {
+	addr      string    // The host:port address of the node
+	seed      bool      // Whether the address was supplied to NewHTTPSender
+	unhealthy bool      // Whether the node failed to serve the last request
+	failed    time.Time // When the node was last found unhealthy
+	checking  bool      // Whether a health check is in progress
+}
+
 // HTTPSender is an implementation of KVSender which exposes the
 // Key-Value database provided by a Cockroach cluster by connecting
-// via HTTP to a Cockroach node. Overly-busy nodes will redirect
-// this client to other nodes.
+// via HTTP to Cockroach nodes. Requests are sent round-robin to the
+// healthy nodes of those supplied and those learned from the node
+// descriptors gossiped in the cluster. A node which fails to serve a
+// request is avoided until it passes a health check. Overly-busy
+// nodes will redirect this client to other nodes.
 type HTTPSender struct {
-	server string       // The host:port address of the Cockroach gateway node
 	client *http.Client // The HTTP client
+
+	mu        sync.Mutex
+	servers   []*httpServer // The known gateway nodes
+	next      int           // Index of the next server to try
+	refreshed time.Time     // When the node list was last updated
 }
 
-// NewHTTPSender returns a new instance of HTTPSender.
+// NewHTTPSender returns a new instance of HTTPSender. server is the
+// host:port address of a Cockroach gateway node, or a comma-separated
+// list of them.
 func NewHTTPSender(server string, client *http.Client) *HTTPSender {
-	return &HTTPSender{
-		server: server,
-		client: client,
+	s := &HTTPSender{
+		client:    client,
+		refreshed: time.Now(),
+	}
+	for _, addr := range strings.Split(server, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			s.servers = append(s.servers, &httpServer{addr: addr, seed: true})
+		}
+	}
+	return s
+}
+
+// pickServer returns the address of the next healthy server in
+// round-robin order. If all servers are unhealthy, the next one is
+// returned regardless. Health checks of unhealthy servers and updates
+// of the node list are kicked off as they become due.
+func (s *HTTPSender) pickServer() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.servers) == 0 {
+		return "", util.Errorf("no server addresses")
+	}
+	now := time.Now()
+	var picked *httpServer
+	for i := range s.servers {
+		idx := (s.next + i) % len(s.servers)
+		srv := s.servers[idx]
+		if srv.unhealthy {
+			if !srv.checking && now.Sub(srv.failed) >= HTTPHealthCheckInterval {
+				srv.checking = true
+				go s.checkHealth(srv)
+			}
+			continue
+		}
+		if picked == nil {
+			picked = srv
+			s.next = idx + 1
+		}
+	}
+	if picked == nil {
+		picked = s.servers[s.next%len(s.servers)]
+		s.next++
+	} else if now.Sub(s.refreshed) >= HTTPNodeRefreshInterval {
+		s.refreshed = now
+		go s.refreshServers(picked.addr)
+	}
+	s.next %= len(s.servers)
+	return picked.addr, nil
+}
+
+// markUnhealthy records that the server at addr failed to serve a
+// request, so that it's avoided until it passes a health check.
+func (s *HTTPSender) markUnhealthy(addr string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, srv := range s.servers {
+		if srv.addr == addr {
+			srv.unhealthy = true
+			srv.failed = time.Now()
+		}
+	}
+}
+
+// checkHealth GETs the health endpoint of srv and marks it healthy if
+// it answers successfully.
+func (s *HTTPSender) checkHealth(srv *httpServer) {
+	healthy := false
+	if resp, err := s.client.Get(KVDBScheme + "://" + srv.addr + HealthEndpoint); err == nil {
+		resp.Body.Close()
+		healthy = resp.StatusCode == http.StatusOK
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	srv.checking = false
+	if healthy {
+		srv.unhealthy = false
+	} else {
+		srv.failed = time.Now()
+	}
+}
+
+// refreshServers updates the list of servers from the nodes which the
+// server at addr knows via gossip. Supplied addresses are kept, as are
+// the health of servers already known.
+func (s *HTTPSender) refreshServers(addr string) {
+	req, err := http.NewRequest("GET", KVDBScheme+"://"+addr+NodesEndpoint, nil)
+	if err != nil {
+		log.Warningf("unable to create node list request: %s", err)
+		return
+	}
+	req.Header.Add("Accept", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		log.Warningf("failed to fetch node list from %s: %s", addr, err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Warningf("failed to fetch node list from %s: %s", addr, resp.Status)
+		return
+	}
+	var list struct {
+		Nodes []struct {
+			Addr string `json:"addr"`
+		} `json:"nodes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		log.Warningf("failed to decode node list from %s: %s", addr, err)
+		return
+	}
+	if len(list.Nodes) == 0 {
+		// The node hasn't joined the gossip network yet.
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	known := map[string]*httpServer{}
+	added := map[string]bool{}
+	var servers []*httpServer
+	for _, srv := range s.servers {
+		known[srv.addr] = srv
+		if srv.seed {
+			servers = append(servers, srv)
+			added[srv.addr] = true
+		}
+	}
+	for _, n := range list.Nodes {
+		// Skip addresses which can't be dialed from elsewhere.
+		if host, _, err := net.SplitHostPort(n.Addr); err != nil || host == "" || added[n.Addr] {
+			continue
+		}
+		added[n.Addr] = true
+		srv, ok := known[n.Addr]
+		if !ok {
+			srv = &httpServer{addr: n.Addr}
+		}
+		servers = append(servers, srv)
+	}
+	s.servers = servers
+	s.next %= len(s.servers)
 }
 
 // Send sends call to Cockroach via an HTTP post. HTTP response codes
@@ -83,20 +258,31 @@ func NewHTTPSender(server string, client *http.Client) *HTTPSender {
 // reporting failure when in fact the command may have gone through
 // and been executed successfully. We retry here to eventually get
 // through with the same client command ID and be given the cached
-// response.
-func (s *HTTPSender) Send(call Call) {
+// response. Each attempt goes to the next healthy server; a server
+// which fails to serve the request is avoided by subsequent attempts.
+// Retries end once ctx is done.
+func (s *HTTPSender) Send(ctx context.Context, call Call) {
 	retryOpts := HTTPRetryOptions
 	retryOpts.Tag = fmt.Sprintf("https %s", call.Method())
 
 	if err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
-		resp, err := s.post(call)
+		if err := ctx.Err(); err != nil {
+			return util.RetryBreak, err
+		}
+		SetTimeout(ctx, call.Args)
+		server, err := s.pickServer()
+		if err != nil {
+			return util.RetryBreak, err
+		}
+		resp, err := s.post(server, call)
 		if err != nil {
 			if resp != nil {
 				log.Warningf("failed to send HTTP request with status code %d, %s", resp.StatusCode, resp.Status)
 				// See if we can retry based on HTTP response code.
 				switch resp.StatusCode {
 				case http.StatusServiceUnavailable, http.StatusGatewayTimeout, StatusTooManyRequests:
-					// Retry on service unavailable and request timeout.
+					// Retry on service unavailable and request timeout. The
+					// retry goes to the next server.
 					// TODO(spencer): consider respecting the Retry-After header for
 					// backoff / retry duration.
 					return util.RetryContinue, nil
@@ -114,7 +300,8 @@ func (s *HTTPSender) Send(call Call) {
 				// warning so there's visiblity that this is happening. Some of
 				// the errors we'll sweep up in this net shouldn't be retried,
 				// but we can't really know for sure which.
-				log.Warningf("failed to send HTTP request or read its response: %s", t)
+				log.Warningf("failed to send HTTP request to %s or read its response: %s", server, t)
+				s.markUnhealthy(server)
 				return util.RetryContinue, nil
 			default:
 				// Can't retry in order to recover from this error. Propagate.
@@ -128,20 +315,20 @@ func (s *HTTPSender) Send(call Call) {
 	}
 }
 
-// post posts the call using the HTTP client. The call's method is
+// post posts the call to server using the HTTP client. The call's method is
 // appended to KVDBEndpoint and set as the URL path. The call's arguments
 // are protobuf-serialized and written as the POST body. The content
 // type is set to application/x-protobuf.
 //
 // On success, the response body is unmarshalled into call.Reply.
-func (s *HTTPSender) post(call Call) (*http.Response, error) {
+func (s *HTTPSender) post(server string, call Call) (*http.Response, error) {
 	// Marshal the args into a request body.
 	body, err := gogoproto.Marshal(call.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	url := KVDBScheme + "://" + s.server + KVDBEndpoint + call.Method().String()
+	url := KVDBScheme + "://" + server + KVDBEndpoint + call.Method().String()
 	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
 	if err != nil {
 		return nil, util.Errorf("unable to create request: %s", err)
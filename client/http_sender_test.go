@@ -22,12 +22,15 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
+	"golang.org/x/net/context"
 )
 
 var (
@@ -79,7 +82,7 @@ func TestHTTPSenderSend(t *testing.T) {
 	}
 	sender := NewHTTPSender(addr, httpClient)
 	reply := &proto.PutResponse{}
-	sender.Send(Call{Args: testPutReq, Reply: reply})
+	sender.Send(context.Background(), Call{Args: testPutReq, Reply: reply})
 	if reply.GoError() != nil {
 		t.Errorf("expected success; got %s", reply.GoError())
 	}
@@ -135,7 +138,7 @@ func TestHTTPSenderRetryResponseCodes(t *testing.T) {
 
 		sender := NewHTTPSender(addr, httpClient)
 		reply := &proto.PutResponse{}
-		sender.Send(Call{Args: testPutReq, Reply: reply})
+		sender.Send(context.Background(), Call{Args: testPutReq, Reply: reply})
 		if test.retry {
 			if count != 2 {
 				t.Errorf("%d: expected retry", i)
@@ -197,7 +200,7 @@ func TestHTTPSenderRetryHTTPSendError(t *testing.T) {
 		s = server
 		sender := NewHTTPSender(addr, httpClient)
 		reply := &proto.PutResponse{}
-		sender.Send(Call{Args: testPutReq, Reply: reply})
+		sender.Send(context.Background(), Call{Args: testPutReq, Reply: reply})
 		if reply.GoError() != nil {
 			t.Errorf("%d: expected success; got %s", i, reply.GoError())
 		}
@@ -207,3 +210,143 @@ func TestHTTPSenderRetryHTTPSendError(t *testing.T) {
 		server.Close()
 	}
 }
+
+// putHandler returns a handler which answers health checks and puts.
+// Puts are counted in count; a put for which fail returns true has
+// its connection closed instead of being answered.
+func putHandler(count *int32, fail func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == HealthEndpoint {
+			fmt.Fprintln(w, "ok")
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, KVDBEndpoint) {
+			http.NotFound(w, r)
+			return
+		}
+		atomic.AddInt32(count, 1)
+		if fail != nil && fail() {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				panic(err)
+			}
+			conn.Close()
+			return
+		}
+		body, contentType, err := util.MarshalResponse(r, testPutResp, util.AllEncodings)
+		if err != nil {
+			panic(err)
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Write(body)
+	})
+}
+
+func sendTestPut(t *testing.T, sender *HTTPSender) {
+	reply := &proto.PutResponse{}
+	sender.Send(context.Background(), Call{Args: testPutReq, Reply: reply})
+	if err := reply.GoError(); err != nil {
+		t.Fatalf("expected success; got %s", err)
+	}
+}
+
+// TestHTTPSenderRoundRobin verifies that requests are spread across
+// the supplied servers.
+func TestHTTPSenderRoundRobin(t *testing.T) {
+	var counts [3]int32
+	var addrs []string
+	for i := range counts {
+		server, addr := startTestHTTPServer(putHandler(&counts[i], nil))
+		defer server.Close()
+		addrs = append(addrs, addr)
+	}
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := NewHTTPSender(strings.Join(addrs, ", "), httpClient)
+	for i := 0; i < 2*len(counts); i++ {
+		sendTestPut(t, sender)
+	}
+	for i := range counts {
+		if c := atomic.LoadInt32(&counts[i]); c != 2 {
+			t.Errorf("expected server %d to receive 2 requests; got %d", i, c)
+		}
+	}
+}
+
+// TestHTTPSenderFailover verifies that a server which fails to serve a
+// request is avoided until it passes a health check.
+func TestHTTPSenderFailover(t *testing.T) {
+	defer func(interval time.Duration) { HTTPHealthCheckInterval = interval }(HTTPHealthCheckInterval)
+	HTTPHealthCheckInterval = time.Hour
+	HTTPRetryOptions.Backoff = 1 * time.Millisecond
+
+	var failing int32 = 1
+	var countA, countB int32
+	serverA, addrA := startTestHTTPServer(putHandler(&countA, func() bool {
+		return atomic.LoadInt32(&failing) == 1
+	}))
+	defer serverA.Close()
+	serverB, addrB := startTestHTTPServer(putHandler(&countB, nil))
+	defer serverB.Close()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := NewHTTPSender(addrA+","+addrB, httpClient)
+	for i := 0; i < 4; i++ {
+		sendTestPut(t, sender)
+	}
+	if a, b := atomic.LoadInt32(&countA), atomic.LoadInt32(&countB); a != 1 || b != 4 {
+		t.Errorf("expected 1 failed request to A and 4 requests to B; got %d and %d", a, b)
+	}
+
+	// Once A recovers and its health check is due, it's used again.
+	atomic.StoreInt32(&failing, 0)
+	HTTPHealthCheckInterval = 0
+	if err := util.IsTrueWithin(func() bool {
+		sendTestPut(t, sender)
+		return atomic.LoadInt32(&countA) > 1
+	}, 500*time.Millisecond); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestHTTPSenderRefreshServers verifies that servers are learned from
+// the node list of a known server.
+func TestHTTPSenderRefreshServers(t *testing.T) {
+	defer func(interval time.Duration) { HTTPNodeRefreshInterval = interval }(HTTPNodeRefreshInterval)
+	HTTPNodeRefreshInterval = 0
+
+	var countA, countB int32
+	serverB, addrB := startTestHTTPServer(putHandler(&countB, nil))
+	defer serverB.Close()
+	handlerA := putHandler(&countA, nil)
+	serverA, addrA := startTestHTTPServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == NodesEndpoint {
+			fmt.Fprintf(w, `{"nodes": [{"addr": %q}, {"addr": ":8080"}]}`, addrB)
+			return
+		}
+		handlerA.ServeHTTP(w, r)
+	}))
+	defer serverA.Close()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := NewHTTPSender(addrA, httpClient)
+	if err := util.IsTrueWithin(func() bool {
+		sendTestPut(t, sender)
+		return atomic.LoadInt32(&countB) > 0
+	}, 500*time.Millisecond); err != nil {
+		t.Error(err)
+	}
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	if len(sender.servers) != 2 {
+		t.Errorf("expected the unspecified address to be skipped; got %d servers", len(sender.servers))
+	}
+}
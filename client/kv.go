@@ -18,34 +18,61 @@
 package client
 
 import (
+	"net"
+	"time"
+
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// User priorities for TransactionOptions.UserPriority and
+// KV.UserPriority. A transaction with user priority N is N times as
+// likely to win a conflict as one with NormalUserPriority. Transactions
+// with MaxUserPriority, meant for administrative operations, win all
+// conflicts except those with other MaxUserPriority transactions,
+// which the older transaction wins.
+const (
+	NormalUserPriority int32 = 1
+	HighUserPriority   int32 = 100
+	MaxUserPriority    int32 = proto.MaxPriority
 )
 
 // TransactionOptions are parameters for use with KV.RunTransaction.
 type TransactionOptions struct {
-	Name         string // Concise desc of txn for debugging
-	Isolation    proto.IsolationType
-	UserPriority int32
+	Name         string              // Concise desc of txn for debugging
+	Isolation    proto.IsolationType // SERIALIZABLE (default) or SNAPSHOT
+	UserPriority int32               // See NormalUserPriority; zero is normal
 }
 
 // KVSender is an interface for sending a request to a Key-Value
 // database backend.
 type KVSender interface {
 	// Send invokes the Call.Method with Call.Args and sets the result
-	// in Call.Reply.
-	Send(Call)
+	// in Call.Reply. Senders should stop waiting on the call and set
+	// ctx.Err() in Call.Reply once the context is done.
+	Send(context.Context, Call)
+}
+
+// A ScanStreamer is a KVSender which can stream the rows of a scan
+// back as they are produced instead of returning them in the reply.
+type ScanStreamer interface {
+	// ScanStream sends the Scan call c, passing its rows to f in order
+	// as they arrive and setting the rest of the result in Call.Reply.
+	// If f returns an error, the scan stops and the error is set in
+	// Call.Reply.
+	ScanStream(ctx context.Context, c Call, f func(rows []proto.KeyValue) error)
 }
 
 // KVSenderFunc is an adapter to allow the use of ordinary functions
 // as KVSenders.
-type KVSenderFunc func(Call)
+type KVSenderFunc func(context.Context, Call)
 
-// Send calls f(c).
-func (f KVSenderFunc) Send(c Call) {
-	f(c)
+// Send calls f(ctx, c).
+func (f KVSenderFunc) Send(ctx context.Context, c Call) {
+	f(ctx, c)
 }
 
 // A Clock is an interface which provides the current time.
@@ -65,8 +92,11 @@ type KV struct {
 	// ignored.
 	UserPriority    int32
 	TxnRetryOptions util.RetryOptions
-	Sender          KVSender
-	clock           Clock
+	// RetryOptions govern the retry of calls which fail with transient
+	// errors, such as a stale range descriptor or a missing leader.
+	RetryOptions util.RetryOptions
+	Sender       KVSender
+	clock        Clock
 }
 
 // NewKV creates a new instance of KV using the specified sender. To
@@ -84,13 +114,68 @@ func NewKV(ctx *Context, sender KVSender) *KV {
 		User:            ctx.User,
 		UserPriority:    ctx.UserPriority,
 		TxnRetryOptions: ctx.TxnRetryOptions,
+		RetryOptions:    ctx.RetryOptions,
 		clock:           ctx.Clock,
 	}
 }
 
+// KVAt returns a copy of kv which reads the database as of the
+// historical timestamp ts, e.g. for point-in-time debugging or for
+// consistent scans spanning several ranges without a transaction.
+// Reads are subject to the GC TTL of the zones read: ts must be
+// within the TTL of the present. Calls other than reads fail.
+func KVAt(kv *KV, ts proto.Timestamp) *KV {
+	at := *kv
+	sender := kv.Sender
+	at.Sender = KVSenderFunc(func(ctx context.Context, call Call) {
+		requests := []proto.Request{call.Args}
+		if b, ok := call.Args.(*proto.BatchRequest); ok {
+			requests = requests[:0]
+			for _, r := range b.Requests {
+				requests = append(requests, r.GetValue().(proto.Request))
+			}
+		}
+		for _, args := range requests {
+			if !proto.IsReadOnly(args) || args.Header().Txn != nil {
+				call.Reply.Header().SetGoError(util.Errorf("%s not permitted in a historical read", args.Method()))
+				return
+			}
+			args.Header().Timestamp = ts
+		}
+		sender.Send(ctx, call)
+	})
+	return &at
+}
+
+// isTransientError returns whether err is a transient failure which
+// may succeed if the call is retried: addressing errors caused by a
+// stale range descriptor or leader, RPC failures and timeouts. All
+// other errors are fatal and returned to the caller.
+func isTransientError(err error) bool {
+	if r, ok := err.(util.Retryable); ok {
+		return r.CanRetry()
+	}
+	if netErr, ok := err.(net.Error); ok {
+		return netErr.Timeout()
+	}
+	return false
+}
+
 // Run runs the specified calls synchronously in a single batch and
-// returns any errors.
-func (kv *KV) Run(calls ...Call) (err error) {
+// returns any errors. Calls failing with transient errors are retried
+// according to kv.RetryOptions; the client command ID is preserved
+// across retries so that a command is not applied twice. Calls which
+// may or may not have been applied fail with a
+// proto.AmbiguousResultError, which isn't retried.
+func (kv *KV) Run(calls ...Call) error {
+	return kv.RunWithContext(context.Background(), calls...)
+}
+
+// RunWithContext is like Run, but gives up on the calls once ctx is
+// done, returning ctx.Err(). A deadline on ctx also bounds the retry
+// of transient errors and is propagated to the servers executing the
+// calls, which abandon commands still queued when it expires.
+func (kv *KV) RunWithContext(ctx context.Context, calls ...Call) (err error) {
 	if len(calls) == 0 {
 		return nil
 	}
@@ -112,11 +197,38 @@ func (kv *KV) Run(calls ...Call) (err error) {
 		if c.Args.Header().UserPriority == nil && kv.UserPriority != 0 {
 			c.Args.Header().UserPriority = gogoproto.Int32(kv.UserPriority)
 		}
-		c.resetClientCmdID(kv.clock)
-		kv.Sender.Send(c)
-		err = c.Reply.Header().GoError()
-		if err != nil {
-			log.Infof("failed %s: %s", c.Method(), err)
+		c.initClientCmdID(kv.clock)
+		retryOpts := kv.RetryOptions
+		retryOpts.Tag = c.Method().String()
+		if deadline, ok := ctx.Deadline(); ok {
+			if timeout := deadline.Sub(time.Now()); retryOpts.Timeout == 0 || timeout < retryOpts.Timeout {
+				retryOpts.Timeout = timeout
+			}
+		}
+		retrying := false
+		retryErr := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
+			if retrying {
+				c.Reply.Reset()
+			}
+			retrying = true
+			if err = ctx.Err(); err != nil {
+				c.Reply.Header().SetGoError(err)
+				return util.RetryBreak, err
+			}
+			kv.Sender.Send(ctx, c)
+			if err = c.Reply.Header().GoError(); err == nil {
+				return util.RetryBreak, nil
+			}
+			if !isTransientError(err) {
+				log.Infof("failed %s: %s", c.Method(), err)
+				return util.RetryBreak, err
+			}
+			return util.RetryContinue, err
+		})
+		// Once the retries are exhausted, the last call's error is
+		// returned rather than that of the retry loop.
+		if err == nil {
+			err = retryErr
 		}
 		return
 	}
@@ -124,10 +236,11 @@ func (kv *KV) Run(calls ...Call) (err error) {
 	replies := make([]proto.Response, 0, len(calls))
 	bArgs, bReply := &proto.BatchRequest{}, &proto.BatchResponse{}
 	for _, call := range calls {
+		call.initClientCmdID(kv.clock)
 		bArgs.Add(call.Args)
 		replies = append(replies, call.Reply)
 	}
-	err = kv.Run(Call{Args: bArgs, Reply: bReply})
+	err = kv.RunWithContext(ctx, Call{Args: bArgs, Reply: bReply})
 
 	// Recover from protobuf merge panics.
 	defer func() {
@@ -150,6 +263,67 @@ func (kv *KV) Run(calls ...Call) (err error) {
 	return
 }
 
+// ScanPages scans the keys from key up to endKey in pages of at most
+// maxResults rows and about targetBytes bytes each, either of which
+// may be zero for no limit, passing the rows of each page to f in
+// order. This keeps a large scan from being buffered in full in a
+// single response. Each page is a separate call, so pages read
+// outside of a transaction are mutually consistent only if kv reads
+// at a fixed timestamp; see KVAt. Scanning stops at the first error
+// returned by f or a call, which is returned.
+func (kv *KV) ScanPages(key, endKey proto.Key, maxResults, targetBytes int64, f func([]proto.KeyValue) error) error {
+	return scanPages(kv.Run, key, endKey, maxResults, targetBytes, f)
+}
+
+// scanStreamPageBytes is the size of the pages in which ScanStream
+// scans the span if the sender can't stream it.
+const scanStreamPageBytes = 1 << 20 // 1M
+
+// ScanStream scans the keys from key up to endKey, passing the rows to
+// f in order as they arrive. If kv's sender is a ScanStreamer, each
+// range streams its rows back in chunks as it scans them; otherwise
+// the span is scanned in pages with ScanPages. Scanning stops at the
+// first error returned by f or the scan, which is returned.
+func (kv *KV) ScanStream(key, endKey proto.Key, f func([]proto.KeyValue) error) error {
+	streamer, ok := kv.Sender.(ScanStreamer)
+	if !ok {
+		return kv.ScanPages(key, endKey, 0, scanStreamPageBytes, f)
+	}
+	call := ScanCall(key, endKey, 0)
+	if call.Args.Header().User == "" {
+		call.Args.Header().User = kv.User
+	}
+	if kv.UserPriority != 0 {
+		call.Args.Header().UserPriority = gogoproto.Int32(kv.UserPriority)
+	}
+	call.initClientCmdID(kv.clock)
+	streamer.ScanStream(context.Background(), call, f)
+	return call.Reply.Header().GoError()
+}
+
+// scanPages implements ScanPages for both KV and Txn, running each
+// page's scan with run.
+func scanPages(run func(calls ...Call) error, key, endKey proto.Key, maxResults, targetBytes int64,
+	f func([]proto.KeyValue) error) error {
+	for {
+		call := ScanCall(key, endKey, maxResults)
+		call.Args.(*proto.ScanRequest).TargetBytes = targetBytes
+		if err := run(call); err != nil {
+			return err
+		}
+		reply := call.Reply.(*proto.ScanResponse)
+		if len(reply.Rows) > 0 {
+			if err := f(reply.Rows); err != nil {
+				return err
+			}
+		}
+		if reply.ResumeSpan == nil {
+			return nil
+		}
+		key, endKey = reply.ResumeSpan.Key, reply.ResumeSpan.EndKey
+	}
+}
+
 // RunTransaction executes retryable in the context of a distributed
 // transaction. The transaction is automatically aborted if retryable
 // returns any error aside from recoverable internal errors, and is
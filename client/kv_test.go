@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
 )
 
 func TestKVCallError(t *testing.T) {
@@ -74,6 +75,66 @@ func TestKVClientCommandID(t *testing.T) {
 	}
 }
 
+// TestKVClientCommandIDRetained verifies that running the same call
+// again reuses its client command ID, so that the retry is idempotent,
+// while a new call is assigned a new ID.
+func TestKVClientCommandIDRetained(t *testing.T) {
+	var cmdIDs []proto.ClientCmdID
+	client := NewKV(nil, newTestSender(func(call Call) {
+		cmdIDs = append(cmdIDs, call.Args.Header().CmdID)
+	}))
+	call := IncrementCall(proto.Key("a"), 1)
+	for i := 0; i < 2; i++ {
+		if err := client.Run(call); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := client.Run(IncrementCall(proto.Key("a"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	if len(cmdIDs) != 3 {
+		t.Fatalf("expected 3 calls; got %d", len(cmdIDs))
+	}
+	if cmdIDs[0].IsEmpty() || !reflect.DeepEqual(cmdIDs[0], cmdIDs[1]) {
+		t.Errorf("expected retried call to reuse its command ID; got %+v, %+v", cmdIDs[0], cmdIDs[1])
+	}
+	if reflect.DeepEqual(cmdIDs[2], cmdIDs[0]) {
+		t.Errorf("expected new call to be assigned a new command ID; got %+v", cmdIDs[2])
+	}
+}
+
+// TestKVBatchClientCommandIDs verifies that each call in a batch is
+// assigned its own client command ID.
+func TestKVBatchClientCommandIDs(t *testing.T) {
+	count := 0
+	client := NewKV(nil, newTestSender(func(call Call) {
+		count++
+		bArgs, ok := call.Args.(*proto.BatchRequest)
+		if !ok {
+			t.Fatalf("expected batch request; got %s", call.Method())
+		}
+		seen := []proto.ClientCmdID{bArgs.CmdID}
+		for _, req := range bArgs.Requests {
+			cmdID := req.GetValue().(proto.Request).Header().CmdID
+			if cmdID.IsEmpty() {
+				t.Errorf("expected command ID to be initialized")
+			}
+			for _, s := range seen {
+				if reflect.DeepEqual(s, cmdID) {
+					t.Errorf("duplicate command ID %+v", cmdID)
+				}
+			}
+			seen = append(seen, cmdID)
+		}
+	}))
+	if err := client.Run(IncrementCall(proto.Key("a"), 1), IncrementCall(proto.Key("b"), 1)); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected test sender to be invoked once; got %d", count)
+	}
+}
+
 // TestKVTransactionPrepareAndFlush verifies that Flush sends single prepared
 // call without a batch and more than one prepared calls with a batch.
 func TestKVTransactionPrepareAndFlush(t *testing.T) {
@@ -236,6 +297,7 @@ func TestKVAbortMutatingTransaction(t *testing.T) {
 func TestKVRunTransactionRetryOnErrors(t *testing.T) {
 	ctx := NewContext()
 	ctx.TxnRetryOptions.Backoff = 1 * time.Millisecond
+	ctx.RetryOptions.Backoff = 1 * time.Millisecond
 
 	testCases := []struct {
 		err   error
@@ -246,8 +308,9 @@ func TestKVRunTransactionRetryOnErrors(t *testing.T) {
 		{&proto.TransactionPushError{}, true},
 		{&proto.TransactionRetryError{}, true},
 		{&proto.Error{}, false},
-		{&proto.RangeNotFoundError{}, false},
-		{&proto.RangeKeyMismatchError{}, false},
+		// Addressing errors are retried by KV.Run.
+		{&proto.RangeNotFoundError{}, true},
+		{&proto.RangeKeyMismatchError{}, true},
 		{&proto.TransactionStatusError{}, false},
 	}
 
@@ -282,3 +345,169 @@ func TestKVRunTransactionRetryOnErrors(t *testing.T) {
 		}
 	}
 }
+
+// TestKVRunRetryOnTransientErrors verifies that calls are retried on
+// transient errors with the same client command ID, and that fatal
+// errors are returned immediately.
+func TestKVRunRetryOnTransientErrors(t *testing.T) {
+	ctx := NewContext()
+	ctx.RetryOptions.Backoff = 1 * time.Millisecond
+
+	testCases := []struct {
+		err   error
+		retry bool // Expect retry?
+	}{
+		{&proto.NotLeaderError{}, true},
+		{&proto.RangeNotFoundError{}, true},
+		{&proto.RangeKeyMismatchError{}, true},
+		{&proto.Error{Retryable: true}, true},
+		{&proto.Error{}, false},
+		{&proto.ConditionFailedError{}, false},
+		{&proto.TransactionStatusError{}, false},
+	}
+
+	for i, test := range testCases {
+		count := 0
+		var cmdIDs []proto.ClientCmdID
+		client := NewKV(ctx, newTestSender(func(call Call) {
+			count++
+			cmdIDs = append(cmdIDs, call.Args.Header().CmdID)
+			if count == 1 {
+				call.Reply.Header().SetGoError(test.err)
+			}
+		}))
+		err := client.Run(Call{Args: testPutReq, Reply: &proto.PutResponse{}})
+		if test.retry {
+			if count != 2 {
+				t.Errorf("%d: expected one retry; got %d", i, count)
+			}
+			if err != nil {
+				t.Errorf("%d: expected success on retry; got %s", i, err)
+			}
+			if len(cmdIDs) == 2 && !reflect.DeepEqual(cmdIDs[0], cmdIDs[1]) {
+				t.Errorf("%d: expected retry to reuse client command ID %+v; got %+v", i, cmdIDs[0], cmdIDs[1])
+			}
+		} else {
+			if count != 1 {
+				t.Errorf("%d: expected no retries; got %d", i, count)
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+				t.Errorf("%d: expected error of type %T; got %T", i, test.err, err)
+			}
+		}
+	}
+}
+
+// TestKVRunRetryTimeout verifies that a call failing persistently with
+// a transient error stops retrying once the retry timeout expires.
+func TestKVRunRetryTimeout(t *testing.T) {
+	ctx := NewContext()
+	ctx.RetryOptions.Backoff = 1 * time.Millisecond
+	ctx.RetryOptions.MaxBackoff = 1 * time.Millisecond
+	ctx.RetryOptions.Timeout = 20 * time.Millisecond
+
+	count := 0
+	client := NewKV(ctx, newTestSender(func(call Call) {
+		count++
+		call.Reply.Header().SetGoError(&proto.RangeNotFoundError{})
+	}))
+	err := client.Run(Call{Args: testPutReq, Reply: &proto.PutResponse{}})
+	if _, ok := err.(*proto.RangeNotFoundError); !ok {
+		t.Errorf("expected range not found error; got %v", err)
+	}
+	if count < 2 {
+		t.Errorf("expected retries before timing out; got %d attempts", count)
+	}
+}
+
+// TestKVRunWithContextDeadline verifies that a call blocked in the
+// sender is abandoned once the context's deadline passes, and that
+// the remaining time is available to the sender.
+func TestKVRunWithContextDeadline(t *testing.T) {
+	client := NewKV(nil, KVSenderFunc(func(ctx context.Context, call Call) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Errorf("expected sender context to have a deadline")
+		}
+		<-ctx.Done()
+		call.Reply.Header().SetGoError(ctx.Err())
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := client.RunWithContext(ctx, Call{Args: testPutReq, Reply: &proto.PutResponse{}})
+	if err == nil || err.Error() != context.DeadlineExceeded.Error() {
+		t.Errorf("expected deadline exceeded error; got %v", err)
+	}
+}
+
+// TestKVAt verifies that KVAt sends reads, including those batched,
+// at the given timestamp and rejects writes.
+func TestKVAt(t *testing.T) {
+	ts := proto.Timestamp{WallTime: 10}
+	var sent []proto.Request
+	kv := KVAt(NewKV(nil, KVSenderFunc(func(_ context.Context, call Call) {
+		sent = append(sent, call.Args)
+	})), ts)
+
+	if err := kv.Run(GetCall(proto.Key("a")), ScanCall(proto.Key("b"), proto.Key("c"), 0)); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 1 {
+		t.Fatalf("expected a single batch; got %d calls", len(sent))
+	}
+	for _, r := range sent[0].(*proto.BatchRequest).Requests {
+		if args := r.GetValue().(proto.Request); !args.Header().Timestamp.Equal(ts) {
+			t.Errorf("expected %s at %s; got %s", args.Method(), ts, args.Header().Timestamp)
+		}
+	}
+
+	sent = nil
+	if err := kv.Run(PutCall(proto.Key("a"), []byte("value"))); err == nil {
+		t.Error("expected historical put to fail")
+	}
+	if len(sent) != 0 {
+		t.Errorf("expected put not to be sent; got %+v", sent)
+	}
+}
+
+// TestRequestTimeout verifies that a context's deadline survives the
+// round trip through a request header timeout.
+func TestRequestTimeout(t *testing.T) {
+	args := &proto.GetRequest{}
+	SetTimeout(context.Background(), args)
+	if args.Timeout != 0 {
+		t.Errorf("expected no timeout without a deadline; got %d", args.Timeout)
+	}
+	noCtx, noCancel := RequestContext(args)
+	defer noCancel()
+	if _, ok := noCtx.Deadline(); ok {
+		t.Errorf("expected no deadline for request without timeout")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	SetTimeout(ctx, args)
+	if args.Timeout <= 0 || args.Timeout > int64(time.Hour) {
+		t.Fatalf("expected timeout of at most 1h; got %d", args.Timeout)
+	}
+	reqCtx, reqCancel := RequestContext(args)
+	defer reqCancel()
+	deadline, ok := reqCtx.Deadline()
+	if !ok {
+		t.Fatal("expected request context to have a deadline")
+	}
+	if expDeadline, _ := ctx.Deadline(); deadline.Before(expDeadline.Add(-time.Second)) || deadline.After(expDeadline.Add(time.Second)) {
+		t.Errorf("expected deadline near %s; got %s", expDeadline, deadline)
+	}
+
+	// An expired deadline yields an already expired request context.
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	SetTimeout(expired, args)
+	reqCtx, reqCancel = RequestContext(args)
+	defer reqCancel()
+	select {
+	case <-reqCtx.Done():
+	case <-time.After(time.Second):
+		t.Error("expected request context with expired deadline to be done")
+	}
+}
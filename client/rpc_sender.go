@@ -27,6 +27,8 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // RPCSender is an implementation of KVSender which exposes the
@@ -40,8 +42,10 @@ type RPCSender struct {
 	client *rpc.Client
 }
 
-// NewRPCSender returns a new instance of RPCSender.
-func NewRPCSender(server string, certsDir string) (*RPCSender, error) {
+// NewRPCSender returns a new instance of RPCSender. Requests are
+// authenticated as the specified user, whose client certificate must
+// be in certsDir.
+func NewRPCSender(server, certsDir, user string) (*RPCSender, error) {
 	addr, err := net.ResolveTCPAddr("tcp", server)
 	if err != nil {
 		return nil, err
@@ -54,7 +58,7 @@ func NewRPCSender(server string, certsDir string) (*RPCSender, error) {
 	} else {
 		log.V(1).Infof("setting up TLS from certificates directory: %s", certsDir)
 		var err error
-		tlsConfig, err = security.LoadClientTLSConfigFromDir(certsDir)
+		tlsConfig, err = security.LoadUserClientTLSConfigFromDir(certsDir, user)
 		if err != nil {
 			return nil, util.Errorf("error setting up client TLS config: %s", err)
 		}
@@ -65,15 +69,18 @@ func NewRPCSender(server string, certsDir string) (*RPCSender, error) {
 	return &RPCSender{client: client}, nil
 }
 
-// NewTestRPCSender initializes a new RPCSender using an insecure TLS
-// config.
+// NewTestRPCSender initializes a new RPCSender which presents the
+// embedded test node certificate.
 func NewTestRPCSender(server string) *RPCSender {
 	addr, err := net.ResolveTCPAddr("tcp", server)
 	if err != nil {
 		return nil
 	}
 
-	tlsConfig := security.LoadInsecureClientTLSConfig()
+	tlsConfig, err := security.LoadUserClientTLSConfigFromDir(security.EmbeddedCertsDir, security.NodeUser)
+	if err != nil {
+		return nil
+	}
 	ctx := rpc.NewContext(hlc.NewClock(hlc.UnixNano), tlsConfig, nil)
 	client := rpc.NewClient(addr, &HTTPRetryOptions, ctx)
 	return &RPCSender{client: client}
@@ -86,19 +93,31 @@ func NewTestRPCSender(server string) *RPCSender {
 // reporting failure when in fact the command may have gone through
 // and been executed successfully. We retry here to eventually get
 // through with the same client command ID and be given the cached
-// response.
-func (s *RPCSender) Send(call Call) {
+// response. Retries end, and an outstanding RPC is abandoned, once
+// ctx is done.
+func (s *RPCSender) Send(ctx context.Context, call Call) {
 	retryOpts := HTTPRetryOptions
 	retryOpts.Tag = fmt.Sprintf("rpc %s", call.Method())
 
 	if err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
+		if err := ctx.Err(); err != nil {
+			return util.RetryBreak, err
+		}
 		if !s.client.IsHealthy() {
 			return util.RetryContinue, nil
 		}
 
 		method := call.Args.Method().String()
-		c := s.client.Go("Server."+method, call.Args, call.Reply, nil)
-		<-c.Done
+		SetTimeout(ctx, call.Args)
+		// Decode into a separate reply so that an abandoned RPC cannot
+		// write to call.Reply after Send returns.
+		reply := call.Args.CreateReply()
+		c := s.client.Go("Server."+method, call.Args, reply, nil)
+		select {
+		case <-c.Done:
+		case <-ctx.Done():
+			return util.RetryBreak, ctx.Err()
+		}
 		if c.Error != nil {
 			// Assume all errors sending request are retryable. The actual
 			// number of things that could go wrong is vast, but we don't
@@ -111,6 +130,8 @@ func (s *RPCSender) Send(call Call) {
 			return util.RetryContinue, nil
 		}
 
+		call.Reply.Reset()
+		gogoproto.Merge(call.Reply, reply)
 		// On successful post, we're done with retry loop.
 		return util.RetryBreak, nil
 	}); err != nil {
@@ -21,25 +21,33 @@ import (
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 type txnSender struct {
 	*Txn
 }
 
-func (ts *txnSender) Send(call Call) {
+func (ts *txnSender) Send(ctx context.Context, call Call) {
+	// Each call gets the next sequence number, which is recorded on the
+	// intents it writes and positions savepoints.
+	ts.txn.Sequence = gogoproto.Int32(ts.txn.GetSequence() + 1)
 	// Send call through wrapped sender.
 	call.Args.Header().Txn = &ts.txn
-	ts.wrapped.Send(call)
+	ts.wrapped.Send(ctx, call)
 	ts.txn.Update(call.Reply.Header().Txn)
 
 	if err, ok := call.Reply.Header().GoError().(*proto.TransactionAbortedError); ok {
 		// On Abort, reset the transaction so we start anew on restart.
+		// The priority is escalated so that repeatedly aborted
+		// transactions eventually make progress.
 		ts.txn = proto.Transaction{
 			Name:      ts.txn.Name,
 			Isolation: ts.txn.Isolation,
-			Priority:  err.Txn.Priority, // acts as a minimum priority on restart
+			Priority:  proto.EscalatePriority(err.Txn.Priority), // acts as a minimum priority on restart
 		}
+		ts.aborts++
 	}
 }
 
@@ -57,6 +65,15 @@ type Txn struct {
 	txnSender   txnSender
 	prepared    []Call
 	needsEndTxn bool // True if EndTransaction needs to be sent
+	aborts      int  // Number of times the txn was aborted and re-created
+}
+
+// A Savepoint marks a position in the writes of a transaction, to
+// which the transaction can be rolled back. See Txn.CreateSavepoint.
+type Savepoint struct {
+	aborts   int
+	epoch    int32
+	sequence int32
 }
 
 var defaultTxnOpts = TransactionOptions{}
@@ -140,6 +157,43 @@ func (t *Txn) Run(calls ...Call) error {
 	return t.kv.Run(calls...)
 }
 
+// ScanPages is like KV.ScanPages, but scans within the transaction.
+func (t *Txn) ScanPages(key, endKey proto.Key, maxResults, targetBytes int64, f func([]proto.KeyValue) error) error {
+	return scanPages(t.Run, key, endKey, maxResults, targetBytes, f)
+}
+
+// CreateSavepoint flushes any prepared calls and returns a savepoint
+// marking the transaction's writes so far.
+func (t *Txn) CreateSavepoint() (Savepoint, error) {
+	if err := t.Flush(); err != nil {
+		return Savepoint{}, err
+	}
+	return Savepoint{
+		aborts:   t.aborts,
+		epoch:    t.txn.Epoch,
+		sequence: t.txn.GetSequence(),
+	}, nil
+}
+
+// RollbackToSavepoint undoes the transaction's writes since the
+// savepoint was created, discarding any prepared calls which haven't
+// been flushed. The transaction continues from the savepoint: its
+// reads no longer see the undone writes, which are discarded on
+// commit. Returns an error if the transaction was restarted since the
+// savepoint was created, in which case the writes before the
+// savepoint were undone as well.
+func (t *Txn) RollbackToSavepoint(sp Savepoint) error {
+	if sp.aborts != t.aborts || sp.epoch != t.txn.Epoch {
+		return util.Errorf("cannot roll back to savepoint: transaction %q was restarted", t.txn.Name)
+	}
+	t.prepared = nil
+	if seq := t.txn.GetSequence(); seq > sp.sequence {
+		t.txn.IgnoredSequences = append(t.txn.IgnoredSequences,
+			proto.SequenceRange{Start: sp.sequence + 1, End: seq})
+	}
+	return nil
+}
+
 // Prepare accepts a KV API call, specified by arguments and a reply
 // struct. The call will be buffered locally until the first call to
 // Flush(), at which time it will be sent for execution as part of a
@@ -160,7 +214,7 @@ func (t *Txn) Run(calls ...Call) error {
 func (t *Txn) Prepare(calls ...Call) {
 	t.updateNeedsEndTxn(calls)
 	for _, c := range calls {
-		c.resetClientCmdID(t.kv.clock)
+		c.initClientCmdID(t.kv.clock)
 	}
 	t.prepared = append(t.prepared, calls...)
 }
@@ -23,6 +23,7 @@ import (
 	"code.google.com/p/go-uuid/uuid"
 	"github.com/cockroachdb/cockroach/proto"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 var (
@@ -38,7 +39,7 @@ func makeTS(walltime int64, logical int32) proto.Timestamp {
 }
 
 func newTestSender(handler func(Call)) KVSenderFunc {
-	return func(call Call) {
+	return func(_ context.Context, call Call) {
 		header := call.Args.Header()
 		header.UserPriority = gogoproto.Int32(-1)
 		if header.Txn != nil && len(header.Txn.ID) == 0 {
@@ -86,7 +87,7 @@ func TestTxnRequestTxnTimestamp(t *testing.T) {
 	txn := newTxn(kv, nil)
 
 	for testIdx = range testCases {
-		txn.kv.Sender.Send(Call{Args: testPutReq, Reply: &proto.PutResponse{}})
+		txn.kv.Sender.Send(context.Background(), Call{Args: testPutReq, Reply: &proto.PutResponse{}})
 	}
 }
 
@@ -98,7 +99,7 @@ func TestTxnResetTxnOnAbort(t *testing.T) {
 	}))
 	txn := newTxn(kv, nil)
 
-	txn.kv.Sender.Send(Call{Args: testPutReq, Reply: &proto.PutResponse{}})
+	txn.kv.Sender.Send(context.Background(), Call{Args: testPutReq, Reply: &proto.PutResponse{}})
 
 	if len(txn.txn.ID) != 0 {
 		t.Errorf("expected txn to be cleared")
@@ -0,0 +1,141 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"golang.org/x/net/context"
+)
+
+// watchWait is the time each Watch command waits on the server for
+// changes before returning empty-handed.
+const watchWait = 10 * time.Second
+
+// A Watcher streams the committed changes to the keys under a prefix.
+// Each range spanned by the prefix is watched by a goroutine issuing
+// successive Watch commands, each resuming from the timestamp of the
+// last. When a range is found to have split, a goroutine is started
+// to watch the right-hand side. Changes to a key are delivered in
+// order of their timestamps, while changes to different keys are not
+// ordered. Changes may be coalesced: each event holds a key's value as
+// of the time it was read, which may reflect several writes.
+type Watcher struct {
+	// Events receives the watched changes. It is closed once the
+	// watcher has stopped, either on Close() or an error.
+	Events <-chan proto.WatchEvent
+
+	kv     *KV
+	events chan proto.WatchEvent
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu  sync.Mutex
+	err error
+}
+
+// Watch returns a new Watcher streaming the changes to the keys with
+// the given prefix committed after startTime. A zero startTime streams
+// the current value of every key under the prefix before any later
+// changes.
+func (kv *KV) Watch(prefix proto.Key, startTime proto.Timestamp) *Watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Watcher{
+		kv:     kv,
+		events: make(chan proto.WatchEvent),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	w.Events = w.events
+	w.wg.Add(1)
+	go w.watchSpan(prefix, prefix.PrefixEnd(), startTime)
+	go func() {
+		w.wg.Wait()
+		close(w.events)
+	}()
+	return w
+}
+
+// Close stops the watcher and waits for its goroutines to exit.
+func (w *Watcher) Close() {
+	w.cancel()
+	// Drain events so that blocked goroutines notice the cancellation.
+	for range w.events {
+	}
+}
+
+// Err returns the error which stopped the watcher, if any.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// fail stops the watcher with the given error, unless it is already
+// stopping.
+func (w *Watcher) fail(err error) {
+	w.mu.Lock()
+	if w.err == nil && w.ctx.Err() == nil {
+		w.err = err
+	}
+	w.mu.Unlock()
+	w.cancel()
+}
+
+// watchSpan watches [start, end) from timestamp ts until the watcher
+// stops.
+func (w *Watcher) watchSpan(start, end proto.Key, ts proto.Timestamp) {
+	defer w.wg.Done()
+	for {
+		call := Call{
+			Args: &proto.WatchRequest{
+				RequestHeader: proto.RequestHeader{Key: start},
+				LimitKey:      end,
+				StartTime:     ts,
+				MaxWait:       int64(watchWait),
+			},
+			Reply: &proto.WatchResponse{},
+		}
+		if err := w.kv.RunWithContext(w.ctx, call); err != nil {
+			w.fail(err)
+			return
+		}
+		reply := call.Reply.(*proto.WatchResponse)
+		if !start.Less(reply.EndKey) {
+			w.fail(util.Errorf("watch of %q made no progress", start))
+			return
+		}
+		if reply.EndKey.Less(end) {
+			w.wg.Add(1)
+			go w.watchSpan(reply.EndKey, end, ts)
+			end = reply.EndKey
+		}
+		for _, event := range reply.Events {
+			select {
+			case w.events <- event:
+			case <-w.ctx.Done():
+				return
+			}
+		}
+		ts = reply.Timestamp
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package client
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// TestWatcherFollowsSplits verifies that a Watcher watches each range
+// spanned by its prefix, starting from the same timestamp, and stops
+// with the error of a failed Watch command.
+func TestWatcherFollowsSplits(t *testing.T) {
+	split := proto.Key("x5")
+	changes := map[string]string{"x1": "x", "x7": "y"}
+	client := NewKV(nil, newTestSender(func(call Call) {
+		args, ok := call.Args.(*proto.WatchRequest)
+		if !ok {
+			return
+		}
+		reply := call.Reply.(*proto.WatchResponse)
+		reply.EndKey = args.LimitKey
+		if args.Key.Less(split) && split.Less(args.LimitKey) {
+			reply.EndKey = split
+		}
+		reply.Timestamp = proto.Timestamp{WallTime: 1}
+		if args.StartTime.WallTime > 0 {
+			// Emulate waiting for changes.
+			time.Sleep(time.Millisecond)
+			return
+		}
+		for key, value := range changes {
+			if k := proto.Key(key); !k.Less(args.Key) && k.Less(reply.EndKey) {
+				reply.Events = append(reply.Events, proto.WatchEvent{
+					Key:   k,
+					Value: &proto.Value{Bytes: []byte(value)},
+				})
+			}
+		}
+	}))
+
+	w := client.Watch(proto.Key("x"), proto.ZeroTimestamp)
+	var keys []string
+	for len(keys) < len(changes) {
+		event, ok := <-w.Events
+		if !ok {
+			t.Fatalf("watcher stopped: %v", w.Err())
+		}
+		if string(event.Value.Bytes) != changes[string(event.Key)] {
+			t.Errorf("unexpected event %+v", event)
+		}
+		keys = append(keys, string(event.Key))
+	}
+	w.Close()
+	if err := w.Err(); err != nil {
+		t.Errorf("expected no error on close; got %s", err)
+	}
+	sort.Strings(keys)
+	if keys[0] != "x1" || keys[1] != "x7" {
+		t.Errorf("expected events for x1 and x7; got %q", keys)
+	}
+
+	// A failing Watch command stops the watcher.
+	client = NewKV(nil, newTestSender(func(call Call) {
+		call.Reply.Header().SetGoError(&proto.ConditionFailedError{})
+	}))
+	w = client.Watch(proto.Key("x"), proto.ZeroTimestamp)
+	if _, ok := <-w.Events; ok {
+		t.Fatal("expected watcher to stop")
+	}
+	if _, ok := w.Err().(*proto.ConditionFailedError); !ok {
+		t.Errorf("expected ConditionFailedError; got %v", w.Err())
+	}
+}
@@ -33,6 +33,13 @@ const (
 	// maxWaitForNewGossip is maximum wait for new gossip before a
 	// peer is considered a poor source of good gossip and is GC'd.
 	maxWaitForNewGossip = 1 * time.Minute
+	// fullGossipCycles is the number of gossip exchanges with a peer
+	// after which the client exchanges its full infostore in place of
+	// a delta and requests the same of the peer. These periodic full
+	// exchanges act as anti-entropy, repairing infos the peer is
+	// missing which a delta would never resend because they are older
+	// than the sequence high-water mark.
+	fullGossipCycles = 100
 )
 
 // init pre-registers net.UnixAddr and net.TCPAddr concrete types with
@@ -81,8 +88,10 @@ func (c *client) start(g *Gossip, done chan *client, context *rpc.Context, stopp
 		}
 
 		// Start gossipping and wait for disconnect or error.
+		g.counters.recordOpened()
 		c.lastFresh = time.Now().UnixNano()
 		c.err = c.gossip(g, stopper)
+		g.counters.recordClosed()
 		if c.err != nil {
 			c.rpcClient.Close()
 		}
@@ -96,12 +105,21 @@ func (c *client) close() {
 }
 
 // gossip loops, sending deltas of the infostore and receiving deltas
-// in turn. If an alternate is proposed on response, the client addr
-// is modified and method returns for forwarding by caller.
+// in turn. Only infos newer than the sequence high-water mark of the
+// last exchange are sent in either direction, except that every
+// fullGossipCycles exchanges the full infostore is exchanged. If an
+// alternate is proposed on response, the client addr is modified and
+// method returns for forwarding by caller.
 func (c *client) gossip(g *Gossip, stopper *util.Stopper) error {
 	localMaxSeq := int64(0)
 	remoteMaxSeq := int64(-1)
-	for {
+	for cycle := 1; ; cycle++ {
+		// Periodically reset both high-water marks so the next
+		// exchange sends and requests every info; see fullGossipCycles.
+		if cycle%fullGossipCycles == 0 {
+			log.V(1).Infof("exchanging full infostore with %s", c.addr)
+			localMaxSeq, remoteMaxSeq = 0, 0
+		}
 		// Compute the delta of local node's infostore to send with request.
 		g.mu.Lock()
 		delta := g.is.delta(c.peerID, localMaxSeq)
@@ -141,6 +159,9 @@ func (c *client) gossip(g *Gossip, stopper *util.Stopper) error {
 		case <-time.After(g.interval * 10):
 			return util.Errorf("timeout after: %s", g.interval*10)
 		}
+		if delta != nil {
+			g.counters.recordSent(delta.infoCount(), len(deltaBytes))
+		}
 
 		// Handle remote forwarding.
 		if reply.Alternate != nil {
@@ -159,6 +180,7 @@ func (c *client) gossip(g *Gossip, stopper *util.Stopper) error {
 				return util.Errorf("infostore could not be decoded: %s", err)
 			}
 			log.V(1).Infof("received gossip reply delta from %s: %s", c.addr, delta)
+			g.counters.recordReceived(delta.infoCount(), len(reply.Delta))
 			g.mu.Lock()
 			c.peerID = delta.NodeID
 			g.outgoing.addNode(c.peerID)
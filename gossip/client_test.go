@@ -91,6 +91,64 @@ func TestClientGossip(t *testing.T) {
 	}
 }
 
+// TestClientGossipEmptyDelta verifies that a client completes its
+// connection bookkeeping even when the server has no new infos to
+// return. The local node holds the sentinel but has not yet marked
+// itself connected; the remote node only has the infos the local node
+// sent it, so its reply delta is empty.
+func TestClientGossipEmptyDelta(t *testing.T) {
+	local, remote, stopper := startGossip(t)
+	defer stopper.Stop()
+	local.mu.Lock()
+	if err := local.is.addInfo(local.is.newInfo(KeySentinel, "cluster", time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	local.mu.Unlock()
+	disconnected := make(chan *client, 1)
+
+	client := newClient(remote.is.NodeAddr)
+	client.start(local, disconnected, local.RPCContext, stopper)
+
+	select {
+	case <-local.Connected:
+	case <-time.After(500 * time.Millisecond):
+		t.Errorf("client did not mark gossip connected after exchange with empty delta")
+	}
+}
+
+// TestClientGossipFullExchange verifies that the periodic full
+// exchange resends infos which the peer is missing, even though they
+// are older than the sequence high-water mark of the last delta.
+func TestClientGossipFullExchange(t *testing.T) {
+	local, remote, stopper := startGossip(t)
+	defer stopper.Stop()
+	local.AddInfo("local-key", "local value", time.Hour)
+	disconnected := make(chan *client, 1)
+
+	client := newClient(remote.is.NodeAddr)
+	client.start(local, disconnected, local.RPCContext, stopper)
+
+	if err := util.IsTrueWithin(func() bool {
+		_, err := remote.GetInfo("local-key")
+		return err == nil
+	}, 500*time.Millisecond); err != nil {
+		t.Fatalf("gossip exchange failed or taking too long")
+	}
+
+	// Drop the info from the remote infostore; a delta will never
+	// resend it, so it only returns with a full exchange.
+	remote.mu.Lock()
+	delete(remote.is.Infos, "local-key")
+	remote.mu.Unlock()
+
+	if err := util.IsTrueWithin(func() bool {
+		_, err := remote.GetInfo("local-key")
+		return err == nil
+	}, fullGossipCycles*gossipInterval*3); err != nil {
+		t.Errorf("full gossip exchange did not restore dropped info")
+	}
+}
+
 // TestClientDisconnectRedundant verifies that the gossip server
 // will drop an outgoing client connection that is already an
 // inbound client connection of another node.
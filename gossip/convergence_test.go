@@ -40,6 +40,10 @@ func verifyConvergence(numNodes, maxCycles int, interval time.Duration, t *testi
 		t.Errorf("expected a fully-connected network within %d cycles; took %d",
 			maxCycles, connectedCycle)
 	}
+	if stats := network.Stats(); stats.MaxFanout == 0 || stats.Counters.InfosSent == 0 ||
+		stats.Counters.BytesReceived == 0 || stats.Counters.ConnectionsOpened == 0 {
+		t.Errorf("expected fan-out and gossip traffic to be counted; got %+v", stats)
+	}
 	network.Stop()
 }
 
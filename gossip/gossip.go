@@ -58,8 +58,12 @@ package gossip
 import (
 	"encoding/gob"
 	"encoding/json"
+	"fmt"
 	"math"
 	"net"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -86,8 +90,10 @@ const (
 	// is replaced.
 	defaultNodeCount = 1000
 
-	// ttlNodeIDGossip is time-to-live for node ID -> address.
-	ttlNodeIDGossip = 0 * time.Second
+	// ttlNodeIDGossip is time-to-live for node ID -> address. A
+	// running node refreshes its descriptor well before it expires,
+	// so only the descriptors of dead nodes expire.
+	ttlNodeIDGossip = 10 * time.Minute
 
 	// TestInterval is the default gossip interval used for running tests.
 	TestInterval = 10 * time.Millisecond
@@ -109,6 +115,19 @@ func init() {
 	gob.Register(&NodeDescriptor{})
 }
 
+// Storage is an interface which allows the gossip instance to read
+// and write bootstrapping data to persistent storage between
+// instantiations.
+type Storage interface {
+	// ReadBootstrapInfo fetches the bootstrap data from the persistent
+	// store into the provided bootstrap protobuf. Returns nil or an
+	// error.
+	ReadBootstrapInfo(*proto.GossipBootstrapInfo) error
+	// WriteBootstrapInfo stores the provided bootstrap data to the
+	// persistent store. Returns nil or an error.
+	WriteBootstrapInfo(*proto.GossipBootstrapInfo) error
+}
+
 // Gossip is an instance of a gossip node. It embeds a gossip server.
 // During bootstrapping, the bootstrap list contains candidates for
 // entry to the gossip network.
@@ -124,12 +143,18 @@ type Gossip struct {
 	clients       []*client           // Slice of clients
 	disconnected  chan *client        // Channel of disconnected clients
 	stalled       chan struct{}       // Channel to wakeup stalled bootstrap
+	nodeDesc      *NodeDescriptor     // This node's descriptor, refreshed periodically
 
 	// resolvers is a list of resolvers used to determine
 	// bootstrap hosts for connecting to the gossip network.
 	resolverIdx int
 	resolvers   []Resolver
 	triedAll    bool // True when all resolvers have been tried once
+
+	// storage persists the addresses of recently known nodes so that
+	// they may be used as bootstrap hosts after a restart.
+	storage       Storage
+	bootstrapInfo proto.GossipBootstrapInfo // Last persisted bootstrap info
 }
 
 // New creates an instance of a gossip node.
@@ -168,15 +193,34 @@ func (g *Gossip) GetNodeID() proto.NodeID {
 func (g *Gossip) SetNodeDescriptor(desc *NodeDescriptor) error {
 	nodeIDKey := MakeNodeIDKey(desc.NodeID)
 	log.Infof("gossiping node descriptor %+v", desc)
-	if err := g.AddInfo(nodeIDKey, desc, ttlNodeIDGossip); err != nil {
-		return util.Errorf("couldn't gossip descriptor for node %d: %v", desc.NodeID, err)
-	}
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	// Set the node ID first so that the descriptor is attributed to
+	// this node as its originator.
 	g.is.NodeID = desc.NodeID
+	g.nodeDesc = desc
+	if err := g.is.addInfo(g.is.newInfo(nodeIDKey, desc, ttlNodeIDGossip)); err != nil {
+		return util.Errorf("couldn't gossip descriptor for node %d: %v", desc.NodeID, err)
+	}
 	return nil
 }
 
+// refreshNodeDescriptorLocked re-gossips this node's descriptor once
+// half of its time-to-live has elapsed. The mutex is assumed held by
+// the caller.
+func (g *Gossip) refreshNodeDescriptorLocked() {
+	if g.nodeDesc == nil {
+		return
+	}
+	nodeIDKey := MakeNodeIDKey(g.nodeDesc.NodeID)
+	if i := g.is.getInfo(nodeIDKey); i != nil && i.TTLStamp-time.Now().UnixNano() > int64(ttlNodeIDGossip/2) {
+		return
+	}
+	if err := g.is.addInfo(g.is.newInfo(nodeIDKey, g.nodeDesc, ttlNodeIDGossip)); err != nil {
+		log.Errorf("couldn't refresh descriptor for node %d: %s", g.nodeDesc.NodeID, err)
+	}
+}
+
 // SetResolvers initializes the set of gossip resolvers used to
 // find nodes to bootstrap the gossip network.
 func (g *Gossip) SetResolvers(resolvers []Resolver) {
@@ -187,6 +231,82 @@ func (g *Gossip) SetResolvers(resolvers []Resolver) {
 	g.triedAll = false
 }
 
+// SetStorage provides an instance of the Storage interface for
+// reading and writing gossip bootstrap data. Addresses read from
+// storage which are not already known are added to the set of
+// resolvers.
+func (g *Gossip) SetStorage(storage Storage) error {
+	var info proto.GossipBootstrapInfo
+	if err := storage.ReadBootstrapInfo(&info); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.storage = storage
+	g.bootstrapInfo = info
+
+	existing := map[string]struct{}{}
+	for _, r := range g.resolvers {
+		existing[resolverSpec(r.Type(), r.Addr())] = struct{}{}
+	}
+	for _, spec := range info.Addresses {
+		r, err := NewResolver(spec)
+		if err != nil {
+			log.Warningf("bad persisted gossip bootstrap address: %s", err)
+			continue
+		}
+		if _, ok := existing[resolverSpec(r.Type(), r.Addr())]; ok {
+			continue
+		}
+		existing[resolverSpec(r.Type(), r.Addr())] = struct{}{}
+		g.resolvers = append(g.resolvers, r)
+	}
+	return nil
+}
+
+// persistBootstrapInfoLocked writes the addresses of all nodes known
+// via gossip, excepting this node, to storage if they have changed
+// since last written. Nothing is written if no other nodes are known,
+// so that a partitioned node keeps its previous bootstrap addresses.
+// The mutex is assumed held by the caller.
+func (g *Gossip) persistBootstrapInfoLocked() {
+	if g.storage == nil {
+		return
+	}
+	var addrs []string
+	_ = g.is.visitInfos(nil, func(i *info) error {
+		desc, ok := i.Val.(*NodeDescriptor)
+		if !ok || i.Deleted || desc.Address == nil {
+			return nil
+		}
+		if g.is.NodeAddr != nil && desc.Address.String() == g.is.NodeAddr.String() {
+			return nil
+		}
+		addrs = append(addrs, resolverSpec(desc.Address.Network(), desc.Address.String()))
+		return nil
+	})
+	if len(addrs) == 0 {
+		return
+	}
+	sort.Strings(addrs)
+	if reflect.DeepEqual(addrs, g.bootstrapInfo.Addresses) {
+		return
+	}
+	info := proto.GossipBootstrapInfo{Addresses: addrs}
+	if err := g.storage.WriteBootstrapInfo(&info); err != nil {
+		log.Errorf("unable to persist gossip bootstrap info: %s", err)
+		return
+	}
+	g.bootstrapInfo = info
+}
+
+// resolverSpec returns the resolver specification, as accepted by
+// NewResolver, for the supplied type and address.
+func resolverSpec(typ, addr string) string {
+	return fmt.Sprintf("%s=%s", typ, addr)
+}
+
 // GetNodeIDAddress looks up the address of the node by ID.
 func (g *Gossip) GetNodeIDAddress(nodeID proto.NodeID) (net.Addr, error) {
 	g.mu.Lock()
@@ -221,15 +341,24 @@ func (g *Gossip) AddInfo(key string, val interface{}, ttl time.Duration) error {
 	return err
 }
 
+// DeleteInfo deletes an info object by replacing it with a tombstone,
+// which is gossiped in its place so that the info is deleted from all
+// nodes. Returns an error if the info belongs to a group.
+func (g *Gossip) DeleteInfo(key string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.is.addInfo(g.is.newTombstone(key))
+}
+
 // GetInfo returns an info value by key or an error if specified
-// key does not exist or has expired.
+// key does not exist, has expired or has been deleted.
 func (g *Gossip) GetInfo(key string) (interface{}, error) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 	if i := g.is.getInfo(key); i != nil {
 		return i.Val, nil
 	}
-	return nil, util.Errorf("key %q does not exist or has expired", key)
+	return nil, util.Errorf("key %q does not exist, has expired or has been deleted", key)
 }
 
 // GetInfosAsJSON returns the contents of the infostore, marshalled to
@@ -240,6 +369,39 @@ func (g *Gossip) GetInfosAsJSON() ([]byte, error) {
 	return json.Marshal(g.is)
 }
 
+// GetInfosWithPrefix returns the values of all infos whose keys were
+// created by MakeKey with prefix as the first component, keyed by
+// info key.
+func (g *Gossip) GetInfosWithPrefix(prefix string) map[string]interface{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	values := map[string]interface{}{}
+	g.is.visitInfos(nil, func(i *info) error {
+		if !i.Deleted && strings.HasPrefix(i.Key, prefix+separator) {
+			values[i.Key] = i.Val
+		}
+		return nil
+	})
+	return values
+}
+
+// LastHeard returns the most recent origination time (Unix nanos) of
+// the infos originated by each node, including tombstones. As every
+// node regularly refreshes its node descriptor, this gives a view of
+// how recently each node in the cluster was heard from.
+func (g *Gossip) LastHeard() map[proto.NodeID]int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	lastHeard := map[proto.NodeID]int64{}
+	g.is.visitInfos(nil, func(i *info) error {
+		if i.NodeID != 0 && i.Timestamp > lastHeard[i.NodeID] {
+			lastHeard[i.NodeID] = i.Timestamp
+		}
+		return nil
+	})
+	return lastHeard
+}
+
 // GetGroupInfos returns a slice of info values from specified group,
 // or an error if group is not registered.
 func (g *Gossip) GetGroupInfos(prefix string) ([]interface{}, error) {
@@ -264,20 +426,39 @@ func (g *Gossip) RegisterGroup(prefix string, limit int, typeOf GroupType) error
 	return g.is.registerGroup(newGroup(prefix, limit, typeOf))
 }
 
-// Callback is a callback method to be invoked on gossip update
-// of info denoted by key. The contentsChanged bool indicates whether
-// the info contents were updated. False indicates the info timestamp
-// was refreshed, but its contents remained unchanged.
-type Callback func(key string, contentsChanged bool)
+// Callback is a callback method to be invoked on gossip update of
+// the info denoted by key, with the info's new value. Callbacks are
+// only invoked when the contents of an info change, not when its
+// timestamp is merely refreshed, and observe updates in the order in
+// which they were received.
+type Callback func(key string, val interface{})
 
 // RegisterCallback registers a callback for a key pattern to be
 // invoked whenever new info for a gossip key matching pattern is
 // received. The callback method is invoked with the info key which
-// matched pattern.
+// matched pattern and the info's value. Before RegisterCallback
+// returns, the callback is invoked for each matching info already
+// present, so that callers needn't also poll GetInfo. RegisterCallback
+// must not be invoked from within a callback.
 func (g *Gossip) RegisterCallback(pattern string, method Callback) {
+	g.mu.Lock()
+	done := g.is.registerCallback(pattern, method)
+	g.mu.Unlock()
+	<-done
+}
+
+// ExpiryCallback is a callback method to be invoked when the info
+// denoted by key expires or is deleted.
+type ExpiryCallback func(key string)
+
+// RegisterExpiryCallback registers a callback for a key pattern to be
+// invoked whenever an info for a gossip key matching pattern expires
+// or is deleted. Expired infos are discarded by a periodic sweep, so
+// the callback may be invoked up to a gossip interval after expiry.
+func (g *Gossip) RegisterExpiryCallback(pattern string, method ExpiryCallback) {
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	g.is.registerCallback(pattern, method)
+	g.is.registerExpiryCallback(pattern, method)
 }
 
 // MaxHops returns the maximum number of hops to reach the furthest
@@ -451,6 +632,11 @@ func (g *Gossip) manage(stopper *util.Stopper) {
 
 			case <-checkTimeout:
 				g.mu.Lock()
+				// Discard expired infos, keep this node's descriptor fresh and
+				// persist the addresses of known nodes for future bootstraps.
+				g.is.sweep()
+				g.refreshNodeDescriptorLocked()
+				g.persistBootstrapInfoLocked()
 				// Check whether the graph needs to be tightened to
 				// accommodate distant infos.
 				distant := g.filterExtant(g.is.distant(g.maxToleratedHops()))
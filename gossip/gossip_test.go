@@ -19,11 +19,14 @@ package gossip
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 )
 
@@ -54,6 +57,157 @@ func TestGossipInfoStore(t *testing.T) {
 	}
 }
 
+// TestGossipDeleteInfo verifies deletion of infos via the gossip
+// instance infostore.
+func TestGossipDeleteInfo(t *testing.T) {
+	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), security.LoadInsecureTLSConfig(), nil)
+	g := New(rpcContext, TestInterval, TestBootstrap)
+	g.AddInfo("i", int64(1), time.Hour)
+	if err := g.DeleteInfo("i"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.GetInfo("i"); err == nil {
+		t.Errorf("expected error fetching deleted key \"i\"")
+	}
+	g.RegisterGroup("g", 3, MinGroup)
+	g.AddInfo("g.1", int64(1), time.Hour)
+	if err := g.DeleteInfo("g.1"); err == nil {
+		t.Errorf("expected error deleting group info \"g.1\"")
+	}
+}
+
+// TestGossipRefreshNodeDescriptor verifies that the node descriptor is
+// re-gossiped once half of its time-to-live has elapsed.
+func TestGossipRefreshNodeDescriptor(t *testing.T) {
+	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), security.LoadInsecureTLSConfig(), nil)
+	g := New(rpcContext, TestInterval, TestBootstrap)
+	desc := &NodeDescriptor{NodeID: 1}
+	if err := g.SetNodeDescriptor(desc); err != nil {
+		t.Fatal(err)
+	}
+	nodeIDKey := MakeNodeIDKey(desc.NodeID)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	i := g.is.getInfo(nodeIDKey)
+	g.refreshNodeDescriptorLocked()
+	if g.is.getInfo(nodeIDKey) != i {
+		t.Errorf("expected fresh node descriptor not to be refreshed")
+	}
+	// Replace the descriptor with one close to expiring.
+	if err := g.is.addInfo(g.is.newInfo(nodeIDKey, desc, ttlNodeIDGossip/4)); err != nil {
+		t.Fatal(err)
+	}
+	g.refreshNodeDescriptorLocked()
+	if i := g.is.getInfo(nodeIDKey); i.TTLStamp-time.Now().UnixNano() <= int64(ttlNodeIDGossip/2) {
+		t.Errorf("expected node descriptor to be refreshed; expires at %d", i.TTLStamp)
+	}
+}
+
+// TestGossipInfosWithPrefix verifies fetching infos by key prefix and
+// the most recent origination time of each node's infos.
+func TestGossipInfosWithPrefix(t *testing.T) {
+	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), security.LoadInsecureTLSConfig(), nil)
+	g := New(rpcContext, TestInterval, TestBootstrap)
+	if err := g.SetNodeDescriptor(&NodeDescriptor{NodeID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.AddInfo(KeyNodeCount, int64(2), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	// Add a descriptor originated by node 2 as if received via gossip.
+	g.mu.Lock()
+	i := g.is.newInfo(MakeNodeIDKey(2), &NodeDescriptor{NodeID: 2}, time.Hour)
+	i.NodeID = 2
+	i.Timestamp = 100
+	if err := g.is.addInfo(i); err != nil {
+		t.Fatal(err)
+	}
+	g.mu.Unlock()
+
+	infos := g.GetInfosWithPrefix(KeyNodeIDPrefix)
+	if len(infos) != 2 || infos[MakeNodeIDKey(1)] == nil || infos[MakeNodeIDKey(2)] == nil {
+		t.Errorf("expected node descriptors of nodes 1 and 2 only; got %+v", infos)
+	}
+	if err := g.DeleteInfo(MakeNodeIDKey(1)); err != nil {
+		t.Fatal(err)
+	}
+	if infos := g.GetInfosWithPrefix(KeyNodeIDPrefix); len(infos) != 1 {
+		t.Errorf("expected deleted descriptor to be omitted; got %+v", infos)
+	}
+
+	lastHeard := g.LastHeard()
+	if len(lastHeard) != 2 || lastHeard[2] != 100 || lastHeard[1] <= 100 {
+		t.Errorf("expected last heard times for nodes 1 and 2; got %+v", lastHeard)
+	}
+}
+
+// testStorage is an in-memory implementation of the Storage interface.
+type testStorage struct {
+	info   proto.GossipBootstrapInfo
+	writes int
+}
+
+func (ts *testStorage) ReadBootstrapInfo(info *proto.GossipBootstrapInfo) error {
+	*info = ts.info
+	return nil
+}
+
+func (ts *testStorage) WriteBootstrapInfo(info *proto.GossipBootstrapInfo) error {
+	ts.info = *info
+	ts.writes++
+	return nil
+}
+
+// TestGossipStorage verifies that persisted bootstrap addresses are
+// added to the gossip resolvers and that the addresses of nodes known
+// via gossip are persisted when they change.
+func TestGossipStorage(t *testing.T) {
+	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), security.LoadInsecureTLSConfig(), nil)
+	resolver, err := NewResolver("tcp=127.0.0.1:9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := New(rpcContext, TestInterval, []Resolver{resolver})
+	storage := &testStorage{info: proto.GossipBootstrapInfo{
+		Addresses: []string{"tcp=127.0.0.1:9000", "tcp=127.0.0.1:9001", "foo=bar"},
+	}}
+	if err := g.SetStorage(storage); err != nil {
+		t.Fatal(err)
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.resolvers) != 2 || g.resolvers[1].Addr() != "127.0.0.1:9001" {
+		t.Errorf("expected persisted address to be appended to resolvers; got %+v", g.resolvers)
+	}
+
+	// Nothing is written while no other nodes are known.
+	g.persistBootstrapInfoLocked()
+	if storage.writes != 0 {
+		t.Errorf("expected no writes without known nodes; got %d", storage.writes)
+	}
+
+	for i := 1; i <= 2; i++ {
+		desc := &NodeDescriptor{
+			NodeID:  proto.NodeID(i),
+			Address: util.MakeRawAddr("tcp", fmt.Sprintf("127.0.0.1:900%d", i)),
+		}
+		if err := g.is.addInfo(g.is.newInfo(MakeNodeIDKey(desc.NodeID), desc, time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.persistBootstrapInfoLocked()
+	g.persistBootstrapInfoLocked()
+	expAddrs := []string{"tcp=127.0.0.1:9001", "tcp=127.0.0.1:9002"}
+	if !reflect.DeepEqual(storage.info.Addresses, expAddrs) {
+		t.Errorf("expected persisted addresses %s; got %s", expAddrs, storage.info.Addresses)
+	}
+	if storage.writes != 1 {
+		t.Errorf("expected unchanged addresses to be written once; got %d writes", storage.writes)
+	}
+}
+
 // TestGossipGroupsInfoStore verifies gossiping of groups via the
 // gossip instance infostore.
 func TestGossipGroupsInfoStore(t *testing.T) {
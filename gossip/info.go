@@ -36,6 +36,7 @@ type info struct {
 	Timestamp int64        `json:"-"` // Wall time at origination (Unix-nanos)
 	TTLStamp  int64        `json:"-"` // Wall time before info is discarded (Unix-nanos)
 	Hops      uint32       `json:"-"` // Number of hops from originator
+	Deleted   bool         `json:"-"` // True if info is a deletion tombstone
 	NodeID    proto.NodeID `json:"-"` // Originating node's ID
 	peerID    proto.NodeID // Proximate peer's ID which passed us the info
 	seq       int64        // Sequence number for incremental updates
@@ -38,6 +38,17 @@ type callback struct {
 	method  Callback
 }
 
+// expiryCallback holds regexp pattern match and ExpiryCallback method.
+type expiryCallback struct {
+	pattern *regexp.Regexp
+	method  ExpiryCallback
+}
+
+// ttlTombstone is the time-to-live of the tombstone left in place of a
+// deleted info. It must be long enough for the tombstone to propagate
+// to every node which may hold the deleted info.
+const ttlTombstone = 10 * time.Minute
+
 // infoStore objects manage maps of Info and maps of Info Group
 // objects. They maintain a sequence number generator which they use
 // to allocate new info objects.
@@ -56,6 +67,14 @@ type infoStore struct {
 	MaxSeq    int64        `json:"-"`                // Maximum sequence number inserted
 	seqGen    int64        // Sequence generator incremented each time info is added
 	callbacks []callback
+
+	expiryCallbacks []expiryCallback
+
+	// Callbacks are queued as work and run in order by a single
+	// goroutine, which exits once the queue is empty.
+	callbackMu      sync.Mutex
+	callbackWork    []func()
+	callbackWorking bool
 }
 
 // monotonicUnixNano returns a monotonically increasing value for
@@ -146,7 +165,16 @@ func (is *infoStore) newInfo(key string, val interface{}, ttl time.Duration) *in
 	}
 }
 
-// getInfo returns an info object by key or nil if it doesn't exist.
+// newTombstone allocates and returns a new info object marking the
+// info with the specified key as deleted.
+func (is *infoStore) newTombstone(key string) *info {
+	i := is.newInfo(key, nil, ttlTombstone)
+	i.Deleted = true
+	return i
+}
+
+// getInfo returns an info object by key or nil if it doesn't exist
+// or has been deleted.
 func (is *infoStore) getInfo(key string) *info {
 	if group := is.belongsToGroup(key); group != nil {
 		return group.getInfo(key)
@@ -154,7 +182,10 @@ func (is *infoStore) getInfo(key string) *info {
 	if info, ok := is.Infos[key]; ok {
 		// Check TTL and discard if too old.
 		if info.expired(time.Now().UnixNano()) {
-			delete(is.Infos, key)
+			is.expireInfo(info)
+			return nil
+		}
+		if info.Deleted {
 			return nil
 		}
 		return info
@@ -162,6 +193,16 @@ func (is *infoStore) getInfo(key string) *info {
 	return nil
 }
 
+// expireInfo removes an expired info from the infos map. Expiry
+// callbacks are invoked unless the info is a tombstone, in which case
+// they were already invoked on deletion.
+func (is *infoStore) expireInfo(i *info) {
+	delete(is.Infos, i.Key)
+	if !i.Deleted {
+		is.processExpiryCallbacks(i.Key)
+	}
+}
+
 // getGroupInfos returns an array of info objects from specified group.
 // Returns nil if group is not registered.
 func (is *infoStore) getGroupInfos(prefix string) infoSlice {
@@ -194,10 +235,17 @@ func (is *infoStore) registerGroup(g *group) error {
 // info is added to that group (prefix is defined by prefix of string up
 // until last period '.'). Otherwise, the info is added to the infos map.
 //
+// If the info is a tombstone, it replaces any existing info and expiry
+// callbacks are invoked in place of update callbacks. Infos belonging
+// to groups cannot be deleted.
+//
 // Returns nil if info was added; error otherwise.
 func (is *infoStore) addInfo(i *info) error {
 	// If the prefix matches a group, add to group.
 	if group := is.belongsToGroup(i.Key); group != nil {
+		if i.Deleted {
+			return util.Errorf("info %q belongs to group %q and cannot be deleted", i.Key, group.Prefix)
+		}
 		contentsChanged, err := group.addInfo(i)
 		if err != nil {
 			return err
@@ -205,18 +253,19 @@ func (is *infoStore) addInfo(i *info) error {
 		if i.seq > is.MaxSeq {
 			is.MaxSeq = i.seq
 		}
-		is.processCallbacks(i.Key, contentsChanged)
+		is.processCallbacks(i.Key, i.Val, contentsChanged)
 		return nil
 	}
 	// Only replace an existing info if new timestamp is greater, or if
 	// timestamps are equal, but new hops is smaller.
-	var contentsChanged bool
+	var contentsChanged, existed bool
 	if existingInfo, ok := is.Infos[i.Key]; ok {
 		if i.Timestamp < existingInfo.Timestamp ||
 			(i.Timestamp == existingInfo.Timestamp && i.Hops >= existingInfo.Hops) {
 			return util.Errorf("info %+v older than current info %+v", i, existingInfo)
 		}
-		contentsChanged = !reflect.DeepEqual(existingInfo.Val, i.Val)
+		contentsChanged = existingInfo.Deleted != i.Deleted || !reflect.DeepEqual(existingInfo.Val, i.Val)
+		existed = !existingInfo.Deleted
 	} else {
 		// No preexisting info means contentsChanged is true.
 		contentsChanged = true
@@ -226,7 +275,13 @@ func (is *infoStore) addInfo(i *info) error {
 	if i.seq > is.MaxSeq {
 		is.MaxSeq = i.seq
 	}
-	is.processCallbacks(i.Key, contentsChanged)
+	if i.Deleted {
+		if existed {
+			is.processExpiryCallbacks(i.Key)
+		}
+		return nil
+	}
+	is.processCallbacks(i.Key, i.Val, contentsChanged)
 	return nil
 }
 
@@ -256,43 +311,118 @@ func (is *infoStore) maxHops() uint32 {
 }
 
 // registerCallback compiles a regexp for pattern and adds it to
-// the callbacks slice.
-func (is *infoStore) registerCallback(pattern string, method Callback) {
+// the callbacks slice. The callback is invoked for all matching infos
+// already in the info store; the returned channel is closed once it
+// has been.
+func (is *infoStore) registerCallback(pattern string, method Callback) <-chan struct{} {
 	re := regexp.MustCompile(pattern)
 	is.callbacks = append(is.callbacks, callback{pattern: re, method: method})
 	var infos []*info
 	if err := is.visitInfos(nil, func(i *info) error {
-		if re.MatchString(i.Key) {
+		if !i.Deleted && re.MatchString(i.Key) {
 			infos = append(infos, i)
 		}
 		return nil
 	}); err != nil {
 		log.Errorf("failed to properly run registered callback while visiting pre-existing info: %s", err)
 	}
-	// Run callbacks in a goroutine to avoid mutex reentry.
-	go func() {
+	done := make(chan struct{})
+	is.runCallbacks(func() {
 		for _, i := range infos {
-			method(i.Key, true /* contentsChanged */)
+			method(i.Key, i.Val)
 		}
-	}()
+		close(done)
+	})
+	return done
 }
 
 // processCallbacks processes callbacks for the specified key by
 // matching callback regular expression against the key and invoking
-// the corresponding callback method on a match.
-func (is *infoStore) processCallbacks(key string, contentsChanged bool) {
+// the corresponding callback method on a match. Callbacks are only
+// invoked if the info's contents changed.
+func (is *infoStore) processCallbacks(key string, val interface{}, contentsChanged bool) {
+	if !contentsChanged {
+		return
+	}
 	var matches []callback
 	for _, cb := range is.callbacks {
 		if cb.pattern.MatchString(key) {
 			matches = append(matches, cb)
 		}
 	}
-	// Run callbacks in a goroutine to avoid mutex reentry.
-	go func() {
+	if len(matches) == 0 {
+		return
+	}
+	is.runCallbacks(func() {
 		for _, cb := range matches {
-			cb.method(key, contentsChanged)
+			cb.method(key, val)
 		}
-	}()
+	})
+}
+
+// runCallbacks queues work which invokes callbacks. The work is run
+// in a goroutine to avoid mutex reentry, after all previously queued
+// work, so that callbacks observe updates in the order they occurred.
+func (is *infoStore) runCallbacks(work func()) {
+	is.callbackMu.Lock()
+	defer is.callbackMu.Unlock()
+	is.callbackWork = append(is.callbackWork, work)
+	if !is.callbackWorking {
+		is.callbackWorking = true
+		go is.processCallbackWork()
+	}
+}
+
+// processCallbackWork runs queued callback work until the queue is
+// empty.
+func (is *infoStore) processCallbackWork() {
+	for {
+		is.callbackMu.Lock()
+		work := is.callbackWork
+		is.callbackWork = nil
+		if len(work) == 0 {
+			is.callbackWorking = false
+			is.callbackMu.Unlock()
+			return
+		}
+		is.callbackMu.Unlock()
+		for _, w := range work {
+			w()
+		}
+	}
+}
+
+// registerExpiryCallback compiles a regexp for pattern and adds it to
+// the expiry callbacks slice.
+func (is *infoStore) registerExpiryCallback(pattern string, method ExpiryCallback) {
+	re := regexp.MustCompile(pattern)
+	is.expiryCallbacks = append(is.expiryCallbacks, expiryCallback{pattern: re, method: method})
+}
+
+// processExpiryCallbacks processes expiry callbacks for the specified
+// key, which has expired or been deleted.
+func (is *infoStore) processExpiryCallbacks(key string) {
+	var matches []expiryCallback
+	for _, cb := range is.expiryCallbacks {
+		if cb.pattern.MatchString(key) {
+			matches = append(matches, cb)
+		}
+	}
+	if len(matches) == 0 {
+		return
+	}
+	is.runCallbacks(func() {
+		for _, cb := range matches {
+			cb.method(key)
+		}
+	})
+}
+
+// sweep discards all expired infos and tombstones from the info
+// store, invoking expiry callbacks for the infos. Expired infos are
+// otherwise discarded only when they are next visited.
+func (is *infoStore) sweep() {
+	is.visitInfos(nil, func(*info) error { return nil })
 }
 
 // visitInfos implements a visitor pattern to run two methods in the
@@ -301,7 +431,8 @@ func (is *infoStore) processCallbacks(key string, contentsChanged bool) {
 // turn. After each group is visited, the visitInfo function is run
 // against each of its infos. Finally, after all groups have been
 // visitied, the visitInfo function is run against each non-group info
-// in turn. Be sure to skip over any expired infos.
+// in turn, including tombstones. Be sure to skip over any expired
+// infos.
 func (is *infoStore) visitInfos(visitGroup func(*group) error, visitInfo func(*info) error) error {
 	now := time.Now().UnixNano()
 	for _, g := range is.Groups {
@@ -326,7 +457,7 @@ func (is *infoStore) visitInfos(visitGroup func(*group) error, visitInfo func(*i
 	if visitInfo != nil {
 		for _, i := range is.Infos {
 			if i.expired(now) {
-				delete(is.Infos, i.Key)
+				is.expireInfo(i)
 				continue
 			}
 			if err := visitInfo(i); err != nil {
@@ -373,7 +504,8 @@ func (is *infoStore) combine(delta *infoStore) int {
 // are intended for efficiently updating peer nodes. Any infos passed
 // from node requesting delta are ignored.
 //
-// Returns nil if there are no deltas.
+// Returns nil if there are no deltas, including when the only infos
+// added since seq were passed from the requesting node.
 func (is *infoStore) delta(nodeID proto.NodeID, seq int64) *infoStore {
 	if seq >= is.MaxSeq {
 		return nil
@@ -392,6 +524,9 @@ func (is *infoStore) delta(nodeID proto.NodeID, seq int64) *infoStore {
 		}
 		return nil
 	})
+	if delta.infoCount() == 0 {
+		return nil
+	}
 
 	delta.MaxSeq = is.MaxSeq
 	return delta
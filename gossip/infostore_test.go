@@ -126,6 +126,93 @@ func TestInfoStoreGetInfoTTL(t *testing.T) {
 	}
 }
 
+// TestInfoStoreDeleteInfo verifies that a tombstone hides the deleted
+// info, is propagated in deltas in its place, and is itself replaced
+// by newer infos.
+func TestInfoStoreDeleteInfo(t *testing.T) {
+	is := newInfoStore(1, emptyAddr)
+	if err := is.addInfo(is.newInfo("a", float64(1), time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	peer := newInfoStore(2, emptyAddr)
+	peer.combine(is.delta(2, 0))
+	if peer.getInfo("a") == nil {
+		t.Fatal("expected info to be propagated")
+	}
+
+	seq := is.MaxSeq
+	if err := is.addInfo(is.newTombstone("a")); err != nil {
+		t.Fatal(err)
+	}
+	if is.getInfo("a") != nil {
+		t.Error("expected deleted info to be hidden")
+	}
+	peer.combine(is.delta(2, seq))
+	if peer.getInfo("a") != nil {
+		t.Error("expected tombstone to delete info from peer")
+	}
+
+	if err := is.addInfo(is.newInfo("a", float64(2), time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if i := is.getInfo("a"); i == nil || i.Val.(float64) != 2 {
+		t.Errorf("expected newer info to replace tombstone; got %+v", i)
+	}
+
+	// Infos belonging to groups can't be deleted.
+	if err := is.registerGroup(newGroup("g", 10, MinGroup)); err != nil {
+		t.Fatal(err)
+	}
+	if err := is.addInfo(is.newTombstone("g.a")); err == nil {
+		t.Error("expected error deleting group info")
+	}
+}
+
+// TestExpiryCallbacks verifies that expiry callbacks are invoked for
+// infos which are deleted or which expire and are swept, but not for
+// tombstones of nonexistent infos.
+func TestExpiryCallbacks(t *testing.T) {
+	is := newInfoStore(1, emptyAddr)
+	wg := &sync.WaitGroup{}
+	cb := callbackRecord{wg: wg}
+	is.registerExpiryCallback("key.*", cb.Expire)
+
+	for _, i := range []*info{
+		is.newInfo("key1", float64(1), time.Nanosecond),
+		is.newInfo("key2", float64(1), time.Hour),
+		is.newInfo("other", float64(1), time.Nanosecond),
+	} {
+		if err := is.addInfo(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wg.Add(1)
+	if err := is.addInfo(is.newTombstone("key2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := is.addInfo(is.newTombstone("key3")); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	if expKeys := []string{"key2"}; !reflect.DeepEqual(cb.Keys(), expKeys) {
+		t.Errorf("expected %v, got %v", expKeys, cb.Keys())
+	}
+
+	time.Sleep(time.Millisecond)
+	wg.Add(1)
+	is.sweep()
+	wg.Wait()
+	if expKeys := []string{"key2", "key1"}; !reflect.DeepEqual(cb.Keys(), expKeys) {
+		t.Errorf("expected %v, got %v", expKeys, cb.Keys())
+	}
+	for _, key := range []string{"key1", "other"} {
+		if _, ok := is.Infos[key]; ok {
+			t.Errorf("expected expired info %q to be swept", key)
+		}
+	}
+}
+
 // Add infos using same key, same and lesser timestamp; verify no
 // replacement.
 func TestAddInfoSameKeyLessThanEqualTimestamp(t *testing.T) {
@@ -412,6 +499,20 @@ func TestInfoStoreDelta(t *testing.T) {
 	if delta := is.delta(2, int64(30)); delta != nil {
 		t.Error("fetching delta of infostore at maximum sequence number should return nil")
 	}
+
+	// An info passed from node 2 is not sent back to it, so its delta
+	// remains empty while other nodes receive the info.
+	i := is.newInfo("d", int64(1), time.Hour)
+	i.peerID = 2
+	if err := is.addInfo(i); err != nil {
+		t.Fatal(err)
+	}
+	if delta := is.delta(2, int64(30)); delta != nil {
+		t.Errorf("expected no delta for node which passed the only new info; got %s", delta)
+	}
+	if delta := is.delta(3, int64(30)); delta == nil || delta.getInfo("d") == nil {
+		t.Errorf("expected delta containing new info; got %s", delta)
+	}
 }
 
 // TestInfoStoreDistant verifies selection of infos from store with
@@ -491,10 +592,17 @@ type callbackRecord struct {
 	sync.Mutex
 }
 
-func (cr *callbackRecord) Add(key string, contentsChanged bool) {
+func (cr *callbackRecord) Add(key string, val interface{}) {
+	cr.Lock()
+	defer cr.Unlock()
+	cr.keys = append(cr.keys, fmt.Sprintf("%s-%v", key, val))
+	cr.wg.Done()
+}
+
+func (cr *callbackRecord) Expire(key string) {
 	cr.Lock()
 	defer cr.Unlock()
-	cr.keys = append(cr.keys, fmt.Sprintf("%s-%t", key, contentsChanged))
+	cr.keys = append(cr.keys, key)
 	cr.wg.Done()
 }
 
@@ -527,40 +635,41 @@ func TestCallbacks(t *testing.T) {
 		is.addInfo(i3)
 		wg.Wait()
 
-		if expKeys := []string{"key1-true"}; !reflect.DeepEqual(cb1.Keys(), expKeys) {
+		if expKeys := []string{"key1-1"}; !reflect.DeepEqual(cb1.Keys(), expKeys) {
 			t.Errorf("expected %v, got %v", expKeys, cb1.Keys())
 		}
-		if expKeys := []string{"key2-true"}; !reflect.DeepEqual(cb2.Keys(), expKeys) {
+		if expKeys := []string{"key2-1"}; !reflect.DeepEqual(cb2.Keys(), expKeys) {
 			t.Errorf("expected %v, got %v", expKeys, cb2.Keys())
 		}
-		if expKeys := []string{"key1-true", "key2-true", "key3-true"}; !reflect.DeepEqual(cbAll.Keys(), expKeys) {
+		if expKeys := []string{"key1-1", "key2-1", "key3-1"}; !reflect.DeepEqual(cbAll.Keys(), expKeys) {
 			t.Errorf("expected %v, got %v", expKeys, cbAll.Keys())
 		}
 	}
 
-	// Update an info.
+	// Refresh an info without changing its contents and verify
+	// callbacks aren't called, then update an info.
+	is.addInfo(is.newInfo("key2", float64(1), time.Second))
 	i1 = is.newInfo("key1", float64(2), time.Second)
 	wg.Add(2)
 	is.addInfo(i1)
 	wg.Wait()
 
-	if expKeys := []string{"key1-true", "key1-true"}; !reflect.DeepEqual(cb1.Keys(), expKeys) {
+	if expKeys := []string{"key1-1", "key1-2"}; !reflect.DeepEqual(cb1.Keys(), expKeys) {
 		t.Errorf("expected %v, got %v", expKeys, cb1.Keys())
 	}
-	if expKeys := []string{"key2-true"}; !reflect.DeepEqual(cb2.Keys(), expKeys) {
+	if expKeys := []string{"key2-1"}; !reflect.DeepEqual(cb2.Keys(), expKeys) {
 		t.Errorf("expected %v, got %v", expKeys, cb2.Keys())
 	}
-	if expKeys := []string{"key1-true", "key2-true", "key3-true", "key1-true"}; !reflect.DeepEqual(cbAll.Keys(), expKeys) {
+	if expKeys := []string{"key1-1", "key2-1", "key3-1", "key1-2"}; !reflect.DeepEqual(cbAll.Keys(), expKeys) {
 		t.Errorf("expected %v, got %v", expKeys, cbAll.Keys())
 	}
 
 	// Register another callback with same pattern and verify it is
-	// invoked for all three keys.
+	// invoked for all three keys with their current values.
 	wg.Add(3)
-	is.registerCallback("key.*", cbAll.Add)
-	wg.Wait()
+	<-is.registerCallback("key.*", cbAll.Add)
 
-	expKeys := []string{"key1-true", "key2-true", "key3-true", "key1-true", "key1-true", "key2-true", "key3-true"}
+	expKeys := []string{"key1-1", "key2-1", "key3-1", "key1-2", "key1-2", "key2-1", "key3-1"}
 	sort.Strings(expKeys)
 	keys := append([]string{}, cbAll.Keys()...)
 	sort.Strings(keys)
@@ -569,6 +678,28 @@ func TestCallbacks(t *testing.T) {
 	}
 }
 
+// TestCallbacksInOrder verifies that callbacks observe successive
+// updates of an info in the order in which they were made.
+func TestCallbacksInOrder(t *testing.T) {
+	is := newInfoStore(1, emptyAddr)
+	wg := &sync.WaitGroup{}
+	cb := callbackRecord{wg: wg}
+	is.registerCallback("key", cb.Add)
+
+	var expKeys []string
+	wg.Add(100)
+	for i := 0; i < 100; i++ {
+		if err := is.addInfo(is.newInfo("key", int64(i), time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		expKeys = append(expKeys, fmt.Sprintf("key-%d", i))
+	}
+	wg.Wait()
+	if !reflect.DeepEqual(cb.Keys(), expKeys) {
+		t.Errorf("expected %v, got %v", expKeys, cb.Keys())
+	}
+}
+
 // TestRegisterCallback verifies that a callback is invoked when
 // registered if there are items which match its regexp in the
 // infostore.
@@ -583,11 +714,10 @@ func TestRegisterCallback(t *testing.T) {
 	is.addInfo(i2)
 
 	wg.Add(2)
-	is.registerCallback("key.*", cb.Add)
-	wg.Wait()
+	<-is.registerCallback("key.*", cb.Add)
 	actKeys := cb.Keys()
 	sort.Strings(actKeys)
-	if expKeys := []string{"key1-true", "key2-true"}; !reflect.DeepEqual(actKeys, expKeys) {
+	if expKeys := []string{"key1-1", "key2-1"}; !reflect.DeepEqual(actKeys, expKeys) {
 		t.Errorf("expected %v, got %v", expKeys, cb.Keys())
 	}
 }
@@ -36,18 +36,14 @@ const (
 	// The value is a string UUID for the cluster.
 	KeyClusterID = "cluster-id"
 
-	// KeyConfigAccounting is the accounting configuration map.
-	KeyConfigAccounting = "accounting"
-
-	// KeyConfigPermission is the permission configuration map.
-	KeyConfigPermission = "permissions"
-
-	// KeyConfigZone is the zone configuration map.
-	KeyConfigZone = "zones"
+	// KeyAcctUsagePrefix is the key prefix for gossiping the usage of
+	// accounting prefixes by the ranges each store leads. The suffix is
+	// the store ID. The value is a storage.AcctUsage map.
+	KeyAcctUsagePrefix = "acct-usage"
 
 	// KeyMaxAvailCapacityPrefix is the key prefix for gossiping available
-	// store capacity. The suffix is composed of: <node ID>-<store ID>.
-	// The value is a storage.StoreDescriptor struct.
+	// store capacity. The suffix is the node ID. The value is a slice of
+	// storage.StoreDescriptor structs, one for each of the node's stores.
 	KeyMaxAvailCapacityPrefix = "max-avail-capacity"
 
 	// KeyNodeCount is the count of gossip nodes in the network. The
@@ -62,15 +58,28 @@ const (
 	// string address of the node. E.g. node:1 => 127.0.0.1:24001
 	KeyNodeIDPrefix = "node"
 
+	// KeySystemConfig is the snapshot of all configuration maps stored
+	// in the system config span, gossiped whenever any of them changes.
+	// The value is a storage.SystemConfig struct.
+	KeySystemConfig = "system-config"
+
 	// KeySentinel is a key for gossip which must not expire or else the
 	// node considers itself partitioned and will retry with bootstrap hosts.
 	KeySentinel = KeyClusterID
 
 	// KeyFirstRangeDescriptor is the descriptor for the "first"
 	// range. The "first" range contains the meta1 key range, the first
-	// level of the bi-level key addressing scheme. The value is a slice
-	// of storage.Replica structs.
+	// level of the bi-level key addressing scheme. The value is a
+	// storage.FirstRangeDescriptor, gossiped by the raft leader of the
+	// range.
 	KeyFirstRangeDescriptor = "first-range"
+
+	// KeyFirstRangeReplicaPrefix is the key prefix for gossiping the
+	// first range descriptor from each of its replicas, keyed by store
+	// ID. The value is a storage.FirstRangeDescriptor. Callback patterns
+	// are unanchored regular expressions, so the prefix must not contain
+	// KeyFirstRangeDescriptor.
+	KeyFirstRangeReplicaPrefix = "first-replica"
 )
 
 // MakeKey creates a canonical key under which to gossip a piece of
@@ -93,7 +102,20 @@ func MakeNodeIDKey(nodeID proto.NodeID) string {
 	return MakeKey(KeyNodeIDPrefix, nodeID.String())
 }
 
-// MakeMaxAvailCapacityKey returns the gossip key for the given store's capacity.
-func MakeMaxAvailCapacityKey(nodeID proto.NodeID, storeID proto.StoreID) string {
-	return MakeKey(KeyMaxAvailCapacityPrefix, nodeID.String(), storeID.String())
+// MakeAcctUsageKey returns the gossip key for the given store's
+// accounting usage.
+func MakeAcctUsageKey(storeID proto.StoreID) string {
+	return MakeKey(KeyAcctUsagePrefix, storeID.String())
+}
+
+// MakeFirstRangeReplicaKey returns the gossip key under which the
+// replica of the first range on the given store gossips its descriptor.
+func MakeFirstRangeReplicaKey(storeID proto.StoreID) string {
+	return MakeKey(KeyFirstRangeReplicaPrefix, storeID.String())
+}
+
+// MakeMaxAvailCapacityKey returns the gossip key for the capacities of
+// the given node's stores.
+func MakeMaxAvailCapacityKey(nodeID proto.NodeID) string {
+	return MakeKey(KeyMaxAvailCapacityPrefix, nodeID.String())
 }
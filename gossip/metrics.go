@@ -0,0 +1,106 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package gossip
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/cockroach/util/metrics"
+)
+
+const (
+	// Names of the gossip metrics registered via RegisterMetrics.
+	infosSentMetric         = "gossip.infos.sent"
+	infosReceivedMetric     = "gossip.infos.received"
+	bytesSentMetric         = "gossip.bytes.sent"
+	bytesReceivedMetric     = "gossip.bytes.received"
+	connectionsOpenedMetric = "gossip.connections.opened"
+	connectionsClosedMetric = "gossip.connections.closed"
+)
+
+// Counters holds cumulative counts of a gossip instance's network
+// activity, covering both the client and server sides of the protocol.
+type Counters struct {
+	InfosSent         int64 // Infos sent to peers
+	InfosReceived     int64 // Infos received from peers
+	BytesSent         int64 // Bytes of encoded infostore deltas sent
+	BytesReceived     int64 // Bytes of encoded infostore deltas received
+	ConnectionsOpened int64 // Incoming and outgoing peer connections opened
+	ConnectionsClosed int64 // Incoming and outgoing peer connections closed
+}
+
+// recordSent adds a delta of count infos and size bytes sent to a peer.
+func (c *Counters) recordSent(count uint32, size int) {
+	atomic.AddInt64(&c.InfosSent, int64(count))
+	atomic.AddInt64(&c.BytesSent, int64(size))
+}
+
+// recordReceived adds a delta of count infos and size bytes received
+// from a peer.
+func (c *Counters) recordReceived(count uint32, size int) {
+	atomic.AddInt64(&c.InfosReceived, int64(count))
+	atomic.AddInt64(&c.BytesReceived, int64(size))
+}
+
+// recordOpened counts a newly opened peer connection.
+func (c *Counters) recordOpened() {
+	atomic.AddInt64(&c.ConnectionsOpened, 1)
+}
+
+// recordClosed counts a closed peer connection.
+func (c *Counters) recordClosed() {
+	atomic.AddInt64(&c.ConnectionsClosed, 1)
+}
+
+// snapshot returns a copy of the counters, loading each atomically.
+func (c *Counters) snapshot() Counters {
+	return Counters{
+		InfosSent:         atomic.LoadInt64(&c.InfosSent),
+		InfosReceived:     atomic.LoadInt64(&c.InfosReceived),
+		BytesSent:         atomic.LoadInt64(&c.BytesSent),
+		BytesReceived:     atomic.LoadInt64(&c.BytesReceived),
+		ConnectionsOpened: atomic.LoadInt64(&c.ConnectionsOpened),
+		ConnectionsClosed: atomic.LoadInt64(&c.ConnectionsClosed),
+	}
+}
+
+// Counters returns a copy of the gossip instance's counters.
+func (g *Gossip) Counters() Counters {
+	return g.counters.snapshot()
+}
+
+// RegisterMetrics registers the gossip instance's counters as gauges
+// with the supplied metric system. Only a single gossip instance per
+// process should be registered, as registering another replaces the
+// gauges of the first.
+func (g *Gossip) RegisterMetrics(ms *metrics.MetricSystem) {
+	gauges := map[string]*int64{
+		infosSentMetric:         &g.counters.InfosSent,
+		infosReceivedMetric:     &g.counters.InfosReceived,
+		bytesSentMetric:         &g.counters.BytesSent,
+		bytesReceivedMetric:     &g.counters.BytesReceived,
+		connectionsOpenedMetric: &g.counters.ConnectionsOpened,
+		connectionsClosedMetric: &g.counters.ConnectionsClosed,
+	}
+	for name, counter := range gauges {
+		counter := counter
+		ms.RegisterGaugeFunc(name, func() float64 {
+			return float64(atomic.LoadInt64(counter))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package gossip
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// TestServerCounters verifies that the gossip server counts the infos
+// and bytes it receives and sends, as well as new incoming clients.
+func TestServerCounters(t *testing.T) {
+	s := newServer(time.Hour)
+	s.is.NodeID = 1
+	if err := s.is.addInfo(s.is.newInfo("local", int64(1), time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	delta := newInfoStore(2, nil)
+	for _, key := range []string{"a", "b"} {
+		if err := delta.addInfo(delta.newInfo(key, int64(2), time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(delta); err != nil {
+		t.Fatal(err)
+	}
+	args := &proto.GossipRequest{
+		NodeID: 2,
+		Addr:   *proto.FromNetAddr(util.MakeRawAddr("tcp", "127.0.0.1:2")),
+		LAddr:  *proto.FromNetAddr(util.MakeRawAddr("tcp", "127.0.0.1:3")),
+		MaxSeq: -1,
+		Delta:  buf.Bytes(),
+	}
+	reply := &proto.GossipResponse{}
+	if err := s.Gossip(args, reply); err != nil {
+		t.Fatal(err)
+	}
+
+	c := s.counters.snapshot()
+	if c.InfosReceived != 2 || c.BytesReceived != int64(len(args.Delta)) {
+		t.Errorf("expected 2 infos and %d bytes received; got %+v", len(args.Delta), c)
+	}
+	// Infos received from node 2 aren't sent back to it.
+	if c.InfosSent != 1 || c.BytesSent != int64(len(reply.Delta)) {
+		t.Errorf("expected 1 info and %d bytes sent; got %+v", len(reply.Delta), c)
+	}
+	if c.ConnectionsOpened != 1 || c.ConnectionsClosed != 0 {
+		t.Errorf("expected a single opened connection; got %+v", c)
+	}
+}
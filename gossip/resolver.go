@@ -19,6 +19,8 @@ package gossip
 
 import (
 	"net"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -34,6 +36,14 @@ type Resolver interface {
 	IsExhausted() bool
 }
 
+// lookupHost and lookupSRV are the DNS lookup functions used by
+// "dns" and "srv" resolvers. They are variables so that tests may
+// substitute their own.
+var (
+	lookupHost = net.LookupHost
+	lookupSRV  = net.LookupSRV
+)
+
 // socketResolver represents the different types of socket-based
 // address resolvers. Based on the Type, it may return the same
 // address multiple times (eg: "lb") or not (eg: "tcp").
@@ -41,6 +51,7 @@ type socketResolver struct {
 	typ       string
 	addr      string
 	exhausted bool // Can we try this resolver again?
+	idx       int  // Index of the next DNS result to return
 }
 
 // Type returns the resolver type.
@@ -69,6 +80,39 @@ func (sr *socketResolver) GetAddress() (net.Addr, error) {
 			sr.exhausted = true
 		}
 		return util.MakeRawAddr("tcp", sr.addr), nil
+	case "dns":
+		// "dns" resolvers look up the host on each call, cycling through
+		// the returned addresses. They are never exhausted.
+		host, port, err := net.SplitHostPort(sr.addr)
+		if err != nil {
+			return nil, err
+		}
+		hosts, err := lookupHost(host)
+		if err != nil {
+			return nil, err
+		}
+		if len(hosts) == 0 {
+			return nil, util.Errorf("no addresses found for host %q", host)
+		}
+		sort.Strings(hosts)
+		addr := net.JoinHostPort(hosts[sr.idx%len(hosts)], port)
+		sr.idx++
+		return util.MakeRawAddr("tcp", addr), nil
+	case "srv":
+		// "srv" resolvers look up the SRV records for the name on each
+		// call, cycling through the returned targets. They are never
+		// exhausted.
+		_, srvs, err := lookupSRV("", "", sr.addr)
+		if err != nil {
+			return nil, err
+		}
+		if len(srvs) == 0 {
+			return nil, util.Errorf("no SRV records found for %q", sr.addr)
+		}
+		srv := srvs[sr.idx%len(srvs)]
+		sr.idx++
+		target := strings.TrimSuffix(srv.Target, ".")
+		return util.MakeRawAddr("tcp", net.JoinHostPort(target, strconv.Itoa(int(srv.Port)))), nil
 	}
 	return nil, util.Errorf("unknown address type: %q", sr.typ)
 }
@@ -81,6 +125,8 @@ var validTypes = map[string]struct{}{
 	"tcp":  struct{}{},
 	"lb":   struct{}{},
 	"unix": struct{}{},
+	"dns":  struct{}{},
+	"srv":  struct{}{},
 }
 
 // NewResolver takes a resolver specification and returns a new resolver.
@@ -89,6 +135,10 @@ var validTypes = map[string]struct{}{
 // - tcp: plain hostname of ip address
 // - lb: load balancer host name or ip: points to an unknown number of backends
 // - unix: unix sockets
+// - dns: host name and port: the host is re-resolved on each attempt
+//   and all of its addresses are tried in turn
+// - srv: DNS SRV record name (eg: "_cockroach._tcp.example.com"): the
+//   records are re-resolved on each attempt and all targets are tried in turn
 // If "network type" is not specified, "tcp" is assumed.
 func NewResolver(spec string) (Resolver, error) {
 	parts := strings.Split(spec, "=")
@@ -115,8 +165,8 @@ func NewResolver(spec string) (Resolver, error) {
 			"valid types are %s", spec, validTypes)
 	}
 
-	// If we're on tcp or lb make sure we fill in the host when not specified (eg: ":8080")
-	if typ == "tcp" || typ == "lb" {
+	// If we're on tcp, lb or dns make sure we fill in the host when not specified (eg: ":8080")
+	if typ == "tcp" || typ == "lb" || typ == "dns" {
 		addr = util.EnsureHost(addr)
 	}
 
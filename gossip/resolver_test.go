@@ -18,6 +18,7 @@
 package gossip
 
 import (
+	"net"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -37,6 +38,9 @@ func TestParseResolverSpec(t *testing.T) {
 		{"tcp=127.0.0.1", true, "tcp", "127.0.0.1"},
 		{"lb=127.0.0.1", true, "lb", "127.0.0.1"},
 		{"unix=/tmp/unix-socket12345", true, "unix", "/tmp/unix-socket12345"},
+		{"dns=localhost:8080", true, "dns", "localhost:8080"},
+		{"dns=:8080", true, "dns", util.EnsureHost(":8080")},
+		{"srv=_cockroach._tcp.example.com", true, "srv", "_cockroach._tcp.example.com"},
 		{"", false, "", ""},
 		{"foo=127.0.0.1", false, "", ""},
 		{"lb=", false, "", ""},
@@ -100,3 +104,77 @@ func TestGetAddress(t *testing.T) {
 		}
 	}
 }
+
+// TestDNSResolvers verifies that "dns" and "srv" resolvers look up
+// their addresses on each call, cycle through the results and are
+// never exhausted.
+func TestDNSResolvers(t *testing.T) {
+	defer func(h func(string) ([]string, error), s func(string, string, string) (string, []*net.SRV, error)) {
+		lookupHost, lookupSRV = h, s
+	}(lookupHost, lookupSRV)
+
+	hosts := []string{"10.0.0.2", "10.0.0.1"}
+	lookupHost = func(host string) ([]string, error) {
+		if host != "cockroach.example.com" {
+			return nil, util.Errorf("unknown host %q", host)
+		}
+		return append([]string(nil), hosts...), nil
+	}
+	srvs := []*net.SRV{
+		{Target: "node1.example.com.", Port: 26257},
+		{Target: "node2.example.com.", Port: 26258},
+	}
+	lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		if name != "_cockroach._tcp.example.com" {
+			return "", nil, util.Errorf("unknown name %q", name)
+		}
+		return name, srvs, nil
+	}
+
+	testCases := []struct {
+		resolverSpec string
+		expAddrs     []string
+	}{
+		{"dns=cockroach.example.com:8080", []string{"10.0.0.1:8080", "10.0.0.2:8080", "10.0.0.1:8080"}},
+		{"srv=_cockroach._tcp.example.com", []string{"node1.example.com:26257", "node2.example.com:26258", "node1.example.com:26257"}},
+	}
+	for tcNum, tc := range testCases {
+		resolver, err := NewResolver(tc.resolverSpec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, expAddr := range tc.expAddrs {
+			address, err := resolver.GetAddress()
+			if err != nil {
+				t.Fatalf("#%d.%d: unexpected error: %s", tcNum, i, err)
+			}
+			if address.Network() != "tcp" || address.String() != expAddr {
+				t.Errorf("#%d.%d: expected address %s; got %s", tcNum, i, expAddr, address)
+			}
+			if resolver.IsExhausted() {
+				t.Errorf("#%d.%d: expected resolver not to be exhausted", tcNum, i)
+			}
+		}
+	}
+
+	// Lookups are repeated on each call, so a change in the records is
+	// picked up without creating a new resolver.
+	resolver, err := NewResolver("dns=cockroach.example.com:8080")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hosts = []string{"10.0.0.3"}
+	if address, err := resolver.GetAddress(); err != nil || address.String() != "10.0.0.3:8080" {
+		t.Errorf("expected re-resolved address 10.0.0.3:8080; got %v, %v", address, err)
+	}
+
+	for _, spec := range []string{"dns=unknown.example.com:8080", "dns=cockroach.example.com", "srv=unknown.example.com"} {
+		resolver, err := NewResolver(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := resolver.GetAddress(); err == nil {
+			t.Errorf("%s: expected lookup failure", spec)
+		}
+	}
+}
@@ -39,6 +39,7 @@ type clientInfo struct {
 // server maintains an array of connected peers to which it gossips
 // newly arrived information on a periodic basis.
 type server struct {
+	counters Counters      // Counts of network activity
 	interval time.Duration // Interval at which to gossip fresh info
 	ready    *sync.Cond    // Broadcasts wakeup to waiting gossip requests
 
@@ -92,6 +93,7 @@ func (s *server) Gossip(args *proto.GossipRequest, reply *proto.GossipResponse)
 			}
 		}
 		s.incoming.addNode(args.NodeID)
+		s.counters.recordOpened()
 		// This lookup map allows the incoming client to be removed from
 		// the incoming addr set when its connection is closed. See
 		// server.serveConn() below.
@@ -105,6 +107,7 @@ func (s *server) Gossip(args *proto.GossipRequest, reply *proto.GossipResponse)
 			return util.Errorf("infostore could not be decoded: %s", err)
 		}
 		log.V(1).Infof("received delta infostore from client %s: %s", addr, delta)
+		s.counters.recordReceived(delta.infoCount(), len(args.Delta))
 		s.is.combine(delta)
 	}
 	// If requested max sequence is not -1, wait for gossip interval to expire.
@@ -115,17 +118,22 @@ func (s *server) Gossip(args *proto.GossipRequest, reply *proto.GossipResponse)
 	if s.closed {
 		return util.Errorf("gossip server shutdown")
 	}
-	// Return reciprocal delta.
+	// Return reciprocal delta. If there is nothing new, an empty
+	// infostore is returned so the client still learns this node's ID
+	// and high-water mark and completes its connection bookkeeping.
 	delta := s.is.delta(args.NodeID, args.MaxSeq)
-	if delta != nil {
-		var buf bytes.Buffer
-		if err := gob.NewEncoder(&buf).Encode(delta); err != nil {
-			log.Fatalf("infostore could not be encoded: %s", err)
-		}
-		reply.Delta = buf.Bytes()
-		if delta.infoCount() > 0 {
-			log.Infof("gossip: client %s sent %d info(s)", addr, delta.infoCount())
-		}
+	if delta == nil {
+		delta = newInfoStore(s.is.NodeID, s.is.NodeAddr)
+		delta.MaxSeq = s.is.MaxSeq
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(delta); err != nil {
+		log.Fatalf("infostore could not be encoded: %s", err)
+	}
+	reply.Delta = buf.Bytes()
+	s.counters.recordSent(delta.infoCount(), len(reply.Delta))
+	if delta.infoCount() > 0 {
+		log.Infof("gossip: client %s sent %d info(s)", addr, delta.infoCount())
 	}
 	return nil
 }
@@ -179,5 +187,6 @@ func (s *server) onClose(conn net.Conn) {
 	defer s.mu.Unlock()
 	if cInfo, ok := s.lAddrMap[conn.RemoteAddr().String()]; ok {
 		s.incoming.removeNode(cInfo.id)
+		s.counters.recordClosed()
 	}
 }
@@ -32,7 +32,8 @@ successive visualization of the gossip network graph via dot.
 
 Uses unix domain or tcp sockets for connecting 3, 10, 25, 50, 100 or
 250 nodes. Generates .dot graph output files for each cycle of the
-simulation.
+simulation, and reports the cycle at which the network became fully
+connected along with node fan-out and gossip traffic counters.
 
 To run:
 
@@ -304,11 +305,17 @@ func main() {
 	edgeSet := make(map[string]edge)
 
 	n := simulation.NewNetwork(nodeCount, *networkType, gossipInterval)
+	start := time.Now()
+	connectedCycle := -1
 	n.SimulateNetwork(
 		func(cycle int, network *simulation.Network) bool {
 			if cycle == numCycles {
 				return false
 			}
+			if connectedCycle == -1 && network.IsNetworkConnected() {
+				connectedCycle = cycle
+				log.Infof("network fully connected at cycle %d after %s", cycle, time.Since(start))
+			}
 			// Update infos.
 			nodes := network.Nodes
 			for i := 0; i < len(nodes); i++ {
@@ -326,8 +333,20 @@ func main() {
 
 			return true
 		})
+	stats := n.Stats()
 	n.Stop()
 
+	// Output convergence and network statistics.
+	if connectedCycle == -1 {
+		fmt.Printf("Network did not fully connect within %d cycles\n", numCycles)
+	} else {
+		fmt.Printf("Network fully connected at cycle %d\n", connectedCycle)
+	}
+	fmt.Printf("Fan-out: min=%d max=%d mean=%.2f\n", stats.MinFanout, stats.MaxFanout, stats.MeanFanout)
+	fmt.Printf("Infos sent=%d received=%d; bytes sent=%d received=%d; connections opened=%d closed=%d\n",
+		stats.Counters.InfosSent, stats.Counters.InfosReceived, stats.Counters.BytesSent,
+		stats.Counters.BytesReceived, stats.Counters.ConnectionsOpened, stats.Counters.ConnectionsClosed)
+
 	// Output instructions for viewing graphs.
 	fmt.Printf("To view simulation graph output run (you must install graphviz):\n\nfor f in %s/*.dot ; do circo $f -Tpng -o $f.png ; echo $f.png ; done\n", dirName)
 }
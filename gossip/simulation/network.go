@@ -165,6 +165,53 @@ func (n *Network) Stop() {
 	n.Stopper.Stop()
 }
 
+// ConvergenceTime runs the simulation until the network is fully
+// connected, as RunUntilFullyConnected does. It returns the gossip
+// cycle at which the network became fully connected and the elapsed
+// wall time.
+func (n *Network) ConvergenceTime() (int, time.Duration) {
+	start := time.Now()
+	cycle := n.RunUntilFullyConnected()
+	return cycle, time.Since(start)
+}
+
+// Stats summarizes the topology and activity of a Network.
+type Stats struct {
+	MinFanout  int             // Fewest outgoing connections of any node
+	MaxFanout  int             // Most outgoing connections of any node
+	MeanFanout float64         // Mean outgoing connections per node
+	Counters   gossip.Counters // Gossip counters summed across all nodes
+}
+
+// Stats returns the current fan-out of the network's nodes along
+// with the sum of their gossip counters.
+func (n *Network) Stats() Stats {
+	var stats Stats
+	var totalFanout int
+	for i, node := range n.Nodes {
+		fanout := len(node.Gossip.Outgoing())
+		if i == 0 || fanout < stats.MinFanout {
+			stats.MinFanout = fanout
+		}
+		if fanout > stats.MaxFanout {
+			stats.MaxFanout = fanout
+		}
+		totalFanout += fanout
+
+		c := node.Gossip.Counters()
+		stats.Counters.InfosSent += c.InfosSent
+		stats.Counters.InfosReceived += c.InfosReceived
+		stats.Counters.BytesSent += c.BytesSent
+		stats.Counters.BytesReceived += c.BytesReceived
+		stats.Counters.ConnectionsOpened += c.ConnectionsOpened
+		stats.Counters.ConnectionsClosed += c.ConnectionsClosed
+	}
+	if len(n.Nodes) > 0 {
+		stats.MeanFanout = float64(totalFanout) / float64(len(n.Nodes))
+	}
+	return stats
+}
+
 // RunUntilFullyConnected blocks until the gossip network has received
 // gossip from every other node in the network. It returns the gossip
 // cycle at which the network became fully connected.
@@ -176,7 +223,7 @@ func (n *Network) RunUntilFullyConnected() int {
 		for i := 0; i < len(nodes); i++ {
 			nodes[i].Gossip.AddInfo(nodes[i].Addr.String(), int64(cycle), time.Hour)
 		}
-		if network.isNetworkConnected() {
+		if network.IsNetworkConnected() {
 			connectedAtCycle = cycle
 			return false
 		}
@@ -185,10 +232,10 @@ func (n *Network) RunUntilFullyConnected() int {
 	return connectedAtCycle
 }
 
-// isNetworkConnected returns true if the network is fully connected
+// IsNetworkConnected returns true if the network is fully connected
 // with no partitions (i.e. every node knows every other node's
 // network address).
-func (n *Network) isNetworkConnected() bool {
+func (n *Network) IsNetworkConnected() bool {
 	for i := 0; i < len(n.Nodes); i++ {
 		for keyIdx := 0; keyIdx < len(n.Addrs); keyIdx++ {
 			_, err := n.Nodes[i].Gossip.GetInfo(n.Addrs[keyIdx].String())
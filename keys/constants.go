@@ -16,246 +16,12 @@
 // Author: Spencer Kimball (spencer.kimball@gmail.com)
 // Author: Tobias Schottdorf (tobias.schottdorf@gmail.com)
 
-package engine
+package keys
 
 import (
-	"bytes"
-	"fmt"
-
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/util/encoding"
-	"github.com/cockroachdb/cockroach/util/log"
 )
 
-// MakeKey makes a new key which is the concatenation of the
-// given inputs, in order.
-func MakeKey(keys ...proto.Key) proto.Key {
-	return proto.MakeKey(keys...)
-}
-
-// MakeStoreKey creates a store-local key based on the metadata key
-// suffix, and optional detail.
-func MakeStoreKey(suffix, detail proto.Key) proto.Key {
-	return MakeKey(KeyLocalStorePrefix, suffix, detail)
-}
-
-// StoreIdentKey returns a store-local key for the store metadata.
-func StoreIdentKey() proto.Key {
-	return MakeStoreKey(KeyLocalStoreIdentSuffix, proto.Key{})
-}
-
-// StoreStatKey returns the key for accessing the named stat.
-func StoreStatKey(stat proto.Key) proto.Key {
-	return MakeStoreKey(KeyLocalStoreStatSuffix, stat)
-}
-
-// StoreStatusKey returns the key for accessing the store status for the
-// specified store ID.
-func StoreStatusKey(storeID int32) proto.Key {
-	return MakeKey(KeyStatusStorePrefix, encoding.EncodeUvarint(nil, uint64(storeID)))
-}
-
-// MakeRangeIDKey creates a range-local key based on the range's
-// Raft ID, metadata key suffix, and optional detail (e.g. the
-// encoded command ID for a response cache entry, etc.).
-func MakeRangeIDKey(raftID int64, suffix, detail proto.Key) proto.Key {
-	if len(suffix) != KeyLocalSuffixLength {
-		panic(fmt.Sprintf("suffix len(%q) != %d", suffix, KeyLocalSuffixLength))
-	}
-	return MakeKey(KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(raftID)), suffix, detail)
-}
-
-// RaftLogKey returns a system-local key for a Raft log entry.
-func RaftLogKey(raftID int64, logIndex uint64) proto.Key {
-	// The log is stored "backwards" so we can easily find the highest index stored.
-	return MakeRangeIDKey(raftID, KeyLocalRaftLogSuffix, encoding.EncodeUint64Decreasing(nil, logIndex))
-}
-
-// RaftLogPrefix returns the system-local prefix shared by all entries in a Raft log.
-func RaftLogPrefix(raftID int64) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRaftLogSuffix, proto.Key{})
-}
-
-// RaftHardStateKey returns a system-local key for a Raft HardState.
-func RaftHardStateKey(raftID int64) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRaftHardStateSuffix, proto.Key{})
-}
-
-// DecodeRaftStateKey extracts the Raft ID from a RaftStateKey.
-func DecodeRaftStateKey(key proto.Key) int64 {
-	if !bytes.HasPrefix(key, KeyLocalRangeIDPrefix) {
-		panic(fmt.Sprintf("key %q does not have %q prefix", key, KeyLocalRangeIDPrefix))
-	}
-	// Cut the prefix and the Raft ID.
-	b := key[len(KeyLocalRangeIDPrefix):]
-	_, raftID := encoding.DecodeUvarint(b)
-	return int64(raftID)
-}
-
-// RaftTruncatedStateKey returns a system-local key for a RaftTruncatedState.
-func RaftTruncatedStateKey(raftID int64) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRaftTruncatedStateSuffix, proto.Key{})
-}
-
-// RaftAppliedIndexKey returns a system-local key for a raft applied index.
-func RaftAppliedIndexKey(raftID int64) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRaftAppliedIndexSuffix, proto.Key{})
-}
-
-// RangeStatKey returns the key for accessing the named stat
-// for the specified Raft ID.
-func RangeStatKey(raftID int64, stat proto.Key) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRangeStatSuffix, stat)
-}
-
-// ResponseCacheKey returns a range-local key by Raft ID for a
-// response cache entry, with detail specified by encoding the
-// supplied client command ID.
-func ResponseCacheKey(raftID int64, cmdID *proto.ClientCmdID) proto.Key {
-	detail := proto.Key{}
-	if cmdID != nil {
-		detail = encoding.EncodeUvarint(nil, uint64(cmdID.WallTime)) // wall time helps sort for locality
-		detail = encoding.EncodeUint64(detail, uint64(cmdID.Random))
-	}
-	return MakeRangeIDKey(raftID, KeyLocalResponseCacheSuffix, detail)
-}
-
-// MakeRangeKey creates a range-local key based on the range
-// start key, metadata key suffix, and optional detail (e.g. the
-// transaction UUID for a txn record, etc.).
-func MakeRangeKey(key, suffix, detail proto.Key) proto.Key {
-	if len(suffix) != KeyLocalSuffixLength {
-		panic(fmt.Sprintf("suffix len(%q) != %d", suffix, KeyLocalSuffixLength))
-	}
-	return MakeKey(KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, key), suffix, detail)
-}
-
-// DecodeRangeKey decodes the range key into range start key,
-// suffix and optional detail (may be nil).
-func DecodeRangeKey(key proto.Key) (startKey, suffix, detail proto.Key) {
-	if !bytes.HasPrefix(key, KeyLocalRangeKeyPrefix) {
-		panic(fmt.Sprintf("key %q does not have %q prefix", key, KeyLocalRangeKeyPrefix))
-	}
-	// Cut the prefix and the Raft ID.
-	b := key[len(KeyLocalRangeKeyPrefix):]
-	b, startKey = encoding.DecodeBytes(b)
-	if len(b) < KeyLocalSuffixLength {
-		panic(fmt.Sprintf("key %q does not have suffix of length %d", key, KeyLocalSuffixLength))
-	}
-	// Cut the response cache suffix.
-	suffix = b[:KeyLocalSuffixLength]
-	detail = b[KeyLocalSuffixLength:]
-	return
-}
-
-// RangeGCMetadataKey returns a range-local key for range garbage
-// collection metadata.
-func RangeGCMetadataKey(raftID int64) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRangeGCMetadataSuffix, proto.Key{})
-}
-
-// RangeLastVerificationTimestampKey returns a range-local key for
-// the range's last verification timestamp.
-func RangeLastVerificationTimestampKey(raftID int64) proto.Key {
-	return MakeRangeIDKey(raftID, KeyLocalRangeLastVerificationTimestampSuffix, proto.Key{})
-}
-
-// RangeTreeNodeKey returns a range-local key for the the range's
-// node in the range tree.
-func RangeTreeNodeKey(key proto.Key) proto.Key {
-	return MakeRangeKey(key, KeyLocalRangeTreeNodeSuffix, proto.Key{})
-}
-
-// RangeDescriptorKey returns a range-local key for the descriptor
-// for the range with specified key.
-func RangeDescriptorKey(key proto.Key) proto.Key {
-	return MakeRangeKey(key, KeyLocalRangeDescriptorSuffix, proto.Key{})
-}
-
-// TransactionKey returns a transaction key based on the provided
-// transaction key and ID. The base key is encoded in order to
-// guarantee that all transaction records for a range sort together.
-func TransactionKey(key proto.Key, id []byte) proto.Key {
-	return MakeRangeKey(key, KeyLocalTransactionSuffix, proto.Key(id))
-}
-
-// KeyAddress returns the address for the key, used to lookup the
-// range containing the key. In the normal case, this is simply the
-// key's value. However, for local keys, such as transaction records,
-// range-spanning binary tree node pointers, and message queues, the
-// address is the trailing suffix of the key, with the local key
-// prefix removed. In this way, local keys address to the same range
-// as non-local keys, but are stored separately so that they don't
-// collide with user-space or global system keys.
-//
-// However, not all local keys are addressable in the global map. Only
-// range local keys incorporating a range key (start key or transaction
-// key) are addressable (e.g. range metadata and txn records). Range
-// local keys incorporating the Raft ID are not (e.g. response cache
-// entries, and range stats).
-func KeyAddress(k proto.Key) proto.Key {
-	if !bytes.HasPrefix(k, KeyLocalPrefix) {
-		return k
-	}
-	if bytes.HasPrefix(k, KeyLocalRangeKeyPrefix) {
-		k = k[len(KeyLocalRangeKeyPrefix):]
-		_, k = encoding.DecodeBytes(k)
-		return k
-	}
-	log.Fatalf("local key %q malformed; should contain prefix %q", k, KeyLocalRangeKeyPrefix)
-	return nil
-}
-
-// RangeMetaKey returns a range metadata (meta1, meta2) indexing key
-// for the given key. For ordinary keys this returns a level 2
-// metadata key - for level 2 keys, it returns a level 1 key. For
-// level 1 keys and local keys, KeyMin is returned.
-func RangeMetaKey(key proto.Key) proto.Key {
-	if len(key) == 0 {
-		return KeyMin
-	}
-	addr := KeyAddress(key)
-	if !bytes.HasPrefix(addr, KeyMetaPrefix) {
-		return MakeKey(KeyMeta2Prefix, addr)
-	}
-	if bytes.HasPrefix(addr, KeyMeta2Prefix) {
-		return MakeKey(KeyMeta1Prefix, addr[len(KeyMeta2Prefix):])
-	}
-
-	return KeyMin
-}
-
-// ValidateRangeMetaKey validates that the given key is a valid Range Metadata
-// key. It must have an appropriate metadata range prefix, and the original key
-// value must be less than KeyMax. As a special case, KeyMin is considered a
-// valid Range Metadata Key.
-func ValidateRangeMetaKey(key proto.Key) error {
-	// KeyMin is a valid key.
-	if len(key) == 0 {
-		return nil
-	}
-	// Key must be at least as long as KeyMeta1Prefix.
-	if len(key) < len(KeyMeta1Prefix) {
-		return NewInvalidRangeMetaKeyError("too short", key)
-	}
-
-	prefix, body := key[:len(KeyMeta1Prefix)], key[len(KeyMeta1Prefix):]
-
-	// The prefix must be equal to KeyMeta1Prefix or KeyMeta2Prefix
-	if !bytes.HasPrefix(key, KeyMetaPrefix) {
-		return NewInvalidRangeMetaKeyError(fmt.Sprintf("does not have %q prefix", KeyMetaPrefix), key)
-	}
-	if lvl := string(prefix[len(KeyMetaPrefix)]); lvl != "1" && lvl != "2" {
-		return NewInvalidRangeMetaKeyError("meta level is not 1 or 2", key)
-	}
-	// Body of the key must sort before KeyMax
-	if !body.Less(KeyMax) {
-		return NewInvalidRangeMetaKeyError("body of range lookup is >= KeyMax", key)
-	}
-
-	return nil
-}
-
 // Constants for stat key construction.
 var (
 	// StatLiveBytes counts how many bytes are "live", including bytes
@@ -311,10 +77,6 @@ var (
 	// KeyMax is a maximum key value which sorts after all other keys.
 	KeyMax = proto.KeyMax
 
-	// MVCCKeyMax is a maximum mvcc-encoded key value which sorts after
-	// all other keys.
-	MVCCKeyMax = MVCCEncodeKey(KeyMax)
-
 	// KeyLocalPrefix is the prefix for keys which hold data local to a
 	// RocksDB instance, such as store and range-specific metadata which
 	// must not pollute the user key space, but must be collocate with
@@ -350,6 +112,12 @@ var (
 	// KeyLocalStoreIdentSuffix stores an immutable identifier for this
 	// store, created when the store is first bootstrapped.
 	KeyLocalStoreIdentSuffix = proto.Key("iden")
+	// KeyLocalStoreGossipSuffix stores gossip bootstrap metadata for this
+	// store, updated any time new gossip hosts are encountered.
+	KeyLocalStoreGossipSuffix = proto.Key("goss")
+	// KeyLocalStoreHighWaterSuffix stores a timestamp which exceeds any
+	// issued by the store's clock, updated periodically.
+	KeyLocalStoreHighWaterSuffix = proto.Key("hwat")
 	// KeyLocalStoreStatSuffix is the suffix for store statistics.
 	KeyLocalStoreStatSuffix = proto.Key("sst-")
 
@@ -371,11 +139,19 @@ var (
 	KeyLocalRaftTruncatedStateSuffix = proto.Key("rftt")
 	// KeyLocalRaftAppliedIndexSuffix is the suffix for the raft applied index.
 	KeyLocalRaftAppliedIndexSuffix = proto.Key("rfta")
+	// KeyLocalRaftLeaseAppliedIndexSuffix is the suffix for the highest
+	// lease index of the raft commands applied.
+	KeyLocalRaftLeaseAppliedIndexSuffix = proto.Key("rfla")
+	// KeyLocalRaftLeaderLeaseSuffix is the suffix for the range's leader lease.
+	KeyLocalRaftLeaderLeaseSuffix = proto.Key("rfll")
 	// KeyLocalRangeGCMetadataSuffix is the suffix for a range's GC metadata.
 	KeyLocalRangeGCMetadataSuffix = proto.Key("rgcm")
 	// KeyLocalRangeLastVerificationTimestampSuffix is the suffix for a range's
 	// last verification timestamp (for checking integrity of on-disk data).
 	KeyLocalRangeLastVerificationTimestampSuffix = proto.Key("rlvt")
+	// KeyLocalRangeLastConsistencyCheckTimestampSuffix is the suffix for a
+	// range's last consistency check timestamp (for comparing replicas).
+	KeyLocalRangeLastConsistencyCheckTimestampSuffix = proto.Key("rlcc")
 	// KeyLocalRangeStatSuffix is the suffix for range statistics.
 	KeyLocalRangeStatSuffix = proto.Key("rst-")
 	// KeyLocalResponseCacheSuffix is the suffix for keys storing
@@ -430,28 +206,63 @@ var (
 	// KeyMetaMax is the end of the range of addressing keys.
 	KeyMetaMax = MakeKey(KeySystemPrefix, proto.Key("\x01"))
 
+	// KeySystemConfigPrefix is the start of the system config span,
+	// which holds all configuration maps. The span is never split, so
+	// that a single range leader may gossip all configs at once.
+	KeySystemConfigPrefix = MakeKey(KeySystemPrefix, proto.Key("config-"))
+	// KeySystemConfigMax is the end of the system config span.
+	KeySystemConfigMax = KeySystemConfigPrefix.PrefixEnd()
 	// KeyConfigAccountingPrefix specifies the key prefix for accounting
 	// configurations. The suffix is the affected key prefix.
-	KeyConfigAccountingPrefix = MakeKey(KeySystemPrefix, proto.Key("acct"))
+	KeyConfigAccountingPrefix = MakeKey(KeySystemConfigPrefix, proto.Key("acct"))
 	// KeyConfigPermissionPrefix specifies the key prefix for accounting
 	// configurations. The suffix is the affected key prefix.
-	KeyConfigPermissionPrefix = MakeKey(KeySystemPrefix, proto.Key("perm"))
+	KeyConfigPermissionPrefix = MakeKey(KeySystemConfigPrefix, proto.Key("perm"))
 	// KeyConfigZonePrefix specifies the key prefix for zone
 	// configurations. The suffix is the affected key prefix.
-	KeyConfigZonePrefix = MakeKey(KeySystemPrefix, proto.Key("zone"))
+	KeyConfigZonePrefix = MakeKey(KeySystemConfigPrefix, proto.Key("zone"))
+	// KeySettingPrefix specifies the key prefix for cluster settings.
+	// The suffix is the setting name and the value is the setting's
+	// value as a string.
+	KeySettingPrefix = MakeKey(KeySystemConfigPrefix, proto.Key("setting-"))
+	// KeyNodeLivenessPrefix specifies the key prefix of the liveness
+	// records of nodes. The suffix is the encoded node ID and the value
+	// is a Liveness.
+	KeyNodeLivenessPrefix = MakeKey(KeySystemPrefix, proto.Key("liveness-"))
+	// KeyClusterVersion stores the cluster version, a ClusterVersion
+	// counting the startup migrations which have been run.
+	KeyClusterVersion = MakeKey(KeySystemPrefix, proto.Key("cluster-version"))
+	// KeyMigrationLease stores the MigrationLease held by the node
+	// running startup migrations.
+	KeyMigrationLease = MakeKey(KeySystemPrefix, proto.Key("migration-lease"))
+	// KeyNodeIDClaimPrefix specifies the key prefix of node ID claims.
+	// The suffix is the encoded node ID and the value is a NodeIDClaim.
+	KeyNodeIDClaimPrefix = MakeKey(KeySystemPrefix, proto.Key("node-idclaim-"))
 	// KeyNodeIDGenerator is the global node ID generator sequence.
 	KeyNodeIDGenerator = MakeKey(KeySystemPrefix, proto.Key("node-idgen"))
 	// KeyRaftIDGenerator is the global Raft consensus group ID generator sequence.
 	KeyRaftIDGenerator = MakeKey(KeySystemPrefix, proto.Key("raft-idgen"))
 	// KeySchemaPrefix specifies key prefixes for schema definitions.
 	KeySchemaPrefix = MakeKey(KeySystemPrefix, proto.Key("schema"))
+	// KeyStoreIDClaimPrefix specifies the key prefix of store ID
+	// claims. The suffix is the encoded store ID and the value is a
+	// StoreIDClaim.
+	KeyStoreIDClaimPrefix = MakeKey(KeySystemPrefix, proto.Key("store-idclaim-"))
 	// KeyStoreIDGenerator is the global store ID generator sequence.
 	KeyStoreIDGenerator = MakeKey(KeySystemPrefix, proto.Key("store-idgen"))
 	// KeyRangeTreeRoot specifies the root range in the range tree.
 	KeyRangeTreeRoot = MakeKey(KeySystemPrefix, proto.Key("range-tree-root"))
+	// KeyUserPrefix specifies the key prefix of the system users table.
+	// The suffix is the user name and the value is a UserConfig.
+	KeyUserPrefix = MakeKey(KeySystemPrefix, proto.Key("user"))
 
 	// KeyStatusPrefix specifies the key prefix to store all status details.
 	KeyStatusPrefix = MakeKey(KeySystemPrefix, proto.Key("status-"))
 	// KeyStatusStorePrefix stores all status info for stores.
 	KeyStatusStorePrefix = MakeKey(KeyStatusPrefix, proto.Key("store-"))
+	// KeyStatusRestorePrefix stores the status of restore jobs.
+	KeyStatusRestorePrefix = MakeKey(KeyStatusPrefix, proto.Key("restore-"))
+
+	// KeyTimeseriesPrefix is the key prefix for time series data keys.
+	KeyTimeseriesPrefix = MakeKey(KeySystemPrefix, proto.Key("tsd"))
 )
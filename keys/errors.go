@@ -15,7 +15,7 @@
 //
 // Author: Matt Tracy (matt.r.tracy@gmail.com)
 
-package engine
+package keys
 
 import (
 	"encoding/gob"
@@ -41,7 +41,7 @@ func (i *InvalidRangeMetaKeyError) Error() string {
 	return fmt.Sprintf("%q is not valid range metadata key: %s", string(i.Key), i.Msg)
 }
 
-// Init registers engine error types with Gob.
+// Init registers key error types with Gob.
 func init() {
 	gob.Register(&InvalidRangeMetaKeyError{})
 }
@@ -0,0 +1,312 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: Spencer Kimball (spencer.kimball@gmail.com)
+// Author: Tobias Schottdorf (tobias.schottdorf@gmail.com)
+
+// Package keys constructs and renders the keys of the key space: the
+// meta range addressing records, store-local and range-local data,
+// and the system keys holding configs, status and ID generators.
+package keys
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// MakeKey makes a new key which is the concatenation of the
+// given inputs, in order.
+func MakeKey(keys ...proto.Key) proto.Key {
+	return proto.MakeKey(keys...)
+}
+
+// MakeStoreKey creates a store-local key based on the metadata key
+// suffix, and optional detail.
+func MakeStoreKey(suffix, detail proto.Key) proto.Key {
+	return MakeKey(KeyLocalStorePrefix, suffix, detail)
+}
+
+// StoreIdentKey returns a store-local key for the store metadata.
+func StoreIdentKey() proto.Key {
+	return MakeStoreKey(KeyLocalStoreIdentSuffix, proto.Key{})
+}
+
+// StoreGossipKey returns a store-local key for the gossip bootstrap metadata.
+func StoreGossipKey() proto.Key {
+	return MakeStoreKey(KeyLocalStoreGossipSuffix, proto.Key{})
+}
+
+// StoreHighWaterKey returns a store-local key for the high-water
+// timestamp of the store's clock.
+func StoreHighWaterKey() proto.Key {
+	return MakeStoreKey(KeyLocalStoreHighWaterSuffix, proto.Key{})
+}
+
+// StoreStatKey returns the key for accessing the named stat.
+func StoreStatKey(stat proto.Key) proto.Key {
+	return MakeStoreKey(KeyLocalStoreStatSuffix, stat)
+}
+
+// StoreStatusKey returns the key for accessing the store status for the
+// specified store ID.
+func StoreStatusKey(storeID int32) proto.Key {
+	return MakeKey(KeyStatusStorePrefix, encoding.EncodeUvarint(nil, uint64(storeID)))
+}
+
+// RestoreStatusKey returns the key for accessing the status of the
+// restore job with the specified ID.
+func RestoreStatusKey(jobID string) proto.Key {
+	return MakeKey(KeyStatusRestorePrefix, proto.Key(jobID))
+}
+
+// NodeLivenessKey returns the key for the liveness record of the
+// specified node.
+func NodeLivenessKey(nodeID int32) proto.Key {
+	return MakeKey(KeyNodeLivenessPrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
+}
+
+// NodeIDClaimKey returns the key for the claim of the specified node
+// ID.
+func NodeIDClaimKey(nodeID int32) proto.Key {
+	return MakeKey(KeyNodeIDClaimPrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
+}
+
+// StoreIDClaimKey returns the key for the claim of the specified
+// store ID.
+func StoreIDClaimKey(storeID int32) proto.Key {
+	return MakeKey(KeyStoreIDClaimPrefix, encoding.EncodeUvarint(nil, uint64(storeID)))
+}
+
+// MakeRangeIDKey creates a range-local key based on the range's
+// Raft ID, metadata key suffix, and optional detail (e.g. the
+// encoded command ID for a response cache entry, etc.).
+func MakeRangeIDKey(raftID int64, suffix, detail proto.Key) proto.Key {
+	if len(suffix) != KeyLocalSuffixLength {
+		panic(fmt.Sprintf("suffix len(%q) != %d", suffix, KeyLocalSuffixLength))
+	}
+	return MakeKey(KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(raftID)), suffix, detail)
+}
+
+// RaftLogKey returns a system-local key for a Raft log entry.
+func RaftLogKey(raftID int64, logIndex uint64) proto.Key {
+	// The log is stored "backwards" so we can easily find the highest index stored.
+	return MakeRangeIDKey(raftID, KeyLocalRaftLogSuffix, encoding.EncodeUint64Decreasing(nil, logIndex))
+}
+
+// RaftLogPrefix returns the system-local prefix shared by all entries in a Raft log.
+func RaftLogPrefix(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRaftLogSuffix, proto.Key{})
+}
+
+// RaftHardStateKey returns a system-local key for a Raft HardState.
+func RaftHardStateKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRaftHardStateSuffix, proto.Key{})
+}
+
+// DecodeRaftStateKey extracts the Raft ID from a RaftStateKey.
+func DecodeRaftStateKey(key proto.Key) int64 {
+	if !bytes.HasPrefix(key, KeyLocalRangeIDPrefix) {
+		panic(fmt.Sprintf("key %q does not have %q prefix", key, KeyLocalRangeIDPrefix))
+	}
+	// Cut the prefix and the Raft ID.
+	b := key[len(KeyLocalRangeIDPrefix):]
+	_, raftID := encoding.DecodeUvarint(b)
+	return int64(raftID)
+}
+
+// RaftTruncatedStateKey returns a system-local key for a RaftTruncatedState.
+func RaftTruncatedStateKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRaftTruncatedStateSuffix, proto.Key{})
+}
+
+// RaftAppliedIndexKey returns a system-local key for a raft applied index.
+func RaftAppliedIndexKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRaftAppliedIndexSuffix, proto.Key{})
+}
+
+// RaftLeaseAppliedIndexKey returns a system-local key for the highest
+// lease index of the raft commands applied.
+func RaftLeaseAppliedIndexKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRaftLeaseAppliedIndexSuffix, proto.Key{})
+}
+
+// RaftLeaderLeaseKey returns a system-local key for a range's leader lease.
+func RaftLeaderLeaseKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRaftLeaderLeaseSuffix, proto.Key{})
+}
+
+// RangeStatKey returns the key for accessing the named stat
+// for the specified Raft ID.
+func RangeStatKey(raftID int64, stat proto.Key) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeStatSuffix, stat)
+}
+
+// ResponseCacheKey returns a range-local key by Raft ID for a
+// response cache entry, with detail specified by encoding the
+// supplied client command ID.
+func ResponseCacheKey(raftID int64, cmdID *proto.ClientCmdID) proto.Key {
+	detail := proto.Key{}
+	if cmdID != nil {
+		detail = encoding.EncodeUvarint(nil, uint64(cmdID.WallTime)) // wall time helps sort for locality
+		detail = encoding.EncodeUint64(detail, uint64(cmdID.Random))
+	}
+	return MakeRangeIDKey(raftID, KeyLocalResponseCacheSuffix, detail)
+}
+
+// MakeRangeKey creates a range-local key based on the range
+// start key, metadata key suffix, and optional detail (e.g. the
+// transaction UUID for a txn record, etc.).
+func MakeRangeKey(key, suffix, detail proto.Key) proto.Key {
+	if len(suffix) != KeyLocalSuffixLength {
+		panic(fmt.Sprintf("suffix len(%q) != %d", suffix, KeyLocalSuffixLength))
+	}
+	return MakeKey(KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, key), suffix, detail)
+}
+
+// DecodeRangeKey decodes the range key into range start key,
+// suffix and optional detail (may be nil).
+func DecodeRangeKey(key proto.Key) (startKey, suffix, detail proto.Key) {
+	if !bytes.HasPrefix(key, KeyLocalRangeKeyPrefix) {
+		panic(fmt.Sprintf("key %q does not have %q prefix", key, KeyLocalRangeKeyPrefix))
+	}
+	// Cut the prefix and the Raft ID.
+	b := key[len(KeyLocalRangeKeyPrefix):]
+	b, startKey = encoding.DecodeBytes(b)
+	if len(b) < KeyLocalSuffixLength {
+		panic(fmt.Sprintf("key %q does not have suffix of length %d", key, KeyLocalSuffixLength))
+	}
+	// Cut the response cache suffix.
+	suffix = b[:KeyLocalSuffixLength]
+	detail = b[KeyLocalSuffixLength:]
+	return
+}
+
+// RangeGCMetadataKey returns a range-local key for range garbage
+// collection metadata.
+func RangeGCMetadataKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeGCMetadataSuffix, proto.Key{})
+}
+
+// RangeLastVerificationTimestampKey returns a range-local key for
+// the range's last verification timestamp.
+func RangeLastVerificationTimestampKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeLastVerificationTimestampSuffix, proto.Key{})
+}
+
+// RangeLastConsistencyCheckTimestampKey returns a range-local key for
+// the timestamp of the range's last consistency check.
+func RangeLastConsistencyCheckTimestampKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeLastConsistencyCheckTimestampSuffix, proto.Key{})
+}
+
+// RangeTreeNodeKey returns a range-local key for the the range's
+// node in the range tree.
+func RangeTreeNodeKey(key proto.Key) proto.Key {
+	return MakeRangeKey(key, KeyLocalRangeTreeNodeSuffix, proto.Key{})
+}
+
+// RangeDescriptorKey returns a range-local key for the descriptor
+// for the range with specified key.
+func RangeDescriptorKey(key proto.Key) proto.Key {
+	return MakeRangeKey(key, KeyLocalRangeDescriptorSuffix, proto.Key{})
+}
+
+// TransactionKey returns a transaction key based on the provided
+// transaction key and ID. The base key is encoded in order to
+// guarantee that all transaction records for a range sort together.
+func TransactionKey(key proto.Key, id []byte) proto.Key {
+	return MakeRangeKey(key, KeyLocalTransactionSuffix, proto.Key(id))
+}
+
+// KeyAddress returns the address for the key, used to lookup the
+// range containing the key. In the normal case, this is simply the
+// key's value. However, for local keys, such as transaction records,
+// range-spanning binary tree node pointers, and message queues, the
+// address is the trailing suffix of the key, with the local key
+// prefix removed. In this way, local keys address to the same range
+// as non-local keys, but are stored separately so that they don't
+// collide with user-space or global system keys.
+//
+// However, not all local keys are addressable in the global map. Only
+// range local keys incorporating a range key (start key or transaction
+// key) are addressable (e.g. range metadata and txn records). Range
+// local keys incorporating the Raft ID are not (e.g. response cache
+// entries, and range stats).
+func KeyAddress(k proto.Key) proto.Key {
+	if !bytes.HasPrefix(k, KeyLocalPrefix) {
+		return k
+	}
+	if bytes.HasPrefix(k, KeyLocalRangeKeyPrefix) {
+		k = k[len(KeyLocalRangeKeyPrefix):]
+		_, k = encoding.DecodeBytes(k)
+		return k
+	}
+	log.Fatalf("local key %q malformed; should contain prefix %q", k, KeyLocalRangeKeyPrefix)
+	return nil
+}
+
+// RangeMetaKey returns a range metadata (meta1, meta2) indexing key
+// for the given key. For ordinary keys this returns a level 2
+// metadata key - for level 2 keys, it returns a level 1 key. For
+// level 1 keys and local keys, KeyMin is returned.
+func RangeMetaKey(key proto.Key) proto.Key {
+	if len(key) == 0 {
+		return KeyMin
+	}
+	addr := KeyAddress(key)
+	if !bytes.HasPrefix(addr, KeyMetaPrefix) {
+		return MakeKey(KeyMeta2Prefix, addr)
+	}
+	if bytes.HasPrefix(addr, KeyMeta2Prefix) {
+		return MakeKey(KeyMeta1Prefix, addr[len(KeyMeta2Prefix):])
+	}
+
+	return KeyMin
+}
+
+// ValidateRangeMetaKey validates that the given key is a valid Range Metadata
+// key. It must have an appropriate metadata range prefix, and the original key
+// value must be less than KeyMax. As a special case, KeyMin is considered a
+// valid Range Metadata Key.
+func ValidateRangeMetaKey(key proto.Key) error {
+	// KeyMin is a valid key.
+	if len(key) == 0 {
+		return nil
+	}
+	// Key must be at least as long as KeyMeta1Prefix.
+	if len(key) < len(KeyMeta1Prefix) {
+		return NewInvalidRangeMetaKeyError("too short", key)
+	}
+
+	prefix, body := key[:len(KeyMeta1Prefix)], key[len(KeyMeta1Prefix):]
+
+	// The prefix must be equal to KeyMeta1Prefix or KeyMeta2Prefix
+	if !bytes.HasPrefix(key, KeyMetaPrefix) {
+		return NewInvalidRangeMetaKeyError(fmt.Sprintf("does not have %q prefix", KeyMetaPrefix), key)
+	}
+	if lvl := string(prefix[len(KeyMetaPrefix)]); lvl != "1" && lvl != "2" {
+		return NewInvalidRangeMetaKeyError("meta level is not 1 or 2", key)
+	}
+	// Body of the key must sort before KeyMax
+	if !body.Less(KeyMax) {
+		return NewInvalidRangeMetaKeyError("body of range lookup is >= KeyMax", key)
+	}
+
+	return nil
+}
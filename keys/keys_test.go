@@ -15,7 +15,7 @@
 //
 // Author: Tobias Schottdorf (tobias.schottdorf@gmail.com)
 
-package engine
+package keys
 
 import (
 	"bytes"
@@ -23,13 +23,11 @@ import (
 
 	"code.google.com/p/go-uuid/uuid"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
 // TestLocalKeySorting is a sanity check to make sure that
 // the non-replicated part of a store sorts before the meta.
 func TestKeySorting(t *testing.T) {
-	defer leaktest.AfterTest(t)
 	// Reminder: Increasing the last byte by one < adding a null byte.
 	if !(proto.Key("").Less(proto.Key("\x00")) && proto.Key("\x00").Less(proto.Key("\x01")) &&
 		proto.Key("\x01").Less(proto.Key("\x01\x00"))) {
@@ -44,7 +42,6 @@ func TestKeySorting(t *testing.T) {
 }
 
 func TestMakeKey(t *testing.T) {
-	defer leaktest.AfterTest(t)
 	if !bytes.Equal(MakeKey(proto.Key("A"), proto.Key("B")), proto.Key("AB")) ||
 		!bytes.Equal(MakeKey(proto.Key("A")), proto.Key("A")) ||
 		!bytes.Equal(MakeKey(proto.Key("A"), proto.Key("B"), proto.Key("C")), proto.Key("ABC")) {
@@ -53,13 +50,12 @@ func TestMakeKey(t *testing.T) {
 }
 
 func TestKeyAddress(t *testing.T) {
-	defer leaktest.AfterTest(t)
 	testCases := []struct {
 		key, expAddress proto.Key
 	}{
 		{proto.Key{}, KeyMin},
 		{proto.Key("123"), proto.Key("123")},
-		{MakeKey(KeyConfigAccountingPrefix, proto.Key("foo")), proto.Key("\x00acctfoo")},
+		{MakeKey(KeyConfigAccountingPrefix, proto.Key("foo")), proto.Key("\x00config-acctfoo")},
 		{RangeDescriptorKey(proto.Key("foo")), proto.Key("foo")},
 		{TransactionKey(proto.Key("baz"), proto.Key(uuid.New())), proto.Key("baz")},
 		{TransactionKey(KeyMax, proto.Key(uuid.New())), KeyMax},
@@ -73,7 +69,6 @@ func TestKeyAddress(t *testing.T) {
 }
 
 func TestRangeMetaKey(t *testing.T) {
-	defer leaktest.AfterTest(t)
 	testCases := []struct {
 		key, expKey proto.Key
 	}{
@@ -83,14 +78,14 @@ func TestRangeMetaKey(t *testing.T) {
 		},
 		{
 			key:    MakeKey(KeyConfigAccountingPrefix, proto.Key("foo")),
-			expKey: proto.Key("\x00\x00meta2\x00acctfoo"),
+			expKey: proto.Key("\x00\x00meta2\x00config-acctfoo"),
 		},
 		{
-			key:    proto.Key("\x00\x00meta2\x00acctfoo"),
-			expKey: proto.Key("\x00\x00meta1\x00acctfoo"),
+			key:    proto.Key("\x00\x00meta2\x00config-acctfoo"),
+			expKey: proto.Key("\x00\x00meta1\x00config-acctfoo"),
 		},
 		{
-			key:    proto.Key("\x00\x00meta1\x00acctfoo"),
+			key:    proto.Key("\x00\x00meta1\x00config-acctfoo"),
 			expKey: KeyMin,
 		},
 		{
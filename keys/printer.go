@@ -0,0 +1,174 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package keys
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// localSuffixNames names the suffixes of store-local and range-local
+// keys for PrettyPrint.
+var localSuffixNames = map[string]string{
+	string(KeyLocalStoreIdentSuffix):                         "Ident",
+	string(KeyLocalStoreGossipSuffix):                        "Gossip",
+	string(KeyLocalStoreHighWaterSuffix):                     "HighWater",
+	string(KeyLocalStoreStatSuffix):                          "Stat",
+	string(KeyLocalRaftLogSuffix):                            "RaftLog",
+	string(KeyLocalRaftHardStateSuffix):                      "RaftHardState",
+	string(KeyLocalRaftTruncatedStateSuffix):                 "RaftTruncatedState",
+	string(KeyLocalRaftAppliedIndexSuffix):                   "RaftAppliedIndex",
+	string(KeyLocalRaftLeaseAppliedIndexSuffix):              "RaftLeaseAppliedIndex",
+	string(KeyLocalRaftLeaderLeaseSuffix):                    "RaftLeaderLease",
+	string(KeyLocalRangeGCMetadataSuffix):                    "RangeGCMetadata",
+	string(KeyLocalRangeLastVerificationTimestampSuffix):     "RangeLastVerificationTimestamp",
+	string(KeyLocalRangeLastConsistencyCheckTimestampSuffix): "RangeLastConsistencyCheckTimestamp",
+	string(KeyLocalRangeStatSuffix):                          "RangeStat",
+	string(KeyLocalResponseCacheSuffix):                      "ResponseCache",
+	string(KeyLocalRangeDescriptorSuffix):                    "RangeDescriptor",
+	string(KeyLocalRangeTreeNodeSuffix):                      "RangeTreeNode",
+	string(KeyLocalTransactionSuffix):                        "Transaction",
+}
+
+// systemKeyNames names the prefixes of system keys for PrettyPrint,
+// along with the rendering of the remainder of the key. Prefixes must
+// precede any shorter prefix of themselves.
+var systemKeyNames = []struct {
+	prefix proto.Key
+	name   string
+	rest   func([]byte) string
+}{
+	{KeyConfigAccountingPrefix, "/System/Config/Accounting", quotedBytes},
+	{KeyConfigPermissionPrefix, "/System/Config/Permission", quotedBytes},
+	{KeyConfigZonePrefix, "/System/Config/Zone", quotedBytes},
+	{KeySettingPrefix, "/System/Config/Setting", quotedBytes},
+	{KeySystemConfigPrefix, "/System/Config", quotedBytes},
+	{KeyStatusStorePrefix, "/System/Status/Store", uvarintID},
+	{KeyStatusRestorePrefix, "/System/Status/Restore", quotedBytes},
+	{KeyStatusPrefix, "/System/Status", quotedBytes},
+	{KeyNodeLivenessPrefix, "/System/NodeLiveness", uvarintID},
+	{KeyNodeIDClaimPrefix, "/System/NodeIDClaim", uvarintID},
+	{KeyStoreIDClaimPrefix, "/System/StoreIDClaim", uvarintID},
+	{KeyNodeIDGenerator, "/System/NodeIDGenerator", quotedBytes},
+	{KeyStoreIDGenerator, "/System/StoreIDGenerator", quotedBytes},
+	{KeyRaftIDGenerator, "/System/RaftIDGenerator", quotedBytes},
+	{KeyClusterVersion, "/System/ClusterVersion", quotedBytes},
+	{KeyMigrationLease, "/System/MigrationLease", quotedBytes},
+	{KeyRangeTreeRoot, "/System/RangeTreeRoot", quotedBytes},
+	{KeySchemaPrefix, "/System/Schema", quotedBytes},
+	{KeyUserPrefix, "/System/User", quotedBytes},
+	{KeyTimeseriesPrefix, "/System/Timeseries", quotedBytes},
+	{KeySystemPrefix, "/System", quotedBytes},
+}
+
+// quotedBytes renders the remainder of a key as a quoted component.
+func quotedBytes(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("/%q", b)
+}
+
+// uvarintID renders the remainder of a key holding a uvarint-encoded
+// node or store ID.
+func uvarintID(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	b, id := encoding.DecodeUvarint(b)
+	return fmt.Sprintf("/%d", id) + quotedBytes(b)
+}
+
+// nestedKey renders the remainder of a key which is itself a key, as
+// in the case of meta keys and the start keys of range-local keys.
+func nestedKey(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	pretty := PrettyPrint(proto.Key(b))
+	if pretty[0] != '/' {
+		pretty = "/" + pretty
+	}
+	return pretty
+}
+
+// PrettyPrint returns a human-readable rendering of the key, naming
+// the components of store-local, range-local, meta and system keys,
+// for use in logs, debug endpoints and command-line output. For
+// example, the key of the Raft log entry at index 5 of range 1 is
+// rendered as /Local/RangeID/1/RaftLog/5, and the meta2 record of
+// range "a"-"m" as /Meta2/"m". Quoted components are the raw bytes of
+// the key; keys which can't be decoded are quoted in full.
+func PrettyPrint(key proto.Key) (pretty string) {
+	defer func() {
+		if recover() != nil {
+			pretty = fmt.Sprintf("%q", []byte(key))
+		}
+	}()
+	suffix := func(b []byte) (string, []byte) {
+		if len(b) < KeyLocalSuffixLength {
+			return fmt.Sprintf("%q", b), nil
+		}
+		name, ok := localSuffixNames[string(b[:KeyLocalSuffixLength])]
+		if !ok {
+			name = fmt.Sprintf("%q", b[:KeyLocalSuffixLength])
+		}
+		return name, b[KeyLocalSuffixLength:]
+	}
+
+	switch {
+	case len(key) == 0:
+		return "/Min"
+	case key.Equal(KeyMax):
+		return "/Max"
+	case bytes.HasPrefix(key, KeyLocalStorePrefix):
+		s, b := suffix(key[len(KeyLocalStorePrefix):])
+		return "/Local/Store/" + s + quotedBytes(b)
+	case bytes.HasPrefix(key, KeyLocalRangeIDPrefix):
+		b, raftID := encoding.DecodeUvarint(key[len(KeyLocalRangeIDPrefix):])
+		s, b := suffix(b)
+		if s == localSuffixNames[string(KeyLocalRaftLogSuffix)] && len(b) == 8 {
+			_, index := encoding.DecodeUint64Decreasing(b)
+			return fmt.Sprintf("/Local/RangeID/%d/%s/%d", raftID, s, index)
+		}
+		return fmt.Sprintf("/Local/RangeID/%d/%s", raftID, s) + quotedBytes(b)
+	case bytes.HasPrefix(key, KeyLocalRangeKeyPrefix):
+		b, startKey := encoding.DecodeBytes(key[len(KeyLocalRangeKeyPrefix):])
+		s, b := suffix(b)
+		start := nestedKey(startKey)
+		if len(startKey) == 0 {
+			start = "/Min"
+		}
+		return "/Local/Range" + start + "/" + s + quotedBytes(b)
+	case bytes.HasPrefix(key, KeyLocalPrefix):
+		return "/Local" + quotedBytes(key[len(KeyLocalPrefix):])
+	case bytes.HasPrefix(key, KeyMeta1Prefix):
+		return "/Meta1" + nestedKey(key[len(KeyMeta1Prefix):])
+	case bytes.HasPrefix(key, KeyMeta2Prefix):
+		return "/Meta2" + nestedKey(key[len(KeyMeta2Prefix):])
+	}
+	for _, k := range systemKeyNames {
+		if bytes.HasPrefix(key, k.prefix) {
+			return k.name + k.rest(key[len(k.prefix):])
+		}
+	}
+	return fmt.Sprintf("%q", []byte(key))
+}
@@ -0,0 +1,76 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package keys
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// TestPrettyPrint verifies the rendering of keys for display.
+func TestPrettyPrint(t *testing.T) {
+	testCases := []struct {
+		key      proto.Key
+		expected string
+	}{
+		{KeyMin, "/Min"},
+		{KeyMax, "/Max"},
+		{StoreIdentKey(), "/Local/Store/Ident"},
+		{StoreStatKey(StatLiveBytes), `/Local/Store/Stat/"live-bytes"`},
+		{RaftLogKey(1, 5), "/Local/RangeID/1/RaftLog/5"},
+		{RaftHardStateKey(2), "/Local/RangeID/2/RaftHardState"},
+		{RangeStatKey(3, StatKeyCount), `/Local/RangeID/3/RangeStat/"key-count"`},
+		{RangeDescriptorKey(proto.Key("a")), `/Local/Range/"a"/RangeDescriptor`},
+		{RangeDescriptorKey(KeyMin), "/Local/Range/Min/RangeDescriptor"},
+		{RangeDescriptorKey(RangeMetaKey(proto.Key("a"))), `/Local/Range/Meta2/"a"/RangeDescriptor`},
+		{TransactionKey(proto.Key("a"), []byte("id")), `/Local/Range/"a"/Transaction/"id"`},
+		{RangeMetaKey(proto.Key("foo")), `/Meta2/"foo"`},
+		{RangeMetaKey(RangeMetaKey(proto.Key("foo"))), `/Meta1/"foo"`},
+		{RangeMetaKey(KeyMax), "/Meta2/Max"},
+		{RangeMetaKey(MakeKey(KeyConfigZonePrefix, proto.Key("db"))), `/Meta2/System/Config/Zone/"db"`},
+		{MakeKey(KeyConfigAccountingPrefix, proto.Key("db")), `/System/Config/Accounting/"db"`},
+		{MakeKey(KeyConfigPermissionPrefix, proto.Key("db")), `/System/Config/Permission/"db"`},
+		{MakeKey(KeyConfigZonePrefix, proto.Key("db")), `/System/Config/Zone/"db"`},
+		{MakeKey(KeySettingPrefix, proto.Key("gc")), `/System/Config/Setting/"gc"`},
+		{KeyConfigZonePrefix, "/System/Config/Zone"},
+		{StoreStatusKey(4), "/System/Status/Store/4"},
+		{RestoreStatusKey("job"), `/System/Status/Restore/"job"`},
+		{NodeLivenessKey(5), "/System/NodeLiveness/5"},
+		{NodeIDClaimKey(6), "/System/NodeIDClaim/6"},
+		{StoreIDClaimKey(7), "/System/StoreIDClaim/7"},
+		{KeyRaftIDGenerator, "/System/RaftIDGenerator"},
+		{KeyNodeIDGenerator, "/System/NodeIDGenerator"},
+		{KeyStoreIDGenerator, "/System/StoreIDGenerator"},
+		{KeyRangeTreeRoot, "/System/RangeTreeRoot"},
+		{MakeKey(KeyUserPrefix, proto.Key("root")), `/System/User/"root"`},
+		{MakeKey(KeyTimeseriesPrefix, proto.Key("cpu")), `/System/Timeseries/"cpu"`},
+		{MakeKey(KeySystemPrefix, proto.Key("other")), `/System/"other"`},
+		{proto.Key("foo"), `"foo"`},
+		// Range-local keys with malformed start keys are quoted in full.
+		{MakeKey(KeyLocalRangeKeyPrefix, proto.Key("\x00\x05")), `"\x00\x00\x00k\x00\x05"`},
+		// Trailing bytes following an encoded ID are quoted.
+		{MakeKey(KeyNodeLivenessPrefix, encoding.EncodeUvarint(nil, 5), proto.Key("x")), `/System/NodeLiveness/5/"x"`},
+	}
+	for i, test := range testCases {
+		if pretty := PrettyPrint(test.key); pretty != test.expected {
+			t.Errorf("%d: expected %s; got %s", i, test.expected, pretty)
+		}
+	}
+}
@@ -18,14 +18,21 @@
 package kv
 
 import (
+	"crypto/tls"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/audit"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -44,6 +51,9 @@ func verifyRequest(args proto.Request) error {
 		if t.InternalCommitTrigger != nil {
 			return util.Errorf("EndTransaction request from public KV API contains commit trigger: %+v", t.GetInternalCommitTrigger())
 		}
+		if t.RefreshedTimestamp != nil {
+			return util.Errorf("EndTransaction request from public KV API contains refreshed timestamp: %s", t.RefreshedTimestamp)
+		}
 	}
 	return nil
 }
@@ -64,14 +74,22 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 			return &proto.PutRequest{}, &proto.PutResponse{}
 		case proto.ConditionalPut:
 			return &proto.ConditionalPutRequest{}, &proto.ConditionalPutResponse{}
+		case proto.InitPut:
+			return &proto.InitPutRequest{}, &proto.InitPutResponse{}
 		case proto.Increment:
 			return &proto.IncrementRequest{}, &proto.IncrementResponse{}
 		case proto.Delete:
 			return &proto.DeleteRequest{}, &proto.DeleteResponse{}
 		case proto.DeleteRange:
 			return &proto.DeleteRangeRequest{}, &proto.DeleteRangeResponse{}
+		case proto.ClearRange:
+			return &proto.ClearRangeRequest{}, &proto.ClearRangeResponse{}
 		case proto.Scan:
 			return &proto.ScanRequest{}, &proto.ScanResponse{}
+		case proto.Aggregate:
+			return &proto.AggregateRequest{}, &proto.AggregateResponse{}
+		case proto.Watch:
+			return &proto.WatchRequest{}, &proto.WatchResponse{}
 		case proto.EndTransaction:
 			return &proto.EndTransactionRequest{}, &proto.EndTransactionResponse{}
 		case proto.Batch:
@@ -80,6 +98,14 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 			return &proto.AdminSplitRequest{}, &proto.AdminSplitResponse{}
 		case proto.AdminMerge:
 			return &proto.AdminMergeRequest{}, &proto.AdminMergeResponse{}
+		case proto.AdminBulkLoad:
+			return &proto.AdminBulkLoadRequest{}, &proto.AdminBulkLoadResponse{}
+		case proto.AdminTransferLease:
+			return &proto.AdminTransferLeaseRequest{}, &proto.AdminTransferLeaseResponse{}
+		case proto.AdminBackup:
+			return &proto.AdminBackupRequest{}, &proto.AdminBackupResponse{}
+		case proto.AdminRestore:
+			return &proto.AdminRestoreRequest{}, &proto.AdminRestoreResponse{}
 		}
 	}
 	return nil, nil
@@ -88,12 +114,30 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 // A DBServer provides an HTTP server endpoint serving the key-value API.
 // It accepts either JSON or serialized protobuf content types.
 type DBServer struct {
-	sender client.KVSender
+	sender    client.KVSender
+	latencies *storage.RequestLatencies
 }
 
 // NewDBServer allocates and returns a new DBServer.
 func NewDBServer(sender client.KVSender) *DBServer {
-	return &DBServer{sender: sender}
+	return &DBServer{
+		sender:    sender,
+		latencies: storage.NewRequestLatencies("node.request"),
+	}
+}
+
+// Latencies returns the latencies of the requests served, by method,
+// whether over HTTP or RPC.
+func (s *DBServer) Latencies() *storage.RequestLatencies {
+	return s.latencies
+}
+
+// send sends the call through the server's sender, recording its
+// latency.
+func (s *DBServer) send(ctx context.Context, call client.Call) {
+	start := time.Now()
+	s.sender.Send(ctx, call)
+	s.latencies.Record(call.Method(), time.Since(start))
 }
 
 // ServeHTTP serves the key-value API by treating the request URL path
@@ -135,8 +179,18 @@ func (s *DBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create a call and invoke through sender.
-	s.sender.Send(client.Call{Args: args, Reply: reply})
+	// Tie the request to the user of the client certificate.
+	if err := s.authenticate(args, r.TLS); err != nil {
+		audit.LogRequest(audit.EventAuthFailed, args, err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	// Create a call and invoke through sender, bounded by the timeout
+	// the client set in the request header.
+	ctx, cancel := client.RequestContext(args)
+	defer cancel()
+	s.send(ctx, client.Call{Args: args, Reply: reply})
 
 	// Marshal the response.
 	body, contentType, err := util.MarshalResponse(r, reply, allowedEncodings)
@@ -148,18 +202,72 @@ func (s *DBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(body)
 }
 
+// authenticate ties the request to the user authenticated by the
+// connection's client certificate (see security.AuthenticateRequest).
+// Users other than nodes and root must exist in the system users
+// table. Insecure connections aren't authenticated.
+func (s *DBServer) authenticate(args proto.Request, state *tls.ConnectionState) error {
+	if err := security.AuthenticateRequest(args, state); err != nil {
+		return err
+	}
+	user, ok := security.AuthenticatedUser(state)
+	if !ok || user == security.NodeUser || user == storage.UserRoot {
+		return nil
+	}
+	return s.verifyUser(user)
+}
+
+// verifyUser returns an error if the user doesn't exist in the system
+// users table.
+func (s *DBServer) verifyUser(user string) error {
+	call := client.GetCall(keys.MakeKey(keys.KeyUserPrefix, proto.Key(user)))
+	call.Args.Header().User = storage.UserRoot
+	ctx, cancel := client.RequestContext(call.Args)
+	defer cancel()
+	s.sender.Send(ctx, call)
+	reply := call.Reply.(*proto.GetResponse)
+	if err := reply.GoError(); err != nil {
+		return err
+	}
+	if reply.Value == nil {
+		return util.Errorf("unknown user %q", user)
+	}
+	return nil
+}
+
 // RegisterRPC registers the RPC endpoints.
 func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 	return rpcServer.RegisterName("Server", (*rpcDBServer)(s))
 }
 
+// RegisterServices registers the KV and Admin services defined in
+// api.proto, which are called by the clients generated from it.
+func (s *DBServer) RegisterServices(r proto.Registrar) error {
+	if err := proto.RegisterKVServer(r, (*rpcDBServer)(s)); err != nil {
+		return err
+	}
+	return proto.RegisterAdminServer(r, (*rpcDBServer)(s))
+}
+
 // rpcDBServer is used to provide a separate method namespace for RPC
 // registration.
 type rpcDBServer DBServer
 
 // executeCmd creates a client.Call struct and sends if via our local sender.
+// The request's user has already been authenticated by the RPC server
+// codec; as on the HTTP endpoint, users other than nodes and root must
+// also exist in the system users table.
 func (s *rpcDBServer) executeCmd(args proto.Request, reply proto.Response) error {
-	s.sender.Send(client.Call{Args: args, Reply: reply})
+	if user := args.Header().User; user != "" && user != security.NodeUser && user != storage.UserRoot {
+		if err := (*DBServer)(s).verifyUser(user); err != nil {
+			audit.LogRequest(audit.EventAuthFailed, args, err)
+			reply.Header().SetGoError(err)
+			return nil
+		}
+	}
+	ctx, cancel := client.RequestContext(args)
+	defer cancel()
+	(*DBServer)(s).send(ctx, client.Call{Args: args, Reply: reply})
 	return nil
 }
 
@@ -183,6 +291,11 @@ func (s *rpcDBServer) ConditionalPut(args *proto.ConditionalPutRequest, reply *p
 	return s.executeCmd(args, reply)
 }
 
+// InitPut .
+func (s *rpcDBServer) InitPut(args *proto.InitPutRequest, reply *proto.InitPutResponse) error {
+	return s.executeCmd(args, reply)
+}
+
 // Increment .
 func (s *rpcDBServer) Increment(args *proto.IncrementRequest, reply *proto.IncrementResponse) error {
 	return s.executeCmd(args, reply)
@@ -198,11 +311,26 @@ func (s *rpcDBServer) DeleteRange(args *proto.DeleteRangeRequest, reply *proto.D
 	return s.executeCmd(args, reply)
 }
 
+// ClearRange .
+func (s *rpcDBServer) ClearRange(args *proto.ClearRangeRequest, reply *proto.ClearRangeResponse) error {
+	return s.executeCmd(args, reply)
+}
+
 // Scan .
 func (s *rpcDBServer) Scan(args *proto.ScanRequest, reply *proto.ScanResponse) error {
 	return s.executeCmd(args, reply)
 }
 
+// Aggregate .
+func (s *rpcDBServer) Aggregate(args *proto.AggregateRequest, reply *proto.AggregateResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+// Watch .
+func (s *rpcDBServer) Watch(args *proto.WatchRequest, reply *proto.WatchResponse) error {
+	return s.executeCmd(args, reply)
+}
+
 // EndTransaction .
 func (s *rpcDBServer) EndTransaction(args *proto.EndTransactionRequest, reply *proto.EndTransactionResponse) error {
 	return s.executeCmd(args, reply)
@@ -222,3 +350,24 @@ func (s *rpcDBServer) AdminSplit(args *proto.AdminSplitRequest, reply *proto.Adm
 func (s *rpcDBServer) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMergeResponse) error {
 	return s.executeCmd(args, reply)
 }
+
+// AdminBulkLoad .
+func (s *rpcDBServer) AdminBulkLoad(args *proto.AdminBulkLoadRequest, reply *proto.AdminBulkLoadResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+// AdminTransferLease .
+func (s *rpcDBServer) AdminTransferLease(args *proto.AdminTransferLeaseRequest,
+	reply *proto.AdminTransferLeaseResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+// AdminBackup .
+func (s *rpcDBServer) AdminBackup(args *proto.AdminBackupRequest, reply *proto.AdminBackupResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+// AdminRestore .
+func (s *rpcDBServer) AdminRestore(args *proto.AdminRestoreRequest, reply *proto.AdminRestoreResponse) error {
+	return s.executeCmd(args, reply)
+}
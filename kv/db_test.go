@@ -20,15 +20,21 @@ package kv_test
 import (
 	"bytes"
 	"encoding/json"
+	"net"
 	"net/http"
+	"net/rpc"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/rpc/codec"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 	yaml "gopkg.in/yaml.v1"
 )
 
@@ -175,6 +181,9 @@ func TestKVDBInternalMethods(t *testing.T) {
 		{&proto.InternalResolveIntentRequest{}, &proto.InternalResolveIntentResponse{}},
 		{&proto.InternalMergeRequest{}, &proto.InternalMergeResponse{}},
 		{&proto.InternalTruncateLogRequest{}, &proto.InternalTruncateLogResponse{}},
+		{&proto.InternalCheckConsistencyRequest{}, &proto.InternalCheckConsistencyResponse{}},
+		{&proto.InternalRecomputeStatsRequest{}, &proto.InternalRecomputeStatsResponse{}},
+		{&proto.InternalRefreshRangeRequest{}, &proto.InternalRefreshRangeResponse{}},
 	}
 	// Verify non-public methods experience bad request errors.
 	kvClient := createTestClient(t, addr)
@@ -345,3 +354,51 @@ func TestKVDBTransaction(t *testing.T) {
 		t.Errorf("expected value %q; got %q", value, gr.Value.Bytes)
 	}
 }
+
+// TestKVDBRPCVerifiesUser verifies that requests served over RPC are
+// rejected if their user is missing from the system users table.
+func TestKVDBRPCVerifiesUser(t *testing.T) {
+	puts := 0
+	db := kv.NewDBServer(client.KVSenderFunc(func(_ context.Context, call client.Call) {
+		switch args := call.Args.(type) {
+		case *proto.GetRequest:
+			if args.Key.Equal(keys.MakeKey(keys.KeyUserPrefix, proto.Key("alice"))) {
+				call.Reply.(*proto.GetResponse).Value = &proto.Value{}
+			}
+		case *proto.PutRequest:
+			puts++
+		}
+	}))
+	srv := rpc.NewServer()
+	if err := db.RegisterServices(srv); err != nil {
+		t.Fatal(err)
+	}
+	clientConn, serverConn := net.Pipe()
+	go srv.ServeCodec(codec.NewServerCodec(serverConn))
+	c := rpc.NewClientWithCodec(codec.NewClientCodecWithCompression(clientConn, false))
+	defer c.Close()
+
+	kvClient := proto.NewKVClient(c)
+	testCases := []struct {
+		user   string
+		expErr bool
+	}{
+		{"alice", false},
+		{"bob", true},
+		{storage.UserRoot, false},
+	}
+	for i, test := range testCases {
+		reply, err := kvClient.Put(&proto.PutRequest{
+			RequestHeader: proto.RequestHeader{Key: proto.Key("a"), User: test.user},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := reply.GoError(); (err != nil) != test.expErr {
+			t.Errorf("%d: expected error %t; got %v", i, test.expErr, err)
+		}
+	}
+	if puts != 2 {
+		t.Errorf("expected 2 puts to be sent; got %d", puts)
+	}
+}
@@ -20,19 +20,22 @@ package kv
 import (
 	"bytes"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/storage"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/trace"
 
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // Default constants for timeouts.
@@ -42,6 +45,9 @@ const (
 	defaultClientTimeout   = 10 * time.Second
 	retryBackoff           = 1 * time.Second
 	maxRetryBackoff        = 30 * time.Second
+	// The timeout for asking a single node for the first range
+	// descriptor when it isn't known via gossip.
+	firstRangeLookupTimeout = 1 * time.Second
 
 	// The default maximum number of ranges to return
 	// from an internal range lookup.
@@ -102,6 +108,14 @@ type DistSender struct {
 	// key range, used to find the replica metadata for arbitrary key
 	// ranges.
 	gossip *gossip.Gossip
+	// gossipMu protects the first range descriptor and permissions,
+	// which are updated by gossip callbacks. firstRangeSeq is the
+	// highest sequence of the first range descriptors gossiped by
+	// individual replicas.
+	gossipMu      sync.Mutex
+	firstRange    *proto.RangeDescriptor
+	firstRangeSeq uint64
+	permMap       storage.PrefixConfigMap
 	// rangeCache caches replica metadata for key ranges.
 	rangeCache           *rangeDescriptorCache
 	rangeLookupMaxRanges int32
@@ -112,6 +126,11 @@ type DistSender struct {
 	// outside of tests.
 	rpcSend         rpcSendFn
 	rpcRetryOptions util.RetryOptions
+	// tracer collects the spans of requests sent, if set.
+	tracer *trace.Tracer
+	// closedTimestampLag is the lag behind the current time at which
+	// ranges close timestamps. Zero disables follower reads.
+	closedTimestampLag time.Duration
 }
 
 // rpcSendFn is the function type used to dispatch RPC calls.
@@ -129,6 +148,13 @@ type DistSenderContext struct {
 	RangeLookupMaxRanges int32
 	LeaderCacheSize      int32
 	RPCRetryOptions      *util.RetryOptions
+	// Tracer, if set, traces the requests sent which aren't already
+	// part of a trace.
+	Tracer *trace.Tracer
+	// ClosedTimestampLag, if set, is how far behind the current time
+	// ranges close timestamps. Historical reads at least that old are
+	// sent to the nearest replica instead of the leader.
+	ClosedTimestampLag time.Duration
 	// nodeDescriptor, if provided, is used to describe which node the DistSender
 	// lives on, for instance when deciding where to send RPCs.
 	// Usually it is filled in from the Gossip network on demand.
@@ -143,7 +169,7 @@ type DistSenderContext struct {
 // Cockroach cluster via the supplied gossip instance. Supplying a
 // DistSenderContext or the fields within is optional. For omitted values, sane
 // defaults will be used.
-func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
+func NewDistSender(ctx *DistSenderContext, g *gossip.Gossip) *DistSender {
 	if ctx == nil {
 		ctx = &DistSenderContext{}
 	}
@@ -152,8 +178,10 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 		clock = hlc.NewClock(hlc.UnixNano)
 	}
 	ds := &DistSender{
-		clock:  clock,
-		gossip: gossip,
+		clock:              clock,
+		gossip:             g,
+		tracer:             ctx.Tracer,
+		closedTimestampLag: ctx.ClosedTimestampLag,
 	}
 	ds.nodeDescriptor = ctx.nodeDescriptor
 	rcSize := ctx.RangeDescriptorCacheSize
@@ -181,73 +209,75 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 	if ctx.RPCRetryOptions != nil {
 		ds.rpcRetryOptions = *ctx.RPCRetryOptions
 	}
+	if g != nil {
+		g.RegisterCallback(gossip.KeyFirstRangeDescriptor, ds.firstRangeGossipUpdate)
+		g.RegisterCallback(gossip.MakePrefixPattern(gossip.KeyFirstRangeReplicaPrefix),
+			ds.firstRangeReplicaGossipUpdate)
+		g.RegisterCallback(gossip.KeySystemConfig, ds.systemConfigGossipUpdate)
+	}
 	return ds
 }
 
-// verifyPermissions verifies that the requesting user (header.User)
-// has permission to read/write (capabilities depend on method
-// name). In the event that multiple permission configs apply to the
-// key range implicated by the command, the lowest common denominator
-// for permission. For example, if a scan crosses two permission
-// configs, both configs must allow read permissions or the entire
-// scan will fail.
-func (ds *DistSender) verifyPermissions(args proto.Request) error {
-	// The root user can always proceed.
-	header := args.Header()
-	if header.User == storage.UserRoot {
-		return nil
+// firstRangeGossipUpdate is a gossip callback triggered whenever the
+// raft leader of the first range gossips its descriptor.
+func (ds *DistSender) firstRangeGossipUpdate(_ string, val interface{}) {
+	frd, ok := val.(storage.FirstRangeDescriptor)
+	if !ok {
+		log.Errorf("gossiped info is not a first range descriptor: %+v", val)
+		return
 	}
-	// Check for admin methods.
-	if proto.IsAdmin(args) {
-		if header.User != storage.UserRoot {
-			return util.Errorf("user %q cannot invoke admin command %s", header.User, args.Method())
-		}
-		return nil
-	}
-	// Get permissions map from gossip.
-	configMap, err := ds.gossip.GetInfo(gossip.KeyConfigPermission)
-	if err != nil {
-		return util.Errorf("permissions not available via gossip")
-	}
-	if configMap == nil {
-		return util.Errorf("perm configs not available; cannot execute %s", args.Method())
-	}
-	permMap := configMap.(storage.PrefixConfigMap)
-	headerEnd := header.EndKey
-	if headerEnd == nil {
-		headerEnd = header.Key
-	}
-	// Visit PermConfig(s) which apply to the method's key range.
-	//   - For each perm config which the range covers, verify read or writes
-	//     are allowed as method requires.
-	//   - Verify the permissions hierarchically; that is, if permissions aren't
-	//     granted at the longest prefix, try next longest, then next, etc., up
-	//     to and including the default prefix.
-	//
-	// TODO(spencer): it might make sense to visit prefixes from the
-	//   shortest to longest instead for performance. Keep an eye on profiling
-	//   for this code path as permission sets grow large.
-	return permMap.VisitPrefixes(header.Key, headerEnd,
-		func(start, end proto.Key, config interface{}) (bool, error) {
-			hasPerm := false
-			permMap.VisitPrefixesHierarchically(start, func(start, end proto.Key, config interface{}) (bool, error) {
-				perm := config.(*proto.PermConfig)
-				if proto.IsRead(args) && !perm.CanRead(header.User) {
-					return false, nil
-				}
-				if proto.IsWrite(args) && !perm.CanWrite(header.User) {
-					return false, nil
-				}
-				// Return done = true, as permissions have been granted by this config.
-				hasPerm = true
-				return true, nil
-			})
-			if !hasPerm {
-				return false, util.Errorf("user %q cannot invoke %s at %q-%q",
-					header.User, args.Method(), start, end)
-			}
-			return false, nil
-		})
+	ds.gossipMu.Lock()
+	defer ds.gossipMu.Unlock()
+	ds.updateFirstRangeLocked(frd)
+}
+
+// firstRangeReplicaGossipUpdate is a gossip callback triggered whenever
+// a replica of the first range gossips its descriptor. The descriptor
+// is only used if its sequence is at least as high as any seen before,
+// so that lagging replicas can't replace a newer descriptor.
+func (ds *DistSender) firstRangeReplicaGossipUpdate(_ string, val interface{}) {
+	frd, ok := val.(storage.FirstRangeDescriptor)
+	if !ok {
+		log.Errorf("gossiped info is not a first range descriptor: %+v", val)
+		return
+	}
+	ds.gossipMu.Lock()
+	defer ds.gossipMu.Unlock()
+	ds.updateFirstRangeLocked(frd)
+}
+
+// updateFirstRangeLocked sets the first range descriptor and its
+// sequence unless a descriptor with a higher sequence is already
+// known. gossipMu must be held.
+func (ds *DistSender) updateFirstRangeLocked(frd storage.FirstRangeDescriptor) {
+	if ds.firstRange != nil && frd.Sequence < ds.firstRangeSeq {
+		return
+	}
+	ds.firstRange = &frd.Desc
+	ds.firstRangeSeq = frd.Sequence
+}
+
+// systemConfigGossipUpdate is a gossip callback triggered whenever
+// the system config changes. Only its permission configs are kept.
+func (ds *DistSender) systemConfigGossipUpdate(_ string, val interface{}) {
+	cfg, ok := val.(storage.SystemConfig)
+	if !ok {
+		log.Errorf("gossiped info is not a system config: %+v", val)
+		return
+	}
+	ds.gossipMu.Lock()
+	defer ds.gossipMu.Unlock()
+	ds.permMap = cfg.Permissions
+}
+
+// verifyPermissions verifies that the requesting user has permission
+// to execute the request against the permission configs last received
+// via gossip. See storage.VerifyPermissions.
+func (ds *DistSender) verifyPermissions(args proto.Request) error {
+	ds.gossipMu.Lock()
+	permMap := ds.permMap
+	ds.gossipMu.Unlock()
+	return storage.VerifyPermissions(args, permMap)
 }
 
 // internalRangeLookup dispatches an InternalRangeLookup request for the given
@@ -268,7 +298,7 @@ func (ds *DistSender) internalRangeLookup(key proto.Key, info *proto.RangeDescri
 		MaxRanges: ds.rangeLookupMaxRanges,
 	}
 	reply := &proto.InternalRangeLookupResponse{}
-	if err := ds.sendRPC(info, args, reply); err != nil {
+	if err := ds.sendRPC(context.Background(), info, args, reply, false); err != nil {
 		return nil, err
 	}
 	if reply.Error != nil {
@@ -281,12 +311,78 @@ func (ds *DistSender) internalRangeLookup(key proto.Key, info *proto.RangeDescri
 // the cluster, which is retrieved from the gossip protocol instead of the
 // datastore.
 func (ds *DistSender) getFirstRangeDescriptor() (*proto.RangeDescriptor, error) {
-	infoI, err := ds.gossip.GetInfo(gossip.KeyFirstRangeDescriptor)
-	if err != nil {
+	ds.gossipMu.Lock()
+	defer ds.gossipMu.Unlock()
+	if ds.firstRange == nil {
 		return nil, firstRangeMissingError{}
 	}
-	info := infoI.(proto.RangeDescriptor)
-	return &info, nil
+	desc := *ds.firstRange
+	return &desc, nil
+}
+
+// lookupFirstRangeDescriptor returns the first range descriptor from
+// gossip if it's known. Otherwise, it asks the nodes known via gossip
+// in turn to look it up from their local replica of the first range.
+// The first descriptor found seeds ds.firstRange until gossip delivers
+// a fresh one.
+func (ds *DistSender) lookupFirstRangeDescriptor() (*proto.RangeDescriptor, error) {
+	desc, err := ds.getFirstRangeDescriptor()
+	if _, ok := err.(firstRangeMissingError); !ok || ds.gossip == nil {
+		return desc, err
+	}
+	for _, val := range ds.gossip.GetInfosWithPrefix(gossip.KeyNodeIDPrefix) {
+		nodeDesc, ok := val.(*gossip.NodeDescriptor)
+		if !ok || nodeDesc.Address == nil {
+			continue
+		}
+		rd, err := ds.firstRangeLookup(nodeDesc.Address)
+		if err != nil {
+			log.V(1).Infof("node %d could not look up first range: %s", nodeDesc.NodeID, err)
+			continue
+		}
+		ds.gossipMu.Lock()
+		if ds.firstRange == nil {
+			ds.firstRange = rd
+		}
+		ds.gossipMu.Unlock()
+		return ds.getFirstRangeDescriptor()
+	}
+	return nil, firstRangeMissingError{}
+}
+
+// firstRangeLookup sends an InternalRangeLookup for the first meta1
+// record to the node at addr. The request carries no replica, so the
+// node routes it by key to its local replica of the first range, if it
+// has one.
+func (ds *DistSender) firstRangeLookup(addr net.Addr) (*proto.RangeDescriptor, error) {
+	args := &proto.InternalRangeLookupRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:             keys.KeyMeta1Prefix,
+			User:            storage.UserRoot,
+			ReadConsistency: proto.INCONSISTENT,
+		},
+		MaxRanges: 1,
+	}
+	reply := &proto.InternalRangeLookupResponse{}
+	rpcOpts := rpc.Options{
+		N:               1,
+		Ordering:        rpc.OrderStable,
+		SendNextTimeout: firstRangeLookupTimeout,
+		Timeout:         firstRangeLookupTimeout,
+	}
+	if _, err := ds.rpcSend(rpcOpts, "Node."+args.Method().String(), []net.Addr{addr},
+		func(net.Addr) interface{} { return args },
+		func() interface{} { return reply },
+		ds.gossip.RPCContext); err != nil {
+		return nil, err
+	}
+	if reply.Error != nil {
+		return nil, reply.GoError()
+	}
+	if len(reply.Ranges) == 0 || !reply.Ranges[0].StartKey.Equal(keys.KeyMin) {
+		return nil, util.Errorf("lookup did not return the first range: %+v", reply.Ranges)
+	}
+	return &reply.Ranges[0], nil
 }
 
 // getRangeDescriptor retrieves the descriptor for the range
@@ -300,7 +396,7 @@ func (ds *DistSender) getRangeDescriptor(key proto.Key) ([]proto.RangeDescriptor
 	var (
 		// metadataKey is sent to InternalRangeLookup to find the
 		// RangeDescriptor which contains key.
-		metadataKey = engine.RangeMetaKey(key)
+		metadataKey = keys.RangeMetaKey(key)
 		// desc is the RangeDescriptor for the range which contains
 		// metadataKey.
 		desc *proto.RangeDescriptor
@@ -310,15 +406,15 @@ func (ds *DistSender) getRangeDescriptor(key proto.Key) ([]proto.RangeDescriptor
 		// In this case, the requested key is stored in the cluster's first
 		// range. Return the first range, which is always gossiped and not
 		// queried from the datastore.
-		rd, err := ds.getFirstRangeDescriptor()
+		rd, err := ds.lookupFirstRangeDescriptor()
 		if err != nil {
 			return nil, err
 		}
 		return []proto.RangeDescriptor{*rd}, nil
 	}
-	if bytes.HasPrefix(metadataKey, engine.KeyMeta1Prefix) {
+	if bytes.HasPrefix(metadataKey, keys.KeyMeta1Prefix) {
 		// In this case, desc is the cluster's first range.
-		if desc, err = ds.getFirstRangeDescriptor(); err != nil {
+		if desc, err = ds.lookupFirstRangeDescriptor(); err != nil {
 			return nil, err
 		}
 	} else {
@@ -378,9 +474,17 @@ func (ds *DistSender) getNodeDescriptor() *gossip.NodeDescriptor {
 // leader) and then sent via rpc.Send, with requirement that one RPC to a
 // server must succeed. Returns an RPC error if the request could not be sent.
 // Note that the reply may contain a higher level error and must be checked in
-// addition to the RPC error.
-func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
-	args proto.Request, reply proto.Response) error {
+// addition to the RPC error. The RPC is bounded by the deadline of ctx, if
+// any, and the time remaining is propagated to the receiving node. If
+// followerRead is set, the request is sent to the nearest replica, the
+// local one if any, instead of the leader. Streamed scans are sent to a
+// single replica, as the chunks it streams can't be taken back if
+// another replica is asked as well.
+func (ds *DistSender) sendRPC(ctx context.Context, desc *proto.RangeDescriptor,
+	args proto.Request, reply proto.Response, followerRead bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	if len(desc.Replicas) == 0 {
 		return util.Errorf("%s: replicas set is empty", args.Method())
 	}
@@ -394,7 +498,17 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 
 	// If this request needs to go to a leader and we know who that is, move
 	// it to the front and send requests in order.
-	if args.Header().ReadConsistency != proto.INCONSISTENT || proto.IsWrite(args) {
+	if followerRead {
+		if nodeDesc := ds.getNodeDescriptor(); nodeDesc != nil {
+			for i := range replicas {
+				if replicas[i].NodeID == nodeDesc.NodeID {
+					replicas.MoveToFront(i)
+					order = rpc.OrderStable
+					break
+				}
+			}
+		}
+	} else if args.Header().ReadConsistency != proto.INCONSISTENT || proto.IsWrite(args) {
 		if leader := ds.leaderCache.Lookup(proto.RaftID(desc.RaftID)); leader != nil {
 			i, _ := replicas.FindReplica(leader.StoreID)
 			if i >= 0 {
@@ -419,6 +533,11 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 	if len(addrs) == 0 {
 		return noNodeAddrsAvailError{}
 	}
+	method := "Node." + args.Method().String()
+	if _, ok := args.(*proto.ScanStreamRequest); ok {
+		method = "Node.ScanStream"
+		addrs = addrs[:1]
+	}
 
 	// TODO(pmattis): This needs to be tested. If it isn't set we'll
 	// still route the request appropriately by key, but won't receive
@@ -432,6 +551,19 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 		SendNextTimeout: defaultSendNextTimeout,
 		Timeout:         defaultRPCTimeout,
 	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if timeout := deadline.Sub(time.Now()); timeout < rpcOpts.Timeout {
+			// A zero timeout means none, so never go below the minimum.
+			if timeout <= 0 {
+				timeout = 1
+			}
+			rpcOpts.Timeout = timeout
+		}
+	}
+	client.SetTimeout(ctx, args)
+	sp := trace.FromContext(ctx)
+	args.Header().Trace = sp.TraceContext()
+	sp.Eventf("sending to %d replicas of range %d", len(addrs), desc.RaftID)
 	// getArgs clones the arguments on demand for all but the first replica.
 	firstArgs := true
 	getArgs := func(addr net.Addr) interface{} {
@@ -455,11 +587,29 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 		}
 		return gogoproto.Clone(reply)
 	}
-	_, err := ds.rpcSend(rpcOpts, "Node."+args.Method().String(),
-		addrs, getArgs, getReply, ds.gossip.RPCContext)
+	_, err := ds.rpcSend(rpcOpts, method, addrs, getArgs, getReply, ds.gossip.RPCContext)
+	sp.EndPhasef(trace.PhaseRPC, "rpc completed")
 	return err
 }
 
+// isHistoricalRead returns whether args is a read-only request at a
+// timestamp set by the client, such as those sent by client.KVAt.
+func isHistoricalRead(args proto.Request) bool {
+	return proto.IsReadOnly(args) && !args.Header().Timestamp.Equal(proto.ZeroTimestamp)
+}
+
+// isFollowerRead returns whether args is a non-transactional, consistent
+// historical read old enough that the replicas of a range have likely
+// closed its timestamp, allowing any of them to serve it.
+func (ds *DistSender) isFollowerRead(args proto.Request) bool {
+	header := args.Header()
+	if ds.closedTimestampLag <= 0 || !isHistoricalRead(args) ||
+		header.Txn != nil || header.ReadConsistency != proto.CONSISTENT {
+		return false
+	}
+	return header.Timestamp.WallTime <= ds.clock.PhysicalNow()-ds.closedTimestampLag.Nanoseconds()
+}
+
 // Send implements the client.KVSender interface. It verifies
 // permissions and looks up the appropriate range based on the
 // supplied key and sends the RPC according to the specified options.
@@ -469,8 +619,12 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 // sequentially and combines the results transparently.
 //
 // This may temporarily adjust the request headers, so the client.Call
-// must not be used concurrently until Send has returned.
-func (ds *DistSender) Send(call client.Call) {
+// must not be used concurrently until Send has returned. Retries end
+// once ctx is done. The request is traced as a child of the span of
+// ctx or, failing that, by the DistSender's tracer.
+func (ds *DistSender) Send(ctx context.Context, call client.Call) {
+	ctx, sp := ds.tracer.StartSpan(ctx, "DistSender."+call.Method().String())
+	defer sp.Finish()
 
 	// TODO: Refactor this method into more manageable pieces.
 	// Verify permissions.
@@ -487,6 +641,10 @@ func (ds *DistSender) Send(call client.Call) {
 	args := call.Args
 	reply := call.Reply
 	endKey := args.Header().EndKey
+	// A write sent to a range by an RPC which failed may still have
+	// been applied, until the range replies to a retry (which its
+	// response cache deduplicates).
+	var ambiguous bool
 
 	// In the event that timestamp isn't set and read consistency isn't
 	// required, set the timestamp using the local clock.
@@ -495,23 +653,38 @@ func (ds *DistSender) Send(call client.Call) {
 	}
 
 	for {
+		// Sufficiently old historical reads go to the nearest replica,
+		// falling back to the leader if the replica can't serve them.
+		followerRead := ds.isFollowerRead(args)
 		err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
+			if err := ctx.Err(); err != nil {
+				return util.RetryBreak, err
+			}
 			reply.Header().Reset()
 			descNext = nil
 			desc, err := ds.rangeCache.LookupRangeDescriptor(args.Header().Key)
+			sp.EndPhasef(trace.PhaseLookup, "looked up range descriptor for key %s", args.Header().Key)
 			if err == nil {
 				// If the request accesses keys beyond the end of this range,
 				// get the descriptor of the adjacent range to address next.
 				if desc.EndKey.Less(endKey) {
 					if _, ok := call.Reply.(proto.Combinable); !ok {
+						// A batch executes as a single command; its
+						// sender may split it up if it spans ranges.
+						if _, ok := args.(*proto.BatchRequest); ok {
+							return util.RetryBreak, proto.NewRangeKeyMismatchError(args.Header().Key, endKey, desc)
+						}
 						return util.RetryBreak, util.Error("illegal cross-range operation", call)
 					}
 					// If there's no transaction and op spans ranges, possibly
 					// re-run as part of a transaction for consistency. The
-					// case where we don't need to re-run is if the read
-					// consistency is not required.
+					// cases where we don't need to re-run are if the read
+					// consistency is not required, or if the op is a read
+					// at a timestamp given by the client, which reads each
+					// range consistently at that same timestamp.
 					if args.Header().Txn == nil &&
-						args.Header().ReadConsistency != proto.INCONSISTENT {
+						args.Header().ReadConsistency != proto.INCONSISTENT &&
+						!isHistoricalRead(args) {
 						return util.RetryBreak, &proto.OpRequiresTxnError{}
 					}
 					// This next lookup is likely for free since we've read the
@@ -524,7 +697,10 @@ func (ds *DistSender) Send(call client.Call) {
 			}
 
 			if err == nil {
-				err = ds.sendRPC(desc, args, reply)
+				err = ds.sendRPC(ctx, desc, args, reply, followerRead)
+				if ambErr, ok := err.(ambiguousError); ok && ambErr.Ambiguous() && proto.IsWrite(args) {
+					ambiguous = true
+				}
 				if err == nil && reply.Header().Error != nil {
 					err = reply.Header().GoError()
 				}
@@ -532,29 +708,19 @@ func (ds *DistSender) Send(call client.Call) {
 
 			if err != nil {
 				log.Warningf("failed to invoke %s: %s", call.Method(), err)
-				// If retryable, allow retry. For range not found or range
-				// key mismatch errors, we don't backoff on the retry,
-				// but reset the backoff loop so we can retry immediately.
-				switch err.(type) {
-				case *proto.RangeNotFoundError, *proto.RangeKeyMismatchError:
-					// Range descriptor might be out of date - evict it.
-					ds.rangeCache.EvictCachedRangeDescriptor(args.Header().Key)
-					// On addressing errors, don't backoff; retry immediately.
+				sp.Eventf("failed: %s", err)
+				if _, ok := err.(*proto.NotLeaderError); ok && followerRead {
+					// The replica hasn't closed the timestamp yet.
+					followerRead = false
 					return util.RetryReset, nil
-				case *proto.NotLeaderError:
-					ds.updateLeaderCache(proto.RaftID(desc.RaftID),
-						err.(*proto.NotLeaderError).GetLeader())
-					return util.RetryReset, nil
-				default:
-					if retryErr, ok := err.(util.Retryable); ok && retryErr.CanRetry() {
-						return util.RetryContinue, nil
-					}
 				}
-				return util.RetryBreak, err
+				return ds.retryStatus(err, desc, args.Header().Key)
 			}
 
-			// If this request has a bound, such as MaxResults in
-			// ScanRequest, check whether enough rows have been retrieved.
+			// If this request has a bound, such as MaxResults or
+			// TargetBytes in ScanRequest, check whether enough rows have
+			// been retrieved.
+			var done bool
 			if args, ok := args.(proto.Bounded); ok && args.GetBound() > 0 {
 				bound := args.GetBound()
 				if reply, ok := reply.(proto.Countable); ok {
@@ -562,16 +728,41 @@ func (ds *DistSender) Send(call client.Call) {
 						// Update bound for the next round.
 						args.SetBound(nextBound)
 					} else {
-						// Set flag to break the loop.
-						descNext = nil
+						done = true
+					}
+				}
+			}
+			if args, ok := args.(proto.ByteBounded); ok && args.GetByteBound() > 0 {
+				bound := args.GetByteBound()
+				if reply, ok := reply.(proto.Sizable); ok {
+					if nextBound := bound - reply.ByteCount(); nextBound > 0 {
+						args.SetByteBound(nextBound)
+					} else {
+						done = true
 					}
 				}
 			}
+			// A range which stopped short of the end of its part of the
+			// span returns the remainder of that part; extend it to the
+			// end of the whole span. If the bound ran out exactly at a
+			// range boundary, the remainder starts at the next range.
+			if reply, ok := reply.(proto.Resumable); ok {
+				if resume := reply.GetResumeSpan(); resume != nil {
+					resume.EndKey = endKey
+					done = true
+				} else if done && descNext != nil {
+					reply.SetResumeSpan(&proto.Span{Key: descNext.StartKey, EndKey: endKey})
+				}
+			}
+			if done {
+				// Set flag to break the loop.
+				descNext = nil
+			}
 
 			if call.Reply != reply {
 				// This is a multi-range request. Combine the new response
 				// with the existing response.
-				call.Reply.(proto.Combinable).Combine(reply)
+				err = call.Reply.(proto.Combinable).Combine(reply)
 			}
 			return util.RetryBreak, err
 		})
@@ -582,10 +773,16 @@ func (ds *DistSender) Send(call client.Call) {
 
 		// Immediately return if querying a range failed non-retryably.
 		// For multi-range requests, we return the failing range's reply.
+		// Unless the range replied, the outcome of a write which may
+		// have been applied is unknown.
 		if err != nil {
+			if ambiguous && reply.Header().Error == nil {
+				err = proto.NewAmbiguousResultError(err)
+			}
 			call.Reply.Header().SetGoError(err)
 			return
 		}
+		ambiguous = false
 		// If this was the last range accessed by this call, exit loop.
 		if descNext == nil {
 			break
@@ -602,6 +799,181 @@ func (ds *DistSender) Send(call client.Call) {
 	}
 }
 
+// An ambiguousError is an RPC error which may leave the outcome of the
+// RPC unknown, like an rpc.SendError.
+type ambiguousError interface {
+	error
+	// Ambiguous returns true if the RPC may have been executed.
+	Ambiguous() bool
+}
+
+// retryStatus returns whether and how a request for key, which failed
+// with err when sent to the range described by desc, is to be
+// retried. For range not found or range key mismatch errors, we don't
+// backoff on the retry, but reset the backoff loop so we can retry
+// immediately. Other retryable errors are retried after backing off.
+func (ds *DistSender) retryStatus(err error, desc *proto.RangeDescriptor, key proto.Key) (util.RetryStatus, error) {
+	switch err.(type) {
+	case *proto.RangeNotFoundError, *proto.RangeKeyMismatchError:
+		// Range descriptor might be out of date - evict it.
+		ds.rangeCache.EvictCachedRangeDescriptor(key)
+		// On addressing errors, don't backoff; retry immediately.
+		return util.RetryReset, nil
+	case *proto.NotLeaderError:
+		ds.updateLeaderCache(proto.RaftID(desc.RaftID),
+			err.(*proto.NotLeaderError).GetLeader())
+		return util.RetryReset, nil
+	default:
+		if retryErr, ok := err.(util.Retryable); ok && retryErr.CanRetry() {
+			return util.RetryContinue, nil
+		}
+	}
+	return util.RetryBreak, err
+}
+
+// ScanStream implements the client.ScanStreamer interface. It is like
+// Send for a Scan call, but has each range stream its rows back in
+// chunks, which are passed to f in order as they arrive, so that no
+// range's rows need to be held in a single response. Scans outside of
+// a transaction read all ranges at the same timestamp, the present
+// unless set. The reply is set as by Send, except that its rows are
+// left empty. If a range fails part way through, it is retried from
+// the row after the last one received. If f returns an error or rows
+// fail verification, the scan stops and the error is set on the
+// reply. f is invoked on the goroutine reading the RPC connection and
+// should return promptly.
+func (ds *DistSender) ScanStream(ctx context.Context, call client.Call, f func(rows []proto.KeyValue) error) {
+	ctx, sp := ds.tracer.StartSpan(ctx, "DistSender.ScanStream")
+	defer sp.Finish()
+
+	args := call.Args.(*proto.ScanRequest)
+	reply := call.Reply.(*proto.ScanResponse)
+	if err := ds.verifyPermissions(args); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if args.Txn == nil && args.Timestamp.Equal(proto.ZeroTimestamp) {
+		args.Timestamp = ds.clock.Now()
+	}
+
+	retryOpts := ds.rpcRetryOptions
+	retryOpts.Tag = "routing streamed scan rpc"
+
+	var mu sync.Mutex // Protects the variables below, written by chunks.
+	key, endKey := args.Key, args.EndKey
+	var rows, bytes int64
+	var fErr error
+	// deliver verifies the rows of a chunk and passes them to f, unless
+	// the attempt to scan the range they belong to has been abandoned.
+	deliver := func(chunk []proto.KeyValue, abandoned *bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if *abandoned || fErr != nil || len(chunk) == 0 {
+			return
+		}
+		resp := &proto.ScanResponse{Rows: chunk}
+		if fErr = resp.Verify(args); fErr != nil {
+			return
+		}
+		rows += resp.Count()
+		bytes += resp.ByteCount()
+		key = chunk[len(chunk)-1].Key.Next()
+		fErr = f(chunk)
+	}
+	limitReached := func() bool {
+		return (args.MaxResults > 0 && rows >= args.MaxResults) ||
+			(args.TargetBytes > 0 && bytes >= args.TargetBytes)
+	}
+
+	first := true
+	for {
+		// Scan the rest of the range containing key. If the range fails
+		// part way through, retries pick up at the row after the last one
+		// received, and may find it in the next range.
+		var desc *proto.RangeDescriptor
+		rangeReply := &proto.ScanResponse{}
+		err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
+			if err := ctx.Err(); err != nil {
+				return util.RetryBreak, err
+			}
+			if !key.Less(endKey) || limitReached() {
+				// Everything asked for arrived before the failure.
+				desc = nil
+				return util.RetryBreak, nil
+			}
+			rangeReply.Reset()
+			var err error
+			if desc, err = ds.rangeCache.LookupRangeDescriptor(key); err == nil {
+				streamArgs := &proto.ScanStreamRequest{ScanRequest: *args}
+				streamArgs.Key = key
+				if desc.EndKey.Less(endKey) {
+					streamArgs.EndKey = desc.EndKey
+				}
+				if args.MaxResults > 0 {
+					streamArgs.MaxResults = args.MaxResults - rows
+				}
+				if args.TargetBytes > 0 {
+					streamArgs.TargetBytes = args.TargetBytes - bytes
+				}
+				abandoned := false
+				streamArgs.OnChunk = func(chunk *proto.ScanResponse) {
+					deliver(chunk.Rows, &abandoned)
+				}
+				err = ds.sendRPC(ctx, desc, streamArgs, rangeReply, false)
+				mu.Lock()
+				abandoned = true
+				mu.Unlock()
+				if err == nil && rangeReply.Error != nil {
+					err = rangeReply.GoError()
+				}
+			}
+			if fErr != nil {
+				return util.RetryBreak, nil
+			}
+			if err != nil {
+				log.Warningf("failed to stream scan: %s", err)
+				sp.Eventf("failed: %s", err)
+				return ds.retryStatus(err, desc, key)
+			}
+			// Nodes which don't stream return all rows with the final
+			// response.
+			final := false
+			deliver(rangeReply.Rows, &final)
+			rangeReply.Rows = nil
+			return util.RetryBreak, nil
+		})
+		if err == nil {
+			err = fErr
+		}
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+
+		if first {
+			reply.ResponseHeader = rangeReply.ResponseHeader
+			first = false
+		} else {
+			reply.Header().Combine(rangeReply.Header())
+		}
+		if resume := rangeReply.ResumeSpan; resume != nil {
+			reply.ResumeSpan = &proto.Span{Key: resume.Key, EndKey: endKey}
+			return
+		}
+		if desc == nil || !desc.EndKey.Less(endKey) {
+			if desc == nil && key.Less(endKey) {
+				reply.ResumeSpan = &proto.Span{Key: key, EndKey: endKey}
+			}
+			return
+		}
+		if limitReached() {
+			reply.ResumeSpan = &proto.Span{Key: desc.EndKey, EndKey: endKey}
+			return
+		}
+		key = desc.EndKey
+	}
+}
+
 // updateLeaderCache updates the cached leader for the given Raft group,
 // evicting any previous value in the process.
 // The new leader is cached only if it isn't equal to the newly evicted value.
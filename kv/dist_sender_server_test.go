@@ -18,16 +18,19 @@
 package kv_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"golang.org/x/net/context"
 )
 
 // NOTE: these tests are in package kv_test to avoid a circular
@@ -50,7 +53,7 @@ func TestRangeLookupWithOpenTransaction(t *testing.T) {
 
 	// Create an intent on the meta1 record by writing directly to the
 	// engine.
-	key := engine.MakeKey(engine.KeyMeta1Prefix, engine.KeyMax)
+	key := keys.MakeKey(keys.KeyMeta1Prefix, keys.KeyMax)
 	now := s.Clock().Now()
 	txn := proto.NewTransaction("txn", proto.Key("foobar"), 0, proto.SERIALIZABLE, now, 0)
 	if err := engine.MVCCPutProto(s.Engine, nil, key, now, txn, &proto.RangeDescriptor{}); err != nil {
@@ -161,7 +164,7 @@ func TestMultiRangeScanInconsistent(t *testing.T) {
 	sa := call.Args.(*proto.ScanRequest)
 	sa.ReadConsistency = proto.INCONSISTENT
 	sa.User = storage.UserRoot
-	ds.Send(call)
+	ds.Send(context.Background(), call)
 	if err := sr.GoError(); err != nil {
 		t.Fatal(err)
 	}
@@ -172,3 +175,45 @@ func TestMultiRangeScanInconsistent(t *testing.T) {
 		t.Errorf("expected key %q; got %q", keys[0], key)
 	}
 }
+
+// TestMultiRangeScanHistorical verifies that a scan across ranges at
+// a historical timestamp runs without a transaction and returns the
+// values as of that timestamp.
+func TestMultiRangeScanHistorical(t *testing.T) {
+	s, db := setupMultipleRanges(t)
+	defer s.Stop()
+
+	// Write keys "a" and "b", then overwrite "a".
+	var ts proto.Timestamp
+	for i, key := range []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("a")} {
+		call := client.PutCall(key, []byte(fmt.Sprintf("value%d", i)))
+		if err := db.Run(call); err != nil {
+			t.Fatal(err)
+		}
+		if i == 1 {
+			ts = call.Reply.(*proto.PutResponse).Timestamp
+		}
+	}
+
+	call := client.ScanCall(proto.Key("a"), proto.Key("c"), 0)
+	sr := call.Reply.(*proto.ScanResponse)
+	if err := client.KVAt(db, ts).Run(call); err != nil {
+		t.Fatalf("unexpected error on scan: %s", err)
+	}
+	if sr.Txn != nil {
+		t.Errorf("expected scan without a transaction; got %s", sr.Txn)
+	}
+	if l := len(sr.Rows); l != 2 {
+		t.Fatalf("expected 2 rows; got %d", l)
+	}
+	for i, exp := range []string{"value0", "value1"} {
+		if v := string(sr.Rows[i].Value.Bytes); v != exp {
+			t.Errorf("%d: expected %q; got %q", i, exp, v)
+		}
+	}
+
+	// Writes are rejected.
+	if err := client.KVAt(db, ts).Run(client.PutCall(proto.Key("a"), nil)); err == nil {
+		t.Error("expected historical write to fail")
+	}
+}
@@ -23,18 +23,21 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/gossip/simulation"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/storage"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 var testRangeDescriptor = proto.RangeDescriptor{
@@ -61,14 +64,15 @@ func makeTestGossip(t *testing.T) *gossip.Gossip {
 	}
 
 	configMap, err := storage.NewPrefixConfigMap([]*storage.PrefixConfig{
-		{engine.KeyMin, nil, permConfig},
+		{keys.KeyMin, nil, permConfig},
 	})
 	if err != nil {
 		t.Fatalf("failed to make prefix config map, err: %s", err.Error())
 	}
 	g.AddInfo(gossip.KeySentinel, "cluster1", time.Hour)
-	g.AddInfo(gossip.KeyConfigPermission, configMap, time.Hour)
-	g.AddInfo(gossip.KeyFirstRangeDescriptor, testRangeDescriptor, time.Hour)
+	g.AddInfo(gossip.KeySystemConfig, storage.SystemConfig{Permissions: configMap}, time.Hour)
+	g.AddInfo(gossip.KeyFirstRangeDescriptor,
+		storage.FirstRangeDescriptor{Desc: testRangeDescriptor}, time.Hour)
 	nodeIDKey := gossip.MakeNodeIDKey(1)
 	g.AddInfo(nodeIDKey, &gossip.NodeDescriptor{
 		NodeID:  1,
@@ -128,7 +132,8 @@ func TestSendRPCOrder(t *testing.T) {
 		// Would really want CONSENSUS here, but that is not implemented.
 		// Likely a test setup here will never have a read lease, but good
 		// to keep in mind.
-		consistent bool
+		consistent   bool
+		followerRead bool
 	}{
 		// Inconsistent Scan without matching attributes.
 		{
@@ -181,6 +186,28 @@ func TestSendRPCOrder(t *testing.T) {
 			fn:     makeVerifier(rpc.OrderStable, []int32{2, 5, 4, 0, 0}),
 			leader: 2,
 		},
+
+		// Follower read with matching attributes and a known leader.
+		// Should address the local node first, followed by the two nodes
+		// matching the attributes, ignoring the leader.
+		{
+			args:         &proto.ScanRequest{},
+			attrs:        nodeAttrs[5],
+			fn:           makeVerifier(rpc.OrderStable, []int32{1, 5, 4, 0, 0}),
+			leader:       2,
+			consistent:   true,
+			followerRead: true,
+		},
+		// Follower read without matching attributes. Should address the
+		// local node first.
+		{
+			args:         &proto.ScanRequest{},
+			attrs:        []string{},
+			fn:           makeVerifier(rpc.OrderStable, []int32{1, 0, 0, 0, 0}),
+			leader:       2,
+			consistent:   true,
+			followerRead: true,
+		},
 	}
 
 	// Stub to be changed in each test case.
@@ -242,7 +269,7 @@ func TestSendRPCOrder(t *testing.T) {
 		}
 		// Kill the cached NodeDescriptor, enforcing a lookup from Gossip.
 		ds.nodeDescriptor = nil
-		if err := ds.sendRPC(&descriptor, args, call.Reply); err != nil {
+		if err := ds.sendRPC(context.Background(), &descriptor, args, call.Reply, tc.followerRead); err != nil {
 			t.Errorf("%d: %s", n, err)
 		}
 	}
@@ -282,7 +309,7 @@ func TestRetryOnNotLeaderError(t *testing.T) {
 	ds := NewDistSender(ctx, g)
 	call := client.PutCall(proto.Key("a"), []byte("value"))
 	reply := call.Reply.(*proto.PutResponse)
-	ds.Send(call)
+	ds.Send(context.Background(), call)
 	if err := reply.GoError(); err != nil {
 		t.Errorf("put encountered error: %s", err)
 	}
@@ -299,6 +326,61 @@ func TestRetryOnNotLeaderError(t *testing.T) {
 	}
 }
 
+// testAmbiguousError is a retryable RPC error after which the RPC may
+// have been executed.
+type testAmbiguousError struct{}
+
+func (testAmbiguousError) Error() string   { return "connection reset" }
+func (testAmbiguousError) CanRetry() bool  { return true }
+func (testAmbiguousError) Ambiguous() bool { return true }
+
+// TestAmbiguousResultError verifies that a write whose RPCs failed
+// after being sent fails with an AmbiguousResultError once the
+// DistSender gives up, unless a replica replied to a retry.
+func TestAmbiguousResultError(t *testing.T) {
+	g := makeTestGossip(t)
+	testCases := []struct {
+		call         client.Call
+		retryErr     error // the error replied to the retry, if any
+		expAmbiguous bool
+		expErr       bool
+	}{
+		{client.PutCall(proto.Key("a"), []byte("value")), nil, true, true},
+		{client.GetCall(proto.Key("a")), nil, false, true},
+		{client.PutCall(proto.Key("a"), []byte("value")), &proto.ConditionFailedError{}, false, true},
+		{client.PutCall(proto.Key("a"), []byte("value")), testAmbiguousError{}, false, false},
+	}
+	for i, test := range testCases {
+		var attempts int
+		var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+			attempts++
+			if attempts == 1 || test.retryErr == nil {
+				return nil, testAmbiguousError{}
+			}
+			if _, ok := test.retryErr.(testAmbiguousError); !ok {
+				getReply().(proto.Response).Header().SetGoError(test.retryErr)
+			}
+			return nil, nil
+		}
+		ctx := &DistSenderContext{
+			rpcSend:         testFn,
+			RPCRetryOptions: &util.RetryOptions{Backoff: time.Millisecond, MaxAttempts: 2},
+			rangeDescriptorDB: mockRangeDescriptorDB(func(_ proto.Key) ([]proto.RangeDescriptor, error) {
+				return []proto.RangeDescriptor{testRangeDescriptor}, nil
+			}),
+		}
+		ds := NewDistSender(ctx, g)
+		ds.Send(context.Background(), test.call)
+		err := test.call.Reply.Header().GoError()
+		if (err != nil) != test.expErr {
+			t.Errorf("%d: expected error=%t; got %v", i, test.expErr, err)
+		}
+		if _, ok := err.(*proto.AmbiguousResultError); ok != test.expAmbiguous {
+			t.Errorf("%d: expected ambiguous=%t; got %v", i, test.expAmbiguous, err)
+		}
+	}
+}
+
 // TestRetryOnWrongReplicaError sets up a DistSender on a minimal gossip
 // network and a mock of rpc.Send, and verifies that the DistSender correctly
 // retries upon encountering a stale entry in its range descriptor cache.
@@ -314,14 +396,14 @@ func TestRetryOnWrongReplicaError(t *testing.T) {
 		if method == "Node.InternalRangeLookup" {
 			// If the non-broken descriptor has already been returned, that's
 			// an error.
-			if !descStale && bytes.HasPrefix(header.Key, engine.KeyMeta2Prefix) {
+			if !descStale && bytes.HasPrefix(header.Key, keys.KeyMeta2Prefix) {
 				t.Errorf("unexpected extra lookup for non-stale replica descriptor at %s",
 					header.Key)
 			}
 
 			r := getReply().(*proto.InternalRangeLookupResponse)
 			// The fresh descriptor is about to be returned.
-			if bytes.HasPrefix(header.Key, engine.KeyMeta2Prefix) &&
+			if bytes.HasPrefix(header.Key, keys.KeyMeta2Prefix) &&
 				newRangeDescriptor.StartKey.Equal(newEndKey) {
 				descStale = false
 			}
@@ -345,7 +427,7 @@ func TestRetryOnWrongReplicaError(t *testing.T) {
 	ds := NewDistSender(ctx, g)
 	call := client.ScanCall(proto.Key("a"), proto.Key("d"), 0)
 	sr := call.Reply.(*proto.ScanResponse)
-	ds.Send(call)
+	ds.Send(context.Background(), call)
 	if err := sr.GoError(); err != nil {
 		t.Errorf("scan encountered error: %s", err)
 	}
@@ -365,7 +447,7 @@ func TestGetFirstRangeDescriptor(t *testing.T) {
 	// this dist sender and ensure that this dist sender has the
 	// information within a given time.
 	n.Nodes[1].Gossip.AddInfo(
-		gossip.KeyFirstRangeDescriptor, *expectedDesc, time.Hour)
+		gossip.KeyFirstRangeDescriptor, storage.FirstRangeDescriptor{Desc: *expectedDesc}, time.Hour)
 	maxCycles := 10
 	n.SimulateNetwork(func(cycle int, network *simulation.Network) bool {
 		desc, err := ds.getFirstRangeDescriptor()
@@ -386,12 +468,403 @@ func TestGetFirstRangeDescriptor(t *testing.T) {
 	n.Stop()
 }
 
+// TestFirstRangeReplicaGossip verifies that the first range descriptors
+// gossiped by individual replicas are only used if their sequence isn't
+// lower than that of the descriptor already known.
+func TestFirstRangeReplicaGossip(t *testing.T) {
+	ds := NewDistSender(nil, nil)
+	for i, test := range []struct {
+		seq    uint64
+		endKey proto.Key
+		expKey proto.Key
+	}{
+		{5, proto.Key("m"), proto.Key("m")},
+		{3, proto.Key("c"), proto.Key("m")}, // stale replica is ignored
+		{5, proto.Key("n"), proto.Key("n")},
+		{7, proto.Key("z"), proto.Key("z")},
+	} {
+		ds.firstRangeReplicaGossipUpdate(gossip.MakeFirstRangeReplicaKey(proto.StoreID(i+1)),
+			storage.FirstRangeDescriptor{
+				Desc:     proto.RangeDescriptor{RaftID: 1, EndKey: test.endKey},
+				Sequence: test.seq,
+			})
+		desc, err := ds.getFirstRangeDescriptor()
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !desc.EndKey.Equal(test.expKey) {
+			t.Errorf("%d: expected first range to end at %q; got %q", i, test.expKey, desc.EndKey)
+		}
+	}
+
+	// The leader's descriptor raises the sequence as well, so a lagging
+	// replica can't replace it.
+	ds.firstRangeGossipUpdate(gossip.KeyFirstRangeDescriptor, storage.FirstRangeDescriptor{
+		Desc:     proto.RangeDescriptor{RaftID: 1, EndKey: proto.Key("x")},
+		Sequence: 9,
+	})
+	ds.firstRangeReplicaGossipUpdate(gossip.MakeFirstRangeReplicaKey(1), storage.FirstRangeDescriptor{
+		Desc:     proto.RangeDescriptor{RaftID: 1, EndKey: proto.Key("z")},
+		Sequence: 7,
+	})
+	desc, err := ds.getFirstRangeDescriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expKey := proto.Key("x"); !desc.EndKey.Equal(expKey) {
+		t.Errorf("expected first range to end at %q; got %q", expKey, desc.EndKey)
+	}
+}
+
+// TestFirstRangeDescriptorFallback verifies that the DistSender asks the
+// nodes known via gossip for the first range descriptor if it hasn't
+// been gossiped, and that the result seeds the first range descriptor.
+func TestFirstRangeDescriptorFallback(t *testing.T) {
+	n := simulation.NewNetwork(1, "unix", gossip.TestInterval)
+	defer n.Stop()
+	g := n.Nodes[0].Gossip
+	addrs := []net.Addr{
+		util.MakeRawAddr("tcp", "node1:8080"),
+		util.MakeRawAddr("tcp", "node2:8080"),
+	}
+	for i, addr := range addrs {
+		nodeID := proto.NodeID(i + 1)
+		if err := g.AddInfo(gossip.MakeNodeIDKey(nodeID),
+			&gossip.NodeDescriptor{NodeID: nodeID, Address: addr}, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expDesc := proto.RangeDescriptor{RaftID: 1, StartKey: keys.KeyMin, EndKey: proto.Key("m")}
+
+	lookups := 0
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		lookups++
+		if method != "Node.InternalRangeLookup" {
+			t.Fatalf("unexpected method %s", method)
+		}
+		args := getArgs(addrs[0]).(*proto.InternalRangeLookupRequest)
+		if !args.Key.Equal(keys.KeyMeta1Prefix) || args.RaftID != 0 || args.Replica.StoreID != 0 {
+			t.Errorf("unexpected lookup header %+v", args.RequestHeader)
+		}
+		reply := getReply().(*proto.InternalRangeLookupResponse)
+		// Only the second node has a replica of the first range.
+		if addrs[0].String() == "node1:8080" {
+			reply.SetGoError(proto.NewRangeKeyMismatchError(args.Key, args.EndKey, nil))
+		} else {
+			reply.Ranges = []proto.RangeDescriptor{expDesc}
+		}
+		return nil, nil
+	}
+	ds := NewDistSender(&DistSenderContext{rpcSend: testFn}, g)
+	if _, err := ds.getFirstRangeDescriptor(); err == nil {
+		t.Fatal("expected first range descriptor to be missing from gossip")
+	}
+	desc, err := ds.lookupFirstRangeDescriptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*desc, expDesc) {
+		t.Errorf("expected first range descriptor %+v; got %+v", expDesc, *desc)
+	}
+	if lookups == 0 || lookups > len(addrs) {
+		t.Errorf("expected between 1 and %d lookups; got %d", len(addrs), lookups)
+	}
+
+	// The looked up descriptor is used from now on.
+	lookups = 0
+	if _, err := ds.lookupFirstRangeDescriptor(); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 0 {
+		t.Errorf("expected the seeded descriptor to be used; got %d lookups", lookups)
+	}
+}
+
+// TestIsFollowerRead verifies which reads are sent to the nearest
+// replica.
+func TestIsFollowerRead(t *testing.T) {
+	manual := hlc.NewManualClock(int64(time.Minute))
+	ds := NewDistSender(&DistSenderContext{
+		Clock:              hlc.NewClock(manual.UnixNano),
+		ClosedTimestampLag: 10 * time.Second,
+	}, nil)
+	old := proto.Timestamp{WallTime: int64(50 * time.Second)}
+	recent := proto.Timestamp{WallTime: int64(55 * time.Second)}
+	txn := &proto.Transaction{Timestamp: old}
+
+	testCases := []struct {
+		args        proto.Request
+		consistency proto.ReadConsistencyType
+		txn         *proto.Transaction
+		expected    bool
+	}{
+		{&proto.GetRequest{RequestHeader: proto.RequestHeader{Timestamp: old}}, proto.CONSISTENT, nil, true},
+		{&proto.ScanRequest{RequestHeader: proto.RequestHeader{Timestamp: old}}, proto.CONSISTENT, nil, true},
+		{&proto.GetRequest{RequestHeader: proto.RequestHeader{Timestamp: recent}}, proto.CONSISTENT, nil, false},
+		{&proto.GetRequest{}, proto.CONSISTENT, nil, false},
+		{&proto.GetRequest{RequestHeader: proto.RequestHeader{Timestamp: old}}, proto.INCONSISTENT, nil, false},
+		{&proto.GetRequest{RequestHeader: proto.RequestHeader{Timestamp: old}}, proto.CONSISTENT, txn, false},
+		{&proto.PutRequest{RequestHeader: proto.RequestHeader{Timestamp: old}}, proto.CONSISTENT, nil, false},
+	}
+	for i, test := range testCases {
+		test.args.Header().ReadConsistency = test.consistency
+		test.args.Header().Txn = test.txn
+		if followerRead := ds.isFollowerRead(test.args); followerRead != test.expected {
+			t.Errorf("%d: expected follower read %t; got %t", i, test.expected, followerRead)
+		}
+	}
+
+	// Without a lag, there are no follower reads.
+	ds.closedTimestampLag = 0
+	if ds.isFollowerRead(testCases[0].args) {
+		t.Error("expected no follower reads without a closed timestamp lag")
+	}
+}
+
+// TestFollowerReadRetriesOnLeader verifies that a follower read which
+// the replica can't serve is retried on the leader.
+func TestFollowerReadRetriesOnLeader(t *testing.T) {
+	g := makeTestGossip(t)
+	var followerReads []bool
+	var testFn rpcSendFn = func(opts rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		// Follower reads are sent with a stable order to the local replica
+		// first, requests to the leader randomly as the leader is unknown.
+		followerReads = append(followerReads, opts.Ordering == rpc.OrderStable)
+		if len(followerReads) == 1 {
+			getReply().(proto.Response).Header().SetGoError(&proto.NotLeaderError{})
+		}
+		return nil, nil
+	}
+	manual := hlc.NewManualClock(int64(time.Minute))
+	ds := NewDistSender(&DistSenderContext{
+		Clock:              hlc.NewClock(manual.UnixNano),
+		ClosedTimestampLag: 10 * time.Second,
+		nodeDescriptor:     &gossip.NodeDescriptor{NodeID: 1},
+		rpcSend:            testFn,
+		rangeDescriptorDB: mockRangeDescriptorDB(func(_ proto.Key) ([]proto.RangeDescriptor, error) {
+			return []proto.RangeDescriptor{testRangeDescriptor}, nil
+		}),
+	}, g)
+	call := client.GetCall(proto.Key("a"))
+	call.Args.Header().Timestamp = proto.Timestamp{WallTime: int64(30 * time.Second)}
+	ds.Send(context.Background(), call)
+	if err := call.Reply.Header().GoError(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(followerReads, []bool{true, false}) {
+		t.Errorf("expected a follower read followed by a leader read; got %v", followerReads)
+	}
+	// The failed follower read doesn't affect the leader cache.
+	if l := ds.leaderCache.Lookup(proto.RaftID(testRangeDescriptor.RaftID)); l != nil {
+		t.Errorf("expected no cached leader; got %+v", l)
+	}
+}
+
+// TestScanResumeSpan verifies that scans spanning ranges stop once
+// their row or byte limit is reached and return the remainder of the
+// span as their resume span.
+func TestScanResumeSpan(t *testing.T) {
+	g := makeTestGossip(t)
+	descs := []proto.RangeDescriptor{testRangeDescriptor, testRangeDescriptor}
+	descs[0].EndKey = proto.Key("c").Next()
+	descs[1].RaftID = 2
+	descs[1].StartKey = descs[0].EndKey
+	data := []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("c"), proto.Key("m"), proto.Key("n")}
+
+	// testFn scans the keys in data the way a range would.
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		args := getArgs(testAddress).(*proto.ScanRequest)
+		reply := getReply().(*proto.ScanResponse)
+		var numBytes int64
+		for _, key := range data {
+			if key.Less(args.Key) || !key.Less(args.EndKey) {
+				continue
+			}
+			kv := proto.KeyValue{Key: key, Value: proto.Value{Bytes: []byte("value")}}
+			reply.Rows = append(reply.Rows, kv)
+			numBytes += int64(kv.Size())
+			if (args.MaxResults != 0 && int64(len(reply.Rows)) == args.MaxResults) ||
+				(args.TargetBytes != 0 && numBytes >= args.TargetBytes) {
+				if next := key.Next(); next.Less(args.EndKey) {
+					reply.ResumeSpan = &proto.Span{Key: next, EndKey: args.EndKey}
+				}
+				break
+			}
+		}
+		return nil, nil
+	}
+
+	testCases := []struct {
+		maxResults, targetBytes int64
+		expRows                 int
+		expResume               *proto.Span
+	}{
+		{0, 0, 5, nil},
+		{2, 0, 2, &proto.Span{Key: proto.Key("b").Next(), EndKey: proto.Key("z")}},
+		// The limit runs out at the end of the first range.
+		{3, 0, 3, &proto.Span{Key: proto.Key("c").Next(), EndKey: proto.Key("z")}},
+		{4, 0, 4, &proto.Span{Key: proto.Key("m").Next(), EndKey: proto.Key("z")}},
+		{5, 0, 5, &proto.Span{Key: proto.Key("n").Next(), EndKey: proto.Key("z")}},
+		{6, 0, 5, nil},
+		// At least one row is returned, however small the target.
+		{0, 1, 1, &proto.Span{Key: proto.Key("a").Next(), EndKey: proto.Key("z")}},
+		{2, 1, 1, &proto.Span{Key: proto.Key("a").Next(), EndKey: proto.Key("z")}},
+	}
+	for i, test := range testCases {
+		ds := NewDistSender(&DistSenderContext{
+			Clock:   hlc.NewClock(hlc.UnixNano),
+			rpcSend: testFn,
+			rangeDescriptorDB: mockRangeDescriptorDB(func(key proto.Key) ([]proto.RangeDescriptor, error) {
+				if key.Less(descs[1].StartKey) {
+					return descs[:1], nil
+				}
+				return descs[1:], nil
+			}),
+		}, g)
+		// Cross-range scans outside a transaction must read at a fixed
+		// timestamp.
+		call := client.ScanCall(proto.Key("a"), proto.Key("z"), test.maxResults)
+		call.Args.(*proto.ScanRequest).TargetBytes = test.targetBytes
+		call.Args.Header().Timestamp = ds.clock.Now()
+		ds.Send(context.Background(), call)
+		reply := call.Reply.(*proto.ScanResponse)
+		if err := reply.GoError(); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if len(reply.Rows) != test.expRows {
+			t.Errorf("%d: expected %d rows; got %d", i, test.expRows, len(reply.Rows))
+		}
+		if !reflect.DeepEqual(reply.ResumeSpan, test.expResume) {
+			t.Errorf("%d: expected resume span %+v; got %+v", i, test.expResume, reply.ResumeSpan)
+		}
+	}
+}
+
+// TestScanStream verifies that streamed scans pass the rows of all
+// ranges to the caller in order, resume a range which fails part way
+// through after the last row received, and stop at their limits.
+func TestScanStream(t *testing.T) {
+	g := makeTestGossip(t)
+	descs := []proto.RangeDescriptor{testRangeDescriptor, testRangeDescriptor}
+	descs[0].EndKey = proto.Key("c").Next()
+	descs[1].RaftID = 2
+	descs[1].StartKey = descs[0].EndKey
+	data := []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("c"), proto.Key("m"), proto.Key("n")}
+
+	var starts []string
+	var failAt proto.Key
+	// testFn streams the keys in data one row per chunk, the way
+	// Node.ScanStream would, failing after sending failAt.
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		if method != "Node.ScanStream" || len(addrs) != 1 {
+			t.Errorf("unexpected %s to %d replicas", method, len(addrs))
+		}
+		args := getArgs(testAddress).(*proto.ScanStreamRequest)
+		reply := getReply().(*proto.ScanResponse)
+		starts = append(starts, string(args.Key))
+		var n int64
+		for _, key := range data {
+			if key.Less(args.Key) || !key.Less(args.EndKey) {
+				continue
+			}
+			args.OnChunk(&proto.ScanResponse{Rows: []proto.KeyValue{{Key: key}}})
+			if key.Equal(failAt) {
+				failAt = nil
+				reply.SetGoError(&proto.NotLeaderError{})
+				return nil, nil
+			}
+			if n++; args.MaxResults != 0 && n == args.MaxResults {
+				if next := key.Next(); next.Less(args.EndKey) {
+					reply.ResumeSpan = &proto.Span{Key: next, EndKey: args.EndKey}
+				}
+				break
+			}
+		}
+		return nil, nil
+	}
+
+	testCases := []struct {
+		maxResults int64
+		failAt     string
+		expStarts  []string
+		expRows    int
+		expResume  *proto.Span
+	}{
+		{0, "", []string{"a", "c\x00"}, 5, nil},
+		{0, "a", []string{"a", "a\x00", "c\x00"}, 5, nil},
+		// The retry after the last row of a range finds the next range.
+		{0, "c", []string{"a", "c\x00"}, 5, nil},
+		{2, "", []string{"a"}, 2, &proto.Span{Key: proto.Key("b").Next(), EndKey: proto.Key("z")}},
+		{3, "", []string{"a"}, 3, &proto.Span{Key: proto.Key("c").Next(), EndKey: proto.Key("z")}},
+		{4, "a", []string{"a", "a\x00", "c\x00"}, 4, &proto.Span{Key: proto.Key("m").Next(), EndKey: proto.Key("z")}},
+	}
+	for i, test := range testCases {
+		starts = nil
+		if test.failAt != "" {
+			failAt = proto.Key(test.failAt)
+		}
+		ds := NewDistSender(&DistSenderContext{
+			Clock:   hlc.NewClock(hlc.UnixNano),
+			rpcSend: testFn,
+			rangeDescriptorDB: mockRangeDescriptorDB(func(key proto.Key) ([]proto.RangeDescriptor, error) {
+				if key.Less(descs[1].StartKey) {
+					return descs[:1], nil
+				}
+				return descs[1:], nil
+			}),
+		}, g)
+		var rows []proto.KeyValue
+		call := client.ScanCall(proto.Key("a"), proto.Key("z"), test.maxResults)
+		ds.ScanStream(context.Background(), call, func(chunk []proto.KeyValue) error {
+			rows = append(rows, chunk...)
+			return nil
+		})
+		reply := call.Reply.(*proto.ScanResponse)
+		if err := reply.GoError(); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(starts, test.expStarts) {
+			t.Errorf("%d: expected scans from %q; got %q", i, test.expStarts, starts)
+		}
+		if len(rows) != test.expRows {
+			t.Fatalf("%d: expected %d rows; got %d", i, test.expRows, len(rows))
+		}
+		for j, row := range rows {
+			if !row.Key.Equal(data[j]) {
+				t.Errorf("%d: expected row %d to be %q; got %q", i, j, data[j], row.Key)
+			}
+		}
+		if !reflect.DeepEqual(reply.ResumeSpan, test.expResume) {
+			t.Errorf("%d: expected resume span %+v; got %+v", i, test.expResume, reply.ResumeSpan)
+		}
+	}
+
+	// An error returned by the caller stops the scan.
+	starts = nil
+	ds := NewDistSender(&DistSenderContext{
+		Clock:   hlc.NewClock(hlc.UnixNano),
+		rpcSend: testFn,
+		rangeDescriptorDB: mockRangeDescriptorDB(func(key proto.Key) ([]proto.RangeDescriptor, error) {
+			return descs[:1], nil
+		}),
+	}, g)
+	call := client.ScanCall(proto.Key("a"), proto.Key("z"), 0)
+	ds.ScanStream(context.Background(), call, func(chunk []proto.KeyValue) error {
+		return util.Errorf("stop")
+	})
+	if err := call.Reply.Header().GoError(); err == nil || !strings.HasSuffix(err.Error(), "stop") {
+		t.Errorf("expected the caller's error; got %v", err)
+	}
+	if len(starts) != 1 {
+		t.Errorf("expected a single scan; got %q", starts)
+	}
+}
+
 // TestVerifyPermissions verifies permissions are checked for single
 // zones and across multiple zones. It also verifies that permissions
 // are checked hierarchically.
 func TestVerifyPermissions(t *testing.T) {
 	n := simulation.NewNetwork(1, "unix", gossip.TestInterval)
-	ds := NewDistSender(nil, n.Nodes[0].Gossip)
 	config1 := &proto.PermConfig{
 		Read:  []string{"read1", "readAll", "rw1", "rwAll"},
 		Write: []string{"write1", "writeAll", "rw1", "rwAll"}}
@@ -399,14 +872,17 @@ func TestVerifyPermissions(t *testing.T) {
 		Read:  []string{"read2", "readAll", "rw2", "rwAll"},
 		Write: []string{"write2", "writeAll", "rw2", "rwAll"}}
 	configs := []*storage.PrefixConfig{
-		{engine.KeyMin, nil, config1},
+		{keys.KeyMin, nil, config1},
 		{proto.Key("a"), nil, config2},
 	}
 	configMap, err := storage.NewPrefixConfigMap(configs)
 	if err != nil {
 		t.Fatalf("failed to make prefix config map, err: %s", err.Error())
 	}
-	ds.gossip.AddInfo(gossip.KeyConfigPermission, configMap, time.Hour)
+	n.Nodes[0].Gossip.AddInfo(gossip.KeySystemConfig, storage.SystemConfig{Permissions: configMap}, time.Hour)
+	// The permissions gossiped before the DistSender is created are
+	// available to it immediately.
+	ds := NewDistSender(nil, n.Nodes[0].Gossip)
 
 	allRequestTypes := []proto.Request{
 		&proto.ContainsRequest{},
@@ -416,11 +892,17 @@ func TestVerifyPermissions(t *testing.T) {
 		&proto.IncrementRequest{},
 		&proto.DeleteRequest{},
 		&proto.DeleteRangeRequest{},
+		&proto.ClearRangeRequest{},
 		&proto.ScanRequest{},
+		&proto.WatchRequest{},
 		&proto.EndTransactionRequest{},
 		&proto.BatchRequest{},
 		&proto.AdminSplitRequest{},
 		&proto.AdminMergeRequest{},
+		&proto.AdminBulkLoadRequest{},
+		&proto.AdminTransferLeaseRequest{},
+		&proto.AdminBackupRequest{},
+		&proto.AdminRestoreRequest{},
 		&proto.InternalHeartbeatTxnRequest{},
 		&proto.InternalGCRequest{},
 		&proto.InternalPushTxnRequest{},
@@ -429,6 +911,9 @@ func TestVerifyPermissions(t *testing.T) {
 		&proto.InternalMergeRequest{},
 		&proto.InternalTruncateLogRequest{},
 		&proto.InternalLeaderLeaseRequest{},
+		&proto.InternalCheckConsistencyRequest{},
+		&proto.InternalRecomputeStatsRequest{},
+		&proto.InternalRefreshRangeRequest{},
 	}
 
 	var readOnlyRequests []proto.Request
@@ -455,33 +940,33 @@ func TestVerifyPermissions(t *testing.T) {
 		hasPermission    bool
 	}{
 		// Test permissions within a single range
-		{readOnlyRequests, "read1", engine.KeyMin, engine.KeyMin, true},
-		{readOnlyRequests, "rw1", engine.KeyMin, engine.KeyMin, true},
-		{readOnlyRequests, "write1", engine.KeyMin, engine.KeyMin, false},
-		{readOnlyRequests, "random", engine.KeyMin, engine.KeyMin, false},
-		{readWriteRequests, "rw1", engine.KeyMin, engine.KeyMin, true},
-		{readWriteRequests, "read1", engine.KeyMin, engine.KeyMin, false},
-		{readWriteRequests, "write1", engine.KeyMin, engine.KeyMin, false},
-		{writeOnlyRequests, "write1", engine.KeyMin, engine.KeyMin, true},
-		{writeOnlyRequests, "rw1", engine.KeyMin, engine.KeyMin, true},
-		{writeOnlyRequests, "read1", engine.KeyMin, engine.KeyMin, false},
-		{writeOnlyRequests, "random", engine.KeyMin, engine.KeyMin, false},
+		{readOnlyRequests, "read1", keys.KeyMin, keys.KeyMin, true},
+		{readOnlyRequests, "rw1", keys.KeyMin, keys.KeyMin, true},
+		{readOnlyRequests, "write1", keys.KeyMin, keys.KeyMin, false},
+		{readOnlyRequests, "random", keys.KeyMin, keys.KeyMin, false},
+		{readWriteRequests, "rw1", keys.KeyMin, keys.KeyMin, true},
+		{readWriteRequests, "read1", keys.KeyMin, keys.KeyMin, false},
+		{readWriteRequests, "write1", keys.KeyMin, keys.KeyMin, false},
+		{writeOnlyRequests, "write1", keys.KeyMin, keys.KeyMin, true},
+		{writeOnlyRequests, "rw1", keys.KeyMin, keys.KeyMin, true},
+		{writeOnlyRequests, "read1", keys.KeyMin, keys.KeyMin, false},
+		{writeOnlyRequests, "random", keys.KeyMin, keys.KeyMin, false},
 		// Test permissions hierarchically.
 		{readOnlyRequests, "read1", proto.Key("a"), proto.Key("a1"), true},
 		{readWriteRequests, "rw1", proto.Key("a"), proto.Key("a1"), true},
 		{writeOnlyRequests, "write1", proto.Key("a"), proto.Key("a1"), true},
 		// Test permissions across both ranges.
-		{readOnlyRequests, "readAll", engine.KeyMin, proto.Key("b"), true},
-		{readOnlyRequests, "read1", engine.KeyMin, proto.Key("b"), true},
-		{readOnlyRequests, "read2", engine.KeyMin, proto.Key("b"), false},
-		{readOnlyRequests, "random", engine.KeyMin, proto.Key("b"), false},
-		{readWriteRequests, "rwAll", engine.KeyMin, proto.Key("b"), true},
-		{readWriteRequests, "rw1", engine.KeyMin, proto.Key("b"), true},
-		{readWriteRequests, "random", engine.KeyMin, proto.Key("b"), false},
-		{writeOnlyRequests, "writeAll", engine.KeyMin, proto.Key("b"), true},
-		{writeOnlyRequests, "write1", engine.KeyMin, proto.Key("b"), true},
-		{writeOnlyRequests, "write2", engine.KeyMin, proto.Key("b"), false},
-		{writeOnlyRequests, "random", engine.KeyMin, proto.Key("b"), false},
+		{readOnlyRequests, "readAll", keys.KeyMin, proto.Key("b"), true},
+		{readOnlyRequests, "read1", keys.KeyMin, proto.Key("b"), true},
+		{readOnlyRequests, "read2", keys.KeyMin, proto.Key("b"), false},
+		{readOnlyRequests, "random", keys.KeyMin, proto.Key("b"), false},
+		{readWriteRequests, "rwAll", keys.KeyMin, proto.Key("b"), true},
+		{readWriteRequests, "rw1", keys.KeyMin, proto.Key("b"), true},
+		{readWriteRequests, "random", keys.KeyMin, proto.Key("b"), false},
+		{writeOnlyRequests, "writeAll", keys.KeyMin, proto.Key("b"), true},
+		{writeOnlyRequests, "write1", keys.KeyMin, proto.Key("b"), true},
+		{writeOnlyRequests, "write2", keys.KeyMin, proto.Key("b"), false},
+		{writeOnlyRequests, "random", keys.KeyMin, proto.Key("b"), false},
 		// Test permissions within and around the boundaries of a range,
 		// representatively using rw methods.
 		{readWriteRequests, "rw2", proto.Key("a"), proto.Key("b"), true},
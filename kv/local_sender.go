@@ -25,6 +25,7 @@ import (
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
+	"golang.org/x/net/context"
 )
 
 // A LocalSender provides methods to access a collection of local stores.
@@ -103,7 +104,8 @@ func (ls *LocalSender) VisitStores(visitor func(s *storage.Store) error) error {
 // up from the store map if specified by header.Replica; otherwise,
 // the command is being executed locally, and the replica is
 // determined via lookup through each store's LookupRange method.
-func (ls *LocalSender) Send(call client.Call) {
+// The command is abandoned if ctx is done before it executes.
+func (ls *LocalSender) Send(ctx context.Context, call client.Call) {
 	var err error
 	var store *storage.Store
 
@@ -134,7 +136,7 @@ func (ls *LocalSender) Send(call client.Call) {
 			// MaxTimestamp = Timestamp corresponds to no clock uncertainty.
 			header.Txn.MaxTimestamp = header.Txn.Timestamp
 		}
-		store.ExecuteCmd(call.Args, call.Reply)
+		store.ExecuteCmd(ctx, call.Args, call.Reply)
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
@@ -146,7 +147,7 @@ func TestLocalSenderLookupReplica(t *testing.T) {
 	if err := store.Start(stopper); err != nil {
 		t.Fatal(err)
 	}
-	rng := splitTestRange(store, engine.KeyMin, proto.Key("a"), t)
+	rng := splitTestRange(store, keys.KeyMin, proto.Key("a"), t)
 	// Make sure to wait for elections before removing; see #702.
 	// TODO(tschottdorf) maybe remove once #702 closes.
 	rng.WaitForElection()
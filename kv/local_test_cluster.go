@@ -32,6 +32,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // retryableLocalSender provides a retry option in the event of range
@@ -52,7 +53,7 @@ func newRetryableLocalSender(lSender *LocalSender) *retryableLocalSender {
 }
 
 // Send implements the client.Sender interface.
-func (rls *retryableLocalSender) Send(call client.Call) {
+func (rls *retryableLocalSender) Send(ctx context.Context, call client.Call) {
 	// Instant retry with max two attempts to handle the case of a
 	// range split, which is exposed here as a RangeKeyMismatchError.
 	// If we fail with two in a row, it's a fatal test error.
@@ -68,7 +69,7 @@ func (rls *retryableLocalSender) Send(call client.Call) {
 	}
 	err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
 		call.Reply.Header().Error = nil
-		rls.LocalSender.Send(call)
+		rls.LocalSender.Send(ctx, call)
 		// Check for range key mismatch error (this could happen if
 		// range was split between lookup and execution). In this case,
 		// reset header.Replica and engage retry loop.
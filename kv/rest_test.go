@@ -36,6 +36,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	. "github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/server"
@@ -412,8 +413,8 @@ func TestSystemKeys(t *testing.T) {
 	// Compute expected system key.
 	desc := &proto.RangeDescriptor{
 		RaftID:   1,
-		StartKey: engine.KeyMin,
-		EndKey:   engine.KeyMax,
+		StartKey: keys.KeyMin,
+		EndKey:   keys.KeyMax,
 		Replicas: []proto.Replica{
 			{
 				NodeID:  1,
@@ -427,7 +428,7 @@ func TestSystemKeys(t *testing.T) {
 	}
 
 	// Manipulate the meta1 key.
-	metaKey := engine.MakeKey(engine.KeyMeta1Prefix, engine.KeyMax)
+	metaKey := keys.MakeKey(keys.KeyMeta1Prefix, keys.KeyMax)
 	encMeta1Key := url.QueryEscape(string(metaKey))
 	url := "https://" + addr + EntryPrefix + encMeta1Key
 	resp := getURL(url, t)
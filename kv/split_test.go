@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
@@ -148,7 +149,7 @@ func TestRangeSplitsWithWritePressure(t *testing.T) {
 		RangeMinBytes: 1 << 8,
 		RangeMaxBytes: 1 << 18,
 	}
-	call := client.PutProtoCall(engine.MakeKey(engine.KeyConfigZonePrefix, engine.KeyMin), zoneConfig)
+	call := client.PutProtoCall(keys.MakeKey(keys.KeyConfigZonePrefix, keys.KeyMin), zoneConfig)
 	if err := s.KV.Run(call); err != nil {
 		t.Fatal(err)
 	}
@@ -162,7 +163,7 @@ func TestRangeSplitsWithWritePressure(t *testing.T) {
 	// Check that we split 5 times in allotted time.
 	if err := util.IsTrueWithin(func() bool {
 		// Scan the txn records.
-		call := client.ScanCall(engine.KeyMeta2Prefix, engine.KeyMetaMax, 0)
+		call := client.ScanCall(keys.KeyMeta2Prefix, keys.KeyMetaMax, 0)
 		resp := call.Reply.(*proto.ScanResponse)
 		if err := s.KV.Run(call); err != nil {
 			t.Fatalf("failed to scan meta2 keys: %s", err)
@@ -182,7 +183,7 @@ func TestRangeSplitsWithWritePressure(t *testing.T) {
 	// for timing of finishing the test writer and a possibly-ongoing
 	// asynchronous split.
 	if err := util.IsTrueWithin(func() bool {
-		if _, err := engine.MVCCScan(s.Eng, engine.KeyLocalMax, engine.KeyMax, 0, proto.MaxTimestamp, true, nil); err != nil {
+		if _, err := engine.MVCCScan(s.Eng, keys.KeyLocalMax, keys.KeyMax, 0, proto.MaxTimestamp, true, nil); err != nil {
 			log.Infof("mvcc scan should be clean: %s", err)
 			return false
 		}
@@ -19,23 +19,28 @@
 package kv
 
 import (
+	"bytes"
+	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // txnMetadata holds information about an ongoing transaction, as
 // seen from the perspective of this coordinator. It records all
 // keys (and key ranges) mutated as part of the transaction for
-// resolution upon transaction commit or abort.
+// resolution upon transaction commit or abort, as well as those read
+// so that a serializable transaction whose timestamp was pushed can
+// commit without restarting if its reads are unchanged.
 //
 // Importantly, more than a single coordinator may participate in
 // a transaction's execution. Client connections may be stateless
@@ -60,6 +65,19 @@ type txnMetadata struct {
 	// to update the write intent when the transaction is committed.
 	keys *util.IntervalCache
 
+	// reads stores key ranges read by this transaction through this
+	// coordinator in epoch readsEpoch. When the transaction's commit
+	// timestamp is pushed, they're refreshed to the new timestamp.
+	reads      *util.IntervalCache
+	readsEpoch int32
+
+	// lastSequence is the latest sequence number of the transaction's
+	// calls seen by this coordinator. If calls are missing, the
+	// transaction may have read keys through other coordinators and
+	// refreshable is cleared, as its reads can't then be refreshed.
+	lastSequence int32
+	refreshable  bool
+
 	// lastUpdateTS is the latest time when the client sent transaction
 	// operations to this coordinator.
 	lastUpdateTS proto.Timestamp
@@ -69,12 +87,16 @@ type txnMetadata struct {
 	// current_timestamp > lastUpdateTS + timeoutDuration If this value
 	// is set to 0, a default timeout will be used.
 	timeoutDuration time.Duration
+
+	// priority is the request priority of the transaction's first
+	// write, which its intent resolutions inherit.
+	priority proto.RequestPriority
 }
 
 // addKeyRange adds the specified key range to the interval cache,
 // taking care not to add this range if existing entries already
 // completely cover the range.
-func (tm *txnMetadata) addKeyRange(start, end proto.Key) {
+func addKeyRange(cache *util.IntervalCache, start, end proto.Key) {
 	// This gives us a memory-efficient end key if end is empty.
 	// The most common case for keys in the intents interval map
 	// is for single keys. However, the interval cache requires
@@ -84,17 +106,40 @@ func (tm *txnMetadata) addKeyRange(start, end proto.Key) {
 		end = start.Next()
 		start = end[:len(start)]
 	}
-	key := tm.keys.NewKey(start, end)
-	for _, o := range tm.keys.GetOverlaps(start, end) {
+	key := cache.NewKey(start, end)
+	for _, o := range cache.GetOverlaps(start, end) {
 		if o.Key.Contains(key) {
 			return
 		} else if key.Contains(o.Key) {
-			tm.keys.Del(o.Key)
+			cache.Del(o.Key)
 		}
 	}
 
 	// Since no existing key range fully covered this range, add it now.
-	tm.keys.Add(key, nil)
+	cache.Add(key, nil)
+}
+
+// addRead records a key range read by the transaction in the given
+// epoch. Ranges read in earlier epochs are dropped, as a restarted
+// transaction doesn't depend on them.
+func (tm *txnMetadata) addRead(epoch int32, start, end proto.Key) {
+	if epoch != tm.readsEpoch {
+		tm.reads.Clear()
+		tm.readsEpoch = epoch
+	}
+	addKeyRange(tm.reads, start, end)
+}
+
+// observeSequence records the sequence number of a call made by the
+// transaction through this coordinator. Calls sharing a sequence
+// number, such as the commands of a batch, are allowed.
+func (tm *txnMetadata) observeSequence(seq int32) {
+	if seq > tm.lastSequence+1 {
+		tm.refreshable = false
+	}
+	if seq > tm.lastSequence {
+		tm.lastSequence = seq
+	}
 }
 
 // close sends resolve intent commands for all key ranges this
@@ -105,7 +150,7 @@ func (tm *txnMetadata) close(txn *proto.Transaction, resolved []proto.Key, sende
 	if tm.keys.Len() > 0 {
 		log.V(1).Infof("cleaning up %d intent(s) for transaction %s", tm.keys.Len(), txn)
 	}
-	for _, o := range tm.keys.GetOverlaps(engine.KeyMin, engine.KeyMax) {
+	for _, o := range tm.keys.GetOverlaps(keys.KeyMin, keys.KeyMax) {
 		call := client.Call{
 			Args: &proto.InternalResolveIntentRequest{
 				RequestHeader: proto.RequestHeader{
@@ -113,6 +158,7 @@ func (tm *txnMetadata) close(txn *proto.Transaction, resolved []proto.Key, sende
 					Key:       o.Key.Start().(proto.Key),
 					User:      storage.UserRoot,
 					Txn:       txn,
+					Priority:  tm.priority,
 				},
 			},
 			Reply: &proto.InternalResolveIntentResponse{},
@@ -139,7 +185,7 @@ func (tm *txnMetadata) close(txn *proto.Transaction, resolved []proto.Key, sende
 		if stopper.StartTask() {
 			go func() {
 				log.V(1).Infof("cleaning up intent %q for txn %s", call.Args.Header().Key, txn)
-				sender.Send(call)
+				sender.Send(context.Background(), call)
 				if call.Reply.Header().Error != nil {
 					log.Warningf("failed to cleanup %q intent: %s", call.Args.Header().Key, call.Reply.Header().GoError())
 				}
@@ -148,6 +194,7 @@ func (tm *txnMetadata) close(txn *proto.Transaction, resolved []proto.Key, sende
 		}
 	}
 	tm.keys.Clear()
+	tm.reads.Clear()
 }
 
 // A TxnCoordSender is an implementation of client.KVSender which
@@ -190,18 +237,37 @@ func NewTxnCoordSender(wrapped client.KVSender, clock *hlc.Clock, linearizable b
 // Send implements the client.KVSender interface. If the call is part
 // of a transaction, the coordinator will initialize the transaction
 // if it's not nil but has an empty ID.
-func (tc *TxnCoordSender) Send(call client.Call) {
+func (tc *TxnCoordSender) Send(ctx context.Context, call client.Call) {
 	header := call.Args.Header()
 	tc.maybeBeginTxn(header)
 
 	// Process batch specially; otherwise, send via wrapped sender.
 	if breq, ok := call.Args.(*proto.BatchRequest); ok {
-		tc.sendBatch(breq, call.Reply.(*proto.BatchResponse))
+		tc.sendBatch(ctx, breq, call.Reply.(*proto.BatchResponse))
 	} else {
-		tc.sendOne(call)
+		tc.sendOne(ctx, call)
 	}
 }
 
+// ScanStream implements the client.ScanStreamer interface. Scans
+// outside of a transaction are streamed by the wrapped sender, if it
+// can stream them. Transactional scans are sent through Send, which
+// keeps track of the transaction, and their rows passed to f at once.
+func (tc *TxnCoordSender) ScanStream(ctx context.Context, call client.Call, f func(rows []proto.KeyValue) error) {
+	if streamer, ok := tc.wrapped.(client.ScanStreamer); ok && call.Args.Header().Txn == nil {
+		streamer.ScanStream(ctx, call, f)
+		return
+	}
+	tc.Send(ctx, call)
+	reply := call.Reply.(*proto.ScanResponse)
+	if reply.Error == nil && len(reply.Rows) > 0 {
+		if err := f(reply.Rows); err != nil {
+			reply.SetGoError(err)
+		}
+	}
+	reply.Rows = nil
+}
+
 // maybeBeginTxn begins a new transaction if a txn has been specified
 // in the request but has a nil ID. The new transaction is initialized
 // using the name and isolation in the otherwise uninitialized txn.
@@ -209,13 +275,16 @@ func (tc *TxnCoordSender) Send(call client.Call) {
 func (tc *TxnCoordSender) maybeBeginTxn(header *proto.RequestHeader) {
 	if header.Txn != nil {
 		if len(header.Txn.ID) == 0 {
-			newTxn := proto.NewTransaction(header.Txn.Name, engine.KeyAddress(header.Key), header.GetUserPriority(),
+			newTxn := proto.NewTransaction(header.Txn.Name, keys.KeyAddress(header.Key), header.GetUserPriority(),
 				header.Txn.Isolation, tc.clock.Now(), tc.clock.MaxOffset().Nanoseconds())
 			// Use existing priority as a minimum. This is used on transaction
 			// aborts to ratchet priority when creating successor transaction.
 			if newTxn.Priority < header.Txn.Priority {
 				newTxn.Priority = header.Txn.Priority
 			}
+			// Savepoints may have been created before the txn began.
+			newTxn.Sequence = header.Txn.Sequence
+			newTxn.IgnoredSequences = header.Txn.IgnoredSequences
 			header.Txn = newTxn
 		}
 	}
@@ -234,7 +303,7 @@ func (tc *TxnCoordSender) maybeBeginTxn(header *proto.RequestHeader) {
 // key range is recorded as live intents for eventual cleanup upon
 // transaction commit. Upon successful txn commit, initiates cleanup
 // of intents.
-func (tc *TxnCoordSender) sendOne(call client.Call) {
+func (tc *TxnCoordSender) sendOne(ctx context.Context, call client.Call) {
 	var startNS int64
 	header := call.Args.Header()
 	// If this call is part of a transaction...
@@ -256,8 +325,14 @@ func (tc *TxnCoordSender) sendOne(call client.Call) {
 		}
 	}
 
-	// Send the command through wrapped sender.
-	tc.wrapped.Send(call)
+	// Send the command through wrapped sender. Commits of serializable
+	// transactions may refresh the transaction's reads first.
+	if args, ok := call.Args.(*proto.EndTransactionRequest); ok && args.Commit &&
+		header.Txn != nil && header.Txn.Isolation == proto.SERIALIZABLE {
+		tc.sendCommit(ctx, call)
+	} else {
+		tc.wrapped.Send(ctx, call)
+	}
 
 	if header.Txn != nil {
 		// If not already set, copy the request txn.
@@ -267,25 +342,44 @@ func (tc *TxnCoordSender) sendOne(call client.Call) {
 		tc.updateResponseTxn(header, call.Reply.Header())
 	}
 
-	// If successful, we're in a transaction, and the command leaves
-	// transactional intents, add the key or key range to the intents map.
-	// If the transaction metadata doesn't yet exist, create it.
-	if call.Reply.Header().GoError() == nil && header.Txn != nil && proto.IsTransactionWrite(call.Args) {
+	// If we're in a transaction, note the call's sequence number. If
+	// successful and the command leaves transactional intents, add the
+	// key or key range to the intents map; if it reads, add it to the
+	// reads map. If the transaction metadata doesn't yet exist, create
+	// it.
+	if header.Txn != nil {
+		isWrite := proto.IsTransactionWrite(call.Args)
+		isRead := proto.IsRead(call.Args)
 		tc.Lock()
-		var ok bool
-		var txnMeta *txnMetadata
-		if txnMeta, ok = tc.txns[string(header.Txn.ID)]; !ok {
-			txnMeta = &txnMetadata{
-				txn:             *header.Txn,
-				keys:            util.NewIntervalCache(util.CacheConfig{Policy: util.CacheNone}),
-				lastUpdateTS:    tc.clock.Now(),
-				timeoutDuration: tc.clientTimeout,
+		txnMeta, ok := tc.txns[string(header.Txn.ID)]
+		if ok {
+			txnMeta.observeSequence(header.Txn.GetSequence())
+		}
+		if call.Reply.Header().GoError() == nil && (isWrite || isRead) {
+			if !ok {
+				txnMeta = &txnMetadata{
+					txn:             *header.Txn,
+					keys:            util.NewIntervalCache(util.CacheConfig{Policy: util.CacheNone}),
+					reads:           util.NewIntervalCache(util.CacheConfig{Policy: util.CacheNone}),
+					readsEpoch:      header.Txn.Epoch,
+					lastSequence:    header.Txn.GetSequence(),
+					refreshable:     header.Txn.GetSequence() == 1,
+					timeoutDuration: tc.clientTimeout,
+				}
+				tc.txns[string(header.Txn.ID)] = txnMeta
+				tc.heartbeat(header.Txn)
+			}
+			txnMeta.lastUpdateTS = tc.clock.Now()
+			if isWrite {
+				if txnMeta.keys.Len() == 0 {
+					txnMeta.priority = header.Priority
+				}
+				addKeyRange(txnMeta.keys, header.Key, header.EndKey)
+			}
+			if isRead {
+				txnMeta.addRead(header.Txn.Epoch, header.Key, header.EndKey)
 			}
-			tc.txns[string(header.Txn.ID)] = txnMeta
-			tc.heartbeat(header.Txn)
 		}
-		txnMeta.lastUpdateTS = tc.clock.Now()
-		txnMeta.addKeyRange(header.Key, header.EndKey)
 		tc.Unlock()
 	}
 
@@ -314,25 +408,7 @@ func (tc *TxnCoordSender) sendOne(call client.Call) {
 		var resolved []proto.Key
 		if _, ok := call.Args.(*proto.EndTransactionRequest); ok {
 			txn = call.Reply.Header().Txn
-			// If the -linearizable flag is set, we want to make sure that
-			// all the clocks in the system are past the commit timestamp
-			// of the transaction. This is guaranteed if either
-			// - the commit timestamp is MaxOffset behind startNS
-			// - MaxOffset ns were spent in this function
-			// when returning to the client. Below we choose the option
-			// that involves less waiting, which is likely the first one
-			// unless a transaction commits with an odd timestamp.
-			if tsNS := txn.Timestamp.WallTime; startNS > tsNS {
-				startNS = tsNS
-			}
-			sleepNS := tc.clock.MaxOffset() -
-				time.Duration(tc.clock.PhysicalNow()-startNS)
-			if tc.linearizable && sleepNS > 0 {
-				defer func() {
-					log.V(1).Infof("%v: waiting %dms on EndTransaction for linearizability", txn.ID, sleepNS/1000000)
-					time.Sleep(sleepNS)
-				}()
-			}
+			defer tc.linearizableWait(txn, startNS)
 			resolved = call.Reply.(*proto.EndTransactionResponse).Resolved
 		}
 		if txn != nil && txn.Status != proto.PENDING {
@@ -341,25 +417,127 @@ func (tc *TxnCoordSender) sendOne(call client.Call) {
 	}
 }
 
+// linearizableWait waits, if the -linearizable flag is set, until all
+// the clocks in the system are past the commit timestamp of txn, whose
+// EndTransaction started at startNS. This is guaranteed if either
+// - the commit timestamp is MaxOffset behind startNS
+// - MaxOffset ns were spent since startNS
+// when returning to the client. The option that involves less waiting
+// is chosen, which is likely the first one unless a transaction
+// commits with an odd timestamp.
+func (tc *TxnCoordSender) linearizableWait(txn *proto.Transaction, startNS int64) {
+	if tsNS := txn.Timestamp.WallTime; startNS > tsNS {
+		startNS = tsNS
+	}
+	sleepNS := tc.clock.MaxOffset() -
+		time.Duration(tc.clock.PhysicalNow()-startNS)
+	if tc.linearizable && sleepNS > 0 {
+		log.V(1).Infof("%v: waiting %dms on EndTransaction for linearizability", txn.ID, sleepNS/1000000)
+		time.Sleep(sleepNS)
+	}
+}
+
+// sendCommit sends the EndTransaction call committing a serializable
+// transaction. A transaction whose timestamp was pushed, whether as
+// known to the client or as recorded in its transaction record, can't
+// commit at its original timestamp and would have to restart. Instead,
+// its reads are refreshed to the pushed timestamp (see refreshTxn)
+// and, if they're unchanged, it commits there.
+func (tc *TxnCoordSender) sendCommit(ctx context.Context, call client.Call) {
+	args := call.Args.(*proto.EndTransactionRequest)
+	args.RefreshedTimestamp = nil
+	if args.Txn.OrigTimestamp.Less(args.Txn.Timestamp) && !tc.refreshTxn(ctx, args, args.Txn.Timestamp) {
+		// The commit fails with a retry error.
+		tc.wrapped.Send(ctx, call)
+		return
+	}
+	tc.wrapped.Send(ctx, call)
+
+	// The transaction record may have been pushed by other transactions.
+	retryErr, ok := call.Reply.Header().GoError().(*proto.TransactionRetryError)
+	if !ok || !tc.refreshTxn(ctx, args, retryErr.Txn.Timestamp) {
+		return
+	}
+	// The failed commit is in the response cache under the call's
+	// client command ID, so the commit is retried under a new one.
+	args.CmdID = proto.ClientCmdID{
+		WallTime: tc.clock.PhysicalNow(),
+		Random:   rand.Int63(),
+	}
+	call.Reply.Reset()
+	tc.wrapped.Send(ctx, call)
+}
+
+// refreshTxn refreshes the key ranges read through this coordinator by
+// the transaction committed by args to the given timestamp, verifying
+// that no values in them were written after the transaction's original
+// timestamp. On success, args is updated to commit at timestamp and
+// true is returned. The refresh fails if the coordinator can't be sure
+// it knows all of the transaction's reads.
+func (tc *TxnCoordSender) refreshTxn(ctx context.Context, args *proto.EndTransactionRequest, timestamp proto.Timestamp) bool {
+	txn := args.Txn
+	var spans []proto.Span
+	tc.Lock()
+	txnMeta, ok := tc.txns[string(txn.ID)]
+	// The commit is the transaction's next call, unless it made calls
+	// through other coordinators since the last one seen here.
+	refreshable := ok && txnMeta.refreshable && txn.GetSequence() <= txnMeta.lastSequence+1
+	if refreshable && txnMeta.readsEpoch == txn.Epoch {
+		for _, o := range txnMeta.reads.GetOverlaps(keys.KeyMin, keys.KeyMax) {
+			spans = append(spans, proto.Span{Key: o.Key.Start().(proto.Key), EndKey: o.Key.End().(proto.Key)})
+		}
+	}
+	tc.Unlock()
+	if !refreshable {
+		return false
+	}
+
+	for _, span := range spans {
+		call := client.Call{
+			Args: &proto.InternalRefreshRangeRequest{
+				RequestHeader: proto.RequestHeader{
+					Key:       span.Key,
+					EndKey:    span.EndKey,
+					Timestamp: timestamp,
+					User:      args.User,
+					Txn:       txn,
+				},
+				RefreshFrom: txn.OrigTimestamp,
+			},
+			Reply: &proto.InternalRefreshRangeResponse{},
+		}
+		tc.wrapped.Send(ctx, call)
+		if err := call.Reply.Header().GoError(); err != nil {
+			log.V(1).Infof("txn %s can't commit at %s: %s", txn, timestamp, err)
+			return false
+		}
+	}
+	args.Timestamp.Forward(timestamp)
+	refreshedTS := args.Timestamp
+	args.RefreshedTimestamp = &refreshedTS
+	return true
+}
+
 // sendBatch unrolls a batched command and sends each constituent
-// command in parallel.
-func (tc *TxnCoordSender) sendBatch(batchArgs *proto.BatchRequest, batchReply *proto.BatchResponse) {
+// command in parallel. A batch committing a transaction which has
+// written nothing yet may instead be sent as is, to be committed in a
+// single command (see sendOnePhaseCommit).
+func (tc *TxnCoordSender) sendBatch(ctx context.Context, batchArgs *proto.BatchRequest, batchReply *proto.BatchResponse) {
+	for i := range batchArgs.Requests {
+		initBatchRequest(batchArgs, i)
+	}
+	if tc.sendOnePhaseCommit(ctx, batchArgs, batchReply) {
+		return
+	}
+
 	// Prepare the calls by unrolling the batch. If the batchReply is
 	// pre-initialized with replies, use those; otherwise create replies
 	// as needed.
 	// TODO(spencer): send calls in parallel.
 	batchReply.Txn = batchArgs.Txn
 	for i := range batchArgs.Requests {
-		// Initialize args header values where appropriate.
 		args := batchArgs.Requests[i].GetValue().(proto.Request)
 		call := client.Call{Args: args}
-		if args.Header().User == "" {
-			args.Header().User = batchArgs.User
-		}
-		if args.Header().UserPriority == nil {
-			args.Header().UserPriority = batchArgs.UserPriority
-		}
-		args.Header().Txn = batchArgs.Txn
 
 		// Create a reply from the method type and add to batch response.
 		if i >= len(batchReply.Responses) {
@@ -368,7 +546,7 @@ func (tc *TxnCoordSender) sendBatch(batchArgs *proto.BatchRequest, batchReply *p
 		} else {
 			call.Reply = batchReply.Responses[i].GetValue().(proto.Response)
 		}
-		tc.sendOne(call)
+		tc.sendOne(ctx, call)
 		// Amalgamate transaction updates and propagate first error, if applicable.
 		if batchReply.Txn != nil {
 			batchReply.Txn.Update(call.Reply.Header().Txn)
@@ -380,6 +558,110 @@ func (tc *TxnCoordSender) sendBatch(batchArgs *proto.BatchRequest, batchReply *p
 	}
 }
 
+// initBatchRequest initializes header values of the i-th request of
+// the batch where appropriate.
+func initBatchRequest(batchArgs *proto.BatchRequest, i int) {
+	args := batchArgs.Requests[i].GetValue().(proto.Request)
+	if args.Header().User == "" {
+		args.Header().User = batchArgs.User
+	}
+	if args.Header().UserPriority == nil {
+		args.Header().UserPriority = batchArgs.UserPriority
+	}
+	args.Header().Txn = batchArgs.Txn
+	// Commands without their own client command ID are assigned one
+	// derived from the batch's ID and their sequence within the
+	// batch, so that a retried batch is deduplicated command by
+	// command by the replicas' response caches.
+	if args.Header().CmdID.IsEmpty() && !batchArgs.CmdID.IsEmpty() {
+		args.Header().CmdID = proto.ClientCmdID{
+			WallTime: batchArgs.CmdID.WallTime,
+			Random:   batchArgs.CmdID.Random + int64(i) + 1,
+		}
+	}
+}
+
+// sendOnePhaseCommit sends a batch which commits its transaction as a
+// single command, if the transaction's writes are all in the batch and
+// it can commit at its current timestamp; after all its reads, the
+// transaction then needs just one round of consensus and no record.
+// Returns false if the batch wasn't sent as such, or if it had to be
+// split up, either as it spans ranges or as its timestamp was pushed;
+// the commands are then to be sent individually.
+func (tc *TxnCoordSender) sendOnePhaseCommit(ctx context.Context, batchArgs *proto.BatchRequest, batchReply *proto.BatchResponse) bool {
+	txn := batchArgs.Txn
+	if !batchArgs.IsOnePhaseCommit() ||
+		(txn.Isolation == proto.SERIALIZABLE && !txn.OrigTimestamp.Equal(txn.Timestamp)) {
+		return false
+	}
+	// The batch spans the transaction's key, where the EndTransaction
+	// is addressed, and the keys written.
+	start, end := txn.Key, txn.Key.Next()
+	for i := range batchArgs.Requests[:len(batchArgs.Requests)-1] {
+		key := batchArgs.Requests[i].GetValue().(proto.Request).Header().Key
+		if bytes.HasPrefix(key, keys.KeyLocalPrefix) {
+			return false
+		}
+		if key.Less(start) {
+			start = key
+		}
+		if !key.Less(end) {
+			end = key.Next()
+		}
+	}
+	// The transaction can't have written elsewhere: it either makes its
+	// first call or only read through this coordinator.
+	tc.Lock()
+	txnMeta, ok := tc.txns[string(txn.ID)]
+	if ok {
+		ok = txnMeta.refreshable && txn.GetSequence() <= txnMeta.lastSequence+1 && txnMeta.keys.Len() == 0
+		txnMeta.observeSequence(txn.GetSequence())
+	} else {
+		ok = txn.GetSequence() == 1
+	}
+	tc.Unlock()
+	if !ok {
+		return false
+	}
+
+	args := gogoproto.Clone(batchArgs).(*proto.BatchRequest)
+	args.Key, args.EndKey = start, end
+	args.Timestamp = txn.Timestamp
+	args.Requests[len(args.Requests)-1].GetValue().(proto.Request).Header().Key = txn.Key
+	reply := &proto.BatchResponse{}
+	startNS := tc.clock.PhysicalNow()
+	tc.wrapped.Send(ctx, client.Call{Args: args, Reply: reply})
+	switch reply.GoError().(type) {
+	case *proto.RangeKeyMismatchError, *proto.TransactionRetryError:
+		log.V(1).Infof("txn %s can't commit in one phase: %s", txn, reply.GoError())
+		return false
+	}
+
+	if reply.Txn == nil {
+		reply.Txn = gogoproto.Clone(txn).(*proto.Transaction)
+	}
+	tc.updateResponseTxn(&args.RequestHeader, &reply.ResponseHeader)
+	if len(batchReply.Responses) == len(reply.Responses) {
+		for i := range reply.Responses {
+			resp := batchReply.Responses[i].GetValue().(proto.Response)
+			resp.Reset()
+			gogoproto.Merge(resp, reply.Responses[i].GetValue().(gogoproto.Message))
+		}
+		reply.Responses = batchReply.Responses
+	}
+	*batchReply = *reply
+
+	switch t := reply.GoError().(type) {
+	case *proto.TransactionAbortedError:
+		tc.cleanupTxn(&t.Txn, nil)
+	case nil:
+		// Stop heartbeating the transaction if it read through here.
+		tc.cleanupTxn(reply.Txn, nil)
+		tc.linearizableWait(reply.Txn, startNS)
+	}
+	return true
+}
+
 // updateResponseTxn updates the response txn based on the response
 // timestamp and error. The timestamp may have changed upon
 // encountering a newer write or read. Both the timestamp and the
@@ -468,6 +750,15 @@ func (tc *TxnCoordSender) hasClientAbandonedCoord(txnID []byte) bool {
 	return false
 }
 
+// hasIntents returns true if the transaction specified by txnID has
+// written keys through this coordinator.
+func (tc *TxnCoordSender) hasIntents(txnID []byte) bool {
+	tc.Lock()
+	defer tc.Unlock()
+	txnMeta, ok := tc.txns[string(txnID)]
+	return ok && txnMeta.keys.Len() > 0
+}
+
 // heartbeat periodically sends an InternalHeartbeatTxn RPC to an
 // extant transaction, stopping in the event the transaction is
 // aborted or committed or if the TxnCoordSender is closed.
@@ -496,13 +787,18 @@ func (tc *TxnCoordSender) heartbeat(txn *proto.Transaction) {
 					tc.stopper.FinishTask()
 					return
 				}
+				// Until the transaction writes, it has no record to heartbeat.
+				if !tc.hasIntents(txn.ID) {
+					tc.stopper.FinishTask()
+					continue
+				}
 				request.Header().Timestamp = tc.clock.Now()
 				reply := &proto.InternalHeartbeatTxnResponse{}
 				call := client.Call{
 					Args:  request,
 					Reply: reply,
 				}
-				tc.wrapped.Send(call)
+				tc.wrapped.Send(context.Background(), call)
 				// If the transaction is not in pending state, then we can stop
 				// the heartbeat. It's either aborted or committed, and we resolve
 				// write intents accordingly.
@@ -30,6 +30,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // createTestDB creates a local test server and starts it. The caller
@@ -122,7 +123,7 @@ func TestTxnCoordSenderBeginTransaction(t *testing.T) {
 
 	reply := &proto.PutResponse{}
 	key := proto.Key("key")
-	s.KV.Sender.Send(client.Call{
+	s.KV.Sender.Send(context.Background(), client.Call{
 		Args: &proto.PutRequest{
 			RequestHeader: proto.RequestHeader{
 				Key:          key,
@@ -160,7 +161,7 @@ func TestTxnCoordSenderBeginTransactionMinPriority(t *testing.T) {
 	defer s.Stop()
 
 	reply := &proto.PutResponse{}
-	s.KV.Sender.Send(client.Call{
+	s.KV.Sender.Send(context.Background(), client.Call{
 		Args: &proto.PutRequest{
 			RequestHeader: proto.RequestHeader{
 				Key:          proto.Key("key"),
@@ -342,7 +343,7 @@ func TestTxnCoordSenderEndTxn(t *testing.T) {
 		t.Fatal(pReply.GoError())
 	}
 	etReply := &proto.EndTransactionResponse{}
-	s.KV.Sender.Send(client.Call{
+	s.KV.Sender.Send(context.Background(), client.Call{
 		Args: &proto.EndTransactionRequest{
 			RequestHeader: proto.RequestHeader{
 				Key:       txn.Key,
@@ -460,7 +461,7 @@ func newTestSender(handler func(client.Call)) *testSender {
 	}
 }
 
-func (ts *testSender) Send(call client.Call) {
+func (ts *testSender) Send(_ context.Context, call client.Call) {
 	ts.handler(call)
 }
 
@@ -518,7 +519,7 @@ func TestTxnCoordSenderTxnUpdatedOnError(t *testing.T) {
 			call.Reply.Header().SetGoError(test.err)
 		}), clock, false, stopper)
 		reply := &proto.PutResponse{}
-		ts.Send(client.Call{Args: testPutReq, Reply: reply})
+		ts.Send(context.Background(), client.Call{Args: testPutReq, Reply: reply})
 
 		if reflect.TypeOf(test.err) != reflect.TypeOf(reply.GoError()) {
 			t.Fatalf("%d: expected %T; got %T", i, test.err, reply.GoError())
@@ -545,3 +546,205 @@ func TestTxnCoordSenderTxnUpdatedOnError(t *testing.T) {
 		}
 	}
 }
+
+// TestTxnCoordSenderBatchCmdIDs verifies that the commands of a batch
+// which lack a client command ID are assigned distinct IDs derived
+// from the batch's, so that resending the batch yields the same IDs.
+func TestTxnCoordSenderBatchCmdIDs(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+
+	var cmdIDs []proto.ClientCmdID
+	ts := NewTxnCoordSender(newTestSender(func(call client.Call) {
+		cmdIDs = append(cmdIDs, call.Args.Header().CmdID)
+	}), clock, false, stopper)
+
+	explicitID := proto.ClientCmdID{WallTime: 1, Random: 1}
+	for i := 0; i < 2; i++ {
+		bArgs := &proto.BatchRequest{}
+		bArgs.CmdID = proto.ClientCmdID{WallTime: 10, Random: 10}
+		bArgs.Add(&proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}})
+		bArgs.Add(&proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("b")}})
+		bArgs.Add(&proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("c"), CmdID: explicitID}})
+		ts.Send(context.Background(), client.Call{Args: bArgs, Reply: &proto.BatchResponse{}})
+	}
+
+	if len(cmdIDs) != 6 {
+		t.Fatalf("expected 6 commands to be sent; got %d", len(cmdIDs))
+	}
+	if cmdIDs[0].IsEmpty() || reflect.DeepEqual(cmdIDs[0], cmdIDs[1]) {
+		t.Errorf("expected distinct command IDs; got %+v, %+v", cmdIDs[0], cmdIDs[1])
+	}
+	if !reflect.DeepEqual(cmdIDs[2], explicitID) {
+		t.Errorf("expected explicit command ID %+v to be kept; got %+v", explicitID, cmdIDs[2])
+	}
+	if !reflect.DeepEqual(cmdIDs[:3], cmdIDs[3:]) {
+		t.Errorf("expected resent batch to use the same command IDs; got %+v, %+v", cmdIDs[:3], cmdIDs[3:])
+	}
+}
+
+// TestTxnCoordSenderRefreshReads verifies that a serializable
+// transaction whose timestamp is pushed, either by one of its writes
+// or in its transaction record, commits at the pushed timestamp
+// without restarting if its reads are unchanged.
+func TestTxnCoordSenderRefreshReads(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	origTS := makeTS(1, 0)
+	pushedTS := makeTS(10, 0)
+
+	testCases := []struct {
+		writePushed bool            // the write is pushed to pushedTS
+		recordTS    proto.Timestamp // the timestamp of the txn record
+		refreshErr  error
+		expCommits  int
+		expRetry    bool
+	}{
+		{true, origTS, nil, 1, false},
+		{true, origTS, util.Errorf("value changed"), 1, true},
+		{false, pushedTS, nil, 2, false},
+		{false, pushedTS, util.Errorf("value changed"), 1, true},
+	}
+	for i, test := range testCases {
+		stopper := util.NewStopper()
+		var refreshes []*proto.InternalRefreshRangeRequest
+		var commitIDs []proto.ClientCmdID
+		ts := NewTxnCoordSender(newTestSender(func(call client.Call) {
+			switch args := call.Args.(type) {
+			case *proto.PutRequest:
+				if test.writePushed {
+					call.Reply.Header().Timestamp = pushedTS
+				}
+			case *proto.InternalRefreshRangeRequest:
+				refreshes = append(refreshes, args)
+				call.Reply.Header().SetGoError(test.refreshErr)
+			case *proto.EndTransactionRequest:
+				commitIDs = append(commitIDs, args.CmdID)
+				commitTS := args.Timestamp
+				commitTS.Forward(test.recordTS)
+				readTS := args.Txn.OrigTimestamp
+				if args.RefreshedTimestamp != nil {
+					readTS.Forward(*args.RefreshedTimestamp)
+				}
+				if !commitTS.Equal(readTS) {
+					txn := *args.Txn
+					txn.Timestamp = commitTS
+					call.Reply.Header().SetGoError(proto.NewTransactionRetryError(&txn))
+				}
+			}
+		}), clock, false, stopper)
+
+		txn := proto.NewTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, origTS, 0)
+		calls := []client.Call{
+			client.GetCall(proto.Key("a")),
+			client.PutCall(proto.Key("b"), []byte("value")),
+			{Args: &proto.EndTransactionRequest{Commit: true}, Reply: &proto.EndTransactionResponse{}},
+		}
+		calls[2].Args.Header().CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+		for _, call := range calls {
+			txn.Sequence = gogoproto.Int32(txn.GetSequence() + 1)
+			call.Args.Header().Txn = txn
+			ts.Send(context.Background(), call)
+			txn.Update(call.Reply.Header().Txn)
+		}
+		stopper.Stop()
+
+		if len(refreshes) != 1 {
+			t.Fatalf("%d: expected 1 refresh; got %d", i, len(refreshes))
+		}
+		if r := refreshes[0]; !r.Key.Equal(proto.Key("a")) || !r.EndKey.Equal(proto.Key("a").Next()) ||
+			!r.RefreshFrom.Equal(origTS) || !r.Timestamp.Equal(pushedTS) {
+			t.Errorf("%d: unexpected refresh %+v", i, r)
+		}
+		if len(commitIDs) != test.expCommits {
+			t.Errorf("%d: expected %d commits; got %d", i, test.expCommits, len(commitIDs))
+		} else if len(commitIDs) == 2 && reflect.DeepEqual(commitIDs[0], commitIDs[1]) {
+			t.Errorf("%d: expected the commit to be retried under a new command ID", i)
+		}
+		err := calls[2].Reply.Header().GoError()
+		if _, ok := err.(*proto.TransactionRetryError); ok != test.expRetry {
+			t.Errorf("%d: expected retry=%t; got %v", i, test.expRetry, err)
+		}
+	}
+}
+
+// TestTxnCoordSenderOnePhaseCommit verifies that a batch holding all
+// of a transaction's writes and its commit is sent as a single command
+// spanning the transaction's keys, and that its commands are sent
+// individually if it spans ranges or is pushed, or if the transaction
+// may have written before.
+func TestTxnCoordSenderOnePhaseCommit(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+
+	testCases := []struct {
+		sequence   int32
+		batchErr   error
+		expBatches int
+		expCalls   int
+	}{
+		{1, nil, 1, 0},
+		{1, proto.NewRangeKeyMismatchError(proto.Key("a"), proto.Key("c"), nil), 1, 3},
+		{1, proto.NewTransactionRetryError(&proto.Transaction{}), 1, 3},
+		{2, nil, 0, 3},
+	}
+	for i, test := range testCases {
+		stopper := util.NewStopper()
+		var batches []*proto.BatchRequest
+		var calls int
+		ts := NewTxnCoordSender(newTestSender(func(call client.Call) {
+			args, ok := call.Args.(*proto.BatchRequest)
+			if _, resolve := call.Args.(*proto.InternalResolveIntentRequest); resolve {
+				return
+			} else if !ok {
+				calls++
+				if _, ok := call.Args.(*proto.EndTransactionRequest); ok {
+					txn := gogoproto.Clone(call.Args.Header().Txn).(*proto.Transaction)
+					txn.Status = proto.COMMITTED
+					call.Reply.Header().Txn = txn
+				}
+				return
+			}
+			batches = append(batches, args)
+			reply := call.Reply.(*proto.BatchResponse)
+			if test.batchErr != nil {
+				reply.SetGoError(test.batchErr)
+				return
+			}
+			for j := range args.Requests {
+				reply.Add(args.Requests[j].GetValue().(proto.Request).CreateReply())
+			}
+			reply.Txn = gogoproto.Clone(args.Txn).(*proto.Transaction)
+			reply.Txn.Status = proto.COMMITTED
+		}), clock, false, stopper)
+
+		txn := proto.NewTransaction("test", proto.Key("b"), 1, proto.SERIALIZABLE, makeTS(1, 0), 0)
+		txn.Sequence = gogoproto.Int32(test.sequence)
+		bArgs := &proto.BatchRequest{}
+		bArgs.Txn = txn
+		bArgs.Add(&proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("b")}})
+		bArgs.Add(&proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}})
+		bArgs.Add(&proto.EndTransactionRequest{Commit: true})
+		bReply := &proto.BatchResponse{}
+		ts.Send(context.Background(), client.Call{Args: bArgs, Reply: bReply})
+		stopper.Stop()
+
+		if len(batches) != test.expBatches || calls != test.expCalls {
+			t.Fatalf("%d: expected %d batches and %d calls; got %d and %d",
+				i, test.expBatches, test.expCalls, len(batches), calls)
+		}
+		if len(batches) > 0 {
+			if b := batches[0]; !b.Key.Equal(proto.Key("a")) || !b.EndKey.Equal(proto.Key("b").Next()) {
+				t.Errorf("%d: expected batch to span [a, b\\x00); got [%s, %s)", i, b.Key, b.EndKey)
+			}
+		}
+		if err := bReply.GoError(); err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err)
+		}
+		if len(bReply.Responses) != 3 || bReply.Txn == nil || bReply.Txn.Status != proto.COMMITTED {
+			t.Errorf("%d: expected committed txn and 3 responses; got %+v", i, bReply)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package migrations
+
+import (
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// configMigrationBatchSize is the number of configs moved per
+// transaction.
+const configMigrationBatchSize = 100
+
+// legacyConfigPrefixes maps the prefixes under which configs were
+// stored before the system config span was introduced to their
+// current prefixes.
+var legacyConfigPrefixes = []struct {
+	old, new proto.Key
+}{
+	{keys.MakeKey(keys.KeySystemPrefix, proto.Key("acct")), keys.KeyConfigAccountingPrefix},
+	{keys.MakeKey(keys.KeySystemPrefix, proto.Key("perm")), keys.KeyConfigPermissionPrefix},
+	{keys.MakeKey(keys.KeySystemPrefix, proto.Key("zone")), keys.KeyConfigZonePrefix},
+}
+
+// moveConfigsToSystemConfigSpan moves the accounting, permission and
+// zone configs from their legacy prefixes into the system config span,
+// which is gossiped as a whole. Each batch of configs is written under
+// its new key and deleted from its old one in a single transaction, so
+// that a migration interrupted part way may simply be run again.
+func moveConfigsToSystemConfigSpan(db *client.KV) error {
+	for _, prefix := range legacyConfigPrefixes {
+		for {
+			var moved int
+			err := db.RunTransaction(&client.TransactionOptions{Name: "move configs"}, func(txn *client.Txn) error {
+				call := client.ScanCall(prefix.old, prefix.old.PrefixEnd(), configMigrationBatchSize)
+				if err := txn.Run(call); err != nil {
+					return err
+				}
+				rows := call.Reply.(*proto.ScanResponse).Rows
+				calls := make([]client.Call, 0, 2*len(rows))
+				for _, kv := range rows {
+					newKey := keys.MakeKey(prefix.new, kv.Key[len(prefix.old):])
+					calls = append(calls, client.PutCall(newKey, kv.Value.Bytes), client.DeleteCall(kv.Key))
+				}
+				moved = len(rows)
+				if moved == 0 {
+					return nil
+				}
+				return txn.Run(calls...)
+			})
+			if err != nil {
+				return err
+			}
+			if moved < configMigrationBatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// Package migrations runs the startup migrations which upgrade the
+// cluster's on-disk formats. The cluster version, stored at
+// keys.KeyClusterVersion, counts the migrations which have been run.
+// Each migration is run exactly once cluster-wide by the node holding
+// the migration lease, and the version is incremented after it
+// completes. Migrations must be idempotent: a node which loses the
+// lease before incrementing the version leaves the migration to be
+// run again by the next lease holder.
+package migrations
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+const (
+	// leaseDuration is the duration of the migration lease, which is
+	// extended before each migration is run.
+	leaseDuration = 1 * time.Minute
+
+	// leaseRetryInterval is the interval at which a node waiting for
+	// the migration lease retries acquiring it.
+	leaseRetryInterval = 1 * time.Second
+)
+
+// A Migration upgrades the cluster's on-disk formats. Run must be
+// idempotent.
+type Migration struct {
+	Name string
+	Run  func(db *client.KV) error
+}
+
+// Registry lists the startup migrations in the order in which they are
+// run. Migrations are only ever appended; the cluster version is the
+// number of migrations which have been run.
+var Registry = []Migration{
+	{
+		Name: "move configs into the system config span",
+		Run:  moveConfigsToSystemConfigSpan,
+	},
+}
+
+// A Manager runs the pending startup migrations on behalf of a node.
+type Manager struct {
+	db         *client.KV
+	clock      *hlc.Clock
+	nodeID     proto.NodeID
+	migrations []Migration
+}
+
+// NewManager returns a new Manager which runs the supplied migrations
+// on behalf of the specified node.
+func NewManager(db *client.KV, clock *hlc.Clock, nodeID proto.NodeID,
+	migrations []Migration) *Manager {
+	return &Manager{
+		db:         db,
+		clock:      clock,
+		nodeID:     nodeID,
+		migrations: migrations,
+	}
+}
+
+// EnsureMigrations runs any migrations not yet run cluster-wide,
+// returning once the cluster version accounts for all of them. If
+// another node holds the migration lease, EnsureMigrations waits for
+// it to finish or for its lease to expire. An error is returned if the
+// cluster version exceeds the number of known migrations, as the
+// cluster was upgraded by a newer binary.
+func (m *Manager) EnsureMigrations(stopper *util.Stopper) error {
+	version, err := m.getVersion()
+	if err != nil {
+		return err
+	}
+	if err := m.checkVersion(version); err != nil || version == int64(len(m.migrations)) {
+		return err
+	}
+
+	lease, err := m.acquireLease(stopper)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.releaseLease(lease); err != nil {
+			log.Warningf("failed to release migration lease: %s", err)
+		}
+	}()
+
+	// Re-read the version, which the previous lease holder may have
+	// advanced while we waited.
+	if version, err = m.getVersion(); err != nil {
+		return err
+	}
+	if err := m.checkVersion(version); err != nil {
+		return err
+	}
+	for ; version < int64(len(m.migrations)); version++ {
+		// Extend the lease before each migration.
+		if lease, err = m.putLease(lease); err != nil {
+			return err
+		}
+		migration := m.migrations[version]
+		log.Infof("running migration %d: %s", version+1, migration.Name)
+		if err := migration.Run(m.db); err != nil {
+			return util.Errorf("migration %q failed: %s", migration.Name, err)
+		}
+		if err := m.setVersion(version, version+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkVersion returns an error if the cluster version exceeds the
+// number of known migrations.
+func (m *Manager) checkVersion(version int64) error {
+	if version > int64(len(m.migrations)) {
+		return util.Errorf("cluster version %d is newer than the %d migrations known to this binary",
+			version, len(m.migrations))
+	}
+	return nil
+}
+
+// getVersion reads the cluster version, which is zero if it has never
+// been written.
+func (m *Manager) getVersion() (int64, error) {
+	call := client.GetCall(keys.KeyClusterVersion)
+	call.Args.Header().Priority = proto.SYSTEM
+	if err := m.db.Run(call); err != nil {
+		return 0, err
+	}
+	reply := call.Reply.(*proto.GetResponse)
+	if reply.Value == nil {
+		return 0, nil
+	}
+	var v proto.ClusterVersion
+	if err := gogoproto.Unmarshal(reply.Value.Bytes, &v); err != nil {
+		return 0, err
+	}
+	return v.Version, nil
+}
+
+// setVersion advances the cluster version from old to new. The version
+// is expected not to exist if old is zero.
+func (m *Manager) setVersion(old, new int64) error {
+	var oldMsg gogoproto.Message
+	if old != 0 {
+		oldMsg = &proto.ClusterVersion{Version: old}
+	}
+	return m.cput(keys.KeyClusterVersion, oldMsg, &proto.ClusterVersion{Version: new})
+}
+
+// acquireLease acquires the migration lease for the local node,
+// retrying until the lease is absent, expired or already held by the
+// local node, or until the stopper stops.
+func (m *Manager) acquireLease(stopper *util.Stopper) (*proto.MigrationLease, error) {
+	for {
+		old, err := m.getLease()
+		if err != nil {
+			return nil, err
+		}
+		if old == nil || old.NodeID == m.nodeID || old.Expiration.Less(m.clock.Now()) {
+			lease, err := m.putLease(old)
+			if err == nil {
+				return lease, nil
+			}
+			if _, ok := err.(*proto.ConditionFailedError); !ok {
+				return nil, err
+			}
+			// Another node acquired the lease first.
+		} else {
+			log.Infof("waiting for node %d to finish running migrations", old.NodeID)
+		}
+		select {
+		case <-time.After(leaseRetryInterval):
+		case <-stopper.ShouldStop():
+			return nil, util.Errorf("stopped while waiting for the migration lease")
+		}
+	}
+}
+
+// releaseLease releases the held migration lease by clearing its
+// expiration, so that it may be acquired at once by another node.
+func (m *Manager) releaseLease(lease *proto.MigrationLease) error {
+	return m.cput(keys.KeyMigrationLease, lease, &proto.MigrationLease{NodeID: m.nodeID})
+}
+
+// getLease reads the migration lease, which is nil if it has never
+// been written.
+func (m *Manager) getLease() (*proto.MigrationLease, error) {
+	call := client.GetCall(keys.KeyMigrationLease)
+	call.Args.Header().Priority = proto.SYSTEM
+	if err := m.db.Run(call); err != nil {
+		return nil, err
+	}
+	reply := call.Reply.(*proto.GetResponse)
+	if reply.Value == nil {
+		return nil, nil
+	}
+	lease := &proto.MigrationLease{}
+	if err := gogoproto.Unmarshal(reply.Value.Bytes, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// putLease writes a migration lease for the local node expiring after
+// the lease duration, provided the stored lease equals old, or doesn't
+// exist if old is nil.
+func (m *Manager) putLease(old *proto.MigrationLease) (*proto.MigrationLease, error) {
+	lease := &proto.MigrationLease{
+		NodeID:     m.nodeID,
+		Expiration: m.clock.Now().Add(leaseDuration.Nanoseconds(), 0),
+	}
+	var oldMsg gogoproto.Message
+	if old != nil {
+		oldMsg = old
+	}
+	if err := m.cput(keys.KeyMigrationLease, oldMsg, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// cput writes the new message at key, provided the stored message
+// equals old, or doesn't exist if old is nil.
+func (m *Manager) cput(key proto.Key, old, new gogoproto.Message) error {
+	newBytes, err := gogoproto.Marshal(new)
+	if err != nil {
+		return err
+	}
+	var expValue *proto.Value
+	if old != nil {
+		oldBytes, err := gogoproto.Marshal(old)
+		if err != nil {
+			return err
+		}
+		expValue = &proto.Value{Bytes: oldBytes}
+	}
+	value := proto.Value{Bytes: newBytes}
+	value.InitChecksum(key)
+	return m.db.Run(client.Call{
+		Args: &proto.ConditionalPutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:      key,
+				Priority: proto.SYSTEM,
+			},
+			Value:    value,
+			ExpValue: expValue,
+		},
+		Reply: &proto.ConditionalPutResponse{},
+	})
+}
@@ -0,0 +1,198 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package migrations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// countingMigrations returns n migrations which count the number of
+// times each has been run.
+func countingMigrations(n int) ([]Migration, []int) {
+	counts := make([]int, n)
+	migrations := make([]Migration, n)
+	for i := range migrations {
+		i := i
+		migrations[i] = Migration{
+			Name: "counting",
+			Run: func(db *client.KV) error {
+				counts[i]++
+				return nil
+			},
+		}
+	}
+	return migrations, counts
+}
+
+// TestEnsureMigrations verifies that pending migrations are run once
+// and the cluster version advanced past them, and that migrations added
+// later are run on their own.
+func TestEnsureMigrations(t *testing.T) {
+	s := &kv.LocalTestCluster{}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	migrations, counts := countingMigrations(3)
+	for i, n := range []int{2, 2, 3} {
+		m := NewManager(s.KV, s.Clock, 1, migrations[:n])
+		if err := m.EnsureMigrations(s.Stopper); err != nil {
+			t.Fatal(err)
+		}
+		version, err := m.getVersion()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != int64(n) {
+			t.Errorf("%d: expected version %d; got %d", i, n, version)
+		}
+	}
+	for i, count := range counts {
+		if count != 1 {
+			t.Errorf("expected migration %d to run once; ran %d times", i, count)
+		}
+	}
+
+	// The lease is released once the migrations have run.
+	m := NewManager(s.KV, s.Clock, 1, migrations)
+	lease, err := m.getLease()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease == nil || !lease.Expiration.Equal(proto.ZeroTimestamp) {
+		t.Errorf("expected released lease; got %+v", lease)
+	}
+}
+
+// TestEnsureMigrationsNewerCluster verifies that a binary knowing fewer
+// migrations than the cluster has run refuses to start.
+func TestEnsureMigrationsNewerCluster(t *testing.T) {
+	s := &kv.LocalTestCluster{}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	migrations, _ := countingMigrations(2)
+	if err := NewManager(s.KV, s.Clock, 1, migrations).EnsureMigrations(s.Stopper); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewManager(s.KV, s.Clock, 1, migrations[:1]).EnsureMigrations(s.Stopper); err == nil {
+		t.Error("expected error running older binary against newer cluster")
+	}
+}
+
+// TestEnsureMigrationsWaitsForLease verifies that migrations aren't
+// run while another node holds the migration lease, and are run once
+// its lease has expired.
+func TestEnsureMigrationsWaitsForLease(t *testing.T) {
+	s := &kv.LocalTestCluster{}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	other := NewManager(s.KV, s.Clock, 2, nil)
+	if _, err := other.putLease(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	migrations, counts := countingMigrations(1)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- NewManager(s.KV, s.Clock, 1, migrations).EnsureMigrations(s.Stopper)
+	}()
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("expected migrations to wait for lease; got %v", err)
+	case <-time.After(2 * leaseRetryInterval):
+	}
+	if counts[0] != 0 {
+		t.Fatalf("expected migration not to run while lease is held")
+	}
+
+	s.Manual.Increment(leaseDuration.Nanoseconds() + 1)
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for migrations")
+	}
+	if counts[0] != 1 {
+		t.Errorf("expected migration to run once; ran %d times", counts[0])
+	}
+}
+
+// TestMoveConfigsToSystemConfigSpan verifies that configs stored under
+// the legacy prefixes are moved into the system config span, and that
+// running the migration again leaves them in place.
+func TestMoveConfigsToSystemConfigSpan(t *testing.T) {
+	s := &kv.LocalTestCluster{}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	for _, prefix := range legacyConfigPrefixes {
+		for _, suffix := range []string{"", "db1", "db2"} {
+			key := keys.MakeKey(prefix.old, proto.Key(suffix))
+			if err := s.KV.Run(client.PutCall(key, []byte(suffix))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := moveConfigsToSystemConfigSpan(s.KV); err != nil {
+			t.Fatal(err)
+		}
+		for _, prefix := range legacyConfigPrefixes {
+			for _, check := range []struct {
+				start proto.Key
+				count int
+			}{
+				{prefix.old, 0},
+				{prefix.new, 3},
+			} {
+				call := client.ScanCall(check.start, check.start.PrefixEnd(), 0)
+				if err := s.KV.Run(call); err != nil {
+					t.Fatal(err)
+				}
+				rows := call.Reply.(*proto.ScanResponse).Rows
+				if len(rows) != check.count {
+					t.Fatalf("%d: expected %d configs under %q; got %d", i, check.count, check.start, len(rows))
+				}
+				for _, kv := range rows {
+					if suffix := kv.Key[len(check.start):]; string(kv.Value.Bytes) != string(suffix) {
+						t.Errorf("%d: expected value %q at %q; got %q", i, suffix, kv.Key, kv.Value.Bytes)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"math/rand"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"golang.org/x/net/context"
+)
+
+// Pre-vote requests and responses are carried in message types which
+// raft only uses locally and which therefore never otherwise cross the
+// wire. They are intercepted by multiraft and never reach the raft
+// state machine.
+const (
+	msgPreVote     = raftpb.MsgHup
+	msgPreVoteResp = raftpb.MsgBeat
+)
+
+// msgTransferLeader is sent by a leader to all members of its group to
+// hand leadership over to the member whose ID is carried in the Index
+// field. The raft message types which are only used locally are all
+// spoken for, so it uses a type beyond those known to raft.
+const msgTransferLeader = raftpb.MessageType(100)
+
+// preVote tracks the responses to a pre-vote started by this node.
+type preVote struct {
+	// term is the term the election would be held at.
+	term    uint64
+	quorum  int
+	granted map[NodeID]struct{}
+}
+
+// quorum returns the number of votes needed for a majority of n members.
+func quorum(n int) int {
+	return n/2 + 1
+}
+
+// randomElectionTimeout returns a number of ticks in the range
+// [ElectionTimeoutTicks, 2*ElectionTimeoutTicks).
+func (s *state) randomElectionTimeout() int {
+	if s.rand != nil {
+		return s.ElectionTimeoutTicks + s.rand.Intn(s.ElectionTimeoutTicks)
+	}
+	return s.ElectionTimeoutTicks + rand.Intn(s.ElectionTimeoutTicks)
+}
+
+// hasLiveLeader returns true if the group has a leader which this node
+// has heard from within the election timeout.
+func (s *state) hasLiveLeader(g *group) bool {
+	return g.leader != 0 && g.leaderElapsed < s.ElectionTimeoutTicks
+}
+
+// recordContact notes that a message for the given group was received
+// from nodeID.
+func (s *state) recordContact(groupID uint64, nodeID NodeID) {
+	g, ok := s.groups[groupID]
+	if !ok {
+		return
+	}
+	if nodeID == g.leader {
+		g.leaderElapsed = 0
+		g.electionElapsed = 0
+	}
+	g.recentActive[nodeID] = struct{}{}
+}
+
+// tickElections advances the election clocks of all groups. If PreVote
+// is enabled, groups which are not led locally and whose election
+// timeout has elapsed start a pre-vote.
+func (s *state) tickElections() {
+	for _, n := range s.nodes {
+		n.heartbeatElapsed++
+	}
+	for groupID, g := range s.groups {
+		if g.transferTarget != 0 {
+			// Give up on a transfer which hasn't completed within an
+			// election timeout.
+			if g.transferElapsed++; g.transferElapsed >= s.ElectionTimeoutTicks {
+				g.transferTarget = 0
+			}
+		}
+		if g.leader == s.nodeID {
+			g.leaderElapsed = 0
+			g.electionElapsed = 0
+			if s.Quiesce && !g.quiescent {
+				if g.idleTicks++; g.idleTicks >= s.ElectionTimeoutTicks {
+					g.idleTicks = 0
+					s.maybeQuiesce(groupID, g)
+				}
+			}
+			continue
+		}
+		if g.quiescent {
+			// Stay quiescent for as long as the leader's node keeps
+			// sending coalesced heartbeats.
+			if n, ok := s.nodes[g.leader]; ok && n.heartbeatElapsed < s.ElectionTimeoutTicks {
+				continue
+			}
+			s.unquiesce(groupID, g)
+		}
+		g.leaderElapsed++
+		g.electionElapsed++
+		if s.PreVote && g.electionElapsed >= g.electionTimeout {
+			g.electionElapsed = 0
+			g.electionTimeout = s.randomElectionTimeout()
+			s.startPreVote(groupID, g)
+		}
+	}
+}
+
+// lastIndexAndTerm returns the index and term of the last entry in the
+// group's stored log.
+func (s *state) lastIndexAndTerm(groupID uint64) (uint64, uint64, error) {
+	gs := s.Storage.GroupStorage(groupID)
+	lastIndex, err := gs.LastIndex()
+	if err != nil {
+		return 0, 0, err
+	}
+	lastTerm, err := gs.Term(lastIndex)
+	if err != nil {
+		return 0, 0, err
+	}
+	return lastIndex, lastTerm, nil
+}
+
+// startPreVote asks the other members of the group whether they would
+// vote for this node in an election at the next term. The election is
+// only called once a quorum has agreed.
+func (s *state) startPreVote(groupID uint64, g *group) {
+	_, cs, err := s.Storage.GroupStorage(groupID).InitialState()
+	if err != nil {
+		log.Warningf("node %v: unable to load members of group %v: %s", s.nodeID, groupID, err)
+		return
+	}
+	isMember := false
+	for _, id := range cs.Nodes {
+		if NodeID(id) == s.nodeID {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return
+	}
+	lastIndex, lastTerm, err := s.lastIndexAndTerm(groupID)
+	if err != nil {
+		log.Warningf("node %v: unable to load last log entry of group %v: %s", s.nodeID, groupID, err)
+		return
+	}
+	status := s.multiNode.Status(groupID)
+	g.preVote = &preVote{
+		term:    status.Term + 1,
+		quorum:  quorum(len(cs.Nodes)),
+		granted: map[NodeID]struct{}{s.nodeID: {}},
+	}
+	if s.maybeCampaign(groupID, g) {
+		return
+	}
+	log.V(3).Infof("node %v: starting pre-vote for group %v at term %d", s.nodeID, groupID, g.preVote.term)
+	for _, id := range cs.Nodes {
+		if NodeID(id) == s.nodeID {
+			continue
+		}
+		err := s.Transport.Send(NodeID(id), &RaftMessageRequest{
+			GroupID: groupID,
+			Message: raftpb.Message{
+				Type:    msgPreVote,
+				From:    uint64(s.nodeID),
+				To:      id,
+				Term:    g.preVote.term,
+				Index:   lastIndex,
+				LogTerm: lastTerm,
+			},
+		})
+		if err != nil {
+			log.Warningf("node %v: error sending pre-vote to %v: %s", s.nodeID, id, err)
+		}
+	}
+}
+
+// handlePreVote responds to a pre-vote request. The request is granted
+// if this node has not heard from a live leader of the group, the
+// prospective term is ahead of its own and the candidate's log is at
+// least as up to date as its own.
+func (s *state) handlePreVote(req *RaftMessageRequest) {
+	m := req.Message
+	grant := false
+	g, ok := s.groups[req.GroupID]
+	if ok && g.leader == s.nodeID {
+		// A member which wants to call an election has missed the
+		// leader's heartbeats, so the group can't stay quiescent.
+		s.unquiesce(req.GroupID, g)
+	}
+	if ok && !s.hasLiveLeader(g) {
+		if status := s.multiNode.Status(req.GroupID); m.Term > status.Term {
+			lastIndex, lastTerm, err := s.lastIndexAndTerm(req.GroupID)
+			if err != nil {
+				log.Warningf("node %v: unable to load last log entry of group %v: %s", s.nodeID, req.GroupID, err)
+			} else {
+				grant = m.LogTerm > lastTerm || (m.LogTerm == lastTerm && m.Index >= lastIndex)
+			}
+		}
+	}
+	log.V(3).Infof("node %v: pre-vote from %v for group %v at term %d granted: %t",
+		s.nodeID, m.From, req.GroupID, m.Term, grant)
+	err := s.Transport.Send(NodeID(m.From), &RaftMessageRequest{
+		GroupID: req.GroupID,
+		Message: raftpb.Message{
+			Type:   msgPreVoteResp,
+			From:   uint64(s.nodeID),
+			To:     m.From,
+			Term:   m.Term,
+			Reject: !grant,
+		},
+	})
+	if err != nil {
+		log.Warningf("node %v: error sending pre-vote response to %v: %s", s.nodeID, m.From, err)
+	}
+}
+
+// handlePreVoteResponse records a response to this node's pre-vote and
+// calls the election once a quorum has granted it.
+func (s *state) handlePreVoteResponse(req *RaftMessageRequest) {
+	g, ok := s.groups[req.GroupID]
+	if !ok || g.preVote == nil || g.preVote.term != req.Message.Term || req.Message.Reject {
+		return
+	}
+	g.preVote.granted[NodeID(req.Message.From)] = struct{}{}
+	s.maybeCampaign(req.GroupID, g)
+}
+
+// maybeCampaign calls an election for the group if its pre-vote has
+// been granted by a quorum. Returns true if an election was called.
+func (s *state) maybeCampaign(groupID uint64, g *group) bool {
+	if len(g.preVote.granted) < g.preVote.quorum {
+		return false
+	}
+	g.preVote = nil
+	log.V(3).Infof("node %v: pre-vote for group %v succeeded; campaigning", s.nodeID, groupID)
+	if err := s.multiNode.Campaign(context.Background(), groupID); err != nil {
+		log.Errorf("node %v: error campaigning for group %v: %s", s.nodeID, groupID, err)
+	}
+	return true
+}
+
+// checkQuorum steps down from the leadership of every group in which
+// this node has not heard from a quorum of members since the last check.
+func (s *state) checkQuorum() {
+	for groupID, g := range s.groups {
+		active := g.recentActive
+		g.recentActive = map[NodeID]struct{}{}
+		if g.leader != s.nodeID || g.quiescent {
+			continue
+		}
+		status := s.multiNode.Status(groupID)
+		if status.RaftState != raft.StateLeader {
+			continue
+		}
+		count := 0
+		for id := range status.Progress {
+			if _, ok := active[NodeID(id)]; ok || NodeID(id) == s.nodeID {
+				count++
+			}
+		}
+		if count >= quorum(len(status.Progress)) {
+			continue
+		}
+		log.Infof("node %v: stepping down as leader of group %v; heard from %d of %d members",
+			s.nodeID, groupID, count, len(status.Progress))
+		s.stepDowns[groupID] = struct{}{}
+	}
+}
+
+// stepDown turns this node from the leader of each group marked by
+// checkQuorum into a follower without a leader at the same term. Raft
+// has no message which makes a leader step down without moving to a
+// higher term, so the group's raft state is reloaded from storage,
+// which is equivalent to the node restarting. This must only be called
+// while no ready state is being persisted, as any which raft hasn't
+// handed out yet is discarded along with the unsent messages it holds.
+func (s *state) stepDown() {
+	for groupID := range s.stepDowns {
+		delete(s.stepDowns, groupID)
+		g, ok := s.groups[groupID]
+		if !ok || g.leader != s.nodeID {
+			continue
+		}
+		if status := s.multiNode.Status(groupID); status.RaftState != raft.StateLeader {
+			continue
+		}
+		raftCfg, err := s.raftConfig(groupID)
+		if err == nil {
+			if err = s.multiNode.RemoveGroup(groupID); err == nil {
+				err = s.multiNode.CreateGroup(groupID, raftCfg, nil)
+			}
+		}
+		if err != nil {
+			log.Errorf("node %v: error stepping down as leader of group %v: %s", s.nodeID, groupID, err)
+			continue
+		}
+		g.leader = 0
+		g.leaderElapsed = 0
+		g.electionElapsed = 0
+		g.preVote = nil
+		g.transferTarget = 0
+	}
+}
+
+// transferLeadership starts handing the leadership of a locally-led
+// group over to the given member.
+func (s *state) transferLeadership(groupID uint64, nodeID NodeID) error {
+	g, ok := s.groups[groupID]
+	if !ok {
+		return util.Errorf("group %d not found", groupID)
+	}
+	if nodeID == s.nodeID && g.leader == s.nodeID {
+		return nil
+	}
+	status := s.multiNode.Status(groupID)
+	if status.RaftState != raft.StateLeader {
+		return util.Errorf("node %v is not the leader of group %d", s.nodeID, groupID)
+	}
+	pr, ok := status.Progress[uint64(nodeID)]
+	if !ok {
+		return util.Errorf("node %v is not a member of group %d", nodeID, groupID)
+	}
+	if lastIndex := status.Progress[uint64(s.nodeID)].Match; pr.Match != lastIndex {
+		return util.Errorf("node %v has not caught up with the log of group %d (%d of %d)",
+			nodeID, groupID, pr.Match, lastIndex)
+	}
+	log.Infof("node %v: transferring leadership of group %v to %v", s.nodeID, groupID, nodeID)
+	s.unquiesce(groupID, g)
+	g.transferTarget = nodeID
+	g.transferElapsed = 0
+	for id := range status.Progress {
+		if NodeID(id) == s.nodeID {
+			continue
+		}
+		err := s.Transport.Send(NodeID(id), &RaftMessageRequest{
+			GroupID: groupID,
+			Message: raftpb.Message{
+				Type:  msgTransferLeader,
+				From:  uint64(s.nodeID),
+				To:    id,
+				Term:  status.Term,
+				Index: uint64(nodeID),
+			},
+		})
+		if err != nil {
+			log.Warningf("node %v: error sending leadership transfer to %v: %s", s.nodeID, id, err)
+		}
+	}
+	return nil
+}
+
+// handleTransferLeader records a leadership transfer announced by the
+// group's leader, so that the vote requests of the new leader are not
+// ignored. The new leader itself calls the election.
+func (s *state) handleTransferLeader(req *RaftMessageRequest) {
+	m := req.Message
+	g, ok := s.groups[req.GroupID]
+	if !ok || NodeID(m.From) != g.leader {
+		return
+	}
+	s.unquiesce(req.GroupID, g)
+	g.transferTarget = NodeID(m.Index)
+	g.transferElapsed = 0
+	if g.transferTarget != s.nodeID {
+		return
+	}
+	log.Infof("node %v: taking over leadership of group %v from %v", s.nodeID, req.GroupID, m.From)
+	g.preVote = nil
+	if err := s.multiNode.Campaign(context.Background(), req.GroupID); err != nil {
+		log.Errorf("node %v: error campaigning for group %v: %s", s.nodeID, req.GroupID, err)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/coreos/etcd/raft"
+)
+
+// partitionTransport is a Transport which drops all messages to or
+// from isolated nodes.
+type partitionTransport struct {
+	Transport
+	mu       sync.Mutex
+	isolated map[NodeID]bool
+}
+
+func newPartitionTransport() *partitionTransport {
+	return &partitionTransport{
+		Transport: NewLocalRPCTransport(),
+		isolated:  map[NodeID]bool{},
+	}
+}
+
+func (pt *partitionTransport) isolate(nodeID NodeID, isolated bool) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.isolated[nodeID] = isolated
+}
+
+func (pt *partitionTransport) Send(id NodeID, req *RaftMessageRequest) error {
+	pt.mu.Lock()
+	drop := pt.isolated[id] || pt.isolated[NodeID(req.Message.From)]
+	pt.mu.Unlock()
+	if drop {
+		return nil
+	}
+	return pt.Transport.Send(id, req)
+}
+
+func enableElectionChecks(config *Config) {
+	config.PreVote = true
+	config.CheckQuorum = true
+}
+
+// TestPreVoteElection verifies that a leader is elected by way of a
+// pre-vote when raft's own election timer is disabled.
+func TestPreVoteElection(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	cluster := newTestClusterWithConfig(nil, 3, stopper, t, enableElectionChecks)
+	defer stopper.Stop()
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+
+	for i := 0; i < 1000; i++ {
+		select {
+		case e := <-cluster.events[0].LeaderElection:
+			if e.NodeID == 0 {
+				continue
+			}
+			if e.NodeID != cluster.nodes[0].nodeID {
+				t.Fatalf("expected %v to win election, but was %v", cluster.nodes[0].nodeID, e.NodeID)
+			}
+			return
+		case <-time.After(10 * time.Millisecond):
+			cluster.tickers[0].Tick()
+		}
+	}
+	t.Fatal("no leader elected")
+}
+
+// TestPreVoteNoDisruption verifies that a partitioned member neither
+// advances the group's term while isolated nor disrupts the leader
+// once reconnected.
+func TestPreVoteNoDisruption(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	transport := newPartitionTransport()
+	cluster := newTestClusterWithConfig(transport, 3, stopper, t, enableElectionChecks)
+	defer stopper.Stop()
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+
+	cluster.triggerElection(0, groupID)
+	cluster.waitForElection(0)
+	term := cluster.nodes[0].multiNode.Status(groupID).Term
+
+	// Run down the election clock of node 2 while it is partitioned, and
+	// again once it has been reconnected. The other members have a live
+	// leader and refuse its pre-votes.
+	transport.isolate(cluster.nodes[2].nodeID, true)
+	for i := 0; i < 10*cluster.nodes[2].ElectionTimeoutTicks; i++ {
+		cluster.tickers[2].Tick()
+	}
+	transport.isolate(cluster.nodes[2].nodeID, false)
+	for i := 0; i < 10*cluster.nodes[2].ElectionTimeoutTicks; i++ {
+		cluster.tickers[2].Tick()
+	}
+
+	// A command proposed to the original leader commits everywhere.
+	cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("command"))
+	for i := range cluster.nodes {
+		for committed := false; !committed; {
+			select {
+			case <-cluster.events[i].CommandCommitted:
+				committed = true
+			case <-time.After(10 * time.Millisecond):
+				cluster.tickers[0].Tick()
+			}
+		}
+	}
+
+	for i, node := range cluster.nodes {
+		status := node.multiNode.Status(groupID)
+		if status.Term != term {
+			t.Errorf("node %d: expected term %d, got %d", i, term, status.Term)
+		}
+		if NodeID(status.Lead) != cluster.nodes[0].nodeID {
+			t.Errorf("node %d: expected leader %v, got %v", i, cluster.nodes[0].nodeID, status.Lead)
+		}
+	}
+}
+
+// TestCheckQuorum verifies that a leader which cannot reach a quorum of
+// its group steps down without moving to a higher term.
+func TestCheckQuorum(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	transport := newPartitionTransport()
+	cluster := newTestClusterWithConfig(transport, 3, stopper, t, enableElectionChecks)
+	defer stopper.Stop()
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+
+	cluster.triggerElection(0, groupID)
+	cluster.waitForElection(0)
+	term := cluster.nodes[0].multiNode.Status(groupID).Term
+
+	transport.isolate(cluster.nodes[0].nodeID, true)
+	util.SucceedsWithin(t, time.Second, func() error {
+		cluster.tickers[0].Tick()
+		if status := cluster.nodes[0].multiNode.Status(groupID); status.RaftState == raft.StateLeader {
+			return util.Errorf("node %v is still leader at term %d", cluster.nodes[0].nodeID, status.Term)
+		}
+		return nil
+	})
+	if status := cluster.nodes[0].multiNode.Status(groupID); status.Term != term {
+		t.Errorf("expected node %v to step down at term %d; got term %d", cluster.nodes[0].nodeID, term, status.Term)
+	}
+}
+
+// TestTransferLeadership verifies that leadership can be handed over to
+// a chosen member despite the rest of the group having a live leader.
+func TestTransferLeadership(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	cluster := newTestClusterWithConfig(nil, 3, stopper, t, enableElectionChecks)
+	defer stopper.Stop()
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+
+	cluster.triggerElection(0, groupID)
+	cluster.waitForElection(0)
+
+	if err := cluster.nodes[1].TransferLeadership(groupID, cluster.nodes[2].nodeID); err == nil {
+		t.Error("expected error transferring leadership from a follower")
+	}
+
+	target := cluster.nodes[2].nodeID
+	util.SucceedsWithin(t, time.Second, func() error {
+		// The target may not have acknowledged the leader's first entry yet.
+		return cluster.nodes[0].TransferLeadership(groupID, target)
+	})
+	for {
+		if e := cluster.waitForElection(2); e.NodeID == target {
+			break
+		}
+	}
+	for i, node := range cluster.nodes {
+		util.SucceedsWithin(t, time.Second, func() error {
+			if lead := NodeID(node.multiNode.Status(groupID).Lead); lead != target {
+				return util.Errorf("node %d: expected leader %v, got %v", i, target, lead)
+			}
+			return nil
+		})
+	}
+}
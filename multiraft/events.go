@@ -18,6 +18,7 @@
 package multiraft
 
 import (
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/coreos/etcd/raft/raftpb"
 )
@@ -85,3 +86,18 @@ func decodeCommand(data []byte) (commandID string, command []byte) {
 	}
 	return string(data[1 : 1+commandIDLen]), data[1+commandIDLen:]
 }
+
+// DecodeCommand splits the data of a Raft log entry into its command
+// ID and command. Unlike the decoding applied to committed entries, it
+// returns an error for malformed data, for use by tools which read the
+// log of a stopped store.
+func DecodeCommand(data []byte) (commandID string, command []byte, err error) {
+	if len(data) < 1+commandIDLen {
+		return "", nil, util.Errorf("command of %d bytes is too short", len(data))
+	}
+	if data[0] != commandEncodingVersion {
+		return "", nil, util.Errorf("unknown command encoding version %v", data[0])
+	}
+	commandID, command = decodeCommand(data)
+	return commandID, command, nil
+}
@@ -19,8 +19,10 @@ package multiraft
 
 import (
 	"fmt"
+	"testing"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
 // eventDemux turns the unified MultiRaft.Events stream into a set of type-safe
@@ -71,3 +73,20 @@ func (e *eventDemux) start(stopper *util.Stopper) {
 		}
 	})
 }
+
+func TestDecodeCommand(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	commandID := "0123456789abcdef"
+	id, cmd, err := DecodeCommand(encodeCommand(commandID, []byte("command")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != commandID || string(cmd) != "command" {
+		t.Errorf("expected %q, %q; got %q, %q", commandID, "command", id, cmd)
+	}
+	for _, data := range [][]byte{nil, []byte("short"), append([]byte{1}, commandID...)} {
+		if _, _, err := DecodeCommand(data); err == nil {
+			t.Errorf("expected error decoding %q", data)
+		}
+	}
+}
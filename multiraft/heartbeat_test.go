@@ -328,3 +328,44 @@ func TestHeartbeatMultipleGroupsJointLeader(t *testing.T) {
 	<-done
 	stopper.Stop()
 }
+
+// TestHeartbeatResponsesCoalesced verifies that a follower sends a
+// single heartbeat response per coalesced heartbeat, no matter how many
+// of the leader's groups it is a member of.
+func TestHeartbeatResponsesCoalesced(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	cluster := newBlockingCluster(3, stopper, t)
+	transport := cluster.transport.(*localInterceptableTransport)
+	done := make(chan struct{})
+	const groupCount, tickCount = 3, 4
+	go func() {
+		for groupID := uint64(1); groupID <= groupCount; groupID++ {
+			cluster.createGroup(groupID, 0, 3)
+			cluster.triggerElection(0, groupID)
+			if el := cluster.waitForElection(0); el.NodeID != 1 {
+				t.Errorf("wrong leader elected, wanted node 1 but got event %v", el)
+			}
+		}
+		for i := 0; i < tickCount; i++ {
+			cluster.tickers[0].Tick()
+		}
+		close(done)
+	}()
+
+	expCnt := heartbeatCountMap{
+		1: {reqOut: 2 * tickCount, reqIn: 0, respOut: 0, respIn: 2 * tickCount},
+		2: {reqOut: 0, reqIn: tickCount, respOut: tickCount, respIn: 0},
+		3: {reqOut: 0, reqIn: tickCount, respOut: tickCount, respIn: 0},
+	}
+	actCnt := countHeartbeats(transport.Events,
+		func(req *RaftMessageRequest, cnt heartbeatCountMap) bool {
+			return cnt.Sum() >= expCnt.Sum()
+		})
+	if !reflect.DeepEqual(actCnt, expCnt) {
+		t.Errorf("expected and actual heartbeat counts differ:\n%v\n%v", expCnt, actCnt)
+	}
+	go processEventsUntil(transport.Events, stopper, alwaysFalse)
+	<-done
+	stopper.Stop()
+}
@@ -0,0 +1,258 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// ConfChangeAddLearnerNode is the type of a membership change which adds
+// a learner to a group: a non-voting member which receives a snapshot
+// and the group's log from the leader, but neither counts toward quorum
+// nor campaigns. A learner is promoted to a voter by a subsequent
+// ConfChangeAddNode for the same node and removed by
+// ConfChangeRemoveNode.
+//
+// Raft itself knows nothing of learners; the leader's multiraft
+// replicates to them directly. Learners are not persisted, so a new
+// leader or a restarted node only knows of a learner once the change
+// adding it is applied again.
+const ConfChangeAddLearnerNode = raftpb.ConfChangeType(100)
+
+// maxLearnerMsgSize bounds the size of the entries sent to a learner in
+// a single message.
+const maxLearnerMsgSize = 1024 * 1024
+
+// learnerProgress tracks the replication of a group's log to a learner.
+type learnerProgress struct {
+	// match is the highest index known to be in the learner's log and
+	// next is the index of the next entry to send it; zero until the
+	// learner is first contacted.
+	match, next uint64
+	// commit is the commit index most recently sent to the learner.
+	commit uint64
+	// inflight is true while an append is outstanding and snapshotting
+	// while a snapshot is being sent.
+	inflight     bool
+	snapshotting bool
+}
+
+type learnerStatusOp struct {
+	groupID uint64
+	nodeID  NodeID
+	ch      chan learnerStatus
+}
+
+type learnerStatus struct {
+	caughtUp bool
+	err      error
+}
+
+// LearnerCaughtUp returns whether the given learner of a group led by
+// this node holds every entry committed so far, and may therefore be
+// promoted to a voter without lowering the group's availability.
+func (m *MultiRaft) LearnerCaughtUp(groupID uint64, nodeID NodeID) (bool, error) {
+	op := &learnerStatusOp{
+		groupID: groupID,
+		nodeID:  nodeID,
+		ch:      make(chan learnerStatus, 1),
+	}
+	m.learnerChan <- op
+	status := <-op.ch
+	return status.caughtUp, status.err
+}
+
+// learnerCaughtUp implements MultiRaft.LearnerCaughtUp.
+func (s *state) learnerCaughtUp(groupID uint64, nodeID NodeID) (bool, error) {
+	g, ok := s.groups[groupID]
+	if !ok {
+		return false, util.Errorf("group %d not found", groupID)
+	}
+	lp, ok := g.learners[nodeID]
+	if !ok {
+		return false, util.Errorf("node %v is not a learner of group %d", nodeID, groupID)
+	}
+	status := s.multiNode.Status(groupID)
+	if status.RaftState != raft.StateLeader {
+		return false, util.Errorf("node %v is not the leader of group %d", s.nodeID, groupID)
+	}
+	return lp.match >= status.Commit, nil
+}
+
+// addLearner records a learner of the given group.
+func (s *state) addLearner(groupID uint64, nodeID NodeID) error {
+	g, ok := s.groups[groupID]
+	if !ok {
+		return util.Errorf("group %d not found", groupID)
+	}
+	if err := s.addNode(nodeID, groupID); err != nil {
+		return err
+	}
+	if g.learners == nil {
+		g.learners = map[NodeID]*learnerProgress{}
+	}
+	if _, ok := g.learners[nodeID]; !ok {
+		g.learners[nodeID] = &learnerProgress{}
+	}
+	return nil
+}
+
+// replicateToLearners sends any entries and commit index updates the
+// learners of every locally-led group are missing. Appends which have
+// gone unacknowledged since the last call are assumed lost and resent.
+func (s *state) replicateToLearners() {
+	for groupID, g := range s.groups {
+		if g.leader != s.nodeID {
+			continue
+		}
+		for nodeID, lp := range g.learners {
+			lp.inflight = false
+			s.sendToLearner(groupID, nodeID, lp)
+		}
+	}
+}
+
+// sendToLearner sends the learner the entries (or, if those have been
+// truncated, the snapshot) which it is missing, unless a message to it
+// is already outstanding or it is up to date.
+func (s *state) sendToLearner(groupID uint64, nodeID NodeID, lp *learnerProgress) {
+	if nodeID == s.nodeID || lp.inflight || lp.snapshotting {
+		return
+	}
+	status := s.multiNode.Status(groupID)
+	if status.RaftState != raft.StateLeader {
+		return
+	}
+	gs := s.Storage.GroupStorage(groupID)
+	firstIndex, err := gs.FirstIndex()
+	if err != nil {
+		log.Errorf("node %v: error reading log of group %v: %s", s.nodeID, groupID, err)
+		return
+	}
+	lastIndex, err := gs.LastIndex()
+	if err != nil {
+		log.Errorf("node %v: error reading log of group %v: %s", s.nodeID, groupID, err)
+		return
+	}
+	if lp.next == 0 {
+		// Start by probing at the end of the log, as raft does for a
+		// newly added voter.
+		lp.next = lastIndex + 1
+	}
+	if lp.next > lastIndex && lp.commit >= status.Commit {
+		return
+	}
+	msg := raftpb.Message{
+		From: uint64(s.nodeID),
+		To:   uint64(nodeID),
+		Term: status.Term,
+	}
+
+	var logTerm uint64
+	var entries []raftpb.Entry
+	if lp.next >= firstIndex {
+		if logTerm, err = gs.Term(lp.next - 1); err == nil && lp.next <= lastIndex {
+			entries, err = gs.Entries(lp.next, lastIndex+1, maxLearnerMsgSize)
+		}
+	}
+	if lp.next < firstIndex || err == raft.ErrCompacted {
+		s.sendLearnerSnapshot(groupID, nodeID, lp, msg)
+		return
+	} else if err != nil {
+		log.Errorf("node %v: error reading log of group %v: %s", s.nodeID, groupID, err)
+		return
+	}
+
+	msg.Type = raftpb.MsgApp
+	msg.Index = lp.next - 1
+	msg.LogTerm = logTerm
+	msg.Entries = entries
+	msg.Commit = status.Commit
+	lp.inflight = true
+	lp.commit = status.Commit
+	if err := s.Transport.Send(nodeID, &RaftMessageRequest{groupID, msg}); err != nil {
+		log.Warningf("node %v failed to send entries to learner %v: %s", s.nodeID, nodeID, err)
+	}
+}
+
+// sendLearnerSnapshot sends a snapshot of the group to a learner whose
+// log is too far behind to be caught up with entries.
+func (s *state) sendLearnerSnapshot(groupID uint64, nodeID NodeID, lp *learnerProgress,
+	msg raftpb.Message) {
+	snap, err := s.Storage.GroupStorage(groupID).Snapshot()
+	if err != nil {
+		log.Errorf("node %v: error generating snapshot of group %v: %s", s.nodeID, groupID, err)
+		return
+	}
+	if raft.IsEmptySnap(snap) {
+		log.Warningf("node %v: no snapshot of group %v to send to learner %v", s.nodeID, groupID, nodeID)
+		return
+	}
+	msg.Type = raftpb.MsgSnap
+	msg.Snapshot = snap
+	lp.snapshotting = true
+	// Learners are added by background work such as up-replication, so
+	// their snapshots yield to those catching up lagging voters.
+	s.sendSnapshot(groupID, msg, true, func(snapStatus raft.SnapshotStatus) {
+		lp.snapshotting = false
+		if snapStatus == raft.SnapshotFinish && snap.Metadata.Index >= lp.next {
+			lp.next = snap.Metadata.Index + 1
+		}
+	})
+}
+
+// handleLearnerResponse handles a learner's response to an append or
+// snapshot, returning false if the message is not from a learner of
+// the group.
+func (s *state) handleLearnerResponse(req *RaftMessageRequest) bool {
+	g, ok := s.groups[req.GroupID]
+	if !ok {
+		return false
+	}
+	nodeID := NodeID(req.Message.From)
+	lp, ok := g.learners[nodeID]
+	if !ok {
+		return false
+	}
+	m := req.Message
+	lp.inflight = false
+	if m.Reject {
+		// Probe backwards as raft does for voters, ignoring rejections of
+		// anything but the most recent append.
+		if lp.next-1 == m.Index {
+			lp.next = m.Index
+			if m.RejectHint+1 < lp.next {
+				lp.next = m.RejectHint + 1
+			}
+			if lp.next < 1 {
+				lp.next = 1
+			}
+			lp.commit = 0
+		}
+	} else if m.Index > lp.match {
+		lp.match = m.Index
+		lp.next = m.Index + 1
+	}
+	if g.leader == s.nodeID {
+		s.sendToLearner(req.GroupID, nodeID, lp)
+	}
+	return true
+}
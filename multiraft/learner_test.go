@@ -0,0 +1,125 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// TestLearner verifies that a learner is caught up with a snapshot and
+// the log without becoming a voting member, and joins the group's
+// configuration once promoted.
+func TestLearner(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	cluster := newTestCluster(nil, 2, stopper, t)
+	defer stopper.Stop()
+
+	// Create a group with a single member, cluster.nodes[0], which
+	// elects itself.
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 1)
+	cluster.waitForElection(0)
+
+	for i := range cluster.nodes {
+		go func(i int) {
+			for {
+				e, ok := <-cluster.events[i].MembershipChangeCommitted
+				if !ok {
+					return
+				}
+				e.Callback(nil)
+			}
+		}(i)
+	}
+
+	if err := <-cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("first")); err != nil {
+		t.Fatal(err)
+	}
+	<-cluster.events[0].CommandCommitted
+
+	learner := cluster.nodes[1].nodeID
+	if err := <-cluster.nodes[0].ChangeGroupMembership(groupID, makeCommandID(),
+		ConfChangeAddLearnerNode, learner, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The learner receives a snapshot followed by the log, and applies
+	// the command committed before it joined.
+	util.SucceedsWithin(t, time.Second, func() error {
+		cluster.tickers[0].Tick()
+		select {
+		case commit := <-cluster.events[1].CommandCommitted:
+			if string(commit.Command) != "first" {
+				t.Fatalf("unexpected command committed on learner: %q", commit.Command)
+			}
+			return nil
+		default:
+			return util.Errorf("learner has not applied command")
+		}
+	})
+	util.SucceedsWithin(t, time.Second, func() error {
+		cluster.tickers[0].Tick()
+		caughtUp, err := cluster.nodes[0].LearnerCaughtUp(groupID, learner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !caughtUp {
+			return util.Errorf("learner has not caught up")
+		}
+		return nil
+	})
+
+	// The learner is not part of raft's configuration, so commands keep
+	// committing with the leader's vote alone.
+	if status := cluster.nodes[0].multiNode.Status(groupID); len(status.Progress) != 1 {
+		t.Fatalf("expected only the leader to vote; got progress %v", status.Progress)
+	}
+	if err := <-cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("second")); err != nil {
+		t.Fatal(err)
+	}
+	<-cluster.events[0].CommandCommitted
+
+	// Once promoted, the former learner is a voter.
+	if err := <-cluster.nodes[0].ChangeGroupMembership(groupID, makeCommandID(),
+		raftpb.ConfChangeAddNode, learner, nil); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if status := cluster.nodes[0].multiNode.Status(groupID); len(status.Progress) != 2 {
+			return util.Errorf("expected two voters; got progress %v", status.Progress)
+		}
+		return nil
+	})
+	if _, err := cluster.nodes[0].LearnerCaughtUp(groupID, learner); err == nil {
+		t.Error("expected error for promoted learner")
+	}
+	if err := <-cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("third")); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"second", "third"} {
+		if commit := <-cluster.events[1].CommandCommitted; string(commit.Command) != expected {
+			t.Errorf("expected %q to commit on the promoted learner; got %q", expected, commit.Command)
+		}
+	}
+}
@@ -20,6 +20,8 @@ package multiraft
 import (
 	"fmt"
 	"math"
+	"math/rand"
+	"sort"
 	"strconv"
 	"time"
 
@@ -65,6 +67,45 @@ type Config struct {
 	// sanity checks will be done.
 	Strict bool
 
+	// If PreVote is true, a member whose election timeout elapses first
+	// asks the rest of the group whether it would win an election, and
+	// only calls one (incrementing the term) once a quorum has agreed.
+	// Members which have heard from a live leader refuse, so a
+	// partitioned or restarted replica cannot force a new term upon a
+	// healthy group.
+	PreVote bool
+	// If CheckQuorum is true, a leader which has not heard from a quorum
+	// of its group within an election timeout steps down, and members
+	// which have heard from a live leader within the election timeout
+	// ignore requests to vote for a different one.
+	CheckQuorum bool
+	// If Quiesce is true, the leader of a group which has been idle for
+	// an election timeout quiesces it: the group stops running its
+	// election clock and is left out of heartbeat fan-out until a new
+	// proposal or message arrives for it, or until the leader's node
+	// misses its coalesced heartbeats. Requires PreVote, as raft must
+	// not run election timers of its own.
+	Quiesce bool
+
+	// MaxIncomingSnapshots and MaxOutgoingSnapshots limit the number of
+	// snapshots this node receives and sends concurrently. A sender
+	// reserves a slot on the recipient through its Transport before
+	// transmitting a snapshot; snapshots which cannot be admitted are
+	// reported as failed and retried by raft later. Zero means no limit.
+	MaxIncomingSnapshots int
+	MaxOutgoingSnapshots int
+	// SnapshotBytesPerSecond throttles the rate at which this node sends
+	// snapshot data. Zero means no limit.
+	SnapshotBytesPerSecond int64
+
+	// If ManualScheduling is true, the node does not run a goroutine of
+	// its own. Its messages, operations and write-backs are processed
+	// one at a time by the caller through the Scheduler returned by
+	// MultiRaft.Scheduler, and time only advances when Scheduler.Tick is
+	// called, so that tests can replay an interleaving of several nodes
+	// exactly. Ticker and TickInterval are unused.
+	ManualScheduling bool
+
 	EntryFormatter raft.EntryFormatter
 }
 
@@ -80,9 +121,18 @@ func (c *Config) validate() error {
 	if c.HeartbeatIntervalTicks == 0 {
 		return util.Error("HeartbeatIntervalTicks must be non-zero")
 	}
-	if c.TickInterval == 0 {
+	if c.TickInterval == 0 && !c.ManualScheduling {
 		return util.Error("TickInterval must be non-zero")
 	}
+	if c.Quiesce && !c.PreVote {
+		return util.Error("Quiesce requires PreVote")
+	}
+	if c.MaxIncomingSnapshots < 0 || c.MaxOutgoingSnapshots < 0 {
+		return util.Error("snapshot limits must not be negative")
+	}
+	if c.SnapshotBytesPerSecond < 0 {
+		return util.Error("SnapshotBytesPerSecond must not be negative")
+	}
 	return nil
 }
 
@@ -96,9 +146,18 @@ type MultiRaft struct {
 	reqChan         chan *RaftMessageRequest
 	createGroupChan chan *createGroupOp
 	removeGroupChan chan *removeGroupOp
+	transferChan    chan *transferLeadershipOp
+	learnerChan     chan *learnerStatusOp
+	statusChan      chan *groupStatusOp
 	proposalChan    chan *proposal
+	// backgroundProposalChan holds proposals which yield to those on
+	// proposalChan.
+	backgroundProposalChan chan *proposal
 	// callbackChan is a generic hook to run a callback in the raft thread.
 	callbackChan chan func()
+	snapshots    *snapshotLimiter
+	// scheduler is set once a manually scheduled node has been started.
+	scheduler *Scheduler
 }
 
 // multiraftServer is a type alias to separate RPC methods
@@ -115,7 +174,7 @@ func NewMultiRaft(nodeID NodeID, config *Config) (*MultiRaft, error) {
 		return nil, err
 	}
 
-	if config.Ticker == nil {
+	if config.Ticker == nil && !config.ManualScheduling {
 		config.Ticker = newTicker(config.TickInterval)
 	}
 
@@ -133,15 +192,21 @@ func NewMultiRaft(nodeID NodeID, config *Config) (*MultiRaft, error) {
 	}
 
 	m := &MultiRaft{
-		Config:          *config,
-		multiNode:       raft.StartMultiNode(uint64(nodeID)),
-		nodeID:          nodeID,
-		Events:          make(chan interface{}, 1000),
-		reqChan:         make(chan *RaftMessageRequest, 100),
-		createGroupChan: make(chan *createGroupOp, 100),
-		removeGroupChan: make(chan *removeGroupOp, 100),
-		proposalChan:    make(chan *proposal, 100),
-		callbackChan:    make(chan func(), 100),
+		Config:                 *config,
+		multiNode:              raft.StartMultiNode(uint64(nodeID)),
+		nodeID:                 nodeID,
+		Events:                 make(chan interface{}, 1000),
+		reqChan:                make(chan *RaftMessageRequest, 100),
+		createGroupChan:        make(chan *createGroupOp, 100),
+		removeGroupChan:        make(chan *removeGroupOp, 100),
+		transferChan:           make(chan *transferLeadershipOp, 100),
+		learnerChan:            make(chan *learnerStatusOp, 100),
+		statusChan:             make(chan *groupStatusOp, 100),
+		proposalChan:           make(chan *proposal, 100),
+		backgroundProposalChan: make(chan *proposal, 100),
+		callbackChan:           make(chan func(), 100),
+		snapshots: newSnapshotLimiter(config.MaxIncomingSnapshots,
+			config.MaxOutgoingSnapshots, config.SnapshotBytesPerSecond),
 	}
 
 	err = m.Transport.Listen(nodeID, (*multiraftServer)(m))
@@ -163,7 +228,28 @@ func (m *MultiRaft) Start(stopper *util.Stopper) error {
 // when we receive a message.
 func (ms *multiraftServer) RaftMessage(req *RaftMessageRequest,
 	resp *RaftMessageResponse) error {
-	ms.reqChan <- req
+	if !ms.ManualScheduling {
+		ms.reqChan <- req
+		return nil
+	}
+	// The sender may be stepped on the same goroutine as this node, so
+	// a full queue drops the message instead of blocking.
+	select {
+	case ms.reqChan <- req:
+		return nil
+	default:
+		return util.Errorf("node %v: message queue full", ms.nodeID)
+	}
+}
+
+// ReserveSnapshot implements ServerInterface; this method is called by
+// net/rpc when a peer asks to send us a snapshot.
+func (ms *multiraftServer) ReserveSnapshot(req *ReserveSnapshotRequest,
+	resp *ReserveSnapshotResponse) error {
+	resp.Reserved = ms.snapshots.reserveIncoming(req.GroupID)
+	if !resp.Reserved {
+		log.V(4).Infof("node %v declined snapshot for group %v", ms.nodeID, req.GroupID)
+	}
 	return nil
 }
 
@@ -202,18 +288,10 @@ func (s *state) fanoutHeartbeat(req *RaftMessageRequest) {
 		// some group, so we need to respond so it can activate the recovery process.
 		log.Warningf("node %v: not fanning out heartbeat from unknown node %v (but responding anyway)",
 			s.nodeID, fromID)
-		err := s.Transport.Send(fromID, &RaftMessageRequest{
-			GroupID: math.MaxUint64,
-			Message: raftpb.Message{
-				From: uint64(s.nodeID),
-				Type: raftpb.MsgHeartbeatResp,
-			},
-		})
-		if err != nil {
-			log.Errorf("node %v: error sending heartbeat response to %v", s.nodeID, fromID)
-		}
+		s.sendHeartbeatResponse(fromID)
 		return
 	}
+	originNode.heartbeatElapsed = 0
 	cnt := 0
 	for groupID := range originNode.groupIDs {
 		// If we don't think that the sending node is leading that group, don't
@@ -223,15 +301,41 @@ func (s *state) fanoutHeartbeat(req *RaftMessageRequest) {
 				s.nodeID, req.Message.To, fromID, s.groups[groupID].leader)
 			continue
 		}
+		// Quiescent groups only need to know that the leader's node is alive.
+		if s.groups[groupID].quiescent {
+			continue
+		}
 		if err := s.multiNode.Step(context.Background(), groupID, req.Message); err != nil {
-			log.V(4).Infof("node %v: coalesced heartbeat step failed for message %s", s.nodeID, groupID,
+			log.V(4).Infof("node %v: coalesced heartbeat step failed for group %d: %s", s.nodeID, groupID,
 				raft.DescribeMessage(req.Message, s.EntryFormatter))
 		}
+		s.recordContact(groupID, fromID)
 		cnt++
 	}
 	log.V(7).Infof("node %v: received coalesced heartbeat from node %v; "+
 		"fanned out to %d followers in %d overlapping groups",
 		s.nodeID, fromID, cnt, len(originNode.groupIDs))
+	// The responses of the individual groups are dropped; a single
+	// coalesced response is fanned out to the sender's groups instead.
+	if cnt > 0 {
+		s.sendHeartbeatResponse(fromID)
+	}
+}
+
+// sendHeartbeatResponse sends a coalesced heartbeat response to the
+// given node.
+func (s *state) sendHeartbeatResponse(toID NodeID) {
+	err := s.Transport.Send(toID, &RaftMessageRequest{
+		GroupID: math.MaxUint64, // irrelevant
+		Message: raftpb.Message{
+			From: uint64(s.nodeID),
+			To:   uint64(toID),
+			Type: raftpb.MsgHeartbeatResp,
+		},
+	})
+	if err != nil {
+		log.Errorf("node %v: error sending heartbeat response to %v: %s", s.nodeID, toID, err)
+	}
 }
 
 // fanoutHeartbeatResponse sends the given heartbeat response to all groups
@@ -247,15 +351,16 @@ func (s *state) fanoutHeartbeatResponse(req *RaftMessageRequest) {
 	cnt := 0
 	for groupID := range originNode.groupIDs {
 		// If we don't think that the local node is leader, don't propagate.
-		if s.groups[groupID].leader != s.nodeID || fromID == s.nodeID {
+		if s.groups[groupID].leader != s.nodeID || fromID == s.nodeID || s.groups[groupID].quiescent {
 			log.V(8).Infof("node %v: not fanning out heartbeat response to %v, msg is from %d and leader is %d",
 				s.nodeID, req.Message.To, fromID, s.groups[groupID].leader)
 			continue
 		}
 		if err := s.multiNode.Step(context.Background(), groupID, req.Message); err != nil {
-			log.V(4).Infof("node %v: coalesced heartbeat response step failed for message %s", s.nodeID, groupID,
+			log.V(4).Infof("node %v: coalesced heartbeat response step failed for group %d: %s", s.nodeID, groupID,
 				raft.DescribeMessage(req.Message, s.EntryFormatter))
 		}
+		s.recordContact(groupID, fromID)
 		cnt++
 	}
 	log.V(7).Infof("node %v: received coalesced heartbeat response from node %v; "+
@@ -271,6 +376,7 @@ func (m *MultiRaft) CreateGroup(groupID uint64) error {
 		ch:      make(chan error, 1),
 	}
 	m.createGroupChan <- op
+	m.await(func() bool { return len(op.ch) > 0 })
 	return <-op.ch
 }
 
@@ -283,17 +389,72 @@ func (m *MultiRaft) RemoveGroup(groupID uint64) error {
 		ch:      make(chan error, 1),
 	}
 	m.removeGroupChan <- op
+	m.await(func() bool { return len(op.ch) > 0 })
 	return <-op.ch
 }
 
+// TransferLeadership hands the leadership of a group led by this node
+// over to another member. The member must have caught up with the
+// leader's log. It is told to call an election at once, which the rest
+// of the group lets it win even with CheckQuorum enabled; the outcome
+// is reported through the usual EventLeaderElection. This method
+// returns once the transfer has been started.
+func (m *MultiRaft) TransferLeadership(groupID uint64, nodeID NodeID) error {
+	op := &transferLeadershipOp{
+		groupID: groupID,
+		nodeID:  nodeID,
+		ch:      make(chan error, 1),
+	}
+	m.transferChan <- op
+	m.await(func() bool { return len(op.ch) > 0 })
+	return <-op.ch
+}
+
+// GroupStatus returns the state of a group on this node.
+func (m *MultiRaft) GroupStatus(groupID uint64) (*GroupStatus, error) {
+	op := &groupStatusOp{
+		groupID: groupID,
+		ch:      make(chan groupStatusResult, 1),
+	}
+	m.statusChan <- op
+	m.await(func() bool { return len(op.ch) > 0 })
+	result := <-op.ch
+	return result.status, result.err
+}
+
+// await steps a manually scheduled node until done returns true, so
+// that methods which wait for the node to process an operation do not
+// deadlock when called from the goroutine driving its Scheduler. It is
+// a no-op for nodes running their own goroutine.
+func (m *MultiRaft) await(done func() bool) {
+	if m.scheduler == nil {
+		return
+	}
+	for !done() && m.scheduler.Step() {
+	}
+}
+
 // SubmitCommand sends a command (a binary blob) to the cluster. This method returns
 // when the command has been successfully sent, not when it has been committed.
 // An error or nil will be written to the returned channel when the command has
 // been committed or aborted.
 func (m *MultiRaft) SubmitCommand(groupID uint64, commandID string, command []byte) <-chan error {
+	return m.submitCommand(m.proposalChan, groupID, commandID, command)
+}
+
+// SubmitBackgroundCommand is like SubmitCommand, but the command is
+// proposed after the commands submitted via SubmitCommand which are
+// waiting to be proposed, so that background work doesn't hold up
+// foreground traffic.
+func (m *MultiRaft) SubmitBackgroundCommand(groupID uint64, commandID string, command []byte) <-chan error {
+	return m.submitCommand(m.backgroundProposalChan, groupID, commandID, command)
+}
+
+func (m *MultiRaft) submitCommand(proposalChan chan<- *proposal, groupID uint64, commandID string,
+	command []byte) <-chan error {
 	log.V(6).Infof("node %v submitting command to group %v", m.nodeID, groupID)
 	ch := make(chan error, 1)
-	m.proposalChan <- &proposal{
+	proposalChan <- &proposal{
 		groupID:   groupID,
 		commandID: commandID,
 		fn: func() {
@@ -356,6 +517,37 @@ type group struct {
 	// is written to proposal.ch and it is removed from this
 	// map.
 	pending map[string]*proposal
+
+	// leaderElapsed counts the ticks since this node last heard from the
+	// group's leader. electionElapsed counts the ticks since the leader
+	// was last heard from or a pre-vote was last started, and a new
+	// pre-vote is started once it reaches electionTimeout.
+	leaderElapsed   int
+	electionElapsed int
+	electionTimeout int
+
+	// preVote tracks this node's in-progress pre-vote, if any.
+	preVote *preVote
+
+	// recentActive holds the nodes heard from since the last quorum
+	// check.
+	recentActive map[NodeID]struct{}
+
+	// transferTarget is the node which the leader is handing leadership
+	// over to, if any, and transferElapsed counts the ticks since the
+	// transfer began.
+	transferTarget  NodeID
+	transferElapsed int
+
+	// learners tracks the group's non-voting members. Replication to
+	// them is only tracked while this node leads the group.
+	learners map[NodeID]*learnerProgress
+
+	// quiescent is true while the group is quiesced. idleTicks counts
+	// the ticks since a locally-led group last saw a proposal or new
+	// log entries.
+	quiescent bool
+	idleTicks int
 }
 
 type createGroupOp struct {
@@ -368,11 +560,44 @@ type removeGroupOp struct {
 	ch      chan error
 }
 
+// A GroupStatus describes the state of a group on a node.
+type GroupStatus struct {
+	// Status is the state of the node's Raft instance for the group.
+	raft.Status
+	// Leader is the last known leader of the group, or 0 if an
+	// election is in progress.
+	Leader NodeID
+	// PendingCommands is the number of commands proposed but not yet
+	// committed in the current term.
+	PendingCommands int
+	// Quiescent is true while the group is quiesced.
+	Quiescent bool
+}
+
+type groupStatusOp struct {
+	groupID uint64
+	ch      chan groupStatusResult
+}
+
+type groupStatusResult struct {
+	status *GroupStatus
+	err    error
+}
+
+type transferLeadershipOp struct {
+	groupID uint64
+	nodeID  NodeID
+	ch      chan error
+}
+
 // node represents a connection to a remote node.
 type node struct {
 	nodeID   NodeID
 	refCount int
 	groupIDs map[uint64]struct{}
+	// heartbeatElapsed counts the ticks since the last coalesced
+	// heartbeat from this node.
+	heartbeatElapsed int
 }
 
 func (n *node) registerGroup(groupID uint64) {
@@ -393,19 +618,43 @@ type state struct {
 	electionTimer *time.Timer
 	writeTask     *writeTask
 	stopper       *util.Stopper
+	// ticks counts up to the heartbeat interval and is then reset.
+	ticks int
+	// quorumTicks counts up to the election timeout and is then reset.
+	quorumTicks int
+	// stepDowns holds the groups whose leadership checkQuorum gave up
+	// and which are yet to step down.
+	stepDowns map[uint64]struct{}
+	// rand, if set, randomizes election timeouts in place of the global
+	// source. Manually scheduled nodes seed it with their ID.
+	rand *rand.Rand
 }
 
 func newState(m *MultiRaft) *state {
-	return &state{
+	s := &state{
 		MultiRaft: m,
 		groups:    make(map[uint64]*group),
 		nodes:     make(map[NodeID]*node),
 		writeTask: newWriteTask(m.Storage),
+		stepDowns: make(map[uint64]struct{}),
 	}
+	if m.ManualScheduling {
+		s.rand = rand.New(rand.NewSource(int64(m.nodeID)))
+	}
+	return s
 }
 
 func (s *state) start(stopper *util.Stopper) {
 	s.stopper = stopper
+	if s.ManualScheduling {
+		s.MultiRaft.scheduler = &Scheduler{s}
+		stopper.RunWorker(func() {
+			<-stopper.ShouldStop()
+			log.V(6).Infof("node %v: stopping", s.nodeID)
+			s.stop()
+		})
+		return
+	}
 	stopper.RunWorker(func() {
 		log.V(1).Infof("node %v starting", s.nodeID)
 		s.writeTask.start(s.stopper)
@@ -414,8 +663,6 @@ func (s *state) start(stopper *util.Stopper) {
 		// way through the rest of the pipeline.
 		var readyGroups map[uint64]raft.Ready
 		var writingGroups map[uint64]raft.Ready
-		// Counts up to heartbeat interval and is then reset.
-		ticks := 0
 		for {
 			// raftReady signals that the Raft state machine has pending
 			// work. That work is supplied over the raftReady channel as a map
@@ -436,6 +683,9 @@ func (s *state) start(stopper *util.Stopper) {
 			if readyGroups != nil {
 				writeReady = s.writeTask.ready
 			} else if writingGroups == nil {
+				if len(s.stepDowns) > 0 {
+					s.stepDown()
+				}
 				raftReady = s.multiNode.Ready()
 			}
 
@@ -447,31 +697,8 @@ func (s *state) start(stopper *util.Stopper) {
 				return
 
 			case req := <-s.reqChan:
-				log.V(5).Infof("node %v: group %v got message %.200s", s.nodeID, req.GroupID,
-					raft.DescribeMessage(req.Message, s.EntryFormatter))
-				switch req.Message.Type {
-				case raftpb.MsgHeartbeat:
-					s.fanoutHeartbeat(req)
-				case raftpb.MsgHeartbeatResp:
-					s.fanoutHeartbeatResponse(req)
-				default:
-					// We only want to lazily create the group if it's not heartbeat-related;
-					// our heartbeats are coalesced and contain a dummy GroupID.
-					// TODO(tschottdorf) still shouldn't hurt to move this part outside,
-					// but suddenly tests will start failing. Should investigate.
-					if _, ok := s.groups[req.GroupID]; !ok {
-						log.Infof("node %v: got message for unknown group %d; creating it", s.nodeID, req.GroupID)
-						if err := s.createGroup(req.GroupID); err != nil {
-							log.Warningf("Error creating group %d: %s", req.GroupID, err)
-							break
-						}
-					}
+				s.handleMessage(req)
 
-					if err := s.multiNode.Step(context.Background(), req.GroupID, req.Message); err != nil {
-						log.V(4).Infof("node %v: multinode step failed for message %s", s.nodeID, req.GroupID,
-							raft.DescribeMessage(req.Message, s.EntryFormatter))
-					}
-				}
 			case op := <-s.createGroupChan:
 				log.V(6).Infof("node %v: got op %#v", s.nodeID, op)
 				op.ch <- s.createGroup(op.groupID)
@@ -480,9 +707,24 @@ func (s *state) start(stopper *util.Stopper) {
 				log.V(6).Infof("node %v: got op %#v", s.nodeID, op)
 				s.removeGroup(op)
 
+			case op := <-s.transferChan:
+				log.V(6).Infof("node %v: got op %#v", s.nodeID, op)
+				op.ch <- s.transferLeadership(op.groupID, op.nodeID)
+
+			case op := <-s.learnerChan:
+				caughtUp, err := s.learnerCaughtUp(op.groupID, op.nodeID)
+				op.ch <- learnerStatus{caughtUp, err}
+
+			case op := <-s.statusChan:
+				status, err := s.groupStatus(op.groupID)
+				op.ch <- groupStatusResult{status, err}
+
 			case prop := <-s.proposalChan:
 				s.propose(prop)
 
+			case prop := <-s.backgroundProposalChan:
+				s.proposeBackground(prop)
+
 			case readyGroups = <-raftReady:
 				s.handleRaftReady(readyGroups)
 
@@ -497,13 +739,7 @@ func (s *state) start(stopper *util.Stopper) {
 				writingGroups = nil
 
 			case <-s.Ticker.Chan():
-				log.V(8).Infof("node %v: got tick", s.nodeID)
-				s.multiNode.Tick()
-				ticks++
-				if ticks >= s.HeartbeatIntervalTicks {
-					ticks = 0
-					s.coalescedHeartbeat()
-				}
+				s.handleTick()
 
 			case cb := <-s.callbackChan:
 				cb()
@@ -512,6 +748,81 @@ func (s *state) start(stopper *util.Stopper) {
 	})
 }
 
+// handleMessage processes a message received from another node.
+func (s *state) handleMessage(req *RaftMessageRequest) {
+	log.V(5).Infof("node %v: group %v got message %.200s", s.nodeID, req.GroupID,
+		raft.DescribeMessage(req.Message, s.EntryFormatter))
+	switch req.Message.Type {
+	case raftpb.MsgHeartbeat:
+		s.fanoutHeartbeat(req)
+	case raftpb.MsgHeartbeatResp:
+		s.fanoutHeartbeatResponse(req)
+	case msgPreVote:
+		s.handlePreVote(req)
+	case msgPreVoteResp:
+		s.handlePreVoteResponse(req)
+	case msgQuiesce:
+		s.handleQuiesce(req)
+	case msgUnquiesce:
+		s.handleUnquiesce(req)
+	case msgTransferLeader:
+		s.handleTransferLeader(req)
+	default:
+		// We only want to lazily create the group if it's not heartbeat-related;
+		// our heartbeats are coalesced and contain a dummy GroupID.
+		// TODO(tschottdorf) still shouldn't hurt to move this part outside,
+		// but suddenly tests will start failing. Should investigate.
+		if _, ok := s.groups[req.GroupID]; !ok {
+			log.Infof("node %v: got message for unknown group %d; creating it", s.nodeID, req.GroupID)
+			if err := s.createGroup(req.GroupID); err != nil {
+				log.Warningf("Error creating group %d: %s", req.GroupID, err)
+				break
+			}
+		}
+
+		if req.Message.Type == raftpb.MsgAppResp && s.handleLearnerResponse(req) {
+			break
+		}
+		g := s.groups[req.GroupID]
+		if s.CheckQuorum && req.Message.Type == raftpb.MsgVote &&
+			s.hasLiveLeader(g) && g.leader != NodeID(req.Message.From) &&
+			g.transferTarget != NodeID(req.Message.From) {
+			log.V(4).Infof("node %v: ignoring vote request from %v for group %v with live leader %v",
+				s.nodeID, req.Message.From, req.GroupID, g.leader)
+			break
+		}
+		s.unquiesce(req.GroupID, g)
+
+		if err := s.multiNode.Step(context.Background(), req.GroupID, req.Message); err != nil {
+			log.V(4).Infof("node %v: multinode step failed for group %d: %s", s.nodeID, req.GroupID,
+				raft.DescribeMessage(req.Message, s.EntryFormatter))
+		}
+		s.recordContact(req.GroupID, NodeID(req.Message.From))
+	}
+}
+
+// handleTick advances the node's clocks by one tick.
+func (s *state) handleTick() {
+	log.V(8).Infof("node %v: got tick", s.nodeID)
+	s.multiNode.Tick()
+	s.ticks++
+	if s.ticks >= s.HeartbeatIntervalTicks {
+		s.ticks = 0
+		s.coalescedHeartbeat()
+		s.replicateToLearners()
+	}
+	if s.PreVote || s.CheckQuorum {
+		s.tickElections()
+	}
+	s.quorumTicks++
+	if s.quorumTicks >= s.ElectionTimeoutTicks {
+		s.quorumTicks = 0
+		if s.CheckQuorum {
+			s.checkQuorum()
+		}
+	}
+}
+
 func (s *state) coalescedHeartbeat() {
 	// TODO(Tobias): We don't need to send heartbeats to nodes that have
 	// no group following one of our local groups. But that's unlikely
@@ -553,6 +864,12 @@ func (s *state) stop() {
 			op.ch <- util.Errorf("shutting down")
 		case op := <-s.removeGroupChan:
 			op.ch <- util.Errorf("shutting down")
+		case op := <-s.transferChan:
+			op.ch <- util.Errorf("shutting down")
+		case op := <-s.learnerChan:
+			op.ch <- learnerStatus{err: util.Errorf("shutting down")}
+		case op := <-s.statusChan:
+			op.ch <- groupStatusResult{err: util.Errorf("shutting down")}
 		default:
 			done = true
 		}
@@ -591,34 +908,21 @@ func (s *state) createGroup(groupID uint64) error {
 	}
 	log.V(6).Infof("node %v creating group %v", s.nodeID, groupID)
 
-	gs := s.Storage.GroupStorage(groupID)
-	_, cs, err := gs.InitialState()
+	_, cs, err := s.Storage.GroupStorage(groupID).InitialState()
 	if err != nil {
 		return err
 	}
-
-	var appliedIndex uint64
-	if s.StateMachine != nil {
-		appliedIndex, err = s.StateMachine.AppliedIndex(groupID)
-		if err != nil {
-			return err
-		}
-	}
-
-	raftCfg := &raft.Config{
-		Applied:       appliedIndex,
-		ElectionTick:  s.ElectionTimeoutTicks,
-		HeartbeatTick: s.HeartbeatIntervalTicks,
-		Storage:       gs,
-		// TODO(bdarnell): make these configurable; evaluate defaults.
-		MaxSizePerMsg:   1024 * 1024,
-		MaxInflightMsgs: 256,
+	raftCfg, err := s.raftConfig(groupID)
+	if err != nil {
+		return err
 	}
 	if err := s.multiNode.CreateGroup(groupID, raftCfg, nil); err != nil {
 		return err
 	}
 	s.groups[groupID] = &group{
-		pending: map[string]*proposal{},
+		pending:         map[string]*proposal{},
+		electionTimeout: s.randomElectionTimeout(),
+		recentActive:    map[NodeID]struct{}{},
 	}
 
 	for _, nodeID := range cs.Nodes {
@@ -649,6 +953,35 @@ func (s *state) createGroup(groupID uint64) error {
 	return nil
 }
 
+// raftConfig returns the configuration with which the raft state of
+// the given group is loaded from storage.
+func (s *state) raftConfig(groupID uint64) (*raft.Config, error) {
+	var appliedIndex uint64
+	if s.StateMachine != nil {
+		var err error
+		appliedIndex, err = s.StateMachine.AppliedIndex(groupID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	raftCfg := &raft.Config{
+		Applied:       appliedIndex,
+		ElectionTick:  s.ElectionTimeoutTicks,
+		HeartbeatTick: s.HeartbeatIntervalTicks,
+		Storage:       s.Storage.GroupStorage(groupID),
+		// TODO(bdarnell): make these configurable; evaluate defaults.
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+	}
+	if s.PreVote {
+		// Elections are called by multiraft once a pre-vote succeeds,
+		// so raft's own election timer must never fire.
+		raftCfg.ElectionTick = math.MaxInt32
+	}
+	return raftCfg, nil
+}
+
 func (s *state) removeGroup(op *removeGroupOp) {
 	// Group creation is lazy and idempotent; so is removal.
 	if _, ok := s.groups[op.groupID]; !ok {
@@ -671,6 +1004,20 @@ func (s *state) removeGroup(op *removeGroupOp) {
 	op.ch <- nil
 }
 
+// groupStatus implements MultiRaft.GroupStatus.
+func (s *state) groupStatus(groupID uint64) (*GroupStatus, error) {
+	g, ok := s.groups[groupID]
+	if !ok {
+		return nil, util.Errorf("group %d not found", groupID)
+	}
+	return &GroupStatus{
+		Status:          s.multiNode.Status(groupID),
+		Leader:          g.leader,
+		PendingCommands: len(g.pending),
+		Quiescent:       g.quiescent,
+	}, nil
+}
+
 func (s *state) propose(p *proposal) {
 	g, ok := s.groups[p.groupID]
 	if !ok {
@@ -678,12 +1025,24 @@ func (s *state) propose(p *proposal) {
 		return
 	}
 	g.pending[p.commandID] = p
+	g.idleTicks = 0
+	s.unquiesce(p.groupID, g)
 	p.fn()
 }
 
+// proposeBackground proposes a background proposal after the
+// foreground proposals waiting at this time.
+func (s *state) proposeBackground(prop *proposal) {
+	for n := len(s.proposalChan); n > 0; n-- {
+		s.propose(<-s.proposalChan)
+	}
+	s.propose(prop)
+}
+
 func (s *state) handleRaftReady(readyGroups map[uint64]raft.Ready) {
 	// Soft state is updated immediately; everything else waits for handleWriteReady.
-	for groupID, ready := range readyGroups {
+	for _, groupID := range sortedGroupIDs(readyGroups) {
+		ready := readyGroups[groupID]
 		if log.V(5) {
 			log.Infof("node %v: group %v raft ready", s.nodeID, groupID)
 			if ready.SoftState != nil {
@@ -715,7 +1074,17 @@ func (s *state) handleRaftReady(readyGroups map[uint64]raft.Ready) {
 		term := g.committedTerm
 		if ready.SoftState != nil {
 			// Always save the leader whenever we get a SoftState.
-			g.leader = NodeID(ready.SoftState.Lead)
+			if lead := NodeID(ready.SoftState.Lead); lead != g.leader {
+				g.leader = lead
+				g.leaderElapsed = 0
+				g.electionElapsed = 0
+				g.preVote = nil
+				g.quiescent = false
+				g.transferTarget = 0
+			}
+		}
+		if len(ready.Entries) > 0 || len(ready.CommittedEntries) > 0 {
+			g.idleTicks = 0
 		}
 		if len(ready.CommittedEntries) > 0 {
 			term = ready.CommittedEntries[len(ready.CommittedEntries)-1].Term
@@ -738,8 +1107,31 @@ func (s *state) handleRaftReady(readyGroups map[uint64]raft.Ready) {
 	}
 }
 
+// sortedGroupIDs returns the IDs of the ready groups in ascending
+// order. Events and messages are produced in this order rather than the
+// map's, which keeps manually scheduled nodes deterministic.
+func sortedGroupIDs(readyGroups map[uint64]raft.Ready) []uint64 {
+	groupIDs := make(uint64Slice, 0, len(readyGroups))
+	for groupID := range readyGroups {
+		groupIDs = append(groupIDs, groupID)
+	}
+	sort.Sort(groupIDs)
+	return groupIDs
+}
+
+type uint64Slice []uint64
+
+func (s uint64Slice) Len() int           { return len(s) }
+func (s uint64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s uint64Slice) Less(i, j int) bool { return s[i] < s[j] }
+
 func (s *state) handleWriteReady(readyGroups map[uint64]raft.Ready) {
 	log.V(6).Infof("node %v write ready, preparing request", s.nodeID)
+	s.writeTask.in <- makeWriteRequest(readyGroups)
+}
+
+// makeWriteRequest returns a request to persist the given ready data.
+func makeWriteRequest(readyGroups map[uint64]raft.Ready) *writeRequest {
 	writeRequest := newWriteRequest()
 	for groupID, ready := range readyGroups {
 		gwr := &groupWriteRequest{}
@@ -754,14 +1146,19 @@ func (s *state) handleWriteReady(readyGroups map[uint64]raft.Ready) {
 		}
 		writeRequest.groups[groupID] = gwr
 	}
-	s.writeTask.in <- writeRequest
+	return writeRequest
 }
 
 func (s *state) handleWriteResponse(response *writeResponse, readyGroups map[uint64]raft.Ready) {
 	log.V(6).Infof("node %v got write response: %#v", s.nodeID, *response)
 	// Everything has been written to disk; now we can apply updates to the state machine
 	// and send outgoing messages.
-	for groupID, ready := range readyGroups {
+	for _, groupID := range sortedGroupIDs(readyGroups) {
+		ready := readyGroups[groupID]
+		if !raft.IsEmptySnap(ready.Snapshot) {
+			// The snapshot has been applied; free its reservation.
+			s.snapshots.releaseIncoming(groupID)
+		}
 		g, ok := s.groups[groupID]
 		if !ok {
 			log.V(4).Infof("dropping stale write to group %v", groupID)
@@ -805,18 +1202,26 @@ func (s *state) handleWriteResponse(response *writeResponse, readyGroups map[uin
 							if err == nil {
 								log.V(3).Infof("node %v applying configuration change %v", s.nodeID, cc)
 								// TODO(bdarnell): dedupe by keeping a record of recently-applied commandIDs
+								raftCC := cc
 								switch cc.Type {
 								case raftpb.ConfChangeAddNode:
+									delete(g.learners, NodeID(cc.NodeID))
 									err = s.addNode(NodeID(cc.NodeID), groupID)
 								case raftpb.ConfChangeRemoveNode:
+									delete(g.learners, NodeID(cc.NodeID))
 									// TODO(bdarnell): support removing nodes; fix double-application of initial entries
 								case raftpb.ConfChangeUpdateNode:
 									// Updates don't concern multiraft, they are simply passed through.
+								case ConfChangeAddLearnerNode:
+									err = s.addLearner(groupID, NodeID(cc.NodeID))
+									// Raft's configuration is unchanged; an empty change
+									// only clears its pending configuration change.
+									raftCC = raftpb.ConfChange{}
 								}
 								if err != nil {
 									log.Errorf("error applying configuration change %v: %s", cc, err)
 								}
-								s.multiNode.ApplyConfChange(groupID, cc)
+								s.multiNode.ApplyConfChange(groupID, raftCC)
 							} else {
 								log.Warningf("aborting configuration change: %s", err)
 								s.multiNode.ApplyConfChange(groupID,
@@ -857,20 +1262,14 @@ func (s *state) handleWriteResponse(response *writeResponse, readyGroups map[uin
 			}
 		}
 
-		noMoreHeartbeats := make(map[uint64]struct{})
 		for _, msg := range ready.Messages {
 			switch msg.Type {
-			case raftpb.MsgHeartbeat:
-				log.V(7).Infof("node %v dropped individual heartbeat to node %v",
-					s.nodeID, msg.To)
+			case raftpb.MsgHeartbeat, raftpb.MsgHeartbeatResp:
+				// Heartbeats and their responses are coalesced per node; see
+				// coalescedHeartbeat and fanoutHeartbeat.
+				log.V(7).Infof("node %v dropped individual %s to node %v",
+					s.nodeID, msg.Type, msg.To)
 				continue
-			case raftpb.MsgHeartbeatResp:
-				if _, ok := noMoreHeartbeats[msg.To]; ok {
-					log.V(7).Infof("node %v dropped redundant heartbeat response to node %v",
-						s.nodeID, msg.To)
-					continue
-				}
-				noMoreHeartbeats[msg.To] = struct{}{}
 			}
 
 			log.V(6).Infof("node %v sending message %.200s to %v", s.nodeID,
@@ -882,17 +1281,17 @@ func (s *state) handleWriteResponse(response *writeResponse, readyGroups map[uin
 					log.Errorf("node %v: error adding node %v", s.nodeID, nodeID)
 				}
 			}
+			if msg.Type == raftpb.MsgSnap {
+				to := msg.To
+				s.sendSnapshot(groupID, msg, false, func(snapStatus raft.SnapshotStatus) {
+					s.multiNode.ReportSnapshot(to, groupID, snapStatus)
+				})
+				continue
+			}
 			err := s.Transport.Send(NodeID(msg.To), &RaftMessageRequest{groupID, msg})
-			snapStatus := raft.SnapshotFinish
 			if err != nil {
 				log.Warningf("node %v failed to send message to %v: %s", s.nodeID, nodeID, err)
 				s.multiNode.ReportUnreachable(msg.To, groupID)
-				snapStatus = raft.SnapshotFailure
-			}
-			if msg.Type == raftpb.MsgSnap {
-				// TODO(bdarnell): add an ack for snapshots and don't report status until
-				// ack, error, or timeout.
-				s.multiNode.ReportSnapshot(msg.To, groupID, snapStatus)
 			}
 		}
 	}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/fault"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/coreos/etcd/raft"
@@ -46,6 +47,13 @@ type testCluster struct {
 }
 
 func newTestCluster(transport Transport, size int, stopper *util.Stopper, t *testing.T) *testCluster {
+	return newTestClusterWithConfig(transport, size, stopper, t, nil)
+}
+
+// newTestClusterWithConfig is like newTestCluster, but passes each
+// node's config to configure (if non-nil) before the node is created.
+func newTestClusterWithConfig(transport Transport, size int, stopper *util.Stopper, t *testing.T,
+	configure func(*Config)) *testCluster {
 	if transport == nil {
 		transport = NewLocalRPCTransport()
 	}
@@ -67,6 +75,9 @@ func newTestCluster(transport Transport, size int, stopper *util.Stopper, t *tes
 			TickInterval:           time.Hour, // not in use
 			Strict:                 true,
 		}
+		if configure != nil {
+			configure(config)
+		}
 		mr, err := NewMultiRaft(NodeID(i+1), config)
 		if err != nil {
 			t.Fatal(err)
@@ -171,6 +182,39 @@ func TestInitialLeaderElection(t *testing.T) {
 	}
 }
 
+// TestElectionAfterPartition verifies that a new leader is elected by
+// the majority of a group once its leader is partitioned from it.
+func TestElectionAfterPartition(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+	faults := fault.NewInjector(0)
+	cluster := newTestCluster(NewLocalRPCTransportWithFaults(faults), 3, stopper, t)
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+	cluster.triggerElection(0, groupID)
+	cluster.waitForElection(0)
+
+	faults.Isolate(FaultEndpoint(cluster.nodes[0].nodeID))
+	cluster.triggerElection(1, groupID)
+	if event := cluster.waitForElection(1); event.NodeID != cluster.nodes[1].nodeID {
+		t.Fatalf("expected %v to win election, but was %v", cluster.nodes[1].nodeID, event.NodeID)
+	}
+
+	// The partitioned leader doesn't learn of its successor.
+	timeout := time.After(50 * time.Millisecond)
+	for {
+		select {
+		case e := <-cluster.events[0].LeaderElection:
+			if e != nil && e.NodeID == cluster.nodes[1].nodeID {
+				t.Fatalf("expected partitioned node not to learn of new leader %v", e.NodeID)
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
 // TestProposeBadGroup ensures that unknown group IDs are an error, not a panic.
 func TestProposeBadGroup(t *testing.T) {
 	defer leaktest.AfterTest(t)
@@ -183,6 +227,33 @@ func TestProposeBadGroup(t *testing.T) {
 	}
 }
 
+// TestGroupStatus verifies that the status of a group reflects its
+// election and that unknown group IDs are an error.
+func TestGroupStatus(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	cluster := newTestCluster(nil, 3, stopper, t)
+	defer stopper.Stop()
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+	cluster.triggerElection(0, groupID)
+	cluster.waitForElection(0)
+
+	status, err := cluster.nodes[0].GroupStatus(groupID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.RaftState != raft.StateLeader || status.Leader != cluster.nodes[0].nodeID {
+		t.Errorf("expected node %v to lead group; got %+v", cluster.nodes[0].nodeID, status)
+	}
+	if status.Commit == 0 || status.Term == 0 {
+		t.Errorf("expected the election to be committed; got %+v", status)
+	}
+	if _, err := cluster.nodes[0].GroupStatus(7); err == nil {
+		t.Error("expected error for unknown group")
+	}
+}
+
 func TestLeaderElectionEvent(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	// Leader election events are fired when the leader commits an entry, not when it
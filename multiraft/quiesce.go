@@ -0,0 +1,154 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"golang.org/x/net/context"
+)
+
+// Like pre-votes, quiesce and unquiesce notifications are carried in
+// message types which raft only uses locally.
+const (
+	msgQuiesce   = raftpb.MsgUnreachable
+	msgUnquiesce = raftpb.MsgSnapStatus
+)
+
+// maybeQuiesce quiesces a locally-led group if it has no pending
+// proposals and all of its members have caught up with the leader's
+// log, and asks the followers to do the same. A quiescent group has no
+// election clock and is left out of coalesced heartbeats.
+func (s *state) maybeQuiesce(groupID uint64, g *group) {
+	// Learners are caught up from the leader's heartbeat ticks, so a
+	// group with learners stays awake until they are promoted.
+	if len(g.pending) > 0 || len(g.learners) > 0 {
+		return
+	}
+	status := s.multiNode.Status(groupID)
+	if status.RaftState != raft.StateLeader {
+		return
+	}
+	lastIndex := status.Progress[uint64(s.nodeID)].Match
+	if status.Commit != lastIndex {
+		return
+	}
+	for _, pr := range status.Progress {
+		if pr.Match != lastIndex {
+			return
+		}
+	}
+	log.V(3).Infof("node %v: quiescing group %v at index %d", s.nodeID, groupID, lastIndex)
+	g.quiescent = true
+	for id := range status.Progress {
+		if NodeID(id) == s.nodeID {
+			continue
+		}
+		s.sendQuiesceMessage(groupID, raftpb.Message{
+			Type:  msgQuiesce,
+			From:  uint64(s.nodeID),
+			To:    id,
+			Term:  status.Term,
+			Index: lastIndex,
+		})
+	}
+}
+
+// handleQuiesce quiesces a follower at the request of its leader,
+// provided that the follower has the leader's whole log. A follower
+// which does not stays awake; if it stops hearing from the leader it
+// starts a pre-vote, which wakes the leader.
+func (s *state) handleQuiesce(req *RaftMessageRequest) {
+	m := req.Message
+	g, ok := s.groups[req.GroupID]
+	if !ok || g.quiescent || NodeID(m.From) != g.leader {
+		return
+	}
+	if status := s.multiNode.Status(req.GroupID); status.Term != m.Term {
+		return
+	}
+	lastIndex, _, err := s.lastIndexAndTerm(req.GroupID)
+	if err != nil {
+		log.Warningf("node %v: unable to load last log entry of group %v: %s", s.nodeID, req.GroupID, err)
+		return
+	}
+	if lastIndex != m.Index {
+		return
+	}
+	// The leader only quiesces once the whole log is committed, but the
+	// follower may not have heard so yet. Pass the commit index on the
+	// way a raft heartbeat would, so that the follower applies the log
+	// before going quiet.
+	if err := s.multiNode.Step(context.Background(), req.GroupID, raftpb.Message{
+		Type:   raftpb.MsgHeartbeat,
+		From:   m.From,
+		To:     uint64(s.nodeID),
+		Term:   m.Term,
+		Commit: m.Index,
+	}); err != nil {
+		log.Warningf("node %v: error committing log of group %v: %s", s.nodeID, req.GroupID, err)
+		return
+	}
+	log.V(3).Infof("node %v: quiescing group %v at index %d", s.nodeID, req.GroupID, lastIndex)
+	g.quiescent = true
+	g.leaderElapsed = 0
+	g.electionElapsed = 0
+}
+
+// unquiesce wakes a quiescent group. A leader also wakes its followers,
+// so that they respond to its heartbeats again.
+func (s *state) unquiesce(groupID uint64, g *group) {
+	if !g.quiescent {
+		return
+	}
+	log.V(3).Infof("node %v: waking group %v", s.nodeID, groupID)
+	g.quiescent = false
+	g.idleTicks = 0
+	g.leaderElapsed = 0
+	g.electionElapsed = 0
+	if g.leader != s.nodeID {
+		return
+	}
+	status := s.multiNode.Status(groupID)
+	for id := range status.Progress {
+		if NodeID(id) == s.nodeID {
+			continue
+		}
+		s.sendQuiesceMessage(groupID, raftpb.Message{
+			Type: msgUnquiesce,
+			From: uint64(s.nodeID),
+			To:   id,
+			Term: status.Term,
+		})
+	}
+}
+
+// handleUnquiesce wakes a quiescent follower at the request of its
+// leader.
+func (s *state) handleUnquiesce(req *RaftMessageRequest) {
+	if g, ok := s.groups[req.GroupID]; ok && NodeID(req.Message.From) == g.leader {
+		s.unquiesce(req.GroupID, g)
+	}
+}
+
+func (s *state) sendQuiesceMessage(groupID uint64, msg raftpb.Message) {
+	if err := s.Transport.Send(NodeID(msg.To), &RaftMessageRequest{GroupID: groupID, Message: msg}); err != nil {
+		log.Warningf("node %v: error sending %s to %v: %s", s.nodeID, msg.Type, msg.To, err)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// isQuiescent returns whether the group is quiescent on the given node.
+func (c *testCluster) isQuiescent(nodeIndex int, groupID uint64) bool {
+	ch := make(chan bool, 1)
+	c.nodes[nodeIndex].callbackChan <- func() {
+		g, ok := c.nodes[nodeIndex].groups[groupID]
+		ch <- ok && g.quiescent
+	}
+	return <-ch
+}
+
+// TestQuiesce verifies that an idle group is quiesced on all of its
+// members and that a new proposal wakes it.
+func TestQuiesce(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	cluster := newTestClusterWithConfig(nil, 3, stopper, t, func(config *Config) {
+		config.PreVote = true
+		config.Quiesce = true
+	})
+	defer stopper.Stop()
+	groupID := uint64(1)
+	cluster.createGroup(groupID, 0, 3)
+
+	cluster.triggerElection(0, groupID)
+	cluster.waitForElection(0)
+
+	util.SucceedsWithin(t, time.Second, func() error {
+		cluster.tickers[0].Tick()
+		for i := range cluster.nodes {
+			if !cluster.isQuiescent(i, groupID) {
+				return util.Errorf("group is not quiescent on node %d", i)
+			}
+		}
+		return nil
+	})
+
+	// Followers stay quiescent while the leader's node heartbeats.
+	for i := 0; i < 10*cluster.nodes[1].ElectionTimeoutTicks; i++ {
+		cluster.tickers[0].Tick()
+		time.Sleep(5 * time.Millisecond)
+		cluster.tickers[1].Tick()
+	}
+	if !cluster.isQuiescent(1, groupID) {
+		t.Fatal("expected follower to remain quiescent")
+	}
+
+	cluster.nodes[0].SubmitCommand(groupID, makeCommandID(), []byte("command"))
+	for i := range cluster.nodes {
+		if commit := <-cluster.events[i].CommandCommitted; string(commit.Command) != "command" {
+			t.Errorf("unexpected value in committed command: %v", commit.Command)
+		}
+		if cluster.isQuiescent(i, groupID) {
+			t.Errorf("expected group to be awake on node %d", i)
+		}
+	}
+}
+
+// TestQuiesceRequiresPreVote verifies that Quiesce can't be enabled
+// while raft runs its own election timers.
+func TestQuiesceRequiresPreVote(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	config := &Config{
+		Transport:              NewLocalRPCTransport(),
+		ElectionTimeoutTicks:   2,
+		HeartbeatIntervalTicks: 1,
+		TickInterval:           time.Hour,
+		Quiesce:                true,
+	}
+	defer config.Transport.Close()
+	if err := config.validate(); err == nil {
+		t.Fatal("expected error enabling Quiesce without PreVote")
+	}
+}
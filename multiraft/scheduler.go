@@ -0,0 +1,157 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"time"
+
+	"github.com/coreos/etcd/raft"
+)
+
+// readyWait bounds how long Step waits for raft to offer ready groups
+// once it has caught up with the requests made of it.
+const readyWait = 10 * time.Millisecond
+
+// A Scheduler drives a MultiRaft object configured with ManualScheduling.
+// A single goroutine, typically a test's, steps one or more nodes
+// through their work in an order of its choosing; MultiRaft methods
+// which wait for the node to respond must be called from that
+// goroutine, and step the node themselves as needed.
+type Scheduler struct {
+	s *state
+}
+
+// Scheduler returns the Scheduler of a started node which uses
+// ManualScheduling, or nil otherwise.
+func (m *MultiRaft) Scheduler() *Scheduler {
+	return m.scheduler
+}
+
+// Step processes a single unit of pending work and returns whether
+// there was any. Work is taken in a fixed order: leaderships given up
+// by the quorum check, operations requested through the MultiRaft methods, callbacks, incoming messages,
+// proposals, and finally groups made ready by raft, whose writes are
+// persisted and whose committed entries and messages are dispatched
+// within the same step.
+func (sc *Scheduler) Step() bool {
+	s := sc.s
+	select {
+	case <-s.stopper.ShouldStop():
+		return false
+	default:
+	}
+	if len(s.stepDowns) > 0 {
+		s.stepDown()
+		return true
+	}
+	select {
+	case op := <-s.createGroupChan:
+		op.ch <- s.createGroup(op.groupID)
+		return true
+	default:
+	}
+	select {
+	case op := <-s.removeGroupChan:
+		s.removeGroup(op)
+		return true
+	default:
+	}
+	select {
+	case op := <-s.transferChan:
+		op.ch <- s.transferLeadership(op.groupID, op.nodeID)
+		return true
+	default:
+	}
+	select {
+	case op := <-s.learnerChan:
+		caughtUp, err := s.learnerCaughtUp(op.groupID, op.nodeID)
+		op.ch <- learnerStatus{caughtUp, err}
+		return true
+	default:
+	}
+	select {
+	case op := <-s.statusChan:
+		status, err := s.groupStatus(op.groupID)
+		op.ch <- groupStatusResult{status, err}
+		return true
+	default:
+	}
+	select {
+	case cb := <-s.callbackChan:
+		cb()
+		return true
+	default:
+	}
+	select {
+	case req := <-s.reqChan:
+		s.handleMessage(req)
+		return true
+	default:
+	}
+	select {
+	case prop := <-s.proposalChan:
+		s.propose(prop)
+		return true
+	default:
+	}
+	select {
+	case prop := <-s.backgroundProposalChan:
+		s.proposeBackground(prop)
+		return true
+	default:
+	}
+	readyGroups := sc.ready()
+	if readyGroups == nil {
+		return false
+	}
+	s.handleRaftReady(readyGroups)
+	s.handleWriteResponse(s.writeTask.write(makeWriteRequest(readyGroups)), readyGroups)
+	s.multiNode.Advance(readyGroups)
+	return true
+}
+
+// ready returns the groups raft has made ready, or nil if there are
+// none. Raft prepares them on a goroutine of its own; a status request,
+// which it answers only after handling the requests made before it,
+// catches up with that goroutine so that the outcome does not depend
+// on how the goroutines happen to be scheduled.
+func (sc *Scheduler) ready() map[uint64]raft.Ready {
+	s := sc.s
+	for groupID := range s.groups {
+		s.multiNode.Status(groupID)
+		break
+	}
+	select {
+	case readyGroups := <-s.multiNode.Ready():
+		return readyGroups
+	case <-time.After(readyWait):
+		return nil
+	}
+}
+
+// Tick advances the node's clocks by one tick, as its Ticker would.
+// The resulting work is left for subsequent calls to Step.
+func (sc *Scheduler) Tick() {
+	sc.s.handleTick()
+}
+
+// Advance steps the node until it has no more pending work.
+func (sc *Scheduler) Advance() {
+	for sc.Step() {
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+// snapshotReservationTimeout bounds how long a reservation for an
+// incoming snapshot is held if the snapshot never arrives.
+const snapshotReservationTimeout = 30 * time.Second
+
+// snapshotLimiter bounds the number of snapshots a node sends and
+// receives concurrently and throttles the rate at which it sends them.
+// Incoming slots are reserved by senders via ReserveSnapshot and freed
+// when the snapshot is applied or the reservation times out.
+type snapshotLimiter struct {
+	mu          sync.Mutex
+	maxIncoming int
+	maxOutgoing int
+	incoming    map[uint64]time.Time // group ID -> reservation expiration
+	outgoing    int
+
+	bytesPerSecond int64
+	// nextSend is the time at which the throttle next admits data of
+	// background snapshots, following that of all snapshots throttled
+	// so far. nextForegroundSend is only advanced by foreground
+	// snapshots, which do not wait on background ones.
+	nextSend           time.Time
+	nextForegroundSend time.Time
+}
+
+// newSnapshotLimiter creates a snapshotLimiter. Zero values for any of
+// the limits disable them.
+func newSnapshotLimiter(maxIncoming, maxOutgoing int, bytesPerSecond int64) *snapshotLimiter {
+	return &snapshotLimiter{
+		maxIncoming:    maxIncoming,
+		maxOutgoing:    maxOutgoing,
+		incoming:       map[uint64]time.Time{},
+		bytesPerSecond: bytesPerSecond,
+	}
+}
+
+// reserveIncoming reserves a slot for a snapshot of the given group,
+// returning false if all slots are taken. Repeated reservations for the
+// same group share a single slot.
+func (l *snapshotLimiter) reserveIncoming(groupID uint64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for id, expiration := range l.incoming {
+		if now.After(expiration) {
+			delete(l.incoming, id)
+		}
+	}
+	if _, ok := l.incoming[groupID]; !ok && l.maxIncoming > 0 && len(l.incoming) >= l.maxIncoming {
+		return false
+	}
+	l.incoming[groupID] = now.Add(snapshotReservationTimeout)
+	return true
+}
+
+// releaseIncoming frees the reservation held for the given group, if any.
+func (l *snapshotLimiter) releaseIncoming(groupID uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.incoming, groupID)
+}
+
+// acquireOutgoing takes a slot for sending a snapshot, returning false
+// if all slots are taken. Slots are returned with releaseOutgoing.
+func (l *snapshotLimiter) acquireOutgoing() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxOutgoing > 0 && l.outgoing >= l.maxOutgoing {
+		return false
+	}
+	l.outgoing++
+	return true
+}
+
+// releaseOutgoing returns a slot taken by acquireOutgoing.
+func (l *snapshotLimiter) releaseOutgoing() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.outgoing--
+}
+
+// throttle accounts for sending the given number of bytes and returns
+// how long the caller must wait before sending them so that the
+// configured byte rate is not exceeded. Background snapshots, such as
+// those sent to learners, use the rate left over by foreground ones:
+// they wait for all snapshots throttled before them, while foreground
+// snapshots only wait for other foreground ones.
+func (l *snapshotLimiter) throttle(bytes int, background bool) time.Duration {
+	if l.bytesPerSecond <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	next := &l.nextForegroundSend
+	if background {
+		next = &l.nextSend
+	}
+	if next.Before(now) {
+		*next = now
+	}
+	wait := next.Sub(now)
+	duration := time.Duration(int64(bytes) * int64(time.Second) / l.bytesPerSecond)
+	*next = next.Add(duration)
+	if !background {
+		if l.nextSend.Before(now) {
+			l.nextSend = now
+		}
+		l.nextSend = l.nextSend.Add(duration)
+	}
+	return wait
+}
+
+// sendSnapshot sends a snapshot message in the background so that the
+// reservation handshake performed by the Transport and any throttling
+// do not hold up the state loop. Background snapshots are throttled
+// behind foreground ones. The outcome is passed to report on
+// the state goroutine. If this node is already sending as many
+// snapshots as it may, the snapshot is reported as failed at once and
+// is sent again later.
+func (s *state) sendSnapshot(groupID uint64, msg raftpb.Message, background bool,
+	report func(raft.SnapshotStatus)) {
+	if !s.snapshots.acquireOutgoing() {
+		log.V(4).Infof("node %v: deferring snapshot of group %v to node %v",
+			s.nodeID, groupID, msg.To)
+		report(raft.SnapshotFailure)
+		return
+	}
+	send := func() {
+		defer s.snapshots.releaseOutgoing()
+		if wait := s.snapshots.throttle(len(msg.Snapshot.Data), background); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+		snapStatus := raft.SnapshotFinish
+		if err := s.Transport.Send(NodeID(msg.To), &RaftMessageRequest{groupID, msg}); err != nil {
+			log.Warningf("node %v failed to send snapshot to %v: %s", s.nodeID, msg.To, err)
+			snapStatus = raft.SnapshotFailure
+		}
+		select {
+		case s.callbackChan <- func() { report(snapStatus) }:
+		case <-s.stopper.ShouldStop():
+		}
+	}
+	if s.ManualScheduling {
+		// The outcome is still reported through callbackChan, as the
+		// scheduler's next step.
+		send()
+		return
+	}
+	s.stopper.RunWorker(send)
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package multiraft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestSnapshotLimiterIncoming(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	l := newSnapshotLimiter(2, 0, 0)
+	if !l.reserveIncoming(1) || !l.reserveIncoming(2) {
+		t.Fatal("expected reservations within the limit to succeed")
+	}
+	// A repeated reservation for a group shares its slot.
+	if !l.reserveIncoming(1) {
+		t.Fatal("expected repeated reservation to succeed")
+	}
+	if l.reserveIncoming(3) {
+		t.Fatal("expected reservation beyond the limit to fail")
+	}
+	l.releaseIncoming(1)
+	if !l.reserveIncoming(3) {
+		t.Fatal("expected reservation to succeed after release")
+	}
+
+	// Expired reservations are discarded.
+	l.mu.Lock()
+	for id := range l.incoming {
+		l.incoming[id] = time.Now().Add(-time.Second)
+	}
+	l.mu.Unlock()
+	if !l.reserveIncoming(4) || !l.reserveIncoming(5) {
+		t.Fatal("expected expired reservations to be discarded")
+	}
+}
+
+func TestSnapshotLimiterOutgoing(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	l := newSnapshotLimiter(0, 1, 0)
+	if !l.acquireOutgoing() {
+		t.Fatal("expected first outgoing snapshot to be admitted")
+	}
+	if l.acquireOutgoing() {
+		t.Fatal("expected second outgoing snapshot to be refused")
+	}
+	l.releaseOutgoing()
+	if !l.acquireOutgoing() {
+		t.Fatal("expected outgoing snapshot to be admitted after release")
+	}
+
+	// With no limit, any number of snapshots are admitted.
+	l = newSnapshotLimiter(0, 0, 0)
+	for i := 0; i < 10; i++ {
+		if !l.acquireOutgoing() || !l.reserveIncoming(uint64(i)) {
+			t.Fatal("expected unlimited snapshots to be admitted")
+		}
+	}
+}
+
+func TestSnapshotLimiterThrottle(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	if wait := newSnapshotLimiter(0, 0, 0).throttle(1<<30, false); wait != 0 {
+		t.Errorf("expected no throttling without a rate; got %s", wait)
+	}
+
+	l := newSnapshotLimiter(0, 0, 1000)
+	if wait := l.throttle(500, false); wait != 0 {
+		t.Errorf("expected first send to proceed immediately; got %s", wait)
+	}
+	// The first 500 bytes take half a second at 1000 bytes per second.
+	if wait := l.throttle(500, false); wait < 400*time.Millisecond || wait > 500*time.Millisecond {
+		t.Errorf("expected a wait of about 500ms; got %s", wait)
+	}
+	if wait := l.throttle(500, false); wait < 900*time.Millisecond || wait > time.Second {
+		t.Errorf("expected a wait of about 1s; got %s", wait)
+	}
+}
+
+func TestSnapshotLimiterThrottleBackground(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	l := newSnapshotLimiter(0, 0, 1000)
+	if wait := l.throttle(500, true); wait != 0 {
+		t.Errorf("expected first background send to proceed immediately; got %s", wait)
+	}
+	// Foreground sends don't wait for background ones.
+	if wait := l.throttle(500, false); wait != 0 {
+		t.Errorf("expected foreground send to proceed immediately; got %s", wait)
+	}
+	// Background sends wait for both.
+	if wait := l.throttle(500, true); wait < 900*time.Millisecond || wait > time.Second {
+		t.Errorf("expected a wait of about 1s; got %s", wait)
+	}
+	if wait := l.throttle(500, false); wait < 400*time.Millisecond || wait > 500*time.Millisecond {
+		t.Errorf("expected a wait of about 500ms; got %s", wait)
+	}
+}
+
+// TestReserveSnapshot verifies that the server interface admits
+// snapshots up to the configured limit and frees a slot once a
+// snapshot has been released.
+func TestReserveSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	m := &MultiRaft{snapshots: newSnapshotLimiter(1, 0, 0)}
+	server := (*multiraftServer)(m)
+	reserve := func(groupID uint64) bool {
+		resp := &ReserveSnapshotResponse{}
+		if err := server.ReserveSnapshot(&ReserveSnapshotRequest{GroupID: groupID}, resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.Reserved
+	}
+	if !reserve(1) {
+		t.Fatal("expected first snapshot to be reserved")
+	}
+	if reserve(2) {
+		t.Fatal("expected second snapshot to be declined")
+	}
+	m.snapshots.releaseIncoming(1)
+	if !reserve(2) {
+		t.Fatal("expected snapshot to be reserved after release")
+	}
+}
@@ -148,38 +148,43 @@ func (w *writeTask) start(stopper *util.Stopper) {
 				return
 			case request = <-w.in:
 			}
-			log.V(6).Infof("writeTask got request %#v", *request)
-			response := &writeResponse{make(map[uint64]*groupWriteResponse)}
-
-			for groupID, groupReq := range request.groups {
-				group := w.storage.GroupStorage(groupID)
-				if group == nil {
-					log.V(4).Infof("dropping write to group %v", groupID)
-					continue
-				}
-				groupResp := &groupWriteResponse{raftpb.HardState{}, -1, -1, groupReq.entries}
-				response.groups[groupID] = groupResp
-				if !raft.IsEmptyHardState(groupReq.state) {
-					err := group.SetHardState(groupReq.state)
-					if err != nil {
-						panic(err) // TODO(bdarnell): mark this node dead on storage errors
-					}
-					groupResp.state = groupReq.state
-				}
-				if !raft.IsEmptySnap(groupReq.snapshot) {
-					err := group.ApplySnapshot(groupReq.snapshot)
-					if err != nil {
-						panic(err) // TODO(bdarnell)
-					}
-				}
-				if len(groupReq.entries) > 0 {
-					err := group.Append(groupReq.entries)
-					if err != nil {
-						panic(err) // TODO(bdarnell)
-					}
-				}
-			}
-			w.out <- response
+			w.out <- w.write(request)
 		}
 	})
 }
+
+// write persists the request to storage and returns the response.
+func (w *writeTask) write(request *writeRequest) *writeResponse {
+	log.V(6).Infof("writeTask got request %#v", *request)
+	response := &writeResponse{make(map[uint64]*groupWriteResponse)}
+
+	for groupID, groupReq := range request.groups {
+		group := w.storage.GroupStorage(groupID)
+		if group == nil {
+			log.V(4).Infof("dropping write to group %v", groupID)
+			continue
+		}
+		groupResp := &groupWriteResponse{raftpb.HardState{}, -1, -1, groupReq.entries}
+		response.groups[groupID] = groupResp
+		if !raft.IsEmptyHardState(groupReq.state) {
+			err := group.SetHardState(groupReq.state)
+			if err != nil {
+				panic(err) // TODO(bdarnell): mark this node dead on storage errors
+			}
+			groupResp.state = groupReq.state
+		}
+		if !raft.IsEmptySnap(groupReq.snapshot) {
+			err := group.ApplySnapshot(groupReq.snapshot)
+			if err != nil {
+				panic(err) // TODO(bdarnell)
+			}
+		}
+		if len(groupReq.entries) > 0 {
+			err := group.Append(groupReq.entries)
+			if err != nil {
+				panic(err) // TODO(bdarnell)
+			}
+		}
+	}
+	return response
+}
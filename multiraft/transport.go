@@ -20,10 +20,13 @@ package multiraft
 import (
 	"net"
 	"net/rpc"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/fault"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/coreos/etcd/raft/raftpb"
 )
@@ -42,7 +45,11 @@ type Transport interface {
 	// Stop undoes a previous Listen.
 	Stop(id NodeID)
 
-	// Send a message to the given node.
+	// Send a message to the given node. Before sending a MsgSnap, the
+	// Transport must reserve a slot for it on the recipient by calling
+	// its ReserveSnapshot method, and return an error without sending
+	// the snapshot if the reservation is declined. Snapshots are sent
+	// synchronously.
 	Send(id NodeID, req *RaftMessageRequest) error
 
 	// Close all associated connections.
@@ -60,13 +67,26 @@ type RaftMessageRequest struct {
 type RaftMessageResponse struct {
 }
 
+// ReserveSnapshotRequest asks the recipient of a snapshot to admit it.
+type ReserveSnapshotRequest struct {
+	GroupID uint64
+	NodeID  NodeID
+}
+
+// ReserveSnapshotResponse reports whether a snapshot was admitted.
+type ReserveSnapshotResponse struct {
+	Reserved bool
+}
+
 // ServerInterface is the methods we expose for use by net/rpc.
 type ServerInterface interface {
 	RaftMessage(req *RaftMessageRequest, resp *RaftMessageResponse) error
+	ReserveSnapshot(req *ReserveSnapshotRequest, resp *ReserveSnapshotResponse) error
 }
 
 var (
-	raftMessageName = "MultiRaft.RaftMessage"
+	raftMessageName     = "MultiRaft.RaftMessage"
+	reserveSnapshotName = "MultiRaft.ReserveSnapshot"
 )
 
 type localRPCTransport struct {
@@ -75,6 +95,7 @@ type localRPCTransport struct {
 	clients   map[NodeID]*rpc.Client
 	conns     map[net.Conn]struct{}
 	closed    chan struct{}
+	faults    *fault.Injector
 }
 
 // NewLocalRPCTransport creates a Transport for local testing use. MultiRaft instances
@@ -83,14 +104,30 @@ type localRPCTransport struct {
 // localhost.
 // Because this is just for local testing, it doesn't use TLS.
 func NewLocalRPCTransport() Transport {
+	return NewLocalRPCTransportWithFaults(nil)
+}
+
+// NewLocalRPCTransportWithFaults creates a local Transport which
+// injects the faults of the supplied Injector into the messages it
+// sends. The endpoints of the Injector's rules are node IDs formatted
+// in decimal (see FaultEndpoint). Dropped messages are discarded
+// silently, except for snapshots, whose sends fail.
+func NewLocalRPCTransportWithFaults(faults *fault.Injector) Transport {
 	return &localRPCTransport{
 		listeners: make(map[NodeID]net.Listener),
 		clients:   make(map[NodeID]*rpc.Client),
 		conns:     make(map[net.Conn]struct{}),
 		closed:    make(chan struct{}),
+		faults:    faults,
 	}
 }
 
+// FaultEndpoint returns the endpoint identifying the specified node in
+// the rules of a fault.Injector supplied to a local Transport.
+func FaultEndpoint(id NodeID) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
 func (lt *localRPCTransport) Listen(id NodeID, server ServerInterface) error {
 	rpcServer := rpc.NewServer()
 	err := rpcServer.RegisterName("MultiRaft", server)
@@ -180,10 +217,52 @@ func (lt *localRPCTransport) getClient(id NodeID) (*rpc.Client, error) {
 }
 
 func (lt *localRPCTransport) Send(id NodeID, req *RaftMessageRequest) error {
+	action := lt.faults.Action(FaultEndpoint(NodeID(req.Message.From)), FaultEndpoint(id))
+	if action.Drop {
+		if req.Message.Type == raftpb.MsgSnap {
+			return util.Errorf("snapshot to node %v dropped by fault injection", id)
+		}
+		return nil
+	}
+	if action.Delay > 0 {
+		if req.Message.Type == raftpb.MsgSnap {
+			time.Sleep(action.Delay)
+		} else {
+			// Deliver the message asynchronously, so that messages sent
+			// with differing delays may be reordered.
+			go func() {
+				select {
+				case <-time.After(action.Delay):
+				case <-lt.closed:
+					return
+				}
+				if err := lt.send(id, req); err != nil {
+					log.Errorf("sending delayed rpc failed: %s", err)
+				}
+			}()
+			return nil
+		}
+	}
+	return lt.send(id, req)
+}
+
+// send sends the message to the specified node.
+func (lt *localRPCTransport) send(id NodeID, req *RaftMessageRequest) error {
 	client, err := lt.getClient(id)
 	if err != nil {
 		return err
 	}
+	if req.Message.Type == raftpb.MsgSnap {
+		resp := &ReserveSnapshotResponse{}
+		if err := client.Call(reserveSnapshotName,
+			&ReserveSnapshotRequest{GroupID: req.GroupID, NodeID: id}, resp); err != nil {
+			return err
+		}
+		if !resp.Reserved {
+			return util.Errorf("node %v declined snapshot for group %v", id, req.GroupID)
+		}
+		return client.Call(raftMessageName, req, &RaftMessageResponse{})
+	}
 	call := client.Go(raftMessageName, req, &RaftMessageResponse{}, nil)
 	select {
 	case <-call.Done:
@@ -219,3 +298,75 @@ func (lt *localRPCTransport) Close() {
 		conn.Close()
 	}
 }
+
+type localDirectTransport struct {
+	mu      sync.Mutex
+	servers map[NodeID]ServerInterface
+	faults  *fault.Injector
+}
+
+// NewLocalDirectTransport creates a Transport for nodes which use
+// Config.ManualScheduling. Messages are delivered to the recipient's
+// queue before Send returns, and are dropped with an error if the queue
+// is full, so the order in which nodes are stepped fully determines the
+// order of delivery. The faults of the supplied Injector, which may be
+// nil, are applied as in NewLocalRPCTransportWithFaults, except that
+// delays are ignored.
+func NewLocalDirectTransport(faults *fault.Injector) Transport {
+	return &localDirectTransport{
+		servers: make(map[NodeID]ServerInterface),
+		faults:  faults,
+	}
+}
+
+func (lt *localDirectTransport) Listen(id NodeID, server ServerInterface) error {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	lt.servers[id] = server
+	return nil
+}
+
+func (lt *localDirectTransport) Stop(id NodeID) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	delete(lt.servers, id)
+}
+
+func (lt *localDirectTransport) Send(id NodeID, req *RaftMessageRequest) error {
+	if lt.faults.Action(FaultEndpoint(NodeID(req.Message.From)), FaultEndpoint(id)).Drop {
+		if req.Message.Type == raftpb.MsgSnap {
+			return util.Errorf("snapshot to node %v dropped by fault injection", id)
+		}
+		return nil
+	}
+	lt.mu.Lock()
+	server, ok := lt.servers[id]
+	lt.mu.Unlock()
+	if !ok {
+		return util.Errorf("unknown peer %v", id)
+	}
+	if req.Message.Type == raftpb.MsgSnap {
+		resp := &ReserveSnapshotResponse{}
+		if err := server.ReserveSnapshot(&ReserveSnapshotRequest{GroupID: req.GroupID, NodeID: id},
+			resp); err != nil {
+			return err
+		}
+		if !resp.Reserved {
+			return util.Errorf("node %v declined snapshot for group %v", id, req.GroupID)
+		}
+	}
+	// Copy the message as the RPC transports do, so that the recipient
+	// shares no memory with the sender.
+	data, err := req.Message.Marshal()
+	if err != nil {
+		return err
+	}
+	msg := &RaftMessageRequest{GroupID: req.GroupID}
+	if err := msg.Message.Unmarshal(data); err != nil {
+		return err
+	}
+	return server.RaftMessage(msg, &RaftMessageResponse{})
+}
+
+func (lt *localDirectTransport) Close() {
+}
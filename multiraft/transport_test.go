@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/coreos/etcd/raft/raftpb"
 )
 
 type localInterceptableTransport struct {
@@ -68,6 +69,16 @@ func (lt *localInterceptableTransport) start() {
 				if !ok {
 					continue
 				}
+				if msg.Message.Type == raftpb.MsgSnap {
+					resp := &ReserveSnapshotResponse{}
+					req := &ReserveSnapshotRequest{GroupID: msg.GroupID, NodeID: NodeID(msg.Message.To)}
+					if err := srv.ReserveSnapshot(req, resp); err != nil {
+						log.Fatal(err)
+					}
+					if !resp.Reserved {
+						continue
+					}
+				}
 				if err := srv.RaftMessage(msg, nil); err != nil {
 					log.Fatal(err)
 				}
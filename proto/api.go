@@ -21,6 +21,7 @@ import (
 	"log"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
 	gogoproto "github.com/gogo/protobuf/proto"
 )
 
@@ -39,6 +40,21 @@ const (
 	isTxnWrite
 )
 
+// requestPriorityRanks orders the request priorities, from lowest to
+// highest.
+var requestPriorityRanks = map[RequestPriority]int{
+	BACKGROUND: 0,
+	LOW:        1,
+	NORMAL:     2,
+	HIGH:       3,
+	SYSTEM:     4,
+}
+
+// Less returns whether priority p is lower than o.
+func (p RequestPriority) Less(o RequestPriority) bool {
+	return requestPriorityRanks[p] < requestPriorityRanks[o]
+}
+
 // IsAdmin returns true if the request requires admin permissions.
 func IsAdmin(args Request) bool {
 	return (args.flags() & isAdmin) != 0
@@ -92,9 +108,10 @@ type Response interface {
 // Combine() allows responses from individual ranges to be aggregated
 // into a single one.
 // It is not expected that Combine() perform any error checking; this
-// should be done by the caller instead.
+// should be done by the caller instead. It only returns an error if
+// the responses can't be aggregated, e.g. because a count overflows.
 type Combinable interface {
-	Combine(Response)
+	Combine(Response) error
 }
 
 // Combine is used by range-spanning Response types (e.g. Scan or DeleteRange)
@@ -111,30 +128,73 @@ func (rh *ResponseHeader) Combine(otherRH *ResponseHeader) {
 }
 
 // Combine implements the Combinable interface for ScanResponse.
-func (sr *ScanResponse) Combine(c Response) {
+func (sr *ScanResponse) Combine(c Response) error {
 	otherSR := c.(*ScanResponse)
 	if sr != nil {
 		sr.Rows = append(sr.Rows, otherSR.GetRows()...)
+		sr.ResumeSpan = otherSR.GetResumeSpan()
 		sr.Header().Combine(otherSR.Header())
 	}
+	return nil
 }
 
 // Combine implements the Combinable interface for DeleteRangeResponse.
-func (dr *DeleteRangeResponse) Combine(c Response) {
+func (dr *DeleteRangeResponse) Combine(c Response) error {
 	otherDR := c.(*DeleteRangeResponse)
 	if dr != nil {
+		if encoding.WillOverflow(dr.NumDeleted, otherDR.GetNumDeleted()) {
+			return util.Errorf("number of deleted keys %d overflows with %d", dr.NumDeleted, otherDR.GetNumDeleted())
+		}
 		dr.NumDeleted += otherDR.GetNumDeleted()
 		dr.Header().Combine(otherDR.Header())
 	}
+	return nil
+}
+
+// Combine implements the Combinable interface for ClearRangeResponse.
+func (cr *ClearRangeResponse) Combine(c Response) error {
+	otherCR := c.(*ClearRangeResponse)
+	if cr != nil {
+		cr.Header().Combine(otherCR.Header())
+	}
+	return nil
+}
+
+// Combine implements the Combinable interface for AggregateResponse.
+func (ar *AggregateResponse) Combine(c Response) error {
+	otherAR := c.(*AggregateResponse)
+	if ar != nil {
+		if encoding.WillOverflow(ar.NumKeys, otherAR.GetNumKeys()) {
+			return util.Errorf("number of keys %d overflows with %d", ar.NumKeys, otherAR.GetNumKeys())
+		}
+		if encoding.WillOverflow(ar.Sum, otherAR.GetSum()) {
+			return util.Errorf("sum %d overflows with %d", ar.Sum, otherAR.GetSum())
+		}
+		ar.NumKeys += otherAR.GetNumKeys()
+		ar.Sum += otherAR.GetSum()
+		ar.Header().Combine(otherAR.Header())
+	}
+	return nil
 }
 
 // Combine implements the Combinable interface for
 // InternalResolveIntentResponse.
-func (rr *InternalResolveIntentResponse) Combine(c Response) {
+func (rr *InternalResolveIntentResponse) Combine(c Response) error {
 	otherRR := c.(*InternalResolveIntentResponse)
 	if rr != nil {
 		rr.Header().Combine(otherRR.Header())
 	}
+	return nil
+}
+
+// Combine implements the Combinable interface for
+// InternalRefreshRangeResponse.
+func (rr *InternalRefreshRangeResponse) Combine(c Response) error {
+	otherRR := c.(*InternalRefreshRangeResponse)
+	if rr != nil {
+		rr.Header().Combine(otherRR.Header())
+	}
+	return nil
 }
 
 // Header implements the Request interface for RequestHeader.
@@ -247,6 +307,29 @@ func (br *BatchRequest) Add(args Request) {
 	br.Requests = append(br.Requests, union)
 }
 
+// IsOnePhaseCommit returns true if the batch consists of transactional
+// writes to single keys followed by the EndTransaction committing
+// them. Such a batch can be executed as a single command by the range
+// containing all of its keys.
+func (br *BatchRequest) IsOnePhaseCommit() bool {
+	n := len(br.Requests)
+	if br.Txn == nil || n < 2 {
+		return false
+	}
+	et, ok := br.Requests[n-1].GetValue().(*EndTransactionRequest)
+	if !ok || !et.Commit || et.InternalCommitTrigger != nil {
+		return false
+	}
+	for i := range br.Requests[:n-1] {
+		switch br.Requests[i].GetValue().(type) {
+		case *PutRequest, *ConditionalPutRequest, *InitPutRequest, *IncrementRequest, *DeleteRequest:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // Add adds a response to the batch response.
 func (br *BatchResponse) Add(reply Response) {
 	union := ResponseUnion{}
@@ -274,6 +357,16 @@ func (sr *ScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxResults field in AggregateRequest.
+func (ar *AggregateRequest) GetBound() int64 {
+	return ar.GetMaxResults()
+}
+
+// SetBound sets the MaxResults field in AggregateRequest.
+func (ar *AggregateRequest) SetBound(bound int64) {
+	ar.MaxResults = bound
+}
+
 // Countable is implemented by response types which have a number of
 // result rows, such as Scan.
 type Countable interface {
@@ -285,6 +378,97 @@ func (sr *ScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Count returns the number of keys aggregated in AggregateResponse.
+func (ar *AggregateResponse) Count() int64 {
+	return ar.GetNumKeys()
+}
+
+// ByteBounded is implemented by request types whose results can be
+// limited by their total size in bytes, such as Scan.
+type ByteBounded interface {
+	GetByteBound() int64
+	SetByteBound(bound int64)
+}
+
+// GetByteBound returns the TargetBytes field in ScanRequest.
+func (sr *ScanRequest) GetByteBound() int64 {
+	return sr.GetTargetBytes()
+}
+
+// SetByteBound sets the TargetBytes field in ScanRequest.
+func (sr *ScanRequest) SetByteBound(bound int64) {
+	sr.TargetBytes = bound
+}
+
+// Sizable is implemented by response types whose result rows have a
+// total size in bytes, such as Scan.
+type Sizable interface {
+	ByteCount() int64
+}
+
+// ByteCount returns the total size of the rows in ScanResponse.
+func (sr *ScanResponse) ByteCount() int64 {
+	var n int64
+	for i := range sr.Rows {
+		n += int64(sr.Rows[i].Size())
+	}
+	return n
+}
+
+// Resumable is implemented by response types which may cover only a
+// prefix of the requested span, such as Scan.
+type Resumable interface {
+	GetResumeSpan() *Span
+	SetResumeSpan(span *Span)
+}
+
+// SetResumeSpan sets the ResumeSpan field in ScanResponse.
+func (sr *ScanResponse) SetResumeSpan(span *Span) {
+	sr.ResumeSpan = span
+}
+
+// A ScanStreamRequest is a ScanRequest whose rows are streamed back in
+// chunks by the Node.ScanStream RPC ahead of its final response. It
+// is encoded exactly as a ScanRequest and implements the rpc codec's
+// StreamingRequest interface.
+type ScanStreamRequest struct {
+	ScanRequest
+	// OnChunk, if set, is invoked on the client with each chunk of rows
+	// received.
+	OnChunk func(chunk *ScanResponse)
+	send    func(gogoproto.Message) error
+}
+
+// NewChunk returns a new chunk of a streamed scan.
+func (sr *ScanStreamRequest) NewChunk() gogoproto.Message {
+	return &ScanResponse{}
+}
+
+// ReceiveChunk passes a chunk of rows received to OnChunk.
+func (sr *ScanStreamRequest) ReceiveChunk(chunk gogoproto.Message) {
+	if sr.OnChunk != nil {
+		sr.OnChunk(chunk.(*ScanResponse))
+	}
+}
+
+// SetChunkSender sets the function with which the server sends chunks
+// of rows to the client.
+func (sr *ScanStreamRequest) SetChunkSender(send func(gogoproto.Message) error) {
+	sr.send = send
+}
+
+// Streaming returns whether the client accepts chunks of rows sent
+// with SendChunk. If not, the rows must be returned with the final
+// response.
+func (sr *ScanStreamRequest) Streaming() bool {
+	return sr.send != nil
+}
+
+// SendChunk sends a chunk of rows to the client.
+func (sr *ScanStreamRequest) SendChunk(chunk *ScanResponse) error {
+	return sr.send(chunk)
+}
+
 // Method implements the Request interface.
 func (*ContainsRequest) Method() Method { return Contains }
 
@@ -297,6 +481,9 @@ func (*PutRequest) Method() Method { return Put }
 // Method implements the Request interface.
 func (*ConditionalPutRequest) Method() Method { return ConditionalPut }
 
+// Method implements the Request interface.
+func (*InitPutRequest) Method() Method { return InitPut }
+
 // Method implements the Request interface.
 func (*IncrementRequest) Method() Method { return Increment }
 
@@ -306,9 +493,18 @@ func (*DeleteRequest) Method() Method { return Delete }
 // Method implements the Request interface.
 func (*DeleteRangeRequest) Method() Method { return DeleteRange }
 
+// Method implements the Request interface.
+func (*ClearRangeRequest) Method() Method { return ClearRange }
+
 // Method implements the Request interface.
 func (*ScanRequest) Method() Method { return Scan }
 
+// Method implements the Request interface.
+func (*AggregateRequest) Method() Method { return Aggregate }
+
+// Method implements the Request interface.
+func (*WatchRequest) Method() Method { return Watch }
+
 // Method implements the Request interface.
 func (*EndTransactionRequest) Method() Method { return EndTransaction }
 
@@ -321,6 +517,18 @@ func (*AdminSplitRequest) Method() Method { return AdminSplit }
 // Method implements the Request interface.
 func (*AdminMergeRequest) Method() Method { return AdminMerge }
 
+// Method implements the Request interface.
+func (*AdminBulkLoadRequest) Method() Method { return AdminBulkLoad }
+
+// Method implements the Request interface.
+func (*AdminTransferLeaseRequest) Method() Method { return AdminTransferLease }
+
+// Method implements the Request interface.
+func (*AdminBackupRequest) Method() Method { return AdminBackup }
+
+// Method implements the Request interface.
+func (*AdminRestoreRequest) Method() Method { return AdminRestore }
+
 // Method implements the Request interface.
 func (*InternalHeartbeatTxnRequest) Method() Method { return InternalHeartbeatTxn }
 
@@ -345,6 +553,15 @@ func (*InternalLeaderLeaseRequest) Method() Method { return InternalLeaderLease
 // Method implements the Request interface.
 func (*InternalTruncateLogRequest) Method() Method { return InternalTruncateLog }
 
+// Method implements the Request interface.
+func (*InternalCheckConsistencyRequest) Method() Method { return InternalCheckConsistency }
+
+// Method implements the Request interface.
+func (*InternalRecomputeStatsRequest) Method() Method { return InternalRecomputeStats }
+
+// Method implements the Request interface.
+func (*InternalRefreshRangeRequest) Method() Method { return InternalRefreshRange }
+
 // CreateReply implements the Request interface.
 func (*ContainsRequest) CreateReply() Response { return &ContainsResponse{} }
 
@@ -357,6 +574,9 @@ func (*PutRequest) CreateReply() Response { return &PutResponse{} }
 // CreateReply implements the Request interface.
 func (*ConditionalPutRequest) CreateReply() Response { return &ConditionalPutResponse{} }
 
+// CreateReply implements the Request interface.
+func (*InitPutRequest) CreateReply() Response { return &InitPutResponse{} }
+
 // CreateReply implements the Request interface.
 func (*IncrementRequest) CreateReply() Response { return &IncrementResponse{} }
 
@@ -366,9 +586,18 @@ func (*DeleteRequest) CreateReply() Response { return &DeleteResponse{} }
 // CreateReply implements the Request interface.
 func (*DeleteRangeRequest) CreateReply() Response { return &DeleteRangeResponse{} }
 
+// CreateReply implements the Request interface.
+func (*ClearRangeRequest) CreateReply() Response { return &ClearRangeResponse{} }
+
 // CreateReply implements the Request interface.
 func (*ScanRequest) CreateReply() Response { return &ScanResponse{} }
 
+// CreateReply implements the Request interface.
+func (*AggregateRequest) CreateReply() Response { return &AggregateResponse{} }
+
+// CreateReply implements the Request interface.
+func (*WatchRequest) CreateReply() Response { return &WatchResponse{} }
+
 // CreateReply implements the Request interface.
 func (*EndTransactionRequest) CreateReply() Response { return &EndTransactionResponse{} }
 
@@ -381,6 +610,18 @@ func (*AdminSplitRequest) CreateReply() Response { return &AdminSplitResponse{}
 // CreateReply implements the Request interface.
 func (*AdminMergeRequest) CreateReply() Response { return &AdminMergeResponse{} }
 
+// CreateReply implements the Request interface.
+func (*AdminBulkLoadRequest) CreateReply() Response { return &AdminBulkLoadResponse{} }
+
+// CreateReply implements the Request interface.
+func (*AdminTransferLeaseRequest) CreateReply() Response { return &AdminTransferLeaseResponse{} }
+
+// CreateReply implements the Request interface.
+func (*AdminBackupRequest) CreateReply() Response { return &AdminBackupResponse{} }
+
+// CreateReply implements the Request interface.
+func (*AdminRestoreRequest) CreateReply() Response { return &AdminRestoreResponse{} }
+
 // CreateReply implements the Request interface.
 func (*InternalHeartbeatTxnRequest) CreateReply() Response { return &InternalHeartbeatTxnResponse{} }
 
@@ -405,23 +646,49 @@ func (*InternalTruncateLogRequest) CreateReply() Response { return &InternalTrun
 // CreateReply implements the Request interface.
 func (*InternalLeaderLeaseRequest) CreateReply() Response { return &InternalLeaderLeaseResponse{} }
 
-func (*ContainsRequest) flags() int              { return isRead }
-func (*GetRequest) flags() int                   { return isRead }
-func (*PutRequest) flags() int                   { return isWrite | isTxnWrite }
-func (*ConditionalPutRequest) flags() int        { return isRead | isWrite | isTxnWrite }
-func (*IncrementRequest) flags() int             { return isRead | isWrite | isTxnWrite }
-func (*DeleteRequest) flags() int                { return isWrite | isTxnWrite }
-func (*DeleteRangeRequest) flags() int           { return isWrite | isTxnWrite }
-func (*ScanRequest) flags() int                  { return isRead }
-func (*EndTransactionRequest) flags() int        { return isWrite }
-func (*BatchRequest) flags() int                 { return isWrite }
-func (*AdminSplitRequest) flags() int            { return isAdmin }
-func (*AdminMergeRequest) flags() int            { return isAdmin }
-func (*InternalHeartbeatTxnRequest) flags() int  { return isWrite }
-func (*InternalGCRequest) flags() int            { return isWrite }
-func (*InternalPushTxnRequest) flags() int       { return isWrite }
-func (*InternalRangeLookupRequest) flags() int   { return isRead }
-func (*InternalResolveIntentRequest) flags() int { return isWrite }
-func (*InternalMergeRequest) flags() int         { return isWrite }
-func (*InternalTruncateLogRequest) flags() int   { return isWrite }
-func (*InternalLeaderLeaseRequest) flags() int   { return isWrite }
+// CreateReply implements the Request interface.
+func (*InternalCheckConsistencyRequest) CreateReply() Response {
+	return &InternalCheckConsistencyResponse{}
+}
+
+// CreateReply implements the Request interface.
+func (*InternalRecomputeStatsRequest) CreateReply() Response {
+	return &InternalRecomputeStatsResponse{}
+}
+
+// CreateReply implements the Request interface.
+func (*InternalRefreshRangeRequest) CreateReply() Response {
+	return &InternalRefreshRangeResponse{}
+}
+
+func (*ContainsRequest) flags() int                 { return isRead }
+func (*GetRequest) flags() int                      { return isRead }
+func (*PutRequest) flags() int                      { return isWrite | isTxnWrite }
+func (*ConditionalPutRequest) flags() int           { return isRead | isWrite | isTxnWrite }
+func (*InitPutRequest) flags() int                  { return isRead | isWrite | isTxnWrite }
+func (*IncrementRequest) flags() int                { return isRead | isWrite | isTxnWrite }
+func (*DeleteRequest) flags() int                   { return isWrite | isTxnWrite }
+func (*DeleteRangeRequest) flags() int              { return isWrite | isTxnWrite }
+func (*ClearRangeRequest) flags() int               { return isWrite }
+func (*ScanRequest) flags() int                     { return isRead }
+func (*AggregateRequest) flags() int                { return isRead }
+func (*WatchRequest) flags() int                    { return isRead }
+func (*EndTransactionRequest) flags() int           { return isWrite }
+func (*BatchRequest) flags() int                    { return isWrite }
+func (*AdminSplitRequest) flags() int               { return isAdmin }
+func (*AdminMergeRequest) flags() int               { return isAdmin }
+func (*AdminBulkLoadRequest) flags() int            { return isAdmin }
+func (*AdminTransferLeaseRequest) flags() int       { return isAdmin }
+func (*AdminBackupRequest) flags() int              { return isAdmin }
+func (*AdminRestoreRequest) flags() int             { return isAdmin }
+func (*InternalHeartbeatTxnRequest) flags() int     { return isWrite }
+func (*InternalGCRequest) flags() int               { return isWrite }
+func (*InternalPushTxnRequest) flags() int          { return isWrite }
+func (*InternalRangeLookupRequest) flags() int      { return isRead }
+func (*InternalResolveIntentRequest) flags() int    { return isWrite }
+func (*InternalMergeRequest) flags() int            { return isWrite }
+func (*InternalTruncateLogRequest) flags() int      { return isWrite }
+func (*InternalLeaderLeaseRequest) flags() int      { return isWrite }
+func (*InternalCheckConsistencyRequest) flags() int { return isWrite }
+func (*InternalRecomputeStatsRequest) flags() int   { return isWrite }
+func (*InternalRefreshRangeRequest) flags() int     { return isRead }
@@ -19,7 +19,11 @@ package proto
 
 import (
 	"fmt"
+	"io/ioutil"
+	"math"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -35,6 +39,17 @@ func TestClientCmdIDIsEmpty(t *testing.T) {
 	}
 }
 
+func TestRequestPriorityLess(t *testing.T) {
+	ordered := []RequestPriority{BACKGROUND, LOW, NORMAL, HIGH, SYSTEM}
+	for i, p := range ordered {
+		for j, o := range ordered {
+			if p.Less(o) != (i < j) {
+				t.Errorf("expected %s.Less(%s) = %t", p, o, i < j)
+			}
+		}
+	}
+}
+
 type testError struct{}
 
 func (t *testError) Error() string  { return "test" }
@@ -138,6 +153,46 @@ func TestCombinable(t *testing.T) {
 	if !reflect.DeepEqual(dr1, wantedDR) {
 		t.Errorf("wanted %v, got %v", wantedDR, dr1)
 	}
+
+	ar1 := &AggregateResponse{
+		ResponseHeader: ResponseHeader{Timestamp: Timestamp{Logical: 2}},
+		NumKeys:        2,
+		Sum:            7,
+	}
+	if _, ok := interface{}(ar1).(Combinable); !ok {
+		t.Fatalf("AggregateResponse does not implement Combinable")
+	}
+	ar2 := &AggregateResponse{
+		ResponseHeader: ResponseHeader{Timestamp: Timestamp{Logical: 5}},
+		NumKeys:        3,
+		Sum:            -2,
+	}
+	wantedAR := &AggregateResponse{
+		ResponseHeader: ResponseHeader{Timestamp: Timestamp{Logical: 5}},
+		NumKeys:        5,
+		Sum:            5,
+	}
+	if err := ar1.Combine(ar2); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(ar1, wantedAR) {
+		t.Errorf("wanted %v, got %v", wantedAR, ar1)
+	}
+
+	// Counts and sums which overflow can't be combined.
+	if err := ar1.Combine(&AggregateResponse{Sum: math.MaxInt64}); err == nil {
+		t.Errorf("expected overflowing sum to fail")
+	}
+	if err := ar1.Combine(&AggregateResponse{NumKeys: math.MaxInt64}); err == nil {
+		t.Errorf("expected overflowing number of keys to fail")
+	}
+	if err := dr1.Combine(&DeleteRangeResponse{NumDeleted: math.MaxInt64}); err == nil {
+		t.Errorf("expected overflowing number of deleted keys to fail")
+	}
+	if !reflect.DeepEqual(ar1, wantedAR) || !reflect.DeepEqual(dr1, wantedDR) {
+		t.Errorf("expected failed combines to leave responses unchanged")
+	}
 }
 
 func TestSetGoErrorCopy(t *testing.T) {
@@ -149,3 +204,37 @@ func TestSetGoErrorCopy(t *testing.T) {
 		t.Fatalf("SetGoError did not create a new error")
 	}
 }
+
+// TestServiceDefinitions verifies that the services defined in
+// api.proto expose only public methods, that their messages follow the
+// naming of the request and response types, and that every admin
+// method is exposed.
+func TestServiceDefinitions(t *testing.T) {
+	contents, err := ioutil.ReadFile("api.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rpcRE := regexp.MustCompile(`rpc (\w+)\((\w+)\) returns \((\w+)\);`)
+	rpcs := map[string]struct{}{}
+	for _, m := range rpcRE.FindAllStringSubmatch(string(contents), -1) {
+		name, req, resp := m[1], m[2], m[3]
+		if _, ok := AllMethods[name]; !ok {
+			t.Errorf("rpc %s does not name a method", name)
+		}
+		if strings.HasPrefix(name, "Internal") {
+			t.Errorf("rpc %s exposes an internal method", name)
+		}
+		if req != name+"Request" || resp != name+"Response" {
+			t.Errorf("rpc %s has unexpected message types %s, %s", name, req, resp)
+		}
+		if _, ok := rpcs[name]; ok {
+			t.Errorf("rpc %s is defined more than once", name)
+		}
+		rpcs[name] = struct{}{}
+	}
+	for name := range AllMethods {
+		if _, ok := rpcs[name]; strings.HasPrefix(name, "Admin") && !ok {
+			t.Errorf("admin method %s is not exposed by a service", name)
+		}
+	}
+}
@@ -91,6 +91,21 @@ func (a Attributes) IsSubset(b Attributes) bool {
 	return true
 }
 
+// IsDisjoint returns whether attributes list a has no attributes in
+// common with attributes list b.
+func (a Attributes) IsDisjoint(b Attributes) bool {
+	m := map[string]struct{}{}
+	for _, s := range b.Attrs {
+		m[s] = struct{}{}
+	}
+	for _, s := range a.Attrs {
+		if _, ok := m[s]; ok {
+			return false
+		}
+	}
+	return true
+}
+
 // SortedString returns a sorted, de-duplicated, comma-separated list
 // of the attributes.
 func (a Attributes) SortedString() string {
@@ -43,8 +43,12 @@ type Replica struct {
 	NodeID  NodeID  `protobuf:"varint,1,opt,name=node_id,customtype=NodeID" json:"node_id"`
 	StoreID StoreID `protobuf:"varint,2,opt,name=store_id,customtype=StoreID" json:"store_id"`
 	// Combination of node & store attributes.
-	Attrs            Attributes `protobuf:"bytes,3,opt,name=attrs" json:"attrs"`
-	XXX_unrecognized []byte     `json:"-"`
+	Attrs Attributes `protobuf:"bytes,3,opt,name=attrs" json:"attrs"`
+	// Learner is set while the replica is being caught up with the rest of
+	// its range. A learner receives the range's data but does not vote, and
+	// is promoted to a voter by a second replica change.
+	Learner          bool   `protobuf:"varint,4,opt,name=learner" json:"learner"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Replica) Reset()         { *m = Replica{} }
@@ -58,6 +62,13 @@ func (m *Replica) GetAttrs() Attributes {
 	return Attributes{}
 }
 
+func (m *Replica) GetLearner() bool {
+	if m != nil {
+		return m.Learner
+	}
+	return false
+}
+
 // RangeDescriptor is the value stored in a range metadata key.
 // A range is described using an inclusive start key, a non-inclusive end key,
 // and a list of replicas where the range is stored.
@@ -103,7 +114,7 @@ type GCPolicy struct {
 	// TTLSeconds specifies the maximum age of a value before it's
 	// garbage collected. Only older versions of values are garbage
 	// collected. Specifying <=0 mean older versions are never GC'd.
-	TTLSeconds       int32  `protobuf:"varint,1,opt,name=ttl_seconds" json:"ttl_seconds"`
+	TTLSeconds       int32  `protobuf:"varint,1,opt,name=ttl_seconds" json:"ttl_seconds" yaml:"ttl_seconds,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -120,7 +131,11 @@ func (m *GCPolicy) GetTTLSeconds() int32 {
 
 // AcctConfig holds accounting configuration.
 type AcctConfig struct {
-	ClusterId        string `protobuf:"bytes,1,opt,name=cluster_id" json:"cluster_id" yaml:"cluster_id,omitempty"`
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id" json:"cluster_id" yaml:"cluster_id,omitempty"`
+	// The maximum number of key and value bytes, including historical
+	// versions, stored under the prefix. Writes adding data under the
+	// prefix are rejected once it is exceeded. Zero means no limit.
+	ByteLimit        int64  `protobuf:"varint,2,opt,name=byte_limit" json:"byte_limit" yaml:"byte_limit,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -135,6 +150,13 @@ func (m *AcctConfig) GetClusterId() string {
 	return ""
 }
 
+func (m *AcctConfig) GetByteLimit() int64 {
+	if m != nil {
+		return m.ByteLimit
+	}
+	return 0
+}
+
 // PermConfig holds permission configuration, specifying read/write ACLs.
 type PermConfig struct {
 	// ACL lists users with read permissions.
@@ -162,6 +184,19 @@ func (m *PermConfig) GetWrite() []string {
 	return nil
 }
 
+// UserConfig holds the configuration of a user of the cluster, stored
+// under the user's name in the system users table. A user whose
+// config is present is registered with the cluster; requests
+// authenticated as any other user, root and nodes excepted, are
+// rejected.
+type UserConfig struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UserConfig) Reset()         { *m = UserConfig{} }
+func (m *UserConfig) String() string { return proto1.CompactTextString(m) }
+func (*UserConfig) ProtoMessage()    {}
+
 // ZoneConfig holds configuration that is needed for a range of KV pairs.
 type ZoneConfig struct {
 	// ReplicaAttrs is a slice of Attributes, each describing required attributes
@@ -172,8 +207,18 @@ type ZoneConfig struct {
 	RangeMaxBytes int64        `protobuf:"varint,3,opt,name=range_max_bytes" json:"range_max_bytes" yaml:"range_max_bytes,omitempty"`
 	// If GC policy is not set, uses the next highest, non-null policy
 	// in the zone config hierarchy, up to the default policy if necessary.
-	GC               *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
-	XXX_unrecognized []byte    `json:"-"`
+	GC *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
+	// ProhibitedAttrs lists attributes which no replica in the zone may be
+	// placed on. A store is ineligible if its combined node and store
+	// attributes contain any of these (e.g. "hdd" or "region=us-west").
+	ProhibitedAttrs *Attributes `protobuf:"bytes,5,opt,name=prohibited_attrs" json:"prohibited_attrs,omitempty" yaml:"prohibited,omitempty"`
+	// RangeBackpressureMultiple is the multiple of RangeMaxBytes beyond
+	// which writes to a range are held back until it has been split, so
+	// that a backlog of splits doesn't let ranges grow too large to be
+	// snapshotted. Zero uses the default multiple of 2; a negative value
+	// disables the backpressure.
+	RangeBackpressureMultiple *float64 `protobuf:"fixed64,6,opt,name=range_backpressure_multiple" json:"range_backpressure_multiple,omitempty" yaml:"range_backpressure_multiple,omitempty"`
+	XXX_unrecognized          []byte   `json:"-"`
 }
 
 func (m *ZoneConfig) Reset()         { *m = ZoneConfig{} }
@@ -208,6 +253,20 @@ func (m *ZoneConfig) GetGC() *GCPolicy {
 	return nil
 }
 
+func (m *ZoneConfig) GetProhibitedAttrs() *Attributes {
+	if m != nil {
+		return m.ProhibitedAttrs
+	}
+	return nil
+}
+
+func (m *ZoneConfig) GetRangeBackpressureMultiple() float64 {
+	if m != nil && m.RangeBackpressureMultiple != nil {
+		return *m.RangeBackpressureMultiple
+	}
+	return 0
+}
+
 // RangeTree holds the root node and size of the range tree.
 type RangeTree struct {
 	RootKey          Key    `protobuf:"bytes,1,opt,name=root_key,customtype=Key" json:"root_key"`
@@ -383,6 +442,23 @@ func (m *Replica) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Learner = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -632,6 +708,21 @@ func (m *AcctConfig) Unmarshal(data []byte) error {
 			}
 			m.ClusterId = string(data[index:postIndex])
 			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteLimit", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.ByteLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -741,6 +832,47 @@ func (m *PermConfig) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *UserConfig) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		switch fieldNum {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (m *ZoneConfig) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -840,6 +972,53 @@ func (m *ZoneConfig) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProhibitedAttrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProhibitedAttrs == nil {
+				m.ProhibitedAttrs = &Attributes{}
+			}
+			if err := m.ProhibitedAttrs.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeBackpressureMultiple", wireType)
+			}
+			var v uint64
+			i := index + 8
+			if i > l {
+				return io.ErrUnexpectedEOF
+			}
+			index = i
+			v = uint64(data[i-8])
+			v |= uint64(data[i-7]) << 8
+			v |= uint64(data[i-6]) << 16
+			v |= uint64(data[i-5]) << 24
+			v |= uint64(data[i-4]) << 32
+			v |= uint64(data[i-3]) << 40
+			v |= uint64(data[i-2]) << 48
+			v |= uint64(data[i-1]) << 56
+			v2 := math.Float64frombits(v)
+			m.RangeBackpressureMultiple = &v2
 		default:
 			var sizeOfWire int
 			for {
@@ -1108,6 +1287,7 @@ func (m *Replica) Size() (n int) {
 	n += 1 + sovConfig(uint64(m.StoreID))
 	l = m.Attrs.Size()
 	n += 1 + l + sovConfig(uint64(l))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1149,6 +1329,7 @@ func (m *AcctConfig) Size() (n int) {
 	_ = l
 	l = len(m.ClusterId)
 	n += 1 + l + sovConfig(uint64(l))
+	n += 1 + sovConfig(uint64(m.ByteLimit))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1176,6 +1357,15 @@ func (m *PermConfig) Size() (n int) {
 	return n
 }
 
+func (m *UserConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ZoneConfig) Size() (n int) {
 	var l int
 	_ = l
@@ -1191,6 +1381,13 @@ func (m *ZoneConfig) Size() (n int) {
 		l = m.GC.Size()
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	if m.ProhibitedAttrs != nil {
+		l = m.ProhibitedAttrs.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.RangeBackpressureMultiple != nil {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1308,6 +1505,14 @@ func (m *Replica) MarshalTo(data []byte) (n int, err error) {
 		return 0, err
 	}
 	i += n1
+	data[i] = 0x20
+	i++
+	if m.Learner {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1409,6 +1614,9 @@ func (m *AcctConfig) MarshalTo(data []byte) (n int, err error) {
 	i++
 	i = encodeVarintConfig(data, i, uint64(len(m.ClusterId)))
 	i += copy(data[i:], m.ClusterId)
+	data[i] = 0x10
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.ByteLimit))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1466,6 +1674,27 @@ func (m *PermConfig) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *UserConfig) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UserConfig) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ZoneConfig) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1509,6 +1738,21 @@ func (m *ZoneConfig) MarshalTo(data []byte) (n int, err error) {
 		}
 		i += n4
 	}
+	if m.ProhibitedAttrs != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintConfig(data, i, uint64(m.ProhibitedAttrs.Size()))
+		n5, err := m.ProhibitedAttrs.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.RangeBackpressureMultiple != nil {
+		data[i] = 0x31
+		i++
+		i = encodeFixed64Config(data, i, uint64(math.Float64bits(*m.RangeBackpressureMultiple)))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1533,11 +1777,11 @@ func (m *RangeTree) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintConfig(data, i, uint64(m.RootKey.Size()))
-	n5, err := m.RootKey.MarshalTo(data[i:])
+	n6, err := m.RootKey.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1562,11 +1806,11 @@ func (m *RangeTreeNode) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintConfig(data, i, uint64(m.Key.Size()))
-	n6, err := m.Key.MarshalTo(data[i:])
+	n7, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	data[i] = 0x10
 	i++
 	if m.Black {
@@ -1578,30 +1822,30 @@ func (m *RangeTreeNode) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintConfig(data, i, uint64(m.ParentKey.Size()))
-	n7, err := m.ParentKey.MarshalTo(data[i:])
+	n8, err := m.ParentKey.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	if m.LeftKey != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintConfig(data, i, uint64(m.LeftKey.Size()))
-		n8, err := m.LeftKey.MarshalTo(data[i:])
+		n9, err := m.LeftKey.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if m.RightKey != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintConfig(data, i, uint64(m.RightKey.Size()))
-		n9, err := m.RightKey.MarshalTo(data[i:])
+		n10, err := m.RightKey.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
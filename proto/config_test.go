@@ -47,6 +47,21 @@ func TestAttributesIsSubset(t *testing.T) {
 	}
 }
 
+func TestAttributesIsDisjoint(t *testing.T) {
+	a := Attributes{Attrs: []string{"a", "b", "c"}}
+	b := Attributes{Attrs: []string{"c", "d"}}
+	c := Attributes{Attrs: []string{"d", "e"}}
+	if a.IsDisjoint(b) {
+		t.Errorf("%+v should not be disjoint from %+v", a, b)
+	}
+	if !a.IsDisjoint(c) {
+		t.Errorf("expected %+v to be disjoint from %+v", a, c)
+	}
+	if !a.IsDisjoint(Attributes{}) {
+		t.Errorf("expected %+v to be disjoint from empty attributes", a)
+	}
+}
+
 func TestAttributesSortedString(t *testing.T) {
 	a := Attributes{Attrs: []string{"a", "b", "c"}}
 	if a.SortedString() != "a,b,c" {
@@ -73,6 +73,13 @@ func (s KeySlice) Len() int           { return len(s) }
 func (s KeySlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s KeySlice) Less(i, j int) bool { return s[i].Less(s[j]) }
 
+// KeyValueSlice implements sort.Interface, ordering by key.
+type KeyValueSlice []KeyValue
+
+func (s KeyValueSlice) Len() int           { return len(s) }
+func (s KeyValueSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s KeyValueSlice) Less(i, j int) bool { return s[i].Key.Less(s[j].Key) }
+
 // Returns the next possible byte by appending an \x00.
 func bytesNext(b []byte) []byte {
 	// TODO(spencer): Do we need to enforce KeyMaxLength here?
@@ -337,6 +344,20 @@ func (v *Value) InitChecksum(key []byte) {
 // set the verification is a noop. It also ensures that both Bytes
 // and Integer are not both set.
 func (v *Value) Verify(key []byte) error {
+	if err := v.VerifyChecksum(key); err != nil {
+		return err
+	}
+	if v.Bytes != nil && v.Integer != nil {
+		return util.Errorf("both the value byte slice and integer fields are set for key %s: [% x]",
+			Key(key), v)
+	}
+	return nil
+}
+
+// VerifyChecksum verifies the value's Checksum matches a
+// newly-computed checksum of the value's contents. If the value's
+// Checksum is not set the verification is a noop.
+func (v *Value) VerifyChecksum(key []byte) error {
 	if v.Checksum != nil {
 		cksum := v.computeChecksum(key)
 		if v.GetChecksum() != cksum {
@@ -344,10 +365,6 @@ func (v *Value) Verify(key []byte) error {
 				cksum, Key(key), v)
 		}
 	}
-	if v.Bytes != nil && v.Integer != nil {
-		return util.Errorf("both the value byte slice and integer fields are set for key %s: [% x]",
-			Key(key), v)
-	}
 	return nil
 }
 
@@ -415,7 +432,9 @@ func NewTransaction(name string, baseKey Key, userPriority int32,
 // MakePriority generates a random priority value, biased by the
 // specified userPriority. If userPriority=100, the resulting
 // priority is 100x more likely to be probabilistically greater
-// than a similar invocation with userPriority=1.
+// than a similar invocation with userPriority=1. A userPriority of
+// MaxPriority yields MaxPriority, which no other userPriority does,
+// so that such transactions win all pushes against the rest.
 func MakePriority(userPriority int32) int32 {
 	// A currently undocumented feature allows an explicit priority to
 	// be set by specifying priority < 1. The explicit priority is
@@ -424,6 +443,9 @@ func MakePriority(userPriority int32) int32 {
 	if userPriority < 0 {
 		return -userPriority
 	}
+	if userPriority == MaxPriority {
+		return MaxPriority
+	}
 	if userPriority == 0 {
 		userPriority = 1
 	}
@@ -437,7 +459,24 @@ func MakePriority(userPriority int32) int32 {
 	//   100           |  top 99/100ths of positive int32s
 	//   1000          |  top 999/1000ths of positive int32s
 	//   ...etc
-	return math.MaxInt32 - rand.Int31n(math.MaxInt32/userPriority)
+	// The top value is reserved for userPriority=MaxPriority.
+	return MaxPriority - 1 - rand.Int31n((MaxPriority-1)/userPriority)
+}
+
+// EscalatePriority returns the priority with which to restart a
+// transaction which was aborted at the given priority. The priority
+// moves halfway towards the highest priority available to random
+// priorities, so that a transaction which is aborted repeatedly
+// eventually wins its conflicts instead of starving. MaxPriority is
+// never reached by escalation.
+func EscalatePriority(priority int32) int32 {
+	if priority >= MaxPriority-1 {
+		return priority
+	}
+	if priority < 0 {
+		priority = 0
+	}
+	return priority + (MaxPriority-priority)/2
 }
 
 // MD5Equal returns whether the specified md5.Size byte arrays are equal.
@@ -496,6 +535,25 @@ func (t *Transaction) Update(o *Transaction) {
 	t.CertainNodes = NodeList{Nodes: append(Int32Slice(nil),
 		o.CertainNodes.Nodes...)}
 	t.UpgradePriority(o.Priority)
+	if t.GetSequence() < o.GetSequence() {
+		t.Sequence = gogoproto.Int32(o.GetSequence())
+	}
+	// Ignored sequences are only ever appended to.
+	if len(t.IgnoredSequences) < len(o.IgnoredSequences) {
+		t.IgnoredSequences = append([]SequenceRange(nil), o.IgnoredSequences...)
+	}
+}
+
+// IsSequenceIgnored returns whether the writes made by the
+// transaction with the given sequence number were rolled back to a
+// savepoint.
+func (t *Transaction) IsSequenceIgnored(seq int32) bool {
+	for _, r := range t.IgnoredSequences {
+		if r.Start <= seq && seq <= r.End {
+			return true
+		}
+	}
+	return false
 }
 
 // UpgradePriority sets transaction priority to the maximum of current
@@ -17,21 +17,62 @@ import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 var _ = proto1.Marshal
 var _ = math.Inf
 
+// ValueCompression specifies the compression applied to the bytes of
+// a stored value.
+type ValueCompression int32
+
+const (
+	VALUE_UNCOMPRESSED ValueCompression = 0
+	// VALUE_SNAPPY indicates the bytes are snappy compressed.
+	VALUE_SNAPPY ValueCompression = 1
+)
+
+var ValueCompression_name = map[int32]string{
+	0: "VALUE_UNCOMPRESSED",
+	1: "VALUE_SNAPPY",
+}
+var ValueCompression_value = map[string]int32{
+	"VALUE_UNCOMPRESSED": 0,
+	"VALUE_SNAPPY":       1,
+}
+
+func (x ValueCompression) Enum() *ValueCompression {
+	p := new(ValueCompression)
+	*p = x
+	return p
+}
+func (x ValueCompression) String() string {
+	return proto1.EnumName(ValueCompression_name, int32(x))
+}
+func (x *ValueCompression) UnmarshalJSON(data []byte) error {
+	value, err := proto1.UnmarshalJSONEnum(ValueCompression_value, data, "ValueCompression")
+	if err != nil {
+		return err
+	}
+	*x = ValueCompression(value)
+	return nil
+}
+
 // ReplicaChangeType is a parameter of ChangeReplicasTrigger.
 type ReplicaChangeType int32
 
 const (
 	ADD_REPLICA    ReplicaChangeType = 0
 	REMOVE_REPLICA ReplicaChangeType = 1
+	// ADD_LEARNER adds a non-voting replica. A subsequent ADD_REPLICA of
+	// the same replica promotes it to a voter.
+	ADD_LEARNER ReplicaChangeType = 2
 )
 
 var ReplicaChangeType_name = map[int32]string{
 	0: "ADD_REPLICA",
 	1: "REMOVE_REPLICA",
+	2: "ADD_LEARNER",
 }
 var ReplicaChangeType_value = map[string]int32{
 	"ADD_REPLICA":    0,
 	"REMOVE_REPLICA": 1,
+	"ADD_LEARNER":    2,
 }
 
 func (x ReplicaChangeType) Enum() *ReplicaChangeType {
@@ -55,9 +96,15 @@ func (x *ReplicaChangeType) UnmarshalJSON(data []byte) error {
 type IsolationType int32
 
 const (
-	// SERIALIZABLE TODO(jiajia) Needs documentation.
+	// SERIALIZABLE transactions read at their original timestamp and
+	// must commit at it; a transaction whose timestamp is pushed by a
+	// conflicting read or write restarts on commit.
 	SERIALIZABLE IsolationType = 0
-	// SNAPSHOT TODO(jiajia) Needs documentation.
+	// SNAPSHOT transactions read at their original timestamp but may
+	// commit at a later one, trading serializability (write skew is
+	// possible) for fewer restarts. Writes to keys which another
+	// transaction committed after the original timestamp restart the
+	// transaction.
 	SNAPSHOT IsolationType = 1
 )
 
@@ -191,8 +238,14 @@ type Value struct {
 	// Tag is an optional string value which can be used to add additional
 	// metadata to this value. For example, Tag might provide information on how
 	// the bytes in the "bytes" field should be interpreted.
-	Tag              *string `protobuf:"bytes,5,opt,name=tag" json:"tag,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Tag *string `protobuf:"bytes,5,opt,name=tag" json:"tag,omitempty"`
+	// Compression is the compression applied to bytes by the range which
+	// proposed the write of the value, which the storage engine stores
+	// as is. Values are decompressed before they are returned, so this is
+	// never set in requests or responses. The checksum always covers the
+	// uncompressed bytes.
+	Compression      *ValueCompression `protobuf:"varint,6,opt,name=compression,enum=cockroach.proto.ValueCompression" json:"compression,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
 }
 
 func (m *Value) Reset()         { *m = Value{} }
@@ -234,6 +287,13 @@ func (m *Value) GetTag() string {
 	return ""
 }
 
+func (m *Value) GetCompression() ValueCompression {
+	if m != nil && m.Compression != nil {
+		return *m.Compression
+	}
+	return VALUE_UNCOMPRESSED
+}
+
 // MVCCValue differentiates between normal versioned values and
 // deletion tombstones.
 type MVCCValue struct {
@@ -282,6 +342,18 @@ func (m *KeyValue) GetValue() Value {
 	return Value{}
 }
 
+// A Span is a contiguous range of keys from Key (inclusive) to EndKey
+// (exclusive).
+type Span struct {
+	Key              Key    `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	EndKey           Key    `protobuf:"bytes,2,opt,name=end_key,customtype=Key" json:"end_key"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Span) Reset()         { *m = Span{} }
+func (m *Span) String() string { return proto1.CompactTextString(m) }
+func (*Span) ProtoMessage()    {}
+
 // RawKeyValue contains the raw bytes of the value for a key.
 type RawKeyValue struct {
 	Key              EncodedKey `protobuf:"bytes,1,opt,name=key,customtype=EncodedKey" json:"key"`
@@ -516,8 +588,16 @@ type Transaction struct {
 	// Bits of this mechanism are found in the local sender, the range and the
 	// txn_coord_sender, with brief comments referring here.
 	// See https://github.com/cockroachdb/cockroach/pull/221.
-	CertainNodes     NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
-	XXX_unrecognized []byte   `json:"-"`
+	CertainNodes NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
+	// Sequence is incremented by the client for each batch of requests
+	// it sends in the transaction and is recorded on the intents they
+	// write. Savepoints are positions in this sequence.
+	Sequence *int32 `protobuf:"varint,13,opt,name=sequence" json:"sequence,omitempty"`
+	// IgnoredSequences are the ranges of sequence numbers whose writes
+	// were rolled back to a savepoint. Reads within the transaction
+	// don't see these writes and they are discarded on commit.
+	IgnoredSequences []SequenceRange `protobuf:"bytes,14,rep,name=ignored_sequences" json:"ignored_sequences"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -600,6 +680,45 @@ func (m *Transaction) GetCertainNodes() NodeList {
 	return NodeList{}
 }
 
+func (m *Transaction) GetSequence() int32 {
+	if m != nil && m.Sequence != nil {
+		return *m.Sequence
+	}
+	return 0
+}
+
+func (m *Transaction) GetIgnoredSequences() []SequenceRange {
+	if m != nil {
+		return m.IgnoredSequences
+	}
+	return nil
+}
+
+// SequenceRange is an inclusive range of transaction sequence numbers.
+type SequenceRange struct {
+	Start            int32  `protobuf:"varint,1,opt,name=start" json:"start"`
+	End              int32  `protobuf:"varint,2,opt,name=end" json:"end"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *SequenceRange) Reset()         { *m = SequenceRange{} }
+func (m *SequenceRange) String() string { return proto1.CompactTextString(m) }
+func (*SequenceRange) ProtoMessage()    {}
+
+func (m *SequenceRange) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SequenceRange) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 // Lease contains information about leader leases including the
 // expiration and lease holder.
 type Lease struct {
@@ -616,7 +735,12 @@ type Lease struct {
 	// The leadership term for this lease.
 	Term uint64 `protobuf:"varint,3,opt,name=term" json:"term"`
 	// The Raft NodeID on which the would-be lease holder lives.
-	RaftNodeID       uint64 `protobuf:"varint,4,opt,name=raft_node_id" json:"raft_node_id"`
+	RaftNodeID uint64 `protobuf:"varint,4,opt,name=raft_node_id" json:"raft_node_id"`
+	// If non-zero, the lease is epoch-based: it remains valid for as long
+	// as the liveness record of the holder's node carries this epoch and
+	// has not expired, and Expiration and Duration are unused. The node's
+	// liveness heartbeat thus extends all of its epoch-based leases at once.
+	Epoch            int64  `protobuf:"varint,5,opt,name=epoch" json:"epoch"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -652,6 +776,136 @@ func (m *Lease) GetRaftNodeID() uint64 {
 	return 0
 }
 
+func (m *Lease) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// Liveness holds the latest heartbeat of a node. Each node heartbeats
+// its own record, stored in the system key range, and the record is
+// consulted where decisions depend on whether a node is up.
+type Liveness struct {
+	NodeID NodeID `protobuf:"varint,1,opt,name=node_id,customtype=NodeID" json:"node_id"`
+	// The epoch is incremented by another node which finds the node's
+	// liveness expired. The node's own heartbeats leave it unchanged.
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch" json:"epoch"`
+	// The node is live until the expiration, which each heartbeat
+	// extends by the liveness threshold.
+	Expiration Timestamp `protobuf:"bytes,3,opt,name=expiration" json:"expiration"`
+	// Decommissioning is set while the node's replicas are moved off it
+	// before it is removed from the cluster. No replicas are allocated
+	// to a decommissioning node.
+	Decommissioning  bool   `protobuf:"varint,4,opt,name=decommissioning" json:"decommissioning"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Liveness) Reset()         { *m = Liveness{} }
+func (m *Liveness) String() string { return proto1.CompactTextString(m) }
+func (*Liveness) ProtoMessage()    {}
+
+func (m *Liveness) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Liveness) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
+func (m *Liveness) GetDecommissioning() bool {
+	if m != nil {
+		return m.Decommissioning
+	}
+	return false
+}
+
+// ClusterVersion is the version of the cluster's on-disk formats: the
+// number of startup migrations which have been run cluster-wide.
+type ClusterVersion struct {
+	Version          int64  `protobuf:"varint,1,opt,name=version" json:"version"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ClusterVersion) Reset()         { *m = ClusterVersion{} }
+func (m *ClusterVersion) String() string { return proto1.CompactTextString(m) }
+func (*ClusterVersion) ProtoMessage()    {}
+
+func (m *ClusterVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// MigrationLease is held by the node running startup migrations, so
+// that each migration runs exactly once cluster-wide. The lease is
+// extended before each migration and released once all have run.
+type MigrationLease struct {
+	NodeID           NodeID    `protobuf:"varint,1,opt,name=node_id,customtype=NodeID" json:"node_id"`
+	Expiration       Timestamp `protobuf:"bytes,2,opt,name=expiration" json:"expiration"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *MigrationLease) Reset()         { *m = MigrationLease{} }
+func (m *MigrationLease) String() string { return proto1.CompactTextString(m) }
+func (*MigrationLease) ProtoMessage()    {}
+
+func (m *MigrationLease) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
+// NodeIDClaim records the allocation of a node ID, the address from
+// which the node last started and the ID of the cluster in which the
+// ID was allocated. A node started with an ID claimed from another
+// address in another cluster is rejected while the claiming node is
+// live.
+type NodeIDClaim struct {
+	NodeID           NodeID `protobuf:"varint,1,opt,name=node_id,customtype=NodeID" json:"node_id"`
+	Address          string `protobuf:"bytes,2,opt,name=address" json:"address"`
+	ClusterID        string `protobuf:"bytes,3,opt,name=cluster_id" json:"cluster_id"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *NodeIDClaim) Reset()         { *m = NodeIDClaim{} }
+func (m *NodeIDClaim) String() string { return proto1.CompactTextString(m) }
+func (*NodeIDClaim) ProtoMessage()    {}
+
+func (m *NodeIDClaim) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NodeIDClaim) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+// StoreIDClaim records the node to which a store ID was allocated. A
+// store started on any other node is rejected.
+type StoreIDClaim struct {
+	StoreID          StoreID `protobuf:"varint,1,opt,name=store_id,customtype=StoreID" json:"store_id"`
+	NodeID           NodeID  `protobuf:"varint,2,opt,name=node_id,customtype=NodeID" json:"node_id"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *StoreIDClaim) Reset()         { *m = StoreIDClaim{} }
+func (m *StoreIDClaim) String() string { return proto1.CompactTextString(m) }
+func (*StoreIDClaim) ProtoMessage()    {}
+
 // MVCCMetadata holds MVCC metadata for a key. Used by storage/engine/mvcc.go.
 type MVCCMetadata struct {
 	Txn *Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn,omitempty"`
@@ -668,8 +922,12 @@ type MVCCMetadata struct {
 	// and subsequent version rows. If timestamp == (0, 0), then there
 	// is only a single MVCC metadata row with value inlined, and with
 	// empty timestamp, key_bytes, and val_bytes.
-	Value            *Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
-	XXX_unrecognized []byte `json:"-"`
+	Value *Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+	// The values of earlier writes to the key by the intent's
+	// transaction, in increasing order of sequence. These are restored
+	// if the intent's own write is rolled back to a savepoint.
+	IntentHistory    []SequencedValue `protobuf:"bytes,7,rep,name=intent_history" json:"intent_history"`
+	XXX_unrecognized []byte           `json:"-"`
 }
 
 func (m *MVCCMetadata) Reset()         { *m = MVCCMetadata{} }
@@ -718,6 +976,39 @@ func (m *MVCCMetadata) GetValue() *Value {
 	return nil
 }
 
+func (m *MVCCMetadata) GetIntentHistory() []SequencedValue {
+	if m != nil {
+		return m.IntentHistory
+	}
+	return nil
+}
+
+// SequencedValue is a value written by a transaction, along with the
+// transaction's sequence number at the time of the write.
+type SequencedValue struct {
+	Sequence         int32     `protobuf:"varint,1,opt,name=sequence" json:"sequence"`
+	Value            MVCCValue `protobuf:"bytes,2,opt,name=value" json:"value"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *SequencedValue) Reset()         { *m = SequencedValue{} }
+func (m *SequencedValue) String() string { return proto1.CompactTextString(m) }
+func (*SequencedValue) ProtoMessage()    {}
+
+func (m *SequencedValue) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SequencedValue) GetValue() MVCCValue {
+	if m != nil {
+		return m.Value
+	}
+	return MVCCValue{}
+}
+
 // GCMetadata holds information about the last complete key/value
 // garbage collection scan of a range.
 type GCMetadata struct {
@@ -934,6 +1225,7 @@ func (m *MVCCStats) GetLastUpdateNanos() int64 {
 }
 
 func init() {
+	proto1.RegisterEnum("cockroach.proto.ValueCompression", ValueCompression_name, ValueCompression_value)
 	proto1.RegisterEnum("cockroach.proto.ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
 	proto1.RegisterEnum("cockroach.proto.IsolationType", IsolationType_name, IsolationType_value)
 	proto1.RegisterEnum("cockroach.proto.TransactionStatus", TransactionStatus_name, TransactionStatus_value)
@@ -1133,6 +1425,23 @@ func (m *Value) Unmarshal(data []byte) error {
 			s := string(data[index:postIndex])
 			m.Tag = &s
 			index = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var v ValueCompression
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (ValueCompression(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compression = &v
 		default:
 			var sizeOfWire int
 			for {
@@ -1332,6 +1641,96 @@ func (m *KeyValue) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *Span) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndKey.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (m *RawKeyValue) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -2303,19 +2702,131 @@ func (m *Transaction) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
+			m.Sequence = &v
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoredSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoredSequences = append(m.IgnoredSequences, SequenceRange{})
+			m.IgnoredSequences[len(m.IgnoredSequences)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *SequenceRange) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Start |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.End |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
 			}
 			if (index + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -2375,9 +2886,448 @@ func (m *Lease) Unmarshal(data []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Term |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftNodeID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.RaftNodeID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *Liveness) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommissioning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decommissioning = bool(v != 0)
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *ClusterVersion) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *MigrationLease) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *NodeIDClaim) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StoreIDClaim) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -2385,14 +3335,14 @@ func (m *Lease) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.Term |= (uint64(b) & 0x7F) << shift
+				m.StoreID |= (StoreID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -2400,7 +3350,7 @@ func (m *Lease) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.RaftNodeID |= (uint64(b) & 0x7F) << shift
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2572,6 +3522,110 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntentHistory = append(m.IntentHistory, SequencedValue{})
+			m.IntentHistory[len(m.IntentHistory)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *SequencedValue) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -3107,6 +4161,9 @@ func (m *Value) Size() (n int) {
 		l = len(*m.Tag)
 		n += 1 + l + sovData(uint64(l))
 	}
+	if m.Compression != nil {
+		n += 1 + sovData(uint64(*m.Compression))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3140,6 +4197,19 @@ func (m *KeyValue) Size() (n int) {
 	return n
 }
 
+func (m *Span) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovData(uint64(l))
+	l = m.EndKey.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RawKeyValue) Size() (n int) {
 	var l int
 	_ = l
@@ -3281,6 +4351,26 @@ func (m *Transaction) Size() (n int) {
 	n += 1 + l + sovData(uint64(l))
 	l = m.CertainNodes.Size()
 	n += 1 + l + sovData(uint64(l))
+	if m.Sequence != nil {
+		n += 1 + sovData(uint64(*m.Sequence))
+	}
+	if len(m.IgnoredSequences) > 0 {
+		for _, e := range m.IgnoredSequences {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SequenceRange) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Start))
+	n += 1 + sovData(uint64(m.End))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3294,6 +4384,68 @@ func (m *Lease) Size() (n int) {
 	n += 1 + sovData(uint64(m.Duration))
 	n += 1 + sovData(uint64(m.Term))
 	n += 1 + sovData(uint64(m.RaftNodeID))
+	n += 1 + sovData(uint64(m.Epoch))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Liveness) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.NodeID))
+	n += 1 + sovData(uint64(m.Epoch))
+	l = m.Expiration.Size()
+	n += 1 + l + sovData(uint64(l))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterVersion) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Version))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigrationLease) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.NodeID))
+	l = m.Expiration.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeIDClaim) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.NodeID))
+	l = len(m.Address)
+	n += 1 + l + sovData(uint64(l))
+	l = len(m.ClusterID)
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreIDClaim) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.StoreID))
+	n += 1 + sovData(uint64(m.NodeID))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3316,6 +4468,24 @@ func (m *MVCCMetadata) Size() (n int) {
 		l = m.Value.Size()
 		n += 1 + l + sovData(uint64(l))
 	}
+	if len(m.IntentHistory) > 0 {
+		for _, e := range m.IntentHistory {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SequencedValue) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Sequence))
+	l = m.Value.Size()
+	n += 1 + l + sovData(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3477,6 +4647,11 @@ func (m *Value) MarshalTo(data []byte) (n int, err error) {
 		i = encodeVarintData(data, i, uint64(len(*m.Tag)))
 		i += copy(data[i:], *m.Tag)
 	}
+	if m.Compression != nil {
+		data[i] = 0x30
+		i++
+		i = encodeVarintData(data, i, uint64(*m.Compression))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3506,23 +4681,60 @@ func (m *MVCCValue) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
-	if m.Value != nil {
-		data[i] = 0x12
-		i++
-		i = encodeVarintData(data, i, uint64(m.Value.Size()))
-		n2, err := m.Value.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if m.Value != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintData(data, i, uint64(m.Value.Size()))
+		n2, err := m.Value.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *KeyValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *KeyValue) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintData(data, i, uint64(m.Key.Size()))
+	n3, err := m.Key.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(m.Value.Size()))
+	n4, err := m.Value.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n4
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *KeyValue) Marshal() (data []byte, err error) {
+func (m *Span) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -3532,7 +4744,7 @@ func (m *KeyValue) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *KeyValue) MarshalTo(data []byte) (n int, err error) {
+func (m *Span) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -3540,19 +4752,19 @@ func (m *KeyValue) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.Key.Size()))
-	n3, err := m.Key.MarshalTo(data[i:])
+	n5, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n5
 	data[i] = 0x12
 	i++
-	i = encodeVarintData(data, i, uint64(m.Value.Size()))
-	n4, err := m.Value.MarshalTo(data[i:])
+	i = encodeVarintData(data, i, uint64(m.EndKey.Size()))
+	n6, err := m.EndKey.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n6
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3577,11 +4789,11 @@ func (m *RawKeyValue) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.Key.Size()))
-	n5, err := m.Key.MarshalTo(data[i:])
+	n7, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n7
 	if m.Value != nil {
 		data[i] = 0x12
 		i++
@@ -3643,19 +4855,19 @@ func (m *SplitTrigger) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.UpdatedDesc.Size()))
-	n6, err := m.UpdatedDesc.MarshalTo(data[i:])
+	n8, err := m.UpdatedDesc.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.NewDesc.Size()))
-	n7, err := m.NewDesc.MarshalTo(data[i:])
+	n9, err := m.NewDesc.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n9
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3680,11 +4892,11 @@ func (m *MergeTrigger) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintData(data, i, uint64(m.UpdatedDesc.Size()))
-	n8, err := m.UpdatedDesc.MarshalTo(data[i:])
+	n10, err := m.UpdatedDesc.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n10
 	data[i] = 0x10
 	i++
 	i = encodeVarintData(data, i, uint64(m.SubsumedRaftID))
@@ -3755,31 +4967,31 @@ func (m *InternalCommitTrigger) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintData(data, i, uint64(m.SplitTrigger.Size()))
-		n9, err := m.SplitTrigger.MarshalTo(data[i:])
+		n11, err := m.SplitTrigger.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.MergeTrigger != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintData(data, i, uint64(m.MergeTrigger.Size()))
-		n10, err := m.MergeTrigger.MarshalTo(data[i:])
+		n12, err := m.MergeTrigger.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.ChangeReplicasTrigger != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintData(data, i, uint64(m.ChangeReplicasTrigger.Size()))
-		n11, err := m.ChangeReplicasTrigger.MarshalTo(data[i:])
+		n13, err := m.ChangeReplicasTrigger.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if len(m.Intents) > 0 {
 		for _, msg := range m.Intents {
@@ -3815,22 +5027,22 @@ func (m *NodeList) MarshalTo(data []byte) (n int, err error) {
 	var l int
 	_ = l
 	if len(m.Nodes) > 0 {
-		data13 := make([]byte, len(m.Nodes)*10)
-		var j12 int
+		data15 := make([]byte, len(m.Nodes)*10)
+		var j14 int
 		for _, num1 := range m.Nodes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				data13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				data15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j12++
+				j14++
 			}
-			data13[j12] = uint8(num)
-			j12++
+			data15[j14] = uint8(num)
+			j14++
 		}
 		data[i] = 0xa
 		i++
-		i = encodeVarintData(data, i, uint64(j12))
-		i += copy(data[i:], data13[:j12])
+		i = encodeVarintData(data, i, uint64(j14))
+		i += copy(data[i:], data15[:j14])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -3860,11 +5072,11 @@ func (m *Transaction) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.Key.Size()))
-	n14, err := m.Key.MarshalTo(data[i:])
+	n16, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n16
 	if m.ID != nil {
 		data[i] = 0x1a
 		i++
@@ -3887,44 +5099,88 @@ func (m *Transaction) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x42
 		i++
 		i = encodeVarintData(data, i, uint64(m.LastHeartbeat.Size()))
-		n15, err := m.LastHeartbeat.MarshalTo(data[i:])
+		n17, err := m.LastHeartbeat.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	data[i] = 0x4a
 	i++
 	i = encodeVarintData(data, i, uint64(m.Timestamp.Size()))
-	n16, err := m.Timestamp.MarshalTo(data[i:])
+	n18, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n18
 	data[i] = 0x52
 	i++
 	i = encodeVarintData(data, i, uint64(m.OrigTimestamp.Size()))
-	n17, err := m.OrigTimestamp.MarshalTo(data[i:])
+	n19, err := m.OrigTimestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	data[i] = 0x5a
 	i++
 	i = encodeVarintData(data, i, uint64(m.MaxTimestamp.Size()))
-	n18, err := m.MaxTimestamp.MarshalTo(data[i:])
+	n20, err := m.MaxTimestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
 	data[i] = 0x62
 	i++
 	i = encodeVarintData(data, i, uint64(m.CertainNodes.Size()))
-	n19, err := m.CertainNodes.MarshalTo(data[i:])
+	n21, err := m.CertainNodes.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n21
+	if m.Sequence != nil {
+		data[i] = 0x68
+		i++
+		i = encodeVarintData(data, i, uint64(*m.Sequence))
+	}
+	if len(m.IgnoredSequences) > 0 {
+		for _, msg := range m.IgnoredSequences {
+			data[i] = 0x72
+			i++
+			i = encodeVarintData(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SequenceRange) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequenceRange) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Start))
+	data[i] = 0x10
+	i++
+	i = encodeVarintData(data, i, uint64(m.End))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3958,6 +5214,167 @@ func (m *Lease) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x20
 	i++
 	i = encodeVarintData(data, i, uint64(m.RaftNodeID))
+	data[i] = 0x28
+	i++
+	i = encodeVarintData(data, i, uint64(m.Epoch))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Liveness) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Liveness) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.NodeID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintData(data, i, uint64(m.Epoch))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+	n22, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	data[i] = 0x20
+	i++
+	if m.Decommissioning {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ClusterVersion) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ClusterVersion) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Version))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MigrationLease) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MigrationLease) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.NodeID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+	n23, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *NodeIDClaim) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *NodeIDClaim) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.NodeID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(len(m.Address)))
+	i += copy(data[i:], m.Address)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintData(data, i, uint64(len(m.ClusterID)))
+	i += copy(data[i:], m.ClusterID)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StoreIDClaim) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StoreIDClaim) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.StoreID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintData(data, i, uint64(m.NodeID))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3983,20 +5400,20 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintData(data, i, uint64(m.Txn.Size()))
-		n20, err := m.Txn.MarshalTo(data[i:])
+		n24, err := m.Txn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n24
 	}
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.Timestamp.Size()))
-	n21, err := m.Timestamp.MarshalTo(data[i:])
+	n25, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n25
 	data[i] = 0x18
 	i++
 	if m.Deleted {
@@ -4015,12 +5432,56 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x32
 		i++
 		i = encodeVarintData(data, i, uint64(m.Value.Size()))
-		n22, err := m.Value.MarshalTo(data[i:])
+		n26, err := m.Value.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n26
+	}
+	if len(m.IntentHistory) > 0 {
+		for _, msg := range m.IntentHistory {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintData(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SequencedValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequencedValue) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(m.Value.Size()))
+	n27, err := m.Value.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n27
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -587,3 +587,60 @@ func TestToInternal(t *testing.T) {
 		}
 	}
 }
+
+// TestMakePriorityMax verifies that only MaxPriority user priorities
+// map to MaxPriority.
+func TestMakePriorityMax(t *testing.T) {
+	if p := MakePriority(MaxPriority); p != MaxPriority {
+		t.Errorf("expected MaxPriority; got %d", p)
+	}
+	for _, userPriority := range []int32{0, 1, 100, MaxPriority - 1} {
+		for i := 0; i < 100; i++ {
+			if p := MakePriority(userPriority); p < 1 || p >= MaxPriority {
+				t.Fatalf("user priority %d: expected priority in [1, %d); got %d", userPriority, MaxPriority, p)
+			}
+		}
+	}
+}
+
+// TestEscalatePriority verifies that escalated priorities increase
+// until they reach MaxPriority-1 and never reach MaxPriority.
+func TestEscalatePriority(t *testing.T) {
+	p := int32(1)
+	for i := 0; i < 40; i++ {
+		next := EscalatePriority(p)
+		if next < p || next >= MaxPriority {
+			t.Fatalf("escalating %d: got %d", p, next)
+		}
+		if next == p && p != MaxPriority-1 {
+			t.Fatalf("escalating %d: expected increase", p)
+		}
+		p = next
+	}
+	if p != MaxPriority-1 {
+		t.Errorf("expected escalation to reach %d; got %d", MaxPriority-1, p)
+	}
+	if p := EscalatePriority(MaxPriority); p != MaxPriority {
+		t.Errorf("expected MaxPriority to be unchanged; got %d", p)
+	}
+}
+
+// TestTransactionUpdateIgnoredSequences verifies that updating a
+// transaction keeps its latest sequence number and the longest list
+// of rolled back sequences.
+func TestTransactionUpdateIgnoredSequences(t *testing.T) {
+	txn := Transaction{ID: []byte("txn"), Sequence: gogoproto.Int32(3)}
+	txn.Update(&Transaction{
+		Sequence:         gogoproto.Int32(5),
+		IgnoredSequences: []SequenceRange{{Start: 2, End: 4}},
+	})
+	txn.Update(&Transaction{Sequence: gogoproto.Int32(1)})
+	if txn.GetSequence() != 5 {
+		t.Errorf("expected sequence 5; got %d", txn.GetSequence())
+	}
+	for seq, exp := range map[int32]bool{1: false, 2: true, 4: true, 5: false} {
+		if ignored := txn.IsSequenceIgnored(seq); ignored != exp {
+			t.Errorf("sequence %d: expected ignored=%t; got %t", seq, exp, ignored)
+		}
+	}
+}
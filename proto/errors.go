@@ -45,6 +45,11 @@ func (e *NotLeaderError) Error() string {
 	return fmt.Sprintf("range not leader; leader is %+v", e.Leader)
 }
 
+// CanRetry indicates whether or not this NotLeaderError can be retried.
+func (e *NotLeaderError) CanRetry() bool {
+	return true
+}
+
 // NewRangeNotFoundError initializes a new RangeNotFoundError.
 func NewRangeNotFoundError(raftID int64) *RangeNotFoundError {
 	return &RangeNotFoundError{
@@ -177,3 +182,14 @@ func (e *OpRequiresTxnError) Error() string {
 func (e *ConditionFailedError) Error() string {
 	return fmt.Sprintf("unexpected value: %s", e.ActualValue)
 }
+
+// NewAmbiguousResultError initializes a new AmbiguousResultError for
+// the error which made a request's result ambiguous.
+func NewAmbiguousResultError(err error) *AmbiguousResultError {
+	return &AmbiguousResultError{Message: err.Error()}
+}
+
+// Error formats error.
+func (e *AmbiguousResultError) Error() string {
+	return fmt.Sprintf("result is ambiguous: %s", e.Message)
+}
@@ -332,6 +332,27 @@ func (m *ConditionFailedError) GetActualValue() *Value {
 	return nil
 }
 
+// An AmbiguousResultError indicates that a request may or may not
+// have been applied: it failed after being sent, e.g. as the
+// connection was reset, or its sender stopped waiting after it had
+// been proposed. Unlike after other errors, a write failing with it
+// may have taken effect.
+type AmbiguousResultError struct {
+	Message          string `protobuf:"bytes,1,opt,name=message" json:"message"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *AmbiguousResultError) Reset()         { *m = AmbiguousResultError{} }
+func (m *AmbiguousResultError) String() string { return proto1.CompactTextString(m) }
+func (*AmbiguousResultError) ProtoMessage()    {}
+
+func (m *AmbiguousResultError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 // ErrorDetail is a union type containing all available errors.
 type ErrorDetail struct {
 	NotLeader                     *NotLeaderError                     `protobuf:"bytes,1,opt,name=not_leader" json:"not_leader,omitempty"`
@@ -346,6 +367,7 @@ type ErrorDetail struct {
 	WriteTooOld                   *WriteTooOldError                   `protobuf:"bytes,10,opt,name=write_too_old" json:"write_too_old,omitempty"`
 	OpRequiresTxn                 *OpRequiresTxnError                 `protobuf:"bytes,11,opt,name=op_requires_txn" json:"op_requires_txn,omitempty"`
 	ConditionFailed               *ConditionFailedError               `protobuf:"bytes,12,opt,name=condition_failed" json:"condition_failed,omitempty"`
+	AmbiguousResult               *AmbiguousResultError               `protobuf:"bytes,13,opt,name=ambiguous_result" json:"ambiguous_result,omitempty"`
 	XXX_unrecognized              []byte                              `json:"-"`
 }
 
@@ -437,6 +459,13 @@ func (m *ErrorDetail) GetConditionFailed() *ConditionFailedError {
 	return nil
 }
 
+func (m *ErrorDetail) GetAmbiguousResult() *AmbiguousResultError {
+	if m != nil {
+		return m.AmbiguousResult
+	}
+	return nil
+}
+
 // Error is a generic representation including a string message
 // and information about retryability.
 type Error struct {
@@ -1439,6 +1468,70 @@ func (m *ConditionFailedError) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *AmbiguousResultError) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (m *ErrorDetail) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -1782,6 +1875,33 @@ func (m *ErrorDetail) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmbiguousResult == nil {
+				m.AmbiguousResult = &AmbiguousResultError{}
+			}
+			if err := m.AmbiguousResult.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1965,6 +2085,9 @@ func (this *ErrorDetail) GetValue() interface{} {
 	if this.ConditionFailed != nil {
 		return this.ConditionFailed
 	}
+	if this.AmbiguousResult != nil {
+		return this.AmbiguousResult
+	}
 	return nil
 }
 
@@ -1994,6 +2117,8 @@ func (this *ErrorDetail) SetValue(value interface{}) bool {
 		this.OpRequiresTxn = vt
 	case *ConditionFailedError:
 		this.ConditionFailed = vt
+	case *AmbiguousResultError:
+		this.AmbiguousResult = vt
 	default:
 		return false
 	}
@@ -2149,6 +2274,17 @@ func (m *ConditionFailedError) Size() (n int) {
 	return n
 }
 
+func (m *AmbiguousResultError) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Message)
+	n += 1 + l + sovErrors(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ErrorDetail) Size() (n int) {
 	var l int
 	_ = l
@@ -2200,6 +2336,10 @@ func (m *ErrorDetail) Size() (n int) {
 		l = m.ConditionFailed.Size()
 		n += 1 + l + sovErrors(uint64(l))
 	}
+	if m.AmbiguousResult != nil {
+		l = m.AmbiguousResult.Size()
+		n += 1 + l + sovErrors(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2637,6 +2777,31 @@ func (m *ConditionFailedError) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *AmbiguousResultError) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AmbiguousResultError) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintErrors(data, i, uint64(len(m.Message)))
+	i += copy(data[i:], m.Message)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ErrorDetail) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -2772,6 +2937,16 @@ func (m *ErrorDetail) MarshalTo(data []byte) (n int, err error) {
 		}
 		i += n28
 	}
+	if m.AmbiguousResult != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintErrors(data, i, uint64(m.AmbiguousResult.Size()))
+		n29, err := m.AmbiguousResult.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -2812,11 +2987,11 @@ func (m *Error) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.Detail.Size()))
-		n29, err := m.Detail.MarshalTo(data[i:])
+		n30, err := m.Detail.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -368,6 +368,132 @@ func (m *InternalLeaderLeaseResponse) Reset()         { *m = InternalLeaderLease
 func (m *InternalLeaderLeaseResponse) String() string { return proto1.CompactTextString(m) }
 func (*InternalLeaderLeaseResponse) ProtoMessage()    {}
 
+// An InternalCheckConsistencyRequest is arguments to the
+// InternalCheckConsistency() method. A consistency check is performed
+// by the range leader in two phases, each sent via Raft so that every
+// replica executes it at the same point in the log. In the first
+// phase, Checksum is empty and each replica computes and retains a
+// checksum of its replicated range data under ChecksumID. In the
+// second phase, Checksum holds the leader's checksum and each replica
+// compares it against its own, reporting divergence.
+type InternalCheckConsistencyRequest struct {
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// ChecksumID identifies the checksum computed in the first phase.
+	ChecksumID []byte `protobuf:"bytes,2,opt,name=checksum_id" json:"checksum_id,omitempty"`
+	// Checksum is the leader's checksum, set only in the second phase.
+	Checksum         []byte `protobuf:"bytes,3,opt,name=checksum" json:"checksum,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalCheckConsistencyRequest) Reset()         { *m = InternalCheckConsistencyRequest{} }
+func (m *InternalCheckConsistencyRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalCheckConsistencyRequest) ProtoMessage()    {}
+
+func (m *InternalCheckConsistencyRequest) GetChecksumID() []byte {
+	if m != nil {
+		return m.ChecksumID
+	}
+	return nil
+}
+
+func (m *InternalCheckConsistencyRequest) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+// An InternalCheckConsistencyResponse is the response to an
+// InternalCheckConsistency() operation. In the first phase, Checksum
+// holds the checksum computed by the replica.
+type InternalCheckConsistencyResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Checksum         []byte `protobuf:"bytes,2,opt,name=checksum" json:"checksum,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalCheckConsistencyResponse) Reset()         { *m = InternalCheckConsistencyResponse{} }
+func (m *InternalCheckConsistencyResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalCheckConsistencyResponse) ProtoMessage()    {}
+
+func (m *InternalCheckConsistencyResponse) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+// An InternalRecomputeStatsRequest is arguments to the
+// InternalRecomputeStats() method. It is sent via Raft by the range
+// leader to have each replica rescan the range data, compute
+// authoritative MVCC stats as of the request timestamp and correct
+// any drift in the range's stats by applying the difference.
+type InternalRecomputeStatsRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalRecomputeStatsRequest) Reset()         { *m = InternalRecomputeStatsRequest{} }
+func (m *InternalRecomputeStatsRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalRecomputeStatsRequest) ProtoMessage()    {}
+
+// An InternalRecomputeStatsResponse is the response to an
+// InternalRecomputeStats() operation.
+type InternalRecomputeStatsResponse struct {
+	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Delta is the correction applied to the range's stats.
+	Delta            MVCCStats `protobuf:"bytes,2,opt,name=delta" json:"delta"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *InternalRecomputeStatsResponse) Reset()         { *m = InternalRecomputeStatsResponse{} }
+func (m *InternalRecomputeStatsResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalRecomputeStatsResponse) ProtoMessage()    {}
+
+func (m *InternalRecomputeStatsResponse) GetDelta() MVCCStats {
+	if m != nil {
+		return m.Delta
+	}
+	return MVCCStats{}
+}
+
+// An InternalRefreshRangeRequest is arguments to the
+// InternalRefreshRange() method. It's sent by transaction coordinators
+// to verify that the values in a key span which the transaction in the
+// request header read at refresh_from are unchanged as of the request
+// timestamp, which allows a serializable transaction whose commit
+// timestamp was pushed to commit at the pushed timestamp without
+// restarting. Succeeds only if no values in the span were written in
+// (refresh_from, timestamp] and no other transaction's intents in it
+// are at or below the request timestamp.
+type InternalRefreshRangeRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	RefreshFrom      Timestamp `protobuf:"bytes,2,opt,name=refresh_from" json:"refresh_from"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *InternalRefreshRangeRequest) Reset()         { *m = InternalRefreshRangeRequest{} }
+func (m *InternalRefreshRangeRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalRefreshRangeRequest) ProtoMessage()    {}
+
+func (m *InternalRefreshRangeRequest) GetRefreshFrom() Timestamp {
+	if m != nil {
+		return m.RefreshFrom
+	}
+	return Timestamp{}
+}
+
+// An InternalRefreshRangeResponse is the return value from the
+// InternalRefreshRange() method.
+type InternalRefreshRangeResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalRefreshRangeResponse) Reset()         { *m = InternalRefreshRangeResponse{} }
+func (m *InternalRefreshRangeResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalRefreshRangeResponse) ProtoMessage()    {}
+
 // A ReadWriteCmdResponse is a union type containing instances of all
 // mutating commands. Note that any entry added here must be handled
 // in storage/engine/db.cc in GetResponseHeader().
@@ -378,12 +504,15 @@ type ReadWriteCmdResponse struct {
 	Delete                *DeleteResponse                `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
 	DeleteRange           *DeleteRangeResponse           `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
 	EndTransaction        *EndTransactionResponse        `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	InitPut               *InitPutResponse               `protobuf:"bytes,7,opt,name=init_put" json:"init_put,omitempty"`
 	InternalHeartbeatTxn  *InternalHeartbeatTxnResponse  `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
 	InternalPushTxn       *InternalPushTxnResponse       `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent *InternalResolveIntentResponse `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
 	InternalMerge         *InternalMergeResponse         `protobuf:"bytes,13,opt,name=internal_merge" json:"internal_merge,omitempty"`
 	InternalTruncateLog   *InternalTruncateLogResponse   `protobuf:"bytes,14,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
 	InternalGc            *InternalGCResponse            `protobuf:"bytes,15,opt,name=internal_gc" json:"internal_gc,omitempty"`
+	ClearRange            *ClearRangeResponse            `protobuf:"bytes,16,opt,name=clear_range" json:"clear_range,omitempty"`
+	Batch                 *BatchResponse                 `protobuf:"bytes,17,opt,name=batch" json:"batch,omitempty"`
 	XXX_unrecognized      []byte                         `json:"-"`
 }
 
@@ -433,6 +562,13 @@ func (m *ReadWriteCmdResponse) GetEndTransaction() *EndTransactionResponse {
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetInitPut() *InitPutResponse {
+	if m != nil {
+		return m.InitPut
+	}
+	return nil
+}
+
 func (m *ReadWriteCmdResponse) GetInternalHeartbeatTxn() *InternalHeartbeatTxnResponse {
 	if m != nil {
 		return m.InternalHeartbeatTxn
@@ -475,6 +611,20 @@ func (m *ReadWriteCmdResponse) GetInternalGc() *InternalGCResponse {
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetClearRange() *ClearRangeResponse {
+	if m != nil {
+		return m.ClearRange
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetBatch() *BatchResponse {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
 // An InternalRaftCommandUnion is the union of all commands which can be
 // sent via raft.
 type InternalRaftCommandUnion struct {
@@ -488,18 +638,24 @@ type InternalRaftCommandUnion struct {
 	DeleteRange    *DeleteRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan           *ScanRequest           `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction *EndTransactionRequest `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	InitPut        *InitPutRequest        `protobuf:"bytes,10,opt,name=init_put" json:"init_put,omitempty"`
+	Aggregate      *AggregateRequest      `protobuf:"bytes,11,opt,name=aggregate" json:"aggregate,omitempty"`
 	// Other requests. Allow a gap in tag numbers so the previous list can
 	// be copy/pasted from RequestUnion.
-	Batch                 *BatchRequest                 `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
-	InternalRangeLookup   *InternalRangeLookupRequest   `protobuf:"bytes,31,opt,name=internal_range_lookup" json:"internal_range_lookup,omitempty"`
-	InternalHeartbeatTxn  *InternalHeartbeatTxnRequest  `protobuf:"bytes,32,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
-	InternalPushTxn       *InternalPushTxnRequest       `protobuf:"bytes,33,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
-	InternalResolveIntent *InternalResolveIntentRequest `protobuf:"bytes,34,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
-	InternalMergeResponse *InternalMergeRequest         `protobuf:"bytes,35,opt,name=internal_merge_response" json:"internal_merge_response,omitempty"`
-	InternalTruncateLog   *InternalTruncateLogRequest   `protobuf:"bytes,36,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
-	InternalGC            *InternalGCRequest            `protobuf:"bytes,37,opt,name=internal_gc" json:"internal_gc,omitempty"`
-	InternalLease         *InternalLeaderLeaseRequest   `protobuf:"bytes,38,opt,name=internal_lease" json:"internal_lease,omitempty"`
-	XXX_unrecognized      []byte                        `json:"-"`
+	Batch                    *BatchRequest                    `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
+	InternalRangeLookup      *InternalRangeLookupRequest      `protobuf:"bytes,31,opt,name=internal_range_lookup" json:"internal_range_lookup,omitempty"`
+	InternalHeartbeatTxn     *InternalHeartbeatTxnRequest     `protobuf:"bytes,32,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
+	InternalPushTxn          *InternalPushTxnRequest          `protobuf:"bytes,33,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
+	InternalResolveIntent    *InternalResolveIntentRequest    `protobuf:"bytes,34,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
+	InternalMergeResponse    *InternalMergeRequest            `protobuf:"bytes,35,opt,name=internal_merge_response" json:"internal_merge_response,omitempty"`
+	InternalTruncateLog      *InternalTruncateLogRequest      `protobuf:"bytes,36,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
+	InternalGC               *InternalGCRequest               `protobuf:"bytes,37,opt,name=internal_gc" json:"internal_gc,omitempty"`
+	InternalLease            *InternalLeaderLeaseRequest      `protobuf:"bytes,38,opt,name=internal_lease" json:"internal_lease,omitempty"`
+	InternalCheckConsistency *InternalCheckConsistencyRequest `protobuf:"bytes,39,opt,name=internal_check_consistency" json:"internal_check_consistency,omitempty"`
+	InternalRecomputeStats   *InternalRecomputeStatsRequest   `protobuf:"bytes,40,opt,name=internal_recompute_stats" json:"internal_recompute_stats,omitempty"`
+	ClearRange               *ClearRangeRequest               `protobuf:"bytes,41,opt,name=clear_range" json:"clear_range,omitempty"`
+	InternalRefreshRange     *InternalRefreshRangeRequest     `protobuf:"bytes,42,opt,name=internal_refresh_range" json:"internal_refresh_range,omitempty"`
+	XXX_unrecognized         []byte                           `json:"-"`
 }
 
 func (m *InternalRaftCommandUnion) Reset()         { *m = InternalRaftCommandUnion{} }
@@ -569,6 +725,20 @@ func (m *InternalRaftCommandUnion) GetEndTransaction() *EndTransactionRequest {
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetInitPut() *InitPutRequest {
+	if m != nil {
+		return m.InitPut
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetAggregate() *AggregateRequest {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
 func (m *InternalRaftCommandUnion) GetBatch() *BatchRequest {
 	if m != nil {
 		return m.Batch
@@ -632,12 +802,54 @@ func (m *InternalRaftCommandUnion) GetInternalLease() *InternalLeaderLeaseReques
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetInternalCheckConsistency() *InternalCheckConsistencyRequest {
+	if m != nil {
+		return m.InternalCheckConsistency
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalRecomputeStats() *InternalRecomputeStatsRequest {
+	if m != nil {
+		return m.InternalRecomputeStats
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetClearRange() *ClearRangeRequest {
+	if m != nil {
+		return m.ClearRange
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalRefreshRange() *InternalRefreshRangeRequest {
+	if m != nil {
+		return m.InternalRefreshRange
+	}
+	return nil
+}
+
 // An InternalRaftCommand is a command which can be serialized and
 // sent via raft.
 type InternalRaftCommand struct {
-	RaftID           int64                    `protobuf:"varint,2,opt,name=raft_id" json:"raft_id"`
-	Cmd              InternalRaftCommandUnion `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
-	XXX_unrecognized []byte                   `json:"-"`
+	RaftID int64                    `protobuf:"varint,2,opt,name=raft_id" json:"raft_id"`
+	Cmd    InternalRaftCommandUnion `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
+	// ClosedTimestamp is a timestamp at or below which the proposing
+	// replica will not propose any further writes. A replica which has
+	// applied the command can serve reads at or below it.
+	ClosedTimestamp Timestamp `protobuf:"bytes,4,opt,name=closed_timestamp" json:"closed_timestamp"`
+	// MaxLeaseIndex orders the commands proposed by the lease holder. A
+	// command is applied only if its lease index exceeds that of every
+	// command applied before it; it is then the range's lease applied
+	// index. Commands without a lease index, such as lease requests, are
+	// not ordered.
+	MaxLeaseIndex *uint64 `protobuf:"varint,5,opt,name=max_lease_index" json:"max_lease_index,omitempty"`
+	// ProposerLease is the leader lease under which the command was
+	// proposed. The command is rejected if the range's lease has since
+	// passed to another holder.
+	ProposerLease    *Lease `protobuf:"bytes,6,opt,name=proposer_lease" json:"proposer_lease,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *InternalRaftCommand) Reset()         { *m = InternalRaftCommand{} }
@@ -658,6 +870,27 @@ func (m *InternalRaftCommand) GetCmd() InternalRaftCommandUnion {
 	return InternalRaftCommandUnion{}
 }
 
+func (m *InternalRaftCommand) GetClosedTimestamp() Timestamp {
+	if m != nil {
+		return m.ClosedTimestamp
+	}
+	return Timestamp{}
+}
+
+func (m *InternalRaftCommand) GetMaxLeaseIndex() uint64 {
+	if m != nil && m.MaxLeaseIndex != nil {
+		return *m.MaxLeaseIndex
+	}
+	return 0
+}
+
+func (m *InternalRaftCommand) GetProposerLease() *Lease {
+	if m != nil {
+		return m.ProposerLease
+	}
+	return nil
+}
+
 // RaftMessageRequest is the request used to send raft messages using our
 // protobuf-based RPC codec. Unlike most of the requests defined in this file
 // and api.proto, this one is implemented in a separate service defined in
@@ -691,6 +924,52 @@ func (m *RaftMessageRequest) GetMsg() []byte {
 	return nil
 }
 
+// ReserveSnapshotRequest is sent before a raft snapshot to reserve a
+// slot for it on the receiving store. It is the equivalent of the
+// non-protobuf multiraft.ReserveSnapshotRequest.
+type ReserveSnapshotRequest struct {
+	GroupID uint64 `protobuf:"varint,1,opt,name=group_id" json:"group_id"`
+	// The raft node ID of the receiving store.
+	NodeID           uint64 `protobuf:"varint,2,opt,name=node_id" json:"node_id"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ReserveSnapshotRequest) Reset()         { *m = ReserveSnapshotRequest{} }
+func (m *ReserveSnapshotRequest) String() string { return proto1.CompactTextString(m) }
+func (*ReserveSnapshotRequest) ProtoMessage()    {}
+
+func (m *ReserveSnapshotRequest) GetGroupID() uint64 {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
+func (m *ReserveSnapshotRequest) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+// ReserveSnapshotResponse reports whether the receiving store admitted
+// the snapshot.
+type ReserveSnapshotResponse struct {
+	Reserved         bool   `protobuf:"varint,1,opt,name=reserved" json:"reserved"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ReserveSnapshotResponse) Reset()         { *m = ReserveSnapshotResponse{} }
+func (m *ReserveSnapshotResponse) String() string { return proto1.CompactTextString(m) }
+func (*ReserveSnapshotResponse) ProtoMessage()    {}
+
+func (m *ReserveSnapshotResponse) GetReserved() bool {
+	if m != nil {
+		return m.Reserved
+	}
+	return false
+}
+
 // RaftMessageResponse is an empty message returned by raft RPCs.
 type RaftMessageResponse struct {
 	XXX_unrecognized []byte `json:"-"`
@@ -944,6 +1223,27 @@ func (m *RaftSnapshotData_KeyValue) GetValue() []byte {
 	return nil
 }
 
+// GossipBootstrapInfo contains the addresses of nodes recently known
+// to the gossip network. A store persists it so that a restarted
+// node can rejoin the cluster even if its original bootstrap hosts
+// are no longer available.
+type GossipBootstrapInfo struct {
+	// Addresses of known nodes, in the form accepted by gossip.NewResolver.
+	Addresses        []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *GossipBootstrapInfo) Reset()         { *m = GossipBootstrapInfo{} }
+func (m *GossipBootstrapInfo) String() string { return proto1.CompactTextString(m) }
+func (*GossipBootstrapInfo) ProtoMessage()    {}
+
+func (m *GossipBootstrapInfo) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
 	proto1.RegisterEnum("cockroach.proto.InternalValueType", InternalValueType_name, InternalValueType_value)
 }
@@ -2309,7 +2609,7 @@ func (m *InternalLeaderLeaseResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
+func (m *InternalCheckConsistencyRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -2330,7 +2630,7 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2348,97 +2648,99 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutResponse{}
-			}
-			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConditionalPut == nil {
-				m.ConditionalPut = &ConditionalPutResponse{}
-			}
-			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
+			m.ChecksumID = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementResponse{}
-			}
-			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
+			m.Checksum = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalCheckConsistencyResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
-				return err
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			index = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2456,43 +2758,740 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRange == nil {
-				m.DeleteRange = &DeleteRangeResponse{}
-			}
-			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTransaction == nil {
+			m.Checksum = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRecomputeStatsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRecomputeStatsResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRefreshRangeRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefreshFrom.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRefreshRangeResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutResponse{}
+			}
+			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConditionalPut == nil {
+				m.ConditionalPut = &ConditionalPutResponse{}
+			}
+			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Increment == nil {
+				m.Increment = &IncrementResponse{}
+			}
+			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeResponse{}
+			}
+			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTransaction == nil {
 				m.EndTransaction = &EndTransactionResponse{}
 			}
-			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitPut == nil {
+				m.InitPut = &InitPutResponse{}
+			}
+			if err := m.InitPut.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalHeartbeatTxn == nil {
+				m.InternalHeartbeatTxn = &InternalHeartbeatTxnResponse{}
+			}
+			if err := m.InternalHeartbeatTxn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalPushTxn == nil {
+				m.InternalPushTxn = &InternalPushTxnResponse{}
+			}
+			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalResolveIntent == nil {
+				m.InternalResolveIntent = &InternalResolveIntentResponse{}
+			}
+			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalMerge == nil {
+				m.InternalMerge = &InternalMergeResponse{}
+			}
+			if err := m.InternalMerge.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalTruncateLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalTruncateLog == nil {
+				m.InternalTruncateLog = &InternalTruncateLogResponse{}
+			}
+			if err := m.InternalTruncateLog.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalGc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalGc == nil {
+				m.InternalGc = &InternalGCResponse{}
+			}
+			if err := m.InternalGc.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 10:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2510,16 +3509,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalHeartbeatTxn == nil {
-				m.InternalHeartbeatTxn = &InternalHeartbeatTxnResponse{}
+			if m.ClearRange == nil {
+				m.ClearRange = &ClearRangeResponse{}
 			}
-			if err := m.InternalHeartbeatTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ClearRange.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 11:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2537,16 +3536,247 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalPushTxn == nil {
-				m.InternalPushTxn = &InternalPushTxnResponse{}
+			if m.Batch == nil {
+				m.Batch = &BatchResponse{}
 			}
-			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Batch.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 12:
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contains == nil {
+				m.Contains = &ContainsRequest{}
+			}
+			if err := m.Contains.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &GetRequest{}
+			}
+			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
+			}
+			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConditionalPut == nil {
+				m.ConditionalPut = &ConditionalPutRequest{}
+			}
+			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Increment == nil {
+				m.Increment = &IncrementRequest{}
+			}
+			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeRequest{}
+			}
+			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2564,16 +3794,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntent == nil {
-				m.InternalResolveIntent = &InternalResolveIntentResponse{}
+			if m.Scan == nil {
+				m.Scan = &ScanRequest{}
 			}
-			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Scan.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 13:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalMerge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2591,16 +3821,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalMerge == nil {
-				m.InternalMerge = &InternalMergeResponse{}
+			if m.EndTransaction == nil {
+				m.EndTransaction = &EndTransactionRequest{}
 			}
-			if err := m.InternalMerge.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 14:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalTruncateLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitPut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2618,16 +3848,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalTruncateLog == nil {
-				m.InternalTruncateLog = &InternalTruncateLogResponse{}
+			if m.InitPut == nil {
+				m.InitPut = &InitPutRequest{}
 			}
-			if err := m.InternalTruncateLog.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InitPut.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 15:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalGc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2645,58 +3875,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalGc == nil {
-				m.InternalGc = &InternalGCResponse{}
+			if m.Aggregate == nil {
+				m.Aggregate = &AggregateRequest{}
 			}
-			if err := m.InternalGc.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Aggregate.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
-			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-	return nil
-}
-func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2714,16 +3902,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contains == nil {
-				m.Contains = &ContainsRequest{}
+			if m.Batch == nil {
+				m.Batch = &BatchRequest{}
 			}
-			if err := m.Contains.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Batch.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 2:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalRangeLookup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2741,16 +3929,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetRequest{}
+			if m.InternalRangeLookup == nil {
+				m.InternalRangeLookup = &InternalRangeLookupRequest{}
 			}
-			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalRangeLookup.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 3:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2768,16 +3956,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutRequest{}
+			if m.InternalHeartbeatTxn == nil {
+				m.InternalHeartbeatTxn = &InternalHeartbeatTxnRequest{}
 			}
-			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalHeartbeatTxn.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 4:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2795,16 +3983,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConditionalPut == nil {
-				m.ConditionalPut = &ConditionalPutRequest{}
+			if m.InternalPushTxn == nil {
+				m.InternalPushTxn = &InternalPushTxnRequest{}
 			}
-			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 5:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2822,16 +4010,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementRequest{}
+			if m.InternalResolveIntent == nil {
+				m.InternalResolveIntent = &InternalResolveIntentRequest{}
 			}
-			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 6:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalMergeResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2849,16 +4037,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
+			if m.InternalMergeResponse == nil {
+				m.InternalMergeResponse = &InternalMergeRequest{}
 			}
-			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalMergeResponse.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 7:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalTruncateLog", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2876,16 +4064,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRange == nil {
-				m.DeleteRange = &DeleteRangeRequest{}
+			if m.InternalTruncateLog == nil {
+				m.InternalTruncateLog = &InternalTruncateLogRequest{}
 			}
-			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalTruncateLog.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 8:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalGC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2903,16 +4091,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scan == nil {
-				m.Scan = &ScanRequest{}
+			if m.InternalGC == nil {
+				m.InternalGC = &InternalGCRequest{}
 			}
-			if err := m.Scan.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalGC.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 9:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalLease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2930,16 +4118,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTransaction == nil {
-				m.EndTransaction = &EndTransactionRequest{}
+			if m.InternalLease == nil {
+				m.InternalLease = &InternalLeaderLeaseRequest{}
 			}
-			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalLease.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 30:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalCheckConsistency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2957,16 +4145,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &BatchRequest{}
+			if m.InternalCheckConsistency == nil {
+				m.InternalCheckConsistency = &InternalCheckConsistencyRequest{}
 			}
-			if err := m.Batch.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalCheckConsistency.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 31:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalRangeLookup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalRecomputeStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2984,16 +4172,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalRangeLookup == nil {
-				m.InternalRangeLookup = &InternalRangeLookupRequest{}
+			if m.InternalRecomputeStats == nil {
+				m.InternalRecomputeStats = &InternalRecomputeStatsRequest{}
 			}
-			if err := m.InternalRangeLookup.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalRecomputeStats.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 32:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3011,16 +4199,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalHeartbeatTxn == nil {
-				m.InternalHeartbeatTxn = &InternalHeartbeatTxnRequest{}
+			if m.ClearRange == nil {
+				m.ClearRange = &ClearRangeRequest{}
 			}
-			if err := m.InternalHeartbeatTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ClearRange.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 33:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalRefreshRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3038,43 +4226,73 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalPushTxn == nil {
-				m.InternalPushTxn = &InternalPushTxnRequest{}
+			if m.InternalRefreshRange == nil {
+				m.InternalRefreshRange = &InternalRefreshRangeRequest{}
 			}
-			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalRefreshRange.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 34:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRaftCommand) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftID", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RaftID |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InternalResolveIntent == nil {
-				m.InternalResolveIntent = &InternalResolveIntentRequest{}
-			}
-			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
-		case 35:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalMergeResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3092,16 +4310,13 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalMergeResponse == nil {
-				m.InternalMergeResponse = &InternalMergeRequest{}
-			}
-			if err := m.InternalMergeResponse.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Cmd.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 36:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalTruncateLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3119,43 +4334,30 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalTruncateLog == nil {
-				m.InternalTruncateLog = &InternalTruncateLogRequest{}
-			}
-			if err := m.InternalTruncateLog.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ClosedTimestamp.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 37:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalGC", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseIndex", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InternalGC == nil {
-				m.InternalGC = &InternalGCRequest{}
-			}
-			if err := m.InternalGC.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
-		case 38:
+			m.MaxLeaseIndex = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalLease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerLease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3173,10 +4375,10 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalLease == nil {
-				m.InternalLease = &InternalLeaderLeaseRequest{}
+			if m.ProposerLease == nil {
+				m.ProposerLease = &Lease{}
 			}
-			if err := m.InternalLease.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ProposerLease.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
@@ -3203,7 +4405,7 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *InternalRaftCommand) Unmarshal(data []byte) error {
+func (m *RaftMessageRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -3222,9 +4424,9 @@ func (m *InternalRaftCommand) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -3232,34 +4434,32 @@ func (m *InternalRaftCommand) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.RaftID |= (int64(b) & 0x7F) << shift
+				m.GroupID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cmd.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
 		default:
 			var sizeOfWire int
@@ -3284,7 +4484,7 @@ func (m *InternalRaftCommand) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *RaftMessageRequest) Unmarshal(data []byte) error {
+func (m *ReserveSnapshotRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -3319,27 +4519,79 @@ func (m *RaftMessageRequest) Unmarshal(data []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.NodeID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + byteLen
-			if postIndex > l {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append([]byte{}, data[index:postIndex]...)
-			index = postIndex
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *ReserveSnapshotResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reserved = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -3908,6 +5160,70 @@ func (m *RaftSnapshotData_KeyValue) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *GossipBootstrapInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(data[index:postIndex]))
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.Put != nil {
 		return this.Put
@@ -3927,6 +5243,9 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.InitPut != nil {
+		return this.InitPut
+	}
 	if this.InternalHeartbeatTxn != nil {
 		return this.InternalHeartbeatTxn
 	}
@@ -3945,6 +5264,12 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.InternalGc != nil {
 		return this.InternalGc
 	}
+	if this.ClearRange != nil {
+		return this.ClearRange
+	}
+	if this.Batch != nil {
+		return this.Batch
+	}
 	return nil
 }
 
@@ -3962,6 +5287,8 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.DeleteRange = vt
 	case *EndTransactionResponse:
 		this.EndTransaction = vt
+	case *InitPutResponse:
+		this.InitPut = vt
 	case *InternalHeartbeatTxnResponse:
 		this.InternalHeartbeatTxn = vt
 	case *InternalPushTxnResponse:
@@ -3974,6 +5301,10 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.InternalTruncateLog = vt
 	case *InternalGCResponse:
 		this.InternalGc = vt
+	case *ClearRangeResponse:
+		this.ClearRange = vt
+	case *BatchResponse:
+		this.Batch = vt
 	default:
 		return false
 	}
@@ -4007,6 +5338,12 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.InitPut != nil {
+		return this.InitPut
+	}
+	if this.Aggregate != nil {
+		return this.Aggregate
+	}
 	if this.Batch != nil {
 		return this.Batch
 	}
@@ -4034,6 +5371,18 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.InternalLease != nil {
 		return this.InternalLease
 	}
+	if this.InternalCheckConsistency != nil {
+		return this.InternalCheckConsistency
+	}
+	if this.InternalRecomputeStats != nil {
+		return this.InternalRecomputeStats
+	}
+	if this.ClearRange != nil {
+		return this.ClearRange
+	}
+	if this.InternalRefreshRange != nil {
+		return this.InternalRefreshRange
+	}
 	return nil
 }
 
@@ -4057,6 +5406,10 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionRequest:
 		this.EndTransaction = vt
+	case *InitPutRequest:
+		this.InitPut = vt
+	case *AggregateRequest:
+		this.Aggregate = vt
 	case *BatchRequest:
 		this.Batch = vt
 	case *InternalRangeLookupRequest:
@@ -4075,6 +5428,14 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.InternalGC = vt
 	case *InternalLeaderLeaseRequest:
 		this.InternalLease = vt
+	case *InternalCheckConsistencyRequest:
+		this.InternalCheckConsistency = vt
+	case *InternalRecomputeStatsRequest:
+		this.InternalRecomputeStats = vt
+	case *ClearRangeRequest:
+		this.ClearRange = vt
+	case *InternalRefreshRangeRequest:
+		this.InternalRefreshRange = vt
 	default:
 		return false
 	}
@@ -4238,46 +5599,128 @@ func (m *InternalMergeRequest) Size() (n int) {
 	return n
 }
 
-func (m *InternalMergeResponse) Size() (n int) {
+func (m *InternalMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalTruncateLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	n += 1 + sovInternal(uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalTruncateLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalLeaderLeaseRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	l = m.Lease.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalLeaderLeaseResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalCheckConsistencyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.ChecksumID != nil {
+		l = len(m.ChecksumID)
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Checksum != nil {
+		l = len(m.Checksum)
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalCheckConsistencyResponse) Size() (n int) {
 	var l int
 	_ = l
 	l = m.ResponseHeader.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	if m.Checksum != nil {
+		l = len(m.Checksum)
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InternalTruncateLogRequest) Size() (n int) {
+func (m *InternalRecomputeStatsRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = m.RequestHeader.Size()
 	n += 1 + l + sovInternal(uint64(l))
-	n += 1 + sovInternal(uint64(m.Index))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InternalTruncateLogResponse) Size() (n int) {
+func (m *InternalRecomputeStatsResponse) Size() (n int) {
 	var l int
 	_ = l
 	l = m.ResponseHeader.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovInternal(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InternalLeaderLeaseRequest) Size() (n int) {
+func (m *InternalRefreshRangeRequest) Size() (n int) {
 	var l int
 	_ = l
 	l = m.RequestHeader.Size()
 	n += 1 + l + sovInternal(uint64(l))
-	l = m.Lease.Size()
+	l = m.RefreshFrom.Size()
 	n += 1 + l + sovInternal(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -4285,7 +5728,7 @@ func (m *InternalLeaderLeaseRequest) Size() (n int) {
 	return n
 }
 
-func (m *InternalLeaderLeaseResponse) Size() (n int) {
+func (m *InternalRefreshRangeResponse) Size() (n int) {
 	var l int
 	_ = l
 	l = m.ResponseHeader.Size()
@@ -4323,6 +5766,10 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.InitPut != nil {
+		l = m.InitPut.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.InternalHeartbeatTxn != nil {
 		l = m.InternalHeartbeatTxn.Size()
 		n += 1 + l + sovInternal(uint64(l))
@@ -4347,6 +5794,14 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.InternalGc.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.ClearRange != nil {
+		l = m.ClearRange.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4392,6 +5847,14 @@ func (m *InternalRaftCommandUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.InitPut != nil {
+		l = m.InitPut.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.Batch != nil {
 		l = m.Batch.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -4428,6 +5891,22 @@ func (m *InternalRaftCommandUnion) Size() (n int) {
 		l = m.InternalLease.Size()
 		n += 2 + l + sovInternal(uint64(l))
 	}
+	if m.InternalCheckConsistency != nil {
+		l = m.InternalCheckConsistency.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.InternalRecomputeStats != nil {
+		l = m.InternalRecomputeStats.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.ClearRange != nil {
+		l = m.ClearRange.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.InternalRefreshRange != nil {
+		l = m.InternalRefreshRange.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4440,6 +5919,15 @@ func (m *InternalRaftCommand) Size() (n int) {
 	n += 1 + sovInternal(uint64(m.RaftID))
 	l = m.Cmd.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	l = m.ClosedTimestamp.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.MaxLeaseIndex != nil {
+		n += 1 + sovInternal(uint64(*m.MaxLeaseIndex))
+	}
+	if m.ProposerLease != nil {
+		l = m.ProposerLease.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4460,6 +5948,27 @@ func (m *RaftMessageRequest) Size() (n int) {
 	return n
 }
 
+func (m *ReserveSnapshotRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovInternal(uint64(m.GroupID))
+	n += 1 + sovInternal(uint64(m.NodeID))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReserveSnapshotResponse) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RaftMessageResponse) Size() (n int) {
 	var l int
 	_ = l
@@ -4559,6 +6068,21 @@ func (m *RaftSnapshotData_KeyValue) Size() (n int) {
 	return n
 }
 
+func (m *GossipBootstrapInfo) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovInternal(x uint64) (n int) {
 	for {
 		n++
@@ -4924,14 +6448,207 @@ func (m *InternalResolveIntentRequest) MarshalTo(data []byte) (n int, err error)
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n14
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalResolveIntentResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalResolveIntentResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
+	n15, err := m.ResponseHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalMergeRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalMergeRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
+	n16, err := m.RequestHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	data[i] = 0x12
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.Value.Size()))
+	n17, err := m.Value.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalMergeResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalMergeResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
+	n18, err := m.ResponseHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalTruncateLogRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalTruncateLogRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
+	n19, err := m.RequestHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	data[i] = 0x10
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.Index))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalTruncateLogResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalTruncateLogResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
+	n20, err := m.ResponseHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalLeaderLeaseRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalLeaderLeaseRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
+	n21, err := m.RequestHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	data[i] = 0x12
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.Lease.Size()))
+	n22, err := m.Lease.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalResolveIntentResponse) Marshal() (data []byte, err error) {
+func (m *InternalLeaderLeaseResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -4941,7 +6658,7 @@ func (m *InternalResolveIntentResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalResolveIntentResponse) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalLeaderLeaseResponse) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -4949,18 +6666,18 @@ func (m *InternalResolveIntentResponse) MarshalTo(data []byte) (n int, err error
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n15, err := m.ResponseHeader.MarshalTo(data[i:])
+	n23, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n23
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalMergeRequest) Marshal() (data []byte, err error) {
+func (m *InternalCheckConsistencyRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -4970,7 +6687,7 @@ func (m *InternalMergeRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalMergeRequest) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalCheckConsistencyRequest) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -4978,26 +6695,30 @@ func (m *InternalMergeRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n16, err := m.RequestHeader.MarshalTo(data[i:])
+	n24, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
-	data[i] = 0x12
-	i++
-	i = encodeVarintInternal(data, i, uint64(m.Value.Size()))
-	n17, err := m.Value.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	i += n24
+	if m.ChecksumID != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintInternal(data, i, uint64(len(m.ChecksumID)))
+		i += copy(data[i:], m.ChecksumID)
+	}
+	if m.Checksum != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintInternal(data, i, uint64(len(m.Checksum)))
+		i += copy(data[i:], m.Checksum)
 	}
-	i += n17
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalMergeResponse) Marshal() (data []byte, err error) {
+func (m *InternalCheckConsistencyResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5007,7 +6728,7 @@ func (m *InternalMergeResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalMergeResponse) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalCheckConsistencyResponse) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -5015,18 +6736,24 @@ func (m *InternalMergeResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n18, err := m.ResponseHeader.MarshalTo(data[i:])
+	n25, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n25
+	if m.Checksum != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintInternal(data, i, uint64(len(m.Checksum)))
+		i += copy(data[i:], m.Checksum)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalTruncateLogRequest) Marshal() (data []byte, err error) {
+func (m *InternalRecomputeStatsRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5036,7 +6763,7 @@ func (m *InternalTruncateLogRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalTruncateLogRequest) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalRecomputeStatsRequest) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -5044,21 +6771,18 @@ func (m *InternalTruncateLogRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n19, err := m.RequestHeader.MarshalTo(data[i:])
+	n26, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
-	data[i] = 0x10
-	i++
-	i = encodeVarintInternal(data, i, uint64(m.Index))
+	i += n26
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalTruncateLogResponse) Marshal() (data []byte, err error) {
+func (m *InternalRecomputeStatsResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5068,7 +6792,7 @@ func (m *InternalTruncateLogResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalTruncateLogResponse) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalRecomputeStatsResponse) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -5076,18 +6800,26 @@ func (m *InternalTruncateLogResponse) MarshalTo(data []byte) (n int, err error)
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n20, err := m.ResponseHeader.MarshalTo(data[i:])
+	n27, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n27
+	data[i] = 0x12
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.Delta.Size()))
+	n28, err := m.Delta.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalLeaderLeaseRequest) Marshal() (data []byte, err error) {
+func (m *InternalRefreshRangeRequest) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5097,7 +6829,7 @@ func (m *InternalLeaderLeaseRequest) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalLeaderLeaseRequest) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalRefreshRangeRequest) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -5105,26 +6837,26 @@ func (m *InternalLeaderLeaseRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n21, err := m.RequestHeader.MarshalTo(data[i:])
+	n29, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n29
 	data[i] = 0x12
 	i++
-	i = encodeVarintInternal(data, i, uint64(m.Lease.Size()))
-	n22, err := m.Lease.MarshalTo(data[i:])
+	i = encodeVarintInternal(data, i, uint64(m.RefreshFrom.Size()))
+	n30, err := m.RefreshFrom.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n30
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *InternalLeaderLeaseResponse) Marshal() (data []byte, err error) {
+func (m *InternalRefreshRangeResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5134,7 +6866,7 @@ func (m *InternalLeaderLeaseResponse) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *InternalLeaderLeaseResponse) MarshalTo(data []byte) (n int, err error) {
+func (m *InternalRefreshRangeResponse) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -5142,11 +6874,11 @@ func (m *InternalLeaderLeaseResponse) MarshalTo(data []byte) (n int, err error)
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n23, err := m.ResponseHeader.MarshalTo(data[i:])
+	n31, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n31
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5172,121 +6904,155 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n24, err := m.Put.MarshalTo(data[i:])
+		n32, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n32
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n25, err := m.ConditionalPut.MarshalTo(data[i:])
+		n33, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n33
 	}
 	if m.Increment != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n26, err := m.Increment.MarshalTo(data[i:])
+		n34, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n34
 	}
 	if m.Delete != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n27, err := m.Delete.MarshalTo(data[i:])
+		n35, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n35
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n28, err := m.DeleteRange.MarshalTo(data[i:])
+		n36, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n36
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n29, err := m.EndTransaction.MarshalTo(data[i:])
+		n37, err := m.EndTransaction.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.InitPut != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InitPut.Size()))
+		n38, err := m.InitPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n38
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x52
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n30, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n39, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n39
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x5a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n31, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n40, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n40
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x62
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n32, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n41, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n41
 	}
 	if m.InternalMerge != nil {
 		data[i] = 0x6a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMerge.Size()))
-		n33, err := m.InternalMerge.MarshalTo(data[i:])
+		n42, err := m.InternalMerge.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n42
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0x72
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n34, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n43, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n43
 	}
 	if m.InternalGc != nil {
 		data[i] = 0x7a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGc.Size()))
-		n35, err := m.InternalGc.MarshalTo(data[i:])
+		n44, err := m.InternalGc.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n44
+	}
+	if m.ClearRange != nil {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ClearRange.Size()))
+		n45, err := m.ClearRange.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if m.Batch != nil {
+		data[i] = 0x8a
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Batch.Size()))
+		n46, err := m.Batch.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -5313,91 +7079,111 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Contains.Size()))
-		n36, err := m.Contains.MarshalTo(data[i:])
+		n47, err := m.Contains.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n47
 	}
 	if m.Get != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n37, err := m.Get.MarshalTo(data[i:])
+		n48, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n48
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n38, err := m.Put.MarshalTo(data[i:])
+		n49, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n49
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n39, err := m.ConditionalPut.MarshalTo(data[i:])
+		n50, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n50
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n40, err := m.Increment.MarshalTo(data[i:])
+		n51, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n51
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n41, err := m.Delete.MarshalTo(data[i:])
+		n52, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n52
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n42, err := m.DeleteRange.MarshalTo(data[i:])
+		n53, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n53
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n43, err := m.Scan.MarshalTo(data[i:])
+		n54, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n54
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n44, err := m.EndTransaction.MarshalTo(data[i:])
+		n55, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n55
+	}
+	if m.InitPut != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InitPut.Size()))
+		n56, err := m.InitPut.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.Aggregate != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Aggregate.Size()))
+		n57, err := m.Aggregate.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
 	}
 	if m.Batch != nil {
 		data[i] = 0xf2
@@ -5405,11 +7191,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Batch.Size()))
-		n45, err := m.Batch.MarshalTo(data[i:])
+		n58, err := m.Batch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n58
 	}
 	if m.InternalRangeLookup != nil {
 		data[i] = 0xfa
@@ -5417,11 +7203,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalRangeLookup.Size()))
-		n46, err := m.InternalRangeLookup.MarshalTo(data[i:])
+		n59, err := m.InternalRangeLookup.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n59
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x82
@@ -5429,11 +7215,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n47, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n60, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n60
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x8a
@@ -5441,11 +7227,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n48, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n61, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n61
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x92
@@ -5453,11 +7239,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n49, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n62, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n62
 	}
 	if m.InternalMergeResponse != nil {
 		data[i] = 0x9a
@@ -5465,11 +7251,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMergeResponse.Size()))
-		n50, err := m.InternalMergeResponse.MarshalTo(data[i:])
+		n63, err := m.InternalMergeResponse.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n63
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0xa2
@@ -5477,11 +7263,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n51, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n64, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n64
 	}
 	if m.InternalGC != nil {
 		data[i] = 0xaa
@@ -5489,11 +7275,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGC.Size()))
-		n52, err := m.InternalGC.MarshalTo(data[i:])
+		n65, err := m.InternalGC.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n65
 	}
 	if m.InternalLease != nil {
 		data[i] = 0xb2
@@ -5501,11 +7287,59 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLease.Size()))
-		n53, err := m.InternalLease.MarshalTo(data[i:])
+		n66, err := m.InternalLease.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n66
+	}
+	if m.InternalCheckConsistency != nil {
+		data[i] = 0xba
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InternalCheckConsistency.Size()))
+		n67, err := m.InternalCheckConsistency.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if m.InternalRecomputeStats != nil {
+		data[i] = 0xc2
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InternalRecomputeStats.Size()))
+		n68, err := m.InternalRecomputeStats.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.ClearRange != nil {
+		data[i] = 0xca
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ClearRange.Size()))
+		n69, err := m.ClearRange.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if m.InternalRefreshRange != nil {
+		data[i] = 0xd2
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InternalRefreshRange.Size()))
+		n70, err := m.InternalRefreshRange.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -5534,11 +7368,34 @@ func (m *InternalRaftCommand) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.Cmd.Size()))
-	n54, err := m.Cmd.MarshalTo(data[i:])
+	n71, err := m.Cmd.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n54
+	i += n71
+	data[i] = 0x22
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ClosedTimestamp.Size()))
+	n72, err := m.ClosedTimestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n72
+	if m.MaxLeaseIndex != nil {
+		data[i] = 0x28
+		i++
+		i = encodeVarintInternal(data, i, uint64(*m.MaxLeaseIndex))
+	}
+	if m.ProposerLease != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ProposerLease.Size()))
+		n73, err := m.ProposerLease.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5575,6 +7432,62 @@ func (m *RaftMessageRequest) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *ReserveSnapshotRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ReserveSnapshotRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.GroupID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.NodeID))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReserveSnapshotResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ReserveSnapshotResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	if m.Reserved {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *RaftMessageResponse) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -5788,6 +7701,42 @@ func (m *RaftSnapshotData_KeyValue) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *GossipBootstrapInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GossipBootstrapInfo) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeFixed64Internal(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	data[offset+1] = uint8(v >> 8)
@@ -35,6 +35,9 @@ const (
 	// matches the value specified in the request. Specifying a null value
 	// for existing means the value must not yet exist.
 	ConditionalPut
+	// InitPut sets the value for a key if the key doesn't exist or
+	// its existing value equals the value specified in the request.
+	InitPut
 	// Increment increments the value at the specified key. Once called
 	// for a key, Put & ConditionalPut will return errors; only
 	// Increment will continue to be a valid command. The value must be
@@ -46,10 +49,24 @@ const (
 	// args.RequestHeader.Key and args.RequestHeader.EndKey, with
 	// the latter endpoint excluded.
 	DeleteRange
+	// ClearRange removes all values, including historical versions, for
+	// keys which fall between args.RequestHeader.Key and
+	// args.RequestHeader.EndKey, with the latter endpoint excluded. The
+	// values are removed from the storage engine outright rather than
+	// deleted through MVCC, which makes it suitable for dropping large
+	// spans of data.
+	ClearRange
 	// Scan fetches the values for all keys which fall between
 	// args.RequestHeader.Key and args.RequestHeader.EndKey, with
 	// the latter endpoint excluded.
 	Scan
+	// Aggregate counts the keys and sums the integer values which
+	// fall between args.RequestHeader.Key and
+	// args.RequestHeader.EndKey, with the latter endpoint excluded.
+	Aggregate
+	// Watch returns the committed changes to the keys of a range
+	// after a start timestamp, waiting for changes if there are none.
+	Watch
 	// EndTransaction either commits or aborts an ongoing transaction.
 	EndTransaction
 	// ReapQueue scans and deletes messages from a recipient message
@@ -68,6 +85,18 @@ const (
 	AdminSplit
 	// AdminMerge is called to coordinate a merge of two adjacent ranges.
 	AdminMerge
+	// AdminBulkLoad writes key/value pairs directly to a range's engine
+	// in a single batch, bypassing per-key Raft proposals.
+	AdminBulkLoad
+	// AdminTransferLease hands a range's Raft leadership and leader
+	// lease over to another of its replicas.
+	AdminTransferLease
+	// AdminBackup exports a range's data as of a timestamp to external
+	// storage.
+	AdminBackup
+	// AdminRestore starts a job restoring a backup from external
+	// storage.
+	AdminRestore
 	// InternalRangeLookup looks up range descriptors, containing the
 	// locations of replicas for the range containing the specified key.
 	InternalRangeLookup
@@ -105,28 +134,51 @@ const (
 	InternalTruncateLog
 	// InternalLeaderLease requests a leader lease for a replica.
 	InternalLeaderLease
+	// InternalCheckConsistency computes a checksum of the range's data on
+	// each replica and compares the results to detect divergence. The call
+	// goes through Raft, so all replicas checksum the same state.
+	InternalCheckConsistency
+	// InternalRecomputeStats rescans a range to compute authoritative MVCC
+	// stats and corrects any drift in the range's stats. The call goes
+	// through Raft, so all replicas apply the same correction.
+	InternalRecomputeStats
+	// InternalRefreshRange verifies that the values in a key range read
+	// by a transaction are unchanged between the timestamp they were
+	// read at and the transaction's pushed commit timestamp.
+	InternalRefreshRange
 )
 
 // AllMethods is a map from string to method enum.
 var AllMethods = map[string]Method{
-	Contains.String():              Contains,
-	Get.String():                   Get,
-	Put.String():                   Put,
-	ConditionalPut.String():        ConditionalPut,
-	Increment.String():             Increment,
-	Delete.String():                Delete,
-	DeleteRange.String():           DeleteRange,
-	Scan.String():                  Scan,
-	EndTransaction.String():        EndTransaction,
-	Batch.String():                 Batch,
-	AdminSplit.String():            AdminSplit,
-	AdminMerge.String():            AdminMerge,
-	InternalRangeLookup.String():   InternalRangeLookup,
-	InternalHeartbeatTxn.String():  InternalHeartbeatTxn,
-	InternalGC.String():            InternalGC,
-	InternalPushTxn.String():       InternalPushTxn,
-	InternalResolveIntent.String(): InternalResolveIntent,
-	InternalMerge.String():         InternalMerge,
-	InternalTruncateLog.String():   InternalTruncateLog,
-	InternalLeaderLease.String():   InternalLeaderLease,
+	Contains.String():                 Contains,
+	Get.String():                      Get,
+	Put.String():                      Put,
+	ConditionalPut.String():           ConditionalPut,
+	InitPut.String():                  InitPut,
+	Increment.String():                Increment,
+	Delete.String():                   Delete,
+	DeleteRange.String():              DeleteRange,
+	ClearRange.String():               ClearRange,
+	Scan.String():                     Scan,
+	Aggregate.String():                Aggregate,
+	Watch.String():                    Watch,
+	EndTransaction.String():           EndTransaction,
+	Batch.String():                    Batch,
+	AdminSplit.String():               AdminSplit,
+	AdminMerge.String():               AdminMerge,
+	AdminBulkLoad.String():            AdminBulkLoad,
+	AdminTransferLease.String():       AdminTransferLease,
+	AdminBackup.String():              AdminBackup,
+	AdminRestore.String():             AdminRestore,
+	InternalRangeLookup.String():      InternalRangeLookup,
+	InternalHeartbeatTxn.String():     InternalHeartbeatTxn,
+	InternalGC.String():               InternalGC,
+	InternalPushTxn.String():          InternalPushTxn,
+	InternalResolveIntent.String():    InternalResolveIntent,
+	InternalMerge.String():            InternalMerge,
+	InternalTruncateLog.String():      InternalTruncateLog,
+	InternalLeaderLease.String():      InternalLeaderLease,
+	InternalCheckConsistency.String(): InternalCheckConsistency,
+	InternalRecomputeStats.String():   InternalRecomputeStats,
+	InternalRefreshRange.String():     InternalRefreshRange,
 }
@@ -4,9 +4,9 @@ package proto
 
 import "fmt"
 
-const _Method_name = "ContainsGetPutConditionalPutIncrementDeleteDeleteRangeScanEndTransactionReapQueueEnqueueUpdateEnqueueMessageBatchAdminSplitAdminMergeInternalRangeLookupInternalHeartbeatTxnInternalGCInternalPushTxnInternalResolveIntentInternalMergeInternalTruncateLogInternalLeaderLease"
+const _Method_name = "ContainsGetPutConditionalPutInitPutIncrementDeleteDeleteRangeClearRangeScanAggregateWatchEndTransactionReapQueueEnqueueUpdateEnqueueMessageBatchAdminSplitAdminMergeAdminBulkLoadAdminTransferLeaseAdminBackupAdminRestoreInternalRangeLookupInternalHeartbeatTxnInternalGCInternalPushTxnInternalResolveIntentInternalMergeInternalTruncateLogInternalLeaderLeaseInternalCheckConsistencyInternalRecomputeStatsInternalRefreshRange"
 
-var _Method_index = [...]uint16{0, 8, 11, 14, 28, 37, 43, 54, 58, 72, 81, 94, 108, 113, 123, 133, 152, 172, 182, 197, 218, 231, 250, 269}
+var _Method_index = [...]uint16{0, 8, 11, 14, 28, 35, 44, 50, 61, 71, 75, 84, 89, 103, 112, 125, 139, 144, 154, 164, 177, 195, 206, 218, 237, 257, 267, 282, 303, 316, 335, 354, 378, 400, 420}
 
 func (i Method) String() string {
 	if i < 0 || i+1 >= Method(len(_Method_index)) {
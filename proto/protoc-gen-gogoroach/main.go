@@ -0,0 +1,77 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// protoc-gen-gogoroach is the protocol buffer compiler plugin which
+// generates the Go code of our .proto files. It generates the same
+// code as protoc-gen-gogo, along with a client and a server interface
+// for each service.
+package main
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+
+	_ "github.com/gogo/protobuf/plugin/defaultcheck"
+	_ "github.com/gogo/protobuf/plugin/description"
+	_ "github.com/gogo/protobuf/plugin/embedcheck"
+	_ "github.com/gogo/protobuf/plugin/enumstringer"
+	_ "github.com/gogo/protobuf/plugin/equal"
+	_ "github.com/gogo/protobuf/plugin/face"
+	_ "github.com/gogo/protobuf/plugin/gostring"
+	_ "github.com/gogo/protobuf/plugin/marshalto"
+	_ "github.com/gogo/protobuf/plugin/populate"
+	_ "github.com/gogo/protobuf/plugin/size"
+	_ "github.com/gogo/protobuf/plugin/stringer"
+	_ "github.com/gogo/protobuf/plugin/union"
+	_ "github.com/gogo/protobuf/plugin/unmarshal"
+)
+
+func init() {
+	generator.RegisterPlugin(&servicePlugin{})
+}
+
+func main() {
+	g := generator.New()
+
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		g.Error(err, "reading input")
+	}
+	if err := proto.Unmarshal(data, g.Request); err != nil {
+		g.Error(err, "parsing input proto")
+	}
+	if len(g.Request.FileToGenerate) == 0 {
+		g.Fail("no files to generate")
+	}
+
+	g.CommandLineParameters(g.Request.GetParameter())
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+
+	data, err = proto.Marshal(g.Response)
+	if err != nil {
+		g.Error(err, "failed to marshal output proto")
+	}
+	if _, err := os.Stdout.Write(data); err != nil {
+		g.Error(err, "failed to write output proto")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+)
+
+const (
+	// servicePath and methodPath are the field numbers of the services
+	// of a file and of the methods of a service, which make up the
+	// paths of their comments (see descriptor.proto).
+	servicePath = 6
+	methodPath  = 2
+)
+
+// servicePlugin generates, for each service, a client interface whose
+// implementation sends the service's RPCs through a Caller, and a
+// server interface whose implementations are registered with a
+// Registrar. Caller and Registrar aren't generated; they must be
+// defined by the package the files are generated into.
+type servicePlugin struct {
+	*generator.Generator
+}
+
+// Name implements generator.Plugin.
+func (p *servicePlugin) Name() string {
+	return "service"
+}
+
+// Init implements generator.Plugin.
+func (p *servicePlugin) Init(g *generator.Generator) {
+	p.Generator = g
+}
+
+// GenerateImports implements generator.Plugin. The generated code
+// imports nothing.
+func (p *servicePlugin) GenerateImports(file *generator.FileDescriptor) {
+}
+
+// Generate implements generator.Plugin.
+func (p *servicePlugin) Generate(file *generator.FileDescriptor) {
+	for i, svc := range file.Service {
+		p.generateService(svc, fmt.Sprintf("%d,%d", servicePath, i))
+	}
+}
+
+// generateService generates the client and server of a service, whose
+// comments are found at path.
+func (p *servicePlugin) generateService(svc *descriptor.ServiceDescriptorProto, path string) {
+	name := svc.GetName()
+	clientType := strings.ToLower(name) + "Client"
+	serverType := strings.ToLower(name) + "Server"
+
+	p.P("// ", name, "Client is the client API of the ", name, " service.")
+	p.P("//")
+	p.PrintComments(path)
+	p.P("type ", name, "Client interface {")
+	p.In()
+	for i, m := range svc.Method {
+		p.PrintComments(fmt.Sprintf("%s,%d,%d", path, methodPath, i))
+		p.P(m.GetName(), "(args *", p.inputType(m), ") (*", p.outputType(m), ", error)")
+	}
+	p.Out()
+	p.P("}")
+	p.P()
+	p.P("type ", clientType, " struct {")
+	p.In()
+	p.P("c Caller")
+	p.Out()
+	p.P("}")
+	p.P()
+	p.P("// New", name, "Client returns a client which sends the RPCs of the")
+	p.P("// ", name, " service through c.")
+	p.P("func New", name, "Client(c Caller) ", name, "Client {")
+	p.In()
+	p.P("return &", clientType, "{c}")
+	p.Out()
+	p.P("}")
+	p.P()
+	for _, m := range svc.Method {
+		p.P("func (c *", clientType, ") ", m.GetName(), "(args *", p.inputType(m), ") (*", p.outputType(m), ", error) {")
+		p.In()
+		p.P("reply := &", p.outputType(m), "{}")
+		p.P("if err := c.c.Call(", fmt.Sprintf("%q", name+"."+m.GetName()), ", args, reply); err != nil {")
+		p.In()
+		p.P("return nil, err")
+		p.Out()
+		p.P("}")
+		p.P("return reply, nil")
+		p.Out()
+		p.P("}")
+		p.P()
+	}
+
+	p.P("// ", name, "Server is the server API of the ", name, " service.")
+	p.P("type ", name, "Server interface {")
+	p.In()
+	for i, m := range svc.Method {
+		p.PrintComments(fmt.Sprintf("%s,%d,%d", path, methodPath, i))
+		p.P(m.GetName(), "(args *", p.inputType(m), ", reply *", p.outputType(m), ") error")
+	}
+	p.Out()
+	p.P("}")
+	p.P()
+	p.P("// Register", name, "Server registers srv with r as the ", name, " service.")
+	p.P("// Only the methods of the service are registered.")
+	p.P("func Register", name, "Server(r Registrar, srv ", name, "Server) error {")
+	p.In()
+	p.P("return r.RegisterName(", fmt.Sprintf("%q", name), ", &", serverType, "{srv})")
+	p.Out()
+	p.P("}")
+	p.P()
+	p.P("type ", serverType, " struct {")
+	p.In()
+	p.P("srv ", name, "Server")
+	p.Out()
+	p.P("}")
+	p.P()
+	for _, m := range svc.Method {
+		p.P("func (s *", serverType, ") ", m.GetName(), "(args *", p.inputType(m), ", reply *", p.outputType(m), ") error {")
+		p.In()
+		p.P("return s.srv.", m.GetName(), "(args, reply)")
+		p.Out()
+		p.P("}")
+		p.P()
+	}
+}
+
+// inputType returns the Go type of the request of a method.
+func (p *servicePlugin) inputType(m *descriptor.MethodDescriptorProto) string {
+	return p.TypeName(p.ObjectNamed(m.GetInputType()))
+}
+
+// outputType returns the Go type of the response of a method.
+func (p *servicePlugin) outputType(m *descriptor.MethodDescriptorProto) string {
+	return p.TypeName(p.ObjectNamed(m.GetOutputType()))
+}
@@ -0,0 +1,31 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package proto
+
+// A Caller sends the RPCs of the clients generated for the services
+// defined in our .proto files, such as KVClient. rpc.Client is a
+// Caller.
+type Caller interface {
+	Call(serviceMethod string, args, reply interface{}) error
+}
+
+// A Registrar registers the servers of the services defined in our
+// .proto files, such as KVServer. rpc.Server is a Registrar.
+type Registrar interface {
+	RegisterName(name string, rcvr interface{}) error
+}
@@ -0,0 +1,28 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package proto
+
+// BlockCacheHitRate returns the fraction of block cache lookups which
+// were hits, or zero if there have been no lookups.
+func (es *EngineStats) BlockCacheHitRate() float64 {
+	total := es.BlockCacheHits + es.BlockCacheMisses
+	if total == 0 {
+		return 0
+	}
+	return float64(es.BlockCacheHits) / float64(total)
+}
@@ -28,8 +28,21 @@ type StoreStatus struct {
 	// The last time this status was updated.
 	UpdatedAt int64 `protobuf:"varint,5,opt,name=updated_at" json:"updated_at"`
 	// All current aggregated stats are contained in MVCCStats.
-	Stats            MVCCStats `protobuf:"bytes,6,opt,name=stats" json:"stats"`
-	XXX_unrecognized []byte    `json:"-"`
+	Stats MVCCStats `protobuf:"bytes,6,opt,name=stats" json:"stats"`
+	// Internal statistics of the store's storage engine.
+	EngineStats *EngineStats `protobuf:"bytes,7,opt,name=engine_stats" json:"engine_stats,omitempty"`
+	// The Raft IDs of ranges whose local replicas were found to be corrupt.
+	CorruptRaftIDs []int64 `protobuf:"varint,8,rep,packed,name=corrupt_raft_ids" json:"corrupt_raft_ids,omitempty"`
+	// The latencies of the requests executed by the store, by method.
+	RequestLatencies []RequestLatency `protobuf:"bytes,9,rep,name=request_latencies" json:"request_latencies"`
+	// The capacity and available space in bytes of the store's device.
+	Capacity  int64 `protobuf:"varint,10,opt,name=capacity" json:"capacity"`
+	Available int64 `protobuf:"varint,11,opt,name=available" json:"available"`
+	// The number of ranges whose local replica is the Raft leader.
+	LeaderRangeCount int32 `protobuf:"varint,12,opt,name=leader_range_count" json:"leader_range_count"`
+	// The total size in bytes of the Raft logs of the store's replicas.
+	RaftLogSize      int64  `protobuf:"varint,13,opt,name=raft_log_size" json:"raft_log_size"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *StoreStatus) Reset()         { *m = StoreStatus{} }
@@ -64,8 +77,394 @@ func (m *StoreStatus) GetStats() MVCCStats {
 	return MVCCStats{}
 }
 
+func (m *StoreStatus) GetEngineStats() *EngineStats {
+	if m != nil {
+		return m.EngineStats
+	}
+	return nil
+}
+
+func (m *StoreStatus) GetCorruptRaftIDs() []int64 {
+	if m != nil {
+		return m.CorruptRaftIDs
+	}
+	return nil
+}
+
+func (m *StoreStatus) GetRequestLatencies() []RequestLatency {
+	if m != nil {
+		return m.RequestLatencies
+	}
+	return nil
+}
+
+func (m *StoreStatus) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *StoreStatus) GetAvailable() int64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *StoreStatus) GetLeaderRangeCount() int32 {
+	if m != nil {
+		return m.LeaderRangeCount
+	}
+	return 0
+}
+
+func (m *StoreStatus) GetRaftLogSize() int64 {
+	if m != nil {
+		return m.RaftLogSize
+	}
+	return 0
+}
+
+// EngineStats contains internal statistics of a storage engine.
+type EngineStats struct {
+	// The number of files at each level of the LSM tree.
+	FilesAtLevel []int64 `protobuf:"varint,1,rep,packed,name=files_at_level" json:"files_at_level,omitempty"`
+	// Whether at least one compaction is pending.
+	CompactionPending bool `protobuf:"varint,2,opt,name=compaction_pending" json:"compaction_pending"`
+	// The approximate size in bytes of all memtables.
+	MemtableSize int64 `protobuf:"varint,3,opt,name=memtable_size" json:"memtable_size"`
+	// The number of block cache hits and misses since the engine was opened.
+	BlockCacheHits   int64 `protobuf:"varint,4,opt,name=block_cache_hits" json:"block_cache_hits"`
+	BlockCacheMisses int64 `protobuf:"varint,5,opt,name=block_cache_misses" json:"block_cache_misses"`
+	// The memory in bytes used by entries in the block cache.
+	BlockCacheUsage int64 `protobuf:"varint,6,opt,name=block_cache_usage" json:"block_cache_usage"`
+	// The number of values read whose checksum didn't match their
+	// contents since the engine was opened.
+	ValueChecksumMismatches int64  `protobuf:"varint,7,opt,name=value_checksum_mismatches" json:"value_checksum_mismatches"`
+	XXX_unrecognized        []byte `json:"-"`
+}
+
+func (m *EngineStats) Reset()         { *m = EngineStats{} }
+func (m *EngineStats) String() string { return proto1.CompactTextString(m) }
+func (*EngineStats) ProtoMessage()    {}
+
+func (m *EngineStats) GetFilesAtLevel() []int64 {
+	if m != nil {
+		return m.FilesAtLevel
+	}
+	return nil
+}
+
+func (m *EngineStats) GetCompactionPending() bool {
+	if m != nil {
+		return m.CompactionPending
+	}
+	return false
+}
+
+func (m *EngineStats) GetMemtableSize() int64 {
+	if m != nil {
+		return m.MemtableSize
+	}
+	return 0
+}
+
+func (m *EngineStats) GetBlockCacheHits() int64 {
+	if m != nil {
+		return m.BlockCacheHits
+	}
+	return 0
+}
+
+func (m *EngineStats) GetBlockCacheMisses() int64 {
+	if m != nil {
+		return m.BlockCacheMisses
+	}
+	return 0
+}
+
+func (m *EngineStats) GetBlockCacheUsage() int64 {
+	if m != nil {
+		return m.BlockCacheUsage
+	}
+	return 0
+}
+
+func (m *EngineStats) GetValueChecksumMismatches() int64 {
+	if m != nil {
+		return m.ValueChecksumMismatches
+	}
+	return 0
+}
+
+// RequestLatency summarizes the latencies, in nanoseconds, of the
+// requests of a method executed since a node was started.
+type RequestLatency struct {
+	Method           string `protobuf:"bytes,1,opt,name=method" json:"method"`
+	Count            int64  `protobuf:"varint,2,opt,name=count" json:"count"`
+	P50              int64  `protobuf:"varint,3,opt,name=p50" json:"p50"`
+	P95              int64  `protobuf:"varint,4,opt,name=p95" json:"p95"`
+	P99              int64  `protobuf:"varint,5,opt,name=p99" json:"p99"`
+	Max              int64  `protobuf:"varint,6,opt,name=max" json:"max"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RequestLatency) Reset()         { *m = RequestLatency{} }
+func (m *RequestLatency) String() string { return proto1.CompactTextString(m) }
+func (*RequestLatency) ProtoMessage()    {}
+
+func (m *RequestLatency) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *RequestLatency) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RequestLatency) GetP50() int64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *RequestLatency) GetP95() int64 {
+	if m != nil {
+		return m.P95
+	}
+	return 0
+}
+
+func (m *RequestLatency) GetP99() int64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+func (m *RequestLatency) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+// RestoreStatus records the progress of a restore job.
+type RestoreStatus struct {
+	URI string `protobuf:"bytes,1,opt,name=uri" json:"uri"`
+	// The number of files in the backup being restored.
+	TotalFiles int32 `protobuf:"varint,2,opt,name=total_files" json:"total_files"`
+	// The number of files restored so far.
+	RestoredFiles int32 `protobuf:"varint,3,opt,name=restored_files" json:"restored_files"`
+	// The number of key/value pairs restored so far.
+	RestoredKeys int64 `protobuf:"varint,4,opt,name=restored_keys" json:"restored_keys"`
+	// The time at which the job was started.
+	StartedAt int64 `protobuf:"varint,5,opt,name=started_at" json:"started_at"`
+	// The last time this status was updated.
+	UpdatedAt int64 `protobuf:"varint,6,opt,name=updated_at" json:"updated_at"`
+	// Whether the job has finished, successfully or not.
+	Done bool `protobuf:"varint,7,opt,name=done" json:"done"`
+	// The error which failed the job, if any.
+	Error            string `protobuf:"bytes,8,opt,name=error" json:"error"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RestoreStatus) Reset()         { *m = RestoreStatus{} }
+func (m *RestoreStatus) String() string { return proto1.CompactTextString(m) }
+func (*RestoreStatus) ProtoMessage()    {}
+
+func (m *RestoreStatus) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *RestoreStatus) GetTotalFiles() int32 {
+	if m != nil {
+		return m.TotalFiles
+	}
+	return 0
+}
+
+func (m *RestoreStatus) GetRestoredFiles() int32 {
+	if m != nil {
+		return m.RestoredFiles
+	}
+	return 0
+}
+
+func (m *RestoreStatus) GetRestoredKeys() int64 {
+	if m != nil {
+		return m.RestoredKeys
+	}
+	return 0
+}
+
+func (m *RestoreStatus) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *RestoreStatus) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *RestoreStatus) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *RestoreStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// A StatusDetailsRequest is the argument to the Details() method.
+type StatusDetailsRequest struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *StatusDetailsRequest) Reset()         { *m = StatusDetailsRequest{} }
+func (m *StatusDetailsRequest) String() string { return proto1.CompactTextString(m) }
+func (*StatusDetailsRequest) ProtoMessage()    {}
+
+// A StatusDetailsResponse is the return value from the Details()
+// method.
+type StatusDetailsResponse struct {
+	NodeID NodeID `protobuf:"varint,1,opt,name=node_id,customtype=NodeID" json:"node_id"`
+	// The tag of the build the node is running.
+	BuildTag string `protobuf:"bytes,2,opt,name=build_tag" json:"build_tag"`
+	// The latencies of the requests served by the node, by method.
+	RequestLatencies []RequestLatency `protobuf:"bytes,3,rep,name=request_latencies" json:"request_latencies"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *StatusDetailsResponse) Reset()         { *m = StatusDetailsResponse{} }
+func (m *StatusDetailsResponse) String() string { return proto1.CompactTextString(m) }
+func (*StatusDetailsResponse) ProtoMessage()    {}
+
+func (m *StatusDetailsResponse) GetBuildTag() string {
+	if m != nil {
+		return m.BuildTag
+	}
+	return ""
+}
+
+func (m *StatusDetailsResponse) GetRequestLatencies() []RequestLatency {
+	if m != nil {
+		return m.RequestLatencies
+	}
+	return nil
+}
+
+// A StatusStoresRequest is the argument to the Stores() method.
+type StatusStoresRequest struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *StatusStoresRequest) Reset()         { *m = StatusStoresRequest{} }
+func (m *StatusStoresRequest) String() string { return proto1.CompactTextString(m) }
+func (*StatusStoresRequest) ProtoMessage()    {}
+
+// A StatusStoresResponse is the return value from the Stores() method.
+type StatusStoresResponse struct {
+	// The statuses most recently recorded by each store of the cluster,
+	// ordered by store ID.
+	Stores           []StoreStatus `protobuf:"bytes,1,rep,name=stores" json:"stores"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *StatusStoresResponse) Reset()         { *m = StatusStoresResponse{} }
+func (m *StatusStoresResponse) String() string { return proto1.CompactTextString(m) }
+func (*StatusStoresResponse) ProtoMessage()    {}
+
+func (m *StatusStoresResponse) GetStores() []StoreStatus {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
 func init() {
 }
+
+// StatusClient is the client API of the Status service.
+//
+// Status is the public status service. Each method is served on the
+// RPC port as "Status.<method>", which the client returned by
+// NewStatusClient calls.
+type StatusClient interface {
+	Details(args *StatusDetailsRequest) (*StatusDetailsResponse, error)
+	Stores(args *StatusStoresRequest) (*StatusStoresResponse, error)
+}
+
+type statusClient struct {
+	c Caller
+}
+
+// NewStatusClient returns a client which sends the RPCs of the
+// Status service through c.
+func NewStatusClient(c Caller) StatusClient {
+	return &statusClient{c}
+}
+
+func (c *statusClient) Details(args *StatusDetailsRequest) (*StatusDetailsResponse, error) {
+	reply := &StatusDetailsResponse{}
+	if err := c.c.Call("Status.Details", args, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (c *statusClient) Stores(args *StatusStoresRequest) (*StatusStoresResponse, error) {
+	reply := &StatusStoresResponse{}
+	if err := c.c.Call("Status.Stores", args, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// StatusServer is the server API of the Status service.
+type StatusServer interface {
+	Details(args *StatusDetailsRequest, reply *StatusDetailsResponse) error
+	Stores(args *StatusStoresRequest, reply *StatusStoresResponse) error
+}
+
+// RegisterStatusServer registers srv with r as the Status service.
+// Only the methods of the service are registered.
+func RegisterStatusServer(r Registrar, srv StatusServer) error {
+	return r.RegisterName("Status", &statusServer{srv})
+}
+
+type statusServer struct {
+	srv StatusServer
+}
+
+func (s *statusServer) Details(args *StatusDetailsRequest, reply *StatusDetailsResponse) error {
+	return s.srv.Details(args, reply)
+}
+
+func (s *statusServer) Stores(args *StatusStoresRequest, reply *StatusStoresResponse) error {
+	return s.srv.Stores(args, reply)
+}
+
 func (m *StoreStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -184,39 +583,1078 @@ func (m *StoreStatus) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EngineStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EngineStats == nil {
+				m.EngineStats = &EngineStats{}
+			}
+			if err := m.EngineStats.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
-			if (index + skippy) > l {
+			index = postIndex
+		case 8:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CorruptRaftIDs = append(m.CorruptRaftIDs, v)
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CorruptRaftIDs = append(m.CorruptRaftIDs, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorruptRaftIDs", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestLatencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-	return nil
-}
-func (m *StoreStatus) Size() (n int) {
-	var l int
-	_ = l
+			m.RequestLatencies = append(m.RequestLatencies, RequestLatency{})
+			m.RequestLatencies[len(m.RequestLatencies)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Capacity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Available |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderRangeCount", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.LeaderRangeCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftLogSize", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.RaftLogSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *EngineStats) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				postIndex := index + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for index < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if index >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[index]
+						index++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FilesAtLevel = append(m.FilesAtLevel, v)
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if index >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[index]
+					index++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FilesAtLevel = append(m.FilesAtLevel, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilesAtLevel", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompactionPending = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemtableSize", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.MemtableSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCacheHits", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.BlockCacheHits |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCacheMisses", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.BlockCacheMisses |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCacheUsage", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.BlockCacheUsage |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueChecksumMismatches", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.ValueChecksumMismatches |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *RequestLatency) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.P50 |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P95", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.P95 |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.P99 |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Max |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *RestoreStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFiles", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.TotalFiles |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredFiles", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.RestoredFiles |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredKeys", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.RestoredKeys |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.StartedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.UpdatedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StatusDetailsRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		switch fieldNum {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StatusDetailsResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.NodeID |= (NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildTag = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestLatencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestLatencies = append(m.RequestLatencies, RequestLatency{})
+			m.RequestLatencies[len(m.RequestLatencies)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StatusStoresRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		switch fieldNum {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StatusStoresResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreStatus{})
+			m.Stores[len(m.Stores)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StoreStatus) Size() (n int) {
+	var l int
+	_ = l
 	n += 1 + sovStatus(uint64(m.StoreID))
 	n += 1 + sovStatus(uint64(m.NodeID))
 	n += 1 + sovStatus(uint64(m.RangeCount))
 	n += 1 + sovStatus(uint64(m.StartedAt))
 	n += 1 + sovStatus(uint64(m.UpdatedAt))
-	l = m.Stats.Size()
+	l = m.Stats.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	if m.EngineStats != nil {
+		l = m.EngineStats.Size()
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if len(m.CorruptRaftIDs) > 0 {
+		l = 0
+		for _, e := range m.CorruptRaftIDs {
+			l += sovStatus(uint64(e))
+		}
+		n += 1 + sovStatus(uint64(l)) + l
+	}
+	if len(m.RequestLatencies) > 0 {
+		for _, e := range m.RequestLatencies {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	n += 1 + sovStatus(uint64(m.Capacity))
+	n += 1 + sovStatus(uint64(m.Available))
+	n += 1 + sovStatus(uint64(m.LeaderRangeCount))
+	n += 1 + sovStatus(uint64(m.RaftLogSize))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EngineStats) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.FilesAtLevel) > 0 {
+		l = 0
+		for _, e := range m.FilesAtLevel {
+			l += sovStatus(uint64(e))
+		}
+		n += 1 + sovStatus(uint64(l)) + l
+	}
+	n += 2
+	n += 1 + sovStatus(uint64(m.MemtableSize))
+	n += 1 + sovStatus(uint64(m.BlockCacheHits))
+	n += 1 + sovStatus(uint64(m.BlockCacheMisses))
+	n += 1 + sovStatus(uint64(m.BlockCacheUsage))
+	n += 1 + sovStatus(uint64(m.ValueChecksumMismatches))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RequestLatency) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Method)
+	n += 1 + l + sovStatus(uint64(l))
+	n += 1 + sovStatus(uint64(m.Count))
+	n += 1 + sovStatus(uint64(m.P50))
+	n += 1 + sovStatus(uint64(m.P95))
+	n += 1 + sovStatus(uint64(m.P99))
+	n += 1 + sovStatus(uint64(m.Max))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URI)
+	n += 1 + l + sovStatus(uint64(l))
+	n += 1 + sovStatus(uint64(m.TotalFiles))
+	n += 1 + sovStatus(uint64(m.RestoredFiles))
+	n += 1 + sovStatus(uint64(m.RestoredKeys))
+	n += 1 + sovStatus(uint64(m.StartedAt))
+	n += 1 + sovStatus(uint64(m.UpdatedAt))
+	n += 2
+	l = len(m.Error)
+	n += 1 + l + sovStatus(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusDetailsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusDetailsResponse) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStatus(uint64(m.NodeID))
+	l = len(m.BuildTag)
 	n += 1 + l + sovStatus(uint64(l))
+	if len(m.RequestLatencies) > 0 {
+		for _, e := range m.RequestLatencies {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusStoresRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusStoresResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -274,6 +1712,327 @@ func (m *StoreStatus) MarshalTo(data []byte) (n int, err error) {
 		return 0, err
 	}
 	i += n1
+	if m.EngineStats != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintStatus(data, i, uint64(m.EngineStats.Size()))
+		n2, err := m.EngineStats.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.CorruptRaftIDs) > 0 {
+		data4 := make([]byte, len(m.CorruptRaftIDs)*10)
+		var j3 int
+		for _, num1 := range m.CorruptRaftIDs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				data4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			data4[j3] = uint8(num)
+			j3++
+		}
+		data[i] = 0x42
+		i++
+		i = encodeVarintStatus(data, i, uint64(j3))
+		i += copy(data[i:], data4[:j3])
+	}
+	if len(m.RequestLatencies) > 0 {
+		for _, msg := range m.RequestLatencies {
+			data[i] = 0x4a
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x50
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Capacity))
+	data[i] = 0x58
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Available))
+	data[i] = 0x60
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.LeaderRangeCount))
+	data[i] = 0x68
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.RaftLogSize))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EngineStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *EngineStats) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FilesAtLevel) > 0 {
+		data6 := make([]byte, len(m.FilesAtLevel)*10)
+		var j5 int
+		for _, num1 := range m.FilesAtLevel {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				data6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			data6[j5] = uint8(num)
+			j5++
+		}
+		data[i] = 0xa
+		i++
+		i = encodeVarintStatus(data, i, uint64(j5))
+		i += copy(data[i:], data6[:j5])
+	}
+	data[i] = 0x10
+	i++
+	if m.CompactionPending {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x18
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.MemtableSize))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.BlockCacheHits))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.BlockCacheMisses))
+	data[i] = 0x30
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.BlockCacheUsage))
+	data[i] = 0x38
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.ValueChecksumMismatches))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RequestLatency) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RequestLatency) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStatus(data, i, uint64(len(m.Method)))
+	i += copy(data[i:], m.Method)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Count))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.P50))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.P95))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.P99))
+	data[i] = 0x30
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Max))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RestoreStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RestoreStatus) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStatus(data, i, uint64(len(m.URI)))
+	i += copy(data[i:], m.URI)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.TotalFiles))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.RestoredFiles))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.RestoredKeys))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.StartedAt))
+	data[i] = 0x30
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.UpdatedAt))
+	data[i] = 0x38
+	i++
+	if m.Done {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x42
+	i++
+	i = encodeVarintStatus(data, i, uint64(len(m.Error)))
+	i += copy(data[i:], m.Error)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatusDetailsRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatusDetailsRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatusDetailsResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatusDetailsResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.NodeID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStatus(data, i, uint64(len(m.BuildTag)))
+	i += copy(data[i:], m.BuildTag)
+	if len(m.RequestLatencies) > 0 {
+		for _, msg := range m.RequestLatencies {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatusStoresRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatusStoresRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatusStoresResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StatusStoresResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for _, msg := range m.Stores {
+			data[i] = 0xa
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -0,0 +1,40 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package proto
+
+import "testing"
+
+// TestBlockCacheHitRate verifies the computation of the block cache
+// hit rate from hit and miss counts.
+func TestBlockCacheHitRate(t *testing.T) {
+	testCases := []struct {
+		hits, misses int64
+		expRate      float64
+	}{
+		{0, 0, 0},
+		{0, 10, 0},
+		{10, 0, 1},
+		{3, 1, 0.75},
+	}
+	for i, test := range testCases {
+		es := &EngineStats{BlockCacheHits: test.hits, BlockCacheMisses: test.misses}
+		if rate := es.BlockCacheHitRate(); rate != test.expRate {
+			t.Errorf("%d: expected hit rate %f; got %f", i, test.expRate, rate)
+		}
+	}
+}
@@ -61,6 +61,26 @@ func (fi bindata_file_info) Sys() interface{} {
 	return nil
 }
 
+var _ui_css_dashboard_css = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x95\x54\xdb\x6e\x1a\x31\x10\x7d\xdf\xaf\x18\x11\x55\x69\x23\xae\xb9\xa8\x0d\x91\x2a\x51\x92\xaa\xa8\x11\x54\x2c\x69\x94\x47\xb3\x9e\xdd\xb5\xe2\xb5\x5d\xdb\x9b\x05\x45\xfd\xf7\x8e\x17\x08\xa4\x10\xa9\x85\x97\x1d\xfb\x68\xce\x99\x33\x33\xee\x9c\x9c\x44\x43\x6d\x96\x56\x64\xb9\x87\xd3\x6e\xef\x02\x66\x39\xc2\x50\x27\x8f\x56\xb3\x24\x87\x41\xe9\x73\x6d\x5d\x3b\x8a\x6e\x45\x82\xca\x21\x87\x52\x71\xb4\xe0\x09\x36\x30\x04\x41\x58\xdf\x34\xe1\x27\x5a\x27\xb4\x82\xd3\x76\x17\xde\x07\x40\x63\x7d\xd5\xf8\x70\x15\x2d\x75\x09\x05\x5b\x82\xd2\x1e\x4a\x87\x94\x40\x38\x48\x85\x44\xc0\x45\x82\xc6\x83\x50\x90\xe8\xc2\x48\xc1\x54\x82\x50\x09\x9f\xd7\x24\xeb\x14\xed\xe8\x61\x9d\x40\xcf\x3d\x23\x2c\x23\xb4\xa1\x28\xdd\x45\x01\xf3\x51\x04\xf4\xcb\xbd\x37\xfd\x4e\xa7\xaa\xaa\x36\xab\x55\xb6\xb5\xcd\x3a\x72\x85\x72\x9d\xdb\xd1\xf0\x66\x1c\xdf\xb4\x48\x69\x14\xdd\x29\x89\xce\x81\xc5\x5f\xa5\xb0\x54\xe0\x7c\x09\xcc\x90\x8e\x84\xcd\x49\x9d\x64\x15\x68\x0b\x2c\xb3\x48\x77\x5e\x07\x9d\x95\x15\x5e\xa8\xac\x09\x4e\xa7\xbe\x62\x16\x23\x2e\x9c\xb7\x62\x5e\xfa\x57\x06\x6d\x54\x51\xa5\xbb\x00\xb2\x88\x29\x68\x0c\x62\x18\xc5\x0d\xf8\x32\x88\x47\x71\x33\xba\x1f\xcd\xbe\x4d\xee\x66\x70\x3f\x98\x4e\x07\xe3\xd9\xe8\x26\x86\xc9\x14\x86\x93\xf1\xf5\x68\x36\x9a\x8c\x29\xfa\x0a\x83\xf1\x03\x7c\x1f\x8d\xaf\x9b\x80\x64\x0f\x91\xe0\xc2\xd8\xa0\x5d\xdb\x48\x04\xeb\x90\xb7\x21\x46\x7c\x45\x9e\xea\x95\x18\x67\x30\x11\xa9\x48\xa8\x22\x95\x95\x2c\x43\xc8\xf4\x13\x5a\x45\x85\x44\x06\x6d\x21\x5c\x68\x9e\x23\x69\x1c\xa4\x28\x84\x67\xbe\x8e\xf7\xca\xd9\x52\x0c\xee\x48\xf3\x34\xae\xdb\x18\x05\x1e\xc5\x0a\x74\xa1\x27\x89\x56\xab\x72\xeb\xe1\x39\xe9\x44\x6d\xce\x5c\x3e\xd7\xcc\x72\x78\xa6\x0e\x19\xc6\x39\x11\xf7\xa1\xd7\x35\x0b\x1a\x3c\xb3\xb8\x8a\x7e\xef\x80\x5a\x0e\x93\x40\x5f\x83\xe7\x2c\x79\xcc\xac\x26\x21\xad\x44\x4b\x6d\xfb\x70\x94\xa6\xe9\x55\xb8\xd1\x96\xc4\x51\x16\x4a\xe2\xb4\x14\x1c\x8e\x38\xe7\xe1\xa6\x60\x36\x13\xaa\x35\xd7\xde\xeb\xa2\xbf\x66\xf8\x0f\xde\xcf\x90\x9f\xd5\xe4\x29\x55\xd2\xaa\x30\x2c\x48\x9f\x86\xd7\x16\x4c\x86\x44\x1e\x17\xbe\xe5\x2d\x53\x8e\xea\x26\x82\xd2\x90\x87\x09\x73\x78\x80\xbc\xb7\x4f\xe3\xeb\xd9\x7a\x7e\x29\x21\x14\x26\x99\x71\xd8\x87\xcd\x57\x48\x54\x09\xee\xf3\x90\xa0\xfb\xee\x60\x02\x9f\x37\x0f\x1c\xf2\xdd\xc4\x2f\x22\xb6\x16\x21\xe2\x2b\x2f\xce\xe9\xea\xd3\xca\x9f\xba\x2c\x26\x45\xa6\xfa\x20\x31\xf5\x6f\xb0\xee\x3b\x73\xd9\xed\xfe\x85\x2d\x55\x8e\x4c\xfa\x7c\x59\x83\x37\x8d\x4b\xf6\x70\x68\x2d\x4d\xce\x1e\xe6\x5f\x5c\xcc\x2c\x33\xb9\xa3\x8d\x34\xa5\x7f\x91\xd4\xa7\x98\x76\x43\xf8\x43\xe0\x1a\x45\xbb\x68\x24\x5b\x06\xa0\x14\x0a\x5b\x73\x49\xef\xdd\x96\x71\x67\x38\x56\x5f\xb5\x1a\x5a\x15\x4f\x4f\x82\xdc\xb8\xe3\xb5\x39\x48\x40\x83\x73\xbe\xb5\x27\x65\x85\x90\xc4\x74\x1c\xeb\xd2\x26\xe1\x65\xe5\x08\x3f\xac\x3e\x6e\xc2\xf1\x90\x8e\x04\xad\xd6\x18\x2b\x0a\xd7\x51\x13\x0a\xad\xb4\xa3\x37\xab\xee\xd1\xc1\xe9\x3b\xc0\xea\x9e\xb2\x37\x57\xe5\x32\xfc\xdf\xd8\x96\x7a\x14\x0e\xe4\x33\x5a\x2e\x83\x37\xab\x4a\x84\x94\x81\x5d\xd5\x92\xe8\x1d\xd3\x8f\x34\xa7\x47\x67\xec\x23\xc7\xde\xf6\xa8\xb5\x99\xd6\xf6\x45\xc8\xf9\x07\x3a\x7e\x43\x17\x5c\x06\x00\x00")
+
+func ui_css_dashboard_css_bytes() ([]byte, error) {
+	return bindata_read(
+		_ui_css_dashboard_css,
+		"ui/css/dashboard.css",
+	)
+}
+
+func ui_css_dashboard_css() (*asset, error) {
+	bytes, err := ui_css_dashboard_css_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "ui/css/dashboard.css", size: 1628, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _ui_css_main_css = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x54\xdf\x6f\xdb\x36\x10\x7e\xe7\x5f\x71\x48\x31\xe4\x07\x2c\xdb\x71\x32\x60\xb0\x5f\xa6\xba\x19\x62\xb4\x50\x86\xc8\x59\xd1\x47\x4a\x3a\x49\x44\x28\x92\x23\x29\xcb\xee\xb0\xff\x7d\x47\x49\xf6\xe2\xb5\x5d\x1e\x02\xf3\xee\xc3\xdd\xf7\x7d\x77\xa7\xd9\xcd\x0d\x5b\x6b\x73\xb0\xa2\xaa\x3d\x2c\xe6\xb7\xf7\xb0\xad\x11\xd6\x3a\x7f\xb5\x9a\xe7\x35\xc4\xad\xaf\xb5\x75\x53\xc6\x3e\x89\x1c\x95\xc3\x02\x5a\x55\xa0\x05\x4f\xb0\xd8\x10\x04\x61\xcc\x4c\xe0\x0f\xb4\x4e\x68\x05\x8b\xe9\x1c\xae\x02\xe0\x62\x4c\x5d\x5c\xaf\xd8\x41\xb7\xd0\xf0\x03\x28\xed\xa1\x75\x48\x05\x84\x83\x52\x48\x04\xdc\xe7\x68\x3c\x08\x05\xb9\x6e\x8c\x14\x5c\xe5\x08\x9d\xf0\x75\xdf\x64\x2c\x31\x65\x5f\xc6\x02\x3a\xf3\x9c\xb0\x9c\xd0\x86\x5e\xe5\x5b\x14\x70\xcf\x18\xd0\x5f\xed\xbd\x59\xce\x66\x5d\xd7\x4d\x79\xcf\x72\xaa\x6d\x35\x93\x03\xca\xcd\x3e\x6d\xd6\x0f\x49\xfa\x10\x11\x53\xc6\x5e\x94\x44\xe7\xc0\xe2\x9f\xad\xb0\x24\x30\x3b\x00\x37\xc4\x23\xe7\x19\xb1\x93\xbc\x03\x6d\x81\x57\x16\x29\xe7\x75\xe0\xd9\x59\xe1\x85\xaa\x26\xe0\x74\xe9\x3b\x6e\x91\x15\xc2\x79\x2b\xb2\xd6\x9f\x19\x74\x64\x45\x4a\xdf\x02\xc8\x22\xae\xe0\x22\x4e\x61\x93\x5e\xc0\xfb\x38\xdd\xa4\x13\xf6\x79\xb3\x7d\x7c\x7a\xd9\xc2\xe7\xf8\xf9\x39\x4e\xb6\x9b\x87\x14\x9e\x9e\x61\xfd\x94\x7c\xd8\x6c\x37\x4f\x09\xbd\x7e\x83\x38\xf9\x02\x1f\x37\xc9\x87\x09\x20\xd9\x43\x4d\x70\x6f\x6c\xe0\xae\x2d\x13\xc1\x3a\x2c\xa6\x90\x22\x9e\x35\x2f\xf5\x40\xc6\x19\xcc\x45\x29\x72\x52\xa4\xaa\x96\x57\x08\x95\xde\xa1\x55\x24\x84\x19\xb4\x8d\x70\x61\x78\x8e\xa8\x15\x20\x45\x23\x3c\xf7\xfd\xfb\x1b\x39\xff\xb6\x88\x5f\x88\xf3\x73\xda\x8f\x91\x85\x3e\x8a\x37\xe8\xc2\x4c\x72\xad\x06\xb9\xc3\xf2\x0c\x6b\xb4\x84\x58\x15\x16\x3b\x78\xaf\xd5\x0e\x09\x80\x70\x45\xdd\x0e\x19\xb5\xf9\xb5\x6a\xb8\x90\x53\xda\x80\x6b\x76\x33\x63\x37\xf0\x17\xcd\xb1\xe1\xb6\x12\x6a\x09\xf3\x15\x3d\x0c\x2f\x0a\xe2\x3a\xbe\xa2\x46\x7f\x8d\x32\xbd\x8f\x9c\xf8\xda\x47\x33\x6d\x89\x67\x08\xf5\xe9\x0e\xb3\x57\xe1\xff\x07\xf1\xa3\xcc\xdf\xac\xf6\x8d\x9c\xb0\x4c\x17\x87\x9e\x44\x8d\xe1\x38\x96\x70\x3b\x9f\xff\x14\xd2\xa7\x44\xc6\xf3\xd7\xca\x6a\xf2\x27\xca\xb5\x0c\xf2\xde\x95\xb7\xe5\xa2\xbc\x0b\xe5\x8f\x91\xbb\xbb\xfe\x59\x92\x1f\x51\xc9\x1b\x21\x0f\x4b\xb8\x4c\x75\x6b\x69\xc7\x53\x4e\xf6\xfe\x6e\xf5\xe5\x04\x2e\x1f\x51\xee\xd0\xd3\xce\x41\x82\x2d\x52\xe4\x14\xa0\x2d\x23\x5c\xe4\xd0\x8a\xf2\x54\xaa\x1b\x49\xdd\xcf\xe7\xa7\x18\xa9\x41\xa2\x79\x6f\x7a\x15\xd3\xb2\x95\xf2\xb1\x87\xad\x29\x4b\x27\x43\x53\x7c\xab\x27\xe7\x32\xbf\x0a\xa2\x20\x82\x9f\xe7\x66\x7f\xbd\x82\xd9\x0d\x7c\x44\x34\x61\xc5\x25\xe1\x87\x23\x1c\xf0\x61\xa8\x74\x4a\x26\xe1\x3b\xa0\xf9\x50\xfd\xf1\xf1\xb6\x64\x28\xb3\xfa\x81\x33\x65\x39\xb8\x3e\x3a\xed\xbd\x6e\x88\xac\xd9\xd3\x0d\x49\x51\xc0\xbb\xa2\x28\xce\xa6\x7c\x4b\xb5\xe8\x8b\x34\x8a\x19\x9a\x45\xc4\xea\x75\x19\xfe\x4d\xce\x43\x3b\xe1\x44\xb8\xac\x40\xc6\xe3\xde\x47\x05\xe6\xda\xf6\x0b\xbc\xa4\x2f\x8e\xc2\xd5\x31\xe1\x2d\x99\x49\xab\x4a\xcd\x5b\x43\x6b\x9f\x73\x87\xdf\x99\x17\x9d\xab\x91\x9c\x66\x25\x54\x70\x22\xca\x24\x7d\x12\x57\xa7\x95\x8c\xec\x20\xf8\x7b\xfc\x8e\xbf\x6b\xdd\x60\x42\xf7\xd0\x93\x3a\x1b\xda\x2f\xdf\x0c\x6d\xb1\x18\x8c\xfb\x2f\xc5\x23\x75\xba\xd3\xb0\x0a\x32\xe2\x52\x54\x24\x29\x5a\x0c\x7d\xff\x09\x00\x00\xff\xff\xbf\xd9\x39\x3d\xc0\x05\x00\x00")
 
 func ui_css_main_css_bytes() ([]byte, error) {
@@ -101,7 +121,7 @@ func ui_css_rest_explorer_css() (*asset, error) {
 	return a, nil
 }
 
-var _ui_index_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x55\x51\x6f\xdb\x36\x10\x7e\xd7\xaf\xb8\x6a\x0f\x6e\x50\x4b\x72\xbb\x02\xdb\x32\xdb\xab\xea\x78\xa8\xb1\xc2\x1e\x2c\x67\x45\x9f\x06\x5a\x3a\x49\x4c\x28\x52\x23\x29\x2b\x02\xf6\xe3\x77\x94\xe4\x34\xc9\x32\x20\x0f\xcb\x4b\x44\xf2\xe3\xdd\x77\xdf\x7d\x47\xcf\x5f\x05\x81\xb7\x52\x75\xa7\x79\x51\x5a\x78\x37\x7b\xfb\x1e\x0e\x25\xc2\x4a\xa5\xb7\x5a\xb1\xb4\x84\xb8\xb1\xa5\xd2\x26\xf4\xbc\xcf\x3c\x45\x69\x30\x83\x46\x66\xa8\xc1\x12\x2c\xae\x09\x82\x30\x9e\x4c\xe1\x0f\xd4\x86\x2b\x09\xef\xc2\x19\xbc\x76\x00\x7f\x3c\xf2\x2f\x7e\xf6\x3a\xd5\x40\xc5\x3a\x90\xca\x42\x63\x90\x02\x70\x03\x39\x17\x08\x78\x97\x62\x6d\x81\x4b\x48\x55\x55\x0b\xce\x64\x8a\xd0\x72\x5b\xf6\x49\xc6\x10\xa1\xf7\x75\x0c\xa0\x8e\x96\x11\x96\x11\xba\xa6\x55\xfe\x10\x05\xcc\x7a\x1e\xd0\x5f\x69\x6d\x7d\x19\x45\x6d\xdb\x86\xac\x67\x19\x2a\x5d\x44\x62\x40\x99\xe8\xf3\x66\xb5\xde\x26\xeb\x80\x98\x7a\xde\xb5\x14\x68\x0c\x68\xfc\xab\xe1\x9a\x0a\x3c\x76\xc0\x6a\xe2\x91\xb2\x23\xb1\x13\xac\x05\xa5\x81\x15\x1a\xe9\xcc\x2a\xc7\xb3\xd5\xdc\x72\x59\x4c\xc1\xa8\xdc\xb6\x4c\xa3\x97\x71\x63\x35\x3f\x36\xf6\x91\x40\x67\x56\x54\xe9\x43\x00\x49\xc4\x24\xf8\x71\x02\x9b\xc4\x87\x8f\x71\xb2\x49\xa6\xde\x97\xcd\xe1\xd3\xee\xfa\x00\x5f\xe2\xfd\x3e\xde\x1e\x36\xeb\x04\x76\x7b\x58\xed\xb6\x57\x9b\xc3\x66\xb7\xa5\xd5\xaf\x10\x6f\xbf\xc2\x6f\x9b\xed\xd5\x14\x90\xe4\xa1\x24\x78\x57\x6b\xc7\x5d\x69\x8f\x3b\xe9\x30\x0b\x21\x41\x7c\x94\x3c\x57\x03\x19\x53\x63\xca\x73\x9e\x52\x45\xb2\x68\x58\x81\x50\xa8\x13\x6a\x49\x85\x78\x35\xea\x8a\x1b\xd7\x3c\x43\xd4\x32\x10\xbc\xe2\x96\xd9\x7e\xfd\xaf\x72\xbe\xa5\x88\xaf\x89\xf3\x3e\xe9\xdb\xe8\xb9\x3c\x92\x55\x68\x5c\x4f\x52\x25\x87\x72\x07\xf3\x0c\x36\xba\x84\x58\x66\x1a\x5b\xf8\xa8\xe4\x09\x09\x80\xf0\x9a\xb2\x75\x47\x4a\xf3\xa1\xa8\x18\x17\x21\x39\xe0\xc2\x0b\x82\xa5\x37\x7f\x95\xa9\xd4\x76\x35\x52\x2b\x2b\x41\x6b\xf7\x0f\x64\x11\x50\x6b\x16\x7e\x7a\x36\xa8\xbf\xa4\x76\xcf\x4b\x64\xd9\xb2\xef\xfb\xbc\x42\x4b\xce\x28\x99\x36\x68\x17\x7e\x63\xf3\xe0\x47\x7f\x3c\x12\x5c\xde\x42\xa9\x31\x5f\x4c\x46\x7b\xe4\xc4\xd2\x84\x85\x52\x85\x40\x56\x73\xe3\xd2\x47\xa9\x31\xbf\xe4\xac\xe2\xa2\x5b\x24\xaa\xd1\x29\xbe\x49\x98\x34\x6f\x7e\xd7\xea\xf2\xfd\x6c\x36\xfd\x69\x36\xfb\x7b\xdc\x5f\xa9\x0c\xdd\xfe\x84\xbc\x23\x16\x13\x63\x3b\x32\x52\x89\x68\x27\xe0\x98\x2f\x26\x16\xef\xac\x8b\x37\x79\xc8\xc0\x61\xfd\x6f\x58\x7f\xa0\xe4\x3b\x5c\x44\x1a\xc8\x90\x3e\xfc\x97\x5e\xa0\xee\xdb\x3f\xc9\x05\x42\x69\xd4\xc3\x4d\x70\x53\x0d\x87\xdd\xd5\xee\x5e\xdc\x8b\x4b\xf8\x40\xfe\x50\xda\x06\x82\xdf\x22\x1c\xb1\x64\x27\x4e\xfd\x72\x4a\xf7\x89\x4c\xaa\x39\xcd\xa0\xd1\xe9\xc2\x77\xe2\x18\x52\x87\xdd\xb0\xbb\xa7\xe2\xb8\x3d\x1a\xa3\xa3\x89\x9c\x89\x04\xd3\x37\x26\x7a\x1b\x7e\x1f\xfe\x70\x5e\x87\x15\x55\x70\x43\x34\xe6\xd1\x10\xf3\xff\x4e\x10\x68\x45\x43\xf4\x92\x34\xd1\xcd\x28\xe8\x0b\x70\xbd\x5f\x95\x10\xf4\x82\x3d\xd1\xf4\xd1\xe5\xe7\xb5\x75\x25\x84\xe3\xf3\xf1\x5f\x02\x5b\x6e\x05\x2e\xef\x1f\xd6\x79\x34\x6c\x38\xf7\x46\x67\xfb\xce\x8f\x2a\xeb\x46\xbc\xdb\xa3\xb1\x4b\x05\x33\x66\xe1\x93\xe9\xb7\xec\x34\xba\x82\x4e\xd9\xe8\x81\xef\x22\xff\x31\x24\xe8\x1d\x33\x7e\x97\xaa\xc2\x2d\x4d\xa4\xff\x30\x2f\x7b\x26\x88\xab\x38\x38\x57\xfc\x5c\x44\x7f\xb9\x5f\x27\x07\x58\x8f\x90\xfb\x28\x03\x77\xd4\xe3\x2a\xe3\xa7\xf3\xe5\xbc\x11\xe2\x13\xba\x9f\x95\x15\x49\x4b\x5d\x70\x81\x69\x7c\x4f\x1c\x5b\xd2\x93\x90\x43\xed\x43\xc9\x14\xa7\x9f\xf2\x7f\x02\x00\x00\xff\xff\xef\x5c\x56\x02\x91\x06\x00\x00")
+var _ui_index_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x55\xc1\x8e\xdb\x36\x10\xbd\xeb\x2b\x26\xea\xc1\x59\xc4\x92\x9c\x34\x40\xdb\xad\xed\x46\xf1\xba\x88\xd1\xc0\x2e\x2c\x6f\x83\x9c\x0a\x4a\x1a\x49\xdc\xa5\x48\x85\xa4\xec\x15\xd0\x8f\xef\xd0\x92\x37\x76\xbb\x05\x36\x40\x7d\xb1\x49\xbe\x99\x79\x33\xef\x91\x9e\xbe\x08\x02\x6f\xa1\x9a\x4e\xf3\xb2\xb2\xf0\x66\xf2\xfa\x2d\xec\x2a\x84\x85\xca\xee\xb5\x62\x59\x05\x71\x6b\x2b\xa5\x4d\xe8\x79\x1f\x79\x86\xd2\x60\x0e\xad\xcc\x51\x83\x25\x58\xdc\x10\x04\x61\x38\x19\xc3\x1f\xa8\x0d\x57\x12\xde\x84\x13\x78\xe9\x00\xfe\x70\xe4\x5f\xfd\xec\x75\xaa\x85\x9a\x75\x20\x95\x85\xd6\x20\x25\xe0\x06\x0a\x2e\x10\xf0\x21\xc3\xc6\x02\x97\x90\xa9\xba\x11\x9c\xc9\x0c\xe1\xc0\x6d\x75\x2c\x32\xa4\x08\xbd\xcf\x43\x02\x95\x5a\x46\x58\x46\xe8\x86\x56\xc5\x39\x0a\x98\xf5\x3c\xa0\x4f\x65\x6d\x73\x1d\x45\x87\xc3\x21\x64\x47\x96\xa1\xd2\x65\x24\x7a\x94\x89\x3e\xae\x16\xcb\x75\xb2\x0c\x88\xa9\xe7\xdd\x4a\x81\xc6\x80\xc6\x2f\x2d\xd7\xd4\x60\xda\x01\x6b\x88\x47\xc6\x52\x62\x27\xd8\x01\x94\x06\x56\x6a\xa4\x33\xab\x1c\xcf\x83\xe6\x96\xcb\x72\x0c\x46\x15\xf6\xc0\x34\x7a\x39\x37\x56\xf3\xb4\xb5\x17\x03\x3a\xb1\xa2\x4e\xcf\x01\x34\x22\x26\xc1\x8f\x13\x58\x25\x3e\xbc\x8f\x93\x55\x32\xf6\x3e\xad\x76\x1f\x36\xb7\x3b\xf8\x14\x6f\xb7\xf1\x7a\xb7\x5a\x26\xb0\xd9\xc2\x62\xb3\xbe\x59\xed\x56\x9b\x35\xad\x7e\x85\x78\xfd\x19\x7e\x5b\xad\x6f\xc6\x80\x34\x1e\x2a\x82\x0f\x8d\x76\xdc\x95\xf6\xb8\x1b\x1d\xe6\x21\x24\x88\x17\xc5\x0b\xd5\x93\x31\x0d\x66\xbc\xe0\x19\x75\x24\xcb\x96\x95\x08\xa5\xda\xa3\x96\xd4\x88\xd7\xa0\xae\xb9\x71\xe2\x19\xa2\x96\x83\xe0\x35\xb7\xcc\x1e\xd7\xff\x6a\xe7\x6b\x89\xf8\x96\x38\x6f\x93\xa3\x8c\x9e\xab\x23\x59\x8d\xc6\x69\x92\x29\xd9\xb7\xdb\x9b\xa7\xb7\xd1\x35\xc4\x32\xd7\x78\x80\xf7\x4a\xee\x91\x00\x08\x2f\xa9\x5a\x97\x52\x99\x77\x65\xcd\xb8\x08\xc9\x01\x57\x5e\x10\xcc\xbd\xe9\x8b\x5c\x65\xb6\x6b\x90\xa4\xac\x05\xad\xdd\x17\xc8\x32\x20\x69\x66\x7e\x76\x32\xa8\x3f\x27\xb9\xa7\x15\xb2\x7c\x7e\xd4\x7d\x5a\xa3\x25\x67\x54\x4c\x1b\xb4\x33\xbf\xb5\x45\xf0\xa3\x3f\x1c\x09\x2e\xef\xa1\xd2\x58\xcc\x46\x83\x3d\x0a\x62\x69\xc2\x52\xa9\x52\x20\x6b\xb8\x71\xe5\xa3\xcc\x98\x5f\x0a\x56\x73\xd1\xcd\x12\xd5\xea\x0c\x5f\x25\x4c\x9a\x57\xbf\x6b\x75\xfd\x76\x32\x19\xff\x34\x99\xfc\x35\xec\x2f\x54\x8e\x6e\x7f\x44\xde\x11\xb3\x91\xb1\x1d\x19\xa9\x42\xb4\x23\x70\xcc\x67\x23\x8b\x0f\xd6\xe5\x1b\x9d\x33\x70\x58\xff\x2b\xd6\xef\x29\xf9\x0e\x17\xd1\x0c\x64\x48\x3f\xfc\xe7\x06\xe4\xcc\x54\xa9\x62\x3a\xff\xa6\x28\xf2\x8c\xfd\x93\xbc\x23\x94\x46\xdd\x47\xc2\x94\xde\x02\xd8\x6d\x6e\x36\x8f\x92\x5c\x5d\xc3\x3b\x72\x95\xd2\x36\x10\xfc\x1e\x21\xc5\x8a\xed\x39\xa9\xec\xf4\x39\x16\x32\x99\xe6\x74\x73\x8d\xce\x66\xbe\x1b\xa9\xa1\x99\xb2\x3b\xf6\xf0\xcf\x91\xba\x3d\xba\x7c\xa9\x89\x9c\xf5\x04\xd3\x77\x26\x7a\x1d\x7e\x1f\xfe\x70\x5a\x87\x35\xf5\x7d\x47\x34\xa6\x51\x9f\xf3\xff\x2e\x10\x68\x45\x57\xef\x39\x65\xa2\xbb\x41\x86\x67\xe0\x8e\x2e\x57\x42\xd0\xbb\x77\xa6\xc4\x37\x06\x5e\x8a\x71\x11\xfc\xb4\x28\xae\xf7\x70\x78\xad\xfe\x4b\x19\xcb\xad\xc0\xf9\xe3\x3b\x3e\x8d\xfa\x0d\x77\x59\xa2\xd3\x6d\x99\xa6\x2a\xef\x06\xbc\xdb\xa3\x5b\x9e\x09\x66\xcc\xcc\xa7\x3b\xb6\x66\xfb\xc1\x4e\x74\xca\x06\xf3\x7c\x17\xf9\x97\x90\xe0\x68\xb5\xe1\x77\xa5\x6a\x5c\xd3\x03\xe0\x9f\xd7\x65\xcf\x4c\xe2\xcf\x6f\x4e\x03\x7c\x3a\xc8\x8d\x29\x38\x8d\xe9\xe9\x0c\xdb\x65\xb2\x83\xe5\x00\x79\xcc\xd2\x37\x8c\x7a\x58\xe5\x7c\x7f\x0a\x2e\x5a\x21\x3e\xa0\xfb\xeb\x5b\x90\x1e\xa4\xb9\x4b\x4c\x4f\xcc\x9e\xe3\x81\x44\x20\x64\x3f\xb0\x7e\x4e\x94\xe7\xf8\x12\xfd\x0d\xbf\x4e\xde\xfd\x35\x07\x00\x00")
 
 func ui_index_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -116,7 +136,27 @@ func ui_index_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "ui/index.html", size: 1681, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	info := bindata_file_info{name: "ui/index.html", size: 1845, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _ui_js_controllers_dashboard_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8d\x57\x6d\x6f\xdb\xb6\x16\xfe\xde\x5f\x71\x52\x14\x93\x7c\xab\xc8\x49\xb1\xdd\x0f\xe9\xb2\xc1\x4d\xd3\xce\xd8\x96\x0c\x71\xba\x62\x08\x82\x80\x91\x68\x99\x9b\x2c\x6a\x24\x15\xc7\xeb\xf2\xdf\x77\x0e\x49\x49\x94\xec\xec\x5e\xb7\x88\x24\xf2\xf0\xbc\x3c\xe7\x95\xd3\x29\x9c\xc9\x7a\xab\x44\xb1\x32\xf0\xe6\xe8\xf8\x1b\xb8\x5e\x71\x5c\xca\xfe\x50\x92\x65\x2b\x98\x35\x66\x25\x95\x4e\x5f\x4c\xa7\xf8\x1f\x7e\x12\x19\xaf\x34\xcf\xa1\xa9\x72\xae\xc0\x20\xed\xac\x46\x3a\xde\xee\x24\xf0\x2b\x57\x5a\xc8\x0a\xde\xa4\x47\x10\x13\xc1\x4b\xbf\xf5\x72\xf2\x96\x58\x6c\x65\x03\x6b\xb6\x85\x4a\x1a\x68\x34\x47\x1e\x42\xc3\x52\x94\x1c\xf8\x63\xc6\x6b\x03\xa2\x82\x4c\xae\xeb\x52\xb0\x2a\xe3\xb0\x11\x66\x65\xe5\x78\x2e\xa4\x09\xfc\xe6\x79\xc8\x7b\xc3\x90\x9c\xe1\x81\x1a\xbf\x96\x21\x21\x30\xe3\x95\xa6\xdf\xca\x98\xfa\x64\x3a\xdd\x6c\x36\x29\xb3\x0a\xa7\x52\x15\xd3\xd2\x91\xea\xe9\x4f\xf3\xb3\xf3\x8b\xc5\xf9\x21\x2a\xed\x0f\x7d\xaa\x4a\xae\x35\x28\xfe\x67\x23\x14\x1a\x7c\xbf\x05\x56\xa3\x52\x19\xbb\x47\x55\x4b\xb6\x01\xa9\x80\x15\x8a\xe3\x9e\x91\xa4\xf4\x46\x09\x23\xaa\x22\x01\x2d\x97\x66\xc3\x14\x27\x36\xb9\xd0\x46\x89\xfb\xc6\x0c\x30\x6b\x55\x44\xcb\x43\x02\x44\x8d\x55\xf0\x72\xb6\x80\xf9\xe2\x25\xbc\x9b\x2d\xe6\x8b\x84\x98\x7c\x9e\x5f\xff\x70\xf9\xe9\x1a\x3e\xcf\xae\xae\x66\x17\xd7\xf3\xf3\x05\x5c\x5e\xc1\xd9\xe5\xc5\xfb\xf9\xf5\xfc\xf2\x02\xbf\x3e\xc0\xec\xe2\x37\xf8\x71\x7e\xf1\x3e\x01\x8e\x88\xa1\x1c\xfe\x58\x2b\xb2\x40\x2a\x62\x21\x08\x50\x9e\xa7\xb0\xe0\x7c\xa0\xc2\x52\x3a\x95\x74\xcd\x33\xb1\x14\x19\x9a\x56\x15\x0d\x2b\x38\x14\xf2\x81\xab\x0a\x2d\xa2\xf3\x35\x57\x6b\xa1\xc9\xb1\x1a\x75\xcc\xa1\x14\x6b\x61\x98\xb1\xdf\x3b\x76\xf5\x52\x66\x9f\x50\xf3\xab\x85\xf5\x2f\xb1\x21\x69\x15\x5b\x73\x4d\xce\xca\x64\xe5\x4c\xb7\x01\xf6\xe2\x81\x29\xc8\xd4\xac\xae\xe1\x14\x48\x87\x92\xa9\x74\x2d\xf3\xa6\xe4\x71\x94\xb5\x01\x19\x61\x10\x11\x23\x8a\x52\xcd\x95\x40\x4e\x85\x62\xf5\xca\x79\x28\xe7\x4b\xd6\x94\x26\x41\xb7\x65\x52\xe5\xb8\xb8\x54\x72\x0d\x9c\x22\xb9\x92\x39\x8f\xd0\xa1\x4d\x65\xc4\xda\x2a\xa3\x19\x82\xc2\x75\x0a\x97\x68\x1d\x23\x2d\x6c\x50\xb1\x1c\x3d\x41\x10\x6a\x62\x49\xda\xa6\x56\x37\xcf\x7c\xe1\xa4\x9e\xc2\x4d\x94\xa9\x94\xb8\xa6\x7a\xab\xd3\x42\x2a\xd9\xa0\xff\xb9\x8e\x12\x18\xec\xac\x38\xab\x53\x56\x96\x32\x8b\x6e\x7b\xe5\x45\x65\xb8\x7a\x60\x25\x86\x29\x6c\x56\x22\x73\x41\x9e\x33\xbd\xba\x97\x4c\xe5\x14\x1a\x8a\x2f\xd1\x83\x68\x5a\x42\xe1\xb5\x16\x65\x29\x34\xda\x55\xe5\xda\x29\xe4\xf7\xe7\x2d\xa7\x53\x38\x3e\xc2\x1f\xca\xb0\x30\xa6\x16\x5f\x59\x96\x5c\xc5\xd1\xfb\x96\xf1\x99\x51\x25\x6a\x78\x13\xbd\xd2\x98\x34\x9c\x94\x7d\x45\xc9\x61\x5f\x5a\xa5\xa2\xe4\x05\x25\xcd\xb2\xa9\x32\x72\x71\x6c\x49\x13\x9b\x44\x49\xa7\xf9\x04\xbe\x20\x95\xdd\xb2\xc6\x5a\x4c\xd0\xc2\x76\x4d\x23\xa2\x3b\x8b\xd6\x59\xe3\x45\xae\x14\x81\x3f\x3a\xde\xe2\x3c\xc0\x3d\xd5\x94\xb3\xf1\x64\xc4\xf1\xb3\xc8\xb1\x4c\x9c\xc2\xd7\x64\xfe\x60\xe7\x07\x6e\x4b\x1b\x62\xf3\x86\x90\x69\xf7\x30\x10\xd7\xcc\xbc\xdb\x1a\x2b\xa2\x33\xf4\x9e\x16\x9c\x61\x00\x84\x71\x53\x09\xe3\x9c\xfd\x8e\x10\xfa\x51\xd8\xc7\xcf\xee\xf1\xd1\x3d\xae\xf1\x61\x15\x77\x47\x04\x92\x1f\xb9\x4f\x74\x2c\x16\x0b\xc7\x15\xbe\x23\xff\xbc\xf9\x1a\xbe\xfa\x0a\x49\xbe\x75\x9c\xd3\x92\x57\x05\x6a\x7e\x08\xc7\xad\x54\x00\x47\x3e\x75\xe4\x6f\xfd\xa2\x78\xfd\xda\xbd\x3e\xd9\xbf\x8a\x9b\x46\x55\x10\xa3\x30\x94\x06\xdf\xfb\x43\x27\xee\x99\x1a\xf9\x41\x3c\xf2\x3c\x3e\x9e\x4c\xe0\x35\x44\xf8\xef\xb5\x13\x78\x23\xac\xa6\x4f\x16\x0a\xd2\xd6\x62\xff\xa1\x0a\x41\xe0\x55\x5e\x4b\xf4\x72\xab\x91\x97\xd5\xed\xe7\xcc\x30\xac\x72\x98\xfe\x8d\xee\xb5\x0e\x5d\x99\xd6\x8d\x5e\xc5\x37\xd1\xc7\xf3\x6b\x40\x84\x5a\x86\x88\xd5\x09\x7d\xbb\xa3\x09\xe9\x95\x00\x71\xbb\x4d\x7f\xc7\xfd\x38\x8a\x26\x13\x6f\x64\xaf\x24\xe6\x8b\x0f\xf4\x85\x0b\xa8\x35\x57\x05\x3e\x28\x5d\x32\xaa\xe5\x58\x75\xa9\x0a\x48\xac\x4e\x35\x66\x7c\xd7\x30\x28\x26\xb1\x4e\x69\xe3\x98\xa0\x7c\xe9\x2a\x1d\xb1\xf1\x3a\x70\xdd\x97\x0a\xcc\x31\x97\x82\x86\x59\x92\xd4\x23\x34\x94\x1e\xe0\xd4\xb2\x08\xe3\x25\xd0\xe8\x14\xbe\x3c\x39\x6b\xda\x6a\x86\x41\x77\x8e\xa5\x28\x0e\x72\x26\xe9\xd9\xd1\x77\x0f\xe7\xf8\x8c\x2b\x27\x56\x87\x24\x54\x01\x17\xfa\x43\x10\xc8\xbf\x71\x46\x88\xfc\x16\x35\xb1\xef\x6d\x28\x3d\xb5\x20\xfb\xe7\x28\x5d\x5b\xb3\xd2\x35\xab\xe3\x91\xb1\xbd\xa8\xc0\xd8\x2d\x1e\x1a\xc8\x25\x4a\xc7\xef\x8e\xc4\xff\xfd\x37\x7c\x69\x49\x4f\xe0\x28\x01\xf6\xc0\x44\x49\x7d\x14\xbf\x9e\xde\x86\x0c\xa5\x52\x4d\x6d\x3a\x25\x52\xbf\x70\xa7\xd8\xd2\x20\x2f\x4d\xbc\x6e\x6e\xdb\x23\x3e\x30\x7b\xeb\x45\x7e\x02\x23\xf1\x49\xb7\x49\x08\xce\xdf\x77\x04\xf4\x39\xd8\xef\x55\x6c\xdf\xd2\xf6\xa5\x27\x0a\x54\xef\xa8\xba\xb5\x9e\x0c\xf1\xcb\x7f\xe1\x0a\xbb\xa1\xd9\xc3\x0e\xbe\xa3\x94\xed\x88\xe9\x87\x95\x1b\xfe\x03\xf1\x2e\xe9\xe1\x1e\x39\x13\x98\xee\x61\x4a\xd0\x76\x3c\x15\xc6\x0f\x3f\x93\x0d\xc9\xf7\x06\xdb\xa5\xbb\x8c\xd6\x7a\xba\x52\x3c\x70\x5b\x05\x03\xe0\x18\xd5\x25\x5c\xbf\xb3\xb5\xa4\xa7\xc5\x46\x56\x9a\x15\xe1\xe3\xbc\xd2\x16\x2f\xaa\x40\x63\xaa\xbd\x44\x58\xa6\x22\xf9\x47\x04\x3b\x9b\x54\xa2\x5a\xdf\x2b\x6e\xa7\x2c\x1d\x05\x68\xd6\x98\x94\x3c\x9f\xf5\xb6\xf8\x95\x3b\x6c\x9e\x53\x38\xe6\xff\x6d\x23\x3b\x0c\xec\xae\x7c\xd8\x3e\x40\xd3\x06\xce\x33\x46\x77\x49\x6e\x4b\x92\x1d\x44\x58\x3b\x4a\x74\x35\x22\xd8\xab\x1c\x93\xc5\xaf\x1f\x71\xb5\xdc\x96\xd8\xe2\x31\x63\x58\xe9\xe6\x3e\x5b\x50\xc4\x5f\xbc\x1d\x3e\xad\xac\xb6\x72\x38\xc1\x41\xc5\xa0\x61\x22\x09\x84\x87\x95\xa3\xa0\x82\x41\x04\x27\xe0\xc8\x1c\x09\x16\x4c\x2c\x92\x99\xf3\x64\x7f\xd2\x23\xe7\x0d\x16\x4b\x88\x77\xf6\x2c\xe4\x7d\xc2\xfa\x64\x29\xc2\x2e\x42\x72\xb1\x93\x37\xae\xcf\xf6\x0c\x06\x99\x9f\xd7\xc8\xa4\x3d\x9e\xd7\xe9\xb2\x94\xcc\xdc\xd9\x63\x6f\xbb\x1a\x52\xa4\x6b\x41\x4d\xe4\x67\x66\x56\xf4\x9a\xd2\xb0\xbc\x8d\xab\xa6\x2c\x13\x2f\xa2\xa7\x64\x8f\x1d\x25\x7b\xfc\x37\xca\x12\x2b\x31\x92\xba\xd5\x1b\xf7\x08\x5a\x66\xd0\x74\x97\x42\x59\xd2\xde\x88\x9b\xa3\xdb\x94\x26\x3d\x8c\x98\x75\x7d\x57\xb1\x4a\xea\x9e\x5c\xd7\x8c\xb4\x0d\x40\xbb\xd9\xc5\x8f\x24\x8c\x59\xe0\xa2\x15\x35\xa1\x4a\x74\xdc\x33\xb4\xc9\x45\x1c\x9d\x79\x87\x0e\x90\x90\xaa\x48\x7d\x50\xfd\x1b\xd2\x38\x5d\x0d\x4b\x2c\x21\xb5\x33\xe7\x60\xa1\x40\x3f\x3c\xab\xd9\xd4\x9a\x17\xd6\xd5\xed\x90\x8b\x9f\x89\x90\xcd\x31\x9e\x8a\x3d\xbc\xe2\xb6\x57\x7b\xea\x0c\x9a\x8c\x2a\xed\x63\x30\x58\x50\xd2\x26\x34\x57\x6c\x83\xc5\x36\xff\x7c\x3f\x87\xc8\xaf\x84\xc1\xd7\xcf\x1e\xbe\xb3\x7e\x6c\x67\xc2\x0e\x88\x41\x5e\x0c\x27\xc6\xe7\xba\xa9\xcb\xe0\x64\x9c\x6b\x23\x38\xdb\x49\x04\xd9\x45\x53\xa3\xa7\x7f\x36\x5c\x6d\xbf\x27\xd2\x53\x32\x85\x57\x19\x76\x85\x4f\x57\xf3\x33\xbc\x80\xca\x0a\xab\xb7\x65\xd3\xc1\x40\x03\x70\x5a\x70\xd3\x8f\x48\xa9\x6e\xb2\x0c\xaf\x59\xf1\x60\x3a\x0a\x3b\xb2\xd3\x9f\xd0\x3d\x75\xef\x41\x15\x48\x83\x3a\x64\x3b\x5b\x27\x69\x67\x5e\x76\x2f\x29\x5e\xa4\x70\xfa\xee\xa5\x15\x41\x6e\x1e\x1c\x14\x7d\x42\x5a\x2f\xd8\x69\x2c\xf6\x23\x5e\xaf\xf4\xa8\xff\x53\xf5\x08\x41\xdc\x5f\x3f\xf6\xce\xef\xae\x90\xec\x78\x74\x9f\x2f\xf7\x8e\xfa\x01\xa4\xd1\xf4\xce\x15\xf7\xa9\x1d\x8c\xa6\xd1\xff\xc4\x76\x78\xf5\xb0\x78\xda\x8f\x1d\x6f\xf5\xac\xdd\x90\xf3\x7f\xf0\x86\xe1\xd8\x67\x77\xfd\x88\xf4\x3c\xc0\x7b\xe4\xf4\x19\xf1\x1c\x69\x6b\x6d\x97\x29\x41\x4e\xc4\x41\x23\x73\xd6\xe2\xe5\xb4\xbb\x80\xee\x40\x4c\x8e\x3c\x38\xf0\xb0\xf0\x8d\x27\xc4\xfb\xc6\xc0\xbb\x02\xaf\xeb\x8f\x97\xcb\x78\x44\x37\x21\x7f\x1f\x1e\x8f\xe1\xf5\x87\xec\x40\x3f\x3e\xd1\x57\x87\x1d\x9d\xdb\x0e\x33\x56\x06\xf3\x2e\x1a\xdb\xa4\xf8\x5a\x3e\xf0\x5d\xb3\x6c\xea\x0d\xa2\xa7\xbb\x13\x0e\x74\x1b\xe7\x84\x0e\x72\x42\xc3\xc1\xa9\xed\xa7\x7d\x66\x3c\x8b\xb0\xdf\x70\x4b\x14\xcd\x54\x61\x15\x4a\x6b\x6f\xbc\xb1\xa7\x48\xc6\x57\xef\xe0\x3a\xfa\x0a\x15\x88\x5e\xa1\x4b\xf1\xea\xbd\x8d\x92\x3d\x4e\xf2\x67\x70\x70\xab\x32\x5e\xc6\x56\x88\xd3\x02\xff\x3e\x51\x09\xf8\x07\x0b\xf3\x23\xae\x95\x13\x00\x00")
+
+func ui_js_controllers_dashboard_js_bytes() ([]byte, error) {
+	return bindata_read(
+		_ui_js_controllers_dashboard_js,
+		"ui/js/controllers/dashboard.js",
+	)
+}
+
+func ui_js_controllers_dashboard_js() (*asset, error) {
+	bytes, err := ui_js_controllers_dashboard_js_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "ui/js/controllers/dashboard.js", size: 5013, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -141,7 +181,7 @@ func ui_js_controllers_rest_explorer_js() (*asset, error) {
 	return a, nil
 }
 
-var _ui_js_main_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x52\x61\x6b\xdb\x40\x0c\xfd\x9e\x5f\x21\xc2\xc0\x09\xa4\x76\x57\xf6\x29\x65\x30\x37\xcd\x98\x59\x49\x46\x9c\xac\x94\x32\xc6\xe5\xa2\xd8\xc7\xce\x77\x9e\xee\x1c\x37\x8c\xfe\xf7\xe9\xec\x84\x35\xcc\xf8\x8b\x4e\x4f\x4f\xef\x49\x4a\x12\x98\xd9\xfa\x48\xaa\x28\x3d\xdc\x5c\xbf\xff\x00\xeb\x12\xf9\x49\xfe\x22\x2b\x64\x09\x69\xe3\x4b\x4b\x2e\x1e\x24\x09\xff\xf0\xa0\x24\x1a\x87\x3b\x68\xcc\x0e\x09\x3c\x63\xd3\x9a\x71\x78\xce\x4c\xe0\x3b\x92\x53\xd6\xc0\x4d\x7c\x0d\xa3\x00\x18\x9e\x52\xc3\xf1\x6d\xa0\x38\xda\x06\x2a\x71\x04\x63\x3d\x34\x0e\x99\x43\x39\xd8\x2b\x8d\x80\x2f\x12\x6b\x0f\xca\x80\xb4\x55\xad\x95\x30\x12\xa1\x55\xbe\xec\xfa\x9c\x58\x82\x12\x78\x3a\x71\xd8\xad\x17\x0c\x17\x5c\x50\x73\xb4\x7f\x0b\x04\xe1\x4f\xa2\xc3\x57\x7a\x5f\x4f\x93\xa4\x6d\xdb\x58\x74\x82\x63\x4b\x45\xa2\x7b\xa8\x4b\x1e\xb2\xd9\x7c\x91\xcf\xaf\x58\xf4\xa9\x68\x63\x34\x3a\x07\x84\xbf\x1b\x45\x6c\x78\x7b\x04\x51\xb3\x28\x29\xb6\x2c\x55\x8b\x16\x2c\x81\x28\x08\x39\xe7\x6d\x10\xdd\x92\xf2\xca\x14\x13\x70\x76\xef\x5b\x41\x18\x68\x76\xca\x79\x52\xdb\xc6\x5f\xcc\xec\x2c\x91\x9d\xbf\x05\xf0\xd4\x84\x81\x61\x9a\x43\x96\x0f\xe1\x2e\xcd\xb3\x7c\x12\x48\x1e\xb3\xf5\x97\xe5\x66\x0d\x8f\xe9\x6a\x95\x2e\xd6\xd9\x3c\x87\xe5\x0a\x66\xcb\xc5\x7d\xb6\xce\x96\x0b\x8e\x3e\x43\xba\x78\x82\xaf\xd9\xe2\x7e\x02\xc8\x13\xe3\x3e\xf8\x52\x53\x70\x60\x29\x50\xa8\x30\x50\xdc\xc5\x90\x23\x5e\x48\xd8\xdb\x5e\x92\xab\x51\xaa\xbd\x92\x6c\xcd\x14\x8d\x28\x10\x0a\x7b\x40\x32\xec\x28\xd4\xd7\x48\x95\x72\x61\xb1\x8e\x35\xee\x40\xab\x4a\x79\xe1\xbb\xf8\x3f\x5f\xff\xba\xa4\x1b\x56\xbe\xca\xbb\xfd\x06\x9a\xd0\xcd\x88\x0a\x5d\x58\x96\xb4\xa6\xb7\xfe\xe6\xc0\xfa\x7b\x9b\x42\x6a\x76\x84\x2d\xdc\x59\x73\x40\x46\x21\x8c\xb8\xeb\x71\xcb\xed\x3e\x15\x95\x50\x3a\xe6\x13\x19\x0f\x06\x07\x41\x20\x29\xad\x6b\xf8\x08\x41\xb7\x16\x14\x57\x76\xd7\x68\x1c\x45\xf2\x7c\xc4\xd1\x04\x9e\x23\x53\xac\x2c\x0f\x39\xfa\xc1\x57\xd8\x55\x30\x83\xd9\xab\x62\xf4\x1c\xbd\xa3\x90\xf9\x46\xf6\xa0\xd8\x08\xa3\xf7\x8d\x91\xc1\xda\xe8\x22\x31\x86\x3f\x03\x80\x8b\xa7\xb8\x2d\xd1\x8c\xa2\x84\x07\xed\xaf\x78\xe0\xda\x52\x47\x10\x80\xd0\xfb\xb3\x5a\x23\x4d\xa3\x15\x23\xe6\x27\xc0\xcc\x93\x8e\x26\x1d\xc4\x23\xef\x45\x78\xdc\x90\x9e\x46\xc9\x39\x72\x1d\xe1\xcf\x33\x61\x5c\xfa\x4a\x47\x8c\x7f\x1d\xc7\x36\xec\xb6\x55\x0e\x47\x7d\x0f\x3e\x4d\x3e\x4f\xe9\xd7\x96\xeb\x7b\xcc\xed\xe0\x35\x98\xfc\x1b\x00\x00\xff\xff\x7a\xc6\x8d\x09\xda\x03\x00\x00")
+var _ui_js_main_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8d\x52\xdf\x6b\xdb\x30\x10\x7e\xcf\x5f\x71\x84\x81\x13\x48\xed\xae\xec\x29\x65\x30\x37\xcd\x98\x59\x49\x46\x9c\xac\x94\x32\x86\x22\x9f\x6d\x31\x59\xf2\x24\x39\x6e\x18\xfd\xdf\x77\xb2\x63\x96\xd0\x97\x85\xbc\xc8\xf7\xdd\xf7\xe3\xee\xa2\x08\x16\xba\x3e\x1a\x51\x94\x0e\x6e\xae\xdf\x7f\x80\x6d\x89\xf4\x89\xff\x32\x9a\xf1\x12\xe2\xc6\x95\xda\xd8\x70\x14\x45\xf4\x87\x07\xc1\x51\x59\xcc\xa0\x51\x19\x1a\x70\x84\x8d\x6b\xc2\xe1\x50\x99\xc1\x77\x34\x56\x68\x05\x37\xe1\x35\x4c\x3c\x60\x7c\x2a\x8d\xa7\xb7\x9e\xe2\xa8\x1b\xa8\xd8\x11\x94\x76\xd0\x58\x24\x0e\x61\x21\x17\x12\x01\x5f\x38\xd6\x0e\x84\x02\xae\xab\x5a\x0a\xa6\x38\x42\x2b\x5c\xd9\xe9\x9c\x58\xbc\x13\x78\x3a\x71\xe8\xbd\x63\x04\x67\xd4\x50\xd3\x2b\x3f\x07\x02\x73\x27\xd3\xfe\x57\x3a\x57\xcf\xa3\xa8\x6d\xdb\x90\x75\x86\x43\x6d\x8a\x48\xf6\x50\x1b\x3d\x24\x8b\xe5\x2a\x5d\x5e\x91\xe9\x53\xd3\x4e\x49\xb4\x16\x0c\xfe\x6e\x84\xa1\xc0\xfb\x23\xb0\x9a\x4c\x71\xb6\x27\xab\x92\xb5\xa0\x0d\xb0\xc2\x20\xd5\x9c\xf6\xa6\x5b\x23\x9c\x50\xc5\x0c\xac\xce\x5d\xcb\x0c\x7a\x9a\x4c\x58\x67\xc4\xbe\x71\x17\x33\x1b\x2c\x52\xf2\x73\x00\x4d\x8d\x29\x18\xc7\x29\x24\xe9\x18\xee\xe2\x34\x49\x67\x9e\xe4\x31\xd9\x7e\x59\xef\xb6\xf0\x18\x6f\x36\xf1\x6a\x9b\x2c\x53\x58\x6f\x60\xb1\x5e\xdd\x27\xdb\x64\xbd\xa2\xd7\x67\x88\x57\x4f\xf0\x35\x59\xdd\xcf\x00\x69\x62\xa4\x83\x2f\xb5\xf1\x09\xb4\xf1\x14\xc2\x0f\x14\xb3\x10\x52\xc4\x0b\x0b\xb9\xee\x2d\xd9\x1a\xb9\xc8\x05\xa7\x68\xaa\x68\x58\x81\x50\xe8\x03\x1a\x45\x89\x7c\x7f\x8d\xa6\x12\xd6\x2f\xd6\x92\xc7\x0c\xa4\xa8\x84\x63\xae\x7b\xbf\xc9\xf5\x4f\x25\xde\x91\xf3\x4d\xda\xed\xd7\xd3\x78\x35\xc5\x2a\xb4\x7e\x59\x5c\xab\x3e\xfa\xd9\x81\xf5\xf7\x36\x87\x58\x65\x06\x5b\xb8\xd3\xea\x80\x84\x42\x98\x90\xea\x71\x4f\x72\x9f\x8a\x8a\x09\x19\xd2\x89\x4c\x47\xa3\x03\x33\xc0\x4d\x5c\xd7\xf0\x11\xbc\x6f\xc9\x4c\x58\xe9\xac\x91\x38\x09\xf8\x70\xc4\xc1\x0c\x9e\x03\x55\x6c\x34\x0d\x39\xf8\x41\x57\xd8\x75\x10\x83\xca\x45\x31\x79\x0e\xde\x19\x5f\xf9\x66\xf4\x41\x50\x10\x42\xe7\x8d\xe2\x3e\xda\xe4\xa2\x30\x85\x3f\x23\x80\x8b\x4f\x61\x5b\xa2\x9a\x04\x11\xf5\xf8\x1a\xf4\x91\xb4\x94\x68\xe6\xc1\x3d\xb3\xe5\x5e\x33\x93\x2d\x9c\x91\xc1\xac\xab\x3b\xa4\x3d\x30\x87\x3b\x23\xe7\x41\x34\xbc\x6c\x94\x0d\xd8\xb0\x74\x95\x0c\x08\xfb\x3a\x1d\xc8\x69\x8b\xee\x8a\xb6\x29\xb5\xe9\xdc\xbd\x55\xda\x10\x62\x79\x02\xfc\x87\x98\x27\xfc\x39\x10\x9e\x0b\x6a\x7f\x38\xad\xb0\x38\xe9\x35\xe8\xee\xe9\xf6\xb9\xdb\x6a\xea\xef\x31\xb7\xa3\x57\x3f\xc1\xbf\xe6\xd0\x55\xc6\x37\x04\x00\x00")
 
 func ui_js_main_js_bytes() ([]byte, error) {
 	return bindata_read(
@@ -156,7 +196,27 @@ func ui_js_main_js() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "ui/js/main.js", size: 986, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	info := bindata_file_info{name: "ui/js/main.js", size: 1079, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _ui_templates_dashboard_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x56\xdb\x6e\xe3\x36\x10\x7d\xd7\x57\xcc\x0a\x6d\x9d\x00\xb6\x9c\xdd\x6e\x5f\xb2\xb6\x01\xaf\x93\x22\x46\x03\xa7\x88\x9d\x06\xfb\x48\x4b\x63\x89\x8d\x44\xaa\x24\xe5\x0b\x52\xfd\x7b\x87\x94\x6c\xcb\xb0\x36\x48\x51\xd4\x2f\xe6\x5c\x38\x3c\x73\x66\xc8\xd1\xe0\x43\xaf\xe7\x4d\x64\xbe\x53\x3c\x4e\x0c\x7c\xba\xfa\xf8\x0b\x2c\x12\x84\x89\x0c\x5f\x94\x64\x61\x02\xe3\xc2\x24\x52\xe9\xc0\xf3\xee\x79\x88\x42\x63\x04\x85\x88\x50\x81\x21\xb7\x71\x4e\x2e\x08\xb5\xa5\x0b\x7f\xa0\xd2\x5c\x0a\xf8\x14\x5c\xc1\x85\x75\xf0\x6b\x93\x7f\xf9\xc5\xdb\xc9\x02\x32\xb6\x03\x21\x0d\x14\x1a\x29\x00\xd7\xb0\xe2\x29\x02\x6e\x43\xcc\x0d\x70\x01\xa1\xcc\xf2\x94\x33\x11\x22\x6c\xb8\x49\xdc\x21\x75\x88\xc0\xfb\x56\x07\x90\x4b\xc3\xc8\x97\x91\x77\x4e\xd2\xaa\xe9\x05\xcc\x78\x1e\xd0\x2f\x31\x26\xbf\xee\xf7\x37\x9b\x4d\xc0\x1c\xca\x40\xaa\xb8\x9f\x56\x5e\xba\x7f\x3f\x9d\xdc\xce\xe6\xb7\x3d\x42\xea\x79\x4f\x22\x45\xad\x41\xe1\x5f\x05\x57\x94\xe0\x72\x07\x2c\x27\x1c\x21\x5b\x12\xba\x94\x6d\x40\x2a\x60\xb1\x42\xb2\x19\x69\x71\x6e\x14\x37\x5c\xc4\x5d\xd0\x72\x65\x36\x4c\xa1\x17\x71\x6d\x14\x5f\x16\xe6\x84\xa0\x3d\x2a\xca\xb4\xe9\x40\x14\x31\x01\xfe\x78\x0e\xd3\xb9\x0f\x5f\xc7\xf3\xe9\xbc\xeb\x3d\x4f\x17\x77\x0f\x4f\x0b\x78\x1e\x3f\x3e\x8e\x67\x8b\xe9\xed\x1c\x1e\x1e\x61\xf2\x30\xbb\x99\x2e\xa6\x0f\x33\x92\x7e\x85\xf1\xec\x1b\xfc\x36\x9d\xdd\x74\x01\x89\x1e\x3a\x04\xb7\xb9\xb2\xd8\xa5\xf2\xb8\xa5\x0e\xa3\x00\xe6\x88\x27\x87\xaf\x64\x05\x46\xe7\x18\xf2\x15\x0f\x29\x23\x11\x17\x2c\x46\x88\xe5\x1a\x95\xa0\x44\xbc\x1c\x55\xc6\xb5\x2d\x9e\x26\x68\x11\xa4\x3c\xe3\x86\x19\x27\x9f\xa5\x73\x3c\x62\xfc\x44\x98\x1f\xe7\xae\x8c\x9e\x3d\x47\xb0\x0c\xb5\xad\x49\x28\x45\x95\xae\x6b\x9e\x5e\x6f\xe4\x0d\x22\xbe\x86\x30\x65\x5a\x0f\xfd\x88\xe9\x64\x29\x99\x8a\xfc\x11\x55\xab\xd5\xd2\x43\xa5\xa4\xf2\x41\xc4\x3d\x85\x39\x32\x33\xf4\x9d\xc6\xd2\xef\x16\x1a\x8c\x62\xe1\x8b\xad\xd6\x0f\x9c\x20\x6e\xfd\xd1\xeb\xab\xb3\x94\xe5\xa0\x4f\x21\x5d\x68\x8d\xa1\xcd\xe2\x3c\x7c\x6d\x70\x00\xc8\x2f\xf9\x79\x34\x93\x11\xea\x41\x9f\x56\x95\xca\xb8\xf2\x9f\x6d\x74\xea\x7a\x9b\xf5\x52\xfb\xa5\x15\x92\xd1\xf4\x66\xd0\xa7\xbf\x13\xdd\x38\x8a\x6c\x95\x5a\x0c\xa6\xee\x89\x16\xdb\x9c\xa8\x6b\xd3\xdf\x33\x6d\xe0\x0e\x09\x4a\xd3\x46\x6b\xd5\xc0\xd4\xa4\x4d\x50\x5e\x96\x35\xfb\xaf\xfd\x66\xb0\x88\x18\xb3\xda\x80\x47\x96\x33\x92\xdb\x8c\x8c\xd0\xbf\x65\xa6\x1c\x74\xf0\xa7\xe4\xe2\xa2\xd3\x85\xce\xe5\x1b\xae\xda\xa5\x14\xa4\x28\x62\x93\xbc\xe1\x47\x94\x1b\x97\x21\xfc\x0d\x11\x33\x78\xdd\xc9\x30\xe2\x45\xd6\x39\xdd\x73\xcc\x99\x56\xb6\x2a\xae\xe2\xfd\xba\xb2\xff\xb6\xfc\x7b\xbe\xff\x63\xfd\x5d\x98\xf3\xaa\xd9\xe6\x3a\xd7\x4e\xec\xdb\xc4\xcd\xae\xa5\x33\xd6\x8c\xa7\xf6\xa4\x73\xd3\x13\x3d\xc2\xe7\xda\x47\xba\xd4\xad\xed\xc2\xd7\x08\x5f\x77\xad\x2d\x46\x1c\xa7\x26\x69\x39\x21\xb7\xac\xbf\xb7\xbf\x5c\x55\x6d\x83\x55\xe5\x3d\xeb\x30\xa7\xfe\x6e\x8b\x55\x56\x5b\xf6\xe9\x4d\xbb\x07\xbd\x2b\x19\x33\x2e\x85\x8b\xca\x3b\xac\x69\xbb\x7c\xef\x06\xb6\x67\xf3\xf2\x2d\x10\x34\x95\xa2\xdf\x51\xd1\x1b\x67\xa8\xf1\x44\x91\x2d\x51\x5d\x7f\x2c\xcb\x1f\xbf\xbf\x45\x59\xd6\x27\xb2\x10\xe6\xbd\x50\x52\xaa\x87\x93\xdb\xa0\x58\x5a\xeb\x86\x7b\xed\x1c\x5b\xae\x10\x89\xab\xd4\xae\x73\x0d\x1f\xaa\x30\xb5\xa2\xf4\x0f\x50\x2a\xcd\x9b\xf9\x55\x65\x1d\x9b\xff\xfd\x5a\xc1\x51\x13\x2b\x96\x27\xba\x71\xcf\x16\x3c\x43\x1a\x20\x8a\x9f\x5c\x36\x4b\x94\xcd\x5e\x17\x4b\x9a\x3c\x43\x9f\x1e\x9d\xca\xe7\xe2\xf2\x78\xd7\xb8\xc8\x0b\x03\x66\x97\xe3\xd0\x37\xb8\x35\x6e\x3a\x64\xd4\x3a\x29\xbd\x72\xb8\xa9\x36\xf8\x90\xa7\x2c\xc4\x44\xa6\x34\xb6\x86\x7e\xa5\x74\x83\xa9\x3d\x50\x75\xa2\x0f\x6b\x96\x16\x24\xd2\x5b\xbd\x07\xdb\xb7\xa0\xea\x75\xeb\x88\x72\xb9\x9d\x8c\x28\xa7\xb1\x77\xe1\x24\x6d\x9b\xf8\x67\x2a\x83\x53\x06\x16\x4a\x59\xc2\x80\x41\xa2\x70\x35\xf4\xfd\xaa\xea\x3c\x7c\x19\xfa\x0a\x33\x9a\xc9\x75\xe6\x47\x77\xe2\xe0\x27\x43\xbc\xe9\x2f\x83\x3e\x1b\x11\x6d\x9f\x0f\x81\xf5\x3a\xb6\xfb\xed\x2b\xdc\xdb\xf0\xc8\x24\xd4\x3a\xd5\x41\xcf\x56\x2a\x4b\xff\x60\x4e\xd0\x7e\xe5\x1d\xec\x77\x4e\x24\x87\x46\xb7\xe4\x32\xdd\xa5\x5c\xe0\x61\x4f\x4e\xef\xba\xd1\x87\x3d\x41\x25\xdb\x4d\x83\xfe\xde\xf9\xd8\x38\x04\xe6\x20\xd4\x13\x78\x0f\x33\xa7\x2f\x1e\x0a\xca\x57\x35\x49\x41\x68\x6f\x0d\x8c\xe0\xca\x1f\x65\x44\xd8\xfe\x00\xbb\xde\x5f\xbe\xb2\xec\xd2\x17\xdf\xf6\x68\xa3\x75\xd3\x66\xe7\xc4\xc1\xe8\x84\xa3\x95\xc0\xd0\x91\xef\x40\x30\x1c\x5a\x08\x42\xda\x2b\xc1\x6c\xe9\xec\x87\x8d\x0b\x96\xc8\x42\x9d\x86\x39\x7c\x57\x34\x96\x8d\x9b\x51\xeb\xfe\x01\x6a\xf0\xec\x2f\x56\x0b\x00\x00")
+
+func ui_templates_dashboard_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_ui_templates_dashboard_html,
+		"ui/templates/dashboard.html",
+	)
+}
+
+func ui_templates_dashboard_html() (*asset, error) {
+	bytes, err := ui_templates_dashboard_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "ui/templates/dashboard.html", size: 2902, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -233,11 +293,14 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"ui/css/dashboard.css":               ui_css_dashboard_css,
 	"ui/css/main.css":                    ui_css_main_css,
 	"ui/css/rest_explorer.css":           ui_css_rest_explorer_css,
 	"ui/index.html":                      ui_index_html,
+	"ui/js/controllers/dashboard.js":     ui_js_controllers_dashboard_js,
 	"ui/js/controllers/rest_explorer.js": ui_js_controllers_rest_explorer_js,
 	"ui/js/main.js":                      ui_js_main_js,
+	"ui/templates/dashboard.html":        ui_templates_dashboard_html,
 	"ui/templates/rest_explorer.html":    ui_templates_rest_explorer_html,
 }
 
@@ -284,17 +347,20 @@ type _bintree_t struct {
 var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 	"ui": &_bintree_t{nil, map[string]*_bintree_t{
 		"css": &_bintree_t{nil, map[string]*_bintree_t{
+			"dashboard.css":     &_bintree_t{ui_css_dashboard_css, map[string]*_bintree_t{}},
 			"main.css":          &_bintree_t{ui_css_main_css, map[string]*_bintree_t{}},
 			"rest_explorer.css": &_bintree_t{ui_css_rest_explorer_css, map[string]*_bintree_t{}},
 		}},
 		"index.html": &_bintree_t{ui_index_html, map[string]*_bintree_t{}},
 		"js": &_bintree_t{nil, map[string]*_bintree_t{
 			"controllers": &_bintree_t{nil, map[string]*_bintree_t{
+				"dashboard.js":     &_bintree_t{ui_js_controllers_dashboard_js, map[string]*_bintree_t{}},
 				"rest_explorer.js": &_bintree_t{ui_js_controllers_rest_explorer_js, map[string]*_bintree_t{}},
 			}},
 			"main.js": &_bintree_t{ui_js_main_js, map[string]*_bintree_t{}},
 		}},
 		"templates": &_bintree_t{nil, map[string]*_bintree_t{
+			"dashboard.html":     &_bintree_t{ui_templates_dashboard_html, map[string]*_bintree_t{}},
 			"rest_explorer.html": &_bintree_t{ui_templates_rest_explorer_html, map[string]*_bintree_t{}},
 		}},
 	}},
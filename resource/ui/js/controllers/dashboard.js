@@ -0,0 +1,144 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+var crApp = angular.module('cockroach');
+
+// The series graphed by default, recorded from each node's runtime
+// samples. Operators may add others by name.
+var defaultSeries = ['cr.node.sys.goroutines', 'cr.node.sys.heap.alloc'];
+
+// The interval at which the dashboard is refreshed, in milliseconds.
+var refreshInterval = 10000;
+
+crApp.controller('DashboardCtrl', ['$scope', '$http', '$interval',
+    function(scope, http, interval) {
+  scope.nodes = [];
+  scope.stores = [];
+  scope.graphs = [];
+  scope.errors = [];
+  scope.series = defaultSeries.slice();
+  scope.graphWidth = 400;
+  scope.graphHeight = 120;
+
+  scope.formatBytes = function(bytes) {
+    var units = ['B', 'KiB', 'MiB', 'GiB', 'TiB'];
+    var i = 0;
+    while (bytes >= 1024 && i < units.length - 1) {
+      bytes /= 1024;
+      i++;
+    }
+    return (i == 0 ? bytes : bytes.toFixed(1)) + ' ' + units[i];
+  };
+
+  var errorFn = function(endpoint) {
+    return function(data, status) {
+      scope.errors.push(['GET ', endpoint, ': ', status, ' ', data].join(''));
+    };
+  };
+
+  // refreshStores merges the capacities gossiped with the node list
+  // into the store statuses recorded in the datastore.
+  var refreshStores = function(statuses) {
+    var capacities = {};
+    angular.forEach(scope.nodes, function(node) {
+      angular.forEach(node.stores, function(store) {
+        capacities[store.id] = store;
+      });
+    });
+    scope.stores = statuses.map(function(status) {
+      var capacity = capacities[status.store_id] || {capacity: 0, available: 0};
+      var corrupt = status.corrupt_raft_ids || [];
+      return {
+        id: status.store_id,
+        nodeID: status.node_id,
+        capacity: capacity.capacity,
+        available: capacity.available,
+        usedPercent: capacity.capacity > 0 ?
+            100 * (capacity.capacity - capacity.available) / capacity.capacity : 0,
+        rangeCount: status.range_count,
+        liveBytes: status.stats.live_bytes,
+        healthy: corrupt.length == 0,
+        health: corrupt.length == 0 ? 'ok' : corrupt.length + ' corrupt replicas',
+        updatedAt: status.updated_at / 1e6
+      };
+    });
+  };
+
+  // graph converts the datapoints of a series into the points of an
+  // SVG polyline scaled to the size of the graph.
+  var graph = function(name, datapoints) {
+    var g = {name: name, points: '', count: datapoints.length};
+    if (datapoints.length == 0) {
+      return g;
+    }
+    var values = datapoints.map(function(dp) { return dp.float_value; });
+    g.min = Math.min.apply(null, values);
+    g.max = Math.max.apply(null, values);
+    g.last = values[values.length - 1];
+    var first = datapoints[0].timestamp_nanos;
+    var span = (datapoints[datapoints.length - 1].timestamp_nanos - first) || 1;
+    var range = (g.max - g.min) || 1;
+    g.points = datapoints.map(function(dp, i) {
+      var x = scope.graphWidth * (dp.timestamp_nanos - first) / span;
+      var y = scope.graphHeight * (1 - (values[i] - g.min) / range);
+      return x.toFixed(1) + ',' + y.toFixed(1);
+    }).join(' ');
+    return g;
+  };
+
+  var refreshGraphs = function() {
+    var graphs = [];
+    angular.forEach(scope.series, function(name, i) {
+      var endpoint = '/ts/query?name=' + encodeURIComponent(name);
+      http.get(endpoint).success(function(data) {
+        graphs[i] = graph(name, data.datapoints || []);
+        scope.graphs = graphs.filter(function(g) { return !!g; });
+      }).error(errorFn(endpoint));
+    });
+    if (scope.series.length == 0) {
+      scope.graphs = [];
+    }
+  };
+
+  var refresh = function() {
+    scope.errors = [];
+    http.get('/_status/nodes/').success(function(data) {
+      scope.nodes = data.nodes;
+      http.get('/_status/stores/').success(function(data) {
+        refreshStores(data.stores);
+      }).error(errorFn('/_status/stores/'));
+    }).error(errorFn('/_status/nodes/'));
+    refreshGraphs();
+  };
+
+  scope.addSeries = function() {
+    if (!!scope.newSeries && scope.series.indexOf(scope.newSeries) == -1) {
+      scope.series.push(scope.newSeries);
+      refreshGraphs();
+    }
+    scope.newSeries = '';
+  };
+
+  scope.removeSeries = function(name) {
+    scope.series = scope.series.filter(function(s) { return s != name; });
+    refreshGraphs();
+  };
+
+  refresh();
+  var timer = interval(refresh, refreshInterval);
+  scope.$on('$destroy', function() {
+    interval.cancel(timer);
+  });
+}]);
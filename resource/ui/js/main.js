@@ -17,7 +17,10 @@
 
 var crApp = angular.module('cockroach', ['ngRoute']);
 crApp.config(['$routeProvider', function(routeProvider) {
-  routeProvider.when('/rest-explorer', {
+  routeProvider.when('/', {
+    controller:'DashboardCtrl',
+    templateUrl:'/templates/dashboard.html'
+  }).when('/rest-explorer', {
     controller:'RestExplorerCtrl',
     templateUrl:'/templates/rest_explorer.html'
   }).otherwise({
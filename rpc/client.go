@@ -41,8 +41,9 @@ const (
 )
 
 var (
-	clientMu          sync.Mutex         // Protects access to the client cache.
-	clients           map[string]*Client // Cache of RPC clients by server address.
+	clientMu          sync.Mutex          // Protects access to the client cache.
+	clients           map[string]*Client  // Cache of RPC clients by server address.
+	breakers          map[string]*breaker // Circuit breakers by server address. Protected by clientMu.
 	heartbeatInterval time.Duration
 )
 
@@ -58,9 +59,41 @@ var clientRetryOptions = util.RetryOptions{
 // init creates a new client RPC cache.
 func init() {
 	clients = map[string]*Client{}
+	breakers = map[string]*breaker{}
 	heartbeatInterval = defaultHeartbeatInterval
 }
 
+// A breaker is a circuit breaker for connections to a single remote
+// address. Each consecutive failure of a cached client doubles the
+// period during which new clients to the address are closed
+// immediately instead of dialed, which avoids a storm of redials to
+// a dead node. A successful heartbeat resets the breaker.
+type breaker struct {
+	failures int       // Number of consecutive failures
+	until    time.Time // The breaker is open until this time
+}
+
+// trip records a failure at the specified time and opens the breaker
+// for a backoff period which grows exponentially with the number of
+// consecutive failures.
+func (b *breaker) trip(now time.Time) {
+	b.failures++
+	backoff := clientRetryOptions.Backoff
+	for i := 1; i < b.failures && backoff < clientRetryOptions.MaxBackoff; i++ {
+		backoff = time.Duration(float64(backoff) * clientRetryOptions.Constant)
+	}
+	if backoff > clientRetryOptions.MaxBackoff {
+		backoff = clientRetryOptions.MaxBackoff
+	}
+	b.until = now.Add(backoff)
+}
+
+// isOpen returns whether new connections should be refused at the
+// specified time.
+func (b *breaker) isOpen(now time.Time) bool {
+	return now.Before(b.until)
+}
+
 // Client is a Cockroach-specific RPC client with an embedded go
 // rpc.Client struct.
 type Client struct {
@@ -74,6 +107,7 @@ type Client struct {
 	lAddr        net.Addr   // Local address of client
 	healthy      bool
 	offset       proto.RemoteOffset // Latest measured clock offset from the server
+	latency      time.Duration      // Latest measured heartbeat round-trip latency
 	clock        *hlc.Clock
 	remoteClocks *RemoteClockMonitor
 	cached       bool
@@ -90,7 +124,12 @@ type Client struct {
 // The Client.Ready channel is closed after the client has connected
 // and completed one successful heartbeat. The Closed channel is
 // closed if the client fails to connect or if the client's Close()
-// method is invoked.
+// method is invoked. If cached clients to the address have recently
+// failed, the returned client's Closed channel is already closed and
+// no connection is attempted until the address's breaker resets.
+//
+// All callers share the cached client, and concurrent RPCs are
+// multiplexed over its single connection.
 func NewClient(addr net.Addr, opts *util.RetryOptions, context *Context) *Client {
 	clientMu.Lock()
 	if !context.DisableCache {
@@ -98,6 +137,18 @@ func NewClient(addr net.Addr, opts *util.RetryOptions, context *Context) *Client
 			clientMu.Unlock()
 			return c
 		}
+		if b, ok := breakers[addr.String()]; ok && b.isOpen(time.Now()) {
+			clientMu.Unlock()
+			log.V(1).Infof("client %s: breaker open after %d failures", addr, b.failures)
+			c := &Client{
+				addr:   addr,
+				Ready:  make(chan struct{}),
+				Closed: make(chan struct{}),
+				closed: true,
+			}
+			close(c.Closed)
+			return c
+		}
 	}
 	c := &Client{
 		addr:         addr,
@@ -127,14 +178,15 @@ func (c *Client) connect(opts *util.RetryOptions, context *Context) {
 	retryOpts.Stopper = context.stopper
 
 	err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
-		conn, err := tlsDialHTTP(c.addr.Network(), c.addr.String(), context.tlsConfig)
+		conn, err := tlsDialHTTP(c.addr.Network(), c.addr.String(), context.TLSConfig())
 		if err != nil {
 			log.Info(err)
 			return util.RetryContinue, nil
 		}
 
 		c.mu.Lock()
-		c.Client = rpc.NewClientWithCodec(codec.NewClientCodec(conn))
+		c.Client = rpc.NewClientWithCodec(
+			codec.NewClientCodecWithCompression(conn, !context.DisableCompression))
 		c.lAddr = conn.LocalAddr()
 		c.mu.Unlock()
 
@@ -146,6 +198,7 @@ func (c *Client) connect(opts *util.RetryOptions, context *Context) {
 
 		// Signal client is ready by closing Ready channel.
 		log.Infof("client %s connected", c.addr)
+		c.resetBreaker()
 		close(c.Ready)
 
 		// Launch periodic heartbeat.
@@ -155,6 +208,7 @@ func (c *Client) connect(opts *util.RetryOptions, context *Context) {
 	})
 	if err != nil {
 		log.Errorf("client %s failed to connect: %v", c.addr, err)
+		c.tripBreaker()
 		c.Close()
 	}
 }
@@ -195,6 +249,14 @@ func (c *Client) RemoteOffset() proto.RemoteOffset {
 	return c.offset
 }
 
+// Latency returns the most recently measured round-trip latency of a
+// heartbeat to the remote server.
+func (c *Client) Latency() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.latency
+}
+
 // Close removes the client from the clients map and closes
 // the Closed channel.
 func (c *Client) Close() {
@@ -215,6 +277,33 @@ func (c *Client) Close() {
 	clientMu.Unlock()
 }
 
+// tripBreaker records a failure of a cached client with the breaker
+// for its address.
+func (c *Client) tripBreaker() {
+	if !c.cached {
+		return
+	}
+	clientMu.Lock()
+	defer clientMu.Unlock()
+	addr := c.Addr().String()
+	b, ok := breakers[addr]
+	if !ok {
+		b = &breaker{}
+		breakers[addr] = b
+	}
+	b.trip(time.Now())
+}
+
+// resetBreaker resets the breaker for a cached client's address.
+func (c *Client) resetBreaker() {
+	if !c.cached {
+		return
+	}
+	clientMu.Lock()
+	defer clientMu.Unlock()
+	delete(breakers, c.Addr().String())
+}
+
 // startHeartbeat sends periodic heartbeats to client. Closes the
 // connection on error. Heartbeats are sent in an infinite loop until
 // an error is encountered.
@@ -227,6 +316,7 @@ func (c *Client) startHeartbeat() {
 		time.Sleep(heartbeatInterval)
 		if err := c.heartbeat(); err != nil {
 			log.Infof("client %s heartbeat failed: %v; recycling...", c.Addr(), err)
+			c.tripBreaker()
 			c.Close()
 			break
 		}
@@ -234,8 +324,8 @@ func (c *Client) startHeartbeat() {
 }
 
 // heartbeat sends a single heartbeat RPC. As part of the heartbeat protocol,
-// it measures the clock of the remote to determine the node's clock offset
-// from the remote.
+// it measures the round-trip latency to the remote and the clock of the
+// remote to determine the node's clock offset from the remote.
 func (c *Client) heartbeat() error {
 	request := &proto.PingRequest{Offset: c.RemoteOffset(), Addr: c.LocalAddr().String()}
 	response := &proto.PingResponse{}
@@ -247,6 +337,7 @@ func (c *Client) heartbeat() error {
 		log.V(1).Infof("client %s heartbeat: %v", c.Addr(), call.Error)
 		c.mu.Lock()
 		c.healthy = true
+		c.latency = time.Duration(receiveTime - sendTime)
 		c.offset.MeasuredAt = receiveTime
 		if receiveTime-sendTime > maximumClockReadingDelay.Nanoseconds() {
 			c.offset = proto.InfiniteOffset
@@ -262,6 +353,7 @@ func (c *Client) heartbeat() error {
 		}
 		c.mu.Unlock()
 		c.remoteClocks.UpdateOffset(c.addr.String(), c.offset)
+		c.remoteClocks.UpdateLatency(c.addr.String(), time.Duration(receiveTime-sendTime))
 		return call.Error
 	case <-time.After(heartbeatInterval * 2):
 		// Allowed twice gossip interval.
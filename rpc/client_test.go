@@ -18,6 +18,7 @@
 package rpc
 
 import (
+	"net"
 	"net/rpc"
 	"testing"
 	"time"
@@ -69,6 +70,63 @@ func TestClientNoCache(t *testing.T) {
 	s.Close()
 }
 
+// TestBreakerBackoff verifies that each consecutive failure doubles
+// the period during which a breaker is open, up to the maximum.
+func TestBreakerBackoff(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := &breaker{}
+	if b.isOpen(now) {
+		t.Fatal("expected new breaker to be closed")
+	}
+	expBackoff := clientRetryOptions.Backoff
+	for i := 0; i < 10; i++ {
+		b.trip(now)
+		if !b.isOpen(now.Add(expBackoff - 1)) {
+			t.Errorf("%d: expected breaker to be open before %s", i, expBackoff)
+		}
+		if b.isOpen(now.Add(expBackoff)) {
+			t.Errorf("%d: expected breaker to be closed after %s", i, expBackoff)
+		}
+		if expBackoff *= 2; expBackoff > clientRetryOptions.MaxBackoff {
+			expBackoff = clientRetryOptions.MaxBackoff
+		}
+	}
+}
+
+// TestClientBreakerOpen verifies that no connection is attempted to
+// an address whose breaker is open and that the returned client is
+// already closed.
+func TestClientBreakerOpen(t *testing.T) {
+	rpcContext := NewTestContext(t)
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1}
+
+	clientMu.Lock()
+	breakers[addr.String()] = &breaker{failures: 1, until: time.Now().Add(time.Hour)}
+	clientMu.Unlock()
+	defer func() {
+		clientMu.Lock()
+		delete(breakers, addr.String())
+		clientMu.Unlock()
+	}()
+
+	c := NewClient(addr, nil, rpcContext)
+	select {
+	case <-c.Closed:
+	default:
+		t.Fatal("expected client to be closed while breaker is open")
+	}
+	if c.IsConnected() {
+		t.Error("expected client not to be connected")
+	}
+	clientMu.Lock()
+	_, cached := clients[addr.String()]
+	clientMu.Unlock()
+	if cached {
+		t.Error("expected closed client not to be cached")
+	}
+	c.Close()
+}
+
 // TestClientHeartbeatBadServer verifies that the client is not marked
 // as "ready" until a heartbeat request succeeds.
 func TestClientHeartbeatBadServer(t *testing.T) {
@@ -136,6 +194,14 @@ func TestOffsetMeasurement(t *testing.T) {
 		t.Errorf("expected offset %v, actual %v", expectedOffset, o)
 	}
 	context.RemoteClocks.mu.Unlock()
+	// The round-trip latency is the advancement of the client clock
+	// between sending and receiving the heartbeat.
+	if l := c.Latency(); l != 10 {
+		t.Errorf("expected latency 10ns, actual %s", l)
+	}
+	if l := context.RemoteClocks.Latencies()[c.addr.String()]; l != 10 {
+		t.Errorf("expected latency 10ns, actual %s", l)
+	}
 }
 
 // TestDelayedOffsetMeasurement tests that the client will record an
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 )
@@ -40,9 +41,10 @@ func init() {
 // RemoteClockMonitor keeps track of the most recent measurements of remote
 // offsets from this node to connected nodes.
 type RemoteClockMonitor struct {
-	offsets map[string]proto.RemoteOffset // Maps remote string addr to offset.
-	lClock  *hlc.Clock                    // The server clock.
-	mu      sync.Mutex
+	offsets   map[string]proto.RemoteOffset // Maps remote string addr to offset.
+	latencies map[string]time.Duration      // Maps remote string addr to heartbeat round-trip latency.
+	lClock    *hlc.Clock                    // The server clock.
+	mu        sync.Mutex
 	// Wall time in nanoseconds when we last monitored cluster offset.
 	lastMonitoredAt int64
 }
@@ -96,8 +98,9 @@ func (l endpointList) Less(i, j int) bool {
 // newRemoteClockMonitor returns a monitor with the given server clock.
 func newRemoteClockMonitor(clock *hlc.Clock) *RemoteClockMonitor {
 	return &RemoteClockMonitor{
-		offsets: map[string]proto.RemoteOffset{},
-		lClock:  clock,
+		offsets:   map[string]proto.RemoteOffset{},
+		latencies: map[string]time.Duration{},
+		lClock:    clock,
 	}
 }
 
@@ -139,43 +142,79 @@ func (r *RemoteClockMonitor) UpdateOffset(addr string, offset proto.RemoteOffset
 	}
 }
 
+// UpdateLatency is a thread-safe way to record the most recently
+// measured heartbeat round-trip latency to the remote addr.
+func (r *RemoteClockMonitor) UpdateLatency(addr string, latency time.Duration) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies[addr] = latency
+}
+
+// Latencies returns a copy of the most recently measured heartbeat
+// round-trip latencies, keyed by remote addr. Latencies to remotes
+// which are no longer connected are discarded along with their
+// offsets.
+func (r *RemoteClockMonitor) Latencies() map[string]time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	latencies := make(map[string]time.Duration, len(r.latencies))
+	for addr, latency := range r.latencies {
+		latencies[addr] = latency
+	}
+	return latencies
+}
+
 // MonitorRemoteOffsets periodically checks that the offset of this server's
 // clock from the true cluster time is within MaxOffset. If the offset exceeds
 // MaxOffset, then this method will trigger a fatal error, causing the node to
-// suicide.
-func (r *RemoteClockMonitor) MonitorRemoteOffsets() {
+// suicide. The method returns when the stopper is stopped.
+func (r *RemoteClockMonitor) MonitorRemoteOffsets(stopper *util.Stopper) {
 	log.V(1).Infof("monitoring cluster offset")
 	for {
-		time.Sleep(monitorInterval)
-		offsetInterval, err := r.findOffsetInterval()
-		// By the contract of the hlc, if the value is 0, then safety checking
-		// of the max offset is disabled. However we may still want to
-		// propagate the information to a status node.
-		// TODO(embark): once there is a framework for collecting timeseries
-		// data about the db, propagate the offset status to that.
-		// Don't forget to protect r.offsets through the Mutex if those
-		// Fatalf's below ever turn into something less destructive.
-		if r.lClock.MaxOffset() != 0 {
-			if err != nil {
-				log.Fatalf("clock offset from the cluster time "+
-					"for remote clocks %v could not be determined: %s",
-					r.offsets, err)
-			}
-
-			if !isHealthyOffsetInterval(offsetInterval, r.lClock.MaxOffset()) {
-				log.Fatalf("clock offset from the cluster time "+
-					"for remote clocks: %v is in interval: %v, which "+
-					"indicates that the true offset is greater than %d",
-					r.offsets, offsetInterval, r.lClock.MaxOffset())
+		select {
+		case <-time.After(monitorInterval):
+			if err := r.checkOffsets(); err != nil {
+				log.Fatal(err)
 			}
-			log.V(1).Infof("healthy cluster offset: %v", offsetInterval)
+		case <-stopper.ShouldStop():
+			return
 		}
-		r.mu.Lock()
-		r.lastMonitoredAt = r.lClock.PhysicalNow()
-		r.mu.Unlock()
 	}
 }
 
+// checkOffsets measures the offset of this server's clock from the
+// cluster time, returning an error if it could not be determined from
+// a majority of remote clocks or is not within MaxOffset. By the
+// contract of the hlc, if MaxOffset is 0, then safety checking of the
+// max offset is disabled and no error is returned.
+func (r *RemoteClockMonitor) checkOffsets() error {
+	offsetInterval, err := r.findOffsetInterval()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastMonitoredAt = r.lClock.PhysicalNow()
+	// TODO(embark): once there is a framework for collecting timeseries
+	// data about the db, propagate the offset status to that.
+	if r.lClock.MaxOffset() == 0 {
+		return nil
+	}
+	if err != nil {
+		return util.Errorf("clock offset from the cluster time "+
+			"for remote clocks %v could not be determined: %s",
+			r.offsets, err)
+	}
+	if !isHealthyOffsetInterval(offsetInterval, r.lClock.MaxOffset()) {
+		return util.Errorf("clock offset from the cluster time "+
+			"for remote clocks: %v is in interval: %v, which "+
+			"indicates that the true offset is greater than %s",
+			r.offsets, offsetInterval, r.lClock.MaxOffset())
+	}
+	log.V(1).Infof("healthy cluster offset: %v", offsetInterval)
+	return nil
+}
+
 // isHealthyOffsetInterval returns true if the ClusterOffsetInterval indicates
 // that the node's offset is within maxOffset, else false. For example, if the
 // offset interval is [-20, -11] and the maxOffset is 10 nanoseconds, then the
@@ -280,6 +319,7 @@ func (r *RemoteClockMonitor) buildEndpointList() endpointList {
 		// that addr.
 		if o.MeasuredAt < r.lastMonitoredAt {
 			delete(r.offsets, addr)
+			delete(r.latencies, addr)
 			continue
 		}
 
@@ -18,6 +18,7 @@
 package rpc
 
 import (
+	"reflect"
 	"sort"
 	"testing"
 	"time"
@@ -190,6 +191,64 @@ func TestBuildEndpointListRemoveStagnantClocks(t *testing.T) {
 	}
 }
 
+// TestLatencies verifies that heartbeat latencies are recorded and
+// removed along with the stagnant offsets of the same remotes.
+func TestLatencies(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	remoteClocks := newRemoteClockMonitor(clock)
+	remoteClocks.UpdateOffset("0", proto.RemoteOffset{Offset: 0, Error: 10, MeasuredAt: 11})
+	remoteClocks.UpdateOffset("stagnant", proto.RemoteOffset{Offset: 0, Error: 10, MeasuredAt: 0})
+	remoteClocks.UpdateLatency("0", 20*time.Nanosecond)
+	remoteClocks.UpdateLatency("stagnant", 20*time.Nanosecond)
+	remoteClocks.UpdateLatency("0", 30*time.Nanosecond)
+
+	expLatencies := map[string]time.Duration{"0": 30, "stagnant": 20}
+	if latencies := remoteClocks.Latencies(); !reflect.DeepEqual(latencies, expLatencies) {
+		t.Errorf("expected latencies %v; got %v", expLatencies, latencies)
+	}
+	remoteClocks.lastMonitoredAt = 10
+	remoteClocks.buildEndpointList()
+	expLatencies = map[string]time.Duration{"0": 30}
+	if latencies := remoteClocks.Latencies(); !reflect.DeepEqual(latencies, expLatencies) {
+		t.Errorf("expected latencies %v; got %v", expLatencies, latencies)
+	}
+}
+
+// TestCheckOffsets verifies that an error is returned if the offset
+// from the cluster time exceeds MaxOffset or cannot be determined from
+// a majority of remote clocks, unless checking is disabled.
+func TestCheckOffsets(t *testing.T) {
+	testCases := []struct {
+		maxOffset time.Duration
+		offsets   map[string]proto.RemoteOffset
+		expErr    bool
+	}{
+		// No remote clocks.
+		{10, map[string]proto.RemoteOffset{}, false},
+		// Offsets within MaxOffset.
+		{10, map[string]proto.RemoteOffset{"0": {Offset: 5, Error: 1}, "1": {Offset: -5, Error: 1}}, false},
+		// A majority of remote clocks are far behind this clock.
+		{10, map[string]proto.RemoteOffset{"0": {Offset: 100, Error: 1}, "1": {Offset: 100, Error: 1}, "2": {Offset: 0, Error: 1}}, true},
+		// No majority overlap.
+		{1, map[string]proto.RemoteOffset{"0": {Offset: 0, Error: 1}, "1": {Offset: 10, Error: 1}}, true},
+		// Checking is disabled with a MaxOffset of 0.
+		{0, map[string]proto.RemoteOffset{"0": {Offset: 100, Error: 1}, "1": {Offset: 200, Error: 1}}, false},
+	}
+	for i, tc := range testCases {
+		manual := hlc.NewManualClock(0)
+		clock := hlc.NewClock(manual.UnixNano)
+		clock.SetMaxOffset(tc.maxOffset)
+		remoteClocks := &RemoteClockMonitor{
+			offsets: tc.offsets,
+			lClock:  clock,
+		}
+		if err := remoteClocks.checkOffsets(); (err != nil) != tc.expErr {
+			t.Errorf("%d: expected error %t; got %v", i, tc.expErr, err)
+		}
+	}
+}
+
 // TestFindOffsetInterval tests that we correctly determine the interval that
 // a majority of remote offsets overlap.
 func TestFindOffsetInterval(t *testing.T) {
@@ -28,6 +28,7 @@ import (
 	"io"
 	"net"
 	"net/rpc"
+	"sync"
 	"time"
 
 	wire "github.com/cockroachdb/cockroach/rpc/codec/wire.pb"
@@ -39,22 +40,45 @@ type clientCodec struct {
 
 	methods map[string]int32
 
+	// The compression used for requests and accepted for responses.
+	compression wire.CompressionType
+
+	mu sync.Mutex // Protects streams
+	// The streaming requests awaiting their final response, by id.
+	streams map[uint64]StreamingRequest
+
 	// temporary work space
 	reqBuf     bytes.Buffer
+	hdrBuf     bytes.Buffer
 	reqHeader  wire.RequestHeader
 	respHeader wire.ResponseHeader
 }
 
-// NewClientCodec returns a new rpc.ClientCodec using Protobuf-RPC on conn.
+// NewClientCodec returns a new rpc.ClientCodec using Protobuf-RPC on
+// conn. Request and response bodies larger than compressionThreshold
+// are snappy compressed.
 func NewClientCodec(conn io.ReadWriteCloser) rpc.ClientCodec {
-	return &clientCodec{
+	return NewClientCodecWithCompression(conn, true)
+}
+
+// NewClientCodecWithCompression returns a new rpc.ClientCodec using
+// Protobuf-RPC on conn. If compress is false, neither requests nor
+// responses on the connection are compressed.
+func NewClientCodecWithCompression(conn io.ReadWriteCloser, compress bool) rpc.ClientCodec {
+	c := &clientCodec{
 		baseConn: baseConn{
 			r: bufio.NewReader(conn),
 			w: bufio.NewWriter(conn),
 			c: conn,
 		},
-		methods: make(map[string]int32),
+		methods:     make(map[string]int32),
+		compression: wire.CompressionType_NONE,
+		streams:     make(map[uint64]StreamingRequest),
+	}
+	if compress {
+		c.compression = wire.CompressionType_SNAPPY
 	}
+	return c
 }
 
 func (c *clientCodec) WriteRequest(r *rpc.Request, param interface{}) error {
@@ -69,16 +93,42 @@ func (c *clientCodec) WriteRequest(r *rpc.Request, param interface{}) error {
 		}
 	}
 
-	if err := c.writeRequest(r, request); err != nil {
-		return err
+	stream, ok := request.(StreamingRequest)
+	if ok {
+		c.mu.Lock()
+		c.streams[r.Seq] = stream
+		c.mu.Unlock()
+	}
+	err := c.writeRequest(r, request, ok)
+	if err == nil {
+		err = c.w.Flush()
 	}
-	return c.w.Flush()
+	if err != nil && ok {
+		c.mu.Lock()
+		delete(c.streams, r.Seq)
+		c.mu.Unlock()
+	}
+	return err
 }
 
+// ReadResponseHeader reads the header of the next final response,
+// passing the chunks of streaming responses read until then to their
+// requests.
 func (c *clientCodec) ReadResponseHeader(r *rpc.Response) error {
-	if err := c.readResponseHeader(&c.respHeader); err != nil {
-		return err
+	for {
+		if err := c.readResponseHeader(&c.respHeader); err != nil {
+			return err
+		}
+		if !c.respHeader.Chunk {
+			break
+		}
+		if err := c.readChunk(); err != nil {
+			return err
+		}
 	}
+	c.mu.Lock()
+	delete(c.streams, c.respHeader.Id)
+	c.mu.Unlock()
 
 	r.Seq = c.respHeader.Id
 	r.ServiceMethod = c.respHeader.GetMethod()
@@ -108,12 +158,41 @@ func (c *clientCodec) ReadResponseBody(x interface{}) error {
 	return nil
 }
 
-func (c *clientCodec) writeRequest(r *rpc.Request, request proto.Message) error {
+// readChunk reads the body of a chunk of a streaming response and
+// passes it to the request. Chunks of requests which aren't awaiting
+// their response any longer are discarded.
+func (c *clientCodec) readChunk() error {
+	c.mu.Lock()
+	stream := c.streams[c.respHeader.Id]
+	c.mu.Unlock()
+	if stream == nil {
+		err := c.recvProto(nil, discardBody)
+		c.respHeader.Reset()
+		return err
+	}
+	chunk := stream.NewChunk()
+	if err := c.readResponseBody(&c.respHeader, chunk); err != nil {
+		return err
+	}
+	c.respHeader.Reset()
+	stream.ReceiveChunk(chunk)
+	return nil
+}
+
+func (c *clientCodec) writeRequest(r *rpc.Request, request proto.Message, stream bool) error {
+	// marshal request
+	pbRequest, err := marshal(&c.reqBuf, request)
+	if err != nil {
+		return err
+	}
+
 	// generate header
 	header := &c.reqHeader
 	*header = wire.RequestHeader{
-		Id:          r.Seq,
-		Compression: wire.CompressionType_NONE,
+		Id:                r.Seq,
+		Compression:       compressionFor(pbRequest, c.compression),
+		AcceptCompression: c.compression,
+		Stream:            stream,
 	}
 	if mid, ok := c.methods[r.ServiceMethod]; ok {
 		header.MethodId = mid
@@ -122,12 +201,9 @@ func (c *clientCodec) writeRequest(r *rpc.Request, request proto.Message) error
 		header.MethodId = int32(len(c.methods))
 		c.methods[r.ServiceMethod] = header.MethodId
 	}
-	if enableSnappy {
-		header.Compression = wire.CompressionType_SNAPPY
-	}
 
 	// marshal header
-	pbHeader, err := marshal(&c.reqBuf, header)
+	pbHeader, err := marshal(&c.hdrBuf, header)
 	if err != nil {
 		return err
 	}
@@ -137,17 +213,8 @@ func (c *clientCodec) writeRequest(r *rpc.Request, request proto.Message) error
 		return err
 	}
 
-	// marshal request
-	pbRequest, err := marshal(&c.reqBuf, request)
-	if err != nil {
-		return err
-	}
-
 	// send body (end)
-	if enableSnappy {
-		return snappyEncode(pbRequest, c.sendFrame)
-	}
-	return c.sendFrame(pbRequest)
+	return c.sendBody(pbRequest, header.Compression)
 }
 
 func (c *clientCodec) readResponseHeader(header *wire.ResponseHeader) error {
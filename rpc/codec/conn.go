@@ -24,6 +24,7 @@ package codec
 import (
 	"bufio"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"net"
 
@@ -31,7 +32,10 @@ import (
 	"github.com/gogo/protobuf/proto"
 )
 
-const enableSnappy = true
+// compressionThreshold is the minimum size of a marshaled message body
+// that is compressed. Smaller bodies are sent uncompressed, as
+// compressing them costs more than it saves.
+const compressionThreshold = 1 << 10 // 1K
 
 type decompressFunc func(src []byte, m proto.Message) error
 
@@ -45,6 +49,11 @@ type baseConn struct {
 	r        *bufio.Reader
 	c        io.Closer
 	frameBuf [binary.MaxVarintLen64]byte
+	// The maximum size of the frames received; zero means no limit.
+	maxFrameSize uint64
+	// Once a frame is left unread, as it's too large or its contents
+	// aren't accepted, all further receives fail with recvErr.
+	recvErr error
 }
 
 // Close closes the underlying connection.
@@ -69,6 +78,23 @@ func (c *baseConn) sendFrame(data []byte) error {
 	return c.write(c.w, data)
 }
 
+// compressionFor returns the compression to use for a marshaled
+// message body when the peer accepts the specified compression.
+func compressionFor(data []byte, accept wire.CompressionType) wire.CompressionType {
+	if len(data) < compressionThreshold {
+		return wire.CompressionType_NONE
+	}
+	return accept
+}
+
+// sendBody sends a marshaled message body, compressed as specified.
+func (c *baseConn) sendBody(data []byte, compression wire.CompressionType) error {
+	if compression == wire.CompressionType_SNAPPY {
+		return snappyEncode(data, c.sendFrame)
+	}
+	return c.sendFrame(data)
+}
+
 func (c *baseConn) write(w io.Writer, data []byte) error {
 	for index := 0; index < len(data); {
 		n, err := w.Write(data[index:])
@@ -83,10 +109,17 @@ func (c *baseConn) write(w io.Writer, data []byte) error {
 }
 
 func (c *baseConn) recvProto(m proto.Message, decompressor decompressFunc) error {
+	if c.recvErr != nil {
+		return c.recvErr
+	}
 	size, err := binary.ReadUvarint(c.r)
 	if err != nil {
 		return err
 	}
+	if c.maxFrameSize > 0 && size > c.maxFrameSize {
+		c.recvErr = fmt.Errorf("frame of %d bytes exceeds maximum of %d", size, c.maxFrameSize)
+		return c.recvErr
+	}
 	if size == 0 {
 		return nil
 	}
@@ -31,12 +31,15 @@ import (
 	"net"
 	"net/http"
 	"net/rpc"
+	"reflect"
+	"strings"
 	"testing"
 
 	// can not import xxx.pb with rpc stub here,
 	// because it will cause import cycle.
 
 	msg "github.com/cockroachdb/cockroach/rpc/codec/message.pb"
+	wire "github.com/cockroachdb/cockroach/rpc/codec/wire.pb"
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/gogo/protobuf/proto"
 )
@@ -73,6 +76,36 @@ func (t *Echo) Echo(args *msg.EchoRequest, reply *msg.EchoResponse) error {
 	return nil
 }
 
+// A StreamEchoRequest is an EchoRequest whose words are streamed back
+// as chunks by EchoService.StreamEcho.
+type StreamEchoRequest struct {
+	msg.EchoRequest
+	chunks []string
+	send   func(proto.Message) error
+}
+
+func (r *StreamEchoRequest) NewChunk() proto.Message { return &msg.EchoResponse{} }
+
+func (r *StreamEchoRequest) ReceiveChunk(chunk proto.Message) {
+	r.chunks = append(r.chunks, chunk.(*msg.EchoResponse).Msg)
+}
+
+func (r *StreamEchoRequest) SetChunkSender(send func(proto.Message) error) { r.send = send }
+
+func (t *Echo) StreamEcho(args *StreamEchoRequest, reply *msg.EchoResponse) error {
+	if args.send == nil {
+		reply.Msg = args.Msg
+		return nil
+	}
+	for _, word := range strings.Fields(args.Msg) {
+		if err := args.send(&msg.EchoResponse{Msg: word}); err != nil {
+			return err
+		}
+	}
+	reply.Msg = "done"
+	return nil
+}
+
 func TestAll(t *testing.T) {
 	srvAddr, err := listenAndServeArithAndEchoService("tcp", "127.0.0.1:0")
 	if err != nil {
@@ -92,6 +125,147 @@ func TestAll(t *testing.T) {
 	testEchoClientAsync(t, client)
 }
 
+// recordingClientCodec records the compression of each response
+// read by the wrapped client codec.
+type recordingClientCodec struct {
+	*clientCodec
+	compressions chan wire.CompressionType
+}
+
+func (c recordingClientCodec) ReadResponseHeader(r *rpc.Response) error {
+	err := c.clientCodec.ReadResponseHeader(r)
+	c.compressions <- c.respHeader.Compression
+	return err
+}
+
+// TestCompression verifies that only bodies of at least
+// compressionThreshold bytes are compressed and that responses are
+// compressed only if the client accepts compression.
+func TestCompression(t *testing.T) {
+	srvAddr, err := listenAndServeArithAndEchoService("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("could not start server")
+	}
+	testCases := []struct {
+		compress bool
+		size     int
+		expected wire.CompressionType
+	}{
+		{true, compressionThreshold / 2, wire.CompressionType_NONE},
+		{true, compressionThreshold * 64, wire.CompressionType_SNAPPY},
+		{false, compressionThreshold / 2, wire.CompressionType_NONE},
+		{false, compressionThreshold * 64, wire.CompressionType_NONE},
+	}
+	for i, test := range testCases {
+		conn, err := net.Dial(srvAddr.Network(), srvAddr.String())
+		if err != nil {
+			t.Fatalf("could not dial client to %s: %s", srvAddr, err)
+		}
+		codec := recordingClientCodec{
+			clientCodec:  NewClientCodecWithCompression(conn, test.compress).(*clientCodec),
+			compressions: make(chan wire.CompressionType, 1),
+		}
+		client := rpc.NewClientWithCodec(codec)
+
+		args := &msg.EchoRequest{Msg: randString(test.size)}
+		reply := &msg.EchoResponse{}
+		if err := client.Call("EchoService.Echo", args, reply); err != nil {
+			t.Fatalf("%d: EchoService.Echo: %v", i, err)
+		}
+		if reply.GetMsg() != args.GetMsg() {
+			t.Errorf("%d: EchoService.Echo: expected %d bytes, got %d", i, len(args.GetMsg()), len(reply.GetMsg()))
+		}
+		if compression := <-codec.compressions; compression != test.expected {
+			t.Errorf("%d: expected response compression %s, got %s", i, test.expected, compression)
+		}
+		client.Close()
+	}
+}
+
+// TestStreaming verifies that the chunks of a streaming response are
+// passed to the request ahead of the final response, and that
+// requests which don't accept streaming get the complete response.
+func TestStreaming(t *testing.T) {
+	srvAddr, err := listenAndServeArithAndEchoService("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("could not start server")
+	}
+	conn, err := net.Dial(srvAddr.Network(), srvAddr.String())
+	if err != nil {
+		t.Fatalf("could not dial client to %s: %s", srvAddr, err)
+	}
+	client := rpc.NewClientWithCodec(NewClientCodecWithCompression(conn, false))
+	defer client.Close()
+
+	for i := 0; i < 3; i++ {
+		args := &StreamEchoRequest{EchoRequest: msg.EchoRequest{Msg: "streamed in chunks"}}
+		reply := &msg.EchoResponse{}
+		if err := client.Call("EchoService.StreamEcho", args, reply); err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"streamed", "in", "chunks"}; !reflect.DeepEqual(args.chunks, expected) {
+			t.Errorf("%d: expected chunks %q; got %q", i, expected, args.chunks)
+		}
+		if reply.Msg != "done" {
+			t.Errorf("%d: expected final response \"done\"; got %q", i, reply.Msg)
+		}
+		// Other requests on the connection are unaffected.
+		testEchoClient(t, client)
+	}
+
+	args := &msg.EchoRequest{Msg: "not streamed"}
+	reply := &msg.EchoResponse{}
+	if err := client.Call("EchoService.StreamEcho", args, reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Msg != args.Msg {
+		t.Errorf("expected complete response %q; got %q", args.Msg, reply.Msg)
+	}
+}
+
+// TestMaxFrameSize verifies that a server codec with a maximum frame
+// size fails the request whose body exceeds it without reading the
+// body, and any requests after it.
+func TestMaxFrameSize(t *testing.T) {
+	const maxFrameSize = 256
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+	client := NewClientCodecWithCompression(clientConn, false)
+	server := NewServerCodecWithMaxFrameSize(serverConn, maxFrameSize)
+
+	go func() {
+		for i, size := range []int{maxFrameSize / 2, maxFrameSize * 2} {
+			args := &msg.EchoRequest{Msg: randString(size)}
+			if err := client.WriteRequest(&rpc.Request{ServiceMethod: "EchoService.Echo", Seq: uint64(i)}, args); err != nil {
+				// The server is done reading once the body is too large.
+				return
+			}
+		}
+	}()
+
+	var r rpc.Request
+	args := &msg.EchoRequest{}
+	if err := server.ReadRequestHeader(&r); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.ReadRequestBody(args); err != nil {
+		t.Fatal(err)
+	}
+	if len(args.GetMsg()) != maxFrameSize/2 {
+		t.Errorf("expected %d bytes, got %d", maxFrameSize/2, len(args.GetMsg()))
+	}
+	if err := server.ReadRequestHeader(&r); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.ReadRequestBody(args); err == nil {
+		t.Error("expected body exceeding the maximum frame size to fail")
+	}
+	if err := server.ReadRequestHeader(&r); err == nil {
+		t.Error("expected request after the failed body to fail")
+	}
+}
+
 func listenAndServeArithAndEchoService(network, addr string) (net.Addr, error) {
 	clients, err := net.Listen(network, addr)
 	if err != nil {
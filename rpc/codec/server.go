@@ -27,6 +27,8 @@ import (
 	"fmt"
 	"io"
 	"net/rpc"
+	"sync"
+	"sync/atomic"
 
 	wire "github.com/cockroachdb/cockroach/rpc/codec/wire.pb"
 	"github.com/gogo/protobuf/proto"
@@ -37,8 +39,19 @@ type serverCodec struct {
 
 	methods []string
 
+	// The compression accepted by the client for response bodies, as
+	// sent in its request headers. Accessed atomically, as responses
+	// are written concurrently with reading requests.
+	acceptCompression int32
+
+	// writeMu serializes the responses written by net/rpc with the
+	// chunks sent by the handlers of streaming requests, and protects
+	// the temporary work space used to write them.
+	writeMu sync.Mutex
+
 	// temporary work space
 	respBuf    bytes.Buffer
+	hdrBuf     bytes.Buffer
 	respHeader wire.ResponseHeader
 	reqHeader  wire.RequestHeader
 }
@@ -62,6 +75,7 @@ func (c *serverCodec) ReadRequestHeader(r *rpc.Request) error {
 	}
 
 	r.Seq = c.reqHeader.Id
+	atomic.StoreInt32(&c.acceptCompression, int32(c.reqHeader.AcceptCompression))
 	if c.reqHeader.Method == nil {
 		if int(c.reqHeader.MethodId) >= len(c.methods) {
 			return fmt.Errorf("unexpected method-id: %d >= %d",
@@ -92,9 +106,15 @@ func (c *serverCodec) ReadRequestBody(x interface{}) error {
 
 	err := c.readRequestBody(c.r, &c.reqHeader, request)
 	if err != nil {
-		return nil
+		return err
 	}
 
+	if stream, ok := request.(StreamingRequest); ok && c.reqHeader.Stream {
+		id := c.reqHeader.Id
+		stream.SetChunkSender(func(chunk proto.Message) error {
+			return c.writeChunk(id, chunk)
+		})
+	}
 	c.reqHeader.Reset()
 	return nil
 }
@@ -113,19 +133,38 @@ func (c *serverCodec) WriteResponse(r *rpc.Response, x interface{}) error {
 		}
 	}
 
-	if err := c.writeResponse(r, response); err != nil {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.writeResponse(r, response, false); err != nil {
+		return err
+	}
+	return c.w.Flush()
+}
+
+// writeChunk writes a chunk of the streaming response to request id.
+func (c *serverCodec) writeChunk(id uint64, chunk proto.Message) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.writeResponse(&rpc.Response{Seq: id}, chunk, true); err != nil {
 		return err
 	}
 	return c.w.Flush()
 }
 
-func (c *serverCodec) writeResponse(r *rpc.Response, response proto.Message) error {
+func (c *serverCodec) writeResponse(r *rpc.Response, response proto.Message, chunk bool) error {
 	// clear response if error
 	if r.Error != "" {
 		response = nil
 	}
 
+	// marshal response
+	pbResponse, err := marshal(&c.respBuf, response)
+	if err != nil {
+		return err
+	}
+
 	// generate header
+	accept := wire.CompressionType(atomic.LoadInt32(&c.acceptCompression))
 	header := &c.respHeader
 	*header = wire.ResponseHeader{
 		Id: r.Seq,
@@ -134,14 +173,12 @@ func (c *serverCodec) writeResponse(r *rpc.Response, response proto.Message) err
 		//
 		// Method: r.ServiceMethod,
 		Error:       r.Error,
-		Compression: wire.CompressionType_NONE,
-	}
-	if enableSnappy {
-		header.Compression = wire.CompressionType_SNAPPY
+		Compression: compressionFor(pbResponse, accept),
+		Chunk:       chunk,
 	}
 
 	// marshal header
-	pbHeader, err := marshal(&c.respBuf, header)
+	pbHeader, err := marshal(&c.hdrBuf, header)
 	if err != nil {
 		return err
 	}
@@ -151,17 +188,8 @@ func (c *serverCodec) writeResponse(r *rpc.Response, response proto.Message) err
 		return err
 	}
 
-	// marshal response
-	pbResponse, err := marshal(&c.respBuf, response)
-	if err != nil {
-		return err
-	}
-
 	// send body (end)
-	if enableSnappy {
-		return snappyEncode(pbResponse, c.sendFrame)
-	}
-	return c.sendFrame(pbResponse)
+	return c.sendBody(pbResponse, header.Compression)
 }
 
 func (c *serverCodec) readRequestHeader(r *bufio.Reader, header *wire.RequestHeader) error {
@@ -170,9 +198,27 @@ func (c *serverCodec) readRequestHeader(r *bufio.Reader, header *wire.RequestHea
 
 func (c *serverCodec) readRequestBody(r *bufio.Reader, header *wire.RequestHeader,
 	request proto.Message) error {
+	if c.maxFrameSize > 0 && header.Compression != wire.CompressionType_NONE {
+		// The body is left unread, so the connection is done for.
+		if c.recvErr == nil {
+			c.recvErr = fmt.Errorf("compressed request bodies are not accepted")
+		}
+		return c.recvErr
+	}
 	return c.recvProto(request, decompressors[header.Compression])
 }
 
+// NewServerCodecWithMaxFrameSize returns a serverCodec like
+// NewServerCodec which fails once a frame larger than maxFrameSize
+// bytes is received, without reading it. As compressed bodies could
+// expand beyond the limit, requests with compressed bodies are
+// rejected.
+func NewServerCodecWithMaxFrameSize(conn io.ReadWriteCloser, maxFrameSize uint64) rpc.ServerCodec {
+	c := NewServerCodec(conn).(*serverCodec)
+	c.maxFrameSize = maxFrameSize
+	return c
+}
+
 // ServeConn runs the Protobuf-RPC server on a single connection.
 // ServeConn blocks, serving the connection until the client hangs up.
 // The caller typically invokes ServeConn in a go statement.
@@ -0,0 +1,48 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package codec
+
+import "github.com/gogo/protobuf/proto"
+
+// A StreamingRequest is a request whose response may be streamed back
+// as a series of chunks ahead of the final response, so that a large
+// response needn't be materialized in full on either end.
+//
+// On the client, each chunk is decoded into a message obtained from
+// NewChunk and passed to ReceiveChunk. ReceiveChunk is invoked on the
+// goroutine reading the connection, so it delays the responses to all
+// other requests on the connection until it returns; in exchange, a
+// slow receiver slows the server down rather than having chunks pile
+// up in memory.
+//
+// On the server, the codec supplies the function with which the
+// handler sends chunks via SetChunkSender before the request is
+// handled. SetChunkSender is not called for requests sent by clients
+// which don't accept streamed responses, whose handlers must return
+// the complete response instead.
+type StreamingRequest interface {
+	proto.Message
+	NewChunk() proto.Message
+	ReceiveChunk(chunk proto.Message)
+	SetChunkSender(send func(chunk proto.Message) error)
+}
+
+// discardBody is a decompressFunc which discards the body read.
+func discardBody([]byte, proto.Message) error {
+	return nil
+}
@@ -83,11 +83,18 @@ func (x *CompressionType) UnmarshalJSON(data []byte) error {
 }
 
 type RequestHeader struct {
-	Id               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
-	Method           *string         `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
-	MethodId         int32           `protobuf:"varint,3,opt,name=method_id" json:"method_id"`
-	Compression      CompressionType `protobuf:"varint,4,opt,name=compression,enum=wire.CompressionType" json:"compression"`
-	XXX_unrecognized []byte          `json:"-"`
+	Id          uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
+	Method      *string         `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	MethodId    int32           `protobuf:"varint,3,opt,name=method_id" json:"method_id"`
+	Compression CompressionType `protobuf:"varint,4,opt,name=compression,enum=wire.CompressionType" json:"compression"`
+	// The compression the client accepts for the response body. Clients
+	// send this on every request, so it is fixed for the lifetime of
+	// the connection.
+	AcceptCompression CompressionType `protobuf:"varint,5,opt,name=accept_compression,enum=wire.CompressionType" json:"accept_compression"`
+	// True if the client accepts the response as a stream of chunks
+	// ahead of the final response.
+	Stream           bool   `protobuf:"varint,6,opt,name=stream" json:"stream"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *RequestHeader) Reset()         { *m = RequestHeader{} }
@@ -122,12 +129,29 @@ func (m *RequestHeader) GetCompression() CompressionType {
 	return CompressionType_NONE
 }
 
+func (m *RequestHeader) GetAcceptCompression() CompressionType {
+	if m != nil {
+		return m.AcceptCompression
+	}
+	return CompressionType_NONE
+}
+
+func (m *RequestHeader) GetStream() bool {
+	if m != nil {
+		return m.Stream
+	}
+	return false
+}
+
 type ResponseHeader struct {
-	Id               uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
-	Method           *string         `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
-	Error            string          `protobuf:"bytes,3,opt,name=error" json:"error"`
-	Compression      CompressionType `protobuf:"varint,4,opt,name=compression,enum=wire.CompressionType" json:"compression"`
-	XXX_unrecognized []byte          `json:"-"`
+	Id          uint64          `protobuf:"varint,1,opt,name=id" json:"id"`
+	Method      *string         `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	Error       string          `protobuf:"bytes,3,opt,name=error" json:"error"`
+	Compression CompressionType `protobuf:"varint,4,opt,name=compression,enum=wire.CompressionType" json:"compression"`
+	// True if the body is a chunk of a streaming response. The chunks of
+	// a response are followed by its final response, with the same id.
+	Chunk            bool   `protobuf:"varint,5,opt,name=chunk" json:"chunk"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
@@ -162,6 +186,13 @@ func (m *ResponseHeader) GetCompression() CompressionType {
 	return CompressionType_NONE
 }
 
+func (m *ResponseHeader) GetChunk() bool {
+	if m != nil {
+		return m.Chunk
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("wire.CompressionType", CompressionType_name, CompressionType_value)
 }
@@ -252,6 +283,38 @@ func (m *RequestHeader) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptCompression", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.AcceptCompression |= (CompressionType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stream = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -369,6 +432,23 @@ func (m *ResponseHeader) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Chunk = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -402,6 +482,8 @@ func (m *RequestHeader) Size() (n int) {
 	}
 	n += 1 + sovWire(uint64(m.MethodId))
 	n += 1 + sovWire(uint64(m.Compression))
+	n += 1 + sovWire(uint64(m.AcceptCompression))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -419,6 +501,7 @@ func (m *ResponseHeader) Size() (n int) {
 	l = len(m.Error)
 	n += 1 + l + sovWire(uint64(l))
 	n += 1 + sovWire(uint64(m.Compression))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -468,6 +551,17 @@ func (m *RequestHeader) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x20
 	i++
 	i = encodeVarintWire(data, i, uint64(m.Compression))
+	data[i] = 0x28
+	i++
+	i = encodeVarintWire(data, i, uint64(m.AcceptCompression))
+	data[i] = 0x30
+	i++
+	if m.Stream {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -505,6 +599,14 @@ func (m *ResponseHeader) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x20
 	i++
 	i = encodeVarintWire(data, i, uint64(m.Compression))
+	data[i] = 0x28
+	i++
+	if m.Chunk {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
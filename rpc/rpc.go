@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"crypto/tls"
+	"sync"
 
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
@@ -10,10 +11,13 @@ import (
 // Context contains the fields required by the rpc framework.
 type Context struct {
 	localClock   *hlc.Clock
-	tlsConfig    *tls.Config
+	tlsMu        sync.Mutex  // Protects tlsConfig
+	tlsConfig    *tls.Config // Use TLSConfig() for access
 	stopper      *util.Stopper
 	RemoteClocks *RemoteClockMonitor
 	DisableCache bool // Disable client cache when calling NewClient()
+	// Disable compression of RPC payloads on client connections.
+	DisableCompression bool
 }
 
 // NewContext creates an rpc Context with the supplied values.
@@ -30,10 +34,29 @@ func NewContext(clock *hlc.Clock, config *tls.Config, stopper *util.Stopper) *Co
 // new remote clock monitor.
 func (c *Context) Copy() *Context {
 	return &Context{
-		localClock:   c.localClock,
-		tlsConfig:    c.tlsConfig,
-		stopper:      c.stopper,
-		RemoteClocks: newRemoteClockMonitor(c.localClock),
-		DisableCache: c.DisableCache,
+		localClock:         c.localClock,
+		tlsConfig:          c.tlsConfig,
+		stopper:            c.stopper,
+		RemoteClocks:       newRemoteClockMonitor(c.localClock),
+		DisableCache:       c.DisableCache,
+		DisableCompression: c.DisableCompression,
 	}
 }
+
+// TLSConfig returns the TLS config used for new client connections
+// and for handshakes of new server connections.
+func (c *Context) TLSConfig() *tls.Config {
+	c.tlsMu.Lock()
+	defer c.tlsMu.Unlock()
+	return c.tlsConfig
+}
+
+// SetTLSConfig replaces the TLS config, for example after certificates
+// have been reloaded from disk. Connections established before the
+// call are unaffected; new connections use the supplied config. A
+// server started with TLS can't be switched to an insecure config.
+func (c *Context) SetTLSConfig(config *tls.Config) {
+	c.tlsMu.Lock()
+	defer c.tlsMu.Unlock()
+	c.tlsConfig = config
+}
@@ -60,9 +60,11 @@ type Options struct {
 }
 
 // An rpcError indicates a failure to send the RPC. rpcErrors are
-// retryable.
+// retryable. An rpcError is ambiguous if the RPC failed after it was
+// sent, in which case it may have been executed.
 type rpcError struct {
-	errMsg string
+	errMsg    string
+	ambiguous bool
 }
 
 // Error implements the error interface.
@@ -76,10 +78,12 @@ var sendOneFn = sendOne
 
 // A SendError indicates that too many RPCs to the replica
 // set failed to achieve requested number of successful responses.
-// canRetry is set depending on the types of errors encountered.
+// canRetry is set depending on the types of errors encountered, and
+// ambiguous if any of the RPCs failed after being sent.
 type SendError struct {
-	errMsg   string
-	canRetry bool
+	errMsg    string
+	canRetry  bool
+	ambiguous bool
 }
 
 // Error implements the error interface.
@@ -90,6 +94,10 @@ func (s SendError) Error() string {
 // CanRetry implements the Retryable interface.
 func (s SendError) CanRetry() bool { return s.canRetry }
 
+// Ambiguous returns true if any of the failed RPCs may have been
+// executed.
+func (s SendError) Ambiguous() bool { return s.ambiguous }
+
 // Send sends one or more method RPCs to clients specified by the
 // slice of endpoint addrs. Arguments for methods are obtained using
 // the supplied getArgs function. The number of required replies is
@@ -149,6 +157,7 @@ func Send(opts Options, method string, addrs []net.Addr, getArgs func(addr net.A
 	N := opts.N
 	errors := 0
 	retryableErrors := 0
+	ambiguous := false
 	successes := 0
 	index := 0
 
@@ -174,14 +183,18 @@ func Send(opts Options, method string, addrs []net.Addr, getArgs func(addr net.A
 				if retryErr, ok := t.(util.Retryable); ok && retryErr.CanRetry() {
 					retryableErrors++
 				}
+				if rpcErr, ok := t.(rpcError); ok && rpcErr.ambiguous {
+					ambiguous = true
+				}
 				if log.V(1) {
 					log.Warningf("%s: error reply: %+v", method, t)
 				}
 				remainingNonErrorRPCs := len(clients) - errors
 				if remainingNonErrorRPCs < opts.N {
 					return nil, SendError{
-						errMsg:   fmt.Sprintf("too many errors encountered (%d of %d total): %v", errors, len(clients), t),
-						canRetry: remainingNonErrorRPCs+retryableErrors >= opts.N,
+						errMsg:    fmt.Sprintf("too many errors encountered (%d of %d total): %v", errors, len(clients), t),
+						canRetry:  remainingNonErrorRPCs+retryableErrors >= opts.N,
+						ambiguous: ambiguous,
 					}
 				}
 				// Send to additional replicas if available.
@@ -216,12 +229,13 @@ func sendOne(client *Client, timeout time.Duration, method string, args, reply i
 	select {
 	case <-client.Ready:
 	case <-client.Closed:
-		c <- rpcError{fmt.Sprintf("rpc to %s failed as client connection was closed", method)}
+		c <- rpcError{errMsg: fmt.Sprintf("rpc to %s failed as client connection was closed", method)}
 		return
 	case <-time.After(timeout):
-		c <- rpcError{fmt.Sprintf("rpc to %s: client not ready after %s", method, timeout)}
+		c <- rpcError{errMsg: fmt.Sprintf("rpc to %s: client not ready after %s", method, timeout)}
 		return
 	}
+	// Once sent, the RPC may be executed even if it fails.
 	call := client.Go(method, args, reply, nil)
 	select {
 	case <-call.Done:
@@ -231,7 +245,7 @@ func sendOne(client *Client, timeout time.Duration, method string, args, reply i
 			case rpc.ErrShutdown: // client connection fails: rpc/client.go
 				fallthrough
 			case io.ErrUnexpectedEOF: // server connection fails: rpc/client.go
-				c <- rpcError{call.Error.Error()}
+				c <- rpcError{errMsg: call.Error.Error(), ambiguous: true}
 			default:
 				// Otherwise, not retryable; just return error.
 				c <- call.Error
@@ -249,8 +263,8 @@ func sendOne(client *Client, timeout time.Duration, method string, args, reply i
 			c <- reply
 		}
 	case <-client.Closed:
-		c <- rpcError{fmt.Sprintf("rpc to %s failed as client connection was closed", method)}
+		c <- rpcError{errMsg: fmt.Sprintf("rpc to %s failed as client connection was closed", method), ambiguous: true}
 	case <-time.After(timeout):
-		c <- rpcError{fmt.Sprintf("rpc to %s timed out after %s", method, timeout)}
+		c <- rpcError{errMsg: fmt.Sprintf("rpc to %s timed out after %s", method, timeout), ambiguous: true}
 	}
 }
@@ -311,6 +311,50 @@ func TestComplexScenarios(t *testing.T) {
 	}
 }
 
+// TestAmbiguousError verifies that Send's error is ambiguous if any
+// of the failed RPCs failed after being sent.
+func TestAmbiguousError(t *testing.T) {
+	rpcContext := NewTestContext(t)
+	var serverAddrs []net.Addr
+	for j := 0; j < 2; j++ {
+		s := createAndStartNewServer(rpcContext, t)
+		defer s.Close()
+		serverAddrs = append(serverAddrs, s.Addr())
+	}
+	opts := Options{
+		N:               1,
+		Ordering:        OrderStable,
+		SendNextTimeout: 1 * time.Second,
+		Timeout:         1 * time.Second,
+	}
+	getArgs := func(addr net.Addr) interface{} {
+		return addr
+	}
+	getReply := func() interface{} {
+		return 0
+	}
+	defer func() { sendOneFn = sendOne }()
+
+	for i, ambiguousAddr := range []int{-1, 0, 1} {
+		sendOneFn = func(client *Client, timeout time.Duration, method string, args, reply interface{},
+			c chan interface{}) {
+			ambiguous := ambiguousAddr >= 0 && args.(net.Addr).String() == serverAddrs[ambiguousAddr].String()
+			c <- rpcError{errMsg: "test", ambiguous: ambiguous}
+		}
+		_, err := Send(opts, "Heartbeat.Ping", serverAddrs, getArgs, getReply, rpcContext)
+		sendErr, ok := err.(SendError)
+		if !ok {
+			t.Fatalf("%d: unexpected error type: %v", i, err)
+		}
+		if !sendErr.CanRetry() {
+			t.Errorf("%d: expected retryable error: %v", i, err)
+		}
+		if sendErr.Ambiguous() != (ambiguousAddr >= 0) {
+			t.Errorf("%d: expected ambiguous=%t; got %v", i, ambiguousAddr >= 0, err)
+		}
+	}
+}
+
 // createAndStartNewServer creates and starts a new server with a test address.
 func createAndStartNewServer(rpcContext *Context, t *testing.T) *Server {
 	s := NewServer(util.CreateTestAddr("tcp"), rpcContext)
@@ -18,15 +18,21 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/rpc"
 	"sync"
+	"time"
 
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc/codec"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/audit"
+	"github.com/cockroachdb/cockroach/util/fault"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -46,6 +52,7 @@ type Server struct {
 	addr           net.Addr              // Server address; may change if picking unused port
 	closed         bool                  // Set upon invocation of Close()
 	closeCallbacks []func(conn net.Conn) // Slice of callbacks to invoke on conn close
+	faults         *fault.Injector       // Faults injected into served requests
 }
 
 // NewServer creates a new instance of Server.
@@ -73,12 +80,25 @@ func (s *Server) AddCloseCallback(cb func(conn net.Conn)) {
 	s.closeCallbacks = append(s.closeCallbacks, cb)
 }
 
+// SetFaultInjector sets the Injector consulted before each request is
+// served. The endpoints of its rules are the remote address of the
+// connection carrying a request and the server's address; as the
+// remote address of a client is generally unknown in advance, rules
+// from fault.Any isolate the server from all of its clients. Dropping
+// a request closes its connection, failing the request and any others
+// in flight on the connection. A nil Injector injects no faults.
+func (s *Server) SetFaultInjector(faults *fault.Injector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = faults
+}
+
 // Can connect to RPC service using HTTP CONNECT to rpcPath.
 var connected = "200 Connected to Go RPC"
 
 // ServeHTTP implements an http.Handler that answers RPC requests.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if r.URL.Path != rpc.DefaultRPCPath {
+	if r.URL.Path != rpc.DefaultRPCPath && r.URL.Path != streamRPCPath {
 		if s.handler != nil {
 			s.handler.ServeHTTP(w, r)
 			return
@@ -99,7 +119,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	io.WriteString(conn, "HTTP/1.0 "+connected+"\n\n")
-	s.serveConn(conn)
+	if r.URL.Path == streamRPCPath {
+		s.serveStream(conn, r.TLS)
+		return
+	}
+	s.serveConn(conn, r.TLS)
 }
 
 // Listen listens on the configured address but does not start
@@ -107,7 +131,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (s *Server) Listen() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	ln, err := tlsListen(s.addr.Network(), s.addr.String(), s.context.tlsConfig)
+	ln, err := tlsListen(s.addr.Network(), s.addr.String(), s.context)
 	if err != nil {
 		return err
 	}
@@ -198,10 +222,12 @@ func (s *Server) Close() {
 	}
 }
 
-// serveConn synchronously serves a single connection. When the
-// connection is closed, close callbacks are invoked.
-func (s *Server) serveConn(conn net.Conn) {
-	s.ServeCodec(codec.NewServerCodec(conn))
+// serveConn synchronously serves a single connection. Requests are
+// authenticated against the supplied TLS state, which is nil for
+// insecure connections. When the connection is closed, close
+// callbacks are invoked.
+func (s *Server) serveConn(conn net.Conn, state *tls.ConnectionState) {
+	s.ServeCodec(s.wrapServerCodec(codec.NewServerCodec(conn), conn, state))
 	s.mu.Lock()
 	if s.closeCallbacks != nil {
 		for _, cb := range s.closeCallbacks {
@@ -211,3 +237,69 @@ func (s *Server) serveConn(conn net.Conn) {
 	s.mu.Unlock()
 	conn.Close()
 }
+
+// wrapServerCodec wraps the codec of a connection to authenticate the
+// requests read against the supplied TLS state and to inject faults.
+func (s *Server) wrapServerCodec(serverCodec rpc.ServerCodec, conn net.Conn,
+	state *tls.ConnectionState) rpc.ServerCodec {
+	serverCodec = authServerCodec{ServerCodec: serverCodec, state: state}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.faults != nil {
+		serverCodec = faultServerCodec{
+			ServerCodec: serverCodec,
+			faults:      s.faults,
+			from:        conn.RemoteAddr().String(),
+			to:          s.addr.String(),
+		}
+	}
+	return serverCodec
+}
+
+// faultServerCodec wraps a server codec to inject faults into the
+// requests read.
+type faultServerCodec struct {
+	rpc.ServerCodec
+	faults   *fault.Injector
+	from, to string
+}
+
+// ReadRequestHeader reads the request header, then drops or delays the
+// request as directed by the fault injector. A request is dropped by
+// returning an error, which closes the connection.
+func (c faultServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	if err := c.ServerCodec.ReadRequestHeader(r); err != nil {
+		return err
+	}
+	action := c.faults.Action(c.from, c.to)
+	if action.Drop {
+		return util.Errorf("request %s from %s dropped by fault injection", r.ServiceMethod, c.from)
+	}
+	if action.Delay > 0 {
+		time.Sleep(action.Delay)
+	}
+	return nil
+}
+
+// authServerCodec wraps a server codec to tie the user of each
+// request read to the user authenticated by the connection's client
+// certificate.
+type authServerCodec struct {
+	rpc.ServerCodec
+	state *tls.ConnectionState
+}
+
+// ReadRequestBody reads the request body and authenticates it if it
+// is a request to the key value API.
+func (c authServerCodec) ReadRequestBody(x interface{}) error {
+	if err := c.ServerCodec.ReadRequestBody(x); err != nil {
+		return err
+	}
+	if args, ok := x.(proto.Request); ok {
+		if err := security.AuthenticateRequest(args, c.state); err != nil {
+			audit.LogRequest(audit.EventAuthFailed, args, err)
+			return err
+		}
+	}
+	return nil
+}
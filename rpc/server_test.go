@@ -18,9 +18,15 @@
 package rpc
 
 import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/fault"
 )
 
 func checkUpdateMatches(t *testing.T, network, oldAddrString, newAddrString, expAddrString string) {
@@ -59,3 +65,111 @@ func TestUpdatedAddr(t *testing.T) {
 	checkUpdateMatches(t, "unix", "address", "address", "address")
 	checkUpdateFails(t, "unix", "address", "anotheraddress")
 }
+
+// peerCertificate returns the certificate served by the server
+// listening at addr.
+func peerCertificate(t *testing.T, addr string) []byte {
+	conn, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].Raw
+}
+
+// TestServerReloadsCertificate verifies that new connections to a
+// server are handshaked with the certificate of the context's current
+// TLS config.
+func TestServerReloadsCertificate(t *testing.T) {
+	context := NewTestContext(t)
+	s := NewServer(util.CreateTestAddr("tcp"), context)
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if cert := peerCertificate(t, s.Addr().String()); !bytes.Equal(cert, context.TLSConfig().Certificates[0].Certificate[0]) {
+		t.Fatal("expected initial certificate to be served")
+	}
+
+	// Any certificate will do, as the client doesn't verify it.
+	certBytes, key, err := security.GenerateCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	context.SetTLSConfig(&tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certBytes}, PrivateKey: key}},
+	})
+
+	if cert := peerCertificate(t, s.Addr().String()); !bytes.Equal(cert, certBytes) {
+		t.Fatal("expected reloaded certificate to be served")
+	}
+}
+
+// TestServerReloadsClientCAs verifies that client certificates of new
+// connections to a server are verified against the client CAs of the
+// context's current TLS config.
+func TestServerReloadsClientCAs(t *testing.T) {
+	context := NewTestContext(t)
+	s := NewServer(util.CreateTestAddr("tcp"), context)
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	clientConfig := context.TLSConfig()
+	conn, err := tlsDialHTTP("tcp", s.Addr().String(), clientConfig)
+	if err != nil {
+		t.Fatalf("expected client certificate to be accepted: %s", err)
+	}
+	conn.Close()
+
+	// Trust no client CAs.
+	context.SetTLSConfig(&tls.Config{
+		Certificates: clientConfig.Certificates,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    x509.NewCertPool(),
+	})
+
+	if conn, err := tlsDialHTTP("tcp", s.Addr().String(), clientConfig); err == nil {
+		conn.Close()
+		t.Fatal("expected client certificate to be rejected after reloading client CAs")
+	}
+}
+
+// TestServerFaultInjection verifies that requests to a server isolated
+// by its fault injector are dropped, and that they're served again once
+// the fault is healed.
+func TestServerFaultInjection(t *testing.T) {
+	rpcContext := NewTestContext(t)
+	rpcContext.DisableCache = true
+	s := NewServer(util.CreateTestAddr("tcp"), rpcContext)
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	faults := fault.NewInjector(0)
+	faults.Isolate(s.Addr().String())
+	s.SetFaultInjector(faults)
+
+	opts := &util.RetryOptions{Backoff: time.Millisecond, MaxBackoff: time.Millisecond, Constant: 1, MaxAttempts: 1}
+	c := NewClient(s.Addr(), opts, rpcContext)
+	select {
+	case <-c.Ready:
+		t.Fatal("expected heartbeat to isolated server to fail")
+	case <-c.Closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for client to close")
+	}
+
+	faults.Heal()
+	c = NewClient(s.Addr(), opts, rpcContext)
+	select {
+	case <-c.Ready:
+	case <-c.Closed:
+		t.Fatal("expected heartbeat to healed server to succeed")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for client to connect")
+	}
+	c.Close()
+}
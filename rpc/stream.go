@@ -0,0 +1,115 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package rpc
+
+import (
+	"crypto/tls"
+	"net"
+	"net/rpc"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/rpc/codec"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// streamRPCPath is the path of the CONNECT requests opening streams.
+const streamRPCPath = rpc.DefaultRPCPath + "/stream"
+
+// MaxStreamMessageSize is the maximum size in bytes of the arguments
+// of an RPC sent on a stream. Servers close streams on which larger
+// messages arrive without reading them.
+const MaxStreamMessageSize = 16 << 20 // 16M
+
+// A Stream sends one-way RPCs to a server over a connection of its
+// own. The server serves them like other RPCs, but sends no
+// responses: the errors returned by their methods are logged by the
+// server instead.
+type Stream struct {
+	mu    sync.Mutex // Protects codec and seq
+	codec rpc.ClientCodec
+	seq   uint64
+}
+
+// OpenStream opens a stream to the server at addr.
+func OpenStream(addr net.Addr, context *Context) (*Stream, error) {
+	conn, err := tlsDialHTTPPath(addr.Network(), addr.String(), context.TLSConfig(), streamRPCPath)
+	if err != nil {
+		return nil, err
+	}
+	// Servers don't accept compressed messages on streams, as they
+	// could expand beyond MaxStreamMessageSize.
+	return &Stream{codec: codec.NewClientCodecWithCompression(conn, false)}, nil
+}
+
+// Send sends an RPC to serviceMethod with args. It returns once args
+// has been written to the connection, without waiting for the RPC to
+// be served. An error means the stream failed and must be closed.
+func (s *Stream) Send(serviceMethod string, args gogoproto.Message) error {
+	var size int
+	if m, ok := args.(interface {
+		Size() int
+	}); ok {
+		// Avoid marshaling generated messages just to size them.
+		size = m.Size()
+	} else {
+		size = gogoproto.Size(args)
+	}
+	if size > MaxStreamMessageSize {
+		return util.Errorf("%s message of %d bytes exceeds maximum of %d",
+			serviceMethod, size, MaxStreamMessageSize)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	return s.codec.WriteRequest(&rpc.Request{ServiceMethod: serviceMethod, Seq: s.seq}, args)
+}
+
+// Close closes the stream's connection. RPCs sent but not yet read by
+// the server may be lost.
+func (s *Stream) Close() error {
+	return s.codec.Close()
+}
+
+// serveStream synchronously serves a stream. Its requests are
+// authenticated and subject to fault injection like those of other
+// connections.
+func (s *Server) serveStream(conn net.Conn, state *tls.ConnectionState) {
+	serverCodec := codec.NewServerCodecWithMaxFrameSize(conn, MaxStreamMessageSize)
+	s.ServeCodec(streamServerCodec{
+		ServerCodec: s.wrapServerCodec(serverCodec, conn, state),
+		from:        conn.RemoteAddr().String(),
+	})
+}
+
+// streamServerCodec wraps the server codec of a stream to discard the
+// responses to its requests.
+type streamServerCodec struct {
+	rpc.ServerCodec
+	from string
+}
+
+// WriteResponse logs the error of a failed request instead of sending
+// a response.
+func (c streamServerCodec) WriteResponse(r *rpc.Response, x interface{}) error {
+	if r.Error != "" {
+		log.Warningf("%s streamed from %s failed: %s", r.ServiceMethod, c.from, r.Error)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package rpc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// recordingService records the pings it is sent.
+type recordingService struct {
+	pings chan string
+}
+
+func (s *recordingService) Record(args *proto.PingRequest, reply *proto.PingResponse) error {
+	s.pings <- args.Ping
+	return nil
+}
+
+func startRecordingServer(t *testing.T, context *Context) (*Server, *recordingService) {
+	s := NewServer(util.CreateTestAddr("tcp"), context)
+	service := &recordingService{pings: make(chan string, 10)}
+	if err := s.RegisterName("Recording", service); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return s, service
+}
+
+// TestStream verifies that the RPCs sent on a stream are served.
+func TestStream(t *testing.T) {
+	context := NewTestContext(t)
+	s, service := startRecordingServer(t, context)
+	defer s.Close()
+
+	stream, err := OpenStream(s.Addr(), context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+
+	expected := map[string]bool{"a": true, "b": true, "c": true}
+	for ping := range expected {
+		if err := stream.Send("Recording.Record", &proto.PingRequest{Ping: ping}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// RPCs are served concurrently, so they may arrive in any order.
+	for range expected {
+		select {
+		case ping := <-service.pings:
+			if !expected[ping] {
+				t.Errorf("unexpected ping %q", ping)
+			}
+			delete(expected, ping)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for pings %v", expected)
+		}
+	}
+}
+
+// TestStreamMaxMessageSize verifies that messages larger than
+// MaxStreamMessageSize aren't sent.
+func TestStreamMaxMessageSize(t *testing.T) {
+	context := NewTestContext(t)
+	s, service := startRecordingServer(t, context)
+	defer s.Close()
+
+	stream, err := OpenStream(s.Addr(), context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+
+	args := &proto.PingRequest{Ping: strings.Repeat("x", MaxStreamMessageSize)}
+	if err := stream.Send("Recording.Record", args); err == nil {
+		t.Fatal("expected message exceeding the maximum size to fail")
+	}
+	if err := stream.Send("Recording.Record", &proto.PingRequest{Ping: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ping := <-service.pings:
+		if ping != "a" {
+			t.Errorf("expected ping %q; got %q", "a", ping)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for ping")
+	}
+}
@@ -33,15 +33,46 @@ import (
 )
 
 // tlsListen wraps either net.Listen or crypto/tls.Listen, depending on the contents of
-// the passed TLS Config.
-func tlsListen(network, address string, config *tls.Config) (net.Listener, error) {
+// the context's TLS Config. Each connection accepted by a TLS listener
+// is handshaked with the context's TLS config at the time it's
+// accepted, so that reloaded certificates and client CAs apply to new
+// connections without restarting the listener.
+func tlsListen(network, address string, context *Context) (net.Listener, error) {
+	config := context.TLSConfig()
 	if config == nil {
 		if network != "unix" {
 			log.Warningf("listening via %s to %s without TLS", network, address)
 		}
 		return net.Listen(network, address)
 	}
-	return tls.Listen(network, address, config)
+	ln, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return tlsListener{Listener: ln, context: context}, nil
+}
+
+// tlsListener is a TLS listener whose connections use the TLS config
+// of its context at the time they're accepted.
+type tlsListener struct {
+	net.Listener
+	context *Context
+}
+
+// Accept waits for and returns the next connection, wrapped in a TLS
+// server connection.
+func (l tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	config := l.context.TLSConfig()
+	if config == nil {
+		// Servers started with TLS can't switch to an insecure
+		// config; fail the handshake rather than the listener.
+		config = &tls.Config{}
+	}
+	return tls.Server(conn, config), nil
 }
 
 // tlsDial wraps either net.Dial or crypto/tls.Dial, depending on the contents of
@@ -58,13 +89,19 @@ func tlsDial(network, address string, config *tls.Config) (net.Conn, error) {
 
 // tlsDialHTTP connects to an HTTP RPC server at the specified address.
 func tlsDialHTTP(network, address string, config *tls.Config) (net.Conn, error) {
+	return tlsDialHTTPPath(network, address, config, rpc.DefaultRPCPath)
+}
+
+// tlsDialHTTPPath connects to an HTTP RPC server at the specified
+// address, listening on the specified path.
+func tlsDialHTTPPath(network, address string, config *tls.Config, path string) (net.Conn, error) {
 	conn, err := tlsDial(network, address, config)
 	if err != nil {
 		return conn, err
 	}
 
 	// Note: this code was adapted from net/rpc.DialHTTPPath.
-	io.WriteString(conn, "CONNECT "+rpc.DefaultRPCPath+" HTTP/1.0\n\n")
+	io.WriteString(conn, "CONNECT "+path+" HTTP/1.0\n\n")
 
 	// Require successful HTTP response before switching to RPC protocol.
 	resp, err := http.ReadResponse(bufio.NewReader(conn), &http.Request{Method: "CONNECT"})
@@ -0,0 +1,83 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package security
+
+import (
+	"crypto/tls"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// AuthenticatedUser returns the user authenticated by the verified
+// client certificate of a TLS connection, and whether there was one.
+// Connections of nodes authenticate as NodeUser, the common name of
+// node certificates. Certificates without a common name don't
+// authenticate.
+func AuthenticatedUser(state *tls.ConnectionState) (string, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	user := state.VerifiedChains[0][0].Subject.CommonName
+	if user == "" {
+		return "", false
+	}
+	return user, true
+}
+
+// AuthenticateRequest ties the user of a request received on a
+// connection with the supplied TLS state to the connection's
+// authenticated user. Requests which don't specify a user, including
+// those in a batch, are executed as the authenticated user; requests
+// specifying a different user are rejected. Nodes may send requests
+// on behalf of any user. Requests received on insecure connections
+// (nil state) keep the user they specify.
+func AuthenticateRequest(args proto.Request, state *tls.ConnectionState) error {
+	if state == nil {
+		return nil
+	}
+	user, ok := AuthenticatedUser(state)
+	if !ok {
+		return util.Errorf("%s requires a client certificate", args.Method())
+	}
+	if user == NodeUser {
+		return nil
+	}
+	if err := authenticateHeader(args.Header(), user); err != nil {
+		return err
+	}
+	if batch, ok := args.(*proto.BatchRequest); ok {
+		for i := range batch.Requests {
+			if err := authenticateHeader(batch.Requests[i].GetValue().(proto.Request).Header(), user); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// authenticateHeader sets the user of the header to the authenticated
+// user if unset, or verifies that they match.
+func authenticateHeader(header *proto.RequestHeader, user string) error {
+	if header.User == "" {
+		header.User = user
+	} else if header.User != user {
+		return util.Errorf("user %q cannot send requests as %q", user, header.User)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package security_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+)
+
+func chain(cn string) [][]*x509.Certificate {
+	return [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}}
+}
+
+func TestAuthenticatedUser(t *testing.T) {
+	testCases := []struct {
+		state   *tls.ConnectionState
+		user    string
+		expAuth bool
+	}{
+		{nil, "", false},
+		{&tls.ConnectionState{}, "", false},
+		// Certificates without a common name don't authenticate.
+		{&tls.ConnectionState{VerifiedChains: chain("")}, "", false},
+		{&tls.ConnectionState{VerifiedChains: chain(security.NodeUser)}, security.NodeUser, true},
+		{&tls.ConnectionState{VerifiedChains: chain("foo")}, "foo", true},
+		// Unverified peer certificates don't authenticate.
+		{&tls.ConnectionState{PeerCertificates: chain("foo")[0]}, "", false},
+	}
+	for i, test := range testCases {
+		user, ok := security.AuthenticatedUser(test.state)
+		if user != test.user || ok != test.expAuth {
+			t.Errorf("%d: expected (%q, %t); got (%q, %t)", i, test.user, test.expAuth, user, ok)
+		}
+	}
+}
+
+func TestAuthenticateRequest(t *testing.T) {
+	testCases := []struct {
+		state   *tls.ConnectionState
+		reqUser string
+		expUser string
+		expErr  bool
+	}{
+		// Insecure connections keep the requested user.
+		{nil, "foo", "foo", false},
+		// Secure connections require a client certificate.
+		{&tls.ConnectionState{}, "", "", true},
+		// Requests without a user run as the authenticated user.
+		{&tls.ConnectionState{VerifiedChains: chain("foo")}, "", "foo", false},
+		{&tls.ConnectionState{VerifiedChains: chain("foo")}, "foo", "foo", false},
+		{&tls.ConnectionState{VerifiedChains: chain("foo")}, "bar", "bar", true},
+		// Nodes may act on behalf of any user.
+		{&tls.ConnectionState{VerifiedChains: chain(security.NodeUser)}, "bar", "bar", false},
+		// Certificates without a common name get no node privileges.
+		{&tls.ConnectionState{VerifiedChains: chain("")}, "bar", "bar", true},
+		{&tls.ConnectionState{VerifiedChains: chain("Node")}, "bar", "bar", true},
+	}
+	for i, test := range testCases {
+		args := &proto.PutRequest{}
+		args.User = test.reqUser
+		err := security.AuthenticateRequest(args, test.state)
+		if (err != nil) != test.expErr {
+			t.Errorf("%d: expected error %t; got %v", i, test.expErr, err)
+		}
+		if args.User != test.expUser {
+			t.Errorf("%d: expected user %q; got %q", i, test.expUser, args.User)
+		}
+	}
+}
+
+func TestAuthenticateBatchRequest(t *testing.T) {
+	state := &tls.ConnectionState{VerifiedChains: chain("foo")}
+	put := &proto.PutRequest{}
+	batch := &proto.BatchRequest{}
+	batch.Add(put)
+	if err := security.AuthenticateRequest(batch, state); err != nil {
+		t.Fatal(err)
+	}
+	if user := batch.Requests[0].GetValue().(proto.Request).Header().User; user != "foo" {
+		t.Errorf("expected batched request to run as %q; got %q", "foo", user)
+	}
+
+	put = &proto.PutRequest{}
+	put.User = "bar"
+	batch = &proto.BatchRequest{}
+	batch.Add(put)
+	if err := security.AuthenticateRequest(batch, state); err == nil {
+		t.Error("expected batched request for another user to be rejected")
+	}
+}
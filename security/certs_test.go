@@ -18,6 +18,7 @@
 package security_test
 
 import (
+	"crypto/x509"
 	"net/http"
 	"testing"
 
@@ -61,6 +62,31 @@ func TestGenerateCerts(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
+
+	// Client certs require a user other than the node user.
+	err = security.RunCreateClientCert(certsDir, "")
+	if err == nil {
+		t.Fatalf("Expected error, but got none")
+	}
+	err = security.RunCreateClientCert(certsDir, security.NodeUser)
+	if err == nil {
+		t.Fatalf("Expected error, but got none")
+	}
+	err = security.RunCreateClientCert(certsDir, "foo")
+	if err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	config, err := security.LoadUserClientTLSConfigFromDir(certsDir, "foo")
+	if err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	x509Cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cn := x509Cert.Subject.CommonName; cn != "foo" {
+		t.Errorf("expected client cert common name %q; got %q", "foo", cn)
+	}
 }
 
 // This is a fairly high-level test of CA and node certificates.
@@ -123,7 +123,7 @@ func test_certs_ca_key() (*asset, error) {
 	return a, nil
 }
 
-var _test_certs_node_crt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x92\xcd\x72\xa2\x40\x14\x85\xf7\x3c\xc5\xbc\xc0\x14\xa8\x24\xe2\x22\x8b\x7b\xfb\x07\x1b\x6d\x48\x23\x88\x64\x87\x18\x1b\x10\x7f\x48\xc6\x69\xf4\xe9\x47\xad\x4c\xcd\x62\x2a\x77\xf9\x2d\xbe\x73\xea\xd6\xf9\x79\x3f\x64\xbe\x08\x7f\x10\x16\x27\x82\x0b\x02\x09\x7b\x50\x4b\x0a\x81\xfa\x4a\x08\x2c\x3a\x0d\x46\x20\x68\xa1\xd2\x40\xe4\xbf\xa5\xec\xa3\x90\x76\xa3\xf9\xc0\x44\x82\x73\x9b\xc2\x0c\xb5\xee\xaa\x5d\x13\xbd\x2a\x45\xa1\x81\x54\xc6\xc2\x58\x0c\x72\xba\x54\x6a\xc6\x4c\x4b\xd7\xc3\xf0\xa3\xdc\x4f\xaa\x7c\xa8\xcd\xb4\x2a\x43\x99\xa4\x26\xa4\xa2\x97\x0d\x18\x49\xe1\x29\x7b\xb0\xfc\xce\xcc\x5f\x66\x65\x5f\xa6\xef\x44\x59\x02\x09\xea\xf2\x2b\x59\xe0\xbf\x16\x12\xd1\x84\xc4\x02\x48\x4e\x83\xcb\xb6\x6c\x52\x3e\x91\x05\xd2\xeb\x7e\x5e\xe6\x98\xce\x2e\xd7\x43\x4b\xdb\x55\xc1\x73\xbb\xb0\xcb\xa8\xad\xc7\xd1\xf8\x1c\x10\x67\xe0\x79\xc5\x75\x97\x39\xdd\xbb\xe3\x38\xae\x08\x2c\x7b\xeb\x69\x77\xc8\x90\x06\xd5\x78\x2d\xab\x7a\x45\xcf\x4f\x75\x9f\xc1\x71\xe0\x9a\x15\x85\x08\x75\xb8\x9c\x2a\x0f\x61\xeb\x31\x04\x49\x20\x02\x33\x55\xb7\xb6\xb1\xd3\x22\xe6\xc6\xe2\x90\x8b\x99\xc9\x11\x55\x3a\x05\xc3\x7c\x42\x3e\x7d\x50\x29\x47\x23\x89\x04\xcf\x87\x41\xba\x61\x86\xa1\x6d\x14\x97\x77\x81\x12\xc6\xd7\x0f\x41\x8c\x28\x6f\x4f\xcc\x45\xb0\xf6\x27\x4d\x9e\xf5\xc7\xf5\x28\x74\x2a\x13\xdb\x00\x80\x12\x8e\x37\x59\xe7\x2f\xa4\x3b\x79\x24\x3b\x25\x48\xa6\x88\x20\x67\xfe\x3c\xd2\xd9\xeb\xaa\x10\x9f\x1f\xbf\xa8\x15\x19\x6e\x8a\xa0\xf6\x76\xe5\xa2\x3e\xf5\xe5\x69\x26\xdf\x7c\x1a\xbc\xd5\x17\xe2\x08\xa8\x01\x3b\x7a\x18\x0e\xcb\xe7\x25\x87\xf7\xe5\xee\x14\xc4\xbd\xbb\x27\x87\x76\x95\xd8\x61\x73\xd9\x1c\x27\xa9\xb5\xec\xdc\xdd\x9c\x6c\xf8\x42\xbf\xbc\x58\x8f\x69\xb0\x90\xfe\x3f\x97\x3f\x01\x00\x00\xff\xff\xb9\x94\x37\x92\x4b\x02\x00\x00")
+var _test_certs_node_crt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x92\x4d\x8f\xa2\x40\x10\x86\xef\xfc\x8a\xbd\x4f\x0c\xe0\x47\xd4\xc3\x1c\xaa\xba\x1b\x68\xa5\x1b\x61\x40\x6c\x6f\xc8\x8e\x7c\x88\xa8\xe3\x3a\xad\xfe\xfa\x05\x93\xcd\x1e\x36\x5b\xc7\x27\xa9\xa7\xde\x4a\xde\xc1\xa0\x1b\x64\x2e\x97\x3f\x08\x8b\x62\xee\x70\x02\x31\xeb\xe1\xc0\x10\x9c\x63\xfd\x24\x1d\xc8\x0a\xd0\x1c\xa1\xe0\x61\xb2\xe0\xea\x5b\x88\x7b\x20\xe9\x65\xe4\xdb\x3a\xe0\x8e\x63\x52\x58\x62\x51\x5c\xca\x43\x1d\xac\xc2\x90\x42\x0d\x89\x88\xb8\x36\x18\x28\xba\x0e\xc3\x25\xd3\x0d\xdd\x0d\xe5\x57\x7e\x9c\x97\x6a\x58\x68\xaf\xcc\xa5\x88\x13\x2d\x29\xbf\x8b\x1a\xb4\xa0\x30\x49\x5f\x4c\xf5\x4c\xff\x61\x46\x5a\x43\xdd\x9b\xfe\x23\xba\xd3\x18\x7c\x2c\xe4\x1a\x41\xc4\xe8\x4e\xbe\xb7\x6e\xa2\xd3\x18\x62\x2c\xf2\x57\x1a\x63\x15\x72\xfc\x1b\x4d\x20\x6a\x49\x00\xe2\xb3\xfd\xd8\xe7\x75\xe2\xcc\x45\x86\xf4\x79\xf4\x73\x85\xc9\xf2\xf1\x6c\x1b\xda\x6c\x32\x47\x99\x99\x99\x07\x4d\x35\x0d\xa6\xc6\x6d\x41\x2c\x7b\x36\xcb\x9e\x87\xd4\xba\x7c\x5a\x96\x35\xe6\x0b\x73\x3f\x2b\xc6\x43\x86\x74\x51\x4e\x77\xa2\xac\x36\xf4\x36\xa9\xee\x29\x9c\xec\x6b\x7f\x3d\xe8\x13\x79\xe1\x0c\x61\x3f\x63\x46\x17\x8d\xe0\x27\x68\x2f\xec\x5e\x88\xac\x06\x51\x69\x07\x14\x5f\x6a\x85\x18\x26\x1e\x68\xe6\x12\x72\x75\x21\x4c\x1c\xd4\x82\x08\xd0\x2e\xd8\xc9\x4f\xa6\x19\x9a\x3a\x24\x86\x80\x8e\x14\xaf\xe5\x08\x51\x68\xa6\xf8\x62\xe7\xce\x6b\x95\xde\x4f\xbb\x91\xb4\x4a\x1d\x99\x00\x80\x02\x4e\x9d\xe8\xe2\x7e\x88\xf1\xbc\x3f\x0a\x56\x0e\x82\x75\x02\xee\x5d\x9b\xfa\xf0\x48\x96\xeb\x4a\xaf\xcf\xa1\x94\xd5\xb6\x0e\x8e\x65\xb1\xdf\x56\xf5\x47\xdb\x8e\x4e\x7e\x6b\x4f\x4c\xea\x6f\x94\x26\x50\xe1\x44\x79\x7e\x56\xbf\xb5\x48\xc8\x69\xd5\xb4\xae\x34\xbc\x73\x7e\xdb\xa5\x9e\xb0\xdf\xa2\x63\x11\x8f\x2e\x96\xbb\xfd\x35\x8c\x9e\xf4\x0b\xde\xdf\x8d\x57\x55\x98\xa4\xff\xd6\xe7\x37\xd7\xfa\xb7\xbe\x5b\x02\x00\x00")
 
 func test_certs_node_crt_bytes() ([]byte, error) {
 	return bindata_read(
@@ -138,7 +138,7 @@ func test_certs_node_crt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "test_certs/node.crt", size: 587, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	info := bindata_file_info{name: "test_certs/node.crt", size: 603, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -90,10 +90,12 @@ func LoadTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 
 	return &tls.Config{
 		Certificates: []tls.Certificate{cert},
-		// TODO(marc): no client certs for now. Even specifying VerifyClientCertIfGiven
-		// causes issues with various browsers. We should switch to
-		// tls.RequireAndVerifyClientCert once client certs are properly set.
-		ClientAuth: tls.NoClientCert,
+		// Client certificates are verified if given; their common name
+		// is the authenticated user. Browsers may prompt for a
+		// certificate, but can proceed without one.
+		// TODO(marc): switch to tls.RequireAndVerifyClientCert once all
+		// clients are issued certificates.
+		ClientAuth: tls.VerifyClientCertIfGiven,
 		RootCAs:    certPool,
 		ClientCAs:  certPool,
 
@@ -144,6 +146,42 @@ func LoadClientTLSConfig(caPEM []byte) (*tls.Config, error) {
 	}, nil
 }
 
+// LoadUserClientTLSConfigFromDir creates a client TLSConfig which
+// presents the client certificate of the specified user. The
+// directory must contain ca.crt, client.<user>.crt and client.<user>.key.
+func LoadUserClientTLSConfigFromDir(certDir, user string) (*tls.Config, error) {
+	caPEM, err := readFileFn(path.Join(certDir, "ca.crt"))
+	if err != nil {
+		return nil, err
+	}
+	certPEM, err := readFileFn(path.Join(certDir, clientCertPrefix(user)+".crt"))
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := readFileFn(path.Join(certDir, clientCertPrefix(user)+".key"))
+	if err != nil {
+		return nil, err
+	}
+	return LoadUserClientTLSConfig(certPEM, keyPEM, caPEM)
+}
+
+// LoadUserClientTLSConfig creates a client TLSConfig from the supplied
+// byte strings containing the certificate and private key of a user
+// (the certificate should be signed by the CA) and the certificate of
+// the cluster CA.
+func LoadUserClientTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	config, err := LoadClientTLSConfig(caPEM)
+	if err != nil {
+		return nil, err
+	}
+	config.Certificates = []tls.Certificate{cert}
+	return config, nil
+}
+
 // LoadInsecureClientTLSConfig creates a TLSConfig that disables TLS.
 func LoadInsecureClientTLSConfig() *tls.Config {
 	return &tls.Config{
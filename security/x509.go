@@ -37,6 +37,10 @@ import (
 // Most fields and settings are hard-coded. TODO(marc): allow customization.
 
 const (
+	// NodeUser is the common name of node certificates. Requests
+	// authenticated by a node certificate are executed as the root user.
+	NodeUser = "node"
+
 	validFor      = time.Hour * 24 * 365
 	maxPathLength = 2
 	keyBits       = 2048
@@ -146,6 +150,7 @@ func GenerateNodeCert(caCert *x509.Certificate, caKey crypto.PrivateKey, hosts [
 
 	// Set node-specific fields.
 	// Nodes needs SSL for both server and client authentication.
+	template.Subject.CommonName = NodeUser
 	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
 	if hosts != nil {
 		for _, h := range hosts {
@@ -164,3 +169,32 @@ func GenerateNodeCert(caCert *x509.Certificate, caKey crypto.PrivateKey, hosts [
 
 	return certBytes, privateKey, nil
 }
+
+// GenerateClientCert generates a client certificate for the specified
+// user and returns the cert bytes as well as the private key used to
+// generate the certificate. The user is stored in the certificate's
+// common name and is the user a server authenticates the client as.
+// The CA cert and private key should be passed in.
+func GenerateClientCert(caCert *x509.Certificate, caKey crypto.PrivateKey, user string) (
+	[]byte, crypto.PrivateKey, error) {
+	privateKey, publicKey, err := generateKeyPair()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := newTemplate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Set client-specific fields.
+	template.Subject.CommonName = user
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, caCert, publicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certBytes, privateKey, nil
+}
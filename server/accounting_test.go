@@ -25,8 +25,8 @@ import (
 	"net/url"
 	"os"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	yaml "gopkg.in/yaml.v1"
 )
 
@@ -45,7 +45,7 @@ func ExampleSetAndGetAccts() {
 		prefix proto.Key
 		yaml   string
 	}{
-		{engine.KeyMin, testAcctConfig},
+		{keys.KeyMin, testAcctConfig},
 		{proto.Key("db1"), testAcctConfig},
 		{proto.Key("db 2"), testAcctConfig},
 		{proto.Key("\xfe"), testAcctConfig},
@@ -85,7 +85,7 @@ func ExampleLsAccts() {
 	defer os.Remove(testConfigFn)
 
 	keys := []proto.Key{
-		engine.KeyMin,
+		keys.KeyMin,
 		proto.Key("db1"),
 		proto.Key("db2"),
 		proto.Key("db3"),
@@ -144,7 +144,7 @@ func ExampleRmAccts() {
 	defer os.Remove(testConfigFn)
 
 	keys := []proto.Key{
-		engine.KeyMin,
+		keys.KeyMin,
 		proto.Key("db1"),
 	}
 
@@ -21,6 +21,7 @@ package server
 import (
 	// This is imported for its side-effect of registering expvar
 	// endpoints with the http.DefaultServeMux.
+	"encoding/json"
 	_ "expvar"
 	"fmt"
 	"io/ioutil"
@@ -29,10 +30,17 @@ import (
 	// endpoints with the http.DefaultServeMux.
 	_ "net/http/pprof"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/trace"
 )
 
 const (
@@ -46,16 +54,30 @@ const (
 	// debugEndpoint is the prefix of golang's standard debug functionality
 	// for access to exported vars and pprof tools.
 	debugEndpoint = "/debug/"
-	// healthPath is the health endpoint.
-	healthPath = adminEndpoint + "health"
+	// tracePath is the endpoint for retrieving the traces of recent
+	// requests.
+	tracePath = "/_debug/trace"
+	// healthPath is the health endpoint, used by clients to check
+	// nodes they failed over from.
+	healthPath = client.HealthEndpoint
 	// quitPath is the quit endpoint.
 	quitPath = adminEndpoint + "quit"
+	// logPath is the endpoint for viewing and changing log verbosity.
+	logPath = adminEndpoint + "log"
 	// acctPathPrefix is the prefix for accounting configuration changes.
 	acctPathPrefix = adminEndpoint + "acct"
+	// acctUsagePath is the endpoint reporting usage by accounting prefix.
+	acctUsagePath = adminEndpoint + "acct-usage"
 	// permPathPrefix is the prefix for permission configuration changes.
 	permPathPrefix = adminEndpoint + "perms"
 	// zonePathPrefix is the prefix for zone configuration changes.
 	zonePathPrefix = adminEndpoint + "zones"
+	// settingsPathPrefix is the prefix for changes to cluster settings.
+	settingsPathPrefix = adminEndpoint + "settings"
+	// usersPathPrefix is the prefix for changes to the system users.
+	usersPathPrefix = adminEndpoint + "users"
+	// exportPath is the endpoint for exporting the data of a key span.
+	exportPath = adminEndpoint + "export"
 )
 
 // An actionHandler is an interface which provides Get, Put & Delete
@@ -69,22 +91,34 @@ type actionHandler interface {
 // A adminServer provides a RESTful HTTP API to administration of
 // the cockroach cluster.
 type adminServer struct {
-	db      *client.KV    // Key-value database client
-	stopper *util.Stopper // Used to shutdown the server
+	db      *client.KV     // Key-value database client
+	stopper *util.Stopper  // Used to shutdown the server
+	gossip  *gossip.Gossip // Source of accounting configs and usage
+	tracer  *trace.Tracer  // Spans of the node's recent requests
 	acct    *acctHandler
 	perm    *permHandler
 	zone    *zoneHandler
+	setting *settingHandler
+	user    *userHandler
+	export  *exportLimiter
 }
 
 // newAdminServer allocates and returns a new REST server for
-// administrative APIs.
-func newAdminServer(db *client.KV, stopper *util.Stopper) *adminServer {
+// administrative APIs. Exports are written at no more than
+// exportBytesPerSecond; zero means no limit.
+func newAdminServer(db *client.KV, stopper *util.Stopper, gossip *gossip.Gossip,
+	tracer *trace.Tracer, exportBytesPerSecond int64) *adminServer {
 	return &adminServer{
 		db:      db,
 		stopper: stopper,
+		gossip:  gossip,
+		tracer:  tracer,
 		acct:    &acctHandler{db: db},
 		perm:    &permHandler{db: db},
-		zone:    &zoneHandler{db: db},
+		zone:    &zoneHandler{db: db, gossip: gossip},
+		setting: &settingHandler{db: db},
+		user:    &userHandler{db: db},
+		export:  &exportLimiter{bytesPerSecond: exportBytesPerSecond},
 	}
 }
 
@@ -95,13 +129,21 @@ func (s *adminServer) registerHandlers(mux *http.ServeMux) {
 	// get exported variables and pprof tools.
 	mux.HandleFunc(acctPathPrefix, s.handleAcctAction)
 	mux.HandleFunc(acctPathPrefix+"/", s.handleAcctAction)
+	mux.HandleFunc(acctUsagePath, s.handleAcctUsage)
 	mux.HandleFunc(debugEndpoint, s.handleDebug)
+	mux.HandleFunc(exportPath, s.handleExport)
 	mux.HandleFunc(healthPath, s.handleHealth)
+	mux.HandleFunc(logPath, s.handleLog)
 	mux.HandleFunc(quitPath, s.handleQuit)
+	mux.HandleFunc(tracePath, s.handleTrace)
 	mux.HandleFunc(permPathPrefix, s.handlePermAction)
+	mux.HandleFunc(settingsPathPrefix, s.handleSettingAction)
+	mux.HandleFunc(settingsPathPrefix+"/", s.handleSettingAction)
 	mux.HandleFunc(permPathPrefix+"/", s.handlePermAction)
 	mux.HandleFunc(zonePathPrefix, s.handleZoneAction)
 	mux.HandleFunc(zonePathPrefix+"/", s.handleZoneAction)
+	mux.HandleFunc(usersPathPrefix, s.handleUserAction)
+	mux.HandleFunc(usersPathPrefix+"/", s.handleUserAction)
 }
 
 // handleHealth responds to health requests from monitoring services.
@@ -118,19 +160,174 @@ func (s *adminServer) handleQuit(w http.ResponseWriter, r *http.Request) {
 	go s.stopper.Stop()
 }
 
+// logSettings are the log verbosity settings viewed and changed via
+// the log endpoint. Unset fields are left unchanged by a PUT.
+type logSettings struct {
+	Verbosity *log.Level `json:"verbosity,omitempty"`
+	Packages  *string    `json:"packages,omitempty"`
+}
+
+// handleLog responds with the current log verbosity settings on GET
+// and applies the supplied JSON encoded settings on PUT or POST, e.g.
+// {"verbosity": 1, "packages": "storage=2,multiraft=3"}.
+func (s *adminServer) handleLog(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+	case "PUT", "POST":
+		var settings logSettings
+		if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if settings.Verbosity != nil {
+			if err := log.SetVerbosity(*settings.Verbosity); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		if settings.Packages != nil {
+			if err := log.SetPackageVerbosity(*settings.Packages); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	default:
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	verbosity, packages := log.Verbosity(), log.PackageVerbosity()
+	b, contentType, err := util.MarshalResponse(r, logSettings{&verbosity, &packages},
+		[]util.EncodingType{util.JSONEncoding, util.YAMLEncoding})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// handleTrace responds with the spans recorded on this node. Given an
+// "id" query parameter, the spans of that trace are returned; otherwise
+// the local root spans of the most recent traces are, up to the number
+// given by "n" (default 50). With "format=text", a trace is rendered
+// as an indented tree instead of JSON.
+func (s *adminServer) handleTrace(w http.ResponseWriter, r *http.Request) {
+	if s.tracer == nil {
+		http.Error(w, "tracing is not enabled", http.StatusNotFound)
+		return
+	}
+	query := r.URL.Query()
+	var spans []*trace.Span
+	if id := query.Get("id"); id != "" {
+		traceID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		spans = s.tracer.Trace(traceID)
+	} else {
+		n := 50
+		if limit := query.Get("n"); limit != "" {
+			var err error
+			if n, err = strconv.Atoi(limit); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		spans = s.tracer.Recent(n)
+	}
+	if query.Get("format") == "text" {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, trace.Format(spans))
+		return
+	}
+	if spans == nil {
+		spans = []*trace.Span{}
+	}
+	b, contentType, err := util.MarshalResponse(r, spans, []util.EncodingType{util.JSONEncoding, util.YAMLEncoding})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
 // handleDebug passes requests with the debugPathPrefix onto the default
 // serve mux, which is preconfigured (by import of expvar and net/http/pprof)
 // to serve endpoints which access exported variables and pprof tools.
+// On-demand CPU and heap profiles are served at /debug/pprof/profile
+// and /debug/pprof/heap. As profiles expose the server's internals,
+// they are only served to nodes and the root user.
 func (s *adminServer) handleDebug(w http.ResponseWriter, r *http.Request) {
+	if err := authenticateAdmin(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
 	handler, _ := http.DefaultServeMux.Handler(r)
 	handler.ServeHTTP(w, r)
 }
 
+// authenticateAdmin returns an error unless the request was sent by a
+// node or the root user, as authenticated by the client certificate
+// of its connection. Requests received on insecure connections aren't
+// authenticated.
+func authenticateAdmin(r *http.Request) error {
+	if r.TLS == nil {
+		return nil
+	}
+	user, ok := security.AuthenticatedUser(r.TLS)
+	if !ok {
+		return util.Errorf("%s requires a client certificate", r.URL.Path)
+	}
+	if user != security.NodeUser && user != storage.UserRoot {
+		return util.Errorf("user %q may not access %s", user, r.URL.Path)
+	}
+	return nil
+}
+
 // handleAcctAction handles actions for accounting configuration by method.
 func (s *adminServer) handleAcctAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.acct, w, r, acctPathPrefix)
 }
 
+// acctUsage reports the byte limit and aggregated stats of an
+// accounting prefix.
+type acctUsage struct {
+	Prefix    proto.Key       `json:"prefix"`
+	ByteLimit int64           `json:"byte_limit"`
+	Stats     proto.MVCCStats `json:"stats"`
+}
+
+// handleAcctUsage responds with the usage of each accounting prefix,
+// as gossiped by the cluster's stores.
+func (s *adminServer) handleAcctUsage(w http.ResponseWriter, r *http.Request) {
+	usages := []acctUsage{}
+	if s.gossip != nil {
+		if cfg, err := storage.GetSystemConfig(s.gossip); err == nil {
+			total := storage.ClusterAcctUsage(s.gossip)
+			for _, pc := range cfg.Accounting {
+				if pc.Canonical != nil {
+					// Skip entries marking the end of a prefix's range.
+					continue
+				}
+				usages = append(usages, acctUsage{
+					Prefix:    pc.Prefix,
+					ByteLimit: pc.Config.(*proto.AcctConfig).ByteLimit,
+					Stats:     total[string(pc.Prefix)],
+				})
+			}
+		}
+	}
+	b, contentType, err := util.MarshalResponse(r, usages, []util.EncodingType{util.JSONEncoding, util.YAMLEncoding})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
 // handlePermAction handles actions for perm configuration by method.
 func (s *adminServer) handlePermAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.perm, w, r, permPathPrefix)
@@ -141,6 +338,16 @@ func (s *adminServer) handleZoneAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.zone, w, r, zonePathPrefix)
 }
 
+// handleSettingAction handles actions for cluster settings by method.
+func (s *adminServer) handleSettingAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.setting, w, r, settingsPathPrefix)
+}
+
+// handleUserAction handles actions for system users by method.
+func (s *adminServer) handleUserAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.user, w, r, usersPathPrefix)
+}
+
 // handleRESTAction handles RESTful admin actions.
 func (s *adminServer) handleRESTAction(handler actionHandler, w http.ResponseWriter, r *http.Request, prefix string) {
 	switch r.Method {
@@ -18,6 +18,7 @@
 package server
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -43,10 +44,18 @@ func startAdminServer() (string, *util.Stopper) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	admin := newAdminServer(db, stopper)
+	admin := newAdminServer(db, stopper, nil, nil, 0)
 	mux := http.NewServeMux()
 	admin.registerHandlers(mux)
-	httpServer := httptest.NewTLSServer(mux)
+	// Serve the test node certificate, verifying client certificates
+	// as a node does.
+	tlsConfig, err := testutils.NewTestBaseContext().GetServerTLSConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	httpServer := httptest.NewUnstartedServer(mux)
+	httpServer.TLS = tlsConfig
+	httpServer.StartTLS()
 	stopper.AddCloser(httpServer)
 
 	if strings.HasPrefix(httpServer.URL, "http://") {
@@ -106,6 +115,27 @@ func TestAdminDebugExpVar(t *testing.T) {
 	}
 }
 
+// TestAdminDebugAuth verifies that the debug endpoints are not served
+// to clients which don't present a certificate.
+func TestAdminDebugAuth(t *testing.T) {
+	url, stopper := startAdminServer()
+	defer stopper.Stop()
+
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}}
+	for _, path := range []string{"vars", "pprof/heap"} {
+		resp, err := client.Get(url + debugEndpoint + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("%s: expected status code %d; got %d", path, http.StatusUnauthorized, resp.StatusCode)
+		}
+	}
+}
+
 // TestAdminDebugPprof verifies that pprof tools are available.
 // via the /debug/pprof/* links.
 func TestAdminDebugPprof(t *testing.T) {
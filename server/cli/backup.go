@@ -0,0 +1,178 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A backupCmd command backs up the cluster's data.
+var backupCmd = &commander.Command{
+	UsageLine: "backup [options] <uri> [proto [<base uri>]]",
+	Short:     "backs up the cluster's data",
+	Long: `
+Exports a consistent snapshot of all of the cluster's user data, i.e.
+of all keys outside of the system keyspace, to the external storage
+at <uri>. The snapshot is taken range by range, each range's data being written to
+a file in the given format (proto, currently the only format), and is complete once
+a manifest named BACKUP listing those files has been written. The
+manifest also holds the schema definitions, users and zone,
+permission and accounting configs needed to restore the data.
+
+<uri> is either a directory, with an optional file:// scheme, or an
+http:// or https:// location to which files are written with PUT
+requests. Directories are written to by the leader of each range, and
+so must be on storage shared by all nodes.
+
+If the uri of a complete base backup is given, the backup is
+incremental, exporting only the keys changed since the base backup
+was taken; deleted keys are exported with empty values.
+`,
+	Run:  runBackup,
+	Flag: *flag.CommandLine,
+}
+
+func runBackup(cmd *commander.Command, args []string) {
+	if len(args) == 0 || len(args) > 3 {
+		cmd.Usage()
+		return
+	}
+	format := proto.PROTO
+	if len(args) >= 2 {
+		f, ok := proto.BackupFormat_value[strings.ToUpper(args[1])]
+		if !ok {
+			cmd.Usage()
+			return
+		}
+		format = proto.BackupFormat(f)
+	}
+	startTime := proto.ZeroTimestamp
+	if len(args) == 3 {
+		es, err := storage.NewExportStorage(args[2])
+		if err != nil {
+			fmt.Fprintf(osStderr, "invalid base backup uri: %s\n", err)
+			osExit(1)
+			return
+		}
+		base, err := storage.ReadBackupManifest(es)
+		if err != nil {
+			fmt.Fprintf(osStderr, "unable to read base backup manifest: %s\n", err)
+			osExit(1)
+			return
+		}
+		startTime = base.Timestamp
+	}
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	manifest, err := storage.Backup(kv, args[0], format, keys.KeySystemMax, keys.KeyMax,
+		startTime, proto.ZeroTimestamp)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "backup failed: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("backed up %d ranges as of %s\n", len(manifest.Files), manifest.Timestamp)
+}
+
+// restoreStatusInterval is the interval at which the restore command
+// reports the progress of its job.
+const restoreStatusInterval = time.Second
+
+// A restoreCmd command restores a backup.
+var restoreCmd = &commander.Command{
+	UsageLine: "restore [options] <uri>",
+	Short:     "restores a backup",
+	Long: `
+Restores the backup at <uri>, written by the backup command, into the
+cluster and reports the progress of the restore until it completes.
+The backup's schema definitions, users and configs replace those of
+the cluster, and the keyspace is split to match the ranges of the
+backup, whose data is then bulk loaded. The restored spans must be empty and their ranges
+must have a single replica, as in a newly initialized cluster; further
+replicas are added afterwards, as configured by the zones.
+`,
+	Run:  runRestore,
+	Flag: *flag.CommandLine,
+}
+
+func runRestore(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	req := &proto.AdminRestoreRequest{
+		RequestHeader: proto.RequestHeader{
+			Key: keys.KeyMin,
+		},
+		URI: args[0],
+	}
+	resp := &proto.AdminRestoreResponse{}
+	if err := kv.Run(client.Call{Args: req, Reply: resp}); err != nil {
+		fmt.Fprintf(os.Stderr, "restore failed: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("started restore job %s\n", resp.JobID)
+
+	key := keys.RestoreStatusKey(resp.JobID)
+	for {
+		time.Sleep(restoreStatusInterval)
+		status := &proto.RestoreStatus{}
+		call := client.GetCall(key)
+		if err := kv.Run(call); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to get status of restore job: %s\n", err)
+			os.Exit(1)
+		}
+		if value := call.Reply.(*proto.GetResponse).Value; value != nil {
+			if err := gogoproto.Unmarshal(value.Bytes, status); err != nil {
+				fmt.Fprintf(os.Stderr, "unable to unmarshal status of restore job: %s\n", err)
+				os.Exit(1)
+			}
+		}
+		fmt.Printf("restored %d of %d files (%d keys)\n",
+			status.RestoredFiles, status.TotalFiles, status.RestoredKeys)
+		if status.Done {
+			if status.Error != "" {
+				fmt.Fprintf(os.Stderr, "restore failed: %s\n", status.Error)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+}
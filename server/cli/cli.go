@@ -71,22 +71,22 @@ var allCmds = &commander.Commander{
 		startCmd,
 		exterminateCmd,
 		quitCmd,
+		nodeCmd,
 
 		// Certificate commands.
 		createCACertCmd,
 		createNodeCertCmd,
+		createClientCertCmd,
 
 		// Key/value commands.
-		getCmd,
-		putCmd,
-		incCmd,
-		delCmd,
-		scanCmd,
+		kvCmd,
 
 		// Range commands.
-		lsRangesCmd,
-		splitRangeCmd,
-		mergeRangeCmd,
+		rangeCmd,
+
+		// Backup and restore commands.
+		backupCmd,
+		restoreCmd,
 
 		// Accounting commands.
 		getAcctCmd,
@@ -95,16 +95,19 @@ var allCmds = &commander.Commander{
 		setAcctCmd,
 
 		// Permission commands.
-		getPermsCmd,
-		lsPermsCmd,
-		rmPermsCmd,
-		setPermsCmd,
+		permCmd,
 
 		// Zone commands.
-		getZoneCmd,
-		lsZonesCmd,
-		rmZoneCmd,
-		setZoneCmd,
+		zoneCmd,
+
+		// Setting commands.
+		settingCmd,
+
+		// Load generation commands.
+		loadCmd,
+
+		// Debugging commands.
+		debugCmd,
 
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
@@ -113,6 +116,24 @@ var allCmds = &commander.Commander{
 	},
 }
 
+// runSubcommand returns a command runner which runs the command of
+// the supplied commander named by the first argument.
+func runSubcommand(cmds *commander.Commander) func(*commander.Command, []string) {
+	return func(cmd *commander.Command, args []string) {
+		if len(args) == 0 {
+			cmd.Usage()
+			for _, c := range cmds.Commands {
+				fmt.Fprintf(osStderr, "  %-10s %s\n", c.Name(), strings.TrimSpace(c.Short))
+			}
+			return
+		}
+		if err := cmds.Run(args); err != nil {
+			fmt.Fprintf(osStderr, "%s\n", err)
+			osExit(1)
+		}
+	}
+}
+
 // Run ...
 func Run(args []string) error {
 	return allCmds.Run(args)
@@ -62,79 +62,124 @@ func (c cliTest) Run(line string) {
 func ExampleBasic() {
 	c := newCLITest()
 
-	c.Run("put a 1 b 2")
-	c.Run("scan")
-	c.Run("del a")
-	c.Run("get a")
-	c.Run("get b")
-	c.Run("inc c 1")
-	c.Run("inc c 10")
-	c.Run("inc c 100")
-	c.Run("scan")
-	c.Run("inc c b")
+	c.Run("kv put a 1 b 2")
+	c.Run("kv scan")
+	c.Run("kv del a")
+	c.Run("kv get a")
+	c.Run("kv get b")
+	c.Run("kv inc c 1")
+	c.Run("kv inc c 10")
+	c.Run("kv inc c 100")
+	c.Run("kv scan")
+	c.Run("kv inc c b")
 	c.Run("quit")
 
 	// Output:
-	// put a 1 b 2
-	// scan
+	// kv put a 1 b 2
+	// kv scan
 	// "a"	1
 	// "b"	2
-	// del a
-	// get a
+	// kv del a
+	// kv get a
 	// "a" not found
-	// get b
+	// kv get b
 	// 2
-	// inc c 1
+	// kv inc c 1
 	// 1
-	// inc c 10
+	// kv inc c 10
 	// 11
-	// inc c 100
+	// kv inc c 100
 	// 111
-	// scan
+	// kv scan
 	// "b"	2
 	// "c"	111
-	// inc c b
+	// kv inc c b
 	// invalid increment: b: strconv.ParseInt: parsing "b": invalid syntax
 	// quit
 	// node drained and shutdown: ok
 }
 
+func ExampleKVSyntax() {
+	c := newCLITest()
+
+	c.Run(`kv put 0x6100 1 "b\x00" "v\x01"`)
+	c.Run(`kv get "a\x00"`)
+	c.Run("kv get 0x620000")
+	c.Run("kv get 0x62")
+	c.Run("kv scan")
+	c.Run("kv get --ts=1.0 0x6100")
+	c.Run("kv get --ts= 0x6100")
+	c.Run("kv put 0x00 x")
+	c.Run("kv get 0xzz")
+	c.Run("quit")
+
+	// Output:
+	// kv put 0x6100 1 "b\x00" "v\x01"
+	// kv get "a\x00"
+	// 1
+	// kv get 0x620000
+	// "b\x00\x00" not found
+	// kv get 0x62
+	// "b" not found
+	// kv scan
+	// "a\x00"	1
+	// "b\x00"	"v\x01"
+	// kv get --ts=1.0 0x6100
+	// "a\x00" not found
+	// kv get --ts= 0x6100
+	// 1
+	// kv put 0x00 x
+	// unable to modify system key: "\x00"
+	// kv get 0xzz
+	// invalid hex string 0xzz: encoding/hex: invalid byte: U+007A 'z'
+	// quit
+	// node drained and shutdown: ok
+}
+
 func ExampleSplitMergeRanges() {
 	c := newCLITest()
 
-	c.Run("put a 1 b 2 c 3 d 4")
-	c.Run("scan")
-	c.Run("split-range c c")
-	c.Run("ls-ranges")
-	c.Run("scan")
-	c.Run("merge-range b")
-	c.Run("ls-ranges")
-	c.Run("scan")
+	c.Run("kv put a 1 b 2 c 3 d 4")
+	c.Run("kv scan")
+	c.Run("range split c c")
+	c.Run("range ls")
+	c.Run("range ls b c")
+	c.Run("range scatter")
+	c.Run("kv scan")
+	c.Run("range merge b")
+	c.Run("range ls")
+	c.Run("kv scan")
 	c.Run("quit")
 
 	// Output:
-	// put a 1 b 2 c 3 d 4
-	// scan
+	// kv put a 1 b 2 c 3 d 4
+	// kv scan
 	// "a"	1
 	// "b"	2
 	// "c"	3
 	// "d"	4
-	// split-range c c
-	// ls-ranges
-	// ""-"c" [1]
+	// range split c c
+	// range ls
+	// /Min-"c" [1]
 	// 	0: node-id=1 store-id=1 attrs=[]
-	// "c"-"\xff\xff" [2]
+	// "c"-/Max [2]
 	// 	0: node-id=1 store-id=1 attrs=[]
-	// scan
+	// range ls b c
+	// /Min-"c" [1]
+	// 	0: node-id=1 store-id=1 attrs=[]
+	// range scatter
+	// /Min-"c" [1]: lease to store-id=1
+	// "c"-/Max [2]: lease to store-id=1
+	// kv scan
 	// "a"	1
 	// "b"	2
 	// "c"	3
 	// "d"	4
-	// merge-range b
-	// ls-ranges
-	// ""-"\xff\xff" [1]
+	// range merge b
+	// range ls
+	// /Min-/Max [1]
 	// 	0: node-id=1 store-id=1 attrs=[]
-	// scan
+	// kv scan
 	// "a"	1
 	// "b"	2
 	// "c"	3
@@ -142,3 +187,25 @@ func ExampleSplitMergeRanges() {
 	// quit
 	// node drained and shutdown: ok
 }
+
+func ExampleDecommissionNode() {
+	c := newCLITest()
+
+	c.Run("node decommission 1")
+	c.Run("node recommission 1")
+	c.Run("node decommission 2")
+	c.Run("node decommission x")
+	c.Run("quit")
+
+	// Output:
+	// node decommission 1
+	// node 1 is decommissioning
+	// node recommission 1
+	// node 1 is no longer decommissioning
+	// node decommission 2
+	// node 2 has no liveness record
+	// node decommission x
+	// invalid node ID: x
+	// quit
+	// node drained and shutdown: ok
+}
@@ -0,0 +1,484 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/coreos/etcd/raft/raftpb"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A debugCmd command runs the offline debugging subcommands.
+var debugCmd = &commander.Command{
+	UsageLine: "debug [options] <command> [arguments]",
+	Short:     "inspects the stores of a stopped node\n",
+	Long: `
+Runs the debugging command given by <command> against the store in a
+RocksDB directory, one of:
+
+  keys                   dumps the keys and values of a span
+  range-state            dumps the descriptor and Raft state of replicas
+  raft-log               dumps the Raft log of a replica
+  unsafe-remove-replica  deletes a replica from the store
+
+Except for unsafe-remove-replica, the store is opened read-only and may
+belong to a node which fails to start. Keys are given as for the kv
+commands: verbatim, Go-quoted or as hex prefixed by 0x. If the store
+keeps its Raft log apart, specify its location with --raft-dir.
+
+The range-lookup command instead asks the running node at --addr to
+describe the range containing a key.
+`,
+	Run:  runSubcommand(debugCmds),
+	Flag: *flag.CommandLine,
+}
+
+var debugCmds = &commander.Commander{
+	Name: "debug",
+	Commands: []*commander.Command{
+		debugKeysCmd,
+		debugRangeStateCmd,
+		debugRaftLogCmd,
+		debugRemoveReplicaCmd,
+		debugRangeLookupCmd,
+	},
+}
+
+// debugRaftDir is the directory of the Raft log of the store being
+// debugged, if it is kept apart from the store.
+var debugRaftDir string
+
+// openStore opens the RocksDB instance in dir, read-only unless
+// otherwise specified.
+func openStore(dir string, readOnly bool) (*engine.RocksDB, error) {
+	db := engine.NewRocksDB(proto.Attributes{}, dir, engine.RocksDBOptions{
+		CacheSize: Context.CacheSize,
+		ReadOnly:  readOnly,
+	})
+	if err := db.Open(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// openStoreEngines opens the store in dir along with its Raft log,
+// which is the store itself unless --raft-dir is specified. The
+// returned function closes both.
+func openStoreEngines(dir string, readOnly bool) (eng, raftEng engine.Engine, closer func(), err error) {
+	db, err := openStore(dir, readOnly)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if debugRaftDir == "" {
+		return db, db, db.Close, nil
+	}
+	raftDB, err := openStore(debugRaftDir, readOnly)
+	if err != nil {
+		db.Close()
+		return nil, nil, nil, err
+	}
+	return db, raftDB, func() {
+		raftDB.Close()
+		db.Close()
+	}, nil
+}
+
+// parseRaftID parses a Raft ID given on the command line.
+func parseRaftID(arg string) (int64, error) {
+	raftID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || raftID <= 0 {
+		return 0, fmt.Errorf("invalid raft ID: %s", arg)
+	}
+	return raftID, nil
+}
+
+// formatRawKeyValue formats a raw key/value pair of a store for
+// display. MVCC keys are decoded and displayed by keys.PrettyPrint along with
+// the timestamps of versioned values, and MVCC metadata and values are
+// displayed as protobuf text. Pairs which cannot be decoded are
+// displayed quoted.
+func formatRawKeyValue(kv proto.RawKeyValue) (formatted string) {
+	defer func() {
+		if recover() != nil {
+			formatted = fmt.Sprintf("%q: %q", []byte(kv.Key), kv.Value)
+		}
+	}()
+	key, timestamp, isValue := engine.MVCCDecodeKey(kv.Key)
+	var msg gogoproto.Message = &proto.MVCCMetadata{}
+	prefix := keys.PrettyPrint(key)
+	if isValue {
+		msg = &proto.MVCCValue{}
+		prefix = fmt.Sprintf("%s @%s", prefix, timestamp)
+	}
+	if err := gogoproto.Unmarshal(kv.Value, msg); err != nil {
+		return fmt.Sprintf("%s: %q", prefix, kv.Value)
+	}
+	return fmt.Sprintf("%s: %s", prefix, gogoproto.CompactTextString(msg))
+}
+
+// A debugKeysCmd command dumps the keys and values of a store.
+var debugKeysCmd = &commander.Command{
+	UsageLine: "keys [options] <directory> [<start-key> [<end-key>]]",
+	Short:     "dumps the keys and values of a store",
+	Long: `
+Dumps the raw keys and values of the store in the RocksDB <directory>
+from <start-key> to <end-key>, which defaults to all keys, including
+store-local and range-local keys.
+`,
+	Run:  runDebugKeys,
+	Flag: *flag.CommandLine,
+}
+
+func runDebugKeys(cmd *commander.Command, args []string) {
+	if len(args) == 0 || len(args) > 3 {
+		cmd.Usage()
+		return
+	}
+	rangeKeys, err := parseKeys(args[1:], true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+	start, end := engine.MVCCEncodeKey(keys.KeyMin), engine.MVCCKeyMax
+	if len(rangeKeys) >= 1 {
+		start = engine.MVCCEncodeKey(rangeKeys[0])
+	}
+	if len(rangeKeys) >= 2 {
+		end = engine.MVCCEncodeKey(rangeKeys[1])
+	}
+
+	db, err := openStore(args[0], true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to open store: %s\n", err)
+		osExit(1)
+		return
+	}
+	defer db.Close()
+	if err := db.Iterate(start, end, func(kv proto.RawKeyValue) (bool, error) {
+		fmt.Printf("%s\n", formatRawKeyValue(kv))
+		return false, nil
+	}); err != nil {
+		fmt.Fprintf(osStderr, "unable to iterate: %s\n", err)
+		osExit(1)
+	}
+}
+
+// A debugRangeStateCmd command dumps the state of the replicas of a
+// store.
+var debugRangeStateCmd = &commander.Command{
+	UsageLine: "range-state [options] <directory> [<raft-id>]",
+	Short:     "dumps the descriptor and Raft state of replicas",
+	Long: `
+Dumps the range descriptor, Raft HardState, Raft truncated state and
+applied index of the replica with <raft-id> on the store in the RocksDB
+<directory>, or of all of its replicas if <raft-id> is omitted.
+`,
+	Run:  runDebugRangeState,
+	Flag: *flag.CommandLine,
+}
+
+func runDebugRangeState(cmd *commander.Command, args []string) {
+	if len(args) == 0 || len(args) > 2 {
+		cmd.Usage()
+		return
+	}
+	var raftID int64
+	if len(args) == 2 {
+		var err error
+		if raftID, err = parseRaftID(args[1]); err != nil {
+			fmt.Fprintf(osStderr, "%s\n", err)
+			osExit(1)
+			return
+		}
+	}
+	eng, raftEng, closer, err := openStoreEngines(args[0], true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to open store: %s\n", err)
+		osExit(1)
+		return
+	}
+	defer closer()
+	states, err := storage.LoadReplicaStates(eng, raftEng)
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to load range descriptors: %s\n", err)
+		osExit(1)
+		return
+	}
+	found := false
+	for _, state := range states {
+		if raftID != 0 && state.Desc.RaftID != raftID {
+			continue
+		}
+		found = true
+		fmt.Printf("range %d: %s-%s\n", state.Desc.RaftID, keys.PrettyPrint(state.Desc.StartKey),
+			keys.PrettyPrint(state.Desc.EndKey))
+		fmt.Printf("  descriptor: %s\n", gogoproto.CompactTextString(&state.Desc))
+		fmt.Printf("  hard state: %s\n", gogoproto.CompactTextString(&state.HardState))
+		fmt.Printf("  truncated state: %s\n", gogoproto.CompactTextString(&state.Truncated))
+		fmt.Printf("  applied index: %d\n", state.AppliedIndex)
+	}
+	if raftID != 0 && !found {
+		fmt.Fprintf(osStderr, "range %d not found\n", raftID)
+		osExit(1)
+	}
+}
+
+// A debugRaftLogCmd command dumps the Raft log of a replica.
+var debugRaftLogCmd = &commander.Command{
+	UsageLine: "raft-log [options] <directory> <raft-id>",
+	Short:     "dumps the Raft log of a replica",
+	Long: `
+Dumps the HardState, truncated state and log entries of the Raft group
+with <raft-id> on the store in the RocksDB <directory>, decoding the
+commands of the entries.
+`,
+	Run:  runDebugRaftLog,
+	Flag: *flag.CommandLine,
+}
+
+func runDebugRaftLog(cmd *commander.Command, args []string) {
+	if len(args) != 2 {
+		cmd.Usage()
+		return
+	}
+	raftID, err := parseRaftID(args[1])
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+	_, raftEng, closer, err := openStoreEngines(args[0], true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to open store: %s\n", err)
+		osExit(1)
+		return
+	}
+	defer closer()
+	if err := dumpRaftLog(raftEng, raftID); err != nil {
+		fmt.Fprintf(osStderr, "unable to read raft log: %s\n", err)
+		osExit(1)
+	}
+}
+
+// dumpRaftLog prints the Raft state and log of the group with the
+// specified Raft ID, oldest log entry first.
+func dumpRaftLog(raftEng engine.Engine, raftID int64) error {
+	var hs raftpb.HardState
+	if _, err := engine.MVCCGetProto(raftEng, keys.RaftHardStateKey(raftID),
+		proto.ZeroTimestamp, true, nil, &hs); err != nil {
+		return err
+	}
+	var ts proto.RaftTruncatedState
+	if _, err := engine.MVCCGetProto(raftEng, keys.RaftTruncatedStateKey(raftID),
+		proto.ZeroTimestamp, true, nil, &ts); err != nil {
+		return err
+	}
+	fmt.Printf("hard state: %s\n", gogoproto.CompactTextString(&hs))
+	fmt.Printf("truncated state: %s\n", gogoproto.CompactTextString(&ts))
+
+	logKey := keys.RaftLogPrefix(raftID)
+	kvs, err := engine.MVCCScan(raftEng, logKey, logKey.PrefixEnd(), 0, proto.ZeroTimestamp, true, nil)
+	if err != nil {
+		return err
+	}
+	// The log is stored backwards; print it in index order.
+	for i := len(kvs) - 1; i >= 0; i-- {
+		var ent raftpb.Entry
+		if err := gogoproto.Unmarshal(kvs[i].Value.GetBytes(), &ent); err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", formatRaftEntry(ent))
+	}
+	return nil
+}
+
+// formatRaftEntry formats a Raft log entry for display, decoding the
+// InternalRaftCommand of normal entries and the replica change of
+// configuration change entries.
+func formatRaftEntry(ent raftpb.Entry) string {
+	prefix := fmt.Sprintf("%d/%d %s", ent.Index, ent.Term, ent.Type)
+	data := ent.Data
+	if ent.Type == raftpb.EntryConfChange {
+		var cc raftpb.ConfChange
+		if err := cc.Unmarshal(ent.Data); err != nil {
+			return fmt.Sprintf("%s: [error parsing conf change: %s]", prefix, err)
+		}
+		prefix = fmt.Sprintf("%s %s node=%d", prefix, cc.Type, cc.NodeID)
+		data = cc.Context
+	}
+	if len(data) == 0 {
+		return fmt.Sprintf("%s: [empty]", prefix)
+	}
+	cmdID, encoded, err := multiraft.DecodeCommand(data)
+	if err != nil {
+		return fmt.Sprintf("%s: [error decoding command: %s]", prefix, err)
+	}
+	var cmd proto.InternalRaftCommand
+	if err := gogoproto.Unmarshal(encoded, &cmd); err != nil {
+		return fmt.Sprintf("%s %x: [error parsing command: %s]", prefix, cmdID, err)
+	}
+	return fmt.Sprintf("%s %x: %s", prefix, cmdID, gogoproto.CompactTextString(&cmd))
+}
+
+// A debugRemoveReplicaCmd command deletes a replica from a store.
+var debugRemoveReplicaCmd = &commander.Command{
+	UsageLine: "unsafe-remove-replica [options] <directory> <raft-id>",
+	Short:     "deletes a replica from a store",
+	Long: `
+Deletes all data of the replica with <raft-id> from the store in the
+RocksDB <directory>, including its range descriptor and Raft log, so
+that the node no longer serves the range when restarted.
+
+This is a last resort for recovering a node which fails to start due to
+a corrupt replica. The deletion is not coordinated with the range's
+other replicas: if it removes the most recent copy of the range's data,
+that data is lost, and the range's Raft group still counts the replica
+as a member until it is removed with a replica change.
+`,
+	Run:  runDebugRemoveReplica,
+	Flag: *flag.CommandLine,
+}
+
+func runDebugRemoveReplica(cmd *commander.Command, args []string) {
+	if len(args) != 2 {
+		cmd.Usage()
+		return
+	}
+	raftID, err := parseRaftID(args[1])
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+	eng, raftEng, closer, err := openStoreEngines(args[0], false)
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to open store: %s\n", err)
+		osExit(1)
+		return
+	}
+	defer closer()
+	states, err := storage.LoadReplicaStates(eng, raftEng)
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to load range descriptors: %s\n", err)
+		osExit(1)
+		return
+	}
+	for _, state := range states {
+		if state.Desc.RaftID != raftID {
+			continue
+		}
+		if err := storage.DestroyReplicaData(eng, raftEng, &state.Desc); err != nil {
+			fmt.Fprintf(osStderr, "unable to remove replica: %s\n", err)
+			osExit(1)
+			return
+		}
+		fmt.Printf("removed replica of range %d: %s-%s\n", raftID, keys.PrettyPrint(state.Desc.StartKey),
+			keys.PrettyPrint(state.Desc.EndKey))
+		return
+	}
+	fmt.Fprintf(osStderr, "range %d not found\n", raftID)
+	osExit(1)
+}
+
+// A debugRangeLookupCmd command describes the range containing a key.
+var debugRangeLookupCmd = &commander.Command{
+	UsageLine: "range-lookup [options] <key>",
+	Short:     "describes the range containing a key",
+	Long: `
+Asks the node at --addr for the range containing <key> and prints its
+descriptor, the holder of its leader lease and the state of each of its
+replicas, including their recent processing by the queues of their
+stores.
+`,
+	Run:  runDebugRangeLookup,
+	Flag: *flag.CommandLine,
+}
+
+func runDebugRangeLookup(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	keys, err := parseKeys(args, true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+	lookup, err := server.LookupRange(Context, keys[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "range lookup failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	printRangeLookup(os.Stdout, lookup)
+}
+
+// printRangeLookup prints the description of a range for display.
+func printRangeLookup(w io.Writer, lookup *status.RangeLookup) {
+	desc := &lookup.Desc
+	fmt.Fprintf(w, "key %s is in range %d: %s-%s\n", keys.PrettyPrint(lookup.Key), desc.RaftID,
+		keys.PrettyPrint(desc.StartKey), keys.PrettyPrint(desc.EndKey))
+	fmt.Fprintf(w, "  descriptor: %s\n", gogoproto.CompactTextString(desc))
+	if lookup.Lease == nil {
+		fmt.Fprintf(w, "  lease: none\n")
+	} else if lookup.LeaseHolder == nil {
+		fmt.Fprintf(w, "  lease: %s\n", gogoproto.CompactTextString(lookup.Lease))
+	} else {
+		fmt.Fprintf(w, "  lease: %s held by node-id=%d store-id=%d\n", gogoproto.CompactTextString(lookup.Lease),
+			lookup.LeaseHolder.NodeID, lookup.LeaseHolder.StoreID)
+	}
+	for _, rng := range lookup.Replicas {
+		fmt.Fprintf(w, "  replica on store-id=%d:", rng.StoreID)
+		if !rng.Initialized {
+			fmt.Fprintf(w, " uninitialized")
+		}
+		if rng.Raft.Error != "" {
+			fmt.Fprintf(w, " raft error: %s\n", rng.Raft.Error)
+		} else {
+			fmt.Fprintf(w, " %s term=%d commit=%d applied=%d last-index=%d leader=%d/%d pending=%d\n",
+				rng.Raft.State, rng.Raft.Term, rng.Raft.Commit, rng.Raft.Applied, rng.Raft.LastIndex,
+				rng.Raft.LeaderNodeID, rng.Raft.LeaderStoreID, rng.PendingCmds)
+		}
+		for _, event := range rng.QueueActivity {
+			fmt.Fprintf(w, "    %s %s queue took %s", event.Time.Format(time.RFC3339), event.Queue, event.Duration)
+			if event.Error != "" {
+				fmt.Fprintf(w, ": %s", event.Error)
+			}
+			fmt.Fprintf(w, "\n")
+		}
+	}
+	for _, nodeErr := range lookup.Errors {
+		fmt.Fprintf(w, "  node %s unreachable: %s\n", nodeErr.NodeID, nodeErr.Error)
+	}
+}
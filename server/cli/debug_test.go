@@ -0,0 +1,165 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/coreos/etcd/raft/raftpb"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// TestFormatRawKeyValue verifies the display of MVCC metadata and
+// versioned values, and of pairs which cannot be decoded.
+func TestFormatRawKeyValue(t *testing.T) {
+	meta := &proto.MVCCMetadata{Timestamp: proto.Timestamp{WallTime: 1}}
+	metaBytes, err := gogoproto.Marshal(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := &proto.MVCCValue{Value: &proto.Value{Bytes: []byte("v")}}
+	valueBytes, err := gogoproto.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamp := proto.Timestamp{WallTime: 1}
+
+	testCases := []struct {
+		kv       proto.RawKeyValue
+		expected string
+	}{
+		{
+			proto.RawKeyValue{Key: engine.MVCCEncodeKey(proto.Key("a")), Value: metaBytes},
+			`"a": ` + gogoproto.CompactTextString(meta),
+		},
+		{
+			proto.RawKeyValue{Key: engine.MVCCEncodeVersionKey(keys.RaftHardStateKey(1), timestamp), Value: valueBytes},
+			fmt.Sprintf("/Local/RangeID/1/RaftHardState @%s: %s", timestamp, gogoproto.CompactTextString(value)),
+		},
+		{
+			proto.RawKeyValue{Key: engine.MVCCEncodeKey(proto.Key("a")), Value: []byte("\xff")},
+			`"a": "\xff"`,
+		},
+		{
+			proto.RawKeyValue{Key: proto.EncodedKey("a"), Value: []byte("b")},
+			`"a": "b"`,
+		},
+	}
+	for i, test := range testCases {
+		if formatted := formatRawKeyValue(test.kv); formatted != test.expected {
+			t.Errorf("%d: expected %s; got %s", i, test.expected, formatted)
+		}
+	}
+}
+
+// TestFormatRaftEntry verifies the display of Raft log entries and
+// their commands.
+func TestFormatRaftEntry(t *testing.T) {
+	cmd := &proto.InternalRaftCommand{RaftID: 1}
+	cmd.Cmd.SetValue(&proto.PutRequest{Value: proto.Value{Bytes: []byte("v")}})
+	cmdBytes, err := gogoproto.Marshal(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmdID := "0123456789abcdef"
+	data := append(append([]byte{0}, cmdID...), cmdBytes...)
+	cc := raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 2, Context: data}
+	ccBytes, err := cc.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		ent      raftpb.Entry
+		expected string
+	}{
+		{
+			raftpb.Entry{Index: 12, Term: 3, Type: raftpb.EntryNormal, Data: data},
+			fmt.Sprintf("12/3 EntryNormal %x: %s", cmdID, gogoproto.CompactTextString(cmd)),
+		},
+		{
+			raftpb.Entry{Index: 13, Term: 3, Type: raftpb.EntryConfChange, Data: ccBytes},
+			fmt.Sprintf("13/3 EntryConfChange ConfChangeAddNode node=2 %x: %s", cmdID, gogoproto.CompactTextString(cmd)),
+		},
+		{
+			raftpb.Entry{Index: 14, Term: 4, Type: raftpb.EntryNormal},
+			"14/4 EntryNormal: [empty]",
+		},
+	}
+	for i, test := range testCases {
+		if formatted := formatRaftEntry(test.ent); formatted != test.expected {
+			t.Errorf("%d: expected %s; got %s", i, test.expected, formatted)
+		}
+	}
+
+	// Malformed commands are reported rather than decoded.
+	formatted := formatRaftEntry(raftpb.Entry{Index: 15, Term: 4, Type: raftpb.EntryNormal, Data: []byte("short")})
+	if !strings.HasPrefix(formatted, "15/4 EntryNormal: [error decoding command: ") {
+		t.Errorf("unexpected formatting of malformed entry: %s", formatted)
+	}
+}
+
+// TestPrintRangeLookup verifies the display of a range's descriptor,
+// lease holder, replicas and queue activity.
+func TestPrintRangeLookup(t *testing.T) {
+	replica := proto.Replica{NodeID: 1, StoreID: 2}
+	lookup := &status.RangeLookup{
+		Key: proto.Key("a"),
+		Desc: proto.RangeDescriptor{
+			RaftID:   3,
+			StartKey: proto.Key("a"),
+			EndKey:   proto.Key("b"),
+			Replicas: []proto.Replica{replica},
+		},
+		Lease:       &proto.Lease{Term: 4},
+		LeaseHolder: &replica,
+		Replicas: []storage.RangeStatus{{
+			StoreID:     2,
+			Initialized: true,
+			Raft:        storage.RaftStatus{State: "leader", Term: 4, Commit: 10, Applied: 10, LastIndex: 10},
+			QueueActivity: []storage.QueueEvent{
+				{Queue: "gc", Time: time.Unix(0, 0).UTC(), Duration: time.Second},
+				{Queue: "split", Time: time.Unix(1, 0).UTC(), Duration: time.Millisecond, Error: "boom"},
+			},
+		}},
+		Errors: []status.NodeError{{NodeID: "5", Error: "timeout"}},
+	}
+	var buf bytes.Buffer
+	printRangeLookup(&buf, lookup)
+	for _, expected := range []string{
+		`key "a" is in range 3: "a"-"b"`,
+		"held by node-id=1 store-id=2",
+		"replica on store-id=2: leader term=4 commit=10 applied=10 last-index=10",
+		"1970-01-01T00:00:00Z gc queue took 1s\n",
+		"1970-01-01T00:00:01Z split queue took 1ms: boom\n",
+		"node 5 unreachable: timeout",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected output to contain %q; got:\n%s", expected, buf.String())
+		}
+	}
+}
@@ -29,7 +29,11 @@ import (
 func initFlags(ctx *server.Context) {
 	// Server flags.
 	flag.StringVar(&ctx.Addr, "addr", ctx.Addr, "when run as the server the host:port to bind for "+
-		"HTTP/RPC traffic; when run as the client the address for connection to the cockroach cluster.")
+		"HTTP/RPC traffic; when run as the client the address for connection to the cockroach cluster, or a "+
+		"comma-separated list of addresses to fail over between.")
+
+	flag.StringVar(&ctx.PGAddr, "pgaddr", ctx.PGAddr, "the host:port to bind for clients of the "+
+		"postgres wire protocol, such as psql; the postgres endpoint is disabled unless specified.")
 
 	flag.StringVar(&ctx.Certs, "certs", ctx.Certs, "directory containing RSA key and x509 certs.")
 
@@ -41,6 +45,12 @@ func initFlags(ctx *server.Context) {
 		"attributes might also include speeds and other specs (7200rpm, 200kiops, etc.). "+
 		"For example, -store=hdd:7200rpm=/mnt/hda1,ssd=/mnt/ssd01,ssd=/mnt/ssd02,mem=1073741824.")
 
+	flag.StringVar(&ctx.RaftLogDirs, "raft-log-dirs", ctx.RaftLogDirs, "specify an optional "+
+		"comma-separated list of locations for the raft logs of the stores given by -stores, "+
+		"in the same order. Each location is either a filepath or an integer size in bytes "+
+		"for an in-memory engine; an empty location keeps the raft log in the store itself. "+
+		"For example, -raft-log-dirs=/mnt/log1,,/mnt/log2.")
+
 	flag.StringVar(&ctx.Attrs, "attrs", ctx.Attrs, "specify an ordered, colon-separated list of node "+
 		"attributes. Attributes are arbitrary strings specifying topography or "+
 		"machine capabilities. Topography might include datacenter designation "+
@@ -57,13 +67,21 @@ func initFlags(ctx *server.Context) {
 		"node-to-node links and if any node notices it has clock offset in excess "+
 		"of -max-offset, it will commit suicide. Setting this value too high may "+
 		"decrease transaction performance in the presence of contention.")
+	flag.DurationVar(&ctx.ClockJumpTolerance, "clock-jump-tolerance", ctx.ClockJumpTolerance,
+		"duration beyond which a sudden jump of the local wall clock, forward or "+
+			"backward, is reported; 0 disables the detection of clock jumps.")
+	flag.BoolVar(&ctx.FatalOnClockJump, "fatal-on-clock-jump", ctx.FatalOnClockJump,
+		"exit instead of only reporting a jump of the wall clock in excess of "+
+			"-clock-jump-tolerance.")
 
 	// Gossip flags.
 	flag.StringVar(&ctx.GossipBootstrap, "gossip", ctx.GossipBootstrap, "specify a "+
 		"comma-separated list of gossip addresses or resolvers for gossip bootstrap. "+
 		"Each item in the list has an optional type: [type=]<address>. "+
 		"Unspecified type means ip address or dns. Type can also be a load balancer (\"lb\"), "+
-		"a unix socket (\"unix\") or, for single-node systems, \"self\".")
+		"a host name re-resolved on each attempt (\"dns\"), a DNS SRV record name (\"srv\"), "+
+		"a unix socket (\"unix\") or, for single-node systems, \"self\". Addresses of nodes "+
+		"seen via gossip are also persisted and used for bootstrap after a restart.")
 
 	flag.DurationVar(&ctx.GossipInterval, "gossip-interval", ctx.GossipInterval,
 		"approximate interval (time.Duration) for gossiping new information to peers.")
@@ -79,10 +97,147 @@ func initFlags(ctx *server.Context) {
 	flag.Int64Var(&ctx.CacheSize, "cache-size", ctx.CacheSize, "total size in bytes for "+
 		"caches, shared evenly if there are multiple storage devices.")
 
+	flag.Int64Var(&ctx.WriteBufferSize, "write-buffer-size", ctx.WriteBufferSize, "size in bytes "+
+		"of each in-memory write buffer (memtable) before it is flushed to disk.")
+
+	flag.IntVar(&ctx.BloomFilterBitsPerKey, "bloom-bits-per-key", ctx.BloomFilterBitsPerKey, "number "+
+		"of bits per key used by the bloom filter of each table; 0 disables bloom filters.")
+
+	flag.StringVar(&ctx.Compression, "compression", ctx.Compression, "compression applied to "+
+		"on-disk blocks; one of \"snappy\", \"none\", \"zlib\" or \"lz4\".")
+
+	flag.IntVar(&ctx.MaxOpenFiles, "max-open-files", ctx.MaxOpenFiles, "maximum number of files "+
+		"kept open by each store; 0 uses the storage engine default and -1 is unlimited.")
+
+	flag.StringVar(&ctx.ValueChecksumMode, "value-checksum-mode", ctx.ValueChecksumMode, "handling of "+
+		"values read whose checksum doesn't match their contents; \"error\" fails the read and "+
+		"\"log\" logs the mismatch. Mismatches are counted in the store status either way.")
+
+	flag.IntVar(&ctx.ValueCompressionThreshold, "value-compression-threshold", ctx.ValueCompressionThreshold,
+		"size in bytes beyond which values written through this node are compressed; 0 disables value compression.")
+
 	flag.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, "specify "+
 		"--scan_interval to adjust the target for the duration of a single scan "+
 		"through a store's ranges. The scan is slowed as necessary to approximately"+
 		"achieve this duration.")
+
+	flag.BoolVar(&ctx.FatalOnInconsistency, "fatal-on-inconsistency", ctx.FatalOnInconsistency, "exit "+
+		"if a periodic consistency check finds that a replica on this node has diverged "+
+		"from its range leader. By default, the diverged replica is removed and re-replicated.")
+
+	flag.BoolVar(&ctx.EnableFaultInjection, "enable-fault-injection", ctx.EnableFaultInjection,
+		"expose an endpoint for injecting network, clock and disk faults into this node. "+
+			"For testing only.")
+
+	flag.BoolVar(&ctx.SyncRaftLog, "sync-raft-log", ctx.SyncRaftLog, "sync appends to "+
+		"the raft log to disk before acknowledging them.")
+
+	flag.BoolVar(&ctx.SyncApply, "sync-apply", ctx.SyncApply, "sync the application of "+
+		"committed raft commands to disk. Committed commands are replayed from the raft "+
+		"log on restart, so this is unnecessary if --sync-raft-log is set.")
+
+	flag.BoolVar(&ctx.StreamRaft, "stream-raft", ctx.StreamRaft, "send raft messages to each "+
+		"other node on a single connection instead of as separate RPCs; snapshots are still "+
+		"sent as RPCs.")
+
+	flag.IntVar(&ctx.MaxIncomingSnapshots, "max-incoming-snapshots", ctx.MaxIncomingSnapshots,
+		"maximum number of raft snapshots each store receives concurrently; 0 is unlimited.")
+
+	flag.IntVar(&ctx.MaxOutgoingSnapshots, "max-outgoing-snapshots", ctx.MaxOutgoingSnapshots,
+		"maximum number of raft snapshots each store sends concurrently; 0 is unlimited.")
+
+	flag.Int64Var(&ctx.SnapshotBytesPerSecond, "snapshot-rate", ctx.SnapshotBytesPerSecond,
+		"maximum rate in bytes per second at which each store sends raft snapshots; 0 is unlimited.")
+
+	flag.Int64Var(&ctx.QueueRangesPerSecond, "queue-rate", ctx.QueueRangesPerSecond,
+		"maximum rate in ranges per second at which the background queues of each store, "+
+			"such as the GC and split queues, process ranges in total; 0 is unlimited.")
+
+	flag.Float64Var(&ctx.CapacityGossipAvailableDelta, "capacity-gossip-available-delta",
+		ctx.CapacityGossipAvailableDelta, "change of a store's available space, as a fraction of "+
+			"its capacity, which causes the capacities of the node's stores to be re-gossiped.")
+
+	flag.IntVar(&ctx.CapacityGossipRangeDelta, "capacity-gossip-range-delta", ctx.CapacityGossipRangeDelta,
+		"change of a store's range count which causes the capacities of the node's stores to be "+
+			"re-gossiped.")
+
+	flag.DurationVar(&ctx.CapacityGossipMaxStaleness, "capacity-gossip-max-staleness",
+		ctx.CapacityGossipMaxStaleness, "interval after which the capacities of the node's stores "+
+			"are re-gossiped even if they haven't changed; at most half the gossip time-to-live of 2m.")
+
+	flag.DurationVar(&ctx.ClosedTimestampLag, "closed-timestamp-lag", ctx.ClosedTimestampLag,
+		"how far behind the current time ranges close timestamps, allowing reads at or below "+
+			"them to be served by any replica; 0 disables follower reads.")
+
+	flag.Int64Var(&ctx.ExportBytesPerSecond, "export-rate", ctx.ExportBytesPerSecond,
+		"maximum rate in bytes per second at which the node writes data exported via "+
+			"the admin export endpoint; 0 is unlimited.")
+
+	flag.Int64Var(&ctx.AdmissionL0Files, "admission-l0-files", ctx.AdmissionL0Files,
+		"number of files in level 0 of a store's storage engine, which accumulate when "+
+			"compactions fall behind, at which the store pushes back on writes; 0 disables the check.")
+
+	flag.Int64Var(&ctx.AdmissionMemtableBytes, "admission-memtable-size", ctx.AdmissionMemtableBytes,
+		"size in bytes of a store's unflushed memtables at which the store pushes back on "+
+			"writes; 0 disables the check.")
+
+	flag.Int64Var(&ctx.AdmissionRaftLogEntries, "admission-raft-log-entries", ctx.AdmissionRaftLogEntries,
+		"number of entries in one of a store's raft logs at which the store pushes back on "+
+			"writes; 0 disables the check.")
+
+	flag.Int64Var(&ctx.AdmissionWritesPerSecond, "admission-rate", ctx.AdmissionWritesPerSecond,
+		"maximum rate in writes per second to which a store pushing back on writes limits "+
+			"them; 0 is unlimited. Low and background priority writes are rejected at the "+
+			"admission thresholds, and default priority writes at twice any of them.")
+
+	// Audit flags.
+
+	flag.StringVar(&ctx.AuditLog, "audit-log", ctx.AuditLog, "file to which admin commands, "+
+		"replica changes, config changes, failed authentications and denied requests are "+
+		"appended as JSON entries; empty disables the audit log.")
+
+	flag.StringVar(&ctx.AuditPrefixes, "audit-prefixes", ctx.AuditPrefixes, "comma-separated "+
+		"list of key prefixes under which all writes are also recorded in the audit log.")
+
+	// Key/value flags.
+
+	flag.StringVar(&kvTimestamp, "ts", kvTimestamp, "timestamp at which kv get and scan "+
+		"read, as an RFC3339 time or as <seconds>.<nanoseconds>[,<logical>]; empty reads "+
+		"the latest values.")
+
+	// Load flags.
+
+	flag.Int64Var(&loadContext.Keys, "load-keys", loadContext.Keys, "number of distinct keys "+
+		"read and written by the load generators.")
+
+	flag.StringVar(&loadContext.Distribution, "load-distribution", loadContext.Distribution,
+		"distribution of the keys read and written by the load generators, either uniform or zipf.")
+
+	flag.IntVar(&loadContext.ReadPercent, "load-read-percent", loadContext.ReadPercent,
+		"percentage of the operations of the load generators which are reads; the rest are writes.")
+
+	flag.IntVar(&loadContext.Batch, "load-batch", loadContext.Batch, "number of operations "+
+		"sent by the load generators in each batch.")
+
+	flag.IntVar(&loadContext.Concurrency, "load-concurrency", loadContext.Concurrency,
+		"number of batches the load generators keep in flight.")
+
+	flag.DurationVar(&loadContext.Duration, "load-duration", loadContext.Duration,
+		"duration for which the load generators run.")
+
+	flag.IntVar(&loadContext.ValueBytes, "load-value-bytes", loadContext.ValueBytes,
+		"size in bytes of the values written by the load generators.")
+
+	// Debug flags.
+
+	flag.StringVar(&debugRaftDir, "raft-dir", debugRaftDir, "directory of the raft log of the "+
+		"store given to the debug commands, if kept apart from the store by --raft-log-dirs.")
+
+	// Tracing flags.
+
+	flag.DurationVar(&ctx.SlowRequestThreshold, "slow-request-threshold", ctx.SlowRequestThreshold,
+		"duration beyond which the trace of a request is logged, with the time spent in each "+
+			"phase of its execution; 0 disables logging of slow requests.")
 }
 
 func init() {
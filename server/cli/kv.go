@@ -21,41 +21,206 @@ package cli
 
 import (
 	"bytes"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 
 	commander "code.google.com/p/go-commander"
+	gogoproto "github.com/gogo/protobuf/proto"
 )
 
 var osExit = os.Exit
 var osStderr = os.Stderr
 
+// kvTimestamp is the timestamp at which get and scan read, if set.
+var kvTimestamp string
+
 func makeKVClient() (*client.KV, error) {
 	httpClient, err := Context.GetHTTPClient()
 	if err != nil {
 		return nil, err
 	}
-	httpSender := client.NewHTTPSender(util.EnsureHost(Context.Addr), httpClient)
+	addrs := strings.Split(Context.Addr, ",")
+	for i := range addrs {
+		addrs[i] = util.EnsureHost(strings.TrimSpace(addrs[i]))
+	}
+	httpSender := client.NewHTTPSender(strings.Join(addrs, ","), httpClient)
 	kv := client.NewKV(nil, httpSender)
 	// TODO(pmattis): Initialize this to something more reasonable
 	kv.User = "root"
 	return kv, nil
 }
 
+// A kvCmd command runs the key/value subcommands.
+var kvCmd = &commander.Command{
+	UsageLine: "kv [options] <command> [arguments]",
+	Short:     "gets, puts, scans and deletes keys",
+	Long: `
+Runs the key/value command given by <command>, one of get, put, inc,
+del and scan, against the nodes at the comma-separated addresses given
+by --addr, using the client certificates in --certs. Requests fail over
+between the nodes, including those learned from the cluster.
+
+Keys and values are given verbatim, as Go-quoted strings (e.g.
+"a\x00b") or as hex prefixed by 0x (e.g. 0x610062). Keys are displayed
+quoted. Values of system keys holding range descriptors, configs and
+store statuses are displayed as protobuf text.
+
+get and scan read at the timestamp given by --ts, if set, either as
+an RFC3339 time or as <seconds>.<nanoseconds>[,<logical>].
+`,
+	Run:  runSubcommand(kvCmds),
+	Flag: *flag.CommandLine,
+}
+
+var kvCmds = &commander.Commander{
+	Name: "kv",
+	Commands: []*commander.Command{
+		getCmd,
+		putCmd,
+		incCmd,
+		delCmd,
+		scanCmd,
+	},
+}
+
+// parseBytes parses a key or value given verbatim, Go-quoted or as
+// hex prefixed by 0x.
+func parseBytes(arg string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(arg, `"`):
+		s, err := strconv.Unquote(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted string %s: %s", arg, err)
+		}
+		return []byte(s), nil
+	case strings.HasPrefix(arg, "0x"):
+		b, err := hex.DecodeString(arg[2:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex string %s: %s", arg, err)
+		}
+		return b, nil
+	}
+	return []byte(arg), nil
+}
+
+// parseKeys parses each of the supplied keys. Unless system is true,
+// system keys are rejected.
+func parseKeys(args []string, system bool) ([]proto.Key, error) {
+	parsed := make([]proto.Key, len(args))
+	for i, arg := range args {
+		b, err := parseBytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = proto.Key(b)
+		if !system && bytes.HasPrefix(parsed[i], keys.KeySystemPrefix) {
+			return nil, fmt.Errorf("unable to modify system key: %s", parsed[i])
+		}
+	}
+	return parsed, nil
+}
+
+// parseTimestamp parses a timestamp given as an RFC3339 time or as
+// <seconds>.<nanoseconds>[,<logical>], the format in which timestamps
+// are displayed.
+func parseTimestamp(arg string) (proto.Timestamp, error) {
+	if t, err := time.Parse(time.RFC3339Nano, arg); err == nil {
+		return proto.Timestamp{WallTime: t.UnixNano()}, nil
+	}
+	var ts proto.Timestamp
+	wall := arg
+	if i := strings.Index(arg, ","); i >= 0 {
+		logical, err := strconv.ParseInt(arg[i+1:], 10, 32)
+		if err != nil {
+			return ts, fmt.Errorf("invalid timestamp %s: %s", arg, err)
+		}
+		ts.Logical, wall = int32(logical), arg[:i]
+	}
+	secs, nanos := wall, ""
+	if i := strings.Index(wall, "."); i >= 0 {
+		secs, nanos = wall[:i], wall[i+1:]
+	}
+	if len(nanos) > 9 {
+		return ts, fmt.Errorf("invalid timestamp %s: more than 9 fractional digits", arg)
+	}
+	s, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return ts, fmt.Errorf("invalid timestamp %s: %s", arg, err)
+	}
+	var n int64
+	if nanos != "" {
+		if n, err = strconv.ParseInt(nanos+strings.Repeat("0", 9-len(nanos)), 10, 64); err != nil {
+			return ts, fmt.Errorf("invalid timestamp %s: %s", arg, err)
+		}
+	}
+	ts.WallTime = s*1e9 + n
+	return ts, nil
+}
+
+// readTimestamp returns the timestamp given by --ts, or the zero
+// timestamp to read the latest values.
+func readTimestamp() (proto.Timestamp, error) {
+	if kvTimestamp == "" {
+		return proto.ZeroTimestamp, nil
+	}
+	return parseTimestamp(kvTimestamp)
+}
+
+// systemValueTypes maps the prefixes of system keys to the protobufs
+// of their values.
+var systemValueTypes = []struct {
+	prefix  proto.Key
+	newFunc func() gogoproto.Message
+}{
+	{keys.KeyMeta1Prefix, func() gogoproto.Message { return &proto.RangeDescriptor{} }},
+	{keys.KeyMeta2Prefix, func() gogoproto.Message { return &proto.RangeDescriptor{} }},
+	{keys.KeyConfigAccountingPrefix, func() gogoproto.Message { return &proto.AcctConfig{} }},
+	{keys.KeyConfigPermissionPrefix, func() gogoproto.Message { return &proto.PermConfig{} }},
+	{keys.KeyConfigZonePrefix, func() gogoproto.Message { return &proto.ZoneConfig{} }},
+	{keys.KeyUserPrefix, func() gogoproto.Message { return &proto.UserConfig{} }},
+	{keys.KeyStatusStorePrefix, func() gogoproto.Message { return &proto.StoreStatus{} }},
+	{keys.KeyNodeLivenessPrefix, func() gogoproto.Message { return &proto.Liveness{} }},
+}
+
+// formatValue formats the value of the supplied key for display.
+// Values of system keys with protobuf values are displayed as protobuf
+// text; bytes which aren't printable are displayed quoted.
+func formatValue(key proto.Key, value *proto.Value) string {
+	if value.Integer != nil {
+		return strconv.FormatInt(*value.Integer, 10)
+	}
+	for _, t := range systemValueTypes {
+		if bytes.HasPrefix(key, t.prefix) {
+			msg := t.newFunc()
+			if err := gogoproto.Unmarshal(value.Bytes, msg); err == nil {
+				return gogoproto.CompactTextString(msg)
+			}
+			break
+		}
+	}
+	if utf8.Valid(value.Bytes) && strconv.CanBackquote(string(value.Bytes)) {
+		return string(value.Bytes)
+	}
+	return strconv.Quote(string(value.Bytes))
+}
+
 // A getCmd command gets the value for the specified key.
 var getCmd = &commander.Command{
 	UsageLine: "get [options] <key>",
 	Short:     "gets the value for a key",
 	Long: `
-Fetches and display the value for <key>.
+Fetches and display the value for <key>, as of --ts if set.
 `,
 	Run:  runGet,
 	Flag: *flag.CommandLine,
@@ -66,14 +231,27 @@ func runGet(cmd *commander.Command, args []string) {
 		cmd.Usage()
 		return
 	}
+	keys, err := parseKeys(args, true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+	timestamp, err := readTimestamp()
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
 	kv, err := makeKVClient()
 	if err != nil {
 		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
 		osExit(1)
 		return
 	}
-	key := proto.Key(args[0])
+	key := keys[0]
 	call := client.GetCall(key)
+	call.Args.Header().Timestamp = timestamp
 	resp := call.Reply.(*proto.GetResponse)
 	if err := kv.Run(call); err != nil {
 		fmt.Fprintf(osStderr, "get failed: %s\n", err)
@@ -85,11 +263,7 @@ func runGet(cmd *commander.Command, args []string) {
 		osExit(1)
 		return
 	}
-	if resp.Value.Integer != nil {
-		fmt.Printf("%d\n", *resp.Value.Integer)
-	} else {
-		fmt.Printf("%s\n", resp.Value.Bytes)
-	}
+	fmt.Printf("%s\n", formatValue(key, resp.Value))
 }
 
 // A putCmd command sets the value for one or more keys.
@@ -111,16 +285,23 @@ func runPut(cmd *commander.Command, args []string) {
 		return
 	}
 
-	// TODO(pmattis): Investigate allowing keys/values to be quoted and
-	// to unquote them using strconv.Unquote.
-
 	// Do not allow system keys to be put.
+	var keys []proto.Key
+	var values [][]byte
 	for i := 0; i < len(args); i += 2 {
-		if strings.HasPrefix(args[i], "\x00") {
-			fmt.Fprintf(osStderr, "unable to put system key: %s\n", proto.Key(args[i]))
+		key, err := parseKeys(args[i:i+1], false)
+		if err != nil {
+			fmt.Fprintf(osStderr, "%s\n", err)
 			osExit(1)
 			return
 		}
+		value, err := parseBytes(args[i+1])
+		if err != nil {
+			fmt.Fprintf(osStderr, "%s\n", err)
+			osExit(1)
+			return
+		}
+		keys, values = append(keys, key[0]), append(values, value)
 	}
 
 	kv, err := makeKVClient()
@@ -131,10 +312,8 @@ func runPut(cmd *commander.Command, args []string) {
 	}
 	opts := &client.TransactionOptions{Name: "test", Isolation: proto.SERIALIZABLE}
 	err = kv.RunTransaction(opts, func(txn *client.Txn) error {
-		for i := 0; i < len(args); i += 2 {
-			key := proto.Key(args[i])
-			value := []byte(args[i+1])
-			txn.Prepare(client.PutCall(key, value))
+		for i := range keys {
+			txn.Prepare(client.PutCall(keys[i], values[i]))
 		}
 		return nil
 	})
@@ -158,13 +337,14 @@ not specified. Displays the incremented value upon success.
 }
 
 func runInc(cmd *commander.Command, args []string) {
-	if len(args) > 2 {
+	if len(args) == 0 || len(args) > 2 {
 		cmd.Usage()
 		return
 	}
 
-	if strings.HasPrefix(args[0], "\x00") {
-		fmt.Fprintf(osStderr, "unable to increment system key: %s\n", proto.Key(args[0]))
+	keys, err := parseKeys(args[:1], false)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
 		osExit(1)
 		return
 	}
@@ -185,8 +365,7 @@ func runInc(cmd *commander.Command, args []string) {
 		}
 	}
 
-	key := proto.Key(args[0])
-	call := client.IncrementCall(key, int64(amount))
+	call := client.IncrementCall(keys[0], int64(amount))
 	resp := call.Reply.(*proto.IncrementResponse)
 	if err := kv.Run(call); err != nil {
 		fmt.Fprintf(osStderr, "increment failed: %s\n", err)
@@ -214,12 +393,11 @@ func runDel(cmd *commander.Command, args []string) {
 	}
 
 	// Do not allow system keys to be deleted.
-	for i := 0; i < len(args); i++ {
-		if strings.HasPrefix(args[i], "\x00") {
-			fmt.Fprintf(osStderr, "unable to delete system key: %s\n", proto.Key(args[i]))
-			osExit(1)
-			return
-		}
+	keys, err := parseKeys(args, false)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
 	}
 
 	kv, err := makeKVClient()
@@ -230,8 +408,7 @@ func runDel(cmd *commander.Command, args []string) {
 	}
 	opts := &client.TransactionOptions{Name: "test", Isolation: proto.SERIALIZABLE}
 	err = kv.RunTransaction(opts, func(txn *client.Txn) error {
-		for i := 0; i < len(args); i++ {
-			key := proto.Key(args[i])
+		for _, key := range keys {
 			txn.Prepare(client.DeleteCall(key))
 		}
 		return nil
@@ -249,10 +426,10 @@ var scanCmd = &commander.Command{
 	UsageLine: "scan [options] [<start-key> [<end-key>]]",
 	Short:     "scans a range of keys\n",
 	Long: `
-Fetches and display the key/value pairs for a range. If no <start-key>
-is specified then all (non-system) key/value pairs are retrieved. If no
-<end-key> is specified then all keys greater than or equal to <start-key>
-are retrieved.
+Fetches and display the key/value pairs for a range, as of --ts if
+set. If no <start-key> is specified then all (non-system) key/value
+pairs are retrieved. If no <end-key> is specified then all keys greater
+than or equal to <start-key> are retrieved.
 
 Caveat: Currently only retrieves up to 1000 keys.
 `,
@@ -265,22 +442,26 @@ func runScan(cmd *commander.Command, args []string) {
 		cmd.Usage()
 		return
 	}
-	var (
-		startKey proto.Key
-		endKey   proto.Key
-	)
-	if len(args) >= 1 {
-		startKey = proto.Key(args[0])
-	} else {
-		// Start with the first key after the system key range.
-		//
-		// TODO(pmattis): Add a flag for retrieving system keys as well.
-		startKey = engine.KeySystemMax
+	scanKeys, err := parseKeys(args, true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
 	}
-	if len(args) >= 2 {
-		endKey = proto.Key(args[1])
-	} else {
-		endKey = proto.KeyMax
+	// Start with the first key after the system key range, unless
+	// otherwise specified.
+	startKey, endKey := keys.KeySystemMax, proto.KeyMax
+	if len(scanKeys) >= 1 {
+		startKey = scanKeys[0]
+	}
+	if len(scanKeys) >= 2 {
+		endKey = scanKeys[1]
+	}
+	timestamp, err := readTimestamp()
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
 	}
 
 	kv, err := makeKVClient()
@@ -291,6 +472,7 @@ func runScan(cmd *commander.Command, args []string) {
 	}
 	// TODO(pmattis): Add a flag for the number of results to scan.
 	call := client.ScanCall(startKey, endKey, 1000)
+	call.Args.Header().Timestamp = timestamp
 	resp := call.Reply.(*proto.ScanResponse)
 	if err := kv.Run(call); err != nil {
 		fmt.Fprintf(osStderr, "scan failed: %s\n", err)
@@ -299,16 +481,6 @@ func runScan(cmd *commander.Command, args []string) {
 	}
 
 	for _, r := range resp.Rows {
-		if bytes.HasPrefix(r.Key, []byte{0}) {
-			// TODO(pmattis): Pretty-print system keys.
-			fmt.Printf("%s\n", r.Key)
-			continue
-		}
-
-		if r.Value.Integer != nil {
-			fmt.Printf("%s\t%d\n", r.Key, *r.Value.Integer)
-		} else {
-			fmt.Printf("%s\t%s\n", r.Key, r.Value.Bytes)
-		}
+		fmt.Printf("%s\t%s\n", r.Key, formatValue(r.Key, &r.Value))
 	}
 }
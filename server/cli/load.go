@@ -0,0 +1,420 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+
+	commander "code.google.com/p/go-commander"
+)
+
+// loadSettings are the settings of the load generators.
+type loadSettings struct {
+	Keys         int64
+	Distribution string
+	ReadPercent  int
+	Batch        int
+	Concurrency  int
+	Duration     time.Duration
+	ValueBytes   int
+}
+
+// loadContext holds the settings of the load generators, which are set
+// by the load flags.
+var loadContext = loadSettings{
+	Keys:         100000,
+	Distribution: "uniform",
+	ReadPercent:  95,
+	Batch:        1,
+	Concurrency:  16,
+	Duration:     time.Minute,
+	ValueBytes:   100,
+}
+
+// A loadCmd command runs the load generators.
+var loadCmd = &commander.Command{
+	UsageLine: "load [options] <generator>",
+	Short:     "generates load against a cluster\n",
+	Long: `
+Runs the load generator given by <generator> against the node at the
+address given by --addr, using the client certificates in --certs, for
+--load-duration. The only generator is:
+
+  kv  reads and writes random keys, each batch of --load-batch
+      operations reading with probability --load-read-percent and
+      otherwise writing values of --load-value-bytes bytes
+
+Keys are drawn from --load-keys keys by the distribution given by
+--load-distribution, either uniform or zipf; under zipf, a few keys
+are far more popular than the others. Each of --load-concurrency
+workers runs one batch at a time.
+
+Throughput and latency are reported each second and summarized at the
+end, when the latencies are displayed as a histogram.
+`,
+	Run:  runSubcommand(loadCmds),
+	Flag: *flag.CommandLine,
+}
+
+var loadCmds = &commander.Commander{
+	Name: "load",
+	Commands: []*commander.Command{
+		loadKVCmd,
+	},
+}
+
+var loadKVCmd = &commander.Command{
+	UsageLine: "kv",
+	Short:     "reads and writes random keys",
+	Long: `
+Reads and writes random keys in batches, as configured by the load
+flags.
+`,
+	Run:  runLoadKV,
+	Flag: *flag.CommandLine,
+}
+
+// loadKeyPrefix prefixes the keys read and written by the kv load
+// generator.
+var loadKeyPrefix = proto.Key("load-")
+
+// A keyGenerator draws keys from a distribution.
+type keyGenerator interface {
+	// next returns the index of the next key, in [0, keys).
+	next() int64
+}
+
+type uniformGenerator struct {
+	rng  *rand.Rand
+	keys int64
+}
+
+func (g uniformGenerator) next() int64 {
+	return g.rng.Int63n(g.keys)
+}
+
+type zipfGenerator struct {
+	zipf *rand.Zipf
+}
+
+func (g zipfGenerator) next() int64 {
+	return int64(g.zipf.Uint64())
+}
+
+// zipfS is the exponent of the zipf distribution; the i'th most
+// popular key is drawn with probability proportional to 1/(1+i)^zipfS.
+const zipfS = 1.1
+
+// newKeyGenerator returns a generator drawing from keys keys by the
+// named distribution.
+func newKeyGenerator(distribution string, keys int64, rng *rand.Rand) (keyGenerator, error) {
+	if keys <= 0 {
+		return nil, fmt.Errorf("invalid number of keys: %d", keys)
+	}
+	switch distribution {
+	case "uniform":
+		return uniformGenerator{rng: rng, keys: keys}, nil
+	case "zipf":
+		return zipfGenerator{zipf: rand.NewZipf(rng, zipfS, 1, uint64(keys-1))}, nil
+	}
+	return nil, fmt.Errorf("unknown key distribution %q; expected uniform or zipf", distribution)
+}
+
+func loadKey(i int64) proto.Key {
+	return proto.Key(fmt.Sprintf("%s%016x", loadKeyPrefix, i))
+}
+
+// randomValue returns n random printable bytes.
+func randomValue(rng *rand.Rand, n int) []byte {
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	value := make([]byte, n)
+	for i := range value {
+		value[i] = chars[rng.Intn(len(chars))]
+	}
+	return value
+}
+
+// histogramPrecision is the number of buckets of a latencyHistogram
+// per factor of e, giving each bucket a width of 1%.
+const histogramPrecision = 100
+
+// histogramBuckets suffices for latencies of up to about half an hour.
+const histogramBuckets = 2200
+
+// A latencyHistogram counts latencies in buckets of exponentially
+// growing width, so that quantiles are accurate to within 1%.
+type latencyHistogram struct {
+	counts [histogramBuckets]int64
+	count  int64
+	sum    time.Duration
+	min    time.Duration
+	max    time.Duration
+}
+
+func histogramBucket(d time.Duration) int {
+	us := float64(d) / float64(time.Microsecond)
+	b := int(histogramPrecision*math.Log1p(math.Max(us, 0)) + 0.5)
+	if b >= histogramBuckets {
+		b = histogramBuckets - 1
+	}
+	return b
+}
+
+func histogramValue(b int) time.Duration {
+	return time.Duration(math.Expm1(float64(b)/histogramPrecision) * float64(time.Microsecond))
+}
+
+// record adds the latency to the histogram.
+func (h *latencyHistogram) record(d time.Duration) {
+	h.counts[histogramBucket(d)]++
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	if d > h.max {
+		h.max = d
+	}
+	h.count++
+	h.sum += d
+}
+
+// merge adds the latencies of o to the histogram.
+func (h *latencyHistogram) merge(o *latencyHistogram) {
+	if o.count == 0 {
+		return
+	}
+	for b, c := range o.counts {
+		h.counts[b] += c
+	}
+	if h.count == 0 || o.min < h.min {
+		h.min = o.min
+	}
+	if o.max > h.max {
+		h.max = o.max
+	}
+	h.count += o.count
+	h.sum += o.sum
+}
+
+// mean returns the mean latency.
+func (h *latencyHistogram) mean() time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.count)
+}
+
+// quantile returns the latency below which a fraction q of the
+// latencies fall.
+func (h *latencyHistogram) quantile(q float64) time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q * float64(h.count)))
+	var seen int64
+	for b, c := range h.counts {
+		if seen += c; seen >= rank && c > 0 {
+			// Clamp the approximation to the exact extremes.
+			d := histogramValue(b)
+			if d < h.min {
+				d = h.min
+			} else if d > h.max {
+				d = h.max
+			}
+			return d
+		}
+	}
+	return h.max
+}
+
+// print displays the histogram, merging buckets so that each line
+// accounts for a factor of two in latency.
+func (h *latencyHistogram) print(w io.Writer) {
+	if h.count == 0 {
+		return
+	}
+	var bins []int64
+	for b, c := range h.counts {
+		if c == 0 {
+			continue
+		}
+		bin := 0
+		for upper := time.Microsecond; histogramValue(b) >= upper; upper *= 2 {
+			bin++
+		}
+		for len(bins) <= bin {
+			bins = append(bins, 0)
+		}
+		bins[bin] += c
+	}
+	tw := tabwriter.NewWriter(w, 2, 1, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "latency\tcount\t%%\t\n")
+	for bin, c := range bins {
+		if c > 0 {
+			fmt.Fprintf(tw, "< %s\t%d\t%.1f\t\n", time.Microsecond<<uint(bin), c,
+				100*float64(c)/float64(h.count))
+		}
+	}
+	tw.Flush()
+}
+
+// loadStats accumulates the outcomes of the batches run by the load
+// generator's workers.
+type loadStats struct {
+	sync.Mutex
+	reads, writes int64
+	errors        int64
+	latency       latencyHistogram
+}
+
+// record records the outcome of a batch of reads and writes taking
+// the specified latency.
+func (s *loadStats) record(reads, writes int, latency time.Duration, err error) {
+	s.Lock()
+	defer s.Unlock()
+	if err != nil {
+		s.errors++
+		return
+	}
+	s.reads += int64(reads)
+	s.writes += int64(writes)
+	s.latency.record(latency)
+}
+
+// snapshot returns the stats accumulated since the last snapshot and
+// resets them.
+func (s *loadStats) snapshot() *loadStats {
+	s.Lock()
+	defer s.Unlock()
+	snap := &loadStats{reads: s.reads, writes: s.writes, errors: s.errors, latency: s.latency}
+	s.reads, s.writes, s.errors = 0, 0, 0
+	s.latency = latencyHistogram{}
+	return snap
+}
+
+// runLoadKV runs the kv load generator.
+func runLoadKV(cmd *commander.Command, args []string) {
+	if len(args) != 0 {
+		cmd.Usage()
+		return
+	}
+	if err := loadKV(os.Stdout); err != nil {
+		fmt.Fprintf(osStderr, "load failed: %s\n", err)
+		osExit(1)
+	}
+}
+
+// loadKV runs the kv load generator as configured by loadContext and
+// reports on its progress to w.
+func loadKV(w io.Writer) error {
+	lc := loadContext
+	if lc.ReadPercent < 0 || lc.ReadPercent > 100 {
+		return fmt.Errorf("invalid read percentage: %d", lc.ReadPercent)
+	}
+	if lc.Batch <= 0 || lc.Concurrency <= 0 {
+		return fmt.Errorf("batch size and concurrency must be positive")
+	}
+	seed := time.Now().UnixNano()
+	if _, err := newKeyGenerator(lc.Distribution, lc.Keys, rand.New(rand.NewSource(seed))); err != nil {
+		return err
+	}
+	kv, err := makeKVClient()
+	if err != nil {
+		return err
+	}
+
+	stats := &loadStats{}
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < lc.Concurrency; i++ {
+		rng := rand.New(rand.NewSource(seed + int64(i)))
+		keys, _ := newKeyGenerator(lc.Distribution, lc.Keys, rng)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				calls := make([]client.Call, lc.Batch)
+				var reads, writes int
+				for j := range calls {
+					key := loadKey(keys.next())
+					if rng.Intn(100) < lc.ReadPercent {
+						calls[j] = client.GetCall(key)
+						reads++
+					} else {
+						calls[j] = client.PutCall(key, randomValue(rng, lc.ValueBytes))
+						writes++
+					}
+				}
+				start := time.Now()
+				err := kv.Run(calls...)
+				stats.record(reads, writes, time.Since(start), err)
+			}
+		}()
+	}
+
+	tw := tabwriter.NewWriter(w, 2, 1, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "elapsed\treads/s\twrites/s\terrors\tp50\tp95\tp99\t\n")
+	total := &loadStats{}
+	start := time.Now()
+	ticker := time.NewTicker(time.Second)
+	deadline := time.After(lc.Duration)
+	for done := false; !done; {
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			done = true
+			close(stop)
+			wg.Wait()
+		}
+		snap := stats.snapshot()
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%s\t%s\t\n", time.Since(start)/time.Second*time.Second,
+			snap.reads, snap.writes, snap.errors, snap.latency.quantile(.5),
+			snap.latency.quantile(.95), snap.latency.quantile(.99))
+		tw.Flush()
+		total.reads += snap.reads
+		total.writes += snap.writes
+		total.errors += snap.errors
+		total.latency.merge(&snap.latency)
+	}
+	ticker.Stop()
+
+	elapsed := time.Since(start).Seconds()
+	fmt.Fprintf(w, "\n%d reads (%.1f/s), %d writes (%.1f/s), %d failed batches in %.1fs\n",
+		total.reads, float64(total.reads)/elapsed, total.writes, float64(total.writes)/elapsed,
+		total.errors, elapsed)
+	h := &total.latency
+	fmt.Fprintf(w, "batch latency: min %s, mean %s, p50 %s, p95 %s, p99 %s, p99.9 %s, max %s\n\n",
+		h.min, h.mean(), h.quantile(.5), h.quantile(.95), h.quantile(.99), h.quantile(.999), h.max)
+	h.print(w)
+	return nil
+}
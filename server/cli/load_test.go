@@ -0,0 +1,127 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/server"
+)
+
+// TestLatencyHistogram verifies that the quantiles of a histogram are
+// accurate to within its precision, and that histograms merge.
+func TestLatencyHistogram(t *testing.T) {
+	var a, b latencyHistogram
+	for i := 1; i <= 1000; i++ {
+		if i%2 == 0 {
+			a.record(time.Duration(i) * time.Millisecond)
+		} else {
+			b.record(time.Duration(i) * time.Millisecond)
+		}
+	}
+	a.merge(&b)
+	if a.count != 1000 || a.min != time.Millisecond || a.max != time.Second {
+		t.Fatalf("unexpected count %d, min %s, max %s", a.count, a.min, a.max)
+	}
+	if mean := a.mean(); mean != 500500*time.Microsecond {
+		t.Errorf("expected mean of 500.5ms; got %s", mean)
+	}
+	for _, test := range []struct {
+		q        float64
+		expected time.Duration
+	}{
+		{0, time.Millisecond},
+		{.5, 500 * time.Millisecond},
+		{.99, 990 * time.Millisecond},
+		{1, time.Second},
+	} {
+		d := a.quantile(test.q)
+		if diff := d - test.expected; diff < -test.expected/100 || diff > test.expected/100 {
+			t.Errorf("expected quantile %.2f to be within 1%% of %s; got %s", test.q, test.expected, d)
+		}
+	}
+
+	var buf bytes.Buffer
+	a.print(&buf)
+	if out := buf.String(); !strings.Contains(out, "< 1.048576s") || strings.Contains(out, "< 2.097152s") {
+		t.Errorf("unexpected histogram:\n%s", out)
+	}
+}
+
+// TestKeyGenerators verifies that keys are drawn from the requested
+// range, and that the zipf distribution favors the first keys.
+func TestKeyGenerators(t *testing.T) {
+	const keys = 100
+	for _, distribution := range []string{"uniform", "zipf"} {
+		g, err := newKeyGenerator(distribution, keys, rand.New(rand.NewSource(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var counts [keys]int
+		for i := 0; i < 10000; i++ {
+			k := g.next()
+			if k < 0 || k >= keys {
+				t.Fatalf("%s: key %d out of range", distribution, k)
+			}
+			counts[k]++
+		}
+		if skewed := counts[0] > 10*counts[keys-1]; skewed != (distribution == "zipf") {
+			t.Errorf("%s: unexpected counts of the first and last keys: %d, %d",
+				distribution, counts[0], counts[keys-1])
+		}
+	}
+	if _, err := newKeyGenerator("normal", keys, rand.New(rand.NewSource(1))); err == nil {
+		t.Error("expected an error for an unknown distribution")
+	}
+}
+
+// TestLoadKV runs the kv load generator briefly against a server.
+func TestLoadKV(t *testing.T) {
+	s := &server.TestServer{}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	addr, certs, lc := Context.Addr, Context.Certs, loadContext
+	defer func() {
+		Context.Addr, Context.Certs, loadContext = addr, certs, lc
+	}()
+	Context.Addr = s.ServingAddr()
+	Context.Certs = security.EmbeddedCertsDir
+	loadContext.Keys = 100
+	loadContext.Distribution = "zipf"
+	loadContext.ReadPercent = 50
+	loadContext.Batch = 4
+	loadContext.Concurrency = 4
+	loadContext.Duration = time.Second
+
+	var buf bytes.Buffer
+	if err := loadKV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, " 0 failed batches") || strings.Contains(out, "\n0 reads") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
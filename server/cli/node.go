@@ -0,0 +1,121 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util/hlc"
+)
+
+// A nodeCmd command runs the node subcommands.
+var nodeCmd = &commander.Command{
+	UsageLine: "node [options] <command> [arguments]",
+	Short:     "decommissions and recommissions nodes",
+	Long: `
+Runs the node command given by <command>, one of decommission and
+recommission.
+`,
+	Run:  runSubcommand(nodeCmds),
+	Flag: *flag.CommandLine,
+}
+
+var nodeCmds = &commander.Commander{
+	Name: "node",
+	Commands: []*commander.Command{
+		decommissionNodeCmd,
+		recommissionNodeCmd,
+	},
+}
+
+// A decommissionNodeCmd command decommissions a node.
+var decommissionNodeCmd = &commander.Command{
+	UsageLine: "decommission [options] <node-id>",
+	Short:     "decommissions a node",
+	Long: `
+Marks the node with ID <node-id> as decommissioning in its liveness
+record. No new replicas are allocated to a decommissioning node.
+`,
+	Run:  runSetDecommissioning(true),
+	Flag: *flag.CommandLine,
+}
+
+// A recommissionNodeCmd command recommissions a node.
+var recommissionNodeCmd = &commander.Command{
+	UsageLine: "recommission [options] <node-id>",
+	Short:     "recommissions a decommissioning node",
+	Long: `
+Clears the decommissioning mark of the node with ID <node-id>, again
+allowing replicas to be allocated to it.
+`,
+	Run:  runSetDecommissioning(false),
+	Flag: *flag.CommandLine,
+}
+
+// runSetDecommissioning returns a command runner which sets or clears
+// the decommissioning flag of the liveness record of the node given by
+// the only argument. The record must exist, i.e. the node must have
+// heartbeated its liveness at least once.
+func runSetDecommissioning(decommissioning bool) func(*commander.Command, []string) {
+	return func(cmd *commander.Command, args []string) {
+		if len(args) != 1 {
+			cmd.Usage()
+			return
+		}
+		id, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil || id <= 0 {
+			fmt.Fprintf(osStderr, "invalid node ID: %s\n", args[0])
+			osExit(1)
+			return
+		}
+		nodeID := proto.NodeID(id)
+
+		kv, err := makeKVClient()
+		if err != nil {
+			fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+			osExit(1)
+			return
+		}
+		nl := storage.NewNodeLiveness(kv, hlc.NewClock(hlc.UnixNano), storage.DefaultLivenessThreshold)
+		if err := nl.Refresh(); err != nil {
+			fmt.Fprintf(osStderr, "failed to read node liveness: %s\n", err)
+			osExit(1)
+			return
+		}
+		if _, ok := nl.GetLiveness(nodeID); !ok {
+			fmt.Fprintf(osStderr, "node %d has no liveness record\n", nodeID)
+			osExit(1)
+			return
+		}
+		if err := nl.SetDecommissioning(nodeID, decommissioning); err != nil {
+			fmt.Fprintf(osStderr, "failed to update node liveness: %s\n", err)
+			osExit(1)
+			return
+		}
+		if decommissioning {
+			fmt.Printf("node %d is decommissioning\n", nodeID)
+		} else {
+			fmt.Printf("node %d is no longer decommissioning\n", nodeID)
+		}
+	}
+}
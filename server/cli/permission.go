@@ -24,10 +24,32 @@ import (
 	"github.com/cockroachdb/cockroach/server"
 )
 
+// A permCmd command runs the permission config subcommands.
+var permCmd = &commander.Command{
+	UsageLine: "perm [options] <command> [arguments]",
+	Short:     "gets, sets, lists and removes permission configs",
+	Long: `
+Runs the permission config command given by <command>, one of get, set,
+ls and rm. Permission configs are read and written as YAML.
+`,
+	Run:  runSubcommand(permCmds),
+	Flag: *flag.CommandLine,
+}
+
+var permCmds = &commander.Commander{
+	Name: "perm",
+	Commands: []*commander.Command{
+		getPermsCmd,
+		setPermsCmd,
+		lsPermsCmd,
+		rmPermsCmd,
+	},
+}
+
 // A getPermsCmd command displays the perm config for the specified
 // prefix.
 var getPermsCmd = &commander.Command{
-	UsageLine: "get-perms [options] <key-prefix>",
+	UsageLine: "get [options] <key-prefix>",
 	Short:     "fetches and displays the permission config",
 	Long: `
 Fetches and displays the permission configuration for <key-prefix>. The key
@@ -49,8 +71,8 @@ func runGetPerms(cmd *commander.Command, args []string) {
 
 // A lsPermsCmd command displays a list of perm configs by prefix.
 var lsPermsCmd = &commander.Command{
-	UsageLine: "ls-perms [options] [key-regexp]",
-	Short:     "list all permisison configs by key prefix",
+	UsageLine: "ls [options] [key-regexp]",
+	Short:     "list all permission configs by key prefix",
 	Long: `
 List permission configs. If a regular expression is given, the results of
 the listing are filtered by key prefixes matching the regexp. The key
@@ -75,12 +97,11 @@ func runLsPerms(cmd *commander.Command, args []string) {
 		pattern = args[0]
 	}
 	server.RunLsPerm(Context, pattern)
-
 }
 
 // A rmPermsCmd command removes a perm config by prefix.
 var rmPermsCmd = &commander.Command{
-	UsageLine: "rm-perms [options] <key-prefix>",
+	UsageLine: "rm [options] <key-prefix>",
 	Short:     "remove a permission config by key prefix",
 	Long: `
 Remove an existing permission config by key prefix. No action is taken if no
@@ -106,8 +127,8 @@ func runRmPerms(cmd *commander.Command, args []string) {
 // A setPermsCmd command creates a new or updates an existing perm
 // config.
 var setPermsCmd = &commander.Command{
-	UsageLine: "set-perm [options] <key-prefix> <perm-config-file>",
-	Short:     "create or update permission config for key prefix\n",
+	UsageLine: "set [options] <key-prefix> <perm-config-file>",
+	Short:     "create or update permission config for key prefix",
 	Long: `
 Create or update a perm config for the specified key prefix (first
 argument: <key-prefix>) to the contents of the specified file
@@ -122,9 +143,9 @@ The permission config format has the following YAML schema:
     - user2
     - ...
   write:
-  	- user1
-  	- user2
-  	- ...
+    - user1
+    - user2
+    - ...
 
 For example:
 
@@ -20,38 +20,116 @@ package cli
 import (
 	"flag"
 	"fmt"
-	"os"
 
 	commander "code.google.com/p/go-commander"
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	gogoproto "github.com/gogo/protobuf/proto"
 )
 
+// maxRanges is the maximum number of range descriptors listed at once.
+const maxRanges = 1000
+
+// A rangeCmd command runs the range subcommands.
+var rangeCmd = &commander.Command{
+	UsageLine: "range [options] <command> [arguments]",
+	Short:     "lists, splits, merges and scatters ranges",
+	Long: `
+Runs the range command given by <command>, one of ls, split, merge and
+scatter. Keys are given as for the kv commands: verbatim, Go-quoted or
+as hex prefixed by 0x.
+`,
+	Run:  runSubcommand(rangeCmds),
+	Flag: *flag.CommandLine,
+}
+
+var rangeCmds = &commander.Commander{
+	Name: "range",
+	Commands: []*commander.Command{
+		lsRangesCmd,
+		splitRangeCmd,
+		mergeRangeCmd,
+		scatterRangesCmd,
+	},
+}
+
+// parseSpan parses the optional start and end keys of a span of
+// ranges, which defaults to all ranges.
+func parseSpan(args []string) (proto.Key, proto.Key, error) {
+	keys, err := parseKeys(args, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	startKey, endKey := proto.KeyMin, proto.KeyMax
+	if len(keys) >= 1 {
+		startKey = keys[0]
+	}
+	if len(keys) >= 2 {
+		endKey = keys[1]
+	}
+	return startKey, endKey, nil
+}
+
+// lookupRanges returns the descriptors of the ranges overlapping the
+// span from startKey to endKey, scanning the meta2 range addressing
+// records. At most maxRanges are returned.
+func lookupRanges(kv *client.KV, startKey, endKey proto.Key) ([]proto.RangeDescriptor, error) {
+	// Ranges are addressed by their end keys, so the first range
+	// overlapping the span is the first whose end key follows startKey.
+	metaStart := keys.KeyMeta2Prefix
+	if len(startKey) > 0 {
+		metaStart = keys.RangeMetaKey(startKey.Next())
+	}
+	call := client.ScanCall(metaStart, keys.KeyMeta2Prefix.PrefixEnd(), maxRanges)
+	resp := call.Reply.(*proto.ScanResponse)
+	if err := kv.Run(call); err != nil {
+		return nil, err
+	}
+
+	var descs []proto.RangeDescriptor
+	for _, r := range resp.Rows {
+		var desc proto.RangeDescriptor
+		if err := gogoproto.Unmarshal(r.Value.Bytes, &desc); err != nil {
+			return nil, fmt.Errorf("%s: unable to unmarshal range descriptor: %s", r.Key, err)
+		}
+		if !desc.StartKey.Less(endKey) {
+			break
+		}
+		descs = append(descs, desc)
+	}
+	return descs, nil
+}
+
+// formatRange renders the span and Raft ID of the range for display.
+func formatRange(desc *proto.RangeDescriptor) string {
+	return fmt.Sprintf("%s-%s [%d]", keys.PrettyPrint(desc.StartKey), keys.PrettyPrint(desc.EndKey), desc.RaftID)
+}
+
 // A lsRangesCmd command lists the ranges in a cluster.
 var lsRangesCmd = &commander.Command{
-	UsageLine: "ls-ranges [options] [<start-key>]",
+	UsageLine: "ls [options] [<start-key> [<end-key>]]",
 	Short:     "lists the ranges",
 	Long: `
-Lists the ranges in a cluster.
+Lists the ranges overlapping the span from <start-key> to <end-key>,
+which defaults to all ranges, with the replicas of each.
 
-Caveat: Currently only lists up to 1000 rangges.
+Caveat: Currently only lists up to 1000 ranges.
 `,
 	Run:  runLsRanges,
 	Flag: *flag.CommandLine,
 }
 
 func runLsRanges(cmd *commander.Command, args []string) {
-	if len(args) > 1 {
+	if len(args) > 2 {
 		cmd.Usage()
 		return
 	}
-	var startKey proto.Key
-	if len(args) >= 1 {
-		startKey = engine.RangeMetaKey(proto.Key(args[0]))
-	} else {
-		startKey = engine.KeyMeta2Prefix
+	startKey, endKey, err := parseSpan(args)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
 	}
 
 	kv, err := makeKVClient()
@@ -60,20 +138,15 @@ func runLsRanges(cmd *commander.Command, args []string) {
 		osExit(1)
 		return
 	}
-	call := client.ScanCall(startKey, engine.KeyMeta2Prefix.PrefixEnd(), 1000)
-	resp := call.Reply.(*proto.ScanResponse)
-	if err := kv.Run(call); err != nil {
-		fmt.Fprintf(os.Stderr, "scan failed: %s\n", err)
-		os.Exit(1)
+	descs, err := lookupRanges(kv, startKey, endKey)
+	if err != nil {
+		fmt.Fprintf(osStderr, "scan failed: %s\n", err)
+		osExit(1)
+		return
 	}
 
-	for _, r := range resp.Rows {
-		desc := &proto.RangeDescriptor{}
-		if err := gogoproto.Unmarshal(r.Value.Bytes, desc); err != nil {
-			fmt.Fprintf(os.Stderr, "%s: unable to unmarshal range descriptor\n", r.Key)
-			continue
-		}
-		fmt.Printf("%s-%s [%d]\n", desc.StartKey, desc.EndKey, desc.RaftID)
+	for _, desc := range descs {
+		fmt.Printf("%s\n", formatRange(&desc))
 		for i, r := range desc.Replicas {
 			fmt.Printf("\t%d: node-id=%d store-id=%d attrs=%v\n",
 				i, r.NodeID, r.StoreID, r.Attrs.Attrs)
@@ -83,7 +156,7 @@ func runLsRanges(cmd *commander.Command, args []string) {
 
 // A splitRangeCmd command splits a range.
 var splitRangeCmd = &commander.Command{
-	UsageLine: "split-range [options] <key> [<split-key>]",
+	UsageLine: "split [options] <key> [<split-key>]",
 	Short:     "splits a range",
 	Long: `
 Splits the range containing <key>. If <split-key> is not specified a
@@ -99,11 +172,15 @@ func runSplitRange(cmd *commander.Command, args []string) {
 		cmd.Usage()
 		return
 	}
-
-	key := proto.Key(args[0])
+	keys, err := parseKeys(args, true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
 	var splitKey proto.Key
-	if len(args) >= 2 {
-		splitKey = proto.Key(args[1])
+	if len(keys) >= 2 {
+		splitKey = keys[1]
 	}
 
 	kv, err := makeKVClient()
@@ -114,21 +191,21 @@ func runSplitRange(cmd *commander.Command, args []string) {
 	}
 	req := &proto.AdminSplitRequest{
 		RequestHeader: proto.RequestHeader{
-			Key: key,
+			Key: keys[0],
 		},
 		SplitKey: splitKey,
 	}
 	resp := &proto.AdminSplitResponse{}
 	if err := kv.Run(client.Call{Args: req, Reply: resp}); err != nil {
-		fmt.Fprintf(os.Stderr, "split failed: %s\n", err)
-		os.Exit(1)
+		fmt.Fprintf(osStderr, "split failed: %s\n", err)
+		osExit(1)
 	}
 }
 
 // A mergeRangeCmd command merges a range.
 var mergeRangeCmd = &commander.Command{
-	UsageLine: "merge-range [options] <key>",
-	Short:     "merges a range\n",
+	UsageLine: "merge [options] <key>",
+	Short:     "merges a range",
 	Long: `
 Merges the range containing <key> with the immediate successor range.
 `,
@@ -141,6 +218,12 @@ func runMergeRange(cmd *commander.Command, args []string) {
 		cmd.Usage()
 		return
 	}
+	keys, err := parseKeys(args, true)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
 
 	kv, err := makeKVClient()
 	if err != nil {
@@ -150,12 +233,98 @@ func runMergeRange(cmd *commander.Command, args []string) {
 	}
 	req := &proto.AdminMergeRequest{
 		RequestHeader: proto.RequestHeader{
-			Key: proto.Key(args[0]),
+			Key: keys[0],
 		},
 	}
 	resp := &proto.AdminMergeResponse{}
 	if err := kv.Run(client.Call{Args: req, Reply: resp}); err != nil {
-		fmt.Fprintf(os.Stderr, "merge failed: %s\n", err)
-		os.Exit(1)
+		fmt.Fprintf(osStderr, "merge failed: %s\n", err)
+		osExit(1)
+	}
+}
+
+// A scatterRangesCmd command spreads the leases of a span of ranges
+// across the stores holding their replicas.
+var scatterRangesCmd = &commander.Command{
+	UsageLine: "scatter [options] [<start-key> [<end-key>]]",
+	Short:     "scatters the leases of ranges\n",
+	Long: `
+Spreads the leases of the ranges overlapping the span from <start-key>
+to <end-key>, which defaults to all ranges, evenly across the stores
+holding their replicas, spreading the load of a span which was bulk
+loaded through a single node. The lease of each range in turn is
+transferred to its replica on the store holding the fewest of the
+span's leases so far. Replicas are not moved; their placement is left
+to the replicate queue.
+
+Caveat: Currently only scatters up to 1000 ranges.
+`,
+	Run:  runScatterRanges,
+	Flag: *flag.CommandLine,
+}
+
+func runScatterRanges(cmd *commander.Command, args []string) {
+	if len(args) > 2 {
+		cmd.Usage()
+		return
+	}
+	startKey, endKey, err := parseSpan(args)
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	descs, err := lookupRanges(kv, startKey, endKey)
+	if err != nil {
+		fmt.Fprintf(osStderr, "scan failed: %s\n", err)
+		osExit(1)
+		return
+	}
+
+	leases := map[proto.StoreID]int{}
+	for _, desc := range descs {
+		target, ok := scatterTarget(desc, leases)
+		if !ok {
+			fmt.Printf("%s: no replica may hold the lease\n", formatRange(&desc))
+			continue
+		}
+		req := &proto.AdminTransferLeaseRequest{
+			RequestHeader: proto.RequestHeader{
+				Key: desc.StartKey,
+			},
+			StoreID: target,
+		}
+		resp := &proto.AdminTransferLeaseResponse{}
+		if err := kv.Run(client.Call{Args: req, Reply: resp}); err != nil {
+			fmt.Fprintf(osStderr, "%s: lease transfer failed: %s\n", formatRange(&desc), err)
+			continue
+		}
+		leases[target]++
+		fmt.Printf("%s: lease to store-id=%d\n", formatRange(&desc), target)
+	}
+}
+
+// scatterTarget returns the store of the range's voting replica which
+// holds the fewest of the supplied lease counts, breaking ties by
+// store ID. Returns false if the range has no voting replica.
+func scatterTarget(desc proto.RangeDescriptor, leases map[proto.StoreID]int) (proto.StoreID, bool) {
+	var target proto.StoreID
+	found := false
+	for _, r := range desc.Replicas {
+		if r.Learner {
+			continue
+		}
+		if !found || leases[r.StoreID] < leases[target] ||
+			(leases[r.StoreID] == leases[target] && r.StoreID < target) {
+			target, found = r.StoreID, true
+		}
 	}
+	return target, found
 }
@@ -0,0 +1,115 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package cli
+
+import (
+	"flag"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/server"
+)
+
+// A settingCmd command runs the cluster setting subcommands.
+var settingCmd = &commander.Command{
+	UsageLine: "setting [options] <command> [arguments]",
+	Short:     "lists, sets and resets cluster settings",
+	Long: `
+Runs the cluster setting command given by <command>, one of ls, set
+and reset. Settings take effect on every node once gossiped, without
+restarting the cluster.
+`,
+	Run:  runSubcommand(settingCmds),
+	Flag: *flag.CommandLine,
+}
+
+var settingCmds = &commander.Commander{
+	Name: "setting",
+	Commands: []*commander.Command{
+		lsSettingsCmd,
+		setSettingCmd,
+		resetSettingCmd,
+	},
+}
+
+// A lsSettingsCmd command displays the cluster settings.
+var lsSettingsCmd = &commander.Command{
+	UsageLine: "ls [options]",
+	Short:     "lists the cluster settings and their values",
+	Long: `
+Lists the name, type, value and description of each cluster setting. The
+values are those in effect on the node the command is sent to.
+`,
+	Run:  runLsSettings,
+	Flag: *flag.CommandLine,
+}
+
+// runLsSettings invokes the REST API with GET action and no path.
+func runLsSettings(cmd *commander.Command, args []string) {
+	if len(args) != 0 {
+		cmd.Usage()
+		return
+	}
+	server.RunLsSettings(Context)
+}
+
+// A setSettingCmd command sets a cluster setting.
+var setSettingCmd = &commander.Command{
+	UsageLine: "set [options] <name> <value>",
+	Short:     "sets a cluster setting",
+	Long: `
+Sets the cluster setting <name> to <value>. Values are parsed according
+to the setting's type:
+
+  duration:  a Go duration, e.g. 1m30s
+  byte size: a number of bytes with an optional unit, e.g. 64MiB or 10KB
+  bool:      true or false
+`,
+	Run:  runSetSetting,
+	Flag: *flag.CommandLine,
+}
+
+// runSetSetting invokes the REST API with POST action and the setting
+// name as path.
+func runSetSetting(cmd *commander.Command, args []string) {
+	if len(args) != 2 {
+		cmd.Usage()
+		return
+	}
+	server.RunSetSetting(Context, args[0], args[1])
+}
+
+// A resetSettingCmd command resets a cluster setting to its default.
+var resetSettingCmd = &commander.Command{
+	UsageLine: "reset [options] <name>",
+	Short:     "resets a cluster setting to its default",
+	Long: `
+Resets the cluster setting <name> to its default value.
+`,
+	Run:  runResetSetting,
+	Flag: *flag.CommandLine,
+}
+
+// runResetSetting invokes the REST API with DELETE action and the
+// setting name as path.
+func runResetSetting(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunResetSetting(Context, args[0])
+}
@@ -72,7 +72,7 @@ func runInit(cmd *commander.Command, args []string) {
 
 	// Generate a new UUID for cluster ID and bootstrap the cluster.
 	clusterID := uuid.New()
-	e := engine.NewRocksDB(proto.Attributes{}, args[0], 1<<20)
+	e := engine.NewRocksDB(proto.Attributes{}, args[0], engine.RocksDBOptions{CacheSize: 1 << 20})
 	stopper := util.NewStopper()
 	if _, err := server.BootstrapCluster(clusterID, e, stopper); err != nil {
 		log.Errorf("unable to bootstrap cluster: %s", err)
@@ -108,6 +108,9 @@ For example:
 
   cockroach start -gossip=host1:port1,host2:port2 -stores=ssd=/mnt/ssd1,ssd=/mnt/ssd2
 
+Sending the node a SIGHUP reloads its certificates from the -certs
+directory, so that certificates can be rotated without a restart.
+
 A node exports an HTTP API with the following endpoints:
 
   Health check:           /healthz
@@ -150,6 +153,24 @@ func runStart(cmd *commander.Command, args []string) {
 		return
 	}
 
+	// Reload certificates on SIGHUP so that expiring certs can be
+	// rotated without restarting the node.
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	stopper.RunWorker(func() {
+		for {
+			select {
+			case <-hupCh:
+				if err := s.ReloadCertificates(); err != nil {
+					log.Errorf("failed to reload certificates: %s", err)
+				}
+			case <-stopper.ShouldStop():
+				signal.Stop(hupCh)
+				return
+			}
+		}
+	})
+
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, os.Interrupt, os.Kill)
 	// TODO(spencer): move this behind a build tag.
@@ -25,10 +25,32 @@ import (
 	"github.com/cockroachdb/cockroach/server"
 )
 
+// A zoneCmd command runs the zone config subcommands.
+var zoneCmd = &commander.Command{
+	UsageLine: "zone [options] <command> [arguments]",
+	Short:     "gets, sets, lists and removes zone configs",
+	Long: `
+Runs the zone config command given by <command>, one of get, set, ls
+and rm. Zone configs are read and written as YAML.
+`,
+	Run:  runSubcommand(zoneCmds),
+	Flag: *flag.CommandLine,
+}
+
+var zoneCmds = &commander.Commander{
+	Name: "zone",
+	Commands: []*commander.Command{
+		getZoneCmd,
+		setZoneCmd,
+		lsZonesCmd,
+		rmZoneCmd,
+	},
+}
+
 // A getZoneCmd command displays the zone config for the specified
 // prefix.
 var getZoneCmd = &commander.Command{
-	UsageLine: "get-zone [options] <key-prefix>",
+	UsageLine: "get [options] <key-prefix>",
 	Short:     "fetches and displays the zone config",
 	Long: `
 Fetches and displays the zone configuration for <key-prefix>. The key
@@ -50,7 +72,7 @@ func runGetZone(cmd *commander.Command, args []string) {
 
 // A lsZonesCmd command displays a list of zone configs by prefix.
 var lsZonesCmd = &commander.Command{
-	UsageLine: "ls-zones [options] [key-regexp]",
+	UsageLine: "ls [options] [key-regexp]",
 	Short:     "list all zone configs by key prefix",
 	Long: `
 List zone configs. If a regular expression is given, the results of
@@ -80,7 +102,7 @@ func runLsZones(cmd *commander.Command, args []string) {
 
 // A rmZoneCmd command removes a zone config by prefix.
 var rmZoneCmd = &commander.Command{
-	UsageLine: "rm-zone [options] <key-prefix>",
+	UsageLine: "rm [options] <key-prefix>",
 	Short:     "remove a zone config by key prefix",
 	Long: `
 Remove an existing zone config by key prefix. No action is taken if no
@@ -106,8 +128,8 @@ func runRmZone(cmd *commander.Command, args []string) {
 // A setZoneCmd command creates a new or updates an existing zone
 // config.
 var setZoneCmd = &commander.Command{
-	UsageLine: "set-zone [options] <key-prefix> <zone-config-file>",
-	Short:     "create or update zone config for key prefix\n",
+	UsageLine: "set [options] <key-prefix> <zone-config-file>",
+	Short:     "create or update zone config for key prefix",
 	Long: `
 Create or update a zone config for the specified key prefix (first
 argument: <key-prefix>) to the contents of the specified file
@@ -122,15 +144,29 @@ The zone config format has the following YAML schema:
     - ...
   range_min_bytes: <size-in-bytes>
   range_max_bytes: <size-in-bytes>
+  range_backpressure_multiple: <multiple-of-range-max-bytes>
+  gc:
+    ttl_seconds: <seconds>
 
 For example:
 
   replicas:
-    - [us-east-1a, ssd]
-    - [us-east-1b, ssd]
-    - [us-west-1b, ssd]
+    - attrs: [us-east-1a, ssd]
+    - attrs: [us-east-1b, ssd]
+    - attrs: [us-west-1b, ssd]
   range_min_bytes: 8388608
-  range_min_bytes: 67108864
+  range_max_bytes: 67108864
+  gc:
+    ttl_seconds: 86400
+
+The config is rejected if it specifies more replicas than there are
+nodes in the cluster, or a GC TTL which is negative or shorter than ten
+minutes; a TTL of zero disables garbage collection.
+
+Writes to a range which has grown beyond range_backpressure_multiple
+times range_max_bytes, for example because its splits are backlogged,
+are held back until it has been split. The multiple defaults to 2 and
+must be at least 1; a negative multiple disables the backpressure.
 
 Setting zone configs will guarantee that key ranges will be split
 such that no key range straddles two zone config specifications.
@@ -23,6 +23,12 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/util/hlc"
 )
 
 // TestSetZoneInvalid sets invalid zone configs and verifies error
@@ -51,6 +57,29 @@ range_max_bytes: 67108864
 range_min_bytes: 1048576
 range_max_bytes: 67108864
 `, "attributes for at least one replica must be specified in zone config"},
+		{`
+replicas:
+  - attrs: [dc1, ssd]
+range_min_bytes: 1048576
+range_max_bytes: 67108864
+gc:
+  ttl_seconds: -1
+`, "GC TTLSeconds -1 is negative"},
+		{`
+replicas:
+  - attrs: [dc1, ssd]
+range_min_bytes: 1048576
+range_max_bytes: 67108864
+gc:
+  ttl_seconds: 60
+`, "GC TTLSeconds 60 less than minimum allowed"},
+		{`
+replicas:
+  - attrs: [dc1, ssd]
+range_min_bytes: 1048576
+range_max_bytes: 67108864
+range_backpressure_multiple: 0.5
+`, "RangeBackpressureMultiple 0.5 less than 1"},
 	}
 
 	for i, test := range testData {
@@ -68,3 +97,34 @@ range_max_bytes: 67108864
 		}
 	}
 }
+
+// TestValidateZoneClusterSize verifies that zone configs specifying
+// more replicas than there are nodes in the cluster are rejected.
+func TestValidateZoneClusterSize(t *testing.T) {
+	g := gossip.New(rpc.NewContext(hlc.NewClock(hlc.UnixNano), nil, nil), gossip.TestInterval, gossip.TestBootstrap)
+	zh := &zoneHandler{gossip: g}
+	zone := &proto.ZoneConfig{
+		ReplicaAttrs:  []proto.Attributes{{}, {}, {}},
+		RangeMinBytes: 1 << 20,
+		RangeMaxBytes: 64 << 20,
+	}
+	// Without any nodes known to gossip, the cluster size isn't checked.
+	if err := zh.validate(zone); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		if err := g.AddInfo(gossip.MakeNodeIDKey(proto.NodeID(i)),
+			&gossip.NodeDescriptor{NodeID: proto.NodeID(i)}, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		err := zh.validate(zone)
+		if i < 3 {
+			expErr := fmt.Sprintf("zone config specifies 3 replicas, but the cluster has only %d nodes", i)
+			if err == nil || !strings.Contains(err.Error(), expErr) {
+				t.Errorf("%d: expected error %q; got %v", i, expErr, err)
+			}
+		} else if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err)
+		}
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -35,13 +36,51 @@ import (
 
 // Context defaults.
 const (
-	defaultAddr           = ":8080"
-	defaultMaxOffset      = 250 * time.Millisecond
-	defaultGossipInterval = 2 * time.Second
-	defaultCacheSize      = 1 << 30 // GB
+	defaultAddr      = ":8080"
+	defaultMaxOffset = 250 * time.Millisecond
+	// defaultClockJumpTolerance is the default size of jumps of the
+	// wall clock which go unreported.
+	defaultClockJumpTolerance = 250 * time.Millisecond
+	defaultGossipInterval     = 2 * time.Second
+	defaultCacheSize          = 1 << 30  // GB
+	defaultWriteBufferSize    = 64 << 20 // MB
+	defaultCompression        = "snappy"
+	defaultValueChecksumMode  = "error"
 	// defaultScanInterval is the default value for the scan interval.
 	// command line flag.
 	defaultScanInterval = 10 * time.Minute
+	// defaultMaxSnapshots is the default number of Raft snapshots each
+	// store receives and sends concurrently.
+	defaultMaxSnapshots = 2
+	// defaultSlowRequestThreshold is the default duration beyond which
+	// the trace of a request is logged.
+	defaultSlowRequestThreshold = time.Second
+	// defaultExportBytesPerSecond is the default rate at which data
+	// exported via the admin endpoint is written.
+	defaultExportBytesPerSecond = 4 << 20 // MB
+	// defaultQueueRangesPerSecond is the default total rate at which
+	// the queues of each store process ranges.
+	defaultQueueRangesPerSecond = 50
+	// defaultCapacityGossipAvailableDelta and
+	// defaultCapacityGossipRangeDelta are the default changes of a
+	// store's available space, as a fraction of its capacity, and of its
+	// range count which cause the node's store capacities to be
+	// re-gossiped before they go stale.
+	defaultCapacityGossipAvailableDelta = 0.05
+	defaultCapacityGossipRangeDelta     = 10
+	// defaultCapacityGossipMaxStaleness is the default interval after
+	// which the node's store capacities are re-gossiped regardless.
+	defaultCapacityGossipMaxStaleness = time.Minute
+	// defaultClosedTimestampLag is the default lag behind the current
+	// time of the timestamps closed by ranges for follower reads.
+	defaultClosedTimestampLag = 10 * time.Second
+	// defaultAdmissionL0Files is the default number of files in level 0
+	// of a store's LSM tree at which it pushes back on writes. RocksDB
+	// itself slows writes down at 20 files.
+	defaultAdmissionL0Files = 20
+	// defaultAdmissionWritesPerSecond is the default rate to which a
+	// store limits writes while it pushes back on them.
+	defaultAdmissionWritesPerSecond = 1000
 )
 
 // Context holds parameters needed to setup a server.
@@ -54,6 +93,10 @@ type Context struct {
 	// Addr is the host:port to bind for HTTP/RPC traffic.
 	Addr string
 
+	// PGAddr is the host:port to bind for clients speaking the Postgres
+	// wire protocol. Empty, the default, disables the Postgres endpoint.
+	PGAddr string
+
 	// Stores is specified to enable durable key-value storage.
 	// Memory-backed key value stores may be optionally specified
 	// via mem=<integer byte size>.
@@ -67,6 +110,15 @@ type Context struct {
 	// For example, -store=hdd:7200rpm=/mnt/hda1,ssd=/mnt/ssd01,ssd=/mnt/ssd02,mem=1073741824
 	Stores string
 
+	// RaftLogDirs optionally specifies a comma-separated list of
+	// locations for the Raft logs of the stores listed in Stores, in
+	// the same order. Each location is either a filepath or an integer
+	// size in bytes for an in-memory engine. An empty entry keeps the
+	// corresponding store's Raft log in the store itself. Placing the
+	// Raft log on a dedicated device keeps log appends from competing
+	// with compactions of the store's data.
+	RaftLogDirs string
+
 	// Attrs specifies a colon-separated list of node topography or machine
 	// capabilities, used to match capabilities or location preferences specified
 	// in zone configs.
@@ -96,11 +148,41 @@ type Context struct {
 	// The value is split evenly between the stores if there are more than one.
 	CacheSize int64
 
+	// WriteBufferSize is the size in bytes of each RocksDB memtable.
+	WriteBufferSize int64
+
+	// BloomFilterBitsPerKey is the number of bits per key used by
+	// RocksDB bloom filters. Zero disables bloom filters.
+	BloomFilterBitsPerKey int
+
+	// Compression is the name of the RocksDB block compression type, one
+	// of "snappy", "none", "zlib" or "lz4".
+	Compression string
+
+	// MaxOpenFiles is the maximum number of files RocksDB keeps open per
+	// store. Zero selects the RocksDB default; -1 means unlimited.
+	MaxOpenFiles int
+
+	// ValueChecksumMode specifies how reads of values whose checksum
+	// doesn't match their contents are handled, one of "error" or
+	// "log".
+	ValueChecksumMode string
+
+	// ValueCompressionThreshold is the size in bytes beyond which values
+	// written through this node's ranges are snappy compressed before
+	// being proposed to Raft. Zero disables value compression.
+	ValueCompressionThreshold int
+
 	// Parsed values.
 
 	// Engines is the storage instances specified by Stores.
 	Engines []engine.Engine
 
+	// RaftEngines is the Raft log storage instances specified by
+	// RaftLogDirs, indexed like Engines. A nil entry indicates that the
+	// corresponding store keeps its Raft log in its own engine.
+	RaftEngines []engine.Engine
+
 	// NodeAttributes is the parsed representation of Attrs.
 	NodeAttributes proto.Attributes
 
@@ -108,22 +190,143 @@ type Context struct {
 	// to find bootstrap nodes for connecting to the gossip network.
 	GossipBootstrapResolvers []gossip.Resolver
 
+	// PhysicalClock, if set, supplies the wall time of the node's
+	// hybrid logical clock in place of the system clock. Tests set it to
+	// a manual clock in order to control the passage of time.
+	PhysicalClock func() int64
+
 	// ScanInterval determines a duration during which each range should be
 	// visited approximately once by the range scanner.
 	ScanInterval time.Duration
+
+	// FatalOnInconsistency causes the node to exit if a consistency check
+	// finds that one of its replicas has diverged from its range leader.
+	FatalOnInconsistency bool
+
+	// EnableFaultInjection exposes an endpoint through which the node
+	// can be told to drop the RPCs it receives, skew its clock and slow
+	// its writes. It is intended for acceptance tests only.
+	EnableFaultInjection bool
+
+	// SyncRaftLog causes Raft log appends to be synced to disk before
+	// they are acknowledged.
+	SyncRaftLog bool
+
+	// SyncApply causes the application of committed Raft commands to be
+	// synced to disk.
+	SyncApply bool
+
+	// StreamRaft causes Raft messages to be sent to each other node on a
+	// single connection instead of as separate RPCs.
+	StreamRaft bool
+
+	// MaxIncomingSnapshots and MaxOutgoingSnapshots limit the number of
+	// Raft snapshots each store receives and sends concurrently. Zero
+	// means no limit.
+	MaxIncomingSnapshots int
+	MaxOutgoingSnapshots int
+
+	// SnapshotBytesPerSecond limits the rate at which each store sends
+	// Raft snapshots. Zero means no limit.
+	SnapshotBytesPerSecond int64
+
+	// ExportBytesPerSecond limits the rate at which the node writes
+	// data exported via the admin endpoint. Zero means no limit.
+	ExportBytesPerSecond int64
+
+	// QueueRangesPerSecond limits the total rate at which the queues
+	// of each store, such as the GC and split queues, process ranges.
+	// Zero means no limit.
+	QueueRangesPerSecond int64
+
+	// CapacityGossipAvailableDelta and CapacityGossipRangeDelta are the
+	// changes of a store's available space, as a fraction of its
+	// capacity, and of its range count which cause the capacities of the
+	// node's stores to be re-gossiped. Smaller changes are gossiped once
+	// CapacityGossipMaxStaleness has elapsed.
+	CapacityGossipAvailableDelta float64
+	CapacityGossipRangeDelta     int
+	CapacityGossipMaxStaleness   time.Duration
+
+	// ClosedTimestampLag is how far behind the current time ranges close
+	// timestamps so that reads at or below them can be served by any
+	// replica. Zero disables follower reads.
+	ClosedTimestampLag time.Duration
+
+	// AdmissionL0Files, AdmissionMemtableBytes and AdmissionRaftLogEntries
+	// are the levels of compaction debt, memtable size and Raft log
+	// growth at which each store begins to push back on writes, first
+	// limiting and then rejecting them. Zero disables the respective
+	// measure.
+	AdmissionL0Files        int64
+	AdmissionMemtableBytes  int64
+	AdmissionRaftLogEntries int64
+
+	// AdmissionWritesPerSecond is the rate to which each store limits
+	// writes while it pushes back on them. Zero means no limit.
+	AdmissionWritesPerSecond int64
+
+	// AuditLog is the file to which administrative operations, config
+	// changes and denied requests are audited. Empty disables auditing.
+	AuditLog string
+
+	// AuditPrefixes is a comma-separated list of key prefixes under
+	// which all writes are also audited.
+	AuditPrefixes string
+
+	// SlowRequestThreshold is the duration beyond which the trace of a
+	// request is logged, along with the time spent in each phase of its
+	// execution. Each node logs its part of the request: the gateway
+	// times the range lookups and RPCs, while the receiving nodes time
+	// the command queue, Raft and engine. Zero disables logging of slow
+	// requests.
+	SlowRequestThreshold time.Duration
+
+	// ClockJumpTolerance is the size beyond which sudden jumps of the
+	// wall clock, forward or backward, are reported. Zero disables
+	// their detection.
+	ClockJumpTolerance time.Duration
+
+	// FatalOnClockJump causes the node to exit on a jump of the wall
+	// clock beyond the tolerance rather than continue to serve with its
+	// clock's consistency assumptions possibly violated.
+	FatalOnClockJump bool
 }
 
 // NewContext returns a Context with default values.
 func NewContext() *Context {
 	ctx := &Context{
-		Addr:           defaultAddr,
-		MaxOffset:      defaultMaxOffset,
-		GossipInterval: defaultGossipInterval,
-		CacheSize:      defaultCacheSize,
-		ScanInterval:   defaultScanInterval,
+		Addr:            defaultAddr,
+		MaxOffset:       defaultMaxOffset,
+		GossipInterval:  defaultGossipInterval,
+		CacheSize:       defaultCacheSize,
+		WriteBufferSize: defaultWriteBufferSize,
+		Compression:     defaultCompression,
+		ScanInterval:    defaultScanInterval,
+		SyncRaftLog:     true,
+
+		ValueChecksumMode:    defaultValueChecksumMode,
+		MaxIncomingSnapshots: defaultMaxSnapshots,
+		MaxOutgoingSnapshots: defaultMaxSnapshots,
+		SlowRequestThreshold: defaultSlowRequestThreshold,
+		ClockJumpTolerance:   defaultClockJumpTolerance,
+		ExportBytesPerSecond: defaultExportBytesPerSecond,
+		QueueRangesPerSecond: defaultQueueRangesPerSecond,
+
+		CapacityGossipAvailableDelta: defaultCapacityGossipAvailableDelta,
+		CapacityGossipRangeDelta:     defaultCapacityGossipRangeDelta,
+		CapacityGossipMaxStaleness:   defaultCapacityGossipMaxStaleness,
+		ClosedTimestampLag:           defaultClosedTimestampLag,
+
+		AdmissionL0Files:         defaultAdmissionL0Files,
+		AdmissionMemtableBytes:   4 * defaultWriteBufferSize,
+		AdmissionWritesPerSecond: defaultAdmissionWritesPerSecond,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
+	// Nodes, and the command line tools sharing their certificates
+	// directory, present the node certificate.
+	ctx.User = security.NodeUser
 	return ctx
 }
 
@@ -155,6 +358,23 @@ func (ctx *Context) Init() error {
 	}
 	log.Infof("initialized %d storage engine(s)", len(ctx.Engines))
 
+	ctx.RaftEngines = nil
+	if len(ctx.RaftLogDirs) > 0 {
+		dirs := strings.Split(ctx.RaftLogDirs, ",")
+		if len(dirs) != len(ctx.Engines) {
+			return util.Errorf("%d raft log locations specified for %d stores", len(dirs), len(ctx.Engines))
+		}
+		for i, dir := range dirs {
+			var raftEngine engine.Engine
+			if len(dir) > 0 {
+				if raftEngine, err = ctx.initEngine("", dir); err != nil {
+					return util.Errorf("unable to init raft log engine for store %q: %s", storeSpecs[i][0], err)
+				}
+			}
+			ctx.RaftEngines = append(ctx.RaftEngines, raftEngine)
+		}
+	}
+
 	ctx.NodeAttributes = parseAttributes(ctx.Attrs)
 
 	resolvers, err := ctx.parseGossipBootstrapResolvers()
@@ -183,7 +403,22 @@ func (ctx *Context) initEngine(attrsStr, path string) (engine.Engine, error) {
 		// TODO(spencer): should be using rocksdb for in-memory stores and
 		// relegate the InMem engine to usage only from unittests.
 	}
-	return engine.NewRocksDB(attrs, path, ctx.CacheSize), nil
+	compression, err := engine.ParseCompressionType(ctx.Compression)
+	if err != nil {
+		return nil, err
+	}
+	checksumMode, err := engine.ParseValueChecksumMode(ctx.ValueChecksumMode)
+	if err != nil {
+		return nil, err
+	}
+	return engine.NewRocksDB(attrs, path, engine.RocksDBOptions{
+		CacheSize:             ctx.CacheSize,
+		WriteBufferSize:       ctx.WriteBufferSize,
+		BloomFilterBitsPerKey: ctx.BloomFilterBitsPerKey,
+		Compression:           compression,
+		MaxOpenFiles:          ctx.MaxOpenFiles,
+		ValueChecksumMode:     checksumMode,
+	}), nil
 }
 
 // parseGossipBootstrapResolvers parses a comma-separated list of
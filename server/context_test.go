@@ -60,3 +60,24 @@ func TestParseGossipBootstrapAddrs(t *testing.T) {
 		t.Fatalf("Unexpected bootstrap addresses: %v, expected: %v", ctx.GossipBootstrapResolvers, expected)
 	}
 }
+
+// TestParseRaftLogDirs verifies that RaftLogDirs yields a Raft log
+// engine for each store with a non-empty location.
+func TestParseRaftLogDirs(t *testing.T) {
+	ctx := NewContext()
+	ctx.Stores = "mem=1,mem=1"
+	ctx.RaftLogDirs = ",1"
+	ctx.GossipBootstrap = "self://"
+	if err := ctx.Init(); err != nil {
+		t.Fatalf("Failed to initialize the context: %v", err)
+	}
+	if len(ctx.RaftEngines) != 2 || ctx.RaftEngines[0] != nil || ctx.RaftEngines[1] == nil {
+		t.Fatalf("unexpected raft log engines: %v", ctx.RaftEngines)
+	}
+
+	// The number of locations must match the number of stores.
+	ctx.RaftLogDirs = "1"
+	if err := ctx.Init(); err == nil {
+		t.Fatal("expected error for mismatched raft log locations")
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// exportBatchSize is the number of rows scanned per request while
+// exporting a key span.
+const exportBatchSize = 1000
+
+// exportLimiter throttles the rate at which exported data is written
+// so that large exports don't starve the node's foreground traffic.
+// It is shared by all exports served by the node.
+type exportLimiter struct {
+	mu             sync.Mutex
+	bytesPerSecond int64
+	// nextSend is the time at which the throttle next admits data.
+	nextSend time.Time
+}
+
+// throttle accounts for writing the given number of bytes and returns
+// how long the caller must wait before writing more so that the
+// configured byte rate is not exceeded.
+func (l *exportLimiter) throttle(bytes int) time.Duration {
+	if l.bytesPerSecond <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if l.nextSend.Before(now) {
+		l.nextSend = now
+	}
+	l.nextSend = l.nextSend.Add(time.Duration(int64(bytes) * int64(time.Second) / l.bytesPerSecond))
+	return l.nextSend.Sub(now)
+}
+
+// exportRow is an exported key/value pair as encoded in JSON. Keys and
+// byte values are escaped as in Go string literals.
+type exportRow struct {
+	Key       string          `json:"key"`
+	Value     string          `json:"value"`
+	Timestamp proto.Timestamp `json:"timestamp"`
+}
+
+// newExportRow decodes the key/value pair for export.
+func newExportRow(kv proto.KeyValue) exportRow {
+	row := exportRow{Key: escapeExportBytes(kv.Key)}
+	if kv.Value.Integer != nil {
+		row.Value = strconv.FormatInt(*kv.Value.Integer, 10)
+	} else {
+		row.Value = escapeExportBytes(kv.Value.Bytes)
+	}
+	if kv.Value.Timestamp != nil {
+		row.Timestamp = *kv.Value.Timestamp
+	}
+	return row
+}
+
+// escapeExportBytes escapes b as in the body of a double-quoted Go
+// string literal.
+func escapeExportBytes(b []byte) string {
+	s := strconv.Quote(string(b))
+	return s[1 : len(s)-1]
+}
+
+// An exportWriter writes exported rows in one of the export formats.
+type exportWriter interface {
+	writeRow(row exportRow) error
+	flush() error
+}
+
+// csvExportWriter writes rows as CSV records of key, value, wall time
+// and logical time, following a header record.
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func newCSVExportWriter(w io.Writer) (*csvExportWriter, error) {
+	cw := &csvExportWriter{w: csv.NewWriter(w)}
+	return cw, cw.w.Write([]string{"key", "value", "wall_time", "logical"})
+}
+
+func (cw *csvExportWriter) writeRow(row exportRow) error {
+	return cw.w.Write([]string{row.Key, row.Value,
+		strconv.FormatInt(row.Timestamp.WallTime, 10),
+		strconv.FormatInt(int64(row.Timestamp.Logical), 10)})
+}
+
+func (cw *csvExportWriter) flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// jsonExportWriter writes rows as JSON objects, one per line.
+type jsonExportWriter struct {
+	enc *json.Encoder
+}
+
+func (jw *jsonExportWriter) writeRow(row exportRow) error {
+	return jw.enc.Encode(row)
+}
+
+func (jw *jsonExportWriter) flush() error {
+	return nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += n
+	return n, err
+}
+
+// handleExport streams the key/value pairs of the span from the "start"
+// key (inclusive) to the "end" key (exclusive), along with the
+// timestamps at which they were written. The "format" parameter
+// selects "json" (the default), one object per line, or "csv". The
+// data is read as of the "timestamp" parameter, in nanoseconds since
+// the epoch, or as of now if it's not given, so that the export is a
+// consistent snapshot of the span. Reads are made on behalf of the
+// "user" parameter, which must match the user authenticated by the
+// client certificate, and are subject to that user's permissions. The
+// export is written at no more than the node's export rate.
+func (s *adminServer) handleExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	start, end := proto.Key(query.Get("start")), proto.Key(query.Get("end"))
+	if len(end) == 0 || !start.Less(end) {
+		http.Error(w, "a start key before a non-empty end key is required", http.StatusBadRequest)
+		return
+	}
+	timestamp := proto.Timestamp{WallTime: time.Now().UnixNano()}
+	if ts := query.Get("timestamp"); ts != "" {
+		wallTime, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		timestamp = proto.Timestamp{WallTime: wallTime}
+	}
+
+	// Authenticate the user against the client certificate, as for
+	// requests received by the KV endpoints. Nodes and insecure clients
+	// export as root unless a user is given.
+	args := &proto.ScanRequest{RequestHeader: proto.RequestHeader{User: query.Get("user")}}
+	if err := security.AuthenticateRequest(args, r.TLS); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	db := client.KVAt(s.db, timestamp)
+	db.User = args.User
+	if db.User == "" {
+		db.User = storage.UserRoot
+	}
+
+	cw := &countingWriter{w: w}
+	var ew exportWriter
+	switch format := query.Get("format"); format {
+	case "", "json":
+		w.Header().Set("Content-Type", util.JSONContentType)
+		ew = &jsonExportWriter{enc: json.NewEncoder(cw)}
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		var err error
+		if ew, err = newCSVExportWriter(cw); err != nil {
+			log.Warningf("export of %q-%q failed: %s", start, end, err)
+			return
+		}
+	default:
+		http.Error(w, "unknown export format "+strconv.Quote(format), http.StatusBadRequest)
+		return
+	}
+	flusher, _ := w.(http.Flusher)
+
+	for wrote := false; ; {
+		call := client.ScanCall(start, end, exportBatchSize)
+		if err := db.Run(call); err != nil {
+			if !wrote {
+				// Nothing has been written, so the error can still be
+				// reported in the status of the response.
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			} else {
+				log.Warningf("export of %q-%q failed: %s", start, end, err)
+			}
+			return
+		}
+		wrote = true
+		rows := call.Reply.(*proto.ScanResponse).Rows
+		for _, kv := range rows {
+			if err := ew.writeRow(newExportRow(kv)); err != nil {
+				log.Warningf("export of %q-%q failed: %s", start, end, err)
+				return
+			}
+		}
+		if err := ew.flush(); err != nil {
+			log.Warningf("export of %q-%q failed: %s", start, end, err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if len(rows) < exportBatchSize {
+			return
+		}
+		start = rows[len(rows)-1].Key.Next()
+		wait := s.export.throttle(cw.n)
+		cw.n = 0
+		select {
+		case <-time.After(wait):
+		case <-s.stopper.ShouldStop():
+			return
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// TestAdminExport verifies that the export endpoint streams the
+// key/value pairs of a span in both formats as of the requested
+// timestamp and rejects malformed requests.
+func TestAdminExport(t *testing.T) {
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+	db, err := BootstrapCluster("cluster-1", engine.NewInMem(proto.Attributes{}, 1<<20), stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now().UnixNano()
+	if err := db.Run(client.PutCall(proto.Key("a"), []byte("1\n")),
+		client.IncrementCall(proto.Key("b"), 5),
+		client.PutCall(proto.Key("c"), []byte("x,y"))); err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	newAdminServer(db, stopper, nil, nil, 0).registerHandlers(mux)
+	httpServer := httptest.NewTLSServer(mux)
+	defer httpServer.Close()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	export := func(query string) (int, string) {
+		resp, err := httpClient.Get(httpServer.URL + exportPath + "?" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(b)
+	}
+
+	status, body := export("start=a&end=d&format=csv")
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", status, body)
+	}
+	records, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expRecords := [][]string{{"key", "value", "wall_time", "logical"}, {"a", `1\n`}, {"b", "5"}, {"c", "x,y"}}
+	if len(records) != len(expRecords) {
+		t.Fatalf("expected %d records; got %q", len(expRecords), records)
+	}
+	for i, exp := range expRecords {
+		if records[i][0] != exp[0] || records[i][1] != exp[1] {
+			t.Errorf("%d: expected %q; got %q", i, exp, records[i])
+		}
+		if i == 0 {
+			continue
+		}
+		if wallTime, err := strconv.ParseInt(records[i][2], 10, 64); err != nil || wallTime <= before {
+			t.Errorf("%d: expected a timestamp after %d; got %s", i, before, records[i][2])
+		}
+	}
+
+	status, body = export("start=b&end=c")
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", status, body)
+	}
+	var row exportRow
+	if err := json.Unmarshal([]byte(body), &row); err != nil {
+		t.Fatal(err)
+	}
+	if row.Key != "b" || row.Value != "5" || row.Timestamp.WallTime <= before {
+		t.Errorf("unexpected row %+v", row)
+	}
+
+	if status, body = export(fmt.Sprintf("start=a&end=d&timestamp=%d", before)); status != http.StatusOK || body != "" {
+		t.Errorf("expected an empty export before the writes; got %d: %q", status, body)
+	}
+
+	for _, query := range []string{"start=a", "start=c&end=a", "start=a&end=d&format=xml", "start=a&end=d&timestamp=x"} {
+		if status, _ := export(query); status != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d; got %d", query, http.StatusBadRequest, status)
+		}
+	}
+}
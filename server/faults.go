@@ -0,0 +1,124 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/fault"
+)
+
+// faultsPath is the endpoint for viewing and changing the faults
+// injected into a node started with --enable-fault-injection.
+const faultsPath = "/_debug/faults"
+
+// faultSettings are the faults injected into a node.
+type faultSettings struct {
+	// DropRPCs drops every RPC the node receives, cutting it off from
+	// the requests of its peers.
+	DropRPCs bool `json:"dropRPCs"`
+	// ClockOffset is added to the node's wall time.
+	ClockOffset time.Duration `json:"clockOffset"`
+	// WriteDelay delays each write to the node's stores.
+	WriteDelay time.Duration `json:"writeDelay"`
+}
+
+// writeDelayer is implemented by engines whose writes can be slowed.
+type writeDelayer interface {
+	SetWriteDelay(time.Duration)
+}
+
+// A faultHandler serves faultsPath, applying the requested faults to
+// the node's RPC server, clock and engines.
+type faultHandler struct {
+	ctx      *Context
+	rpc      *rpc.Server
+	injector *fault.Injector
+	// clockOffset is the current ClockOffset in nanoseconds; accessed
+	// atomically.
+	clockOffset int64
+
+	mu       sync.Mutex
+	settings faultSettings
+}
+
+// newFaultHandler returns a faultHandler for the engines of the
+// supplied context. Its rpc server must be set before it is served.
+func newFaultHandler(ctx *Context) *faultHandler {
+	return &faultHandler{
+		ctx:      ctx,
+		injector: fault.NewInjector(0),
+	}
+}
+
+// wrapClock returns a physical clock which adds the injected clock
+// offset to the one supplied.
+func (fh *faultHandler) wrapClock(physicalClock func() int64) func() int64 {
+	return func() int64 {
+		return physicalClock() + atomic.LoadInt64(&fh.clockOffset)
+	}
+}
+
+// ServeHTTP responds with the faults injected into the node. PUT and
+// POST requests replace them with the faultSettings in their body
+// first.
+func (fh *faultHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fh.mu.Lock()
+	defer fh.mu.Unlock()
+	switch r.Method {
+	case "GET":
+	case "PUT", "POST":
+		var settings faultSettings
+		if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fh.apply(settings)
+	default:
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	b, contentType, err := util.MarshalResponse(r, fh.settings, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// apply injects the supplied faults in place of the current ones.
+func (fh *faultHandler) apply(settings faultSettings) {
+	fh.injector.Heal()
+	if settings.DropRPCs {
+		fh.injector.Set(fault.Any, fh.rpc.Addr().String(), fault.Rule{DropRate: 1})
+	}
+	atomic.StoreInt64(&fh.clockOffset, int64(settings.ClockOffset))
+	for _, e := range fh.ctx.Engines {
+		if d, ok := e.(writeDelayer); ok {
+			d.SetWriteDelay(settings.WriteDelay)
+		}
+	}
+	fh.settings = settings
+}
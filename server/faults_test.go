@@ -0,0 +1,90 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/testutils"
+)
+
+// TestFaultInjection verifies that the faults endpoint drops incoming
+// RPCs, skews the clock and delays writes as requested, and that
+// clearing the faults restores normal operation.
+func TestFaultInjection(t *testing.T) {
+	ctx := NewTestContext()
+	ctx.EnableFaultInjection = true
+	s := &TestServer{Ctx: ctx}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setFaults := func(settings faultSettings) {
+		body, err := json.Marshal(settings)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := httpClient.Post("https://"+s.ServingAddr()+faultsPath, "application/json",
+			bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var reply faultSettings
+		if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+			t.Fatal(err)
+		}
+		if reply != settings {
+			t.Fatalf("expected faults %+v, got %+v", settings, reply)
+		}
+	}
+
+	const offset, delay = time.Hour, 50 * time.Millisecond
+	before := s.Clock().PhysicalNow()
+	setFaults(faultSettings{DropRPCs: true, ClockOffset: offset, WriteDelay: delay})
+	if !s.faults.injector.Action("127.0.0.1:1", s.rpc.Addr().String()).Drop {
+		t.Error("expected incoming RPCs to be dropped")
+	}
+	if now := s.Clock().PhysicalNow(); now-before < offset.Nanoseconds() {
+		t.Errorf("expected the clock to be skewed by %s; advanced by %s", offset, time.Duration(now-before))
+	}
+	start := time.Now()
+	if err := s.Engine.Put(proto.EncodedKey("a"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Errorf("expected the write to be delayed by %s; took %s", delay, elapsed)
+	}
+
+	setFaults(faultSettings{})
+	if s.faults.injector.Action("127.0.0.1:1", s.rpc.Addr().String()).Drop {
+		t.Error("expected incoming RPCs to be delivered")
+	}
+	if now := s.Clock().PhysicalNow(); now-before >= offset.Nanoseconds() {
+		t.Error("expected the clock offset to be removed")
+	}
+}
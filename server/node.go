@@ -24,6 +24,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
@@ -31,8 +32,10 @@ import (
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/audit"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
 )
 
@@ -42,6 +45,12 @@ const (
 	gossipGroupLimit = 100
 	// gossipInterval is the interval for gossiping storage-related info.
 	gossipInterval = 1 * time.Minute
+	// capacityGossipInterval is the interval at which the capacities of
+	// the node's stores are checked for changes worth gossiping.
+	capacityGossipInterval = 10 * time.Second
+	// scanStreamChunkBytes is the size of the chunks of rows in which
+	// ScanStream streams a scan back to the client.
+	scanStreamChunkBytes = 256 << 10 // 256K
 )
 
 // A Node manages a map of stores (by store ID) for which it serves
@@ -58,43 +67,136 @@ type Node struct {
 	Descriptor gossip.NodeDescriptor // Node ID, network/physical topology
 	ctx        storage.StoreContext  // Context to use and pass to stores
 	lSender    *kv.LocalSender       // Local KV sender for access to node-local stores
+
+	capacityGossiper *storage.CapacityGossiper // Accessed only by the gossip worker
 }
 
+// maxIDAllocationAttempts bounds the number of times allocation of a
+// node or store ID is retried because the allocated ID was already
+// claimed, as happens if the ID generator has been restored from a
+// backup.
+const maxIDAllocationAttempts = 10
+
 // allocateNodeID increments the node id generator key to allocate
-// a new, unique node id.
-func allocateNodeID(db *client.KV) (proto.NodeID, error) {
+// a new, unique node id and claims it for a node at the specified
+// address in the specified cluster.
+func allocateNodeID(db *client.KV, addr, clusterID string) (proto.NodeID, error) {
+	for i := 0; i < maxIDAllocationAttempts; i++ {
+		newValue, err := incrementIDGenerator(db, keys.KeyNodeIDGenerator, 1)
+		if err != nil {
+			return 0, util.Errorf("unable to allocate node ID: %s", err)
+		}
+		nodeID := proto.NodeID(newValue)
+		claim := &proto.NodeIDClaim{NodeID: nodeID, Address: addr, ClusterID: clusterID}
+		if err := putIDClaim(db, keys.NodeIDClaimKey(int32(nodeID)), nil, claim); err != nil {
+			if _, ok := err.(*proto.ConditionFailedError); ok {
+				log.Warningf("allocated node ID %d has already been claimed", nodeID)
+				continue
+			}
+			return 0, util.Errorf("unable to claim node ID %d: %s", nodeID, err)
+		}
+		return nodeID, nil
+	}
+	return 0, util.Errorf("unable to allocate an unclaimed node ID after %d attempts", maxIDAllocationAttempts)
+}
+
+// allocateStoreIDs increments the store id generator key for the
+// specified node to allocate "inc" new, unique store ids, and claims
+// them for the node. The first ID in a contiguous range is returned
+// on success.
+func allocateStoreIDs(nodeID proto.NodeID, inc int64, db *client.KV) (proto.StoreID, error) {
+	for i := 0; i < maxIDAllocationAttempts; i++ {
+		newValue, err := incrementIDGenerator(db, keys.KeyStoreIDGenerator, inc)
+		if err != nil {
+			return 0, util.Errorf("unable to allocate %d store IDs for node %d: %s", inc, nodeID, err)
+		}
+		firstID := proto.StoreID(newValue - inc + 1)
+		claimed := true
+		for storeID := firstID; storeID < firstID+proto.StoreID(inc); storeID++ {
+			claim := &proto.StoreIDClaim{StoreID: storeID, NodeID: nodeID}
+			if err := putIDClaim(db, keys.StoreIDClaimKey(int32(storeID)), nil, claim); err != nil {
+				if _, ok := err.(*proto.ConditionFailedError); ok {
+					log.Warningf("allocated store ID %d has already been claimed", storeID)
+					claimed = false
+					break
+				}
+				return 0, util.Errorf("unable to claim store ID %d for node %d: %s", storeID, nodeID, err)
+			}
+		}
+		if claimed {
+			return firstID, nil
+		}
+	}
+	return 0, util.Errorf("unable to allocate %d unclaimed store IDs for node %d after %d attempts",
+		inc, nodeID, maxIDAllocationAttempts)
+}
+
+// incrementIDGenerator increments the ID generator at key by inc,
+// returning the new value.
+func incrementIDGenerator(db *client.KV, key proto.Key, inc int64) (int64, error) {
 	iReply := &proto.IncrementResponse{}
 	if err := db.Run(client.Call{
 		Args: &proto.IncrementRequest{
 			RequestHeader: proto.RequestHeader{
-				Key:  engine.KeyNodeIDGenerator,
+				Key:  key,
 				User: storage.UserRoot,
 			},
-			Increment: 1,
+			Increment: inc,
 		},
 		Reply: iReply}); err != nil {
-		return 0, util.Errorf("unable to allocate node ID: %s", err)
+		return 0, err
 	}
-	return proto.NodeID(iReply.NewValue), nil
+	return iReply.NewValue, nil
 }
 
-// allocateStoreIDs increments the store id generator key for the
-// specified node to allocate "inc" new, unique store ids. The
-// first ID in a contiguous range is returned on success.
-func allocateStoreIDs(nodeID proto.NodeID, inc int64, db *client.KV) (proto.StoreID, error) {
-	iReply := &proto.IncrementResponse{}
+// getIDClaim reads the ID claim at key into claim, returning false if
+// the ID hasn't been claimed.
+func getIDClaim(db *client.KV, key proto.Key, claim gogoproto.Message) (bool, error) {
+	gReply := &proto.GetResponse{}
 	if err := db.Run(client.Call{
-		Args: &proto.IncrementRequest{
+		Args: &proto.GetRequest{
 			RequestHeader: proto.RequestHeader{
-				Key:  engine.KeyStoreIDGenerator,
+				Key:  key,
 				User: storage.UserRoot,
 			},
-			Increment: inc,
 		},
-		Reply: iReply}); err != nil {
-		return 0, util.Errorf("unable to allocate %d store IDs for node %d: %s", inc, nodeID, err)
+		Reply: gReply}); err != nil {
+		return false, err
+	}
+	if gReply.Value == nil {
+		return false, nil
 	}
-	return proto.StoreID(iReply.NewValue - inc + 1), nil
+	return true, gogoproto.Unmarshal(gReply.Value.Bytes, claim)
+}
+
+// putIDClaim writes the ID claim at key, provided the stored claim
+// equals old, or the ID is unclaimed if old is nil.
+func putIDClaim(db *client.KV, key proto.Key, old, claim gogoproto.Message) error {
+	newBytes, err := gogoproto.Marshal(claim)
+	if err != nil {
+		return err
+	}
+	var expValue *proto.Value
+	if old != nil {
+		oldBytes, err := gogoproto.Marshal(old)
+		if err != nil {
+			return err
+		}
+		expValue = &proto.Value{Bytes: oldBytes}
+	}
+	value := proto.Value{Bytes: newBytes}
+	value.InitChecksum(key)
+	return db.Run(client.Call{
+		Args: &proto.ConditionalPutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:  key,
+				User: storage.UserRoot,
+			},
+			Value:    value,
+			ExpValue: expValue,
+		},
+		Reply: &proto.ConditionalPutResponse{},
+	})
 }
 
 // BootstrapCluster bootstraps a store using the provided engine and
@@ -143,7 +245,7 @@ func BootstrapCluster(clusterID string, eng engine.Engine, stopper *util.Stopper
 
 	// Initialize node and store ids after the fact to account
 	// for use of node ID = 1 and store ID = 1.
-	if nodeID, err := allocateNodeID(localDB); nodeID != sIdent.NodeID || err != nil {
+	if nodeID, err := allocateNodeID(localDB, "", clusterID); nodeID != sIdent.NodeID || err != nil {
 		return nil, util.Errorf("expected to intialize node id allocator to %d, got %d: %s",
 			sIdent.NodeID, nodeID, err)
 	}
@@ -158,8 +260,9 @@ func BootstrapCluster(clusterID string, eng engine.Engine, stopper *util.Stopper
 // NewNode returns a new instance of Node.
 func NewNode(ctx storage.StoreContext) *Node {
 	return &Node{
-		ctx:     ctx,
-		lSender: kv.NewLocalSender(),
+		ctx:              ctx,
+		lSender:          kv.NewLocalSender(),
+		capacityGossiper: storage.NewCapacityGossiper(ctx.Gossip, ctx),
 	}
 }
 
@@ -190,7 +293,7 @@ func (n *Node) initNodeID(id proto.NodeID) {
 	}
 	var err error
 	if id == 0 {
-		id, err = allocateNodeID(n.ctx.DB)
+		id, err = allocateNodeID(n.ctx.DB, n.Descriptor.Address.String(), n.ClusterID)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -202,6 +305,10 @@ func (n *Node) initNodeID(id proto.NodeID) {
 	}
 	// Gossip the node descriptor to make this node addressable by node ID.
 	n.Descriptor.NodeID = id
+	audit.SetNodeID(id)
+	if n.ctx.Tracer != nil {
+		n.ctx.Tracer.SetNodeID(id)
+	}
 	if err = n.ctx.Gossip.SetNodeDescriptor(&n.Descriptor); err != nil {
 		log.Fatalf("couldn't gossip descriptor for node %d: %s", n.Descriptor.NodeID, err)
 	}
@@ -209,8 +316,10 @@ func (n *Node) initNodeID(id proto.NodeID) {
 
 // start starts the node by registering the storage instance for the
 // RPC service "Node" and initializing stores for each specified
-// engine. Launches periodic store gossiping in a goroutine.
-func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
+// engine. A non-nil entry of raftEngines holds the Raft logs of the
+// store at the same index of engines. Launches periodic store
+// gossiping in a goroutine.
+func (n *Node) start(rpcServer *rpc.Server, engines, raftEngines []engine.Engine,
 	attrs proto.Attributes, stopper *util.Stopper) error {
 	n.initDescriptor(rpcServer.Addr(), attrs)
 	if err := rpcServer.RegisterName("Node", n); err != nil {
@@ -218,7 +327,7 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 	}
 
 	// Initialize stores, including bootstrapping new ones.
-	if err := n.initStores(engines, stopper); err != nil {
+	if err := n.initStores(engines, raftEngines, stopper); err != nil {
 		return err
 	}
 	n.startGossip(stopper)
@@ -232,14 +341,18 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 // the Store doesn't yet have a valid ident, it's added to the
 // bootstraps list for initialization once the cluster and node IDs
 // have been determined.
-func (n *Node) initStores(engines []engine.Engine, stopper *util.Stopper) error {
+func (n *Node) initStores(engines, raftEngines []engine.Engine, stopper *util.Stopper) error {
 	bootstraps := list.New()
 
 	if len(engines) == 0 {
 		return util.Error("no engines")
 	}
-	for _, e := range engines {
-		s := storage.NewStore(n.ctx, e)
+	for i, e := range engines {
+		raftEngine := e
+		if i < len(raftEngines) && raftEngines[i] != nil {
+			raftEngine = raftEngines[i]
+		}
+		s := storage.NewStoreWithRaftEngine(n.ctx, e, raftEngine)
 		// Initialize each store in turn, handling un-bootstrapped errors by
 		// adding the store to the bootstraps list.
 		if err := s.Start(stopper); err != nil {
@@ -266,6 +379,15 @@ func (n *Node) initStores(engines []engine.Engine, stopper *util.Stopper) error
 		return err
 	}
 
+	// Set the gossip storage to the first initialized store so that the
+	// addresses of nodes known before a restart are used as bootstrap
+	// hosts, in addition to those specified on the command line.
+	if n.lSender.GetStoreCount() > 0 {
+		if err := n.setGossipStorage(); err != nil {
+			return err
+		}
+	}
+
 	// Connect gossip before starting bootstrap. For new nodes, connecting
 	// to the gossip network is necessary to get the cluster ID.
 	n.connectGossip()
@@ -276,6 +398,12 @@ func (n *Node) initStores(engines []engine.Engine, stopper *util.Stopper) error
 		n.initNodeID(0)
 	}
 
+	// Verify that the node and its initialized stores still hold their
+	// ID claims.
+	if err := n.verifyIDClaims(); err != nil {
+		return err
+	}
+
 	// Bootstrap any uninitialized stores asynchronously.
 	if bootstraps.Len() > 0 {
 		go n.bootstrapStores(bootstraps, stopper)
@@ -301,6 +429,65 @@ func (n *Node) validateStores() error {
 	})
 }
 
+// verifyIDClaims verifies that each initialized store's ID was
+// claimed for this node, and that this node's ID isn't claimed by a
+// live node of another cluster, as happens when a store restored from
+// a backup of another cluster is started while the node it was copied
+// from is running. A node restarted at a new address holds the claim
+// of its ID in this cluster and is accepted without waiting for the
+// liveness of its previous incarnation to expire. The node's claim is
+// then updated with its address. Claims missing for IDs allocated
+// before claims were recorded are written.
+func (n *Node) verifyIDClaims() error {
+	nodeID := n.Descriptor.NodeID
+	addr := n.Descriptor.Address.String()
+	key := keys.NodeIDClaimKey(int32(nodeID))
+	claim := &proto.NodeIDClaim{}
+	ok, err := getIDClaim(n.ctx.DB, key, claim)
+	if err != nil {
+		return util.Errorf("unable to read claim of node ID %d: %s", nodeID, err)
+	}
+	if !ok || claim.Address != addr || claim.ClusterID != n.ClusterID {
+		if ok && claim.ClusterID != n.ClusterID && n.ctx.NodeLiveness != nil {
+			if err := n.ctx.NodeLiveness.Refresh(); err != nil {
+				return util.Errorf("unable to read liveness of node %d: %s", nodeID, err)
+			}
+			if n.ctx.NodeLiveness.IsLive(nodeID) {
+				return util.Errorf("node ID %d is claimed by live node at %s of cluster %q; if the node "+
+					"has stopped, retry once its liveness expires", nodeID, claim.Address, claim.ClusterID)
+			}
+		}
+		var old gogoproto.Message
+		if ok {
+			old = claim
+		}
+		newClaim := &proto.NodeIDClaim{NodeID: nodeID, Address: addr, ClusterID: n.ClusterID}
+		if err := putIDClaim(n.ctx.DB, key, old, newClaim); err != nil {
+			return util.Errorf("unable to claim node ID %d: %s", nodeID, err)
+		}
+	}
+
+	return n.lSender.VisitStores(func(s *storage.Store) error {
+		key := keys.StoreIDClaimKey(int32(s.Ident.StoreID))
+		claim := &proto.StoreIDClaim{}
+		ok, err := getIDClaim(n.ctx.DB, key, claim)
+		if err != nil {
+			return util.Errorf("unable to read claim of store ID %d: %s", s.Ident.StoreID, err)
+		}
+		if ok {
+			if claim.NodeID != nodeID {
+				return util.Errorf("store %s has an ID claimed by node %d", s, claim.NodeID)
+			}
+			return nil
+		}
+		newClaim := &proto.StoreIDClaim{StoreID: s.Ident.StoreID, NodeID: nodeID}
+		if err := putIDClaim(n.ctx.DB, key, nil, newClaim); err != nil {
+			return util.Errorf("unable to claim store ID %d: %s", s.Ident.StoreID, err)
+		}
+		return nil
+	})
+}
+
 // bootstrapStores bootstraps uninitialized stores once the cluster
 // and node IDs have been established for this node. Store IDs are
 // allocated via a sequence id generator stored at a system key per
@@ -331,12 +518,38 @@ func (n *Node) bootstrapStores(bootstraps *list.List, stopper *util.Stopper) {
 		if err := s.Start(stopper); err != nil {
 			log.Fatal(err)
 		}
+		first := n.lSender.GetStoreCount() == 0
 		n.lSender.AddStore(s)
+		// A node without any previously initialized stores persists
+		// gossip bootstrap info to its first new store.
+		if first {
+			if err := n.setGossipStorage(); err != nil {
+				log.Error(err)
+			}
+		}
 		sIdent.StoreID++
 		log.Infof("bootstrapped store %s", s)
 	}
 }
 
+// setGossipStorage sets the gossip storage to the first of the
+// node's stores.
+func (n *Node) setGossipStorage() error {
+	var first *storage.Store
+	if err := n.lSender.VisitStores(func(s *storage.Store) error {
+		if first == nil || s.StoreID() < first.StoreID() {
+			first = s
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := n.ctx.Gossip.SetStorage(first); err != nil {
+		return util.Errorf("failed to read gossip bootstrap info from store %s: %s", first, err)
+	}
+	return nil
+}
+
 // connectGossip connects to gossip network and reads cluster ID. If
 // this node is already part of a cluster, the cluster ID is verified
 // for a match. If not part of a cluster, the cluster ID is set. The
@@ -362,14 +575,22 @@ func (n *Node) connectGossip() {
 	log.Infof("node connected via gossip and verified as part of cluster %q", gossipClusterID)
 }
 
-// startGossip loops on a periodic ticker to gossip node-related
+// startGossip loops on periodic tickers to gossip node-related
 // information. Starts a goroutine to loop until the node is closed.
 func (n *Node) startGossip(stopper *util.Stopper) {
 	stopper.RunWorker(func() {
 		ticker := time.NewTicker(gossipInterval)
+		defer ticker.Stop()
+		capacityTicker := time.NewTicker(capacityGossipInterval)
+		defer capacityTicker.Stop()
 		for {
 			select {
 			case <-ticker.C:
+				if stopper.StartTask() {
+					n.gossipAcctUsages()
+					stopper.FinishTask()
+				}
+			case <-capacityTicker.C:
 				if stopper.StartTask() {
 					n.gossipCapacities()
 					stopper.FinishTask()
@@ -381,18 +602,69 @@ func (n *Node) startGossip(stopper *util.Stopper) {
 	})
 }
 
-// gossipCapacities calls capacity on each store and adds it to the
-// gossip network.
+// gossipCapacities gossips the capacities of the node's stores as a
+// single info if they have changed sufficiently since last gossiped.
 func (n *Node) gossipCapacities() {
+	var descs []storage.StoreDescriptor
 	n.lSender.VisitStores(func(s *storage.Store) error {
-		s.GossipCapacity(&n.Descriptor)
+		desc, err := s.Descriptor(&n.Descriptor)
+		if err != nil {
+			log.Warningf("problem getting store descriptor for store %+v: %v", s.Ident, err)
+			return nil
+		}
+		descs = append(descs, *desc)
 		return nil
 	})
+	if len(descs) == 0 {
+		return
+	}
+	if _, err := n.capacityGossiper.MaybeGossip(n.Descriptor.NodeID, descs, time.Now()); err != nil {
+		log.Warningf("unable to gossip store capacities of node %d: %s", n.Descriptor.NodeID, err)
+	}
 }
 
-// executeCmd creates a client.Call struct and sends if via our local sender.
+// gossipAcctUsages adds the accounting usage of each store to the
+// gossip network.
+func (n *Node) gossipAcctUsages() {
+	n.lSender.VisitStores(func(s *storage.Store) error {
+		s.GossipAcctUsage()
+		return nil
+	})
+}
+
+// executeCmd creates a client.Call struct and sends if via our local
+// sender, bounded by the timeout the sender set in the request header.
+// The command is traced as part of the sender's trace, if any.
+// Requests without a user are rejected; see requireUser.
 func (n *Node) executeCmd(args proto.Request, reply proto.Response) error {
-	n.lSender.Send(client.Call{Args: args, Reply: reply})
+	if err := requireUser(args); err != nil {
+		reply.Header().SetGoError(err)
+		return nil
+	}
+	ctx, cancel := client.RequestContext(args)
+	defer cancel()
+	ctx, sp := n.ctx.Tracer.JoinSpan(ctx, args.Header().Trace, "Node."+args.Method().String())
+	defer sp.Finish()
+	n.lSender.Send(ctx, client.Call{Args: args, Reply: reply})
+	return nil
+}
+
+// requireUser returns an error if the request, or one of the requests
+// of a batch, doesn't specify a user. Requests received on secure
+// connections carry the authenticated user; the store checks
+// permissions only of requests which specify one, as the commands it
+// issues itself don't.
+func requireUser(args proto.Request) error {
+	if args.Header().User == "" {
+		return util.Errorf("%s request does not specify a user", args.Method())
+	}
+	if batch, ok := args.(*proto.BatchRequest); ok {
+		for i := range batch.Requests {
+			if err := requireUser(batch.Requests[i].GetValue().(proto.Request)); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -418,6 +690,11 @@ func (n *Node) ConditionalPut(args *proto.ConditionalPutRequest, reply *proto.Co
 	return n.executeCmd(args, reply)
 }
 
+// InitPut .
+func (n *Node) InitPut(args *proto.InitPutRequest, reply *proto.InitPutResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // Increment .
 func (n *Node) Increment(args *proto.IncrementRequest, reply *proto.IncrementResponse) error {
 	return n.executeCmd(args, reply)
@@ -433,16 +710,84 @@ func (n *Node) DeleteRange(args *proto.DeleteRangeRequest, reply *proto.DeleteRa
 	return n.executeCmd(args, reply)
 }
 
+// ClearRange .
+func (n *Node) ClearRange(args *proto.ClearRangeRequest, reply *proto.ClearRangeResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // Scan .
 func (n *Node) Scan(args *proto.ScanRequest, reply *proto.ScanResponse) error {
 	return n.executeCmd(args, reply)
 }
 
+// ScanStream is like Scan, but streams the rows back to the client in
+// chunks as they are scanned, so that the rows of a large range are
+// never held in a single response. The chunks are read at the same
+// timestamp, and the final response carries no rows but its resume
+// span if the scan stopped at a limit. Clients which don't accept
+// streamed responses get all rows with the final response.
+func (n *Node) ScanStream(args *proto.ScanStreamRequest, reply *proto.ScanResponse) error {
+	if !args.Streaming() {
+		return n.executeCmd(&args.ScanRequest, reply)
+	}
+	scan := args.ScanRequest
+	var rows, bytes int64
+	for {
+		chunkArgs := scan
+		if scan.MaxResults > 0 {
+			chunkArgs.MaxResults = scan.MaxResults - rows
+		}
+		chunkArgs.TargetBytes = scanStreamChunkBytes
+		if scan.TargetBytes > 0 && scan.TargetBytes-bytes < chunkArgs.TargetBytes {
+			chunkArgs.TargetBytes = scan.TargetBytes - bytes
+		}
+		chunk := &proto.ScanResponse{}
+		if err := n.executeCmd(&chunkArgs, chunk); err != nil {
+			return err
+		}
+		if chunk.Error != nil {
+			reply.ResponseHeader = chunk.ResponseHeader
+			return nil
+		}
+		if len(chunk.Rows) > 0 {
+			rows += chunk.Count()
+			bytes += chunk.ByteCount()
+			if err := args.SendChunk(&proto.ScanResponse{Rows: chunk.Rows}); err != nil {
+				return err
+			}
+		}
+		if chunk.ResumeSpan == nil ||
+			(scan.MaxResults > 0 && rows >= scan.MaxResults) ||
+			(scan.TargetBytes > 0 && bytes >= scan.TargetBytes) {
+			reply.ResponseHeader = chunk.ResponseHeader
+			reply.ResumeSpan = chunk.ResumeSpan
+			return nil
+		}
+		scan.Key = chunk.ResumeSpan.Key
+		scan.Timestamp = chunkArgs.Timestamp
+	}
+}
+
+// Aggregate .
+func (n *Node) Aggregate(args *proto.AggregateRequest, reply *proto.AggregateResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// Watch .
+func (n *Node) Watch(args *proto.WatchRequest, reply *proto.WatchResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // EndTransaction .
 func (n *Node) EndTransaction(args *proto.EndTransactionRequest, reply *proto.EndTransactionResponse) error {
 	return n.executeCmd(args, reply)
 }
 
+// Batch .
+func (n *Node) Batch(args *proto.BatchRequest, reply *proto.BatchResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // AdminSplit .
 func (n *Node) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSplitResponse) error {
 	return n.executeCmd(args, reply)
@@ -453,6 +798,27 @@ func (n *Node) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMerge
 	return n.executeCmd(args, reply)
 }
 
+// AdminBulkLoad .
+func (n *Node) AdminBulkLoad(args *proto.AdminBulkLoadRequest, reply *proto.AdminBulkLoadResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// AdminTransferLease .
+func (n *Node) AdminTransferLease(args *proto.AdminTransferLeaseRequest,
+	reply *proto.AdminTransferLeaseResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// AdminBackup .
+func (n *Node) AdminBackup(args *proto.AdminBackupRequest, reply *proto.AdminBackupResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// AdminRestore .
+func (n *Node) AdminRestore(args *proto.AdminRestoreRequest, reply *proto.AdminRestoreResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // InternalRangeLookup .
 func (n *Node) InternalRangeLookup(args *proto.InternalRangeLookupRequest, reply *proto.InternalRangeLookupResponse) error {
 	return n.executeCmd(args, reply)
@@ -493,3 +859,21 @@ func (n *Node) InternalLeaderLease(args *proto.InternalLeaderLeaseRequest,
 	reply *proto.InternalLeaderLeaseResponse) error {
 	return n.executeCmd(args, reply)
 }
+
+// InternalCheckConsistency .
+func (n *Node) InternalCheckConsistency(args *proto.InternalCheckConsistencyRequest,
+	reply *proto.InternalCheckConsistencyResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// InternalRecomputeStats .
+func (n *Node) InternalRecomputeStats(args *proto.InternalRecomputeStatsRequest,
+	reply *proto.InternalRecomputeStatsResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// InternalRefreshRange .
+func (n *Node) InternalRefreshRange(args *proto.InternalRefreshRangeRequest,
+	reply *proto.InternalRefreshRangeResponse) error {
+	return n.executeCmd(args, reply)
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
@@ -84,7 +85,7 @@ func createTestNode(addr net.Addr, engines []engine.Engine, gossipBS net.Addr, t
 func createAndStartTestNode(addr net.Addr, engines []engine.Engine, gossipBS net.Addr, t *testing.T) (
 	*rpc.Server, *Node, *util.Stopper) {
 	rpcServer, _, node, stopper := createTestNode(addr, engines, gossipBS, t)
-	if err := node.start(rpcServer, engines, proto.Attributes{}, stopper); err != nil {
+	if err := node.start(rpcServer, engines, nil, proto.Attributes{}, stopper); err != nil {
 		t.Fatal(err)
 	}
 	return rpcServer, node, stopper
@@ -114,8 +115,8 @@ func TestBootstrapCluster(t *testing.T) {
 	if err := localDB.Run(client.Call{
 		Args: &proto.ScanRequest{
 			RequestHeader: proto.RequestHeader{
-				Key:    engine.KeyLocalPrefix.PrefixEnd(), // skip local keys
-				EndKey: engine.KeyMax,
+				Key:    keys.KeyLocalPrefix.PrefixEnd(), // skip local keys
+				EndKey: keys.KeyMax,
 				User:   storage.UserRoot,
 			},
 			MaxResults: math.MaxInt64,
@@ -123,23 +124,25 @@ func TestBootstrapCluster(t *testing.T) {
 		Reply: sr}); err != nil {
 		t.Fatal(err)
 	}
-	var keys []proto.Key
+	var testKeys []proto.Key
 	for _, kv := range sr.Rows {
-		keys = append(keys, kv.Key)
+		testKeys = append(testKeys, kv.Key)
 	}
 	var expectedKeys = []proto.Key{
-		engine.MakeKey(proto.Key("\x00\x00meta1"), engine.KeyMax),
-		engine.MakeKey(proto.Key("\x00\x00meta2"), engine.KeyMax),
-		proto.Key("\x00acct"),
+		keys.MakeKey(proto.Key("\x00\x00meta1"), keys.KeyMax),
+		keys.MakeKey(proto.Key("\x00\x00meta2"), keys.KeyMax),
+		proto.Key("\x00config-acct"),
+		proto.Key("\x00config-perm"),
+		proto.Key("\x00config-zone"),
+		keys.NodeIDClaimKey(1),
 		proto.Key("\x00node-idgen"),
-		proto.Key("\x00perm"),
 		proto.Key("\x00range-tree-root"),
+		keys.StoreIDClaimKey(1),
 		proto.Key("\x00store-idgen"),
-		proto.Key("\x00zone"),
 	}
-	if !reflect.DeepEqual(keys, expectedKeys) {
+	if !reflect.DeepEqual(testKeys, expectedKeys) {
 		t.Errorf("expected keys mismatch:\n%s\n  -- vs. -- \n\n%s",
-			formatKeys(keys), formatKeys(expectedKeys))
+			formatKeys(testKeys), formatKeys(expectedKeys))
 	}
 
 	// TODO(spencer): check values.
@@ -250,14 +253,167 @@ func TestCorruptedClusterID(t *testing.T) {
 		NodeID:    1,
 		StoreID:   1,
 	}
-	if err = engine.MVCCPutProto(e, nil, engine.StoreIdentKey(), proto.ZeroTimestamp, nil, &sIdent); err != nil {
+	if err = engine.MVCCPutProto(e, nil, keys.StoreIdentKey(), proto.ZeroTimestamp, nil, &sIdent); err != nil {
 		t.Fatal(err)
 	}
 
 	engines := []engine.Engine{e}
 	server, _, node, stopper := createTestNode(util.CreateTestAddr("tcp"), engines, nil, t)
-	if err := node.start(server, engines, proto.Attributes{}, stopper); err == nil {
+	if err := node.start(server, engines, nil, proto.Attributes{}, stopper); err == nil {
 		t.Errorf("unexpected success")
 	}
 	stopper.Stop()
 }
+
+// TestNodeIDClaims verifies that a node started with the bootstrapped
+// store updates the claim of its node ID with its address.
+func TestNodeIDClaims(t *testing.T) {
+	stopper := util.NewStopper()
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	_, err := BootstrapCluster("cluster-1", e, stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopper.Stop()
+
+	addr := util.CreateTestAddr("tcp")
+	server, node, stopper := createAndStartTestNode(addr, []engine.Engine{e}, addr, t)
+	defer stopper.Stop()
+
+	nodeClaim := &proto.NodeIDClaim{}
+	if ok, err := getIDClaim(node.ctx.DB, keys.NodeIDClaimKey(1), nodeClaim); !ok || err != nil {
+		t.Fatalf("expected claim of node ID 1: %t, %v", ok, err)
+	}
+	if nodeClaim.Address != server.Addr().String() {
+		t.Errorf("expected node ID claimed from %s; got %s", server.Addr(), nodeClaim.Address)
+	}
+	if nodeClaim.ClusterID != "cluster-1" {
+		t.Errorf("expected node ID claimed in cluster-1; got %q", nodeClaim.ClusterID)
+	}
+	storeClaim := &proto.StoreIDClaim{}
+	if ok, err := getIDClaim(node.ctx.DB, keys.StoreIDClaimKey(1), storeClaim); !ok || err != nil {
+		t.Fatalf("expected claim of store ID 1: %t, %v", ok, err)
+	}
+	if storeClaim.NodeID != 1 {
+		t.Errorf("expected store ID claimed by node 1; got %d", storeClaim.NodeID)
+	}
+}
+
+// TestNodeRejectsStoreClaimedByOtherNode verifies that a node fails to
+// start with a store whose ID was claimed by another node.
+func TestNodeRejectsStoreClaimedByOtherNode(t *testing.T) {
+	stopper := util.NewStopper()
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	_, err := BootstrapCluster("cluster-1", e, stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopper.Stop()
+
+	claim := &proto.StoreIDClaim{StoreID: 1, NodeID: 2}
+	if err := engine.MVCCPutProto(e, nil, keys.StoreIDClaimKey(1), proto.Timestamp{WallTime: 1}, nil, claim); err != nil {
+		t.Fatal(err)
+	}
+
+	engines := []engine.Engine{e}
+	addr := util.CreateTestAddr("tcp")
+	server, _, node, stopper := createTestNode(addr, engines, addr, t)
+	defer stopper.Stop()
+	if err := node.start(server, engines, nil, proto.Attributes{}, stopper); err == nil {
+		t.Errorf("unexpected success starting store claimed by another node")
+	}
+}
+
+// TestNodeRejectsNodeIDClaimedByLiveNode verifies that a node fails to
+// start with an ID claimed from another address by a live node of
+// another cluster, as when a store restored from a backup of another
+// cluster is started alongside the original.
+func TestNodeRejectsNodeIDClaimedByLiveNode(t *testing.T) {
+	stopper := util.NewStopper()
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	_, err := BootstrapCluster("cluster-1", e, stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopper.Stop()
+
+	claim := &proto.NodeIDClaim{NodeID: 1, Address: "elsewhere:26257", ClusterID: "cluster-0"}
+	if err := engine.MVCCPutProto(e, nil, keys.NodeIDClaimKey(1), proto.Timestamp{WallTime: 1}, nil, claim); err != nil {
+		t.Fatal(err)
+	}
+	liveness := &proto.Liveness{NodeID: 1, Epoch: 1, Expiration: proto.Timestamp{WallTime: math.MaxInt64}}
+	if err := engine.MVCCPutProto(e, nil, keys.NodeLivenessKey(1), proto.Timestamp{WallTime: 1}, nil, liveness); err != nil {
+		t.Fatal(err)
+	}
+
+	engines := []engine.Engine{e}
+	addr := util.CreateTestAddr("tcp")
+	server, clock, node, stopper := createTestNode(addr, engines, addr, t)
+	defer stopper.Stop()
+	node.ctx.NodeLiveness = storage.NewNodeLiveness(node.ctx.DB, clock, storage.DefaultLivenessThreshold)
+	if err := node.start(server, engines, nil, proto.Attributes{}, stopper); err == nil {
+		t.Errorf("unexpected success starting node ID claimed by a live node")
+	}
+}
+
+// TestNodeRestartAtNewAddress verifies that a node restarted at a new
+// address takes over the claim of its ID without waiting for the
+// liveness of its previous incarnation to expire.
+func TestNodeRestartAtNewAddress(t *testing.T) {
+	stopper := util.NewStopper()
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	_, err := BootstrapCluster("cluster-1", e, stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopper.Stop()
+
+	claim := &proto.NodeIDClaim{NodeID: 1, Address: "elsewhere:26257", ClusterID: "cluster-1"}
+	if err := engine.MVCCPutProto(e, nil, keys.NodeIDClaimKey(1), proto.Timestamp{WallTime: 1}, nil, claim); err != nil {
+		t.Fatal(err)
+	}
+	liveness := &proto.Liveness{NodeID: 1, Epoch: 1, Expiration: proto.Timestamp{WallTime: math.MaxInt64}}
+	if err := engine.MVCCPutProto(e, nil, keys.NodeLivenessKey(1), proto.Timestamp{WallTime: 1}, nil, liveness); err != nil {
+		t.Fatal(err)
+	}
+
+	engines := []engine.Engine{e}
+	addr := util.CreateTestAddr("tcp")
+	server, clock, node, stopper := createTestNode(addr, engines, addr, t)
+	defer stopper.Stop()
+	node.ctx.NodeLiveness = storage.NewNodeLiveness(node.ctx.DB, clock, storage.DefaultLivenessThreshold)
+	if err := node.start(server, engines, nil, proto.Attributes{}, stopper); err != nil {
+		t.Fatal(err)
+	}
+	newClaim := &proto.NodeIDClaim{}
+	if ok, err := getIDClaim(node.ctx.DB, keys.NodeIDClaimKey(1), newClaim); !ok || err != nil {
+		t.Fatalf("expected claim of node ID 1: %t, %v", ok, err)
+	}
+	if newClaim.Address != server.Addr().String() {
+		t.Errorf("expected node ID claimed from %s; got %s", server.Addr(), newClaim.Address)
+	}
+}
+
+// TestNodeRequiresUser verifies that the node rejects requests, and
+// batched requests, which don't specify a user.
+func TestNodeRequiresUser(t *testing.T) {
+	n := &Node{}
+	putArgs := &proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}}
+	putReply := &proto.PutResponse{}
+	if err := n.Put(putArgs, putReply); err != nil {
+		t.Fatal(err)
+	}
+	if putReply.GoError() == nil {
+		t.Error("expected request without a user to be rejected")
+	}
+
+	batchArgs := &proto.BatchRequest{RequestHeader: proto.RequestHeader{User: storage.UserRoot}}
+	batchArgs.Add(&proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}})
+	batchReply := &proto.BatchResponse{}
+	if err := n.Batch(batchArgs, batchReply); err != nil {
+		t.Fatal(err)
+	}
+	if batchReply.GoError() == nil {
+		t.Error("expected batched request without a user to be rejected")
+	}
+}
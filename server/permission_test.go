@@ -26,8 +26,8 @@ import (
 	"os"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	yaml "gopkg.in/yaml.v1"
 )
 
@@ -49,7 +49,7 @@ func ExampleSetAndGetPerms() {
 		prefix proto.Key
 		yaml   string
 	}{
-		{engine.KeyMin, testPermConfig},
+		{keys.KeyMin, testPermConfig},
 		{proto.Key("db1"), testPermConfig},
 		{proto.Key("db 2"), testPermConfig},
 		{proto.Key("\xfe"), testPermConfig},
@@ -109,7 +109,7 @@ func ExampleLsPerms() {
 	defer os.Remove(testConfigFn)
 
 	keys := []proto.Key{
-		engine.KeyMin,
+		keys.KeyMin,
 		proto.Key("db1"),
 		proto.Key("db2"),
 		proto.Key("db3"),
@@ -168,7 +168,7 @@ func ExampleRmPerms() {
 	defer os.Remove(testConfigFn)
 
 	keys := []proto.Key{
-		engine.KeyMin,
+		keys.KeyMin,
 		proto.Key("db1"),
 	}
 
@@ -0,0 +1,131 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// handleProblemRanges handles GET requests for the unhealthy ranges of
+// the cluster. The status of every replica is requested from each node
+// known via gossip and each range is checked against its zone config.
+func (s *statusServer) handleProblemRanges(w http.ResponseWriter, r *http.Request) {
+	var zones storage.PrefixConfigMap
+	if cfg, err := storage.GetSystemConfig(s.gossip); err == nil {
+		zones = cfg.Zones
+	}
+	replicas, errors := s.clusterRangeStatuses(0)
+	problems := findProblemRanges(replicas, zones)
+	problems.Errors = errors
+	b, contentType, err := util.MarshalResponse(r, problems, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// findProblemRanges classifies the ranges whose replicas are supplied,
+// ordered by Raft ID. Replication and size are checked against the
+// matching entry of zones, unless zones is nil.
+func findProblemRanges(replicas []storage.RangeStatus, zones storage.PrefixConfigMap) *status.ProblemRanges {
+	problems := &status.ProblemRanges{
+		UnderReplicated: []int64{},
+		Leaderless:      []int64{},
+		Stalled:         []int64{},
+		Oversized:       []int64{},
+		PendingSnapshot: []int64{},
+	}
+	for len(replicas) > 0 {
+		n := 1
+		for n < len(replicas) && replicas[n].Desc.RaftID == replicas[0].Desc.RaftID {
+			n++
+		}
+		checkRange(replicas[:n], zones, problems)
+		replicas = replicas[n:]
+	}
+	return problems
+}
+
+// checkRange adds the range whose replicas are supplied to each of
+// the problems it exhibits. The leader's view of the range is
+// preferred; absent a leader, that of any initialized replica is used.
+func checkRange(replicas []storage.RangeStatus, zones storage.PrefixConfigMap,
+	problems *status.ProblemRanges) {
+	raftID := replicas[0].Desc.RaftID
+	var leader, view *storage.RangeStatus
+	stalled, pendingSnapshot := false, false
+	for i := range replicas {
+		rng := &replicas[i]
+		if rng.Raft.State == "leader" {
+			leader = rng
+		}
+		if rng.Initialized && view == nil {
+			view = rng
+		} else if !rng.Initialized {
+			pendingSnapshot = true
+		}
+		// Commands proposed to a group without a leader, or whose group
+		// has gone quiet, are not being committed.
+		if rng.Raft.Error != "" ||
+			(rng.PendingCmds > 0 && (rng.Raft.Quiescent || rng.Raft.LeaderNodeID == 0)) {
+			stalled = true
+		}
+	}
+	if leader != nil {
+		view = leader
+		for _, pr := range leader.Raft.Progress {
+			if pr.Snapshot {
+				pendingSnapshot = true
+			}
+		}
+	} else {
+		problems.Leaderless = append(problems.Leaderless, raftID)
+	}
+	if stalled {
+		problems.Stalled = append(problems.Stalled, raftID)
+	}
+	if pendingSnapshot {
+		problems.PendingSnapshot = append(problems.PendingSnapshot, raftID)
+	}
+
+	if view == nil || zones == nil {
+		return
+	}
+	zone := zones.MatchByPrefix(view.Desc.StartKey).Config.(*proto.ZoneConfig)
+	voters := 0
+	for _, replica := range view.Desc.Replicas {
+		if !replica.Learner {
+			voters++
+		}
+	}
+	if voters < len(zone.ReplicaAttrs) {
+		problems.UnderReplicated = append(problems.UnderReplicated, raftID)
+	}
+	if zone.RangeMaxBytes > 0 && view.Stats.KeyBytes+view.Stats.ValBytes > zone.RangeMaxBytes {
+		problems.Oversized = append(problems.Oversized, raftID)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+)
+
+// TestFindProblemRanges verifies the classification of ranges from the
+// statuses of their replicas.
+func TestFindProblemRanges(t *testing.T) {
+	zones, err := storage.NewPrefixConfigMap([]*storage.PrefixConfig{
+		{Prefix: keys.KeyMin, Config: &proto.ZoneConfig{
+			ReplicaAttrs:  []proto.Attributes{{}, {}},
+			RangeMaxBytes: 100,
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := func(raftID int64, stores ...proto.StoreID) proto.RangeDescriptor {
+		d := proto.RangeDescriptor{RaftID: raftID, StartKey: proto.Key("a"), EndKey: proto.Key("b")}
+		for _, storeID := range stores {
+			d.Replicas = append(d.Replicas, proto.Replica{NodeID: proto.NodeID(storeID), StoreID: storeID})
+		}
+		return d
+	}
+	leader := storage.RaftStatus{State: "leader", LeaderNodeID: 1, LeaderStoreID: 1}
+	follower := storage.RaftStatus{State: "follower", LeaderNodeID: 1, LeaderStoreID: 1}
+	replicas := []storage.RangeStatus{
+		// Range 1 is healthy.
+		{StoreID: 1, Desc: desc(1, 1, 2), Initialized: true, Raft: leader},
+		{StoreID: 2, Desc: desc(1, 1, 2), Initialized: true, Raft: follower},
+		// Range 2 has a single replica, which has no leader and a
+		// command which cannot commit.
+		{StoreID: 1, Desc: desc(2, 1), Initialized: true, PendingCmds: 1},
+		// Range 3 is oversized, and its second replica awaits a snapshot.
+		{StoreID: 1, Desc: desc(3, 1, 2), Initialized: true, Raft: leader,
+			Stats: proto.MVCCStats{KeyBytes: 60, ValBytes: 60}},
+		{StoreID: 2, Desc: proto.RangeDescriptor{RaftID: 3}, Raft: follower},
+		// Range 4's leader is sending its follower a snapshot.
+		{StoreID: 1, Desc: desc(4, 1, 2), Initialized: true, Raft: storage.RaftStatus{
+			State:    "leader",
+			Progress: []storage.RaftReplicaProgress{{NodeID: 2, StoreID: 2, Snapshot: true}},
+		}},
+	}
+	expected := &status.ProblemRanges{
+		UnderReplicated: []int64{2},
+		Leaderless:      []int64{2},
+		Stalled:         []int64{2},
+		Oversized:       []int64{3},
+		PendingSnapshot: []int64{3, 4},
+	}
+	if problems := findProblemRanges(replicas, zones); !reflect.DeepEqual(problems, expected) {
+		t.Errorf("expected %+v; got %+v", expected, problems)
+	}
+
+	// Without zone configs, only the Raft state is checked.
+	expected.UnderReplicated, expected.Oversized = []int64{}, []int64{}
+	if problems := findProblemRanges(replicas, nil); !reflect.DeepEqual(problems, expected) {
+		t.Errorf("expected %+v; got %+v", expected, problems)
+	}
+}
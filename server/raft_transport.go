@@ -19,6 +19,7 @@
 package server
 
 import (
+	"net"
 	"sync"
 
 	"github.com/cockroachdb/cockroach/gossip"
@@ -28,30 +29,44 @@ import (
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft/raftpb"
 )
 
 const (
-	raftServiceName = "MultiRaft"
-	raftMessageName = raftServiceName + ".RaftMessage"
+	raftServiceName     = "MultiRaft"
+	raftMessageName     = raftServiceName + ".RaftMessage"
+	reserveSnapshotName = raftServiceName + ".ReserveSnapshot"
+
+	// raftStreamBufferSize is the number of messages buffered for each
+	// stream; messages sent while it's full are dropped.
+	raftStreamBufferSize = 100
 )
 
-// rpcTransport handles the rpc messages for multiraft.
+// rpcTransport handles the rpc messages for multiraft. Messages are
+// sent as separate RPCs or, if streaming is enabled, on one rpc.Stream
+// per node. Snapshots, and messages too large for a stream, are always
+// sent as RPCs; snapshots are reserved on the receiving store first.
 type rpcTransport struct {
 	gossip     *gossip.Gossip
 	rpcServer  *rpc.Server
 	rpcContext *rpc.Context
+	stream     bool // Send messages on streams
 	mu         sync.Mutex
 	servers    map[multiraft.NodeID]multiraft.ServerInterface
+	streams    map[string]*raftStream // Outgoing streams by node address
 }
 
-// newRPCTransport creates a new rpcTransport with specified gossip and rpc server.
-func newRPCTransport(gossip *gossip.Gossip, rpcServer *rpc.Server, rpcContext *rpc.Context) (
-	multiraft.Transport, error) {
+// newRPCTransport creates a new rpcTransport with specified gossip and
+// rpc server. If stream is true, raft messages are sent on streams.
+func newRPCTransport(gossip *gossip.Gossip, rpcServer *rpc.Server, rpcContext *rpc.Context,
+	stream bool) (multiraft.Transport, error) {
 	t := &rpcTransport{
 		gossip:     gossip,
 		rpcServer:  rpcServer,
 		rpcContext: rpcContext,
+		stream:     stream,
 		servers:    make(map[multiraft.NodeID]multiraft.ServerInterface),
+		streams:    make(map[string]*raftStream),
 	}
 
 	err := t.rpcServer.RegisterName(raftServiceName, (*transportRPCServer)(t))
@@ -86,6 +101,109 @@ func (t *transportRPCServer) RaftMessage(protoReq *proto.RaftMessageRequest,
 	return util.Errorf("Unable to proxy message to node: %d", req.Message.To)
 }
 
+// ReserveSnapshot proxies the incoming snapshot reservation to the
+// listening server interface.
+func (t *transportRPCServer) ReserveSnapshot(protoReq *proto.ReserveSnapshotRequest,
+	resp *proto.ReserveSnapshotResponse) error {
+	t.mu.Lock()
+	server, ok := t.servers[multiraft.NodeID(protoReq.NodeID)]
+	t.mu.Unlock()
+
+	if !ok {
+		return util.Errorf("Unable to proxy snapshot reservation to node: %d", protoReq.NodeID)
+	}
+	req := &multiraft.ReserveSnapshotRequest{
+		GroupID: protoReq.GroupID,
+		NodeID:  multiraft.NodeID(protoReq.NodeID),
+	}
+	reply := &multiraft.ReserveSnapshotResponse{}
+	if err := server.ReserveSnapshot(req, reply); err != nil {
+		return err
+	}
+	resp.Reserved = reply.Reserved
+	return nil
+}
+
+// A raftStream sends raft messages to a node over an rpc.Stream.
+type raftStream struct {
+	addr     net.Addr
+	stream   *rpc.Stream // Set once opened; protected by rpcTransport.mu
+	messages chan *proto.RaftMessageRequest
+	closer   chan struct{}
+}
+
+// getStream returns the stream to the node at addr, starting one if
+// there is none.
+func (t *rpcTransport) getStream(addr net.Addr) *raftStream {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.streams[addr.String()]; ok {
+		return s
+	}
+	s := &raftStream{
+		addr:     addr,
+		messages: make(chan *proto.RaftMessageRequest, raftStreamBufferSize),
+		closer:   make(chan struct{}),
+	}
+	t.streams[addr.String()] = s
+	go t.writeStream(s)
+	return s
+}
+
+// writeStream opens s and sends the messages sent on it until it's
+// closed or fails. A failed stream is removed, so that the next
+// message starts a new one; the messages still buffered are dropped,
+// which raft recovers from like from any other lost message.
+func (t *rpcTransport) writeStream(s *raftStream) {
+	stream, err := rpc.OpenStream(s.addr, t.rpcContext)
+	t.mu.Lock()
+	select {
+	case <-s.closer:
+		t.mu.Unlock()
+		if err == nil {
+			stream.Close()
+		}
+		return
+	default:
+	}
+	if err != nil {
+		t.removeStreamLocked(s)
+		t.mu.Unlock()
+		log.Errorf("raft stream to %s failed: %s", s.addr, err)
+		return
+	}
+	s.stream = stream
+	t.mu.Unlock()
+
+	for {
+		select {
+		case protoReq := <-s.messages:
+			if err := stream.Send(raftMessageName, protoReq); err != nil {
+				t.mu.Lock()
+				select {
+				case <-s.closer:
+				default:
+					log.Errorf("raft stream to %s failed: %s", s.addr, err)
+					t.removeStreamLocked(s)
+				}
+				t.mu.Unlock()
+				stream.Close()
+				return
+			}
+		case <-s.closer:
+			return
+		}
+	}
+}
+
+// removeStreamLocked removes s from the streams of the transport if
+// it's still the stream to its node. t.mu must be held.
+func (t *rpcTransport) removeStreamLocked(s *raftStream) {
+	if t.streams[s.addr.String()] == s {
+		delete(t.streams, s.addr.String())
+	}
+}
+
 // Listen implements the multiraft.Transport interface by registering a ServerInterface
 // to receive proxied messages.
 func (t *rpcTransport) Listen(id multiraft.NodeID, server multiraft.ServerInterface) error {
@@ -117,6 +235,15 @@ func (t *rpcTransport) Send(id multiraft.NodeID, req *multiraft.RaftMessageReque
 		return err
 	}
 
+	if t.stream && req.Message.Type != raftpb.MsgSnap && protoReq.Size() <= rpc.MaxStreamMessageSize {
+		select {
+		case t.getStream(addr).messages <- protoReq:
+			return nil
+		default:
+			return util.Errorf("raft stream to %s is full", addr)
+		}
+	}
+
 	client := rpc.NewClient(addr, nil, t.rpcContext)
 	select {
 	case <-client.Ready:
@@ -124,6 +251,21 @@ func (t *rpcTransport) Send(id multiraft.NodeID, req *multiraft.RaftMessageReque
 		return util.Errorf("raft client failed to connect")
 	}
 
+	if req.Message.Type == raftpb.MsgSnap {
+		// Snapshots are large, so reserve a slot for them on the
+		// receiving store before sending and wait for them to arrive.
+		resp := &proto.ReserveSnapshotResponse{}
+		err := client.Call(reserveSnapshotName,
+			&proto.ReserveSnapshotRequest{GroupID: req.GroupID, NodeID: uint64(id)}, resp)
+		if err != nil {
+			return err
+		}
+		if !resp.Reserved {
+			return util.Errorf("node %d declined snapshot for group %d", id, req.GroupID)
+		}
+		return client.Call(raftMessageName, protoReq, &proto.RaftMessageResponse{})
+	}
+
 	call := client.Go(raftMessageName, protoReq, &proto.RaftMessageResponse{}, nil)
 	select {
 	case <-call.Done:
@@ -141,7 +283,17 @@ func (t *rpcTransport) Send(id multiraft.NodeID, req *multiraft.RaftMessageReque
 	}
 }
 
-// Close shuts down an rpcTransport.
+// Close shuts down an rpcTransport by closing its streams. Clients of
+// RPCs are left open since we share the global cache of client
+// connections.
 func (t *rpcTransport) Close() {
-	// No-op since we share the global cache of client connections.
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for addr, s := range t.streams {
+		close(s.closer)
+		if s.stream != nil {
+			s.stream.Close()
+		}
+		delete(t.streams, addr)
+	}
 }
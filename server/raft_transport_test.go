@@ -39,7 +39,23 @@ func (s ChannelServer) RaftMessage(req *multiraft.RaftMessageRequest,
 	return nil
 }
 
+func (s ChannelServer) ReserveSnapshot(req *multiraft.ReserveSnapshotRequest,
+	resp *multiraft.ReserveSnapshotResponse) error {
+	resp.Reserved = true
+	return nil
+}
+
 func TestSendAndReceive(t *testing.T) {
+	testSendAndReceive(t, false)
+}
+
+// TestSendAndReceiveStream verifies that raft messages sent on
+// streams are received.
+func TestSendAndReceiveStream(t *testing.T) {
+	testSendAndReceive(t, true)
+}
+
+func testSendAndReceive(t *testing.T, stream bool) {
 	tlsConfig, err := testContext.GetServerTLSConfig()
 	if err != nil {
 		t.Fatal(err)
@@ -66,7 +82,7 @@ func TestSendAndReceive(t *testing.T) {
 		}
 		defer server.Close()
 
-		transport, err := newRPCTransport(g, server, rpcContext)
+		transport, err := newRPCTransport(g, server, rpcContext, stream)
 		if err != nil {
 			t.Fatalf("Unexpected error creating transport, Error: %s", err)
 		}
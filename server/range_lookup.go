@@ -0,0 +1,148 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// handleRangeLookup handles GET requests for the range containing the
+// key given by the "key" query parameter. The range's descriptor is
+// looked up in the range addressing records, and each node known via
+// gossip is asked for the status of its replicas of the range.
+func (s *statusServer) handleRangeLookup(w http.ResponseWriter, r *http.Request) {
+	values, ok := r.URL.Query()["key"]
+	if !ok {
+		http.Error(w, "the \"key\" parameter must be specified", http.StatusBadRequest)
+		return
+	}
+	key := proto.Key(values[0])
+	desc, err := s.lookupRangeDescriptor(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	lookup := &status.RangeLookup{Key: key, Desc: *desc}
+	lookup.Replicas, lookup.Errors = s.clusterRangeStatuses(desc.RaftID)
+	lookup.Lease, lookup.LeaseHolder = latestLease(lookup.Replicas)
+	b, contentType, err := util.MarshalResponse(r, lookup, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// lookupRangeDescriptor returns the descriptor of the range containing
+// key. The descriptor of the first range is taken from gossip; those
+// of all others are read from the meta1 or meta2 records, which are
+// addressed by the end keys of the ranges.
+func (s *statusServer) lookupRangeDescriptor(key proto.Key) (*proto.RangeDescriptor, error) {
+	metaKey := keys.RangeMetaKey(key)
+	if len(metaKey) == 0 {
+		info, err := s.gossip.GetInfo(gossip.KeyFirstRangeDescriptor)
+		if err != nil {
+			return nil, util.Errorf("unable to get first range descriptor: %s", err)
+		}
+		frd, ok := info.(storage.FirstRangeDescriptor)
+		if !ok {
+			return nil, util.Errorf("first range descriptor gossiped as %T", info)
+		}
+		return &frd.Desc, nil
+	}
+	metaEnd := keys.KeyMeta2Prefix.PrefixEnd()
+	if metaKey.Less(keys.KeyMeta2Prefix) {
+		metaEnd = keys.KeyMeta1Prefix.PrefixEnd()
+	}
+	call := client.ScanCall(metaKey.Next(), metaEnd, 1)
+	if err := s.db.Run(call); err != nil {
+		return nil, err
+	}
+	rows := call.Reply.(*proto.ScanResponse).Rows
+	if len(rows) == 0 {
+		return nil, util.Errorf("no range addressing record found for key %q", key)
+	}
+	desc := &proto.RangeDescriptor{}
+	if err := gogoproto.Unmarshal(rows[0].Value.Bytes, desc); err != nil {
+		return nil, util.Errorf("%s: unable to unmarshal range descriptor: %s", rows[0].Key, err)
+	}
+	if !desc.ContainsKey(keys.KeyAddress(key)) {
+		return nil, util.Errorf("range addressing record %s does not contain key %q", rows[0].Key, key)
+	}
+	return desc, nil
+}
+
+// latestLease returns the leader lease of the highest term known to
+// any of the replicas, along with the replica holding it, if the
+// holder is one of the replicas.
+func latestLease(replicas []storage.RangeStatus) (*proto.Lease, *proto.Replica) {
+	var lease *proto.Lease
+	for _, rng := range replicas {
+		if rng.Lease != nil && (lease == nil || lease.Term < rng.Lease.Term) {
+			lease = rng.Lease
+		}
+	}
+	if lease == nil {
+		return nil, nil
+	}
+	nodeID, storeID := storage.DecodeRaftNodeID(multiraft.NodeID(lease.RaftNodeID))
+	for _, rng := range replicas {
+		for i := range rng.Desc.Replicas {
+			if replica := &rng.Desc.Replicas[i]; replica.NodeID == nodeID && replica.StoreID == storeID {
+				return lease, replica
+			}
+		}
+	}
+	return lease, nil
+}
+
+// LookupRange requests the description of the range containing key
+// from the node at the context's address.
+func LookupRange(ctx *Context, key proto.Key) (*status.RangeLookup, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s?key=%s", adminScheme, ctx.Addr, rangeLookupPath,
+		url.QueryEscape(string(key))), nil)
+	if err != nil {
+		return nil, util.Errorf("unable to create request to admin REST endpoint: %s", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	b, err := sendAdminRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	lookup := &status.RangeLookup{}
+	if err := json.Unmarshal(b, lookup); err != nil {
+		return nil, util.Errorf("unable to decode range lookup: %s", err)
+	}
+	return lookup, nil
+}
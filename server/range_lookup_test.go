@@ -0,0 +1,68 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// TestRangeLookup verifies that the range containing a key is found
+// on either side of a split, along with its replica and leader lease.
+func TestRangeLookup(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	if err := s.node.ctx.DB.Run(client.Call{
+		Args: &proto.AdminSplitRequest{
+			RequestHeader: proto.RequestHeader{
+				Key: proto.Key("m"),
+			},
+			SplitKey: proto.Key("m"),
+		},
+		Reply: &proto.AdminSplitResponse{}}); err != nil {
+		t.Fatal(err)
+	}
+	raftIDs := map[int64]bool{}
+	for _, key := range []proto.Key{proto.Key("a"), proto.Key("z")} {
+		// Write to the range so that its leader lease is acquired.
+		if err := s.node.ctx.DB.Run(client.PutCall(key, []byte("value"))); err != nil {
+			t.Fatal(err)
+		}
+		lookup, err := LookupRange(s.Ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !lookup.Key.Equal(key) || !lookup.Desc.ContainsKey(key) {
+			t.Errorf("%s: expected the range containing the key; got %+v", key, lookup.Desc)
+		}
+		if len(lookup.Replicas) != 1 || len(lookup.Errors) != 0 {
+			t.Errorf("%s: expected a single replica; got %+v", key, lookup)
+		}
+		if lookup.Lease == nil || lookup.LeaseHolder == nil || lookup.LeaseHolder.StoreID != 1 {
+			t.Errorf("%s: expected the lease to be held by store 1; got %+v held by %+v",
+				key, lookup.Lease, lookup.LeaseHolder)
+		}
+		raftIDs[lookup.Desc.RaftID] = true
+	}
+	if len(raftIDs) != 2 {
+		t.Errorf("expected the keys to be found in two ranges; got %v", raftIDs)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/ts"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// runtimeSeriesPrefix is the prefix of the names of the time series
+// recorded from runtime samples. Each node is the source of its own
+// samples.
+const runtimeSeriesPrefix = "cr.node.sys."
+
+// readRuntimeStats samples the Go runtime and the process's file
+// descriptors.
+func readRuntimeStats() status.RuntimeStats {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	stats := status.RuntimeStats{
+		Time:       time.Now().UTC(),
+		Goroutines: runtime.NumGoroutine(),
+		CgoCalls:   runtime.NumCgoCall(),
+		Sys:        memStats.Sys,
+		Heap: status.HeapStats{
+			Alloc:    memStats.HeapAlloc,
+			Sys:      memStats.HeapSys,
+			Idle:     memStats.HeapIdle,
+			Inuse:    memStats.HeapInuse,
+			Released: memStats.HeapReleased,
+			Objects:  memStats.HeapObjects,
+		},
+		GC: status.GCStats{
+			NumGC:        memStats.NumGC,
+			PauseTotalNs: memStats.PauseTotalNs,
+		},
+		FileDescriptors: status.FileDescriptors{Open: -1},
+	}
+	if memStats.NumGC > 0 {
+		stats.GC.LastPauseNs = memStats.PauseNs[(memStats.NumGC+255)%256]
+		stats.GC.LastGC = time.Unix(0, int64(memStats.LastGC)).UTC()
+	}
+	// The process's descriptors are listed under /proc/self/fd on
+	// Linux and /dev/fd on other unixes.
+	for _, dir := range []string{"/proc/self/fd", "/dev/fd"} {
+		if fds, err := ioutil.ReadDir(dir); err == nil {
+			stats.FileDescriptors.Open = len(fds)
+			break
+		}
+	}
+	var rlimit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlimit); err == nil {
+		stats.FileDescriptors.Limit = rlimit.Cur
+	}
+	return stats
+}
+
+// runtimeTimeSeries converts a runtime sample into the time series
+// recorded for the supplied source.
+func runtimeTimeSeries(stats status.RuntimeStats, source string) []proto.TimeSeriesData {
+	values := []struct {
+		name  string
+		value int64
+	}{
+		{"goroutines", int64(stats.Goroutines)},
+		{"cgocalls", stats.CgoCalls},
+		{"sys", int64(stats.Sys)},
+		{"heap.alloc", int64(stats.Heap.Alloc)},
+		{"heap.inuse", int64(stats.Heap.Inuse)},
+		{"heap.objects", int64(stats.Heap.Objects)},
+		{"gc.count", int64(stats.GC.NumGC)},
+		{"gc.pause.ns", int64(stats.GC.PauseTotalNs)},
+		{"fd.open", int64(stats.FileDescriptors.Open)},
+	}
+	data := make([]proto.TimeSeriesData, len(values))
+	for i, v := range values {
+		data[i] = proto.TimeSeriesData{
+			Name:   runtimeSeriesPrefix + v.name,
+			Source: source,
+			Datapoints: []*proto.TimeSeriesDatapoint{{
+				TimestampNanos: stats.Time.UnixNano(),
+				IntValue:       gogoproto.Int64(v.value),
+			}},
+		}
+	}
+	return data
+}
+
+// startRuntimeSampler records a runtime sample of the node with the
+// supplied ID to the time series database at each sampling interval
+// of the supplied resolution, until the stopper is stopped.
+func startRuntimeSampler(db *ts.DB, r ts.Resolution, nodeID proto.NodeID, stopper *util.Stopper) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(time.Duration(r.SampleDuration()))
+		defer ticker.Stop()
+		source := nodeID.String()
+		for {
+			select {
+			case <-ticker.C:
+				if !stopper.StartTask() {
+					continue
+				}
+				if err := db.StoreData(r, runtimeTimeSeries(readRuntimeStats(), source)); err != nil {
+					log.Warningf("unable to record runtime sample: %s", err)
+				}
+				stopper.FinishTask()
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// handleRuntimeStatus handles GET requests for a runtime sample of the
+// node serving the request.
+func (s *statusServer) handleRuntimeStatus(w http.ResponseWriter, r *http.Request) {
+	b, contentType, err := util.MarshalResponse(r, readRuntimeStats(), []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
@@ -23,26 +23,40 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"code.google.com/p/snappy-go/snappy"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/migrations"
 	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/resource"
 	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/pgwire"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/audit"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metrics"
+	"github.com/cockroachdb/cockroach/util/trace"
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"golang.org/x/net/context"
 )
 
+// clockJumpCheckInterval is the interval at which the wall clock is
+// checked for jumps.
+const clockJumpCheckInterval = time.Second
+
 var (
 	// Allocation pool for gzip writers.
 	gzipWriterPool sync.Pool
@@ -57,19 +71,41 @@ type Server struct {
 	mux            *http.ServeMux
 	clock          *hlc.Clock
 	rpc            *rpc.Server
+	rpcContext     *rpc.Context
 	gossip         *gossip.Gossip
 	kv             *client.KV
 	kvDB           *kv.DBServer
 	kvREST         *kv.RESTServer
 	node           *Node
+	nodeLiveness   *storage.NodeLiveness
 	admin          *adminServer
 	status         *statusServer
 	structuredDB   structured.DB
 	structuredREST *structured.RESTServer
+	pgServer       *pgwire.Server
+	pgListener     net.Listener
+	tsDB           *ts.DB
+	tsServer       *ts.Server
 	raftTransport  multiraft.Transport
+	tracer         *trace.Tracer
+	faults         *faultHandler // nil unless fault injection is enabled
 	stopper        *util.Stopper
 }
 
+// onClockJump reports a jump of the wall clock beyond the configured
+// tolerance, exiting if so configured. A backward jump may cause this
+// node to hand out timestamps beneath those it has already promised,
+// e.g. through its timestamp cache; a forward jump in excess of the
+// maximum offset may push the clocks of the cluster past it.
+func (s *Server) onClockJump(jump time.Duration) {
+	if s.ctx.FatalOnClockJump {
+		log.Fatalf("wall clock jumped by %s (tolerance %s, max offset %s)",
+			jump, s.ctx.ClockJumpTolerance, s.ctx.MaxOffset)
+	}
+	log.Errorf("wall clock jumped by %s (tolerance %s, max offset %s); "+
+		"consistency guarantees may be violated", jump, s.ctx.ClockJumpTolerance, s.ctx.MaxOffset)
+}
+
 // NewServer creates a Server from a server.Context.
 func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 	if ctx == nil {
@@ -87,27 +123,58 @@ func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 		return nil, err
 	}
 
+	physicalClock := hlc.UnixNano
+	if ctx.PhysicalClock != nil {
+		physicalClock = ctx.PhysicalClock
+	}
+	var faults *faultHandler
+	if ctx.EnableFaultInjection {
+		faults = newFaultHandler(ctx)
+		physicalClock = faults.wrapClock(physicalClock)
+	}
 	s := &Server{
 		ctx:     ctx,
 		mux:     http.NewServeMux(),
-		clock:   hlc.NewClock(hlc.UnixNano),
+		clock:   hlc.NewClock(physicalClock),
+		faults:  faults,
 		stopper: stopper,
 	}
 	s.clock.SetMaxOffset(ctx.MaxOffset)
 
 	rpcContext := rpc.NewContext(s.clock, tlsConfig, stopper)
-	go rpcContext.RemoteClocks.MonitorRemoteOffsets()
+	s.stopper.RunWorker(func() {
+		rpcContext.RemoteClocks.MonitorRemoteOffsets(s.stopper)
+	})
+	if ctx.ClockJumpTolerance > 0 {
+		s.stopper.RunWorker(func() {
+			s.clock.DetectJumps(clockJumpCheckInterval, ctx.ClockJumpTolerance,
+				s.stopper.ShouldStop(), s.onClockJump)
+		})
+	}
 
+	s.rpcContext = rpcContext
 	s.rpc = rpc.NewServer(util.MakeRawAddr("tcp", addr), rpcContext)
 	s.stopper.AddCloser(s.rpc)
+	if faults != nil {
+		faults.rpc = s.rpc
+		s.rpc.SetFaultInjector(faults.injector)
+	}
 	s.gossip = gossip.New(rpcContext, s.ctx.GossipInterval, s.ctx.GossipBootstrapResolvers)
-
-	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.clock}, s.gossip)
+	s.gossip.RegisterMetrics(metrics.Metrics)
+	s.gossip.RegisterCallback(gossip.KeySystemConfig, settingsGossipUpdate)
+
+	s.tracer = trace.NewTracer(0)
+	s.tracer.SetSlowThreshold(ctx.SlowRequestThreshold)
+	ds := kv.NewDistSender(&kv.DistSenderContext{
+		Clock:              s.clock,
+		Tracer:             s.tracer,
+		ClosedTimestampLag: s.ctx.ClosedTimestampLag,
+	}, s.gossip)
 	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, s.stopper)
 	s.kv = client.NewKV(nil, sender)
 	s.kv.User = storage.UserRoot
 
-	s.raftTransport, err = newRPCTransport(s.gossip, s.rpc, rpcContext)
+	s.raftTransport, err = newRPCTransport(s.gossip, s.rpc, rpcContext, ctx.StreamRaft)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +185,7 @@ func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 		s.kvDB.RegisterRPC(s.rpc)
 	}
 	s.kvREST = kv.NewRESTServer(s.kv)
+	s.nodeLiveness = storage.NewNodeLiveness(s.kv, s.clock, storage.DefaultLivenessThreshold)
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
 		Clock:        s.clock,
@@ -125,13 +193,45 @@ func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 		Gossip:       s.gossip,
 		Transport:    s.raftTransport,
 		Context:      context.Background(),
+		Tracer:       s.tracer,
+		NodeLiveness: s.nodeLiveness,
 		ScanInterval: s.ctx.ScanInterval,
+
+		FatalOnInconsistency: s.ctx.FatalOnInconsistency,
+		SyncRaftLog:          s.ctx.SyncRaftLog,
+		SyncApply:            s.ctx.SyncApply,
+
+		MaxIncomingSnapshots:   s.ctx.MaxIncomingSnapshots,
+		MaxOutgoingSnapshots:   s.ctx.MaxOutgoingSnapshots,
+		SnapshotBytesPerSecond: s.ctx.SnapshotBytesPerSecond,
+		QueueRangesPerSecond:   s.ctx.QueueRangesPerSecond,
+
+		CapacityGossipAvailableDelta: s.ctx.CapacityGossipAvailableDelta,
+		CapacityGossipRangeDelta:     s.ctx.CapacityGossipRangeDelta,
+		CapacityGossipMaxStaleness:   s.ctx.CapacityGossipMaxStaleness,
+		ClosedTimestampLag:           s.ctx.ClosedTimestampLag,
+		ValueCompressionThreshold:    s.ctx.ValueCompressionThreshold,
+
+		AdmissionL0Files:         s.ctx.AdmissionL0Files,
+		AdmissionMemtableBytes:   s.ctx.AdmissionMemtableBytes,
+		AdmissionRaftLogEntries:  s.ctx.AdmissionRaftLogEntries,
+		AdmissionWritesPerSecond: s.ctx.AdmissionWritesPerSecond,
 	}
 	s.node = NewNode(nCtx)
-	s.admin = newAdminServer(s.kv, s.stopper)
-	s.status = newStatusServer(s.kv, s.gossip)
+	s.admin = newAdminServer(s.kv, s.stopper, s.gossip, s.tracer, s.ctx.ExportBytesPerSecond)
+	s.status = newStatusServer(s.kv, s.gossip, s.node.lSender, s.kvDB.Latencies(), s.ctx)
+	if err := s.kvDB.RegisterServices(s.rpc); err != nil {
+		return nil, err
+	}
+	if err := s.status.registerServices(s.rpc); err != nil {
+		return nil, err
+	}
 	s.structuredDB = structured.NewDB(s.kv)
 	s.structuredREST = structured.NewRESTServer(s.structuredDB)
+	s.pgServer = pgwire.NewServer(tlsConfig, sql.NewExecutor(s.kv))
+	s.stopper.AddCloser(s.pgServer)
+	s.tsDB = ts.NewDB(s.kv)
+	s.tsServer = ts.NewServer(s.tsDB)
 
 	return s, nil
 }
@@ -154,17 +254,80 @@ func (s *Server) Start(selfBootstrap bool) error {
 	}
 	s.gossip.Start(s.rpc, s.stopper)
 
-	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.NodeAttributes, s.stopper); err != nil {
+	if err := s.startAuditLog(); err != nil {
+		return err
+	}
+
+	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.RaftEngines, s.ctx.NodeAttributes, s.stopper); err != nil {
 		return err
 	}
+	s.nodeLiveness.Start(s.node.Descriptor.NodeID, s.stopper)
+
+	// Upgrade the cluster's on-disk formats before serving clients.
+	mm := migrations.NewManager(s.kv, s.clock, s.node.Descriptor.NodeID, migrations.Registry)
+	if err := mm.EnsureMigrations(s.stopper); err != nil {
+		return err
+	}
+	startRuntimeSampler(s.tsDB, ts.Resolution10s, s.node.Descriptor.NodeID, s.stopper)
 
 	log.Infof("starting https server at %s", s.rpc.Addr())
 	// TODO(spencer): go1.5 is supposed to allow shutdown of running http server.
 	s.initHTTP()
 	s.rpc.Serve(s)
+
+	if s.ctx.PGAddr != "" {
+		ln, err := net.Listen("tcp", s.ctx.PGAddr)
+		if err != nil {
+			return util.Errorf("could not listen on %s: %s", s.ctx.PGAddr, err)
+		}
+		s.pgListener = ln
+		log.Infof("starting postgres server at %s", ln.Addr())
+		// The server is closed by the stopper's closers, which run once
+		// the workers have finished, so it isn't served by a worker.
+		go func() {
+			if err := s.pgServer.Serve(ln); err != nil {
+				log.Errorf("postgres server failed: %s", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// startAuditLog opens the audit log, if one is configured, and sets
+// the key prefixes under which all writes are audited.
+func (s *Server) startAuditLog() error {
+	if s.ctx.AuditLog == "" {
+		return nil
+	}
+	f, err := os.OpenFile(s.ctx.AuditLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return util.Errorf("unable to open audit log: %s", err)
+	}
+	var prefixes []proto.Key
+	for _, prefix := range strings.Split(s.ctx.AuditPrefixes, ",") {
+		if prefix != "" {
+			prefixes = append(prefixes, proto.Key(prefix))
+		}
+	}
+	audit.SetWritePrefixes(prefixes)
+	audit.SetOutput(f)
+	s.stopper.AddCloser(auditLogFile{f})
 	return nil
 }
 
+// auditLogFile is the audit log, closed once the server has stopped.
+type auditLogFile struct {
+	*os.File
+}
+
+// Close implements util.Closer, disabling the audit log.
+func (f auditLogFile) Close() {
+	audit.SetOutput(nil)
+	if err := f.File.Close(); err != nil {
+		log.Errorf("unable to close audit log: %s", err)
+	}
+}
+
 func (s *Server) initHTTP() {
 	s.mux.Handle("/", http.FileServer(
 		&assetfs.AssetFS{Asset: resource.Asset, AssetDir: resource.AssetDir, Prefix: "./ui/"}))
@@ -178,6 +341,10 @@ func (s *Server) initHTTP() {
 	s.mux.Handle(kv.RESTPrefix, s.kvREST)
 	s.mux.Handle(kv.DBPrefix, s.kvDB)
 	s.mux.Handle(structured.StructuredKeyPrefix, s.structuredREST)
+	s.mux.Handle(ts.URLPrefix, s.tsServer)
+	if s.faults != nil {
+		s.mux.Handle(faultsPath, s.faults)
+	}
 }
 
 // Stop stops the server.
@@ -185,6 +352,24 @@ func (s *Server) Stop() {
 	s.stopper.Stop()
 }
 
+// ReloadCertificates reloads the node's certificates and CA from the
+// certs directory. New RPC and HTTP connections, both incoming and
+// outgoing, are handshaked with the reloaded certificates, and client
+// certificates are verified against the reloaded CA; existing
+// connections are unaffected.
+func (s *Server) ReloadCertificates() error {
+	if err := s.ctx.ReloadTLSConfigs(); err != nil {
+		return err
+	}
+	tlsConfig, err := s.ctx.GetServerTLSConfig()
+	if err != nil {
+		return err
+	}
+	s.rpcContext.SetTLSConfig(tlsConfig)
+	log.Infof("reloaded certificates from %s", s.ctx.Certs)
+	return nil
+}
+
 // ServeHTTP is necessary to implement the http.Handler interface. It
 // will snappy a response if the appropriate request headers are set.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -25,16 +25,20 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"code.google.com/p/snappy-go/snappy"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
 )
 
 var testContext = NewTestContext()
@@ -273,7 +277,7 @@ func TestMultiRangeScanDeleteRange(t *testing.T) {
 	}
 	get.Args.Header().User = storage.UserRoot
 	get.Args.Header().EndKey = writes[len(writes)-1]
-	tds.Send(get)
+	tds.Send(context.Background(), get)
 	if err := get.Reply.Header().GoError(); err == nil {
 		t.Errorf("able to call Get with a key range: %v", get)
 	}
@@ -281,7 +285,7 @@ func TestMultiRangeScanDeleteRange(t *testing.T) {
 	for i, k := range writes {
 		call = client.PutCall(k, k)
 		call.Args.Header().User = storage.UserRoot
-		tds.Send(call)
+		tds.Send(context.Background(), call)
 		if err := call.Reply.Header().GoError(); err != nil {
 			t.Fatal(err)
 		}
@@ -290,7 +294,7 @@ func TestMultiRangeScanDeleteRange(t *testing.T) {
 		// so make sure we see their values in our Scan.
 		scan.Args.Header().Timestamp = call.Reply.Header().Timestamp
 		scan.Args.Header().User = storage.UserRoot
-		tds.Send(scan)
+		tds.Send(context.Background(), scan)
 		if err := scan.Reply.Header().GoError(); err != nil {
 			t.Fatal(err)
 		}
@@ -313,7 +317,7 @@ func TestMultiRangeScanDeleteRange(t *testing.T) {
 		},
 		Reply: &proto.DeleteRangeResponse{},
 	}
-	tds.Send(del)
+	tds.Send(context.Background(), del)
 	if err := del.Reply.Header().GoError(); err != nil {
 		t.Fatal(err)
 	}
@@ -329,7 +333,7 @@ func TestMultiRangeScanDeleteRange(t *testing.T) {
 	scan.Args.Header().Timestamp = del.Reply.Header().Timestamp
 	scan.Args.Header().User = storage.UserRoot
 	scan.Args.Header().Txn = &proto.Transaction{Name: "MyTxn"}
-	tds.Send(scan)
+	tds.Send(context.Background(), scan)
 	if err := scan.Reply.Header().GoError(); err != nil {
 		t.Fatal(err)
 	}
@@ -377,7 +381,7 @@ func TestMultiRangeScanWithMaxResults(t *testing.T) {
 		for _, k := range tc.keys {
 			call = client.PutCall(k, k)
 			call.Args.Header().User = storage.UserRoot
-			tds.Send(call)
+			tds.Send(context.Background(), call)
 			if err := call.Reply.Header().GoError(); err != nil {
 				t.Fatal(err)
 			}
@@ -391,7 +395,7 @@ func TestMultiRangeScanWithMaxResults(t *testing.T) {
 					int64(maxResults))
 				scan.Args.Header().Timestamp = call.Reply.Header().Timestamp
 				scan.Args.Header().User = storage.UserRoot
-				tds.Send(scan)
+				tds.Send(context.Background(), scan)
 				if err := scan.Reply.Header().GoError(); err != nil {
 					t.Fatal(err)
 				}
@@ -406,3 +410,201 @@ func TestMultiRangeScanWithMaxResults(t *testing.T) {
 		defer s.Stop()
 	}
 }
+
+// TestMultiRangeScanStream verifies that streamed scans return the
+// rows of all ranges in order, stopping at MaxResults.
+func TestMultiRangeScanStream(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.Clock()}, s.Gossip())
+	tds := kv.NewTxnCoordSender(ds, s.Clock(), testContext.Linearizable, s.stopper)
+
+	for _, sk := range []proto.Key{proto.Key("h"), proto.Key("q")} {
+		if err := s.node.ctx.DB.Run(client.Call{
+			Args: &proto.AdminSplitRequest{
+				RequestHeader: proto.RequestHeader{
+					Key: sk,
+				},
+				SplitKey: sk,
+			},
+			Reply: &proto.AdminSplitResponse{}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	keys := []proto.Key{proto.Key("b"), proto.Key("f"), proto.Key("k"),
+		proto.Key("r"), proto.Key("w"), proto.Key("y")}
+	for _, k := range keys {
+		call := client.PutCall(k, k)
+		call.Args.Header().User = storage.UserRoot
+		tds.Send(context.Background(), call)
+		if err := call.Reply.Header().GoError(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for maxResults := 0; maxResults <= len(keys)+1; maxResults++ {
+		var rows []proto.KeyValue
+		scan := client.ScanCall(keys[0], keys[len(keys)-1].Next(), int64(maxResults))
+		scan.Args.Header().User = storage.UserRoot
+		tds.ScanStream(context.Background(), scan, func(chunk []proto.KeyValue) error {
+			rows = append(rows, chunk...)
+			return nil
+		})
+		if err := scan.Reply.Header().GoError(); err != nil {
+			t.Fatal(err)
+		}
+		expRows := len(keys)
+		if maxResults > 0 && maxResults < expRows {
+			expRows = maxResults
+		}
+		if len(rows) != expRows {
+			t.Fatalf("%d: expected %d rows; got %d", maxResults, expRows, len(rows))
+		}
+		for i, row := range rows {
+			if !row.Key.Equal(keys[i]) {
+				t.Errorf("%d: expected row %d to be %q; got %q", maxResults, i, keys[i], row.Key)
+			}
+		}
+	}
+}
+
+// TestServerRestartStickyEngine verifies that data written to a test
+// server survives restarts, both of the same TestServer and of a new
+// TestServer using the same sticky in-memory engine.
+func TestServerRestartStickyEngine(t *testing.T) {
+	defer CloseStickyInMemEngines()
+	s := &TestServer{StickyEngineID: "restart"}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	key := proto.Key("a")
+	if err := s.kv.Run(client.PutCall(key, []byte("value"))); err != nil {
+		s.Stop()
+		t.Fatal(err)
+	}
+
+	check := func(s *TestServer) {
+		call := client.GetCall(key)
+		if err := s.kv.Run(call); err != nil {
+			t.Fatal(err)
+		}
+		if val := call.Reply.(*proto.GetResponse).Value; val == nil || string(val.Bytes) != "value" {
+			t.Errorf("expected value to survive restart; got %+v", val)
+		}
+	}
+
+	if err := s.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	check(s)
+	addr := s.ServingAddr()
+	s.Stop()
+
+	ctx := NewTestContext()
+	ctx.Addr = addr
+	s = &TestServer{Ctx: ctx, StickyEngineID: "restart"}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	check(s)
+}
+
+// TestServerManualClock verifies that a test server with a manual
+// clock timestamps writes with the manual time, and that it continues
+// to serve after its leader leases are expired.
+func TestServerManualClock(t *testing.T) {
+	manual := hlc.NewManualClock(time.Now().UnixNano())
+	s := &TestServer{Manual: manual}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+
+	for i := 0; i < 2; i++ {
+		start := manual.UnixNano()
+		key := proto.Key(fmt.Sprintf("a%d", i))
+		if err := s.kv.Run(client.PutCall(key, []byte("value"))); err != nil {
+			t.Fatal(err)
+		}
+		call := client.GetCall(key)
+		if err := s.kv.Run(call); err != nil {
+			t.Fatal(err)
+		}
+		val := call.Reply.(*proto.GetResponse).Value
+		// The write may be pushed by logical ticks of the clock, but not
+		// beyond the manual time.
+		if val == nil || val.Timestamp == nil || val.Timestamp.WallTime != start {
+			t.Errorf("%d: expected value written at manual time %d; got %+v", i, start, val)
+		}
+		s.ExpireLeaderLeases()
+	}
+}
+
+// TestServices verifies that the KV, Admin and Status services are
+// served to the clients generated from their definitions.
+func TestServices(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	tlsConfig, err := s.Ctx.GetServerTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), tlsConfig, nil)
+	rpcContext.DisableCache = true
+	c := rpc.NewClient(s.rpc.Addr(), nil, rpcContext)
+	select {
+	case <-c.Ready:
+	case <-c.Closed:
+		t.Fatal("client failed to connect")
+	}
+	defer c.Close()
+
+	kvClient := proto.NewKVClient(c)
+	header := proto.RequestHeader{Key: proto.Key("a"), User: storage.UserRoot}
+	putReply, err := kvClient.Put(&proto.PutRequest{
+		RequestHeader: header,
+		Value:         proto.Value{Bytes: []byte("value")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := putReply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+	getReply, err := kvClient.Get(&proto.GetRequest{RequestHeader: header})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := getReply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+	if getReply.Value == nil || string(getReply.Value.Bytes) != "value" {
+		t.Errorf("expected value %q; got %+v", "value", getReply.Value)
+	}
+
+	adminClient := proto.NewAdminClient(c)
+	splitReply, err := adminClient.AdminSplit(&proto.AdminSplitRequest{
+		RequestHeader: proto.RequestHeader{Key: proto.Key("m"), User: storage.UserRoot},
+		SplitKey:      proto.Key("m"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := splitReply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+
+	statusClient := proto.NewStatusClient(c)
+	details, err := statusClient.Details(&proto.StatusDetailsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.NodeID != s.Gossip().GetNodeID() {
+		t.Errorf("expected node ID %d; got %d", s.Gossip().GetNodeID(), details.NodeID)
+	}
+	if _, err := statusClient.Stores(&proto.StatusStoresRequest{}); err != nil {
+		t.Fatal(err)
+	}
+}
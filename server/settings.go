@@ -0,0 +1,157 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/settings"
+)
+
+// settingsGossipUpdate is a gossip callback which applies the cluster
+// settings of each system config received to this node's registry.
+func settingsGossipUpdate(_ string, val interface{}) {
+	cfg, ok := val.(storage.SystemConfig)
+	if !ok {
+		log.Errorf("gossiped info is not a system config: %+v", val)
+		return
+	}
+	if err := settings.Update(cfg.Settings); err != nil {
+		log.Warning(err)
+	}
+}
+
+// settingInfo describes a setting and the value in effect on the node
+// serving the request.
+type settingInfo struct {
+	Name        string `json:"name" yaml:"name"`
+	Type        string `json:"type" yaml:"type"`
+	Value       string `json:"value" yaml:"value"`
+	Description string `json:"description" yaml:"description"`
+}
+
+func makeSettingInfo(s settings.Setting) settingInfo {
+	return settingInfo{
+		Name:        s.Name(),
+		Type:        s.Typ(),
+		Value:       s.String(),
+		Description: s.Description(),
+	}
+}
+
+// A settingHandler implements the adminHandler interface.
+type settingHandler struct {
+	db *client.KV // Key-value database client
+}
+
+// Put sets the setting named by the path to the value in the input
+// "body". The new value takes effect on each node once gossiped.
+func (sh *settingHandler) Put(path string, body []byte, r *http.Request) error {
+	if len(path) <= 1 {
+		return util.Errorf("no setting specified for Put")
+	}
+	name, value := path[1:], strings.TrimSpace(string(body))
+	if err := settings.Validate(name, value); err != nil {
+		return err
+	}
+	return sh.db.Run(client.PutCall(keys.MakeKey(keys.KeySettingPrefix, proto.Key(name)), []byte(value)))
+}
+
+// Get retrieves the setting named by the path. If the path is empty,
+// all registered settings are listed.
+func (sh *settingHandler) Get(path string, r *http.Request) ([]byte, string, error) {
+	if len(path) <= 1 {
+		var infos []settingInfo
+		for _, s := range settings.All() {
+			infos = append(infos, makeSettingInfo(s))
+		}
+		return util.MarshalResponse(r, infos, util.AllEncodings)
+	}
+	s, ok := settings.Lookup(path[1:])
+	if !ok {
+		return nil, "", util.Errorf("unknown setting %q", path[1:])
+	}
+	return util.MarshalResponse(r, makeSettingInfo(s), util.AllEncodings)
+}
+
+// Delete resets the setting named by the path to its default.
+func (sh *settingHandler) Delete(path string, r *http.Request) error {
+	if len(path) <= 1 {
+		return util.Errorf("no setting specified for Delete")
+	}
+	if _, ok := settings.Lookup(path[1:]); !ok {
+		return util.Errorf("unknown setting %q", path[1:])
+	}
+	return sh.db.Run(client.DeleteCall(keys.MakeKey(keys.KeySettingPrefix, proto.Key(path[1:]))))
+}
+
+// RunLsSettings lists the cluster settings and their values.
+func RunLsSettings(ctx *Context) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s", adminScheme, ctx.Addr, settingsPathPrefix), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	req.Header.Add("Accept", "text/yaml")
+	b, err := sendAdminRequest(ctx, req)
+	if err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", string(b))
+}
+
+// RunSetSetting sets the named cluster setting to value.
+func RunSetSetting(ctx *Context, name, value string) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s://%s%s/%s", adminScheme, ctx.Addr, settingsPathPrefix, name),
+		bytes.NewReader([]byte(value)))
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	req.Header.Add("Content-Type", "text/plain")
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "set setting %q to %q\n", name, value)
+}
+
+// RunResetSetting resets the named cluster setting to its default.
+func RunResetSetting(ctx *Context, name string) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s://%s%s/%s", adminScheme, ctx.Addr, settingsPathPrefix, name), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "reset setting %q\n", name)
+}
@@ -0,0 +1,100 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util/settings"
+)
+
+// TestSettings verifies that settings can be set, listed and reset,
+// and that invalid values and unknown settings are rejected.
+func TestSettings(t *testing.T) {
+	_, stopper := startAdminServer()
+	defer stopper.Stop()
+
+	sendSettingRequest := func(method, path, body string) (string, error) {
+		req, err := http.NewRequest(method, fmt.Sprintf("%s://%s%s%s", adminScheme, testContext.Addr, settingsPathPrefix, path), bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "text/plain")
+		req.Header.Set("Accept", "application/json")
+		b, err := sendAdminRequest(testContext, req)
+		return string(b), err
+	}
+
+	if _, err := sendSettingRequest("POST", "/storage.backpressure.timeout", "10s"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sendSettingRequest("POST", "/storage.backpressure.timeout", "soon"); err == nil {
+		t.Error("expected setting an invalid value to fail")
+	}
+	if _, err := sendSettingRequest("POST", "/storage.unknown", "10s"); err == nil {
+		t.Error("expected setting an unknown setting to fail")
+	}
+	if _, err := sendSettingRequest("POST", "/", "10s"); err == nil {
+		t.Error("expected setting without a name to fail")
+	}
+
+	body, err := sendSettingRequest("GET", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, `"storage.backpressure.timeout"`) {
+		t.Errorf("expected listing to contain setting; got %s", body)
+	}
+	if _, err := sendSettingRequest("GET", "/storage.unknown", ""); err == nil {
+		t.Error("expected getting an unknown setting to fail")
+	}
+
+	if _, err := sendSettingRequest("DELETE", "/storage.backpressure.timeout", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sendSettingRequest("DELETE", "/storage.unknown", ""); err == nil {
+		t.Error("expected resetting an unknown setting to fail")
+	}
+}
+
+// TestSettingsGossipUpdate verifies that the settings of a gossiped
+// system config are applied.
+func TestSettingsGossipUpdate(t *testing.T) {
+	defer settings.Update(nil)
+
+	settingsGossipUpdate("", storage.SystemConfig{
+		Settings: map[string]string{"storage.backpressure.timeout": "10s"},
+	})
+	s, ok := settings.Lookup("storage.backpressure.timeout")
+	if !ok {
+		t.Fatal("expected setting to be registered")
+	}
+	if v := s.String(); v != "10s" {
+		t.Errorf("expected value 10s; got %s", v)
+	}
+
+	settingsGossipUpdate("", storage.SystemConfig{})
+	if v := s.String(); v != "5s" {
+		t.Errorf("expected default value 5s; got %s", v)
+	}
+}
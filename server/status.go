@@ -18,14 +18,25 @@
 package server
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
 )
 
 const (
@@ -51,29 +62,93 @@ const (
 	// statusNodesKeyPrefix exposes status for each of the nodes the cluster.
 	// GETing statusNodesKeyPrefix will list all nodes.
 	// Individual node status can be queried at statusNodesKeyPrefix/NodeID.
-	statusNodesKeyPrefix = statusKeyPrefix + "nodes/"
+	// Clients update their lists of nodes from it.
+	statusNodesKeyPrefix = client.NodesEndpoint
 
 	// statusStoresKeyPrefix exposes status for each store.
 	statusStoresKeyPrefix = statusKeyPrefix + "stores/"
 
 	// statusTransactionsKeyPrefix exposes transaction statistics.
 	statusTransactionsKeyPrefix = statusKeyPrefix + "txns/"
+
+	// statusRangesKeyPrefix exposes the status of the replicas on the
+	// stores of the node serving the request. Individual replicas can
+	// be queried at statusRangesKeyPrefix/RaftID.
+	statusRangesKeyPrefix = statusKeyPrefix + "ranges/"
+
+	// statusRuntimeKey exposes a sample of the Go runtime and process
+	// resources of the node serving the request.
+	statusRuntimeKey = statusKeyPrefix + "runtime"
+
+	// statusProblemRangesKey reports the ranges of the cluster which
+	// are unhealthy, as seen by the nodes holding their replicas.
+	statusProblemRangesKey = statusKeyPrefix + "problemranges"
+
+	// debugRangePath aggregates the status of the range given by the
+	// "id" query parameter from each node holding one of its replicas.
+	debugRangePath = "/_debug/range"
+
+	// rangeLookupPath describes the range containing the key given by
+	// the "key" query parameter, including the status of its replicas.
+	rangeLookupPath = adminEndpoint + "range-lookup"
 )
 
 // A statusServer provides a RESTful status API.
 type statusServer struct {
-	db     *client.KV
-	gossip *gossip.Gossip
+	db        *client.KV
+	gossip    *gossip.Gossip
+	stores    *kv.LocalSender
+	latencies *storage.RequestLatencies
+	ctx       *Context
 }
 
-// newStatusServer allocates and returns a statusServer.
-func newStatusServer(db *client.KV, gossip *gossip.Gossip) *statusServer {
+// newStatusServer allocates and returns a statusServer. The
+// supplied context is used to query the status of other nodes, and
+// latencies are those of the requests served by the node.
+func newStatusServer(db *client.KV, gossip *gossip.Gossip, stores *kv.LocalSender,
+	latencies *storage.RequestLatencies, ctx *Context) *statusServer {
 	return &statusServer{
-		db:     db,
-		gossip: gossip,
+		db:        db,
+		gossip:    gossip,
+		stores:    stores,
+		latencies: latencies,
+		ctx:       ctx,
 	}
 }
 
+// registerServices registers the Status service defined in
+// status.proto, which is called by the clients generated from it.
+func (s *statusServer) registerServices(r proto.Registrar) error {
+	return proto.RegisterStatusServer(r, (*rpcStatusServer)(s))
+}
+
+// rpcStatusServer is a type alias to separate the methods of the
+// Status service from the handlers of the status server.
+type rpcStatusServer statusServer
+
+// Details returns the details of the node.
+func (s *rpcStatusServer) Details(args *proto.StatusDetailsRequest,
+	reply *proto.StatusDetailsResponse) error {
+	reply.NodeID = s.gossip.GetNodeID()
+	reply.BuildTag = util.GetBuildInfo().Tag
+	reply.RequestLatencies = []proto.RequestLatency{}
+	if s.latencies != nil {
+		reply.RequestLatencies = s.latencies.Summaries()
+	}
+	return nil
+}
+
+// Stores returns the statuses most recently recorded by each store.
+func (s *rpcStatusServer) Stores(args *proto.StatusStoresRequest,
+	reply *proto.StatusStoresResponse) error {
+	stores, err := (*statusServer)(s).storeStatuses()
+	if err != nil {
+		return err
+	}
+	reply.Stores = stores
+	return nil
+}
+
 // registerHandlers registers admin handlers with the supplied
 // serve mux.
 func (s *statusServer) registerHandlers(mux *http.ServeMux) {
@@ -84,6 +159,11 @@ func (s *statusServer) registerHandlers(mux *http.ServeMux) {
 	mux.HandleFunc(statusNodesKeyPrefix, s.handleNodeStatus)
 	mux.HandleFunc(statusStoresKeyPrefix, s.handleStoresStatus)
 	mux.HandleFunc(statusTransactionsKeyPrefix, s.handleTransactionStatus)
+	mux.HandleFunc(statusRangesKeyPrefix, s.handleRangesStatus)
+	mux.HandleFunc(statusRuntimeKey, s.handleRuntimeStatus)
+	mux.HandleFunc(statusProblemRangesKey, s.handleProblemRanges)
+	mux.HandleFunc(debugRangePath, s.handleDebugRange)
+	mux.HandleFunc(rangeLookupPath, s.handleRangeLookup)
 }
 
 // handleStatus handles GET requests for cluster status.
@@ -110,12 +190,18 @@ func (s *statusServer) handleGossipStatus(w http.ResponseWriter, r *http.Request
 	w.Write(b)
 }
 
-// handleLocalStatus handles GET requests for local-node status.
+// handleLocalStatus handles GET requests for local-node status,
+// including the latencies of the requests served by the node.
 func (s *statusServer) handleLocalStatus(w http.ResponseWriter, r *http.Request) {
 	local := struct {
-		BuildInfo util.BuildInfo `json:"buildInfo"`
+		BuildInfo        util.BuildInfo         `json:"buildInfo"`
+		RequestLatencies []proto.RequestLatency `json:"requestLatencies"`
 	}{
-		BuildInfo: util.GetBuildInfo(),
+		BuildInfo:        util.GetBuildInfo(),
+		RequestLatencies: []proto.RequestLatency{},
+	}
+	if s.latencies != nil {
+		local.RequestLatencies = s.latencies.Summaries()
 	}
 	b, contentType, err := util.MarshalResponse(r, local, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
@@ -145,11 +231,94 @@ func (s *statusServer) handleLocalStacks(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// handleNodeStatus handles GET requests for node status.
+// nodeSummaries returns summaries of all nodes known via gossip,
+// sorted by node ID. Nodes are known by their gossiped node
+// descriptors and store capacities.
+func (s *statusServer) nodeSummaries() []status.NodeSummary {
+	nodes := map[proto.NodeID]*status.NodeSummary{}
+	addNode := func(desc *gossip.NodeDescriptor) *status.NodeSummary {
+		if n, ok := nodes[desc.NodeID]; ok {
+			return n
+		}
+		n := &status.NodeSummary{
+			ID:     desc.NodeID.String(),
+			Attrs:  desc.Attrs.Attrs,
+			Stores: []status.StoreSummary{},
+		}
+		if desc.Address != nil {
+			n.Addr = desc.Address.String()
+		}
+		nodes[desc.NodeID] = n
+		return n
+	}
+	for _, val := range s.gossip.GetInfosWithPrefix(gossip.KeyNodeIDPrefix) {
+		if desc, ok := val.(*gossip.NodeDescriptor); ok {
+			addNode(desc)
+		}
+	}
+	var stores storeDescriptors
+	for _, val := range s.gossip.GetInfosWithPrefix(gossip.KeyMaxAvailCapacityPrefix) {
+		if descs, ok := val.([]storage.StoreDescriptor); ok {
+			stores = append(stores, descs...)
+		}
+	}
+	sort.Sort(stores)
+	for i := range stores {
+		desc := &stores[i]
+		n := addNode(&desc.Node)
+		n.Stores = append(n.Stores, status.StoreSummary{
+			ID:        desc.StoreID.String(),
+			Attrs:     desc.Attrs.Attrs,
+			Capacity:  desc.Capacity.Capacity,
+			Available: desc.Capacity.Available,
+		})
+	}
+	for nodeID, lastHeard := range s.gossip.LastHeard() {
+		if n, ok := nodes[nodeID]; ok {
+			n.LastHeard = time.Unix(0, lastHeard).UTC()
+		}
+	}
+
+	var nodeIDs []int
+	for nodeID := range nodes {
+		nodeIDs = append(nodeIDs, int(nodeID))
+	}
+	sort.Ints(nodeIDs)
+	summaries := make([]status.NodeSummary, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		summaries[i] = *nodes[proto.NodeID(nodeID)]
+	}
+	return summaries
+}
+
+// storeDescriptors implements sort.Interface, ordering by store ID.
+type storeDescriptors []storage.StoreDescriptor
+
+func (s storeDescriptors) Len() int           { return len(s) }
+func (s storeDescriptors) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s storeDescriptors) Less(i, j int) bool { return s[i].StoreID < s[j].StoreID }
+
+// handleNodeStatus handles GET requests for node status. Unadorned,
+// the URL lists all nodes known via gossip. A node ID following the
+// prefix selects the status of that node alone.
 func (s *statusServer) handleNodeStatus(w http.ResponseWriter, r *http.Request) {
-	// TODO(shawn) parse node-id in path
-	nodes := &status.NodeList{}
-	b, contentType, err := util.MarshalResponse(r, nodes, []util.EncodingType{util.JSONEncoding})
+	var value interface{}
+	nodes := s.nodeSummaries()
+	if id := strings.TrimPrefix(r.URL.Path, statusNodesKeyPrefix); id == "" {
+		value = &status.NodeList{Nodes: nodes}
+	} else {
+		for i := range nodes {
+			if nodes[i].ID == id {
+				value = &nodes[i]
+				break
+			}
+		}
+		if value == nil {
+			http.Error(w, fmt.Sprintf("node %q not found", id), http.StatusNotFound)
+			return
+		}
+	}
+	b, contentType, err := util.MarshalResponse(r, value, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
 		log.Error(err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -159,10 +328,43 @@ func (s *statusServer) handleNodeStatus(w http.ResponseWriter, r *http.Request)
 	w.Write(b)
 }
 
-// handleStoresStatus handles GET requests for store status.
+// handleStoresStatus handles GET requests for store status. The
+// statuses are those most recently recorded by each store at the end
+// of its range scan, ordered by store ID.
 func (s *statusServer) handleStoresStatus(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	w.Write([]byte(`{"stores": []}`))
+	storeStatuses, err := s.storeStatuses()
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stores := &status.StoreList{Stores: storeStatuses}
+	b, contentType, err := util.MarshalResponse(r, stores, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// storeStatuses returns the statuses most recently recorded by each
+// store, ordered by store ID.
+func (s *statusServer) storeStatuses() ([]proto.StoreStatus, error) {
+	call := client.ScanCall(keys.KeyStatusStorePrefix, keys.KeyStatusStorePrefix.PrefixEnd(), 0)
+	if err := s.db.Run(call); err != nil {
+		return nil, err
+	}
+	stores := []proto.StoreStatus{}
+	for _, row := range call.Reply.(*proto.ScanResponse).Rows {
+		var storeStatus proto.StoreStatus
+		if err := gogoproto.Unmarshal(row.Value.Bytes, &storeStatus); err != nil {
+			return nil, err
+		}
+		stores = append(stores, storeStatus)
+	}
+	return stores, nil
 }
 
 // handleTransactionStatus handles GET requests for transaction status.
@@ -170,3 +372,128 @@ func (s *statusServer) handleTransactionStatus(w http.ResponseWriter, r *http.Re
 	w.Header().Set("Content-Type", "application/json")
 	w.Write([]byte(`{"transactions": []}`))
 }
+
+// localRangeStatuses returns the status of each replica on the node's
+// stores, ordered by Raft ID and then store ID. If raftID is non-zero,
+// only replicas of that range are returned.
+func (s *statusServer) localRangeStatuses(raftID int64) []storage.RangeStatus {
+	ranges := []storage.RangeStatus{}
+	if s.stores == nil {
+		return ranges
+	}
+	_ = s.stores.VisitStores(func(store *storage.Store) error {
+		if raftID == 0 {
+			ranges = append(ranges, store.RangeStatuses()...)
+		} else if rangeStatus, err := store.RangeStatus(raftID); err == nil {
+			ranges = append(ranges, rangeStatus)
+		}
+		return nil
+	})
+	sort.Sort(rangeStatuses(ranges))
+	return ranges
+}
+
+// rangeStatuses implements sort.Interface, ordering by Raft ID and
+// then store ID.
+type rangeStatuses []storage.RangeStatus
+
+func (r rangeStatuses) Len() int      { return len(r) }
+func (r rangeStatuses) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r rangeStatuses) Less(i, j int) bool {
+	if r[i].Desc.RaftID != r[j].Desc.RaftID {
+		return r[i].Desc.RaftID < r[j].Desc.RaftID
+	}
+	return r[i].StoreID < r[j].StoreID
+}
+
+// handleRangesStatus handles GET requests for the status of the
+// replicas on this node. Unadorned, the URL lists every replica. A
+// Raft ID following the prefix selects the replicas of that range.
+func (s *statusServer) handleRangesStatus(w http.ResponseWriter, r *http.Request) {
+	var raftID int64
+	if id := strings.TrimPrefix(r.URL.Path, statusRangesKeyPrefix); id != "" {
+		var err error
+		if raftID, err = strconv.ParseInt(id, 10, 64); err != nil || raftID <= 0 {
+			http.Error(w, fmt.Sprintf("invalid raft ID %q", id), http.StatusBadRequest)
+			return
+		}
+	}
+	ranges := &status.RangeList{Ranges: s.localRangeStatuses(raftID)}
+	b, contentType, err := util.MarshalResponse(r, ranges, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// handleDebugRange handles GET requests for the status of all replicas
+// of the range given by the "id" query parameter. Each node known via
+// gossip is asked for its replicas of the range; nodes which cannot be
+// reached are reported alongside the replicas found.
+func (s *statusServer) handleDebugRange(w http.ResponseWriter, r *http.Request) {
+	raftID, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil || raftID <= 0 {
+		http.Error(w, "the \"id\" parameter must be a raft ID", http.StatusBadRequest)
+		return
+	}
+	replicas := &status.RangeReplicas{RaftID: raftID}
+	replicas.Replicas, replicas.Errors = s.clusterRangeStatuses(raftID)
+	b, contentType, err := util.MarshalResponse(r, replicas, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
+// clusterRangeStatuses requests the status of their replicas from
+// each node known via gossip, returning the replicas ordered by Raft
+// ID and then store ID along with the nodes which could not be
+// reached. If raftID is non-zero, only replicas of that range are
+// returned.
+func (s *statusServer) clusterRangeStatuses(raftID int64) ([]storage.RangeStatus, []status.NodeError) {
+	replicas := []storage.RangeStatus{}
+	var errors []status.NodeError
+	for _, node := range s.nodeSummaries() {
+		ranges, err := s.fetchRangeStatuses(node.Addr, raftID)
+		if err != nil {
+			errors = append(errors, status.NodeError{NodeID: node.ID, Error: err.Error()})
+			continue
+		}
+		replicas = append(replicas, ranges...)
+	}
+	sort.Sort(rangeStatuses(replicas))
+	return replicas, errors
+}
+
+// fetchRangeStatuses requests the status of the node's replicas from
+// the node at addr. If raftID is non-zero, only replicas of that range
+// are returned.
+func (s *statusServer) fetchRangeStatuses(addr string, raftID int64) ([]storage.RangeStatus, error) {
+	if addr == "" {
+		return nil, util.Errorf("node address unknown")
+	}
+	path := statusRangesKeyPrefix
+	if raftID != 0 {
+		path += strconv.FormatInt(raftID, 10)
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s", adminScheme, addr, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	b, err := sendAdminRequest(s.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var ranges status.RangeList
+	if err := json.Unmarshal(b, &ranges); err != nil {
+		return nil, util.Errorf("unable to decode range status: %s", err)
+	}
+	return ranges.Ranges, nil
+}
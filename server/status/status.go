@@ -18,6 +18,13 @@
 // Package status defines the data types of cluster-wide and per-node status responses.
 package status
 
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+)
+
 // A Cluster that contains nodes.
 type Cluster struct{}
 
@@ -26,10 +33,120 @@ type NodeList struct {
 	Nodes []NodeSummary `json:"nodes"`
 }
 
-// A NodeSummary contains a summary for a particular node.
+// A NodeSummary contains a summary for a particular node, as known
+// via gossip.
 type NodeSummary struct {
-	ID   string `json:"id"`
-	Addr string `json:"addr"`
+	ID        string         `json:"id"`
+	Addr      string         `json:"addr"`
+	Attrs     []string       `json:"attrs"`
+	LastHeard time.Time      `json:"lastHeard"` // Most recent origination of a gossiped info
+	Stores    []StoreSummary `json:"stores"`
+}
+
+// A StoreSummary contains a summary of a node's store, including its
+// most recently gossiped capacity.
+type StoreSummary struct {
+	ID        string   `json:"id"`
+	Attrs     []string `json:"attrs"`
+	Capacity  int64    `json:"capacity"`
+	Available int64    `json:"available"`
+}
+
+// StoreList contains the most recently recorded status of each store.
+type StoreList struct {
+	Stores []proto.StoreStatus `json:"stores"`
+}
+
+// RangeList contains the status of each replica on a node's stores.
+type RangeList struct {
+	Ranges []storage.RangeStatus `json:"ranges"`
+}
+
+// RangeReplicas aggregates the status of a range's replicas as
+// reported by each node in the cluster. Nodes which could not be
+// reached are listed in Errors.
+type RangeReplicas struct {
+	RaftID   int64                 `json:"raftID"`
+	Replicas []storage.RangeStatus `json:"replicas"`
+	Errors   []NodeError           `json:"errors,omitempty"`
+}
+
+// A RangeLookup describes the range containing Key: its descriptor,
+// the holder of its leader lease and the status of each of its
+// replicas, which includes their recent processing by the queues of
+// their stores. Nodes which could not be reached are listed in Errors.
+type RangeLookup struct {
+	Key  proto.Key             `json:"key"`
+	Desc proto.RangeDescriptor `json:"desc"`
+	// Lease is the most recent leader lease known to any replica, held
+	// by the replica on LeaseHolder.
+	Lease       *proto.Lease          `json:"lease,omitempty"`
+	LeaseHolder *proto.Replica        `json:"leaseHolder,omitempty"`
+	Replicas    []storage.RangeStatus `json:"replicas"`
+	Errors      []NodeError           `json:"errors,omitempty"`
+}
+
+// ProblemRanges lists, by Raft ID, the ranges of the cluster which
+// need an operator's attention. A range appears under each problem it
+// exhibits. Nodes which could not be reached are listed in Errors;
+// their replicas are not considered.
+type ProblemRanges struct {
+	// UnderReplicated ranges have fewer replicas than their zone requires.
+	UnderReplicated []int64 `json:"underReplicated"`
+	// Leaderless ranges have no replica reporting itself as Raft leader.
+	Leaderless []int64 `json:"leaderless"`
+	// Stalled ranges have a replica which cannot make Raft progress.
+	Stalled []int64 `json:"stalled"`
+	// Oversized ranges exceed their zone's maximum range size.
+	Oversized []int64 `json:"oversized"`
+	// PendingSnapshot ranges have a replica awaiting a snapshot.
+	PendingSnapshot []int64     `json:"pendingSnapshot"`
+	Errors          []NodeError `json:"errors,omitempty"`
+}
+
+// A NodeError describes the failure of a request to a node.
+type NodeError struct {
+	NodeID string `json:"nodeID"`
+	Error  string `json:"error"`
+}
+
+// RuntimeStats describes the Go runtime and process resources of a
+// node at the time given.
+type RuntimeStats struct {
+	Time       time.Time `json:"time"`
+	Goroutines int       `json:"goroutines"`
+	CgoCalls   int64     `json:"cgoCalls"`
+	// Sys is the memory obtained from the operating system by the Go
+	// runtime, in bytes. Memory allocated by RocksDB is not included.
+	Sys             uint64          `json:"sys"`
+	Heap            HeapStats       `json:"heap"`
+	GC              GCStats         `json:"gc"`
+	FileDescriptors FileDescriptors `json:"fileDescriptors"`
+}
+
+// HeapStats describes the Go heap, in bytes.
+type HeapStats struct {
+	Alloc    uint64 `json:"alloc"`
+	Sys      uint64 `json:"sys"`
+	Idle     uint64 `json:"idle"`
+	Inuse    uint64 `json:"inuse"`
+	Released uint64 `json:"released"`
+	Objects  uint64 `json:"objects"`
+}
+
+// GCStats describes the garbage collections run since the node started.
+type GCStats struct {
+	NumGC        uint32    `json:"numGC"`
+	PauseTotalNs uint64    `json:"pauseTotalNs"`
+	LastPauseNs  uint64    `json:"lastPauseNs"`
+	LastGC       time.Time `json:"lastGC"`
+}
+
+// FileDescriptors describes the process's open file descriptors. Open
+// is -1 if the count is unavailable on the platform.
+type FileDescriptors struct {
+	Open  int    `json:"open"`
+	Limit uint64 `json:"limit"`
 }
 
 // Node represents an individual node within the cluster.
@@ -27,7 +27,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils"
@@ -45,7 +48,7 @@ func startStatusServer() (*httptest.Server, *util.Stopper) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	status := newStatusServer(db, nil)
+	status := newStatusServer(db, nil, nil, nil, nil)
 	mux := http.NewServeMux()
 	status.registerHandlers(mux)
 	httpServer := httptest.NewTLSServer(mux)
@@ -82,7 +85,9 @@ func TestStatusJson(t *testing.T) {
 
 	testCases := []TestCase{
 		{statusKeyPrefix, "{}"},
-		{statusNodesKeyPrefix, "\"nodes\": null"},
+		{statusNodesKeyPrefix, `"nodes": \[\s*\{\s*"id": "1",\s*"addr": "[^"]+"`},
+		{statusNodesKeyPrefix + "1", `^\{\s*"id": "1",`},
+		{statusRuntimeKey, `"goroutines": [1-9][0-9]*,`},
 	}
 	// Test the /_status/local/stacks endpoint only in a go release branch.
 	if !strings.HasPrefix(runtime.Version(), "devel") {
@@ -92,8 +97,8 @@ func TestStatusJson(t *testing.T) {
     "tag": "",
     "time": "",
     "dependencies": ""
-  }
-}`})
+  },
+  "requestLatencies": \[`})
 	}
 
 	httpClient, err := testutils.NewTestHTTPClient()
@@ -131,6 +136,153 @@ func TestStatusJson(t *testing.T) {
 	}
 }
 
+// TestStatusNodeNotFound verifies that requesting the status of an
+// unknown node returns a not found error.
+func TestStatusNodeNotFound(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := httpClient.Get("https://" + s.ServingAddr() + statusNodesKeyPrefix + "5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status code %d; got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
+
+// TestStatusStoresJson verifies that the store statuses recorded in
+// the datastore are listed, in store ID order, by the stores endpoint.
+func TestStatusStoresJson(t *testing.T) {
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+	db, err := BootstrapCluster("cluster-1", engine.NewInMem(proto.Attributes{}, 1<<20), stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, storeID := range []proto.StoreID{2, 1} {
+		storeStatus := &proto.StoreStatus{StoreID: storeID, NodeID: 1, RangeCount: int32(storeID)}
+		if err := db.Run(client.PutProtoCall(keys.StoreStatusKey(int32(storeID)), storeStatus)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mux := http.NewServeMux()
+	newStatusServer(db, nil, nil, nil, nil).registerHandlers(mux)
+	httpServer := httptest.NewTLSServer(mux)
+	defer httpServer.Close()
+
+	body, err := getText(httpServer.URL + statusStoresKeyPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stores status.StoreList
+	if err := json.Unmarshal(body, &stores); err != nil {
+		t.Fatalf("unable to unmarshal %q: %s", body, err)
+	}
+	if len(stores.Stores) != 2 {
+		t.Fatalf("expected 2 store statuses; got %+v", stores.Stores)
+	}
+	for i, storeStatus := range stores.Stores {
+		if storeID := proto.StoreID(i + 1); storeStatus.StoreID != storeID || storeStatus.RangeCount != int32(storeID) {
+			t.Errorf("%d: expected status of store %d; got %+v", i, storeID, storeStatus)
+		}
+	}
+}
+
+// TestStatusRangesJson verifies that the ranges endpoint lists the
+// replicas on the node's stores and that the range debug endpoint
+// aggregates the replicas of a range from all nodes.
+func TestStatusRangesJson(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	body, err := getText("https://" + s.ServingAddr() + statusRangesKeyPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ranges status.RangeList
+	if err := json.Unmarshal(body, &ranges); err != nil {
+		t.Fatalf("unable to unmarshal %q: %s", body, err)
+	}
+	if len(ranges.Ranges) == 0 || ranges.Ranges[0].Desc.RaftID != 1 {
+		t.Fatalf("expected the first range to be listed; got %+v", ranges.Ranges)
+	}
+	if rng := ranges.Ranges[0]; rng.StoreID != 1 || rng.Raft.Error != "" {
+		t.Errorf("expected the raft status of store 1's replica; got %+v", rng)
+	}
+
+	body, err = getText("https://" + s.ServingAddr() + debugRangePath + "?id=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var replicas status.RangeReplicas
+	if err := json.Unmarshal(body, &replicas); err != nil {
+		t.Fatalf("unable to unmarshal %q: %s", body, err)
+	}
+	if replicas.RaftID != 1 || len(replicas.Replicas) != 1 || len(replicas.Errors) != 0 {
+		t.Errorf("expected the single replica of range 1; got %+v", replicas)
+	}
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{statusRangesKeyPrefix + "x", debugRangePath + "?id=0"} {
+		resp, err := httpClient.Get("https://" + s.ServingAddr() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d; got %d", path, http.StatusBadRequest, resp.StatusCode)
+		}
+	}
+}
+
+// TestStatusLocalRequestLatencies verifies that the local status
+// reports the latencies of the requests served by the node.
+func TestStatusLocalRequestLatencies(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kv := client.NewKV(nil, client.NewHTTPSender(s.ServingAddr(), httpClient))
+	kv.User = storage.UserRoot
+	for i := 0; i < 3; i++ {
+		if err := kv.Run(client.PutCall(proto.Key("a"), []byte("value"))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	body, err := getText("https://" + s.ServingAddr() + statusLocalKeyPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var local struct {
+		RequestLatencies []proto.RequestLatency `json:"requestLatencies"`
+	}
+	if err := json.Unmarshal(body, &local); err != nil {
+		t.Fatalf("unable to unmarshal %q: %s", body, err)
+	}
+	for _, l := range local.RequestLatencies {
+		if l.Method == "Put" {
+			if l.Count != 3 || l.Max <= 0 || l.P50 > l.Max {
+				t.Errorf("unexpected latencies of puts: %+v", l)
+			}
+			return
+		}
+	}
+	t.Errorf("expected latencies of puts; got %+v", local.RequestLatencies)
+}
+
 // TestStatusGossipJson ensures that the output response for the full gossip
 // info contains the required fields.
 func TestStatusGossipJson(t *testing.T) {
@@ -143,8 +295,8 @@ func TestStatusGossipJson(t *testing.T) {
 	}
 
 	type rangeDescriptorInfo struct {
-		Key string                `json:"Key"`
-		Val proto.RangeDescriptor `json:"Val"`
+		Key string                       `json:"Key"`
+		Val storage.FirstRangeDescriptor `json:"Val"`
 	}
 
 	type keyValueStringPair struct {
@@ -18,9 +18,12 @@
 package server
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/storage"
@@ -44,6 +47,41 @@ func StartTestServer(t *testing.T) *TestServer {
 	return s
 }
 
+// stickyEngines holds the in-memory engines retained across test
+// server restarts, keyed by ID.
+var stickyEngines = struct {
+	sync.Mutex
+	engines map[string]*engine.InMem
+}{engines: map[string]*engine.InMem{}}
+
+// GetStickyInMemEngine returns the in-memory engine registered with
+// the specified ID, creating it if none is. The engine outlives the
+// servers using it, so that a TestServer stopped and started again
+// with the same StickyEngineID recovers its data. The engine is closed
+// by CloseStickyInMemEngines.
+func GetStickyInMemEngine(id string) engine.Engine {
+	stickyEngines.Lock()
+	defer stickyEngines.Unlock()
+	e, ok := stickyEngines.engines[id]
+	if !ok {
+		e = engine.NewInMem(proto.Attributes{}, 100<<20)
+		stickyEngines.engines[id] = e
+	}
+	return e
+}
+
+// CloseStickyInMemEngines closes and unregisters all sticky in-memory
+// engines. Tests using sticky engines should defer a call once all of
+// their servers have been stopped.
+func CloseStickyInMemEngines() {
+	stickyEngines.Lock()
+	defer stickyEngines.Unlock()
+	for id, e := range stickyEngines.engines {
+		e.Close()
+		delete(stickyEngines.engines, id)
+	}
+}
+
 // NewTestContext returns a context for testing. It overrides the
 // Certs with the test certs directory.
 // We need to override the certs loader.
@@ -65,6 +103,8 @@ func NewTestContext() *Context {
 	// Start() to an available port.
 	// Call TestServer.ServingAddr() for the full address (including bound port).
 	ctx.Addr = "127.0.0.1:0"
+	// Likewise for the Postgres endpoint; see TestServer.PGAddr().
+	ctx.PGAddr = "127.0.0.1:0"
 	return ctx
 }
 
@@ -82,6 +122,12 @@ type TestServer struct {
 	// Ctx is the context used by this server.
 	Ctx           *Context
 	SkipBootstrap bool
+	// StickyEngineID, if set, selects the sticky in-memory engine used
+	// by the server in place of a new one. See GetStickyInMemEngine.
+	StickyEngineID string
+	// Manual, if set, supplies the wall time of the server's clock,
+	// which then only advances on calls to AdvanceClock.
+	Manual *hlc.ManualClock
 	// server is the embedded Cockroach server struct.
 	*Server
 	// Engine underlying the test server.
@@ -105,14 +151,19 @@ func (ts *TestServer) Clock() *hlc.Clock {
 }
 
 // Start starts the TestServer by bootstrapping an in-memory store
-// (defaults to maximum of 100M). The server is started, launching the
-// node RPC server and all HTTP endpoints. Use the value of
+// (defaults to maximum of 100M). An engine which has already been
+// bootstrapped, as when the server is restarted, is started as is.
+// The server is started, launching the node RPC server and all HTTP
+// endpoints. Use the value of
 // TestServer.ServingAddr() after Start() for client connections. Use Stop()
 // to shutdown the server after the test completes.
 func (ts *TestServer) Start() error {
 	if ts.Ctx == nil {
 		ts.Ctx = NewTestContext()
 	}
+	if ts.Manual != nil {
+		ts.Ctx.PhysicalClock = ts.Manual.UnixNano
+	}
 
 	var err error
 	ts.Server, err = NewServer(ts.Ctx, util.NewStopper())
@@ -121,10 +172,19 @@ func (ts *TestServer) Start() error {
 	}
 
 	if ts.Engine == nil {
-		ts.Engine = engine.NewInMem(proto.Attributes{}, 100<<20)
+		if ts.StickyEngineID != "" {
+			ts.Engine = GetStickyInMemEngine(ts.StickyEngineID)
+		} else {
+			ts.Engine = engine.NewInMem(proto.Attributes{}, 100<<20)
+		}
 	}
 	ts.Ctx.Engines = []engine.Engine{ts.Engine}
-	if !ts.SkipBootstrap {
+	bootstrapped, err := engine.MVCCGetProto(ts.Engine, keys.StoreIdentKey(), proto.ZeroTimestamp,
+		true, nil, &proto.StoreIdent{})
+	if err != nil {
+		return util.Errorf("could not read store ident: %s", err)
+	}
+	if !ts.SkipBootstrap && !bootstrapped {
 		stopper := util.NewStopper()
 		_, err := BootstrapCluster("cluster-1", ts.Engine, stopper)
 		if err != nil {
@@ -145,11 +205,49 @@ func (ts *TestServer) ServingAddr() string {
 	return ts.rpc.Addr().String()
 }
 
+// PGAddr returns the address of the server's Postgres endpoint.
+func (ts *TestServer) PGAddr() string {
+	return ts.pgListener.Addr().String()
+}
+
 // Stop stops the TestServer.
 func (ts *TestServer) Stop() {
 	ts.Server.Stop()
 }
 
+// Restart stops the TestServer and starts it again with the same
+// engine and address, as a node restarted after a crash would be.
+func (ts *TestServer) Restart() error {
+	addr := ts.ServingAddr()
+	ts.Stop()
+	ts.Ctx.Addr = addr
+	return ts.Start()
+}
+
+// AdvanceClock advances the server's manual clock by the duration and
+// enqueues all ranges with the GC and replicate queues, so that effects
+// of the passage of time, such as values becoming eligible for GC,
+// take place without waiting for the range scanner. Leader leases
+// which the advance expires are replaced on the next command to their
+// ranges.
+func (ts *TestServer) AdvanceClock(d time.Duration) {
+	if ts.Manual == nil {
+		log.Fatal("test server has no manual clock to advance")
+	}
+	ts.Manual.Increment(d.Nanoseconds())
+	ts.node.lSender.VisitStores(func(s *storage.Store) error {
+		s.ForceGCScan()
+		s.ForceReplicationScan()
+		return nil
+	})
+}
+
+// ExpireLeaderLeases advances the server's manual clock past the
+// duration of leader leases, expiring the leases of all ranges.
+func (ts *TestServer) ExpireLeaderLeases() {
+	ts.AdvanceClock(storage.DefaultLeaderLeaseDuration + 1)
+}
+
 // SetRangeRetryOptions sets the retry options for stores in TestServer.
 func (ts *TestServer) SetRangeRetryOptions(ro util.RetryOptions) {
 	ts.node.lSender.VisitStores(func(s *storage.Store) error {
@@ -0,0 +1,54 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// A userHandler implements the adminHandler interface.
+type userHandler struct {
+	db *client.KV // Key-value database client
+}
+
+// Put adds the user named by the path to the system users table. The
+// user config is parsed from the input "body".
+func (uh *userHandler) Put(path string, body []byte, r *http.Request) error {
+	if path == "/" {
+		return util.Errorf("no user specified for Put")
+	}
+	return putConfig(uh.db, keys.KeyUserPrefix, &proto.UserConfig{},
+		path, body, r, nil)
+}
+
+// Get retrieves the user config for the user named by the path. If
+// the path is empty, all users are listed.
+func (uh *userHandler) Get(path string, r *http.Request) ([]byte, string, error) {
+	return getConfig(uh.db, keys.KeyUserPrefix, &proto.UserConfig{}, path, r)
+}
+
+// Delete removes the user named by the path from the system users
+// table.
+func (uh *userHandler) Delete(path string, r *http.Request) error {
+	return deleteConfig(uh.db, keys.KeyUserPrefix, path, r)
+}
@@ -0,0 +1,69 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestUsers verifies that users can be added to, listed and removed
+// from the system users table.
+func TestUsers(t *testing.T) {
+	_, stopper := startAdminServer()
+	defer stopper.Stop()
+
+	sendUserRequest := func(method, path, body string) (string, error) {
+		req, err := http.NewRequest(method, fmt.Sprintf("%s://%s%s%s", adminScheme, testContext.Addr, usersPathPrefix, path), bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		b, err := sendAdminRequest(testContext, req)
+		return string(b), err
+	}
+
+	for _, user := range []string{"alice", "bob"} {
+		if _, err := sendUserRequest("POST", "/"+user, "{}"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := sendUserRequest("POST", "/", "{}"); err == nil {
+		t.Error("expected adding a user without a name to fail")
+	}
+	if _, err := sendUserRequest("GET", "/alice", ""); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := sendUserRequest("DELETE", "/alice", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sendUserRequest("GET", "/alice", ""); err == nil {
+		t.Error("expected removed user to be absent")
+	}
+	body, err := sendUserRequest("GET", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expBody := "[\n  \"bob\"\n]"; body != expBody {
+		t.Errorf("expected %q; got %q", expBody, body)
+	}
+}
@@ -21,8 +21,9 @@ import (
 	"net/http"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	gogoproto "github.com/gogo/protobuf/proto"
 )
@@ -30,11 +31,16 @@ import (
 const (
 	// minRangeMaxBytes is the minimum value for range max bytes.
 	minRangeMaxBytes = 1 << 20
+	// minGCTTLSeconds is the minimum value for a GC policy's TTL other
+	// than zero, which disables GC. Shorter TTLs would collect values
+	// still being read by long-running transactions and backups.
+	minGCTTLSeconds = 10 * 60
 )
 
 // A zoneHandler implements the adminHandler interface.
 type zoneHandler struct {
-	db *client.KV // Key-value database client
+	db     *client.KV     // Key-value database client
+	gossip *gossip.Gossip // Gossip instance for the cluster size; may be nil
 }
 
 // validateZoneConfig returns an error if a given zone config is invalid.
@@ -43,6 +49,14 @@ func validateZoneConfig(config gogoproto.Message) error {
 	if len(zConfig.ReplicaAttrs) == 0 {
 		return util.Errorf("attributes for at least one replica must be specified in zone config")
 	}
+	if prohibited := zConfig.ProhibitedAttrs; prohibited != nil {
+		for _, attrs := range zConfig.ReplicaAttrs {
+			if !attrs.IsDisjoint(*prohibited) {
+				return util.Errorf("replica attributes %s conflict with prohibited attributes %s",
+					attrs.SortedString(), prohibited.SortedString())
+			}
+		}
+	}
 	if zConfig.RangeMaxBytes < minRangeMaxBytes {
 		return util.Errorf("RangeMaxBytes %d less than minimum allowed %d", zConfig.RangeMaxBytes, minRangeMaxBytes)
 	}
@@ -50,6 +64,39 @@ func validateZoneConfig(config gogoproto.Message) error {
 		return util.Errorf("RangeMinBytes %d is greater than or equal to RangeMaxBytes %d",
 			zConfig.RangeMinBytes, zConfig.RangeMaxBytes)
 	}
+	if m := zConfig.GetRangeBackpressureMultiple(); m > 0 && m < 1 {
+		return util.Errorf("RangeBackpressureMultiple %g less than 1; writes would be held back before ranges split", m)
+	}
+	if gc := zConfig.GC; gc != nil {
+		if gc.TTLSeconds < 0 {
+			return util.Errorf("GC TTLSeconds %d is negative; specify 0 to disable GC", gc.TTLSeconds)
+		}
+		if gc.TTLSeconds > 0 && gc.TTLSeconds < minGCTTLSeconds {
+			return util.Errorf("GC TTLSeconds %d less than minimum allowed %d", gc.TTLSeconds, minGCTTLSeconds)
+		}
+	}
+	return nil
+}
+
+// validate returns an error if a given zone config is invalid or
+// specifies more replicas than there are nodes known to gossip, as no
+// two replicas of a range are placed on the same node.
+func (zh *zoneHandler) validate(config gogoproto.Message) error {
+	if err := validateZoneConfig(config); err != nil {
+		return err
+	}
+	if zh.gossip == nil {
+		return nil
+	}
+	nodes := 0
+	for _, val := range zh.gossip.GetInfosWithPrefix(gossip.KeyNodeIDPrefix) {
+		if _, ok := val.(*gossip.NodeDescriptor); ok {
+			nodes++
+		}
+	}
+	if replicas := len(config.(*proto.ZoneConfig).ReplicaAttrs); nodes > 0 && replicas > nodes {
+		return util.Errorf("zone config specifies %d replicas, but the cluster has only %d nodes", replicas, nodes)
+	}
 	return nil
 }
 
@@ -58,8 +105,8 @@ func validateZoneConfig(config gogoproto.Message) error {
 // "body". The specified body must validly parse into a zone config
 // struct.
 func (zh *zoneHandler) Put(path string, body []byte, r *http.Request) error {
-	return putConfig(zh.db, engine.KeyConfigZonePrefix, &proto.ZoneConfig{},
-		path, body, r, validateZoneConfig)
+	return putConfig(zh.db, keys.KeyConfigZonePrefix, &proto.ZoneConfig{},
+		path, body, r, zh.validate)
 }
 
 // Get retrieves the zone configuration for the specified key. If the
@@ -71,10 +118,10 @@ func (zh *zoneHandler) Put(path string, body []byte, r *http.Request) error {
 // JSON-formatted output for a listing of keys and JSON-formatted
 // output for retrieval of a zone config.
 func (zh *zoneHandler) Get(path string, r *http.Request) (body []byte, contentType string, err error) {
-	return getConfig(zh.db, engine.KeyConfigZonePrefix, &proto.ZoneConfig{}, path, r)
+	return getConfig(zh.db, keys.KeyConfigZonePrefix, &proto.ZoneConfig{}, path, r)
 }
 
 // Delete removes the zone config specified by key.
 func (zh *zoneHandler) Delete(path string, r *http.Request) error {
-	return deleteConfig(zh.db, engine.KeyConfigZonePrefix, path, r)
+	return deleteConfig(zh.db, keys.KeyConfigZonePrefix, path, r)
 }
@@ -25,8 +25,8 @@ import (
 	"net/url"
 	"os"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	yaml "gopkg.in/yaml.v1"
 )
 
@@ -52,7 +52,7 @@ func ExampleSetAndGetZone() {
 		prefix proto.Key
 		yaml   string
 	}{
-		{engine.KeyMin, testZoneConfig},
+		{keys.KeyMin, testZoneConfig},
 		{proto.Key("db1"), testZoneConfig},
 		{proto.Key("db 2"), testZoneConfig},
 		{proto.Key("\xfe"), testZoneConfig},
@@ -112,7 +112,7 @@ func ExampleLsZones() {
 	defer os.Remove(testConfigFn)
 
 	keys := []proto.Key{
-		engine.KeyMin,
+		keys.KeyMin,
 		proto.Key("db1"),
 		proto.Key("db2"),
 		proto.Key("db3"),
@@ -171,7 +171,7 @@ func ExampleRmZones() {
 	defer os.Remove(testConfigFn)
 
 	keys := []proto.Key{
-		engine.KeyMin,
+		keys.KeyMin,
 		proto.Key("db1"),
 	}
 
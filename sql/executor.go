@@ -0,0 +1,368 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// An Executor executes SQL statements against the tables of the
+// structured data layer. Databases are structured schemas, identified
+// by their keys, and tables are identified by name. Only the
+// statements supported by the structured layer are executed: USE,
+// SHOW TABLES, INSERT, and SELECT of a single row by primary key.
+type Executor struct {
+	kv *client.KV
+}
+
+// NewExecutor creates an Executor for the tables of the structured
+// data layer of the supplied KV database. The statements of each
+// session are executed through a copy of kv whose user is the
+// session's, so that they're subject to the user's permissions.
+func NewExecutor(kv *client.KV) *Executor {
+	return &Executor{kv: kv}
+}
+
+// A Session holds the state of a client connection across the
+// statements it executes.
+type Session struct {
+	// User is the user on whose behalf the statements are executed.
+	User string
+	// Database is the key of the schema in which tables are resolved.
+	Database string
+
+	db structured.DB // Structured database of User, made on first use
+}
+
+// sessionDB returns the structured database through which the
+// session's statements are executed.
+func (e *Executor) sessionDB(session *Session) structured.DB {
+	if session.db == nil {
+		kv := *e.kv
+		kv.User = session.User
+		session.db = structured.NewDB(&kv)
+	}
+	return session.db
+}
+
+// A Column describes a column of a result by its name and structured
+// column type (see structured.Column).
+type Column struct {
+	Name string
+	Type string
+}
+
+// A Result is the outcome of a statement. Statements which don't
+// return rows have no columns.
+type Result struct {
+	Columns []Column
+	// Rows holds the values of each row, indexed like Columns. Values
+	// are of the Go types of the columns' structured types; nil values
+	// are null.
+	Rows [][]interface{}
+	// Tag is the command tag describing the statement, e.g. "INSERT 0 2"
+	// or "SELECT 1".
+	Tag string
+}
+
+// Execute parses and executes the query in the supplied session.
+func (e *Executor) Execute(session *Session, query string) (Result, error) {
+	stmt, err := parser.Parse(strings.TrimRight(strings.TrimSpace(query), ";"))
+	if err != nil {
+		return Result{}, err
+	}
+	switch stmt := stmt.(type) {
+	case *parser.Use:
+		return e.use(session, stmt)
+	case *parser.DDL:
+		if stmt.Action == "SHOW TABLES" {
+			return e.showTables(session)
+		}
+	case *parser.Insert:
+		return e.insert(session, stmt)
+	case *parser.Select:
+		return e.selectRow(session, stmt)
+	}
+	return Result{}, util.Errorf("unsupported statement: %s", stmt)
+}
+
+// use sets the session's database.
+func (e *Executor) use(session *Session, stmt *parser.Use) (Result, error) {
+	s, err := e.sessionDB(session).GetSchema(stmt.Name)
+	if err != nil {
+		return Result{}, err
+	}
+	if s == nil {
+		return Result{}, util.Errorf("database %q does not exist", stmt.Name)
+	}
+	session.Database = stmt.Name
+	return Result{Tag: "SET"}, nil
+}
+
+// showTables returns the names of the tables of the session's
+// database.
+func (e *Executor) showTables(session *Session) (Result, error) {
+	s, err := e.getSchema(session)
+	if err != nil {
+		return Result{}, err
+	}
+	var names []string
+	for _, t := range s.Tables {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	result := Result{Columns: []Column{{Name: "Table", Type: "string"}}}
+	for _, name := range names {
+		result.Rows = append(result.Rows, []interface{}{name})
+	}
+	result.Tag = fmt.Sprintf("SELECT %d", len(result.Rows))
+	return result, nil
+}
+
+// insert inserts each of the statement's rows. The rows of a statement
+// are inserted independently, so a failure leaves the preceding rows
+// inserted.
+func (e *Executor) insert(session *Session, stmt *parser.Insert) (Result, error) {
+	t, err := e.getTable(session, stmt.Table)
+	if err != nil {
+		return Result{}, err
+	}
+	values, ok := stmt.Rows.(parser.Values)
+	if !ok {
+		return Result{}, util.Errorf("unsupported insert rows: %s", stmt)
+	}
+	var cols []*structured.Column
+	if len(stmt.Columns) == 0 {
+		cols = t.Columns
+	}
+	for _, expr := range stmt.Columns {
+		c, err := findSelectColumn(t, expr)
+		if err != nil {
+			return Result{}, err
+		}
+		cols = append(cols, c)
+	}
+	for _, tuple := range values {
+		vals, ok := tuple.(parser.ValTuple)
+		if !ok || len(vals) != len(cols) {
+			return Result{}, util.Errorf("expected %d values; got %s", len(cols), tuple)
+		}
+		row := structured.Row{}
+		for i, c := range cols {
+			if row[c.Name], err = parseValue(c, vals[i]); err != nil {
+				return Result{}, err
+			}
+		}
+		if err := e.sessionDB(session).Insert(session.Database, t.Name, row); err != nil {
+			return Result{}, err
+		}
+	}
+	return Result{Tag: fmt.Sprintf("INSERT 0 %d", len(values))}, nil
+}
+
+// selectRow returns the row of a single table selected by equality
+// conditions on each of the table's primary key columns.
+func (e *Executor) selectRow(session *Session, stmt *parser.Select) (Result, error) {
+	if len(stmt.From) != 1 {
+		return Result{}, util.Errorf("unsupported select: %s", stmt)
+	}
+	from, ok := stmt.From[0].(*parser.AliasedTableExpr)
+	if !ok {
+		return Result{}, util.Errorf("unsupported select: %s", stmt)
+	}
+	name, ok := from.Expr.(*parser.TableName)
+	if !ok {
+		return Result{}, util.Errorf("unsupported select: %s", stmt)
+	}
+	t, err := e.getTable(session, name)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var cols []*structured.Column
+	for _, expr := range stmt.Exprs {
+		switch expr := expr.(type) {
+		case *parser.StarExpr:
+			cols = append(cols, t.Columns...)
+		case *parser.NonStarExpr:
+			c, err := findSelectColumn(t, expr)
+			if err != nil {
+				return Result{}, err
+			}
+			cols = append(cols, c)
+		}
+	}
+
+	if stmt.Where == nil {
+		return Result{}, util.Errorf("select requires a WHERE clause on the primary key")
+	}
+	key := structured.Row{}
+	if err := addKeyConditions(t, stmt.Where.Expr, key); err != nil {
+		return Result{}, err
+	}
+	for _, c := range t.Columns {
+		if _, ok := key[c.Name]; c.PrimaryKey && !ok {
+			return Result{}, util.Errorf("select requires a condition on primary key column %q", c.Name)
+		}
+	}
+	row, err := e.sessionDB(session).Select(session.Database, t.Name, key)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{}
+	for _, c := range cols {
+		result.Columns = append(result.Columns, Column{Name: c.Name, Type: c.Type})
+	}
+	if row != nil {
+		vals := make([]interface{}, len(cols))
+		for i, c := range cols {
+			vals[i] = row[c.Name]
+		}
+		result.Rows = append(result.Rows, vals)
+	}
+	result.Tag = fmt.Sprintf("SELECT %d", len(result.Rows))
+	return result, nil
+}
+
+// addKeyConditions adds the values of the equality conditions on
+// primary key columns joined by AND in expr to key.
+func addKeyConditions(t *structured.Table, expr parser.BoolExpr, key structured.Row) error {
+	switch expr := expr.(type) {
+	case *parser.AndExpr:
+		if err := addKeyConditions(t, expr.Left, key); err != nil {
+			return err
+		}
+		return addKeyConditions(t, expr.Right, key)
+	case *parser.ParenBoolExpr:
+		return addKeyConditions(t, expr.Expr, key)
+	case *parser.ComparisonExpr:
+		col, val := expr.Left, expr.Right
+		if _, ok := col.(*parser.ColName); !ok {
+			col, val = val, col
+		}
+		if name, ok := col.(*parser.ColName); ok && expr.Operator == "=" {
+			c, err := findColumn(t, name.Name)
+			if err != nil {
+				return err
+			}
+			if !c.PrimaryKey {
+				return util.Errorf("conditions are only supported on primary key columns, not %q", c.Name)
+			}
+			if key[c.Name], err = parseValue(c, val); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+	return util.Errorf("unsupported condition: %s", expr)
+}
+
+// getSchema returns the schema of the session's database.
+func (e *Executor) getSchema(session *Session) (*structured.Schema, error) {
+	if session.Database == "" {
+		return nil, util.Errorf("no database specified")
+	}
+	s, err := e.sessionDB(session).GetSchema(session.Database)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, util.Errorf("database %q does not exist", session.Database)
+	}
+	return s, nil
+}
+
+// getTable returns the named table, which must be in the session's
+// database if qualified. Names are matched without regard to case.
+func (e *Executor) getTable(session *Session, name *parser.TableName) (*structured.Table, error) {
+	if name.Qualifier != "" && name.Qualifier != session.Database {
+		return nil, util.Errorf("table %s is not in the current database %q", name, session.Database)
+	}
+	s, err := e.getSchema(session)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range s.Tables {
+		if strings.EqualFold(t.Name, name.Name) {
+			return t, nil
+		}
+	}
+	return nil, util.Errorf("table %q does not exist", name.Name)
+}
+
+// findColumn returns the table's column with the given name. As the
+// parser folds identifiers to lower case, names are matched without
+// regard to case.
+func findColumn(t *structured.Table, name string) (*structured.Column, error) {
+	for _, c := range t.Columns {
+		if strings.EqualFold(c.Name, name) {
+			return c, nil
+		}
+	}
+	return nil, util.Errorf("column %q does not exist", name)
+}
+
+// findSelectColumn returns the table's column named by expr, which
+// must be an unqualified column name.
+func findSelectColumn(t *structured.Table, expr parser.SelectExpr) (*structured.Column, error) {
+	if e, ok := expr.(*parser.NonStarExpr); ok {
+		if name, ok := e.Expr.(*parser.ColName); ok {
+			return findColumn(t, name.Name)
+		}
+	}
+	return nil, util.Errorf("unsupported column expression: %s", expr)
+}
+
+// parseValue converts the literal to a value of the column's type. Time
+// values are given as RFC 3339 strings.
+func parseValue(c *structured.Column, expr parser.ValExpr) (interface{}, error) {
+	switch v := expr.(type) {
+	case *parser.NullVal:
+		if c.PrimaryKey {
+			return nil, util.Errorf("primary key column %q may not be null", c.Name)
+		}
+		return nil, nil
+	case parser.NumVal:
+		switch c.Type {
+		case "integer":
+			return strconv.ParseInt(string(v), 10, 64)
+		case "float":
+			return strconv.ParseFloat(string(v), 64)
+		}
+	case parser.StrVal:
+		switch c.Type {
+		case "string":
+			return string(v), nil
+		case "blob":
+			return []byte(v), nil
+		case "time":
+			return time.Parse(time.RFC3339Nano, string(v))
+		}
+	}
+	return nil, util.Errorf("column %q: %s is not a valid value of type %q", c.Name, expr, c.Type)
+}
@@ -0,0 +1,160 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package sql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+	"golang.org/x/net/context"
+)
+
+// newTestExecutor returns an executor for a bootstrapped cluster with
+// a "pdb" schema holding a User table.
+func newTestExecutor(t *testing.T, stopper *util.Stopper) *sql.Executor {
+	localDB, err := server.BootstrapCluster("test-cluster", engine.NewInMem(proto.Attributes{}, 1<<20), stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := structured.NewDB(localDB)
+	if err := db.PutSchema(&structured.Schema{Name: "PhotoDB", Key: "pdb"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable("pdb", &structured.Table{
+		Name: "User",
+		Key:  "us",
+		Columns: []*structured.Column{
+			{Name: "ID", Key: "id", Type: "integer", PrimaryKey: true},
+			{Name: "Name", Key: "na", Type: "string"},
+			{Name: "Score", Key: "sc", Type: "float", Family: "s"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return sql.NewExecutor(localDB)
+}
+
+// TestExecutor verifies the execution of the supported statements and
+// the rejection of malformed and unsupported ones.
+func TestExecutor(t *testing.T) {
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+	e := newTestExecutor(t, stopper)
+	session := &sql.Session{User: "root"}
+
+	if _, err := e.Execute(session, "SHOW TABLES"); err == nil {
+		t.Error("expected SHOW TABLES without a database to fail")
+	}
+	if _, err := e.Execute(session, "USE xyz"); err == nil {
+		t.Error("expected USE of a missing database to fail")
+	}
+
+	testCases := []struct {
+		query string
+		exp   sql.Result
+	}{
+		{"USE pdb", sql.Result{Tag: "SET"}},
+		{"SHOW TABLES;", sql.Result{
+			Columns: []sql.Column{{Name: "Table", Type: "string"}},
+			Rows:    [][]interface{}{{"User"}},
+			Tag:     "SELECT 1",
+		}},
+		{"INSERT INTO User (ID, Name, Score) VALUES (1, 'spencer', 1.5), (2, 'peter', NULL)",
+			sql.Result{Tag: "INSERT 0 2"}},
+		{"INSERT INTO User VALUES (3, 'ben', 2)", sql.Result{Tag: "INSERT 0 1"}},
+		{"SELECT * FROM User WHERE ID = 1", sql.Result{
+			Columns: []sql.Column{{"ID", "integer"}, {"Name", "string"}, {"Score", "float"}},
+			Rows:    [][]interface{}{{int64(1), "spencer", 1.5}},
+			Tag:     "SELECT 1",
+		}},
+		{"SELECT Score, Name FROM pdb.User WHERE 2 = ID", sql.Result{
+			Columns: []sql.Column{{"Score", "float"}, {"Name", "string"}},
+			Rows:    [][]interface{}{{nil, "peter"}},
+			Tag:     "SELECT 1",
+		}},
+		{"SELECT Name FROM User WHERE ID = 3", sql.Result{
+			Columns: []sql.Column{{"Name", "string"}},
+			Rows:    [][]interface{}{{"ben"}},
+			Tag:     "SELECT 1",
+		}},
+		{"SELECT Name FROM User WHERE ID = 4", sql.Result{
+			Columns: []sql.Column{{"Name", "string"}},
+			Tag:     "SELECT 0",
+		}},
+	}
+	for i, test := range testCases {
+		result, err := e.Execute(session, test.query)
+		if err != nil {
+			t.Fatalf("%d: %s: %s", i, test.query, err)
+		}
+		if !reflect.DeepEqual(result, test.exp) {
+			t.Errorf("%d: %s: expected %+v; got %+v", i, test.query, test.exp, result)
+		}
+	}
+
+	for _, query := range []string{
+		"INSERT INTO User (ID, Name) VALUES (1, 'duplicate')",
+		"INSERT INTO User (ID, Name) VALUES ('x', 'y')",
+		"INSERT INTO User (ID, Nickname) VALUES (5, 'y')",
+		"INSERT INTO User (ID) VALUES (5, 'y')",
+		"INSERT INTO Photo (ID) VALUES (5)",
+		"SELECT * FROM User",
+		"SELECT * FROM User WHERE Name = 'ben'",
+		"SELECT * FROM User WHERE ID > 1",
+		"SELECT * FROM other.User WHERE ID = 1",
+		"DELETE FROM User WHERE ID = 1",
+		"SELECT",
+	} {
+		if _, err := e.Execute(session, query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+// TestExecutorSessionUser verifies that the statements of a session are
+// executed on behalf of the session's user.
+func TestExecutorSessionUser(t *testing.T) {
+	var users []string
+	kv := client.NewKV(nil, client.KVSenderFunc(func(_ context.Context, call client.Call) {
+		users = append(users, call.Args.Header().User)
+	}))
+	kv.User = storage.UserRoot
+	e := sql.NewExecutor(kv)
+	for _, user := range []string{"alice", "bob"} {
+		users = nil
+		if _, err := e.Execute(&sql.Session{User: user}, "USE pdb"); err == nil {
+			t.Errorf("%s: expected USE of a missing database to fail", user)
+		}
+		if len(users) == 0 {
+			t.Fatalf("%s: expected the statement to be sent", user)
+		}
+		for _, u := range users {
+			if u != user {
+				t.Errorf("expected call on behalf of %s; got %s", user, u)
+			}
+		}
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package pgwire
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// Startup message codes. Protocol versions hold the major version in
+// their upper 16 bits and the minor version in the lower 16.
+const (
+	version30     = 3 << 16
+	versionSSL    = 1234<<16 + 5679
+	versionCancel = 1234<<16 + 5678
+)
+
+// maxMessageSize bounds the size of the messages read from clients.
+const maxMessageSize = 1 << 24
+
+// Client message types.
+const (
+	clientMsgQuery     = 'Q'
+	clientMsgSync      = 'S'
+	clientMsgTerminate = 'X'
+)
+
+// Server message types.
+const (
+	serverMsgAuth            = 'R'
+	serverMsgCommandComplete = 'C'
+	serverMsgDataRow         = 'D'
+	serverMsgEmptyQuery      = 'I'
+	serverMsgErrorResponse   = 'E'
+	serverMsgParameterStatus = 'S'
+	serverMsgReady           = 'Z'
+	serverMsgRowDescription  = 'T'
+)
+
+// Type OIDs of the result columns, by structured column type. Columns
+// of other types are sent as text.
+var typeOIDs = map[string]int32{
+	"integer": 20,   // int8
+	"float":   701,  // float8
+	"string":  25,   // text
+	"blob":    17,   // bytea
+	"time":    1184, // timestamptz
+}
+
+const textOID = 25
+
+// Error codes of the errors sent to clients.
+const (
+	codeProtocolViolation = "08P01"
+	codeInvalidAuth       = "28000"
+	codeInternalError     = "XX000"
+)
+
+// A conn is a client connection to the server.
+type conn struct {
+	server *Server
+	conn   net.Conn
+	rd     *bufio.Reader
+	wr     *bufio.Writer
+	buf    bytes.Buffer // the message being written
+}
+
+func newConn(netConn net.Conn, s *Server) *conn {
+	c := &conn{server: s}
+	c.setConn(netConn)
+	return c
+}
+
+func (c *conn) setConn(netConn net.Conn) {
+	c.conn = netConn
+	c.rd = bufio.NewReader(netConn)
+	c.wr = bufio.NewWriter(netConn)
+}
+
+// serve runs the startup and authentication of the connection and
+// then executes its queries until the client terminates it.
+func (c *conn) serve() error {
+	defer c.conn.Close()
+	version, body, err := c.readStartup()
+	if err != nil {
+		return err
+	}
+	if version == versionSSL {
+		if c.server.tlsConfig == nil {
+			if _, err := c.conn.Write([]byte{'N'}); err != nil {
+				return err
+			}
+		} else {
+			if _, err := c.conn.Write([]byte{'S'}); err != nil {
+				return err
+			}
+			tlsConn := tls.Server(c.conn, c.server.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return err
+			}
+			c.setConn(tlsConn)
+		}
+		if version, body, err = c.readStartup(); err != nil {
+			return err
+		}
+	}
+	switch version {
+	case version30:
+	case versionCancel:
+		// Queries run to completion, so there's nothing to cancel.
+		return nil
+	default:
+		return c.sendError(codeProtocolViolation, fmt.Sprintf("unsupported protocol version %d.%d",
+			version>>16, version&0xffff))
+	}
+
+	params, err := parseStartupParams(body)
+	if err != nil {
+		return c.sendError(codeProtocolViolation, err.Error())
+	}
+	session := &sql.Session{User: params["user"], Database: params["database"]}
+	if err := c.authenticate(session.User); err != nil {
+		return c.sendError(codeInvalidAuth, err.Error())
+	}
+	c.beginMessage(serverMsgAuth)
+	c.putInt32(0) // AuthenticationOk
+	c.finishMessage()
+	for _, param := range [][2]string{
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO"},
+		{"server_encoding", "UTF8"},
+		{"server_version", "9.5.0"},
+	} {
+		c.beginMessage(serverMsgParameterStatus)
+		c.putString(param[0])
+		c.putString(param[1])
+		c.finishMessage()
+	}
+	if err := c.sendReady(); err != nil {
+		return err
+	}
+
+	// The messages of the extended query protocol are rejected. Once one
+	// has been, the rest of those preceding the next sync are ignored, as
+	// after any error in the extended protocol.
+	var failed bool
+	for {
+		typ, body, err := c.readMessage()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch typ {
+		case clientMsgQuery:
+			query := string(bytes.TrimRight(body, "\x00"))
+			c.query(session, query)
+			err = c.sendReady()
+		case clientMsgSync:
+			failed = false
+			err = c.sendReady()
+		case clientMsgTerminate:
+			return nil
+		default:
+			if !failed {
+				failed = true
+				err = c.sendError(codeProtocolViolation, fmt.Sprintf("unsupported message type %q", typ))
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// authenticate verifies that the connection may act as the user. On a
+// secure server, the user must be that of the client certificate,
+// unless the certificate is a node's.
+func (c *conn) authenticate(user string) error {
+	if user == "" {
+		return util.Errorf("no user specified")
+	}
+	if c.server.tlsConfig == nil {
+		return nil
+	}
+	tlsConn, ok := c.conn.(*tls.Conn)
+	if !ok {
+		return util.Errorf("connections require SSL")
+	}
+	state := tlsConn.ConnectionState()
+	certUser, ok := security.AuthenticatedUser(&state)
+	if !ok {
+		return util.Errorf("connections require a client certificate")
+	}
+	if certUser != security.NodeUser && certUser != user {
+		return util.Errorf("user %q cannot connect as %q", certUser, user)
+	}
+	return nil
+}
+
+// query executes the query and writes its result, or the error it
+// failed with.
+func (c *conn) query(session *sql.Session, query string) {
+	if len(bytes.TrimSpace([]byte(query))) == 0 {
+		c.beginMessage(serverMsgEmptyQuery)
+		c.finishMessage()
+		return
+	}
+	result, err := c.server.executor.Execute(session, query)
+	if err != nil {
+		c.putError(codeInternalError, err.Error())
+		return
+	}
+	if len(result.Columns) > 0 {
+		c.beginMessage(serverMsgRowDescription)
+		c.putInt16(int16(len(result.Columns)))
+		for _, col := range result.Columns {
+			oid, ok := typeOIDs[col.Type]
+			if !ok {
+				oid = textOID
+			}
+			c.putString(col.Name)
+			c.putInt32(0)   // table OID
+			c.putInt16(0)   // column attribute number
+			c.putInt32(oid) // type OID
+			c.putInt16(-1)  // type size
+			c.putInt32(-1)  // type modifier
+			c.putInt16(0)   // text format
+		}
+		c.finishMessage()
+		for _, row := range result.Rows {
+			c.beginMessage(serverMsgDataRow)
+			c.putInt16(int16(len(row)))
+			for _, v := range row {
+				if v == nil {
+					c.putInt32(-1)
+					continue
+				}
+				text := formatValue(v)
+				c.putInt32(int32(len(text)))
+				c.buf.WriteString(text)
+			}
+			c.finishMessage()
+		}
+	}
+	c.beginMessage(serverMsgCommandComplete)
+	c.putString(result.Tag)
+	c.finishMessage()
+}
+
+// formatValue formats the value in the Postgres text format of its
+// type.
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return v
+	case []byte:
+		return `\x` + hex.EncodeToString(v)
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05.999999999-07:00")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// readStartup reads a startup message, which has no type, returning
+// its protocol version and the remainder of its body.
+func (c *conn) readStartup() (int32, []byte, error) {
+	body, err := c.readBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(body) < 4 {
+		return 0, nil, util.Errorf("startup message too short")
+	}
+	return int32(binary.BigEndian.Uint32(body)), body[4:], nil
+}
+
+// readMessage reads a message, returning its type and body.
+func (c *conn) readMessage() (byte, []byte, error) {
+	typ, err := c.rd.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := c.readBody()
+	return typ, body, err
+}
+
+// readBody reads the length of a message, which includes the length
+// itself, and then its body.
+func (c *conn) readBody() ([]byte, error) {
+	var length int32
+	if err := binary.Read(c.rd, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if length < 4 || length > maxMessageSize {
+		return nil, util.Errorf("invalid message length %d", length)
+	}
+	body := make([]byte, length-4)
+	if _, err := io.ReadFull(c.rd, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// parseStartupParams parses the NUL-terminated name/value pairs of a
+// startup message, which are terminated by an empty name.
+func parseStartupParams(body []byte) (map[string]string, error) {
+	if len(body) == 0 || body[len(body)-1] != 0 {
+		return nil, util.Errorf("malformed startup parameters")
+	}
+	params := map[string]string{}
+	fields := bytes.Split(body[:len(body)-1], []byte{0})
+	for i := 0; i+1 < len(fields); i += 2 {
+		params[string(fields[i])] = string(fields[i+1])
+	}
+	return params, nil
+}
+
+func (c *conn) beginMessage(typ byte) {
+	c.buf.Reset()
+	c.buf.WriteByte(typ)
+	c.putInt32(0) // length, set by finishMessage
+}
+
+func (c *conn) putInt16(v int16) {
+	binary.Write(&c.buf, binary.BigEndian, v)
+}
+
+func (c *conn) putInt32(v int32) {
+	binary.Write(&c.buf, binary.BigEndian, v)
+}
+
+func (c *conn) putString(s string) {
+	c.buf.WriteString(s)
+	c.buf.WriteByte(0)
+}
+
+// finishMessage sets the length of the message and buffers it for
+// writing to the client.
+func (c *conn) finishMessage() {
+	b := c.buf.Bytes()
+	binary.BigEndian.PutUint32(b[1:5], uint32(len(b)-1))
+	c.wr.Write(b)
+}
+
+// putError buffers an error response.
+func (c *conn) putError(code, msg string) {
+	c.beginMessage(serverMsgErrorResponse)
+	c.buf.WriteByte('S')
+	c.putString("ERROR")
+	c.buf.WriteByte('C')
+	c.putString(code)
+	c.buf.WriteByte('M')
+	c.putString(msg)
+	c.buf.WriteByte(0)
+	c.finishMessage()
+}
+
+// sendError writes an error response to the client.
+func (c *conn) sendError(code, msg string) error {
+	c.putError(code, msg)
+	return c.wr.Flush()
+}
+
+// sendReady writes the buffered messages to the client, followed by a
+// message indicating that the server is ready for the next query.
+func (c *conn) sendReady() error {
+	c.beginMessage(serverMsgReady)
+	c.buf.WriteByte('I') // idle, outside of a transaction
+	c.finishMessage()
+	return c.wr.Flush()
+}
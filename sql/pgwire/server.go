@@ -0,0 +1,118 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package pgwire
+
+import (
+	"crypto/tls"
+	"net"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// A Server accepts connections speaking the Postgres wire protocol
+// and executes their queries. Only the simple query protocol is
+// supported.
+//
+// When the server has a TLS config, clients must negotiate SSL and are
+// authenticated by their client certificates, as on the node's HTTP
+// and RPC endpoints: the user of the startup message must be the user
+// of the certificate. Without a TLS config, the server is insecure and
+// clients are trusted to be the user they claim.
+type Server struct {
+	executor  *sql.Executor
+	tlsConfig *tls.Config
+
+	mu        sync.Mutex
+	closed    bool
+	listeners map[net.Listener]struct{}
+	conns     map[net.Conn]struct{}
+}
+
+// NewServer creates a Server executing queries with the supplied
+// executor. A nil tlsConfig makes the server insecure.
+func NewServer(tlsConfig *tls.Config, executor *sql.Executor) *Server {
+	return &Server{
+		executor:  executor,
+		tlsConfig: tlsConfig,
+		listeners: map[net.Listener]struct{}{},
+		conns:     map[net.Conn]struct{}{},
+	}
+}
+
+// Serve accepts connections on the listener, serving each in its own
+// goroutine, until the listener or the server is closed. The listener
+// is closed when Serve returns, including when the server was closed
+// before Serve registered it.
+func (s *Server) Serve(ln net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		ln.Close()
+		return nil
+	}
+	s.listeners[ln] = struct{}{}
+	s.mu.Unlock()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			s.mu.Lock()
+			delete(s.listeners, ln)
+			closed := s.closed
+			s.mu.Unlock()
+			ln.Close()
+			if closed {
+				return nil
+			}
+			return err
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			continue
+		}
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		go func() {
+			if err := newConn(conn, s).serve(); err != nil {
+				log.Warningf("pgwire connection from %s failed: %s", conn.RemoteAddr(), err)
+			}
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+		}()
+	}
+}
+
+// Close implements util.Closer, closing the server's listeners and the
+// connections they accepted.
+func (s *Server) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for ln := range s.listeners {
+		ln.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
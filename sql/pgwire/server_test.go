@@ -0,0 +1,251 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package pgwire_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/sql/pgwire"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/testutils"
+)
+
+// A testClient speaks just enough of the Postgres protocol to run
+// simple queries.
+type testClient struct {
+	t    *testing.T
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// connect negotiates SSL using the node's client certificate and sends
+// the startup message for the user and database.
+func connect(t *testing.T, addr, user, database string) *testClient {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &testClient{t: t, conn: conn, rd: bufio.NewReader(conn)}
+	c.write(0, 1234<<16+5679)
+	if b, err := c.rd.ReadByte(); err != nil || b != 'S' {
+		t.Fatalf("expected SSL to be accepted; got %q, %v", b, err)
+	}
+	tlsConfig, err := testutils.NewTestBaseContext().GetClientTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.conn = tls.Client(conn, tlsConfig)
+	c.rd = bufio.NewReader(c.conn)
+	c.write(0, 3<<16, "user", user, "database", database, "")
+	return c
+}
+
+// write sends a message of the given type, or a startup message if the
+// type is zero, holding the supplied int32 and NUL-terminated string
+// fields.
+func (c *testClient) write(typ byte, fields ...interface{}) {
+	var body bytes.Buffer
+	for _, f := range fields {
+		switch f := f.(type) {
+		case int:
+			binary.Write(&body, binary.BigEndian, int32(f))
+		case string:
+			body.WriteString(f)
+			body.WriteByte(0)
+		}
+	}
+	var msg bytes.Buffer
+	if typ != 0 {
+		msg.WriteByte(typ)
+	}
+	binary.Write(&msg, binary.BigEndian, int32(body.Len()+4))
+	msg.Write(body.Bytes())
+	if _, err := c.conn.Write(msg.Bytes()); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// read returns the type and body of the next message from the server.
+func (c *testClient) read() (byte, []byte) {
+	typ, err := c.rd.ReadByte()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	var length int32
+	if err := binary.Read(c.rd, binary.BigEndian, &length); err != nil {
+		c.t.Fatal(err)
+	}
+	body := make([]byte, length-4)
+	if _, err := io.ReadFull(c.rd, body); err != nil {
+		c.t.Fatal(err)
+	}
+	return typ, body
+}
+
+// readUntilReady returns the types of the messages preceding the next
+// ReadyForQuery, along with the values of the data rows and the first
+// error message, if any.
+func (c *testClient) readUntilReady() (types string, rows [][]string, errMsg string) {
+	for {
+		typ, body := c.read()
+		if typ == 'Z' {
+			return
+		}
+		types += string(typ)
+		switch typ {
+		case 'D':
+			var row []string
+			n := int(binary.BigEndian.Uint16(body))
+			body = body[2:]
+			for i := 0; i < n; i++ {
+				length := int32(binary.BigEndian.Uint32(body))
+				body = body[4:]
+				if length < 0 {
+					row = append(row, "NULL")
+					continue
+				}
+				row = append(row, string(body[:length]))
+				body = body[length:]
+			}
+			rows = append(rows, row)
+		case 'E':
+			for _, field := range bytes.Split(body, []byte{0}) {
+				if len(field) > 0 && field[0] == 'M' && errMsg == "" {
+					errMsg = string(field[1:])
+				}
+			}
+		}
+	}
+}
+
+// query runs the query and returns the types of the messages of its
+// response, along with the values of its rows and its error.
+func (c *testClient) query(query string) (string, [][]string, string) {
+	c.write('Q', query)
+	return c.readUntilReady()
+}
+
+// TestPGWire verifies that queries sent over the Postgres wire protocol
+// are executed against the structured tables.
+func TestPGWire(t *testing.T) {
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	httpClient, err := testutils.NewTestHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvClient := client.NewKV(nil, client.NewHTTPSender(s.ServingAddr(), httpClient))
+	kvClient.User = storage.UserRoot
+	db := structured.NewDB(kvClient)
+	if err := db.PutSchema(&structured.Schema{Name: "PhotoDB", Key: "pdb"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable("pdb", &structured.Table{
+		Name: "User",
+		Key:  "us",
+		Columns: []*structured.Column{
+			{Name: "ID", Key: "id", Type: "integer", PrimaryKey: true},
+			{Name: "Name", Key: "na", Type: "string"},
+			{Name: "Photo", Key: "ph", Type: "blob"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	c := connect(t, s.PGAddr(), storage.UserRoot, "pdb")
+	defer c.conn.Close()
+	if types, _, errMsg := c.readUntilReady(); types[0] != 'R' || errMsg != "" {
+		t.Fatalf("unexpected startup response %q: %s", types, errMsg)
+	}
+
+	if types, _, errMsg := c.query("INSERT INTO User (ID, Name, Photo) VALUES (1, 'spencer', 'ab'), (2, 'peter', NULL)"); types != "C" {
+		t.Fatalf("unexpected insert response %q: %s", types, errMsg)
+	}
+	types, rows, errMsg := c.query("SELECT * FROM User WHERE ID = 1")
+	if types != "TDC" {
+		t.Fatalf("unexpected select response %q: %s", types, errMsg)
+	}
+	if exp := [][]string{{"1", "spencer", `\x6162`}}; !reflect.DeepEqual(rows, exp) {
+		t.Errorf("expected rows %q; got %q", exp, rows)
+	}
+	if _, rows, _ = c.query("SELECT Photo FROM User WHERE ID = 2"); !reflect.DeepEqual(rows, [][]string{{"NULL"}}) {
+		t.Errorf("expected a null photo; got %q", rows)
+	}
+	if types, _, _ := c.query(" "); types != "I" {
+		t.Errorf("expected an empty query response; got %q", types)
+	}
+
+	// Errors are reported and leave the connection usable.
+	if types, _, errMsg := c.query("SELECT * FROM Photo WHERE ID = 1"); types != "E" || errMsg == "" {
+		t.Errorf("expected an error; got %q", types)
+	}
+	if types, _, _ := c.query("USE pdb"); types != "C" {
+		t.Errorf("unexpected use response %q", types)
+	}
+
+	// Messages of the extended protocol are rejected once until the
+	// next sync.
+	c.write('P', "", "SELECT * FROM User WHERE ID = 1", "")
+	c.write('B', "", "")
+	c.write('S')
+	if types, _, _ := c.readUntilReady(); types != "E" {
+		t.Errorf("expected a single error for the extended protocol; got %q", types)
+	}
+	c.write('X')
+
+	// The startup user must match the client certificate, unless it's a
+	// node's certificate.
+	other := connect(t, s.PGAddr(), "foo", "pdb")
+	defer other.conn.Close()
+	if types, _, errMsg := other.readUntilReady(); types[0] != 'R' || errMsg != "" {
+		t.Errorf("expected a node certificate to connect as any user; got %q: %s", types, errMsg)
+	}
+	// Statements run with the permissions of the session's user.
+	if types, _, errMsg := other.query("SELECT * FROM User WHERE ID = 1"); types != "E" || errMsg == "" {
+		t.Errorf("expected a select without read permission to fail; got %q", types)
+	}
+}
+
+// TestServeAfterClose verifies that a listener served once the server
+// has been closed is closed.
+func TestServeAfterClose(t *testing.T) {
+	s := pgwire.NewServer(nil, nil)
+	s.Close()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Serve(ln); err != nil {
+		t.Fatal(err)
+	}
+	if conn, err := ln.Accept(); err == nil {
+		conn.Close()
+		t.Error("expected the listener to be closed")
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"encoding/gob"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// acctUsageRefreshInterval is the interval after which a store's
+// cached cluster accounting usage is summed anew from gossip. Stores
+// gossip their usage only once per node gossip interval, so a
+// shorter interval would gain little.
+const acctUsageRefreshInterval = 10 * time.Second
+
+func init() {
+	gob.Register(AcctUsage{})
+}
+
+// AcctUsage maps the prefixes of accounting configs to the aggregated
+// MVCC stats of the data stored under them.
+type AcctUsage map[string]proto.MVCCStats
+
+// add accumulates the supplied stats into the usage of prefix.
+func (u AcctUsage) add(prefix proto.Key, ms proto.MVCCStats) {
+	total := u[string(prefix)]
+	engine.Accumulate(&total, ms)
+	u[string(prefix)] = total
+}
+
+// ClusterAcctUsage sums the accounting usage gossiped by all stores.
+// As each store accounts only for the ranges it leads, each range is
+// counted once, except briefly while its leadership moves.
+func ClusterAcctUsage(g *gossip.Gossip) AcctUsage {
+	total := AcctUsage{}
+	for _, val := range g.GetInfosWithPrefix(gossip.KeyAcctUsagePrefix) {
+		if usage, ok := val.(AcctUsage); ok {
+			for prefix, ms := range usage {
+				total.add(proto.Key(prefix), ms)
+			}
+		}
+	}
+	return total
+}
+
+// acctUsageCache caches the cluster accounting usage so that quota
+// checks don't sum the usage gossiped by all stores on each write.
+type acctUsageCache struct {
+	sync.Mutex           // Protects usage and refreshed
+	usage      AcctUsage // Nil if the cache has been invalidated
+	refreshed  time.Time // Time at which usage was summed
+}
+
+// AcctUsage returns the cluster accounting usage, summing the usage
+// gossiped by all stores if the cached sum is older than
+// acctUsageRefreshInterval. The returned usage must not be modified.
+func (s *Store) AcctUsage() AcctUsage {
+	s.acctUsage.Lock()
+	defer s.acctUsage.Unlock()
+	if now := time.Now(); s.acctUsage.usage == nil || now.Sub(s.acctUsage.refreshed) >= acctUsageRefreshInterval {
+		s.acctUsage.usage = ClusterAcctUsage(s.ctx.Gossip)
+		s.acctUsage.refreshed = now
+	}
+	return s.acctUsage.usage
+}
+
+// GossipAcctUsage gossips the usage of each accounting prefix by the
+// ranges this store leads. Ranges are split at accounting config
+// boundaries, so each lies under a single prefix.
+func (s *Store) GossipAcctUsage() {
+	cfg, err := GetSystemConfig(s.ctx.Gossip)
+	if err != nil || len(cfg.Accounting) == 0 {
+		return
+	}
+	acctMap := cfg.Accounting
+	usage := AcctUsage{}
+	s.mu.RLock()
+	for _, rng := range s.rangesByKey {
+		if rng.IsLeader() {
+			usage.add(acctMap.MatchByPrefix(rng.Desc().StartKey).Prefix, rng.stats.GetMVCC())
+		}
+	}
+	s.mu.RUnlock()
+	if err := s.ctx.Gossip.AddInfo(gossip.MakeAcctUsageKey(s.StoreID()), usage, ttlCapacityGossip); err != nil {
+		log.Warningf("unable to gossip accounting usage of store %d: %s", s.StoreID(), err)
+	}
+	// Invalidate the cached cluster usage so that this store's quota
+	// checks see its own usage right away.
+	s.acctUsage.Lock()
+	s.acctUsage.usage = nil
+	s.acctUsage.Unlock()
+}
+
+// addsData returns whether the request may add data, as opposed to
+// deleting it or maintaining the range or transaction records.
+func addsData(args proto.Request) bool {
+	switch t := args.(type) {
+	case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.InitPutRequest, *proto.IncrementRequest:
+		return true
+	case *proto.BatchRequest:
+		for i := range t.Requests {
+			if addsData(t.Requests[i].GetValue().(proto.Request)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkQuota returns an error if the request adds data under an
+// accounting prefix whose usage exceeds the config's byte limit.
+// System keys are exempt so that the cluster remains operable. The
+// usage is the store's cached cluster usage, so a prefix may exceed
+// its limit by the data written within acctUsageRefreshInterval.
+func (r *Range) checkQuota(args proto.Request) error {
+	key := args.Header().Key
+	if r.rm.Gossip() == nil || key.Less(keys.KeySystemMax) || !addsData(args) {
+		return nil
+	}
+	cfg, err := GetSystemConfig(r.rm.Gossip())
+	if err != nil || len(cfg.Accounting) == 0 {
+		return nil
+	}
+	prefixConfig := cfg.Accounting.MatchByPrefix(key)
+	limit := prefixConfig.Config.(*proto.AcctConfig).ByteLimit
+	if limit <= 0 {
+		return nil
+	}
+	usage := r.rm.AcctUsage()[string(prefixConfig.Prefix)]
+	if used := usage.KeyBytes + usage.ValBytes; used >= limit {
+		return util.Errorf("accounting prefix %q has used %d bytes, exceeding its limit of %d bytes",
+			prefixConfig.Prefix, used, limit)
+	}
+	return nil
+}
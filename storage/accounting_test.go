@@ -0,0 +1,108 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"golang.org/x/net/context"
+)
+
+// TestRangeQuota verifies that writes under an accounting prefix are
+// rejected once its gossiped usage reaches the config's byte limit,
+// while deletions proceed.
+func TestRangeQuota(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	configMap, err := NewPrefixConfigMap([]*PrefixConfig{
+		{keys.KeyMin, nil, &proto.AcctConfig{ByteLimit: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updateTestSystemConfig(t, tc.gossip, func(cfg *SystemConfig) { cfg.Accounting = configMap })
+
+	// No usage has been gossiped yet, so the first write succeeds.
+	pArgs, pReply := putArgs(proto.Key("a"), []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	tc.store.GossipAcctUsage()
+	usage := ClusterAcctUsage(tc.gossip)[string(keys.KeyMin)]
+	if usage.KeyBytes+usage.ValBytes == 0 {
+		t.Fatalf("expected non-zero usage; got %+v", usage)
+	}
+
+	pArgs, pReply = putArgs(proto.Key("b"), []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err == nil {
+		t.Error("expected write exceeding the byte limit to fail")
+	}
+	dArgs, dReply := deleteArgs(proto.Key("a"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), dArgs, dReply, true); err != nil {
+		t.Errorf("expected delete to succeed; got %s", err)
+	}
+	// System keys are exempt from the limit.
+	pArgs, pReply = putArgs(keys.MakeKey(keys.KeySystemPrefix, proto.Key("quota")),
+		[]byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Errorf("expected system write to succeed; got %s", err)
+	}
+}
+
+// TestStoreAcctUsageCache verifies that the store caches the cluster
+// accounting usage until it gossips its own usage.
+func TestStoreAcctUsageCache(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	configMap, err := NewPrefixConfigMap([]*PrefixConfig{
+		{keys.KeyMin, nil, &proto.AcctConfig{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updateTestSystemConfig(t, tc.gossip, func(cfg *SystemConfig) { cfg.Accounting = configMap })
+
+	remote := AcctUsage{string(keys.KeyMin): proto.MVCCStats{KeyBytes: 1}}
+	if err := tc.gossip.AddInfo(gossip.MakeAcctUsageKey(2), remote, ttlCapacityGossip); err != nil {
+		t.Fatal(err)
+	}
+	if usage := tc.store.AcctUsage()[string(keys.KeyMin)]; usage.KeyBytes != 1 {
+		t.Fatalf("expected 1 key byte; got %+v", usage)
+	}
+	remote = AcctUsage{string(keys.KeyMin): proto.MVCCStats{KeyBytes: 2}}
+	if err := tc.gossip.AddInfo(gossip.MakeAcctUsageKey(2), remote, ttlCapacityGossip); err != nil {
+		t.Fatal(err)
+	}
+	if usage := tc.store.AcctUsage()[string(keys.KeyMin)]; usage.KeyBytes != 1 {
+		t.Errorf("expected cached usage of 1 key byte; got %+v", usage)
+	}
+	tc.store.GossipAcctUsage()
+	if usage := tc.store.AcctUsage()[string(keys.KeyMin)]; usage.KeyBytes < 2 {
+		t.Errorf("expected refreshed usage of at least 2 key bytes; got %+v", usage)
+	}
+}
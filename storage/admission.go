@@ -0,0 +1,258 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
+)
+
+// admissionInterval is the interval at which a store with admission
+// control enabled reassesses its load.
+const admissionInterval = time.Second
+
+// admissionLoad describes how saturated a store is.
+type admissionLoad int
+
+const (
+	loadNormal admissionLoad = iota
+	// loadOverloaded is reached once any of the store's measures
+	// reaches its threshold.
+	loadOverloaded
+	// loadSaturated is reached once any measure reaches twice its
+	// threshold.
+	loadSaturated
+)
+
+// admissionLimits gives for each load above normal the lowest priority
+// of the writes admitted at once, and the lowest priority of those
+// admitted after waiting to be limited to the configured rate. Writes
+// of still lower priority are rejected.
+var admissionLimits = map[admissionLoad]struct{ unlimited, limited proto.RequestPriority }{
+	loadOverloaded: {proto.HIGH, proto.NORMAL},
+	loadSaturated:  {proto.SYSTEM, proto.HIGH},
+}
+
+// A StoreBusyError indicates that a write was rejected because the
+// store was saturated. The error is retryable.
+type StoreBusyError struct {
+	StoreID proto.StoreID
+}
+
+// Error formats error.
+func (e *StoreBusyError) Error() string {
+	return fmt.Sprintf("store %d is busy; write rejected", e.StoreID)
+}
+
+// CanRetry implements the Retryable interface.
+func (e *StoreBusyError) CanRetry() bool {
+	return true
+}
+
+// requestAdmissionPriority returns the priority by which admission
+// control decides on a write. Writes to system keys, such as the meta
+// addressing records updated by splits, are always of system priority.
+func requestAdmissionPriority(args proto.Request) proto.RequestPriority {
+	header := args.Header()
+	if bytes.Compare(header.Key, keys.KeySystemMax) < 0 {
+		return proto.SYSTEM
+	}
+	return header.Priority
+}
+
+// admissionController tracks a store's compaction debt, memtable size
+// and Raft log growth and decides whether incoming writes are
+// admitted, delayed or rejected. Zero thresholds disable the
+// respective measure.
+type admissionController struct {
+	l0Files         int64
+	memtableBytes   int64
+	raftLogEntries  int64
+	writesPerSecond int64
+
+	mu   sync.Mutex
+	load admissionLoad
+	// nextAdmit is the time at which the throttle next admits a write.
+	nextAdmit time.Time
+}
+
+// newAdmissionController returns an admissionController with the
+// thresholds configured in the supplied context.
+func newAdmissionController(ctx *StoreContext) *admissionController {
+	return &admissionController{
+		l0Files:         ctx.AdmissionL0Files,
+		memtableBytes:   ctx.AdmissionMemtableBytes,
+		raftLogEntries:  ctx.AdmissionRaftLogEntries,
+		writesPerSecond: ctx.AdmissionWritesPerSecond,
+	}
+}
+
+// enabled returns whether any measure is checked.
+func (ac *admissionController) enabled() bool {
+	return ac.l0Files > 0 || ac.memtableBytes > 0 || ac.raftLogEntries > 0
+}
+
+// update computes the load from the engine's statistics and the
+// number of entries in the longest Raft log of the store's ranges,
+// and returns it.
+func (ac *admissionController) update(stats *proto.EngineStats, raftLogEntries int64) admissionLoad {
+	var l0Files int64
+	if len(stats.FilesAtLevel) > 0 {
+		l0Files = stats.FilesAtLevel[0]
+	}
+	load := loadNormal
+	for _, m := range []struct{ value, threshold int64 }{
+		{l0Files, ac.l0Files},
+		{stats.MemtableSize, ac.memtableBytes},
+		{raftLogEntries, ac.raftLogEntries},
+	} {
+		if m.threshold <= 0 {
+			continue
+		}
+		if m.value >= 2*m.threshold {
+			load = loadSaturated
+		} else if m.value >= m.threshold && load < loadOverloaded {
+			load = loadOverloaded
+		}
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	ac.load = load
+	return load
+}
+
+// admit decides on a write of the given priority according to
+// admissionLimits. It returns rejected if the write is to be rejected,
+// and otherwise how long the write must wait before it may proceed.
+func (ac *admissionController) admit(priority proto.RequestPriority, now time.Time) (wait time.Duration, rejected bool) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if ac.load == loadNormal {
+		return 0, false
+	}
+	limits := admissionLimits[ac.load]
+	if !priority.Less(limits.unlimited) {
+		return 0, false
+	}
+	if priority.Less(limits.limited) {
+		return 0, true
+	}
+	if ac.writesPerSecond <= 0 {
+		return 0, false
+	}
+	if ac.nextAdmit.Before(now) {
+		ac.nextAdmit = now
+	}
+	wait = ac.nextAdmit.Sub(now)
+	ac.nextAdmit = ac.nextAdmit.Add(time.Second / time.Duration(ac.writesPerSecond))
+	return wait, false
+}
+
+// admitWrite applies admission control to a write, waiting as long as
+// it is limited. Only writes which may be part of a transaction are
+// subject to it; commits, intent resolution, GC and the other internal
+// commands finish or clean up work already admitted and reduce the
+// store's load. Returns a StoreBusyError if the write is rejected, or
+// the context's error if it is done while the write waits.
+func (s *Store) admitWrite(ctx context.Context, args proto.Request) error {
+	wait, rejected := s.admission.admit(requestAdmissionPriority(args), time.Now())
+	if rejected {
+		return &StoreBusyError{StoreID: s.Ident.StoreID}
+	}
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// maxRaftLogEntries returns the number of entries in the longest Raft
+// log of the store's ranges.
+func (s *Store) maxRaftLogEntries() int64 {
+	s.mu.RLock()
+	ranges := make([]*Range, 0, len(s.ranges))
+	for _, rng := range s.ranges {
+		ranges = append(ranges, rng)
+	}
+	s.mu.RUnlock()
+	var max int64
+	for _, rng := range ranges {
+		first, err := rng.FirstIndex()
+		if err != nil {
+			log.Warningf("range %d: unable to read first index: %s", rng.Desc().RaftID, err)
+			continue
+		}
+		last, _ := rng.LastIndex()
+		if n := int64(last) - int64(first) + 1; n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// updateAdmission reassesses the store's load from its engine and its
+// ranges' Raft logs, logging changes of the load.
+func (s *Store) updateAdmission() {
+	stats, err := s.engine.GetStats()
+	if err != nil {
+		log.Warningf("store %d: unable to get engine stats for admission control: %s", s.Ident.StoreID, err)
+		return
+	}
+	var raftLogEntries int64
+	if s.admission.raftLogEntries > 0 {
+		raftLogEntries = s.maxRaftLogEntries()
+	}
+	s.admission.mu.Lock()
+	prev := s.admission.load
+	s.admission.mu.Unlock()
+	if load := s.admission.update(stats, raftLogEntries); load != prev {
+		log.Infof("store %d: admission load changed from %d to %d (L0 files %v, memtables %d bytes, raft log %d entries)",
+			s.Ident.StoreID, prev, load, stats.FilesAtLevel, stats.MemtableSize, raftLogEntries)
+	}
+}
+
+// startAdmission starts a worker which reassesses the store's load
+// every admissionInterval, if admission control is enabled.
+func (s *Store) startAdmission() {
+	if !s.admission.enabled() {
+		return
+	}
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(admissionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.updateAdmission()
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
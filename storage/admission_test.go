@@ -0,0 +1,92 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestRequestAdmissionPriority(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		key      proto.Key
+		priority proto.RequestPriority
+		expected proto.RequestPriority
+	}{
+		{proto.Key("a"), proto.NORMAL, proto.NORMAL},
+		{proto.Key("a"), proto.HIGH, proto.HIGH},
+		{proto.Key("a"), proto.BACKGROUND, proto.BACKGROUND},
+		{keys.NodeLivenessKey(1), proto.NORMAL, proto.SYSTEM},
+		{keys.RangeMetaKey(proto.Key("a")), proto.BACKGROUND, proto.SYSTEM},
+	}
+	for i, test := range testCases {
+		args := &proto.PutRequest{RequestHeader: proto.RequestHeader{
+			Key:      test.key,
+			Priority: test.priority,
+		}}
+		if p := requestAdmissionPriority(args); p != test.expected {
+			t.Errorf("%d: expected priority %s; got %s", i, test.expected, p)
+		}
+	}
+}
+
+func TestAdmissionController(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ac := newAdmissionController(&StoreContext{
+		AdmissionL0Files:         10,
+		AdmissionMemtableBytes:   100,
+		AdmissionWritesPerSecond: 10,
+	})
+	if !ac.enabled() {
+		t.Fatal("expected admission control to be enabled")
+	}
+	now := time.Unix(0, 0)
+	testCases := []struct {
+		stats    proto.EngineStats
+		raftLog  int64
+		load     admissionLoad
+		priority proto.RequestPriority
+		wait     time.Duration
+		rejected bool
+	}{
+		{proto.EngineStats{FilesAtLevel: []int64{9}, MemtableSize: 99}, 0, loadNormal, proto.NORMAL, 0, false},
+		// The Raft log measure is disabled.
+		{proto.EngineStats{}, 1 << 20, loadNormal, proto.NORMAL, 0, false},
+		{proto.EngineStats{FilesAtLevel: []int64{10}}, 0, loadOverloaded, proto.NORMAL, 0, false},
+		{proto.EngineStats{MemtableSize: 150}, 0, loadOverloaded, proto.NORMAL, 100 * time.Millisecond, false},
+		{proto.EngineStats{MemtableSize: 150}, 0, loadOverloaded, proto.HIGH, 0, false},
+		{proto.EngineStats{MemtableSize: 150}, 0, loadOverloaded, proto.LOW, 0, true},
+		{proto.EngineStats{FilesAtLevel: []int64{20}}, 0, loadSaturated, proto.NORMAL, 0, true},
+		{proto.EngineStats{FilesAtLevel: []int64{20}}, 0, loadSaturated, proto.HIGH, 200 * time.Millisecond, false},
+		{proto.EngineStats{MemtableSize: 200}, 0, loadSaturated, proto.SYSTEM, 0, false},
+	}
+	for i, test := range testCases {
+		if load := ac.update(&test.stats, test.raftLog); load != test.load {
+			t.Errorf("%d: expected load %d; got %d", i, test.load, load)
+		}
+		wait, rejected := ac.admit(test.priority, now)
+		if wait != test.wait || rejected != test.rejected {
+			t.Errorf("%d: expected wait %s, rejected %t; got %s, %t", i, test.wait, test.rejected, wait, rejected)
+		}
+	}
+}
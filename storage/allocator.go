@@ -29,16 +29,21 @@ import (
 // existing range metadata and available stores. Configuration
 // settings and range metadata information is stored directly in the
 // engine-backed range they describe. Information on suitability and
-// availability of servers is gleaned from the gossip network.
+// availability of servers is gleaned from the gossip network and from
+// the liveness records of nodes.
 type allocator struct {
 	storeFinder FindStoreFunc
+	liveness    *NodeLiveness
 	rand        rand.Rand
 }
 
-// newAllocator creates a new allocator.
-func newAllocator(f FindStoreFunc) *allocator {
+// newAllocator creates a new allocator. Nodes which the supplied
+// NodeLiveness, if not nil, finds dead or decommissioning are never
+// chosen.
+func newAllocator(f FindStoreFunc, liveness *NodeLiveness) *allocator {
 	return &allocator{
 		storeFinder: f,
+		liveness:    liveness,
 		// TODO(bdarnell): use a real random seed.
 		rand: *rand.New(rand.NewSource(0)),
 	}
@@ -49,7 +54,10 @@ func newAllocator(f FindStoreFunc) *allocator {
 // error. It uses the allocator's StoreFinder to select the set of
 // available stores matching attributes for missing replicas and picks
 // using randomly weighted selection based on available capacities.
-func (a *allocator) allocate(required proto.Attributes, existingReplicas []proto.Replica) (
+// Stores whose combined attributes contain any of the prohibited
+// attributes, or whose nodes are dead or decommissioning, are never
+// chosen.
+func (a *allocator) allocate(required, prohibited proto.Attributes, existingReplicas []proto.Replica) (
 	*StoreDescriptor, error) {
 	// Get a set of current nodes -- we never want to allocate on an existing node.
 	usedNodes := make(map[proto.NodeID]struct{})
@@ -66,6 +74,9 @@ func (a *allocator) allocate(required proto.Attributes, existingReplicas []proto
 	var candidates []*StoreDescriptor
 	var capacityTotal float64
 	for _, s := range stores {
+		if !prohibited.IsDisjoint(*s.CombinedAttrs()) || !a.liveness.allowsAllocation(s.Node.NodeID) {
+			continue
+		}
 		if _, ok := usedNodes[s.Node.NodeID]; !ok {
 			candidates = append(candidates, s)
 			capacityTotal += s.Capacity.PercentAvail()
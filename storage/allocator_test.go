@@ -182,7 +182,7 @@ func TestSimpleRetrieval(t *testing.T) {
 		storeFinder: singleStore,
 		rand:        *rand.New(rand.NewSource(0)),
 	}
-	result, err := a.allocate(simpleZoneConfig.ReplicaAttrs[0], []proto.Replica{})
+	result, err := a.allocate(simpleZoneConfig.ReplicaAttrs[0], proto.Attributes{}, []proto.Replica{})
 	if err != nil {
 		t.Errorf("Unable to perform allocation: %v", err)
 	}
@@ -197,7 +197,7 @@ func TestNoAvailableDisks(t *testing.T) {
 		storeFinder: noStores,
 		rand:        *rand.New(rand.NewSource(0)),
 	}
-	result, err := a.allocate(simpleZoneConfig.ReplicaAttrs[0], []proto.Replica{})
+	result, err := a.allocate(simpleZoneConfig.ReplicaAttrs[0], proto.Attributes{}, []proto.Replica{})
 	if result != nil {
 		t.Errorf("expected nil result: %+v", result)
 	}
@@ -212,7 +212,7 @@ func TestThreeDisksSameDC(t *testing.T) {
 		storeFinder: sameDCStores,
 		rand:        *rand.New(rand.NewSource(0)),
 	}
-	result1, err := a.allocate(multiDisksConfig.ReplicaAttrs[0], []proto.Replica{})
+	result1, err := a.allocate(multiDisksConfig.ReplicaAttrs[0], proto.Attributes{}, []proto.Replica{})
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
@@ -226,7 +226,7 @@ func TestThreeDisksSameDC(t *testing.T) {
 			Attrs:   multiDisksConfig.ReplicaAttrs[0],
 		},
 	}
-	result2, err := a.allocate(multiDisksConfig.ReplicaAttrs[1], exReplicas)
+	result2, err := a.allocate(multiDisksConfig.ReplicaAttrs[1], proto.Attributes{}, exReplicas)
 	if err != nil {
 		t.Errorf("Unable to perform allocation: %v", err)
 	}
@@ -236,7 +236,7 @@ func TestThreeDisksSameDC(t *testing.T) {
 	if result1.Node.NodeID == result2.Node.NodeID {
 		t.Errorf("Expected node ids to be different %+v vs %+v", result1, result2)
 	}
-	result3, err := a.allocate(multiDisksConfig.ReplicaAttrs[2], []proto.Replica{})
+	result3, err := a.allocate(multiDisksConfig.ReplicaAttrs[2], proto.Attributes{}, []proto.Replica{})
 	if err != nil {
 		t.Errorf("Unable to perform allocation: %v", err)
 	}
@@ -251,11 +251,11 @@ func TestTwoDatacenters(t *testing.T) {
 		storeFinder: multiDCStores,
 		rand:        *rand.New(rand.NewSource(0)),
 	}
-	result1, err := a.allocate(multiDCConfig.ReplicaAttrs[0], []proto.Replica{})
+	result1, err := a.allocate(multiDCConfig.ReplicaAttrs[0], proto.Attributes{}, []proto.Replica{})
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
-	result2, err := a.allocate(multiDCConfig.ReplicaAttrs[1], []proto.Replica{})
+	result2, err := a.allocate(multiDCConfig.ReplicaAttrs[1], proto.Attributes{}, []proto.Replica{})
 	if err != nil {
 		t.Fatalf("Unable to perform allocation: %v", err)
 	}
@@ -263,7 +263,7 @@ func TestTwoDatacenters(t *testing.T) {
 		t.Errorf("Expected nodes 1 & 2: %+v vs %+v", result1.Node, result2.Node)
 	}
 	// Verify that no result is forthcoming if we already have a replica.
-	_, err = a.allocate(multiDCConfig.ReplicaAttrs[1], []proto.Replica{
+	_, err = a.allocate(multiDCConfig.ReplicaAttrs[1], proto.Attributes{}, []proto.Replica{
 		{
 			NodeID:  result2.Node.NodeID,
 			StoreID: result2.StoreID,
@@ -281,7 +281,7 @@ func TestExistingReplica(t *testing.T) {
 		storeFinder: sameDCStores,
 		rand:        *rand.New(rand.NewSource(0)),
 	}
-	result, err := a.allocate(multiDisksConfig.ReplicaAttrs[1], []proto.Replica{
+	result, err := a.allocate(multiDisksConfig.ReplicaAttrs[1], proto.Attributes{}, []proto.Replica{
 		{
 			NodeID:  1,
 			StoreID: 1,
@@ -295,3 +295,26 @@ func TestExistingReplica(t *testing.T) {
 		t.Errorf("expected result to have node 3 and store 4: %+v", result)
 	}
 }
+
+func TestProhibitedAttributes(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var a = allocator{
+		storeFinder: sameDCStores,
+		rand:        *rand.New(rand.NewSource(0)),
+	}
+	// With ssd and mem stores prohibited, only the hdd stores remain eligible.
+	prohibited := proto.Attributes{Attrs: []string{"ssd", "mem"}}
+	for i := 0; i < 10; i++ {
+		result, err := a.allocate(proto.Attributes{Attrs: []string{"a"}}, prohibited, []proto.Replica{})
+		if err != nil {
+			t.Fatalf("Unable to perform allocation: %v", err)
+		}
+		if !prohibited.IsDisjoint(*result.CombinedAttrs()) {
+			t.Errorf("allocated store with prohibited attributes: %+v", result)
+		}
+	}
+	// Prohibiting the only matching attribute leaves no candidates.
+	if result, err := a.allocate(multiDisksConfig.ReplicaAttrs[0], prohibited, []proto.Replica{}); err == nil {
+		t.Errorf("expected error when all candidate stores are prohibited; got %+v", result)
+	}
+}
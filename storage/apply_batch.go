@@ -0,0 +1,99 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+)
+
+// maxApplyBatchSize is the maximum number of committed Raft commands
+// applied to the engine in a single write batch.
+const maxApplyBatchSize = 256
+
+// An applyBatch accumulates the writes of consecutive committed Raft
+// commands so that they reach the engine in a single write batch per
+// Raft ready cycle, rather than one write batch per command. Work
+// which must not be done before the writes are visible in the engine,
+// such as signaling the command's proposer, is deferred until commit.
+//
+// An applyBatch is not thread safe.
+type applyBatch struct {
+	eng      engine.Engine
+	sync     bool
+	batch    *engine.Batch
+	count    int      // Number of commands applied since the last commit
+	onCommit []func() // Invoked in order after each commit
+}
+
+// newApplyBatch returns an applyBatch which writes to eng, syncing
+// each commit to stable storage if sync is true.
+func newApplyBatch(eng engine.Engine, sync bool) *applyBatch {
+	return &applyBatch{
+		eng:   eng,
+		sync:  sync,
+		batch: engine.NewBatch(eng),
+	}
+}
+
+// deferUntilCommit arranges for fn to be invoked once the writes
+// accumulated so far have been committed.
+func (ab *applyBatch) deferUntilCommit(fn func()) {
+	ab.onCommit = append(ab.onCommit, fn)
+}
+
+// commit writes the accumulated updates to the engine in a single
+// write batch and then runs the deferred functions. The applyBatch
+// may be reused afterwards.
+func (ab *applyBatch) commit() error {
+	var err error
+	if ab.batch.Len() > 0 {
+		if ab.sync {
+			err = ab.batch.CommitSync()
+		} else {
+			err = ab.batch.Commit()
+		}
+	}
+	onCommit := ab.onCommit
+	ab.batch.Close()
+	ab.batch = engine.NewBatch(ab.eng)
+	ab.count = 0
+	ab.onCommit = nil
+	for _, fn := range onCommit {
+		fn()
+	}
+	return err
+}
+
+// full returns whether the batch holds the maximum number of commands
+// and should be committed before applying any more.
+func (ab *applyBatch) full() bool {
+	return ab.count >= maxApplyBatchSize
+}
+
+// requiresIsolatedApply returns whether the command must be applied
+// in a write batch of its own. Commit triggers read committed state
+// directly from the engine and modify the store's set of ranges, so
+// the writes of preceding commands must reach the engine first, and
+// the trigger's writes must do so before any later command is applied.
+func requiresIsolatedApply(args proto.Request) bool {
+	if et, ok := args.(*proto.EndTransactionRequest); ok {
+		return et.InternalCommitTrigger != nil
+	}
+	return false
+}
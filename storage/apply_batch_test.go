@@ -0,0 +1,101 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestApplyBatch verifies that commands applied to the same
+// applyBatch read each other's writes, that their writes reach the
+// engine only on commit, and that deferred work runs after commit.
+func TestApplyBatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	ab := newApplyBatch(tc.engine, false)
+	pArgs, pReply := putArgs(key, []byte("value1"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	tc.rng.applyCmd(ab, 0, pArgs, pReply)
+	if err := pReply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The conditional put only succeeds if it reads the pending put.
+	cpArgs := &proto.ConditionalPutRequest{
+		RequestHeader: pArgs.RequestHeader,
+		Value:         proto.Value{Bytes: []byte("value2")},
+		ExpValue:      &proto.Value{Bytes: []byte("value1")},
+	}
+	cpArgs.Timestamp = tc.clock.Now()
+	cpReply := &proto.ConditionalPutResponse{}
+	tc.rng.applyCmd(ab, 0, cpArgs, cpReply)
+	if err := cpReply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+
+	committed := false
+	ab.deferUntilCommit(func() { committed = true })
+
+	if v, err := engine.MVCCGet(tc.engine, key, tc.clock.Now(), true, nil); err != nil || v != nil {
+		t.Fatalf("expected no value before commit; got %+v, %v", v, err)
+	}
+	if err := ab.commit(); err != nil {
+		t.Fatal(err)
+	}
+	if !committed {
+		t.Error("expected deferred function to run on commit")
+	}
+	v, err := engine.MVCCGet(tc.engine, key, tc.clock.Now(), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil || string(v.Bytes) != "value2" {
+		t.Errorf("expected value2 after commit; got %+v", v)
+	}
+	if ab.count != 0 || ab.batch.Len() != 0 {
+		t.Errorf("expected batch to be reset after commit; got %d commands, %d updates",
+			ab.count, ab.batch.Len())
+	}
+}
+
+// TestRequiresIsolatedApply verifies that only commands carrying a
+// commit trigger are applied in a write batch of their own.
+func TestRequiresIsolatedApply(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		args     proto.Request
+		isolated bool
+	}{
+		{&proto.PutRequest{}, false},
+		{&proto.EndTransactionRequest{}, false},
+		{&proto.EndTransactionRequest{InternalCommitTrigger: &proto.InternalCommitTrigger{}}, true},
+	}
+	for i, test := range testCases {
+		if isolated := requiresIsolatedApply(test.args); isolated != test.isolated {
+			t.Errorf("%d: expected isolated=%t; got %t", i, test.isolated, isolated)
+		}
+	}
+}
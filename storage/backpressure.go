@@ -0,0 +1,145 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/settings"
+	"golang.org/x/net/context"
+)
+
+const (
+	// defaultBackpressureMultiple is the multiple of a range's max bytes
+	// beyond which writes to it are held back, unless the zone config
+	// specifies otherwise.
+	defaultBackpressureMultiple = 2
+	// backpressurePollInterval is the interval at which a held back
+	// write checks whether the range has been split.
+	backpressurePollInterval = 50 * time.Millisecond
+)
+
+var (
+	// backpressureEnabled allows backpressure to be switched off, e.g.
+	// while ranges which can't be split are investigated.
+	backpressureEnabled = settings.RegisterBoolSetting("storage.backpressure.enabled",
+		"hold back writes to ranges grown beyond their backpressure threshold", true)
+	// backpressureTimeout bounds the time a write is held back before it
+	// fails with a retryable error.
+	backpressureTimeout = settings.RegisterDurationSetting("storage.backpressure.timeout",
+		"maximum time a write is held back awaiting a split of its range", 5*time.Second)
+)
+
+// A RangeTooLargeError indicates that a write was held back for too
+// long because the range exceeded its backpressure threshold while
+// waiting to be split. The error is retryable.
+type RangeTooLargeError struct {
+	RaftID    int64
+	Size      int64
+	Threshold int64
+}
+
+// Error formats error.
+func (e *RangeTooLargeError) Error() string {
+	return fmt.Sprintf("range %d size %d exceeds backpressure threshold %d; waiting for split",
+		e.RaftID, e.Size, e.Threshold)
+}
+
+// CanRetry implements the Retryable interface.
+func (e *RangeTooLargeError) CanRetry() bool {
+	return true
+}
+
+// backpressureBytes returns the size beyond which writes to a range
+// of the supplied zone are held back, or zero if they never are.
+func backpressureBytes(zone *proto.ZoneConfig) int64 {
+	multiple := zone.GetRangeBackpressureMultiple()
+	if multiple < 0 || zone.RangeMaxBytes <= 0 {
+		return 0
+	}
+	if multiple == 0 {
+		multiple = defaultBackpressureMultiple
+	}
+	return int64(multiple * float64(zone.RangeMaxBytes))
+}
+
+// GetBackpressureBytes atomically gets the size beyond which writes to
+// the range are held back.
+func (r *Range) GetBackpressureBytes() int64 {
+	return atomic.LoadInt64(&r.backpressureBytes)
+}
+
+// SetBackpressureBytes atomically sets the size beyond which writes to
+// the range are held back; zero disables the backpressure.
+func (r *Range) SetBackpressureBytes(backpressureBytes int64) {
+	atomic.StoreInt64(&r.backpressureBytes, backpressureBytes)
+}
+
+// shouldBackpressure returns whether the write is to be held back
+// because the range exceeds its backpressure threshold. Deletions,
+// which allow the range to shrink, writes to system keys and writes
+// outside of the range, which fail to execute, are not held back.
+func (r *Range) shouldBackpressure(args proto.Request) bool {
+	threshold := r.GetBackpressureBytes()
+	if threshold <= 0 || !backpressureEnabled.Get() || r.stats.GetSize() <= threshold || !proto.IsTransactionWrite(args) {
+		return false
+	}
+	switch args.(type) {
+	case *proto.DeleteRequest, *proto.DeleteRangeRequest:
+		return false
+	}
+	header := args.Header()
+	return bytes.Compare(header.Key, keys.KeySystemMax) >= 0 && r.ContainsKey(header.Key)
+}
+
+// maybeBackpressure holds back a write to a range exceeding its
+// backpressure threshold until the range has been split below it, or
+// the write no longer belongs to it. The range is added to the split
+// queue. If the range remains too large for backpressureTimeout, a
+// RangeTooLargeError is returned; if ctx is done first, its error.
+func (s *Store) maybeBackpressure(ctx context.Context, rng *Range, args proto.Request) error {
+	if !rng.shouldBackpressure(args) {
+		return nil
+	}
+	s.splitQueue.MaybeAdd(rng, s.ctx.Clock.Now())
+	ticker := time.NewTicker(backpressurePollInterval)
+	defer ticker.Stop()
+	timeout := time.After(backpressureTimeout.Get())
+	for rng.shouldBackpressure(args) {
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return &RangeTooLargeError{
+				RaftID:    rng.Desc().RaftID,
+				Size:      rng.stats.GetSize(),
+				Threshold: rng.GetBackpressureBytes(),
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopper.ShouldStop():
+			return util.Errorf("store %d stopped while holding back write to range %d", s.Ident.StoreID, rng.Desc().RaftID)
+		}
+	}
+	return nil
+}
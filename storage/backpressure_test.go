@@ -0,0 +1,97 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/cockroachdb/cockroach/util/settings"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+func TestBackpressureBytes(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		maxBytes int64
+		multiple float64
+		expected int64
+	}{
+		{100, 0, 200},
+		{100, 1.5, 150},
+		{100, -1, 0},
+		{0, 2, 0},
+	}
+	for i, test := range testCases {
+		zone := &proto.ZoneConfig{RangeMaxBytes: test.maxBytes, RangeBackpressureMultiple: gogoproto.Float64(test.multiple)}
+		if b := backpressureBytes(zone); b != test.expected {
+			t.Errorf("%d: expected %d; got %d", i, test.expected, b)
+		}
+	}
+}
+
+// TestRangeShouldBackpressure verifies that writes to a range larger
+// than its backpressure threshold are held back, except for deletions
+// and writes to system keys.
+func TestRangeShouldBackpressure(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	rng := store.LookupRange(keys.KeyMin, nil)
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), rng.Desc().RaftID, store.StoreID())
+	if err := rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if rng.shouldBackpressure(pArgs) {
+		t.Fatal("expected no backpressure without threshold")
+	}
+	rng.SetBackpressureBytes(1)
+	if !rng.shouldBackpressure(pArgs) {
+		t.Error("expected backpressure of put to range beyond threshold")
+	}
+	dArgs, _ := deleteArgs(proto.Key("a"), rng.Desc().RaftID, store.StoreID())
+	if rng.shouldBackpressure(dArgs) {
+		t.Error("expected no backpressure of delete")
+	}
+	sArgs, _ := putArgs(keys.NodeLivenessKey(1), []byte("value"), rng.Desc().RaftID, store.StoreID())
+	if rng.shouldBackpressure(sArgs) {
+		t.Error("expected no backpressure of write to system key")
+	}
+	gArgs, _ := getArgs([]byte("a"), rng.Desc().RaftID, store.StoreID())
+	if rng.shouldBackpressure(gArgs) {
+		t.Error("expected no backpressure of read")
+	}
+	// Disabling backpressure via the cluster setting lets the put through.
+	if err := settings.Update(map[string]string{"storage.backpressure.enabled": "false"}); err != nil {
+		t.Fatal(err)
+	}
+	if rng.shouldBackpressure(pArgs) {
+		t.Error("expected no backpressure with backpressure disabled")
+	}
+	if err := settings.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	rng.SetBackpressureBytes(1 << 30)
+	if rng.shouldBackpressure(pArgs) {
+		t.Error("expected no backpressure of put to range below threshold")
+	}
+}
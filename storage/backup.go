@@ -0,0 +1,295 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"code.google.com/p/go-uuid/uuid"
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// BackupManifestName is the name of the file holding a backup's
+// manifest. It is written once all of the backup's data files have
+// been written, so that its presence marks the backup as complete.
+const BackupManifestName = "BACKUP"
+
+// backupSystemPrefixes are the prefixes of the system keyspace whose
+// keys are included in a backup's manifest, as the backed up data
+// can't be made sense of without them.
+var backupSystemPrefixes = []proto.Key{
+	keys.KeySchemaPrefix,
+	keys.KeyUserPrefix,
+	keys.KeyConfigAccountingPrefix,
+	keys.KeyConfigPermissionPrefix,
+	keys.KeyConfigZonePrefix,
+}
+
+// Backup exports the data in [start, end) as of timestamp into the
+// export storage at uri, one range at a time, and writes a manifest
+// listing the files written. If timestamp is zero, the timestamp
+// assigned to the export of the first range is used for all of them.
+// Since every range is exported as a consistent read at the same
+// timestamp, the backup is a consistent snapshot of the span, provided
+// that the timestamp remains within the GC TTL of the span's zones for
+// the duration of the backup.
+//
+// If startTime is not zero, the backup is incremental, holding only
+// the keys changed since startTime, typically the timestamp of an
+// earlier backup's manifest.
+//
+// The system keyspace isn't backed up range by range. Instead, the
+// manifest holds the schema definitions, users and configs as of the
+// backup's timestamp, which a restore writes before loading the data.
+func Backup(db *client.KV, uri string, format proto.BackupFormat, start, end proto.Key,
+	startTime, timestamp proto.Timestamp) (*proto.BackupManifest, error) {
+	es, err := NewExportStorage(uri)
+	if err != nil {
+		return nil, err
+	}
+	if !start.Less(end) {
+		return nil, util.Errorf("invalid backup span [%q, %q)", start, end)
+	}
+	manifest := &proto.BackupManifest{
+		Format:    format,
+		StartKey:  start,
+		EndKey:    end,
+		StartTime: startTime,
+	}
+	for key := start; key.Less(end); {
+		args := &proto.AdminBackupRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				Timestamp: timestamp,
+			},
+			URI:       uri,
+			Format:    format,
+			LimitKey:  end,
+			StartTime: startTime,
+		}
+		reply := &proto.AdminBackupResponse{}
+		if err := db.Run(client.Call{Args: args, Reply: reply}); err != nil {
+			return nil, util.Errorf("backup of %q failed: %s", key, err)
+		}
+		if !key.Less(reply.File.EndKey) {
+			return nil, util.Errorf("backup of %q made no progress", key)
+		}
+		timestamp = reply.Timestamp
+		manifest.Files = append(manifest.Files, reply.File)
+		key = reply.File.EndKey
+	}
+	manifest.Timestamp = timestamp
+	if manifest.SystemKeys, err = backupSystemKeys(db, timestamp); err != nil {
+		return nil, util.Errorf("backup of system keys failed: %s", err)
+	}
+
+	data, err := gogoproto.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := es.WriteFile(BackupManifestName, bytes.NewReader(data)); err != nil {
+		return nil, util.Errorf("unable to write backup manifest: %s", err)
+	}
+	return manifest, nil
+}
+
+// backupSystemKeys returns the keys under backupSystemPrefixes as of
+// timestamp.
+func backupSystemKeys(db *client.KV, timestamp proto.Timestamp) ([]proto.KeyValue, error) {
+	var kvs []proto.KeyValue
+	for _, prefix := range backupSystemPrefixes {
+		call := client.ScanCall(prefix, prefix.PrefixEnd(), 0)
+		call.Args.Header().Timestamp = timestamp
+		if err := db.Run(call); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, call.Reply.(*proto.ScanResponse).Rows...)
+	}
+	return kvs, nil
+}
+
+// AdminBackup exports the range's data from the request's key up to
+// the lesser of its limit key and the end of the range, as of the
+// request's timestamp, into a new file in the request's export
+// storage. The export is a consistent read: it waits for overlapping
+// commands in the command queue and adds its timestamp to the read
+// timestamp cache so that no later write may slip beneath it, while
+// intents which it encounters result in a WriteIntentError, which the
+// store resolves before retrying.
+func (r *Range) AdminBackup(args *proto.AdminBackupRequest, reply *proto.AdminBackupResponse) {
+	desc := r.Desc()
+	start, end := args.Key, desc.EndKey
+	if len(args.LimitKey) > 0 && args.LimitKey.Less(end) {
+		end = args.LimitKey
+	}
+	if !start.Less(end) {
+		reply.SetGoError(util.Errorf("empty backup span [%q, %q) in range %d", start, end, desc.RaftID))
+		return
+	}
+	es, err := NewExportStorage(args.URI)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+
+	cmdKey, err := r.beginCmd(context.Background(), start, end, true, args.Header().Priority)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	defer func() {
+		r.Lock()
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+	}()
+	r.Lock()
+	r.tsCache.Add(start, end, args.Timestamp, proto.NoTxnMD5, true /* readOnly */)
+	r.Unlock()
+
+	var kvs []proto.KeyValue
+	if args.StartTime.Equal(proto.ZeroTimestamp) {
+		kvs, err = engine.MVCCScan(r.rm.Engine(), start, end, 0, args.Timestamp, true, nil)
+	} else if !args.StartTime.Less(args.Timestamp) {
+		err = util.Errorf("incremental backup start time %s not before timestamp %s",
+			args.StartTime, args.Timestamp)
+	} else {
+		kvs, err = backupChanges(r.rm.Engine(), start, end, args.StartTime, args.Timestamp)
+	}
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	reply.Timestamp = args.Timestamp
+	reply.File = proto.BackupFile{
+		StartKey: start,
+		EndKey:   end,
+		Count:    int64(len(kvs)),
+	}
+	if len(kvs) == 0 {
+		return
+	}
+
+	name := fmt.Sprintf("%d-%s", desc.RaftID, uuid.New())
+	var content io.Reader
+	switch args.Format {
+	case proto.PROTO:
+		name += ".pb"
+		var buf bytes.Buffer
+		if err := writeBackupProto(&buf, kvs); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		content = &buf
+	default:
+		reply.SetGoError(util.Errorf("unknown backup format %s", args.Format))
+		return
+	}
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	if err := es.WriteFile(name, io.TeeReader(content, io.MultiWriter(hash, counter))); err != nil {
+		reply.SetGoError(util.Errorf("unable to export backup of range %d: %s", desc.RaftID, err))
+		return
+	}
+	reply.File.Name = name
+	reply.File.Bytes = counter.n
+	reply.File.Checksum = hash.Sum(nil)
+}
+
+// backupChanges returns the keys in [start, end) changed in
+// (startTime, endTime] with their values as of endTime. Deleted keys
+// are returned with an empty value.
+func backupChanges(e engine.Engine, start, end proto.Key, startTime, endTime proto.Timestamp) (
+	[]proto.KeyValue, error) {
+	var kvs []proto.KeyValue
+	err := engine.MVCCIterateChanges(e, start, end, startTime, endTime,
+		func(key proto.Key, value *proto.Value) (bool, error) {
+			kv := proto.KeyValue{Key: key}
+			if value != nil {
+				kv.Value = *value
+			}
+			kvs = append(kvs, kv)
+			return false, nil
+		})
+	return kvs, err
+}
+
+// writeBackupProto writes kvs to w as a sequence of marshaled
+// KeyValue messages, each prefixed by its length as a uvarint.
+func writeBackupProto(w io.Writer, kvs []proto.KeyValue) error {
+	var lenBuf [binary.MaxVarintLen64]byte
+	for i := range kvs {
+		data, err := gogoproto.Marshal(&kvs[i])
+		if err != nil {
+			return err
+		}
+		n := binary.PutUvarint(lenBuf[:], uint64(len(data)))
+		if _, err := w.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readBackupProto reads the key/value pairs written by
+// writeBackupProto from r.
+func readBackupProto(r io.Reader) ([]proto.KeyValue, error) {
+	br := bufio.NewReader(r)
+	var kvs []proto.KeyValue
+	for {
+		n, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return kvs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, err
+		}
+		var kv proto.KeyValue
+		if err := gogoproto.Unmarshal(data, &kv); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
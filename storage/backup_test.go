@@ -0,0 +1,237 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"golang.org/x/net/context"
+)
+
+// TestAdminBackup verifies that a range's data is exported as of the
+// backup's timestamp and that later writes to the exported span are
+// pushed above it.
+func TestAdminBackup(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	dir, err := ioutil.TempDir("", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, key := range []string{"a", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value-"+key), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	timestamp := tc.clock.Now()
+	args := &proto.AdminBackupRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			Timestamp: timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		},
+		URI:      dir,
+		Format:   proto.PROTO,
+		LimitKey: proto.Key("c"),
+	}
+	reply := &proto.AdminBackupResponse{}
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.File.StartKey.Equal(proto.Key("a")) || !reply.File.EndKey.Equal(proto.Key("c")) {
+		t.Errorf("expected span [a, c); got [%q, %q)", reply.File.StartKey, reply.File.EndKey)
+	}
+	if reply.File.Count != 2 {
+		t.Errorf("expected 2 exported keys; got %d", reply.File.Count)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, reply.File.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := sha256.Sum256(data); !bytes.Equal(sum[:], reply.File.Checksum) {
+		t.Errorf("checksum mismatch")
+	}
+	if int64(len(data)) != reply.File.Bytes {
+		t.Errorf("expected size %d; got %d", len(data), reply.File.Bytes)
+	}
+	kvs, err := readBackupProto(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range []string{"a", "b"} {
+		if i >= len(kvs) || !kvs[i].Key.Equal(proto.Key(key)) ||
+			!bytes.Equal(kvs[i].Value.Bytes, []byte("value-"+key)) {
+			t.Errorf("%d: expected %q; got %+v", i, key, kvs)
+		}
+	}
+
+	// A write beneath the backup's timestamp is pushed above it.
+	pArgs, pReply := putArgs([]byte("b"), []byte("new"), 1, tc.store.StoreID())
+	pArgs.Timestamp = proto.Timestamp{WallTime: timestamp.WallTime - 1}
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !timestamp.Less(pReply.Timestamp) {
+		t.Errorf("expected write to be pushed above %s; got %s", timestamp, pReply.Timestamp)
+	}
+}
+
+// TestAdminBackupIncremental verifies that an incremental backup
+// exports only the keys changed since its start time, including
+// deletions.
+func TestAdminBackupIncremental(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	dir, err := ioutil.TempDir("", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, key := range []string{"a", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value-"+key), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	startTime := tc.clock.Now()
+	pArgs, pReply := putArgs([]byte("b"), []byte("new"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	dArgs, dReply := deleteArgs([]byte("c"), 1, tc.store.StoreID())
+	dArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), dArgs, dReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	args := &proto.AdminBackupRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		},
+		URI:       dir,
+		Format:    proto.PROTO,
+		LimitKey:  proto.Key("d"),
+		StartTime: startTime,
+	}
+	reply := &proto.AdminBackupResponse{}
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, reply.File.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := readBackupProto(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 || !kvs[0].Key.Equal(proto.Key("b")) || !bytes.Equal(kvs[0].Value.Bytes, []byte("new")) ||
+		!kvs[1].Key.Equal(proto.Key("c")) || kvs[1].Value.Bytes != nil {
+		t.Errorf("expected changes to b and deletion of c; got %+v", kvs)
+	}
+}
+
+// TestExportStorage verifies that files round trip through local and
+// HTTP export storage.
+func TestExportStorage(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir, err := ioutil.TempDir("", "export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var mu sync.Mutex
+	files := map[string][]byte{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case "PUT":
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			files[r.URL.Path] = data
+		case "GET":
+			data, ok := files[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write(data)
+		}
+	}))
+	defer s.Close()
+
+	for _, uri := range []string{dir, "file://" + dir, s.URL + "/backups/"} {
+		es, err := NewExportStorage(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := es.WriteFile("file", bytes.NewReader([]byte("content"))); err != nil {
+			t.Fatalf("%s: %s", uri, err)
+		}
+		r, err := es.ReadFile("file")
+		if err != nil {
+			t.Fatalf("%s: %s", uri, err)
+		}
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil || string(data) != "content" {
+			t.Errorf("%s: expected %q; got %q (%v)", uri, "content", data, err)
+		}
+		if _, err := es.ReadFile("missing"); err == nil {
+			t.Errorf("%s: expected error reading missing file", uri)
+		}
+	}
+
+	if _, err := NewExportStorage("ftp://host/dir"); err == nil {
+		t.Error("expected error for unsupported scheme")
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// A CapacityGossiper gossips the descriptors of all of a node's stores
+// as a single info. The descriptors are only re-gossiped once one of
+// the stores' available space or range count has moved beyond the
+// thresholds of the StoreContext since it was last gossiped, the set
+// of stores has changed, or the gossiped info is about to go stale.
+//
+// A CapacityGossiper is not thread safe.
+type CapacityGossiper struct {
+	gossip         *gossip.Gossip
+	availableDelta float64
+	rangeDelta     int
+	maxStaleness   time.Duration
+	last           []StoreDescriptor // As last gossiped, sorted by store ID
+	lastTime       time.Time
+}
+
+// NewCapacityGossiper returns a CapacityGossiper which adds infos to
+// g, using the thresholds of ctx.
+func NewCapacityGossiper(g *gossip.Gossip, ctx StoreContext) *CapacityGossiper {
+	maxStaleness := ctx.CapacityGossipMaxStaleness
+	// Re-gossip well before the info expires.
+	if maxStaleness <= 0 || maxStaleness > ttlCapacityGossip/2 {
+		maxStaleness = ttlCapacityGossip / 2
+	}
+	return &CapacityGossiper{
+		gossip:         g,
+		availableDelta: ctx.CapacityGossipAvailableDelta,
+		rangeDelta:     ctx.CapacityGossipRangeDelta,
+		maxStaleness:   maxStaleness,
+	}
+}
+
+// MaybeGossip gossips the descriptors of the stores of the given node
+// if they have changed sufficiently since they were last gossiped, or
+// the last gossip is older than the maximum staleness. Returns whether
+// the descriptors were gossiped.
+func (cg *CapacityGossiper) MaybeGossip(nodeID proto.NodeID, descs []StoreDescriptor, now time.Time) (bool, error) {
+	descs = append([]StoreDescriptor(nil), descs...)
+	sort.Sort(storeDescriptorsByID(descs))
+	if !cg.shouldGossip(descs, now) {
+		return false, nil
+	}
+	if err := cg.gossip.AddInfo(gossip.MakeMaxAvailCapacityKey(nodeID), descs, ttlCapacityGossip); err != nil {
+		return false, err
+	}
+	cg.last = descs
+	cg.lastTime = now
+	return true, nil
+}
+
+// shouldGossip returns whether the supplied descriptors, sorted by
+// store ID, should be gossiped.
+func (cg *CapacityGossiper) shouldGossip(descs []StoreDescriptor, now time.Time) bool {
+	if cg.last == nil || now.Sub(cg.lastTime) >= cg.maxStaleness || len(descs) != len(cg.last) {
+		return true
+	}
+	for i := range descs {
+		desc, last := &descs[i], &cg.last[i]
+		if desc.StoreID != last.StoreID {
+			return true
+		}
+		availDelta := desc.Capacity.Available - last.Capacity.Available
+		if availDelta < 0 {
+			availDelta = -availDelta
+		}
+		if availDelta > 0 && float64(availDelta) >= cg.availableDelta*float64(desc.Capacity.Capacity) {
+			return true
+		}
+		rangeDelta := desc.RangeCount - last.RangeCount
+		if rangeDelta < 0 {
+			rangeDelta = -rangeDelta
+		}
+		if rangeDelta > 0 && rangeDelta >= cg.rangeDelta {
+			return true
+		}
+	}
+	return false
+}
+
+// storeDescriptorsByID sorts store descriptors by store ID.
+type storeDescriptorsByID []StoreDescriptor
+
+func (s storeDescriptorsByID) Len() int           { return len(s) }
+func (s storeDescriptorsByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s storeDescriptorsByID) Less(i, j int) bool { return s[i].StoreID < s[j].StoreID }
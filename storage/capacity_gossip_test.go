@@ -0,0 +1,114 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func makeCapacityDesc(storeID proto.StoreID, available int64, rangeCount int) StoreDescriptor {
+	return StoreDescriptor{
+		StoreID:    storeID,
+		Capacity:   engine.StoreCapacity{Capacity: 1000, Available: available},
+		RangeCount: rangeCount,
+	}
+}
+
+// TestCapacityGossiperThresholds verifies that store capacities are
+// only re-gossiped after changing beyond the thresholds, on a change
+// of the set of stores, or once the last gossip has gone stale.
+func TestCapacityGossiperThresholds(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	cg := NewCapacityGossiper(nil, StoreContext{
+		CapacityGossipAvailableDelta: 0.05,
+		CapacityGossipRangeDelta:     10,
+		CapacityGossipMaxStaleness:   time.Minute,
+	})
+	start := time.Unix(0, 0)
+	cg.last = []StoreDescriptor{makeCapacityDesc(1, 500, 20), makeCapacityDesc(2, 500, 20)}
+	cg.lastTime = start
+
+	testCases := []struct {
+		descs    []StoreDescriptor
+		elapsed  time.Duration
+		expected bool
+	}{
+		// Unchanged.
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 20), makeCapacityDesc(2, 500, 20)}, time.Second, false},
+		// Changes below the thresholds.
+		{[]StoreDescriptor{makeCapacityDesc(1, 460, 20), makeCapacityDesc(2, 500, 11)}, time.Second, false},
+		// Changes reaching the available space or range count thresholds.
+		{[]StoreDescriptor{makeCapacityDesc(1, 450, 20), makeCapacityDesc(2, 500, 20)}, time.Second, true},
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 20), makeCapacityDesc(2, 550, 20)}, time.Second, true},
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 20), makeCapacityDesc(2, 500, 30)}, time.Second, true},
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 10), makeCapacityDesc(2, 500, 20)}, time.Second, true},
+		// A store added, removed or replaced.
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 20)}, time.Second, true},
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 20), makeCapacityDesc(3, 500, 20)}, time.Second, true},
+		// Stale.
+		{[]StoreDescriptor{makeCapacityDesc(1, 500, 20), makeCapacityDesc(2, 500, 20)}, time.Minute, true},
+	}
+	for i, test := range testCases {
+		if actual := cg.shouldGossip(test.descs, start.Add(test.elapsed)); actual != test.expected {
+			t.Errorf("%d: expected shouldGossip %t; got %t", i, test.expected, actual)
+		}
+	}
+}
+
+// TestCapacityGossiperMaxStaleness verifies that the maximum
+// staleness is capped below the time-to-live of the gossip.
+func TestCapacityGossiperMaxStaleness(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	for _, staleness := range []time.Duration{0, ttlCapacityGossip} {
+		cg := NewCapacityGossiper(nil, StoreContext{CapacityGossipMaxStaleness: staleness})
+		if cg.maxStaleness != ttlCapacityGossip/2 {
+			t.Errorf("expected max staleness %s for %s; got %s", ttlCapacityGossip/2, staleness, cg.maxStaleness)
+		}
+	}
+}
+
+// TestCapacityGossiperBatchesStores verifies that the descriptors of
+// all of a node's stores are gossiped as a single info, and that
+// unchanged descriptors aren't re-gossiped.
+func TestCapacityGossiperBatchesStores(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	cg := NewCapacityGossiper(s.ctx.Gossip, StoreContext{})
+	now := time.Now()
+	descs := []StoreDescriptor{makeCapacityDesc(3, 500, 1), makeCapacityDesc(2, 500, 1)}
+	if gossiped, err := cg.MaybeGossip(2, descs, now); err != nil || !gossiped {
+		t.Fatalf("expected descriptors to be gossiped; got %t, %v", gossiped, err)
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if stores, _ := s.findStores(proto.Attributes{}); len(stores) != 2 {
+			return util.Errorf("expected two stores found, instead %+v", stores)
+		}
+		return nil
+	})
+	if gossiped, err := cg.MaybeGossip(2, descs, now.Add(time.Second)); err != nil || gossiped {
+		t.Errorf("expected unchanged descriptors not to be gossiped; got %t, %v", gossiped, err)
+	}
+}
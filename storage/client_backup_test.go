@@ -0,0 +1,137 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// TestStoreBackupRestore verifies that a backup of one store, spanning
+// several ranges, is restored into another along with its range
+// boundaries and the system keys describing its data.
+func TestStoreBackupRestore(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	dir, err := ioutil.TempDir("", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testKeys := []string{"a", "b", "c"}
+	for _, key := range testKeys {
+		if err := store.DB().Run(client.PutCall(proto.Key(key), []byte("value-"+key))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	schemaKey := keys.MakeKey(keys.KeySchemaPrefix, proto.Key("test"))
+	if err := store.DB().Run(client.PutCall(schemaKey, []byte("schema"))); err != nil {
+		t.Fatal(err)
+	}
+	args, reply := adminSplitArgs(keys.KeyMin, []byte("b"), 1, store.StoreID())
+	if err := store.DB().Run(client.Call{Args: args, Reply: reply}); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := storage.Backup(store.DB(), dir, proto.PROTO, keys.KeySystemMax, keys.KeyMax,
+		proto.ZeroTimestamp, proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Files) != 2 {
+		t.Fatalf("expected a file per range; got %+v", manifest.Files)
+	}
+	var backedUp bool
+	for _, kv := range manifest.SystemKeys {
+		backedUp = backedUp || kv.Key.Equal(schemaKey)
+	}
+	if !backedUp {
+		t.Errorf("expected schema key in the manifest's system keys; got %+v", manifest.SystemKeys)
+	}
+
+	restoreStore, restoreStopper := createTestStore(t)
+	defer restoreStopper.Stop()
+	restoreArgs := &proto.AdminRestoreRequest{
+		RequestHeader: proto.RequestHeader{Key: keys.KeyMin},
+		URI:           dir,
+		JobID:         "test",
+	}
+	restoreReply := &proto.AdminRestoreResponse{}
+	if err := restoreStore.DB().Run(client.Call{Args: restoreArgs, Reply: restoreReply}); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		status := &proto.RestoreStatus{}
+		call := client.GetCall(keys.RestoreStatusKey(restoreReply.JobID))
+		if err := restoreStore.DB().Run(call); err != nil {
+			return err
+		}
+		if value := call.Reply.(*proto.GetResponse).Value; value != nil {
+			if err := gogoproto.Unmarshal(value.Bytes, status); err != nil {
+				return err
+			}
+		}
+		if !status.Done {
+			return util.Errorf("restore not done: %+v", status)
+		}
+		if status.Error != "" {
+			t.Fatalf("restore failed: %s", status.Error)
+		}
+		if status.RestoredKeys != int64(len(testKeys)) {
+			t.Errorf("expected %d restored keys; got %d", len(testKeys), status.RestoredKeys)
+		}
+		return nil
+	})
+
+	for _, key := range testKeys {
+		call := client.GetCall(proto.Key(key))
+		if err := restoreStore.DB().Run(call); err != nil {
+			t.Fatal(err)
+		}
+		if value := call.Reply.(*proto.GetResponse).Value; value == nil ||
+			!bytes.Equal(value.Bytes, []byte("value-"+key)) {
+			t.Errorf("expected %q to be restored; got %+v", key, value)
+		}
+	}
+	call := client.GetCall(schemaKey)
+	if err := restoreStore.DB().Run(call); err != nil {
+		t.Fatal(err)
+	}
+	if value := call.Reply.(*proto.GetResponse).Value; value == nil || !bytes.Equal(value.Bytes, []byte("schema")) {
+		t.Errorf("expected schema key to be restored; got %+v", value)
+	}
+	for _, key := range []string{string(keys.KeySystemMax), "b"} {
+		if rng := restoreStore.LookupRange(proto.Key(key), nil); rng == nil || !rng.Desc().StartKey.Equal(proto.Key(key)) {
+			t.Errorf("expected a range to start at %q", key)
+		}
+	}
+}
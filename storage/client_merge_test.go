@@ -22,11 +22,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
 )
 
 func adminMergeArgs(key []byte, raftID int64, storeID proto.StoreID) (*proto.AdminMergeRequest, *proto.AdminMergeResponse) {
@@ -42,8 +44,8 @@ func adminMergeArgs(key []byte, raftID int64, storeID proto.StoreID) (*proto.Adm
 }
 
 func createSplitRanges(store *storage.Store) (*proto.RangeDescriptor, *proto.RangeDescriptor, error) {
-	args, reply := adminSplitArgs(engine.KeyMin, []byte("b"), 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminSplitArgs(keys.KeyMin, []byte("b"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		return nil, nil, err
 	}
 
@@ -70,8 +72,8 @@ func TestStoreRangeMergeTwoEmptyRanges(t *testing.T) {
 	}
 
 	// Merge the b range back into the a range.
-	args, reply := adminMergeArgs(engine.KeyMin, 1, store.StoreID())
-	err = store.ExecuteCmd(args, reply)
+	args, reply := adminMergeArgs(keys.KeyMin, 1, store.StoreID())
+	err = store.ExecuteCmd(context.Background(), args, reply)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -101,34 +103,34 @@ func TestStoreRangeMergeWithData(t *testing.T) {
 
 	// Write some values left and right of the proposed split key.
 	pArgs, pReply := putArgs([]byte("aaa"), content, aDesc.RaftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 	pArgs, pReply = putArgs([]byte("ccc"), content, bDesc.RaftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 
 	// Confirm the values are there.
 	gArgs, gReply := getArgs([]byte("aaa"), aDesc.RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
 	gArgs, gReply = getArgs([]byte("ccc"), bDesc.RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
 
 	// Merge the b range back into the a range.
-	args, reply := adminMergeArgs(engine.KeyMin, 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminMergeArgs(keys.KeyMin, 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
 	// Verify no intents remains on range descriptor keys.
-	for _, key := range []proto.Key{engine.RangeDescriptorKey(aDesc.StartKey), engine.RangeDescriptorKey(bDesc.StartKey)} {
+	for _, key := range []proto.Key{keys.RangeDescriptorKey(aDesc.StartKey), keys.RangeDescriptorKey(bDesc.StartKey)} {
 		if _, err := engine.MVCCGet(store.Engine(), key, store.Clock().Now(), true, nil); err != nil {
 			t.Fatal(err)
 		}
@@ -141,42 +143,42 @@ func TestStoreRangeMergeWithData(t *testing.T) {
 	if !reflect.DeepEqual(rangeA, rangeB) {
 		t.Fatalf("ranges were not merged %+v=%+v", rangeA.Desc(), rangeB.Desc())
 	}
-	if !bytes.Equal(rangeA.Desc().StartKey, engine.KeyMin) {
-		t.Fatalf("The start key is not equal to KeyMin %q=%q", rangeA.Desc().StartKey, engine.KeyMin)
+	if !bytes.Equal(rangeA.Desc().StartKey, keys.KeyMin) {
+		t.Fatalf("The start key is not equal to KeyMin %q=%q", rangeA.Desc().StartKey, keys.KeyMin)
 	}
-	if !bytes.Equal(rangeA.Desc().EndKey, engine.KeyMax) {
-		t.Fatalf("The end key is not equal to KeyMax %q=%q", rangeA.Desc().EndKey, engine.KeyMax)
+	if !bytes.Equal(rangeA.Desc().EndKey, keys.KeyMax) {
+		t.Fatalf("The end key is not equal to KeyMax %q=%q", rangeA.Desc().EndKey, keys.KeyMax)
 	}
 
 	// Try to get values from after the merge.
 	gArgs, gReply = getArgs([]byte("aaa"), rangeA.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
 	gArgs, gReply = getArgs([]byte("ccc"), rangeB.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
 
 	// Put new values after the merge on both sides.
 	pArgs, pReply = putArgs([]byte("aaaa"), content, rangeA.Desc().RaftID, store.StoreID())
-	if err = store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err = store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 	pArgs, pReply = putArgs([]byte("cccc"), content, rangeB.Desc().RaftID, store.StoreID())
-	if err = store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err = store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 
 	// Try to get the newly placed values.
 	gArgs, gReply = getArgs([]byte("aaaa"), rangeA.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil || !bytes.Equal(gReply.Value.Bytes, content) {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil || !bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
 	gArgs, gReply = getArgs([]byte("cccc"), rangeA.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
@@ -189,8 +191,8 @@ func TestStoreRangeMergeLastRange(t *testing.T) {
 	defer stopper.Stop()
 
 	// Merge last range.
-	args, reply := adminMergeArgs(engine.KeyMin, 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminMergeArgs(keys.KeyMin, 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatalf("merge of last range should be a noop: %s", err)
 	}
 }
@@ -203,12 +205,12 @@ func disabledTestStoreRangeMergeNonConsecutive(t *testing.T) {
 	defer stopper.Stop()
 
 	// Split into 3 ranges
-	argsSplit, replySplit := adminSplitArgs(engine.KeyMin, []byte("d"), 1, store.StoreID())
-	if err := store.ExecuteCmd(argsSplit, replySplit); err != nil {
+	argsSplit, replySplit := adminSplitArgs(keys.KeyMin, []byte("d"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), argsSplit, replySplit); err != nil {
 		t.Fatalf("Can't split range %s", err)
 	}
-	argsSplit, replySplit = adminSplitArgs(engine.KeyMin, []byte("b"), 1, store.StoreID())
-	if err := store.ExecuteCmd(argsSplit, replySplit); err != nil {
+	argsSplit, replySplit = adminSplitArgs(keys.KeyMin, []byte("b"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), argsSplit, replySplit); err != nil {
 		t.Fatalf("Can't split range %s", err)
 	}
 
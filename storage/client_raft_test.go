@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -31,6 +32,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
 )
 
 // TestStoreRecoverFromEngine verifies that the store recovers all ranges and their contents
@@ -49,7 +51,7 @@ func TestStoreRecoverFromEngine(t *testing.T) {
 
 	get := func(store *storage.Store, raftID int64, key proto.Key) int64 {
 		args, resp := getArgs(key, raftID, store.StoreID())
-		err := store.ExecuteCmd(args, resp)
+		err := store.ExecuteCmd(context.Background(), args, resp)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -72,7 +74,7 @@ func TestStoreRecoverFromEngine(t *testing.T) {
 
 		increment := func(raftID int64, key proto.Key, value int64) (*proto.IncrementResponse, error) {
 			args, resp := incrementArgs(key, value, raftID, store.StoreID())
-			err := store.ExecuteCmd(args, resp)
+			err := store.ExecuteCmd(context.Background(), args, resp)
 			return resp, err
 		}
 
@@ -82,8 +84,8 @@ func TestStoreRecoverFromEngine(t *testing.T) {
 		if _, err := increment(raftID, key2, 5); err != nil {
 			t.Fatal(err)
 		}
-		splitArgs, splitResp := adminSplitArgs(engine.KeyMin, splitKey, raftID, store.StoreID())
-		if err := store.ExecuteCmd(splitArgs, splitResp); err != nil {
+		splitArgs, splitResp := adminSplitArgs(keys.KeyMin, splitKey, raftID, store.StoreID())
+		if err := store.ExecuteCmd(context.Background(), splitArgs, splitResp); err != nil {
 			t.Fatal(err)
 		}
 		raftID2 = store.LookupRange(key2, nil).Desc().RaftID
@@ -108,11 +110,11 @@ func TestStoreRecoverFromEngine(t *testing.T) {
 	// Raft processing is initialized lazily; issue a no-op write request on each key to
 	// ensure that is has been started.
 	incArgs, incReply := incrementArgs(key1, 0, raftID, store.StoreID())
-	if err := store.ExecuteCmd(incArgs, incReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), incArgs, incReply); err != nil {
 		t.Fatal(err)
 	}
 	incArgs, incReply = incrementArgs(key2, 0, raftID2, store.StoreID())
-	if err := store.ExecuteCmd(incArgs, incReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), incArgs, incReply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -145,14 +147,14 @@ func TestStoreRecoverWithErrors(t *testing.T) {
 
 		// Write a bytes value so the increment will fail.
 		putArgs, putReply := putArgs(proto.Key("a"), []byte("asdf"), 1, store.StoreID())
-		if err := store.ExecuteCmd(putArgs, putReply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), putArgs, putReply); err != nil {
 			t.Fatal(err)
 		}
 
 		// Try and fail to increment the key. It is important for this test that the
 		// failure be the last thing in the raft log when the store is stopped.
 		incArgs, incReply := incrementArgs(proto.Key("a"), 42, 1, store.StoreID())
-		if err := store.ExecuteCmd(incArgs, incReply); err == nil {
+		if err := store.ExecuteCmd(context.Background(), incArgs, incReply); err == nil {
 			t.Fatal("did not get expected error")
 		}
 	}()
@@ -167,7 +169,7 @@ func TestStoreRecoverWithErrors(t *testing.T) {
 
 	// Issue a no-op write to lazily initialize raft on the range.
 	incArgs, incReply := incrementArgs(proto.Key("b"), 0, 1, store.StoreID())
-	if err := store.ExecuteCmd(incArgs, incReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), incArgs, incReply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -187,7 +189,7 @@ func TestReplicateRange(t *testing.T) {
 
 	// Issue a command on the first node before replicating.
 	incArgs, incResp := incrementArgs([]byte("a"), 5, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
@@ -205,7 +207,7 @@ func TestReplicateRange(t *testing.T) {
 		t.Fatal(err)
 	}
 	// Verify no intent remain on range descriptor key.
-	key := engine.RangeDescriptorKey(rng.Desc().StartKey)
+	key := keys.RangeDescriptorKey(rng.Desc().StartKey)
 	if _, err := engine.MVCCGet(mtc.stores[0].Engine(), key, mtc.stores[0].Clock().Now(), true, nil); err != nil {
 		t.Fatal(err)
 	}
@@ -217,7 +219,7 @@ func TestReplicateRange(t *testing.T) {
 	// Also applies to other tests in this file.
 	if err := util.IsTrueWithin(func() bool {
 		getArgs, getResp := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
-		if err := mtc.stores[1].ExecuteCmd(getArgs, getResp); err != nil {
+		if err := mtc.stores[1].ExecuteCmd(context.Background(), getArgs, getResp); err != nil {
 			return false
 		}
 		return getResp.Value.GetInteger() == 5
@@ -226,6 +228,51 @@ func TestReplicateRange(t *testing.T) {
 	}
 }
 
+// TestReplicateRangeViaLearner verifies that a replica added as a
+// learner is left out of the range's Raft configuration until it is
+// promoted.
+func TestReplicateRangeViaLearner(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := multiTestContext{}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+
+	rng, err := mtc.stores[0].GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replica := proto.Replica{
+		NodeID:  mtc.stores[1].Ident.NodeID,
+		StoreID: mtc.stores[1].Ident.StoreID,
+	}
+	if err := rng.ChangeReplicas(proto.ADD_LEARNER, replica); err != nil {
+		t.Fatal(err)
+	}
+	desc := rng.Desc()
+	if len(desc.Replicas) != 2 || !desc.Replicas[1].Learner {
+		t.Fatalf("expected a learner to be added; got replicas %+v", desc.Replicas)
+	}
+	if _, cs, err := rng.InitialState(); err != nil {
+		t.Fatal(err)
+	} else if len(cs.Nodes) != 1 {
+		t.Fatalf("expected learner to be left out of the configuration; got %v", cs.Nodes)
+	}
+
+	// Adding the learner as a replica promotes it.
+	if err := rng.ChangeReplicas(proto.ADD_REPLICA, replica); err != nil {
+		t.Fatal(err)
+	}
+	desc = rng.Desc()
+	if len(desc.Replicas) != 2 || desc.Replicas[1].Learner {
+		t.Fatalf("expected learner to be promoted; got replicas %+v", desc.Replicas)
+	}
+	if _, cs, err := rng.InitialState(); err != nil {
+		t.Fatal(err)
+	} else if len(cs.Nodes) != 2 {
+		t.Fatalf("expected two voters; got %v", cs.Nodes)
+	}
+}
+
 // TestRestoreReplicas ensures that consensus group membership is properly
 // persisted to disk and restored when a node is stopped and restarted.
 func TestRestoreReplicas(t *testing.T) {
@@ -242,7 +289,7 @@ func TestRestoreReplicas(t *testing.T) {
 	// Perform an increment before replication to ensure that commands are not
 	// repeated on restarts.
 	incArgs, incResp := incrementArgs([]byte("a"), 23, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
@@ -270,17 +317,17 @@ func TestRestoreReplicas(t *testing.T) {
 	// Send a command on each store. The follower will forward to the leader and both
 	// commands will eventually commit.
 	incArgs, incResp = incrementArgs([]byte("a"), 5, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 	incArgs, incResp = incrementArgs([]byte("a"), 11, 1, mtc.stores[1].StoreID())
-	if err := mtc.stores[1].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[1].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
 	if err := util.IsTrueWithin(func() bool {
 		getArgs, getResp := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
-		if err := mtc.stores[1].ExecuteCmd(getArgs, getResp); err != nil {
+		if err := mtc.stores[1].ExecuteCmd(context.Background(), getArgs, getResp); err != nil {
 			return false
 		}
 		return getResp.Value.GetInteger() == 39
@@ -390,7 +437,7 @@ func TestReplicateAfterTruncation(t *testing.T) {
 
 	// Issue a command on the first node before replicating.
 	incArgs, incResp := incrementArgs([]byte("a"), 5, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
@@ -403,13 +450,13 @@ func TestReplicateAfterTruncation(t *testing.T) {
 	// Truncate the log at index+1 (log entries < N are removed, so this includes
 	// the increment).
 	truncArgs, truncResp := internalTruncateLogArgs(index+1, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(truncArgs, truncResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), truncArgs, truncResp); err != nil {
 		t.Fatal(err)
 	}
 
 	// Issue a second command post-truncation.
 	incArgs, incResp = incrementArgs([]byte("a"), 11, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
@@ -426,7 +473,7 @@ func TestReplicateAfterTruncation(t *testing.T) {
 	// Once it catches up, the effects of both commands can be seen.
 	if err := util.IsTrueWithin(func() bool {
 		getArgs, getResp := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
-		if err := mtc.stores[1].ExecuteCmd(getArgs, getResp); err != nil {
+		if err := mtc.stores[1].ExecuteCmd(context.Background(), getArgs, getResp); err != nil {
 			return false
 		}
 		log.Infof("read value %d", getResp.Value.GetInteger())
@@ -438,13 +485,13 @@ func TestReplicateAfterTruncation(t *testing.T) {
 	// Send a third command to verify that the log states are synced up so the
 	// new node can accept new commands.
 	incArgs, incResp = incrementArgs([]byte("a"), 23, 1, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
 	if err := util.IsTrueWithin(func() bool {
 		getArgs, getResp := getArgs([]byte("a"), 1, mtc.stores[1].StoreID())
-		if err := mtc.stores[1].ExecuteCmd(getArgs, getResp); err != nil {
+		if err := mtc.stores[1].ExecuteCmd(context.Background(), getArgs, getResp); err != nil {
 			return false
 		}
 		log.Infof("read value %d", getResp.Value.GetInteger())
@@ -496,7 +543,7 @@ func TestProgressWithDownNode(t *testing.T) {
 	mtc.replicateRange(raftID, 0, 1, 2)
 
 	incArgs, incResp := incrementArgs([]byte("a"), 5, raftID, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
@@ -522,7 +569,7 @@ func TestProgressWithDownNode(t *testing.T) {
 	// Stop one of the replicas and issue a new increment.
 	mtc.stopStore(1)
 	incArgs, incResp = incrementArgs([]byte("a"), 11, raftID, mtc.stores[0].StoreID())
-	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 		t.Fatal(err)
 	}
 
@@ -548,7 +595,7 @@ func TestReplicateAddAndRemove(t *testing.T) {
 		mtc.replicateRange(raftID, 0, 3, 1)
 
 		incArgs, incResp := incrementArgs([]byte("a"), 5, raftID, mtc.stores[0].StoreID())
-		if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+		if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 			t.Fatal(err)
 		}
 
@@ -585,7 +632,7 @@ func TestReplicateAddAndRemove(t *testing.T) {
 
 		// Ensure that the rest of the group can make progress.
 		incArgs, incResp = incrementArgs([]byte("a"), 11, raftID, mtc.stores[0].StoreID())
-		if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+		if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 			t.Fatal(err)
 		}
 		verify([]int64{16, 5, 16, 16})
@@ -596,7 +643,7 @@ func TestReplicateAddAndRemove(t *testing.T) {
 		// Node 1 never sees the increment that was added while it was
 		// down. Perform another increment on the live nodes to verify.
 		incArgs, incResp = incrementArgs([]byte("a"), 23, raftID, mtc.stores[0].StoreID())
-		if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+		if err := mtc.stores[0].ExecuteCmd(context.Background(), incArgs, incResp); err != nil {
 			t.Fatal(err)
 		}
 		verify([]int64{39, 5, 39, 39})
@@ -606,3 +653,59 @@ func TestReplicateAddAndRemove(t *testing.T) {
 		// back up.
 	}
 }
+
+// TestLeaderChangeUnderClosedTimestamp verifies that a replica which
+// becomes leader pushes writes above the timestamp closed by the
+// proposals of the previous leader, at which it already served a
+// follower read.
+func TestLeaderChangeUnderClosedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.ClosedTimestampLag = 10 * time.Second
+	mtc := &multiTestContext{storeContext: &ctx}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+	mtc.manualClock.Set(int64(time.Minute))
+
+	raftID := int64(1)
+	mtc.replicateRange(raftID, 0, 1)
+
+	// A write through the first store closes 50s.
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), raftID, mtc.stores[0].StoreID())
+	pArgs.Timestamp = mtc.clock.Now()
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+	closed := proto.Timestamp{WallTime: int64(50 * time.Second)}
+
+	// Once the second store has applied the closed timestamp, it serves
+	// reads at it as a follower.
+	util.SucceedsWithin(t, time.Second, func() error {
+		gArgs, gReply := getArgs([]byte("b"), raftID, mtc.stores[1].StoreID())
+		gArgs.Timestamp = closed
+		return mtc.stores[1].ExecuteCmd(context.Background(), gArgs, gReply)
+	})
+
+	tArgs := &proto.AdminTransferLeaseRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     []byte("a"),
+			RaftID:  raftID,
+			Replica: proto.Replica{StoreID: mtc.stores[0].StoreID()},
+		},
+		StoreID: mtc.stores[1].StoreID(),
+	}
+	if err := mtc.stores[0].ExecuteCmd(context.Background(), tArgs, &proto.AdminTransferLeaseResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A write through the new leader below the closed timestamp is
+	// pushed above it, although the new leader never proposed it.
+	util.SucceedsWithin(t, time.Second, func() error {
+		pArgs, pReply = putArgs([]byte("b"), []byte("value"), raftID, mtc.stores[1].StoreID())
+		pArgs.Timestamp = proto.Timestamp{WallTime: int64(40 * time.Second)}
+		return mtc.stores[1].ExecuteCmd(context.Background(), pArgs, pReply)
+	})
+	if !closed.Less(pReply.Timestamp) {
+		t.Errorf("expected write to be pushed above %s; got %s", closed, pReply.Timestamp)
+	}
+}
@@ -25,8 +25,8 @@ import (
 
 	"github.com/biogo/store/llrb"
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -100,7 +100,7 @@ func treeNodesEqual(db *client.KV, expected testRangeTree, key proto.Key) error
 		return util.Errorf("Expected does not contain a node for %s", key)
 	}
 	actualNode := &proto.RangeTreeNode{}
-	if err := getProto(db, engine.RangeTreeNodeKey(key), actualNode); err != nil {
+	if err := getProto(db, keys.RangeTreeNodeKey(key), actualNode); err != nil {
 		return err
 	}
 	if err := nodesEqual(key, expectedNode, *actualNode); err != nil {
@@ -124,7 +124,7 @@ func treeNodesEqual(db *client.KV, expected testRangeTree, key proto.Key) error
 func treesEqual(db *client.KV, expected testRangeTree) error {
 	// Compare the tree roots.
 	actualTree := &proto.RangeTree{}
-	if err := getProto(db, engine.KeyRangeTreeRoot, actualTree); err != nil {
+	if err := getProto(db, keys.KeyRangeTreeRoot, actualTree); err != nil {
 		return err
 	}
 	if !reflect.DeepEqual(&expected.Tree, actualTree) {
@@ -155,12 +155,12 @@ func TestSetupRangeTree(t *testing.T) {
 
 	// Check to make sure the range tree is stored correctly.
 	tree := proto.RangeTree{
-		RootKey: engine.KeyMin,
+		RootKey: keys.KeyMin,
 	}
 	nodes := map[string]proto.RangeTreeNode{
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -194,13 +194,13 @@ func TestInsertRight(t *testing.T) {
 	nodes := map[string]proto.RangeTreeNode{
 		string(keyA): proto.RangeTreeNode{
 			Key:       keyA,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 		},
 	}
@@ -222,19 +222,19 @@ func TestInsertRight(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyA): proto.RangeTreeNode{
 			Key:       keyA,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 			RightKey:  &keyB,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyB): proto.RangeTreeNode{
 			Key:       keyB,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -256,25 +256,25 @@ func TestInsertRight(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyA): proto.RangeTreeNode{
 			Key:       keyA,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 			RightKey:  &keyC,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyC): proto.RangeTreeNode{
 			Key:       keyC,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyB,
 		},
 		string(keyB): proto.RangeTreeNode{
 			Key:       keyB,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 		},
 	}
@@ -296,31 +296,31 @@ func TestInsertRight(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyC): proto.RangeTreeNode{
 			Key:       keyC,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyA,
 			RightKey:  &keyD,
 		},
 		string(keyA): proto.RangeTreeNode{
 			Key:       keyA,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 			RightKey:  &keyB,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyB): proto.RangeTreeNode{
 			Key:       keyB,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyD): proto.RangeTreeNode{
 			Key:       keyD,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -342,37 +342,37 @@ func TestInsertRight(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyC): proto.RangeTreeNode{
 			Key:       keyC,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyA,
 			RightKey:  &keyE,
 		},
 		string(keyA): proto.RangeTreeNode{
 			Key:       keyA,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 			RightKey:  &keyB,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyB): proto.RangeTreeNode{
 			Key:       keyB,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyE): proto.RangeTreeNode{
 			Key:       keyE,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyD,
 		},
 		string(keyD): proto.RangeTreeNode{
 			Key:       keyD,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 		},
 	}
@@ -409,13 +409,13 @@ func TestInsertLeft(t *testing.T) {
 	nodes := map[string]proto.RangeTreeNode{
 		string(keyE): proto.RangeTreeNode{
 			Key:       keyE,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 		},
 	}
@@ -437,19 +437,19 @@ func TestInsertLeft(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyD): proto.RangeTreeNode{
 			Key:       keyD,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 			RightKey:  &keyE,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyE): proto.RangeTreeNode{
 			Key:       keyE,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -471,26 +471,26 @@ func TestInsertLeft(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyD): proto.RangeTreeNode{
 			Key:       keyD,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyC,
 			RightKey:  &keyE,
 		},
 		string(keyC): proto.RangeTreeNode{
 			Key:       keyC,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 		},
 
 		string(keyE): proto.RangeTreeNode{
 			Key:       keyE,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -512,31 +512,31 @@ func TestInsertLeft(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyD): proto.RangeTreeNode{
 			Key:       keyD,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyB,
 			RightKey:  &keyE,
 		},
 		string(keyB): proto.RangeTreeNode{
 			Key:       keyB,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 			RightKey:  &keyC,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyC): proto.RangeTreeNode{
 			Key:       keyC,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyE): proto.RangeTreeNode{
 			Key:       keyE,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -558,37 +558,37 @@ func TestInsertLeft(t *testing.T) {
 	nodes = map[string]proto.RangeTreeNode{
 		string(keyD): proto.RangeTreeNode{
 			Key:       keyD,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 			LeftKey:   &keyB,
 			RightKey:  &keyE,
 		},
 		string(keyB): proto.RangeTreeNode{
 			Key:       keyB,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 			LeftKey:   &keyA,
 			RightKey:  &keyC,
 		},
 		string(keyA): proto.RangeTreeNode{
 			Key:       keyA,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
-			LeftKey:   &engine.KeyMin,
+			LeftKey:   &keys.KeyMin,
 		},
-		string(engine.KeyMin): proto.RangeTreeNode{
-			Key:       engine.KeyMin,
-			ParentKey: engine.KeyMin,
+		string(keys.KeyMin): proto.RangeTreeNode{
+			Key:       keys.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     false,
 		},
 		string(keyC): proto.RangeTreeNode{
 			Key:       keyC,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 		string(keyE): proto.RangeTreeNode{
 			Key:       keyE,
-			ParentKey: engine.KeyMin,
+			ParentKey: keys.KeyMin,
 			Black:     true,
 		},
 	}
@@ -615,13 +615,13 @@ func (k Key) Compare(b llrb.Comparable) int {
 func compareBiogoNode(db *client.KV, biogoNode *llrb.Node, key *proto.Key) error {
 	// Retrieve the node form the range tree.
 	rtNode := &proto.RangeTreeNode{}
-	if err := getProto(db, engine.RangeTreeNodeKey(*key), rtNode); err != nil {
+	if err := getProto(db, keys.RangeTreeNodeKey(*key), rtNode); err != nil {
 		return err
 	}
 
 	bNode := &proto.RangeTreeNode{
 		Key:       proto.Key(biogoNode.Elem.(Key)),
-		ParentKey: engine.KeyMin,
+		ParentKey: keys.KeyMin,
 		Black:     bool(biogoNode.Color),
 	}
 	if biogoNode.Left != nil {
@@ -652,7 +652,7 @@ func compareBiogoNode(db *client.KV, biogoNode *llrb.Node, key *proto.Key) error
 // contain the same values in the same order.
 func compareBiogoTree(db *client.KV, biogoTree *llrb.Tree) error {
 	rt := &proto.RangeTree{}
-	if err := getProto(db, engine.KeyRangeTreeRoot, rt); err != nil {
+	if err := getProto(db, keys.KeyRangeTreeRoot, rt); err != nil {
 		return err
 	}
 	return compareBiogoNode(db, biogoTree.Root, &rt.RootKey)
@@ -671,7 +671,7 @@ func TestRandomSplits(t *testing.T) {
 	t.Logf("using pseudo random number generator with seed %d", seed)
 
 	tree := &llrb.Tree{}
-	tree.Insert(Key(engine.KeyMin))
+	tree.Insert(Key(keys.KeyMin))
 
 	// Test an unsplit tree.
 	if err := compareBiogoTree(db, tree); err != nil {
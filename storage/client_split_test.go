@@ -28,12 +28,14 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
 )
 
 func adminSplitArgs(key, splitKey []byte, raftID int64, storeID proto.StoreID) (*proto.AdminSplitRequest, *proto.AdminSplitResponse) {
@@ -67,15 +69,15 @@ func TestStoreRangeSplitAtIllegalKeys(t *testing.T) {
 	defer stopper.Stop()
 
 	for _, key := range []proto.Key{
-		engine.KeyMeta1Prefix,
-		engine.MakeKey(engine.KeyMeta1Prefix, []byte("a")),
-		engine.MakeKey(engine.KeyMeta1Prefix, engine.KeyMax),
-		engine.MakeKey(engine.KeyConfigAccountingPrefix, []byte("a")),
-		engine.MakeKey(engine.KeyConfigPermissionPrefix, []byte("a")),
-		engine.MakeKey(engine.KeyConfigZonePrefix, []byte("a")),
+		keys.KeyMeta1Prefix,
+		keys.MakeKey(keys.KeyMeta1Prefix, []byte("a")),
+		keys.MakeKey(keys.KeyMeta1Prefix, keys.KeyMax),
+		keys.MakeKey(keys.KeyConfigAccountingPrefix, []byte("a")),
+		keys.MakeKey(keys.KeyConfigPermissionPrefix, []byte("a")),
+		keys.MakeKey(keys.KeyConfigZonePrefix, []byte("a")),
 	} {
-		args, reply := adminSplitArgs(engine.KeyMin, key, 1, store.StoreID())
-		err := store.ExecuteCmd(args, reply)
+		args, reply := adminSplitArgs(keys.KeyMin, key, 1, store.StoreID())
+		err := store.ExecuteCmd(context.Background(), args, reply)
 		if err == nil {
 			t.Fatalf("%q: split succeeded unexpectedly", key)
 		}
@@ -92,17 +94,17 @@ func TestStoreRangeSplitAtRangeBounds(t *testing.T) {
 	store, stopper := createTestStore(t)
 	defer stopper.Stop()
 
-	args, reply := adminSplitArgs(engine.KeyMin, []byte("a"), 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminSplitArgs(keys.KeyMin, []byte("a"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 	// This second split will try to split at end of first split range.
-	if err := store.ExecuteCmd(args, reply); err == nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err == nil {
 		t.Fatalf("split succeeded unexpectedly")
 	}
 	// Now try to split at start of new range.
-	args, reply = adminSplitArgs(engine.KeyMin, []byte("a"), 2, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err == nil {
+	args, reply = adminSplitArgs(keys.KeyMin, []byte("a"), 2, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err == nil {
 		t.Fatalf("split succeeded unexpectedly")
 	}
 }
@@ -121,8 +123,8 @@ func TestStoreRangeSplitConcurrent(t *testing.T) {
 	failureCount := int32(0)
 	for i := int32(0); i < concurrentCount; i++ {
 		go func() {
-			args, reply := adminSplitArgs(engine.KeyMin, []byte("a"), 1, store.StoreID())
-			err := store.ExecuteCmd(args, reply)
+			args, reply := adminSplitArgs(keys.KeyMin, []byte("a"), 1, store.StoreID())
+			err := store.ExecuteCmd(context.Background(), args, reply)
 			if err != nil {
 				if matched, regexpErr := regexp.MatchString(".*outside of bounds of range", err.Error()); !matched || regexpErr != nil {
 					t.Errorf("error %s didn't match: %s", err, regexpErr)
@@ -152,11 +154,11 @@ func TestStoreRangeSplit(t *testing.T) {
 
 	// First, write some values left and right of the proposed split key.
 	pArgs, pReply := putArgs([]byte("c"), content, raftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 	pArgs, pReply = putArgs([]byte("x"), content, raftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -165,55 +167,55 @@ func TestStoreRangeSplit(t *testing.T) {
 	// the key.
 	lIncArgs, lIncReply := incrementArgs([]byte("apoptosis"), 100, raftID, store.StoreID())
 	lIncArgs.CmdID = proto.ClientCmdID{WallTime: 123, Random: 423}
-	if err := store.ExecuteCmd(lIncArgs, lIncReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), lIncArgs, lIncReply); err != nil {
 		t.Fatal(err)
 	}
 	rIncArgs, rIncReply := incrementArgs([]byte("wobble"), 10, raftID, store.StoreID())
 	rIncArgs.CmdID = proto.ClientCmdID{WallTime: 12, Random: 42}
-	if err := store.ExecuteCmd(rIncArgs, rIncReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), rIncArgs, rIncReply); err != nil {
 		t.Fatal(err)
 	}
 
 	// Get the original stats for key and value bytes.
-	keyBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, engine.StatKeyBytes)
+	keyBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, keys.StatKeyBytes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	valBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, engine.StatValBytes)
+	valBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, keys.StatValBytes)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Split the range.
-	args, reply := adminSplitArgs(engine.KeyMin, splitKey, 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminSplitArgs(keys.KeyMin, splitKey, 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
 	// Verify no intents remains on range descriptor keys.
-	for _, key := range []proto.Key{engine.RangeDescriptorKey(engine.KeyMin), engine.RangeDescriptorKey(splitKey)} {
+	for _, key := range []proto.Key{keys.RangeDescriptorKey(keys.KeyMin), keys.RangeDescriptorKey(splitKey)} {
 		if _, err := engine.MVCCGet(store.Engine(), key, store.Clock().Now(), true, nil); err != nil {
 			t.Fatal(err)
 		}
 	}
 
-	rng := store.LookupRange(engine.KeyMin, nil)
+	rng := store.LookupRange(keys.KeyMin, nil)
 	newRng := store.LookupRange([]byte("m"), nil)
 	if !bytes.Equal(newRng.Desc().StartKey, splitKey) || !bytes.Equal(splitKey, rng.Desc().EndKey) {
 		t.Errorf("ranges mismatched, wanted %q=%q=%q", newRng.Desc().StartKey, splitKey, rng.Desc().EndKey)
 	}
-	if !bytes.Equal(newRng.Desc().EndKey, engine.KeyMax) || !bytes.Equal(rng.Desc().StartKey, engine.KeyMin) {
+	if !bytes.Equal(newRng.Desc().EndKey, keys.KeyMax) || !bytes.Equal(rng.Desc().StartKey, keys.KeyMin) {
 		t.Errorf("new ranges do not cover KeyMin-KeyMax, but only %q-%q", rng.Desc().StartKey, newRng.Desc().EndKey)
 	}
 
 	// Try to get values from both left and right of where the split happened.
 	gArgs, gReply := getArgs([]byte("c"), raftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
 	gArgs, gReply = getArgs([]byte("x"), newRng.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil ||
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil ||
 		!bytes.Equal(gReply.Value.Bytes, content) {
 		t.Fatal(err)
 	}
@@ -221,7 +223,7 @@ func TestStoreRangeSplit(t *testing.T) {
 	// Send out an increment request copied from above (same ClientCmdID) which
 	// remains in the old range.
 	lIncReply = &proto.IncrementResponse{}
-	if err := store.ExecuteCmd(lIncArgs, lIncReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), lIncArgs, lIncReply); err != nil {
 		t.Fatal(err)
 	}
 	if lIncReply.NewValue != 100 {
@@ -232,7 +234,7 @@ func TestStoreRangeSplit(t *testing.T) {
 	// now to the newly created range (which should hold that key).
 	rIncArgs.RequestHeader.RaftID = newRng.Desc().RaftID
 	rIncReply = &proto.IncrementResponse{}
-	if err := store.ExecuteCmd(rIncArgs, rIncReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), rIncArgs, rIncReply); err != nil {
 		t.Fatal(err)
 	}
 	if rIncReply.NewValue != 10 {
@@ -241,19 +243,19 @@ func TestStoreRangeSplit(t *testing.T) {
 
 	// Compare stats of split ranges to ensure they are non ero and
 	// exceed the original range when summed.
-	lKeyBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, engine.StatKeyBytes)
+	lKeyBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, keys.StatKeyBytes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	lValBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, engine.StatValBytes)
+	lValBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, keys.StatValBytes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	rKeyBytes, err := engine.MVCCGetRangeStat(store.Engine(), newRng.Desc().RaftID, engine.StatKeyBytes)
+	rKeyBytes, err := engine.MVCCGetRangeStat(store.Engine(), newRng.Desc().RaftID, keys.StatKeyBytes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	rValBytes, err := engine.MVCCGetRangeStat(store.Engine(), newRng.Desc().RaftID, engine.StatValBytes)
+	rValBytes, err := engine.MVCCGetRangeStat(store.Engine(), newRng.Desc().RaftID, keys.StatValBytes)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -283,8 +285,8 @@ func TestStoreRangeSplitStats(t *testing.T) {
 	defer stopper.Stop()
 
 	// Split the range at the first user key.
-	args, reply := adminSplitArgs(engine.KeyMin, proto.Key("\x01"), 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminSplitArgs(keys.KeyMin, proto.Key("\x01"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 	// Verify empty range has empty stats.
@@ -298,7 +300,7 @@ func TestStoreRangeSplitStats(t *testing.T) {
 		val := util.RandBytes(src, int(src.Int31n(1<<8)))
 		pArgs, pReply := putArgs(key, val, rng.Desc().RaftID, store.StoreID())
 		pArgs.Timestamp = store.Clock().Now()
-		if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -310,7 +312,7 @@ func TestStoreRangeSplitStats(t *testing.T) {
 
 	// Split the range at approximate halfway point ("Z" in string "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").
 	args, reply = adminSplitArgs(proto.Key("\x01"), proto.Key("Z"), rng.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -344,11 +346,11 @@ func TestStoreRangeSplitStats(t *testing.T) {
 func fillRange(store *storage.Store, raftID int64, prefix proto.Key, bytes int64, t *testing.T) {
 	src := rand.New(rand.NewSource(0))
 	for {
-		keyBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, engine.StatKeyBytes)
+		keyBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, keys.StatKeyBytes)
 		if err != nil {
 			t.Fatal(err)
 		}
-		valBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, engine.StatValBytes)
+		valBytes, err := engine.MVCCGetRangeStat(store.Engine(), raftID, keys.StatValBytes)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -359,7 +361,7 @@ func fillRange(store *storage.Store, raftID int64, prefix proto.Key, bytes int64
 		val := util.RandBytes(src, int(src.Int31n(1<<8)))
 		pArgs, pReply := putArgs(key, val, raftID, store.StoreID())
 		pArgs.Timestamp = store.Clock().Now()
-		if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -376,7 +378,7 @@ func TestStoreZoneUpdateAndRangeSplit(t *testing.T) {
 	defer stopper.Stop()
 
 	maxBytes := int64(1 << 16)
-	rng := store.LookupRange(engine.KeyMin, nil)
+	rng := store.LookupRange(keys.KeyMin, nil)
 	fillRange(store, rng.Desc().RaftID, proto.Key("test"), maxBytes, t)
 
 	// Rewrite zone config with range max bytes set to 64K.
@@ -392,7 +394,7 @@ func TestStoreZoneUpdateAndRangeSplit(t *testing.T) {
 		RangeMinBytes: 1 << 8,
 		RangeMaxBytes: maxBytes,
 	}
-	call := client.PutProtoCall(engine.MakeKey(engine.KeyConfigZonePrefix, engine.KeyMin), zoneConfig)
+	call := client.PutProtoCall(keys.MakeKey(keys.KeyConfigZonePrefix, keys.KeyMin), zoneConfig)
 	if err := store.DB().Run(call); err != nil {
 		t.Fatal(err)
 	}
@@ -413,29 +415,34 @@ func TestStoreZoneUpdateAndRangeSplit(t *testing.T) {
 	}
 }
 
-// TestStoreRangeSplitOnConfigs verifies that config changes to both
-// accounting and zone configs cause ranges to be split along prefix
-// boundaries.
+// TestStoreRangeSplitOnConfigs verifies that config changes to
+// accounting, permission and zone configs cause ranges to be split
+// along prefix boundaries.
 func TestStoreRangeSplitOnConfigs(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	store, stopper := createTestStore(t)
 	defer stopper.Stop()
 
 	acctConfig := &proto.AcctConfig{}
+	permConfig := &proto.PermConfig{}
 	zoneConfig := &proto.ZoneConfig{}
 
 	// Write zone configs for db3 & db4.
 	var calls []client.Call
 	for _, k := range []string{"db4", "db3"} {
 		call := client.PutProtoCall(
-			engine.MakeKey(engine.KeyConfigZonePrefix, proto.Key(k)),
+			keys.MakeKey(keys.KeyConfigZonePrefix, proto.Key(k)),
 			zoneConfig)
 		calls = append(calls, call)
 	}
+	// Write a permission config for db6.
+	calls = append(calls, client.PutProtoCall(
+		keys.MakeKey(keys.KeyConfigPermissionPrefix, proto.Key("db6")),
+		permConfig))
 	// Write accounting configs for db1 & db2.
 	for _, k := range []string{"db2", "db1"} {
 		call := client.PutProtoCall(
-			engine.MakeKey(engine.KeyConfigAccountingPrefix, proto.Key(k)),
+			keys.MakeKey(keys.KeyConfigAccountingPrefix, proto.Key(k)),
 			acctConfig)
 		calls = append(calls, call)
 	}
@@ -451,10 +458,12 @@ func TestStoreRangeSplitOnConfigs(t *testing.T) {
 		proto.Key("\x00\x00meta2db3"),
 		proto.Key("\x00\x00meta2db4"),
 		proto.Key("\x00\x00meta2db5"),
-		engine.MakeKey(proto.Key("\x00\x00meta2"), engine.KeyMax),
+		proto.Key("\x00\x00meta2db6"),
+		proto.Key("\x00\x00meta2db7"),
+		keys.MakeKey(proto.Key("\x00\x00meta2"), keys.KeyMax),
 	}
 	if err := util.IsTrueWithin(func() bool {
-		call := client.ScanCall(engine.KeyMeta2Prefix, engine.KeyMetaMax, 0)
+		call := client.ScanCall(keys.KeyMeta2Prefix, keys.KeyMetaMax, 0)
 		resp := call.Reply.(*proto.ScanResponse)
 		if err := store.DB().Run(call); err != nil {
 			t.Fatalf("failed to scan meta2 keys: %s", err)
@@ -21,12 +21,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // compareStoreStatus ensures that the actual store status for the passed in
@@ -35,9 +37,9 @@ import (
 // and Val are at least the expected value.  The latest actual stats are
 // returned.
 func compareStoreStatus(t *testing.T, store *storage.Store, expectedStoreStatus *proto.StoreStatus, testNumber int) *proto.StoreStatus {
-	storeStatusKey := engine.StoreStatusKey(int32(store.Ident.StoreID))
+	storeStatusKey := keys.StoreStatusKey(int32(store.Ident.StoreID))
 	gArgs, gReply := getArgs(storeStatusKey, 1, store.Ident.StoreID)
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil {
 		t.Fatalf("%v: failure getting store status: %s", testNumber, err)
 	}
 	if gReply.Value == nil {
@@ -53,9 +55,18 @@ func compareStoreStatus(t *testing.T, store *storage.Store, expectedStoreStatus
 	if expectedStoreStatus.NodeID != storeStatus.NodeID {
 		t.Errorf("%v: actual node ID does not match expected\nexpected: %+v\nactual: %v\n", testNumber, expectedStoreStatus, storeStatus)
 	}
+	if storeStatus.EngineStats == nil {
+		t.Errorf("%v: expected engine stats to be populated\nactual: %v\n", testNumber, storeStatus)
+	}
 	if expectedStoreStatus.RangeCount != storeStatus.RangeCount {
 		t.Errorf("%v: actual RangeCount does not match expected\nexpected: %+v\nactual: %v\n", testNumber, expectedStoreStatus, storeStatus)
 	}
+	if storeStatus.LeaderRangeCount < 1 || storeStatus.LeaderRangeCount > storeStatus.RangeCount {
+		t.Errorf("%v: expected the store to lead between 1 and all of its ranges\nactual: %v\n", testNumber, storeStatus)
+	}
+	if storeStatus.Capacity <= 0 || storeStatus.Available > storeStatus.Capacity || storeStatus.RaftLogSize <= 0 {
+		t.Errorf("%v: expected capacity and raft log size to be populated\nactual: %v\n", testNumber, storeStatus)
+	}
 	if storeStatus.Stats.LiveBytes < expectedStoreStatus.Stats.LiveBytes {
 		t.Errorf("%v: actual Live Bytes is not greater or equal to expected\nexpected: %+v\nactual: %v\n", testNumber, expectedStoreStatus, storeStatus)
 	}
@@ -109,11 +120,11 @@ func TestStoreStatus(t *testing.T) {
 	// Write some values left and right of the proposed split key.
 	rng := store.LookupRange([]byte("a"), nil)
 	pArgs, pReply := putArgs([]byte("a"), content, rng.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 	pArgs, pReply = putArgs([]byte("c"), content, rng.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -135,8 +146,8 @@ func TestStoreStatus(t *testing.T) {
 	oldstats = compareStoreStatus(t, store, expectedStoreStatus, 1)
 
 	// Split the range.
-	args, reply := adminSplitArgs(engine.KeyMin, splitKey, rng.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	args, reply := adminSplitArgs(keys.KeyMin, splitKey, rng.Desc().RaftID, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -160,12 +171,12 @@ func TestStoreStatus(t *testing.T) {
 	// Write some values left and right of the split key.
 	rng = store.LookupRange([]byte("aa"), nil)
 	pArgs, pReply = putArgs([]byte("aa"), content, rng.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 	rng2 := store.LookupRange([]byte("cc"), nil)
 	pArgs, pReply = putArgs([]byte("cc"), content, rng2.Desc().RaftID, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -0,0 +1,64 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import "github.com/cockroachdb/cockroach/proto"
+
+// A closedTimestampTracker determines the timestamps which a replica
+// closes with its proposals. Each write which has been admitted past
+// the timestamp cache but not yet proposed is tracked, and no
+// timestamp at or above one of them is closed: the write may still be
+// ordered after the proposal which closes the timestamp in the raft
+// log. Closed timestamps never regress.
+type closedTimestampTracker struct {
+	nextID   int64
+	inflight map[int64]proto.Timestamp
+	closed   proto.Timestamp // Highest timestamp closed so far
+}
+
+func newClosedTimestampTracker() *closedTimestampTracker {
+	return &closedTimestampTracker{inflight: map[int64]proto.Timestamp{}}
+}
+
+// track records a write at the given timestamp which is about to be
+// proposed and returns the ID with which to untrack it.
+func (ct *closedTimestampTracker) track(timestamp proto.Timestamp) int64 {
+	ct.nextID++
+	ct.inflight[ct.nextID] = timestamp
+	return ct.nextID
+}
+
+// untrack removes the write with the given ID once it's been proposed.
+func (ct *closedTimestampTracker) untrack(id int64) {
+	delete(ct.inflight, id)
+}
+
+// close returns the timestamp to close with the next proposal. It's
+// the target timestamp, lowered to just below the oldest tracked
+// write, unless that's below the timestamp closed previously.
+func (ct *closedTimestampTracker) close(target proto.Timestamp) proto.Timestamp {
+	for _, timestamp := range ct.inflight {
+		if !target.Less(timestamp) {
+			target = proto.Timestamp{WallTime: timestamp.WallTime - 1}
+		}
+	}
+	if ct.closed.Less(target) {
+		ct.closed = target
+	}
+	return ct.closed
+}
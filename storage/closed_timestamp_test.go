@@ -0,0 +1,56 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestClosedTimestampTracker verifies that closed timestamps stay
+// below tracked writes and never regress.
+func TestClosedTimestampTracker(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ct := newClosedTimestampTracker()
+	if closed := ct.close(makeTS(10, 0)); !closed.Equal(makeTS(10, 0)) {
+		t.Errorf("expected closed timestamp 10; got %s", closed)
+	}
+
+	// Writes at or below the target hold the closed timestamp below them.
+	id1 := ct.track(makeTS(15, 0))
+	id2 := ct.track(makeTS(13, 2))
+	id3 := ct.track(makeTS(30, 0))
+	if closed := ct.close(makeTS(20, 0)); !closed.Equal(makeTS(12, 0)) {
+		t.Errorf("expected closed timestamp 12; got %s", closed)
+	}
+	ct.untrack(id2)
+	if closed := ct.close(makeTS(20, 0)); !closed.Equal(makeTS(14, 0)) {
+		t.Errorf("expected closed timestamp 14; got %s", closed)
+	}
+	ct.untrack(id1)
+	ct.untrack(id3)
+	if closed := ct.close(makeTS(20, 0)); !closed.Equal(makeTS(20, 0)) {
+		t.Errorf("expected closed timestamp 20; got %s", closed)
+	}
+
+	// Closed timestamps never regress.
+	if closed := ct.close(makeTS(5, 0)); !closed.Equal(makeTS(20, 0)) {
+		t.Errorf("expected closed timestamp to remain 20; got %s", closed)
+	}
+}
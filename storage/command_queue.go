@@ -46,6 +46,11 @@ import (
 // possibly signaling waiting commands who were gated by the executing
 // command's affected key(s).
 //
+// Commands of a priority below normal yield to overlapping commands of
+// higher priority: before adding themselves to the queue, they wait on
+// those via GetHigherPriorityWait, so that later foreground commands
+// don't queue behind background ones which were themselves waiting.
+//
 // CommandQueue is not thread safe.
 type CommandQueue struct {
 	cache *util.IntervalCache
@@ -53,6 +58,7 @@ type CommandQueue struct {
 
 type cmd struct {
 	readOnly bool
+	priority proto.RequestPriority
 	pending  []*sync.WaitGroup // Pending commands gated on cmd
 }
 
@@ -82,19 +88,38 @@ func (cq *CommandQueue) onEvicted(key, value interface{}) {
 // failed. readOnly is true if the requester is a read-only command;
 // false for read-write.
 func (cq *CommandQueue) GetWait(start, end proto.Key, readOnly bool, wg *sync.WaitGroup) {
+	cq.getWait(start, end, readOnly, nil, wg)
+}
+
+// GetHigherPriorityWait is like GetWait, but only counts the commands
+// of a priority higher than the one specified. Returns the number of
+// commands added to the wait group.
+func (cq *CommandQueue) GetHigherPriorityWait(start, end proto.Key, readOnly bool,
+	priority proto.RequestPriority, wg *sync.WaitGroup) int {
+	return cq.getWait(start, end, readOnly, func(c *cmd) bool { return priority.Less(c.priority) }, wg)
+}
+
+// getWait adds the overlapping commands which conflict with the
+// specified one and satisfy filter, if not nil, to the wait group and
+// returns their number.
+func (cq *CommandQueue) getWait(start, end proto.Key, readOnly bool, filter func(*cmd) bool,
+	wg *sync.WaitGroup) int {
 	// This gives us a memory-efficient end key if end is empty.
 	if len(end) == 0 {
 		end = start.Next()
 		start = end[:len(start)]
 	}
+	count := 0
 	for _, c := range cq.cache.GetOverlaps(start, end) {
 		c := c.Value.(*cmd)
 		// Only add to the wait group if one of the commands isn't read-only.
-		if !readOnly || !c.readOnly {
+		if (!readOnly || !c.readOnly) && (filter == nil || filter(c)) {
 			c.pending = append(c.pending, wg)
 			wg.Add(1)
+			count++
 		}
 	}
+	return count
 }
 
 // Add adds a command to the queue which affects the specified key
@@ -107,11 +132,18 @@ func (cq *CommandQueue) GetWait(start, end proto.Key, readOnly bool, wg *sync.Wa
 // overlapping commands via the WaitGroup initialized through
 // GetWait().
 func (cq *CommandQueue) Add(start, end proto.Key, readOnly bool) interface{} {
+	return cq.AddWithPriority(start, end, readOnly, proto.NORMAL)
+}
+
+// AddWithPriority is like Add, but records the priority of the
+// command, on which lower priority commands yield.
+func (cq *CommandQueue) AddWithPriority(start, end proto.Key, readOnly bool,
+	priority proto.RequestPriority) interface{} {
 	if len(end) == 0 {
 		end = start.Next()
 	}
 	key := cq.cache.NewKey(start, end)
-	cq.cache.Add(key, &cmd{readOnly: readOnly})
+	cq.cache.Add(key, &cmd{readOnly: readOnly, priority: priority})
 	return key
 }
 
@@ -171,3 +171,89 @@ func TestCommandQueueClear(t *testing.T) {
 		t.Fatal("commands should finish when clearing queue")
 	}
 }
+
+func TestCommandQueueDisjointSpans(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	cq := NewCommandQueue()
+	wg := sync.WaitGroup{}
+
+	// Writes to [a, c) and [c, e) don't overlap, so later commands on
+	// spans adjacent to either of them proceed without waiting.
+	wk1 := cq.Add(proto.Key("a"), proto.Key("c"), false)
+	wk2 := cq.Add(proto.Key("c"), proto.Key("e"), false)
+	cq.GetWait(proto.Key("e"), proto.Key("g"), false, &wg)
+	wg.Wait()
+	cq.GetWait(proto.Key("0"), proto.Key("a"), false, &wg)
+	wg.Wait()
+
+	// A write partially overlapping [a, c) waits only on that command.
+	cq.GetWait(proto.Key("b"), proto.Key("bb"), false, &wg)
+	cmdDone := waitForCmd(&wg)
+	cq.Remove(wk2)
+	if testCmdDone(cmdDone, 1*time.Millisecond) {
+		t.Fatal("command should not finish with overlapping command outstanding")
+	}
+	cq.Remove(wk1)
+	if !testCmdDone(cmdDone, 5*time.Millisecond) {
+		t.Fatal("command should finish with no overlapping commands outstanding")
+	}
+}
+
+func TestCommandQueueReadOnlySpans(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	cq := NewCommandQueue()
+	wg := sync.WaitGroup{}
+
+	// Overlapping reads don't wait on each other, while a write
+	// overlapping any of them waits on all overlapping reads.
+	wk1 := cq.Add(proto.Key("a"), proto.Key("d"), true)
+	wk2 := cq.Add(proto.Key("c"), proto.Key("f"), true)
+	cq.GetWait(proto.Key("b"), proto.Key("e"), true, &wg)
+	wg.Wait()
+
+	cq.GetWait(proto.Key("cc"), nil, false, &wg)
+	cmdDone := waitForCmd(&wg)
+	cq.Remove(wk1)
+	if testCmdDone(cmdDone, 1*time.Millisecond) {
+		t.Fatal("command should not finish with one overlapping read outstanding")
+	}
+	cq.Remove(wk2)
+	if !testCmdDone(cmdDone, 5*time.Millisecond) {
+		t.Fatal("command should finish with no overlapping reads outstanding")
+	}
+}
+
+// TestCommandQueueHigherPriorityWait verifies that only overlapping
+// commands of higher priority are waited on by GetHigherPriorityWait.
+func TestCommandQueueHigherPriorityWait(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	cq := NewCommandQueue()
+	bk := cq.AddWithPriority(proto.Key("a"), nil, false, proto.BACKGROUND)
+	nk := cq.AddWithPriority(proto.Key("a"), proto.Key("c"), false, proto.NORMAL)
+	cq.AddWithPriority(proto.Key("d"), nil, false, proto.HIGH)
+	rk := cq.AddWithPriority(proto.Key("b"), nil, true, proto.HIGH)
+
+	wg := sync.WaitGroup{}
+	if n := cq.GetHigherPriorityWait(proto.Key("a"), proto.Key("c"), true, proto.BACKGROUND, &wg); n != 1 {
+		t.Fatalf("expected to wait on 1 command; got %d", n)
+	}
+	cmdDone := waitForCmd(&wg)
+	cq.Remove(bk)
+	if testCmdDone(cmdDone, 1*time.Millisecond) {
+		t.Fatal("command should not finish with higher priority command outstanding")
+	}
+	cq.Remove(nk)
+	if !testCmdDone(cmdDone, 5*time.Millisecond) {
+		t.Fatal("command should finish with no higher priority commands outstanding")
+	}
+
+	// The read-only command conflicts with writes only.
+	if n := cq.GetHigherPriorityWait(proto.Key("a"), proto.Key("c"), false, proto.NORMAL, &wg); n != 1 {
+		t.Fatalf("expected to wait on 1 command; got %d", n)
+	}
+	cq.Remove(rk)
+	wg.Wait()
+	if n := cq.GetHigherPriorityWait(proto.Key("d"), nil, false, proto.HIGH, &wg); n != 0 {
+		t.Fatalf("expected not to wait on commands of equal priority; got %d", n)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// consistencyQueueMaxSize is the max size of the consistency queue.
+	consistencyQueueMaxSize = 100
+	// consistencyCheckInterval is the target duration between
+	// consistency checks of each range.
+	consistencyCheckInterval = 24 * time.Hour
+)
+
+// consistencyQueue periodically checks that the replicas of each
+// range hold identical data, detecting silent replica divergence. See
+// Range.CheckConsistency.
+type consistencyQueue struct {
+	stats storeStatsFn
+	*baseQueue
+}
+
+// newConsistencyQueue returns a new instance of consistencyQueue.
+func newConsistencyQueue(stats storeStatsFn) *consistencyQueue {
+	cq := &consistencyQueue{stats: stats}
+	cq.baseQueue = newBaseQueue("consistency", cq, consistencyQueueMaxSize)
+	return cq
+}
+
+// shouldQueue determines whether a range should be queued for a
+// consistency check, and if so, at what priority. Returns true for
+// shouldQ if this replica is the range leader and it's been longer
+// since the last check than the consistency check interval.
+func (cq *consistencyQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool, priority float64) {
+	// Only the leader coordinates consistency checks.
+	if !rng.IsLeader() {
+		return
+	}
+	lastCheck, err := rng.GetLastConsistencyCheckTimestamp()
+	if err != nil {
+		log.Errorf("unable to fetch last consistency check timestamp: %s", err)
+		return
+	}
+	checkScore := float64(now.WallTime-lastCheck.WallTime) / float64(consistencyCheckInterval.Nanoseconds())
+	if checkScore > 1 {
+		priority = checkScore
+		shouldQ = true
+	}
+	return
+}
+
+// process runs a consistency check on the range.
+func (cq *consistencyQueue) process(now proto.Timestamp, rng *Range) error {
+	if err := rng.CheckConsistency(); err != nil {
+		return err
+	}
+	// Store current timestamp as last consistency check for this range.
+	return rng.SetLastConsistencyCheckTimestamp(now)
+}
+
+// timer returns the duration of intervals between successive
+// consistency checks. The durations are sized so that all of the
+// store's ranges are checked within consistencyCheckInterval.
+func (cq *consistencyQueue) timer() time.Duration {
+	return time.Duration(consistencyCheckInterval.Nanoseconds() / int64((cq.stats().RangeCount + 1)))
+}
@@ -21,9 +21,12 @@ package engine
 
 import (
 	"bytes"
+	"fmt"
 	"runtime/debug"
+	"sync"
 
 	"github.com/biogo/store/llrb"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -42,11 +45,22 @@ type Batch struct {
 	engine    Engine
 	updates   llrb.Tree
 	committed bool
+	cmds      []interface{} // Reused by pending
 }
 
-// NewBatch returns a new instance of Batch which wraps engine.
+var batchPool = sync.Pool{
+	New: func() interface{} {
+		return &Batch{}
+	},
+}
+
+// NewBatch returns a new instance of Batch which wraps engine. The
+// batch should be closed once it is no longer used, allowing it to be
+// reused by a later call.
 func NewBatch(engine Engine) *Batch {
-	return &Batch{engine: engine}
+	b := batchPool.Get().(*Batch)
+	b.engine = engine
+	return b
 }
 
 // Put stores the key / value as a BatchPut in the updates tree.
@@ -200,16 +214,34 @@ func (b *Batch) Merge(key proto.EncodedKey, value []byte) error {
 // Commit writes all pending updates to the underlying engine in
 // an atomic write batch.
 func (b *Batch) Commit() error {
+	return b.engine.WriteBatch(b.pending())
+}
+
+// CommitSync is like Commit, but does not return until the updates
+// have been synced to stable storage.
+func (b *Batch) CommitSync() error {
+	return b.engine.WriteSyncBatch(b.pending())
+}
+
+// Len returns the number of pending updates.
+func (b *Batch) Len() int {
+	return b.updates.Len()
+}
+
+// pending marks the batch committed and returns its updates in key
+// order.
+func (b *Batch) pending() []interface{} {
 	if b.committed {
 		panic("this batch was already committed")
 	}
-	var batch []interface{}
+	batch := b.cmds[:0]
 	b.updates.DoRange(func(n llrb.Comparable) (done bool) {
 		batch = append(batch, n)
 		return false
-	}, proto.RawKeyValue{Key: proto.EncodedKey(KeyMin)}, proto.RawKeyValue{Key: proto.EncodedKey(KeyMax)})
+	}, proto.RawKeyValue{Key: proto.EncodedKey(keys.KeyMin)}, proto.RawKeyValue{Key: proto.EncodedKey(keys.KeyMax)})
 	b.committed = true
-	return b.engine.WriteBatch(batch)
+	b.cmds = batch
+	return batch
 }
 
 // Open returns an error if called on a Batch.
@@ -217,8 +249,20 @@ func (b *Batch) Open() error {
 	return util.Errorf("cannot open a batch")
 }
 
-// Close is a noop for Batch.
+// Close discards any pending updates and returns the batch to a pool
+// for reuse. The batch must not be used once closed, and iterators
+// over it must have been closed first.
 func (b *Batch) Close() {
+	if b.engine == nil {
+		// Already closed.
+		return
+	}
+	// Drop the references to the committed updates before pooling.
+	for i := range b.cmds {
+		b.cmds[i] = nil
+	}
+	*b = Batch{cmds: b.cmds[:0]}
+	batchPool.Put(b)
 }
 
 // Attrs is a noop for Batch.
@@ -226,9 +270,33 @@ func (b *Batch) Attrs() proto.Attributes {
 	return proto.Attributes{}
 }
 
-// WriteBatch returns an error if called on a Batch.
-func (b *Batch) WriteBatch([]interface{}) error {
-	return util.Errorf("cannot write batch from a Batch")
+// WriteBatch adds the specified writes, deletions and merges to the
+// pending updates. This allows a Batch to wrap another Batch, with
+// the inner batch's updates becoming visible in the outer batch
+// atomically on commit.
+func (b *Batch) WriteBatch(cmds []interface{}) error {
+	for i, e := range cmds {
+		var err error
+		switch v := e.(type) {
+		case BatchDelete:
+			err = b.Clear(v.Key)
+		case BatchPut:
+			err = b.Put(v.Key, v.Value)
+		case BatchMerge:
+			err = b.Merge(v.Key, v.Value)
+		default:
+			panic(fmt.Sprintf("illegal operation #%d passed to writeBatch: %T", i, v))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteSyncBatch returns an error if called on a Batch.
+func (b *Batch) WriteSyncBatch([]interface{}) error {
+	return util.Errorf("cannot sync a write batch to a Batch")
 }
 
 // Capacity returns an error if called on a Batch.
@@ -236,6 +304,21 @@ func (b *Batch) Capacity() (StoreCapacity, error) {
 	return StoreCapacity{}, util.Errorf("cannot report capacity from a Batch")
 }
 
+// GetStats returns an error if called on a Batch.
+func (b *Batch) GetStats() (*proto.EngineStats, error) {
+	return nil, util.Errorf("cannot get stats from a Batch")
+}
+
+// reportChecksumMismatch reports to the underlying engine.
+func (b *Batch) reportChecksumMismatch(err error) error {
+	return reportChecksumMismatch(b.engine, err)
+}
+
+// VerifyChecksums returns an error if called on a Batch.
+func (b *Batch) VerifyChecksums(start, end proto.EncodedKey) error {
+	return util.Errorf("cannot verify checksums of a Batch")
+}
+
 // SetGCTimeouts is a noop for Batch.
 func (b *Batch) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 }
@@ -256,6 +339,12 @@ func (b *Batch) NewIterator() Iterator {
 	return newBatchIterator(b.engine, &b.updates)
 }
 
+// NewTimeBoundIterator returns an iterator over Batch. The time
+// bounds aren't used to skip any data.
+func (b *Batch) NewTimeBoundIterator(start, end proto.Timestamp) Iterator {
+	return b.NewIterator()
+}
+
 // NewSnapshot returns nil if called on a Batch.
 func (b *Batch) NewSnapshot() Engine {
 	return nil
@@ -263,34 +352,49 @@ func (b *Batch) NewSnapshot() Engine {
 
 // NewBatch returns a new Batch instance wrapping same underlying engine.
 func (b *Batch) NewBatch() Engine {
-	return &Batch{engine: b.engine}
+	return NewBatch(b.engine)
 }
 
 type batchIterator struct {
 	iter    Iterator
 	updates *llrb.Tree
 	pending []proto.RawKeyValue
+	buf     []proto.RawKeyValue // Backs pending; reused on each refill
 	err     error
 }
 
+var batchIteratorPool = sync.Pool{
+	New: func() interface{} {
+		return &batchIterator{}
+	},
+}
+
 // newBatchIterator returns a new iterator over the supplied Batch instance.
 func newBatchIterator(engine Engine, updates *llrb.Tree) *batchIterator {
-	return &batchIterator{
-		iter:    engine.NewIterator(),
-		updates: updates,
-	}
+	bi := batchIteratorPool.Get().(*batchIterator)
+	bi.iter = engine.NewIterator()
+	bi.updates = updates
+	return bi
 }
 
 // The following methods implement the Iterator interface.
 func (bi *batchIterator) Close() {
 	bi.iter.Close()
+	// Retain the buffer, dropping its references to keys and values.
+	buf := bi.buf[:cap(bi.buf)]
+	for i := range buf {
+		buf[i] = proto.RawKeyValue{}
+	}
+	*bi = batchIterator{buf: buf[:0]}
+	batchIteratorPool.Put(bi)
 }
 
 func (bi *batchIterator) Seek(key []byte) {
-	bi.pending = []proto.RawKeyValue{}
+	bi.pending = bi.buf[:0]
 	bi.err = nil
 	bi.iter.Seek(key)
 	bi.mergeUpdates(key)
+	bi.buf = bi.pending[:0]
 }
 
 func (bi *batchIterator) Valid() bool {
@@ -307,7 +411,9 @@ func (bi *batchIterator) Next() {
 		bi.pending = bi.pending[1:]
 	}
 	if len(bi.pending) == 0 {
+		bi.pending = bi.buf[:0]
 		bi.mergeUpdates(last)
+		bi.buf = bi.pending[:0]
 	}
 }
 
@@ -371,7 +477,7 @@ func (bi *batchIterator) mergeUpdates(start proto.EncodedKey) {
 	}
 
 	if len(bi.pending) == 0 {
-		bi.getUpdates(start, proto.EncodedKey(KeyMax))
+		bi.getUpdates(start, proto.EncodedKey(keys.KeyMax))
 	}
 }
 
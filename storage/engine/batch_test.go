@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -59,7 +60,7 @@ func TestBatchBasics(t *testing.T) {
 		{Key: proto.EncodedKey("b"), Value: []byte("value")},
 		{Key: proto.EncodedKey("c"), Value: appender("foo")},
 	}
-	kvs, err := Scan(e, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
+	kvs, err := Scan(e, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -73,7 +74,7 @@ func TestBatchBasics(t *testing.T) {
 		{Key: proto.EncodedKey("c"), Value: appender("foobar")},
 	}
 	// Scan values from batch directly.
-	kvs, err = Scan(b, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
+	kvs, err = Scan(b, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -85,7 +86,7 @@ func TestBatchBasics(t *testing.T) {
 	if err := b.Commit(); err != nil {
 		t.Fatal(err)
 	}
-	kvs, err = Scan(e, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
+	kvs, err = Scan(e, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -339,7 +340,7 @@ func TestBatchScanWithDelete(t *testing.T) {
 	if err := b.Clear(proto.EncodedKey("a")); err != nil {
 		t.Fatal(err)
 	}
-	kvs, err := Scan(b, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
+	kvs, err := Scan(b, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -369,7 +370,7 @@ func TestBatchScanMaxWithDeleted(t *testing.T) {
 		t.Fatal(err)
 	}
 	// A scan with max=1 should scan "b".
-	kvs, err := Scan(b, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 1)
+	kvs, err := Scan(b, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 1)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -412,3 +413,120 @@ func TestBatchConcurrency(t *testing.T) {
 		t.Error("mismatch of \"a\"")
 	}
 }
+
+// TestBatchNested verifies that a batch wrapping another batch sees
+// the outer batch's pending updates and that its own updates reach
+// the engine only once the outer batch is committed.
+func TestBatchNested(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Close()
+
+	outer := NewBatch(e)
+	if err := outer.Put(proto.EncodedKey("a"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := outer.Merge(proto.EncodedKey("c"), appender("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	inner := NewBatch(outer)
+	if val, err := inner.Get(proto.EncodedKey("a")); err != nil || !bytes.Equal(val, []byte("value")) {
+		t.Fatalf("expected inner batch to read \"value\"; got %q, %v", val, err)
+	}
+	if err := inner.Clear(proto.EncodedKey("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := inner.Put(proto.EncodedKey("b"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := inner.Merge(proto.EncodedKey("c"), appender("bar")); err != nil {
+		t.Fatal(err)
+	}
+	if err := inner.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing has reached the engine yet.
+	kvs, err := Scan(e, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 0 {
+		t.Fatalf("expected no values in engine before commit; got %v", kvs)
+	}
+	if outer.Len() != 3 {
+		t.Errorf("expected 3 pending updates in outer batch; got %d", outer.Len())
+	}
+
+	if err := outer.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	expValues := []proto.RawKeyValue{
+		{Key: proto.EncodedKey("b"), Value: []byte("value")},
+		{Key: proto.EncodedKey("c"), Value: appender("foobar")},
+	}
+	kvs, err = Scan(e, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expValues, kvs) {
+		t.Errorf("%v != %v", kvs, expValues)
+	}
+}
+
+// TestBatchReuse verifies that batches and RocksDB write batches
+// reused after being closed or applied carry over no updates.
+func TestBatchReuse(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Close()
+
+	b := NewBatch(e)
+	if err := b.Put(proto.EncodedKey("a"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+	// Close a batch with uncommitted updates, which are discarded.
+	b = NewBatch(e)
+	if err := b.Put(proto.EncodedKey("b"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	// A failed write leaves nothing behind for the next one.
+	failed := []interface{}{
+		BatchPut{proto.RawKeyValue{Key: proto.EncodedKey("c"), Value: []byte("value")}},
+		BatchDelete{proto.RawKeyValue{}},
+	}
+	if err := e.WriteBatch(failed); err == nil {
+		t.Fatal("expected an error writing an empty key")
+	}
+
+	b = NewBatch(e)
+	if b.Len() != 0 {
+		t.Fatalf("expected reused batch to be empty; got %d updates", b.Len())
+	}
+	if err := b.Put(proto.EncodedKey("d"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	expValues := []proto.RawKeyValue{
+		{Key: proto.EncodedKey("a"), Value: []byte("value")},
+		{Key: proto.EncodedKey("d"), Value: []byte("value")},
+	}
+	kvs, err := Scan(e, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expValues, kvs) {
+		t.Errorf("%v != %v", kvs, expValues)
+	}
+}
@@ -27,6 +27,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* RequestHeader_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RequestHeader_reflection_ = NULL;
+const ::google::protobuf::Descriptor* TraceContext_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  TraceContext_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ResponseHeader_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ResponseHeader_reflection_ = NULL;
@@ -54,6 +57,12 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* ConditionalPutResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ConditionalPutResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InitPutRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InitPutRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InitPutResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InitPutResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* IncrementRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   IncrementRequest_reflection_ = NULL;
@@ -72,12 +81,33 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* DeleteRangeResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   DeleteRangeResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ClearRangeRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ClearRangeRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ClearRangeResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ClearRangeResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ScanRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ScanRequest_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ScanResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ScanResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AggregateRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AggregateRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AggregateResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AggregateResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* WatchRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  WatchRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* WatchEvent_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  WatchEvent_reflection_ = NULL;
+const ::google::protobuf::Descriptor* WatchResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  WatchResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* EndTransactionRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   EndTransactionRequest_reflection_ = NULL;
@@ -97,6 +127,8 @@ struct RequestUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::InitPutRequest* init_put_;
+  const ::cockroach::proto::AggregateRequest* aggregate_;
 }* RequestUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* ResponseUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -111,6 +143,8 @@ struct ResponseUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::ScanResponse* scan_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::InitPutResponse* init_put_;
+  const ::cockroach::proto::AggregateResponse* aggregate_;
 }* ResponseUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* BatchRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -130,7 +164,39 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* AdminMergeResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   AdminMergeResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminBulkLoadRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminBulkLoadRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminBulkLoadResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminBulkLoadResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminTransferLeaseRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminTransferLeaseRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminTransferLeaseResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminTransferLeaseResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* BackupFile_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  BackupFile_reflection_ = NULL;
+const ::google::protobuf::Descriptor* BackupManifest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  BackupManifest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminBackupRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminBackupRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminBackupResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminBackupResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminRestoreRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminRestoreRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AdminRestoreResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AdminRestoreResponse_reflection_ = NULL;
 const ::google::protobuf::EnumDescriptor* ReadConsistencyType_descriptor_ = NULL;
+const ::google::protobuf::EnumDescriptor* RequestPriority_descriptor_ = NULL;
+const ::google::protobuf::EnumDescriptor* BackupFormat_descriptor_ = NULL;
 
 }  // namespace
 
@@ -158,7 +224,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ClientCmdID));
   RequestHeader_descriptor_ = file->message_type(1);
-  static const int RequestHeader_offsets_[10] = {
+  static const int RequestHeader_offsets_[13] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, cmd_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, key_),
@@ -169,6 +235,9 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, user_priority_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, read_consistency_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, timeout_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, trace_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, priority_),
   };
   RequestHeader_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -181,7 +250,23 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RequestHeader));
-  ResponseHeader_descriptor_ = file->message_type(2);
+  TraceContext_descriptor_ = file->message_type(2);
+  static const int TraceContext_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TraceContext, trace_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TraceContext, span_id_),
+  };
+  TraceContext_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      TraceContext_descriptor_,
+      TraceContext::default_instance_,
+      TraceContext_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TraceContext, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TraceContext, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(TraceContext));
+  ResponseHeader_descriptor_ = file->message_type(3);
   static const int ResponseHeader_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseHeader, error_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseHeader, timestamp_),
@@ -198,7 +283,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ResponseHeader));
-  ContainsRequest_descriptor_ = file->message_type(3);
+  ContainsRequest_descriptor_ = file->message_type(4);
   static const int ContainsRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ContainsRequest, header_),
   };
@@ -213,7 +298,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ContainsRequest));
-  ContainsResponse_descriptor_ = file->message_type(4);
+  ContainsResponse_descriptor_ = file->message_type(5);
   static const int ContainsResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ContainsResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ContainsResponse, exists_),
@@ -229,7 +314,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ContainsResponse));
-  GetRequest_descriptor_ = file->message_type(5);
+  GetRequest_descriptor_ = file->message_type(6);
   static const int GetRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRequest, header_),
   };
@@ -244,7 +329,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GetRequest));
-  GetResponse_descriptor_ = file->message_type(6);
+  GetResponse_descriptor_ = file->message_type(7);
   static const int GetResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetResponse, value_),
@@ -260,7 +345,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GetResponse));
-  PutRequest_descriptor_ = file->message_type(7);
+  PutRequest_descriptor_ = file->message_type(8);
   static const int PutRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRequest, value_),
@@ -276,7 +361,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(PutRequest));
-  PutResponse_descriptor_ = file->message_type(8);
+  PutResponse_descriptor_ = file->message_type(9);
   static const int PutResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutResponse, header_),
   };
@@ -291,11 +376,12 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(PutResponse));
-  ConditionalPutRequest_descriptor_ = file->message_type(9);
-  static const int ConditionalPutRequest_offsets_[3] = {
+  ConditionalPutRequest_descriptor_ = file->message_type(10);
+  static const int ConditionalPutRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutRequest, value_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutRequest, exp_value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutRequest, allow_if_does_not_exist_),
   };
   ConditionalPutRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -308,7 +394,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionalPutRequest));
-  ConditionalPutResponse_descriptor_ = file->message_type(10);
+  ConditionalPutResponse_descriptor_ = file->message_type(11);
   static const int ConditionalPutResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutResponse, header_),
   };
@@ -323,10 +409,42 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionalPutResponse));
-  IncrementRequest_descriptor_ = file->message_type(11);
-  static const int IncrementRequest_offsets_[2] = {
+  InitPutRequest_descriptor_ = file->message_type(12);
+  static const int InitPutRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutRequest, value_),
+  };
+  InitPutRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InitPutRequest_descriptor_,
+      InitPutRequest::default_instance_,
+      InitPutRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InitPutRequest));
+  InitPutResponse_descriptor_ = file->message_type(13);
+  static const int InitPutResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutResponse, header_),
+  };
+  InitPutResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InitPutResponse_descriptor_,
+      InitPutResponse::default_instance_,
+      InitPutResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InitPutResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InitPutResponse));
+  IncrementRequest_descriptor_ = file->message_type(14);
+  static const int IncrementRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, increment_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, return_previous_),
   };
   IncrementRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -339,10 +457,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(IncrementRequest));
-  IncrementResponse_descriptor_ = file->message_type(12);
-  static const int IncrementResponse_offsets_[2] = {
+  IncrementResponse_descriptor_ = file->message_type(15);
+  static const int IncrementResponse_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementResponse, new_value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementResponse, previous_value_),
   };
   IncrementResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -355,7 +474,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(IncrementResponse));
-  DeleteRequest_descriptor_ = file->message_type(13);
+  DeleteRequest_descriptor_ = file->message_type(16);
   static const int DeleteRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRequest, header_),
   };
@@ -370,7 +489,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteRequest));
-  DeleteResponse_descriptor_ = file->message_type(14);
+  DeleteResponse_descriptor_ = file->message_type(17);
   static const int DeleteResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteResponse, header_),
   };
@@ -385,7 +504,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteResponse));
-  DeleteRangeRequest_descriptor_ = file->message_type(15);
+  DeleteRangeRequest_descriptor_ = file->message_type(18);
   static const int DeleteRangeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeRequest, max_entries_to_delete_),
@@ -401,7 +520,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteRangeRequest));
-  DeleteRangeResponse_descriptor_ = file->message_type(16);
+  DeleteRangeResponse_descriptor_ = file->message_type(19);
   static const int DeleteRangeResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeResponse, num_deleted_),
@@ -417,10 +536,41 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteRangeResponse));
-  ScanRequest_descriptor_ = file->message_type(17);
-  static const int ScanRequest_offsets_[2] = {
+  ClearRangeRequest_descriptor_ = file->message_type(20);
+  static const int ClearRangeRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeRequest, header_),
+  };
+  ClearRangeRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ClearRangeRequest_descriptor_,
+      ClearRangeRequest::default_instance_,
+      ClearRangeRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ClearRangeRequest));
+  ClearRangeResponse_descriptor_ = file->message_type(21);
+  static const int ClearRangeResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, header_),
+  };
+  ClearRangeResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ClearRangeResponse_descriptor_,
+      ClearRangeResponse::default_instance_,
+      ClearRangeResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ClearRangeResponse));
+  ScanRequest_descriptor_ = file->message_type(22);
+  static const int ScanRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, max_results_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, target_bytes_),
   };
   ScanRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -433,10 +583,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ScanRequest));
-  ScanResponse_descriptor_ = file->message_type(18);
-  static const int ScanResponse_offsets_[2] = {
+  ScanResponse_descriptor_ = file->message_type(23);
+  static const int ScanResponse_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, rows_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, resume_span_),
   };
   ScanResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -449,11 +600,96 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ScanResponse));
-  EndTransactionRequest_descriptor_ = file->message_type(19);
-  static const int EndTransactionRequest_offsets_[3] = {
+  AggregateRequest_descriptor_ = file->message_type(24);
+  static const int AggregateRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateRequest, max_results_),
+  };
+  AggregateRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AggregateRequest_descriptor_,
+      AggregateRequest::default_instance_,
+      AggregateRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AggregateRequest));
+  AggregateResponse_descriptor_ = file->message_type(25);
+  static const int AggregateResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateResponse, num_keys_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateResponse, sum_),
+  };
+  AggregateResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AggregateResponse_descriptor_,
+      AggregateResponse::default_instance_,
+      AggregateResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AggregateResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AggregateResponse));
+  WatchRequest_descriptor_ = file->message_type(26);
+  static const int WatchRequest_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchRequest, limit_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchRequest, start_time_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchRequest, max_wait_),
+  };
+  WatchRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      WatchRequest_descriptor_,
+      WatchRequest::default_instance_,
+      WatchRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(WatchRequest));
+  WatchEvent_descriptor_ = file->message_type(27);
+  static const int WatchEvent_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchEvent, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchEvent, value_),
+  };
+  WatchEvent_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      WatchEvent_descriptor_,
+      WatchEvent::default_instance_,
+      WatchEvent_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchEvent, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchEvent, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(WatchEvent));
+  WatchResponse_descriptor_ = file->message_type(28);
+  static const int WatchResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchResponse, events_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchResponse, end_key_),
+  };
+  WatchResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      WatchResponse_descriptor_,
+      WatchResponse::default_instance_,
+      WatchResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(WatchResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(WatchResponse));
+  EndTransactionRequest_descriptor_ = file->message_type(29);
+  static const int EndTransactionRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, commit_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, internal_commit_trigger_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, refreshed_timestamp_),
   };
   EndTransactionRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -466,7 +702,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EndTransactionRequest));
-  EndTransactionResponse_descriptor_ = file->message_type(20);
+  EndTransactionResponse_descriptor_ = file->message_type(30);
   static const int EndTransactionResponse_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, commit_wait_),
@@ -483,8 +719,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EndTransactionResponse));
-  RequestUnion_descriptor_ = file->message_type(21);
-  static const int RequestUnion_offsets_[10] = {
+  RequestUnion_descriptor_ = file->message_type(31);
+  static const int RequestUnion_offsets_[12] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, contains_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, put_),
@@ -494,6 +730,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, init_put_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, aggregate_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, value_),
   };
   RequestUnion_reflection_ =
@@ -509,8 +747,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RequestUnion));
-  ResponseUnion_descriptor_ = file->message_type(22);
-  static const int ResponseUnion_offsets_[10] = {
+  ResponseUnion_descriptor_ = file->message_type(32);
+  static const int ResponseUnion_offsets_[12] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, contains_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, put_),
@@ -520,6 +758,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, init_put_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, aggregate_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, value_),
   };
   ResponseUnion_reflection_ =
@@ -535,7 +775,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ResponseUnion));
-  BatchRequest_descriptor_ = file->message_type(23);
+  BatchRequest_descriptor_ = file->message_type(33);
   static const int BatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, requests_),
@@ -551,7 +791,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(BatchRequest));
-  BatchResponse_descriptor_ = file->message_type(24);
+  BatchResponse_descriptor_ = file->message_type(34);
   static const int BatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, responses_),
@@ -567,7 +807,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(BatchResponse));
-  AdminSplitRequest_descriptor_ = file->message_type(25);
+  AdminSplitRequest_descriptor_ = file->message_type(35);
   static const int AdminSplitRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, split_key_),
@@ -583,7 +823,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminSplitRequest));
-  AdminSplitResponse_descriptor_ = file->message_type(26);
+  AdminSplitResponse_descriptor_ = file->message_type(36);
   static const int AdminSplitResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, header_),
   };
@@ -598,7 +838,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminSplitResponse));
-  AdminMergeRequest_descriptor_ = file->message_type(27);
+  AdminMergeRequest_descriptor_ = file->message_type(37);
   static const int AdminMergeRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, header_),
   };
@@ -613,7 +853,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminMergeRequest));
-  AdminMergeResponse_descriptor_ = file->message_type(28);
+  AdminMergeResponse_descriptor_ = file->message_type(38);
   static const int AdminMergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, header_),
   };
@@ -628,7 +868,181 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminMergeResponse));
+  AdminBulkLoadRequest_descriptor_ = file->message_type(39);
+  static const int AdminBulkLoadRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadRequest, rows_),
+  };
+  AdminBulkLoadRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminBulkLoadRequest_descriptor_,
+      AdminBulkLoadRequest::default_instance_,
+      AdminBulkLoadRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminBulkLoadRequest));
+  AdminBulkLoadResponse_descriptor_ = file->message_type(40);
+  static const int AdminBulkLoadResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadResponse, load_timestamp_),
+  };
+  AdminBulkLoadResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminBulkLoadResponse_descriptor_,
+      AdminBulkLoadResponse::default_instance_,
+      AdminBulkLoadResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBulkLoadResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminBulkLoadResponse));
+  AdminTransferLeaseRequest_descriptor_ = file->message_type(41);
+  static const int AdminTransferLeaseRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseRequest, store_id_),
+  };
+  AdminTransferLeaseRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminTransferLeaseRequest_descriptor_,
+      AdminTransferLeaseRequest::default_instance_,
+      AdminTransferLeaseRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminTransferLeaseRequest));
+  AdminTransferLeaseResponse_descriptor_ = file->message_type(42);
+  static const int AdminTransferLeaseResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseResponse, header_),
+  };
+  AdminTransferLeaseResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminTransferLeaseResponse_descriptor_,
+      AdminTransferLeaseResponse::default_instance_,
+      AdminTransferLeaseResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminTransferLeaseResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminTransferLeaseResponse));
+  BackupFile_descriptor_ = file->message_type(43);
+  static const int BackupFile_offsets_[6] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, name_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, start_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, end_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, checksum_),
+  };
+  BackupFile_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      BackupFile_descriptor_,
+      BackupFile::default_instance_,
+      BackupFile_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupFile, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(BackupFile));
+  BackupManifest_descriptor_ = file->message_type(44);
+  static const int BackupManifest_offsets_[7] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, format_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, start_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, end_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, files_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, start_time_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, system_keys_),
+  };
+  BackupManifest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      BackupManifest_descriptor_,
+      BackupManifest::default_instance_,
+      BackupManifest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BackupManifest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(BackupManifest));
+  AdminBackupRequest_descriptor_ = file->message_type(45);
+  static const int AdminBackupRequest_offsets_[5] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, uri_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, format_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, limit_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, start_time_),
+  };
+  AdminBackupRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminBackupRequest_descriptor_,
+      AdminBackupRequest::default_instance_,
+      AdminBackupRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminBackupRequest));
+  AdminBackupResponse_descriptor_ = file->message_type(46);
+  static const int AdminBackupResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupResponse, file_),
+  };
+  AdminBackupResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminBackupResponse_descriptor_,
+      AdminBackupResponse::default_instance_,
+      AdminBackupResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminBackupResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminBackupResponse));
+  AdminRestoreRequest_descriptor_ = file->message_type(47);
+  static const int AdminRestoreRequest_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreRequest, uri_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreRequest, job_id_),
+  };
+  AdminRestoreRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminRestoreRequest_descriptor_,
+      AdminRestoreRequest::default_instance_,
+      AdminRestoreRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminRestoreRequest));
+  AdminRestoreResponse_descriptor_ = file->message_type(48);
+  static const int AdminRestoreResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreResponse, job_id_),
+  };
+  AdminRestoreResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AdminRestoreResponse_descriptor_,
+      AdminRestoreResponse::default_instance_,
+      AdminRestoreResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminRestoreResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AdminRestoreResponse));
   ReadConsistencyType_descriptor_ = file->enum_type(0);
+  RequestPriority_descriptor_ = file->enum_type(1);
+  BackupFormat_descriptor_ = file->enum_type(2);
 }
 
 namespace {
@@ -645,6 +1059,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     ClientCmdID_descriptor_, &ClientCmdID::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RequestHeader_descriptor_, &RequestHeader::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    TraceContext_descriptor_, &TraceContext::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ResponseHeader_descriptor_, &ResponseHeader::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -663,6 +1079,10 @@ void protobuf_RegisterTypes(const ::std::string&) {
     ConditionalPutRequest_descriptor_, &ConditionalPutRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ConditionalPutResponse_descriptor_, &ConditionalPutResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InitPutRequest_descriptor_, &InitPutRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InitPutResponse_descriptor_, &InitPutResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     IncrementRequest_descriptor_, &IncrementRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -675,10 +1095,24 @@ void protobuf_RegisterTypes(const ::std::string&) {
     DeleteRangeRequest_descriptor_, &DeleteRangeRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     DeleteRangeResponse_descriptor_, &DeleteRangeResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ClearRangeRequest_descriptor_, &ClearRangeRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ClearRangeResponse_descriptor_, &ClearRangeResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ScanRequest_descriptor_, &ScanRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ScanResponse_descriptor_, &ScanResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AggregateRequest_descriptor_, &AggregateRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AggregateResponse_descriptor_, &AggregateResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    WatchRequest_descriptor_, &WatchRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    WatchEvent_descriptor_, &WatchEvent::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    WatchResponse_descriptor_, &WatchResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     EndTransactionRequest_descriptor_, &EndTransactionRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -699,6 +1133,26 @@ void protobuf_RegisterTypes(const ::std::string&) {
     AdminMergeRequest_descriptor_, &AdminMergeRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     AdminMergeResponse_descriptor_, &AdminMergeResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminBulkLoadRequest_descriptor_, &AdminBulkLoadRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminBulkLoadResponse_descriptor_, &AdminBulkLoadResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminTransferLeaseRequest_descriptor_, &AdminTransferLeaseRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminTransferLeaseResponse_descriptor_, &AdminTransferLeaseResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    BackupFile_descriptor_, &BackupFile::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    BackupManifest_descriptor_, &BackupManifest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminBackupRequest_descriptor_, &AdminBackupRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminBackupResponse_descriptor_, &AdminBackupResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminRestoreRequest_descriptor_, &AdminRestoreRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AdminRestoreResponse_descriptor_, &AdminRestoreResponse::default_instance());
 }
 
 }  // namespace
@@ -708,6 +1162,8 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto() {
   delete ClientCmdID_reflection_;
   delete RequestHeader::default_instance_;
   delete RequestHeader_reflection_;
+  delete TraceContext::default_instance_;
+  delete TraceContext_reflection_;
   delete ResponseHeader::default_instance_;
   delete ResponseHeader_reflection_;
   delete ContainsRequest::default_instance_;
@@ -726,6 +1182,10 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto() {
   delete ConditionalPutRequest_reflection_;
   delete ConditionalPutResponse::default_instance_;
   delete ConditionalPutResponse_reflection_;
+  delete InitPutRequest::default_instance_;
+  delete InitPutRequest_reflection_;
+  delete InitPutResponse::default_instance_;
+  delete InitPutResponse_reflection_;
   delete IncrementRequest::default_instance_;
   delete IncrementRequest_reflection_;
   delete IncrementResponse::default_instance_;
@@ -738,10 +1198,24 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto() {
   delete DeleteRangeRequest_reflection_;
   delete DeleteRangeResponse::default_instance_;
   delete DeleteRangeResponse_reflection_;
+  delete ClearRangeRequest::default_instance_;
+  delete ClearRangeRequest_reflection_;
+  delete ClearRangeResponse::default_instance_;
+  delete ClearRangeResponse_reflection_;
   delete ScanRequest::default_instance_;
   delete ScanRequest_reflection_;
   delete ScanResponse::default_instance_;
   delete ScanResponse_reflection_;
+  delete AggregateRequest::default_instance_;
+  delete AggregateRequest_reflection_;
+  delete AggregateResponse::default_instance_;
+  delete AggregateResponse_reflection_;
+  delete WatchRequest::default_instance_;
+  delete WatchRequest_reflection_;
+  delete WatchEvent::default_instance_;
+  delete WatchEvent_reflection_;
+  delete WatchResponse::default_instance_;
+  delete WatchResponse_reflection_;
   delete EndTransactionRequest::default_instance_;
   delete EndTransactionRequest_reflection_;
   delete EndTransactionResponse::default_instance_;
@@ -764,6 +1238,26 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto() {
   delete AdminMergeRequest_reflection_;
   delete AdminMergeResponse::default_instance_;
   delete AdminMergeResponse_reflection_;
+  delete AdminBulkLoadRequest::default_instance_;
+  delete AdminBulkLoadRequest_reflection_;
+  delete AdminBulkLoadResponse::default_instance_;
+  delete AdminBulkLoadResponse_reflection_;
+  delete AdminTransferLeaseRequest::default_instance_;
+  delete AdminTransferLeaseRequest_reflection_;
+  delete AdminTransferLeaseResponse::default_instance_;
+  delete AdminTransferLeaseResponse_reflection_;
+  delete BackupFile::default_instance_;
+  delete BackupFile_reflection_;
+  delete BackupManifest::default_instance_;
+  delete BackupManifest_reflection_;
+  delete AdminBackupRequest::default_instance_;
+  delete AdminBackupRequest_reflection_;
+  delete AdminBackupResponse::default_instance_;
+  delete AdminBackupResponse_reflection_;
+  delete AdminRestoreRequest::default_instance_;
+  delete AdminRestoreRequest_reflection_;
+  delete AdminRestoreResponse::default_instance_;
+  delete AdminRestoreResponse_reflection_;
 }
 
 void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
@@ -782,7 +1276,7 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
     "roach/proto/data.proto\032\034cockroach/proto/"
     "errors.proto\032\024gogoproto/gogo.proto\"<\n\013Cl"
     "ientCmdID\022\027\n\twall_time\030\001 \001(\003B\004\310\336\037\000\022\024\n\006ra"
-    "ndom\030\002 \001(\003B\004\310\336\037\000\"\252\003\n\rRequestHeader\0223\n\tti"
+    "ndom\030\002 \001(\003B\004\310\336\037\000\"\251\004\n\rRequestHeader\0223\n\tti"
     "mestamp\030\001 \001(\0132\032.cockroach.proto.Timestam"
     "pB\004\310\336\037\000\022;\n\006cmd_id\030\002 \001(\0132\034.cockroach.prot"
     "o.ClientCmdIDB\r\310\336\037\000\342\336\037\005CmdID\022\030\n\003key\030\003 \001("
@@ -793,107 +1287,236 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
     "ity\030\010 \001(\005:\0011\022)\n\003txn\030\t \001(\0132\034.cockroach.pr"
     "oto.Transaction\022D\n\020read_consistency\030\n \001("
     "\0162$.cockroach.proto.ReadConsistencyTypeB"
-    "\004\310\336\037\000\"\227\001\n\016ResponseHeader\022%\n\005error\030\001 \001(\0132"
-    "\026.cockroach.proto.Error\0223\n\ttimestamp\030\002 \001"
-    "(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\022)\n\003"
-    "txn\030\003 \001(\0132\034.cockroach.proto.Transaction\""
-    "K\n\017ContainsRequest\0228\n\006header\030\001 \001(\0132\036.coc"
-    "kroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"c\n\020"
-    "ContainsResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\024\n\006e"
-    "xists\030\002 \001(\010B\004\310\336\037\000\"F\n\nGetRequest\0228\n\006heade"
-    "r\030\001 \001(\0132\036.cockroach.proto.RequestHeaderB"
-    "\010\310\336\037\000\320\336\037\001\"o\n\013GetResponse\0229\n\006header\030\001 \001(\013"
-    "2\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320"
-    "\336\037\001\022%\n\005value\030\002 \001(\0132\026.cockroach.proto.Val"
-    "ue\"s\n\nPutRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
-    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005va"
-    "lue\030\002 \001(\0132\026.cockroach.proto.ValueB\004\310\336\037\000\""
-    "H\n\013PutResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
-    "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\251\001\n\025Co"
-    "nditionalPutRequest\0228\n\006header\030\001 \001(\0132\036.co"
-    "ckroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022+\n"
-    "\005value\030\002 \001(\0132\026.cockroach.proto.ValueB\004\310\336"
-    "\037\000\022)\n\texp_value\030\003 \001(\0132\026.cockroach.proto."
-    "Value\"S\n\026ConditionalPutResponse\0229\n\006heade"
-    "r\030\001 \001(\0132\037.cockroach.proto.ResponseHeader"
-    "B\010\310\336\037\000\320\336\037\001\"e\n\020IncrementRequest\0228\n\006header"
+    "\004\310\336\037\000\022\025\n\007timeout\030\013 \001(\003B\004\310\336\037\000\022,\n\005trace\030\014 "
+    "\001(\0132\035.cockroach.proto.TraceContext\0228\n\010pr"
+    "iority\030\r \001(\0162 .cockroach.proto.RequestPr"
+    "iorityB\004\310\336\037\000\"R\n\014TraceContext\022!\n\010trace_id"
+    "\030\001 \001(\003B\017\310\336\037\000\342\336\037\007TraceID\022\037\n\007span_id\030\002 \001(\003"
+    "B\016\310\336\037\000\342\336\037\006SpanID\"\227\001\n\016ResponseHeader\022%\n\005e"
+    "rror\030\001 \001(\0132\026.cockroach.proto.Error\0223\n\tti"
+    "mestamp\030\002 \001(\0132\032.cockroach.proto.Timestam"
+    "pB\004\310\336\037\000\022)\n\003txn\030\003 \001(\0132\034.cockroach.proto.T"
+    "ransaction\"K\n\017ContainsRequest\0228\n\006header\030"
+    "\001 \001(\0132\036.cockroach.proto.RequestHeaderB\010\310"
+    "\336\037\000\320\336\037\001\"c\n\020ContainsResponse\0229\n\006header\030\001 "
+    "\001(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\022\024\n\006exists\030\002 \001(\010B\004\310\336\037\000\"F\n\nGetReque"
+    "st\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Req"
+    "uestHeaderB\010\310\336\037\000\320\336\037\001\"o\n\013GetResponse\0229\n\006h"
+    "eader\030\001 \001(\0132\037.cockroach.proto.ResponseHe"
+    "aderB\010\310\336\037\000\320\336\037\001\022%\n\005value\030\002 \001(\0132\026.cockroac"
+    "h.proto.Value\"s\n\nPutRequest\0228\n\006header\030\001 "
+    "\001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037"
+    "\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockroach.proto.V"
+    "alueB\004\310\336\037\000\"H\n\013PutResponse\0229\n\006header\030\001 \001("
+    "\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\"\320\001\n\025ConditionalPutRequest\0228\n\006header"
     "\030\001 \001(\0132\036.cockroach.proto.RequestHeaderB\010"
-    "\310\336\037\000\320\336\037\001\022\027\n\tincrement\030\002 \001(\003B\004\310\336\037\000\"g\n\021Inc"
-    "rementResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
-    "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tnew"
-    "_value\030\002 \001(\003B\004\310\336\037\000\"I\n\rDeleteRequest\0228\n\006h"
-    "eader\030\001 \001(\0132\036.cockroach.proto.RequestHea"
-    "derB\010\310\336\037\000\320\336\037\001\"K\n\016DeleteResponse\0229\n\006heade"
+    "\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockroach.prot"
+    "o.ValueB\004\310\336\037\000\022)\n\texp_value\030\003 \001(\0132\026.cockr"
+    "oach.proto.Value\022%\n\027allow_if_does_not_ex"
+    "ist\030\004 \001(\010B\004\310\336\037\000\"S\n\026ConditionalPutRespons"
+    "e\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Resp"
+    "onseHeaderB\010\310\336\037\000\320\336\037\001\"w\n\016InitPutRequest\0228"
+    "\n\006header\030\001 \001(\0132\036.cockroach.proto.Request"
+    "HeaderB\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockro"
+    "ach.proto.ValueB\004\310\336\037\000\"L\n\017InitPutResponse"
+    "\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Respo"
+    "nseHeaderB\010\310\336\037\000\320\336\037\001\"\204\001\n\020IncrementRequest"
+    "\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Reque"
+    "stHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tincrement\030\002 \001(\003B\004\310"
+    "\336\037\000\022\035\n\017return_previous\030\003 \001(\010B\004\310\336\037\000\"\205\001\n\021I"
+    "ncrementResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
+    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tn"
+    "ew_value\030\002 \001(\003B\004\310\336\037\000\022\034\n\016previous_value\030\003"
+    " \001(\003B\004\310\336\037\000\"I\n\rDeleteRequest\0228\n\006header\030\001 "
+    "\001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037"
+    "\000\320\336\037\001\"K\n\016DeleteResponse\0229\n\006header\030\001 \001(\0132"
+    "\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336"
+    "\037\001\"s\n\022DeleteRangeRequest\0228\n\006header\030\001 \001(\013"
+    "2\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336"
+    "\037\001\022#\n\025max_entries_to_delete\030\002 \001(\003B\004\310\336\037\000\""
+    "k\n\023DeleteRangeResponse\0229\n\006header\030\001 \001(\0132\037"
+    ".cockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037"
+    "\001\022\031\n\013num_deleted\030\002 \001(\003B\004\310\336\037\000\"M\n\021ClearRan"
+    "geRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.pr"
+    "oto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"O\n\022ClearRang"
+    "eResponse\0229\n\006header\030\001 \001(\0132\037.cockroach.pr"
+    "oto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"~\n\013ScanRequ"
+    "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
+    "questHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001"
+    "(\003B\004\310\336\037\000\022\032\n\014target_bytes\030\003 \001(\003B\004\310\336\037\000\"\244\001\n"
+    "\014ScanResponse\0229\n\006header\030\001 \001(\0132\037.cockroac"
+    "h.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022-\n\004rows"
+    "\030\002 \003(\0132\031.cockroach.proto.KeyValueB\004\310\336\037\000\022"
+    "*\n\013resume_span\030\003 \001(\0132\025.cockroach.proto.S"
+    "pan\"g\n\020AggregateRequest\0228\n\006header\030\001 \001(\0132"
+    "\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037"
+    "\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000\"y\n\021Aggregat"
+    "eResponse\0229\n\006header\030\001 \001(\0132\037.cockroach.pr"
+    "oto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\026\n\010num_keys"
+    "\030\002 \001(\003B\004\310\336\037\000\022\021\n\003sum\030\003 \001(\003B\004\310\336\037\000\"\266\001\n\014Watc"
+    "hRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.pro"
+    "to.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\036\n\tlimit_key\030"
+    "\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\0224\n\nstart_time\030\003 \001(\0132\032"
+    ".cockroach.proto.TimestampB\004\310\336\037\000\022\026\n\010max_"
+    "wait\030\004 \001(\003B\004\310\336\037\000\"M\n\nWatchEvent\022\030\n\003key\030\001 "
+    "\001(\014B\013\310\336\037\000\332\336\037\003Key\022%\n\005value\030\002 \001(\0132\026.cockro"
+    "ach.proto.Value\"\233\001\n\rWatchResponse\0229\n\006hea"
+    "der\030\001 \001(\0132\037.cockroach.proto.ResponseHead"
+    "erB\010\310\336\037\000\320\336\037\001\0221\n\006events\030\002 \003(\0132\033.cockroach"
+    ".proto.WatchEventB\004\310\336\037\000\022\034\n\007end_key\030\003 \001(\014"
+    "B\013\310\336\037\000\332\336\037\003Key\"\351\001\n\025EndTransactionRequest\022"
+    "8\n\006header\030\001 \001(\0132\036.cockroach.proto.Reques"
+    "tHeaderB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022"
+    "G\n\027internal_commit_trigger\030\003 \001(\0132&.cockr"
+    "oach.proto.InternalCommitTrigger\0227\n\023refr"
+    "eshed_timestamp\030\004 \001(\0132\032.cockroach.proto."
+    "Timestamp\"\211\001\n\026EndTransactionResponse\0229\n\006"
+    "header\030\001 \001(\0132\037.cockroach.proto.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wait\030\002 \001(\003B\004\310\336"
+    "\037\000\022\031\n\010resolved\030\003 \003(\014B\007\332\336\037\003Key\"\363\004\n\014Reques"
+    "tUnion\0224\n\010contains\030\001 \001(\0132 .cockroach.pro"
+    "to.ContainsRequestH\000\022*\n\003get\030\002 \001(\0132\033.cock"
+    "roach.proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033."
+    "cockroach.proto.PutRequestH\000\022A\n\017conditio"
+    "nal_put\030\004 \001(\0132&.cockroach.proto.Conditio"
+    "nalPutRequestH\000\0226\n\tincrement\030\005 \001(\0132!.coc"
+    "kroach.proto.IncrementRequestH\000\0220\n\006delet"
+    "e\030\006 \001(\0132\036.cockroach.proto.DeleteRequestH"
+    "\000\022;\n\014delete_range\030\007 \001(\0132#.cockroach.prot"
+    "o.DeleteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034.c"
+    "ockroach.proto.ScanRequestH\000\022A\n\017end_tran"
+    "saction\030\t \001(\0132&.cockroach.proto.EndTrans"
+    "actionRequestH\000\0223\n\010init_put\030\n \001(\0132\037.cock"
+    "roach.proto.InitPutRequestH\000\0226\n\taggregat"
+    "e\030\013 \001(\0132!.cockroach.proto.AggregateReque"
+    "stH\000:\004\310\240\037\001B\007\n\005value\"\377\004\n\rResponseUnion\0225\n"
+    "\010contains\030\001 \001(\0132!.cockroach.proto.Contai"
+    "nsResponseH\000\022+\n\003get\030\002 \001(\0132\034.cockroach.pr"
+    "oto.GetResponseH\000\022+\n\003put\030\003 \001(\0132\034.cockroa"
+    "ch.proto.PutResponseH\000\022B\n\017conditional_pu"
+    "t\030\004 \001(\0132\'.cockroach.proto.ConditionalPut"
+    "ResponseH\000\0227\n\tincrement\030\005 \001(\0132\".cockroac"
+    "h.proto.IncrementResponseH\000\0221\n\006delete\030\006 "
+    "\001(\0132\037.cockroach.proto.DeleteResponseH\000\022<"
+    "\n\014delete_range\030\007 \001(\0132$.cockroach.proto.D"
+    "eleteRangeResponseH\000\022-\n\004scan\030\010 \001(\0132\035.coc"
+    "kroach.proto.ScanResponseH\000\022B\n\017end_trans"
+    "action\030\t \001(\0132\'.cockroach.proto.EndTransa"
+    "ctionResponseH\000\0224\n\010init_put\030\n \001(\0132 .cock"
+    "roach.proto.InitPutResponseH\000\0227\n\taggrega"
+    "te\030\013 \001(\0132\".cockroach.proto.AggregateResp"
+    "onseH\000:\004\310\240\037\001B\007\n\005value\"\177\n\014BatchRequest\0228\n"
+    "\006header\030\001 \001(\0132\036.cockroach.proto.RequestH"
+    "eaderB\010\310\336\037\000\320\336\037\001\0225\n\010requests\030\002 \003(\0132\035.cock"
+    "roach.proto.RequestUnionB\004\310\336\037\000\"\203\001\n\rBatch"
+    "Response\0229\n\006header\030\001 \001(\0132\037.cockroach.pro"
+    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0227\n\tresponses"
+    "\030\002 \003(\0132\036.cockroach.proto.ResponseUnionB\004"
+    "\310\336\037\000\"m\n\021AdminSplitRequest\0228\n\006header\030\001 \001("
+    "\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320"
+    "\336\037\001\022\036\n\tsplit_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\"O\n\022A"
+    "dminSplitResponse\0229\n\006header\030\001 \001(\0132\037.cock"
+    "roach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"M\n\021"
+    "AdminMergeRequest\0228\n\006header\030\001 \001(\0132\036.cock"
+    "roach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"O\n\022A"
+    "dminMergeResponse\0229\n\006header\030\001 \001(\0132\037.cock"
+    "roach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\177\n\024"
+    "AdminBulkLoadRequest\0228\n\006header\030\001 \001(\0132\036.c"
+    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022-"
+    "\n\004rows\030\002 \003(\0132\031.cockroach.proto.KeyValueB"
+    "\004\310\336\037\000\"\214\001\n\025AdminBulkLoadResponse\0229\n\006heade"
     "r\030\001 \001(\0132\037.cockroach.proto.ResponseHeader"
-    "B\010\310\336\037\000\320\336\037\001\"s\n\022DeleteRangeRequest\0228\n\006head"
-    "er\030\001 \001(\0132\036.cockroach.proto.RequestHeader"
-    "B\010\310\336\037\000\320\336\037\001\022#\n\025max_entries_to_delete\030\002 \001("
-    "\003B\004\310\336\037\000\"k\n\023DeleteRangeResponse\0229\n\006header"
-    "\030\001 \001(\0132\037.cockroach.proto.ResponseHeaderB"
-    "\010\310\336\037\000\320\336\037\001\022\031\n\013num_deleted\030\002 \001(\003B\004\310\336\037\000\"b\n\013"
-    "ScanRequest\0228\n\006header\030\001 \001(\0132\036.cockroach."
-    "proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_res"
-    "ults\030\002 \001(\003B\004\310\336\037\000\"x\n\014ScanResponse\0229\n\006head"
+    "B\010\310\336\037\000\320\336\037\001\0228\n\016load_timestamp\030\002 \001(\0132\032.coc"
+    "kroach.proto.TimestampB\004\310\336\037\000\"\203\001\n\031AdminTr"
+    "ansferLeaseRequest\0228\n\006header\030\001 \001(\0132\036.coc"
+    "kroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022,\n\010"
+    "store_id\030\002 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007Store"
+    "ID\"W\n\032AdminTransferLeaseResponse\0229\n\006head"
     "er\030\001 \001(\0132\037.cockroach.proto.ResponseHeade"
-    "rB\010\310\336\037\000\320\336\037\001\022-\n\004rows\030\002 \003(\0132\031.cockroach.pr"
-    "oto.KeyValueB\004\310\336\037\000\"\260\001\n\025EndTransactionReq"
-    "uest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.R"
-    "equestHeaderB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004"
-    "\310\336\037\000\022G\n\027internal_commit_trigger\030\003 \001(\0132&."
-    "cockroach.proto.InternalCommitTrigger\"\211\001"
-    "\n\026EndTransactionResponse\0229\n\006header\030\001 \001(\013"
-    "2\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320"
-    "\336\037\001\022\031\n\013commit_wait\030\002 \001(\003B\004\310\336\037\000\022\031\n\010resolv"
-    "ed\030\003 \003(\014B\007\332\336\037\003Key\"\206\004\n\014RequestUnion\0224\n\010co"
-    "ntains\030\001 \001(\0132 .cockroach.proto.ContainsR"
-    "equestH\000\022*\n\003get\030\002 \001(\0132\033.cockroach.proto."
-    "GetRequestH\000\022*\n\003put\030\003 \001(\0132\033.cockroach.pr"
-    "oto.PutRequestH\000\022A\n\017conditional_put\030\004 \001("
-    "\0132&.cockroach.proto.ConditionalPutReques"
-    "tH\000\0226\n\tincrement\030\005 \001(\0132!.cockroach.proto"
-    ".IncrementRequestH\000\0220\n\006delete\030\006 \001(\0132\036.co"
-    "ckroach.proto.DeleteRequestH\000\022;\n\014delete_"
-    "range\030\007 \001(\0132#.cockroach.proto.DeleteRang"
-    "eRequestH\000\022,\n\004scan\030\010 \001(\0132\034.cockroach.pro"
-    "to.ScanRequestH\000\022A\n\017end_transaction\030\t \001("
-    "\0132&.cockroach.proto.EndTransactionReques"
-    "tH\000:\004\310\240\037\001B\007\n\005value\"\220\004\n\rResponseUnion\0225\n\010"
-    "contains\030\001 \001(\0132!.cockroach.proto.Contain"
-    "sResponseH\000\022+\n\003get\030\002 \001(\0132\034.cockroach.pro"
-    "to.GetResponseH\000\022+\n\003put\030\003 \001(\0132\034.cockroac"
-    "h.proto.PutResponseH\000\022B\n\017conditional_put"
-    "\030\004 \001(\0132\'.cockroach.proto.ConditionalPutR"
-    "esponseH\000\0227\n\tincrement\030\005 \001(\0132\".cockroach"
-    ".proto.IncrementResponseH\000\0221\n\006delete\030\006 \001"
-    "(\0132\037.cockroach.proto.DeleteResponseH\000\022<\n"
-    "\014delete_range\030\007 \001(\0132$.cockroach.proto.De"
-    "leteRangeResponseH\000\022-\n\004scan\030\010 \001(\0132\035.cock"
-    "roach.proto.ScanResponseH\000\022B\n\017end_transa"
-    "ction\030\t \001(\0132\'.cockroach.proto.EndTransac"
-    "tionResponseH\000:\004\310\240\037\001B\007\n\005value\"\177\n\014BatchRe"
-    "quest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto."
-    "RequestHeaderB\010\310\336\037\000\320\336\037\001\0225\n\010requests\030\002 \003("
-    "\0132\035.cockroach.proto.RequestUnionB\004\310\336\037\000\"\203"
-    "\001\n\rBatchResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0227\n\tr"
-    "esponses\030\002 \003(\0132\036.cockroach.proto.Respons"
-    "eUnionB\004\310\336\037\000\"m\n\021AdminSplitRequest\0228\n\006hea"
-    "der\030\001 \001(\0132\036.cockroach.proto.RequestHeade"
-    "rB\010\310\336\037\000\320\336\037\001\022\036\n\tsplit_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003"
-    "Key\"O\n\022AdminSplitResponse\0229\n\006header\030\001 \001("
-    "\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000"
-    "\320\336\037\001\"M\n\021AdminMergeRequest\0228\n\006header\030\001 \001("
-    "\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320"
-    "\336\037\001\"O\n\022AdminMergeResponse\0229\n\006header\030\001 \001("
-    "\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000"
-    "\320\336\037\001*L\n\023ReadConsistencyType\022\016\n\nCONSISTEN"
-    "T\020\000\022\r\n\tCONSENSUS\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243"
-    "\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 4503);
+    "rB\010\310\336\037\000\320\336\037\001\"\232\001\n\nBackupFile\022\022\n\004name\030\001 \001(\t"
+    "B\004\310\336\037\000\022\036\n\tstart_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034"
+    "\n\007end_key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\023\n\005count\030\004 "
+    "\001(\003B\004\310\336\037\000\022\023\n\005bytes\030\005 \001(\003B\004\310\336\037\000\022\020\n\010checks"
+    "um\030\006 \001(\014\"\326\002\n\016BackupManifest\0223\n\ttimestamp"
+    "\030\001 \001(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000"
+    "\0223\n\006format\030\002 \001(\0162\035.cockroach.proto.Backu"
+    "pFormatB\004\310\336\037\000\022\036\n\tstart_key\030\003 \001(\014B\013\310\336\037\000\332\336"
+    "\037\003Key\022\034\n\007end_key\030\004 \001(\014B\013\310\336\037\000\332\336\037\003Key\0220\n\005f"
+    "iles\030\005 \003(\0132\033.cockroach.proto.BackupFileB"
+    "\004\310\336\037\000\0224\n\nstart_time\030\006 \001(\0132\032.cockroach.pr"
+    "oto.TimestampB\004\310\336\037\000\0224\n\013system_keys\030\007 \003(\013"
+    "2\031.cockroach.proto.KeyValueB\004\310\336\037\000\"\363\001\n\022Ad"
+    "minBackupRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
+    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\030\n\003ur"
+    "i\030\002 \001(\tB\013\310\336\037\000\342\336\037\003URI\0223\n\006format\030\003 \001(\0162\035.c"
+    "ockroach.proto.BackupFormatB\004\310\336\037\000\022\036\n\tlim"
+    "it_key\030\004 \001(\014B\013\310\336\037\000\332\336\037\003Key\0224\n\nstart_time\030"
+    "\005 \001(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\""
+    "\201\001\n\023AdminBackupResponse\0229\n\006header\030\001 \001(\0132"
+    "\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336"
+    "\037\001\022/\n\004file\030\002 \001(\0132\033.cockroach.proto.Backu"
+    "pFileB\004\310\336\037\000\"\210\001\n\023AdminRestoreRequest\0228\n\006h"
+    "eader\030\001 \001(\0132\036.cockroach.proto.RequestHea"
+    "derB\010\310\336\037\000\320\336\037\001\022\030\n\003uri\030\002 \001(\tB\013\310\336\037\000\342\336\037\003URI\022"
+    "\035\n\006job_id\030\003 \001(\tB\r\310\336\037\000\342\336\037\005JobID\"p\n\024AdminR"
+    "estoreResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
+    "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\035\n\006job"
+    "_id\030\002 \001(\tB\r\310\336\037\000\342\336\037\005JobID*L\n\023ReadConsiste"
+    "ncyType\022\016\n\nCONSISTENT\020\000\022\r\n\tCONSENSUS\020\001\022\020"
+    "\n\014INCONSISTENT\020\002\032\004\210\243\036\000*R\n\017RequestPriorit"
+    "y\022\n\n\006NORMAL\020\000\022\n\n\006SYSTEM\020\001\022\010\n\004HIGH\020\002\022\007\n\003L"
+    "OW\020\003\022\016\n\nBACKGROUND\020\004\032\004\210\243\036\000*\037\n\014BackupForm"
+    "at\022\t\n\005PROTO\020\000\032\004\210\243\036\0002\346\010\n\002KV\022O\n\010Contains\022 "
+    ".cockroach.proto.ContainsRequest\032!.cockr"
+    "oach.proto.ContainsResponse\022@\n\003Get\022\033.coc"
+    "kroach.proto.GetRequest\032\034.cockroach.prot"
+    "o.GetResponse\022@\n\003Put\022\033.cockroach.proto.P"
+    "utRequest\032\034.cockroach.proto.PutResponse\022"
+    "a\n\016ConditionalPut\022&.cockroach.proto.Cond"
+    "itionalPutRequest\032\'.cockroach.proto.Cond"
+    "itionalPutResponse\022L\n\007InitPut\022\037.cockroac"
+    "h.proto.InitPutRequest\032 .cockroach.proto"
+    ".InitPutResponse\022R\n\tIncrement\022!.cockroac"
+    "h.proto.IncrementRequest\032\".cockroach.pro"
+    "to.IncrementResponse\022I\n\006Delete\022\036.cockroa"
+    "ch.proto.DeleteRequest\032\037.cockroach.proto"
+    ".DeleteResponse\022X\n\013DeleteRange\022#.cockroa"
+    "ch.proto.DeleteRangeRequest\032$.cockroach."
+    "proto.DeleteRangeResponse\022U\n\nClearRange\022"
+    "\".cockroach.proto.ClearRangeRequest\032#.co"
+    "ckroach.proto.ClearRangeResponse\022C\n\004Scan"
+    "\022\034.cockroach.proto.ScanRequest\032\035.cockroa"
+    "ch.proto.ScanResponse\022R\n\tAggregate\022!.coc"
+    "kroach.proto.AggregateRequest\032\".cockroac"
+    "h.proto.AggregateResponse\022F\n\005Watch\022\035.coc"
+    "kroach.proto.WatchRequest\032\036.cockroach.pr"
+    "oto.WatchResponse\022a\n\016EndTransaction\022&.co"
+    "ckroach.proto.EndTransactionRequest\032\'.co"
+    "ckroach.proto.EndTransactionResponse\022F\n\005"
+    "Batch\022\035.cockroach.proto.BatchRequest\032\036.c"
+    "ockroach.proto.BatchResponse2\273\004\n\005Admin\022U"
+    "\n\nAdminSplit\022\".cockroach.proto.AdminSpli"
+    "tRequest\032#.cockroach.proto.AdminSplitRes"
+    "ponse\022U\n\nAdminMerge\022\".cockroach.proto.Ad"
+    "minMergeRequest\032#.cockroach.proto.AdminM"
+    "ergeResponse\022^\n\rAdminBulkLoad\022%.cockroac"
+    "h.proto.AdminBulkLoadRequest\032&.cockroach"
+    ".proto.AdminBulkLoadResponse\022m\n\022AdminTra"
+    "nsferLease\022*.cockroach.proto.AdminTransf"
+    "erLeaseRequest\032+.cockroach.proto.AdminTr"
+    "ansferLeaseResponse\022X\n\013AdminBackup\022#.coc"
+    "kroach.proto.AdminBackupRequest\032$.cockro"
+    "ach.proto.AdminBackupResponse\022[\n\014AdminRe"
+    "store\022$.cockroach.proto.AdminRestoreRequ"
+    "est\032%.cockroach.proto.AdminRestoreRespon"
+    "seB\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 9623);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/api.proto", &protobuf_RegisterTypes);
   ClientCmdID::default_instance_ = new ClientCmdID();
   RequestHeader::default_instance_ = new RequestHeader();
+  TraceContext::default_instance_ = new TraceContext();
   ResponseHeader::default_instance_ = new ResponseHeader();
   ContainsRequest::default_instance_ = new ContainsRequest();
   ContainsResponse::default_instance_ = new ContainsResponse();
@@ -903,14 +1526,23 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   PutResponse::default_instance_ = new PutResponse();
   ConditionalPutRequest::default_instance_ = new ConditionalPutRequest();
   ConditionalPutResponse::default_instance_ = new ConditionalPutResponse();
+  InitPutRequest::default_instance_ = new InitPutRequest();
+  InitPutResponse::default_instance_ = new InitPutResponse();
   IncrementRequest::default_instance_ = new IncrementRequest();
   IncrementResponse::default_instance_ = new IncrementResponse();
   DeleteRequest::default_instance_ = new DeleteRequest();
   DeleteResponse::default_instance_ = new DeleteResponse();
   DeleteRangeRequest::default_instance_ = new DeleteRangeRequest();
   DeleteRangeResponse::default_instance_ = new DeleteRangeResponse();
+  ClearRangeRequest::default_instance_ = new ClearRangeRequest();
+  ClearRangeResponse::default_instance_ = new ClearRangeResponse();
   ScanRequest::default_instance_ = new ScanRequest();
   ScanResponse::default_instance_ = new ScanResponse();
+  AggregateRequest::default_instance_ = new AggregateRequest();
+  AggregateResponse::default_instance_ = new AggregateResponse();
+  WatchRequest::default_instance_ = new WatchRequest();
+  WatchEvent::default_instance_ = new WatchEvent();
+  WatchResponse::default_instance_ = new WatchResponse();
   EndTransactionRequest::default_instance_ = new EndTransactionRequest();
   EndTransactionResponse::default_instance_ = new EndTransactionResponse();
   RequestUnion::default_instance_ = new RequestUnion();
@@ -923,8 +1555,19 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   AdminSplitResponse::default_instance_ = new AdminSplitResponse();
   AdminMergeRequest::default_instance_ = new AdminMergeRequest();
   AdminMergeResponse::default_instance_ = new AdminMergeResponse();
+  AdminBulkLoadRequest::default_instance_ = new AdminBulkLoadRequest();
+  AdminBulkLoadResponse::default_instance_ = new AdminBulkLoadResponse();
+  AdminTransferLeaseRequest::default_instance_ = new AdminTransferLeaseRequest();
+  AdminTransferLeaseResponse::default_instance_ = new AdminTransferLeaseResponse();
+  BackupFile::default_instance_ = new BackupFile();
+  BackupManifest::default_instance_ = new BackupManifest();
+  AdminBackupRequest::default_instance_ = new AdminBackupRequest();
+  AdminBackupResponse::default_instance_ = new AdminBackupResponse();
+  AdminRestoreRequest::default_instance_ = new AdminRestoreRequest();
+  AdminRestoreResponse::default_instance_ = new AdminRestoreResponse();
   ClientCmdID::default_instance_->InitAsDefaultInstance();
   RequestHeader::default_instance_->InitAsDefaultInstance();
+  TraceContext::default_instance_->InitAsDefaultInstance();
   ResponseHeader::default_instance_->InitAsDefaultInstance();
   ContainsRequest::default_instance_->InitAsDefaultInstance();
   ContainsResponse::default_instance_->InitAsDefaultInstance();
@@ -934,14 +1577,23 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   PutResponse::default_instance_->InitAsDefaultInstance();
   ConditionalPutRequest::default_instance_->InitAsDefaultInstance();
   ConditionalPutResponse::default_instance_->InitAsDefaultInstance();
+  InitPutRequest::default_instance_->InitAsDefaultInstance();
+  InitPutResponse::default_instance_->InitAsDefaultInstance();
   IncrementRequest::default_instance_->InitAsDefaultInstance();
   IncrementResponse::default_instance_->InitAsDefaultInstance();
   DeleteRequest::default_instance_->InitAsDefaultInstance();
   DeleteResponse::default_instance_->InitAsDefaultInstance();
   DeleteRangeRequest::default_instance_->InitAsDefaultInstance();
   DeleteRangeResponse::default_instance_->InitAsDefaultInstance();
+  ClearRangeRequest::default_instance_->InitAsDefaultInstance();
+  ClearRangeResponse::default_instance_->InitAsDefaultInstance();
   ScanRequest::default_instance_->InitAsDefaultInstance();
   ScanResponse::default_instance_->InitAsDefaultInstance();
+  AggregateRequest::default_instance_->InitAsDefaultInstance();
+  AggregateResponse::default_instance_->InitAsDefaultInstance();
+  WatchRequest::default_instance_->InitAsDefaultInstance();
+  WatchEvent::default_instance_->InitAsDefaultInstance();
+  WatchResponse::default_instance_->InitAsDefaultInstance();
   EndTransactionRequest::default_instance_->InitAsDefaultInstance();
   EndTransactionResponse::default_instance_->InitAsDefaultInstance();
   RequestUnion::default_instance_->InitAsDefaultInstance();
@@ -952,6 +1604,16 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   AdminSplitResponse::default_instance_->InitAsDefaultInstance();
   AdminMergeRequest::default_instance_->InitAsDefaultInstance();
   AdminMergeResponse::default_instance_->InitAsDefaultInstance();
+  AdminBulkLoadRequest::default_instance_->InitAsDefaultInstance();
+  AdminBulkLoadResponse::default_instance_->InitAsDefaultInstance();
+  AdminTransferLeaseRequest::default_instance_->InitAsDefaultInstance();
+  AdminTransferLeaseResponse::default_instance_->InitAsDefaultInstance();
+  BackupFile::default_instance_->InitAsDefaultInstance();
+  BackupManifest::default_instance_->InitAsDefaultInstance();
+  AdminBackupRequest::default_instance_->InitAsDefaultInstance();
+  AdminBackupResponse::default_instance_->InitAsDefaultInstance();
+  AdminRestoreRequest::default_instance_->InitAsDefaultInstance();
+  AdminRestoreResponse::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto);
 }
 
@@ -976,6 +1638,36 @@ bool ReadConsistencyType_IsValid(int value) {
   }
 }
 
+const ::google::protobuf::EnumDescriptor* RequestPriority_descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RequestPriority_descriptor_;
+}
+bool RequestPriority_IsValid(int value) {
+  switch(value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+    case 4:
+      return true;
+    default:
+      return false;
+  }
+}
+
+const ::google::protobuf::EnumDescriptor* BackupFormat_descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return BackupFormat_descriptor_;
+}
+bool BackupFormat_IsValid(int value) {
+  switch(value) {
+    case 0:
+      return true;
+    default:
+      return false;
+  }
+}
+
 
 // ===================================================================
 
@@ -1264,6 +1956,9 @@ const int RequestHeader::kRaftIdFieldNumber;
 const int RequestHeader::kUserPriorityFieldNumber;
 const int RequestHeader::kTxnFieldNumber;
 const int RequestHeader::kReadConsistencyFieldNumber;
+const int RequestHeader::kTimeoutFieldNumber;
+const int RequestHeader::kTraceFieldNumber;
+const int RequestHeader::kPriorityFieldNumber;
 #endif  // !_MSC_VER
 
 RequestHeader::RequestHeader()
@@ -1277,6 +1972,7 @@ void RequestHeader::InitAsDefaultInstance() {
   cmd_id_ = const_cast< ::cockroach::proto::ClientCmdID*>(&::cockroach::proto::ClientCmdID::default_instance());
   replica_ = const_cast< ::cockroach::proto::Replica*>(&::cockroach::proto::Replica::default_instance());
   txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+  trace_ = const_cast< ::cockroach::proto::TraceContext*>(&::cockroach::proto::TraceContext::default_instance());
 }
 
 RequestHeader::RequestHeader(const RequestHeader& from)
@@ -1299,6 +1995,9 @@ void RequestHeader::SharedCtor() {
   user_priority_ = 1;
   txn_ = NULL;
   read_consistency_ = 0;
+  timeout_ = GOOGLE_LONGLONG(0);
+  trace_ = NULL;
+  priority_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1322,6 +2021,7 @@ void RequestHeader::SharedDtor() {
     delete cmd_id_;
     delete replica_;
     delete txn_;
+    delete trace_;
   }
 }
 
@@ -1347,6 +2047,16 @@ RequestHeader* RequestHeader::New() const {
 }
 
 void RequestHeader::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<RequestHeader*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
   if (_has_bits_[0 / 32] & 255) {
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
@@ -1375,12 +2085,20 @@ void RequestHeader::Clear() {
     raft_id_ = GOOGLE_LONGLONG(0);
     user_priority_ = 1;
   }
-  if (_has_bits_[8 / 32] & 768) {
+  if (_has_bits_[8 / 32] & 7936) {
+    ZR_(read_consistency_, timeout_);
     if (has_txn()) {
       if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
     }
-    read_consistency_ = 0;
+    if (has_trace()) {
+      if (trace_ != NULL) trace_->::cockroach::proto::TraceContext::Clear();
+    }
+    priority_ = 0;
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -1535,6 +2253,54 @@ bool RequestHeader::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(88)) goto parse_timeout;
+        break;
+      }
+
+      // optional int64 timeout = 11;
+      case 11: {
+        if (tag == 88) {
+         parse_timeout:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &timeout_)));
+          set_has_timeout();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_trace;
+        break;
+      }
+
+      // optional .cockroach.proto.TraceContext trace = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_trace:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_trace()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(104)) goto parse_priority;
+        break;
+      }
+
+      // optional .cockroach.proto.RequestPriority priority = 13;
+      case 13: {
+        if (tag == 104) {
+         parse_priority:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::RequestPriority_IsValid(value)) {
+            set_priority(static_cast< ::cockroach::proto::RequestPriority >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(13, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1626,6 +2392,23 @@ void RequestHeader::SerializeWithCachedSizes(
       10, this->read_consistency(), output);
   }
 
+  // optional int64 timeout = 11;
+  if (has_timeout()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(11, this->timeout(), output);
+  }
+
+  // optional .cockroach.proto.TraceContext trace = 12;
+  if (has_trace()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, this->trace(), output);
+  }
+
+  // optional .cockroach.proto.RequestPriority priority = 13;
+  if (has_priority()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      13, this->priority(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1705,6 +2488,24 @@ void RequestHeader::SerializeWithCachedSizes(
       10, this->read_consistency(), target);
   }
 
+  // optional int64 timeout = 11;
+  if (has_timeout()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(11, this->timeout(), target);
+  }
+
+  // optional .cockroach.proto.TraceContext trace = 12;
+  if (has_trace()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, this->trace(), target);
+  }
+
+  // optional .cockroach.proto.RequestPriority priority = 13;
+  if (has_priority()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      13, this->priority(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1788,6 +2589,26 @@ int RequestHeader::ByteSize() const {
         ::google::protobuf::internal::WireFormatLite::EnumSize(this->read_consistency());
     }
 
+    // optional int64 timeout = 11;
+    if (has_timeout()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->timeout());
+    }
+
+    // optional .cockroach.proto.TraceContext trace = 12;
+    if (has_trace()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->trace());
+    }
+
+    // optional .cockroach.proto.RequestPriority priority = 13;
+    if (has_priority()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->priority());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1847,6 +2668,15 @@ void RequestHeader::MergeFrom(const RequestHeader& from) {
     if (from.has_read_consistency()) {
       set_read_consistency(from.read_consistency());
     }
+    if (from.has_timeout()) {
+      set_timeout(from.timeout());
+    }
+    if (from.has_trace()) {
+      mutable_trace()->::cockroach::proto::TraceContext::MergeFrom(from.trace());
+    }
+    if (from.has_priority()) {
+      set_priority(from.priority());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1880,6 +2710,9 @@ void RequestHeader::Swap(RequestHeader* other) {
     std::swap(user_priority_, other->user_priority_);
     std::swap(txn_, other->txn_);
     std::swap(read_consistency_, other->read_consistency_);
+    std::swap(timeout_, other->timeout_);
+    std::swap(trace_, other->trace_);
+    std::swap(priority_, other->priority_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -1898,34 +2731,308 @@ void RequestHeader::Swap(RequestHeader* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ResponseHeader::kErrorFieldNumber;
-const int ResponseHeader::kTimestampFieldNumber;
-const int ResponseHeader::kTxnFieldNumber;
+const int TraceContext::kTraceIdFieldNumber;
+const int TraceContext::kSpanIdFieldNumber;
 #endif  // !_MSC_VER
 
-ResponseHeader::ResponseHeader()
+TraceContext::TraceContext()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ResponseHeader)
+  // @@protoc_insertion_point(constructor:cockroach.proto.TraceContext)
 }
 
-void ResponseHeader::InitAsDefaultInstance() {
-  error_ = const_cast< ::cockroach::proto::Error*>(&::cockroach::proto::Error::default_instance());
-  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+void TraceContext::InitAsDefaultInstance() {
 }
 
-ResponseHeader::ResponseHeader(const ResponseHeader& from)
+TraceContext::TraceContext(const TraceContext& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ResponseHeader)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.TraceContext)
 }
 
-void ResponseHeader::SharedCtor() {
+void TraceContext::SharedCtor() {
   _cached_size_ = 0;
-  error_ = NULL;
-  timestamp_ = NULL;
+  trace_id_ = GOOGLE_LONGLONG(0);
+  span_id_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+TraceContext::~TraceContext() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.TraceContext)
+  SharedDtor();
+}
+
+void TraceContext::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void TraceContext::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* TraceContext::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return TraceContext_descriptor_;
+}
+
+const TraceContext& TraceContext::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+TraceContext* TraceContext::default_instance_ = NULL;
+
+TraceContext* TraceContext::New() const {
+  return new TraceContext;
+}
+
+void TraceContext::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<TraceContext*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  ZR_(trace_id_, span_id_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool TraceContext::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.TraceContext)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 trace_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &trace_id_)));
+          set_has_trace_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_span_id;
+        break;
+      }
+
+      // optional int64 span_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_span_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &span_id_)));
+          set_has_span_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.TraceContext)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.TraceContext)
+  return false;
+#undef DO_
+}
+
+void TraceContext::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.TraceContext)
+  // optional int64 trace_id = 1;
+  if (has_trace_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->trace_id(), output);
+  }
+
+  // optional int64 span_id = 2;
+  if (has_span_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->span_id(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.TraceContext)
+}
+
+::google::protobuf::uint8* TraceContext::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.TraceContext)
+  // optional int64 trace_id = 1;
+  if (has_trace_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->trace_id(), target);
+  }
+
+  // optional int64 span_id = 2;
+  if (has_span_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->span_id(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.TraceContext)
+  return target;
+}
+
+int TraceContext::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 trace_id = 1;
+    if (has_trace_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->trace_id());
+    }
+
+    // optional int64 span_id = 2;
+    if (has_span_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->span_id());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void TraceContext::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const TraceContext* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const TraceContext*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void TraceContext::MergeFrom(const TraceContext& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_trace_id()) {
+      set_trace_id(from.trace_id());
+    }
+    if (from.has_span_id()) {
+      set_span_id(from.span_id());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void TraceContext::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void TraceContext::CopyFrom(const TraceContext& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool TraceContext::IsInitialized() const {
+
+  return true;
+}
+
+void TraceContext::Swap(TraceContext* other) {
+  if (other != this) {
+    std::swap(trace_id_, other->trace_id_);
+    std::swap(span_id_, other->span_id_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata TraceContext::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = TraceContext_descriptor_;
+  metadata.reflection = TraceContext_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ResponseHeader::kErrorFieldNumber;
+const int ResponseHeader::kTimestampFieldNumber;
+const int ResponseHeader::kTxnFieldNumber;
+#endif  // !_MSC_VER
+
+ResponseHeader::ResponseHeader()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ResponseHeader)
+}
+
+void ResponseHeader::InitAsDefaultInstance() {
+  error_ = const_cast< ::cockroach::proto::Error*>(&::cockroach::proto::Error::default_instance());
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+}
+
+ResponseHeader::ResponseHeader(const ResponseHeader& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ResponseHeader)
+}
+
+void ResponseHeader::SharedCtor() {
+  _cached_size_ = 0;
+  error_ = NULL;
+  timestamp_ = NULL;
   txn_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
@@ -3711,6 +4818,7 @@ void PutResponse::Swap(PutResponse* other) {
 const int ConditionalPutRequest::kHeaderFieldNumber;
 const int ConditionalPutRequest::kValueFieldNumber;
 const int ConditionalPutRequest::kExpValueFieldNumber;
+const int ConditionalPutRequest::kAllowIfDoesNotExistFieldNumber;
 #endif  // !_MSC_VER
 
 ConditionalPutRequest::ConditionalPutRequest()
@@ -3737,6 +4845,7 @@ void ConditionalPutRequest::SharedCtor() {
   header_ = NULL;
   value_ = NULL;
   exp_value_ = NULL;
+  allow_if_does_not_exist_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -3775,7 +4884,7 @@ ConditionalPutRequest* ConditionalPutRequest::New() const {
 }
 
 void ConditionalPutRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7) {
+  if (_has_bits_[0 / 32] & 15) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
@@ -3785,6 +4894,7 @@ void ConditionalPutRequest::Clear() {
     if (has_exp_value()) {
       if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
     }
+    allow_if_does_not_exist_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -3834,6 +4944,21 @@ bool ConditionalPutRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(32)) goto parse_allow_if_does_not_exist;
+        break;
+      }
+
+      // optional bool allow_if_does_not_exist = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_allow_if_does_not_exist:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &allow_if_does_not_exist_)));
+          set_has_allow_if_does_not_exist();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3881,6 +5006,11 @@ void ConditionalPutRequest::SerializeWithCachedSizes(
       3, this->exp_value(), output);
   }
 
+  // optional bool allow_if_does_not_exist = 4;
+  if (has_allow_if_does_not_exist()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->allow_if_does_not_exist(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -3912,6 +5042,11 @@ void ConditionalPutRequest::SerializeWithCachedSizes(
         3, this->exp_value(), target);
   }
 
+  // optional bool allow_if_does_not_exist = 4;
+  if (has_allow_if_does_not_exist()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->allow_if_does_not_exist(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -3945,6 +5080,11 @@ int ConditionalPutRequest::ByteSize() const {
           this->exp_value());
     }
 
+    // optional bool allow_if_does_not_exist = 4;
+    if (has_allow_if_does_not_exist()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -3981,6 +5121,9 @@ void ConditionalPutRequest::MergeFrom(const ConditionalPutRequest& from) {
     if (from.has_exp_value()) {
       mutable_exp_value()->::cockroach::proto::Value::MergeFrom(from.exp_value());
     }
+    if (from.has_allow_if_does_not_exist()) {
+      set_allow_if_does_not_exist(from.allow_if_does_not_exist());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -4007,6 +5150,7 @@ void ConditionalPutRequest::Swap(ConditionalPutRequest* other) {
     std::swap(header_, other->header_);
     std::swap(value_, other->value_);
     std::swap(exp_value_, other->exp_value_);
+    std::swap(allow_if_does_not_exist_, other->allow_if_does_not_exist_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -4252,82 +5396,86 @@ void ConditionalPutResponse::Swap(ConditionalPutResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int IncrementRequest::kHeaderFieldNumber;
-const int IncrementRequest::kIncrementFieldNumber;
+const int InitPutRequest::kHeaderFieldNumber;
+const int InitPutRequest::kValueFieldNumber;
 #endif  // !_MSC_VER
 
-IncrementRequest::IncrementRequest()
+InitPutRequest::InitPutRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.InitPutRequest)
 }
 
-void IncrementRequest::InitAsDefaultInstance() {
+void InitPutRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
 }
 
-IncrementRequest::IncrementRequest(const IncrementRequest& from)
+InitPutRequest::InitPutRequest(const InitPutRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InitPutRequest)
 }
 
-void IncrementRequest::SharedCtor() {
+void InitPutRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  increment_ = GOOGLE_LONGLONG(0);
+  value_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-IncrementRequest::~IncrementRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.IncrementRequest)
+InitPutRequest::~InitPutRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InitPutRequest)
   SharedDtor();
 }
 
-void IncrementRequest::SharedDtor() {
+void InitPutRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete value_;
   }
 }
 
-void IncrementRequest::SetCachedSize(int size) const {
+void InitPutRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* IncrementRequest::descriptor() {
+const ::google::protobuf::Descriptor* InitPutRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return IncrementRequest_descriptor_;
+  return InitPutRequest_descriptor_;
 }
 
-const IncrementRequest& IncrementRequest::default_instance() {
+const InitPutRequest& InitPutRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-IncrementRequest* IncrementRequest::default_instance_ = NULL;
+InitPutRequest* InitPutRequest::default_instance_ = NULL;
 
-IncrementRequest* IncrementRequest::New() const {
-  return new IncrementRequest;
+InitPutRequest* InitPutRequest::New() const {
+  return new InitPutRequest;
 }
 
-void IncrementRequest::Clear() {
+void InitPutRequest::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    increment_ = GOOGLE_LONGLONG(0);
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool IncrementRequest::MergePartialFromCodedStream(
+bool InitPutRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InitPutRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -4341,18 +5489,16 @@ bool IncrementRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_increment;
+        if (input->ExpectTag(18)) goto parse_value;
         break;
       }
 
-      // optional int64 increment = 2;
+      // optional .cockroach.proto.Value value = 2;
       case 2: {
-        if (tag == 16) {
-         parse_increment:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &increment_)));
-          set_has_increment();
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
         } else {
           goto handle_unusual;
         }
@@ -4374,38 +5520,39 @@ bool IncrementRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InitPutRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InitPutRequest)
   return false;
 #undef DO_
 }
 
-void IncrementRequest::SerializeWithCachedSizes(
+void InitPutRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InitPutRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 increment = 2;
-  if (has_increment()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->increment(), output);
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->value(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InitPutRequest)
 }
 
-::google::protobuf::uint8* IncrementRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* InitPutRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InitPutRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -4413,20 +5560,22 @@ void IncrementRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 increment = 2;
-  if (has_increment()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->increment(), target);
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->value(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.IncrementRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InitPutRequest)
   return target;
 }
 
-int IncrementRequest::ByteSize() const {
+int InitPutRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -4437,11 +5586,11 @@ int IncrementRequest::ByteSize() const {
           this->header());
     }
 
-    // optional int64 increment = 2;
-    if (has_increment()) {
+    // optional .cockroach.proto.Value value = 2;
+    if (has_value()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->increment());
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
     }
 
   }
@@ -4456,10 +5605,10 @@ int IncrementRequest::ByteSize() const {
   return total_size;
 }
 
-void IncrementRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void InitPutRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const IncrementRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const IncrementRequest*>(
+  const InitPutRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InitPutRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -4468,51 +5617,51 @@ void IncrementRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void IncrementRequest::MergeFrom(const IncrementRequest& from) {
+void InitPutRequest::MergeFrom(const InitPutRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_increment()) {
-      set_increment(from.increment());
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void IncrementRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void InitPutRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void IncrementRequest::CopyFrom(const IncrementRequest& from) {
+void InitPutRequest::CopyFrom(const InitPutRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool IncrementRequest::IsInitialized() const {
+bool InitPutRequest::IsInitialized() const {
 
   return true;
 }
 
-void IncrementRequest::Swap(IncrementRequest* other) {
+void InitPutRequest::Swap(InitPutRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(increment_, other->increment_);
+    std::swap(value_, other->value_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata IncrementRequest::GetMetadata() const {
+::google::protobuf::Metadata InitPutRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = IncrementRequest_descriptor_;
-  metadata.reflection = IncrementRequest_reflection_;
+  metadata.descriptor = InitPutRequest_descriptor_;
+  metadata.reflection = InitPutRequest_reflection_;
   return metadata;
 }
 
@@ -4520,82 +5669,77 @@ void IncrementRequest::Swap(IncrementRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int IncrementResponse::kHeaderFieldNumber;
-const int IncrementResponse::kNewValueFieldNumber;
+const int InitPutResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-IncrementResponse::IncrementResponse()
+InitPutResponse::InitPutResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.InitPutResponse)
 }
 
-void IncrementResponse::InitAsDefaultInstance() {
+void InitPutResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-IncrementResponse::IncrementResponse(const IncrementResponse& from)
+InitPutResponse::InitPutResponse(const InitPutResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InitPutResponse)
 }
 
-void IncrementResponse::SharedCtor() {
+void InitPutResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  new_value_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-IncrementResponse::~IncrementResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.IncrementResponse)
+InitPutResponse::~InitPutResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InitPutResponse)
   SharedDtor();
 }
 
-void IncrementResponse::SharedDtor() {
+void InitPutResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void IncrementResponse::SetCachedSize(int size) const {
+void InitPutResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* IncrementResponse::descriptor() {
+const ::google::protobuf::Descriptor* InitPutResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return IncrementResponse_descriptor_;
+  return InitPutResponse_descriptor_;
 }
 
-const IncrementResponse& IncrementResponse::default_instance() {
+const InitPutResponse& InitPutResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-IncrementResponse* IncrementResponse::default_instance_ = NULL;
+InitPutResponse* InitPutResponse::default_instance_ = NULL;
 
-IncrementResponse* IncrementResponse::New() const {
-  return new IncrementResponse;
+InitPutResponse* InitPutResponse::New() const {
+  return new InitPutResponse;
 }
 
-void IncrementResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-    }
-    new_value_ = GOOGLE_LONGLONG(0);
+void InitPutResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool IncrementResponse::MergePartialFromCodedStream(
+bool InitPutResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InitPutResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -4609,21 +5753,6 @@ bool IncrementResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_new_value;
-        break;
-      }
-
-      // optional int64 new_value = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_new_value:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &new_value_)));
-          set_has_new_value();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4642,38 +5771,33 @@ bool IncrementResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InitPutResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InitPutResponse)
   return false;
 #undef DO_
 }
 
-void IncrementResponse::SerializeWithCachedSizes(
+void InitPutResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InitPutResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 new_value = 2;
-  if (has_new_value()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->new_value(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InitPutResponse)
 }
 
-::google::protobuf::uint8* IncrementResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* InitPutResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InitPutResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -4681,20 +5805,15 @@ void IncrementResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 new_value = 2;
-  if (has_new_value()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->new_value(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.IncrementResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InitPutResponse)
   return target;
 }
 
-int IncrementResponse::ByteSize() const {
+int InitPutResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -4705,13 +5824,6 @@ int IncrementResponse::ByteSize() const {
           this->header());
     }
 
-    // optional int64 new_value = 2;
-    if (has_new_value()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->new_value());
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -4724,10 +5836,10 @@ int IncrementResponse::ByteSize() const {
   return total_size;
 }
 
-void IncrementResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void InitPutResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const IncrementResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const IncrementResponse*>(
+  const InitPutResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InitPutResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -4736,51 +5848,47 @@ void IncrementResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void IncrementResponse::MergeFrom(const IncrementResponse& from) {
+void InitPutResponse::MergeFrom(const InitPutResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
-    if (from.has_new_value()) {
-      set_new_value(from.new_value());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void IncrementResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void InitPutResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void IncrementResponse::CopyFrom(const IncrementResponse& from) {
+void InitPutResponse::CopyFrom(const InitPutResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool IncrementResponse::IsInitialized() const {
+bool InitPutResponse::IsInitialized() const {
 
   return true;
 }
 
-void IncrementResponse::Swap(IncrementResponse* other) {
+void InitPutResponse::Swap(InitPutResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(new_value_, other->new_value_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata IncrementResponse::GetMetadata() const {
+::google::protobuf::Metadata InitPutResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = IncrementResponse_descriptor_;
-  metadata.reflection = IncrementResponse_reflection_;
+  metadata.descriptor = InitPutResponse_descriptor_;
+  metadata.reflection = InitPutResponse_reflection_;
   return metadata;
 }
 
@@ -4788,77 +5896,98 @@ void IncrementResponse::Swap(IncrementResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int DeleteRequest::kHeaderFieldNumber;
+const int IncrementRequest::kHeaderFieldNumber;
+const int IncrementRequest::kIncrementFieldNumber;
+const int IncrementRequest::kReturnPreviousFieldNumber;
 #endif  // !_MSC_VER
 
-DeleteRequest::DeleteRequest()
+IncrementRequest::IncrementRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.IncrementRequest)
 }
 
-void DeleteRequest::InitAsDefaultInstance() {
+void IncrementRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-DeleteRequest::DeleteRequest(const DeleteRequest& from)
+IncrementRequest::IncrementRequest(const IncrementRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.IncrementRequest)
 }
 
-void DeleteRequest::SharedCtor() {
+void IncrementRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  increment_ = GOOGLE_LONGLONG(0);
+  return_previous_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-DeleteRequest::~DeleteRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRequest)
+IncrementRequest::~IncrementRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.IncrementRequest)
   SharedDtor();
 }
 
-void DeleteRequest::SharedDtor() {
+void IncrementRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void DeleteRequest::SetCachedSize(int size) const {
+void IncrementRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* DeleteRequest::descriptor() {
+const ::google::protobuf::Descriptor* IncrementRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return DeleteRequest_descriptor_;
+  return IncrementRequest_descriptor_;
 }
 
-const DeleteRequest& DeleteRequest::default_instance() {
+const IncrementRequest& IncrementRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-DeleteRequest* DeleteRequest::default_instance_ = NULL;
+IncrementRequest* IncrementRequest::default_instance_ = NULL;
 
-DeleteRequest* DeleteRequest::New() const {
-  return new DeleteRequest;
+IncrementRequest* IncrementRequest::New() const {
+  return new IncrementRequest;
 }
 
-void DeleteRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+void IncrementRequest::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<IncrementRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 7) {
+    ZR_(increment_, return_previous_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool DeleteRequest::MergePartialFromCodedStream(
+bool IncrementRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.IncrementRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -4872,6 +6001,36 @@ bool DeleteRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_increment;
+        break;
+      }
+
+      // optional int64 increment = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_increment:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &increment_)));
+          set_has_increment();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_return_previous;
+        break;
+      }
+
+      // optional bool return_previous = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_return_previous:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &return_previous_)));
+          set_has_return_previous();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4890,33 +6049,43 @@ bool DeleteRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.IncrementRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.IncrementRequest)
   return false;
 #undef DO_
 }
 
-void DeleteRequest::SerializeWithCachedSizes(
+void IncrementRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.IncrementRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional int64 increment = 2;
+  if (has_increment()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->increment(), output);
+  }
+
+  // optional bool return_previous = 3;
+  if (has_return_previous()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->return_previous(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.IncrementRequest)
 }
 
-::google::protobuf::uint8* DeleteRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* IncrementRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.IncrementRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -4924,15 +6093,25 @@ void DeleteRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  if (!unknown_fields().empty()) {
-    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+  // optional int64 increment = 2;
+  if (has_increment()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->increment(), target);
+  }
+
+  // optional bool return_previous = 3;
+  if (has_return_previous()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->return_previous(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.IncrementRequest)
   return target;
 }
 
-int DeleteRequest::ByteSize() const {
+int IncrementRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -4943,6 +6122,18 @@ int DeleteRequest::ByteSize() const {
           this->header());
     }
 
+    // optional int64 increment = 2;
+    if (has_increment()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->increment());
+    }
+
+    // optional bool return_previous = 3;
+    if (has_return_previous()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -4955,10 +6146,10 @@ int DeleteRequest::ByteSize() const {
   return total_size;
 }
 
-void DeleteRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void IncrementRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const DeleteRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRequest*>(
+  const IncrementRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const IncrementRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -4967,47 +6158,55 @@ void DeleteRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void DeleteRequest::MergeFrom(const DeleteRequest& from) {
+void IncrementRequest::MergeFrom(const IncrementRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
+    if (from.has_increment()) {
+      set_increment(from.increment());
+    }
+    if (from.has_return_previous()) {
+      set_return_previous(from.return_previous());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void DeleteRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void IncrementRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void DeleteRequest::CopyFrom(const DeleteRequest& from) {
+void IncrementRequest::CopyFrom(const IncrementRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool DeleteRequest::IsInitialized() const {
+bool IncrementRequest::IsInitialized() const {
 
   return true;
 }
 
-void DeleteRequest::Swap(DeleteRequest* other) {
+void IncrementRequest::Swap(IncrementRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(increment_, other->increment_);
+    std::swap(return_previous_, other->return_previous_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata DeleteRequest::GetMetadata() const {
+::google::protobuf::Metadata IncrementRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = DeleteRequest_descriptor_;
-  metadata.reflection = DeleteRequest_reflection_;
+  metadata.descriptor = IncrementRequest_descriptor_;
+  metadata.reflection = IncrementRequest_reflection_;
   return metadata;
 }
 
@@ -5015,77 +6214,98 @@ void DeleteRequest::Swap(DeleteRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int DeleteResponse::kHeaderFieldNumber;
+const int IncrementResponse::kHeaderFieldNumber;
+const int IncrementResponse::kNewValueFieldNumber;
+const int IncrementResponse::kPreviousValueFieldNumber;
 #endif  // !_MSC_VER
 
-DeleteResponse::DeleteResponse()
+IncrementResponse::IncrementResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.IncrementResponse)
 }
 
-void DeleteResponse::InitAsDefaultInstance() {
+void IncrementResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-DeleteResponse::DeleteResponse(const DeleteResponse& from)
+IncrementResponse::IncrementResponse(const IncrementResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.IncrementResponse)
 }
 
-void DeleteResponse::SharedCtor() {
+void IncrementResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  new_value_ = GOOGLE_LONGLONG(0);
+  previous_value_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-DeleteResponse::~DeleteResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteResponse)
+IncrementResponse::~IncrementResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.IncrementResponse)
   SharedDtor();
 }
 
-void DeleteResponse::SharedDtor() {
+void IncrementResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void DeleteResponse::SetCachedSize(int size) const {
+void IncrementResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* DeleteResponse::descriptor() {
+const ::google::protobuf::Descriptor* IncrementResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return DeleteResponse_descriptor_;
+  return IncrementResponse_descriptor_;
 }
 
-const DeleteResponse& DeleteResponse::default_instance() {
+const IncrementResponse& IncrementResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-DeleteResponse* DeleteResponse::default_instance_ = NULL;
+IncrementResponse* IncrementResponse::default_instance_ = NULL;
 
-DeleteResponse* DeleteResponse::New() const {
-  return new DeleteResponse;
+IncrementResponse* IncrementResponse::New() const {
+  return new IncrementResponse;
 }
 
-void DeleteResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+void IncrementResponse::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<IncrementResponse*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 7) {
+    ZR_(new_value_, previous_value_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool DeleteResponse::MergePartialFromCodedStream(
+bool IncrementResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.IncrementResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -5099,6 +6319,36 @@ bool DeleteResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_new_value;
+        break;
+      }
+
+      // optional int64 new_value = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_new_value:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &new_value_)));
+          set_has_new_value();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_previous_value;
+        break;
+      }
+
+      // optional int64 previous_value = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_previous_value:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &previous_value_)));
+          set_has_previous_value();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5117,33 +6367,43 @@ bool DeleteResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.IncrementResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.IncrementResponse)
   return false;
 #undef DO_
 }
 
-void DeleteResponse::SerializeWithCachedSizes(
+void IncrementResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.IncrementResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional int64 new_value = 2;
+  if (has_new_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->new_value(), output);
+  }
+
+  // optional int64 previous_value = 3;
+  if (has_previous_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->previous_value(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.IncrementResponse)
 }
 
-::google::protobuf::uint8* DeleteResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* IncrementResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.IncrementResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -5151,15 +6411,25 @@ void DeleteResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // optional int64 new_value = 2;
+  if (has_new_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->new_value(), target);
+  }
+
+  // optional int64 previous_value = 3;
+  if (has_previous_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->previous_value(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.IncrementResponse)
   return target;
 }
 
-int DeleteResponse::ByteSize() const {
+int IncrementResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -5170,6 +6440,20 @@ int DeleteResponse::ByteSize() const {
           this->header());
     }
 
+    // optional int64 new_value = 2;
+    if (has_new_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->new_value());
+    }
+
+    // optional int64 previous_value = 3;
+    if (has_previous_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->previous_value());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -5182,10 +6466,10 @@ int DeleteResponse::ByteSize() const {
   return total_size;
 }
 
-void DeleteResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void IncrementResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const DeleteResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteResponse*>(
+  const IncrementResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const IncrementResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5194,47 +6478,55 @@ void DeleteResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void DeleteResponse::MergeFrom(const DeleteResponse& from) {
+void IncrementResponse::MergeFrom(const IncrementResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_new_value()) {
+      set_new_value(from.new_value());
+    }
+    if (from.has_previous_value()) {
+      set_previous_value(from.previous_value());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void DeleteResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void IncrementResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void DeleteResponse::CopyFrom(const DeleteResponse& from) {
+void IncrementResponse::CopyFrom(const IncrementResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool DeleteResponse::IsInitialized() const {
+bool IncrementResponse::IsInitialized() const {
 
   return true;
 }
 
-void DeleteResponse::Swap(DeleteResponse* other) {
+void IncrementResponse::Swap(IncrementResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(new_value_, other->new_value_);
+    std::swap(previous_value_, other->previous_value_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata DeleteResponse::GetMetadata() const {
+::google::protobuf::Metadata IncrementResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = DeleteResponse_descriptor_;
-  metadata.reflection = DeleteResponse_reflection_;
+  metadata.descriptor = IncrementResponse_descriptor_;
+  metadata.reflection = IncrementResponse_reflection_;
   return metadata;
 }
 
@@ -5242,82 +6534,77 @@ void DeleteResponse::Swap(DeleteResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int DeleteRangeRequest::kHeaderFieldNumber;
-const int DeleteRangeRequest::kMaxEntriesToDeleteFieldNumber;
+const int DeleteRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-DeleteRangeRequest::DeleteRangeRequest()
+DeleteRequest::DeleteRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRequest)
 }
 
-void DeleteRangeRequest::InitAsDefaultInstance() {
+void DeleteRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-DeleteRangeRequest::DeleteRangeRequest(const DeleteRangeRequest& from)
+DeleteRequest::DeleteRequest(const DeleteRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRequest)
 }
 
-void DeleteRangeRequest::SharedCtor() {
+void DeleteRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-DeleteRangeRequest::~DeleteRangeRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRangeRequest)
+DeleteRequest::~DeleteRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRequest)
   SharedDtor();
 }
 
-void DeleteRangeRequest::SharedDtor() {
+void DeleteRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void DeleteRangeRequest::SetCachedSize(int size) const {
+void DeleteRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* DeleteRangeRequest::descriptor() {
+const ::google::protobuf::Descriptor* DeleteRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return DeleteRangeRequest_descriptor_;
+  return DeleteRequest_descriptor_;
 }
 
-const DeleteRangeRequest& DeleteRangeRequest::default_instance() {
+const DeleteRequest& DeleteRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-DeleteRangeRequest* DeleteRangeRequest::default_instance_ = NULL;
+DeleteRequest* DeleteRequest::default_instance_ = NULL;
 
-DeleteRangeRequest* DeleteRangeRequest::New() const {
-  return new DeleteRangeRequest;
+DeleteRequest* DeleteRequest::New() const {
+  return new DeleteRequest;
 }
 
-void DeleteRangeRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-    }
-    max_entries_to_delete_ = GOOGLE_LONGLONG(0);
+void DeleteRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool DeleteRangeRequest::MergePartialFromCodedStream(
+bool DeleteRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -5331,22 +6618,7 @@ bool DeleteRangeRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_entries_to_delete;
-        break;
-      }
-
-      // optional int64 max_entries_to_delete = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_max_entries_to_delete:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &max_entries_to_delete_)));
-          set_has_max_entries_to_delete();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectAtEnd()) goto success;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
@@ -5364,38 +6636,33 @@ bool DeleteRangeRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRequest)
   return false;
 #undef DO_
 }
 
-void DeleteRangeRequest::SerializeWithCachedSizes(
+void DeleteRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 max_entries_to_delete = 2;
-  if (has_max_entries_to_delete()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_entries_to_delete(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRequest)
 }
 
-::google::protobuf::uint8* DeleteRangeRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* DeleteRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -5403,20 +6670,15 @@ void DeleteRangeRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 max_entries_to_delete = 2;
-  if (has_max_entries_to_delete()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_entries_to_delete(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRequest)
   return target;
 }
 
-int DeleteRangeRequest::ByteSize() const {
+int DeleteRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -5427,13 +6689,6 @@ int DeleteRangeRequest::ByteSize() const {
           this->header());
     }
 
-    // optional int64 max_entries_to_delete = 2;
-    if (has_max_entries_to_delete()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->max_entries_to_delete());
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -5446,10 +6701,10 @@ int DeleteRangeRequest::ByteSize() const {
   return total_size;
 }
 
-void DeleteRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void DeleteRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const DeleteRangeRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeRequest*>(
+  const DeleteRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5458,51 +6713,47 @@ void DeleteRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void DeleteRangeRequest::MergeFrom(const DeleteRangeRequest& from) {
+void DeleteRequest::MergeFrom(const DeleteRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_max_entries_to_delete()) {
-      set_max_entries_to_delete(from.max_entries_to_delete());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void DeleteRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void DeleteRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void DeleteRangeRequest::CopyFrom(const DeleteRangeRequest& from) {
+void DeleteRequest::CopyFrom(const DeleteRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool DeleteRangeRequest::IsInitialized() const {
+bool DeleteRequest::IsInitialized() const {
 
   return true;
 }
 
-void DeleteRangeRequest::Swap(DeleteRangeRequest* other) {
+void DeleteRequest::Swap(DeleteRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(max_entries_to_delete_, other->max_entries_to_delete_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata DeleteRangeRequest::GetMetadata() const {
+::google::protobuf::Metadata DeleteRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = DeleteRangeRequest_descriptor_;
-  metadata.reflection = DeleteRangeRequest_reflection_;
+  metadata.descriptor = DeleteRequest_descriptor_;
+  metadata.reflection = DeleteRequest_reflection_;
   return metadata;
 }
 
@@ -5510,82 +6761,77 @@ void DeleteRangeRequest::Swap(DeleteRangeRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int DeleteRangeResponse::kHeaderFieldNumber;
-const int DeleteRangeResponse::kNumDeletedFieldNumber;
+const int DeleteResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-DeleteRangeResponse::DeleteRangeResponse()
+DeleteResponse::DeleteResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteResponse)
 }
 
-void DeleteRangeResponse::InitAsDefaultInstance() {
+void DeleteResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-DeleteRangeResponse::DeleteRangeResponse(const DeleteRangeResponse& from)
+DeleteResponse::DeleteResponse(const DeleteResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteResponse)
 }
 
-void DeleteRangeResponse::SharedCtor() {
+void DeleteResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  num_deleted_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-DeleteRangeResponse::~DeleteRangeResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRangeResponse)
+DeleteResponse::~DeleteResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteResponse)
   SharedDtor();
 }
 
-void DeleteRangeResponse::SharedDtor() {
+void DeleteResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void DeleteRangeResponse::SetCachedSize(int size) const {
+void DeleteResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* DeleteRangeResponse::descriptor() {
+const ::google::protobuf::Descriptor* DeleteResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return DeleteRangeResponse_descriptor_;
+  return DeleteResponse_descriptor_;
 }
 
-const DeleteRangeResponse& DeleteRangeResponse::default_instance() {
+const DeleteResponse& DeleteResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-DeleteRangeResponse* DeleteRangeResponse::default_instance_ = NULL;
+DeleteResponse* DeleteResponse::default_instance_ = NULL;
 
-DeleteRangeResponse* DeleteRangeResponse::New() const {
-  return new DeleteRangeResponse;
+DeleteResponse* DeleteResponse::New() const {
+  return new DeleteResponse;
 }
 
-void DeleteRangeResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-    }
-    num_deleted_ = GOOGLE_LONGLONG(0);
+void DeleteResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool DeleteRangeResponse::MergePartialFromCodedStream(
+bool DeleteResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -5599,21 +6845,6 @@ bool DeleteRangeResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_num_deleted;
-        break;
-      }
-
-      // optional int64 num_deleted = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_num_deleted:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &num_deleted_)));
-          set_has_num_deleted();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5632,38 +6863,33 @@ bool DeleteRangeResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteResponse)
   return false;
 #undef DO_
 }
 
-void DeleteRangeResponse::SerializeWithCachedSizes(
+void DeleteResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 num_deleted = 2;
-  if (has_num_deleted()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->num_deleted(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteResponse)
 }
 
-::google::protobuf::uint8* DeleteRangeResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* DeleteResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -5671,20 +6897,15 @@ void DeleteRangeResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 num_deleted = 2;
-  if (has_num_deleted()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->num_deleted(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteResponse)
   return target;
 }
 
-int DeleteRangeResponse::ByteSize() const {
+int DeleteResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -5695,13 +6916,6 @@ int DeleteRangeResponse::ByteSize() const {
           this->header());
     }
 
-    // optional int64 num_deleted = 2;
-    if (has_num_deleted()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->num_deleted());
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -5714,10 +6928,10 @@ int DeleteRangeResponse::ByteSize() const {
   return total_size;
 }
 
-void DeleteRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void DeleteResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const DeleteRangeResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeResponse*>(
+  const DeleteResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5726,51 +6940,47 @@ void DeleteRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void DeleteRangeResponse::MergeFrom(const DeleteRangeResponse& from) {
+void DeleteResponse::MergeFrom(const DeleteResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
-    if (from.has_num_deleted()) {
-      set_num_deleted(from.num_deleted());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void DeleteRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void DeleteResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void DeleteRangeResponse::CopyFrom(const DeleteRangeResponse& from) {
+void DeleteResponse::CopyFrom(const DeleteResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool DeleteRangeResponse::IsInitialized() const {
+bool DeleteResponse::IsInitialized() const {
 
   return true;
 }
 
-void DeleteRangeResponse::Swap(DeleteRangeResponse* other) {
+void DeleteResponse::Swap(DeleteResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(num_deleted_, other->num_deleted_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata DeleteRangeResponse::GetMetadata() const {
+::google::protobuf::Metadata DeleteResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = DeleteRangeResponse_descriptor_;
-  metadata.reflection = DeleteRangeResponse_reflection_;
+  metadata.descriptor = DeleteResponse_descriptor_;
+  metadata.reflection = DeleteResponse_reflection_;
   return metadata;
 }
 
@@ -5778,82 +6988,82 @@ void DeleteRangeResponse::Swap(DeleteRangeResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ScanRequest::kHeaderFieldNumber;
-const int ScanRequest::kMaxResultsFieldNumber;
+const int DeleteRangeRequest::kHeaderFieldNumber;
+const int DeleteRangeRequest::kMaxEntriesToDeleteFieldNumber;
 #endif  // !_MSC_VER
 
-ScanRequest::ScanRequest()
+DeleteRangeRequest::DeleteRangeRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRangeRequest)
 }
 
-void ScanRequest::InitAsDefaultInstance() {
+void DeleteRangeRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-ScanRequest::ScanRequest(const ScanRequest& from)
+DeleteRangeRequest::DeleteRangeRequest(const DeleteRangeRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRangeRequest)
 }
 
-void ScanRequest::SharedCtor() {
+void DeleteRangeRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  max_results_ = GOOGLE_LONGLONG(0);
+  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ScanRequest::~ScanRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.ScanRequest)
+DeleteRangeRequest::~DeleteRangeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRangeRequest)
   SharedDtor();
 }
 
-void ScanRequest::SharedDtor() {
+void DeleteRangeRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ScanRequest::SetCachedSize(int size) const {
+void DeleteRangeRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ScanRequest::descriptor() {
+const ::google::protobuf::Descriptor* DeleteRangeRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ScanRequest_descriptor_;
+  return DeleteRangeRequest_descriptor_;
 }
 
-const ScanRequest& ScanRequest::default_instance() {
+const DeleteRangeRequest& DeleteRangeRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-ScanRequest* ScanRequest::default_instance_ = NULL;
+DeleteRangeRequest* DeleteRangeRequest::default_instance_ = NULL;
 
-ScanRequest* ScanRequest::New() const {
-  return new ScanRequest;
+DeleteRangeRequest* DeleteRangeRequest::New() const {
+  return new DeleteRangeRequest;
 }
 
-void ScanRequest::Clear() {
+void DeleteRangeRequest::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    max_results_ = GOOGLE_LONGLONG(0);
+    max_entries_to_delete_ = GOOGLE_LONGLONG(0);
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ScanRequest::MergePartialFromCodedStream(
+bool DeleteRangeRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRangeRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -5867,19 +7077,19 @@ bool ScanRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_results;
+        if (input->ExpectTag(16)) goto parse_max_entries_to_delete;
         break;
       }
 
-      // optional int64 max_results = 2;
+      // optional int64 max_entries_to_delete = 2;
       case 2: {
         if (tag == 16) {
-         parse_max_results:
+         parse_max_entries_to_delete:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &max_results_)));
-          set_has_max_results();
-        } else {
+                 input, &max_entries_to_delete_)));
+          set_has_max_entries_to_delete();
+        } else {
           goto handle_unusual;
         }
         if (input->ExpectAtEnd()) goto success;
@@ -5900,38 +7110,38 @@ bool ScanRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRangeRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRangeRequest)
   return false;
 #undef DO_
 }
 
-void ScanRequest::SerializeWithCachedSizes(
+void DeleteRangeRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRangeRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 max_results = 2;
-  if (has_max_results()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  // optional int64 max_entries_to_delete = 2;
+  if (has_max_entries_to_delete()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_entries_to_delete(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRangeRequest)
 }
 
-::google::protobuf::uint8* ScanRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* DeleteRangeRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRangeRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -5939,20 +7149,20 @@ void ScanRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 max_results = 2;
-  if (has_max_results()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  // optional int64 max_entries_to_delete = 2;
+  if (has_max_entries_to_delete()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_entries_to_delete(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRangeRequest)
   return target;
 }
 
-int ScanRequest::ByteSize() const {
+int DeleteRangeRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -5963,11 +7173,11 @@ int ScanRequest::ByteSize() const {
           this->header());
     }
 
-    // optional int64 max_results = 2;
-    if (has_max_results()) {
+    // optional int64 max_entries_to_delete = 2;
+    if (has_max_entries_to_delete()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->max_results());
+          this->max_entries_to_delete());
     }
 
   }
@@ -5982,10 +7192,10 @@ int ScanRequest::ByteSize() const {
   return total_size;
 }
 
-void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void DeleteRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ScanRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ScanRequest*>(
+  const DeleteRangeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5994,51 +7204,51 @@ void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ScanRequest::MergeFrom(const ScanRequest& from) {
+void DeleteRangeRequest::MergeFrom(const DeleteRangeRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_max_results()) {
-      set_max_results(from.max_results());
+    if (from.has_max_entries_to_delete()) {
+      set_max_entries_to_delete(from.max_entries_to_delete());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void DeleteRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ScanRequest::CopyFrom(const ScanRequest& from) {
+void DeleteRangeRequest::CopyFrom(const DeleteRangeRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ScanRequest::IsInitialized() const {
+bool DeleteRangeRequest::IsInitialized() const {
 
   return true;
 }
 
-void ScanRequest::Swap(ScanRequest* other) {
+void DeleteRangeRequest::Swap(DeleteRangeRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(max_results_, other->max_results_);
+    std::swap(max_entries_to_delete_, other->max_entries_to_delete_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ScanRequest::GetMetadata() const {
+::google::protobuf::Metadata DeleteRangeRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ScanRequest_descriptor_;
-  metadata.reflection = ScanRequest_reflection_;
+  metadata.descriptor = DeleteRangeRequest_descriptor_;
+  metadata.reflection = DeleteRangeRequest_reflection_;
   return metadata;
 }
 
@@ -6046,79 +7256,82 @@ void ScanRequest::Swap(ScanRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ScanResponse::kHeaderFieldNumber;
-const int ScanResponse::kRowsFieldNumber;
+const int DeleteRangeResponse::kHeaderFieldNumber;
+const int DeleteRangeResponse::kNumDeletedFieldNumber;
 #endif  // !_MSC_VER
 
-ScanResponse::ScanResponse()
+DeleteRangeResponse::DeleteRangeResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRangeResponse)
 }
 
-void ScanResponse::InitAsDefaultInstance() {
+void DeleteRangeResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-ScanResponse::ScanResponse(const ScanResponse& from)
+DeleteRangeResponse::DeleteRangeResponse(const DeleteRangeResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRangeResponse)
 }
 
-void ScanResponse::SharedCtor() {
+void DeleteRangeResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  num_deleted_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ScanResponse::~ScanResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.ScanResponse)
+DeleteRangeResponse::~DeleteRangeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRangeResponse)
   SharedDtor();
 }
 
-void ScanResponse::SharedDtor() {
+void DeleteRangeResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ScanResponse::SetCachedSize(int size) const {
+void DeleteRangeResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ScanResponse::descriptor() {
+const ::google::protobuf::Descriptor* DeleteRangeResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ScanResponse_descriptor_;
+  return DeleteRangeResponse_descriptor_;
 }
 
-const ScanResponse& ScanResponse::default_instance() {
+const DeleteRangeResponse& DeleteRangeResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-ScanResponse* ScanResponse::default_instance_ = NULL;
+DeleteRangeResponse* DeleteRangeResponse::default_instance_ = NULL;
 
-ScanResponse* ScanResponse::New() const {
-  return new ScanResponse;
+DeleteRangeResponse* DeleteRangeResponse::New() const {
+  return new DeleteRangeResponse;
 }
 
-void ScanResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+void DeleteRangeResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    num_deleted_ = GOOGLE_LONGLONG(0);
   }
-  rows_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ScanResponse::MergePartialFromCodedStream(
+bool DeleteRangeResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRangeResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6132,20 +7345,21 @@ bool ScanResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectTag(16)) goto parse_num_deleted;
         break;
       }
 
-      // repeated .cockroach.proto.KeyValue rows = 2;
+      // optional int64 num_deleted = 2;
       case 2: {
-        if (tag == 18) {
-         parse_rows:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-                input, add_rows()));
+        if (tag == 16) {
+         parse_num_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &num_deleted_)));
+          set_has_num_deleted();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_rows;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6164,39 +7378,38 @@ bool ScanResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRangeResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRangeResponse)
   return false;
 #undef DO_
 }
 
-void ScanResponse::SerializeWithCachedSizes(
+void DeleteRangeResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRangeResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // repeated .cockroach.proto.KeyValue rows = 2;
-  for (int i = 0; i < this->rows_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->rows(i), output);
+  // optional int64 num_deleted = 2;
+  if (has_num_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->num_deleted(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRangeResponse)
 }
 
-::google::protobuf::uint8* ScanResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* DeleteRangeResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRangeResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6204,22 +7417,20 @@ void ScanResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // repeated .cockroach.proto.KeyValue rows = 2;
-  for (int i = 0; i < this->rows_size(); i++) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->rows(i), target);
+  // optional int64 num_deleted = 2;
+  if (has_num_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->num_deleted(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRangeResponse)
   return target;
 }
 
-int ScanResponse::ByteSize() const {
+int DeleteRangeResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6230,15 +7441,14 @@ int ScanResponse::ByteSize() const {
           this->header());
     }
 
-  }
-  // repeated .cockroach.proto.KeyValue rows = 2;
-  total_size += 1 * this->rows_size();
-  for (int i = 0; i < this->rows_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->rows(i));
-  }
+    // optional int64 num_deleted = 2;
+    if (has_num_deleted()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->num_deleted());
+    }
 
+  }
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -6250,10 +7460,10 @@ int ScanResponse::ByteSize() const {
   return total_size;
 }
 
-void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void DeleteRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ScanResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ScanResponse*>(
+  const DeleteRangeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6262,49 +7472,51 @@ void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ScanResponse::MergeFrom(const ScanResponse& from) {
+void DeleteRangeResponse::MergeFrom(const DeleteRangeResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
-  rows_.MergeFrom(from.rows_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_num_deleted()) {
+      set_num_deleted(from.num_deleted());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void DeleteRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ScanResponse::CopyFrom(const ScanResponse& from) {
+void DeleteRangeResponse::CopyFrom(const DeleteRangeResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ScanResponse::IsInitialized() const {
+bool DeleteRangeResponse::IsInitialized() const {
 
   return true;
 }
 
-void ScanResponse::Swap(ScanResponse* other) {
+void DeleteRangeResponse::Swap(DeleteRangeResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    rows_.Swap(&other->rows_);
+    std::swap(num_deleted_, other->num_deleted_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ScanResponse::GetMetadata() const {
+::google::protobuf::Metadata DeleteRangeResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ScanResponse_descriptor_;
-  metadata.reflection = ScanResponse_reflection_;
+  metadata.descriptor = DeleteRangeResponse_descriptor_;
+  metadata.reflection = DeleteRangeResponse_reflection_;
   return metadata;
 }
 
@@ -6312,89 +7524,77 @@ void ScanResponse::Swap(ScanResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EndTransactionRequest::kHeaderFieldNumber;
-const int EndTransactionRequest::kCommitFieldNumber;
-const int EndTransactionRequest::kInternalCommitTriggerFieldNumber;
+const int ClearRangeRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-EndTransactionRequest::EndTransactionRequest()
+ClearRangeRequest::ClearRangeRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ClearRangeRequest)
 }
 
-void EndTransactionRequest::InitAsDefaultInstance() {
+void ClearRangeRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
-  internal_commit_trigger_ = const_cast< ::cockroach::proto::InternalCommitTrigger*>(&::cockroach::proto::InternalCommitTrigger::default_instance());
 }
 
-EndTransactionRequest::EndTransactionRequest(const EndTransactionRequest& from)
+ClearRangeRequest::ClearRangeRequest(const ClearRangeRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ClearRangeRequest)
 }
 
-void EndTransactionRequest::SharedCtor() {
+void ClearRangeRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  commit_ = false;
-  internal_commit_trigger_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EndTransactionRequest::~EndTransactionRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionRequest)
+ClearRangeRequest::~ClearRangeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ClearRangeRequest)
   SharedDtor();
 }
 
-void EndTransactionRequest::SharedDtor() {
+void ClearRangeRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete internal_commit_trigger_;
   }
 }
 
-void EndTransactionRequest::SetCachedSize(int size) const {
+void ClearRangeRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EndTransactionRequest::descriptor() {
+const ::google::protobuf::Descriptor* ClearRangeRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EndTransactionRequest_descriptor_;
+  return ClearRangeRequest_descriptor_;
 }
 
-const EndTransactionRequest& EndTransactionRequest::default_instance() {
+const ClearRangeRequest& ClearRangeRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-EndTransactionRequest* EndTransactionRequest::default_instance_ = NULL;
+ClearRangeRequest* ClearRangeRequest::default_instance_ = NULL;
 
-EndTransactionRequest* EndTransactionRequest::New() const {
-  return new EndTransactionRequest;
+ClearRangeRequest* ClearRangeRequest::New() const {
+  return new ClearRangeRequest;
 }
 
-void EndTransactionRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-    }
-    commit_ = false;
-    if (has_internal_commit_trigger()) {
-      if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-    }
+void ClearRangeRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EndTransactionRequest::MergePartialFromCodedStream(
+bool ClearRangeRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ClearRangeRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6408,35 +7608,7 @@ bool EndTransactionRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_commit;
-        break;
-      }
-
-      // optional bool commit = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_commit:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &commit_)));
-          set_has_commit();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_internal_commit_trigger;
-        break;
-      }
-
-      // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_internal_commit_trigger:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_commit_trigger()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectAtEnd()) goto success;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
@@ -6454,44 +7626,33 @@ bool EndTransactionRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ClearRangeRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ClearRangeRequest)
   return false;
 #undef DO_
 }
 
-void EndTransactionRequest::SerializeWithCachedSizes(
+void ClearRangeRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ClearRangeRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional bool commit = 2;
-  if (has_commit()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->commit(), output);
-  }
-
-  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-  if (has_internal_commit_trigger()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->internal_commit_trigger(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ClearRangeRequest)
 }
 
-::google::protobuf::uint8* EndTransactionRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ClearRangeRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ClearRangeRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6499,27 +7660,15 @@ void EndTransactionRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional bool commit = 2;
-  if (has_commit()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->commit(), target);
-  }
-
-  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-  if (has_internal_commit_trigger()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->internal_commit_trigger(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ClearRangeRequest)
   return target;
 }
 
-int EndTransactionRequest::ByteSize() const {
+int ClearRangeRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6530,18 +7679,6 @@ int EndTransactionRequest::ByteSize() const {
           this->header());
     }
 
-    // optional bool commit = 2;
-    if (has_commit()) {
-      total_size += 1 + 1;
-    }
-
-    // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-    if (has_internal_commit_trigger()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_commit_trigger());
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -6554,10 +7691,10 @@ int EndTransactionRequest::ByteSize() const {
   return total_size;
 }
 
-void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ClearRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EndTransactionRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionRequest*>(
+  const ClearRangeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ClearRangeRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6566,55 +7703,47 @@ void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
+void ClearRangeRequest::MergeFrom(const ClearRangeRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_commit()) {
-      set_commit(from.commit());
-    }
-    if (from.has_internal_commit_trigger()) {
-      mutable_internal_commit_trigger()->::cockroach::proto::InternalCommitTrigger::MergeFrom(from.internal_commit_trigger());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EndTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ClearRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EndTransactionRequest::CopyFrom(const EndTransactionRequest& from) {
+void ClearRangeRequest::CopyFrom(const ClearRangeRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EndTransactionRequest::IsInitialized() const {
+bool ClearRangeRequest::IsInitialized() const {
 
   return true;
 }
 
-void EndTransactionRequest::Swap(EndTransactionRequest* other) {
+void ClearRangeRequest::Swap(ClearRangeRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(commit_, other->commit_);
-    std::swap(internal_commit_trigger_, other->internal_commit_trigger_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EndTransactionRequest::GetMetadata() const {
+::google::protobuf::Metadata ClearRangeRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EndTransactionRequest_descriptor_;
-  metadata.reflection = EndTransactionRequest_reflection_;
+  metadata.descriptor = ClearRangeRequest_descriptor_;
+  metadata.reflection = ClearRangeRequest_reflection_;
   return metadata;
 }
 
@@ -6622,85 +7751,77 @@ void EndTransactionRequest::Swap(EndTransactionRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EndTransactionResponse::kHeaderFieldNumber;
-const int EndTransactionResponse::kCommitWaitFieldNumber;
-const int EndTransactionResponse::kResolvedFieldNumber;
+const int ClearRangeResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-EndTransactionResponse::EndTransactionResponse()
+ClearRangeResponse::ClearRangeResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ClearRangeResponse)
 }
 
-void EndTransactionResponse::InitAsDefaultInstance() {
+void ClearRangeResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-EndTransactionResponse::EndTransactionResponse(const EndTransactionResponse& from)
+ClearRangeResponse::ClearRangeResponse(const ClearRangeResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ClearRangeResponse)
 }
 
-void EndTransactionResponse::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void ClearRangeResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  commit_wait_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EndTransactionResponse::~EndTransactionResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionResponse)
+ClearRangeResponse::~ClearRangeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ClearRangeResponse)
   SharedDtor();
 }
 
-void EndTransactionResponse::SharedDtor() {
+void ClearRangeResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void EndTransactionResponse::SetCachedSize(int size) const {
+void ClearRangeResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EndTransactionResponse::descriptor() {
+const ::google::protobuf::Descriptor* ClearRangeResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EndTransactionResponse_descriptor_;
+  return ClearRangeResponse_descriptor_;
 }
 
-const EndTransactionResponse& EndTransactionResponse::default_instance() {
+const ClearRangeResponse& ClearRangeResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-EndTransactionResponse* EndTransactionResponse::default_instance_ = NULL;
+ClearRangeResponse* ClearRangeResponse::default_instance_ = NULL;
 
-EndTransactionResponse* EndTransactionResponse::New() const {
-  return new EndTransactionResponse;
+ClearRangeResponse* ClearRangeResponse::New() const {
+  return new ClearRangeResponse;
 }
 
-void EndTransactionResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-    }
-    commit_wait_ = GOOGLE_LONGLONG(0);
+void ClearRangeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   }
-  resolved_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EndTransactionResponse::MergePartialFromCodedStream(
+bool ClearRangeResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ClearRangeResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6714,35 +7835,6 @@ bool EndTransactionResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_commit_wait;
-        break;
-      }
-
-      // optional int64 commit_wait = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_commit_wait:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &commit_wait_)));
-          set_has_commit_wait();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_resolved;
-        break;
-      }
-
-      // repeated bytes resolved = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_resolved:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->add_resolved()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_resolved;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6761,44 +7853,33 @@ bool EndTransactionResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ClearRangeResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ClearRangeResponse)
   return false;
 #undef DO_
 }
 
-void EndTransactionResponse::SerializeWithCachedSizes(
+void ClearRangeResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ClearRangeResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 commit_wait = 2;
-  if (has_commit_wait()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->commit_wait(), output);
-  }
-
-  // repeated bytes resolved = 3;
-  for (int i = 0; i < this->resolved_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytes(
-      3, this->resolved(i), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ClearRangeResponse)
 }
 
-::google::protobuf::uint8* EndTransactionResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ClearRangeResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ClearRangeResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6806,26 +7887,15 @@ void EndTransactionResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 commit_wait = 2;
-  if (has_commit_wait()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->commit_wait(), target);
-  }
-
-  // repeated bytes resolved = 3;
-  for (int i = 0; i < this->resolved_size(); i++) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteBytesToArray(3, this->resolved(i), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ClearRangeResponse)
   return target;
 }
 
-int EndTransactionResponse::ByteSize() const {
+int ClearRangeResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6836,21 +7906,7 @@ int EndTransactionResponse::ByteSize() const {
           this->header());
     }
 
-    // optional int64 commit_wait = 2;
-    if (has_commit_wait()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->commit_wait());
-    }
-
-  }
-  // repeated bytes resolved = 3;
-  total_size += 1 * this->resolved_size();
-  for (int i = 0; i < this->resolved_size(); i++) {
-    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
-      this->resolved(i));
   }
-
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -6862,10 +7918,10 @@ int EndTransactionResponse::ByteSize() const {
   return total_size;
 }
 
-void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ClearRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EndTransactionResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionResponse*>(
+  const ClearRangeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ClearRangeResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6874,53 +7930,47 @@ void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from)
   }
 }
 
-void EndTransactionResponse::MergeFrom(const EndTransactionResponse& from) {
+void ClearRangeResponse::MergeFrom(const ClearRangeResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
-  resolved_.MergeFrom(from.resolved_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
-    if (from.has_commit_wait()) {
-      set_commit_wait(from.commit_wait());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EndTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ClearRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EndTransactionResponse::CopyFrom(const EndTransactionResponse& from) {
+void ClearRangeResponse::CopyFrom(const ClearRangeResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EndTransactionResponse::IsInitialized() const {
+bool ClearRangeResponse::IsInitialized() const {
 
   return true;
 }
 
-void EndTransactionResponse::Swap(EndTransactionResponse* other) {
+void ClearRangeResponse::Swap(ClearRangeResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(commit_wait_, other->commit_wait_);
-    resolved_.Swap(&other->resolved_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EndTransactionResponse::GetMetadata() const {
+::google::protobuf::Metadata ClearRangeResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EndTransactionResponse_descriptor_;
-  metadata.reflection = EndTransactionResponse_reflection_;
+  metadata.descriptor = ClearRangeResponse_descriptor_;
+  metadata.reflection = ClearRangeResponse_reflection_;
   return metadata;
 }
 
@@ -6928,253 +7978,138 @@ void EndTransactionResponse::Swap(EndTransactionResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int RequestUnion::kContainsFieldNumber;
-const int RequestUnion::kGetFieldNumber;
-const int RequestUnion::kPutFieldNumber;
-const int RequestUnion::kConditionalPutFieldNumber;
-const int RequestUnion::kIncrementFieldNumber;
-const int RequestUnion::kDeleteFieldNumber;
-const int RequestUnion::kDeleteRangeFieldNumber;
-const int RequestUnion::kScanFieldNumber;
-const int RequestUnion::kEndTransactionFieldNumber;
+const int ScanRequest::kHeaderFieldNumber;
+const int ScanRequest::kMaxResultsFieldNumber;
+const int ScanRequest::kTargetBytesFieldNumber;
 #endif  // !_MSC_VER
 
-RequestUnion::RequestUnion()
+ScanRequest::ScanRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ScanRequest)
 }
 
-void RequestUnion::InitAsDefaultInstance() {
-  RequestUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsRequest*>(&::cockroach::proto::ContainsRequest::default_instance());
-  RequestUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
-  RequestUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
-  RequestUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
-  RequestUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
-  RequestUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
-  RequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
-  RequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
-  RequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+void ScanRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-RequestUnion::RequestUnion(const RequestUnion& from)
+ScanRequest::ScanRequest(const ScanRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanRequest)
 }
 
-void RequestUnion::SharedCtor() {
+void ScanRequest::SharedCtor() {
   _cached_size_ = 0;
+  header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  target_bytes_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-RequestUnion::~RequestUnion() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.RequestUnion)
+ScanRequest::~ScanRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ScanRequest)
   SharedDtor();
 }
 
-void RequestUnion::SharedDtor() {
-  if (has_value()) {
-    clear_value();
-  }
+void ScanRequest::SharedDtor() {
   if (this != default_instance_) {
+    delete header_;
   }
 }
 
-void RequestUnion::SetCachedSize(int size) const {
+void ScanRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* RequestUnion::descriptor() {
+const ::google::protobuf::Descriptor* ScanRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return RequestUnion_descriptor_;
+  return ScanRequest_descriptor_;
 }
 
-const RequestUnion& RequestUnion::default_instance() {
+const ScanRequest& ScanRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-RequestUnion* RequestUnion::default_instance_ = NULL;
+ScanRequest* ScanRequest::default_instance_ = NULL;
 
-RequestUnion* RequestUnion::New() const {
-  return new RequestUnion;
+ScanRequest* ScanRequest::New() const {
+  return new ScanRequest;
 }
 
-void RequestUnion::clear_value() {
-  switch(value_case()) {
-    case kContains: {
-      delete value_.contains_;
-      break;
-    }
-    case kGet: {
-      delete value_.get_;
-      break;
-    }
-    case kPut: {
-      delete value_.put_;
-      break;
-    }
-    case kConditionalPut: {
-      delete value_.conditional_put_;
-      break;
-    }
-    case kIncrement: {
-      delete value_.increment_;
-      break;
-    }
-    case kDelete: {
-      delete value_.delete__;
-      break;
-    }
-    case kDeleteRange: {
-      delete value_.delete_range_;
-      break;
-    }
-    case kScan: {
-      delete value_.scan_;
-      break;
-    }
-    case kEndTransaction: {
-      delete value_.end_transaction_;
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+void ScanRequest::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<ScanRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 7) {
+    ZR_(max_results_, target_bytes_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
   }
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
 
+#undef OFFSET_OF_FIELD_
+#undef ZR_
 
-void RequestUnion::Clear() {
-  clear_value();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool RequestUnion::MergePartialFromCodedStream(
+bool ScanRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.ContainsRequest contains = 1;
+      // optional .cockroach.proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_contains()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_get;
+        if (input->ExpectTag(16)) goto parse_max_results;
         break;
       }
 
-      // optional .cockroach.proto.GetRequest get = 2;
+      // optional int64 max_results = 2;
       case 2: {
-        if (tag == 18) {
-         parse_get:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_get()));
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_put;
+        if (input->ExpectTag(24)) goto parse_target_bytes;
         break;
       }
 
-      // optional .cockroach.proto.PutRequest put = 3;
+      // optional int64 target_bytes = 3;
       case 3: {
-        if (tag == 26) {
-         parse_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_put()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(34)) goto parse_conditional_put;
-        break;
-      }
-
-      // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_conditional_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(42)) goto parse_increment;
-        break;
-      }
-
-      // optional .cockroach.proto.IncrementRequest increment = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_increment:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_delete;
-        break;
-      }
-
-      // optional .cockroach.proto.DeleteRequest delete = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_delete:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(58)) goto parse_delete_range;
-        break;
-      }
-
-      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(66)) goto parse_scan;
-        break;
-      }
-
-      // optional .cockroach.proto.ScanRequest scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
-        break;
-      }
-
-      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
+        if (tag == 24) {
+         parse_target_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &target_bytes_)));
+          set_has_target_bytes();
         } else {
           goto handle_unusual;
         }
@@ -7196,222 +8131,93 @@ bool RequestUnion::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanRequest)
   return false;
 #undef DO_
 }
 
-void RequestUnion::SerializeWithCachedSizes(
+void ScanRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.RequestUnion)
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  if (has_contains()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->contains(), output);
-  }
-
-  // optional .cockroach.proto.GetRequest get = 2;
-  if (has_get()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->get(), output);
-  }
-
-  // optional .cockroach.proto.PutRequest put = 3;
-  if (has_put()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->put(), output);
+      1, this->header(), output);
   }
 
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  if (has_conditional_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, this->conditional_put(), output);
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
   }
 
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  if (has_increment()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, this->increment(), output);
+  // optional int64 target_bytes = 3;
+  if (has_target_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->target_bytes(), output);
   }
 
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  if (has_delete_()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      6, this->delete_(), output);
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
   }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanRequest)
+}
 
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  if (has_delete_range()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      7, this->delete_range(), output);
+::google::protobuf::uint8* ScanRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
   }
 
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  if (has_scan()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      8, this->scan(), output);
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
   }
 
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  if (has_end_transaction()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      9, this->end_transaction(), output);
+  // optional int64 target_bytes = 3;
+  if (has_target_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->target_bytes(), target);
   }
 
   if (!unknown_fields().empty()) {
-    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
-        unknown_fields(), output);
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanRequest)
+  return target;
 }
 
-::google::protobuf::uint8* RequestUnion::SerializeWithCachedSizesToArray(
-    ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RequestUnion)
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  if (has_contains()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->contains(), target);
-  }
-
-  // optional .cockroach.proto.GetRequest get = 2;
-  if (has_get()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->get(), target);
-  }
-
-  // optional .cockroach.proto.PutRequest put = 3;
-  if (has_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->put(), target);
-  }
-
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  if (has_conditional_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        4, this->conditional_put(), target);
-  }
-
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  if (has_increment()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        5, this->increment(), target);
-  }
-
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  if (has_delete_()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        6, this->delete_(), target);
-  }
-
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  if (has_delete_range()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        7, this->delete_range(), target);
-  }
-
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  if (has_scan()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        8, this->scan(), target);
-  }
-
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  if (has_end_transaction()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        9, this->end_transaction(), target);
-  }
-
-  if (!unknown_fields().empty()) {
-    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
-        unknown_fields(), target);
-  }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RequestUnion)
-  return target;
-}
-
-int RequestUnion::ByteSize() const {
+int ScanRequest::ByteSize() const {
   int total_size = 0;
 
-  switch (value_case()) {
-    // optional .cockroach.proto.ContainsRequest contains = 1;
-    case kContains: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->contains());
-      break;
-    }
-    // optional .cockroach.proto.GetRequest get = 2;
-    case kGet: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->get());
-      break;
-    }
-    // optional .cockroach.proto.PutRequest put = 3;
-    case kPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->put());
-      break;
-    }
-    // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-    case kConditionalPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->conditional_put());
-      break;
-    }
-    // optional .cockroach.proto.IncrementRequest increment = 5;
-    case kIncrement: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->increment());
-      break;
-    }
-    // optional .cockroach.proto.DeleteRequest delete = 6;
-    case kDelete: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_());
-      break;
-    }
-    // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-    case kDeleteRange: {
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_range());
-      break;
+          this->header());
     }
-    // optional .cockroach.proto.ScanRequest scan = 8;
-    case kScan: {
+
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->scan());
-      break;
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
     }
-    // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-    case kEndTransaction: {
+
+    // optional int64 target_bytes = 3;
+    if (has_target_bytes()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->end_transaction());
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->target_bytes());
     }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -7424,10 +8230,10 @@ int RequestUnion::ByteSize() const {
   return total_size;
 }
 
-void RequestUnion::MergeFrom(const ::google::protobuf::Message& from) {
+void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const RequestUnion* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const RequestUnion*>(
+  const ScanRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ScanRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7436,84 +8242,55 @@ void RequestUnion::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void RequestUnion::MergeFrom(const RequestUnion& from) {
+void ScanRequest::MergeFrom(const ScanRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
-  switch (from.value_case()) {
-    case kContains: {
-      mutable_contains()->::cockroach::proto::ContainsRequest::MergeFrom(from.contains());
-      break;
-    }
-    case kGet: {
-      mutable_get()->::cockroach::proto::GetRequest::MergeFrom(from.get());
-      break;
-    }
-    case kPut: {
-      mutable_put()->::cockroach::proto::PutRequest::MergeFrom(from.put());
-      break;
-    }
-    case kConditionalPut: {
-      mutable_conditional_put()->::cockroach::proto::ConditionalPutRequest::MergeFrom(from.conditional_put());
-      break;
-    }
-    case kIncrement: {
-      mutable_increment()->::cockroach::proto::IncrementRequest::MergeFrom(from.increment());
-      break;
-    }
-    case kDelete: {
-      mutable_delete_()->::cockroach::proto::DeleteRequest::MergeFrom(from.delete_());
-      break;
-    }
-    case kDeleteRange: {
-      mutable_delete_range()->::cockroach::proto::DeleteRangeRequest::MergeFrom(from.delete_range());
-      break;
-    }
-    case kScan: {
-      mutable_scan()->::cockroach::proto::ScanRequest::MergeFrom(from.scan());
-      break;
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    case kEndTransaction: {
-      mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
-      break;
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
     }
-    case VALUE_NOT_SET: {
-      break;
+    if (from.has_target_bytes()) {
+      set_target_bytes(from.target_bytes());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void RequestUnion::CopyFrom(const ::google::protobuf::Message& from) {
+void ScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void RequestUnion::CopyFrom(const RequestUnion& from) {
+void ScanRequest::CopyFrom(const ScanRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool RequestUnion::IsInitialized() const {
+bool ScanRequest::IsInitialized() const {
 
   return true;
 }
 
-void RequestUnion::Swap(RequestUnion* other) {
+void ScanRequest::Swap(ScanRequest* other) {
   if (other != this) {
-    std::swap(value_, other->value_);
-    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(header_, other->header_);
+    std::swap(max_results_, other->max_results_);
+    std::swap(target_bytes_, other->target_bytes_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata RequestUnion::GetMetadata() const {
+::google::protobuf::Metadata ScanRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = RequestUnion_descriptor_;
-  metadata.reflection = RequestUnion_reflection_;
+  metadata.descriptor = ScanRequest_descriptor_;
+  metadata.reflection = ScanRequest_reflection_;
   return metadata;
 }
 
@@ -7521,253 +8298,125 @@ void RequestUnion::Swap(RequestUnion* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ResponseUnion::kContainsFieldNumber;
-const int ResponseUnion::kGetFieldNumber;
-const int ResponseUnion::kPutFieldNumber;
-const int ResponseUnion::kConditionalPutFieldNumber;
-const int ResponseUnion::kIncrementFieldNumber;
-const int ResponseUnion::kDeleteFieldNumber;
-const int ResponseUnion::kDeleteRangeFieldNumber;
-const int ResponseUnion::kScanFieldNumber;
-const int ResponseUnion::kEndTransactionFieldNumber;
+const int ScanResponse::kHeaderFieldNumber;
+const int ScanResponse::kRowsFieldNumber;
+const int ScanResponse::kResumeSpanFieldNumber;
 #endif  // !_MSC_VER
 
-ResponseUnion::ResponseUnion()
+ScanResponse::ScanResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ScanResponse)
 }
 
-void ResponseUnion::InitAsDefaultInstance() {
-  ResponseUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsResponse*>(&::cockroach::proto::ContainsResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetResponse*>(&::cockroach::proto::GetResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutResponse*>(&::cockroach::proto::PutResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutResponse*>(&::cockroach::proto::ConditionalPutResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementResponse*>(&::cockroach::proto::IncrementResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteResponse*>(&::cockroach::proto::DeleteResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanResponse*>(&::cockroach::proto::ScanResponse::default_instance());
-  ResponseUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+void ScanResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  resume_span_ = const_cast< ::cockroach::proto::Span*>(&::cockroach::proto::Span::default_instance());
 }
 
-ResponseUnion::ResponseUnion(const ResponseUnion& from)
+ScanResponse::ScanResponse(const ScanResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanResponse)
 }
 
-void ResponseUnion::SharedCtor() {
+void ScanResponse::SharedCtor() {
   _cached_size_ = 0;
+  header_ = NULL;
+  resume_span_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-ResponseUnion::~ResponseUnion() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.ResponseUnion)
+ScanResponse::~ScanResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ScanResponse)
   SharedDtor();
 }
 
-void ResponseUnion::SharedDtor() {
-  if (has_value()) {
-    clear_value();
-  }
+void ScanResponse::SharedDtor() {
   if (this != default_instance_) {
+    delete header_;
+    delete resume_span_;
   }
 }
 
-void ResponseUnion::SetCachedSize(int size) const {
+void ScanResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ResponseUnion::descriptor() {
+const ::google::protobuf::Descriptor* ScanResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ResponseUnion_descriptor_;
+  return ScanResponse_descriptor_;
 }
 
-const ResponseUnion& ResponseUnion::default_instance() {
+const ScanResponse& ScanResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-ResponseUnion* ResponseUnion::default_instance_ = NULL;
+ScanResponse* ScanResponse::default_instance_ = NULL;
 
-ResponseUnion* ResponseUnion::New() const {
-  return new ResponseUnion;
+ScanResponse* ScanResponse::New() const {
+  return new ScanResponse;
 }
 
-void ResponseUnion::clear_value() {
-  switch(value_case()) {
-    case kContains: {
-      delete value_.contains_;
-      break;
-    }
-    case kGet: {
-      delete value_.get_;
-      break;
-    }
-    case kPut: {
-      delete value_.put_;
-      break;
-    }
-    case kConditionalPut: {
-      delete value_.conditional_put_;
-      break;
-    }
-    case kIncrement: {
-      delete value_.increment_;
-      break;
-    }
-    case kDelete: {
-      delete value_.delete__;
-      break;
-    }
-    case kDeleteRange: {
-      delete value_.delete_range_;
-      break;
-    }
-    case kScan: {
-      delete value_.scan_;
-      break;
-    }
-    case kEndTransaction: {
-      delete value_.end_transaction_;
-      break;
+void ScanResponse::Clear() {
+  if (_has_bits_[0 / 32] & 5) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
     }
-    case VALUE_NOT_SET: {
-      break;
+    if (has_resume_span()) {
+      if (resume_span_ != NULL) resume_span_->::cockroach::proto::Span::Clear();
     }
   }
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
-
-
-void ResponseUnion::Clear() {
-  clear_value();
+  rows_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ResponseUnion::MergePartialFromCodedStream(
+bool ScanResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.ContainsResponse contains = 1;
+      // optional .cockroach.proto.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_contains()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_get;
+        if (input->ExpectTag(18)) goto parse_rows;
         break;
       }
 
-      // optional .cockroach.proto.GetResponse get = 2;
+      // repeated .cockroach.proto.KeyValue rows = 2;
       case 2: {
         if (tag == 18) {
-         parse_get:
+         parse_rows:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_get()));
+                input, add_rows()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_put;
+        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectTag(26)) goto parse_resume_span;
         break;
       }
 
-      // optional .cockroach.proto.PutResponse put = 3;
+      // optional .cockroach.proto.Span resume_span = 3;
       case 3: {
         if (tag == 26) {
-         parse_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_put()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(34)) goto parse_conditional_put;
-        break;
-      }
-
-      // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_conditional_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(42)) goto parse_increment;
-        break;
-      }
-
-      // optional .cockroach.proto.IncrementResponse increment = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_increment:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_delete;
-        break;
-      }
-
-      // optional .cockroach.proto.DeleteResponse delete = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_delete:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(58)) goto parse_delete_range;
-        break;
-      }
-
-      // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(66)) goto parse_scan;
-        break;
-      }
-
-      // optional .cockroach.proto.ScanResponse scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
-        break;
-      }
-
-      // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
+         parse_resume_span:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
+               input, mutable_resume_span()));
         } else {
           goto handle_unusual;
         }
@@ -7789,222 +8438,6189 @@ bool ResponseUnion::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanResponse)
   return false;
 #undef DO_
 }
 
-void ResponseUnion::SerializeWithCachedSizes(
+void ScanResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.ResponseUnion)
-  // optional .cockroach.proto.ContainsResponse contains = 1;
-  if (has_contains()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->contains(), output);
-  }
-
-  // optional .cockroach.proto.GetResponse get = 2;
-  if (has_get()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->get(), output);
-  }
-
-  // optional .cockroach.proto.PutResponse put = 3;
-  if (has_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->put(), output);
-  }
-
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-  if (has_conditional_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, this->conditional_put(), output);
-  }
-
-  // optional .cockroach.proto.IncrementResponse increment = 5;
-  if (has_increment()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, this->increment(), output);
-  }
-
-  // optional .cockroach.proto.DeleteResponse delete = 6;
-  if (has_delete_()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      6, this->delete_(), output);
-  }
-
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-  if (has_delete_range()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      7, this->delete_range(), output);
+      1, this->header(), output);
   }
 
-  // optional .cockroach.proto.ScanResponse scan = 8;
-  if (has_scan()) {
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      8, this->scan(), output);
+      2, this->rows(i), output);
   }
 
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-  if (has_end_transaction()) {
+  // optional .cockroach.proto.Span resume_span = 3;
+  if (has_resume_span()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      9, this->end_transaction(), output);
+      3, this->resume_span(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanResponse)
 }
 
-::google::protobuf::uint8* ResponseUnion::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ScanResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ResponseUnion)
-  // optional .cockroach.proto.ContainsResponse contains = 1;
-  if (has_contains()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        1, this->contains(), target);
+        1, this->header(), target);
   }
 
-  // optional .cockroach.proto.GetResponse get = 2;
-  if (has_get()) {
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->get(), target);
+        2, this->rows(i), target);
   }
 
-  // optional .cockroach.proto.PutResponse put = 3;
-  if (has_put()) {
+  // optional .cockroach.proto.Span resume_span = 3;
+  if (has_resume_span()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        3, this->put(), target);
+        3, this->resume_span(), target);
   }
 
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-  if (has_conditional_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        4, this->conditional_put(), target);
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
   }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanResponse)
+  return target;
+}
 
-  // optional .cockroach.proto.IncrementResponse increment = 5;
-  if (has_increment()) {
+int ScanResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.Span resume_span = 3;
+    if (has_resume_span()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->resume_span());
+    }
+
+  }
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ScanResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ScanResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanResponse::MergeFrom(const ScanResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  rows_.MergeFrom(from.rows_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_resume_span()) {
+      mutable_resume_span()->::cockroach::proto::Span::MergeFrom(from.resume_span());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanResponse::CopyFrom(const ScanResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ScanResponse::Swap(ScanResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    rows_.Swap(&other->rows_);
+    std::swap(resume_span_, other->resume_span_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ScanResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanResponse_descriptor_;
+  metadata.reflection = ScanResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AggregateRequest::kHeaderFieldNumber;
+const int AggregateRequest::kMaxResultsFieldNumber;
+#endif  // !_MSC_VER
+
+AggregateRequest::AggregateRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AggregateRequest)
+}
+
+void AggregateRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+AggregateRequest::AggregateRequest(const AggregateRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AggregateRequest)
+}
+
+void AggregateRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AggregateRequest::~AggregateRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AggregateRequest)
+  SharedDtor();
+}
+
+void AggregateRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AggregateRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AggregateRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AggregateRequest_descriptor_;
+}
+
+const AggregateRequest& AggregateRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AggregateRequest* AggregateRequest::default_instance_ = NULL;
+
+AggregateRequest* AggregateRequest::New() const {
+  return new AggregateRequest;
+}
+
+void AggregateRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AggregateRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AggregateRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AggregateRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AggregateRequest)
+  return false;
+#undef DO_
+}
+
+void AggregateRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AggregateRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AggregateRequest)
+}
+
+::google::protobuf::uint8* AggregateRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AggregateRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AggregateRequest)
+  return target;
+}
+
+int AggregateRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AggregateRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AggregateRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AggregateRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AggregateRequest::MergeFrom(const AggregateRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AggregateRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AggregateRequest::CopyFrom(const AggregateRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AggregateRequest::IsInitialized() const {
+
+  return true;
+}
+
+void AggregateRequest::Swap(AggregateRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(max_results_, other->max_results_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AggregateRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AggregateRequest_descriptor_;
+  metadata.reflection = AggregateRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AggregateResponse::kHeaderFieldNumber;
+const int AggregateResponse::kNumKeysFieldNumber;
+const int AggregateResponse::kSumFieldNumber;
+#endif  // !_MSC_VER
+
+AggregateResponse::AggregateResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AggregateResponse)
+}
+
+void AggregateResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+AggregateResponse::AggregateResponse(const AggregateResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AggregateResponse)
+}
+
+void AggregateResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  num_keys_ = GOOGLE_LONGLONG(0);
+  sum_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AggregateResponse::~AggregateResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AggregateResponse)
+  SharedDtor();
+}
+
+void AggregateResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AggregateResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AggregateResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AggregateResponse_descriptor_;
+}
+
+const AggregateResponse& AggregateResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AggregateResponse* AggregateResponse::default_instance_ = NULL;
+
+AggregateResponse* AggregateResponse::New() const {
+  return new AggregateResponse;
+}
+
+void AggregateResponse::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<AggregateResponse*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 7) {
+    ZR_(num_keys_, sum_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AggregateResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AggregateResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_num_keys;
+        break;
+      }
+
+      // optional int64 num_keys = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_num_keys:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &num_keys_)));
+          set_has_num_keys();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_sum;
+        break;
+      }
+
+      // optional int64 sum = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_sum:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &sum_)));
+          set_has_sum();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AggregateResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AggregateResponse)
+  return false;
+#undef DO_
+}
+
+void AggregateResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AggregateResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 num_keys = 2;
+  if (has_num_keys()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->num_keys(), output);
+  }
+
+  // optional int64 sum = 3;
+  if (has_sum()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->sum(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AggregateResponse)
+}
+
+::google::protobuf::uint8* AggregateResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AggregateResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 num_keys = 2;
+  if (has_num_keys()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->num_keys(), target);
+  }
+
+  // optional int64 sum = 3;
+  if (has_sum()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->sum(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AggregateResponse)
+  return target;
+}
+
+int AggregateResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 num_keys = 2;
+    if (has_num_keys()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->num_keys());
+    }
+
+    // optional int64 sum = 3;
+    if (has_sum()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->sum());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AggregateResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AggregateResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AggregateResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AggregateResponse::MergeFrom(const AggregateResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_num_keys()) {
+      set_num_keys(from.num_keys());
+    }
+    if (from.has_sum()) {
+      set_sum(from.sum());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AggregateResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AggregateResponse::CopyFrom(const AggregateResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AggregateResponse::IsInitialized() const {
+
+  return true;
+}
+
+void AggregateResponse::Swap(AggregateResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(num_keys_, other->num_keys_);
+    std::swap(sum_, other->sum_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AggregateResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AggregateResponse_descriptor_;
+  metadata.reflection = AggregateResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int WatchRequest::kHeaderFieldNumber;
+const int WatchRequest::kLimitKeyFieldNumber;
+const int WatchRequest::kStartTimeFieldNumber;
+const int WatchRequest::kMaxWaitFieldNumber;
+#endif  // !_MSC_VER
+
+WatchRequest::WatchRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.WatchRequest)
+}
+
+void WatchRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  start_time_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+WatchRequest::WatchRequest(const WatchRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.WatchRequest)
+}
+
+void WatchRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  limit_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  start_time_ = NULL;
+  max_wait_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+WatchRequest::~WatchRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.WatchRequest)
+  SharedDtor();
+}
+
+void WatchRequest::SharedDtor() {
+  if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete limit_key_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+    delete start_time_;
+  }
+}
+
+void WatchRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* WatchRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return WatchRequest_descriptor_;
+}
+
+const WatchRequest& WatchRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+WatchRequest* WatchRequest::default_instance_ = NULL;
+
+WatchRequest* WatchRequest::New() const {
+  return new WatchRequest;
+}
+
+void WatchRequest::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_limit_key()) {
+      if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        limit_key_->clear();
+      }
+    }
+    if (has_start_time()) {
+      if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+    }
+    max_wait_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool WatchRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.WatchRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_limit_key;
+        break;
+      }
+
+      // optional bytes limit_key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_limit_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_limit_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_start_time;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp start_time = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_start_time:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_start_time()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_max_wait;
+        break;
+      }
+
+      // optional int64 max_wait = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_max_wait:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_wait_)));
+          set_has_max_wait();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.WatchRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.WatchRequest)
+  return false;
+#undef DO_
+}
+
+void WatchRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.WatchRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bytes limit_key = 2;
+  if (has_limit_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->limit_key(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp start_time = 3;
+  if (has_start_time()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->start_time(), output);
+  }
+
+  // optional int64 max_wait = 4;
+  if (has_max_wait()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->max_wait(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.WatchRequest)
+}
+
+::google::protobuf::uint8* WatchRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.WatchRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bytes limit_key = 2;
+  if (has_limit_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->limit_key(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp start_time = 3;
+  if (has_start_time()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->start_time(), target);
+  }
+
+  // optional int64 max_wait = 4;
+  if (has_max_wait()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->max_wait(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.WatchRequest)
+  return target;
+}
+
+int WatchRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes limit_key = 2;
+    if (has_limit_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->limit_key());
+    }
+
+    // optional .cockroach.proto.Timestamp start_time = 3;
+    if (has_start_time()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->start_time());
+    }
+
+    // optional int64 max_wait = 4;
+    if (has_max_wait()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_wait());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void WatchRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const WatchRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const WatchRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void WatchRequest::MergeFrom(const WatchRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_limit_key()) {
+      set_limit_key(from.limit_key());
+    }
+    if (from.has_start_time()) {
+      mutable_start_time()->::cockroach::proto::Timestamp::MergeFrom(from.start_time());
+    }
+    if (from.has_max_wait()) {
+      set_max_wait(from.max_wait());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void WatchRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void WatchRequest::CopyFrom(const WatchRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool WatchRequest::IsInitialized() const {
+
+  return true;
+}
+
+void WatchRequest::Swap(WatchRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(limit_key_, other->limit_key_);
+    std::swap(start_time_, other->start_time_);
+    std::swap(max_wait_, other->max_wait_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata WatchRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = WatchRequest_descriptor_;
+  metadata.reflection = WatchRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int WatchEvent::kKeyFieldNumber;
+const int WatchEvent::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+WatchEvent::WatchEvent()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.WatchEvent)
+}
+
+void WatchEvent::InitAsDefaultInstance() {
+  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
+}
+
+WatchEvent::WatchEvent(const WatchEvent& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.WatchEvent)
+}
+
+void WatchEvent::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+WatchEvent::~WatchEvent() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.WatchEvent)
+  SharedDtor();
+}
+
+void WatchEvent::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (this != default_instance_) {
+    delete value_;
+  }
+}
+
+void WatchEvent::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* WatchEvent::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return WatchEvent_descriptor_;
+}
+
+const WatchEvent& WatchEvent::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+WatchEvent* WatchEvent::default_instance_ = NULL;
+
+WatchEvent* WatchEvent::New() const {
+  return new WatchEvent;
+}
+
+void WatchEvent::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool WatchEvent::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.WatchEvent)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.proto.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.WatchEvent)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.WatchEvent)
+  return false;
+#undef DO_
+}
+
+void WatchEvent::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.WatchEvent)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->value(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.WatchEvent)
+}
+
+::google::protobuf::uint8* WatchEvent::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.WatchEvent)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->value(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.WatchEvent)
+  return target;
+}
+
+int WatchEvent::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional .cockroach.proto.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void WatchEvent::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const WatchEvent* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const WatchEvent*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void WatchEvent::MergeFrom(const WatchEvent& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void WatchEvent::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void WatchEvent::CopyFrom(const WatchEvent& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool WatchEvent::IsInitialized() const {
+
+  return true;
+}
+
+void WatchEvent::Swap(WatchEvent* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(value_, other->value_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata WatchEvent::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = WatchEvent_descriptor_;
+  metadata.reflection = WatchEvent_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int WatchResponse::kHeaderFieldNumber;
+const int WatchResponse::kEventsFieldNumber;
+const int WatchResponse::kEndKeyFieldNumber;
+#endif  // !_MSC_VER
+
+WatchResponse::WatchResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.WatchResponse)
+}
+
+void WatchResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+WatchResponse::WatchResponse(const WatchResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.WatchResponse)
+}
+
+void WatchResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+WatchResponse::~WatchResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.WatchResponse)
+  SharedDtor();
+}
+
+void WatchResponse::SharedDtor() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void WatchResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* WatchResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return WatchResponse_descriptor_;
+}
+
+const WatchResponse& WatchResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+WatchResponse* WatchResponse::default_instance_ = NULL;
+
+WatchResponse* WatchResponse::New() const {
+  return new WatchResponse;
+}
+
+void WatchResponse::Clear() {
+  if (_has_bits_[0 / 32] & 5) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+  }
+  events_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool WatchResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.WatchResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_events;
+        break;
+      }
+
+      // repeated .cockroach.proto.WatchEvent events = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_events:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_events()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_events;
+        if (input->ExpectTag(26)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.WatchResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.WatchResponse)
+  return false;
+#undef DO_
+}
+
+void WatchResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.WatchResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .cockroach.proto.WatchEvent events = 2;
+  for (int i = 0; i < this->events_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->events(i), output);
+  }
+
+  // optional bytes end_key = 3;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->end_key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.WatchResponse)
+}
+
+::google::protobuf::uint8* WatchResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.WatchResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .cockroach.proto.WatchEvent events = 2;
+  for (int i = 0; i < this->events_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->events(i), target);
+  }
+
+  // optional bytes end_key = 3;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->end_key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.WatchResponse)
+  return target;
+}
+
+int WatchResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes end_key = 3;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+  }
+  // repeated .cockroach.proto.WatchEvent events = 2;
+  total_size += 1 * this->events_size();
+  for (int i = 0; i < this->events_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->events(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void WatchResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const WatchResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const WatchResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void WatchResponse::MergeFrom(const WatchResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  events_.MergeFrom(from.events_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void WatchResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void WatchResponse::CopyFrom(const WatchResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool WatchResponse::IsInitialized() const {
+
+  return true;
+}
+
+void WatchResponse::Swap(WatchResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    events_.Swap(&other->events_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata WatchResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = WatchResponse_descriptor_;
+  metadata.reflection = WatchResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EndTransactionRequest::kHeaderFieldNumber;
+const int EndTransactionRequest::kCommitFieldNumber;
+const int EndTransactionRequest::kInternalCommitTriggerFieldNumber;
+const int EndTransactionRequest::kRefreshedTimestampFieldNumber;
+#endif  // !_MSC_VER
+
+EndTransactionRequest::EndTransactionRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionRequest)
+}
+
+void EndTransactionRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  internal_commit_trigger_ = const_cast< ::cockroach::proto::InternalCommitTrigger*>(&::cockroach::proto::InternalCommitTrigger::default_instance());
+  refreshed_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+EndTransactionRequest::EndTransactionRequest(const EndTransactionRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionRequest)
+}
+
+void EndTransactionRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  commit_ = false;
+  internal_commit_trigger_ = NULL;
+  refreshed_timestamp_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EndTransactionRequest::~EndTransactionRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionRequest)
+  SharedDtor();
+}
+
+void EndTransactionRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete internal_commit_trigger_;
+    delete refreshed_timestamp_;
+  }
+}
+
+void EndTransactionRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EndTransactionRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EndTransactionRequest_descriptor_;
+}
+
+const EndTransactionRequest& EndTransactionRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EndTransactionRequest* EndTransactionRequest::default_instance_ = NULL;
+
+EndTransactionRequest* EndTransactionRequest::New() const {
+  return new EndTransactionRequest;
+}
+
+void EndTransactionRequest::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    commit_ = false;
+    if (has_internal_commit_trigger()) {
+      if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+    }
+    if (has_refreshed_timestamp()) {
+      if (refreshed_timestamp_ != NULL) refreshed_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool EndTransactionRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_commit;
+        break;
+      }
+
+      // optional bool commit = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_commit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &commit_)));
+          set_has_commit();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_internal_commit_trigger;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_internal_commit_trigger:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_commit_trigger()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_refreshed_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp refreshed_timestamp = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_refreshed_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_refreshed_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionRequest)
+  return false;
+#undef DO_
+}
+
+void EndTransactionRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bool commit = 2;
+  if (has_commit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->commit(), output);
+  }
+
+  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+  if (has_internal_commit_trigger()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->internal_commit_trigger(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp refreshed_timestamp = 4;
+  if (has_refreshed_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->refreshed_timestamp(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionRequest)
+}
+
+::google::protobuf::uint8* EndTransactionRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bool commit = 2;
+  if (has_commit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->commit(), target);
+  }
+
+  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+  if (has_internal_commit_trigger()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->internal_commit_trigger(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp refreshed_timestamp = 4;
+  if (has_refreshed_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->refreshed_timestamp(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionRequest)
+  return target;
+}
+
+int EndTransactionRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bool commit = 2;
+    if (has_commit()) {
+      total_size += 1 + 1;
+    }
+
+    // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+    if (has_internal_commit_trigger()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_commit_trigger());
+    }
+
+    // optional .cockroach.proto.Timestamp refreshed_timestamp = 4;
+    if (has_refreshed_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->refreshed_timestamp());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const EndTransactionRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_commit()) {
+      set_commit(from.commit());
+    }
+    if (from.has_internal_commit_trigger()) {
+      mutable_internal_commit_trigger()->::cockroach::proto::InternalCommitTrigger::MergeFrom(from.internal_commit_trigger());
+    }
+    if (from.has_refreshed_timestamp()) {
+      mutable_refreshed_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.refreshed_timestamp());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void EndTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EndTransactionRequest::CopyFrom(const EndTransactionRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EndTransactionRequest::IsInitialized() const {
+
+  return true;
+}
+
+void EndTransactionRequest::Swap(EndTransactionRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(commit_, other->commit_);
+    std::swap(internal_commit_trigger_, other->internal_commit_trigger_);
+    std::swap(refreshed_timestamp_, other->refreshed_timestamp_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata EndTransactionRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EndTransactionRequest_descriptor_;
+  metadata.reflection = EndTransactionRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EndTransactionResponse::kHeaderFieldNumber;
+const int EndTransactionResponse::kCommitWaitFieldNumber;
+const int EndTransactionResponse::kResolvedFieldNumber;
+#endif  // !_MSC_VER
+
+EndTransactionResponse::EndTransactionResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EndTransactionResponse)
+}
+
+void EndTransactionResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+EndTransactionResponse::EndTransactionResponse(const EndTransactionResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EndTransactionResponse)
+}
+
+void EndTransactionResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EndTransactionResponse::~EndTransactionResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EndTransactionResponse)
+  SharedDtor();
+}
+
+void EndTransactionResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void EndTransactionResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EndTransactionResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EndTransactionResponse_descriptor_;
+}
+
+const EndTransactionResponse& EndTransactionResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+EndTransactionResponse* EndTransactionResponse::default_instance_ = NULL;
+
+EndTransactionResponse* EndTransactionResponse::New() const {
+  return new EndTransactionResponse;
+}
+
+void EndTransactionResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    commit_wait_ = GOOGLE_LONGLONG(0);
+  }
+  resolved_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool EndTransactionResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EndTransactionResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_commit_wait;
+        break;
+      }
+
+      // optional int64 commit_wait = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_commit_wait:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &commit_wait_)));
+          set_has_commit_wait();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_resolved;
+        break;
+      }
+
+      // repeated bytes resolved = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_resolved:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_resolved()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_resolved;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EndTransactionResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EndTransactionResponse)
+  return false;
+#undef DO_
+}
+
+void EndTransactionResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EndTransactionResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 commit_wait = 2;
+  if (has_commit_wait()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->commit_wait(), output);
+  }
+
+  // repeated bytes resolved = 3;
+  for (int i = 0; i < this->resolved_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      3, this->resolved(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EndTransactionResponse)
+}
+
+::google::protobuf::uint8* EndTransactionResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EndTransactionResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 commit_wait = 2;
+  if (has_commit_wait()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->commit_wait(), target);
+  }
+
+  // repeated bytes resolved = 3;
+  for (int i = 0; i < this->resolved_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(3, this->resolved(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EndTransactionResponse)
+  return target;
+}
+
+int EndTransactionResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 commit_wait = 2;
+    if (has_commit_wait()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->commit_wait());
+    }
+
+  }
+  // repeated bytes resolved = 3;
+  total_size += 1 * this->resolved_size();
+  for (int i = 0; i < this->resolved_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->resolved(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const EndTransactionResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EndTransactionResponse::MergeFrom(const EndTransactionResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  resolved_.MergeFrom(from.resolved_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_commit_wait()) {
+      set_commit_wait(from.commit_wait());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void EndTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EndTransactionResponse::CopyFrom(const EndTransactionResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EndTransactionResponse::IsInitialized() const {
+
+  return true;
+}
+
+void EndTransactionResponse::Swap(EndTransactionResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(commit_wait_, other->commit_wait_);
+    resolved_.Swap(&other->resolved_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata EndTransactionResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EndTransactionResponse_descriptor_;
+  metadata.reflection = EndTransactionResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RequestUnion::kContainsFieldNumber;
+const int RequestUnion::kGetFieldNumber;
+const int RequestUnion::kPutFieldNumber;
+const int RequestUnion::kConditionalPutFieldNumber;
+const int RequestUnion::kIncrementFieldNumber;
+const int RequestUnion::kDeleteFieldNumber;
+const int RequestUnion::kDeleteRangeFieldNumber;
+const int RequestUnion::kScanFieldNumber;
+const int RequestUnion::kEndTransactionFieldNumber;
+const int RequestUnion::kInitPutFieldNumber;
+const int RequestUnion::kAggregateFieldNumber;
+#endif  // !_MSC_VER
+
+RequestUnion::RequestUnion()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RequestUnion)
+}
+
+void RequestUnion::InitAsDefaultInstance() {
+  RequestUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsRequest*>(&::cockroach::proto::ContainsRequest::default_instance());
+  RequestUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
+  RequestUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
+  RequestUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
+  RequestUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
+  RequestUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
+  RequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
+  RequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
+  RequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  RequestUnion_default_oneof_instance_->init_put_ = const_cast< ::cockroach::proto::InitPutRequest*>(&::cockroach::proto::InitPutRequest::default_instance());
+  RequestUnion_default_oneof_instance_->aggregate_ = const_cast< ::cockroach::proto::AggregateRequest*>(&::cockroach::proto::AggregateRequest::default_instance());
+}
+
+RequestUnion::RequestUnion(const RequestUnion& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RequestUnion)
+}
+
+void RequestUnion::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+RequestUnion::~RequestUnion() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RequestUnion)
+  SharedDtor();
+}
+
+void RequestUnion::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void RequestUnion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RequestUnion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RequestUnion_descriptor_;
+}
+
+const RequestUnion& RequestUnion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+RequestUnion* RequestUnion::default_instance_ = NULL;
+
+RequestUnion* RequestUnion::New() const {
+  return new RequestUnion;
+}
+
+void RequestUnion::clear_value() {
+  switch(value_case()) {
+    case kContains: {
+      delete value_.contains_;
+      break;
+    }
+    case kGet: {
+      delete value_.get_;
+      break;
+    }
+    case kPut: {
+      delete value_.put_;
+      break;
+    }
+    case kConditionalPut: {
+      delete value_.conditional_put_;
+      break;
+    }
+    case kIncrement: {
+      delete value_.increment_;
+      break;
+    }
+    case kDelete: {
+      delete value_.delete__;
+      break;
+    }
+    case kDeleteRange: {
+      delete value_.delete_range_;
+      break;
+    }
+    case kScan: {
+      delete value_.scan_;
+      break;
+    }
+    case kEndTransaction: {
+      delete value_.end_transaction_;
+      break;
+    }
+    case kInitPut: {
+      delete value_.init_put_;
+      break;
+    }
+    case kAggregate: {
+      delete value_.aggregate_;
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+
+
+void RequestUnion::Clear() {
+  clear_value();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool RequestUnion::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RequestUnion)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ContainsRequest contains = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_contains()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_get;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRequest get = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_get:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_put;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRequest put = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_conditional_put;
+        break;
+      }
+
+      // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_conditional_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_increment;
+        break;
+      }
+
+      // optional .cockroach.proto.IncrementRequest increment = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRequest delete = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRequest scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_init_put;
+        break;
+      }
+
+      // optional .cockroach.proto.InitPutRequest init_put = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_init_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_init_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_aggregate;
+        break;
+      }
+
+      // optional .cockroach.proto.AggregateRequest aggregate = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_aggregate:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_aggregate()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RequestUnion)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RequestUnion)
+  return false;
+#undef DO_
+}
+
+void RequestUnion::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RequestUnion)
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  if (has_contains()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->contains(), output);
+  }
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  if (has_get()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->get(), output);
+  }
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  if (has_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->put(), output);
+  }
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  if (has_conditional_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->conditional_put(), output);
+  }
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  if (has_increment()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->increment(), output);
+  }
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  if (has_delete_()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->delete_(), output);
+  }
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  if (has_delete_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->delete_range(), output);
+  }
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  if (has_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->scan(), output);
+  }
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  if (has_end_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->end_transaction(), output);
+  }
+
+  // optional .cockroach.proto.InitPutRequest init_put = 10;
+  if (has_init_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->init_put(), output);
+  }
+
+  // optional .cockroach.proto.AggregateRequest aggregate = 11;
+  if (has_aggregate()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->aggregate(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RequestUnion)
+}
+
+::google::protobuf::uint8* RequestUnion::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RequestUnion)
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  if (has_contains()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->contains(), target);
+  }
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  if (has_get()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->get(), target);
+  }
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  if (has_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->put(), target);
+  }
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  if (has_conditional_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->conditional_put(), target);
+  }
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  if (has_increment()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->increment(), target);
+  }
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  if (has_delete_()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->delete_(), target);
+  }
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  if (has_delete_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->delete_range(), target);
+  }
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  if (has_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->scan(), target);
+  }
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  if (has_end_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->end_transaction(), target);
+  }
+
+  // optional .cockroach.proto.InitPutRequest init_put = 10;
+  if (has_init_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->init_put(), target);
+  }
+
+  // optional .cockroach.proto.AggregateRequest aggregate = 11;
+  if (has_aggregate()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->aggregate(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RequestUnion)
+  return target;
+}
+
+int RequestUnion::ByteSize() const {
+  int total_size = 0;
+
+  switch (value_case()) {
+    // optional .cockroach.proto.ContainsRequest contains = 1;
+    case kContains: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->contains());
+      break;
+    }
+    // optional .cockroach.proto.GetRequest get = 2;
+    case kGet: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->get());
+      break;
+    }
+    // optional .cockroach.proto.PutRequest put = 3;
+    case kPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->put());
+      break;
+    }
+    // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+    case kConditionalPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_put());
+      break;
+    }
+    // optional .cockroach.proto.IncrementRequest increment = 5;
+    case kIncrement: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->increment());
+      break;
+    }
+    // optional .cockroach.proto.DeleteRequest delete = 6;
+    case kDelete: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_());
+      break;
+    }
+    // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+    case kDeleteRange: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_range());
+      break;
+    }
+    // optional .cockroach.proto.ScanRequest scan = 8;
+    case kScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->scan());
+      break;
+    }
+    // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+    case kEndTransaction: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->end_transaction());
+      break;
+    }
+    // optional .cockroach.proto.InitPutRequest init_put = 10;
+    case kInitPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->init_put());
+      break;
+    }
+    // optional .cockroach.proto.AggregateRequest aggregate = 11;
+    case kAggregate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->aggregate());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RequestUnion::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const RequestUnion* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RequestUnion*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RequestUnion::MergeFrom(const RequestUnion& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  switch (from.value_case()) {
+    case kContains: {
+      mutable_contains()->::cockroach::proto::ContainsRequest::MergeFrom(from.contains());
+      break;
+    }
+    case kGet: {
+      mutable_get()->::cockroach::proto::GetRequest::MergeFrom(from.get());
+      break;
+    }
+    case kPut: {
+      mutable_put()->::cockroach::proto::PutRequest::MergeFrom(from.put());
+      break;
+    }
+    case kConditionalPut: {
+      mutable_conditional_put()->::cockroach::proto::ConditionalPutRequest::MergeFrom(from.conditional_put());
+      break;
+    }
+    case kIncrement: {
+      mutable_increment()->::cockroach::proto::IncrementRequest::MergeFrom(from.increment());
+      break;
+    }
+    case kDelete: {
+      mutable_delete_()->::cockroach::proto::DeleteRequest::MergeFrom(from.delete_());
+      break;
+    }
+    case kDeleteRange: {
+      mutable_delete_range()->::cockroach::proto::DeleteRangeRequest::MergeFrom(from.delete_range());
+      break;
+    }
+    case kScan: {
+      mutable_scan()->::cockroach::proto::ScanRequest::MergeFrom(from.scan());
+      break;
+    }
+    case kEndTransaction: {
+      mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
+      break;
+    }
+    case kInitPut: {
+      mutable_init_put()->::cockroach::proto::InitPutRequest::MergeFrom(from.init_put());
+      break;
+    }
+    case kAggregate: {
+      mutable_aggregate()->::cockroach::proto::AggregateRequest::MergeFrom(from.aggregate());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void RequestUnion::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RequestUnion::CopyFrom(const RequestUnion& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RequestUnion::IsInitialized() const {
+
+  return true;
+}
+
+void RequestUnion::Swap(RequestUnion* other) {
+  if (other != this) {
+    std::swap(value_, other->value_);
+    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata RequestUnion::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RequestUnion_descriptor_;
+  metadata.reflection = RequestUnion_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ResponseUnion::kContainsFieldNumber;
+const int ResponseUnion::kGetFieldNumber;
+const int ResponseUnion::kPutFieldNumber;
+const int ResponseUnion::kConditionalPutFieldNumber;
+const int ResponseUnion::kIncrementFieldNumber;
+const int ResponseUnion::kDeleteFieldNumber;
+const int ResponseUnion::kDeleteRangeFieldNumber;
+const int ResponseUnion::kScanFieldNumber;
+const int ResponseUnion::kEndTransactionFieldNumber;
+const int ResponseUnion::kInitPutFieldNumber;
+const int ResponseUnion::kAggregateFieldNumber;
+#endif  // !_MSC_VER
+
+ResponseUnion::ResponseUnion()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ResponseUnion)
+}
+
+void ResponseUnion::InitAsDefaultInstance() {
+  ResponseUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsResponse*>(&::cockroach::proto::ContainsResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetResponse*>(&::cockroach::proto::GetResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutResponse*>(&::cockroach::proto::PutResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutResponse*>(&::cockroach::proto::ConditionalPutResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementResponse*>(&::cockroach::proto::IncrementResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteResponse*>(&::cockroach::proto::DeleteResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanResponse*>(&::cockroach::proto::ScanResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->init_put_ = const_cast< ::cockroach::proto::InitPutResponse*>(&::cockroach::proto::InitPutResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->aggregate_ = const_cast< ::cockroach::proto::AggregateResponse*>(&::cockroach::proto::AggregateResponse::default_instance());
+}
+
+ResponseUnion::ResponseUnion(const ResponseUnion& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ResponseUnion)
+}
+
+void ResponseUnion::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+ResponseUnion::~ResponseUnion() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ResponseUnion)
+  SharedDtor();
+}
+
+void ResponseUnion::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void ResponseUnion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ResponseUnion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ResponseUnion_descriptor_;
+}
+
+const ResponseUnion& ResponseUnion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ResponseUnion* ResponseUnion::default_instance_ = NULL;
+
+ResponseUnion* ResponseUnion::New() const {
+  return new ResponseUnion;
+}
+
+void ResponseUnion::clear_value() {
+  switch(value_case()) {
+    case kContains: {
+      delete value_.contains_;
+      break;
+    }
+    case kGet: {
+      delete value_.get_;
+      break;
+    }
+    case kPut: {
+      delete value_.put_;
+      break;
+    }
+    case kConditionalPut: {
+      delete value_.conditional_put_;
+      break;
+    }
+    case kIncrement: {
+      delete value_.increment_;
+      break;
+    }
+    case kDelete: {
+      delete value_.delete__;
+      break;
+    }
+    case kDeleteRange: {
+      delete value_.delete_range_;
+      break;
+    }
+    case kScan: {
+      delete value_.scan_;
+      break;
+    }
+    case kEndTransaction: {
+      delete value_.end_transaction_;
+      break;
+    }
+    case kInitPut: {
+      delete value_.init_put_;
+      break;
+    }
+    case kAggregate: {
+      delete value_.aggregate_;
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+
+
+void ResponseUnion::Clear() {
+  clear_value();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ResponseUnion::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ResponseUnion)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ContainsResponse contains = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_contains()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_get;
+        break;
+      }
+
+      // optional .cockroach.proto.GetResponse get = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_get:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_put;
+        break;
+      }
+
+      // optional .cockroach.proto.PutResponse put = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_conditional_put;
+        break;
+      }
+
+      // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_conditional_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_increment;
+        break;
+      }
+
+      // optional .cockroach.proto.IncrementResponse increment = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteResponse delete = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanResponse scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_init_put;
+        break;
+      }
+
+      // optional .cockroach.proto.InitPutResponse init_put = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_init_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_init_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_aggregate;
+        break;
+      }
+
+      // optional .cockroach.proto.AggregateResponse aggregate = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_aggregate:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_aggregate()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ResponseUnion)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ResponseUnion)
+  return false;
+#undef DO_
+}
+
+void ResponseUnion::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ResponseUnion)
+  // optional .cockroach.proto.ContainsResponse contains = 1;
+  if (has_contains()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->contains(), output);
+  }
+
+  // optional .cockroach.proto.GetResponse get = 2;
+  if (has_get()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->get(), output);
+  }
+
+  // optional .cockroach.proto.PutResponse put = 3;
+  if (has_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->put(), output);
+  }
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+  if (has_conditional_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->conditional_put(), output);
+  }
+
+  // optional .cockroach.proto.IncrementResponse increment = 5;
+  if (has_increment()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->increment(), output);
+  }
+
+  // optional .cockroach.proto.DeleteResponse delete = 6;
+  if (has_delete_()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->delete_(), output);
+  }
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+  if (has_delete_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->delete_range(), output);
+  }
+
+  // optional .cockroach.proto.ScanResponse scan = 8;
+  if (has_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->scan(), output);
+  }
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+  if (has_end_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->end_transaction(), output);
+  }
+
+  // optional .cockroach.proto.InitPutResponse init_put = 10;
+  if (has_init_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->init_put(), output);
+  }
+
+  // optional .cockroach.proto.AggregateResponse aggregate = 11;
+  if (has_aggregate()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->aggregate(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ResponseUnion)
+}
+
+::google::protobuf::uint8* ResponseUnion::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ResponseUnion)
+  // optional .cockroach.proto.ContainsResponse contains = 1;
+  if (has_contains()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->contains(), target);
+  }
+
+  // optional .cockroach.proto.GetResponse get = 2;
+  if (has_get()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->get(), target);
+  }
+
+  // optional .cockroach.proto.PutResponse put = 3;
+  if (has_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->put(), target);
+  }
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+  if (has_conditional_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->conditional_put(), target);
+  }
+
+  // optional .cockroach.proto.IncrementResponse increment = 5;
+  if (has_increment()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->increment(), target);
+  }
+
+  // optional .cockroach.proto.DeleteResponse delete = 6;
+  if (has_delete_()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->delete_(), target);
+  }
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+  if (has_delete_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->delete_range(), target);
+  }
+
+  // optional .cockroach.proto.ScanResponse scan = 8;
+  if (has_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->scan(), target);
+  }
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+  if (has_end_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->end_transaction(), target);
+  }
+
+  // optional .cockroach.proto.InitPutResponse init_put = 10;
+  if (has_init_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->init_put(), target);
+  }
+
+  // optional .cockroach.proto.AggregateResponse aggregate = 11;
+  if (has_aggregate()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->aggregate(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ResponseUnion)
+  return target;
+}
+
+int ResponseUnion::ByteSize() const {
+  int total_size = 0;
+
+  switch (value_case()) {
+    // optional .cockroach.proto.ContainsResponse contains = 1;
+    case kContains: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->contains());
+      break;
+    }
+    // optional .cockroach.proto.GetResponse get = 2;
+    case kGet: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->get());
+      break;
+    }
+    // optional .cockroach.proto.PutResponse put = 3;
+    case kPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->put());
+      break;
+    }
+    // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+    case kConditionalPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_put());
+      break;
+    }
+    // optional .cockroach.proto.IncrementResponse increment = 5;
+    case kIncrement: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->increment());
+      break;
+    }
+    // optional .cockroach.proto.DeleteResponse delete = 6;
+    case kDelete: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_());
+      break;
+    }
+    // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+    case kDeleteRange: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_range());
+      break;
+    }
+    // optional .cockroach.proto.ScanResponse scan = 8;
+    case kScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->scan());
+      break;
+    }
+    // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+    case kEndTransaction: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->end_transaction());
+      break;
+    }
+    // optional .cockroach.proto.InitPutResponse init_put = 10;
+    case kInitPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->init_put());
+      break;
+    }
+    // optional .cockroach.proto.AggregateResponse aggregate = 11;
+    case kAggregate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->aggregate());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ResponseUnion::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ResponseUnion* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ResponseUnion*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ResponseUnion::MergeFrom(const ResponseUnion& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  switch (from.value_case()) {
+    case kContains: {
+      mutable_contains()->::cockroach::proto::ContainsResponse::MergeFrom(from.contains());
+      break;
+    }
+    case kGet: {
+      mutable_get()->::cockroach::proto::GetResponse::MergeFrom(from.get());
+      break;
+    }
+    case kPut: {
+      mutable_put()->::cockroach::proto::PutResponse::MergeFrom(from.put());
+      break;
+    }
+    case kConditionalPut: {
+      mutable_conditional_put()->::cockroach::proto::ConditionalPutResponse::MergeFrom(from.conditional_put());
+      break;
+    }
+    case kIncrement: {
+      mutable_increment()->::cockroach::proto::IncrementResponse::MergeFrom(from.increment());
+      break;
+    }
+    case kDelete: {
+      mutable_delete_()->::cockroach::proto::DeleteResponse::MergeFrom(from.delete_());
+      break;
+    }
+    case kDeleteRange: {
+      mutable_delete_range()->::cockroach::proto::DeleteRangeResponse::MergeFrom(from.delete_range());
+      break;
+    }
+    case kScan: {
+      mutable_scan()->::cockroach::proto::ScanResponse::MergeFrom(from.scan());
+      break;
+    }
+    case kEndTransaction: {
+      mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
+      break;
+    }
+    case kInitPut: {
+      mutable_init_put()->::cockroach::proto::InitPutResponse::MergeFrom(from.init_put());
+      break;
+    }
+    case kAggregate: {
+      mutable_aggregate()->::cockroach::proto::AggregateResponse::MergeFrom(from.aggregate());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ResponseUnion::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ResponseUnion::CopyFrom(const ResponseUnion& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ResponseUnion::IsInitialized() const {
+
+  return true;
+}
+
+void ResponseUnion::Swap(ResponseUnion* other) {
+  if (other != this) {
+    std::swap(value_, other->value_);
+    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ResponseUnion::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ResponseUnion_descriptor_;
+  metadata.reflection = ResponseUnion_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int BatchRequest::kHeaderFieldNumber;
+const int BatchRequest::kRequestsFieldNumber;
+#endif  // !_MSC_VER
+
+BatchRequest::BatchRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.BatchRequest)
+}
+
+void BatchRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+BatchRequest::BatchRequest(const BatchRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.BatchRequest)
+}
+
+void BatchRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+BatchRequest::~BatchRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.BatchRequest)
+  SharedDtor();
+}
+
+void BatchRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void BatchRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* BatchRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return BatchRequest_descriptor_;
+}
+
+const BatchRequest& BatchRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+BatchRequest* BatchRequest::default_instance_ = NULL;
+
+BatchRequest* BatchRequest::New() const {
+  return new BatchRequest;
+}
+
+void BatchRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  }
+  requests_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool BatchRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.BatchRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_requests;
+        break;
+      }
+
+      // repeated .cockroach.proto.RequestUnion requests = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_requests:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_requests()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_requests;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.BatchRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.BatchRequest)
+  return false;
+#undef DO_
+}
+
+void BatchRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.BatchRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .cockroach.proto.RequestUnion requests = 2;
+  for (int i = 0; i < this->requests_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->requests(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.BatchRequest)
+}
+
+::google::protobuf::uint8* BatchRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.BatchRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .cockroach.proto.RequestUnion requests = 2;
+  for (int i = 0; i < this->requests_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->requests(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.BatchRequest)
+  return target;
+}
+
+int BatchRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated .cockroach.proto.RequestUnion requests = 2;
+  total_size += 1 * this->requests_size();
+  for (int i = 0; i < this->requests_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->requests(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void BatchRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const BatchRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const BatchRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void BatchRequest::MergeFrom(const BatchRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  requests_.MergeFrom(from.requests_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void BatchRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void BatchRequest::CopyFrom(const BatchRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool BatchRequest::IsInitialized() const {
+
+  return true;
+}
+
+void BatchRequest::Swap(BatchRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    requests_.Swap(&other->requests_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata BatchRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = BatchRequest_descriptor_;
+  metadata.reflection = BatchRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int BatchResponse::kHeaderFieldNumber;
+const int BatchResponse::kResponsesFieldNumber;
+#endif  // !_MSC_VER
+
+BatchResponse::BatchResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.BatchResponse)
+}
+
+void BatchResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+BatchResponse::BatchResponse(const BatchResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.BatchResponse)
+}
+
+void BatchResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+BatchResponse::~BatchResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.BatchResponse)
+  SharedDtor();
+}
+
+void BatchResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void BatchResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* BatchResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return BatchResponse_descriptor_;
+}
+
+const BatchResponse& BatchResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+BatchResponse* BatchResponse::default_instance_ = NULL;
+
+BatchResponse* BatchResponse::New() const {
+  return new BatchResponse;
+}
+
+void BatchResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  responses_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool BatchResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.BatchResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_responses;
+        break;
+      }
+
+      // repeated .cockroach.proto.ResponseUnion responses = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_responses:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_responses()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_responses;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.BatchResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.BatchResponse)
+  return false;
+#undef DO_
+}
+
+void BatchResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.BatchResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .cockroach.proto.ResponseUnion responses = 2;
+  for (int i = 0; i < this->responses_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->responses(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.BatchResponse)
+}
+
+::google::protobuf::uint8* BatchResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.BatchResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .cockroach.proto.ResponseUnion responses = 2;
+  for (int i = 0; i < this->responses_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->responses(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.BatchResponse)
+  return target;
+}
+
+int BatchResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated .cockroach.proto.ResponseUnion responses = 2;
+  total_size += 1 * this->responses_size();
+  for (int i = 0; i < this->responses_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->responses(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void BatchResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const BatchResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const BatchResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void BatchResponse::MergeFrom(const BatchResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  responses_.MergeFrom(from.responses_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void BatchResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void BatchResponse::CopyFrom(const BatchResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool BatchResponse::IsInitialized() const {
+
+  return true;
+}
+
+void BatchResponse::Swap(BatchResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    responses_.Swap(&other->responses_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata BatchResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = BatchResponse_descriptor_;
+  metadata.reflection = BatchResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminSplitRequest::kHeaderFieldNumber;
+const int AdminSplitRequest::kSplitKeyFieldNumber;
+#endif  // !_MSC_VER
+
+AdminSplitRequest::AdminSplitRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminSplitRequest)
+}
+
+void AdminSplitRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+AdminSplitRequest::AdminSplitRequest(const AdminSplitRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminSplitRequest)
+}
+
+void AdminSplitRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminSplitRequest::~AdminSplitRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminSplitRequest)
+  SharedDtor();
+}
+
+void AdminSplitRequest::SharedDtor() {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete split_key_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminSplitRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminSplitRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminSplitRequest_descriptor_;
+}
+
+const AdminSplitRequest& AdminSplitRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminSplitRequest* AdminSplitRequest::default_instance_ = NULL;
+
+AdminSplitRequest* AdminSplitRequest::New() const {
+  return new AdminSplitRequest;
+}
+
+void AdminSplitRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_split_key()) {
+      if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        split_key_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminSplitRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminSplitRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_split_key;
+        break;
+      }
+
+      // optional bytes split_key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_split_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_split_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminSplitRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminSplitRequest)
+  return false;
+#undef DO_
+}
+
+void AdminSplitRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminSplitRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bytes split_key = 2;
+  if (has_split_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->split_key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminSplitRequest)
+}
+
+::google::protobuf::uint8* AdminSplitRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminSplitRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bytes split_key = 2;
+  if (has_split_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->split_key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminSplitRequest)
+  return target;
+}
+
+int AdminSplitRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes split_key = 2;
+    if (has_split_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->split_key());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminSplitRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminSplitRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminSplitRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminSplitRequest::MergeFrom(const AdminSplitRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_split_key()) {
+      set_split_key(from.split_key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminSplitRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminSplitRequest::CopyFrom(const AdminSplitRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminSplitRequest::IsInitialized() const {
+
+  return true;
+}
+
+void AdminSplitRequest::Swap(AdminSplitRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(split_key_, other->split_key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminSplitRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminSplitRequest_descriptor_;
+  metadata.reflection = AdminSplitRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminSplitResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+AdminSplitResponse::AdminSplitResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminSplitResponse)
+}
+
+void AdminSplitResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+AdminSplitResponse::AdminSplitResponse(const AdminSplitResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminSplitResponse)
+}
+
+void AdminSplitResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminSplitResponse::~AdminSplitResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminSplitResponse)
+  SharedDtor();
+}
+
+void AdminSplitResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminSplitResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminSplitResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminSplitResponse_descriptor_;
+}
+
+const AdminSplitResponse& AdminSplitResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminSplitResponse* AdminSplitResponse::default_instance_ = NULL;
+
+AdminSplitResponse* AdminSplitResponse::New() const {
+  return new AdminSplitResponse;
+}
+
+void AdminSplitResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminSplitResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminSplitResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminSplitResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminSplitResponse)
+  return false;
+#undef DO_
+}
+
+void AdminSplitResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminSplitResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminSplitResponse)
+}
+
+::google::protobuf::uint8* AdminSplitResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminSplitResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminSplitResponse)
+  return target;
+}
+
+int AdminSplitResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminSplitResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminSplitResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminSplitResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminSplitResponse::MergeFrom(const AdminSplitResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminSplitResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminSplitResponse::CopyFrom(const AdminSplitResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminSplitResponse::IsInitialized() const {
+
+  return true;
+}
+
+void AdminSplitResponse::Swap(AdminSplitResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminSplitResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminSplitResponse_descriptor_;
+  metadata.reflection = AdminSplitResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminMergeRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+AdminMergeRequest::AdminMergeRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminMergeRequest)
+}
+
+void AdminMergeRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+AdminMergeRequest::AdminMergeRequest(const AdminMergeRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminMergeRequest)
+}
+
+void AdminMergeRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminMergeRequest::~AdminMergeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminMergeRequest)
+  SharedDtor();
+}
+
+void AdminMergeRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminMergeRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminMergeRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminMergeRequest_descriptor_;
+}
+
+const AdminMergeRequest& AdminMergeRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminMergeRequest* AdminMergeRequest::default_instance_ = NULL;
+
+AdminMergeRequest* AdminMergeRequest::New() const {
+  return new AdminMergeRequest;
+}
+
+void AdminMergeRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminMergeRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminMergeRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminMergeRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminMergeRequest)
+  return false;
+#undef DO_
+}
+
+void AdminMergeRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminMergeRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminMergeRequest)
+}
+
+::google::protobuf::uint8* AdminMergeRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminMergeRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminMergeRequest)
+  return target;
+}
+
+int AdminMergeRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminMergeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminMergeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminMergeRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminMergeRequest::MergeFrom(const AdminMergeRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminMergeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminMergeRequest::CopyFrom(const AdminMergeRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminMergeRequest::IsInitialized() const {
+
+  return true;
+}
+
+void AdminMergeRequest::Swap(AdminMergeRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminMergeRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminMergeRequest_descriptor_;
+  metadata.reflection = AdminMergeRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminMergeResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+AdminMergeResponse::AdminMergeResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminMergeResponse)
+}
+
+void AdminMergeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+AdminMergeResponse::AdminMergeResponse(const AdminMergeResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminMergeResponse)
+}
+
+void AdminMergeResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminMergeResponse::~AdminMergeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminMergeResponse)
+  SharedDtor();
+}
+
+void AdminMergeResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminMergeResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminMergeResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminMergeResponse_descriptor_;
+}
+
+const AdminMergeResponse& AdminMergeResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminMergeResponse* AdminMergeResponse::default_instance_ = NULL;
+
+AdminMergeResponse* AdminMergeResponse::New() const {
+  return new AdminMergeResponse;
+}
+
+void AdminMergeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminMergeResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminMergeResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminMergeResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminMergeResponse)
+  return false;
+#undef DO_
+}
+
+void AdminMergeResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminMergeResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminMergeResponse)
+}
+
+::google::protobuf::uint8* AdminMergeResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminMergeResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminMergeResponse)
+  return target;
+}
+
+int AdminMergeResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminMergeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminMergeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminMergeResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminMergeResponse::MergeFrom(const AdminMergeResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminMergeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminMergeResponse::CopyFrom(const AdminMergeResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminMergeResponse::IsInitialized() const {
+
+  return true;
+}
+
+void AdminMergeResponse::Swap(AdminMergeResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminMergeResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminMergeResponse_descriptor_;
+  metadata.reflection = AdminMergeResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminBulkLoadRequest::kHeaderFieldNumber;
+const int AdminBulkLoadRequest::kRowsFieldNumber;
+#endif  // !_MSC_VER
+
+AdminBulkLoadRequest::AdminBulkLoadRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminBulkLoadRequest)
+}
+
+void AdminBulkLoadRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+AdminBulkLoadRequest::AdminBulkLoadRequest(const AdminBulkLoadRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminBulkLoadRequest)
+}
+
+void AdminBulkLoadRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminBulkLoadRequest::~AdminBulkLoadRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminBulkLoadRequest)
+  SharedDtor();
+}
+
+void AdminBulkLoadRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminBulkLoadRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminBulkLoadRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminBulkLoadRequest_descriptor_;
+}
+
+const AdminBulkLoadRequest& AdminBulkLoadRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminBulkLoadRequest* AdminBulkLoadRequest::default_instance_ = NULL;
+
+AdminBulkLoadRequest* AdminBulkLoadRequest::New() const {
+  return new AdminBulkLoadRequest;
+}
+
+void AdminBulkLoadRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  }
+  rows_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminBulkLoadRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminBulkLoadRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        break;
+      }
+
+      // repeated .cockroach.proto.KeyValue rows = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminBulkLoadRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminBulkLoadRequest)
+  return false;
+#undef DO_
+}
+
+void AdminBulkLoadRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminBulkLoadRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminBulkLoadRequest)
+}
+
+::google::protobuf::uint8* AdminBulkLoadRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminBulkLoadRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminBulkLoadRequest)
+  return target;
+}
+
+int AdminBulkLoadRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminBulkLoadRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminBulkLoadRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminBulkLoadRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminBulkLoadRequest::MergeFrom(const AdminBulkLoadRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  rows_.MergeFrom(from.rows_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminBulkLoadRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminBulkLoadRequest::CopyFrom(const AdminBulkLoadRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminBulkLoadRequest::IsInitialized() const {
+
+  return true;
+}
+
+void AdminBulkLoadRequest::Swap(AdminBulkLoadRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    rows_.Swap(&other->rows_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminBulkLoadRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminBulkLoadRequest_descriptor_;
+  metadata.reflection = AdminBulkLoadRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminBulkLoadResponse::kHeaderFieldNumber;
+const int AdminBulkLoadResponse::kLoadTimestampFieldNumber;
+#endif  // !_MSC_VER
+
+AdminBulkLoadResponse::AdminBulkLoadResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminBulkLoadResponse)
+}
+
+void AdminBulkLoadResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  load_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+AdminBulkLoadResponse::AdminBulkLoadResponse(const AdminBulkLoadResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminBulkLoadResponse)
+}
+
+void AdminBulkLoadResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  load_timestamp_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminBulkLoadResponse::~AdminBulkLoadResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminBulkLoadResponse)
+  SharedDtor();
+}
+
+void AdminBulkLoadResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete load_timestamp_;
+  }
+}
+
+void AdminBulkLoadResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminBulkLoadResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminBulkLoadResponse_descriptor_;
+}
+
+const AdminBulkLoadResponse& AdminBulkLoadResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminBulkLoadResponse* AdminBulkLoadResponse::default_instance_ = NULL;
+
+AdminBulkLoadResponse* AdminBulkLoadResponse::New() const {
+  return new AdminBulkLoadResponse;
+}
+
+void AdminBulkLoadResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_load_timestamp()) {
+      if (load_timestamp_ != NULL) load_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminBulkLoadResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminBulkLoadResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_load_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp load_timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_load_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_load_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminBulkLoadResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminBulkLoadResponse)
+  return false;
+#undef DO_
+}
+
+void AdminBulkLoadResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminBulkLoadResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp load_timestamp = 2;
+  if (has_load_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->load_timestamp(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminBulkLoadResponse)
+}
+
+::google::protobuf::uint8* AdminBulkLoadResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminBulkLoadResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        5, this->increment(), target);
+        1, this->header(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp load_timestamp = 2;
+  if (has_load_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->load_timestamp(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminBulkLoadResponse)
+  return target;
+}
+
+int AdminBulkLoadResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.Timestamp load_timestamp = 2;
+    if (has_load_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->load_timestamp());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminBulkLoadResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminBulkLoadResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminBulkLoadResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminBulkLoadResponse::MergeFrom(const AdminBulkLoadResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_load_timestamp()) {
+      mutable_load_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.load_timestamp());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminBulkLoadResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminBulkLoadResponse::CopyFrom(const AdminBulkLoadResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminBulkLoadResponse::IsInitialized() const {
+
+  return true;
+}
+
+void AdminBulkLoadResponse::Swap(AdminBulkLoadResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(load_timestamp_, other->load_timestamp_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminBulkLoadResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminBulkLoadResponse_descriptor_;
+  metadata.reflection = AdminBulkLoadResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminTransferLeaseRequest::kHeaderFieldNumber;
+const int AdminTransferLeaseRequest::kStoreIdFieldNumber;
+#endif  // !_MSC_VER
+
+AdminTransferLeaseRequest::AdminTransferLeaseRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminTransferLeaseRequest)
+}
+
+void AdminTransferLeaseRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+AdminTransferLeaseRequest::AdminTransferLeaseRequest(const AdminTransferLeaseRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminTransferLeaseRequest)
+}
+
+void AdminTransferLeaseRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  store_id_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminTransferLeaseRequest::~AdminTransferLeaseRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminTransferLeaseRequest)
+  SharedDtor();
+}
+
+void AdminTransferLeaseRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminTransferLeaseRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminTransferLeaseRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminTransferLeaseRequest_descriptor_;
+}
+
+const AdminTransferLeaseRequest& AdminTransferLeaseRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminTransferLeaseRequest* AdminTransferLeaseRequest::default_instance_ = NULL;
+
+AdminTransferLeaseRequest* AdminTransferLeaseRequest::New() const {
+  return new AdminTransferLeaseRequest;
+}
+
+void AdminTransferLeaseRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    store_id_ = 0;
   }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
 
-  // optional .cockroach.proto.DeleteResponse delete = 6;
-  if (has_delete_()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        6, this->delete_(), target);
+bool AdminTransferLeaseRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminTransferLeaseRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_store_id;
+        break;
+      }
+
+      // optional int32 store_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_store_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &store_id_)));
+          set_has_store_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
   }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminTransferLeaseRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminTransferLeaseRequest)
+  return false;
+#undef DO_
+}
 
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-  if (has_delete_range()) {
+void AdminTransferLeaseRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminTransferLeaseRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int32 store_id = 2;
+  if (has_store_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->store_id(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminTransferLeaseRequest)
+}
+
+::google::protobuf::uint8* AdminTransferLeaseRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminTransferLeaseRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        7, this->delete_range(), target);
+        1, this->header(), target);
+  }
+
+  // optional int32 store_id = 2;
+  if (has_store_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->store_id(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminTransferLeaseRequest)
+  return target;
+}
+
+int AdminTransferLeaseRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int32 store_id = 2;
+    if (has_store_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->store_id());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AdminTransferLeaseRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AdminTransferLeaseRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminTransferLeaseRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AdminTransferLeaseRequest::MergeFrom(const AdminTransferLeaseRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_store_id()) {
+      set_store_id(from.store_id());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AdminTransferLeaseRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AdminTransferLeaseRequest::CopyFrom(const AdminTransferLeaseRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AdminTransferLeaseRequest::IsInitialized() const {
+
+  return true;
+}
+
+void AdminTransferLeaseRequest::Swap(AdminTransferLeaseRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(store_id_, other->store_id_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AdminTransferLeaseRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AdminTransferLeaseRequest_descriptor_;
+  metadata.reflection = AdminTransferLeaseRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AdminTransferLeaseResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+AdminTransferLeaseResponse::AdminTransferLeaseResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminTransferLeaseResponse)
+}
+
+void AdminTransferLeaseResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+AdminTransferLeaseResponse::AdminTransferLeaseResponse(const AdminTransferLeaseResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminTransferLeaseResponse)
+}
+
+void AdminTransferLeaseResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AdminTransferLeaseResponse::~AdminTransferLeaseResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminTransferLeaseResponse)
+  SharedDtor();
+}
+
+void AdminTransferLeaseResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void AdminTransferLeaseResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AdminTransferLeaseResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AdminTransferLeaseResponse_descriptor_;
+}
+
+const AdminTransferLeaseResponse& AdminTransferLeaseResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+AdminTransferLeaseResponse* AdminTransferLeaseResponse::default_instance_ = NULL;
+
+AdminTransferLeaseResponse* AdminTransferLeaseResponse::New() const {
+  return new AdminTransferLeaseResponse;
+}
+
+void AdminTransferLeaseResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AdminTransferLeaseResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminTransferLeaseResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminTransferLeaseResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminTransferLeaseResponse)
+  return false;
+#undef DO_
+}
+
+void AdminTransferLeaseResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminTransferLeaseResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
   }
 
-  // optional .cockroach.proto.ScanResponse scan = 8;
-  if (has_scan()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        8, this->scan(), target);
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
   }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminTransferLeaseResponse)
+}
 
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-  if (has_end_transaction()) {
+::google::protobuf::uint8* AdminTransferLeaseResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminTransferLeaseResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        9, this->end_transaction(), target);
+        1, this->header(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ResponseUnion)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminTransferLeaseResponse)
   return target;
 }
 
-int ResponseUnion::ByteSize() const {
+int AdminTransferLeaseResponse::ByteSize() const {
   int total_size = 0;
 
-  switch (value_case()) {
-    // optional .cockroach.proto.ContainsResponse contains = 1;
-    case kContains: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->contains());
-      break;
-    }
-    // optional .cockroach.proto.GetResponse get = 2;
-    case kGet: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->get());
-      break;
-    }
-    // optional .cockroach.proto.PutResponse put = 3;
-    case kPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->put());
-      break;
-    }
-    // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-    case kConditionalPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->conditional_put());
-      break;
-    }
-    // optional .cockroach.proto.IncrementResponse increment = 5;
-    case kIncrement: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->increment());
-      break;
-    }
-    // optional .cockroach.proto.DeleteResponse delete = 6;
-    case kDelete: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_());
-      break;
-    }
-    // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-    case kDeleteRange: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_range());
-      break;
-    }
-    // optional .cockroach.proto.ScanResponse scan = 8;
-    case kScan: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->scan());
-      break;
-    }
-    // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-    case kEndTransaction: {
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->end_transaction());
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+          this->header());
     }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -8017,10 +14633,10 @@ int ResponseUnion::ByteSize() const {
   return total_size;
 }
 
-void ResponseUnion::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminTransferLeaseResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ResponseUnion* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ResponseUnion*>(
+  const AdminTransferLeaseResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminTransferLeaseResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8029,84 +14645,47 @@ void ResponseUnion::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ResponseUnion::MergeFrom(const ResponseUnion& from) {
+void AdminTransferLeaseResponse::MergeFrom(const AdminTransferLeaseResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
-  switch (from.value_case()) {
-    case kContains: {
-      mutable_contains()->::cockroach::proto::ContainsResponse::MergeFrom(from.contains());
-      break;
-    }
-    case kGet: {
-      mutable_get()->::cockroach::proto::GetResponse::MergeFrom(from.get());
-      break;
-    }
-    case kPut: {
-      mutable_put()->::cockroach::proto::PutResponse::MergeFrom(from.put());
-      break;
-    }
-    case kConditionalPut: {
-      mutable_conditional_put()->::cockroach::proto::ConditionalPutResponse::MergeFrom(from.conditional_put());
-      break;
-    }
-    case kIncrement: {
-      mutable_increment()->::cockroach::proto::IncrementResponse::MergeFrom(from.increment());
-      break;
-    }
-    case kDelete: {
-      mutable_delete_()->::cockroach::proto::DeleteResponse::MergeFrom(from.delete_());
-      break;
-    }
-    case kDeleteRange: {
-      mutable_delete_range()->::cockroach::proto::DeleteRangeResponse::MergeFrom(from.delete_range());
-      break;
-    }
-    case kScan: {
-      mutable_scan()->::cockroach::proto::ScanResponse::MergeFrom(from.scan());
-      break;
-    }
-    case kEndTransaction: {
-      mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ResponseUnion::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminTransferLeaseResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ResponseUnion::CopyFrom(const ResponseUnion& from) {
+void AdminTransferLeaseResponse::CopyFrom(const AdminTransferLeaseResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ResponseUnion::IsInitialized() const {
+bool AdminTransferLeaseResponse::IsInitialized() const {
 
   return true;
 }
 
-void ResponseUnion::Swap(ResponseUnion* other) {
+void AdminTransferLeaseResponse::Swap(AdminTransferLeaseResponse* other) {
   if (other != this) {
-    std::swap(value_, other->value_);
-    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(header_, other->header_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ResponseUnion::GetMetadata() const {
+::google::protobuf::Metadata AdminTransferLeaseResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ResponseUnion_descriptor_;
-  metadata.reflection = ResponseUnion_reflection_;
+  metadata.descriptor = AdminTransferLeaseResponse_descriptor_;
+  metadata.reflection = AdminTransferLeaseResponse_reflection_;
   return metadata;
 }
 
@@ -8114,106 +14693,218 @@ void ResponseUnion::Swap(ResponseUnion* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int BatchRequest::kHeaderFieldNumber;
-const int BatchRequest::kRequestsFieldNumber;
+const int BackupFile::kNameFieldNumber;
+const int BackupFile::kStartKeyFieldNumber;
+const int BackupFile::kEndKeyFieldNumber;
+const int BackupFile::kCountFieldNumber;
+const int BackupFile::kBytesFieldNumber;
+const int BackupFile::kChecksumFieldNumber;
 #endif  // !_MSC_VER
 
-BatchRequest::BatchRequest()
+BackupFile::BackupFile()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.BackupFile)
 }
 
-void BatchRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+void BackupFile::InitAsDefaultInstance() {
 }
 
-BatchRequest::BatchRequest(const BatchRequest& from)
+BackupFile::BackupFile(const BackupFile& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.BackupFile)
 }
 
-void BatchRequest::SharedCtor() {
+void BackupFile::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  header_ = NULL;
+  name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  count_ = GOOGLE_LONGLONG(0);
+  bytes_ = GOOGLE_LONGLONG(0);
+  checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-BatchRequest::~BatchRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.BatchRequest)
+BackupFile::~BackupFile() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.BackupFile)
   SharedDtor();
 }
 
-void BatchRequest::SharedDtor() {
+void BackupFile::SharedDtor() {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete name_;
+  }
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete start_key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
+  }
   if (this != default_instance_) {
-    delete header_;
   }
 }
 
-void BatchRequest::SetCachedSize(int size) const {
+void BackupFile::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* BatchRequest::descriptor() {
+const ::google::protobuf::Descriptor* BackupFile::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return BatchRequest_descriptor_;
+  return BackupFile_descriptor_;
 }
 
-const BatchRequest& BatchRequest::default_instance() {
+const BackupFile& BackupFile::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-BatchRequest* BatchRequest::default_instance_ = NULL;
+BackupFile* BackupFile::default_instance_ = NULL;
 
-BatchRequest* BatchRequest::New() const {
-  return new BatchRequest;
+BackupFile* BackupFile::New() const {
+  return new BackupFile;
 }
 
-void BatchRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+void BackupFile::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<BackupFile*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 63) {
+    ZR_(count_, bytes_);
+    if (has_name()) {
+      if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        name_->clear();
+      }
+    }
+    if (has_start_key()) {
+      if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        start_key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+    if (has_checksum()) {
+      if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        checksum_->clear();
+      }
+    }
   }
-  requests_.Clear();
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool BatchRequest::MergePartialFromCodedStream(
+bool BackupFile::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.BackupFile)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.RequestHeader header = 1;
+      // optional string name = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->name().data(), this->name().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "name");
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_requests;
+        if (input->ExpectTag(18)) goto parse_start_key;
         break;
       }
 
-      // repeated .cockroach.proto.RequestUnion requests = 2;
+      // optional bytes start_key = 2;
       case 2: {
         if (tag == 18) {
-         parse_requests:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-                input, add_requests()));
+         parse_start_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_start_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_count;
+        break;
+      }
+
+      // optional int64 count = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &count_)));
+          set_has_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_bytes;
+        break;
+      }
+
+      // optional int64 bytes = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &bytes_)));
+          set_has_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_checksum;
+        break;
+      }
+
+      // optional bytes checksum = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_checksum:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_checksum()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_requests;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8232,81 +14923,162 @@ bool BatchRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.BackupFile)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.BackupFile)
   return false;
 #undef DO_
 }
 
-void BatchRequest::SerializeWithCachedSizes(
+void BackupFile::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.BatchRequest)
-  // optional .cockroach.proto.RequestHeader header = 1;
-  if (has_header()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->header(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.BackupFile)
+  // optional string name = 1;
+  if (has_name()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->name().data(), this->name().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
   }
 
-  // repeated .cockroach.proto.RequestUnion requests = 2;
-  for (int i = 0; i < this->requests_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->requests(i), output);
+  // optional bytes start_key = 2;
+  if (has_start_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->start_key(), output);
+  }
+
+  // optional bytes end_key = 3;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->end_key(), output);
+  }
+
+  // optional int64 count = 4;
+  if (has_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->count(), output);
+  }
+
+  // optional int64 bytes = 5;
+  if (has_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->bytes(), output);
+  }
+
+  // optional bytes checksum = 6;
+  if (has_checksum()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      6, this->checksum(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.BackupFile)
 }
 
-::google::protobuf::uint8* BatchRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* BackupFile::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.BatchRequest)
-  // optional .cockroach.proto.RequestHeader header = 1;
-  if (has_header()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->header(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.BackupFile)
+  // optional string name = 1;
+  if (has_name()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->name().data(), this->name().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "name");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->name(), target);
   }
 
-  // repeated .cockroach.proto.RequestUnion requests = 2;
-  for (int i = 0; i < this->requests_size(); i++) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->requests(i), target);
+  // optional bytes start_key = 2;
+  if (has_start_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->start_key(), target);
+  }
+
+  // optional bytes end_key = 3;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->end_key(), target);
+  }
+
+  // optional int64 count = 4;
+  if (has_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->count(), target);
+  }
+
+  // optional int64 bytes = 5;
+  if (has_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->bytes(), target);
+  }
+
+  // optional bytes checksum = 6;
+  if (has_checksum()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        6, this->checksum(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.BatchRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.BackupFile)
   return target;
 }
 
-int BatchRequest::ByteSize() const {
+int BackupFile::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .cockroach.proto.RequestHeader header = 1;
-    if (has_header()) {
+    // optional string name = 1;
+    if (has_name()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->header());
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->name());
     }
 
-  }
-  // repeated .cockroach.proto.RequestUnion requests = 2;
-  total_size += 1 * this->requests_size();
-  for (int i = 0; i < this->requests_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->requests(i));
-  }
+    // optional bytes start_key = 2;
+    if (has_start_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->start_key());
+    }
+
+    // optional bytes end_key = 3;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+    // optional int64 count = 4;
+    if (has_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->count());
+    }
+
+    // optional int64 bytes = 5;
+    if (has_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->bytes());
+    }
+
+    // optional bytes checksum = 6;
+    if (has_checksum()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->checksum());
+    }
 
+  }
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -8318,10 +15090,10 @@ int BatchRequest::ByteSize() const {
   return total_size;
 }
 
-void BatchRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void BackupFile::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const BatchRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const BatchRequest*>(
+  const BackupFile* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const BackupFile*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8330,49 +15102,67 @@ void BatchRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void BatchRequest::MergeFrom(const BatchRequest& from) {
+void BackupFile::MergeFrom(const BackupFile& from) {
   GOOGLE_CHECK_NE(&from, this);
-  requests_.MergeFrom(from.requests_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_header()) {
-      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    if (from.has_name()) {
+      set_name(from.name());
+    }
+    if (from.has_start_key()) {
+      set_start_key(from.start_key());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+    if (from.has_count()) {
+      set_count(from.count());
+    }
+    if (from.has_bytes()) {
+      set_bytes(from.bytes());
+    }
+    if (from.has_checksum()) {
+      set_checksum(from.checksum());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void BatchRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void BackupFile::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void BatchRequest::CopyFrom(const BatchRequest& from) {
+void BackupFile::CopyFrom(const BackupFile& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool BatchRequest::IsInitialized() const {
+bool BackupFile::IsInitialized() const {
 
   return true;
 }
 
-void BatchRequest::Swap(BatchRequest* other) {
+void BackupFile::Swap(BackupFile* other) {
   if (other != this) {
-    std::swap(header_, other->header_);
-    requests_.Swap(&other->requests_);
+    std::swap(name_, other->name_);
+    std::swap(start_key_, other->start_key_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(count_, other->count_);
+    std::swap(bytes_, other->bytes_);
+    std::swap(checksum_, other->checksum_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata BatchRequest::GetMetadata() const {
+::google::protobuf::Metadata BackupFile::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = BatchRequest_descriptor_;
-  metadata.reflection = BatchRequest_reflection_;
+  metadata.descriptor = BackupFile_descriptor_;
+  metadata.reflection = BackupFile_reflection_;
   return metadata;
 }
 
@@ -8380,106 +15170,214 @@ void BatchRequest::Swap(BatchRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int BatchResponse::kHeaderFieldNumber;
-const int BatchResponse::kResponsesFieldNumber;
+const int BackupManifest::kTimestampFieldNumber;
+const int BackupManifest::kFormatFieldNumber;
+const int BackupManifest::kStartKeyFieldNumber;
+const int BackupManifest::kEndKeyFieldNumber;
+const int BackupManifest::kFilesFieldNumber;
+const int BackupManifest::kStartTimeFieldNumber;
+const int BackupManifest::kSystemKeysFieldNumber;
 #endif  // !_MSC_VER
 
-BatchResponse::BatchResponse()
+BackupManifest::BackupManifest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.BatchResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.BackupManifest)
 }
 
-void BatchResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+void BackupManifest::InitAsDefaultInstance() {
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  start_time_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
-BatchResponse::BatchResponse(const BatchResponse& from)
+BackupManifest::BackupManifest(const BackupManifest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.BatchResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.BackupManifest)
 }
 
-void BatchResponse::SharedCtor() {
+void BackupManifest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  header_ = NULL;
+  timestamp_ = NULL;
+  format_ = 0;
+  start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  start_time_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-BatchResponse::~BatchResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.BatchResponse)
+BackupManifest::~BackupManifest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.BackupManifest)
   SharedDtor();
 }
 
-void BatchResponse::SharedDtor() {
+void BackupManifest::SharedDtor() {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete start_key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
   if (this != default_instance_) {
-    delete header_;
+    delete timestamp_;
+    delete start_time_;
   }
 }
 
-void BatchResponse::SetCachedSize(int size) const {
+void BackupManifest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* BatchResponse::descriptor() {
+const ::google::protobuf::Descriptor* BackupManifest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return BatchResponse_descriptor_;
+  return BackupManifest_descriptor_;
 }
 
-const BatchResponse& BatchResponse::default_instance() {
+const BackupManifest& BackupManifest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-BatchResponse* BatchResponse::default_instance_ = NULL;
+BackupManifest* BackupManifest::default_instance_ = NULL;
 
-BatchResponse* BatchResponse::New() const {
-  return new BatchResponse;
+BackupManifest* BackupManifest::New() const {
+  return new BackupManifest;
 }
 
-void BatchResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+void BackupManifest::Clear() {
+  if (_has_bits_[0 / 32] & 47) {
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    format_ = 0;
+    if (has_start_key()) {
+      if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        start_key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+    if (has_start_time()) {
+      if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+    }
   }
-  responses_.Clear();
+  files_.Clear();
+  system_keys_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool BatchResponse::MergePartialFromCodedStream(
+bool BackupManifest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.BatchResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.BackupManifest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.ResponseHeader header = 1;
+      // optional .cockroach.proto.Timestamp timestamp = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
+               input, mutable_timestamp()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_responses;
+        if (input->ExpectTag(16)) goto parse_format;
         break;
       }
 
-      // repeated .cockroach.proto.ResponseUnion responses = 2;
+      // optional .cockroach.proto.BackupFormat format = 2;
       case 2: {
-        if (tag == 18) {
-         parse_responses:
+        if (tag == 16) {
+         parse_format:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::BackupFormat_IsValid(value)) {
+            set_format(static_cast< ::cockroach::proto::BackupFormat >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(2, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_start_key;
+        break;
+      }
+
+      // optional bytes start_key = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_start_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_start_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_files;
+        break;
+      }
+
+      // repeated .cockroach.proto.BackupFile files = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_files:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-                input, add_responses()));
+                input, add_files()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_responses;
+        if (input->ExpectTag(42)) goto parse_files;
+        if (input->ExpectTag(50)) goto parse_start_time;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp start_time = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_start_time:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_start_time()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_system_keys;
+        break;
+      }
+
+      // repeated .cockroach.proto.KeyValue system_keys = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_system_keys:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_system_keys()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_system_keys;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8497,80 +15395,179 @@ bool BatchResponse::MergePartialFromCodedStream(
       }
     }
   }
-success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.BatchResponse)
-  return true;
-failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.BatchResponse)
-  return false;
-#undef DO_
-}
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.BackupManifest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.BackupManifest)
+  return false;
+#undef DO_
+}
+
+void BackupManifest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.BackupManifest)
+  // optional .cockroach.proto.Timestamp timestamp = 1;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->timestamp(), output);
+  }
+
+  // optional .cockroach.proto.BackupFormat format = 2;
+  if (has_format()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      2, this->format(), output);
+  }
+
+  // optional bytes start_key = 3;
+  if (has_start_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->start_key(), output);
+  }
 
-void BatchResponse::SerializeWithCachedSizes(
-    ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.BatchResponse)
-  // optional .cockroach.proto.ResponseHeader header = 1;
-  if (has_header()) {
+  // optional bytes end_key = 4;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->end_key(), output);
+  }
+
+  // repeated .cockroach.proto.BackupFile files = 5;
+  for (int i = 0; i < this->files_size(); i++) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->header(), output);
+      5, this->files(i), output);
   }
 
-  // repeated .cockroach.proto.ResponseUnion responses = 2;
-  for (int i = 0; i < this->responses_size(); i++) {
+  // optional .cockroach.proto.Timestamp start_time = 6;
+  if (has_start_time()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->responses(i), output);
+      6, this->start_time(), output);
+  }
+
+  // repeated .cockroach.proto.KeyValue system_keys = 7;
+  for (int i = 0; i < this->system_keys_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->system_keys(i), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.BatchResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.BackupManifest)
 }
 
-::google::protobuf::uint8* BatchResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* BackupManifest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.BatchResponse)
-  // optional .cockroach.proto.ResponseHeader header = 1;
-  if (has_header()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.BackupManifest)
+  // optional .cockroach.proto.Timestamp timestamp = 1;
+  if (has_timestamp()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        1, this->header(), target);
+        1, this->timestamp(), target);
   }
 
-  // repeated .cockroach.proto.ResponseUnion responses = 2;
-  for (int i = 0; i < this->responses_size(); i++) {
+  // optional .cockroach.proto.BackupFormat format = 2;
+  if (has_format()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      2, this->format(), target);
+  }
+
+  // optional bytes start_key = 3;
+  if (has_start_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->start_key(), target);
+  }
+
+  // optional bytes end_key = 4;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->end_key(), target);
+  }
+
+  // repeated .cockroach.proto.BackupFile files = 5;
+  for (int i = 0; i < this->files_size(); i++) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->responses(i), target);
+        5, this->files(i), target);
+  }
+
+  // optional .cockroach.proto.Timestamp start_time = 6;
+  if (has_start_time()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->start_time(), target);
+  }
+
+  // repeated .cockroach.proto.KeyValue system_keys = 7;
+  for (int i = 0; i < this->system_keys_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->system_keys(i), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.BatchResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.BackupManifest)
   return target;
 }
 
-int BatchResponse::ByteSize() const {
+int BackupManifest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .cockroach.proto.ResponseHeader header = 1;
-    if (has_header()) {
+    // optional .cockroach.proto.Timestamp timestamp = 1;
+    if (has_timestamp()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->header());
+          this->timestamp());
+    }
+
+    // optional .cockroach.proto.BackupFormat format = 2;
+    if (has_format()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->format());
+    }
+
+    // optional bytes start_key = 3;
+    if (has_start_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->start_key());
+    }
+
+    // optional bytes end_key = 4;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+    // optional .cockroach.proto.Timestamp start_time = 6;
+    if (has_start_time()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->start_time());
     }
 
   }
-  // repeated .cockroach.proto.ResponseUnion responses = 2;
-  total_size += 1 * this->responses_size();
-  for (int i = 0; i < this->responses_size(); i++) {
+  // repeated .cockroach.proto.BackupFile files = 5;
+  total_size += 1 * this->files_size();
+  for (int i = 0; i < this->files_size(); i++) {
     total_size +=
       ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->responses(i));
+        this->files(i));
+  }
+
+  // repeated .cockroach.proto.KeyValue system_keys = 7;
+  total_size += 1 * this->system_keys_size();
+  for (int i = 0; i < this->system_keys_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->system_keys(i));
   }
 
   if (!unknown_fields().empty()) {
@@ -8584,10 +15581,10 @@ int BatchResponse::ByteSize() const {
   return total_size;
 }
 
-void BatchResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void BackupManifest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const BatchResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const BatchResponse*>(
+  const BackupManifest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const BackupManifest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8596,49 +15593,67 @@ void BatchResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void BatchResponse::MergeFrom(const BatchResponse& from) {
+void BackupManifest::MergeFrom(const BackupManifest& from) {
   GOOGLE_CHECK_NE(&from, this);
-  responses_.MergeFrom(from.responses_);
+  files_.MergeFrom(from.files_);
+  system_keys_.MergeFrom(from.system_keys_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_header()) {
-      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_format()) {
+      set_format(from.format());
+    }
+    if (from.has_start_key()) {
+      set_start_key(from.start_key());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+    if (from.has_start_time()) {
+      mutable_start_time()->::cockroach::proto::Timestamp::MergeFrom(from.start_time());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void BatchResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void BackupManifest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void BatchResponse::CopyFrom(const BatchResponse& from) {
+void BackupManifest::CopyFrom(const BackupManifest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool BatchResponse::IsInitialized() const {
+bool BackupManifest::IsInitialized() const {
 
   return true;
 }
 
-void BatchResponse::Swap(BatchResponse* other) {
+void BackupManifest::Swap(BackupManifest* other) {
   if (other != this) {
-    std::swap(header_, other->header_);
-    responses_.Swap(&other->responses_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(format_, other->format_);
+    std::swap(start_key_, other->start_key_);
+    std::swap(end_key_, other->end_key_);
+    files_.Swap(&other->files_);
+    std::swap(start_time_, other->start_time_);
+    system_keys_.Swap(&other->system_keys_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata BatchResponse::GetMetadata() const {
+::google::protobuf::Metadata BackupManifest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = BatchResponse_descriptor_;
-  metadata.reflection = BatchResponse_reflection_;
+  metadata.descriptor = BackupManifest_descriptor_;
+  metadata.reflection = BackupManifest_reflection_;
   return metadata;
 }
 
@@ -8646,90 +15661,110 @@ void BatchResponse::Swap(BatchResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int AdminSplitRequest::kHeaderFieldNumber;
-const int AdminSplitRequest::kSplitKeyFieldNumber;
+const int AdminBackupRequest::kHeaderFieldNumber;
+const int AdminBackupRequest::kUriFieldNumber;
+const int AdminBackupRequest::kFormatFieldNumber;
+const int AdminBackupRequest::kLimitKeyFieldNumber;
+const int AdminBackupRequest::kStartTimeFieldNumber;
 #endif  // !_MSC_VER
 
-AdminSplitRequest::AdminSplitRequest()
+AdminBackupRequest::AdminBackupRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminBackupRequest)
 }
 
-void AdminSplitRequest::InitAsDefaultInstance() {
+void AdminBackupRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  start_time_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
-AdminSplitRequest::AdminSplitRequest(const AdminSplitRequest& from)
+AdminBackupRequest::AdminBackupRequest(const AdminBackupRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminBackupRequest)
 }
 
-void AdminSplitRequest::SharedCtor() {
+void AdminBackupRequest::SharedCtor() {
   ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
-  split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  format_ = 0;
+  limit_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  start_time_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-AdminSplitRequest::~AdminSplitRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.AdminSplitRequest)
+AdminBackupRequest::~AdminBackupRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminBackupRequest)
   SharedDtor();
 }
 
-void AdminSplitRequest::SharedDtor() {
-  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete split_key_;
+void AdminBackupRequest::SharedDtor() {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete uri_;
+  }
+  if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete limit_key_;
   }
   if (this != default_instance_) {
     delete header_;
+    delete start_time_;
   }
 }
 
-void AdminSplitRequest::SetCachedSize(int size) const {
+void AdminBackupRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* AdminSplitRequest::descriptor() {
+const ::google::protobuf::Descriptor* AdminBackupRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return AdminSplitRequest_descriptor_;
+  return AdminBackupRequest_descriptor_;
 }
 
-const AdminSplitRequest& AdminSplitRequest::default_instance() {
+const AdminBackupRequest& AdminBackupRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-AdminSplitRequest* AdminSplitRequest::default_instance_ = NULL;
+AdminBackupRequest* AdminBackupRequest::default_instance_ = NULL;
 
-AdminSplitRequest* AdminSplitRequest::New() const {
-  return new AdminSplitRequest;
+AdminBackupRequest* AdminBackupRequest::New() const {
+  return new AdminBackupRequest;
 }
 
-void AdminSplitRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+void AdminBackupRequest::Clear() {
+  if (_has_bits_[0 / 32] & 31) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    if (has_split_key()) {
-      if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        split_key_->clear();
+    if (has_uri()) {
+      if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        uri_->clear();
       }
     }
+    format_ = 0;
+    if (has_limit_key()) {
+      if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        limit_key_->clear();
+      }
+    }
+    if (has_start_time()) {
+      if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool AdminSplitRequest::MergePartialFromCodedStream(
+bool AdminBackupRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminBackupRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -8743,16 +15778,66 @@ bool AdminSplitRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_split_key;
+        if (input->ExpectTag(18)) goto parse_uri;
         break;
       }
 
-      // optional bytes split_key = 2;
+      // optional string uri = 2;
       case 2: {
         if (tag == 18) {
-         parse_split_key:
+         parse_uri:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_uri()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->uri().data(), this->uri().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "uri");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_format;
+        break;
+      }
+
+      // optional .cockroach.proto.BackupFormat format = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_format:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::BackupFormat_IsValid(value)) {
+            set_format(static_cast< ::cockroach::proto::BackupFormat >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(3, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_limit_key;
+        break;
+      }
+
+      // optional bytes limit_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_limit_key:
           DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_split_key()));
+                input, this->mutable_limit_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_start_time;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp start_time = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_start_time:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_start_time()));
         } else {
           goto handle_unusual;
         }
@@ -8774,39 +15859,61 @@ bool AdminSplitRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminBackupRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminBackupRequest)
   return false;
 #undef DO_
 }
 
-void AdminSplitRequest::SerializeWithCachedSizes(
+void AdminBackupRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminBackupRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional bytes split_key = 2;
-  if (has_split_key()) {
+  // optional string uri = 2;
+  if (has_uri()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->uri().data(), this->uri().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "uri");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->uri(), output);
+  }
+
+  // optional .cockroach.proto.BackupFormat format = 3;
+  if (has_format()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      3, this->format(), output);
+  }
+
+  // optional bytes limit_key = 4;
+  if (has_limit_key()) {
     ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      2, this->split_key(), output);
+      4, this->limit_key(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp start_time = 5;
+  if (has_start_time()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->start_time(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminBackupRequest)
 }
 
-::google::protobuf::uint8* AdminSplitRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* AdminBackupRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminBackupRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8814,22 +15921,46 @@ void AdminSplitRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional bytes split_key = 2;
-  if (has_split_key()) {
+  // optional string uri = 2;
+  if (has_uri()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->uri().data(), this->uri().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "uri");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->uri(), target);
+  }
+
+  // optional .cockroach.proto.BackupFormat format = 3;
+  if (has_format()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      3, this->format(), target);
+  }
+
+  // optional bytes limit_key = 4;
+  if (has_limit_key()) {
     target =
       ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        2, this->split_key(), target);
+        4, this->limit_key(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp start_time = 5;
+  if (has_start_time()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->start_time(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminBackupRequest)
   return target;
 }
 
-int AdminSplitRequest::ByteSize() const {
+int AdminBackupRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -8840,11 +15971,31 @@ int AdminSplitRequest::ByteSize() const {
           this->header());
     }
 
-    // optional bytes split_key = 2;
-    if (has_split_key()) {
+    // optional string uri = 2;
+    if (has_uri()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->uri());
+    }
+
+    // optional .cockroach.proto.BackupFormat format = 3;
+    if (has_format()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->format());
+    }
+
+    // optional bytes limit_key = 4;
+    if (has_limit_key()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->split_key());
+          this->limit_key());
+    }
+
+    // optional .cockroach.proto.Timestamp start_time = 5;
+    if (has_start_time()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->start_time());
     }
 
   }
@@ -8859,10 +16010,10 @@ int AdminSplitRequest::ByteSize() const {
   return total_size;
 }
 
-void AdminSplitRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminBackupRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const AdminSplitRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const AdminSplitRequest*>(
+  const AdminBackupRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminBackupRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8871,51 +16022,63 @@ void AdminSplitRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void AdminSplitRequest::MergeFrom(const AdminSplitRequest& from) {
+void AdminBackupRequest::MergeFrom(const AdminBackupRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_split_key()) {
-      set_split_key(from.split_key());
+    if (from.has_uri()) {
+      set_uri(from.uri());
+    }
+    if (from.has_format()) {
+      set_format(from.format());
+    }
+    if (from.has_limit_key()) {
+      set_limit_key(from.limit_key());
+    }
+    if (from.has_start_time()) {
+      mutable_start_time()->::cockroach::proto::Timestamp::MergeFrom(from.start_time());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void AdminSplitRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminBackupRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void AdminSplitRequest::CopyFrom(const AdminSplitRequest& from) {
+void AdminBackupRequest::CopyFrom(const AdminBackupRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool AdminSplitRequest::IsInitialized() const {
+bool AdminBackupRequest::IsInitialized() const {
 
   return true;
 }
 
-void AdminSplitRequest::Swap(AdminSplitRequest* other) {
+void AdminBackupRequest::Swap(AdminBackupRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(split_key_, other->split_key_);
+    std::swap(uri_, other->uri_);
+    std::swap(format_, other->format_);
+    std::swap(limit_key_, other->limit_key_);
+    std::swap(start_time_, other->start_time_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata AdminSplitRequest::GetMetadata() const {
+::google::protobuf::Metadata AdminBackupRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = AdminSplitRequest_descriptor_;
-  metadata.reflection = AdminSplitRequest_reflection_;
+  metadata.descriptor = AdminBackupRequest_descriptor_;
+  metadata.reflection = AdminBackupRequest_reflection_;
   return metadata;
 }
 
@@ -8923,77 +16086,86 @@ void AdminSplitRequest::Swap(AdminSplitRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int AdminSplitResponse::kHeaderFieldNumber;
+const int AdminBackupResponse::kHeaderFieldNumber;
+const int AdminBackupResponse::kFileFieldNumber;
 #endif  // !_MSC_VER
 
-AdminSplitResponse::AdminSplitResponse()
+AdminBackupResponse::AdminBackupResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminBackupResponse)
 }
 
-void AdminSplitResponse::InitAsDefaultInstance() {
+void AdminBackupResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  file_ = const_cast< ::cockroach::proto::BackupFile*>(&::cockroach::proto::BackupFile::default_instance());
 }
 
-AdminSplitResponse::AdminSplitResponse(const AdminSplitResponse& from)
+AdminBackupResponse::AdminBackupResponse(const AdminBackupResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminBackupResponse)
 }
 
-void AdminSplitResponse::SharedCtor() {
+void AdminBackupResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  file_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-AdminSplitResponse::~AdminSplitResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.AdminSplitResponse)
+AdminBackupResponse::~AdminBackupResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminBackupResponse)
   SharedDtor();
 }
 
-void AdminSplitResponse::SharedDtor() {
+void AdminBackupResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete file_;
   }
 }
 
-void AdminSplitResponse::SetCachedSize(int size) const {
+void AdminBackupResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* AdminSplitResponse::descriptor() {
+const ::google::protobuf::Descriptor* AdminBackupResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return AdminSplitResponse_descriptor_;
+  return AdminBackupResponse_descriptor_;
 }
 
-const AdminSplitResponse& AdminSplitResponse::default_instance() {
+const AdminBackupResponse& AdminBackupResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-AdminSplitResponse* AdminSplitResponse::default_instance_ = NULL;
+AdminBackupResponse* AdminBackupResponse::default_instance_ = NULL;
 
-AdminSplitResponse* AdminSplitResponse::New() const {
-  return new AdminSplitResponse;
+AdminBackupResponse* AdminBackupResponse::New() const {
+  return new AdminBackupResponse;
 }
 
-void AdminSplitResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+void AdminBackupResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_file()) {
+      if (file_ != NULL) file_->::cockroach::proto::BackupFile::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool AdminSplitResponse::MergePartialFromCodedStream(
+bool AdminBackupResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminBackupResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -9007,6 +16179,19 @@ bool AdminSplitResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_file;
+        break;
+      }
+
+      // optional .cockroach.proto.BackupFile file = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_file:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_file()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -9025,33 +16210,39 @@ bool AdminSplitResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminBackupResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminBackupResponse)
   return false;
 #undef DO_
 }
 
-void AdminSplitResponse::SerializeWithCachedSizes(
+void AdminBackupResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminBackupResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional .cockroach.proto.BackupFile file = 2;
+  if (has_file()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->file(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminBackupResponse)
 }
 
-::google::protobuf::uint8* AdminSplitResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* AdminBackupResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminBackupResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -9059,15 +16250,22 @@ void AdminSplitResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // optional .cockroach.proto.BackupFile file = 2;
+  if (has_file()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->file(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminBackupResponse)
   return target;
 }
 
-int AdminSplitResponse::ByteSize() const {
+int AdminBackupResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -9078,6 +16276,13 @@ int AdminSplitResponse::ByteSize() const {
           this->header());
     }
 
+    // optional .cockroach.proto.BackupFile file = 2;
+    if (has_file()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->file());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -9090,10 +16295,10 @@ int AdminSplitResponse::ByteSize() const {
   return total_size;
 }
 
-void AdminSplitResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminBackupResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const AdminSplitResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const AdminSplitResponse*>(
+  const AdminBackupResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminBackupResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -9102,47 +16307,51 @@ void AdminSplitResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void AdminSplitResponse::MergeFrom(const AdminSplitResponse& from) {
+void AdminBackupResponse::MergeFrom(const AdminBackupResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_file()) {
+      mutable_file()->::cockroach::proto::BackupFile::MergeFrom(from.file());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void AdminSplitResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminBackupResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void AdminSplitResponse::CopyFrom(const AdminSplitResponse& from) {
+void AdminBackupResponse::CopyFrom(const AdminBackupResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool AdminSplitResponse::IsInitialized() const {
+bool AdminBackupResponse::IsInitialized() const {
 
   return true;
 }
 
-void AdminSplitResponse::Swap(AdminSplitResponse* other) {
+void AdminBackupResponse::Swap(AdminBackupResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(file_, other->file_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata AdminSplitResponse::GetMetadata() const {
+::google::protobuf::Metadata AdminBackupResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = AdminSplitResponse_descriptor_;
-  metadata.reflection = AdminSplitResponse_reflection_;
+  metadata.descriptor = AdminBackupResponse_descriptor_;
+  metadata.reflection = AdminBackupResponse_reflection_;
   return metadata;
 }
 
@@ -9150,77 +16359,100 @@ void AdminSplitResponse::Swap(AdminSplitResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int AdminMergeRequest::kHeaderFieldNumber;
+const int AdminRestoreRequest::kHeaderFieldNumber;
+const int AdminRestoreRequest::kUriFieldNumber;
+const int AdminRestoreRequest::kJobIdFieldNumber;
 #endif  // !_MSC_VER
 
-AdminMergeRequest::AdminMergeRequest()
+AdminRestoreRequest::AdminRestoreRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminRestoreRequest)
 }
 
-void AdminMergeRequest::InitAsDefaultInstance() {
+void AdminRestoreRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-AdminMergeRequest::AdminMergeRequest(const AdminMergeRequest& from)
+AdminRestoreRequest::AdminRestoreRequest(const AdminRestoreRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminRestoreRequest)
 }
 
-void AdminMergeRequest::SharedCtor() {
+void AdminRestoreRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
+  uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  job_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-AdminMergeRequest::~AdminMergeRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.AdminMergeRequest)
+AdminRestoreRequest::~AdminRestoreRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminRestoreRequest)
   SharedDtor();
 }
 
-void AdminMergeRequest::SharedDtor() {
+void AdminRestoreRequest::SharedDtor() {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete uri_;
+  }
+  if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete job_id_;
+  }
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void AdminMergeRequest::SetCachedSize(int size) const {
+void AdminRestoreRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* AdminMergeRequest::descriptor() {
+const ::google::protobuf::Descriptor* AdminRestoreRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return AdminMergeRequest_descriptor_;
+  return AdminRestoreRequest_descriptor_;
 }
 
-const AdminMergeRequest& AdminMergeRequest::default_instance() {
+const AdminRestoreRequest& AdminRestoreRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-AdminMergeRequest* AdminMergeRequest::default_instance_ = NULL;
+AdminRestoreRequest* AdminRestoreRequest::default_instance_ = NULL;
 
-AdminMergeRequest* AdminMergeRequest::New() const {
-  return new AdminMergeRequest;
+AdminRestoreRequest* AdminRestoreRequest::New() const {
+  return new AdminRestoreRequest;
 }
 
-void AdminMergeRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+void AdminRestoreRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_uri()) {
+      if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        uri_->clear();
+      }
+    }
+    if (has_job_id()) {
+      if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        job_id_->clear();
+      }
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool AdminMergeRequest::MergePartialFromCodedStream(
+bool AdminRestoreRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminRestoreRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -9234,6 +16466,40 @@ bool AdminMergeRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_uri;
+        break;
+      }
+
+      // optional string uri = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_uri:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_uri()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->uri().data(), this->uri().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "uri");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_job_id;
+        break;
+      }
+
+      // optional string job_id = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_job_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_job_id()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->job_id().data(), this->job_id().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "job_id");
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -9252,33 +16518,53 @@ bool AdminMergeRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminRestoreRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminRestoreRequest)
   return false;
 #undef DO_
 }
 
-void AdminMergeRequest::SerializeWithCachedSizes(
+void AdminRestoreRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminRestoreRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional string uri = 2;
+  if (has_uri()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->uri().data(), this->uri().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "uri");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->uri(), output);
+  }
+
+  // optional string job_id = 3;
+  if (has_job_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->job_id().data(), this->job_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "job_id");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->job_id(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminRestoreRequest)
 }
 
-::google::protobuf::uint8* AdminMergeRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* AdminRestoreRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminRestoreRequest)
   // optional .cockroach.proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -9286,15 +16572,37 @@ void AdminMergeRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // optional string uri = 2;
+  if (has_uri()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->uri().data(), this->uri().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "uri");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->uri(), target);
+  }
+
+  // optional string job_id = 3;
+  if (has_job_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->job_id().data(), this->job_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "job_id");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        3, this->job_id(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminMergeRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminRestoreRequest)
   return target;
 }
 
-int AdminMergeRequest::ByteSize() const {
+int AdminRestoreRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -9305,6 +16613,20 @@ int AdminMergeRequest::ByteSize() const {
           this->header());
     }
 
+    // optional string uri = 2;
+    if (has_uri()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->uri());
+    }
+
+    // optional string job_id = 3;
+    if (has_job_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->job_id());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -9317,10 +16639,10 @@ int AdminMergeRequest::ByteSize() const {
   return total_size;
 }
 
-void AdminMergeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminRestoreRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const AdminMergeRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const AdminMergeRequest*>(
+  const AdminRestoreRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminRestoreRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -9329,47 +16651,55 @@ void AdminMergeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void AdminMergeRequest::MergeFrom(const AdminMergeRequest& from) {
+void AdminRestoreRequest::MergeFrom(const AdminRestoreRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
     }
+    if (from.has_uri()) {
+      set_uri(from.uri());
+    }
+    if (from.has_job_id()) {
+      set_job_id(from.job_id());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void AdminMergeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminRestoreRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void AdminMergeRequest::CopyFrom(const AdminMergeRequest& from) {
+void AdminRestoreRequest::CopyFrom(const AdminRestoreRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool AdminMergeRequest::IsInitialized() const {
+bool AdminRestoreRequest::IsInitialized() const {
 
   return true;
 }
 
-void AdminMergeRequest::Swap(AdminMergeRequest* other) {
+void AdminRestoreRequest::Swap(AdminRestoreRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(uri_, other->uri_);
+    std::swap(job_id_, other->job_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata AdminMergeRequest::GetMetadata() const {
+::google::protobuf::Metadata AdminRestoreRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = AdminMergeRequest_descriptor_;
-  metadata.reflection = AdminMergeRequest_reflection_;
+  metadata.descriptor = AdminRestoreRequest_descriptor_;
+  metadata.reflection = AdminRestoreRequest_reflection_;
   return metadata;
 }
 
@@ -9377,77 +16707,90 @@ void AdminMergeRequest::Swap(AdminMergeRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int AdminMergeResponse::kHeaderFieldNumber;
+const int AdminRestoreResponse::kHeaderFieldNumber;
+const int AdminRestoreResponse::kJobIdFieldNumber;
 #endif  // !_MSC_VER
 
-AdminMergeResponse::AdminMergeResponse()
+AdminRestoreResponse::AdminRestoreResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.AdminRestoreResponse)
 }
 
-void AdminMergeResponse::InitAsDefaultInstance() {
+void AdminRestoreResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
 }
 
-AdminMergeResponse::AdminMergeResponse(const AdminMergeResponse& from)
+AdminRestoreResponse::AdminRestoreResponse(const AdminRestoreResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AdminRestoreResponse)
 }
 
-void AdminMergeResponse::SharedCtor() {
+void AdminRestoreResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
+  job_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-AdminMergeResponse::~AdminMergeResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.AdminMergeResponse)
+AdminRestoreResponse::~AdminRestoreResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AdminRestoreResponse)
   SharedDtor();
 }
 
-void AdminMergeResponse::SharedDtor() {
+void AdminRestoreResponse::SharedDtor() {
+  if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete job_id_;
+  }
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void AdminMergeResponse::SetCachedSize(int size) const {
+void AdminRestoreResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* AdminMergeResponse::descriptor() {
+const ::google::protobuf::Descriptor* AdminRestoreResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return AdminMergeResponse_descriptor_;
+  return AdminRestoreResponse_descriptor_;
 }
 
-const AdminMergeResponse& AdminMergeResponse::default_instance() {
+const AdminRestoreResponse& AdminRestoreResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-AdminMergeResponse* AdminMergeResponse::default_instance_ = NULL;
+AdminRestoreResponse* AdminRestoreResponse::default_instance_ = NULL;
 
-AdminMergeResponse* AdminMergeResponse::New() const {
-  return new AdminMergeResponse;
+AdminRestoreResponse* AdminRestoreResponse::New() const {
+  return new AdminRestoreResponse;
 }
 
-void AdminMergeResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+void AdminRestoreResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_job_id()) {
+      if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        job_id_->clear();
+      }
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool AdminMergeResponse::MergePartialFromCodedStream(
+bool AdminRestoreResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AdminRestoreResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -9461,6 +16804,23 @@ bool AdminMergeResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_job_id;
+        break;
+      }
+
+      // optional string job_id = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_job_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_job_id()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->job_id().data(), this->job_id().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "job_id");
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -9479,33 +16839,43 @@ bool AdminMergeResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AdminRestoreResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AdminRestoreResponse)
   return false;
 #undef DO_
 }
 
-void AdminMergeResponse::SerializeWithCachedSizes(
+void AdminRestoreResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AdminRestoreResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional string job_id = 2;
+  if (has_job_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->job_id().data(), this->job_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "job_id");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->job_id(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AdminRestoreResponse)
 }
 
-::google::protobuf::uint8* AdminMergeResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* AdminRestoreResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AdminRestoreResponse)
   // optional .cockroach.proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -9513,15 +16883,26 @@ void AdminMergeResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // optional string job_id = 2;
+  if (has_job_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->job_id().data(), this->job_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "job_id");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->job_id(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminMergeResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AdminRestoreResponse)
   return target;
 }
 
-int AdminMergeResponse::ByteSize() const {
+int AdminRestoreResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -9532,6 +16913,13 @@ int AdminMergeResponse::ByteSize() const {
           this->header());
     }
 
+    // optional string job_id = 2;
+    if (has_job_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->job_id());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -9544,10 +16932,10 @@ int AdminMergeResponse::ByteSize() const {
   return total_size;
 }
 
-void AdminMergeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void AdminRestoreResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const AdminMergeResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const AdminMergeResponse*>(
+  const AdminRestoreResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AdminRestoreResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -9556,47 +16944,51 @@ void AdminMergeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void AdminMergeResponse::MergeFrom(const AdminMergeResponse& from) {
+void AdminRestoreResponse::MergeFrom(const AdminRestoreResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_job_id()) {
+      set_job_id(from.job_id());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void AdminMergeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void AdminRestoreResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void AdminMergeResponse::CopyFrom(const AdminMergeResponse& from) {
+void AdminRestoreResponse::CopyFrom(const AdminRestoreResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool AdminMergeResponse::IsInitialized() const {
+bool AdminRestoreResponse::IsInitialized() const {
 
   return true;
 }
 
-void AdminMergeResponse::Swap(AdminMergeResponse* other) {
+void AdminRestoreResponse::Swap(AdminRestoreResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(job_id_, other->job_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata AdminMergeResponse::GetMetadata() const {
+::google::protobuf::Metadata AdminRestoreResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = AdminMergeResponse_descriptor_;
-  metadata.reflection = AdminMergeResponse_reflection_;
+  metadata.descriptor = AdminRestoreResponse_descriptor_;
+  metadata.reflection = AdminRestoreResponse_reflection_;
   return metadata;
 }
 
@@ -41,6 +41,7 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
 class ClientCmdID;
 class RequestHeader;
+class TraceContext;
 class ResponseHeader;
 class ContainsRequest;
 class ContainsResponse;
@@ -50,14 +51,23 @@ class PutRequest;
 class PutResponse;
 class ConditionalPutRequest;
 class ConditionalPutResponse;
+class InitPutRequest;
+class InitPutResponse;
 class IncrementRequest;
 class IncrementResponse;
 class DeleteRequest;
 class DeleteResponse;
 class DeleteRangeRequest;
 class DeleteRangeResponse;
+class ClearRangeRequest;
+class ClearRangeResponse;
 class ScanRequest;
 class ScanResponse;
+class AggregateRequest;
+class AggregateResponse;
+class WatchRequest;
+class WatchEvent;
+class WatchResponse;
 class EndTransactionRequest;
 class EndTransactionResponse;
 class RequestUnion;
@@ -68,6 +78,16 @@ class AdminSplitRequest;
 class AdminSplitResponse;
 class AdminMergeRequest;
 class AdminMergeResponse;
+class AdminBulkLoadRequest;
+class AdminBulkLoadResponse;
+class AdminTransferLeaseRequest;
+class AdminTransferLeaseResponse;
+class BackupFile;
+class BackupManifest;
+class AdminBackupRequest;
+class AdminBackupResponse;
+class AdminRestoreRequest;
+class AdminRestoreResponse;
 
 enum ReadConsistencyType {
   CONSISTENT = 0,
@@ -89,6 +109,46 @@ inline bool ReadConsistencyType_Parse(
   return ::google::protobuf::internal::ParseNamedEnum<ReadConsistencyType>(
     ReadConsistencyType_descriptor(), name, value);
 }
+enum RequestPriority {
+  NORMAL = 0,
+  SYSTEM = 1,
+  HIGH = 2,
+  LOW = 3,
+  BACKGROUND = 4
+};
+bool RequestPriority_IsValid(int value);
+const RequestPriority RequestPriority_MIN = NORMAL;
+const RequestPriority RequestPriority_MAX = BACKGROUND;
+const int RequestPriority_ARRAYSIZE = RequestPriority_MAX + 1;
+
+const ::google::protobuf::EnumDescriptor* RequestPriority_descriptor();
+inline const ::std::string& RequestPriority_Name(RequestPriority value) {
+  return ::google::protobuf::internal::NameOfEnum(
+    RequestPriority_descriptor(), value);
+}
+inline bool RequestPriority_Parse(
+    const ::std::string& name, RequestPriority* value) {
+  return ::google::protobuf::internal::ParseNamedEnum<RequestPriority>(
+    RequestPriority_descriptor(), name, value);
+}
+enum BackupFormat {
+  PROTO = 0
+};
+bool BackupFormat_IsValid(int value);
+const BackupFormat BackupFormat_MIN = PROTO;
+const BackupFormat BackupFormat_MAX = PROTO;
+const int BackupFormat_ARRAYSIZE = BackupFormat_MAX + 1;
+
+const ::google::protobuf::EnumDescriptor* BackupFormat_descriptor();
+inline const ::std::string& BackupFormat_Name(BackupFormat value) {
+  return ::google::protobuf::internal::NameOfEnum(
+    BackupFormat_descriptor(), value);
+}
+inline bool BackupFormat_Parse(
+    const ::std::string& name, BackupFormat* value) {
+  return ::google::protobuf::internal::ParseNamedEnum<BackupFormat>(
+    BackupFormat_descriptor(), name, value);
+}
 // ===================================================================
 
 class ClientCmdID : public ::google::protobuf::Message {
@@ -326,6 +386,29 @@ class RequestHeader : public ::google::protobuf::Message {
   inline ::cockroach::proto::ReadConsistencyType read_consistency() const;
   inline void set_read_consistency(::cockroach::proto::ReadConsistencyType value);
 
+  // optional int64 timeout = 11;
+  inline bool has_timeout() const;
+  inline void clear_timeout();
+  static const int kTimeoutFieldNumber = 11;
+  inline ::google::protobuf::int64 timeout() const;
+  inline void set_timeout(::google::protobuf::int64 value);
+
+  // optional .cockroach.proto.TraceContext trace = 12;
+  inline bool has_trace() const;
+  inline void clear_trace();
+  static const int kTraceFieldNumber = 12;
+  inline const ::cockroach::proto::TraceContext& trace() const;
+  inline ::cockroach::proto::TraceContext* mutable_trace();
+  inline ::cockroach::proto::TraceContext* release_trace();
+  inline void set_allocated_trace(::cockroach::proto::TraceContext* trace);
+
+  // optional .cockroach.proto.RequestPriority priority = 13;
+  inline bool has_priority() const;
+  inline void clear_priority();
+  static const int kPriorityFieldNumber = 13;
+  inline ::cockroach::proto::RequestPriority priority() const;
+  inline void set_priority(::cockroach::proto::RequestPriority value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.RequestHeader)
  private:
   inline void set_has_timestamp();
@@ -348,6 +431,12 @@ class RequestHeader : public ::google::protobuf::Message {
   inline void clear_has_txn();
   inline void set_has_read_consistency();
   inline void clear_has_read_consistency();
+  inline void set_has_timeout();
+  inline void clear_has_timeout();
+  inline void set_has_trace();
+  inline void clear_has_trace();
+  inline void set_has_priority();
+  inline void clear_has_priority();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -363,6 +452,9 @@ class RequestHeader : public ::google::protobuf::Message {
   ::cockroach::proto::Transaction* txn_;
   ::google::protobuf::int32 user_priority_;
   int read_consistency_;
+  ::google::protobuf::int64 timeout_;
+  ::cockroach::proto::TraceContext* trace_;
+  int priority_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
@@ -372,6 +464,95 @@ class RequestHeader : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class TraceContext : public ::google::protobuf::Message {
+ public:
+  TraceContext();
+  virtual ~TraceContext();
+
+  TraceContext(const TraceContext& from);
+
+  inline TraceContext& operator=(const TraceContext& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const TraceContext& default_instance();
+
+  void Swap(TraceContext* other);
+
+  // implements Message ----------------------------------------------
+
+  TraceContext* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const TraceContext& from);
+  void MergeFrom(const TraceContext& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 trace_id = 1;
+  inline bool has_trace_id() const;
+  inline void clear_trace_id();
+  static const int kTraceIdFieldNumber = 1;
+  inline ::google::protobuf::int64 trace_id() const;
+  inline void set_trace_id(::google::protobuf::int64 value);
+
+  // optional int64 span_id = 2;
+  inline bool has_span_id() const;
+  inline void clear_span_id();
+  static const int kSpanIdFieldNumber = 2;
+  inline ::google::protobuf::int64 span_id() const;
+  inline void set_span_id(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.TraceContext)
+ private:
+  inline void set_has_trace_id();
+  inline void clear_has_trace_id();
+  inline void set_has_span_id();
+  inline void clear_has_span_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 trace_id_;
+  ::google::protobuf::int64 span_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static TraceContext* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class ResponseHeader : public ::google::protobuf::Message {
  public:
   ResponseHeader();
@@ -1077,6 +1258,13 @@ class ConditionalPutRequest : public ::google::protobuf::Message {
   inline ::cockroach::proto::Value* release_exp_value();
   inline void set_allocated_exp_value(::cockroach::proto::Value* exp_value);
 
+  // optional bool allow_if_does_not_exist = 4;
+  inline bool has_allow_if_does_not_exist() const;
+  inline void clear_allow_if_does_not_exist();
+  static const int kAllowIfDoesNotExistFieldNumber = 4;
+  inline bool allow_if_does_not_exist() const;
+  inline void set_allow_if_does_not_exist(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.ConditionalPutRequest)
  private:
   inline void set_has_header();
@@ -1085,6 +1273,8 @@ class ConditionalPutRequest : public ::google::protobuf::Message {
   inline void clear_has_value();
   inline void set_has_exp_value();
   inline void clear_has_exp_value();
+  inline void set_has_allow_if_does_not_exist();
+  inline void clear_has_allow_if_does_not_exist();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1093,6 +1283,7 @@ class ConditionalPutRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* header_;
   ::cockroach::proto::Value* value_;
   ::cockroach::proto::Value* exp_value_;
+  bool allow_if_does_not_exist_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
@@ -1183,6 +1374,180 @@ class ConditionalPutResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class InitPutRequest : public ::google::protobuf::Message {
+ public:
+  InitPutRequest();
+  virtual ~InitPutRequest();
+
+  InitPutRequest(const InitPutRequest& from);
+
+  inline InitPutRequest& operator=(const InitPutRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InitPutRequest& default_instance();
+
+  void Swap(InitPutRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InitPutRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InitPutRequest& from);
+  void MergeFrom(const InitPutRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Value value = 2;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 2;
+  inline const ::cockroach::proto::Value& value() const;
+  inline ::cockroach::proto::Value* mutable_value();
+  inline ::cockroach::proto::Value* release_value();
+  inline void set_allocated_value(::cockroach::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InitPutRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Value* value_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static InitPutRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InitPutResponse : public ::google::protobuf::Message {
+ public:
+  InitPutResponse();
+  virtual ~InitPutResponse();
+
+  InitPutResponse(const InitPutResponse& from);
+
+  inline InitPutResponse& operator=(const InitPutResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InitPutResponse& default_instance();
+
+  void Swap(InitPutResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InitPutResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InitPutResponse& from);
+  void MergeFrom(const InitPutResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InitPutResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static InitPutResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class IncrementRequest : public ::google::protobuf::Message {
  public:
   IncrementRequest();
@@ -1252,12 +1617,21 @@ class IncrementRequest : public ::google::protobuf::Message {
   inline ::google::protobuf::int64 increment() const;
   inline void set_increment(::google::protobuf::int64 value);
 
+  // optional bool return_previous = 3;
+  inline bool has_return_previous() const;
+  inline void clear_return_previous();
+  static const int kReturnPreviousFieldNumber = 3;
+  inline bool return_previous() const;
+  inline void set_return_previous(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.IncrementRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_increment();
   inline void clear_has_increment();
+  inline void set_has_return_previous();
+  inline void clear_has_return_previous();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1265,6 +1639,7 @@ class IncrementRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
   ::google::protobuf::int64 increment_;
+  bool return_previous_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
@@ -1343,12 +1718,21 @@ class IncrementResponse : public ::google::protobuf::Message {
   inline ::google::protobuf::int64 new_value() const;
   inline void set_new_value(::google::protobuf::int64 value);
 
+  // optional int64 previous_value = 3;
+  inline bool has_previous_value() const;
+  inline void clear_previous_value();
+  static const int kPreviousValueFieldNumber = 3;
+  inline ::google::protobuf::int64 previous_value() const;
+  inline void set_previous_value(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.IncrementResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_new_value();
   inline void clear_has_new_value();
+  inline void set_has_previous_value();
+  inline void clear_has_previous_value();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1356,6 +1740,7 @@ class IncrementResponse : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
   ::google::protobuf::int64 new_value_;
+  ::google::protobuf::int64 previous_value_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
@@ -1709,14 +2094,14 @@ class DeleteRangeResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class ScanRequest : public ::google::protobuf::Message {
+class ClearRangeRequest : public ::google::protobuf::Message {
  public:
-  ScanRequest();
-  virtual ~ScanRequest();
+  ClearRangeRequest();
+  virtual ~ClearRangeRequest();
 
-  ScanRequest(const ScanRequest& from);
+  ClearRangeRequest(const ClearRangeRequest& from);
 
-  inline ScanRequest& operator=(const ScanRequest& from) {
+  inline ClearRangeRequest& operator=(const ClearRangeRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1730,17 +2115,17 @@ class ScanRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ScanRequest& default_instance();
+  static const ClearRangeRequest& default_instance();
 
-  void Swap(ScanRequest* other);
+  void Swap(ClearRangeRequest* other);
 
   // implements Message ----------------------------------------------
 
-  ScanRequest* New() const;
+  ClearRangeRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ScanRequest& from);
-  void MergeFrom(const ScanRequest& from);
+  void CopyFrom(const ClearRangeRequest& from);
+  void MergeFrom(const ClearRangeRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1771,43 +2156,33 @@ class ScanRequest : public ::google::protobuf::Message {
   inline ::cockroach::proto::RequestHeader* release_header();
   inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional int64 max_results = 2;
-  inline bool has_max_results() const;
-  inline void clear_max_results();
-  static const int kMaxResultsFieldNumber = 2;
-  inline ::google::protobuf::int64 max_results() const;
-  inline void set_max_results(::google::protobuf::int64 value);
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ClearRangeRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_max_results();
-  inline void clear_has_max_results();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::google::protobuf::int64 max_results_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static ScanRequest* default_instance_;
+  static ClearRangeRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class ScanResponse : public ::google::protobuf::Message {
+class ClearRangeResponse : public ::google::protobuf::Message {
  public:
-  ScanResponse();
-  virtual ~ScanResponse();
+  ClearRangeResponse();
+  virtual ~ClearRangeResponse();
 
-  ScanResponse(const ScanResponse& from);
+  ClearRangeResponse(const ClearRangeResponse& from);
 
-  inline ScanResponse& operator=(const ScanResponse& from) {
+  inline ClearRangeResponse& operator=(const ClearRangeResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1821,17 +2196,17 @@ class ScanResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ScanResponse& default_instance();
+  static const ClearRangeResponse& default_instance();
 
-  void Swap(ScanResponse* other);
+  void Swap(ClearRangeResponse* other);
 
   // implements Message ----------------------------------------------
 
-  ScanResponse* New() const;
+  ClearRangeResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ScanResponse& from);
-  void MergeFrom(const ScanResponse& from);
+  void CopyFrom(const ClearRangeResponse& from);
+  void MergeFrom(const ClearRangeResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1862,19 +2237,7 @@ class ScanResponse : public ::google::protobuf::Message {
   inline ::cockroach::proto::ResponseHeader* release_header();
   inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // repeated .cockroach.proto.KeyValue rows = 2;
-  inline int rows_size() const;
-  inline void clear_rows();
-  static const int kRowsFieldNumber = 2;
-  inline const ::cockroach::proto::KeyValue& rows(int index) const;
-  inline ::cockroach::proto::KeyValue* mutable_rows(int index);
-  inline ::cockroach::proto::KeyValue* add_rows();
-  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
-      rows() const;
-  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
-      mutable_rows();
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanResponse)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ClearRangeResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
@@ -1884,24 +2247,23 @@ class ScanResponse : public ::google::protobuf::Message {
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue > rows_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static ScanResponse* default_instance_;
+  static ClearRangeResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class EndTransactionRequest : public ::google::protobuf::Message {
+class ScanRequest : public ::google::protobuf::Message {
  public:
-  EndTransactionRequest();
-  virtual ~EndTransactionRequest();
+  ScanRequest();
+  virtual ~ScanRequest();
 
-  EndTransactionRequest(const EndTransactionRequest& from);
+  ScanRequest(const ScanRequest& from);
 
-  inline EndTransactionRequest& operator=(const EndTransactionRequest& from) {
+  inline ScanRequest& operator=(const ScanRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1915,17 +2277,17 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const EndTransactionRequest& default_instance();
+  static const ScanRequest& default_instance();
 
-  void Swap(EndTransactionRequest* other);
+  void Swap(ScanRequest* other);
 
   // implements Message ----------------------------------------------
 
-  EndTransactionRequest* New() const;
+  ScanRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const EndTransactionRequest& from);
-  void MergeFrom(const EndTransactionRequest& from);
+  void CopyFrom(const ScanRequest& from);
+  void MergeFrom(const ScanRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1956,55 +2318,53 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   inline ::cockroach::proto::RequestHeader* release_header();
   inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional bool commit = 2;
-  inline bool has_commit() const;
-  inline void clear_commit();
-  static const int kCommitFieldNumber = 2;
-  inline bool commit() const;
-  inline void set_commit(bool value);
+  // optional int64 max_results = 2;
+  inline bool has_max_results() const;
+  inline void clear_max_results();
+  static const int kMaxResultsFieldNumber = 2;
+  inline ::google::protobuf::int64 max_results() const;
+  inline void set_max_results(::google::protobuf::int64 value);
 
-  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-  inline bool has_internal_commit_trigger() const;
-  inline void clear_internal_commit_trigger();
-  static const int kInternalCommitTriggerFieldNumber = 3;
-  inline const ::cockroach::proto::InternalCommitTrigger& internal_commit_trigger() const;
-  inline ::cockroach::proto::InternalCommitTrigger* mutable_internal_commit_trigger();
-  inline ::cockroach::proto::InternalCommitTrigger* release_internal_commit_trigger();
-  inline void set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger);
+  // optional int64 target_bytes = 3;
+  inline bool has_target_bytes() const;
+  inline void clear_target_bytes();
+  static const int kTargetBytesFieldNumber = 3;
+  inline ::google::protobuf::int64 target_bytes() const;
+  inline void set_target_bytes(::google::protobuf::int64 value);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_commit();
-  inline void clear_has_commit();
-  inline void set_has_internal_commit_trigger();
-  inline void clear_has_internal_commit_trigger();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
+  inline void set_has_target_bytes();
+  inline void clear_has_target_bytes();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::cockroach::proto::InternalCommitTrigger* internal_commit_trigger_;
-  bool commit_;
+  ::google::protobuf::int64 max_results_;
+  ::google::protobuf::int64 target_bytes_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static EndTransactionRequest* default_instance_;
+  static ScanRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class EndTransactionResponse : public ::google::protobuf::Message {
+class ScanResponse : public ::google::protobuf::Message {
  public:
-  EndTransactionResponse();
-  virtual ~EndTransactionResponse();
+  ScanResponse();
+  virtual ~ScanResponse();
 
-  EndTransactionResponse(const EndTransactionResponse& from);
+  ScanResponse(const ScanResponse& from);
 
-  inline EndTransactionResponse& operator=(const EndTransactionResponse& from) {
+  inline ScanResponse& operator=(const ScanResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2018,17 +2378,17 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const EndTransactionResponse& default_instance();
+  static const ScanResponse& default_instance();
 
-  void Swap(EndTransactionResponse* other);
+  void Swap(ScanResponse* other);
 
   // implements Message ----------------------------------------------
 
-  EndTransactionResponse* New() const;
+  ScanResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const EndTransactionResponse& from);
-  void MergeFrom(const EndTransactionResponse& from);
+  void CopyFrom(const ScanResponse& from);
+  void MergeFrom(const ScanResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2059,60 +2419,58 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   inline ::cockroach::proto::ResponseHeader* release_header();
   inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // optional int64 commit_wait = 2;
-  inline bool has_commit_wait() const;
-  inline void clear_commit_wait();
-  static const int kCommitWaitFieldNumber = 2;
-  inline ::google::protobuf::int64 commit_wait() const;
-  inline void set_commit_wait(::google::protobuf::int64 value);
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  inline int rows_size() const;
+  inline void clear_rows();
+  static const int kRowsFieldNumber = 2;
+  inline const ::cockroach::proto::KeyValue& rows(int index) const;
+  inline ::cockroach::proto::KeyValue* mutable_rows(int index);
+  inline ::cockroach::proto::KeyValue* add_rows();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+      rows() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+      mutable_rows();
 
-  // repeated bytes resolved = 3;
-  inline int resolved_size() const;
-  inline void clear_resolved();
-  static const int kResolvedFieldNumber = 3;
-  inline const ::std::string& resolved(int index) const;
-  inline ::std::string* mutable_resolved(int index);
-  inline void set_resolved(int index, const ::std::string& value);
-  inline void set_resolved(int index, const char* value);
-  inline void set_resolved(int index, const void* value, size_t size);
-  inline ::std::string* add_resolved();
-  inline void add_resolved(const ::std::string& value);
-  inline void add_resolved(const char* value);
-  inline void add_resolved(const void* value, size_t size);
-  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& resolved() const;
-  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_resolved();
+  // optional .cockroach.proto.Span resume_span = 3;
+  inline bool has_resume_span() const;
+  inline void clear_resume_span();
+  static const int kResumeSpanFieldNumber = 3;
+  inline const ::cockroach::proto::Span& resume_span() const;
+  inline ::cockroach::proto::Span* mutable_resume_span();
+  inline ::cockroach::proto::Span* release_resume_span();
+  inline void set_allocated_resume_span(::cockroach::proto::Span* resume_span);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionResponse)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_commit_wait();
-  inline void clear_has_commit_wait();
+  inline void set_has_resume_span();
+  inline void clear_has_resume_span();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
-  ::google::protobuf::int64 commit_wait_;
-  ::google::protobuf::RepeatedPtrField< ::std::string> resolved_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue > rows_;
+  ::cockroach::proto::Span* resume_span_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static EndTransactionResponse* default_instance_;
+  static ScanResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class RequestUnion : public ::google::protobuf::Message {
+class AggregateRequest : public ::google::protobuf::Message {
  public:
-  RequestUnion();
-  virtual ~RequestUnion();
+  AggregateRequest();
+  virtual ~AggregateRequest();
 
-  RequestUnion(const RequestUnion& from);
+  AggregateRequest(const AggregateRequest& from);
 
-  inline RequestUnion& operator=(const RequestUnion& from) {
+  inline AggregateRequest& operator=(const AggregateRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2126,30 +2484,17 @@ class RequestUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RequestUnion& default_instance();
-
-  enum ValueCase {
-    kContains = 1,
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    VALUE_NOT_SET = 0,
-  };
+  static const AggregateRequest& default_instance();
 
-  void Swap(RequestUnion* other);
+  void Swap(AggregateRequest* other);
 
   // implements Message ----------------------------------------------
 
-  RequestUnion* New() const;
+  AggregateRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RequestUnion& from);
-  void MergeFrom(const RequestUnion& from);
+  void CopyFrom(const AggregateRequest& from);
+  void MergeFrom(const AggregateRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2171,141 +2516,55 @@ class RequestUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::cockroach::proto::ContainsRequest& contains() const;
-  inline ::cockroach::proto::ContainsRequest* mutable_contains();
-  inline ::cockroach::proto::ContainsRequest* release_contains();
-  inline void set_allocated_contains(::cockroach::proto::ContainsRequest* contains);
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional .cockroach.proto.GetRequest get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
-  static const int kGetFieldNumber = 2;
-  inline const ::cockroach::proto::GetRequest& get() const;
-  inline ::cockroach::proto::GetRequest* mutable_get();
-  inline ::cockroach::proto::GetRequest* release_get();
-  inline void set_allocated_get(::cockroach::proto::GetRequest* get);
+  // optional int64 max_results = 2;
+  inline bool has_max_results() const;
+  inline void clear_max_results();
+  static const int kMaxResultsFieldNumber = 2;
+  inline ::google::protobuf::int64 max_results() const;
+  inline void set_max_results(::google::protobuf::int64 value);
 
-  // optional .cockroach.proto.PutRequest put = 3;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 3;
-  inline const ::cockroach::proto::PutRequest& put() const;
-  inline ::cockroach::proto::PutRequest* mutable_put();
-  inline ::cockroach::proto::PutRequest* release_put();
-  inline void set_allocated_put(::cockroach::proto::PutRequest* put);
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AggregateRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
 
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  inline const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
-  inline ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
-  inline ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
-  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  inline const ::cockroach::proto::IncrementRequest& increment() const;
-  inline ::cockroach::proto::IncrementRequest* mutable_increment();
-  inline ::cockroach::proto::IncrementRequest* release_increment();
-  inline void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::int64 max_results_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  inline const ::cockroach::proto::DeleteRequest& delete_() const;
-  inline ::cockroach::proto::DeleteRequest* mutable_delete_();
-  inline ::cockroach::proto::DeleteRequest* release_delete_();
-  inline void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
+  void InitAsDefaultInstance();
+  static AggregateRequest* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  inline const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
-  inline ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
-  inline ::cockroach::proto::DeleteRangeRequest* release_delete_range();
-  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
+class AggregateResponse : public ::google::protobuf::Message {
+ public:
+  AggregateResponse();
+  virtual ~AggregateResponse();
 
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  inline bool has_scan() const;
-  inline void clear_scan();
-  static const int kScanFieldNumber = 8;
-  inline const ::cockroach::proto::ScanRequest& scan() const;
-  inline ::cockroach::proto::ScanRequest* mutable_scan();
-  inline ::cockroach::proto::ScanRequest* release_scan();
-  inline void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
+  AggregateResponse(const AggregateResponse& from);
 
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  inline const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
-  inline ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
-  inline ::cockroach::proto::EndTransactionRequest* release_end_transaction();
-  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
-
-  inline ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestUnion)
- private:
-  inline void set_has_contains();
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-
-  inline bool has_value();
-  void clear_value();
-  inline void clear_has_value();
-
-  ::google::protobuf::UnknownFieldSet _unknown_fields_;
-
-  ::google::protobuf::uint32 _has_bits_[1];
-  mutable int _cached_size_;
-  union ValueUnion {
-    ::cockroach::proto::ContainsRequest* contains_;
-    ::cockroach::proto::GetRequest* get_;
-    ::cockroach::proto::PutRequest* put_;
-    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
-    ::cockroach::proto::IncrementRequest* increment_;
-    ::cockroach::proto::DeleteRequest* delete__;
-    ::cockroach::proto::DeleteRangeRequest* delete_range_;
-    ::cockroach::proto::ScanRequest* scan_;
-    ::cockroach::proto::EndTransactionRequest* end_transaction_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
-  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
-  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
-  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
-
-  void InitAsDefaultInstance();
-  static RequestUnion* default_instance_;
-};
-// -------------------------------------------------------------------
-
-class ResponseUnion : public ::google::protobuf::Message {
- public:
-  ResponseUnion();
-  virtual ~ResponseUnion();
-
-  ResponseUnion(const ResponseUnion& from);
-
-  inline ResponseUnion& operator=(const ResponseUnion& from) {
-    CopyFrom(from);
-    return *this;
-  }
+  inline AggregateResponse& operator=(const AggregateResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
   inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
     return _unknown_fields_;
@@ -2316,30 +2575,17 @@ class ResponseUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ResponseUnion& default_instance();
-
-  enum ValueCase {
-    kContains = 1,
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    VALUE_NOT_SET = 0,
-  };
+  static const AggregateResponse& default_instance();
 
-  void Swap(ResponseUnion* other);
+  void Swap(AggregateResponse* other);
 
   // implements Message ----------------------------------------------
 
-  ResponseUnion* New() const;
+  AggregateResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ResponseUnion& from);
-  void MergeFrom(const ResponseUnion& from);
+  void CopyFrom(const AggregateResponse& from);
+  void MergeFrom(const AggregateResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2361,138 +2607,62 @@ class ResponseUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ContainsResponse contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::cockroach::proto::ContainsResponse& contains() const;
-  inline ::cockroach::proto::ContainsResponse* mutable_contains();
-  inline ::cockroach::proto::ContainsResponse* release_contains();
-  inline void set_allocated_contains(::cockroach::proto::ContainsResponse* contains);
-
-  // optional .cockroach.proto.GetResponse get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
-  static const int kGetFieldNumber = 2;
-  inline const ::cockroach::proto::GetResponse& get() const;
-  inline ::cockroach::proto::GetResponse* mutable_get();
-  inline ::cockroach::proto::GetResponse* release_get();
-  inline void set_allocated_get(::cockroach::proto::GetResponse* get);
-
-  // optional .cockroach.proto.PutResponse put = 3;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 3;
-  inline const ::cockroach::proto::PutResponse& put() const;
-  inline ::cockroach::proto::PutResponse* mutable_put();
-  inline ::cockroach::proto::PutResponse* release_put();
-  inline void set_allocated_put(::cockroach::proto::PutResponse* put);
-
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  inline const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
-  inline ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
-  inline ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
-  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
-
-  // optional .cockroach.proto.IncrementResponse increment = 5;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  inline const ::cockroach::proto::IncrementResponse& increment() const;
-  inline ::cockroach::proto::IncrementResponse* mutable_increment();
-  inline ::cockroach::proto::IncrementResponse* release_increment();
-  inline void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
-
-  // optional .cockroach.proto.DeleteResponse delete = 6;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  inline const ::cockroach::proto::DeleteResponse& delete_() const;
-  inline ::cockroach::proto::DeleteResponse* mutable_delete_();
-  inline ::cockroach::proto::DeleteResponse* release_delete_();
-  inline void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
-
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  inline const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
-  inline ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
-  inline ::cockroach::proto::DeleteRangeResponse* release_delete_range();
-  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
-
-  // optional .cockroach.proto.ScanResponse scan = 8;
-  inline bool has_scan() const;
-  inline void clear_scan();
-  static const int kScanFieldNumber = 8;
-  inline const ::cockroach::proto::ScanResponse& scan() const;
-  inline ::cockroach::proto::ScanResponse* mutable_scan();
-  inline ::cockroach::proto::ScanResponse* release_scan();
-  inline void set_allocated_scan(::cockroach::proto::ScanResponse* scan);
-
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  inline const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
-  inline ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
-  inline ::cockroach::proto::EndTransactionResponse* release_end_transaction();
-  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  inline ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.ResponseUnion)
+  // optional int64 num_keys = 2;
+  inline bool has_num_keys() const;
+  inline void clear_num_keys();
+  static const int kNumKeysFieldNumber = 2;
+  inline ::google::protobuf::int64 num_keys() const;
+  inline void set_num_keys(::google::protobuf::int64 value);
+
+  // optional int64 sum = 3;
+  inline bool has_sum() const;
+  inline void clear_sum();
+  static const int kSumFieldNumber = 3;
+  inline ::google::protobuf::int64 sum() const;
+  inline void set_sum(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AggregateResponse)
  private:
-  inline void set_has_contains();
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-
-  inline bool has_value();
-  void clear_value();
-  inline void clear_has_value();
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_num_keys();
+  inline void clear_has_num_keys();
+  inline void set_has_sum();
+  inline void clear_has_sum();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ::cockroach::proto::ContainsResponse* contains_;
-    ::cockroach::proto::GetResponse* get_;
-    ::cockroach::proto::PutResponse* put_;
-    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
-    ::cockroach::proto::IncrementResponse* increment_;
-    ::cockroach::proto::DeleteResponse* delete__;
-    ::cockroach::proto::DeleteRangeResponse* delete_range_;
-    ::cockroach::proto::ScanResponse* scan_;
-    ::cockroach::proto::EndTransactionResponse* end_transaction_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::int64 num_keys_;
+  ::google::protobuf::int64 sum_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static ResponseUnion* default_instance_;
+  static AggregateResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class BatchRequest : public ::google::protobuf::Message {
+class WatchRequest : public ::google::protobuf::Message {
  public:
-  BatchRequest();
-  virtual ~BatchRequest();
+  WatchRequest();
+  virtual ~WatchRequest();
 
-  BatchRequest(const BatchRequest& from);
+  WatchRequest(const WatchRequest& from);
 
-  inline BatchRequest& operator=(const BatchRequest& from) {
+  inline WatchRequest& operator=(const WatchRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2506,17 +2676,17 @@ class BatchRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchRequest& default_instance();
+  static const WatchRequest& default_instance();
 
-  void Swap(BatchRequest* other);
+  void Swap(WatchRequest* other);
 
   // implements Message ----------------------------------------------
 
-  BatchRequest* New() const;
+  WatchRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchRequest& from);
-  void MergeFrom(const BatchRequest& from);
+  void CopyFrom(const WatchRequest& from);
+  void MergeFrom(const WatchRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2547,46 +2717,70 @@ class BatchRequest : public ::google::protobuf::Message {
   inline ::cockroach::proto::RequestHeader* release_header();
   inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // repeated .cockroach.proto.RequestUnion requests = 2;
-  inline int requests_size() const;
-  inline void clear_requests();
-  static const int kRequestsFieldNumber = 2;
-  inline const ::cockroach::proto::RequestUnion& requests(int index) const;
-  inline ::cockroach::proto::RequestUnion* mutable_requests(int index);
-  inline ::cockroach::proto::RequestUnion* add_requests();
-  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
-      requests() const;
-  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
-      mutable_requests();
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchRequest)
+  // optional bytes limit_key = 2;
+  inline bool has_limit_key() const;
+  inline void clear_limit_key();
+  static const int kLimitKeyFieldNumber = 2;
+  inline const ::std::string& limit_key() const;
+  inline void set_limit_key(const ::std::string& value);
+  inline void set_limit_key(const char* value);
+  inline void set_limit_key(const void* value, size_t size);
+  inline ::std::string* mutable_limit_key();
+  inline ::std::string* release_limit_key();
+  inline void set_allocated_limit_key(::std::string* limit_key);
+
+  // optional .cockroach.proto.Timestamp start_time = 3;
+  inline bool has_start_time() const;
+  inline void clear_start_time();
+  static const int kStartTimeFieldNumber = 3;
+  inline const ::cockroach::proto::Timestamp& start_time() const;
+  inline ::cockroach::proto::Timestamp* mutable_start_time();
+  inline ::cockroach::proto::Timestamp* release_start_time();
+  inline void set_allocated_start_time(::cockroach::proto::Timestamp* start_time);
+
+  // optional int64 max_wait = 4;
+  inline bool has_max_wait() const;
+  inline void clear_max_wait();
+  static const int kMaxWaitFieldNumber = 4;
+  inline ::google::protobuf::int64 max_wait() const;
+  inline void set_max_wait(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.WatchRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_limit_key();
+  inline void clear_has_limit_key();
+  inline void set_has_start_time();
+  inline void clear_has_start_time();
+  inline void set_has_max_wait();
+  inline void clear_has_max_wait();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion > requests_;
+  ::std::string* limit_key_;
+  ::cockroach::proto::Timestamp* start_time_;
+  ::google::protobuf::int64 max_wait_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static BatchRequest* default_instance_;
+  static WatchRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class BatchResponse : public ::google::protobuf::Message {
+class WatchEvent : public ::google::protobuf::Message {
  public:
-  BatchResponse();
-  virtual ~BatchResponse();
+  WatchEvent();
+  virtual ~WatchEvent();
 
-  BatchResponse(const BatchResponse& from);
+  WatchEvent(const WatchEvent& from);
 
-  inline BatchResponse& operator=(const BatchResponse& from) {
+  inline WatchEvent& operator=(const WatchEvent& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2600,17 +2794,17 @@ class BatchResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchResponse& default_instance();
+  static const WatchEvent& default_instance();
 
-  void Swap(BatchResponse* other);
+  void Swap(WatchEvent* other);
 
   // implements Message ----------------------------------------------
 
-  BatchResponse* New() const;
+  WatchEvent* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchResponse& from);
-  void MergeFrom(const BatchResponse& from);
+  void CopyFrom(const WatchEvent& from);
+  void MergeFrom(const WatchEvent& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2632,55 +2826,57 @@ class BatchResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ResponseHeader header = 1;
-  inline bool has_header() const;
-  inline void clear_header();
-  static const int kHeaderFieldNumber = 1;
-  inline const ::cockroach::proto::ResponseHeader& header() const;
-  inline ::cockroach::proto::ResponseHeader* mutable_header();
-  inline ::cockroach::proto::ResponseHeader* release_header();
-  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
-
-  // repeated .cockroach.proto.ResponseUnion responses = 2;
-  inline int responses_size() const;
-  inline void clear_responses();
-  static const int kResponsesFieldNumber = 2;
-  inline const ::cockroach::proto::ResponseUnion& responses(int index) const;
-  inline ::cockroach::proto::ResponseUnion* mutable_responses(int index);
-  inline ::cockroach::proto::ResponseUnion* add_responses();
-  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
-      responses() const;
-  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
-      mutable_responses();
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchResponse)
+  // optional .cockroach.proto.Value value = 2;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 2;
+  inline const ::cockroach::proto::Value& value() const;
+  inline ::cockroach::proto::Value* mutable_value();
+  inline ::cockroach::proto::Value* release_value();
+  inline void set_allocated_value(::cockroach::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.WatchEvent)
  private:
-  inline void set_has_header();
-  inline void clear_has_header();
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_value();
+  inline void clear_has_value();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::ResponseHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion > responses_;
+  ::std::string* key_;
+  ::cockroach::proto::Value* value_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static BatchResponse* default_instance_;
+  static WatchEvent* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminSplitRequest : public ::google::protobuf::Message {
+class WatchResponse : public ::google::protobuf::Message {
  public:
-  AdminSplitRequest();
-  virtual ~AdminSplitRequest();
+  WatchResponse();
+  virtual ~WatchResponse();
 
-  AdminSplitRequest(const AdminSplitRequest& from);
+  WatchResponse(const WatchResponse& from);
 
-  inline AdminSplitRequest& operator=(const AdminSplitRequest& from) {
+  inline WatchResponse& operator=(const WatchResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2694,17 +2890,17 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminSplitRequest& default_instance();
+  static const WatchResponse& default_instance();
 
-  void Swap(AdminSplitRequest* other);
+  void Swap(WatchResponse* other);
 
   // implements Message ----------------------------------------------
 
-  AdminSplitRequest* New() const;
+  WatchResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminSplitRequest& from);
-  void MergeFrom(const AdminSplitRequest& from);
+  void CopyFrom(const WatchResponse& from);
+  void MergeFrom(const WatchResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2726,57 +2922,70 @@ class AdminSplitRequest : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.RequestHeader header = 1;
+  // optional .cockroach.proto.ResponseHeader header = 1;
   inline bool has_header() const;
   inline void clear_header();
   static const int kHeaderFieldNumber = 1;
-  inline const ::cockroach::proto::RequestHeader& header() const;
-  inline ::cockroach::proto::RequestHeader* mutable_header();
-  inline ::cockroach::proto::RequestHeader* release_header();
-  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // optional bytes split_key = 2;
-  inline bool has_split_key() const;
-  inline void clear_split_key();
-  static const int kSplitKeyFieldNumber = 2;
-  inline const ::std::string& split_key() const;
-  inline void set_split_key(const ::std::string& value);
-  inline void set_split_key(const char* value);
-  inline void set_split_key(const void* value, size_t size);
-  inline ::std::string* mutable_split_key();
-  inline ::std::string* release_split_key();
-  inline void set_allocated_split_key(::std::string* split_key);
+  // repeated .cockroach.proto.WatchEvent events = 2;
+  inline int events_size() const;
+  inline void clear_events();
+  static const int kEventsFieldNumber = 2;
+  inline const ::cockroach::proto::WatchEvent& events(int index) const;
+  inline ::cockroach::proto::WatchEvent* mutable_events(int index);
+  inline ::cockroach::proto::WatchEvent* add_events();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::WatchEvent >&
+      events() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::WatchEvent >*
+      mutable_events();
+
+  // optional bytes end_key = 3;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 3;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.WatchResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_split_key();
-  inline void clear_has_split_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::RequestHeader* header_;
-  ::std::string* split_key_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::WatchEvent > events_;
+  ::std::string* end_key_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminSplitRequest* default_instance_;
+  static WatchResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminSplitResponse : public ::google::protobuf::Message {
+class EndTransactionRequest : public ::google::protobuf::Message {
  public:
-  AdminSplitResponse();
-  virtual ~AdminSplitResponse();
+  EndTransactionRequest();
+  virtual ~EndTransactionRequest();
 
-  AdminSplitResponse(const AdminSplitResponse& from);
+  EndTransactionRequest(const EndTransactionRequest& from);
 
-  inline AdminSplitResponse& operator=(const AdminSplitResponse& from) {
+  inline EndTransactionRequest& operator=(const EndTransactionRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2790,17 +2999,17 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminSplitResponse& default_instance();
+  static const EndTransactionRequest& default_instance();
 
-  void Swap(AdminSplitResponse* other);
+  void Swap(EndTransactionRequest* other);
 
   // implements Message ----------------------------------------------
 
-  AdminSplitResponse* New() const;
+  EndTransactionRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminSplitResponse& from);
-  void MergeFrom(const AdminSplitResponse& from);
+  void CopyFrom(const EndTransactionRequest& from);
+  void MergeFrom(const EndTransactionRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2822,42 +3031,76 @@ class AdminSplitResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ResponseHeader header = 1;
+  // optional .cockroach.proto.RequestHeader header = 1;
   inline bool has_header() const;
   inline void clear_header();
   static const int kHeaderFieldNumber = 1;
-  inline const ::cockroach::proto::ResponseHeader& header() const;
-  inline ::cockroach::proto::ResponseHeader* mutable_header();
-  inline ::cockroach::proto::ResponseHeader* release_header();
-  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitResponse)
+  // optional bool commit = 2;
+  inline bool has_commit() const;
+  inline void clear_commit();
+  static const int kCommitFieldNumber = 2;
+  inline bool commit() const;
+  inline void set_commit(bool value);
+
+  // optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+  inline bool has_internal_commit_trigger() const;
+  inline void clear_internal_commit_trigger();
+  static const int kInternalCommitTriggerFieldNumber = 3;
+  inline const ::cockroach::proto::InternalCommitTrigger& internal_commit_trigger() const;
+  inline ::cockroach::proto::InternalCommitTrigger* mutable_internal_commit_trigger();
+  inline ::cockroach::proto::InternalCommitTrigger* release_internal_commit_trigger();
+  inline void set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger);
+
+  // optional .cockroach.proto.Timestamp refreshed_timestamp = 4;
+  inline bool has_refreshed_timestamp() const;
+  inline void clear_refreshed_timestamp();
+  static const int kRefreshedTimestampFieldNumber = 4;
+  inline const ::cockroach::proto::Timestamp& refreshed_timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_refreshed_timestamp();
+  inline ::cockroach::proto::Timestamp* release_refreshed_timestamp();
+  inline void set_allocated_refreshed_timestamp(::cockroach::proto::Timestamp* refreshed_timestamp);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_commit();
+  inline void clear_has_commit();
+  inline void set_has_internal_commit_trigger();
+  inline void clear_has_internal_commit_trigger();
+  inline void set_has_refreshed_timestamp();
+  inline void clear_has_refreshed_timestamp();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::InternalCommitTrigger* internal_commit_trigger_;
+  ::cockroach::proto::Timestamp* refreshed_timestamp_;
+  bool commit_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminSplitResponse* default_instance_;
+  static EndTransactionRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminMergeRequest : public ::google::protobuf::Message {
+class EndTransactionResponse : public ::google::protobuf::Message {
  public:
-  AdminMergeRequest();
-  virtual ~AdminMergeRequest();
+  EndTransactionResponse();
+  virtual ~EndTransactionResponse();
 
-  AdminMergeRequest(const AdminMergeRequest& from);
+  EndTransactionResponse(const EndTransactionResponse& from);
 
-  inline AdminMergeRequest& operator=(const AdminMergeRequest& from) {
+  inline EndTransactionResponse& operator=(const EndTransactionResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2871,17 +3114,17 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminMergeRequest& default_instance();
+  static const EndTransactionResponse& default_instance();
 
-  void Swap(AdminMergeRequest* other);
+  void Swap(EndTransactionResponse* other);
 
   // implements Message ----------------------------------------------
 
-  AdminMergeRequest* New() const;
+  EndTransactionResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminMergeRequest& from);
-  void MergeFrom(const AdminMergeRequest& from);
+  void CopyFrom(const EndTransactionResponse& from);
+  void MergeFrom(const EndTransactionResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2903,42 +3146,69 @@ class AdminMergeRequest : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.RequestHeader header = 1;
+  // optional .cockroach.proto.ResponseHeader header = 1;
   inline bool has_header() const;
   inline void clear_header();
   static const int kHeaderFieldNumber = 1;
-  inline const ::cockroach::proto::RequestHeader& header() const;
-  inline ::cockroach::proto::RequestHeader* mutable_header();
-  inline ::cockroach::proto::RequestHeader* release_header();
-  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeRequest)
+  // optional int64 commit_wait = 2;
+  inline bool has_commit_wait() const;
+  inline void clear_commit_wait();
+  static const int kCommitWaitFieldNumber = 2;
+  inline ::google::protobuf::int64 commit_wait() const;
+  inline void set_commit_wait(::google::protobuf::int64 value);
+
+  // repeated bytes resolved = 3;
+  inline int resolved_size() const;
+  inline void clear_resolved();
+  static const int kResolvedFieldNumber = 3;
+  inline const ::std::string& resolved(int index) const;
+  inline ::std::string* mutable_resolved(int index);
+  inline void set_resolved(int index, const ::std::string& value);
+  inline void set_resolved(int index, const char* value);
+  inline void set_resolved(int index, const void* value, size_t size);
+  inline ::std::string* add_resolved();
+  inline void add_resolved(const ::std::string& value);
+  inline void add_resolved(const char* value);
+  inline void add_resolved(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& resolved() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_resolved();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EndTransactionResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_commit_wait();
+  inline void clear_has_commit_wait();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::int64 commit_wait_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> resolved_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminMergeRequest* default_instance_;
+  static EndTransactionResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminMergeResponse : public ::google::protobuf::Message {
+class RequestUnion : public ::google::protobuf::Message {
  public:
-  AdminMergeResponse();
-  virtual ~AdminMergeResponse();
+  RequestUnion();
+  virtual ~RequestUnion();
 
-  AdminMergeResponse(const AdminMergeResponse& from);
+  RequestUnion(const RequestUnion& from);
 
-  inline AdminMergeResponse& operator=(const AdminMergeResponse& from) {
+  inline RequestUnion& operator=(const RequestUnion& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2952,17 +3222,32 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminMergeResponse& default_instance();
+  static const RequestUnion& default_instance();
 
-  void Swap(AdminMergeResponse* other);
+  enum ValueCase {
+    kContains = 1,
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kInitPut = 10,
+    kAggregate = 11,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(RequestUnion* other);
 
   // implements Message ----------------------------------------------
 
-  AdminMergeResponse* New() const;
+  RequestUnion* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminMergeResponse& from);
-  void MergeFrom(const AdminMergeResponse& from);
+  void CopyFrom(const RequestUnion& from);
+  void MergeFrom(const RequestUnion& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2984,226 +3269,4605 @@ class AdminMergeResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ResponseHeader header = 1;
-  inline bool has_header() const;
-  inline void clear_header();
-  static const int kHeaderFieldNumber = 1;
-  inline const ::cockroach::proto::ResponseHeader& header() const;
-  inline ::cockroach::proto::ResponseHeader* mutable_header();
-  inline ::cockroach::proto::ResponseHeader* release_header();
-  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeResponse)
- private:
-  inline void set_has_header();
-  inline void clear_has_header();
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::cockroach::proto::ContainsRequest& contains() const;
+  inline ::cockroach::proto::ContainsRequest* mutable_contains();
+  inline ::cockroach::proto::ContainsRequest* release_contains();
+  inline void set_allocated_contains(::cockroach::proto::ContainsRequest* contains);
 
-  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+  // optional .cockroach.proto.GetRequest get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 2;
+  inline const ::cockroach::proto::GetRequest& get() const;
+  inline ::cockroach::proto::GetRequest* mutable_get();
+  inline ::cockroach::proto::GetRequest* release_get();
+  inline void set_allocated_get(::cockroach::proto::GetRequest* get);
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 3;
+  inline const ::cockroach::proto::PutRequest& put() const;
+  inline ::cockroach::proto::PutRequest* mutable_put();
+  inline ::cockroach::proto::PutRequest* release_put();
+  inline void set_allocated_put(::cockroach::proto::PutRequest* put);
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  inline const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
+  inline ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
+  inline ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
+  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  inline const ::cockroach::proto::IncrementRequest& increment() const;
+  inline ::cockroach::proto::IncrementRequest* mutable_increment();
+  inline ::cockroach::proto::IncrementRequest* release_increment();
+  inline void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  inline const ::cockroach::proto::DeleteRequest& delete_() const;
+  inline ::cockroach::proto::DeleteRequest* mutable_delete_();
+  inline ::cockroach::proto::DeleteRequest* release_delete_();
+  inline void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  inline const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
+  inline ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
+  inline ::cockroach::proto::DeleteRangeRequest* release_delete_range();
+  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  inline bool has_scan() const;
+  inline void clear_scan();
+  static const int kScanFieldNumber = 8;
+  inline const ::cockroach::proto::ScanRequest& scan() const;
+  inline ::cockroach::proto::ScanRequest* mutable_scan();
+  inline ::cockroach::proto::ScanRequest* release_scan();
+  inline void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  inline const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
+  inline ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
+  inline ::cockroach::proto::EndTransactionRequest* release_end_transaction();
+  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
+
+  // optional .cockroach.proto.InitPutRequest init_put = 10;
+  inline bool has_init_put() const;
+  inline void clear_init_put();
+  static const int kInitPutFieldNumber = 10;
+  inline const ::cockroach::proto::InitPutRequest& init_put() const;
+  inline ::cockroach::proto::InitPutRequest* mutable_init_put();
+  inline ::cockroach::proto::InitPutRequest* release_init_put();
+  inline void set_allocated_init_put(::cockroach::proto::InitPutRequest* init_put);
+
+  // optional .cockroach.proto.AggregateRequest aggregate = 11;
+  inline bool has_aggregate() const;
+  inline void clear_aggregate();
+  static const int kAggregateFieldNumber = 11;
+  inline const ::cockroach::proto::AggregateRequest& aggregate() const;
+  inline ::cockroach::proto::AggregateRequest* mutable_aggregate();
+  inline ::cockroach::proto::AggregateRequest* release_aggregate();
+  inline void set_allocated_aggregate(::cockroach::proto::AggregateRequest* aggregate);
+
+  inline ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestUnion)
+ private:
+  inline void set_has_contains();
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_init_put();
+  inline void set_has_aggregate();
+
+  inline bool has_value();
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::ResponseHeader* header_;
+  union ValueUnion {
+    ::cockroach::proto::ContainsRequest* contains_;
+    ::cockroach::proto::GetRequest* get_;
+    ::cockroach::proto::PutRequest* put_;
+    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
+    ::cockroach::proto::IncrementRequest* increment_;
+    ::cockroach::proto::DeleteRequest* delete__;
+    ::cockroach::proto::DeleteRangeRequest* delete_range_;
+    ::cockroach::proto::ScanRequest* scan_;
+    ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::InitPutRequest* init_put_;
+    ::cockroach::proto::AggregateRequest* aggregate_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
-  void InitAsDefaultInstance();
-  static AdminMergeResponse* default_instance_;
-};
-// ===================================================================
+  void InitAsDefaultInstance();
+  static RequestUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ResponseUnion : public ::google::protobuf::Message {
+ public:
+  ResponseUnion();
+  virtual ~ResponseUnion();
+
+  ResponseUnion(const ResponseUnion& from);
+
+  inline ResponseUnion& operator=(const ResponseUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ResponseUnion& default_instance();
+
+  enum ValueCase {
+    kContains = 1,
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kInitPut = 10,
+    kAggregate = 11,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(ResponseUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  ResponseUnion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ResponseUnion& from);
+  void MergeFrom(const ResponseUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ContainsResponse contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::cockroach::proto::ContainsResponse& contains() const;
+  inline ::cockroach::proto::ContainsResponse* mutable_contains();
+  inline ::cockroach::proto::ContainsResponse* release_contains();
+  inline void set_allocated_contains(::cockroach::proto::ContainsResponse* contains);
+
+  // optional .cockroach.proto.GetResponse get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 2;
+  inline const ::cockroach::proto::GetResponse& get() const;
+  inline ::cockroach::proto::GetResponse* mutable_get();
+  inline ::cockroach::proto::GetResponse* release_get();
+  inline void set_allocated_get(::cockroach::proto::GetResponse* get);
+
+  // optional .cockroach.proto.PutResponse put = 3;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 3;
+  inline const ::cockroach::proto::PutResponse& put() const;
+  inline ::cockroach::proto::PutResponse* mutable_put();
+  inline ::cockroach::proto::PutResponse* release_put();
+  inline void set_allocated_put(::cockroach::proto::PutResponse* put);
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  inline const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
+  inline ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
+  inline ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
+  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
+
+  // optional .cockroach.proto.IncrementResponse increment = 5;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  inline const ::cockroach::proto::IncrementResponse& increment() const;
+  inline ::cockroach::proto::IncrementResponse* mutable_increment();
+  inline ::cockroach::proto::IncrementResponse* release_increment();
+  inline void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
+
+  // optional .cockroach.proto.DeleteResponse delete = 6;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  inline const ::cockroach::proto::DeleteResponse& delete_() const;
+  inline ::cockroach::proto::DeleteResponse* mutable_delete_();
+  inline ::cockroach::proto::DeleteResponse* release_delete_();
+  inline void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  inline const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
+  inline ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
+  inline ::cockroach::proto::DeleteRangeResponse* release_delete_range();
+  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
+
+  // optional .cockroach.proto.ScanResponse scan = 8;
+  inline bool has_scan() const;
+  inline void clear_scan();
+  static const int kScanFieldNumber = 8;
+  inline const ::cockroach::proto::ScanResponse& scan() const;
+  inline ::cockroach::proto::ScanResponse* mutable_scan();
+  inline ::cockroach::proto::ScanResponse* release_scan();
+  inline void set_allocated_scan(::cockroach::proto::ScanResponse* scan);
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  inline const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
+  inline ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
+  inline ::cockroach::proto::EndTransactionResponse* release_end_transaction();
+  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
+
+  // optional .cockroach.proto.InitPutResponse init_put = 10;
+  inline bool has_init_put() const;
+  inline void clear_init_put();
+  static const int kInitPutFieldNumber = 10;
+  inline const ::cockroach::proto::InitPutResponse& init_put() const;
+  inline ::cockroach::proto::InitPutResponse* mutable_init_put();
+  inline ::cockroach::proto::InitPutResponse* release_init_put();
+  inline void set_allocated_init_put(::cockroach::proto::InitPutResponse* init_put);
+
+  // optional .cockroach.proto.AggregateResponse aggregate = 11;
+  inline bool has_aggregate() const;
+  inline void clear_aggregate();
+  static const int kAggregateFieldNumber = 11;
+  inline const ::cockroach::proto::AggregateResponse& aggregate() const;
+  inline ::cockroach::proto::AggregateResponse* mutable_aggregate();
+  inline ::cockroach::proto::AggregateResponse* release_aggregate();
+  inline void set_allocated_aggregate(::cockroach::proto::AggregateResponse* aggregate);
+
+  inline ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ResponseUnion)
+ private:
+  inline void set_has_contains();
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_init_put();
+  inline void set_has_aggregate();
+
+  inline bool has_value();
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ::cockroach::proto::ContainsResponse* contains_;
+    ::cockroach::proto::GetResponse* get_;
+    ::cockroach::proto::PutResponse* put_;
+    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
+    ::cockroach::proto::IncrementResponse* increment_;
+    ::cockroach::proto::DeleteResponse* delete__;
+    ::cockroach::proto::DeleteRangeResponse* delete_range_;
+    ::cockroach::proto::ScanResponse* scan_;
+    ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::InitPutResponse* init_put_;
+    ::cockroach::proto::AggregateResponse* aggregate_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ResponseUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchRequest : public ::google::protobuf::Message {
+ public:
+  BatchRequest();
+  virtual ~BatchRequest();
+
+  BatchRequest(const BatchRequest& from);
+
+  inline BatchRequest& operator=(const BatchRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchRequest& default_instance();
+
+  void Swap(BatchRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  BatchRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchRequest& from);
+  void MergeFrom(const BatchRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // repeated .cockroach.proto.RequestUnion requests = 2;
+  inline int requests_size() const;
+  inline void clear_requests();
+  static const int kRequestsFieldNumber = 2;
+  inline const ::cockroach::proto::RequestUnion& requests(int index) const;
+  inline ::cockroach::proto::RequestUnion* mutable_requests(int index);
+  inline ::cockroach::proto::RequestUnion* add_requests();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
+      requests() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
+      mutable_requests();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion > requests_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchResponse : public ::google::protobuf::Message {
+ public:
+  BatchResponse();
+  virtual ~BatchResponse();
+
+  BatchResponse(const BatchResponse& from);
+
+  inline BatchResponse& operator=(const BatchResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchResponse& default_instance();
+
+  void Swap(BatchResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  BatchResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchResponse& from);
+  void MergeFrom(const BatchResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // repeated .cockroach.proto.ResponseUnion responses = 2;
+  inline int responses_size() const;
+  inline void clear_responses();
+  static const int kResponsesFieldNumber = 2;
+  inline const ::cockroach::proto::ResponseUnion& responses(int index) const;
+  inline ::cockroach::proto::ResponseUnion* mutable_responses(int index);
+  inline ::cockroach::proto::ResponseUnion* add_responses();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
+      responses() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
+      mutable_responses();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion > responses_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminSplitRequest : public ::google::protobuf::Message {
+ public:
+  AdminSplitRequest();
+  virtual ~AdminSplitRequest();
+
+  AdminSplitRequest(const AdminSplitRequest& from);
+
+  inline AdminSplitRequest& operator=(const AdminSplitRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminSplitRequest& default_instance();
+
+  void Swap(AdminSplitRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminSplitRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminSplitRequest& from);
+  void MergeFrom(const AdminSplitRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional bytes split_key = 2;
+  inline bool has_split_key() const;
+  inline void clear_split_key();
+  static const int kSplitKeyFieldNumber = 2;
+  inline const ::std::string& split_key() const;
+  inline void set_split_key(const ::std::string& value);
+  inline void set_split_key(const char* value);
+  inline void set_split_key(const void* value, size_t size);
+  inline ::std::string* mutable_split_key();
+  inline ::std::string* release_split_key();
+  inline void set_allocated_split_key(::std::string* split_key);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_split_key();
+  inline void clear_has_split_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::std::string* split_key_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminSplitRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminSplitResponse : public ::google::protobuf::Message {
+ public:
+  AdminSplitResponse();
+  virtual ~AdminSplitResponse();
+
+  AdminSplitResponse(const AdminSplitResponse& from);
+
+  inline AdminSplitResponse& operator=(const AdminSplitResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminSplitResponse& default_instance();
+
+  void Swap(AdminSplitResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminSplitResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminSplitResponse& from);
+  void MergeFrom(const AdminSplitResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminSplitResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminMergeRequest : public ::google::protobuf::Message {
+ public:
+  AdminMergeRequest();
+  virtual ~AdminMergeRequest();
+
+  AdminMergeRequest(const AdminMergeRequest& from);
+
+  inline AdminMergeRequest& operator=(const AdminMergeRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminMergeRequest& default_instance();
+
+  void Swap(AdminMergeRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminMergeRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminMergeRequest& from);
+  void MergeFrom(const AdminMergeRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminMergeRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminMergeResponse : public ::google::protobuf::Message {
+ public:
+  AdminMergeResponse();
+  virtual ~AdminMergeResponse();
+
+  AdminMergeResponse(const AdminMergeResponse& from);
+
+  inline AdminMergeResponse& operator=(const AdminMergeResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminMergeResponse& default_instance();
+
+  void Swap(AdminMergeResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminMergeResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminMergeResponse& from);
+  void MergeFrom(const AdminMergeResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminMergeResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminBulkLoadRequest : public ::google::protobuf::Message {
+ public:
+  AdminBulkLoadRequest();
+  virtual ~AdminBulkLoadRequest();
+
+  AdminBulkLoadRequest(const AdminBulkLoadRequest& from);
+
+  inline AdminBulkLoadRequest& operator=(const AdminBulkLoadRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminBulkLoadRequest& default_instance();
+
+  void Swap(AdminBulkLoadRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminBulkLoadRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminBulkLoadRequest& from);
+  void MergeFrom(const AdminBulkLoadRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // repeated .cockroach.proto.KeyValue rows = 2;
+  inline int rows_size() const;
+  inline void clear_rows();
+  static const int kRowsFieldNumber = 2;
+  inline const ::cockroach::proto::KeyValue& rows(int index) const;
+  inline ::cockroach::proto::KeyValue* mutable_rows(int index);
+  inline ::cockroach::proto::KeyValue* add_rows();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+      rows() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+      mutable_rows();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminBulkLoadRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue > rows_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminBulkLoadRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminBulkLoadResponse : public ::google::protobuf::Message {
+ public:
+  AdminBulkLoadResponse();
+  virtual ~AdminBulkLoadResponse();
+
+  AdminBulkLoadResponse(const AdminBulkLoadResponse& from);
+
+  inline AdminBulkLoadResponse& operator=(const AdminBulkLoadResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminBulkLoadResponse& default_instance();
+
+  void Swap(AdminBulkLoadResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminBulkLoadResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminBulkLoadResponse& from);
+  void MergeFrom(const AdminBulkLoadResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional .cockroach.proto.Timestamp load_timestamp = 2;
+  inline bool has_load_timestamp() const;
+  inline void clear_load_timestamp();
+  static const int kLoadTimestampFieldNumber = 2;
+  inline const ::cockroach::proto::Timestamp& load_timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_load_timestamp();
+  inline ::cockroach::proto::Timestamp* release_load_timestamp();
+  inline void set_allocated_load_timestamp(::cockroach::proto::Timestamp* load_timestamp);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminBulkLoadResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_load_timestamp();
+  inline void clear_has_load_timestamp();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::Timestamp* load_timestamp_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminBulkLoadResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminTransferLeaseRequest : public ::google::protobuf::Message {
+ public:
+  AdminTransferLeaseRequest();
+  virtual ~AdminTransferLeaseRequest();
+
+  AdminTransferLeaseRequest(const AdminTransferLeaseRequest& from);
+
+  inline AdminTransferLeaseRequest& operator=(const AdminTransferLeaseRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminTransferLeaseRequest& default_instance();
+
+  void Swap(AdminTransferLeaseRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminTransferLeaseRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminTransferLeaseRequest& from);
+  void MergeFrom(const AdminTransferLeaseRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional int32 store_id = 2;
+  inline bool has_store_id() const;
+  inline void clear_store_id();
+  static const int kStoreIdFieldNumber = 2;
+  inline ::google::protobuf::int32 store_id() const;
+  inline void set_store_id(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminTransferLeaseRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_store_id();
+  inline void clear_has_store_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::int32 store_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminTransferLeaseRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminTransferLeaseResponse : public ::google::protobuf::Message {
+ public:
+  AdminTransferLeaseResponse();
+  virtual ~AdminTransferLeaseResponse();
+
+  AdminTransferLeaseResponse(const AdminTransferLeaseResponse& from);
+
+  inline AdminTransferLeaseResponse& operator=(const AdminTransferLeaseResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminTransferLeaseResponse& default_instance();
+
+  void Swap(AdminTransferLeaseResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminTransferLeaseResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminTransferLeaseResponse& from);
+  void MergeFrom(const AdminTransferLeaseResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminTransferLeaseResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminTransferLeaseResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BackupFile : public ::google::protobuf::Message {
+ public:
+  BackupFile();
+  virtual ~BackupFile();
+
+  BackupFile(const BackupFile& from);
+
+  inline BackupFile& operator=(const BackupFile& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BackupFile& default_instance();
+
+  void Swap(BackupFile* other);
+
+  // implements Message ----------------------------------------------
+
+  BackupFile* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BackupFile& from);
+  void MergeFrom(const BackupFile& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string name = 1;
+  inline bool has_name() const;
+  inline void clear_name();
+  static const int kNameFieldNumber = 1;
+  inline const ::std::string& name() const;
+  inline void set_name(const ::std::string& value);
+  inline void set_name(const char* value);
+  inline void set_name(const char* value, size_t size);
+  inline ::std::string* mutable_name();
+  inline ::std::string* release_name();
+  inline void set_allocated_name(::std::string* name);
+
+  // optional bytes start_key = 2;
+  inline bool has_start_key() const;
+  inline void clear_start_key();
+  static const int kStartKeyFieldNumber = 2;
+  inline const ::std::string& start_key() const;
+  inline void set_start_key(const ::std::string& value);
+  inline void set_start_key(const char* value);
+  inline void set_start_key(const void* value, size_t size);
+  inline ::std::string* mutable_start_key();
+  inline ::std::string* release_start_key();
+  inline void set_allocated_start_key(::std::string* start_key);
+
+  // optional bytes end_key = 3;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 3;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // optional int64 count = 4;
+  inline bool has_count() const;
+  inline void clear_count();
+  static const int kCountFieldNumber = 4;
+  inline ::google::protobuf::int64 count() const;
+  inline void set_count(::google::protobuf::int64 value);
+
+  // optional int64 bytes = 5;
+  inline bool has_bytes() const;
+  inline void clear_bytes();
+  static const int kBytesFieldNumber = 5;
+  inline ::google::protobuf::int64 bytes() const;
+  inline void set_bytes(::google::protobuf::int64 value);
+
+  // optional bytes checksum = 6;
+  inline bool has_checksum() const;
+  inline void clear_checksum();
+  static const int kChecksumFieldNumber = 6;
+  inline const ::std::string& checksum() const;
+  inline void set_checksum(const ::std::string& value);
+  inline void set_checksum(const char* value);
+  inline void set_checksum(const void* value, size_t size);
+  inline ::std::string* mutable_checksum();
+  inline ::std::string* release_checksum();
+  inline void set_allocated_checksum(::std::string* checksum);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BackupFile)
+ private:
+  inline void set_has_name();
+  inline void clear_has_name();
+  inline void set_has_start_key();
+  inline void clear_has_start_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+  inline void set_has_count();
+  inline void clear_has_count();
+  inline void set_has_bytes();
+  inline void clear_has_bytes();
+  inline void set_has_checksum();
+  inline void clear_has_checksum();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* name_;
+  ::std::string* start_key_;
+  ::std::string* end_key_;
+  ::google::protobuf::int64 count_;
+  ::google::protobuf::int64 bytes_;
+  ::std::string* checksum_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BackupFile* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BackupManifest : public ::google::protobuf::Message {
+ public:
+  BackupManifest();
+  virtual ~BackupManifest();
+
+  BackupManifest(const BackupManifest& from);
+
+  inline BackupManifest& operator=(const BackupManifest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BackupManifest& default_instance();
+
+  void Swap(BackupManifest* other);
+
+  // implements Message ----------------------------------------------
+
+  BackupManifest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BackupManifest& from);
+  void MergeFrom(const BackupManifest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.Timestamp timestamp = 1;
+  inline bool has_timestamp() const;
+  inline void clear_timestamp();
+  static const int kTimestampFieldNumber = 1;
+  inline const ::cockroach::proto::Timestamp& timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_timestamp();
+  inline ::cockroach::proto::Timestamp* release_timestamp();
+  inline void set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp);
+
+  // optional .cockroach.proto.BackupFormat format = 2;
+  inline bool has_format() const;
+  inline void clear_format();
+  static const int kFormatFieldNumber = 2;
+  inline ::cockroach::proto::BackupFormat format() const;
+  inline void set_format(::cockroach::proto::BackupFormat value);
+
+  // optional bytes start_key = 3;
+  inline bool has_start_key() const;
+  inline void clear_start_key();
+  static const int kStartKeyFieldNumber = 3;
+  inline const ::std::string& start_key() const;
+  inline void set_start_key(const ::std::string& value);
+  inline void set_start_key(const char* value);
+  inline void set_start_key(const void* value, size_t size);
+  inline ::std::string* mutable_start_key();
+  inline ::std::string* release_start_key();
+  inline void set_allocated_start_key(::std::string* start_key);
+
+  // optional bytes end_key = 4;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 4;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // repeated .cockroach.proto.BackupFile files = 5;
+  inline int files_size() const;
+  inline void clear_files();
+  static const int kFilesFieldNumber = 5;
+  inline const ::cockroach::proto::BackupFile& files(int index) const;
+  inline ::cockroach::proto::BackupFile* mutable_files(int index);
+  inline ::cockroach::proto::BackupFile* add_files();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::BackupFile >&
+      files() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::BackupFile >*
+      mutable_files();
+
+  // optional .cockroach.proto.Timestamp start_time = 6;
+  inline bool has_start_time() const;
+  inline void clear_start_time();
+  static const int kStartTimeFieldNumber = 6;
+  inline const ::cockroach::proto::Timestamp& start_time() const;
+  inline ::cockroach::proto::Timestamp* mutable_start_time();
+  inline ::cockroach::proto::Timestamp* release_start_time();
+  inline void set_allocated_start_time(::cockroach::proto::Timestamp* start_time);
+
+  // repeated .cockroach.proto.KeyValue system_keys = 7;
+  inline int system_keys_size() const;
+  inline void clear_system_keys();
+  static const int kSystemKeysFieldNumber = 7;
+  inline const ::cockroach::proto::KeyValue& system_keys(int index) const;
+  inline ::cockroach::proto::KeyValue* mutable_system_keys(int index);
+  inline ::cockroach::proto::KeyValue* add_system_keys();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+      system_keys() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+      mutable_system_keys();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BackupManifest)
+ private:
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_format();
+  inline void clear_has_format();
+  inline void set_has_start_key();
+  inline void clear_has_start_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+  inline void set_has_start_time();
+  inline void clear_has_start_time();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::Timestamp* timestamp_;
+  ::std::string* start_key_;
+  ::std::string* end_key_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::BackupFile > files_;
+  ::cockroach::proto::Timestamp* start_time_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue > system_keys_;
+  int format_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BackupManifest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminBackupRequest : public ::google::protobuf::Message {
+ public:
+  AdminBackupRequest();
+  virtual ~AdminBackupRequest();
+
+  AdminBackupRequest(const AdminBackupRequest& from);
+
+  inline AdminBackupRequest& operator=(const AdminBackupRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminBackupRequest& default_instance();
+
+  void Swap(AdminBackupRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminBackupRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminBackupRequest& from);
+  void MergeFrom(const AdminBackupRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional string uri = 2;
+  inline bool has_uri() const;
+  inline void clear_uri();
+  static const int kUriFieldNumber = 2;
+  inline const ::std::string& uri() const;
+  inline void set_uri(const ::std::string& value);
+  inline void set_uri(const char* value);
+  inline void set_uri(const char* value, size_t size);
+  inline ::std::string* mutable_uri();
+  inline ::std::string* release_uri();
+  inline void set_allocated_uri(::std::string* uri);
+
+  // optional .cockroach.proto.BackupFormat format = 3;
+  inline bool has_format() const;
+  inline void clear_format();
+  static const int kFormatFieldNumber = 3;
+  inline ::cockroach::proto::BackupFormat format() const;
+  inline void set_format(::cockroach::proto::BackupFormat value);
+
+  // optional bytes limit_key = 4;
+  inline bool has_limit_key() const;
+  inline void clear_limit_key();
+  static const int kLimitKeyFieldNumber = 4;
+  inline const ::std::string& limit_key() const;
+  inline void set_limit_key(const ::std::string& value);
+  inline void set_limit_key(const char* value);
+  inline void set_limit_key(const void* value, size_t size);
+  inline ::std::string* mutable_limit_key();
+  inline ::std::string* release_limit_key();
+  inline void set_allocated_limit_key(::std::string* limit_key);
+
+  // optional .cockroach.proto.Timestamp start_time = 5;
+  inline bool has_start_time() const;
+  inline void clear_start_time();
+  static const int kStartTimeFieldNumber = 5;
+  inline const ::cockroach::proto::Timestamp& start_time() const;
+  inline ::cockroach::proto::Timestamp* mutable_start_time();
+  inline ::cockroach::proto::Timestamp* release_start_time();
+  inline void set_allocated_start_time(::cockroach::proto::Timestamp* start_time);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminBackupRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_uri();
+  inline void clear_has_uri();
+  inline void set_has_format();
+  inline void clear_has_format();
+  inline void set_has_limit_key();
+  inline void clear_has_limit_key();
+  inline void set_has_start_time();
+  inline void clear_has_start_time();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::std::string* uri_;
+  ::std::string* limit_key_;
+  ::cockroach::proto::Timestamp* start_time_;
+  int format_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminBackupRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminBackupResponse : public ::google::protobuf::Message {
+ public:
+  AdminBackupResponse();
+  virtual ~AdminBackupResponse();
+
+  AdminBackupResponse(const AdminBackupResponse& from);
+
+  inline AdminBackupResponse& operator=(const AdminBackupResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminBackupResponse& default_instance();
+
+  void Swap(AdminBackupResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminBackupResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminBackupResponse& from);
+  void MergeFrom(const AdminBackupResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional .cockroach.proto.BackupFile file = 2;
+  inline bool has_file() const;
+  inline void clear_file();
+  static const int kFileFieldNumber = 2;
+  inline const ::cockroach::proto::BackupFile& file() const;
+  inline ::cockroach::proto::BackupFile* mutable_file();
+  inline ::cockroach::proto::BackupFile* release_file();
+  inline void set_allocated_file(::cockroach::proto::BackupFile* file);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminBackupResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_file();
+  inline void clear_has_file();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::BackupFile* file_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminBackupResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminRestoreRequest : public ::google::protobuf::Message {
+ public:
+  AdminRestoreRequest();
+  virtual ~AdminRestoreRequest();
+
+  AdminRestoreRequest(const AdminRestoreRequest& from);
+
+  inline AdminRestoreRequest& operator=(const AdminRestoreRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminRestoreRequest& default_instance();
+
+  void Swap(AdminRestoreRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminRestoreRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminRestoreRequest& from);
+  void MergeFrom(const AdminRestoreRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional string uri = 2;
+  inline bool has_uri() const;
+  inline void clear_uri();
+  static const int kUriFieldNumber = 2;
+  inline const ::std::string& uri() const;
+  inline void set_uri(const ::std::string& value);
+  inline void set_uri(const char* value);
+  inline void set_uri(const char* value, size_t size);
+  inline ::std::string* mutable_uri();
+  inline ::std::string* release_uri();
+  inline void set_allocated_uri(::std::string* uri);
+
+  // optional string job_id = 3;
+  inline bool has_job_id() const;
+  inline void clear_job_id();
+  static const int kJobIdFieldNumber = 3;
+  inline const ::std::string& job_id() const;
+  inline void set_job_id(const ::std::string& value);
+  inline void set_job_id(const char* value);
+  inline void set_job_id(const char* value, size_t size);
+  inline ::std::string* mutable_job_id();
+  inline ::std::string* release_job_id();
+  inline void set_allocated_job_id(::std::string* job_id);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminRestoreRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_uri();
+  inline void clear_has_uri();
+  inline void set_has_job_id();
+  inline void clear_has_job_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::std::string* uri_;
+  ::std::string* job_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminRestoreRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminRestoreResponse : public ::google::protobuf::Message {
+ public:
+  AdminRestoreResponse();
+  virtual ~AdminRestoreResponse();
+
+  AdminRestoreResponse(const AdminRestoreResponse& from);
+
+  inline AdminRestoreResponse& operator=(const AdminRestoreResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminRestoreResponse& default_instance();
+
+  void Swap(AdminRestoreResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminRestoreResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminRestoreResponse& from);
+  void MergeFrom(const AdminRestoreResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional string job_id = 2;
+  inline bool has_job_id() const;
+  inline void clear_job_id();
+  static const int kJobIdFieldNumber = 2;
+  inline const ::std::string& job_id() const;
+  inline void set_job_id(const ::std::string& value);
+  inline void set_job_id(const char* value);
+  inline void set_job_id(const char* value, size_t size);
+  inline ::std::string* mutable_job_id();
+  inline ::std::string* release_job_id();
+  inline void set_allocated_job_id(::std::string* job_id);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminRestoreResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_job_id();
+  inline void clear_has_job_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::std::string* job_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminRestoreResponse* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+// ClientCmdID
+
+// optional int64 wall_time = 1;
+inline bool ClientCmdID::has_wall_time() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ClientCmdID::set_has_wall_time() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ClientCmdID::clear_has_wall_time() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ClientCmdID::clear_wall_time() {
+  wall_time_ = GOOGLE_LONGLONG(0);
+  clear_has_wall_time();
+}
+inline ::google::protobuf::int64 ClientCmdID::wall_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.wall_time)
+  return wall_time_;
+}
+inline void ClientCmdID::set_wall_time(::google::protobuf::int64 value) {
+  set_has_wall_time();
+  wall_time_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.wall_time)
+}
+
+// optional int64 random = 2;
+inline bool ClientCmdID::has_random() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ClientCmdID::set_has_random() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ClientCmdID::clear_has_random() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ClientCmdID::clear_random() {
+  random_ = GOOGLE_LONGLONG(0);
+  clear_has_random();
+}
+inline ::google::protobuf::int64 ClientCmdID::random() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.random)
+  return random_;
+}
+inline void ClientCmdID::set_random(::google::protobuf::int64 value) {
+  set_has_random();
+  random_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.random)
+}
+
+// -------------------------------------------------------------------
+
+// RequestHeader
+
+// optional .cockroach.proto.Timestamp timestamp = 1;
+inline bool RequestHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RequestHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RequestHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RequestHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& RequestHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* RequestHeader::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* RequestHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.timestamp)
+}
+
+// optional .cockroach.proto.ClientCmdID cmd_id = 2;
+inline bool RequestHeader::has_cmd_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RequestHeader::set_has_cmd_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RequestHeader::clear_has_cmd_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RequestHeader::clear_cmd_id() {
+  if (cmd_id_ != NULL) cmd_id_->::cockroach::proto::ClientCmdID::Clear();
+  clear_has_cmd_id();
+}
+inline const ::cockroach::proto::ClientCmdID& RequestHeader::cmd_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.cmd_id)
+  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
+}
+inline ::cockroach::proto::ClientCmdID* RequestHeader::mutable_cmd_id() {
+  set_has_cmd_id();
+  if (cmd_id_ == NULL) cmd_id_ = new ::cockroach::proto::ClientCmdID;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.cmd_id)
+  return cmd_id_;
+}
+inline ::cockroach::proto::ClientCmdID* RequestHeader::release_cmd_id() {
+  clear_has_cmd_id();
+  ::cockroach::proto::ClientCmdID* temp = cmd_id_;
+  cmd_id_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_cmd_id(::cockroach::proto::ClientCmdID* cmd_id) {
+  delete cmd_id_;
+  cmd_id_ = cmd_id;
+  if (cmd_id) {
+    set_has_cmd_id();
+  } else {
+    clear_has_cmd_id();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.cmd_id)
+}
+
+// optional bytes key = 3;
+inline bool RequestHeader::has_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RequestHeader::set_has_key() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RequestHeader::clear_has_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RequestHeader::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& RequestHeader::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.key)
+  return *key_;
+}
+inline void RequestHeader::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.key)
+}
+inline void RequestHeader::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.key)
+}
+inline void RequestHeader::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.key)
+}
+inline ::std::string* RequestHeader::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.key)
+  return key_;
+}
+inline ::std::string* RequestHeader::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RequestHeader::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.key)
+}
+
+// optional bytes end_key = 4;
+inline bool RequestHeader::has_end_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RequestHeader::set_has_end_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RequestHeader::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RequestHeader::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
+}
+inline const ::std::string& RequestHeader::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.end_key)
+  return *end_key_;
+}
+inline void RequestHeader::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.end_key)
+}
+inline void RequestHeader::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.end_key)
+}
+inline void RequestHeader::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.end_key)
+}
+inline ::std::string* RequestHeader::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.end_key)
+  return end_key_;
+}
+inline ::std::string* RequestHeader::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RequestHeader::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.end_key)
+}
+
+// optional string user = 5;
+inline bool RequestHeader::has_user() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void RequestHeader::set_has_user() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void RequestHeader::clear_has_user() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void RequestHeader::clear_user() {
+  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_->clear();
+  }
+  clear_has_user();
+}
+inline const ::std::string& RequestHeader::user() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user)
+  return *user_;
+}
+inline void RequestHeader::set_user(const ::std::string& value) {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  user_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user)
+}
+inline void RequestHeader::set_user(const char* value) {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  user_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.user)
+}
+inline void RequestHeader::set_user(const char* value, size_t size) {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  user_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.user)
+}
+inline ::std::string* RequestHeader::mutable_user() {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.user)
+  return user_;
+}
+inline ::std::string* RequestHeader::release_user() {
+  clear_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = user_;
+    user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RequestHeader::set_allocated_user(::std::string* user) {
+  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete user_;
+  }
+  if (user) {
+    set_has_user();
+    user_ = user;
+  } else {
+    clear_has_user();
+    user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.user)
+}
+
+// optional .cockroach.proto.Replica replica = 6;
+inline bool RequestHeader::has_replica() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void RequestHeader::set_has_replica() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void RequestHeader::clear_has_replica() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void RequestHeader::clear_replica() {
+  if (replica_ != NULL) replica_->::cockroach::proto::Replica::Clear();
+  clear_has_replica();
+}
+inline const ::cockroach::proto::Replica& RequestHeader::replica() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.replica)
+  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+}
+inline ::cockroach::proto::Replica* RequestHeader::mutable_replica() {
+  set_has_replica();
+  if (replica_ == NULL) replica_ = new ::cockroach::proto::Replica;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.replica)
+  return replica_;
+}
+inline ::cockroach::proto::Replica* RequestHeader::release_replica() {
+  clear_has_replica();
+  ::cockroach::proto::Replica* temp = replica_;
+  replica_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_replica(::cockroach::proto::Replica* replica) {
+  delete replica_;
+  replica_ = replica;
+  if (replica) {
+    set_has_replica();
+  } else {
+    clear_has_replica();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.replica)
+}
+
+// optional int64 raft_id = 7;
+inline bool RequestHeader::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void RequestHeader::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void RequestHeader::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void RequestHeader::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 RequestHeader::raft_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.raft_id)
+  return raft_id_;
+}
+inline void RequestHeader::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.raft_id)
+}
+
+// optional int32 user_priority = 8 [default = 1];
+inline bool RequestHeader::has_user_priority() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void RequestHeader::set_has_user_priority() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void RequestHeader::clear_has_user_priority() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void RequestHeader::clear_user_priority() {
+  user_priority_ = 1;
+  clear_has_user_priority();
+}
+inline ::google::protobuf::int32 RequestHeader::user_priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user_priority)
+  return user_priority_;
+}
+inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
+  set_has_user_priority();
+  user_priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user_priority)
+}
+
+// optional .cockroach.proto.Transaction txn = 9;
+inline bool RequestHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
+}
+inline void RequestHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000100u;
+}
+inline void RequestHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000100u;
+}
+inline void RequestHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::proto::Transaction& RequestHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::proto::Transaction* RequestHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.txn)
+  return txn_;
+}
+inline ::cockroach::proto::Transaction* RequestHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.txn)
+}
+
+// optional .cockroach.proto.ReadConsistencyType read_consistency = 10;
+inline bool RequestHeader::has_read_consistency() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
+}
+inline void RequestHeader::set_has_read_consistency() {
+  _has_bits_[0] |= 0x00000200u;
+}
+inline void RequestHeader::clear_has_read_consistency() {
+  _has_bits_[0] &= ~0x00000200u;
+}
+inline void RequestHeader::clear_read_consistency() {
+  read_consistency_ = 0;
+  clear_has_read_consistency();
+}
+inline ::cockroach::proto::ReadConsistencyType RequestHeader::read_consistency() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.read_consistency)
+  return static_cast< ::cockroach::proto::ReadConsistencyType >(read_consistency_);
+}
+inline void RequestHeader::set_read_consistency(::cockroach::proto::ReadConsistencyType value) {
+  assert(::cockroach::proto::ReadConsistencyType_IsValid(value));
+  set_has_read_consistency();
+  read_consistency_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
+}
+
+// optional int64 timeout = 11;
+inline bool RequestHeader::has_timeout() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
+}
+inline void RequestHeader::set_has_timeout() {
+  _has_bits_[0] |= 0x00000400u;
+}
+inline void RequestHeader::clear_has_timeout() {
+  _has_bits_[0] &= ~0x00000400u;
+}
+inline void RequestHeader::clear_timeout() {
+  timeout_ = GOOGLE_LONGLONG(0);
+  clear_has_timeout();
+}
+inline ::google::protobuf::int64 RequestHeader::timeout() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timeout)
+  return timeout_;
+}
+inline void RequestHeader::set_timeout(::google::protobuf::int64 value) {
+  set_has_timeout();
+  timeout_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.timeout)
+}
+
+// optional .cockroach.proto.TraceContext trace = 12;
+inline bool RequestHeader::has_trace() const {
+  return (_has_bits_[0] & 0x00000800u) != 0;
+}
+inline void RequestHeader::set_has_trace() {
+  _has_bits_[0] |= 0x00000800u;
+}
+inline void RequestHeader::clear_has_trace() {
+  _has_bits_[0] &= ~0x00000800u;
+}
+inline void RequestHeader::clear_trace() {
+  if (trace_ != NULL) trace_->::cockroach::proto::TraceContext::Clear();
+  clear_has_trace();
+}
+inline const ::cockroach::proto::TraceContext& RequestHeader::trace() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.trace)
+  return trace_ != NULL ? *trace_ : *default_instance_->trace_;
+}
+inline ::cockroach::proto::TraceContext* RequestHeader::mutable_trace() {
+  set_has_trace();
+  if (trace_ == NULL) trace_ = new ::cockroach::proto::TraceContext;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.trace)
+  return trace_;
+}
+inline ::cockroach::proto::TraceContext* RequestHeader::release_trace() {
+  clear_has_trace();
+  ::cockroach::proto::TraceContext* temp = trace_;
+  trace_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_trace(::cockroach::proto::TraceContext* trace) {
+  delete trace_;
+  trace_ = trace;
+  if (trace) {
+    set_has_trace();
+  } else {
+    clear_has_trace();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.trace)
+}
+
+// optional .cockroach.proto.RequestPriority priority = 13;
+inline bool RequestHeader::has_priority() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void RequestHeader::set_has_priority() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void RequestHeader::clear_has_priority() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void RequestHeader::clear_priority() {
+  priority_ = 0;
+  clear_has_priority();
+}
+inline ::cockroach::proto::RequestPriority RequestHeader::priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.priority)
+  return static_cast< ::cockroach::proto::RequestPriority >(priority_);
+}
+inline void RequestHeader::set_priority(::cockroach::proto::RequestPriority value) {
+  assert(::cockroach::proto::RequestPriority_IsValid(value));
+  set_has_priority();
+  priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.priority)
+}
+
+// -------------------------------------------------------------------
+
+// TraceContext
+
+// optional int64 trace_id = 1;
+inline bool TraceContext::has_trace_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void TraceContext::set_has_trace_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void TraceContext::clear_has_trace_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void TraceContext::clear_trace_id() {
+  trace_id_ = GOOGLE_LONGLONG(0);
+  clear_has_trace_id();
+}
+inline ::google::protobuf::int64 TraceContext::trace_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TraceContext.trace_id)
+  return trace_id_;
+}
+inline void TraceContext::set_trace_id(::google::protobuf::int64 value) {
+  set_has_trace_id();
+  trace_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.TraceContext.trace_id)
+}
+
+// optional int64 span_id = 2;
+inline bool TraceContext::has_span_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void TraceContext::set_has_span_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void TraceContext::clear_has_span_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void TraceContext::clear_span_id() {
+  span_id_ = GOOGLE_LONGLONG(0);
+  clear_has_span_id();
+}
+inline ::google::protobuf::int64 TraceContext::span_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.TraceContext.span_id)
+  return span_id_;
+}
+inline void TraceContext::set_span_id(::google::protobuf::int64 value) {
+  set_has_span_id();
+  span_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.TraceContext.span_id)
+}
+
+// -------------------------------------------------------------------
+
+// ResponseHeader
+
+// optional .cockroach.proto.Error error = 1;
+inline bool ResponseHeader::has_error() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ResponseHeader::set_has_error() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ResponseHeader::clear_has_error() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ResponseHeader::clear_error() {
+  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
+  clear_has_error();
+}
+inline const ::cockroach::proto::Error& ResponseHeader::error() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.error)
+  return error_ != NULL ? *error_ : *default_instance_->error_;
+}
+inline ::cockroach::proto::Error* ResponseHeader::mutable_error() {
+  set_has_error();
+  if (error_ == NULL) error_ = new ::cockroach::proto::Error;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.error)
+  return error_;
+}
+inline ::cockroach::proto::Error* ResponseHeader::release_error() {
+  clear_has_error();
+  ::cockroach::proto::Error* temp = error_;
+  error_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_error(::cockroach::proto::Error* error) {
+  delete error_;
+  error_ = error;
+  if (error) {
+    set_has_error();
+  } else {
+    clear_has_error();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.error)
+}
+
+// optional .cockroach.proto.Timestamp timestamp = 2;
+inline bool ResponseHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ResponseHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ResponseHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ResponseHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& ResponseHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ResponseHeader::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ResponseHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.timestamp)
+}
+
+// optional .cockroach.proto.Transaction txn = 3;
+inline bool ResponseHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ResponseHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ResponseHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ResponseHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::proto::Transaction& ResponseHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::proto::Transaction* ResponseHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.txn)
+  return txn_;
+}
+inline ::cockroach::proto::Transaction* ResponseHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.txn)
+}
+
+// -------------------------------------------------------------------
+
+// ContainsRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ContainsRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ContainsRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ContainsRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ContainsRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& ContainsRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ContainsRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* ContainsRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ContainsRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* ContainsRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ContainsRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ContainsRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// ContainsResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ContainsResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ContainsResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ContainsResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ContainsResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ContainsResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ContainsResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* ContainsResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ContainsResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* ContainsResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ContainsResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ContainsResponse.header)
+}
+
+// optional bool exists = 2;
+inline bool ContainsResponse::has_exists() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ContainsResponse::set_has_exists() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ContainsResponse::clear_has_exists() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ContainsResponse::clear_exists() {
+  exists_ = false;
+  clear_has_exists();
+}
+inline bool ContainsResponse::exists() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ContainsResponse.exists)
+  return exists_;
+}
+inline void ContainsResponse::set_exists(bool value) {
+  set_has_exists();
+  exists_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ContainsResponse.exists)
+}
+
+// -------------------------------------------------------------------
+
+// GetRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool GetRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& GetRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* GetRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* GetRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// GetResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool GetResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& GetResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* GetResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* GetResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool GetResponse::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GetResponse::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GetResponse::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GetResponse::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& GetResponse::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* GetResponse::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* GetResponse::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool PutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& PutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* PutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* PutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool PutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void PutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void PutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void PutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& PutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* PutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* PutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool PutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& PutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* PutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* PutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// ConditionalPutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ConditionalPutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConditionalPutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConditionalPutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConditionalPutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& ConditionalPutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool ConditionalPutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ConditionalPutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ConditionalPutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ConditionalPutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& ConditionalPutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.value)
+}
+
+// optional .cockroach.proto.Value exp_value = 3;
+inline bool ConditionalPutRequest::has_exp_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ConditionalPutRequest::set_has_exp_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ConditionalPutRequest::clear_has_exp_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ConditionalPutRequest::clear_exp_value() {
+  if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
+  clear_has_exp_value();
+}
+inline const ::cockroach::proto::Value& ConditionalPutRequest::exp_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.exp_value)
+  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_exp_value() {
+  set_has_exp_value();
+  if (exp_value_ == NULL) exp_value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.exp_value)
+  return exp_value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::release_exp_value() {
+  clear_has_exp_value();
+  ::cockroach::proto::Value* temp = exp_value_;
+  exp_value_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::proto::Value* exp_value) {
+  delete exp_value_;
+  exp_value_ = exp_value;
+  if (exp_value) {
+    set_has_exp_value();
+  } else {
+    clear_has_exp_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.exp_value)
+}
+
+// optional bool allow_if_does_not_exist = 4;
+inline bool ConditionalPutRequest::has_allow_if_does_not_exist() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ConditionalPutRequest::set_has_allow_if_does_not_exist() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ConditionalPutRequest::clear_has_allow_if_does_not_exist() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ConditionalPutRequest::clear_allow_if_does_not_exist() {
+  allow_if_does_not_exist_ = false;
+  clear_has_allow_if_does_not_exist();
+}
+inline bool ConditionalPutRequest::allow_if_does_not_exist() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.allow_if_does_not_exist)
+  return allow_if_does_not_exist_;
+}
+inline void ConditionalPutRequest::set_allow_if_does_not_exist(bool value) {
+  set_has_allow_if_does_not_exist();
+  allow_if_does_not_exist_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ConditionalPutRequest.allow_if_does_not_exist)
+}
+
+// -------------------------------------------------------------------
+
+// ConditionalPutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ConditionalPutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConditionalPutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConditionalPutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConditionalPutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ConditionalPutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InitPutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InitPutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InitPutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InitPutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InitPutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& InitPutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InitPutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* InitPutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InitPutRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* InitPutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InitPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InitPutRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool InitPutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InitPutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InitPutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InitPutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& InitPutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InitPutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* InitPutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InitPutRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* InitPutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void InitPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InitPutRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// InitPutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InitPutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InitPutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InitPutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InitPutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& InitPutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InitPutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* InitPutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InitPutResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* InitPutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InitPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InitPutResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// IncrementRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool IncrementRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void IncrementRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void IncrementRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void IncrementRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& IncrementRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* IncrementRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* IncrementRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementRequest.header)
+}
+
+// optional int64 increment = 2;
+inline bool IncrementRequest::has_increment() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void IncrementRequest::set_has_increment() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void IncrementRequest::clear_has_increment() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void IncrementRequest::clear_increment() {
+  increment_ = GOOGLE_LONGLONG(0);
+  clear_has_increment();
+}
+inline ::google::protobuf::int64 IncrementRequest::increment() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.increment)
+  return increment_;
+}
+inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
+  set_has_increment();
+  increment_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.increment)
+}
+
+// optional bool return_previous = 3;
+inline bool IncrementRequest::has_return_previous() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void IncrementRequest::set_has_return_previous() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void IncrementRequest::clear_has_return_previous() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void IncrementRequest::clear_return_previous() {
+  return_previous_ = false;
+  clear_has_return_previous();
+}
+inline bool IncrementRequest::return_previous() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.return_previous)
+  return return_previous_;
+}
+inline void IncrementRequest::set_return_previous(bool value) {
+  set_has_return_previous();
+  return_previous_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.return_previous)
+}
+
+// -------------------------------------------------------------------
+
+// IncrementResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool IncrementResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void IncrementResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void IncrementResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void IncrementResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& IncrementResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* IncrementResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* IncrementResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void IncrementResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementResponse.header)
+}
+
+// optional int64 new_value = 2;
+inline bool IncrementResponse::has_new_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void IncrementResponse::set_has_new_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void IncrementResponse::clear_has_new_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void IncrementResponse::clear_new_value() {
+  new_value_ = GOOGLE_LONGLONG(0);
+  clear_has_new_value();
+}
+inline ::google::protobuf::int64 IncrementResponse::new_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.new_value)
+  return new_value_;
+}
+inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
+  set_has_new_value();
+  new_value_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.new_value)
+}
+
+// optional int64 previous_value = 3;
+inline bool IncrementResponse::has_previous_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void IncrementResponse::set_has_previous_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void IncrementResponse::clear_has_previous_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void IncrementResponse::clear_previous_value() {
+  previous_value_ = GOOGLE_LONGLONG(0);
+  clear_has_previous_value();
+}
+inline ::google::protobuf::int64 IncrementResponse::previous_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.previous_value)
+  return previous_value_;
+}
+inline void IncrementResponse::set_previous_value(::google::protobuf::int64 value) {
+  set_has_previous_value();
+  previous_value_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.previous_value)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool DeleteRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& DeleteRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* DeleteRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* DeleteRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool DeleteResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& DeleteResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* DeleteResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* DeleteResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRangeRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool DeleteRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& DeleteRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeRequest.header)
+}
+
+// optional int64 max_entries_to_delete = 2;
+inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void DeleteRangeRequest::clear_max_entries_to_delete() {
+  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
+  clear_has_max_entries_to_delete();
+}
+inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+  return max_entries_to_delete_;
+}
+inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
+  set_has_max_entries_to_delete();
+  max_entries_to_delete_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRangeResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool DeleteRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& DeleteRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeResponse.header)
+}
+
+// optional int64 num_deleted = 2;
+inline bool DeleteRangeResponse::has_num_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void DeleteRangeResponse::set_has_num_deleted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void DeleteRangeResponse::clear_has_num_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void DeleteRangeResponse::clear_num_deleted() {
+  num_deleted_ = GOOGLE_LONGLONG(0);
+  clear_has_num_deleted();
+}
+inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.num_deleted)
+  return num_deleted_;
+}
+inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
+  set_has_num_deleted();
+  num_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeResponse.num_deleted)
+}
+
+// -------------------------------------------------------------------
+
+// ClearRangeRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ClearRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ClearRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ClearRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ClearRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& ClearRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClearRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* ClearRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ClearRangeRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* ClearRangeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ClearRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ClearRangeRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// ClearRangeResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ClearRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ClearRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ClearRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ClearRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ClearRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClearRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* ClearRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ClearRangeResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* ClearRangeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ClearRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ClearRangeResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// ScanRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ScanRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
+}
+
+// optional int64 max_results = 2;
+inline bool ScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+inline ::google::protobuf::int64 ScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
+  return max_results_;
+}
+inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+}
+
+// optional int64 target_bytes = 3;
+inline bool ScanRequest::has_target_bytes() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanRequest::set_has_target_bytes() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanRequest::clear_has_target_bytes() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanRequest::clear_target_bytes() {
+  target_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_target_bytes();
+}
+inline ::google::protobuf::int64 ScanRequest::target_bytes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.target_bytes)
+  return target_bytes_;
+}
+inline void ScanRequest::set_target_bytes(::google::protobuf::int64 value) {
+  set_has_target_bytes();
+  target_bytes_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.target_bytes)
+}
 
+// -------------------------------------------------------------------
 
-// ===================================================================
+// ScanResponse
 
-// ClientCmdID
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ScanResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
+}
 
-// optional int64 wall_time = 1;
-inline bool ClientCmdID::has_wall_time() const {
+// repeated .cockroach.proto.KeyValue rows = 2;
+inline int ScanResponse::rows_size() const {
+  return rows_.size();
+}
+inline void ScanResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
+  return rows_.Get(index);
+}
+inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
+  return rows_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
+  return &rows_;
+}
+
+// optional .cockroach.proto.Span resume_span = 3;
+inline bool ScanResponse::has_resume_span() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanResponse::set_has_resume_span() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanResponse::clear_has_resume_span() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanResponse::clear_resume_span() {
+  if (resume_span_ != NULL) resume_span_->::cockroach::proto::Span::Clear();
+  clear_has_resume_span();
+}
+inline const ::cockroach::proto::Span& ScanResponse::resume_span() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.resume_span)
+  return resume_span_ != NULL ? *resume_span_ : *default_instance_->resume_span_;
+}
+inline ::cockroach::proto::Span* ScanResponse::mutable_resume_span() {
+  set_has_resume_span();
+  if (resume_span_ == NULL) resume_span_ = new ::cockroach::proto::Span;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.resume_span)
+  return resume_span_;
+}
+inline ::cockroach::proto::Span* ScanResponse::release_resume_span() {
+  clear_has_resume_span();
+  ::cockroach::proto::Span* temp = resume_span_;
+  resume_span_ = NULL;
+  return temp;
+}
+inline void ScanResponse::set_allocated_resume_span(::cockroach::proto::Span* resume_span) {
+  delete resume_span_;
+  resume_span_ = resume_span;
+  if (resume_span) {
+    set_has_resume_span();
+  } else {
+    clear_has_resume_span();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.resume_span)
+}
+
+// -------------------------------------------------------------------
+
+// AggregateRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AggregateRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ClientCmdID::set_has_wall_time() {
+inline void AggregateRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ClientCmdID::clear_has_wall_time() {
+inline void AggregateRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ClientCmdID::clear_wall_time() {
-  wall_time_ = GOOGLE_LONGLONG(0);
-  clear_has_wall_time();
+inline void AggregateRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::google::protobuf::int64 ClientCmdID::wall_time() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.wall_time)
-  return wall_time_;
+inline const ::cockroach::proto::RequestHeader& AggregateRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AggregateRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline void ClientCmdID::set_wall_time(::google::protobuf::int64 value) {
-  set_has_wall_time();
-  wall_time_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.wall_time)
+inline ::cockroach::proto::RequestHeader* AggregateRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AggregateRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* AggregateRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AggregateRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AggregateRequest.header)
 }
 
-// optional int64 random = 2;
-inline bool ClientCmdID::has_random() const {
+// optional int64 max_results = 2;
+inline bool AggregateRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ClientCmdID::set_has_random() {
+inline void AggregateRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ClientCmdID::clear_has_random() {
+inline void AggregateRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ClientCmdID::clear_random() {
-  random_ = GOOGLE_LONGLONG(0);
-  clear_has_random();
+inline void AggregateRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
-inline ::google::protobuf::int64 ClientCmdID::random() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.random)
-  return random_;
+inline ::google::protobuf::int64 AggregateRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AggregateRequest.max_results)
+  return max_results_;
 }
-inline void ClientCmdID::set_random(::google::protobuf::int64 value) {
-  set_has_random();
-  random_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.random)
+inline void AggregateRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AggregateRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// RequestHeader
+// AggregateResponse
 
-// optional .cockroach.proto.Timestamp timestamp = 1;
-inline bool RequestHeader::has_timestamp() const {
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AggregateResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_timestamp() {
+inline void AggregateResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_timestamp() {
+inline void AggregateResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void AggregateResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Timestamp& RequestHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline const ::cockroach::proto::ResponseHeader& AggregateResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AggregateResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Timestamp* RequestHeader::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.timestamp)
-  return timestamp_;
+inline ::cockroach::proto::ResponseHeader* AggregateResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AggregateResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Timestamp* RequestHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::proto::ResponseHeader* AggregateResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AggregateResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AggregateResponse.header)
+}
+
+// optional int64 num_keys = 2;
+inline bool AggregateResponse::has_num_keys() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AggregateResponse::set_has_num_keys() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AggregateResponse::clear_has_num_keys() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AggregateResponse::clear_num_keys() {
+  num_keys_ = GOOGLE_LONGLONG(0);
+  clear_has_num_keys();
+}
+inline ::google::protobuf::int64 AggregateResponse::num_keys() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AggregateResponse.num_keys)
+  return num_keys_;
+}
+inline void AggregateResponse::set_num_keys(::google::protobuf::int64 value) {
+  set_has_num_keys();
+  num_keys_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AggregateResponse.num_keys)
+}
+
+// optional int64 sum = 3;
+inline bool AggregateResponse::has_sum() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AggregateResponse::set_has_sum() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AggregateResponse::clear_has_sum() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AggregateResponse::clear_sum() {
+  sum_ = GOOGLE_LONGLONG(0);
+  clear_has_sum();
+}
+inline ::google::protobuf::int64 AggregateResponse::sum() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AggregateResponse.sum)
+  return sum_;
+}
+inline void AggregateResponse::set_sum(::google::protobuf::int64 value) {
+  set_has_sum();
+  sum_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AggregateResponse.sum)
+}
+
+// -------------------------------------------------------------------
+
+// WatchRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool WatchRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void WatchRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void WatchRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void WatchRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& WatchRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* WatchRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* WatchRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void WatchRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_timestamp();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchRequest.header)
 }
 
-// optional .cockroach.proto.ClientCmdID cmd_id = 2;
-inline bool RequestHeader::has_cmd_id() const {
+// optional bytes limit_key = 2;
+inline bool WatchRequest::has_limit_key() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestHeader::set_has_cmd_id() {
+inline void WatchRequest::set_has_limit_key() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::clear_has_cmd_id() {
+inline void WatchRequest::clear_has_limit_key() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::clear_cmd_id() {
-  if (cmd_id_ != NULL) cmd_id_->::cockroach::proto::ClientCmdID::Clear();
-  clear_has_cmd_id();
+inline void WatchRequest::clear_limit_key() {
+  if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_->clear();
+  }
+  clear_has_limit_key();
 }
-inline const ::cockroach::proto::ClientCmdID& RequestHeader::cmd_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.cmd_id)
-  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
+inline const ::std::string& WatchRequest::limit_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchRequest.limit_key)
+  return *limit_key_;
 }
-inline ::cockroach::proto::ClientCmdID* RequestHeader::mutable_cmd_id() {
-  set_has_cmd_id();
-  if (cmd_id_ == NULL) cmd_id_ = new ::cockroach::proto::ClientCmdID;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.cmd_id)
-  return cmd_id_;
+inline void WatchRequest::set_limit_key(const ::std::string& value) {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  limit_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.WatchRequest.limit_key)
 }
-inline ::cockroach::proto::ClientCmdID* RequestHeader::release_cmd_id() {
-  clear_has_cmd_id();
-  ::cockroach::proto::ClientCmdID* temp = cmd_id_;
-  cmd_id_ = NULL;
-  return temp;
+inline void WatchRequest::set_limit_key(const char* value) {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  limit_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.WatchRequest.limit_key)
 }
-inline void RequestHeader::set_allocated_cmd_id(::cockroach::proto::ClientCmdID* cmd_id) {
-  delete cmd_id_;
-  cmd_id_ = cmd_id;
-  if (cmd_id) {
-    set_has_cmd_id();
+inline void WatchRequest::set_limit_key(const void* value, size_t size) {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  limit_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.WatchRequest.limit_key)
+}
+inline ::std::string* WatchRequest::mutable_limit_key() {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchRequest.limit_key)
+  return limit_key_;
+}
+inline ::std::string* WatchRequest::release_limit_key() {
+  clear_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_cmd_id();
+    ::std::string* temp = limit_key_;
+    limit_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.cmd_id)
+}
+inline void WatchRequest::set_allocated_limit_key(::std::string* limit_key) {
+  if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete limit_key_;
+  }
+  if (limit_key) {
+    set_has_limit_key();
+    limit_key_ = limit_key;
+  } else {
+    clear_has_limit_key();
+    limit_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchRequest.limit_key)
 }
 
-// optional bytes key = 3;
-inline bool RequestHeader::has_key() const {
+// optional .cockroach.proto.Timestamp start_time = 3;
+inline bool WatchRequest::has_start_time() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void RequestHeader::set_has_key() {
+inline void WatchRequest::set_has_start_time() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void RequestHeader::clear_has_key() {
+inline void WatchRequest::clear_has_start_time() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void RequestHeader::clear_key() {
+inline void WatchRequest::clear_start_time() {
+  if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+  clear_has_start_time();
+}
+inline const ::cockroach::proto::Timestamp& WatchRequest::start_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchRequest.start_time)
+  return start_time_ != NULL ? *start_time_ : *default_instance_->start_time_;
+}
+inline ::cockroach::proto::Timestamp* WatchRequest::mutable_start_time() {
+  set_has_start_time();
+  if (start_time_ == NULL) start_time_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchRequest.start_time)
+  return start_time_;
+}
+inline ::cockroach::proto::Timestamp* WatchRequest::release_start_time() {
+  clear_has_start_time();
+  ::cockroach::proto::Timestamp* temp = start_time_;
+  start_time_ = NULL;
+  return temp;
+}
+inline void WatchRequest::set_allocated_start_time(::cockroach::proto::Timestamp* start_time) {
+  delete start_time_;
+  start_time_ = start_time;
+  if (start_time) {
+    set_has_start_time();
+  } else {
+    clear_has_start_time();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchRequest.start_time)
+}
+
+// optional int64 max_wait = 4;
+inline bool WatchRequest::has_max_wait() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void WatchRequest::set_has_max_wait() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void WatchRequest::clear_has_max_wait() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void WatchRequest::clear_max_wait() {
+  max_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_max_wait();
+}
+inline ::google::protobuf::int64 WatchRequest::max_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchRequest.max_wait)
+  return max_wait_;
+}
+inline void WatchRequest::set_max_wait(::google::protobuf::int64 value) {
+  set_has_max_wait();
+  max_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.WatchRequest.max_wait)
+}
+
+// -------------------------------------------------------------------
+
+// WatchEvent
+
+// optional bytes key = 1;
+inline bool WatchEvent::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void WatchEvent::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void WatchEvent::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void WatchEvent::clear_key() {
   if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_->clear();
   }
   clear_has_key();
 }
-inline const ::std::string& RequestHeader::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.key)
+inline const ::std::string& WatchEvent::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchEvent.key)
   return *key_;
 }
-inline void RequestHeader::set_key(const ::std::string& value) {
+inline void WatchEvent::set_key(const ::std::string& value) {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
   key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.key)
+  // @@protoc_insertion_point(field_set:cockroach.proto.WatchEvent.key)
 }
-inline void RequestHeader::set_key(const char* value) {
+inline void WatchEvent::set_key(const char* value) {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
   key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.key)
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.WatchEvent.key)
 }
-inline void RequestHeader::set_key(const void* value, size_t size) {
+inline void WatchEvent::set_key(const void* value, size_t size) {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
   key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.key)
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.WatchEvent.key)
 }
-inline ::std::string* RequestHeader::mutable_key() {
+inline ::std::string* WatchEvent::mutable_key() {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.key)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchEvent.key)
   return key_;
 }
-inline ::std::string* RequestHeader::release_key() {
+inline ::std::string* WatchEvent::release_key() {
   clear_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
@@ -3213,7 +7877,7 @@ inline ::std::string* RequestHeader::release_key() {
     return temp;
   }
 }
-inline void RequestHeader::set_allocated_key(::std::string* key) {
+inline void WatchEvent::set_allocated_key(::std::string* key) {
   if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     delete key_;
   }
@@ -3224,523 +7888,387 @@ inline void RequestHeader::set_allocated_key(::std::string* key) {
     clear_has_key();
     key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.key)
-}
-
-// optional bytes end_key = 4;
-inline bool RequestHeader::has_end_key() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
-}
-inline void RequestHeader::set_has_end_key() {
-  _has_bits_[0] |= 0x00000008u;
-}
-inline void RequestHeader::clear_has_end_key() {
-  _has_bits_[0] &= ~0x00000008u;
-}
-inline void RequestHeader::clear_end_key() {
-  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    end_key_->clear();
-  }
-  clear_has_end_key();
-}
-inline const ::std::string& RequestHeader::end_key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.end_key)
-  return *end_key_;
-}
-inline void RequestHeader::set_end_key(const ::std::string& value) {
-  set_has_end_key();
-  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    end_key_ = new ::std::string;
-  }
-  end_key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.end_key)
-}
-inline void RequestHeader::set_end_key(const char* value) {
-  set_has_end_key();
-  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    end_key_ = new ::std::string;
-  }
-  end_key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.end_key)
-}
-inline void RequestHeader::set_end_key(const void* value, size_t size) {
-  set_has_end_key();
-  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    end_key_ = new ::std::string;
-  }
-  end_key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.end_key)
-}
-inline ::std::string* RequestHeader::mutable_end_key() {
-  set_has_end_key();
-  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    end_key_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.end_key)
-  return end_key_;
-}
-inline ::std::string* RequestHeader::release_end_key() {
-  clear_has_end_key();
-  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = end_key_;
-    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
-}
-inline void RequestHeader::set_allocated_end_key(::std::string* end_key) {
-  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete end_key_;
-  }
-  if (end_key) {
-    set_has_end_key();
-    end_key_ = end_key;
-  } else {
-    clear_has_end_key();
-    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.end_key)
-}
-
-// optional string user = 5;
-inline bool RequestHeader::has_user() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
-}
-inline void RequestHeader::set_has_user() {
-  _has_bits_[0] |= 0x00000010u;
-}
-inline void RequestHeader::clear_has_user() {
-  _has_bits_[0] &= ~0x00000010u;
-}
-inline void RequestHeader::clear_user() {
-  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    user_->clear();
-  }
-  clear_has_user();
-}
-inline const ::std::string& RequestHeader::user() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user)
-  return *user_;
-}
-inline void RequestHeader::set_user(const ::std::string& value) {
-  set_has_user();
-  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    user_ = new ::std::string;
-  }
-  user_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user)
-}
-inline void RequestHeader::set_user(const char* value) {
-  set_has_user();
-  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    user_ = new ::std::string;
-  }
-  user_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.user)
-}
-inline void RequestHeader::set_user(const char* value, size_t size) {
-  set_has_user();
-  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    user_ = new ::std::string;
-  }
-  user_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.user)
-}
-inline ::std::string* RequestHeader::mutable_user() {
-  set_has_user();
-  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    user_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.user)
-  return user_;
-}
-inline ::std::string* RequestHeader::release_user() {
-  clear_has_user();
-  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = user_;
-    user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
-}
-inline void RequestHeader::set_allocated_user(::std::string* user) {
-  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete user_;
-  }
-  if (user) {
-    set_has_user();
-    user_ = user;
-  } else {
-    clear_has_user();
-    user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.user)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchEvent.key)
 }
 
-// optional .cockroach.proto.Replica replica = 6;
-inline bool RequestHeader::has_replica() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// optional .cockroach.proto.Value value = 2;
+inline bool WatchEvent::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestHeader::set_has_replica() {
-  _has_bits_[0] |= 0x00000020u;
+inline void WatchEvent::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::clear_has_replica() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void WatchEvent::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::clear_replica() {
-  if (replica_ != NULL) replica_->::cockroach::proto::Replica::Clear();
-  clear_has_replica();
+inline void WatchEvent::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
 }
-inline const ::cockroach::proto::Replica& RequestHeader::replica() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.replica)
-  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+inline const ::cockroach::proto::Value& WatchEvent::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchEvent.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline ::cockroach::proto::Replica* RequestHeader::mutable_replica() {
-  set_has_replica();
-  if (replica_ == NULL) replica_ = new ::cockroach::proto::Replica;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.replica)
-  return replica_;
+inline ::cockroach::proto::Value* WatchEvent::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchEvent.value)
+  return value_;
 }
-inline ::cockroach::proto::Replica* RequestHeader::release_replica() {
-  clear_has_replica();
-  ::cockroach::proto::Replica* temp = replica_;
-  replica_ = NULL;
+inline ::cockroach::proto::Value* WatchEvent::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_replica(::cockroach::proto::Replica* replica) {
-  delete replica_;
-  replica_ = replica;
-  if (replica) {
-    set_has_replica();
+inline void WatchEvent::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_replica();
+    clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.replica)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchEvent.value)
 }
 
-// optional int64 raft_id = 7;
-inline bool RequestHeader::has_raft_id() const {
-  return (_has_bits_[0] & 0x00000040u) != 0;
-}
-inline void RequestHeader::set_has_raft_id() {
-  _has_bits_[0] |= 0x00000040u;
+// -------------------------------------------------------------------
+
+// WatchResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool WatchResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::clear_has_raft_id() {
-  _has_bits_[0] &= ~0x00000040u;
+inline void WatchResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_raft_id() {
-  raft_id_ = GOOGLE_LONGLONG(0);
-  clear_has_raft_id();
+inline void WatchResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline ::google::protobuf::int64 RequestHeader::raft_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.raft_id)
-  return raft_id_;
+inline void WatchResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline void RequestHeader::set_raft_id(::google::protobuf::int64 value) {
-  set_has_raft_id();
-  raft_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.raft_id)
+inline const ::cockroach::proto::ResponseHeader& WatchResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-
-// optional int32 user_priority = 8 [default = 1];
-inline bool RequestHeader::has_user_priority() const {
-  return (_has_bits_[0] & 0x00000080u) != 0;
+inline ::cockroach::proto::ResponseHeader* WatchResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchResponse.header)
+  return header_;
 }
-inline void RequestHeader::set_has_user_priority() {
-  _has_bits_[0] |= 0x00000080u;
+inline ::cockroach::proto::ResponseHeader* WatchResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline void RequestHeader::clear_has_user_priority() {
-  _has_bits_[0] &= ~0x00000080u;
+inline void WatchResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchResponse.header)
 }
-inline void RequestHeader::clear_user_priority() {
-  user_priority_ = 1;
-  clear_has_user_priority();
+
+// repeated .cockroach.proto.WatchEvent events = 2;
+inline int WatchResponse::events_size() const {
+  return events_.size();
 }
-inline ::google::protobuf::int32 RequestHeader::user_priority() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user_priority)
-  return user_priority_;
+inline void WatchResponse::clear_events() {
+  events_.Clear();
 }
-inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
-  set_has_user_priority();
-  user_priority_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user_priority)
+inline const ::cockroach::proto::WatchEvent& WatchResponse::events(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchResponse.events)
+  return events_.Get(index);
 }
-
-// optional .cockroach.proto.Transaction txn = 9;
-inline bool RequestHeader::has_txn() const {
-  return (_has_bits_[0] & 0x00000100u) != 0;
+inline ::cockroach::proto::WatchEvent* WatchResponse::mutable_events(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchResponse.events)
+  return events_.Mutable(index);
 }
-inline void RequestHeader::set_has_txn() {
-  _has_bits_[0] |= 0x00000100u;
+inline ::cockroach::proto::WatchEvent* WatchResponse::add_events() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.WatchResponse.events)
+  return events_.Add();
 }
-inline void RequestHeader::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000100u;
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::WatchEvent >&
+WatchResponse::events() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.WatchResponse.events)
+  return events_;
 }
-inline void RequestHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_txn();
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::WatchEvent >*
+WatchResponse::mutable_events() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.WatchResponse.events)
+  return &events_;
 }
-inline const ::cockroach::proto::Transaction& RequestHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+
+// optional bytes end_key = 3;
+inline bool WatchResponse::has_end_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline ::cockroach::proto::Transaction* RequestHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::cockroach::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.txn)
-  return txn_;
+inline void WatchResponse::set_has_end_key() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline ::cockroach::proto::Transaction* RequestHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+inline void WatchResponse::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void RequestHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
+inline void WatchResponse::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.txn)
+  clear_has_end_key();
 }
-
-// optional .cockroach.proto.ReadConsistencyType read_consistency = 10;
-inline bool RequestHeader::has_read_consistency() const {
-  return (_has_bits_[0] & 0x00000200u) != 0;
+inline const ::std::string& WatchResponse::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.WatchResponse.end_key)
+  return *end_key_;
 }
-inline void RequestHeader::set_has_read_consistency() {
-  _has_bits_[0] |= 0x00000200u;
+inline void WatchResponse::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.WatchResponse.end_key)
 }
-inline void RequestHeader::clear_has_read_consistency() {
-  _has_bits_[0] &= ~0x00000200u;
+inline void WatchResponse::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.WatchResponse.end_key)
 }
-inline void RequestHeader::clear_read_consistency() {
-  read_consistency_ = 0;
-  clear_has_read_consistency();
+inline void WatchResponse::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.WatchResponse.end_key)
 }
-inline ::cockroach::proto::ReadConsistencyType RequestHeader::read_consistency() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.read_consistency)
-  return static_cast< ::cockroach::proto::ReadConsistencyType >(read_consistency_);
+inline ::std::string* WatchResponse::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.WatchResponse.end_key)
+  return end_key_;
 }
-inline void RequestHeader::set_read_consistency(::cockroach::proto::ReadConsistencyType value) {
-  assert(::cockroach::proto::ReadConsistencyType_IsValid(value));
-  set_has_read_consistency();
-  read_consistency_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
+inline ::std::string* WatchResponse::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void WatchResponse::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.WatchResponse.end_key)
 }
 
 // -------------------------------------------------------------------
 
-// ResponseHeader
+// EndTransactionRequest
 
-// optional .cockroach.proto.Error error = 1;
-inline bool ResponseHeader::has_error() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool EndTransactionRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseHeader::set_has_error() {
+inline void EndTransactionRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseHeader::clear_has_error() {
+inline void EndTransactionRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResponseHeader::clear_error() {
-  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
-  clear_has_error();
+inline void EndTransactionRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Error& ResponseHeader::error() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.error)
-  return error_ != NULL ? *error_ : *default_instance_->error_;
+inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Error* ResponseHeader::mutable_error() {
-  set_has_error();
-  if (error_ == NULL) error_ = new ::cockroach::proto::Error;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.error)
-  return error_;
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::Error* ResponseHeader::release_error() {
-  clear_has_error();
-  ::cockroach::proto::Error* temp = error_;
-  error_ = NULL;
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_error(::cockroach::proto::Error* error) {
-  delete error_;
-  error_ = error;
-  if (error) {
-    set_has_error();
+inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_error();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.error)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
 }
 
-// optional .cockroach.proto.Timestamp timestamp = 2;
-inline bool ResponseHeader::has_timestamp() const {
+// optional bool commit = 2;
+inline bool EndTransactionRequest::has_commit() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseHeader::set_has_timestamp() {
+inline void EndTransactionRequest::set_has_commit() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ResponseHeader::clear_has_timestamp() {
+inline void EndTransactionRequest::clear_has_commit() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ResponseHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
-}
-inline const ::cockroach::proto::Timestamp& ResponseHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
-}
-inline ::cockroach::proto::Timestamp* ResponseHeader::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.timestamp)
-  return timestamp_;
+inline void EndTransactionRequest::clear_commit() {
+  commit_ = false;
+  clear_has_commit();
 }
-inline ::cockroach::proto::Timestamp* ResponseHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
-  return temp;
+inline bool EndTransactionRequest::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
+  return commit_;
 }
-inline void ResponseHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
-  } else {
-    clear_has_timestamp();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.timestamp)
+inline void EndTransactionRequest::set_commit(bool value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
 }
 
-// optional .cockroach.proto.Transaction txn = 3;
-inline bool ResponseHeader::has_txn() const {
+// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+inline bool EndTransactionRequest::has_internal_commit_trigger() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void ResponseHeader::set_has_txn() {
+inline void EndTransactionRequest::set_has_internal_commit_trigger() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void ResponseHeader::clear_has_txn() {
+inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void ResponseHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_txn();
+inline void EndTransactionRequest::clear_internal_commit_trigger() {
+  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+  clear_has_internal_commit_trigger();
 }
-inline const ::cockroach::proto::Transaction& ResponseHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
 }
-inline ::cockroach::proto::Transaction* ResponseHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::cockroach::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.txn)
-  return txn_;
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
+  set_has_internal_commit_trigger();
+  if (internal_commit_trigger_ == NULL) internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_;
 }
-inline ::cockroach::proto::Transaction* ResponseHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::proto::Transaction* temp = txn_;
-  txn_ = NULL;
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
+  clear_has_internal_commit_trigger();
+  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
+  internal_commit_trigger_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
+inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
+  delete internal_commit_trigger_;
+  internal_commit_trigger_ = internal_commit_trigger;
+  if (internal_commit_trigger) {
+    set_has_internal_commit_trigger();
   } else {
-    clear_has_txn();
+    clear_has_internal_commit_trigger();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
 }
 
-// -------------------------------------------------------------------
-
-// ContainsRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool ContainsRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.Timestamp refreshed_timestamp = 4;
+inline bool EndTransactionRequest::has_refreshed_timestamp() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void ContainsRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void EndTransactionRequest::set_has_refreshed_timestamp() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void ContainsRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void EndTransactionRequest::clear_has_refreshed_timestamp() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline void ContainsRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void EndTransactionRequest::clear_refreshed_timestamp() {
+  if (refreshed_timestamp_ != NULL) refreshed_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_refreshed_timestamp();
 }
-inline const ::cockroach::proto::RequestHeader& ContainsRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ContainsRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::proto::Timestamp& EndTransactionRequest::refreshed_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.refreshed_timestamp)
+  return refreshed_timestamp_ != NULL ? *refreshed_timestamp_ : *default_instance_->refreshed_timestamp_;
 }
-inline ::cockroach::proto::RequestHeader* ContainsRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ContainsRequest.header)
-  return header_;
+inline ::cockroach::proto::Timestamp* EndTransactionRequest::mutable_refreshed_timestamp() {
+  set_has_refreshed_timestamp();
+  if (refreshed_timestamp_ == NULL) refreshed_timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.refreshed_timestamp)
+  return refreshed_timestamp_;
 }
-inline ::cockroach::proto::RequestHeader* ContainsRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::proto::Timestamp* EndTransactionRequest::release_refreshed_timestamp() {
+  clear_has_refreshed_timestamp();
+  ::cockroach::proto::Timestamp* temp = refreshed_timestamp_;
+  refreshed_timestamp_ = NULL;
   return temp;
 }
-inline void ContainsRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void EndTransactionRequest::set_allocated_refreshed_timestamp(::cockroach::proto::Timestamp* refreshed_timestamp) {
+  delete refreshed_timestamp_;
+  refreshed_timestamp_ = refreshed_timestamp;
+  if (refreshed_timestamp) {
+    set_has_refreshed_timestamp();
   } else {
-    clear_has_header();
+    clear_has_refreshed_timestamp();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ContainsRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.refreshed_timestamp)
 }
 
 // -------------------------------------------------------------------
 
-// ContainsResponse
+// EndTransactionResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ContainsResponse::has_header() const {
+inline bool EndTransactionResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ContainsResponse::set_has_header() {
+inline void EndTransactionResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ContainsResponse::clear_has_header() {
+inline void EndTransactionResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ContainsResponse::clear_header() {
+inline void EndTransactionResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& ContainsResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ContainsResponse.header)
+inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* ContainsResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ContainsResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* ContainsResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ContainsResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3748,937 +8276,1094 @@ inline void ContainsResponse::set_allocated_header(::cockroach::proto::ResponseH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ContainsResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
 }
 
-// optional bool exists = 2;
-inline bool ContainsResponse::has_exists() const {
+// optional int64 commit_wait = 2;
+inline bool EndTransactionResponse::has_commit_wait() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ContainsResponse::set_has_exists() {
+inline void EndTransactionResponse::set_has_commit_wait() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ContainsResponse::clear_has_exists() {
+inline void EndTransactionResponse::clear_has_commit_wait() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ContainsResponse::clear_exists() {
-  exists_ = false;
-  clear_has_exists();
+inline void EndTransactionResponse::clear_commit_wait() {
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_commit_wait();
 }
-inline bool ContainsResponse::exists() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ContainsResponse.exists)
-  return exists_;
+inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
+  return commit_wait_;
 }
-inline void ContainsResponse::set_exists(bool value) {
-  set_has_exists();
-  exists_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ContainsResponse.exists)
+inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
+  set_has_commit_wait();
+  commit_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
 }
 
-// -------------------------------------------------------------------
-
-// GetRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool GetRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// repeated bytes resolved = 3;
+inline int EndTransactionResponse::resolved_size() const {
+  return resolved_.size();
 }
-inline void GetRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void EndTransactionResponse::clear_resolved() {
+  resolved_.Clear();
 }
-inline void GetRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline const ::std::string& EndTransactionResponse::resolved(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Get(index);
 }
-inline void GetRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Mutable(index);
 }
-inline const ::cockroach::proto::RequestHeader& GetRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
+  resolved_.Mutable(index)->assign(value);
 }
-inline ::cockroach::proto::RequestHeader* GetRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRequest.header)
-  return header_;
+inline void EndTransactionResponse::set_resolved(int index, const char* value) {
+  resolved_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
 }
-inline ::cockroach::proto::RequestHeader* GetRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
+  resolved_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline ::std::string* EndTransactionResponse::add_resolved() {
+  return resolved_.Add();
+}
+inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const char* value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
+  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionResponse::resolved() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_;
 }
-inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRequest.header)
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionResponse::mutable_resolved() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
+  return &resolved_;
 }
 
 // -------------------------------------------------------------------
 
-// GetResponse
+// RequestUnion
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool GetResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void GetResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void GetResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+// optional .cockroach.proto.ContainsRequest contains = 1;
+inline bool RequestUnion::has_contains() const {
+  return value_case() == kContains;
 }
-inline void GetResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_contains() {
+  _oneof_case_[0] = kContains;
 }
-inline const ::cockroach::proto::ResponseHeader& GetResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_contains() {
+  if (has_contains()) {
+    delete value_.contains_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* GetResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.header)
-  return header_;
+inline const ::cockroach::proto::ContainsRequest& RequestUnion::contains() const {
+  return has_contains() ? *value_.contains_
+                      : ::cockroach::proto::ContainsRequest::default_instance();
 }
-inline ::cockroach::proto::ResponseHeader* GetResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::ContainsRequest* RequestUnion::mutable_contains() {
+  if (!has_contains()) {
+    clear_value();
+    set_has_contains();
+    value_.contains_ = new ::cockroach::proto::ContainsRequest;
+  }
+  return value_.contains_;
 }
-inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::ContainsRequest* RequestUnion::release_contains() {
+  if (has_contains()) {
+    clear_has_value();
+    ::cockroach::proto::ContainsRequest* temp = value_.contains_;
+    value_.contains_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_contains(::cockroach::proto::ContainsRequest* contains) {
+  clear_value();
+  if (contains) {
+    set_has_contains();
+    value_.contains_ = contains;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool GetResponse::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .cockroach.proto.GetRequest get = 2;
+inline bool RequestUnion::has_get() const {
+  return value_case() == kGet;
 }
-inline void GetResponse::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
+inline void RequestUnion::set_has_get() {
+  _oneof_case_[0] = kGet;
 }
-inline void GetResponse::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void RequestUnion::clear_get() {
+  if (has_get()) {
+    delete value_.get_;
+    clear_has_value();
+  }
 }
-inline void GetResponse::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline const ::cockroach::proto::GetRequest& RequestUnion::get() const {
+  return has_get() ? *value_.get_
+                      : ::cockroach::proto::GetRequest::default_instance();
 }
-inline const ::cockroach::proto::Value& GetResponse::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline ::cockroach::proto::GetRequest* RequestUnion::mutable_get() {
+  if (!has_get()) {
+    clear_value();
+    set_has_get();
+    value_.get_ = new ::cockroach::proto::GetRequest;
+  }
+  return value_.get_;
 }
-inline ::cockroach::proto::Value* GetResponse::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.value)
-  return value_;
+inline ::cockroach::proto::GetRequest* RequestUnion::release_get() {
+  if (has_get()) {
+    clear_has_value();
+    ::cockroach::proto::GetRequest* temp = value_.get_;
+    value_.get_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline ::cockroach::proto::Value* GetResponse::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline void RequestUnion::set_allocated_get(::cockroach::proto::GetRequest* get) {
+  clear_value();
+  if (get) {
+    set_has_get();
+    value_.get_ = get;
+  }
 }
-inline void GetResponse::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
+
+// optional .cockroach.proto.PutRequest put = 3;
+inline bool RequestUnion::has_put() const {
+  return value_case() == kPut;
+}
+inline void RequestUnion::set_has_put() {
+  _oneof_case_[0] = kPut;
+}
+inline void RequestUnion::clear_put() {
+  if (has_put()) {
+    delete value_.put_;
     clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.value)
 }
-
-// -------------------------------------------------------------------
-
-// PutRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool PutRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+inline const ::cockroach::proto::PutRequest& RequestUnion::put() const {
+  return has_put() ? *value_.put_
+                      : ::cockroach::proto::PutRequest::default_instance();
 }
-inline void PutRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline ::cockroach::proto::PutRequest* RequestUnion::mutable_put() {
+  if (!has_put()) {
+    clear_value();
+    set_has_put();
+    value_.put_ = new ::cockroach::proto::PutRequest;
+  }
+  return value_.put_;
 }
-inline void PutRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline ::cockroach::proto::PutRequest* RequestUnion::release_put() {
+  if (has_put()) {
+    clear_has_value();
+    ::cockroach::proto::PutRequest* temp = value_.put_;
+    value_.put_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void PutRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_allocated_put(::cockroach::proto::PutRequest* put) {
+  clear_value();
+  if (put) {
+    set_has_put();
+    value_.put_ = put;
+  }
 }
-inline const ::cockroach::proto::RequestHeader& PutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+
+// optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+inline bool RequestUnion::has_conditional_put() const {
+  return value_case() == kConditionalPut;
 }
-inline ::cockroach::proto::RequestHeader* PutRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.header)
-  return header_;
+inline void RequestUnion::set_has_conditional_put() {
+  _oneof_case_[0] = kConditionalPut;
 }
-inline ::cockroach::proto::RequestHeader* PutRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void RequestUnion::clear_conditional_put() {
+  if (has_conditional_put()) {
+    delete value_.conditional_put_;
+    clear_has_value();
+  }
 }
-inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
+inline const ::cockroach::proto::ConditionalPutRequest& RequestUnion::conditional_put() const {
+  return has_conditional_put() ? *value_.conditional_put_
+                      : ::cockroach::proto::ConditionalPutRequest::default_instance();
+}
+inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::mutable_conditional_put() {
+  if (!has_conditional_put()) {
+    clear_value();
+    set_has_conditional_put();
+    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutRequest;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.header)
+  return value_.conditional_put_;
 }
-
-// optional .cockroach.proto.Value value = 2;
-inline bool PutRequest::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::release_conditional_put() {
+  if (has_conditional_put()) {
+    clear_has_value();
+    ::cockroach::proto::ConditionalPutRequest* temp = value_.conditional_put_;
+    value_.conditional_put_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void PutRequest::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
+inline void RequestUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put) {
+  clear_value();
+  if (conditional_put) {
+    set_has_conditional_put();
+    value_.conditional_put_ = conditional_put;
+  }
 }
-inline void PutRequest::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+
+// optional .cockroach.proto.IncrementRequest increment = 5;
+inline bool RequestUnion::has_increment() const {
+  return value_case() == kIncrement;
 }
-inline void PutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void RequestUnion::set_has_increment() {
+  _oneof_case_[0] = kIncrement;
 }
-inline const ::cockroach::proto::Value& PutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline void RequestUnion::clear_increment() {
+  if (has_increment()) {
+    delete value_.increment_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::Value* PutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.value)
-  return value_;
+inline const ::cockroach::proto::IncrementRequest& RequestUnion::increment() const {
+  return has_increment() ? *value_.increment_
+                      : ::cockroach::proto::IncrementRequest::default_instance();
 }
-inline ::cockroach::proto::Value* PutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline ::cockroach::proto::IncrementRequest* RequestUnion::mutable_increment() {
+  if (!has_increment()) {
+    clear_value();
+    set_has_increment();
+    value_.increment_ = new ::cockroach::proto::IncrementRequest;
+  }
+  return value_.increment_;
 }
-inline void PutRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
+inline ::cockroach::proto::IncrementRequest* RequestUnion::release_increment() {
+  if (has_increment()) {
     clear_has_value();
+    ::cockroach::proto::IncrementRequest* temp = value_.increment_;
+    value_.increment_ = NULL;
+    return temp;
+  } else {
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.value)
-}
-
-// -------------------------------------------------------------------
-
-// PutResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool PutResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RequestUnion::set_allocated_increment(::cockroach::proto::IncrementRequest* increment) {
+  clear_value();
+  if (increment) {
+    set_has_increment();
+    value_.increment_ = increment;
+  }
 }
-inline void PutResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.DeleteRequest delete = 6;
+inline bool RequestUnion::has_delete_() const {
+  return value_case() == kDelete;
 }
-inline void PutResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_delete_() {
+  _oneof_case_[0] = kDelete;
 }
-inline const ::cockroach::proto::ResponseHeader& PutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_delete_() {
+  if (has_delete_()) {
+    delete value_.delete__;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* PutResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutResponse.header)
-  return header_;
+inline const ::cockroach::proto::DeleteRequest& RequestUnion::delete_() const {
+  return has_delete_() ? *value_.delete__
+                      : ::cockroach::proto::DeleteRequest::default_instance();
 }
-inline ::cockroach::proto::ResponseHeader* PutResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::DeleteRequest* RequestUnion::mutable_delete_() {
+  if (!has_delete_()) {
+    clear_value();
+    set_has_delete_();
+    value_.delete__ = new ::cockroach::proto::DeleteRequest;
+  }
+  return value_.delete__;
 }
-inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::DeleteRequest* RequestUnion::release_delete_() {
+  if (has_delete_()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRequest* temp = value_.delete__;
+    value_.delete__ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutResponse.header)
 }
-
-// -------------------------------------------------------------------
-
-// ConditionalPutRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool ConditionalPutRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void ConditionalPutRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RequestUnion::set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_) {
+  clear_value();
+  if (delete_) {
+    set_has_delete_();
+    value_.delete__ = delete_;
+  }
 }
-inline void ConditionalPutRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+inline bool RequestUnion::has_delete_range() const {
+  return value_case() == kDeleteRange;
 }
-inline void ConditionalPutRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_delete_range() {
+  _oneof_case_[0] = kDeleteRange;
 }
-inline const ::cockroach::proto::RequestHeader& ConditionalPutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_delete_range() {
+  if (has_delete_range()) {
+    delete value_.delete_range_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.header)
-  return header_;
+inline const ::cockroach::proto::DeleteRangeRequest& RequestUnion::delete_range() const {
+  return has_delete_range() ? *value_.delete_range_
+                      : ::cockroach::proto::DeleteRangeRequest::default_instance();
 }
-inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::mutable_delete_range() {
+  if (!has_delete_range()) {
+    clear_value();
+    set_has_delete_range();
+    value_.delete_range_ = new ::cockroach::proto::DeleteRangeRequest;
+  }
+  return value_.delete_range_;
 }
-inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::release_delete_range() {
+  if (has_delete_range()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRangeRequest* temp = value_.delete_range_;
+    value_.delete_range_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.header)
-}
-
-// optional .cockroach.proto.Value value = 2;
-inline bool ConditionalPutRequest::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void ConditionalPutRequest::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
 }
-inline void ConditionalPutRequest::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range) {
+  clear_value();
+  if (delete_range) {
+    set_has_delete_range();
+    value_.delete_range_ = delete_range;
+  }
 }
-inline void ConditionalPutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+
+// optional .cockroach.proto.ScanRequest scan = 8;
+inline bool RequestUnion::has_scan() const {
+  return value_case() == kScan;
 }
-inline const ::cockroach::proto::Value& ConditionalPutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline void RequestUnion::set_has_scan() {
+  _oneof_case_[0] = kScan;
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.value)
-  return value_;
+inline void RequestUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanRequest::default_instance();
 }
-inline void ConditionalPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
+inline ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanRequest;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.value)
+  return value_.scan_;
 }
-
-// optional .cockroach.proto.Value exp_value = 3;
-inline bool ConditionalPutRequest::has_exp_value() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+inline ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRequest* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void ConditionalPutRequest::set_has_exp_value() {
-  _has_bits_[0] |= 0x00000004u;
+inline void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
 }
-inline void ConditionalPutRequest::clear_has_exp_value() {
-  _has_bits_[0] &= ~0x00000004u;
+
+// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+inline bool RequestUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
 }
-inline void ConditionalPutRequest::clear_exp_value() {
-  if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
-  clear_has_exp_value();
+inline void RequestUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
 }
-inline const ::cockroach::proto::Value& ConditionalPutRequest::exp_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.exp_value)
-  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
+inline void RequestUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_exp_value() {
-  set_has_exp_value();
-  if (exp_value_ == NULL) exp_value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.exp_value)
-  return exp_value_;
+inline const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionRequest::default_instance();
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::release_exp_value() {
-  clear_has_exp_value();
-  ::cockroach::proto::Value* temp = exp_value_;
-  exp_value_ = NULL;
-  return temp;
+inline ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
+  }
+  return value_.end_transaction_;
 }
-inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::proto::Value* exp_value) {
-  delete exp_value_;
-  exp_value_ = exp_value;
-  if (exp_value) {
-    set_has_exp_value();
+inline ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
   } else {
-    clear_has_exp_value();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.exp_value)
-}
-
-// -------------------------------------------------------------------
-
-// ConditionalPutResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ConditionalPutResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
 }
-inline void ConditionalPutResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.InitPutRequest init_put = 10;
+inline bool RequestUnion::has_init_put() const {
+  return value_case() == kInitPut;
 }
-inline void ConditionalPutResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_init_put() {
+  _oneof_case_[0] = kInitPut;
 }
-inline const ::cockroach::proto::ResponseHeader& ConditionalPutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_init_put() {
+  if (has_init_put()) {
+    delete value_.init_put_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutResponse.header)
-  return header_;
+inline const ::cockroach::proto::InitPutRequest& RequestUnion::init_put() const {
+  return has_init_put() ? *value_.init_put_
+                      : ::cockroach::proto::InitPutRequest::default_instance();
 }
-inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::InitPutRequest* RequestUnion::mutable_init_put() {
+  if (!has_init_put()) {
+    clear_value();
+    set_has_init_put();
+    value_.init_put_ = new ::cockroach::proto::InitPutRequest;
+  }
+  return value_.init_put_;
 }
-inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::InitPutRequest* RequestUnion::release_init_put() {
+  if (has_init_put()) {
+    clear_has_value();
+    ::cockroach::proto::InitPutRequest* temp = value_.init_put_;
+    value_.init_put_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutResponse.header)
-}
-
-// -------------------------------------------------------------------
-
-// IncrementRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool IncrementRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void IncrementRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RequestUnion::set_allocated_init_put(::cockroach::proto::InitPutRequest* init_put) {
+  clear_value();
+  if (init_put) {
+    set_has_init_put();
+    value_.init_put_ = init_put;
+  }
 }
-inline void IncrementRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.AggregateRequest aggregate = 11;
+inline bool RequestUnion::has_aggregate() const {
+  return value_case() == kAggregate;
 }
-inline void IncrementRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_aggregate() {
+  _oneof_case_[0] = kAggregate;
 }
-inline const ::cockroach::proto::RequestHeader& IncrementRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_aggregate() {
+  if (has_aggregate()) {
+    delete value_.aggregate_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::RequestHeader* IncrementRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementRequest.header)
-  return header_;
+inline const ::cockroach::proto::AggregateRequest& RequestUnion::aggregate() const {
+  return has_aggregate() ? *value_.aggregate_
+                      : ::cockroach::proto::AggregateRequest::default_instance();
 }
-inline ::cockroach::proto::RequestHeader* IncrementRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::AggregateRequest* RequestUnion::mutable_aggregate() {
+  if (!has_aggregate()) {
+    clear_value();
+    set_has_aggregate();
+    value_.aggregate_ = new ::cockroach::proto::AggregateRequest;
+  }
+  return value_.aggregate_;
 }
-inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::AggregateRequest* RequestUnion::release_aggregate() {
+  if (has_aggregate()) {
+    clear_has_value();
+    ::cockroach::proto::AggregateRequest* temp = value_.aggregate_;
+    value_.aggregate_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementRequest.header)
-}
-
-// optional int64 increment = 2;
-inline bool IncrementRequest::has_increment() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void IncrementRequest::set_has_increment() {
-  _has_bits_[0] |= 0x00000002u;
 }
-inline void IncrementRequest::clear_has_increment() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void RequestUnion::set_allocated_aggregate(::cockroach::proto::AggregateRequest* aggregate) {
+  clear_value();
+  if (aggregate) {
+    set_has_aggregate();
+    value_.aggregate_ = aggregate;
+  }
 }
-inline void IncrementRequest::clear_increment() {
-  increment_ = GOOGLE_LONGLONG(0);
-  clear_has_increment();
+
+inline bool RequestUnion::has_value() {
+  return value_case() != VALUE_NOT_SET;
 }
-inline ::google::protobuf::int64 IncrementRequest::increment() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.increment)
-  return increment_;
+inline void RequestUnion::clear_has_value() {
+  _oneof_case_[0] = VALUE_NOT_SET;
 }
-inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
-  set_has_increment();
-  increment_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.increment)
+inline RequestUnion::ValueCase RequestUnion::value_case() const {
+  return RequestUnion::ValueCase(_oneof_case_[0]);
 }
-
 // -------------------------------------------------------------------
 
-// IncrementResponse
+// ResponseUnion
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool IncrementResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.ContainsResponse contains = 1;
+inline bool ResponseUnion::has_contains() const {
+  return value_case() == kContains;
 }
-inline void IncrementResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ResponseUnion::set_has_contains() {
+  _oneof_case_[0] = kContains;
 }
-inline void IncrementResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ResponseUnion::clear_contains() {
+  if (has_contains()) {
+    delete value_.contains_;
+    clear_has_value();
+  }
 }
-inline void IncrementResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline const ::cockroach::proto::ContainsResponse& ResponseUnion::contains() const {
+  return has_contains() ? *value_.contains_
+                      : ::cockroach::proto::ContainsResponse::default_instance();
+}
+inline ::cockroach::proto::ContainsResponse* ResponseUnion::mutable_contains() {
+  if (!has_contains()) {
+    clear_value();
+    set_has_contains();
+    value_.contains_ = new ::cockroach::proto::ContainsResponse;
+  }
+  return value_.contains_;
+}
+inline ::cockroach::proto::ContainsResponse* ResponseUnion::release_contains() {
+  if (has_contains()) {
+    clear_has_value();
+    ::cockroach::proto::ContainsResponse* temp = value_.contains_;
+    value_.contains_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_contains(::cockroach::proto::ContainsResponse* contains) {
+  clear_value();
+  if (contains) {
+    set_has_contains();
+    value_.contains_ = contains;
+  }
+}
+
+// optional .cockroach.proto.GetResponse get = 2;
+inline bool ResponseUnion::has_get() const {
+  return value_case() == kGet;
 }
-inline const ::cockroach::proto::ResponseHeader& IncrementResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void ResponseUnion::set_has_get() {
+  _oneof_case_[0] = kGet;
 }
-inline ::cockroach::proto::ResponseHeader* IncrementResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementResponse.header)
-  return header_;
+inline void ResponseUnion::clear_get() {
+  if (has_get()) {
+    delete value_.get_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* IncrementResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline const ::cockroach::proto::GetResponse& ResponseUnion::get() const {
+  return has_get() ? *value_.get_
+                      : ::cockroach::proto::GetResponse::default_instance();
 }
-inline void IncrementResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::GetResponse* ResponseUnion::mutable_get() {
+  if (!has_get()) {
+    clear_value();
+    set_has_get();
+    value_.get_ = new ::cockroach::proto::GetResponse;
+  }
+  return value_.get_;
+}
+inline ::cockroach::proto::GetResponse* ResponseUnion::release_get() {
+  if (has_get()) {
+    clear_has_value();
+    ::cockroach::proto::GetResponse* temp = value_.get_;
+    value_.get_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementResponse.header)
 }
-
-// optional int64 new_value = 2;
-inline bool IncrementResponse::has_new_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline void ResponseUnion::set_allocated_get(::cockroach::proto::GetResponse* get) {
+  clear_value();
+  if (get) {
+    set_has_get();
+    value_.get_ = get;
+  }
 }
-inline void IncrementResponse::set_has_new_value() {
-  _has_bits_[0] |= 0x00000002u;
+
+// optional .cockroach.proto.PutResponse put = 3;
+inline bool ResponseUnion::has_put() const {
+  return value_case() == kPut;
 }
-inline void IncrementResponse::clear_has_new_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ResponseUnion::set_has_put() {
+  _oneof_case_[0] = kPut;
 }
-inline void IncrementResponse::clear_new_value() {
-  new_value_ = GOOGLE_LONGLONG(0);
-  clear_has_new_value();
+inline void ResponseUnion::clear_put() {
+  if (has_put()) {
+    delete value_.put_;
+    clear_has_value();
+  }
 }
-inline ::google::protobuf::int64 IncrementResponse::new_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.new_value)
-  return new_value_;
+inline const ::cockroach::proto::PutResponse& ResponseUnion::put() const {
+  return has_put() ? *value_.put_
+                      : ::cockroach::proto::PutResponse::default_instance();
 }
-inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
-  set_has_new_value();
-  new_value_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.new_value)
+inline ::cockroach::proto::PutResponse* ResponseUnion::mutable_put() {
+  if (!has_put()) {
+    clear_value();
+    set_has_put();
+    value_.put_ = new ::cockroach::proto::PutResponse;
+  }
+  return value_.put_;
 }
-
-// -------------------------------------------------------------------
-
-// DeleteRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool DeleteRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+inline ::cockroach::proto::PutResponse* ResponseUnion::release_put() {
+  if (has_put()) {
+    clear_has_value();
+    ::cockroach::proto::PutResponse* temp = value_.put_;
+    value_.put_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void DeleteRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ResponseUnion::set_allocated_put(::cockroach::proto::PutResponse* put) {
+  clear_value();
+  if (put) {
+    set_has_put();
+    value_.put_ = put;
+  }
 }
-inline void DeleteRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+inline bool ResponseUnion::has_conditional_put() const {
+  return value_case() == kConditionalPut;
 }
-inline void DeleteRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ResponseUnion::set_has_conditional_put() {
+  _oneof_case_[0] = kConditionalPut;
 }
-inline const ::cockroach::proto::RequestHeader& DeleteRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void ResponseUnion::clear_conditional_put() {
+  if (has_conditional_put()) {
+    delete value_.conditional_put_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::RequestHeader* DeleteRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRequest.header)
-  return header_;
+inline const ::cockroach::proto::ConditionalPutResponse& ResponseUnion::conditional_put() const {
+  return has_conditional_put() ? *value_.conditional_put_
+                      : ::cockroach::proto::ConditionalPutResponse::default_instance();
 }
-inline ::cockroach::proto::RequestHeader* DeleteRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::mutable_conditional_put() {
+  if (!has_conditional_put()) {
+    clear_value();
+    set_has_conditional_put();
+    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutResponse;
+  }
+  return value_.conditional_put_;
 }
-inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::release_conditional_put() {
+  if (has_conditional_put()) {
+    clear_has_value();
+    ::cockroach::proto::ConditionalPutResponse* temp = value_.conditional_put_;
+    value_.conditional_put_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRequest.header)
-}
-
-// -------------------------------------------------------------------
-
-// DeleteResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool DeleteResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ResponseUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put) {
+  clear_value();
+  if (conditional_put) {
+    set_has_conditional_put();
+    value_.conditional_put_ = conditional_put;
+  }
 }
-inline void DeleteResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.IncrementResponse increment = 5;
+inline bool ResponseUnion::has_increment() const {
+  return value_case() == kIncrement;
 }
-inline void DeleteResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ResponseUnion::set_has_increment() {
+  _oneof_case_[0] = kIncrement;
 }
-inline const ::cockroach::proto::ResponseHeader& DeleteResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void ResponseUnion::clear_increment() {
+  if (has_increment()) {
+    delete value_.increment_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* DeleteResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteResponse.header)
-  return header_;
+inline const ::cockroach::proto::IncrementResponse& ResponseUnion::increment() const {
+  return has_increment() ? *value_.increment_
+                      : ::cockroach::proto::IncrementResponse::default_instance();
 }
-inline ::cockroach::proto::ResponseHeader* DeleteResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::IncrementResponse* ResponseUnion::mutable_increment() {
+  if (!has_increment()) {
+    clear_value();
+    set_has_increment();
+    value_.increment_ = new ::cockroach::proto::IncrementResponse;
+  }
+  return value_.increment_;
 }
-inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::IncrementResponse* ResponseUnion::release_increment() {
+  if (has_increment()) {
+    clear_has_value();
+    ::cockroach::proto::IncrementResponse* temp = value_.increment_;
+    value_.increment_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteResponse.header)
-}
-
-// -------------------------------------------------------------------
-
-// DeleteRangeRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool DeleteRangeRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ResponseUnion::set_allocated_increment(::cockroach::proto::IncrementResponse* increment) {
+  clear_value();
+  if (increment) {
+    set_has_increment();
+    value_.increment_ = increment;
+  }
 }
-inline void DeleteRangeRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.DeleteResponse delete = 6;
+inline bool ResponseUnion::has_delete_() const {
+  return value_case() == kDelete;
 }
-inline void DeleteRangeRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ResponseUnion::set_has_delete_() {
+  _oneof_case_[0] = kDelete;
 }
-inline const ::cockroach::proto::RequestHeader& DeleteRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void ResponseUnion::clear_delete_() {
+  if (has_delete_()) {
+    delete value_.delete__;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeRequest.header)
-  return header_;
+inline const ::cockroach::proto::DeleteResponse& ResponseUnion::delete_() const {
+  return has_delete_() ? *value_.delete__
+                      : ::cockroach::proto::DeleteResponse::default_instance();
 }
-inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::DeleteResponse* ResponseUnion::mutable_delete_() {
+  if (!has_delete_()) {
+    clear_value();
+    set_has_delete_();
+    value_.delete__ = new ::cockroach::proto::DeleteResponse;
+  }
+  return value_.delete__;
 }
-inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::DeleteResponse* ResponseUnion::release_delete_() {
+  if (has_delete_()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteResponse* temp = value_.delete__;
+    value_.delete__ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeRequest.header)
 }
-
-// optional int64 max_entries_to_delete = 2;
-inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline void ResponseUnion::set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_) {
+  clear_value();
+  if (delete_) {
+    set_has_delete_();
+    value_.delete__ = delete_;
+  }
 }
-inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
-  _has_bits_[0] |= 0x00000002u;
+
+// optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+inline bool ResponseUnion::has_delete_range() const {
+  return value_case() == kDeleteRange;
 }
-inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ResponseUnion::set_has_delete_range() {
+  _oneof_case_[0] = kDeleteRange;
 }
-inline void DeleteRangeRequest::clear_max_entries_to_delete() {
-  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
-  clear_has_max_entries_to_delete();
+inline void ResponseUnion::clear_delete_range() {
+  if (has_delete_range()) {
+    delete value_.delete_range_;
+    clear_has_value();
+  }
 }
-inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
-  return max_entries_to_delete_;
+inline const ::cockroach::proto::DeleteRangeResponse& ResponseUnion::delete_range() const {
+  return has_delete_range() ? *value_.delete_range_
+                      : ::cockroach::proto::DeleteRangeResponse::default_instance();
 }
-inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
-  set_has_max_entries_to_delete();
-  max_entries_to_delete_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::mutable_delete_range() {
+  if (!has_delete_range()) {
+    clear_value();
+    set_has_delete_range();
+    value_.delete_range_ = new ::cockroach::proto::DeleteRangeResponse;
+  }
+  return value_.delete_range_;
 }
-
-// -------------------------------------------------------------------
-
-// DeleteRangeResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool DeleteRangeResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::release_delete_range() {
+  if (has_delete_range()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRangeResponse* temp = value_.delete_range_;
+    value_.delete_range_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void DeleteRangeResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ResponseUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range) {
+  clear_value();
+  if (delete_range) {
+    set_has_delete_range();
+    value_.delete_range_ = delete_range;
+  }
 }
-inline void DeleteRangeResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.ScanResponse scan = 8;
+inline bool ResponseUnion::has_scan() const {
+  return value_case() == kScan;
 }
-inline void DeleteRangeResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ResponseUnion::set_has_scan() {
+  _oneof_case_[0] = kScan;
 }
-inline const ::cockroach::proto::ResponseHeader& DeleteRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void ResponseUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeResponse.header)
-  return header_;
+inline const ::cockroach::proto::ScanResponse& ResponseUnion::scan() const {
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanResponse::default_instance();
 }
-inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::ScanResponse* ResponseUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanResponse;
+  }
+  return value_.scan_;
 }
-inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::ScanResponse* ResponseUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanResponse* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeResponse.header)
 }
-
-// optional int64 num_deleted = 2;
-inline bool DeleteRangeResponse::has_num_deleted() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline void ResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
 }
-inline void DeleteRangeResponse::set_has_num_deleted() {
-  _has_bits_[0] |= 0x00000002u;
+
+// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+inline bool ResponseUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
 }
-inline void DeleteRangeResponse::clear_has_num_deleted() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ResponseUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
 }
-inline void DeleteRangeResponse::clear_num_deleted() {
-  num_deleted_ = GOOGLE_LONGLONG(0);
-  clear_has_num_deleted();
+inline void ResponseUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
 }
-inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.num_deleted)
-  return num_deleted_;
+inline const ::cockroach::proto::EndTransactionResponse& ResponseUnion::end_transaction() const {
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionResponse::default_instance();
 }
-inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
-  set_has_num_deleted();
-  num_deleted_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeResponse.num_deleted)
+inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
+  }
+  return value_.end_transaction_;
 }
-
-// -------------------------------------------------------------------
-
-// ScanRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool ScanRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void ScanRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
 }
-inline void ScanRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.InitPutResponse init_put = 10;
+inline bool ResponseUnion::has_init_put() const {
+  return value_case() == kInitPut;
 }
-inline void ScanRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ResponseUnion::set_has_init_put() {
+  _oneof_case_[0] = kInitPut;
 }
-inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void ResponseUnion::clear_init_put() {
+  if (has_init_put()) {
+    delete value_.init_put_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
-  return header_;
+inline const ::cockroach::proto::InitPutResponse& ResponseUnion::init_put() const {
+  return has_init_put() ? *value_.init_put_
+                      : ::cockroach::proto::InitPutResponse::default_instance();
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::InitPutResponse* ResponseUnion::mutable_init_put() {
+  if (!has_init_put()) {
+    clear_value();
+    set_has_init_put();
+    value_.init_put_ = new ::cockroach::proto::InitPutResponse;
+  }
+  return value_.init_put_;
 }
-inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::InitPutResponse* ResponseUnion::release_init_put() {
+  if (has_init_put()) {
+    clear_has_value();
+    ::cockroach::proto::InitPutResponse* temp = value_.init_put_;
+    value_.init_put_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_init_put(::cockroach::proto::InitPutResponse* init_put) {
+  clear_value();
+  if (init_put) {
+    set_has_init_put();
+    value_.init_put_ = init_put;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
 }
 
-// optional int64 max_results = 2;
-inline bool ScanRequest::has_max_results() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .cockroach.proto.AggregateResponse aggregate = 11;
+inline bool ResponseUnion::has_aggregate() const {
+  return value_case() == kAggregate;
 }
-inline void ScanRequest::set_has_max_results() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ResponseUnion::set_has_aggregate() {
+  _oneof_case_[0] = kAggregate;
 }
-inline void ScanRequest::clear_has_max_results() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ResponseUnion::clear_aggregate() {
+  if (has_aggregate()) {
+    delete value_.aggregate_;
+    clear_has_value();
+  }
 }
-inline void ScanRequest::clear_max_results() {
-  max_results_ = GOOGLE_LONGLONG(0);
-  clear_has_max_results();
+inline const ::cockroach::proto::AggregateResponse& ResponseUnion::aggregate() const {
+  return has_aggregate() ? *value_.aggregate_
+                      : ::cockroach::proto::AggregateResponse::default_instance();
 }
-inline ::google::protobuf::int64 ScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
-  return max_results_;
+inline ::cockroach::proto::AggregateResponse* ResponseUnion::mutable_aggregate() {
+  if (!has_aggregate()) {
+    clear_value();
+    set_has_aggregate();
+    value_.aggregate_ = new ::cockroach::proto::AggregateResponse;
+  }
+  return value_.aggregate_;
 }
-inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
-  set_has_max_results();
-  max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+inline ::cockroach::proto::AggregateResponse* ResponseUnion::release_aggregate() {
+  if (has_aggregate()) {
+    clear_has_value();
+    ::cockroach::proto::AggregateResponse* temp = value_.aggregate_;
+    value_.aggregate_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_aggregate(::cockroach::proto::AggregateResponse* aggregate) {
+  clear_value();
+  if (aggregate) {
+    set_has_aggregate();
+    value_.aggregate_ = aggregate;
+  }
 }
 
+inline bool ResponseUnion::has_value() {
+  return value_case() != VALUE_NOT_SET;
+}
+inline void ResponseUnion::clear_has_value() {
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+inline ResponseUnion::ValueCase ResponseUnion::value_case() const {
+  return ResponseUnion::ValueCase(_oneof_case_[0]);
+}
 // -------------------------------------------------------------------
 
-// ScanResponse
+// BatchRequest
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ScanResponse::has_header() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool BatchRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanResponse::set_has_header() {
+inline void BatchRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanResponse::clear_has_header() {
+inline void BatchRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+inline void BatchRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
+inline const ::cockroach::proto::RequestHeader& BatchRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
+inline ::cockroach::proto::RequestHeader* BatchRequest::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
+inline ::cockroach::proto::RequestHeader* BatchRequest::release_header() {
   clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
+  ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void BatchRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4686,74 +9371,74 @@ inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchRequest.header)
 }
 
-// repeated .cockroach.proto.KeyValue rows = 2;
-inline int ScanResponse::rows_size() const {
-  return rows_.size();
+// repeated .cockroach.proto.RequestUnion requests = 2;
+inline int BatchRequest::requests_size() const {
+  return requests_.size();
 }
-inline void ScanResponse::clear_rows() {
-  rows_.Clear();
+inline void BatchRequest::clear_requests() {
+  requests_.Clear();
 }
-inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
-  return rows_.Get(index);
+inline const ::cockroach::proto::RequestUnion& BatchRequest::requests(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.requests)
+  return requests_.Get(index);
 }
-inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
-  return rows_.Mutable(index);
+inline ::cockroach::proto::RequestUnion* BatchRequest::mutable_requests(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.requests)
+  return requests_.Mutable(index);
 }
-inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
-  return rows_.Add();
+inline ::cockroach::proto::RequestUnion* BatchRequest::add_requests() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.BatchRequest.requests)
+  return requests_.Add();
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
-ScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
-  return rows_;
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
+BatchRequest::requests() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.BatchRequest.requests)
+  return requests_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
-ScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
-  return &rows_;
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
+BatchRequest::mutable_requests() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchRequest.requests)
+  return &requests_;
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionRequest
+// BatchResponse
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool EndTransactionRequest::has_header() const {
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool BatchResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionRequest::set_has_header() {
+inline void BatchResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionRequest::clear_has_header() {
+inline void BatchResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+inline void BatchResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+inline const ::cockroach::proto::ResponseHeader& BatchResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* BatchResponse::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+inline ::cockroach::proto::ResponseHeader* BatchResponse::release_header() {
   clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
+  ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void BatchResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4761,109 +9446,74 @@ inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::Requ
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
-}
-
-// optional bool commit = 2;
-inline bool EndTransactionRequest::has_commit() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void EndTransactionRequest::set_has_commit() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void EndTransactionRequest::clear_has_commit() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void EndTransactionRequest::clear_commit() {
-  commit_ = false;
-  clear_has_commit();
-}
-inline bool EndTransactionRequest::commit() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
-  return commit_;
-}
-inline void EndTransactionRequest::set_commit(bool value) {
-  set_has_commit();
-  commit_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchResponse.header)
 }
 
-// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-inline bool EndTransactionRequest::has_internal_commit_trigger() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void EndTransactionRequest::set_has_internal_commit_trigger() {
-  _has_bits_[0] |= 0x00000004u;
+// repeated .cockroach.proto.ResponseUnion responses = 2;
+inline int BatchResponse::responses_size() const {
+  return responses_.size();
 }
-inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void BatchResponse::clear_responses() {
+  responses_.Clear();
 }
-inline void EndTransactionRequest::clear_internal_commit_trigger() {
-  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-  clear_has_internal_commit_trigger();
+inline const ::cockroach::proto::ResponseUnion& BatchResponse::responses(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.responses)
+  return responses_.Get(index);
 }
-inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+inline ::cockroach::proto::ResponseUnion* BatchResponse::mutable_responses(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.responses)
+  return responses_.Mutable(index);
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
-  set_has_internal_commit_trigger();
-  if (internal_commit_trigger_ == NULL) internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_;
+inline ::cockroach::proto::ResponseUnion* BatchResponse::add_responses() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.BatchResponse.responses)
+  return responses_.Add();
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
-  clear_has_internal_commit_trigger();
-  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
-  internal_commit_trigger_ = NULL;
-  return temp;
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
+BatchResponse::responses() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.BatchResponse.responses)
+  return responses_;
 }
-inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
-  delete internal_commit_trigger_;
-  internal_commit_trigger_ = internal_commit_trigger;
-  if (internal_commit_trigger) {
-    set_has_internal_commit_trigger();
-  } else {
-    clear_has_internal_commit_trigger();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
+BatchResponse::mutable_responses() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchResponse.responses)
+  return &responses_;
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool EndTransactionResponse::has_header() const {
+// AdminSplitRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminSplitRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionResponse::set_has_header() {
+inline void AdminSplitRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionResponse::clear_has_header() {
+inline void AdminSplitRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+inline void AdminSplitRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
+inline const ::cockroach::proto::RequestHeader& AdminSplitRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
+inline ::cockroach::proto::RequestHeader* AdminSplitRequest::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
+inline ::cockroach::proto::RequestHeader* AdminSplitRequest::release_header() {
   clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
+  ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void AdminSplitRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4871,922 +9521,1209 @@ inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::Res
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.header)
 }
 
-// optional int64 commit_wait = 2;
-inline bool EndTransactionResponse::has_commit_wait() const {
+// optional bytes split_key = 2;
+inline bool AdminSplitRequest::has_split_key() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EndTransactionResponse::set_has_commit_wait() {
+inline void AdminSplitRequest::set_has_split_key() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EndTransactionResponse::clear_has_commit_wait() {
+inline void AdminSplitRequest::clear_has_split_key() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void EndTransactionResponse::clear_commit_wait() {
-  commit_wait_ = GOOGLE_LONGLONG(0);
-  clear_has_commit_wait();
-}
-inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
-  return commit_wait_;
-}
-inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
-  set_has_commit_wait();
-  commit_wait_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
-}
-
-// repeated bytes resolved = 3;
-inline int EndTransactionResponse::resolved_size() const {
-  return resolved_.size();
-}
-inline void EndTransactionResponse::clear_resolved() {
-  resolved_.Clear();
-}
-inline const ::std::string& EndTransactionResponse::resolved(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Get(index);
-}
-inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Mutable(index);
-}
-inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
-  resolved_.Mutable(index)->assign(value);
-}
-inline void EndTransactionResponse::set_resolved(int index, const char* value) {
-  resolved_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
-}
-inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
-  resolved_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
-}
-inline ::std::string* EndTransactionResponse::add_resolved() {
-  return resolved_.Add();
-}
-inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
-}
-inline void EndTransactionResponse::add_resolved(const char* value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
-}
-inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
-  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
-}
-inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
-EndTransactionResponse::resolved() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_;
-}
-inline ::google::protobuf::RepeatedPtrField< ::std::string>*
-EndTransactionResponse::mutable_resolved() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
-  return &resolved_;
+inline void AdminSplitRequest::clear_split_key() {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_->clear();
+  }
+  clear_has_split_key();
 }
-
-// -------------------------------------------------------------------
-
-// RequestUnion
-
-// optional .cockroach.proto.ContainsRequest contains = 1;
-inline bool RequestUnion::has_contains() const {
-  return value_case() == kContains;
+inline const ::std::string& AdminSplitRequest::split_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.split_key)
+  return *split_key_;
 }
-inline void RequestUnion::set_has_contains() {
-  _oneof_case_[0] = kContains;
+inline void AdminSplitRequest::set_split_key(const ::std::string& value) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  split_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminSplitRequest.split_key)
 }
-inline void RequestUnion::clear_contains() {
-  if (has_contains()) {
-    delete value_.contains_;
-    clear_has_value();
+inline void AdminSplitRequest::set_split_key(const char* value) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
   }
+  split_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminSplitRequest.split_key)
 }
-inline const ::cockroach::proto::ContainsRequest& RequestUnion::contains() const {
-  return has_contains() ? *value_.contains_
-                      : ::cockroach::proto::ContainsRequest::default_instance();
+inline void AdminSplitRequest::set_split_key(const void* value, size_t size) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  split_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminSplitRequest.split_key)
 }
-inline ::cockroach::proto::ContainsRequest* RequestUnion::mutable_contains() {
-  if (!has_contains()) {
-    clear_value();
-    set_has_contains();
-    value_.contains_ = new ::cockroach::proto::ContainsRequest;
+inline ::std::string* AdminSplitRequest::mutable_split_key() {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
   }
-  return value_.contains_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.split_key)
+  return split_key_;
 }
-inline ::cockroach::proto::ContainsRequest* RequestUnion::release_contains() {
-  if (has_contains()) {
-    clear_has_value();
-    ::cockroach::proto::ContainsRequest* temp = value_.contains_;
-    value_.contains_ = NULL;
-    return temp;
-  } else {
+inline ::std::string* AdminSplitRequest::release_split_key() {
+  clear_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = split_key_;
+    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void RequestUnion::set_allocated_contains(::cockroach::proto::ContainsRequest* contains) {
-  clear_value();
-  if (contains) {
-    set_has_contains();
-    value_.contains_ = contains;
+inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key) {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete split_key_;
+  }
+  if (split_key) {
+    set_has_split_key();
+    split_key_ = split_key;
+  } else {
+    clear_has_split_key();
+    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.split_key)
 }
 
-// optional .cockroach.proto.GetRequest get = 2;
-inline bool RequestUnion::has_get() const {
-  return value_case() == kGet;
+// -------------------------------------------------------------------
+
+// AdminSplitResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminSplitResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestUnion::set_has_get() {
-  _oneof_case_[0] = kGet;
+inline void AdminSplitResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestUnion::clear_get() {
-  if (has_get()) {
-    delete value_.get_;
-    clear_has_value();
-  }
+inline void AdminSplitResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::GetRequest& RequestUnion::get() const {
-  return has_get() ? *value_.get_
-                      : ::cockroach::proto::GetRequest::default_instance();
+inline void AdminSplitResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::GetRequest* RequestUnion::mutable_get() {
-  if (!has_get()) {
-    clear_value();
-    set_has_get();
-    value_.get_ = new ::cockroach::proto::GetRequest;
-  }
-  return value_.get_;
+inline const ::cockroach::proto::ResponseHeader& AdminSplitResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::GetRequest* RequestUnion::release_get() {
-  if (has_get()) {
-    clear_has_value();
-    ::cockroach::proto::GetRequest* temp = value_.get_;
-    value_.get_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitResponse.header)
+  return header_;
 }
-inline void RequestUnion::set_allocated_get(::cockroach::proto::GetRequest* get) {
-  clear_value();
-  if (get) {
-    set_has_get();
-    value_.get_ = get;
+inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminSplitResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitResponse.header)
 }
 
-// optional .cockroach.proto.PutRequest put = 3;
-inline bool RequestUnion::has_put() const {
-  return value_case() == kPut;
+// -------------------------------------------------------------------
+
+// AdminMergeRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminMergeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestUnion::set_has_put() {
-  _oneof_case_[0] = kPut;
+inline void AdminMergeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestUnion::clear_put() {
-  if (has_put()) {
-    delete value_.put_;
-    clear_has_value();
-  }
+inline void AdminMergeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::PutRequest& RequestUnion::put() const {
-  return has_put() ? *value_.put_
-                      : ::cockroach::proto::PutRequest::default_instance();
+inline void AdminMergeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::PutRequest* RequestUnion::mutable_put() {
-  if (!has_put()) {
-    clear_value();
-    set_has_put();
-    value_.put_ = new ::cockroach::proto::PutRequest;
-  }
-  return value_.put_;
+inline const ::cockroach::proto::RequestHeader& AdminMergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::PutRequest* RequestUnion::release_put() {
-  if (has_put()) {
-    clear_has_value();
-    ::cockroach::proto::PutRequest* temp = value_.put_;
-    value_.put_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::RequestHeader* AdminMergeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeRequest.header)
+  return header_;
 }
-inline void RequestUnion::set_allocated_put(::cockroach::proto::PutRequest* put) {
-  clear_value();
-  if (put) {
-    set_has_put();
-    value_.put_ = put;
+inline ::cockroach::proto::RequestHeader* AdminMergeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeRequest.header)
 }
 
-// optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-inline bool RequestUnion::has_conditional_put() const {
-  return value_case() == kConditionalPut;
+// -------------------------------------------------------------------
+
+// AdminMergeResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminMergeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestUnion::set_has_conditional_put() {
-  _oneof_case_[0] = kConditionalPut;
+inline void AdminMergeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestUnion::clear_conditional_put() {
-  if (has_conditional_put()) {
-    delete value_.conditional_put_;
-    clear_has_value();
-  }
+inline void AdminMergeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::ConditionalPutRequest& RequestUnion::conditional_put() const {
-  return has_conditional_put() ? *value_.conditional_put_
-                      : ::cockroach::proto::ConditionalPutRequest::default_instance();
+inline void AdminMergeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::mutable_conditional_put() {
-  if (!has_conditional_put()) {
-    clear_value();
-    set_has_conditional_put();
-    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutRequest;
-  }
-  return value_.conditional_put_;
+inline const ::cockroach::proto::ResponseHeader& AdminMergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::release_conditional_put() {
-  if (has_conditional_put()) {
-    clear_has_value();
-    ::cockroach::proto::ConditionalPutRequest* temp = value_.conditional_put_;
-    value_.conditional_put_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeResponse.header)
+  return header_;
 }
-inline void RequestUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put) {
-  clear_value();
-  if (conditional_put) {
-    set_has_conditional_put();
-    value_.conditional_put_ = conditional_put;
+inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeResponse.header)
 }
 
-// optional .cockroach.proto.IncrementRequest increment = 5;
-inline bool RequestUnion::has_increment() const {
-  return value_case() == kIncrement;
+// -------------------------------------------------------------------
+
+// AdminBulkLoadRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminBulkLoadRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestUnion::set_has_increment() {
-  _oneof_case_[0] = kIncrement;
+inline void AdminBulkLoadRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestUnion::clear_increment() {
-  if (has_increment()) {
-    delete value_.increment_;
-    clear_has_value();
-  }
+inline void AdminBulkLoadRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::IncrementRequest& RequestUnion::increment() const {
-  return has_increment() ? *value_.increment_
-                      : ::cockroach::proto::IncrementRequest::default_instance();
+inline void AdminBulkLoadRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::IncrementRequest* RequestUnion::mutable_increment() {
-  if (!has_increment()) {
-    clear_value();
-    set_has_increment();
-    value_.increment_ = new ::cockroach::proto::IncrementRequest;
-  }
-  return value_.increment_;
+inline const ::cockroach::proto::RequestHeader& AdminBulkLoadRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBulkLoadRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::IncrementRequest* RequestUnion::release_increment() {
-  if (has_increment()) {
-    clear_has_value();
-    ::cockroach::proto::IncrementRequest* temp = value_.increment_;
-    value_.increment_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::RequestHeader* AdminBulkLoadRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBulkLoadRequest.header)
+  return header_;
 }
-inline void RequestUnion::set_allocated_increment(::cockroach::proto::IncrementRequest* increment) {
-  clear_value();
-  if (increment) {
-    set_has_increment();
-    value_.increment_ = increment;
+inline ::cockroach::proto::RequestHeader* AdminBulkLoadRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminBulkLoadRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBulkLoadRequest.header)
 }
 
-// optional .cockroach.proto.DeleteRequest delete = 6;
-inline bool RequestUnion::has_delete_() const {
-  return value_case() == kDelete;
+// repeated .cockroach.proto.KeyValue rows = 2;
+inline int AdminBulkLoadRequest::rows_size() const {
+  return rows_.size();
 }
-inline void RequestUnion::set_has_delete_() {
-  _oneof_case_[0] = kDelete;
+inline void AdminBulkLoadRequest::clear_rows() {
+  rows_.Clear();
 }
-inline void RequestUnion::clear_delete_() {
-  if (has_delete_()) {
-    delete value_.delete__;
-    clear_has_value();
-  }
+inline const ::cockroach::proto::KeyValue& AdminBulkLoadRequest::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBulkLoadRequest.rows)
+  return rows_.Get(index);
 }
-inline const ::cockroach::proto::DeleteRequest& RequestUnion::delete_() const {
-  return has_delete_() ? *value_.delete__
-                      : ::cockroach::proto::DeleteRequest::default_instance();
+inline ::cockroach::proto::KeyValue* AdminBulkLoadRequest::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBulkLoadRequest.rows)
+  return rows_.Mutable(index);
 }
-inline ::cockroach::proto::DeleteRequest* RequestUnion::mutable_delete_() {
-  if (!has_delete_()) {
-    clear_value();
-    set_has_delete_();
-    value_.delete__ = new ::cockroach::proto::DeleteRequest;
-  }
-  return value_.delete__;
+inline ::cockroach::proto::KeyValue* AdminBulkLoadRequest::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.AdminBulkLoadRequest.rows)
+  return rows_.Add();
 }
-inline ::cockroach::proto::DeleteRequest* RequestUnion::release_delete_() {
-  if (has_delete_()) {
-    clear_has_value();
-    ::cockroach::proto::DeleteRequest* temp = value_.delete__;
-    value_.delete__ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+AdminBulkLoadRequest::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.AdminBulkLoadRequest.rows)
+  return rows_;
 }
-inline void RequestUnion::set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_) {
-  clear_value();
-  if (delete_) {
-    set_has_delete_();
-    value_.delete__ = delete_;
-  }
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+AdminBulkLoadRequest::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.AdminBulkLoadRequest.rows)
+  return &rows_;
+}
+
+// -------------------------------------------------------------------
+
+// AdminBulkLoadResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminBulkLoadResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void AdminBulkLoadResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-
-// optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-inline bool RequestUnion::has_delete_range() const {
-  return value_case() == kDeleteRange;
+inline void AdminBulkLoadResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestUnion::set_has_delete_range() {
-  _oneof_case_[0] = kDeleteRange;
+inline void AdminBulkLoadResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline void RequestUnion::clear_delete_range() {
-  if (has_delete_range()) {
-    delete value_.delete_range_;
-    clear_has_value();
-  }
+inline const ::cockroach::proto::ResponseHeader& AdminBulkLoadResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBulkLoadResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline const ::cockroach::proto::DeleteRangeRequest& RequestUnion::delete_range() const {
-  return has_delete_range() ? *value_.delete_range_
-                      : ::cockroach::proto::DeleteRangeRequest::default_instance();
+inline ::cockroach::proto::ResponseHeader* AdminBulkLoadResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBulkLoadResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::mutable_delete_range() {
-  if (!has_delete_range()) {
-    clear_value();
-    set_has_delete_range();
-    value_.delete_range_ = new ::cockroach::proto::DeleteRangeRequest;
-  }
-  return value_.delete_range_;
+inline ::cockroach::proto::ResponseHeader* AdminBulkLoadResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::release_delete_range() {
-  if (has_delete_range()) {
-    clear_has_value();
-    ::cockroach::proto::DeleteRangeRequest* temp = value_.delete_range_;
-    value_.delete_range_ = NULL;
-    return temp;
+inline void AdminBulkLoadResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    return NULL;
-  }
-}
-inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range) {
-  clear_value();
-  if (delete_range) {
-    set_has_delete_range();
-    value_.delete_range_ = delete_range;
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBulkLoadResponse.header)
 }
 
-// optional .cockroach.proto.ScanRequest scan = 8;
-inline bool RequestUnion::has_scan() const {
-  return value_case() == kScan;
+// optional .cockroach.proto.Timestamp load_timestamp = 2;
+inline bool AdminBulkLoadResponse::has_load_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestUnion::set_has_scan() {
-  _oneof_case_[0] = kScan;
+inline void AdminBulkLoadResponse::set_has_load_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
-    clear_has_value();
-  }
+inline void AdminBulkLoadResponse::clear_has_load_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanRequest::default_instance();
+inline void AdminBulkLoadResponse::clear_load_timestamp() {
+  if (load_timestamp_ != NULL) load_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_load_timestamp();
 }
-inline ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
-  if (!has_scan()) {
-    clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanRequest;
-  }
-  return value_.scan_;
+inline const ::cockroach::proto::Timestamp& AdminBulkLoadResponse::load_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBulkLoadResponse.load_timestamp)
+  return load_timestamp_ != NULL ? *load_timestamp_ : *default_instance_->load_timestamp_;
 }
-inline ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
-  if (has_scan()) {
-    clear_has_value();
-    ::cockroach::proto::ScanRequest* temp = value_.scan_;
-    value_.scan_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::Timestamp* AdminBulkLoadResponse::mutable_load_timestamp() {
+  set_has_load_timestamp();
+  if (load_timestamp_ == NULL) load_timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBulkLoadResponse.load_timestamp)
+  return load_timestamp_;
 }
-inline void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
-  clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+inline ::cockroach::proto::Timestamp* AdminBulkLoadResponse::release_load_timestamp() {
+  clear_has_load_timestamp();
+  ::cockroach::proto::Timestamp* temp = load_timestamp_;
+  load_timestamp_ = NULL;
+  return temp;
+}
+inline void AdminBulkLoadResponse::set_allocated_load_timestamp(::cockroach::proto::Timestamp* load_timestamp) {
+  delete load_timestamp_;
+  load_timestamp_ = load_timestamp;
+  if (load_timestamp) {
+    set_has_load_timestamp();
+  } else {
+    clear_has_load_timestamp();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBulkLoadResponse.load_timestamp)
 }
 
-// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-inline bool RequestUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// -------------------------------------------------------------------
+
+// AdminTransferLeaseRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminTransferLeaseRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+inline void AdminTransferLeaseRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
-    clear_has_value();
-  }
+inline void AdminTransferLeaseRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionRequest::default_instance();
+inline void AdminTransferLeaseRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
-    clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
-  }
-  return value_.end_transaction_;
+inline const ::cockroach::proto::RequestHeader& AdminTransferLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminTransferLeaseRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
-  if (has_end_transaction()) {
-    clear_has_value();
-    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::RequestHeader* AdminTransferLeaseRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminTransferLeaseRequest.header)
+  return header_;
 }
-inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
-  clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+inline ::cockroach::proto::RequestHeader* AdminTransferLeaseRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminTransferLeaseRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminTransferLeaseRequest.header)
 }
 
-inline bool RequestUnion::has_value() {
-  return value_case() != VALUE_NOT_SET;
+// optional int32 store_id = 2;
+inline bool AdminTransferLeaseRequest::has_store_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestUnion::clear_has_value() {
-  _oneof_case_[0] = VALUE_NOT_SET;
+inline void AdminTransferLeaseRequest::set_has_store_id() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline RequestUnion::ValueCase RequestUnion::value_case() const {
-  return RequestUnion::ValueCase(_oneof_case_[0]);
+inline void AdminTransferLeaseRequest::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AdminTransferLeaseRequest::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
+}
+inline ::google::protobuf::int32 AdminTransferLeaseRequest::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminTransferLeaseRequest.store_id)
+  return store_id_;
 }
+inline void AdminTransferLeaseRequest::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminTransferLeaseRequest.store_id)
+}
+
 // -------------------------------------------------------------------
 
-// ResponseUnion
+// AdminTransferLeaseResponse
 
-// optional .cockroach.proto.ContainsResponse contains = 1;
-inline bool ResponseUnion::has_contains() const {
-  return value_case() == kContains;
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminTransferLeaseResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_contains() {
-  _oneof_case_[0] = kContains;
+inline void AdminTransferLeaseResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_contains() {
-  if (has_contains()) {
-    delete value_.contains_;
-    clear_has_value();
-  }
+inline void AdminTransferLeaseResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::ContainsResponse& ResponseUnion::contains() const {
-  return has_contains() ? *value_.contains_
-                      : ::cockroach::proto::ContainsResponse::default_instance();
+inline void AdminTransferLeaseResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::ContainsResponse* ResponseUnion::mutable_contains() {
-  if (!has_contains()) {
-    clear_value();
-    set_has_contains();
-    value_.contains_ = new ::cockroach::proto::ContainsResponse;
-  }
-  return value_.contains_;
+inline const ::cockroach::proto::ResponseHeader& AdminTransferLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminTransferLeaseResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ContainsResponse* ResponseUnion::release_contains() {
-  if (has_contains()) {
-    clear_has_value();
-    ::cockroach::proto::ContainsResponse* temp = value_.contains_;
-    value_.contains_ = NULL;
-    return temp;
+inline ::cockroach::proto::ResponseHeader* AdminTransferLeaseResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminTransferLeaseResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* AdminTransferLeaseResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminTransferLeaseResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    return NULL;
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminTransferLeaseResponse.header)
 }
-inline void ResponseUnion::set_allocated_contains(::cockroach::proto::ContainsResponse* contains) {
-  clear_value();
-  if (contains) {
-    set_has_contains();
-    value_.contains_ = contains;
+
+// -------------------------------------------------------------------
+
+// BackupFile
+
+// optional string name = 1;
+inline bool BackupFile::has_name() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void BackupFile::set_has_name() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void BackupFile::clear_has_name() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void BackupFile::clear_name() {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_->clear();
   }
+  clear_has_name();
 }
-
-// optional .cockroach.proto.GetResponse get = 2;
-inline bool ResponseUnion::has_get() const {
-  return value_case() == kGet;
+inline const ::std::string& BackupFile::name() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupFile.name)
+  return *name_;
 }
-inline void ResponseUnion::set_has_get() {
-  _oneof_case_[0] = kGet;
+inline void BackupFile::set_name(const ::std::string& value) {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
+  }
+  name_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupFile.name)
 }
-inline void ResponseUnion::clear_get() {
-  if (has_get()) {
-    delete value_.get_;
-    clear_has_value();
+inline void BackupFile::set_name(const char* value) {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
   }
+  name_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.BackupFile.name)
 }
-inline const ::cockroach::proto::GetResponse& ResponseUnion::get() const {
-  return has_get() ? *value_.get_
-                      : ::cockroach::proto::GetResponse::default_instance();
+inline void BackupFile::set_name(const char* value, size_t size) {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
+  }
+  name_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.BackupFile.name)
 }
-inline ::cockroach::proto::GetResponse* ResponseUnion::mutable_get() {
-  if (!has_get()) {
-    clear_value();
-    set_has_get();
-    value_.get_ = new ::cockroach::proto::GetResponse;
+inline ::std::string* BackupFile::mutable_name() {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
   }
-  return value_.get_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupFile.name)
+  return name_;
 }
-inline ::cockroach::proto::GetResponse* ResponseUnion::release_get() {
-  if (has_get()) {
-    clear_has_value();
-    ::cockroach::proto::GetResponse* temp = value_.get_;
-    value_.get_ = NULL;
-    return temp;
-  } else {
+inline ::std::string* BackupFile::release_name() {
+  clear_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = name_;
+    name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_get(::cockroach::proto::GetResponse* get) {
-  clear_value();
-  if (get) {
-    set_has_get();
-    value_.get_ = get;
+inline void BackupFile::set_allocated_name(::std::string* name) {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete name_;
+  }
+  if (name) {
+    set_has_name();
+    name_ = name;
+  } else {
+    clear_has_name();
+    name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupFile.name)
 }
 
-// optional .cockroach.proto.PutResponse put = 3;
-inline bool ResponseUnion::has_put() const {
-  return value_case() == kPut;
+// optional bytes start_key = 2;
+inline bool BackupFile::has_start_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseUnion::set_has_put() {
-  _oneof_case_[0] = kPut;
+inline void BackupFile::set_has_start_key() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void ResponseUnion::clear_put() {
-  if (has_put()) {
-    delete value_.put_;
-    clear_has_value();
+inline void BackupFile::clear_has_start_key() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void BackupFile::clear_start_key() {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_->clear();
   }
+  clear_has_start_key();
 }
-inline const ::cockroach::proto::PutResponse& ResponseUnion::put() const {
-  return has_put() ? *value_.put_
-                      : ::cockroach::proto::PutResponse::default_instance();
+inline const ::std::string& BackupFile::start_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupFile.start_key)
+  return *start_key_;
 }
-inline ::cockroach::proto::PutResponse* ResponseUnion::mutable_put() {
-  if (!has_put()) {
-    clear_value();
-    set_has_put();
-    value_.put_ = new ::cockroach::proto::PutResponse;
+inline void BackupFile::set_start_key(const ::std::string& value) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
   }
-  return value_.put_;
+  start_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupFile.start_key)
 }
-inline ::cockroach::proto::PutResponse* ResponseUnion::release_put() {
-  if (has_put()) {
-    clear_has_value();
-    ::cockroach::proto::PutResponse* temp = value_.put_;
-    value_.put_ = NULL;
-    return temp;
-  } else {
+inline void BackupFile::set_start_key(const char* value) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.BackupFile.start_key)
+}
+inline void BackupFile::set_start_key(const void* value, size_t size) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.BackupFile.start_key)
+}
+inline ::std::string* BackupFile::mutable_start_key() {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupFile.start_key)
+  return start_key_;
+}
+inline ::std::string* BackupFile::release_start_key() {
+  clear_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = start_key_;
+    start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_put(::cockroach::proto::PutResponse* put) {
-  clear_value();
-  if (put) {
-    set_has_put();
-    value_.put_ = put;
+inline void BackupFile::set_allocated_start_key(::std::string* start_key) {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete start_key_;
+  }
+  if (start_key) {
+    set_has_start_key();
+    start_key_ = start_key;
+  } else {
+    clear_has_start_key();
+    start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupFile.start_key)
 }
 
-// optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-inline bool ResponseUnion::has_conditional_put() const {
-  return value_case() == kConditionalPut;
+// optional bytes end_key = 3;
+inline bool BackupFile::has_end_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void ResponseUnion::set_has_conditional_put() {
-  _oneof_case_[0] = kConditionalPut;
+inline void BackupFile::set_has_end_key() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void ResponseUnion::clear_conditional_put() {
-  if (has_conditional_put()) {
-    delete value_.conditional_put_;
-    clear_has_value();
+inline void BackupFile::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void BackupFile::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
   }
+  clear_has_end_key();
 }
-inline const ::cockroach::proto::ConditionalPutResponse& ResponseUnion::conditional_put() const {
-  return has_conditional_put() ? *value_.conditional_put_
-                      : ::cockroach::proto::ConditionalPutResponse::default_instance();
+inline const ::std::string& BackupFile::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupFile.end_key)
+  return *end_key_;
 }
-inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::mutable_conditional_put() {
-  if (!has_conditional_put()) {
-    clear_value();
-    set_has_conditional_put();
-    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutResponse;
+inline void BackupFile::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
   }
-  return value_.conditional_put_;
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupFile.end_key)
 }
-inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::release_conditional_put() {
-  if (has_conditional_put()) {
-    clear_has_value();
-    ::cockroach::proto::ConditionalPutResponse* temp = value_.conditional_put_;
-    value_.conditional_put_ = NULL;
-    return temp;
-  } else {
+inline void BackupFile::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.BackupFile.end_key)
+}
+inline void BackupFile::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.BackupFile.end_key)
+}
+inline ::std::string* BackupFile::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupFile.end_key)
+  return end_key_;
+}
+inline ::std::string* BackupFile::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put) {
-  clear_value();
-  if (conditional_put) {
-    set_has_conditional_put();
-    value_.conditional_put_ = conditional_put;
+inline void BackupFile::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupFile.end_key)
 }
 
-// optional .cockroach.proto.IncrementResponse increment = 5;
-inline bool ResponseUnion::has_increment() const {
-  return value_case() == kIncrement;
+// optional int64 count = 4;
+inline bool BackupFile::has_count() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void ResponseUnion::set_has_increment() {
-  _oneof_case_[0] = kIncrement;
+inline void BackupFile::set_has_count() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void ResponseUnion::clear_increment() {
-  if (has_increment()) {
-    delete value_.increment_;
-    clear_has_value();
+inline void BackupFile::clear_has_count() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void BackupFile::clear_count() {
+  count_ = GOOGLE_LONGLONG(0);
+  clear_has_count();
+}
+inline ::google::protobuf::int64 BackupFile::count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupFile.count)
+  return count_;
+}
+inline void BackupFile::set_count(::google::protobuf::int64 value) {
+  set_has_count();
+  count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupFile.count)
+}
+
+// optional int64 bytes = 5;
+inline bool BackupFile::has_bytes() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void BackupFile::set_has_bytes() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void BackupFile::clear_has_bytes() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void BackupFile::clear_bytes() {
+  bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_bytes();
+}
+inline ::google::protobuf::int64 BackupFile::bytes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupFile.bytes)
+  return bytes_;
+}
+inline void BackupFile::set_bytes(::google::protobuf::int64 value) {
+  set_has_bytes();
+  bytes_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupFile.bytes)
+}
+
+// optional bytes checksum = 6;
+inline bool BackupFile::has_checksum() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void BackupFile::set_has_checksum() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void BackupFile::clear_has_checksum() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void BackupFile::clear_checksum() {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_->clear();
   }
+  clear_has_checksum();
 }
-inline const ::cockroach::proto::IncrementResponse& ResponseUnion::increment() const {
-  return has_increment() ? *value_.increment_
-                      : ::cockroach::proto::IncrementResponse::default_instance();
+inline const ::std::string& BackupFile::checksum() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupFile.checksum)
+  return *checksum_;
 }
-inline ::cockroach::proto::IncrementResponse* ResponseUnion::mutable_increment() {
-  if (!has_increment()) {
-    clear_value();
-    set_has_increment();
-    value_.increment_ = new ::cockroach::proto::IncrementResponse;
+inline void BackupFile::set_checksum(const ::std::string& value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
   }
-  return value_.increment_;
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupFile.checksum)
 }
-inline ::cockroach::proto::IncrementResponse* ResponseUnion::release_increment() {
-  if (has_increment()) {
-    clear_has_value();
-    ::cockroach::proto::IncrementResponse* temp = value_.increment_;
-    value_.increment_ = NULL;
-    return temp;
-  } else {
+inline void BackupFile::set_checksum(const char* value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.BackupFile.checksum)
+}
+inline void BackupFile::set_checksum(const void* value, size_t size) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.BackupFile.checksum)
+}
+inline ::std::string* BackupFile::mutable_checksum() {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupFile.checksum)
+  return checksum_;
+}
+inline ::std::string* BackupFile::release_checksum() {
+  clear_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = checksum_;
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_increment(::cockroach::proto::IncrementResponse* increment) {
-  clear_value();
-  if (increment) {
-    set_has_increment();
-    value_.increment_ = increment;
+inline void BackupFile::set_allocated_checksum(::std::string* checksum) {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
   }
+  if (checksum) {
+    set_has_checksum();
+    checksum_ = checksum;
+  } else {
+    clear_has_checksum();
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupFile.checksum)
 }
 
-// optional .cockroach.proto.DeleteResponse delete = 6;
-inline bool ResponseUnion::has_delete_() const {
-  return value_case() == kDelete;
+// -------------------------------------------------------------------
+
+// BackupManifest
+
+// optional .cockroach.proto.Timestamp timestamp = 1;
+inline bool BackupManifest::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_delete_() {
-  _oneof_case_[0] = kDelete;
+inline void BackupManifest::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_delete_() {
-  if (has_delete_()) {
-    delete value_.delete__;
-    clear_has_value();
-  }
+inline void BackupManifest::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::DeleteResponse& ResponseUnion::delete_() const {
-  return has_delete_() ? *value_.delete__
-                      : ::cockroach::proto::DeleteResponse::default_instance();
+inline void BackupManifest::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
 }
-inline ::cockroach::proto::DeleteResponse* ResponseUnion::mutable_delete_() {
-  if (!has_delete_()) {
-    clear_value();
-    set_has_delete_();
-    value_.delete__ = new ::cockroach::proto::DeleteResponse;
-  }
-  return value_.delete__;
+inline const ::cockroach::proto::Timestamp& BackupManifest::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
 }
-inline ::cockroach::proto::DeleteResponse* ResponseUnion::release_delete_() {
-  if (has_delete_()) {
-    clear_has_value();
-    ::cockroach::proto::DeleteResponse* temp = value_.delete__;
-    value_.delete__ = NULL;
-    return temp;
+inline ::cockroach::proto::Timestamp* BackupManifest::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupManifest.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* BackupManifest::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void BackupManifest::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
   } else {
-    return NULL;
+    clear_has_timestamp();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupManifest.timestamp)
+}
+
+// optional .cockroach.proto.BackupFormat format = 2;
+inline bool BackupManifest::has_format() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void BackupManifest::set_has_format() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void BackupManifest::clear_has_format() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void BackupManifest::clear_format() {
+  format_ = 0;
+  clear_has_format();
+}
+inline ::cockroach::proto::BackupFormat BackupManifest::format() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.format)
+  return static_cast< ::cockroach::proto::BackupFormat >(format_);
+}
+inline void BackupManifest::set_format(::cockroach::proto::BackupFormat value) {
+  assert(::cockroach::proto::BackupFormat_IsValid(value));
+  set_has_format();
+  format_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupManifest.format)
+}
+
+// optional bytes start_key = 3;
+inline bool BackupManifest::has_start_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void BackupManifest::set_has_start_key() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void ResponseUnion::set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_) {
-  clear_value();
-  if (delete_) {
-    set_has_delete_();
-    value_.delete__ = delete_;
+inline void BackupManifest::clear_has_start_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void BackupManifest::clear_start_key() {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_->clear();
   }
+  clear_has_start_key();
 }
-
-// optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-inline bool ResponseUnion::has_delete_range() const {
-  return value_case() == kDeleteRange;
+inline const ::std::string& BackupManifest::start_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.start_key)
+  return *start_key_;
 }
-inline void ResponseUnion::set_has_delete_range() {
-  _oneof_case_[0] = kDeleteRange;
+inline void BackupManifest::set_start_key(const ::std::string& value) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupManifest.start_key)
 }
-inline void ResponseUnion::clear_delete_range() {
-  if (has_delete_range()) {
-    delete value_.delete_range_;
-    clear_has_value();
+inline void BackupManifest::set_start_key(const char* value) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
   }
+  start_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.BackupManifest.start_key)
 }
-inline const ::cockroach::proto::DeleteRangeResponse& ResponseUnion::delete_range() const {
-  return has_delete_range() ? *value_.delete_range_
-                      : ::cockroach::proto::DeleteRangeResponse::default_instance();
+inline void BackupManifest::set_start_key(const void* value, size_t size) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.BackupManifest.start_key)
 }
-inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::mutable_delete_range() {
-  if (!has_delete_range()) {
-    clear_value();
-    set_has_delete_range();
-    value_.delete_range_ = new ::cockroach::proto::DeleteRangeResponse;
+inline ::std::string* BackupManifest::mutable_start_key() {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
   }
-  return value_.delete_range_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupManifest.start_key)
+  return start_key_;
 }
-inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::release_delete_range() {
-  if (has_delete_range()) {
-    clear_has_value();
-    ::cockroach::proto::DeleteRangeResponse* temp = value_.delete_range_;
-    value_.delete_range_ = NULL;
-    return temp;
-  } else {
+inline ::std::string* BackupManifest::release_start_key() {
+  clear_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = start_key_;
+    start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range) {
-  clear_value();
-  if (delete_range) {
-    set_has_delete_range();
-    value_.delete_range_ = delete_range;
+inline void BackupManifest::set_allocated_start_key(::std::string* start_key) {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete start_key_;
+  }
+  if (start_key) {
+    set_has_start_key();
+    start_key_ = start_key;
+  } else {
+    clear_has_start_key();
+    start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupManifest.start_key)
 }
 
-// optional .cockroach.proto.ScanResponse scan = 8;
-inline bool ResponseUnion::has_scan() const {
-  return value_case() == kScan;
+// optional bytes end_key = 4;
+inline bool BackupManifest::has_end_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void ResponseUnion::set_has_scan() {
-  _oneof_case_[0] = kScan;
+inline void BackupManifest::set_has_end_key() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void ResponseUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
-    clear_has_value();
+inline void BackupManifest::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void BackupManifest::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
   }
+  clear_has_end_key();
 }
-inline const ::cockroach::proto::ScanResponse& ResponseUnion::scan() const {
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanResponse::default_instance();
+inline const ::std::string& BackupManifest::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.end_key)
+  return *end_key_;
 }
-inline ::cockroach::proto::ScanResponse* ResponseUnion::mutable_scan() {
-  if (!has_scan()) {
-    clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanResponse;
+inline void BackupManifest::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
   }
-  return value_.scan_;
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.BackupManifest.end_key)
 }
-inline ::cockroach::proto::ScanResponse* ResponseUnion::release_scan() {
-  if (has_scan()) {
-    clear_has_value();
-    ::cockroach::proto::ScanResponse* temp = value_.scan_;
-    value_.scan_ = NULL;
-    return temp;
-  } else {
+inline void BackupManifest::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.BackupManifest.end_key)
+}
+inline void BackupManifest::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.BackupManifest.end_key)
+}
+inline ::std::string* BackupManifest::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupManifest.end_key)
+  return end_key_;
+}
+inline ::std::string* BackupManifest::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
-  clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+inline void BackupManifest::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupManifest.end_key)
 }
 
-// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-inline bool ResponseUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// repeated .cockroach.proto.BackupFile files = 5;
+inline int BackupManifest::files_size() const {
+  return files_.size();
 }
-inline void ResponseUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+inline void BackupManifest::clear_files() {
+  files_.Clear();
 }
-inline void ResponseUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
-    clear_has_value();
-  }
+inline const ::cockroach::proto::BackupFile& BackupManifest::files(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.files)
+  return files_.Get(index);
 }
-inline const ::cockroach::proto::EndTransactionResponse& ResponseUnion::end_transaction() const {
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+inline ::cockroach::proto::BackupFile* BackupManifest::mutable_files(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupManifest.files)
+  return files_.Mutable(index);
 }
-inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
-    clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
-  }
-  return value_.end_transaction_;
+inline ::cockroach::proto::BackupFile* BackupManifest::add_files() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.BackupManifest.files)
+  return files_.Add();
 }
-inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::release_end_transaction() {
-  if (has_end_transaction()) {
-    clear_has_value();
-    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::BackupFile >&
+BackupManifest::files() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.BackupManifest.files)
+  return files_;
 }
-inline void ResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
-  clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::BackupFile >*
+BackupManifest::mutable_files() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BackupManifest.files)
+  return &files_;
+}
+
+// optional .cockroach.proto.Timestamp start_time = 6;
+inline bool BackupManifest::has_start_time() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void BackupManifest::set_has_start_time() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void BackupManifest::clear_has_start_time() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void BackupManifest::clear_start_time() {
+  if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+  clear_has_start_time();
+}
+inline const ::cockroach::proto::Timestamp& BackupManifest::start_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.start_time)
+  return start_time_ != NULL ? *start_time_ : *default_instance_->start_time_;
+}
+inline ::cockroach::proto::Timestamp* BackupManifest::mutable_start_time() {
+  set_has_start_time();
+  if (start_time_ == NULL) start_time_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupManifest.start_time)
+  return start_time_;
+}
+inline ::cockroach::proto::Timestamp* BackupManifest::release_start_time() {
+  clear_has_start_time();
+  ::cockroach::proto::Timestamp* temp = start_time_;
+  start_time_ = NULL;
+  return temp;
+}
+inline void BackupManifest::set_allocated_start_time(::cockroach::proto::Timestamp* start_time) {
+  delete start_time_;
+  start_time_ = start_time;
+  if (start_time) {
+    set_has_start_time();
+  } else {
+    clear_has_start_time();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BackupManifest.start_time)
 }
 
-inline bool ResponseUnion::has_value() {
-  return value_case() != VALUE_NOT_SET;
+// repeated .cockroach.proto.KeyValue system_keys = 7;
+inline int BackupManifest::system_keys_size() const {
+  return system_keys_.size();
 }
-inline void ResponseUnion::clear_has_value() {
-  _oneof_case_[0] = VALUE_NOT_SET;
+inline void BackupManifest::clear_system_keys() {
+  system_keys_.Clear();
 }
-inline ResponseUnion::ValueCase ResponseUnion::value_case() const {
-  return ResponseUnion::ValueCase(_oneof_case_[0]);
+inline const ::cockroach::proto::KeyValue& BackupManifest::system_keys(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BackupManifest.system_keys)
+  return system_keys_.Get(index);
+}
+inline ::cockroach::proto::KeyValue* BackupManifest::mutable_system_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BackupManifest.system_keys)
+  return system_keys_.Mutable(index);
+}
+inline ::cockroach::proto::KeyValue* BackupManifest::add_system_keys() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.BackupManifest.system_keys)
+  return system_keys_.Add();
 }
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+BackupManifest::system_keys() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.BackupManifest.system_keys)
+  return system_keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+BackupManifest::mutable_system_keys() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BackupManifest.system_keys)
+  return &system_keys_;
+}
+
 // -------------------------------------------------------------------
 
-// BatchRequest
+// AdminBackupRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool BatchRequest::has_header() const {
+inline bool AdminBackupRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void BatchRequest::set_has_header() {
+inline void AdminBackupRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void BatchRequest::clear_has_header() {
+inline void AdminBackupRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void BatchRequest::clear_header() {
+inline void AdminBackupRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& BatchRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.header)
+inline const ::cockroach::proto::RequestHeader& AdminBackupRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* BatchRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* AdminBackupRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBackupRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* BatchRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* AdminBackupRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void BatchRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void AdminBackupRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5794,74 +10731,262 @@ inline void BatchRequest::set_allocated_header(::cockroach::proto::RequestHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBackupRequest.header)
+}
+
+// optional string uri = 2;
+inline bool AdminBackupRequest::has_uri() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AdminBackupRequest::set_has_uri() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AdminBackupRequest::clear_has_uri() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AdminBackupRequest::clear_uri() {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_->clear();
+  }
+  clear_has_uri();
+}
+inline const ::std::string& AdminBackupRequest::uri() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupRequest.uri)
+  return *uri_;
+}
+inline void AdminBackupRequest::set_uri(const ::std::string& value) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  uri_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminBackupRequest.uri)
+}
+inline void AdminBackupRequest::set_uri(const char* value) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  uri_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminBackupRequest.uri)
+}
+inline void AdminBackupRequest::set_uri(const char* value, size_t size) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  uri_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminBackupRequest.uri)
+}
+inline ::std::string* AdminBackupRequest::mutable_uri() {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBackupRequest.uri)
+  return uri_;
+}
+inline ::std::string* AdminBackupRequest::release_uri() {
+  clear_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = uri_;
+    uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AdminBackupRequest::set_allocated_uri(::std::string* uri) {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete uri_;
+  }
+  if (uri) {
+    set_has_uri();
+    uri_ = uri;
+  } else {
+    clear_has_uri();
+    uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBackupRequest.uri)
+}
+
+// optional .cockroach.proto.BackupFormat format = 3;
+inline bool AdminBackupRequest::has_format() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AdminBackupRequest::set_has_format() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AdminBackupRequest::clear_has_format() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AdminBackupRequest::clear_format() {
+  format_ = 0;
+  clear_has_format();
+}
+inline ::cockroach::proto::BackupFormat AdminBackupRequest::format() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupRequest.format)
+  return static_cast< ::cockroach::proto::BackupFormat >(format_);
+}
+inline void AdminBackupRequest::set_format(::cockroach::proto::BackupFormat value) {
+  assert(::cockroach::proto::BackupFormat_IsValid(value));
+  set_has_format();
+  format_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminBackupRequest.format)
+}
+
+// optional bytes limit_key = 4;
+inline bool AdminBackupRequest::has_limit_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void AdminBackupRequest::set_has_limit_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void AdminBackupRequest::clear_has_limit_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void AdminBackupRequest::clear_limit_key() {
+  if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_->clear();
+  }
+  clear_has_limit_key();
+}
+inline const ::std::string& AdminBackupRequest::limit_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupRequest.limit_key)
+  return *limit_key_;
+}
+inline void AdminBackupRequest::set_limit_key(const ::std::string& value) {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  limit_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminBackupRequest.limit_key)
+}
+inline void AdminBackupRequest::set_limit_key(const char* value) {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  limit_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminBackupRequest.limit_key)
+}
+inline void AdminBackupRequest::set_limit_key(const void* value, size_t size) {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  limit_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminBackupRequest.limit_key)
+}
+inline ::std::string* AdminBackupRequest::mutable_limit_key() {
+  set_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    limit_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBackupRequest.limit_key)
+  return limit_key_;
+}
+inline ::std::string* AdminBackupRequest::release_limit_key() {
+  clear_has_limit_key();
+  if (limit_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = limit_key_;
+    limit_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AdminBackupRequest::set_allocated_limit_key(::std::string* limit_key) {
+  if (limit_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete limit_key_;
+  }
+  if (limit_key) {
+    set_has_limit_key();
+    limit_key_ = limit_key;
+  } else {
+    clear_has_limit_key();
+    limit_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBackupRequest.limit_key)
 }
 
-// repeated .cockroach.proto.RequestUnion requests = 2;
-inline int BatchRequest::requests_size() const {
-  return requests_.size();
+// optional .cockroach.proto.Timestamp start_time = 5;
+inline bool AdminBackupRequest::has_start_time() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
 }
-inline void BatchRequest::clear_requests() {
-  requests_.Clear();
+inline void AdminBackupRequest::set_has_start_time() {
+  _has_bits_[0] |= 0x00000010u;
 }
-inline const ::cockroach::proto::RequestUnion& BatchRequest::requests(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.requests)
-  return requests_.Get(index);
+inline void AdminBackupRequest::clear_has_start_time() {
+  _has_bits_[0] &= ~0x00000010u;
 }
-inline ::cockroach::proto::RequestUnion* BatchRequest::mutable_requests(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.requests)
-  return requests_.Mutable(index);
+inline void AdminBackupRequest::clear_start_time() {
+  if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+  clear_has_start_time();
 }
-inline ::cockroach::proto::RequestUnion* BatchRequest::add_requests() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.BatchRequest.requests)
-  return requests_.Add();
+inline const ::cockroach::proto::Timestamp& AdminBackupRequest::start_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupRequest.start_time)
+  return start_time_ != NULL ? *start_time_ : *default_instance_->start_time_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
-BatchRequest::requests() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.BatchRequest.requests)
-  return requests_;
+inline ::cockroach::proto::Timestamp* AdminBackupRequest::mutable_start_time() {
+  set_has_start_time();
+  if (start_time_ == NULL) start_time_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBackupRequest.start_time)
+  return start_time_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
-BatchRequest::mutable_requests() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchRequest.requests)
-  return &requests_;
+inline ::cockroach::proto::Timestamp* AdminBackupRequest::release_start_time() {
+  clear_has_start_time();
+  ::cockroach::proto::Timestamp* temp = start_time_;
+  start_time_ = NULL;
+  return temp;
+}
+inline void AdminBackupRequest::set_allocated_start_time(::cockroach::proto::Timestamp* start_time) {
+  delete start_time_;
+  start_time_ = start_time;
+  if (start_time) {
+    set_has_start_time();
+  } else {
+    clear_has_start_time();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBackupRequest.start_time)
 }
 
 // -------------------------------------------------------------------
 
-// BatchResponse
+// AdminBackupResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool BatchResponse::has_header() const {
+inline bool AdminBackupResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void BatchResponse::set_has_header() {
+inline void AdminBackupResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void BatchResponse::clear_has_header() {
+inline void AdminBackupResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void BatchResponse::clear_header() {
+inline void AdminBackupResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& BatchResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.header)
+inline const ::cockroach::proto::ResponseHeader& AdminBackupResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* BatchResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* AdminBackupResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBackupResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* BatchResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* AdminBackupResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void BatchResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void AdminBackupResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5869,74 +10994,85 @@ inline void BatchResponse::set_allocated_header(::cockroach::proto::ResponseHead
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBackupResponse.header)
 }
 
-// repeated .cockroach.proto.ResponseUnion responses = 2;
-inline int BatchResponse::responses_size() const {
-  return responses_.size();
+// optional .cockroach.proto.BackupFile file = 2;
+inline bool AdminBackupResponse::has_file() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void BatchResponse::clear_responses() {
-  responses_.Clear();
+inline void AdminBackupResponse::set_has_file() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::cockroach::proto::ResponseUnion& BatchResponse::responses(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.responses)
-  return responses_.Get(index);
+inline void AdminBackupResponse::clear_has_file() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::cockroach::proto::ResponseUnion* BatchResponse::mutable_responses(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.responses)
-  return responses_.Mutable(index);
+inline void AdminBackupResponse::clear_file() {
+  if (file_ != NULL) file_->::cockroach::proto::BackupFile::Clear();
+  clear_has_file();
 }
-inline ::cockroach::proto::ResponseUnion* BatchResponse::add_responses() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.BatchResponse.responses)
-  return responses_.Add();
+inline const ::cockroach::proto::BackupFile& AdminBackupResponse::file() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminBackupResponse.file)
+  return file_ != NULL ? *file_ : *default_instance_->file_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
-BatchResponse::responses() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.BatchResponse.responses)
-  return responses_;
+inline ::cockroach::proto::BackupFile* AdminBackupResponse::mutable_file() {
+  set_has_file();
+  if (file_ == NULL) file_ = new ::cockroach::proto::BackupFile;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminBackupResponse.file)
+  return file_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
-BatchResponse::mutable_responses() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchResponse.responses)
-  return &responses_;
+inline ::cockroach::proto::BackupFile* AdminBackupResponse::release_file() {
+  clear_has_file();
+  ::cockroach::proto::BackupFile* temp = file_;
+  file_ = NULL;
+  return temp;
+}
+inline void AdminBackupResponse::set_allocated_file(::cockroach::proto::BackupFile* file) {
+  delete file_;
+  file_ = file;
+  if (file) {
+    set_has_file();
+  } else {
+    clear_has_file();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminBackupResponse.file)
 }
 
 // -------------------------------------------------------------------
 
-// AdminSplitRequest
+// AdminRestoreRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool AdminSplitRequest::has_header() const {
+inline bool AdminRestoreRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminSplitRequest::set_has_header() {
+inline void AdminRestoreRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminSplitRequest::clear_has_header() {
+inline void AdminRestoreRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminSplitRequest::clear_header() {
+inline void AdminRestoreRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& AdminSplitRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.header)
+inline const ::cockroach::proto::RequestHeader& AdminRestoreRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminRestoreRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* AdminSplitRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* AdminRestoreRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminRestoreRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* AdminSplitRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* AdminRestoreRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminSplitRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void AdminRestoreRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5944,165 +11080,196 @@ inline void AdminSplitRequest::set_allocated_header(::cockroach::proto::RequestH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminRestoreRequest.header)
 }
 
-// optional bytes split_key = 2;
-inline bool AdminSplitRequest::has_split_key() const {
+// optional string uri = 2;
+inline bool AdminRestoreRequest::has_uri() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void AdminSplitRequest::set_has_split_key() {
+inline void AdminRestoreRequest::set_has_uri() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void AdminSplitRequest::clear_has_split_key() {
+inline void AdminRestoreRequest::clear_has_uri() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void AdminSplitRequest::clear_split_key() {
-  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_->clear();
+inline void AdminRestoreRequest::clear_uri() {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_->clear();
   }
-  clear_has_split_key();
+  clear_has_uri();
 }
-inline const ::std::string& AdminSplitRequest::split_key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.split_key)
-  return *split_key_;
+inline const ::std::string& AdminRestoreRequest::uri() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminRestoreRequest.uri)
+  return *uri_;
 }
-inline void AdminSplitRequest::set_split_key(const ::std::string& value) {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline void AdminRestoreRequest::set_uri(const ::std::string& value) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
   }
-  split_key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.AdminSplitRequest.split_key)
+  uri_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminRestoreRequest.uri)
 }
-inline void AdminSplitRequest::set_split_key(const char* value) {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline void AdminRestoreRequest::set_uri(const char* value) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
   }
-  split_key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminSplitRequest.split_key)
+  uri_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminRestoreRequest.uri)
 }
-inline void AdminSplitRequest::set_split_key(const void* value, size_t size) {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline void AdminRestoreRequest::set_uri(const char* value, size_t size) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
   }
-  split_key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminSplitRequest.split_key)
+  uri_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminRestoreRequest.uri)
 }
-inline ::std::string* AdminSplitRequest::mutable_split_key() {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline ::std::string* AdminRestoreRequest::mutable_uri() {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.split_key)
-  return split_key_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminRestoreRequest.uri)
+  return uri_;
 }
-inline ::std::string* AdminSplitRequest::release_split_key() {
-  clear_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* AdminRestoreRequest::release_uri() {
+  clear_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = split_key_;
-    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = uri_;
+    uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key) {
-  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete split_key_;
+inline void AdminRestoreRequest::set_allocated_uri(::std::string* uri) {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete uri_;
   }
-  if (split_key) {
-    set_has_split_key();
-    split_key_ = split_key;
+  if (uri) {
+    set_has_uri();
+    uri_ = uri;
   } else {
-    clear_has_split_key();
-    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_uri();
+    uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.split_key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminRestoreRequest.uri)
 }
 
-// -------------------------------------------------------------------
-
-// AdminSplitResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool AdminSplitResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional string job_id = 3;
+inline bool AdminRestoreRequest::has_job_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void AdminSplitResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void AdminRestoreRequest::set_has_job_id() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void AdminSplitResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void AdminRestoreRequest::clear_has_job_id() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void AdminSplitResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void AdminRestoreRequest::clear_job_id() {
+  if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_->clear();
+  }
+  clear_has_job_id();
 }
-inline const ::cockroach::proto::ResponseHeader& AdminSplitResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::std::string& AdminRestoreRequest::job_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminRestoreRequest.job_id)
+  return *job_id_;
 }
-inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitResponse.header)
-  return header_;
+inline void AdminRestoreRequest::set_job_id(const ::std::string& value) {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  job_id_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminRestoreRequest.job_id)
 }
-inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void AdminRestoreRequest::set_job_id(const char* value) {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  job_id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminRestoreRequest.job_id)
 }
-inline void AdminSplitResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void AdminRestoreRequest::set_job_id(const char* value, size_t size) {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  job_id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminRestoreRequest.job_id)
+}
+inline ::std::string* AdminRestoreRequest::mutable_job_id() {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminRestoreRequest.job_id)
+  return job_id_;
+}
+inline ::std::string* AdminRestoreRequest::release_job_id() {
+  clear_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_header();
+    ::std::string* temp = job_id_;
+    job_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitResponse.header)
+}
+inline void AdminRestoreRequest::set_allocated_job_id(::std::string* job_id) {
+  if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete job_id_;
+  }
+  if (job_id) {
+    set_has_job_id();
+    job_id_ = job_id;
+  } else {
+    clear_has_job_id();
+    job_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminRestoreRequest.job_id)
 }
 
 // -------------------------------------------------------------------
 
-// AdminMergeRequest
+// AdminRestoreResponse
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool AdminMergeRequest::has_header() const {
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminRestoreResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminMergeRequest::set_has_header() {
+inline void AdminRestoreResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminMergeRequest::clear_has_header() {
+inline void AdminRestoreResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminMergeRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+inline void AdminRestoreResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& AdminMergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeRequest.header)
+inline const ::cockroach::proto::ResponseHeader& AdminRestoreResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminRestoreResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* AdminMergeRequest::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* AdminRestoreResponse::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeRequest.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminRestoreResponse.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* AdminMergeRequest::release_header() {
+inline ::cockroach::proto::ResponseHeader* AdminRestoreResponse::release_header() {
   clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
+  ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void AdminRestoreResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6110,52 +11277,83 @@ inline void AdminMergeRequest::set_allocated_header(::cockroach::proto::RequestH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminRestoreResponse.header)
 }
 
-// -------------------------------------------------------------------
-
-// AdminMergeResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool AdminMergeResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional string job_id = 2;
+inline bool AdminRestoreResponse::has_job_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void AdminMergeResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void AdminRestoreResponse::set_has_job_id() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void AdminMergeResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void AdminRestoreResponse::clear_has_job_id() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void AdminMergeResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void AdminRestoreResponse::clear_job_id() {
+  if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_->clear();
+  }
+  clear_has_job_id();
 }
-inline const ::cockroach::proto::ResponseHeader& AdminMergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::std::string& AdminRestoreResponse::job_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminRestoreResponse.job_id)
+  return *job_id_;
 }
-inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeResponse.header)
-  return header_;
+inline void AdminRestoreResponse::set_job_id(const ::std::string& value) {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  job_id_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminRestoreResponse.job_id)
 }
-inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void AdminRestoreResponse::set_job_id(const char* value) {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  job_id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminRestoreResponse.job_id)
 }
-inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void AdminRestoreResponse::set_job_id(const char* value, size_t size) {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  job_id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminRestoreResponse.job_id)
+}
+inline ::std::string* AdminRestoreResponse::mutable_job_id() {
+  set_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    job_id_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminRestoreResponse.job_id)
+  return job_id_;
+}
+inline ::std::string* AdminRestoreResponse::release_job_id() {
+  clear_has_job_id();
+  if (job_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_header();
+    ::std::string* temp = job_id_;
+    job_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeResponse.header)
+}
+inline void AdminRestoreResponse::set_allocated_job_id(::std::string* job_id) {
+  if (job_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete job_id_;
+  }
+  if (job_id) {
+    set_has_job_id();
+    job_id_ = job_id;
+  } else {
+    clear_has_job_id();
+    job_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminRestoreResponse.job_id)
 }
 
 
@@ -6173,6 +11371,16 @@ template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::ReadConsistencyType>() {
   return ::cockroach::proto::ReadConsistencyType_descriptor();
 }
+template <> struct is_proto_enum< ::cockroach::proto::RequestPriority> : ::google::protobuf::internal::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::RequestPriority>() {
+  return ::cockroach::proto::RequestPriority_descriptor();
+}
+template <> struct is_proto_enum< ::cockroach::proto::BackupFormat> : ::google::protobuf::internal::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::BackupFormat>() {
+  return ::cockroach::proto::BackupFormat_descriptor();
+}
 
 }  // namespace google
 }  // namespace protobuf
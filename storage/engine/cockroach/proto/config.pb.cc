@@ -39,6 +39,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* PermConfig_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   PermConfig_reflection_ = NULL;
+const ::google::protobuf::Descriptor* UserConfig_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  UserConfig_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ZoneConfig_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ZoneConfig_reflection_ = NULL;
@@ -74,10 +77,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Attributes));
   Replica_descriptor_ = file->message_type(1);
-  static const int Replica_offsets_[3] = {
+  static const int Replica_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Replica, node_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Replica, store_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Replica, attrs_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Replica, learner_),
   };
   Replica_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -124,8 +128,9 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GCPolicy));
   AcctConfig_descriptor_ = file->message_type(4);
-  static const int AcctConfig_offsets_[1] = {
+  static const int AcctConfig_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, cluster_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, byte_limit_),
   };
   AcctConfig_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -154,12 +159,28 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(PermConfig));
-  ZoneConfig_descriptor_ = file->message_type(6);
-  static const int ZoneConfig_offsets_[4] = {
+  UserConfig_descriptor_ = file->message_type(6);
+  static const int UserConfig_offsets_[1] = {
+  };
+  UserConfig_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      UserConfig_descriptor_,
+      UserConfig::default_instance_,
+      UserConfig_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(UserConfig, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(UserConfig, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(UserConfig));
+  ZoneConfig_descriptor_ = file->message_type(7);
+  static const int ZoneConfig_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, replica_attrs_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, range_min_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, range_max_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, gc_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, prohibited_attrs_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, range_backpressure_multiple_),
   };
   ZoneConfig_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -172,7 +193,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ZoneConfig));
-  RangeTree_descriptor_ = file->message_type(7);
+  RangeTree_descriptor_ = file->message_type(8);
   static const int RangeTree_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeTree, root_key_),
   };
@@ -187,7 +208,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RangeTree));
-  RangeTreeNode_descriptor_ = file->message_type(8);
+  RangeTreeNode_descriptor_ = file->message_type(9);
   static const int RangeTreeNode_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeTreeNode, key_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeTreeNode, black_),
@@ -230,6 +251,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     AcctConfig_descriptor_, &AcctConfig::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     PermConfig_descriptor_, &PermConfig::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    UserConfig_descriptor_, &UserConfig::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ZoneConfig_descriptor_, &ZoneConfig::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -253,6 +276,8 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto() {
   delete AcctConfig_reflection_;
   delete PermConfig::default_instance_;
   delete PermConfig_reflection_;
+  delete UserConfig::default_instance_;
+  delete UserConfig_reflection_;
   delete ZoneConfig::default_instance_;
   delete ZoneConfig_reflection_;
   delete RangeTree::default_instance_;
@@ -272,33 +297,41 @@ void protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto() {
     "\n\034cockroach/proto/config.proto\022\017cockroac"
     "h.proto\032\024gogoproto/gogo.proto\"6\n\nAttribu"
     "tes\022(\n\005attrs\030\001 \003(\tB\031\310\336\037\000\362\336\037\021yaml:\"attrs,"
-    "flow\"\"\224\001\n\007Replica\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000"
+    "flow\"\"\253\001\n\007Replica\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000"
     "\342\336\037\006NodeID\332\336\037\006NodeID\022,\n\010store_id\030\002 \001(\005B\032"
     "\310\336\037\000\342\336\037\007StoreID\332\336\037\007StoreID\0220\n\005attrs\030\003 \001("
-    "\0132\033.cockroach.proto.AttributesB\004\310\336\037\000\"\242\001\n"
-    "\017RangeDescriptor\022\037\n\007raft_id\030\001 \001(\003B\016\310\336\037\000\342"
-    "\336\037\006RaftID\022\036\n\tstart_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Ke"
-    "y\022\034\n\007end_key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\0220\n\010repli"
-    "cas\030\004 \003(\0132\030.cockroach.proto.ReplicaB\004\310\336\037"
-    "\000\"3\n\010GCPolicy\022\'\n\013ttl_seconds\030\001 \001(\005B\022\310\336\037\000"
-    "\342\336\037\nTTLSeconds\"E\n\nAcctConfig\0227\n\ncluster_"
-    "id\030\001 \001(\tB#\310\336\037\000\362\336\037\033yaml:\"cluster_id,omite"
-    "mpty\"\"h\n\nPermConfig\022+\n\004read\030\001 \003(\tB\035\310\336\037\000\362"
-    "\336\037\025yaml:\"read,omitempty\"\022-\n\005write\030\002 \003(\tB"
-    "\036\310\336\037\000\362\336\037\026yaml:\"write,omitempty\"\"\257\002\n\nZone"
-    "Config\022U\n\rreplica_attrs\030\001 \003(\0132\033.cockroac"
-    "h.proto.AttributesB!\310\336\037\000\362\336\037\031yaml:\"replic"
-    "as,omitempty\"\022A\n\017range_min_bytes\030\002 \001(\003B("
-    "\310\336\037\000\362\336\037 yaml:\"range_min_bytes,omitempty\""
-    "\022A\n\017range_max_bytes\030\003 \001(\003B(\310\336\037\000\362\336\037 yaml:"
-    "\"range_max_bytes,omitempty\"\022D\n\002gc\030\004 \001(\0132"
-    "\031.cockroach.proto.GCPolicyB\035\342\336\037\002GC\362\336\037\023ya"
-    "ml:\"gc,omitempty\"\"*\n\tRangeTree\022\035\n\010root_k"
-    "ey\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\"\226\001\n\rRangeTreeNode\022"
-    "\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\023\n\005black\030\002 \001(\010"
-    "B\004\310\336\037\000\022\037\n\nparent_key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022"
-    "\031\n\010left_key\030\004 \001(\014B\007\332\336\037\003Key\022\032\n\tright_key\030"
-    "\005 \001(\014B\007\332\336\037\003KeyB\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 1195);
+    "\0132\033.cockroach.proto.AttributesB\004\310\336\037\000\022\025\n\007"
+    "learner\030\004 \001(\010B\004\310\336\037\000\"\242\001\n\017RangeDescriptor\022"
+    "\037\n\007raft_id\030\001 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\022\036\n\tstar"
+    "t_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_key\030\003 \001("
+    "\014B\013\310\336\037\000\332\336\037\003Key\0220\n\010replicas\030\004 \003(\0132\030.cockr"
+    "oach.proto.ReplicaB\004\310\336\037\000\"S\n\010GCPolicy\022G\n\013"
+    "ttl_seconds\030\001 \001(\005B2\310\336\037\000\342\336\037\nTTLSeconds\362\336\037"
+    "\034yaml:\"ttl_seconds,omitempty\"\"~\n\nAcctCon"
+    "fig\0227\n\ncluster_id\030\001 \001(\tB#\310\336\037\000\362\336\037\033yaml:\"c"
+    "luster_id,omitempty\"\0227\n\nbyte_limit\030\002 \001(\003"
+    "B#\310\336\037\000\362\336\037\033yaml:\"byte_limit,omitempty\"\"h\n"
+    "\nPermConfig\022+\n\004read\030\001 \003(\tB\035\310\336\037\000\362\336\037\025yaml:"
+    "\"read,omitempty\"\022-\n\005write\030\002 \003(\tB\036\310\336\037\000\362\336\037"
+    "\026yaml:\"write,omitempty\"\"\014\n\nUserConfig\"\336\003"
+    "\n\nZoneConfig\022U\n\rreplica_attrs\030\001 \003(\0132\033.co"
+    "ckroach.proto.AttributesB!\310\336\037\000\362\336\037\031yaml:\""
+    "replicas,omitempty\"\022A\n\017range_min_bytes\030\002"
+    " \001(\003B(\310\336\037\000\362\336\037 yaml:\"range_min_bytes,omit"
+    "empty\"\022A\n\017range_max_bytes\030\003 \001(\003B(\310\336\037\000\362\336\037"
+    " yaml:\"range_max_bytes,omitempty\"\022D\n\002gc\030"
+    "\004 \001(\0132\031.cockroach.proto.GCPolicyB\035\342\336\037\002GC"
+    "\362\336\037\023yaml:\"gc,omitempty\"\022V\n\020prohibited_at"
+    "trs\030\005 \001(\0132\033.cockroach.proto.AttributesB\037"
+    "\362\336\037\033yaml:\"prohibited,omitempty\"\022U\n\033range"
+    "_backpressure_multiple\030\006 \001(\001B0\362\336\037,yaml:\""
+    "range_backpressure_multiple,omitempty\"\"*"
+    "\n\tRangeTree\022\035\n\010root_key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003K"
+    "ey\"\226\001\n\rRangeTreeNode\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332"
+    "\336\037\003Key\022\023\n\005black\030\002 \001(\010B\004\310\336\037\000\022\037\n\nparent_ke"
+    "y\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\031\n\010left_key\030\004 \001(\014B\007"
+    "\332\336\037\003Key\022\032\n\tright_key\030\005 \001(\014B\007\332\336\037\003KeyB\023Z\005p"
+    "roto\340\342\036\001\310\342\036\001\320\342\036\001", 1496);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/config.proto", &protobuf_RegisterTypes);
   Attributes::default_instance_ = new Attributes();
@@ -307,6 +340,7 @@ void protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto() {
   GCPolicy::default_instance_ = new GCPolicy();
   AcctConfig::default_instance_ = new AcctConfig();
   PermConfig::default_instance_ = new PermConfig();
+  UserConfig::default_instance_ = new UserConfig();
   ZoneConfig::default_instance_ = new ZoneConfig();
   RangeTree::default_instance_ = new RangeTree();
   RangeTreeNode::default_instance_ = new RangeTreeNode();
@@ -316,6 +350,7 @@ void protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto() {
   GCPolicy::default_instance_->InitAsDefaultInstance();
   AcctConfig::default_instance_->InitAsDefaultInstance();
   PermConfig::default_instance_->InitAsDefaultInstance();
+  UserConfig::default_instance_->InitAsDefaultInstance();
   ZoneConfig::default_instance_->InitAsDefaultInstance();
   RangeTree::default_instance_->InitAsDefaultInstance();
   RangeTreeNode::default_instance_->InitAsDefaultInstance();
@@ -566,6 +601,7 @@ void Attributes::Swap(Attributes* other) {
 const int Replica::kNodeIdFieldNumber;
 const int Replica::kStoreIdFieldNumber;
 const int Replica::kAttrsFieldNumber;
+const int Replica::kLearnerFieldNumber;
 #endif  // !_MSC_VER
 
 Replica::Replica()
@@ -590,6 +626,7 @@ void Replica::SharedCtor() {
   node_id_ = 0;
   store_id_ = 0;
   attrs_ = NULL;
+  learner_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -636,11 +673,12 @@ void Replica::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 7) {
+  if (_has_bits_[0 / 32] & 15) {
     ZR_(node_id_, store_id_);
     if (has_attrs()) {
       if (attrs_ != NULL) attrs_->::cockroach::proto::Attributes::Clear();
     }
+    learner_ = false;
   }
 
 #undef OFFSET_OF_FIELD_
@@ -698,6 +736,21 @@ bool Replica::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(32)) goto parse_learner;
+        break;
+      }
+
+      // optional bool learner = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_learner:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &learner_)));
+          set_has_learner();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -743,6 +796,11 @@ void Replica::SerializeWithCachedSizes(
       3, this->attrs(), output);
   }
 
+  // optional bool learner = 4;
+  if (has_learner()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->learner(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -770,6 +828,11 @@ void Replica::SerializeWithCachedSizes(
         3, this->attrs(), target);
   }
 
+  // optional bool learner = 4;
+  if (has_learner()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->learner(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -803,6 +866,11 @@ int Replica::ByteSize() const {
           this->attrs());
     }
 
+    // optional bool learner = 4;
+    if (has_learner()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -839,6 +907,9 @@ void Replica::MergeFrom(const Replica& from) {
     if (from.has_attrs()) {
       mutable_attrs()->::cockroach::proto::Attributes::MergeFrom(from.attrs());
     }
+    if (from.has_learner()) {
+      set_learner(from.learner());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -865,6 +936,7 @@ void Replica::Swap(Replica* other) {
     std::swap(node_id_, other->node_id_);
     std::swap(store_id_, other->store_id_);
     std::swap(attrs_, other->attrs_);
+    std::swap(learner_, other->learner_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -1464,6 +1536,7 @@ void GCPolicy::Swap(GCPolicy* other) {
 
 #ifndef _MSC_VER
 const int AcctConfig::kClusterIdFieldNumber;
+const int AcctConfig::kByteLimitFieldNumber;
 #endif  // !_MSC_VER
 
 AcctConfig::AcctConfig()
@@ -1486,6 +1559,7 @@ void AcctConfig::SharedCtor() {
   ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  byte_limit_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1524,10 +1598,13 @@ AcctConfig* AcctConfig::New() const {
 }
 
 void AcctConfig::Clear() {
-  if (has_cluster_id()) {
-    if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-      cluster_id_->clear();
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_cluster_id()) {
+      if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        cluster_id_->clear();
+      }
     }
+    byte_limit_ = GOOGLE_LONGLONG(0);
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -1555,6 +1632,21 @@ bool AcctConfig::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_byte_limit;
+        break;
+      }
+
+      // optional int64 byte_limit = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_byte_limit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &byte_limit_)));
+          set_has_byte_limit();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1594,6 +1686,11 @@ void AcctConfig::SerializeWithCachedSizes(
       1, this->cluster_id(), output);
   }
 
+  // optional int64 byte_limit = 2;
+  if (has_byte_limit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->byte_limit(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1615,6 +1712,11 @@ void AcctConfig::SerializeWithCachedSizes(
         1, this->cluster_id(), target);
   }
 
+  // optional int64 byte_limit = 2;
+  if (has_byte_limit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->byte_limit(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1634,6 +1736,13 @@ int AcctConfig::ByteSize() const {
           this->cluster_id());
     }
 
+    // optional int64 byte_limit = 2;
+    if (has_byte_limit()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->byte_limit());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1664,6 +1773,9 @@ void AcctConfig::MergeFrom(const AcctConfig& from) {
     if (from.has_cluster_id()) {
       set_cluster_id(from.cluster_id());
     }
+    if (from.has_byte_limit()) {
+      set_byte_limit(from.byte_limit());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1688,6 +1800,7 @@ bool AcctConfig::IsInitialized() const {
 void AcctConfig::Swap(AcctConfig* other) {
   if (other != this) {
     std::swap(cluster_id_, other->cluster_id_);
+    std::swap(byte_limit_, other->byte_limit_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -1984,6 +2097,180 @@ void PermConfig::Swap(PermConfig* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+#endif  // !_MSC_VER
+
+UserConfig::UserConfig()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.UserConfig)
+}
+
+void UserConfig::InitAsDefaultInstance() {
+}
+
+UserConfig::UserConfig(const UserConfig& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.UserConfig)
+}
+
+void UserConfig::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+UserConfig::~UserConfig() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.UserConfig)
+  SharedDtor();
+}
+
+void UserConfig::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void UserConfig::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* UserConfig::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return UserConfig_descriptor_;
+}
+
+const UserConfig& UserConfig::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
+  return *default_instance_;
+}
+
+UserConfig* UserConfig::default_instance_ = NULL;
+
+UserConfig* UserConfig::New() const {
+  return new UserConfig;
+}
+
+void UserConfig::Clear() {
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool UserConfig::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.UserConfig)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+  handle_unusual:
+    if (tag == 0 ||
+        ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+        ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+      goto success;
+    }
+    DO_(::google::protobuf::internal::WireFormat::SkipField(
+          input, tag, mutable_unknown_fields()));
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.UserConfig)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.UserConfig)
+  return false;
+#undef DO_
+}
+
+void UserConfig::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.UserConfig)
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.UserConfig)
+}
+
+::google::protobuf::uint8* UserConfig::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.UserConfig)
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.UserConfig)
+  return target;
+}
+
+int UserConfig::ByteSize() const {
+  int total_size = 0;
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void UserConfig::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const UserConfig* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const UserConfig*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void UserConfig::MergeFrom(const UserConfig& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void UserConfig::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void UserConfig::CopyFrom(const UserConfig& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool UserConfig::IsInitialized() const {
+
+  return true;
+}
+
+void UserConfig::Swap(UserConfig* other) {
+  if (other != this) {
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata UserConfig::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = UserConfig_descriptor_;
+  metadata.reflection = UserConfig_reflection_;
+  return metadata;
+}
+
+
 // ===================================================================
 
 #ifndef _MSC_VER
@@ -1991,6 +2278,8 @@ const int ZoneConfig::kReplicaAttrsFieldNumber;
 const int ZoneConfig::kRangeMinBytesFieldNumber;
 const int ZoneConfig::kRangeMaxBytesFieldNumber;
 const int ZoneConfig::kGcFieldNumber;
+const int ZoneConfig::kProhibitedAttrsFieldNumber;
+const int ZoneConfig::kRangeBackpressureMultipleFieldNumber;
 #endif  // !_MSC_VER
 
 ZoneConfig::ZoneConfig()
@@ -2001,6 +2290,7 @@ ZoneConfig::ZoneConfig()
 
 void ZoneConfig::InitAsDefaultInstance() {
   gc_ = const_cast< ::cockroach::proto::GCPolicy*>(&::cockroach::proto::GCPolicy::default_instance());
+  prohibited_attrs_ = const_cast< ::cockroach::proto::Attributes*>(&::cockroach::proto::Attributes::default_instance());
 }
 
 ZoneConfig::ZoneConfig(const ZoneConfig& from)
@@ -2015,6 +2305,8 @@ void ZoneConfig::SharedCtor() {
   range_min_bytes_ = GOOGLE_LONGLONG(0);
   range_max_bytes_ = GOOGLE_LONGLONG(0);
   gc_ = NULL;
+  prohibited_attrs_ = NULL;
+  range_backpressure_multiple_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -2026,6 +2318,7 @@ ZoneConfig::~ZoneConfig() {
 void ZoneConfig::SharedDtor() {
   if (this != default_instance_) {
     delete gc_;
+    delete prohibited_attrs_;
   }
 }
 
@@ -2061,11 +2354,15 @@ void ZoneConfig::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 14) {
+  if (_has_bits_[0 / 32] & 62) {
     ZR_(range_min_bytes_, range_max_bytes_);
     if (has_gc()) {
       if (gc_ != NULL) gc_->::cockroach::proto::GCPolicy::Clear();
     }
+    if (has_prohibited_attrs()) {
+      if (prohibited_attrs_ != NULL) prohibited_attrs_->::cockroach::proto::Attributes::Clear();
+    }
+    range_backpressure_multiple_ = 0;
   }
 
 #undef OFFSET_OF_FIELD_
@@ -2139,6 +2436,34 @@ bool ZoneConfig::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(42)) goto parse_prohibited_attrs;
+        break;
+      }
+
+      // optional .cockroach.proto.Attributes prohibited_attrs = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_prohibited_attrs:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_prohibited_attrs()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(49)) goto parse_range_backpressure_multiple;
+        break;
+      }
+
+      // optional double range_backpressure_multiple = 6;
+      case 6: {
+        if (tag == 49) {
+         parse_range_backpressure_multiple:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   double, ::google::protobuf::internal::WireFormatLite::TYPE_DOUBLE>(
+                 input, &range_backpressure_multiple_)));
+          set_has_range_backpressure_multiple();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -2190,6 +2515,17 @@ void ZoneConfig::SerializeWithCachedSizes(
       4, this->gc(), output);
   }
 
+  // optional .cockroach.proto.Attributes prohibited_attrs = 5;
+  if (has_prohibited_attrs()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->prohibited_attrs(), output);
+  }
+
+  // optional double range_backpressure_multiple = 6;
+  if (has_range_backpressure_multiple()) {
+    ::google::protobuf::internal::WireFormatLite::WriteDouble(6, this->range_backpressure_multiple(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -2224,6 +2560,18 @@ void ZoneConfig::SerializeWithCachedSizes(
         4, this->gc(), target);
   }
 
+  // optional .cockroach.proto.Attributes prohibited_attrs = 5;
+  if (has_prohibited_attrs()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->prohibited_attrs(), target);
+  }
+
+  // optional double range_backpressure_multiple = 6;
+  if (has_range_backpressure_multiple()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteDoubleToArray(6, this->range_backpressure_multiple(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -2257,6 +2605,18 @@ int ZoneConfig::ByteSize() const {
           this->gc());
     }
 
+    // optional .cockroach.proto.Attributes prohibited_attrs = 5;
+    if (has_prohibited_attrs()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->prohibited_attrs());
+    }
+
+    // optional double range_backpressure_multiple = 6;
+    if (has_range_backpressure_multiple()) {
+      total_size += 1 + 8;
+    }
+
   }
   // repeated .cockroach.proto.Attributes replica_attrs = 1;
   total_size += 1 * this->replica_attrs_size();
@@ -2302,6 +2662,12 @@ void ZoneConfig::MergeFrom(const ZoneConfig& from) {
     if (from.has_gc()) {
       mutable_gc()->::cockroach::proto::GCPolicy::MergeFrom(from.gc());
     }
+    if (from.has_prohibited_attrs()) {
+      mutable_prohibited_attrs()->::cockroach::proto::Attributes::MergeFrom(from.prohibited_attrs());
+    }
+    if (from.has_range_backpressure_multiple()) {
+      set_range_backpressure_multiple(from.range_backpressure_multiple());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -2329,6 +2695,8 @@ void ZoneConfig::Swap(ZoneConfig* other) {
     std::swap(range_min_bytes_, other->range_min_bytes_);
     std::swap(range_max_bytes_, other->range_max_bytes_);
     std::swap(gc_, other->gc_);
+    std::swap(prohibited_attrs_, other->prohibited_attrs_);
+    std::swap(range_backpressure_multiple_, other->range_backpressure_multiple_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
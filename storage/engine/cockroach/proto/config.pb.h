@@ -41,6 +41,7 @@ class RangeDescriptor;
 class GCPolicy;
 class AcctConfig;
 class PermConfig;
+class UserConfig;
 class ZoneConfig;
 class RangeTree;
 class RangeTreeNode;
@@ -209,6 +210,13 @@ class Replica : public ::google::protobuf::Message {
   inline ::cockroach::proto::Attributes* release_attrs();
   inline void set_allocated_attrs(::cockroach::proto::Attributes* attrs);
 
+  // optional bool learner = 4;
+  inline bool has_learner() const;
+  inline void clear_learner();
+  static const int kLearnerFieldNumber = 4;
+  inline bool learner() const;
+  inline void set_learner(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Replica)
  private:
   inline void set_has_node_id();
@@ -217,6 +225,8 @@ class Replica : public ::google::protobuf::Message {
   inline void clear_has_store_id();
   inline void set_has_attrs();
   inline void clear_has_attrs();
+  inline void set_has_learner();
+  inline void clear_has_learner();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -225,6 +235,7 @@ class Replica : public ::google::protobuf::Message {
   ::google::protobuf::int32 node_id_;
   ::google::protobuf::int32 store_id_;
   ::cockroach::proto::Attributes* attrs_;
+  bool learner_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto();
@@ -500,16 +511,26 @@ class AcctConfig : public ::google::protobuf::Message {
   inline ::std::string* release_cluster_id();
   inline void set_allocated_cluster_id(::std::string* cluster_id);
 
+  // optional int64 byte_limit = 2;
+  inline bool has_byte_limit() const;
+  inline void clear_byte_limit();
+  static const int kByteLimitFieldNumber = 2;
+  inline ::google::protobuf::int64 byte_limit() const;
+  inline void set_byte_limit(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.AcctConfig)
  private:
   inline void set_has_cluster_id();
   inline void clear_has_cluster_id();
+  inline void set_has_byte_limit();
+  inline void clear_has_byte_limit();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::std::string* cluster_id_;
+  ::google::protobuf::int64 byte_limit_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto();
@@ -622,6 +643,75 @@ class PermConfig : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class UserConfig : public ::google::protobuf::Message {
+ public:
+  UserConfig();
+  virtual ~UserConfig();
+
+  UserConfig(const UserConfig& from);
+
+  inline UserConfig& operator=(const UserConfig& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const UserConfig& default_instance();
+
+  void Swap(UserConfig* other);
+
+  // implements Message ----------------------------------------------
+
+  UserConfig* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const UserConfig& from);
+  void MergeFrom(const UserConfig& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.UserConfig)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto();
+
+  void InitAsDefaultInstance();
+  static UserConfig* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class ZoneConfig : public ::google::protobuf::Message {
  public:
   ZoneConfig();
@@ -710,6 +800,22 @@ class ZoneConfig : public ::google::protobuf::Message {
   inline ::cockroach::proto::GCPolicy* release_gc();
   inline void set_allocated_gc(::cockroach::proto::GCPolicy* gc);
 
+  // optional .cockroach.proto.Attributes prohibited_attrs = 5;
+  inline bool has_prohibited_attrs() const;
+  inline void clear_prohibited_attrs();
+  static const int kProhibitedAttrsFieldNumber = 5;
+  inline const ::cockroach::proto::Attributes& prohibited_attrs() const;
+  inline ::cockroach::proto::Attributes* mutable_prohibited_attrs();
+  inline ::cockroach::proto::Attributes* release_prohibited_attrs();
+  inline void set_allocated_prohibited_attrs(::cockroach::proto::Attributes* prohibited_attrs);
+
+  // optional double range_backpressure_multiple = 6;
+  inline bool has_range_backpressure_multiple() const;
+  inline void clear_range_backpressure_multiple();
+  static const int kRangeBackpressureMultipleFieldNumber = 6;
+  inline double range_backpressure_multiple() const;
+  inline void set_range_backpressure_multiple(double value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.ZoneConfig)
  private:
   inline void set_has_range_min_bytes();
@@ -718,6 +824,10 @@ class ZoneConfig : public ::google::protobuf::Message {
   inline void clear_has_range_max_bytes();
   inline void set_has_gc();
   inline void clear_has_gc();
+  inline void set_has_prohibited_attrs();
+  inline void clear_has_prohibited_attrs();
+  inline void set_has_range_backpressure_multiple();
+  inline void clear_has_range_backpressure_multiple();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -727,6 +837,8 @@ class ZoneConfig : public ::google::protobuf::Message {
   ::google::protobuf::int64 range_min_bytes_;
   ::google::protobuf::int64 range_max_bytes_;
   ::cockroach::proto::GCPolicy* gc_;
+  ::cockroach::proto::Attributes* prohibited_attrs_;
+  double range_backpressure_multiple_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto();
@@ -1111,6 +1223,30 @@ inline void Replica::set_allocated_attrs(::cockroach::proto::Attributes* attrs)
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Replica.attrs)
 }
 
+// optional bool learner = 4;
+inline bool Replica::has_learner() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void Replica::set_has_learner() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void Replica::clear_has_learner() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void Replica::clear_learner() {
+  learner_ = false;
+  clear_has_learner();
+}
+inline bool Replica::learner() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Replica.learner)
+  return learner_;
+}
+inline void Replica::set_learner(bool value) {
+  set_has_learner();
+  learner_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Replica.learner)
+}
+
 // -------------------------------------------------------------------
 
 // RangeDescriptor
@@ -1429,6 +1565,30 @@ inline void AcctConfig::set_allocated_cluster_id(::std::string* cluster_id) {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AcctConfig.cluster_id)
 }
 
+// optional int64 byte_limit = 2;
+inline bool AcctConfig::has_byte_limit() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AcctConfig::set_has_byte_limit() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AcctConfig::clear_has_byte_limit() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AcctConfig::clear_byte_limit() {
+  byte_limit_ = GOOGLE_LONGLONG(0);
+  clear_has_byte_limit();
+}
+inline ::google::protobuf::int64 AcctConfig::byte_limit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AcctConfig.byte_limit)
+  return byte_limit_;
+}
+inline void AcctConfig::set_byte_limit(::google::protobuf::int64 value) {
+  set_has_byte_limit();
+  byte_limit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AcctConfig.byte_limit)
+}
+
 // -------------------------------------------------------------------
 
 // PermConfig
@@ -1543,6 +1703,10 @@ PermConfig::mutable_write() {
 
 // -------------------------------------------------------------------
 
+// UserConfig
+
+// -------------------------------------------------------------------
+
 // ZoneConfig
 
 // repeated .cockroach.proto.Attributes replica_attrs = 1;
@@ -1664,6 +1828,71 @@ inline void ZoneConfig::set_allocated_gc(::cockroach::proto::GCPolicy* gc) {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ZoneConfig.gc)
 }
 
+// optional .cockroach.proto.Attributes prohibited_attrs = 5;
+inline bool ZoneConfig::has_prohibited_attrs() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void ZoneConfig::set_has_prohibited_attrs() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void ZoneConfig::clear_has_prohibited_attrs() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void ZoneConfig::clear_prohibited_attrs() {
+  if (prohibited_attrs_ != NULL) prohibited_attrs_->::cockroach::proto::Attributes::Clear();
+  clear_has_prohibited_attrs();
+}
+inline const ::cockroach::proto::Attributes& ZoneConfig::prohibited_attrs() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ZoneConfig.prohibited_attrs)
+  return prohibited_attrs_ != NULL ? *prohibited_attrs_ : *default_instance_->prohibited_attrs_;
+}
+inline ::cockroach::proto::Attributes* ZoneConfig::mutable_prohibited_attrs() {
+  set_has_prohibited_attrs();
+  if (prohibited_attrs_ == NULL) prohibited_attrs_ = new ::cockroach::proto::Attributes;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ZoneConfig.prohibited_attrs)
+  return prohibited_attrs_;
+}
+inline ::cockroach::proto::Attributes* ZoneConfig::release_prohibited_attrs() {
+  clear_has_prohibited_attrs();
+  ::cockroach::proto::Attributes* temp = prohibited_attrs_;
+  prohibited_attrs_ = NULL;
+  return temp;
+}
+inline void ZoneConfig::set_allocated_prohibited_attrs(::cockroach::proto::Attributes* prohibited_attrs) {
+  delete prohibited_attrs_;
+  prohibited_attrs_ = prohibited_attrs;
+  if (prohibited_attrs) {
+    set_has_prohibited_attrs();
+  } else {
+    clear_has_prohibited_attrs();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ZoneConfig.prohibited_attrs)
+}
+
+// optional double range_backpressure_multiple = 6;
+inline bool ZoneConfig::has_range_backpressure_multiple() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void ZoneConfig::set_has_range_backpressure_multiple() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void ZoneConfig::clear_has_range_backpressure_multiple() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void ZoneConfig::clear_range_backpressure_multiple() {
+  range_backpressure_multiple_ = 0;
+  clear_has_range_backpressure_multiple();
+}
+inline double ZoneConfig::range_backpressure_multiple() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ZoneConfig.range_backpressure_multiple)
+  return range_backpressure_multiple_;
+}
+inline void ZoneConfig::set_range_backpressure_multiple(double value) {
+  set_has_range_backpressure_multiple();
+  range_backpressure_multiple_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ZoneConfig.range_backpressure_multiple)
+}
+
 // -------------------------------------------------------------------
 
 // RangeTree
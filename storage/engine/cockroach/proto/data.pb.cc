@@ -33,6 +33,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* KeyValue_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   KeyValue_reflection_ = NULL;
+const ::google::protobuf::Descriptor* Span_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  Span_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RawKeyValue_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RawKeyValue_reflection_ = NULL;
@@ -57,12 +60,33 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* Transaction_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   Transaction_reflection_ = NULL;
+const ::google::protobuf::Descriptor* SequenceRange_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  SequenceRange_reflection_ = NULL;
 const ::google::protobuf::Descriptor* Lease_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   Lease_reflection_ = NULL;
+const ::google::protobuf::Descriptor* Liveness_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  Liveness_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ClusterVersion_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ClusterVersion_reflection_ = NULL;
+const ::google::protobuf::Descriptor* MigrationLease_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  MigrationLease_reflection_ = NULL;
+const ::google::protobuf::Descriptor* NodeIDClaim_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  NodeIDClaim_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StoreIDClaim_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StoreIDClaim_reflection_ = NULL;
 const ::google::protobuf::Descriptor* MVCCMetadata_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   MVCCMetadata_reflection_ = NULL;
+const ::google::protobuf::Descriptor* SequencedValue_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  SequencedValue_reflection_ = NULL;
 const ::google::protobuf::Descriptor* GCMetadata_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   GCMetadata_reflection_ = NULL;
@@ -75,6 +99,7 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* MVCCStats_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   MVCCStats_reflection_ = NULL;
+const ::google::protobuf::EnumDescriptor* ValueCompression_descriptor_ = NULL;
 const ::google::protobuf::EnumDescriptor* ReplicaChangeType_descriptor_ = NULL;
 const ::google::protobuf::EnumDescriptor* IsolationType_descriptor_ = NULL;
 const ::google::protobuf::EnumDescriptor* TransactionStatus_descriptor_ = NULL;
@@ -105,12 +130,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Timestamp));
   Value_descriptor_ = file->message_type(1);
-  static const int Value_offsets_[5] = {
+  static const int Value_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, integer_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, checksum_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, tag_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, compression_),
   };
   Value_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -155,7 +181,23 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(KeyValue));
-  RawKeyValue_descriptor_ = file->message_type(4);
+  Span_descriptor_ = file->message_type(4);
+  static const int Span_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Span, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Span, end_key_),
+  };
+  Span_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      Span_descriptor_,
+      Span::default_instance_,
+      Span_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Span, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Span, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(Span));
+  RawKeyValue_descriptor_ = file->message_type(5);
   static const int RawKeyValue_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RawKeyValue, key_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RawKeyValue, value_),
@@ -171,7 +213,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RawKeyValue));
-  StoreIdent_descriptor_ = file->message_type(5);
+  StoreIdent_descriptor_ = file->message_type(6);
   static const int StoreIdent_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreIdent, cluster_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreIdent, node_id_),
@@ -188,7 +230,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(StoreIdent));
-  SplitTrigger_descriptor_ = file->message_type(6);
+  SplitTrigger_descriptor_ = file->message_type(7);
   static const int SplitTrigger_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SplitTrigger, updated_desc_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SplitTrigger, new_desc_),
@@ -204,7 +246,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(SplitTrigger));
-  MergeTrigger_descriptor_ = file->message_type(7);
+  MergeTrigger_descriptor_ = file->message_type(8);
   static const int MergeTrigger_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeTrigger, updated_desc_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeTrigger, subsumed_raft_id_),
@@ -220,7 +262,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(MergeTrigger));
-  ChangeReplicasTrigger_descriptor_ = file->message_type(8);
+  ChangeReplicasTrigger_descriptor_ = file->message_type(9);
   static const int ChangeReplicasTrigger_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeReplicasTrigger, node_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChangeReplicasTrigger, store_id_),
@@ -238,7 +280,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ChangeReplicasTrigger));
-  InternalCommitTrigger_descriptor_ = file->message_type(9);
+  InternalCommitTrigger_descriptor_ = file->message_type(10);
   static const int InternalCommitTrigger_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCommitTrigger, split_trigger_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCommitTrigger, merge_trigger_),
@@ -256,7 +298,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalCommitTrigger));
-  NodeList_descriptor_ = file->message_type(10);
+  NodeList_descriptor_ = file->message_type(11);
   static const int NodeList_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeList, nodes_),
   };
@@ -271,8 +313,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(NodeList));
-  Transaction_descriptor_ = file->message_type(11);
-  static const int Transaction_offsets_[12] = {
+  Transaction_descriptor_ = file->message_type(12);
+  static const int Transaction_offsets_[14] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, key_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, id_),
@@ -285,6 +327,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, orig_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, max_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, certain_nodes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, sequence_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, ignored_sequences_),
   };
   Transaction_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -297,12 +341,29 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Transaction));
-  Lease_descriptor_ = file->message_type(12);
-  static const int Lease_offsets_[4] = {
+  SequenceRange_descriptor_ = file->message_type(13);
+  static const int SequenceRange_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, start_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, end_),
+  };
+  SequenceRange_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      SequenceRange_descriptor_,
+      SequenceRange::default_instance_,
+      SequenceRange_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(SequenceRange));
+  Lease_descriptor_ = file->message_type(14);
+  static const int Lease_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, expiration_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, duration_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, term_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, raft_node_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, epoch_),
   };
   Lease_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -315,14 +376,97 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Lease));
-  MVCCMetadata_descriptor_ = file->message_type(13);
-  static const int MVCCMetadata_offsets_[6] = {
+  Liveness_descriptor_ = file->message_type(15);
+  static const int Liveness_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Liveness, node_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Liveness, epoch_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Liveness, expiration_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Liveness, decommissioning_),
+  };
+  Liveness_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      Liveness_descriptor_,
+      Liveness::default_instance_,
+      Liveness_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Liveness, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Liveness, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(Liveness));
+  ClusterVersion_descriptor_ = file->message_type(16);
+  static const int ClusterVersion_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClusterVersion, version_),
+  };
+  ClusterVersion_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ClusterVersion_descriptor_,
+      ClusterVersion::default_instance_,
+      ClusterVersion_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClusterVersion, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClusterVersion, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ClusterVersion));
+  MigrationLease_descriptor_ = file->message_type(17);
+  static const int MigrationLease_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MigrationLease, node_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MigrationLease, expiration_),
+  };
+  MigrationLease_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      MigrationLease_descriptor_,
+      MigrationLease::default_instance_,
+      MigrationLease_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MigrationLease, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MigrationLease, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(MigrationLease));
+  NodeIDClaim_descriptor_ = file->message_type(18);
+  static const int NodeIDClaim_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeIDClaim, node_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeIDClaim, address_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeIDClaim, cluster_id_),
+  };
+  NodeIDClaim_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      NodeIDClaim_descriptor_,
+      NodeIDClaim::default_instance_,
+      NodeIDClaim_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeIDClaim, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NodeIDClaim, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(NodeIDClaim));
+  StoreIDClaim_descriptor_ = file->message_type(19);
+  static const int StoreIDClaim_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreIDClaim, store_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreIDClaim, node_id_),
+  };
+  StoreIDClaim_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StoreIDClaim_descriptor_,
+      StoreIDClaim::default_instance_,
+      StoreIDClaim_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreIDClaim, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreIDClaim, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StoreIDClaim));
+  MVCCMetadata_descriptor_ = file->message_type(20);
+  static const int MVCCMetadata_offsets_[7] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, deleted_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, key_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, val_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, intent_history_),
   };
   MVCCMetadata_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -335,7 +479,23 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(MVCCMetadata));
-  GCMetadata_descriptor_ = file->message_type(14);
+  SequencedValue_descriptor_ = file->message_type(21);
+  static const int SequencedValue_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequencedValue, sequence_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequencedValue, value_),
+  };
+  SequencedValue_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      SequencedValue_descriptor_,
+      SequencedValue::default_instance_,
+      SequencedValue_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequencedValue, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequencedValue, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(SequencedValue));
+  GCMetadata_descriptor_ = file->message_type(22);
   static const int GCMetadata_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, last_scan_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, oldest_intent_nanos_),
@@ -351,7 +511,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GCMetadata));
-  TimeSeriesDatapoint_descriptor_ = file->message_type(15);
+  TimeSeriesDatapoint_descriptor_ = file->message_type(23);
   static const int TimeSeriesDatapoint_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesDatapoint, timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesDatapoint, int_value_),
@@ -368,7 +528,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TimeSeriesDatapoint));
-  TimeSeriesData_descriptor_ = file->message_type(16);
+  TimeSeriesData_descriptor_ = file->message_type(24);
   static const int TimeSeriesData_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesData, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesData, source_),
@@ -385,7 +545,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TimeSeriesData));
-  MVCCStats_descriptor_ = file->message_type(17);
+  MVCCStats_descriptor_ = file->message_type(25);
   static const int MVCCStats_offsets_[11] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStats, live_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStats, key_bytes_),
@@ -410,9 +570,10 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(MVCCStats));
-  ReplicaChangeType_descriptor_ = file->enum_type(0);
-  IsolationType_descriptor_ = file->enum_type(1);
-  TransactionStatus_descriptor_ = file->enum_type(2);
+  ValueCompression_descriptor_ = file->enum_type(0);
+  ReplicaChangeType_descriptor_ = file->enum_type(1);
+  IsolationType_descriptor_ = file->enum_type(2);
+  TransactionStatus_descriptor_ = file->enum_type(3);
 }
 
 namespace {
@@ -433,6 +594,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     MVCCValue_descriptor_, &MVCCValue::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     KeyValue_descriptor_, &KeyValue::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    Span_descriptor_, &Span::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RawKeyValue_descriptor_, &RawKeyValue::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -449,10 +612,24 @@ void protobuf_RegisterTypes(const ::std::string&) {
     NodeList_descriptor_, &NodeList::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     Transaction_descriptor_, &Transaction::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    SequenceRange_descriptor_, &SequenceRange::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     Lease_descriptor_, &Lease::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    Liveness_descriptor_, &Liveness::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ClusterVersion_descriptor_, &ClusterVersion::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    MigrationLease_descriptor_, &MigrationLease::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    NodeIDClaim_descriptor_, &NodeIDClaim::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StoreIDClaim_descriptor_, &StoreIDClaim::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     MVCCMetadata_descriptor_, &MVCCMetadata::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    SequencedValue_descriptor_, &SequencedValue::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     GCMetadata_descriptor_, &GCMetadata::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -474,6 +651,8 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto() {
   delete MVCCValue_reflection_;
   delete KeyValue::default_instance_;
   delete KeyValue_reflection_;
+  delete Span::default_instance_;
+  delete Span_reflection_;
   delete RawKeyValue::default_instance_;
   delete RawKeyValue_reflection_;
   delete StoreIdent::default_instance_;
@@ -490,10 +669,24 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto() {
   delete NodeList_reflection_;
   delete Transaction::default_instance_;
   delete Transaction_reflection_;
+  delete SequenceRange::default_instance_;
+  delete SequenceRange_reflection_;
   delete Lease::default_instance_;
   delete Lease_reflection_;
+  delete Liveness::default_instance_;
+  delete Liveness_reflection_;
+  delete ClusterVersion::default_instance_;
+  delete ClusterVersion_reflection_;
+  delete MigrationLease::default_instance_;
+  delete MigrationLease_reflection_;
+  delete NodeIDClaim::default_instance_;
+  delete NodeIDClaim_reflection_;
+  delete StoreIDClaim::default_instance_;
+  delete StoreIDClaim_reflection_;
   delete MVCCMetadata::default_instance_;
   delete MVCCMetadata_reflection_;
+  delete SequencedValue::default_instance_;
+  delete SequencedValue_reflection_;
   delete GCMetadata::default_instance_;
   delete GCMetadata_reflection_;
   delete TimeSeriesDatapoint::default_instance_;
@@ -517,86 +710,114 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
     "proto\032\034cockroach/proto/config.proto\032\024gog"
     "oproto/gogo.proto\"A\n\tTimestamp\022\027\n\twall_t"
     "ime\030\001 \001(\003B\004\310\336\037\000\022\025\n\007logical\030\002 \001(\005B\004\310\336\037\000:\004"
-    "\230\240\037\000\"u\n\005Value\022\r\n\005bytes\030\001 \001(\014\022\017\n\007integer\030"
-    "\002 \001(\003\022\020\n\010checksum\030\003 \001(\007\022-\n\ttimestamp\030\004 \001"
-    "(\0132\032.cockroach.proto.Timestamp\022\013\n\003tag\030\005 "
-    "\001(\t\"I\n\tMVCCValue\022\025\n\007deleted\030\001 \001(\010B\004\310\336\037\000\022"
-    "%\n\005value\030\002 \001(\0132\026.cockroach.proto.Value\"Q"
-    "\n\010KeyValue\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022+\n\005"
-    "value\030\002 \001(\0132\026.cockroach.proto.ValueB\004\310\336\037"
-    "\000\"C\n\013RawKeyValue\022\037\n\003key\030\001 \001(\014B\022\310\336\037\000\332\336\037\nE"
-    "ncodedKey\022\023\n\005value\030\002 \001(\014B\004\310\336\037\000\"\214\001\n\nStore"
-    "Ident\022%\n\ncluster_id\030\001 \001(\tB\021\310\336\037\000\342\336\037\tClust"
-    "erID\022)\n\007node_id\030\002 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037"
-    "\006NodeID\022,\n\010store_id\030\003 \001(\005B\032\310\336\037\000\342\336\037\007Store"
-    "ID\332\336\037\007StoreID\"\206\001\n\014SplitTrigger\022<\n\014update"
-    "d_desc\030\001 \001(\0132 .cockroach.proto.RangeDesc"
-    "riptorB\004\310\336\037\000\0228\n\010new_desc\030\002 \001(\0132 .cockroa"
-    "ch.proto.RangeDescriptorB\004\310\336\037\000\"~\n\014MergeT"
-    "rigger\022<\n\014updated_desc\030\001 \001(\0132 .cockroach"
-    ".proto.RangeDescriptorB\004\310\336\037\000\0220\n\020subsumed"
-    "_raft_id\030\002 \001(\003B\026\310\336\037\000\342\336\037\016SubsumedRaftID\"\351"
-    "\001\n\025ChangeReplicasTrigger\022)\n\007node_id\030\001 \001("
-    "\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006NodeID\022,\n\010store_id\030"
-    "\002 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007StoreID\022=\n\013cha"
-    "nge_type\030\003 \001(\0162\".cockroach.proto.Replica"
-    "ChangeTypeB\004\310\336\037\000\0228\n\020updated_replicas\030\004 \003"
-    "(\0132\030.cockroach.proto.ReplicaB\004\310\336\037\000\"\346\001\n\025I"
-    "nternalCommitTrigger\0224\n\rsplit_trigger\030\001 "
-    "\001(\0132\035.cockroach.proto.SplitTrigger\0224\n\rme"
-    "rge_trigger\030\002 \001(\0132\035.cockroach.proto.Merg"
-    "eTrigger\022G\n\027change_replicas_trigger\030\003 \001("
-    "\0132&.cockroach.proto.ChangeReplicasTrigge"
-    "r\022\030\n\007intents\030\004 \003(\014B\007\332\336\037\003Key\"\035\n\010NodeList\022"
-    "\021\n\005nodes\030\001 \003(\005B\002\020\001\"\215\004\n\013Transaction\022\022\n\004na"
-    "me\030\001 \001(\tB\004\310\336\037\000\022\030\n\003key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key"
-    "\022\026\n\002id\030\003 \001(\014B\n\310\336\037\000\342\336\037\002ID\022\026\n\010priority\030\004 \001"
-    "(\005B\004\310\336\037\000\0227\n\tisolation\030\005 \001(\0162\036.cockroach."
-    "proto.IsolationTypeB\004\310\336\037\000\0228\n\006status\030\006 \001("
-    "\0162\".cockroach.proto.TransactionStatusB\004\310"
-    "\336\037\000\022\023\n\005epoch\030\007 \001(\005B\004\310\336\037\000\0222\n\016last_heartbe"
-    "at\030\010 \001(\0132\032.cockroach.proto.Timestamp\0223\n\t"
-    "timestamp\030\t \001(\0132\032.cockroach.proto.Timest"
-    "ampB\004\310\336\037\000\0228\n\016orig_timestamp\030\n \001(\0132\032.cock"
-    "roach.proto.TimestampB\004\310\336\037\000\0227\n\rmax_times"
-    "tamp\030\013 \001(\0132\032.cockroach.proto.TimestampB\004"
-    "\310\336\037\000\0226\n\rcertain_nodes\030\014 \001(\0132\031.cockroach."
-    "proto.NodeListB\004\310\336\037\000:\004\230\240\037\000\"w\n\005Lease\022\030\n\ne"
-    "xpiration\030\001 \001(\003B\004\310\336\037\000\022\026\n\010duration\030\002 \001(\003B"
-    "\004\310\336\037\000\022\022\n\004term\030\003 \001(\004B\004\310\336\037\000\022(\n\014raft_node_i"
-    "d\030\004 \001(\004B\022\310\336\037\000\342\336\037\nRaftNodeID\"\336\001\n\014MVCCMeta"
-    "data\022)\n\003txn\030\001 \001(\0132\034.cockroach.proto.Tran"
-    "saction\0223\n\ttimestamp\030\002 \001(\0132\032.cockroach.p"
-    "roto.TimestampB\004\310\336\037\000\022\025\n\007deleted\030\003 \001(\010B\004\310"
-    "\336\037\000\022\027\n\tkey_bytes\030\004 \001(\003B\004\310\336\037\000\022\027\n\tval_byte"
-    "s\030\005 \001(\003B\004\310\336\037\000\022%\n\005value\030\006 \001(\0132\026.cockroach"
-    ".proto.Value\"H\n\nGCMetadata\022\035\n\017last_scan_"
-    "nanos\030\001 \001(\003B\004\310\336\037\000\022\033\n\023oldest_intent_nanos"
-    "\030\002 \001(\003\"\\\n\023TimeSeriesDatapoint\022\035\n\017timesta"
-    "mp_nanos\030\001 \001(\003B\004\310\336\037\000\022\021\n\tint_value\030\002 \001(\003\022"
-    "\023\n\013float_value\030\003 \001(\002\"t\n\016TimeSeriesData\022\022"
-    "\n\004name\030\001 \001(\tB\004\310\336\037\000\022\024\n\006source\030\002 \001(\tB\004\310\336\037\000"
-    "\0228\n\ndatapoints\030\003 \003(\0132$.cockroach.proto.T"
-    "imeSeriesDatapoint\"\300\002\n\tMVCCStats\022\030\n\nlive"
-    "_bytes\030\001 \001(\003B\004\310\336\037\000\022\027\n\tkey_bytes\030\002 \001(\003B\004\310"
-    "\336\037\000\022\027\n\tval_bytes\030\003 \001(\003B\004\310\336\037\000\022\032\n\014intent_b"
-    "ytes\030\004 \001(\003B\004\310\336\037\000\022\030\n\nlive_count\030\005 \001(\003B\004\310\336"
-    "\037\000\022\027\n\tkey_count\030\006 \001(\003B\004\310\336\037\000\022\027\n\tval_count"
-    "\030\007 \001(\003B\004\310\336\037\000\022\032\n\014intent_count\030\010 \001(\003B\004\310\336\037\000"
-    "\022\030\n\nintent_age\030\t \001(\003B\004\310\336\037\000\022(\n\014gc_bytes_a"
-    "ge\030\n \001(\003B\022\310\336\037\000\342\336\037\nGCBytesAge\022\037\n\021last_upd"
-    "ate_nanos\030\013 \001(\003B\004\310\336\037\000*>\n\021ReplicaChangeTy"
-    "pe\022\017\n\013ADD_REPLICA\020\000\022\022\n\016REMOVE_REPLICA\020\001\032"
-    "\004\210\243\036\000*5\n\rIsolationType\022\020\n\014SERIALIZABLE\020\000"
-    "\022\014\n\010SNAPSHOT\020\001\032\004\210\243\036\000*B\n\021TransactionStatu"
-    "s\022\013\n\007PENDING\020\000\022\r\n\tCOMMITTED\020\001\022\013\n\007ABORTED"
-    "\020\002\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 3109);
+    "\230\240\037\000\"\255\001\n\005Value\022\r\n\005bytes\030\001 \001(\014\022\017\n\007integer"
+    "\030\002 \001(\003\022\020\n\010checksum\030\003 \001(\007\022-\n\ttimestamp\030\004 "
+    "\001(\0132\032.cockroach.proto.Timestamp\022\013\n\003tag\030\005"
+    " \001(\t\0226\n\013compression\030\006 \001(\0162!.cockroach.pr"
+    "oto.ValueCompression\"I\n\tMVCCValue\022\025\n\007del"
+    "eted\030\001 \001(\010B\004\310\336\037\000\022%\n\005value\030\002 \001(\0132\026.cockro"
+    "ach.proto.Value\"Q\n\010KeyValue\022\030\n\003key\030\001 \001(\014"
+    "B\013\310\336\037\000\332\336\037\003Key\022+\n\005value\030\002 \001(\0132\026.cockroach"
+    ".proto.ValueB\004\310\336\037\000\">\n\004Span\022\030\n\003key\030\001 \001(\014B"
+    "\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003K"
+    "ey\"C\n\013RawKeyValue\022\037\n\003key\030\001 \001(\014B\022\310\336\037\000\332\336\037\n"
+    "EncodedKey\022\023\n\005value\030\002 \001(\014B\004\310\336\037\000\"\214\001\n\nStor"
+    "eIdent\022%\n\ncluster_id\030\001 \001(\tB\021\310\336\037\000\342\336\037\tClus"
+    "terID\022)\n\007node_id\030\002 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336"
+    "\037\006NodeID\022,\n\010store_id\030\003 \001(\005B\032\310\336\037\000\342\336\037\007Stor"
+    "eID\332\336\037\007StoreID\"\206\001\n\014SplitTrigger\022<\n\014updat"
+    "ed_desc\030\001 \001(\0132 .cockroach.proto.RangeDes"
+    "criptorB\004\310\336\037\000\0228\n\010new_desc\030\002 \001(\0132 .cockro"
+    "ach.proto.RangeDescriptorB\004\310\336\037\000\"~\n\014Merge"
+    "Trigger\022<\n\014updated_desc\030\001 \001(\0132 .cockroac"
+    "h.proto.RangeDescriptorB\004\310\336\037\000\0220\n\020subsume"
+    "d_raft_id\030\002 \001(\003B\026\310\336\037\000\342\336\037\016SubsumedRaftID\""
+    "\351\001\n\025ChangeReplicasTrigger\022)\n\007node_id\030\001 \001"
+    "(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006NodeID\022,\n\010store_id"
+    "\030\002 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007StoreID\022=\n\013ch"
+    "ange_type\030\003 \001(\0162\".cockroach.proto.Replic"
+    "aChangeTypeB\004\310\336\037\000\0228\n\020updated_replicas\030\004 "
+    "\003(\0132\030.cockroach.proto.ReplicaB\004\310\336\037\000\"\346\001\n\025"
+    "InternalCommitTrigger\0224\n\rsplit_trigger\030\001"
+    " \001(\0132\035.cockroach.proto.SplitTrigger\0224\n\rm"
+    "erge_trigger\030\002 \001(\0132\035.cockroach.proto.Mer"
+    "geTrigger\022G\n\027change_replicas_trigger\030\003 \001"
+    "(\0132&.cockroach.proto.ChangeReplicasTrigg"
+    "er\022\030\n\007intents\030\004 \003(\014B\007\332\336\037\003Key\"\035\n\010NodeList"
+    "\022\021\n\005nodes\030\001 \003(\005B\002\020\001\"\340\004\n\013Transaction\022\022\n\004n"
+    "ame\030\001 \001(\tB\004\310\336\037\000\022\030\n\003key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Ke"
+    "y\022\026\n\002id\030\003 \001(\014B\n\310\336\037\000\342\336\037\002ID\022\026\n\010priority\030\004 "
+    "\001(\005B\004\310\336\037\000\0227\n\tisolation\030\005 \001(\0162\036.cockroach"
+    ".proto.IsolationTypeB\004\310\336\037\000\0228\n\006status\030\006 \001"
+    "(\0162\".cockroach.proto.TransactionStatusB\004"
+    "\310\336\037\000\022\023\n\005epoch\030\007 \001(\005B\004\310\336\037\000\0222\n\016last_heartb"
+    "eat\030\010 \001(\0132\032.cockroach.proto.Timestamp\0223\n"
+    "\ttimestamp\030\t \001(\0132\032.cockroach.proto.Times"
+    "tampB\004\310\336\037\000\0228\n\016orig_timestamp\030\n \001(\0132\032.coc"
+    "kroach.proto.TimestampB\004\310\336\037\000\0227\n\rmax_time"
+    "stamp\030\013 \001(\0132\032.cockroach.proto.TimestampB"
+    "\004\310\336\037\000\0226\n\rcertain_nodes\030\014 \001(\0132\031.cockroach"
+    ".proto.NodeListB\004\310\336\037\000\022\020\n\010sequence\030\r \001(\005\022"
+    "\?\n\021ignored_sequences\030\016 \003(\0132\036.cockroach.p"
+    "roto.SequenceRangeB\004\310\336\037\000:\004\230\240\037\000\"7\n\rSequen"
+    "ceRange\022\023\n\005start\030\001 \001(\005B\004\310\336\037\000\022\021\n\003end\030\002 \001("
+    "\005B\004\310\336\037\000\"\214\001\n\005Lease\022\030\n\nexpiration\030\001 \001(\003B\004\310"
+    "\336\037\000\022\026\n\010duration\030\002 \001(\003B\004\310\336\037\000\022\022\n\004term\030\003 \001("
+    "\004B\004\310\336\037\000\022(\n\014raft_node_id\030\004 \001(\004B\022\310\336\037\000\342\336\037\nR"
+    "aftNodeID\022\023\n\005epoch\030\005 \001(\003B\004\310\336\037\000\"\237\001\n\010Liven"
+    "ess\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006"
+    "NodeID\022\023\n\005epoch\030\002 \001(\003B\004\310\336\037\000\0224\n\nexpiratio"
+    "n\030\003 \001(\0132\032.cockroach.proto.TimestampB\004\310\336\037"
+    "\000\022\035\n\017decommissioning\030\004 \001(\010B\004\310\336\037\000\"\'\n\016Clus"
+    "terVersion\022\025\n\007version\030\001 \001(\003B\004\310\336\037\000\"q\n\016Mig"
+    "rationLease\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006No"
+    "deID\332\336\037\006NodeID\0224\n\nexpiration\030\002 \001(\0132\032.coc"
+    "kroach.proto.TimestampB\004\310\336\037\000\"v\n\013NodeIDCl"
+    "aim\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006"
+    "NodeID\022\025\n\007address\030\002 \001(\tB\004\310\336\037\000\022%\n\ncluster"
+    "_id\030\003 \001(\tB\021\310\336\037\000\342\336\037\tClusterID\"g\n\014StoreIDC"
+    "laim\022,\n\010store_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332"
+    "\336\037\007StoreID\022)\n\007node_id\030\002 \001(\005B\030\310\336\037\000\342\336\037\006Nod"
+    "eID\332\336\037\006NodeID\"\235\002\n\014MVCCMetadata\022)\n\003txn\030\001 "
+    "\001(\0132\034.cockroach.proto.Transaction\0223\n\ttim"
+    "estamp\030\002 \001(\0132\032.cockroach.proto.Timestamp"
+    "B\004\310\336\037\000\022\025\n\007deleted\030\003 \001(\010B\004\310\336\037\000\022\027\n\tkey_byt"
+    "es\030\004 \001(\003B\004\310\336\037\000\022\027\n\tval_bytes\030\005 \001(\003B\004\310\336\037\000\022"
+    "%\n\005value\030\006 \001(\0132\026.cockroach.proto.Value\022="
+    "\n\016intent_history\030\007 \003(\0132\037.cockroach.proto"
+    ".SequencedValueB\004\310\336\037\000\"Y\n\016SequencedValue\022"
+    "\026\n\010sequence\030\001 \001(\005B\004\310\336\037\000\022/\n\005value\030\002 \001(\0132\032"
+    ".cockroach.proto.MVCCValueB\004\310\336\037\000\"H\n\nGCMe"
+    "tadata\022\035\n\017last_scan_nanos\030\001 \001(\003B\004\310\336\037\000\022\033\n"
+    "\023oldest_intent_nanos\030\002 \001(\003\"\\\n\023TimeSeries"
+    "Datapoint\022\035\n\017timestamp_nanos\030\001 \001(\003B\004\310\336\037\000"
+    "\022\021\n\tint_value\030\002 \001(\003\022\023\n\013float_value\030\003 \001(\002"
+    "\"t\n\016TimeSeriesData\022\022\n\004name\030\001 \001(\tB\004\310\336\037\000\022\024"
+    "\n\006source\030\002 \001(\tB\004\310\336\037\000\0228\n\ndatapoints\030\003 \003(\013"
+    "2$.cockroach.proto.TimeSeriesDatapoint\"\300"
+    "\002\n\tMVCCStats\022\030\n\nlive_bytes\030\001 \001(\003B\004\310\336\037\000\022\027"
+    "\n\tkey_bytes\030\002 \001(\003B\004\310\336\037\000\022\027\n\tval_bytes\030\003 \001"
+    "(\003B\004\310\336\037\000\022\032\n\014intent_bytes\030\004 \001(\003B\004\310\336\037\000\022\030\n\n"
+    "live_count\030\005 \001(\003B\004\310\336\037\000\022\027\n\tkey_count\030\006 \001("
+    "\003B\004\310\336\037\000\022\027\n\tval_count\030\007 \001(\003B\004\310\336\037\000\022\032\n\014inte"
+    "nt_count\030\010 \001(\003B\004\310\336\037\000\022\030\n\nintent_age\030\t \001(\003"
+    "B\004\310\336\037\000\022(\n\014gc_bytes_age\030\n \001(\003B\022\310\336\037\000\342\336\037\nGC"
+    "BytesAge\022\037\n\021last_update_nanos\030\013 \001(\003B\004\310\336\037"
+    "\000*B\n\020ValueCompression\022\026\n\022VALUE_UNCOMPRES"
+    "SED\020\000\022\020\n\014VALUE_SNAPPY\020\001\032\004\210\243\036\000*O\n\021Replica"
+    "ChangeType\022\017\n\013ADD_REPLICA\020\000\022\022\n\016REMOVE_RE"
+    "PLICA\020\001\022\017\n\013ADD_LEARNER\020\002\032\004\210\243\036\000*5\n\rIsolat"
+    "ionType\022\020\n\014SERIALIZABLE\020\000\022\014\n\010SNAPSHOT\020\001\032"
+    "\004\210\243\036\000*B\n\021TransactionStatus\022\013\n\007PENDING\020\000\022"
+    "\r\n\tCOMMITTED\020\001\022\013\n\007ABORTED\020\002\032\004\210\243\036\000B\023Z\005pro"
+    "to\340\342\036\001\310\342\036\001\320\342\036\001", 4174);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/data.proto", &protobuf_RegisterTypes);
   Timestamp::default_instance_ = new Timestamp();
   Value::default_instance_ = new Value();
   MVCCValue::default_instance_ = new MVCCValue();
   KeyValue::default_instance_ = new KeyValue();
+  Span::default_instance_ = new Span();
   RawKeyValue::default_instance_ = new RawKeyValue();
   StoreIdent::default_instance_ = new StoreIdent();
   SplitTrigger::default_instance_ = new SplitTrigger();
@@ -605,8 +826,15 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
   InternalCommitTrigger::default_instance_ = new InternalCommitTrigger();
   NodeList::default_instance_ = new NodeList();
   Transaction::default_instance_ = new Transaction();
+  SequenceRange::default_instance_ = new SequenceRange();
   Lease::default_instance_ = new Lease();
+  Liveness::default_instance_ = new Liveness();
+  ClusterVersion::default_instance_ = new ClusterVersion();
+  MigrationLease::default_instance_ = new MigrationLease();
+  NodeIDClaim::default_instance_ = new NodeIDClaim();
+  StoreIDClaim::default_instance_ = new StoreIDClaim();
   MVCCMetadata::default_instance_ = new MVCCMetadata();
+  SequencedValue::default_instance_ = new SequencedValue();
   GCMetadata::default_instance_ = new GCMetadata();
   TimeSeriesDatapoint::default_instance_ = new TimeSeriesDatapoint();
   TimeSeriesData::default_instance_ = new TimeSeriesData();
@@ -615,6 +843,7 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
   Value::default_instance_->InitAsDefaultInstance();
   MVCCValue::default_instance_->InitAsDefaultInstance();
   KeyValue::default_instance_->InitAsDefaultInstance();
+  Span::default_instance_->InitAsDefaultInstance();
   RawKeyValue::default_instance_->InitAsDefaultInstance();
   StoreIdent::default_instance_->InitAsDefaultInstance();
   SplitTrigger::default_instance_->InitAsDefaultInstance();
@@ -623,8 +852,15 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
   InternalCommitTrigger::default_instance_->InitAsDefaultInstance();
   NodeList::default_instance_->InitAsDefaultInstance();
   Transaction::default_instance_->InitAsDefaultInstance();
+  SequenceRange::default_instance_->InitAsDefaultInstance();
   Lease::default_instance_->InitAsDefaultInstance();
+  Liveness::default_instance_->InitAsDefaultInstance();
+  ClusterVersion::default_instance_->InitAsDefaultInstance();
+  MigrationLease::default_instance_->InitAsDefaultInstance();
+  NodeIDClaim::default_instance_->InitAsDefaultInstance();
+  StoreIDClaim::default_instance_->InitAsDefaultInstance();
   MVCCMetadata::default_instance_->InitAsDefaultInstance();
+  SequencedValue::default_instance_->InitAsDefaultInstance();
   GCMetadata::default_instance_->InitAsDefaultInstance();
   TimeSeriesDatapoint::default_instance_->InitAsDefaultInstance();
   TimeSeriesData::default_instance_->InitAsDefaultInstance();
@@ -638,6 +874,20 @@ struct StaticDescriptorInitializer_cockroach_2fproto_2fdata_2eproto {
     protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   }
 } static_descriptor_initializer_cockroach_2fproto_2fdata_2eproto_;
+const ::google::protobuf::EnumDescriptor* ValueCompression_descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ValueCompression_descriptor_;
+}
+bool ValueCompression_IsValid(int value) {
+  switch(value) {
+    case 0:
+    case 1:
+      return true;
+    default:
+      return false;
+  }
+}
+
 const ::google::protobuf::EnumDescriptor* ReplicaChangeType_descriptor() {
   protobuf_AssignDescriptorsOnce();
   return ReplicaChangeType_descriptor_;
@@ -646,6 +896,7 @@ bool ReplicaChangeType_IsValid(int value) {
   switch(value) {
     case 0:
     case 1:
+    case 2:
       return true;
     default:
       return false;
@@ -964,6 +1215,7 @@ const int Value::kIntegerFieldNumber;
 const int Value::kChecksumFieldNumber;
 const int Value::kTimestampFieldNumber;
 const int Value::kTagFieldNumber;
+const int Value::kCompressionFieldNumber;
 #endif  // !_MSC_VER
 
 Value::Value()
@@ -991,6 +1243,7 @@ void Value::SharedCtor() {
   checksum_ = 0u;
   timestamp_ = NULL;
   tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  compression_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1033,14 +1286,24 @@ Value* Value::New() const {
 }
 
 void Value::Clear() {
-  if (_has_bits_[0 / 32] & 31) {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<Value*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 63) {
+    ZR_(checksum_, compression_);
     if (has_bytes()) {
       if (bytes_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
         bytes_->clear();
       }
     }
     integer_ = GOOGLE_LONGLONG(0);
-    checksum_ = 0u;
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
     }
@@ -1050,6 +1313,10 @@ void Value::Clear() {
       }
     }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -1132,6 +1399,26 @@ bool Value::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(48)) goto parse_compression;
+        break;
+      }
+
+      // optional .cockroach.proto.ValueCompression compression = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_compression:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::ValueCompression_IsValid(value)) {
+            set_compression(static_cast< ::cockroach::proto::ValueCompression >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(6, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1193,6 +1480,12 @@ void Value::SerializeWithCachedSizes(
       5, this->tag(), output);
   }
 
+  // optional .cockroach.proto.ValueCompression compression = 6;
+  if (has_compression()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      6, this->compression(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1238,6 +1531,12 @@ void Value::SerializeWithCachedSizes(
         5, this->tag(), target);
   }
 
+  // optional .cockroach.proto.ValueCompression compression = 6;
+  if (has_compression()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      6, this->compression(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1283,6 +1582,12 @@ int Value::ByteSize() const {
           this->tag());
     }
 
+    // optional .cockroach.proto.ValueCompression compression = 6;
+    if (has_compression()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->compression());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1325,6 +1630,9 @@ void Value::MergeFrom(const Value& from) {
     if (from.has_tag()) {
       set_tag(from.tag());
     }
+    if (from.has_compression()) {
+      set_compression(from.compression());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1353,6 +1661,7 @@ void Value::Swap(Value* other) {
     std::swap(checksum_, other->checksum_);
     std::swap(timestamp_, other->timestamp_);
     std::swap(tag_, other->tag_);
+    std::swap(compression_, other->compression_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -1914,81 +2223,81 @@ void KeyValue::Swap(KeyValue* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int RawKeyValue::kKeyFieldNumber;
-const int RawKeyValue::kValueFieldNumber;
+const int Span::kKeyFieldNumber;
+const int Span::kEndKeyFieldNumber;
 #endif  // !_MSC_VER
 
-RawKeyValue::RawKeyValue()
+Span::Span()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(constructor:cockroach.proto.Span)
 }
 
-void RawKeyValue::InitAsDefaultInstance() {
+void Span::InitAsDefaultInstance() {
 }
 
-RawKeyValue::RawKeyValue(const RawKeyValue& from)
+Span::Span(const Span& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Span)
 }
 
-void RawKeyValue::SharedCtor() {
+void Span::SharedCtor() {
   ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  value_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-RawKeyValue::~RawKeyValue() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.RawKeyValue)
+Span::~Span() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Span)
   SharedDtor();
 }
 
-void RawKeyValue::SharedDtor() {
+void Span::SharedDtor() {
   if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     delete key_;
   }
-  if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete value_;
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
   }
   if (this != default_instance_) {
   }
 }
 
-void RawKeyValue::SetCachedSize(int size) const {
+void Span::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* RawKeyValue::descriptor() {
+const ::google::protobuf::Descriptor* Span::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return RawKeyValue_descriptor_;
+  return Span_descriptor_;
 }
 
-const RawKeyValue& RawKeyValue::default_instance() {
+const Span& Span::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-RawKeyValue* RawKeyValue::default_instance_ = NULL;
+Span* Span::default_instance_ = NULL;
 
-RawKeyValue* RawKeyValue::New() const {
-  return new RawKeyValue;
+Span* Span::New() const {
+  return new Span;
 }
 
-void RawKeyValue::Clear() {
+void Span::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_key()) {
       if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
         key_->clear();
       }
     }
-    if (has_value()) {
-      if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        value_->clear();
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
       }
     }
   }
@@ -1996,11 +2305,11 @@ void RawKeyValue::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool RawKeyValue::MergePartialFromCodedStream(
+bool Span::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Span)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -2014,16 +2323,16 @@ bool RawKeyValue::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_value;
+        if (input->ExpectTag(18)) goto parse_end_key;
         break;
       }
 
-      // optional bytes value = 2;
+      // optional bytes end_key = 2;
       case 2: {
         if (tag == 18) {
-         parse_value:
+         parse_end_key:
           DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_value()));
+                input, this->mutable_end_key()));
         } else {
           goto handle_unusual;
         }
@@ -2045,39 +2354,39 @@ bool RawKeyValue::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Span)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Span)
   return false;
 #undef DO_
 }
 
-void RawKeyValue::SerializeWithCachedSizes(
+void Span::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Span)
   // optional bytes key = 1;
   if (has_key()) {
     ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
       1, this->key(), output);
   }
 
-  // optional bytes value = 2;
-  if (has_value()) {
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
     ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      2, this->value(), output);
+      2, this->end_key(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Span)
 }
 
-::google::protobuf::uint8* RawKeyValue::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* Span::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Span)
   // optional bytes key = 1;
   if (has_key()) {
     target =
@@ -2085,22 +2394,22 @@ void RawKeyValue::SerializeWithCachedSizes(
         1, this->key(), target);
   }
 
-  // optional bytes value = 2;
-  if (has_value()) {
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
     target =
       ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        2, this->value(), target);
+        2, this->end_key(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RawKeyValue)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Span)
   return target;
 }
 
-int RawKeyValue::ByteSize() const {
+int Span::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -2111,11 +2420,11 @@ int RawKeyValue::ByteSize() const {
           this->key());
     }
 
-    // optional bytes value = 2;
-    if (has_value()) {
+    // optional bytes end_key = 2;
+    if (has_end_key()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->value());
+          this->end_key());
     }
 
   }
@@ -2130,10 +2439,10 @@ int RawKeyValue::ByteSize() const {
   return total_size;
 }
 
-void RawKeyValue::MergeFrom(const ::google::protobuf::Message& from) {
+void Span::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const RawKeyValue* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const RawKeyValue*>(
+  const Span* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Span*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2142,51 +2451,51 @@ void RawKeyValue::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void RawKeyValue::MergeFrom(const RawKeyValue& from) {
+void Span::MergeFrom(const Span& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_key()) {
       set_key(from.key());
     }
-    if (from.has_value()) {
-      set_value(from.value());
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void RawKeyValue::CopyFrom(const ::google::protobuf::Message& from) {
+void Span::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void RawKeyValue::CopyFrom(const RawKeyValue& from) {
+void Span::CopyFrom(const Span& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool RawKeyValue::IsInitialized() const {
+bool Span::IsInitialized() const {
 
   return true;
 }
 
-void RawKeyValue::Swap(RawKeyValue* other) {
+void Span::Swap(Span* other) {
   if (other != this) {
     std::swap(key_, other->key_);
-    std::swap(value_, other->value_);
+    std::swap(end_key_, other->end_key_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata RawKeyValue::GetMetadata() const {
+::google::protobuf::Metadata Span::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = RawKeyValue_descriptor_;
-  metadata.reflection = RawKeyValue_reflection_;
+  metadata.descriptor = Span_descriptor_;
+  metadata.reflection = Span_reflection_;
   return metadata;
 }
 
@@ -2194,146 +2503,116 @@ void RawKeyValue::Swap(RawKeyValue* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int StoreIdent::kClusterIdFieldNumber;
-const int StoreIdent::kNodeIdFieldNumber;
-const int StoreIdent::kStoreIdFieldNumber;
+const int RawKeyValue::kKeyFieldNumber;
+const int RawKeyValue::kValueFieldNumber;
 #endif  // !_MSC_VER
 
-StoreIdent::StoreIdent()
+RawKeyValue::RawKeyValue()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(constructor:cockroach.proto.RawKeyValue)
 }
 
-void StoreIdent::InitAsDefaultInstance() {
+void RawKeyValue::InitAsDefaultInstance() {
 }
 
-StoreIdent::StoreIdent(const StoreIdent& from)
+RawKeyValue::RawKeyValue(const RawKeyValue& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RawKeyValue)
 }
 
-void StoreIdent::SharedCtor() {
+void RawKeyValue::SharedCtor() {
   ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  node_id_ = 0;
-  store_id_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-StoreIdent::~StoreIdent() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.StoreIdent)
+RawKeyValue::~RawKeyValue() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RawKeyValue)
   SharedDtor();
 }
 
-void StoreIdent::SharedDtor() {
-  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete cluster_id_;
+void RawKeyValue::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete value_;
   }
   if (this != default_instance_) {
   }
 }
 
-void StoreIdent::SetCachedSize(int size) const {
+void RawKeyValue::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* StoreIdent::descriptor() {
+const ::google::protobuf::Descriptor* RawKeyValue::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return StoreIdent_descriptor_;
+  return RawKeyValue_descriptor_;
 }
 
-const StoreIdent& StoreIdent::default_instance() {
+const RawKeyValue& RawKeyValue::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-StoreIdent* StoreIdent::default_instance_ = NULL;
+RawKeyValue* RawKeyValue::default_instance_ = NULL;
 
-StoreIdent* StoreIdent::New() const {
-  return new StoreIdent;
+RawKeyValue* RawKeyValue::New() const {
+  return new RawKeyValue;
 }
 
-void StoreIdent::Clear() {
-#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<StoreIdent*>(16)->f) - \
-   reinterpret_cast<char*>(16))
-
-#define ZR_(first, last) do {                              \
-    size_t f = OFFSET_OF_FIELD_(first);                    \
-    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
-    ::memset(&first, 0, n);                                \
-  } while (0)
-
-  if (_has_bits_[0 / 32] & 7) {
-    ZR_(node_id_, store_id_);
-    if (has_cluster_id()) {
-      if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        cluster_id_->clear();
+void RawKeyValue::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_value()) {
+      if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        value_->clear();
       }
     }
   }
-
-#undef OFFSET_OF_FIELD_
-#undef ZR_
-
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool StoreIdent::MergePartialFromCodedStream(
+bool RawKeyValue::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RawKeyValue)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional string cluster_id = 1;
+      // optional bytes key = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_cluster_id()));
-          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-            this->cluster_id().data(), this->cluster_id().length(),
-            ::google::protobuf::internal::WireFormat::PARSE,
-            "cluster_id");
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_node_id;
+        if (input->ExpectTag(18)) goto parse_value;
         break;
       }
 
-      // optional int32 node_id = 2;
+      // optional bytes value = 2;
       case 2: {
-        if (tag == 16) {
-         parse_node_id:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &node_id_)));
-          set_has_node_id();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_store_id;
-        break;
-      }
-
-      // optional int32 store_id = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_store_id:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &store_id_)));
-          set_has_store_id();
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_value()));
         } else {
           goto handle_unusual;
         }
@@ -2355,99 +2634,77 @@ bool StoreIdent::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RawKeyValue)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RawKeyValue)
   return false;
 #undef DO_
 }
 
-void StoreIdent::SerializeWithCachedSizes(
+void RawKeyValue::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.StoreIdent)
-  // optional string cluster_id = 1;
-  if (has_cluster_id()) {
-    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-      this->cluster_id().data(), this->cluster_id().length(),
-      ::google::protobuf::internal::WireFormat::SERIALIZE,
-      "cluster_id");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      1, this->cluster_id(), output);
-  }
-
-  // optional int32 node_id = 2;
-  if (has_node_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->node_id(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RawKeyValue)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
   }
 
-  // optional int32 store_id = 3;
-  if (has_store_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->store_id(), output);
+  // optional bytes value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->value(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RawKeyValue)
 }
 
-::google::protobuf::uint8* StoreIdent::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* RawKeyValue::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StoreIdent)
-  // optional string cluster_id = 1;
-  if (has_cluster_id()) {
-    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-      this->cluster_id().data(), this->cluster_id().length(),
-      ::google::protobuf::internal::WireFormat::SERIALIZE,
-      "cluster_id");
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RawKeyValue)
+  // optional bytes key = 1;
+  if (has_key()) {
     target =
-      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        1, this->cluster_id(), target);
-  }
-
-  // optional int32 node_id = 2;
-  if (has_node_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->node_id(), target);
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
   }
 
-  // optional int32 store_id = 3;
-  if (has_store_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->store_id(), target);
+  // optional bytes value = 2;
+  if (has_value()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->value(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StoreIdent)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RawKeyValue)
   return target;
 }
 
-int StoreIdent::ByteSize() const {
+int RawKeyValue::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional string cluster_id = 1;
-    if (has_cluster_id()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::StringSize(
-          this->cluster_id());
-    }
-
-    // optional int32 node_id = 2;
-    if (has_node_id()) {
+    // optional bytes key = 1;
+    if (has_key()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->node_id());
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
     }
 
-    // optional int32 store_id = 3;
-    if (has_store_id()) {
+    // optional bytes value = 2;
+    if (has_value()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->store_id());
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->value());
     }
 
   }
@@ -2462,10 +2719,10 @@ int StoreIdent::ByteSize() const {
   return total_size;
 }
 
-void StoreIdent::MergeFrom(const ::google::protobuf::Message& from) {
+void RawKeyValue::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const StoreIdent* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const StoreIdent*>(
+  const RawKeyValue* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RawKeyValue*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2474,55 +2731,51 @@ void StoreIdent::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void StoreIdent::MergeFrom(const StoreIdent& from) {
+void RawKeyValue::MergeFrom(const RawKeyValue& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_cluster_id()) {
-      set_cluster_id(from.cluster_id());
-    }
-    if (from.has_node_id()) {
-      set_node_id(from.node_id());
+    if (from.has_key()) {
+      set_key(from.key());
     }
-    if (from.has_store_id()) {
-      set_store_id(from.store_id());
+    if (from.has_value()) {
+      set_value(from.value());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void StoreIdent::CopyFrom(const ::google::protobuf::Message& from) {
+void RawKeyValue::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void StoreIdent::CopyFrom(const StoreIdent& from) {
+void RawKeyValue::CopyFrom(const RawKeyValue& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool StoreIdent::IsInitialized() const {
+bool RawKeyValue::IsInitialized() const {
 
   return true;
 }
 
-void StoreIdent::Swap(StoreIdent* other) {
+void RawKeyValue::Swap(RawKeyValue* other) {
   if (other != this) {
-    std::swap(cluster_id_, other->cluster_id_);
-    std::swap(node_id_, other->node_id_);
-    std::swap(store_id_, other->store_id_);
+    std::swap(key_, other->key_);
+    std::swap(value_, other->value_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata StoreIdent::GetMetadata() const {
+::google::protobuf::Metadata RawKeyValue::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = StoreIdent_descriptor_;
-  metadata.reflection = StoreIdent_reflection_;
+  metadata.descriptor = RawKeyValue_descriptor_;
+  metadata.reflection = RawKeyValue_reflection_;
   return metadata;
 }
 
@@ -2530,109 +2783,146 @@ void StoreIdent::Swap(StoreIdent* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int SplitTrigger::kUpdatedDescFieldNumber;
-const int SplitTrigger::kNewDescFieldNumber;
+const int StoreIdent::kClusterIdFieldNumber;
+const int StoreIdent::kNodeIdFieldNumber;
+const int StoreIdent::kStoreIdFieldNumber;
 #endif  // !_MSC_VER
 
-SplitTrigger::SplitTrigger()
+StoreIdent::StoreIdent()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(constructor:cockroach.proto.StoreIdent)
 }
 
-void SplitTrigger::InitAsDefaultInstance() {
-  updated_desc_ = const_cast< ::cockroach::proto::RangeDescriptor*>(&::cockroach::proto::RangeDescriptor::default_instance());
-  new_desc_ = const_cast< ::cockroach::proto::RangeDescriptor*>(&::cockroach::proto::RangeDescriptor::default_instance());
+void StoreIdent::InitAsDefaultInstance() {
 }
 
-SplitTrigger::SplitTrigger(const SplitTrigger& from)
+StoreIdent::StoreIdent(const StoreIdent& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StoreIdent)
 }
 
-void SplitTrigger::SharedCtor() {
+void StoreIdent::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  updated_desc_ = NULL;
-  new_desc_ = NULL;
+  cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  node_id_ = 0;
+  store_id_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-SplitTrigger::~SplitTrigger() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.SplitTrigger)
+StoreIdent::~StoreIdent() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StoreIdent)
   SharedDtor();
 }
 
-void SplitTrigger::SharedDtor() {
+void StoreIdent::SharedDtor() {
+  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete cluster_id_;
+  }
   if (this != default_instance_) {
-    delete updated_desc_;
-    delete new_desc_;
   }
 }
 
-void SplitTrigger::SetCachedSize(int size) const {
+void StoreIdent::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* SplitTrigger::descriptor() {
+const ::google::protobuf::Descriptor* StoreIdent::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return SplitTrigger_descriptor_;
+  return StoreIdent_descriptor_;
 }
 
-const SplitTrigger& SplitTrigger::default_instance() {
+const StoreIdent& StoreIdent::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-SplitTrigger* SplitTrigger::default_instance_ = NULL;
+StoreIdent* StoreIdent::default_instance_ = NULL;
 
-SplitTrigger* SplitTrigger::New() const {
-  return new SplitTrigger;
+StoreIdent* StoreIdent::New() const {
+  return new StoreIdent;
 }
 
-void SplitTrigger::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_updated_desc()) {
-      if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
-    }
-    if (has_new_desc()) {
-      if (new_desc_ != NULL) new_desc_->::cockroach::proto::RangeDescriptor::Clear();
+void StoreIdent::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<StoreIdent*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 7) {
+    ZR_(node_id_, store_id_);
+    if (has_cluster_id()) {
+      if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        cluster_id_->clear();
+      }
     }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool SplitTrigger::MergePartialFromCodedStream(
+bool StoreIdent::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StoreIdent)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
+      // optional string cluster_id = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_updated_desc()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_new_desc;
-        break;
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_cluster_id()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->cluster_id().data(), this->cluster_id().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "cluster_id");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_node_id;
+        break;
       }
 
-      // optional .cockroach.proto.RangeDescriptor new_desc = 2;
+      // optional int32 node_id = 2;
       case 2: {
-        if (tag == 18) {
-         parse_new_desc:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_new_desc()));
+        if (tag == 16) {
+         parse_node_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &node_id_)));
+          set_has_node_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_store_id;
+        break;
+      }
+
+      // optional int32 store_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_store_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &store_id_)));
+          set_has_store_id();
         } else {
           goto handle_unusual;
         }
@@ -2654,77 +2944,99 @@ bool SplitTrigger::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StoreIdent)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StoreIdent)
   return false;
 #undef DO_
 }
 
-void SplitTrigger::SerializeWithCachedSizes(
+void StoreIdent::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.SplitTrigger)
-  // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
-  if (has_updated_desc()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->updated_desc(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StoreIdent)
+  // optional string cluster_id = 1;
+  if (has_cluster_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->cluster_id().data(), this->cluster_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cluster_id");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->cluster_id(), output);
   }
 
-  // optional .cockroach.proto.RangeDescriptor new_desc = 2;
-  if (has_new_desc()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->new_desc(), output);
+  // optional int32 node_id = 2;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->node_id(), output);
+  }
+
+  // optional int32 store_id = 3;
+  if (has_store_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->store_id(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StoreIdent)
 }
 
-::google::protobuf::uint8* SplitTrigger::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* StoreIdent::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.SplitTrigger)
-  // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
-  if (has_updated_desc()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->updated_desc(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StoreIdent)
+  // optional string cluster_id = 1;
+  if (has_cluster_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->cluster_id().data(), this->cluster_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cluster_id");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->cluster_id(), target);
   }
 
-  // optional .cockroach.proto.RangeDescriptor new_desc = 2;
-  if (has_new_desc()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->new_desc(), target);
+  // optional int32 node_id = 2;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->node_id(), target);
+  }
+
+  // optional int32 store_id = 3;
+  if (has_store_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->store_id(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.SplitTrigger)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StoreIdent)
   return target;
 }
 
-int SplitTrigger::ByteSize() const {
+int StoreIdent::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
-    if (has_updated_desc()) {
+    // optional string cluster_id = 1;
+    if (has_cluster_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->updated_desc());
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->cluster_id());
     }
 
-    // optional .cockroach.proto.RangeDescriptor new_desc = 2;
-    if (has_new_desc()) {
+    // optional int32 node_id = 2;
+    if (has_node_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->new_desc());
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
+    }
+
+    // optional int32 store_id = 3;
+    if (has_store_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->store_id());
     }
 
   }
@@ -2739,10 +3051,10 @@ int SplitTrigger::ByteSize() const {
   return total_size;
 }
 
-void SplitTrigger::MergeFrom(const ::google::protobuf::Message& from) {
+void StoreIdent::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const SplitTrigger* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const SplitTrigger*>(
+  const StoreIdent* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StoreIdent*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2751,51 +3063,55 @@ void SplitTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void SplitTrigger::MergeFrom(const SplitTrigger& from) {
+void StoreIdent::MergeFrom(const StoreIdent& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_updated_desc()) {
-      mutable_updated_desc()->::cockroach::proto::RangeDescriptor::MergeFrom(from.updated_desc());
+    if (from.has_cluster_id()) {
+      set_cluster_id(from.cluster_id());
     }
-    if (from.has_new_desc()) {
-      mutable_new_desc()->::cockroach::proto::RangeDescriptor::MergeFrom(from.new_desc());
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
+    }
+    if (from.has_store_id()) {
+      set_store_id(from.store_id());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void SplitTrigger::CopyFrom(const ::google::protobuf::Message& from) {
+void StoreIdent::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void SplitTrigger::CopyFrom(const SplitTrigger& from) {
+void StoreIdent::CopyFrom(const StoreIdent& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SplitTrigger::IsInitialized() const {
+bool StoreIdent::IsInitialized() const {
 
   return true;
 }
 
-void SplitTrigger::Swap(SplitTrigger* other) {
+void StoreIdent::Swap(StoreIdent* other) {
   if (other != this) {
-    std::swap(updated_desc_, other->updated_desc_);
-    std::swap(new_desc_, other->new_desc_);
+    std::swap(cluster_id_, other->cluster_id_);
+    std::swap(node_id_, other->node_id_);
+    std::swap(store_id_, other->store_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata SplitTrigger::GetMetadata() const {
+::google::protobuf::Metadata StoreIdent::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = SplitTrigger_descriptor_;
-  metadata.reflection = SplitTrigger_reflection_;
+  metadata.descriptor = StoreIdent_descriptor_;
+  metadata.reflection = StoreIdent_reflection_;
   return metadata;
 }
 
@@ -2803,82 +3119,86 @@ void SplitTrigger::Swap(SplitTrigger* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int MergeTrigger::kUpdatedDescFieldNumber;
-const int MergeTrigger::kSubsumedRaftIdFieldNumber;
+const int SplitTrigger::kUpdatedDescFieldNumber;
+const int SplitTrigger::kNewDescFieldNumber;
 #endif  // !_MSC_VER
 
-MergeTrigger::MergeTrigger()
+SplitTrigger::SplitTrigger()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(constructor:cockroach.proto.SplitTrigger)
 }
 
-void MergeTrigger::InitAsDefaultInstance() {
+void SplitTrigger::InitAsDefaultInstance() {
   updated_desc_ = const_cast< ::cockroach::proto::RangeDescriptor*>(&::cockroach::proto::RangeDescriptor::default_instance());
+  new_desc_ = const_cast< ::cockroach::proto::RangeDescriptor*>(&::cockroach::proto::RangeDescriptor::default_instance());
 }
 
-MergeTrigger::MergeTrigger(const MergeTrigger& from)
+SplitTrigger::SplitTrigger(const SplitTrigger& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.SplitTrigger)
 }
 
-void MergeTrigger::SharedCtor() {
+void SplitTrigger::SharedCtor() {
   _cached_size_ = 0;
   updated_desc_ = NULL;
-  subsumed_raft_id_ = GOOGLE_LONGLONG(0);
+  new_desc_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-MergeTrigger::~MergeTrigger() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.MergeTrigger)
+SplitTrigger::~SplitTrigger() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.SplitTrigger)
   SharedDtor();
 }
 
-void MergeTrigger::SharedDtor() {
+void SplitTrigger::SharedDtor() {
   if (this != default_instance_) {
     delete updated_desc_;
+    delete new_desc_;
   }
 }
 
-void MergeTrigger::SetCachedSize(int size) const {
+void SplitTrigger::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* MergeTrigger::descriptor() {
+const ::google::protobuf::Descriptor* SplitTrigger::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return MergeTrigger_descriptor_;
+  return SplitTrigger_descriptor_;
 }
 
-const MergeTrigger& MergeTrigger::default_instance() {
+const SplitTrigger& SplitTrigger::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-MergeTrigger* MergeTrigger::default_instance_ = NULL;
+SplitTrigger* SplitTrigger::default_instance_ = NULL;
 
-MergeTrigger* MergeTrigger::New() const {
-  return new MergeTrigger;
+SplitTrigger* SplitTrigger::New() const {
+  return new SplitTrigger;
 }
 
-void MergeTrigger::Clear() {
+void SplitTrigger::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_updated_desc()) {
       if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
     }
-    subsumed_raft_id_ = GOOGLE_LONGLONG(0);
+    if (has_new_desc()) {
+      if (new_desc_ != NULL) new_desc_->::cockroach::proto::RangeDescriptor::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool MergeTrigger::MergePartialFromCodedStream(
+bool SplitTrigger::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.SplitTrigger)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -2892,18 +3212,16 @@ bool MergeTrigger::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_subsumed_raft_id;
+        if (input->ExpectTag(18)) goto parse_new_desc;
         break;
       }
 
-      // optional int64 subsumed_raft_id = 2;
+      // optional .cockroach.proto.RangeDescriptor new_desc = 2;
       case 2: {
-        if (tag == 16) {
-         parse_subsumed_raft_id:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &subsumed_raft_id_)));
-          set_has_subsumed_raft_id();
+        if (tag == 18) {
+         parse_new_desc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_new_desc()));
         } else {
           goto handle_unusual;
         }
@@ -2925,38 +3243,39 @@ bool MergeTrigger::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.SplitTrigger)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.SplitTrigger)
   return false;
 #undef DO_
 }
 
-void MergeTrigger::SerializeWithCachedSizes(
+void SplitTrigger::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.SplitTrigger)
   // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
   if (has_updated_desc()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->updated_desc(), output);
   }
 
-  // optional int64 subsumed_raft_id = 2;
-  if (has_subsumed_raft_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->subsumed_raft_id(), output);
+  // optional .cockroach.proto.RangeDescriptor new_desc = 2;
+  if (has_new_desc()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->new_desc(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.SplitTrigger)
 }
 
-::google::protobuf::uint8* MergeTrigger::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* SplitTrigger::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.SplitTrigger)
   // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
   if (has_updated_desc()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -2964,20 +3283,22 @@ void MergeTrigger::SerializeWithCachedSizes(
         1, this->updated_desc(), target);
   }
 
-  // optional int64 subsumed_raft_id = 2;
-  if (has_subsumed_raft_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->subsumed_raft_id(), target);
+  // optional .cockroach.proto.RangeDescriptor new_desc = 2;
+  if (has_new_desc()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->new_desc(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.MergeTrigger)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.SplitTrigger)
   return target;
 }
 
-int MergeTrigger::ByteSize() const {
+int SplitTrigger::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -2988,11 +3309,11 @@ int MergeTrigger::ByteSize() const {
           this->updated_desc());
     }
 
-    // optional int64 subsumed_raft_id = 2;
-    if (has_subsumed_raft_id()) {
+    // optional .cockroach.proto.RangeDescriptor new_desc = 2;
+    if (has_new_desc()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->subsumed_raft_id());
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->new_desc());
     }
 
   }
@@ -3007,10 +3328,10 @@ int MergeTrigger::ByteSize() const {
   return total_size;
 }
 
-void MergeTrigger::MergeFrom(const ::google::protobuf::Message& from) {
+void SplitTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const MergeTrigger* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const MergeTrigger*>(
+  const SplitTrigger* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const SplitTrigger*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3019,51 +3340,51 @@ void MergeTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void MergeTrigger::MergeFrom(const MergeTrigger& from) {
+void SplitTrigger::MergeFrom(const SplitTrigger& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_updated_desc()) {
       mutable_updated_desc()->::cockroach::proto::RangeDescriptor::MergeFrom(from.updated_desc());
     }
-    if (from.has_subsumed_raft_id()) {
-      set_subsumed_raft_id(from.subsumed_raft_id());
+    if (from.has_new_desc()) {
+      mutable_new_desc()->::cockroach::proto::RangeDescriptor::MergeFrom(from.new_desc());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void MergeTrigger::CopyFrom(const ::google::protobuf::Message& from) {
+void SplitTrigger::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void MergeTrigger::CopyFrom(const MergeTrigger& from) {
+void SplitTrigger::CopyFrom(const SplitTrigger& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool MergeTrigger::IsInitialized() const {
+bool SplitTrigger::IsInitialized() const {
 
   return true;
 }
 
-void MergeTrigger::Swap(MergeTrigger* other) {
+void SplitTrigger::Swap(SplitTrigger* other) {
   if (other != this) {
     std::swap(updated_desc_, other->updated_desc_);
-    std::swap(subsumed_raft_id_, other->subsumed_raft_id_);
+    std::swap(new_desc_, other->new_desc_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata MergeTrigger::GetMetadata() const {
+::google::protobuf::Metadata SplitTrigger::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = MergeTrigger_descriptor_;
-  metadata.reflection = MergeTrigger_reflection_;
+  metadata.descriptor = SplitTrigger_descriptor_;
+  metadata.reflection = SplitTrigger_reflection_;
   return metadata;
 }
 
@@ -3071,160 +3392,110 @@ void MergeTrigger::Swap(MergeTrigger* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ChangeReplicasTrigger::kNodeIdFieldNumber;
-const int ChangeReplicasTrigger::kStoreIdFieldNumber;
-const int ChangeReplicasTrigger::kChangeTypeFieldNumber;
-const int ChangeReplicasTrigger::kUpdatedReplicasFieldNumber;
+const int MergeTrigger::kUpdatedDescFieldNumber;
+const int MergeTrigger::kSubsumedRaftIdFieldNumber;
 #endif  // !_MSC_VER
 
-ChangeReplicasTrigger::ChangeReplicasTrigger()
+MergeTrigger::MergeTrigger()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(constructor:cockroach.proto.MergeTrigger)
 }
 
-void ChangeReplicasTrigger::InitAsDefaultInstance() {
+void MergeTrigger::InitAsDefaultInstance() {
+  updated_desc_ = const_cast< ::cockroach::proto::RangeDescriptor*>(&::cockroach::proto::RangeDescriptor::default_instance());
 }
 
-ChangeReplicasTrigger::ChangeReplicasTrigger(const ChangeReplicasTrigger& from)
+MergeTrigger::MergeTrigger(const MergeTrigger& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.MergeTrigger)
 }
 
-void ChangeReplicasTrigger::SharedCtor() {
+void MergeTrigger::SharedCtor() {
   _cached_size_ = 0;
-  node_id_ = 0;
-  store_id_ = 0;
-  change_type_ = 0;
+  updated_desc_ = NULL;
+  subsumed_raft_id_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ChangeReplicasTrigger::~ChangeReplicasTrigger() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.ChangeReplicasTrigger)
+MergeTrigger::~MergeTrigger() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.MergeTrigger)
   SharedDtor();
 }
 
-void ChangeReplicasTrigger::SharedDtor() {
+void MergeTrigger::SharedDtor() {
   if (this != default_instance_) {
+    delete updated_desc_;
   }
 }
 
-void ChangeReplicasTrigger::SetCachedSize(int size) const {
+void MergeTrigger::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ChangeReplicasTrigger::descriptor() {
+const ::google::protobuf::Descriptor* MergeTrigger::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ChangeReplicasTrigger_descriptor_;
+  return MergeTrigger_descriptor_;
 }
 
-const ChangeReplicasTrigger& ChangeReplicasTrigger::default_instance() {
+const MergeTrigger& MergeTrigger::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-ChangeReplicasTrigger* ChangeReplicasTrigger::default_instance_ = NULL;
+MergeTrigger* MergeTrigger::default_instance_ = NULL;
 
-ChangeReplicasTrigger* ChangeReplicasTrigger::New() const {
-  return new ChangeReplicasTrigger;
+MergeTrigger* MergeTrigger::New() const {
+  return new MergeTrigger;
 }
 
-void ChangeReplicasTrigger::Clear() {
-#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<ChangeReplicasTrigger*>(16)->f) - \
-   reinterpret_cast<char*>(16))
-
-#define ZR_(first, last) do {                              \
-    size_t f = OFFSET_OF_FIELD_(first);                    \
-    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
-    ::memset(&first, 0, n);                                \
-  } while (0)
-
-  if (_has_bits_[0 / 32] & 7) {
-    ZR_(node_id_, store_id_);
-    change_type_ = 0;
+void MergeTrigger::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_updated_desc()) {
+      if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
+    }
+    subsumed_raft_id_ = GOOGLE_LONGLONG(0);
   }
-
-#undef OFFSET_OF_FIELD_
-#undef ZR_
-
-  updated_replicas_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ChangeReplicasTrigger::MergePartialFromCodedStream(
+bool MergeTrigger::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.MergeTrigger)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional int32 node_id = 1;
+      // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
       case 1: {
-        if (tag == 8) {
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &node_id_)));
-          set_has_node_id();
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_updated_desc()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_store_id;
+        if (input->ExpectTag(16)) goto parse_subsumed_raft_id;
         break;
       }
 
-      // optional int32 store_id = 2;
+      // optional int64 subsumed_raft_id = 2;
       case 2: {
         if (tag == 16) {
-         parse_store_id:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &store_id_)));
-          set_has_store_id();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_change_type;
-        break;
-      }
-
-      // optional .cockroach.proto.ReplicaChangeType change_type = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_change_type:
-          int value;
+         parse_subsumed_raft_id:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          if (::cockroach::proto::ReplicaChangeType_IsValid(value)) {
-            set_change_type(static_cast< ::cockroach::proto::ReplicaChangeType >(value));
-          } else {
-            mutable_unknown_fields()->AddVarint(3, value);
-          }
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(34)) goto parse_updated_replicas;
-        break;
-      }
-
-      // repeated .cockroach.proto.Replica updated_replicas = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_updated_replicas:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-                input, add_updated_replicas()));
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &subsumed_raft_id_)));
+          set_has_subsumed_raft_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(34)) goto parse_updated_replicas;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3243,113 +3514,77 @@ bool ChangeReplicasTrigger::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.MergeTrigger)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.MergeTrigger)
   return false;
 #undef DO_
 }
 
-void ChangeReplicasTrigger::SerializeWithCachedSizes(
+void MergeTrigger::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.ChangeReplicasTrigger)
-  // optional int32 node_id = 1;
-  if (has_node_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->node_id(), output);
-  }
-
-  // optional int32 store_id = 2;
-  if (has_store_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->store_id(), output);
-  }
-
-  // optional .cockroach.proto.ReplicaChangeType change_type = 3;
-  if (has_change_type()) {
-    ::google::protobuf::internal::WireFormatLite::WriteEnum(
-      3, this->change_type(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.MergeTrigger)
+  // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
+  if (has_updated_desc()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->updated_desc(), output);
   }
 
-  // repeated .cockroach.proto.Replica updated_replicas = 4;
-  for (int i = 0; i < this->updated_replicas_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, this->updated_replicas(i), output);
+  // optional int64 subsumed_raft_id = 2;
+  if (has_subsumed_raft_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->subsumed_raft_id(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.MergeTrigger)
 }
 
-::google::protobuf::uint8* ChangeReplicasTrigger::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* MergeTrigger::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ChangeReplicasTrigger)
-  // optional int32 node_id = 1;
-  if (has_node_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->node_id(), target);
-  }
-
-  // optional int32 store_id = 2;
-  if (has_store_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->store_id(), target);
-  }
-
-  // optional .cockroach.proto.ReplicaChangeType change_type = 3;
-  if (has_change_type()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
-      3, this->change_type(), target);
-  }
-
-  // repeated .cockroach.proto.Replica updated_replicas = 4;
-  for (int i = 0; i < this->updated_replicas_size(); i++) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.MergeTrigger)
+  // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
+  if (has_updated_desc()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        4, this->updated_replicas(i), target);
+        1, this->updated_desc(), target);
+  }
+
+  // optional int64 subsumed_raft_id = 2;
+  if (has_subsumed_raft_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->subsumed_raft_id(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ChangeReplicasTrigger)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.MergeTrigger)
   return target;
 }
 
-int ChangeReplicasTrigger::ByteSize() const {
+int MergeTrigger::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional int32 node_id = 1;
-    if (has_node_id()) {
+    // optional .cockroach.proto.RangeDescriptor updated_desc = 1;
+    if (has_updated_desc()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->node_id());
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->updated_desc());
     }
 
-    // optional int32 store_id = 2;
-    if (has_store_id()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->store_id());
-    }
-
-    // optional .cockroach.proto.ReplicaChangeType change_type = 3;
-    if (has_change_type()) {
+    // optional int64 subsumed_raft_id = 2;
+    if (has_subsumed_raft_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::EnumSize(this->change_type());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->subsumed_raft_id());
     }
 
   }
-  // repeated .cockroach.proto.Replica updated_replicas = 4;
-  total_size += 1 * this->updated_replicas_size();
-  for (int i = 0; i < this->updated_replicas_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->updated_replicas(i));
-  }
-
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -3361,10 +3596,10 @@ int ChangeReplicasTrigger::ByteSize() const {
   return total_size;
 }
 
-void ChangeReplicasTrigger::MergeFrom(const ::google::protobuf::Message& from) {
+void MergeTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ChangeReplicasTrigger* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ChangeReplicasTrigger*>(
+  const MergeTrigger* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const MergeTrigger*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3373,57 +3608,51 @@ void ChangeReplicasTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ChangeReplicasTrigger::MergeFrom(const ChangeReplicasTrigger& from) {
+void MergeTrigger::MergeFrom(const MergeTrigger& from) {
   GOOGLE_CHECK_NE(&from, this);
-  updated_replicas_.MergeFrom(from.updated_replicas_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_node_id()) {
-      set_node_id(from.node_id());
-    }
-    if (from.has_store_id()) {
-      set_store_id(from.store_id());
+    if (from.has_updated_desc()) {
+      mutable_updated_desc()->::cockroach::proto::RangeDescriptor::MergeFrom(from.updated_desc());
     }
-    if (from.has_change_type()) {
-      set_change_type(from.change_type());
+    if (from.has_subsumed_raft_id()) {
+      set_subsumed_raft_id(from.subsumed_raft_id());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ChangeReplicasTrigger::CopyFrom(const ::google::protobuf::Message& from) {
+void MergeTrigger::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ChangeReplicasTrigger::CopyFrom(const ChangeReplicasTrigger& from) {
+void MergeTrigger::CopyFrom(const MergeTrigger& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ChangeReplicasTrigger::IsInitialized() const {
+bool MergeTrigger::IsInitialized() const {
 
   return true;
 }
 
-void ChangeReplicasTrigger::Swap(ChangeReplicasTrigger* other) {
+void MergeTrigger::Swap(MergeTrigger* other) {
   if (other != this) {
-    std::swap(node_id_, other->node_id_);
-    std::swap(store_id_, other->store_id_);
-    std::swap(change_type_, other->change_type_);
-    updated_replicas_.Swap(&other->updated_replicas_);
+    std::swap(updated_desc_, other->updated_desc_);
+    std::swap(subsumed_raft_id_, other->subsumed_raft_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ChangeReplicasTrigger::GetMetadata() const {
+::google::protobuf::Metadata MergeTrigger::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ChangeReplicasTrigger_descriptor_;
-  metadata.reflection = ChangeReplicasTrigger_reflection_;
+  metadata.descriptor = MergeTrigger_descriptor_;
+  metadata.reflection = MergeTrigger_reflection_;
   return metadata;
 }
 
@@ -3431,149 +3660,160 @@ void ChangeReplicasTrigger::Swap(ChangeReplicasTrigger* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int InternalCommitTrigger::kSplitTriggerFieldNumber;
-const int InternalCommitTrigger::kMergeTriggerFieldNumber;
-const int InternalCommitTrigger::kChangeReplicasTriggerFieldNumber;
-const int InternalCommitTrigger::kIntentsFieldNumber;
+const int ChangeReplicasTrigger::kNodeIdFieldNumber;
+const int ChangeReplicasTrigger::kStoreIdFieldNumber;
+const int ChangeReplicasTrigger::kChangeTypeFieldNumber;
+const int ChangeReplicasTrigger::kUpdatedReplicasFieldNumber;
 #endif  // !_MSC_VER
 
-InternalCommitTrigger::InternalCommitTrigger()
+ChangeReplicasTrigger::ChangeReplicasTrigger()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ChangeReplicasTrigger)
 }
 
-void InternalCommitTrigger::InitAsDefaultInstance() {
-  split_trigger_ = const_cast< ::cockroach::proto::SplitTrigger*>(&::cockroach::proto::SplitTrigger::default_instance());
-  merge_trigger_ = const_cast< ::cockroach::proto::MergeTrigger*>(&::cockroach::proto::MergeTrigger::default_instance());
-  change_replicas_trigger_ = const_cast< ::cockroach::proto::ChangeReplicasTrigger*>(&::cockroach::proto::ChangeReplicasTrigger::default_instance());
+void ChangeReplicasTrigger::InitAsDefaultInstance() {
 }
 
-InternalCommitTrigger::InternalCommitTrigger(const InternalCommitTrigger& from)
+ChangeReplicasTrigger::ChangeReplicasTrigger(const ChangeReplicasTrigger& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ChangeReplicasTrigger)
 }
 
-void InternalCommitTrigger::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void ChangeReplicasTrigger::SharedCtor() {
   _cached_size_ = 0;
-  split_trigger_ = NULL;
-  merge_trigger_ = NULL;
-  change_replicas_trigger_ = NULL;
+  node_id_ = 0;
+  store_id_ = 0;
+  change_type_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-InternalCommitTrigger::~InternalCommitTrigger() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.InternalCommitTrigger)
+ChangeReplicasTrigger::~ChangeReplicasTrigger() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ChangeReplicasTrigger)
   SharedDtor();
 }
 
-void InternalCommitTrigger::SharedDtor() {
+void ChangeReplicasTrigger::SharedDtor() {
   if (this != default_instance_) {
-    delete split_trigger_;
-    delete merge_trigger_;
-    delete change_replicas_trigger_;
   }
 }
 
-void InternalCommitTrigger::SetCachedSize(int size) const {
+void ChangeReplicasTrigger::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* InternalCommitTrigger::descriptor() {
+const ::google::protobuf::Descriptor* ChangeReplicasTrigger::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return InternalCommitTrigger_descriptor_;
+  return ChangeReplicasTrigger_descriptor_;
 }
 
-const InternalCommitTrigger& InternalCommitTrigger::default_instance() {
+const ChangeReplicasTrigger& ChangeReplicasTrigger::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-InternalCommitTrigger* InternalCommitTrigger::default_instance_ = NULL;
+ChangeReplicasTrigger* ChangeReplicasTrigger::default_instance_ = NULL;
 
-InternalCommitTrigger* InternalCommitTrigger::New() const {
-  return new InternalCommitTrigger;
+ChangeReplicasTrigger* ChangeReplicasTrigger::New() const {
+  return new ChangeReplicasTrigger;
 }
 
-void InternalCommitTrigger::Clear() {
+void ChangeReplicasTrigger::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<ChangeReplicasTrigger*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
   if (_has_bits_[0 / 32] & 7) {
-    if (has_split_trigger()) {
-      if (split_trigger_ != NULL) split_trigger_->::cockroach::proto::SplitTrigger::Clear();
-    }
-    if (has_merge_trigger()) {
-      if (merge_trigger_ != NULL) merge_trigger_->::cockroach::proto::MergeTrigger::Clear();
-    }
-    if (has_change_replicas_trigger()) {
-      if (change_replicas_trigger_ != NULL) change_replicas_trigger_->::cockroach::proto::ChangeReplicasTrigger::Clear();
-    }
+    ZR_(node_id_, store_id_);
+    change_type_ = 0;
   }
-  intents_.Clear();
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  updated_replicas_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool InternalCommitTrigger::MergePartialFromCodedStream(
+bool ChangeReplicasTrigger::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ChangeReplicasTrigger)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.SplitTrigger split_trigger = 1;
+      // optional int32 node_id = 1;
       case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_split_trigger()));
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &node_id_)));
+          set_has_node_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_merge_trigger;
+        if (input->ExpectTag(16)) goto parse_store_id;
         break;
       }
 
-      // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
+      // optional int32 store_id = 2;
       case 2: {
-        if (tag == 18) {
-         parse_merge_trigger:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_merge_trigger()));
+        if (tag == 16) {
+         parse_store_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &store_id_)));
+          set_has_store_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_change_replicas_trigger;
+        if (input->ExpectTag(24)) goto parse_change_type;
         break;
       }
 
-      // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
+      // optional .cockroach.proto.ReplicaChangeType change_type = 3;
       case 3: {
-        if (tag == 26) {
-         parse_change_replicas_trigger:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_change_replicas_trigger()));
+        if (tag == 24) {
+         parse_change_type:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::ReplicaChangeType_IsValid(value)) {
+            set_change_type(static_cast< ::cockroach::proto::ReplicaChangeType >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(3, value);
+          }
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(34)) goto parse_intents;
+        if (input->ExpectTag(34)) goto parse_updated_replicas;
         break;
       }
 
-      // repeated bytes intents = 4;
+      // repeated .cockroach.proto.Replica updated_replicas = 4;
       case 4: {
         if (tag == 34) {
-         parse_intents:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->add_intents()));
+         parse_updated_replicas:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_updated_replicas()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(34)) goto parse_intents;
+        if (input->ExpectTag(34)) goto parse_updated_replicas;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3592,117 +3832,111 @@ bool InternalCommitTrigger::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ChangeReplicasTrigger)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ChangeReplicasTrigger)
   return false;
 #undef DO_
 }
 
-void InternalCommitTrigger::SerializeWithCachedSizes(
+void ChangeReplicasTrigger::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalCommitTrigger)
-  // optional .cockroach.proto.SplitTrigger split_trigger = 1;
-  if (has_split_trigger()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->split_trigger(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ChangeReplicasTrigger)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->node_id(), output);
   }
 
-  // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
-  if (has_merge_trigger()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->merge_trigger(), output);
+  // optional int32 store_id = 2;
+  if (has_store_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->store_id(), output);
   }
 
-  // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
-  if (has_change_replicas_trigger()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->change_replicas_trigger(), output);
+  // optional .cockroach.proto.ReplicaChangeType change_type = 3;
+  if (has_change_type()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      3, this->change_type(), output);
   }
 
-  // repeated bytes intents = 4;
-  for (int i = 0; i < this->intents_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytes(
-      4, this->intents(i), output);
+  // repeated .cockroach.proto.Replica updated_replicas = 4;
+  for (int i = 0; i < this->updated_replicas_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->updated_replicas(i), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ChangeReplicasTrigger)
 }
 
-::google::protobuf::uint8* InternalCommitTrigger::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ChangeReplicasTrigger::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalCommitTrigger)
-  // optional .cockroach.proto.SplitTrigger split_trigger = 1;
-  if (has_split_trigger()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->split_trigger(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ChangeReplicasTrigger)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->node_id(), target);
   }
 
-  // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
-  if (has_merge_trigger()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->merge_trigger(), target);
+  // optional int32 store_id = 2;
+  if (has_store_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->store_id(), target);
   }
 
-  // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
-  if (has_change_replicas_trigger()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->change_replicas_trigger(), target);
+  // optional .cockroach.proto.ReplicaChangeType change_type = 3;
+  if (has_change_type()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      3, this->change_type(), target);
   }
 
-  // repeated bytes intents = 4;
-  for (int i = 0; i < this->intents_size(); i++) {
+  // repeated .cockroach.proto.Replica updated_replicas = 4;
+  for (int i = 0; i < this->updated_replicas_size(); i++) {
     target = ::google::protobuf::internal::WireFormatLite::
-      WriteBytesToArray(4, this->intents(i), target);
+      WriteMessageNoVirtualToArray(
+        4, this->updated_replicas(i), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalCommitTrigger)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ChangeReplicasTrigger)
   return target;
 }
 
-int InternalCommitTrigger::ByteSize() const {
+int ChangeReplicasTrigger::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .cockroach.proto.SplitTrigger split_trigger = 1;
-    if (has_split_trigger()) {
+    // optional int32 node_id = 1;
+    if (has_node_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->split_trigger());
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
     }
 
-    // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
-    if (has_merge_trigger()) {
+    // optional int32 store_id = 2;
+    if (has_store_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->merge_trigger());
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->store_id());
     }
 
-    // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
-    if (has_change_replicas_trigger()) {
+    // optional .cockroach.proto.ReplicaChangeType change_type = 3;
+    if (has_change_type()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->change_replicas_trigger());
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->change_type());
     }
 
   }
-  // repeated bytes intents = 4;
-  total_size += 1 * this->intents_size();
-  for (int i = 0; i < this->intents_size(); i++) {
-    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
-      this->intents(i));
+  // repeated .cockroach.proto.Replica updated_replicas = 4;
+  total_size += 1 * this->updated_replicas_size();
+  for (int i = 0; i < this->updated_replicas_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->updated_replicas(i));
   }
 
   if (!unknown_fields().empty()) {
@@ -3716,10 +3950,10 @@ int InternalCommitTrigger::ByteSize() const {
   return total_size;
 }
 
-void InternalCommitTrigger::MergeFrom(const ::google::protobuf::Message& from) {
+void ChangeReplicasTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const InternalCommitTrigger* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const InternalCommitTrigger*>(
+  const ChangeReplicasTrigger* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ChangeReplicasTrigger*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3728,57 +3962,57 @@ void InternalCommitTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void InternalCommitTrigger::MergeFrom(const InternalCommitTrigger& from) {
+void ChangeReplicasTrigger::MergeFrom(const ChangeReplicasTrigger& from) {
   GOOGLE_CHECK_NE(&from, this);
-  intents_.MergeFrom(from.intents_);
+  updated_replicas_.MergeFrom(from.updated_replicas_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_split_trigger()) {
-      mutable_split_trigger()->::cockroach::proto::SplitTrigger::MergeFrom(from.split_trigger());
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
     }
-    if (from.has_merge_trigger()) {
-      mutable_merge_trigger()->::cockroach::proto::MergeTrigger::MergeFrom(from.merge_trigger());
+    if (from.has_store_id()) {
+      set_store_id(from.store_id());
     }
-    if (from.has_change_replicas_trigger()) {
-      mutable_change_replicas_trigger()->::cockroach::proto::ChangeReplicasTrigger::MergeFrom(from.change_replicas_trigger());
+    if (from.has_change_type()) {
+      set_change_type(from.change_type());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void InternalCommitTrigger::CopyFrom(const ::google::protobuf::Message& from) {
+void ChangeReplicasTrigger::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void InternalCommitTrigger::CopyFrom(const InternalCommitTrigger& from) {
+void ChangeReplicasTrigger::CopyFrom(const ChangeReplicasTrigger& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool InternalCommitTrigger::IsInitialized() const {
+bool ChangeReplicasTrigger::IsInitialized() const {
 
   return true;
 }
 
-void InternalCommitTrigger::Swap(InternalCommitTrigger* other) {
+void ChangeReplicasTrigger::Swap(ChangeReplicasTrigger* other) {
   if (other != this) {
-    std::swap(split_trigger_, other->split_trigger_);
-    std::swap(merge_trigger_, other->merge_trigger_);
-    std::swap(change_replicas_trigger_, other->change_replicas_trigger_);
-    intents_.Swap(&other->intents_);
+    std::swap(node_id_, other->node_id_);
+    std::swap(store_id_, other->store_id_);
+    std::swap(change_type_, other->change_type_);
+    updated_replicas_.Swap(&other->updated_replicas_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata InternalCommitTrigger::GetMetadata() const {
+::google::protobuf::Metadata ChangeReplicasTrigger::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = InternalCommitTrigger_descriptor_;
-  metadata.reflection = InternalCommitTrigger_reflection_;
+  metadata.descriptor = ChangeReplicasTrigger_descriptor_;
+  metadata.reflection = ChangeReplicasTrigger_reflection_;
   return metadata;
 }
 
@@ -3786,90 +4020,149 @@ void InternalCommitTrigger::Swap(InternalCommitTrigger* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int NodeList::kNodesFieldNumber;
+const int InternalCommitTrigger::kSplitTriggerFieldNumber;
+const int InternalCommitTrigger::kMergeTriggerFieldNumber;
+const int InternalCommitTrigger::kChangeReplicasTriggerFieldNumber;
+const int InternalCommitTrigger::kIntentsFieldNumber;
 #endif  // !_MSC_VER
 
-NodeList::NodeList()
+InternalCommitTrigger::InternalCommitTrigger()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalCommitTrigger)
 }
 
-void NodeList::InitAsDefaultInstance() {
+void InternalCommitTrigger::InitAsDefaultInstance() {
+  split_trigger_ = const_cast< ::cockroach::proto::SplitTrigger*>(&::cockroach::proto::SplitTrigger::default_instance());
+  merge_trigger_ = const_cast< ::cockroach::proto::MergeTrigger*>(&::cockroach::proto::MergeTrigger::default_instance());
+  change_replicas_trigger_ = const_cast< ::cockroach::proto::ChangeReplicasTrigger*>(&::cockroach::proto::ChangeReplicasTrigger::default_instance());
 }
 
-NodeList::NodeList(const NodeList& from)
+InternalCommitTrigger::InternalCommitTrigger(const InternalCommitTrigger& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalCommitTrigger)
 }
 
-void NodeList::SharedCtor() {
+void InternalCommitTrigger::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
+  split_trigger_ = NULL;
+  merge_trigger_ = NULL;
+  change_replicas_trigger_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-NodeList::~NodeList() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.NodeList)
+InternalCommitTrigger::~InternalCommitTrigger() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalCommitTrigger)
   SharedDtor();
 }
 
-void NodeList::SharedDtor() {
+void InternalCommitTrigger::SharedDtor() {
   if (this != default_instance_) {
+    delete split_trigger_;
+    delete merge_trigger_;
+    delete change_replicas_trigger_;
   }
 }
 
-void NodeList::SetCachedSize(int size) const {
+void InternalCommitTrigger::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* NodeList::descriptor() {
+const ::google::protobuf::Descriptor* InternalCommitTrigger::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return NodeList_descriptor_;
+  return InternalCommitTrigger_descriptor_;
 }
 
-const NodeList& NodeList::default_instance() {
+const InternalCommitTrigger& InternalCommitTrigger::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-NodeList* NodeList::default_instance_ = NULL;
+InternalCommitTrigger* InternalCommitTrigger::default_instance_ = NULL;
 
-NodeList* NodeList::New() const {
-  return new NodeList;
+InternalCommitTrigger* InternalCommitTrigger::New() const {
+  return new InternalCommitTrigger;
 }
 
-void NodeList::Clear() {
-  nodes_.Clear();
+void InternalCommitTrigger::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_split_trigger()) {
+      if (split_trigger_ != NULL) split_trigger_->::cockroach::proto::SplitTrigger::Clear();
+    }
+    if (has_merge_trigger()) {
+      if (merge_trigger_ != NULL) merge_trigger_->::cockroach::proto::MergeTrigger::Clear();
+    }
+    if (has_change_replicas_trigger()) {
+      if (change_replicas_trigger_ != NULL) change_replicas_trigger_->::cockroach::proto::ChangeReplicasTrigger::Clear();
+    }
+  }
+  intents_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool NodeList::MergePartialFromCodedStream(
+bool InternalCommitTrigger::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalCommitTrigger)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // repeated int32 nodes = 1 [packed = true];
+      // optional .cockroach.proto.SplitTrigger split_trigger = 1;
       case 1: {
         if (tag == 10) {
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, this->mutable_nodes())));
-        } else if (tag == 8) {
-          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitiveNoInline<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 1, 10, input, this->mutable_nodes())));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_split_trigger()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_merge_trigger;
+        break;
+      }
+
+      // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_merge_trigger:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_merge_trigger()));
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(26)) goto parse_change_replicas_trigger;
+        break;
+      }
+
+      // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_change_replicas_trigger:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_change_replicas_trigger()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_intents;
+        break;
+      }
+
+      // repeated bytes intents = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_intents:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_intents()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_intents;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3888,77 +4181,117 @@ bool NodeList::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalCommitTrigger)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalCommitTrigger)
   return false;
 #undef DO_
 }
 
-void NodeList::SerializeWithCachedSizes(
+void InternalCommitTrigger::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.NodeList)
-  // repeated int32 nodes = 1 [packed = true];
-  if (this->nodes_size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteTag(1, ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED, output);
-    output->WriteVarint32(_nodes_cached_byte_size_);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalCommitTrigger)
+  // optional .cockroach.proto.SplitTrigger split_trigger = 1;
+  if (has_split_trigger()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->split_trigger(), output);
   }
-  for (int i = 0; i < this->nodes_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32NoTag(
-      this->nodes(i), output);
+
+  // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
+  if (has_merge_trigger()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->merge_trigger(), output);
+  }
+
+  // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
+  if (has_change_replicas_trigger()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->change_replicas_trigger(), output);
+  }
+
+  // repeated bytes intents = 4;
+  for (int i = 0; i < this->intents_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      4, this->intents(i), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalCommitTrigger)
 }
 
-::google::protobuf::uint8* NodeList::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* InternalCommitTrigger::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.NodeList)
-  // repeated int32 nodes = 1 [packed = true];
-  if (this->nodes_size() > 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteTagToArray(
-      1,
-      ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED,
-      target);
-    target = ::google::protobuf::io::CodedOutputStream::WriteVarint32ToArray(
-      _nodes_cached_byte_size_, target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalCommitTrigger)
+  // optional .cockroach.proto.SplitTrigger split_trigger = 1;
+  if (has_split_trigger()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->split_trigger(), target);
   }
-  for (int i = 0; i < this->nodes_size(); i++) {
+
+  // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
+  if (has_merge_trigger()) {
     target = ::google::protobuf::internal::WireFormatLite::
-      WriteInt32NoTagToArray(this->nodes(i), target);
+      WriteMessageNoVirtualToArray(
+        2, this->merge_trigger(), target);
+  }
+
+  // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
+  if (has_change_replicas_trigger()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->change_replicas_trigger(), target);
+  }
+
+  // repeated bytes intents = 4;
+  for (int i = 0; i < this->intents_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(4, this->intents(i), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.NodeList)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalCommitTrigger)
   return target;
 }
 
-int NodeList::ByteSize() const {
+int InternalCommitTrigger::ByteSize() const {
   int total_size = 0;
 
-  // repeated int32 nodes = 1 [packed = true];
-  {
-    int data_size = 0;
-    for (int i = 0; i < this->nodes_size(); i++) {
-      data_size += ::google::protobuf::internal::WireFormatLite::
-        Int32Size(this->nodes(i));
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.SplitTrigger split_trigger = 1;
+    if (has_split_trigger()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->split_trigger());
     }
-    if (data_size > 0) {
+
+    // optional .cockroach.proto.MergeTrigger merge_trigger = 2;
+    if (has_merge_trigger()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(data_size);
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->merge_trigger());
     }
-    GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
-    _nodes_cached_byte_size_ = data_size;
-    GOOGLE_SAFE_CONCURRENT_WRITES_END();
-    total_size += data_size;
+
+    // optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
+    if (has_change_replicas_trigger()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->change_replicas_trigger());
+    }
+
+  }
+  // repeated bytes intents = 4;
+  total_size += 1 * this->intents_size();
+  for (int i = 0; i < this->intents_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->intents(i));
   }
 
   if (!unknown_fields().empty()) {
@@ -3972,10 +4305,10 @@ int NodeList::ByteSize() const {
   return total_size;
 }
 
-void NodeList::MergeFrom(const ::google::protobuf::Message& from) {
+void InternalCommitTrigger::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const NodeList* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const NodeList*>(
+  const InternalCommitTrigger* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalCommitTrigger*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3984,43 +4317,57 @@ void NodeList::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void NodeList::MergeFrom(const NodeList& from) {
+void InternalCommitTrigger::MergeFrom(const InternalCommitTrigger& from) {
   GOOGLE_CHECK_NE(&from, this);
-  nodes_.MergeFrom(from.nodes_);
+  intents_.MergeFrom(from.intents_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_split_trigger()) {
+      mutable_split_trigger()->::cockroach::proto::SplitTrigger::MergeFrom(from.split_trigger());
+    }
+    if (from.has_merge_trigger()) {
+      mutable_merge_trigger()->::cockroach::proto::MergeTrigger::MergeFrom(from.merge_trigger());
+    }
+    if (from.has_change_replicas_trigger()) {
+      mutable_change_replicas_trigger()->::cockroach::proto::ChangeReplicasTrigger::MergeFrom(from.change_replicas_trigger());
+    }
+  }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void NodeList::CopyFrom(const ::google::protobuf::Message& from) {
+void InternalCommitTrigger::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void NodeList::CopyFrom(const NodeList& from) {
+void InternalCommitTrigger::CopyFrom(const InternalCommitTrigger& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool NodeList::IsInitialized() const {
+bool InternalCommitTrigger::IsInitialized() const {
 
   return true;
 }
 
-void NodeList::Swap(NodeList* other) {
+void InternalCommitTrigger::Swap(InternalCommitTrigger* other) {
   if (other != this) {
-    nodes_.Swap(&other->nodes_);
+    std::swap(split_trigger_, other->split_trigger_);
+    std::swap(merge_trigger_, other->merge_trigger_);
+    std::swap(change_replicas_trigger_, other->change_replicas_trigger_);
+    intents_.Swap(&other->intents_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata NodeList::GetMetadata() const {
+::google::protobuf::Metadata InternalCommitTrigger::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = NodeList_descriptor_;
-  metadata.reflection = NodeList_reflection_;
+  metadata.descriptor = InternalCommitTrigger_descriptor_;
+  metadata.reflection = InternalCommitTrigger_reflection_;
   return metadata;
 }
 
@@ -4028,338 +4375,2716 @@ void NodeList::Swap(NodeList* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int Transaction::kNameFieldNumber;
-const int Transaction::kKeyFieldNumber;
-const int Transaction::kIdFieldNumber;
-const int Transaction::kPriorityFieldNumber;
-const int Transaction::kIsolationFieldNumber;
-const int Transaction::kStatusFieldNumber;
-const int Transaction::kEpochFieldNumber;
-const int Transaction::kLastHeartbeatFieldNumber;
-const int Transaction::kTimestampFieldNumber;
-const int Transaction::kOrigTimestampFieldNumber;
-const int Transaction::kMaxTimestampFieldNumber;
-const int Transaction::kCertainNodesFieldNumber;
+const int NodeList::kNodesFieldNumber;
 #endif  // !_MSC_VER
 
-Transaction::Transaction()
+NodeList::NodeList()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(constructor:cockroach.proto.NodeList)
 }
 
-void Transaction::InitAsDefaultInstance() {
-  last_heartbeat_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  orig_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  max_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  certain_nodes_ = const_cast< ::cockroach::proto::NodeList*>(&::cockroach::proto::NodeList::default_instance());
+void NodeList::InitAsDefaultInstance() {
 }
 
-Transaction::Transaction(const Transaction& from)
+NodeList::NodeList(const NodeList& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.NodeList)
 }
 
-void Transaction::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void NodeList::SharedCtor() {
   _cached_size_ = 0;
-  name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  priority_ = 0;
-  isolation_ = 0;
-  status_ = 0;
-  epoch_ = 0;
-  last_heartbeat_ = NULL;
-  timestamp_ = NULL;
-  orig_timestamp_ = NULL;
-  max_timestamp_ = NULL;
-  certain_nodes_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-Transaction::~Transaction() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.Transaction)
+NodeList::~NodeList() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.NodeList)
   SharedDtor();
 }
 
-void Transaction::SharedDtor() {
-  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete name_;
-  }
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
-  }
-  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete id_;
-  }
+void NodeList::SharedDtor() {
   if (this != default_instance_) {
-    delete last_heartbeat_;
-    delete timestamp_;
-    delete orig_timestamp_;
-    delete max_timestamp_;
-    delete certain_nodes_;
   }
 }
 
-void Transaction::SetCachedSize(int size) const {
+void NodeList::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* Transaction::descriptor() {
+const ::google::protobuf::Descriptor* NodeList::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return Transaction_descriptor_;
+  return NodeList_descriptor_;
 }
 
-const Transaction& Transaction::default_instance() {
+const NodeList& NodeList::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-Transaction* Transaction::default_instance_ = NULL;
+NodeList* NodeList::default_instance_ = NULL;
 
-Transaction* Transaction::New() const {
-  return new Transaction;
+NodeList* NodeList::New() const {
+  return new NodeList;
 }
 
-void Transaction::Clear() {
-#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<Transaction*>(16)->f) - \
-   reinterpret_cast<char*>(16))
-
-#define ZR_(first, last) do {                              \
-    size_t f = OFFSET_OF_FIELD_(first);                    \
-    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
-    ::memset(&first, 0, n);                                \
-  } while (0)
-
-  if (_has_bits_[0 / 32] & 255) {
-    ZR_(priority_, epoch_);
-    if (has_name()) {
-      if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        name_->clear();
-      }
-    }
-    if (has_key()) {
-      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        key_->clear();
-      }
-    }
-    if (has_id()) {
-      if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        id_->clear();
-      }
-    }
-    if (has_last_heartbeat()) {
-      if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
-    }
-  }
-  if (_has_bits_[8 / 32] & 3840) {
-    if (has_timestamp()) {
-      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    if (has_orig_timestamp()) {
-      if (orig_timestamp_ != NULL) orig_timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    if (has_max_timestamp()) {
-      if (max_timestamp_ != NULL) max_timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    if (has_certain_nodes()) {
-      if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
-    }
-  }
-
-#undef OFFSET_OF_FIELD_
-#undef ZR_
-
+void NodeList::Clear() {
+  nodes_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool Transaction::MergePartialFromCodedStream(
+bool NodeList::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.NodeList)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional string name = 1;
+      // repeated int32 nodes = 1 [packed = true];
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_name()));
-          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, this->mutable_nodes())));
+        } else if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitiveNoInline<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 1, 10, input, this->mutable_nodes())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.NodeList)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.NodeList)
+  return false;
+#undef DO_
+}
+
+void NodeList::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.NodeList)
+  // repeated int32 nodes = 1 [packed = true];
+  if (this->nodes_size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteTag(1, ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED, output);
+    output->WriteVarint32(_nodes_cached_byte_size_);
+  }
+  for (int i = 0; i < this->nodes_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32NoTag(
+      this->nodes(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.NodeList)
+}
+
+::google::protobuf::uint8* NodeList::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.NodeList)
+  // repeated int32 nodes = 1 [packed = true];
+  if (this->nodes_size() > 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteTagToArray(
+      1,
+      ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED,
+      target);
+    target = ::google::protobuf::io::CodedOutputStream::WriteVarint32ToArray(
+      _nodes_cached_byte_size_, target);
+  }
+  for (int i = 0; i < this->nodes_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteInt32NoTagToArray(this->nodes(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.NodeList)
+  return target;
+}
+
+int NodeList::ByteSize() const {
+  int total_size = 0;
+
+  // repeated int32 nodes = 1 [packed = true];
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->nodes_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        Int32Size(this->nodes(i));
+    }
+    if (data_size > 0) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(data_size);
+    }
+    GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+    _nodes_cached_byte_size_ = data_size;
+    GOOGLE_SAFE_CONCURRENT_WRITES_END();
+    total_size += data_size;
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void NodeList::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const NodeList* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const NodeList*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void NodeList::MergeFrom(const NodeList& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  nodes_.MergeFrom(from.nodes_);
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void NodeList::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void NodeList::CopyFrom(const NodeList& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool NodeList::IsInitialized() const {
+
+  return true;
+}
+
+void NodeList::Swap(NodeList* other) {
+  if (other != this) {
+    nodes_.Swap(&other->nodes_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata NodeList::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = NodeList_descriptor_;
+  metadata.reflection = NodeList_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Transaction::kNameFieldNumber;
+const int Transaction::kKeyFieldNumber;
+const int Transaction::kIdFieldNumber;
+const int Transaction::kPriorityFieldNumber;
+const int Transaction::kIsolationFieldNumber;
+const int Transaction::kStatusFieldNumber;
+const int Transaction::kEpochFieldNumber;
+const int Transaction::kLastHeartbeatFieldNumber;
+const int Transaction::kTimestampFieldNumber;
+const int Transaction::kOrigTimestampFieldNumber;
+const int Transaction::kMaxTimestampFieldNumber;
+const int Transaction::kCertainNodesFieldNumber;
+const int Transaction::kSequenceFieldNumber;
+const int Transaction::kIgnoredSequencesFieldNumber;
+#endif  // !_MSC_VER
+
+Transaction::Transaction()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.Transaction)
+}
+
+void Transaction::InitAsDefaultInstance() {
+  last_heartbeat_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  orig_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  max_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  certain_nodes_ = const_cast< ::cockroach::proto::NodeList*>(&::cockroach::proto::NodeList::default_instance());
+}
+
+Transaction::Transaction(const Transaction& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Transaction)
+}
+
+void Transaction::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  priority_ = 0;
+  isolation_ = 0;
+  status_ = 0;
+  epoch_ = 0;
+  last_heartbeat_ = NULL;
+  timestamp_ = NULL;
+  orig_timestamp_ = NULL;
+  max_timestamp_ = NULL;
+  certain_nodes_ = NULL;
+  sequence_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Transaction::~Transaction() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Transaction)
+  SharedDtor();
+}
+
+void Transaction::SharedDtor() {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete name_;
+  }
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete id_;
+  }
+  if (this != default_instance_) {
+    delete last_heartbeat_;
+    delete timestamp_;
+    delete orig_timestamp_;
+    delete max_timestamp_;
+    delete certain_nodes_;
+  }
+}
+
+void Transaction::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Transaction::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Transaction_descriptor_;
+}
+
+const Transaction& Transaction::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+Transaction* Transaction::default_instance_ = NULL;
+
+Transaction* Transaction::New() const {
+  return new Transaction;
+}
+
+void Transaction::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<Transaction*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 255) {
+    ZR_(priority_, epoch_);
+    if (has_name()) {
+      if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        name_->clear();
+      }
+    }
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_id()) {
+      if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        id_->clear();
+      }
+    }
+    if (has_last_heartbeat()) {
+      if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  if (_has_bits_[8 / 32] & 7936) {
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_orig_timestamp()) {
+      if (orig_timestamp_ != NULL) orig_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_max_timestamp()) {
+      if (max_timestamp_ != NULL) max_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_certain_nodes()) {
+      if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
+    }
+    sequence_ = 0;
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ignored_sequences_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Transaction::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Transaction)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string name = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
             this->name().data(), this->name().length(),
             ::google::protobuf::internal::WireFormat::PARSE,
             "name");
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_key;
+        if (input->ExpectTag(18)) goto parse_key;
+        break;
+      }
+
+      // optional bytes key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_id;
+        break;
+      }
+
+      // optional bytes id = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_id()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_priority;
+        break;
+      }
+
+      // optional int32 priority = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_priority:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &priority_)));
+          set_has_priority();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_isolation;
+        break;
+      }
+
+      // optional .cockroach.proto.IsolationType isolation = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_isolation:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::IsolationType_IsValid(value)) {
+            set_isolation(static_cast< ::cockroach::proto::IsolationType >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(5, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_status;
+        break;
+      }
+
+      // optional .cockroach.proto.TransactionStatus status = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_status:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::TransactionStatus_IsValid(value)) {
+            set_status(static_cast< ::cockroach::proto::TransactionStatus >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(6, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_epoch;
+        break;
+      }
+
+      // optional int32 epoch = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_epoch:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &epoch_)));
+          set_has_epoch();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_last_heartbeat;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_last_heartbeat:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_last_heartbeat()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp timestamp = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_orig_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_orig_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_orig_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_max_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp max_timestamp = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_max_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_max_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_certain_nodes;
+        break;
+      }
+
+      // optional .cockroach.proto.NodeList certain_nodes = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_certain_nodes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_certain_nodes()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(104)) goto parse_sequence;
+        break;
+      }
+
+      // optional int32 sequence = 13;
+      case 13: {
+        if (tag == 104) {
+         parse_sequence:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &sequence_)));
+          set_has_sequence();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_ignored_sequences;
+        break;
+      }
+
+      // repeated .cockroach.proto.SequenceRange ignored_sequences = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_ignored_sequences:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_ignored_sequences()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_ignored_sequences;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Transaction)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Transaction)
+  return false;
+#undef DO_
+}
+
+void Transaction::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Transaction)
+  // optional string name = 1;
+  if (has_name()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->name().data(), this->name().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
+  }
+
+  // optional bytes key = 2;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->key(), output);
+  }
+
+  // optional bytes id = 3;
+  if (has_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->id(), output);
+  }
+
+  // optional int32 priority = 4;
+  if (has_priority()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->priority(), output);
+  }
+
+  // optional .cockroach.proto.IsolationType isolation = 5;
+  if (has_isolation()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      5, this->isolation(), output);
+  }
+
+  // optional .cockroach.proto.TransactionStatus status = 6;
+  if (has_status()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      6, this->status(), output);
+  }
+
+  // optional int32 epoch = 7;
+  if (has_epoch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(7, this->epoch(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+  if (has_last_heartbeat()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->last_heartbeat(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 9;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->timestamp(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+  if (has_orig_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->orig_timestamp(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp max_timestamp = 11;
+  if (has_max_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->max_timestamp(), output);
+  }
+
+  // optional .cockroach.proto.NodeList certain_nodes = 12;
+  if (has_certain_nodes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, this->certain_nodes(), output);
+  }
+
+  // optional int32 sequence = 13;
+  if (has_sequence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(13, this->sequence(), output);
+  }
+
+  // repeated .cockroach.proto.SequenceRange ignored_sequences = 14;
+  for (int i = 0; i < this->ignored_sequences_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->ignored_sequences(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Transaction)
+}
+
+::google::protobuf::uint8* Transaction::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Transaction)
+  // optional string name = 1;
+  if (has_name()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->name().data(), this->name().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "name");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->name(), target);
+  }
+
+  // optional bytes key = 2;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->key(), target);
+  }
+
+  // optional bytes id = 3;
+  if (has_id()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->id(), target);
+  }
+
+  // optional int32 priority = 4;
+  if (has_priority()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(4, this->priority(), target);
+  }
+
+  // optional .cockroach.proto.IsolationType isolation = 5;
+  if (has_isolation()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      5, this->isolation(), target);
+  }
+
+  // optional .cockroach.proto.TransactionStatus status = 6;
+  if (has_status()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      6, this->status(), target);
+  }
+
+  // optional int32 epoch = 7;
+  if (has_epoch()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(7, this->epoch(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+  if (has_last_heartbeat()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->last_heartbeat(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 9;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->timestamp(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+  if (has_orig_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->orig_timestamp(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp max_timestamp = 11;
+  if (has_max_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->max_timestamp(), target);
+  }
+
+  // optional .cockroach.proto.NodeList certain_nodes = 12;
+  if (has_certain_nodes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, this->certain_nodes(), target);
+  }
+
+  // optional int32 sequence = 13;
+  if (has_sequence()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(13, this->sequence(), target);
+  }
+
+  // repeated .cockroach.proto.SequenceRange ignored_sequences = 14;
+  for (int i = 0; i < this->ignored_sequences_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->ignored_sequences(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Transaction)
+  return target;
+}
+
+int Transaction::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string name = 1;
+    if (has_name()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->name());
+    }
+
+    // optional bytes key = 2;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional bytes id = 3;
+    if (has_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->id());
+    }
+
+    // optional int32 priority = 4;
+    if (has_priority()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->priority());
+    }
+
+    // optional .cockroach.proto.IsolationType isolation = 5;
+    if (has_isolation()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->isolation());
+    }
+
+    // optional .cockroach.proto.TransactionStatus status = 6;
+    if (has_status()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->status());
+    }
+
+    // optional int32 epoch = 7;
+    if (has_epoch()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->epoch());
+    }
+
+    // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+    if (has_last_heartbeat()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->last_heartbeat());
+    }
+
+  }
+  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    // optional .cockroach.proto.Timestamp timestamp = 9;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+    if (has_orig_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->orig_timestamp());
+    }
+
+    // optional .cockroach.proto.Timestamp max_timestamp = 11;
+    if (has_max_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->max_timestamp());
+    }
+
+    // optional .cockroach.proto.NodeList certain_nodes = 12;
+    if (has_certain_nodes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->certain_nodes());
+    }
+
+    // optional int32 sequence = 13;
+    if (has_sequence()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->sequence());
+    }
+
+  }
+  // repeated .cockroach.proto.SequenceRange ignored_sequences = 14;
+  total_size += 1 * this->ignored_sequences_size();
+  for (int i = 0; i < this->ignored_sequences_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->ignored_sequences(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const Transaction* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Transaction*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void Transaction::MergeFrom(const Transaction& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  ignored_sequences_.MergeFrom(from.ignored_sequences_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_name()) {
+      set_name(from.name());
+    }
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_id()) {
+      set_id(from.id());
+    }
+    if (from.has_priority()) {
+      set_priority(from.priority());
+    }
+    if (from.has_isolation()) {
+      set_isolation(from.isolation());
+    }
+    if (from.has_status()) {
+      set_status(from.status());
+    }
+    if (from.has_epoch()) {
+      set_epoch(from.epoch());
+    }
+    if (from.has_last_heartbeat()) {
+      mutable_last_heartbeat()->::cockroach::proto::Timestamp::MergeFrom(from.last_heartbeat());
+    }
+  }
+  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_orig_timestamp()) {
+      mutable_orig_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.orig_timestamp());
+    }
+    if (from.has_max_timestamp()) {
+      mutable_max_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.max_timestamp());
+    }
+    if (from.has_certain_nodes()) {
+      mutable_certain_nodes()->::cockroach::proto::NodeList::MergeFrom(from.certain_nodes());
+    }
+    if (from.has_sequence()) {
+      set_sequence(from.sequence());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void Transaction::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Transaction::CopyFrom(const Transaction& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Transaction::IsInitialized() const {
+
+  return true;
+}
+
+void Transaction::Swap(Transaction* other) {
+  if (other != this) {
+    std::swap(name_, other->name_);
+    std::swap(key_, other->key_);
+    std::swap(id_, other->id_);
+    std::swap(priority_, other->priority_);
+    std::swap(isolation_, other->isolation_);
+    std::swap(status_, other->status_);
+    std::swap(epoch_, other->epoch_);
+    std::swap(last_heartbeat_, other->last_heartbeat_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(orig_timestamp_, other->orig_timestamp_);
+    std::swap(max_timestamp_, other->max_timestamp_);
+    std::swap(certain_nodes_, other->certain_nodes_);
+    std::swap(sequence_, other->sequence_);
+    ignored_sequences_.Swap(&other->ignored_sequences_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata Transaction::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Transaction_descriptor_;
+  metadata.reflection = Transaction_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int SequenceRange::kStartFieldNumber;
+const int SequenceRange::kEndFieldNumber;
+#endif  // !_MSC_VER
+
+SequenceRange::SequenceRange()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.SequenceRange)
+}
+
+void SequenceRange::InitAsDefaultInstance() {
+}
+
+SequenceRange::SequenceRange(const SequenceRange& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.SequenceRange)
+}
+
+void SequenceRange::SharedCtor() {
+  _cached_size_ = 0;
+  start_ = 0;
+  end_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+SequenceRange::~SequenceRange() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.SequenceRange)
+  SharedDtor();
+}
+
+void SequenceRange::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void SequenceRange::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* SequenceRange::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return SequenceRange_descriptor_;
+}
+
+const SequenceRange& SequenceRange::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+SequenceRange* SequenceRange::default_instance_ = NULL;
+
+SequenceRange* SequenceRange::New() const {
+  return new SequenceRange;
+}
+
+void SequenceRange::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<SequenceRange*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  ZR_(start_, end_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool SequenceRange::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.SequenceRange)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 start = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &start_)));
+          set_has_start();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_end;
+        break;
+      }
+
+      // optional int32 end = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_end:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &end_)));
+          set_has_end();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.SequenceRange)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.SequenceRange)
+  return false;
+#undef DO_
+}
+
+void SequenceRange::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.SequenceRange)
+  // optional int32 start = 1;
+  if (has_start()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->start(), output);
+  }
+
+  // optional int32 end = 2;
+  if (has_end()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->end(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.SequenceRange)
+}
+
+::google::protobuf::uint8* SequenceRange::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.SequenceRange)
+  // optional int32 start = 1;
+  if (has_start()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->start(), target);
+  }
+
+  // optional int32 end = 2;
+  if (has_end()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->end(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.SequenceRange)
+  return target;
+}
+
+int SequenceRange::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 start = 1;
+    if (has_start()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->start());
+    }
+
+    // optional int32 end = 2;
+    if (has_end()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->end());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void SequenceRange::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const SequenceRange* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const SequenceRange*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void SequenceRange::MergeFrom(const SequenceRange& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_start()) {
+      set_start(from.start());
+    }
+    if (from.has_end()) {
+      set_end(from.end());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void SequenceRange::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void SequenceRange::CopyFrom(const SequenceRange& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SequenceRange::IsInitialized() const {
+
+  return true;
+}
+
+void SequenceRange::Swap(SequenceRange* other) {
+  if (other != this) {
+    std::swap(start_, other->start_);
+    std::swap(end_, other->end_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata SequenceRange::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = SequenceRange_descriptor_;
+  metadata.reflection = SequenceRange_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Lease::kExpirationFieldNumber;
+const int Lease::kDurationFieldNumber;
+const int Lease::kTermFieldNumber;
+const int Lease::kRaftNodeIdFieldNumber;
+const int Lease::kEpochFieldNumber;
+#endif  // !_MSC_VER
+
+Lease::Lease()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.Lease)
+}
+
+void Lease::InitAsDefaultInstance() {
+}
+
+Lease::Lease(const Lease& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Lease)
+}
+
+void Lease::SharedCtor() {
+  _cached_size_ = 0;
+  expiration_ = GOOGLE_LONGLONG(0);
+  duration_ = GOOGLE_LONGLONG(0);
+  term_ = GOOGLE_ULONGLONG(0);
+  raft_node_id_ = GOOGLE_ULONGLONG(0);
+  epoch_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Lease::~Lease() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Lease)
+  SharedDtor();
+}
+
+void Lease::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void Lease::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Lease::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Lease_descriptor_;
+}
+
+const Lease& Lease::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+Lease* Lease::default_instance_ = NULL;
+
+Lease* Lease::New() const {
+  return new Lease;
+}
+
+void Lease::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<Lease*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 31) {
+    ZR_(expiration_, epoch_);
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Lease::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Lease)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 expiration = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &expiration_)));
+          set_has_expiration();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_duration;
+        break;
+      }
+
+      // optional int64 duration = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_duration:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &duration_)));
+          set_has_duration();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_term;
+        break;
+      }
+
+      // optional uint64 term = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_term:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &term_)));
+          set_has_term();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_raft_node_id;
+        break;
+      }
+
+      // optional uint64 raft_node_id = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_raft_node_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &raft_node_id_)));
+          set_has_raft_node_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_epoch;
+        break;
+      }
+
+      // optional int64 epoch = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_epoch:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &epoch_)));
+          set_has_epoch();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Lease)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Lease)
+  return false;
+#undef DO_
+}
+
+void Lease::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Lease)
+  // optional int64 expiration = 1;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->expiration(), output);
+  }
+
+  // optional int64 duration = 2;
+  if (has_duration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->duration(), output);
+  }
+
+  // optional uint64 term = 3;
+  if (has_term()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(3, this->term(), output);
+  }
+
+  // optional uint64 raft_node_id = 4;
+  if (has_raft_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(4, this->raft_node_id(), output);
+  }
+
+  // optional int64 epoch = 5;
+  if (has_epoch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->epoch(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Lease)
+}
+
+::google::protobuf::uint8* Lease::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Lease)
+  // optional int64 expiration = 1;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->expiration(), target);
+  }
+
+  // optional int64 duration = 2;
+  if (has_duration()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->duration(), target);
+  }
+
+  // optional uint64 term = 3;
+  if (has_term()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(3, this->term(), target);
+  }
+
+  // optional uint64 raft_node_id = 4;
+  if (has_raft_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(4, this->raft_node_id(), target);
+  }
+
+  // optional int64 epoch = 5;
+  if (has_epoch()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->epoch(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Lease)
+  return target;
+}
+
+int Lease::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 expiration = 1;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->expiration());
+    }
+
+    // optional int64 duration = 2;
+    if (has_duration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->duration());
+    }
+
+    // optional uint64 term = 3;
+    if (has_term()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->term());
+    }
+
+    // optional uint64 raft_node_id = 4;
+    if (has_raft_node_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->raft_node_id());
+    }
+
+    // optional int64 epoch = 5;
+    if (has_epoch()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->epoch());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void Lease::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const Lease* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Lease*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void Lease::MergeFrom(const Lease& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_expiration()) {
+      set_expiration(from.expiration());
+    }
+    if (from.has_duration()) {
+      set_duration(from.duration());
+    }
+    if (from.has_term()) {
+      set_term(from.term());
+    }
+    if (from.has_raft_node_id()) {
+      set_raft_node_id(from.raft_node_id());
+    }
+    if (from.has_epoch()) {
+      set_epoch(from.epoch());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void Lease::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Lease::CopyFrom(const Lease& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Lease::IsInitialized() const {
+
+  return true;
+}
+
+void Lease::Swap(Lease* other) {
+  if (other != this) {
+    std::swap(expiration_, other->expiration_);
+    std::swap(duration_, other->duration_);
+    std::swap(term_, other->term_);
+    std::swap(raft_node_id_, other->raft_node_id_);
+    std::swap(epoch_, other->epoch_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata Lease::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Lease_descriptor_;
+  metadata.reflection = Lease_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Liveness::kNodeIdFieldNumber;
+const int Liveness::kEpochFieldNumber;
+const int Liveness::kExpirationFieldNumber;
+const int Liveness::kDecommissioningFieldNumber;
+#endif  // !_MSC_VER
+
+Liveness::Liveness()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.Liveness)
+}
+
+void Liveness::InitAsDefaultInstance() {
+  expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+Liveness::Liveness(const Liveness& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Liveness)
+}
+
+void Liveness::SharedCtor() {
+  _cached_size_ = 0;
+  node_id_ = 0;
+  epoch_ = GOOGLE_LONGLONG(0);
+  expiration_ = NULL;
+  decommissioning_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Liveness::~Liveness() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Liveness)
+  SharedDtor();
+}
+
+void Liveness::SharedDtor() {
+  if (this != default_instance_) {
+    delete expiration_;
+  }
+}
+
+void Liveness::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Liveness::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Liveness_descriptor_;
+}
+
+const Liveness& Liveness::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+Liveness* Liveness::default_instance_ = NULL;
+
+Liveness* Liveness::New() const {
+  return new Liveness;
+}
+
+void Liveness::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<Liveness*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(epoch_, decommissioning_);
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Liveness::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Liveness)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 node_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &node_id_)));
+          set_has_node_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_epoch;
+        break;
+      }
+
+      // optional int64 epoch = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_epoch:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &epoch_)));
+          set_has_epoch();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_expiration;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp expiration = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_expiration:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_expiration()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_decommissioning;
+        break;
+      }
+
+      // optional bool decommissioning = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_decommissioning:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &decommissioning_)));
+          set_has_decommissioning();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Liveness)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Liveness)
+  return false;
+#undef DO_
+}
+
+void Liveness::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Liveness)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->node_id(), output);
+  }
+
+  // optional int64 epoch = 2;
+  if (has_epoch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->epoch(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 3;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->expiration(), output);
+  }
+
+  // optional bool decommissioning = 4;
+  if (has_decommissioning()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->decommissioning(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Liveness)
+}
+
+::google::protobuf::uint8* Liveness::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Liveness)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->node_id(), target);
+  }
+
+  // optional int64 epoch = 2;
+  if (has_epoch()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->epoch(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 3;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->expiration(), target);
+  }
+
+  // optional bool decommissioning = 4;
+  if (has_decommissioning()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->decommissioning(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Liveness)
+  return target;
+}
+
+int Liveness::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 node_id = 1;
+    if (has_node_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
+    }
+
+    // optional int64 epoch = 2;
+    if (has_epoch()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->epoch());
+    }
+
+    // optional .cockroach.proto.Timestamp expiration = 3;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->expiration());
+    }
+
+    // optional bool decommissioning = 4;
+    if (has_decommissioning()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void Liveness::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const Liveness* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Liveness*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void Liveness::MergeFrom(const Liveness& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
+    }
+    if (from.has_epoch()) {
+      set_epoch(from.epoch());
+    }
+    if (from.has_expiration()) {
+      mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
+    }
+    if (from.has_decommissioning()) {
+      set_decommissioning(from.decommissioning());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void Liveness::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Liveness::CopyFrom(const Liveness& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Liveness::IsInitialized() const {
+
+  return true;
+}
+
+void Liveness::Swap(Liveness* other) {
+  if (other != this) {
+    std::swap(node_id_, other->node_id_);
+    std::swap(epoch_, other->epoch_);
+    std::swap(expiration_, other->expiration_);
+    std::swap(decommissioning_, other->decommissioning_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata Liveness::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Liveness_descriptor_;
+  metadata.reflection = Liveness_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ClusterVersion::kVersionFieldNumber;
+#endif  // !_MSC_VER
+
+ClusterVersion::ClusterVersion()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ClusterVersion)
+}
+
+void ClusterVersion::InitAsDefaultInstance() {
+}
 
-      // optional bytes key = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_key:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_key()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_id;
-        break;
-      }
+ClusterVersion::ClusterVersion(const ClusterVersion& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ClusterVersion)
+}
 
-      // optional bytes id = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_id:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_id()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(32)) goto parse_priority;
-        break;
-      }
+void ClusterVersion::SharedCtor() {
+  _cached_size_ = 0;
+  version_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
 
-      // optional int32 priority = 4;
-      case 4: {
-        if (tag == 32) {
-         parse_priority:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &priority_)));
-          set_has_priority();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(40)) goto parse_isolation;
-        break;
-      }
+ClusterVersion::~ClusterVersion() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ClusterVersion)
+  SharedDtor();
+}
 
-      // optional .cockroach.proto.IsolationType isolation = 5;
-      case 5: {
-        if (tag == 40) {
-         parse_isolation:
-          int value;
+void ClusterVersion::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void ClusterVersion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ClusterVersion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ClusterVersion_descriptor_;
+}
+
+const ClusterVersion& ClusterVersion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+ClusterVersion* ClusterVersion::default_instance_ = NULL;
+
+ClusterVersion* ClusterVersion::New() const {
+  return new ClusterVersion;
+}
+
+void ClusterVersion::Clear() {
+  version_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ClusterVersion::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ClusterVersion)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 version = 1;
+      case 1: {
+        if (tag == 8) {
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          if (::cockroach::proto::IsolationType_IsValid(value)) {
-            set_isolation(static_cast< ::cockroach::proto::IsolationType >(value));
-          } else {
-            mutable_unknown_fields()->AddVarint(5, value);
-          }
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &version_)));
+          set_has_version();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(48)) goto parse_status;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.TransactionStatus status = 6;
-      case 6: {
-        if (tag == 48) {
-         parse_status:
-          int value;
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          if (::cockroach::proto::TransactionStatus_IsValid(value)) {
-            set_status(static_cast< ::cockroach::proto::TransactionStatus >(value));
-          } else {
-            mutable_unknown_fields()->AddVarint(6, value);
-          }
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(56)) goto parse_epoch;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ClusterVersion)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ClusterVersion)
+  return false;
+#undef DO_
+}
+
+void ClusterVersion::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ClusterVersion)
+  // optional int64 version = 1;
+  if (has_version()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->version(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ClusterVersion)
+}
+
+::google::protobuf::uint8* ClusterVersion::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ClusterVersion)
+  // optional int64 version = 1;
+  if (has_version()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->version(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ClusterVersion)
+  return target;
+}
+
+int ClusterVersion::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 version = 1;
+    if (has_version()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->version());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ClusterVersion::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ClusterVersion* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ClusterVersion*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ClusterVersion::MergeFrom(const ClusterVersion& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_version()) {
+      set_version(from.version());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ClusterVersion::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ClusterVersion::CopyFrom(const ClusterVersion& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ClusterVersion::IsInitialized() const {
+
+  return true;
+}
+
+void ClusterVersion::Swap(ClusterVersion* other) {
+  if (other != this) {
+    std::swap(version_, other->version_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ClusterVersion::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ClusterVersion_descriptor_;
+  metadata.reflection = ClusterVersion_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int MigrationLease::kNodeIdFieldNumber;
+const int MigrationLease::kExpirationFieldNumber;
+#endif  // !_MSC_VER
+
+MigrationLease::MigrationLease()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.MigrationLease)
+}
+
+void MigrationLease::InitAsDefaultInstance() {
+  expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+MigrationLease::MigrationLease(const MigrationLease& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.MigrationLease)
+}
+
+void MigrationLease::SharedCtor() {
+  _cached_size_ = 0;
+  node_id_ = 0;
+  expiration_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+MigrationLease::~MigrationLease() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.MigrationLease)
+  SharedDtor();
+}
+
+void MigrationLease::SharedDtor() {
+  if (this != default_instance_) {
+    delete expiration_;
+  }
+}
+
+void MigrationLease::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* MigrationLease::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return MigrationLease_descriptor_;
+}
+
+const MigrationLease& MigrationLease::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+MigrationLease* MigrationLease::default_instance_ = NULL;
+
+MigrationLease* MigrationLease::New() const {
+  return new MigrationLease;
+}
+
+void MigrationLease::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    node_id_ = 0;
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
 
-      // optional int32 epoch = 7;
-      case 7: {
-        if (tag == 56) {
-         parse_epoch:
+bool MigrationLease::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.MigrationLease)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 node_id = 1;
+      case 1: {
+        if (tag == 8) {
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &epoch_)));
-          set_has_epoch();
+                 input, &node_id_)));
+          set_has_node_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_last_heartbeat;
+        if (input->ExpectTag(18)) goto parse_expiration;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_last_heartbeat:
+      // optional .cockroach.proto.Timestamp expiration = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_expiration:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_last_heartbeat()));
+               input, mutable_expiration()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_timestamp;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp timestamp = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_timestamp()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(82)) goto parse_orig_timestamp;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.MigrationLease)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.MigrationLease)
+  return false;
+#undef DO_
+}
 
-      // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-      case 10: {
-        if (tag == 82) {
-         parse_orig_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_orig_timestamp()));
+void MigrationLease::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.MigrationLease)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->node_id(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 2;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->expiration(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.MigrationLease)
+}
+
+::google::protobuf::uint8* MigrationLease::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.MigrationLease)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->node_id(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 2;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->expiration(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.MigrationLease)
+  return target;
+}
+
+int MigrationLease::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 node_id = 1;
+    if (has_node_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
+    }
+
+    // optional .cockroach.proto.Timestamp expiration = 2;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->expiration());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void MigrationLease::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const MigrationLease* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const MigrationLease*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void MigrationLease::MergeFrom(const MigrationLease& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
+    }
+    if (from.has_expiration()) {
+      mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void MigrationLease::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void MigrationLease::CopyFrom(const MigrationLease& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool MigrationLease::IsInitialized() const {
+
+  return true;
+}
+
+void MigrationLease::Swap(MigrationLease* other) {
+  if (other != this) {
+    std::swap(node_id_, other->node_id_);
+    std::swap(expiration_, other->expiration_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata MigrationLease::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = MigrationLease_descriptor_;
+  metadata.reflection = MigrationLease_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int NodeIDClaim::kNodeIdFieldNumber;
+const int NodeIDClaim::kAddressFieldNumber;
+const int NodeIDClaim::kClusterIdFieldNumber;
+#endif  // !_MSC_VER
+
+NodeIDClaim::NodeIDClaim()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.NodeIDClaim)
+}
+
+void NodeIDClaim::InitAsDefaultInstance() {
+}
+
+NodeIDClaim::NodeIDClaim(const NodeIDClaim& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.NodeIDClaim)
+}
+
+void NodeIDClaim::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  node_id_ = 0;
+  address_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+NodeIDClaim::~NodeIDClaim() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.NodeIDClaim)
+  SharedDtor();
+}
+
+void NodeIDClaim::SharedDtor() {
+  if (address_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete address_;
+  }
+  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete cluster_id_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void NodeIDClaim::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* NodeIDClaim::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return NodeIDClaim_descriptor_;
+}
+
+const NodeIDClaim& NodeIDClaim::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+NodeIDClaim* NodeIDClaim::default_instance_ = NULL;
+
+NodeIDClaim* NodeIDClaim::New() const {
+  return new NodeIDClaim;
+}
+
+void NodeIDClaim::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    node_id_ = 0;
+    if (has_address()) {
+      if (address_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        address_->clear();
+      }
+    }
+    if (has_cluster_id()) {
+      if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        cluster_id_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool NodeIDClaim::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.NodeIDClaim)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 node_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &node_id_)));
+          set_has_node_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(90)) goto parse_max_timestamp;
+        if (input->ExpectTag(18)) goto parse_address;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp max_timestamp = 11;
-      case 11: {
-        if (tag == 90) {
-         parse_max_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_max_timestamp()));
+      // optional string address = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_address:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_address()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->address().data(), this->address().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "address");
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(98)) goto parse_certain_nodes;
+        if (input->ExpectTag(26)) goto parse_cluster_id;
         break;
       }
 
-      // optional .cockroach.proto.NodeList certain_nodes = 12;
-      case 12: {
-        if (tag == 98) {
-         parse_certain_nodes:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_certain_nodes()));
+      // optional string cluster_id = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_cluster_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_cluster_id()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->cluster_id().data(), this->cluster_id().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "cluster_id");
         } else {
           goto handle_unusual;
         }
@@ -4381,277 +7106,110 @@ bool Transaction::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.NodeIDClaim)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.NodeIDClaim)
   return false;
 #undef DO_
 }
 
-void Transaction::SerializeWithCachedSizes(
+void NodeIDClaim::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.Transaction)
-  // optional string name = 1;
-  if (has_name()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.NodeIDClaim)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->node_id(), output);
+  }
+
+  // optional string address = 2;
+  if (has_address()) {
     ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-      this->name().data(), this->name().length(),
+      this->address().data(), this->address().length(),
       ::google::protobuf::internal::WireFormat::SERIALIZE,
-      "name");
+      "address");
     ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      1, this->name(), output);
-  }
-
-  // optional bytes key = 2;
-  if (has_key()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      2, this->key(), output);
-  }
-
-  // optional bytes id = 3;
-  if (has_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      3, this->id(), output);
-  }
-
-  // optional int32 priority = 4;
-  if (has_priority()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->priority(), output);
-  }
-
-  // optional .cockroach.proto.IsolationType isolation = 5;
-  if (has_isolation()) {
-    ::google::protobuf::internal::WireFormatLite::WriteEnum(
-      5, this->isolation(), output);
-  }
-
-  // optional .cockroach.proto.TransactionStatus status = 6;
-  if (has_status()) {
-    ::google::protobuf::internal::WireFormatLite::WriteEnum(
-      6, this->status(), output);
-  }
-
-  // optional int32 epoch = 7;
-  if (has_epoch()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(7, this->epoch(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-  if (has_last_heartbeat()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      8, this->last_heartbeat(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp timestamp = 9;
-  if (has_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      9, this->timestamp(), output);
+      2, this->address(), output);
   }
 
-  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-  if (has_orig_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      10, this->orig_timestamp(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp max_timestamp = 11;
-  if (has_max_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      11, this->max_timestamp(), output);
-  }
-
-  // optional .cockroach.proto.NodeList certain_nodes = 12;
-  if (has_certain_nodes()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      12, this->certain_nodes(), output);
+  // optional string cluster_id = 3;
+  if (has_cluster_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->cluster_id().data(), this->cluster_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cluster_id");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->cluster_id(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.NodeIDClaim)
 }
 
-::google::protobuf::uint8* Transaction::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* NodeIDClaim::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Transaction)
-  // optional string name = 1;
-  if (has_name()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.NodeIDClaim)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->node_id(), target);
+  }
+
+  // optional string address = 2;
+  if (has_address()) {
     ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-      this->name().data(), this->name().length(),
+      this->address().data(), this->address().length(),
       ::google::protobuf::internal::WireFormat::SERIALIZE,
-      "name");
+      "address");
     target =
       ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        1, this->name(), target);
-  }
-
-  // optional bytes key = 2;
-  if (has_key()) {
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        2, this->key(), target);
+        2, this->address(), target);
   }
 
-  // optional bytes id = 3;
-  if (has_id()) {
+  // optional string cluster_id = 3;
+  if (has_cluster_id()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->cluster_id().data(), this->cluster_id().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "cluster_id");
     target =
-      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        3, this->id(), target);
-  }
-
-  // optional int32 priority = 4;
-  if (has_priority()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(4, this->priority(), target);
-  }
-
-  // optional .cockroach.proto.IsolationType isolation = 5;
-  if (has_isolation()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
-      5, this->isolation(), target);
-  }
-
-  // optional .cockroach.proto.TransactionStatus status = 6;
-  if (has_status()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
-      6, this->status(), target);
-  }
-
-  // optional int32 epoch = 7;
-  if (has_epoch()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(7, this->epoch(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-  if (has_last_heartbeat()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        8, this->last_heartbeat(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp timestamp = 9;
-  if (has_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        9, this->timestamp(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-  if (has_orig_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        10, this->orig_timestamp(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp max_timestamp = 11;
-  if (has_max_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        11, this->max_timestamp(), target);
-  }
-
-  // optional .cockroach.proto.NodeList certain_nodes = 12;
-  if (has_certain_nodes()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        12, this->certain_nodes(), target);
-  }
-
-  if (!unknown_fields().empty()) {
-    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
-        unknown_fields(), target);
-  }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Transaction)
-  return target;
-}
-
-int Transaction::ByteSize() const {
-  int total_size = 0;
-
-  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional string name = 1;
-    if (has_name()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::StringSize(
-          this->name());
-    }
-
-    // optional bytes key = 2;
-    if (has_key()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->key());
-    }
-
-    // optional bytes id = 3;
-    if (has_id()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->id());
-    }
-
-    // optional int32 priority = 4;
-    if (has_priority()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->priority());
-    }
-
-    // optional .cockroach.proto.IsolationType isolation = 5;
-    if (has_isolation()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::EnumSize(this->isolation());
-    }
-
-    // optional .cockroach.proto.TransactionStatus status = 6;
-    if (has_status()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::EnumSize(this->status());
-    }
-
-    // optional int32 epoch = 7;
-    if (has_epoch()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->epoch());
-    }
-
-    // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-    if (has_last_heartbeat()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->last_heartbeat());
-    }
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        3, this->cluster_id(), target);
+  }
 
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
   }
-  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
-    // optional .cockroach.proto.Timestamp timestamp = 9;
-    if (has_timestamp()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->timestamp());
-    }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.NodeIDClaim)
+  return target;
+}
 
-    // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-    if (has_orig_timestamp()) {
+int NodeIDClaim::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 node_id = 1;
+    if (has_node_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->orig_timestamp());
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
     }
 
-    // optional .cockroach.proto.Timestamp max_timestamp = 11;
-    if (has_max_timestamp()) {
+    // optional string address = 2;
+    if (has_address()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->max_timestamp());
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->address());
     }
 
-    // optional .cockroach.proto.NodeList certain_nodes = 12;
-    if (has_certain_nodes()) {
+    // optional string cluster_id = 3;
+    if (has_cluster_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->certain_nodes());
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->cluster_id());
     }
 
   }
@@ -4666,10 +7224,10 @@ int Transaction::ByteSize() const {
   return total_size;
 }
 
-void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
+void NodeIDClaim::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const Transaction* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const Transaction*>(
+  const NodeIDClaim* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const NodeIDClaim*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -4678,93 +7236,55 @@ void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void Transaction::MergeFrom(const Transaction& from) {
+void NodeIDClaim::MergeFrom(const NodeIDClaim& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_name()) {
-      set_name(from.name());
-    }
-    if (from.has_key()) {
-      set_key(from.key());
-    }
-    if (from.has_id()) {
-      set_id(from.id());
-    }
-    if (from.has_priority()) {
-      set_priority(from.priority());
-    }
-    if (from.has_isolation()) {
-      set_isolation(from.isolation());
-    }
-    if (from.has_status()) {
-      set_status(from.status());
-    }
-    if (from.has_epoch()) {
-      set_epoch(from.epoch());
-    }
-    if (from.has_last_heartbeat()) {
-      mutable_last_heartbeat()->::cockroach::proto::Timestamp::MergeFrom(from.last_heartbeat());
-    }
-  }
-  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
-    if (from.has_timestamp()) {
-      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
-    }
-    if (from.has_orig_timestamp()) {
-      mutable_orig_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.orig_timestamp());
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
     }
-    if (from.has_max_timestamp()) {
-      mutable_max_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.max_timestamp());
+    if (from.has_address()) {
+      set_address(from.address());
     }
-    if (from.has_certain_nodes()) {
-      mutable_certain_nodes()->::cockroach::proto::NodeList::MergeFrom(from.certain_nodes());
+    if (from.has_cluster_id()) {
+      set_cluster_id(from.cluster_id());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void Transaction::CopyFrom(const ::google::protobuf::Message& from) {
+void NodeIDClaim::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void Transaction::CopyFrom(const Transaction& from) {
+void NodeIDClaim::CopyFrom(const NodeIDClaim& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool Transaction::IsInitialized() const {
+bool NodeIDClaim::IsInitialized() const {
 
   return true;
 }
 
-void Transaction::Swap(Transaction* other) {
+void NodeIDClaim::Swap(NodeIDClaim* other) {
   if (other != this) {
-    std::swap(name_, other->name_);
-    std::swap(key_, other->key_);
-    std::swap(id_, other->id_);
-    std::swap(priority_, other->priority_);
-    std::swap(isolation_, other->isolation_);
-    std::swap(status_, other->status_);
-    std::swap(epoch_, other->epoch_);
-    std::swap(last_heartbeat_, other->last_heartbeat_);
-    std::swap(timestamp_, other->timestamp_);
-    std::swap(orig_timestamp_, other->orig_timestamp_);
-    std::swap(max_timestamp_, other->max_timestamp_);
-    std::swap(certain_nodes_, other->certain_nodes_);
+    std::swap(node_id_, other->node_id_);
+    std::swap(address_, other->address_);
+    std::swap(cluster_id_, other->cluster_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata Transaction::GetMetadata() const {
+::google::protobuf::Metadata NodeIDClaim::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = Transaction_descriptor_;
-  metadata.reflection = Transaction_reflection_;
+  metadata.descriptor = NodeIDClaim_descriptor_;
+  metadata.reflection = NodeIDClaim_reflection_;
   return metadata;
 }
 
@@ -4772,71 +7292,67 @@ void Transaction::Swap(Transaction* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int Lease::kExpirationFieldNumber;
-const int Lease::kDurationFieldNumber;
-const int Lease::kTermFieldNumber;
-const int Lease::kRaftNodeIdFieldNumber;
+const int StoreIDClaim::kStoreIdFieldNumber;
+const int StoreIDClaim::kNodeIdFieldNumber;
 #endif  // !_MSC_VER
 
-Lease::Lease()
+StoreIDClaim::StoreIDClaim()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.Lease)
+  // @@protoc_insertion_point(constructor:cockroach.proto.StoreIDClaim)
 }
 
-void Lease::InitAsDefaultInstance() {
+void StoreIDClaim::InitAsDefaultInstance() {
 }
 
-Lease::Lease(const Lease& from)
+StoreIDClaim::StoreIDClaim(const StoreIDClaim& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Lease)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StoreIDClaim)
 }
 
-void Lease::SharedCtor() {
+void StoreIDClaim::SharedCtor() {
   _cached_size_ = 0;
-  expiration_ = GOOGLE_LONGLONG(0);
-  duration_ = GOOGLE_LONGLONG(0);
-  term_ = GOOGLE_ULONGLONG(0);
-  raft_node_id_ = GOOGLE_ULONGLONG(0);
+  store_id_ = 0;
+  node_id_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-Lease::~Lease() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.Lease)
+StoreIDClaim::~StoreIDClaim() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StoreIDClaim)
   SharedDtor();
 }
 
-void Lease::SharedDtor() {
+void StoreIDClaim::SharedDtor() {
   if (this != default_instance_) {
   }
 }
 
-void Lease::SetCachedSize(int size) const {
+void StoreIDClaim::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* Lease::descriptor() {
+const ::google::protobuf::Descriptor* StoreIDClaim::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return Lease_descriptor_;
+  return StoreIDClaim_descriptor_;
 }
 
-const Lease& Lease::default_instance() {
+const StoreIDClaim& StoreIDClaim::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-Lease* Lease::default_instance_ = NULL;
+StoreIDClaim* StoreIDClaim::default_instance_ = NULL;
 
-Lease* Lease::New() const {
-  return new Lease;
+StoreIDClaim* StoreIDClaim::New() const {
+  return new StoreIDClaim;
 }
 
-void Lease::Clear() {
+void StoreIDClaim::Clear() {
 #define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<Lease*>(16)->f) - \
+  &reinterpret_cast<StoreIDClaim*>(16)->f) - \
    reinterpret_cast<char*>(16))
 
 #define ZR_(first, last) do {                              \
@@ -4845,7 +7361,7 @@ void Lease::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  ZR_(expiration_, raft_node_id_);
+  ZR_(store_id_, node_id_);
 
 #undef OFFSET_OF_FIELD_
 #undef ZR_
@@ -4854,68 +7370,38 @@ void Lease::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool Lease::MergePartialFromCodedStream(
+bool StoreIDClaim::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.Lease)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StoreIDClaim)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional int64 expiration = 1;
+      // optional int32 store_id = 1;
       case 1: {
         if (tag == 8) {
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &expiration_)));
-          set_has_expiration();
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &store_id_)));
+          set_has_store_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_duration;
+        if (input->ExpectTag(16)) goto parse_node_id;
         break;
       }
 
-      // optional int64 duration = 2;
+      // optional int32 node_id = 2;
       case 2: {
         if (tag == 16) {
-         parse_duration:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &duration_)));
-          set_has_duration();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_term;
-        break;
-      }
-
-      // optional uint64 term = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_term:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
-                 input, &term_)));
-          set_has_term();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(32)) goto parse_raft_node_id;
-        break;
-      }
-
-      // optional uint64 raft_node_id = 4;
-      case 4: {
-        if (tag == 32) {
-         parse_raft_node_id:
+         parse_node_id:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
-                 input, &raft_node_id_)));
-          set_has_raft_node_id();
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &node_id_)));
+          set_has_node_id();
         } else {
           goto handle_unusual;
         }
@@ -4937,105 +7423,71 @@ bool Lease::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.Lease)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StoreIDClaim)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.Lease)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StoreIDClaim)
   return false;
 #undef DO_
 }
 
-void Lease::SerializeWithCachedSizes(
+void StoreIDClaim::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.Lease)
-  // optional int64 expiration = 1;
-  if (has_expiration()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->expiration(), output);
-  }
-
-  // optional int64 duration = 2;
-  if (has_duration()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->duration(), output);
-  }
-
-  // optional uint64 term = 3;
-  if (has_term()) {
-    ::google::protobuf::internal::WireFormatLite::WriteUInt64(3, this->term(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StoreIDClaim)
+  // optional int32 store_id = 1;
+  if (has_store_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->store_id(), output);
   }
 
-  // optional uint64 raft_node_id = 4;
-  if (has_raft_node_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteUInt64(4, this->raft_node_id(), output);
+  // optional int32 node_id = 2;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->node_id(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.Lease)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StoreIDClaim)
 }
 
-::google::protobuf::uint8* Lease::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* StoreIDClaim::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Lease)
-  // optional int64 expiration = 1;
-  if (has_expiration()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->expiration(), target);
-  }
-
-  // optional int64 duration = 2;
-  if (has_duration()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->duration(), target);
-  }
-
-  // optional uint64 term = 3;
-  if (has_term()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(3, this->term(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StoreIDClaim)
+  // optional int32 store_id = 1;
+  if (has_store_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->store_id(), target);
   }
 
-  // optional uint64 raft_node_id = 4;
-  if (has_raft_node_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(4, this->raft_node_id(), target);
+  // optional int32 node_id = 2;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->node_id(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Lease)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StoreIDClaim)
   return target;
 }
 
-int Lease::ByteSize() const {
+int StoreIDClaim::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional int64 expiration = 1;
-    if (has_expiration()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->expiration());
-    }
-
-    // optional int64 duration = 2;
-    if (has_duration()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->duration());
-    }
-
-    // optional uint64 term = 3;
-    if (has_term()) {
+    // optional int32 store_id = 1;
+    if (has_store_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::UInt64Size(
-          this->term());
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->store_id());
     }
 
-    // optional uint64 raft_node_id = 4;
-    if (has_raft_node_id()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::UInt64Size(
-          this->raft_node_id());
+    // optional int32 node_id = 2;
+    if (has_node_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
     }
 
   }
@@ -5050,10 +7502,10 @@ int Lease::ByteSize() const {
   return total_size;
 }
 
-void Lease::MergeFrom(const ::google::protobuf::Message& from) {
+void StoreIDClaim::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const Lease* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const Lease*>(
+  const StoreIDClaim* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StoreIDClaim*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5062,59 +7514,51 @@ void Lease::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void Lease::MergeFrom(const Lease& from) {
+void StoreIDClaim::MergeFrom(const StoreIDClaim& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_expiration()) {
-      set_expiration(from.expiration());
-    }
-    if (from.has_duration()) {
-      set_duration(from.duration());
-    }
-    if (from.has_term()) {
-      set_term(from.term());
+    if (from.has_store_id()) {
+      set_store_id(from.store_id());
     }
-    if (from.has_raft_node_id()) {
-      set_raft_node_id(from.raft_node_id());
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void Lease::CopyFrom(const ::google::protobuf::Message& from) {
+void StoreIDClaim::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void Lease::CopyFrom(const Lease& from) {
+void StoreIDClaim::CopyFrom(const StoreIDClaim& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool Lease::IsInitialized() const {
+bool StoreIDClaim::IsInitialized() const {
 
   return true;
 }
 
-void Lease::Swap(Lease* other) {
+void StoreIDClaim::Swap(StoreIDClaim* other) {
   if (other != this) {
-    std::swap(expiration_, other->expiration_);
-    std::swap(duration_, other->duration_);
-    std::swap(term_, other->term_);
-    std::swap(raft_node_id_, other->raft_node_id_);
+    std::swap(store_id_, other->store_id_);
+    std::swap(node_id_, other->node_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata Lease::GetMetadata() const {
+::google::protobuf::Metadata StoreIDClaim::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = Lease_descriptor_;
-  metadata.reflection = Lease_reflection_;
+  metadata.descriptor = StoreIDClaim_descriptor_;
+  metadata.reflection = StoreIDClaim_reflection_;
   return metadata;
 }
 
@@ -5128,6 +7572,7 @@ const int MVCCMetadata::kDeletedFieldNumber;
 const int MVCCMetadata::kKeyBytesFieldNumber;
 const int MVCCMetadata::kValBytesFieldNumber;
 const int MVCCMetadata::kValueFieldNumber;
+const int MVCCMetadata::kIntentHistoryFieldNumber;
 #endif  // !_MSC_VER
 
 MVCCMetadata::MVCCMetadata()
@@ -5222,6 +7667,7 @@ void MVCCMetadata::Clear() {
 #undef OFFSET_OF_FIELD_
 #undef ZR_
 
+  intent_history_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -5315,6 +7761,20 @@ bool MVCCMetadata::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(58)) goto parse_intent_history;
+        break;
+      }
+
+      // repeated .cockroach.proto.SequencedValue intent_history = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_intent_history:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_intent_history()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_intent_history;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5377,6 +7837,12 @@ void MVCCMetadata::SerializeWithCachedSizes(
       6, this->value(), output);
   }
 
+  // repeated .cockroach.proto.SequencedValue intent_history = 7;
+  for (int i = 0; i < this->intent_history_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->intent_history(i), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -5423,6 +7889,13 @@ void MVCCMetadata::SerializeWithCachedSizes(
         6, this->value(), target);
   }
 
+  // repeated .cockroach.proto.SequencedValue intent_history = 7;
+  for (int i = 0; i < this->intent_history_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->intent_history(i), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -5476,6 +7949,14 @@ int MVCCMetadata::ByteSize() const {
     }
 
   }
+  // repeated .cockroach.proto.SequencedValue intent_history = 7;
+  total_size += 1 * this->intent_history_size();
+  for (int i = 0; i < this->intent_history_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->intent_history(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -5501,6 +7982,7 @@ void MVCCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
 
 void MVCCMetadata::MergeFrom(const MVCCMetadata& from) {
   GOOGLE_CHECK_NE(&from, this);
+  intent_history_.MergeFrom(from.intent_history_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_txn()) {
       mutable_txn()->::cockroach::proto::Transaction::MergeFrom(from.txn());
@@ -5549,6 +8031,7 @@ void MVCCMetadata::Swap(MVCCMetadata* other) {
     std::swap(key_bytes_, other->key_bytes_);
     std::swap(val_bytes_, other->val_bytes_);
     std::swap(value_, other->value_);
+    intent_history_.Swap(&other->intent_history_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -5564,6 +8047,274 @@ void MVCCMetadata::Swap(MVCCMetadata* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int SequencedValue::kSequenceFieldNumber;
+const int SequencedValue::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+SequencedValue::SequencedValue()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.SequencedValue)
+}
+
+void SequencedValue::InitAsDefaultInstance() {
+  value_ = const_cast< ::cockroach::proto::MVCCValue*>(&::cockroach::proto::MVCCValue::default_instance());
+}
+
+SequencedValue::SequencedValue(const SequencedValue& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.SequencedValue)
+}
+
+void SequencedValue::SharedCtor() {
+  _cached_size_ = 0;
+  sequence_ = 0;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+SequencedValue::~SequencedValue() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.SequencedValue)
+  SharedDtor();
+}
+
+void SequencedValue::SharedDtor() {
+  if (this != default_instance_) {
+    delete value_;
+  }
+}
+
+void SequencedValue::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* SequencedValue::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return SequencedValue_descriptor_;
+}
+
+const SequencedValue& SequencedValue::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+SequencedValue* SequencedValue::default_instance_ = NULL;
+
+SequencedValue* SequencedValue::New() const {
+  return new SequencedValue;
+}
+
+void SequencedValue::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    sequence_ = 0;
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::MVCCValue::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool SequencedValue::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.SequencedValue)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 sequence = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &sequence_)));
+          set_has_sequence();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.proto.MVCCValue value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.SequencedValue)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.SequencedValue)
+  return false;
+#undef DO_
+}
+
+void SequencedValue::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.SequencedValue)
+  // optional int32 sequence = 1;
+  if (has_sequence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->sequence(), output);
+  }
+
+  // optional .cockroach.proto.MVCCValue value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->value(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.SequencedValue)
+}
+
+::google::protobuf::uint8* SequencedValue::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.SequencedValue)
+  // optional int32 sequence = 1;
+  if (has_sequence()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->sequence(), target);
+  }
+
+  // optional .cockroach.proto.MVCCValue value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->value(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.SequencedValue)
+  return target;
+}
+
+int SequencedValue::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 sequence = 1;
+    if (has_sequence()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->sequence());
+    }
+
+    // optional .cockroach.proto.MVCCValue value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void SequencedValue::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const SequencedValue* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const SequencedValue*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void SequencedValue::MergeFrom(const SequencedValue& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_sequence()) {
+      set_sequence(from.sequence());
+    }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::MVCCValue::MergeFrom(from.value());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void SequencedValue::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void SequencedValue::CopyFrom(const SequencedValue& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SequencedValue::IsInitialized() const {
+
+  return true;
+}
+
+void SequencedValue::Swap(SequencedValue* other) {
+  if (other != this) {
+    std::swap(sequence_, other->sequence_);
+    std::swap(value_, other->value_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata SequencedValue::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = SequencedValue_descriptor_;
+  metadata.reflection = SequencedValue_reflection_;
+  return metadata;
+}
+
+
 // ===================================================================
 
 #ifndef _MSC_VER
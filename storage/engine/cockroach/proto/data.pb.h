@@ -41,6 +41,7 @@ class Timestamp;
 class Value;
 class MVCCValue;
 class KeyValue;
+class Span;
 class RawKeyValue;
 class StoreIdent;
 class SplitTrigger;
@@ -49,20 +50,47 @@ class ChangeReplicasTrigger;
 class InternalCommitTrigger;
 class NodeList;
 class Transaction;
+class SequenceRange;
 class Lease;
+class Liveness;
+class ClusterVersion;
+class MigrationLease;
+class NodeIDClaim;
+class StoreIDClaim;
 class MVCCMetadata;
+class SequencedValue;
 class GCMetadata;
 class TimeSeriesDatapoint;
 class TimeSeriesData;
 class MVCCStats;
 
+enum ValueCompression {
+  VALUE_UNCOMPRESSED = 0,
+  VALUE_SNAPPY = 1
+};
+bool ValueCompression_IsValid(int value);
+const ValueCompression ValueCompression_MIN = VALUE_UNCOMPRESSED;
+const ValueCompression ValueCompression_MAX = VALUE_SNAPPY;
+const int ValueCompression_ARRAYSIZE = ValueCompression_MAX + 1;
+
+const ::google::protobuf::EnumDescriptor* ValueCompression_descriptor();
+inline const ::std::string& ValueCompression_Name(ValueCompression value) {
+  return ::google::protobuf::internal::NameOfEnum(
+    ValueCompression_descriptor(), value);
+}
+inline bool ValueCompression_Parse(
+    const ::std::string& name, ValueCompression* value) {
+  return ::google::protobuf::internal::ParseNamedEnum<ValueCompression>(
+    ValueCompression_descriptor(), name, value);
+}
 enum ReplicaChangeType {
   ADD_REPLICA = 0,
-  REMOVE_REPLICA = 1
+  REMOVE_REPLICA = 1,
+  ADD_LEARNER = 2
 };
 bool ReplicaChangeType_IsValid(int value);
 const ReplicaChangeType ReplicaChangeType_MIN = ADD_REPLICA;
-const ReplicaChangeType ReplicaChangeType_MAX = REMOVE_REPLICA;
+const ReplicaChangeType ReplicaChangeType_MAX = ADD_LEARNER;
 const int ReplicaChangeType_ARRAYSIZE = ReplicaChangeType_MAX + 1;
 
 const ::google::protobuf::EnumDescriptor* ReplicaChangeType_descriptor();
@@ -305,6 +333,13 @@ class Value : public ::google::protobuf::Message {
   inline ::std::string* release_tag();
   inline void set_allocated_tag(::std::string* tag);
 
+  // optional .cockroach.proto.ValueCompression compression = 6;
+  inline bool has_compression() const;
+  inline void clear_compression();
+  static const int kCompressionFieldNumber = 6;
+  inline ::cockroach::proto::ValueCompression compression() const;
+  inline void set_compression(::cockroach::proto::ValueCompression value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Value)
  private:
   inline void set_has_bytes();
@@ -317,6 +352,8 @@ class Value : public ::google::protobuf::Message {
   inline void clear_has_timestamp();
   inline void set_has_tag();
   inline void clear_has_tag();
+  inline void set_has_compression();
+  inline void clear_has_compression();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -325,8 +362,9 @@ class Value : public ::google::protobuf::Message {
   ::std::string* bytes_;
   ::google::protobuf::int64 integer_;
   ::cockroach::proto::Timestamp* timestamp_;
-  ::std::string* tag_;
   ::google::protobuf::uint32 checksum_;
+  int compression_;
+  ::std::string* tag_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -523,6 +561,105 @@ class KeyValue : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class Span : public ::google::protobuf::Message {
+ public:
+  Span();
+  virtual ~Span();
+
+  Span(const Span& from);
+
+  inline Span& operator=(const Span& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const Span& default_instance();
+
+  void Swap(Span* other);
+
+  // implements Message ----------------------------------------------
+
+  Span* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const Span& from);
+  void MergeFrom(const Span& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional bytes end_key = 2;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 2;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.Span)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::std::string* end_key_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static Span* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class RawKeyValue : public ::google::protobuf::Message {
  public:
   RawKeyValue();
@@ -1389,6 +1526,25 @@ class Transaction : public ::google::protobuf::Message {
   inline ::cockroach::proto::NodeList* release_certain_nodes();
   inline void set_allocated_certain_nodes(::cockroach::proto::NodeList* certain_nodes);
 
+  // optional int32 sequence = 13;
+  inline bool has_sequence() const;
+  inline void clear_sequence();
+  static const int kSequenceFieldNumber = 13;
+  inline ::google::protobuf::int32 sequence() const;
+  inline void set_sequence(::google::protobuf::int32 value);
+
+  // repeated .cockroach.proto.SequenceRange ignored_sequences = 14;
+  inline int ignored_sequences_size() const;
+  inline void clear_ignored_sequences();
+  static const int kIgnoredSequencesFieldNumber = 14;
+  inline const ::cockroach::proto::SequenceRange& ignored_sequences(int index) const;
+  inline ::cockroach::proto::SequenceRange* mutable_ignored_sequences(int index);
+  inline ::cockroach::proto::SequenceRange* add_ignored_sequences();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >&
+      ignored_sequences() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >*
+      mutable_ignored_sequences();
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Transaction)
  private:
   inline void set_has_name();
@@ -1415,6 +1571,8 @@ class Transaction : public ::google::protobuf::Message {
   inline void clear_has_max_timestamp();
   inline void set_has_certain_nodes();
   inline void clear_has_certain_nodes();
+  inline void set_has_sequence();
+  inline void clear_has_sequence();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1432,6 +1590,8 @@ class Transaction : public ::google::protobuf::Message {
   ::cockroach::proto::Timestamp* orig_timestamp_;
   ::cockroach::proto::Timestamp* max_timestamp_;
   ::cockroach::proto::NodeList* certain_nodes_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange > ignored_sequences_;
+  ::google::protobuf::int32 sequence_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1441,6 +1601,95 @@ class Transaction : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class SequenceRange : public ::google::protobuf::Message {
+ public:
+  SequenceRange();
+  virtual ~SequenceRange();
+
+  SequenceRange(const SequenceRange& from);
+
+  inline SequenceRange& operator=(const SequenceRange& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const SequenceRange& default_instance();
+
+  void Swap(SequenceRange* other);
+
+  // implements Message ----------------------------------------------
+
+  SequenceRange* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const SequenceRange& from);
+  void MergeFrom(const SequenceRange& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 start = 1;
+  inline bool has_start() const;
+  inline void clear_start();
+  static const int kStartFieldNumber = 1;
+  inline ::google::protobuf::int32 start() const;
+  inline void set_start(::google::protobuf::int32 value);
+
+  // optional int32 end = 2;
+  inline bool has_end() const;
+  inline void clear_end();
+  static const int kEndFieldNumber = 2;
+  inline ::google::protobuf::int32 end() const;
+  inline void set_end(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.SequenceRange)
+ private:
+  inline void set_has_start();
+  inline void clear_has_start();
+  inline void set_has_end();
+  inline void clear_has_end();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int32 start_;
+  ::google::protobuf::int32 end_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static SequenceRange* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class Lease : public ::google::protobuf::Message {
  public:
   Lease();
@@ -1522,6 +1771,13 @@ class Lease : public ::google::protobuf::Message {
   inline ::google::protobuf::uint64 raft_node_id() const;
   inline void set_raft_node_id(::google::protobuf::uint64 value);
 
+  // optional int64 epoch = 5;
+  inline bool has_epoch() const;
+  inline void clear_epoch();
+  static const int kEpochFieldNumber = 5;
+  inline ::google::protobuf::int64 epoch() const;
+  inline void set_epoch(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Lease)
  private:
   inline void set_has_expiration();
@@ -1532,6 +1788,8 @@ class Lease : public ::google::protobuf::Message {
   inline void clear_has_term();
   inline void set_has_raft_node_id();
   inline void clear_has_raft_node_id();
+  inline void set_has_epoch();
+  inline void clear_has_epoch();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1541,6 +1799,7 @@ class Lease : public ::google::protobuf::Message {
   ::google::protobuf::int64 duration_;
   ::google::protobuf::uint64 term_;
   ::google::protobuf::uint64 raft_node_id_;
+  ::google::protobuf::int64 epoch_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1550,14 +1809,14 @@ class Lease : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class MVCCMetadata : public ::google::protobuf::Message {
+class Liveness : public ::google::protobuf::Message {
  public:
-  MVCCMetadata();
-  virtual ~MVCCMetadata();
+  Liveness();
+  virtual ~Liveness();
 
-  MVCCMetadata(const MVCCMetadata& from);
+  Liveness(const Liveness& from);
 
-  inline MVCCMetadata& operator=(const MVCCMetadata& from) {
+  inline Liveness& operator=(const Liveness& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1571,17 +1830,17 @@ class MVCCMetadata : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const MVCCMetadata& default_instance();
+  static const Liveness& default_instance();
 
-  void Swap(MVCCMetadata* other);
+  void Swap(Liveness* other);
 
   // implements Message ----------------------------------------------
 
-  MVCCMetadata* New() const;
+  Liveness* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const MVCCMetadata& from);
-  void MergeFrom(const MVCCMetadata& from);
+  void CopyFrom(const Liveness& from);
+  void MergeFrom(const Liveness& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1603,96 +1862,72 @@ class MVCCMetadata : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.Transaction txn = 1;
-  inline bool has_txn() const;
-  inline void clear_txn();
-  static const int kTxnFieldNumber = 1;
-  inline const ::cockroach::proto::Transaction& txn() const;
-  inline ::cockroach::proto::Transaction* mutable_txn();
-  inline ::cockroach::proto::Transaction* release_txn();
-  inline void set_allocated_txn(::cockroach::proto::Transaction* txn);
-
-  // optional .cockroach.proto.Timestamp timestamp = 2;
-  inline bool has_timestamp() const;
-  inline void clear_timestamp();
-  static const int kTimestampFieldNumber = 2;
-  inline const ::cockroach::proto::Timestamp& timestamp() const;
-  inline ::cockroach::proto::Timestamp* mutable_timestamp();
-  inline ::cockroach::proto::Timestamp* release_timestamp();
-  inline void set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp);
-
-  // optional bool deleted = 3;
-  inline bool has_deleted() const;
-  inline void clear_deleted();
-  static const int kDeletedFieldNumber = 3;
-  inline bool deleted() const;
-  inline void set_deleted(bool value);
-
-  // optional int64 key_bytes = 4;
-  inline bool has_key_bytes() const;
-  inline void clear_key_bytes();
-  static const int kKeyBytesFieldNumber = 4;
-  inline ::google::protobuf::int64 key_bytes() const;
-  inline void set_key_bytes(::google::protobuf::int64 value);
-
-  // optional int64 val_bytes = 5;
-  inline bool has_val_bytes() const;
-  inline void clear_val_bytes();
-  static const int kValBytesFieldNumber = 5;
-  inline ::google::protobuf::int64 val_bytes() const;
-  inline void set_val_bytes(::google::protobuf::int64 value);
+  // optional int32 node_id = 1;
+  inline bool has_node_id() const;
+  inline void clear_node_id();
+  static const int kNodeIdFieldNumber = 1;
+  inline ::google::protobuf::int32 node_id() const;
+  inline void set_node_id(::google::protobuf::int32 value);
 
-  // optional .cockroach.proto.Value value = 6;
-  inline bool has_value() const;
-  inline void clear_value();
-  static const int kValueFieldNumber = 6;
-  inline const ::cockroach::proto::Value& value() const;
-  inline ::cockroach::proto::Value* mutable_value();
-  inline ::cockroach::proto::Value* release_value();
-  inline void set_allocated_value(::cockroach::proto::Value* value);
+  // optional int64 epoch = 2;
+  inline bool has_epoch() const;
+  inline void clear_epoch();
+  static const int kEpochFieldNumber = 2;
+  inline ::google::protobuf::int64 epoch() const;
+  inline void set_epoch(::google::protobuf::int64 value);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.MVCCMetadata)
+  // optional .cockroach.proto.Timestamp expiration = 3;
+  inline bool has_expiration() const;
+  inline void clear_expiration();
+  static const int kExpirationFieldNumber = 3;
+  inline const ::cockroach::proto::Timestamp& expiration() const;
+  inline ::cockroach::proto::Timestamp* mutable_expiration();
+  inline ::cockroach::proto::Timestamp* release_expiration();
+  inline void set_allocated_expiration(::cockroach::proto::Timestamp* expiration);
+
+  // optional bool decommissioning = 4;
+  inline bool has_decommissioning() const;
+  inline void clear_decommissioning();
+  static const int kDecommissioningFieldNumber = 4;
+  inline bool decommissioning() const;
+  inline void set_decommissioning(bool value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.Liveness)
  private:
-  inline void set_has_txn();
-  inline void clear_has_txn();
-  inline void set_has_timestamp();
-  inline void clear_has_timestamp();
-  inline void set_has_deleted();
-  inline void clear_has_deleted();
-  inline void set_has_key_bytes();
-  inline void clear_has_key_bytes();
-  inline void set_has_val_bytes();
-  inline void clear_has_val_bytes();
-  inline void set_has_value();
-  inline void clear_has_value();
+  inline void set_has_node_id();
+  inline void clear_has_node_id();
+  inline void set_has_epoch();
+  inline void clear_has_epoch();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
+  inline void set_has_decommissioning();
+  inline void clear_has_decommissioning();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::cockroach::proto::Transaction* txn_;
-  ::cockroach::proto::Timestamp* timestamp_;
-  ::google::protobuf::int64 key_bytes_;
-  ::google::protobuf::int64 val_bytes_;
-  ::cockroach::proto::Value* value_;
-  bool deleted_;
+  ::google::protobuf::int64 epoch_;
+  ::google::protobuf::int32 node_id_;
+  bool decommissioning_;
+  ::cockroach::proto::Timestamp* expiration_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
 
   void InitAsDefaultInstance();
-  static MVCCMetadata* default_instance_;
+  static Liveness* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class GCMetadata : public ::google::protobuf::Message {
+class ClusterVersion : public ::google::protobuf::Message {
  public:
-  GCMetadata();
-  virtual ~GCMetadata();
+  ClusterVersion();
+  virtual ~ClusterVersion();
 
-  GCMetadata(const GCMetadata& from);
+  ClusterVersion(const ClusterVersion& from);
 
-  inline GCMetadata& operator=(const GCMetadata& from) {
+  inline ClusterVersion& operator=(const ClusterVersion& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1706,17 +1941,17 @@ class GCMetadata : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const GCMetadata& default_instance();
+  static const ClusterVersion& default_instance();
 
-  void Swap(GCMetadata* other);
+  void Swap(ClusterVersion* other);
 
   // implements Message ----------------------------------------------
 
-  GCMetadata* New() const;
+  ClusterVersion* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const GCMetadata& from);
-  void MergeFrom(const GCMetadata& from);
+  void CopyFrom(const ClusterVersion& from);
+  void MergeFrom(const ClusterVersion& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1738,50 +1973,40 @@ class GCMetadata : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 last_scan_nanos = 1;
-  inline bool has_last_scan_nanos() const;
-  inline void clear_last_scan_nanos();
-  static const int kLastScanNanosFieldNumber = 1;
-  inline ::google::protobuf::int64 last_scan_nanos() const;
-  inline void set_last_scan_nanos(::google::protobuf::int64 value);
+  // optional int64 version = 1;
+  inline bool has_version() const;
+  inline void clear_version();
+  static const int kVersionFieldNumber = 1;
+  inline ::google::protobuf::int64 version() const;
+  inline void set_version(::google::protobuf::int64 value);
 
-  // optional int64 oldest_intent_nanos = 2;
-  inline bool has_oldest_intent_nanos() const;
-  inline void clear_oldest_intent_nanos();
-  static const int kOldestIntentNanosFieldNumber = 2;
-  inline ::google::protobuf::int64 oldest_intent_nanos() const;
-  inline void set_oldest_intent_nanos(::google::protobuf::int64 value);
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ClusterVersion)
  private:
-  inline void set_has_last_scan_nanos();
-  inline void clear_has_last_scan_nanos();
-  inline void set_has_oldest_intent_nanos();
-  inline void clear_has_oldest_intent_nanos();
+  inline void set_has_version();
+  inline void clear_has_version();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 last_scan_nanos_;
-  ::google::protobuf::int64 oldest_intent_nanos_;
+  ::google::protobuf::int64 version_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
 
   void InitAsDefaultInstance();
-  static GCMetadata* default_instance_;
+  static ClusterVersion* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class TimeSeriesDatapoint : public ::google::protobuf::Message {
+class MigrationLease : public ::google::protobuf::Message {
  public:
-  TimeSeriesDatapoint();
-  virtual ~TimeSeriesDatapoint();
+  MigrationLease();
+  virtual ~MigrationLease();
 
-  TimeSeriesDatapoint(const TimeSeriesDatapoint& from);
+  MigrationLease(const MigrationLease& from);
 
-  inline TimeSeriesDatapoint& operator=(const TimeSeriesDatapoint& from) {
+  inline MigrationLease& operator=(const MigrationLease& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1795,17 +2020,17 @@ class TimeSeriesDatapoint : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const TimeSeriesDatapoint& default_instance();
+  static const MigrationLease& default_instance();
 
-  void Swap(TimeSeriesDatapoint* other);
+  void Swap(MigrationLease* other);
 
   // implements Message ----------------------------------------------
 
-  TimeSeriesDatapoint* New() const;
+  MigrationLease* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const TimeSeriesDatapoint& from);
-  void MergeFrom(const TimeSeriesDatapoint& from);
+  void CopyFrom(const MigrationLease& from);
+  void MergeFrom(const MigrationLease& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1827,60 +2052,52 @@ class TimeSeriesDatapoint : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 timestamp_nanos = 1;
-  inline bool has_timestamp_nanos() const;
-  inline void clear_timestamp_nanos();
-  static const int kTimestampNanosFieldNumber = 1;
-  inline ::google::protobuf::int64 timestamp_nanos() const;
-  inline void set_timestamp_nanos(::google::protobuf::int64 value);
-
-  // optional int64 int_value = 2;
-  inline bool has_int_value() const;
-  inline void clear_int_value();
-  static const int kIntValueFieldNumber = 2;
-  inline ::google::protobuf::int64 int_value() const;
-  inline void set_int_value(::google::protobuf::int64 value);
+  // optional int32 node_id = 1;
+  inline bool has_node_id() const;
+  inline void clear_node_id();
+  static const int kNodeIdFieldNumber = 1;
+  inline ::google::protobuf::int32 node_id() const;
+  inline void set_node_id(::google::protobuf::int32 value);
 
-  // optional float float_value = 3;
-  inline bool has_float_value() const;
-  inline void clear_float_value();
-  static const int kFloatValueFieldNumber = 3;
-  inline float float_value() const;
-  inline void set_float_value(float value);
+  // optional .cockroach.proto.Timestamp expiration = 2;
+  inline bool has_expiration() const;
+  inline void clear_expiration();
+  static const int kExpirationFieldNumber = 2;
+  inline const ::cockroach::proto::Timestamp& expiration() const;
+  inline ::cockroach::proto::Timestamp* mutable_expiration();
+  inline ::cockroach::proto::Timestamp* release_expiration();
+  inline void set_allocated_expiration(::cockroach::proto::Timestamp* expiration);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.TimeSeriesDatapoint)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.MigrationLease)
  private:
-  inline void set_has_timestamp_nanos();
-  inline void clear_has_timestamp_nanos();
-  inline void set_has_int_value();
-  inline void clear_has_int_value();
-  inline void set_has_float_value();
-  inline void clear_has_float_value();
+  inline void set_has_node_id();
+  inline void clear_has_node_id();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 timestamp_nanos_;
-  ::google::protobuf::int64 int_value_;
-  float float_value_;
+  ::cockroach::proto::Timestamp* expiration_;
+  ::google::protobuf::int32 node_id_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
 
   void InitAsDefaultInstance();
-  static TimeSeriesDatapoint* default_instance_;
+  static MigrationLease* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class TimeSeriesData : public ::google::protobuf::Message {
+class NodeIDClaim : public ::google::protobuf::Message {
  public:
-  TimeSeriesData();
-  virtual ~TimeSeriesData();
+  NodeIDClaim();
+  virtual ~NodeIDClaim();
 
-  TimeSeriesData(const TimeSeriesData& from);
+  NodeIDClaim(const NodeIDClaim& from);
 
-  inline TimeSeriesData& operator=(const TimeSeriesData& from) {
+  inline NodeIDClaim& operator=(const NodeIDClaim& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1894,17 +2111,17 @@ class TimeSeriesData : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const TimeSeriesData& default_instance();
+  static const NodeIDClaim& default_instance();
 
-  void Swap(TimeSeriesData* other);
+  void Swap(NodeIDClaim* other);
 
   // implements Message ----------------------------------------------
 
-  TimeSeriesData* New() const;
+  NodeIDClaim* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const TimeSeriesData& from);
-  void MergeFrom(const TimeSeriesData& from);
+  void CopyFrom(const NodeIDClaim& from);
+  void MergeFrom(const NodeIDClaim& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1926,73 +2143,70 @@ class TimeSeriesData : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional string name = 1;
-  inline bool has_name() const;
-  inline void clear_name();
-  static const int kNameFieldNumber = 1;
-  inline const ::std::string& name() const;
-  inline void set_name(const ::std::string& value);
-  inline void set_name(const char* value);
-  inline void set_name(const char* value, size_t size);
-  inline ::std::string* mutable_name();
-  inline ::std::string* release_name();
-  inline void set_allocated_name(::std::string* name);
-
-  // optional string source = 2;
-  inline bool has_source() const;
-  inline void clear_source();
-  static const int kSourceFieldNumber = 2;
-  inline const ::std::string& source() const;
-  inline void set_source(const ::std::string& value);
-  inline void set_source(const char* value);
-  inline void set_source(const char* value, size_t size);
-  inline ::std::string* mutable_source();
-  inline ::std::string* release_source();
-  inline void set_allocated_source(::std::string* source);
+  // optional int32 node_id = 1;
+  inline bool has_node_id() const;
+  inline void clear_node_id();
+  static const int kNodeIdFieldNumber = 1;
+  inline ::google::protobuf::int32 node_id() const;
+  inline void set_node_id(::google::protobuf::int32 value);
 
-  // repeated .cockroach.proto.TimeSeriesDatapoint datapoints = 3;
-  inline int datapoints_size() const;
-  inline void clear_datapoints();
-  static const int kDatapointsFieldNumber = 3;
-  inline const ::cockroach::proto::TimeSeriesDatapoint& datapoints(int index) const;
-  inline ::cockroach::proto::TimeSeriesDatapoint* mutable_datapoints(int index);
-  inline ::cockroach::proto::TimeSeriesDatapoint* add_datapoints();
-  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TimeSeriesDatapoint >&
-      datapoints() const;
-  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TimeSeriesDatapoint >*
-      mutable_datapoints();
+  // optional string address = 2;
+  inline bool has_address() const;
+  inline void clear_address();
+  static const int kAddressFieldNumber = 2;
+  inline const ::std::string& address() const;
+  inline void set_address(const ::std::string& value);
+  inline void set_address(const char* value);
+  inline void set_address(const char* value, size_t size);
+  inline ::std::string* mutable_address();
+  inline ::std::string* release_address();
+  inline void set_allocated_address(::std::string* address);
+
+  // optional string cluster_id = 3;
+  inline bool has_cluster_id() const;
+  inline void clear_cluster_id();
+  static const int kClusterIdFieldNumber = 3;
+  inline const ::std::string& cluster_id() const;
+  inline void set_cluster_id(const ::std::string& value);
+  inline void set_cluster_id(const char* value);
+  inline void set_cluster_id(const char* value, size_t size);
+  inline ::std::string* mutable_cluster_id();
+  inline ::std::string* release_cluster_id();
+  inline void set_allocated_cluster_id(::std::string* cluster_id);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.TimeSeriesData)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.NodeIDClaim)
  private:
-  inline void set_has_name();
-  inline void clear_has_name();
-  inline void set_has_source();
-  inline void clear_has_source();
+  inline void set_has_node_id();
+  inline void clear_has_node_id();
+  inline void set_has_address();
+  inline void clear_has_address();
+  inline void set_has_cluster_id();
+  inline void clear_has_cluster_id();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::std::string* name_;
-  ::std::string* source_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TimeSeriesDatapoint > datapoints_;
+  ::std::string* address_;
+  ::std::string* cluster_id_;
+  ::google::protobuf::int32 node_id_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
 
   void InitAsDefaultInstance();
-  static TimeSeriesData* default_instance_;
+  static NodeIDClaim* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class MVCCStats : public ::google::protobuf::Message {
+class StoreIDClaim : public ::google::protobuf::Message {
  public:
-  MVCCStats();
-  virtual ~MVCCStats();
+  StoreIDClaim();
+  virtual ~StoreIDClaim();
 
-  MVCCStats(const MVCCStats& from);
+  StoreIDClaim(const StoreIDClaim& from);
 
-  inline MVCCStats& operator=(const MVCCStats& from) {
+  inline StoreIDClaim& operator=(const StoreIDClaim& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2006,17 +2220,17 @@ class MVCCStats : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const MVCCStats& default_instance();
+  static const StoreIDClaim& default_instance();
 
-  void Swap(MVCCStats* other);
+  void Swap(StoreIDClaim* other);
 
   // implements Message ----------------------------------------------
 
-  MVCCStats* New() const;
+  StoreIDClaim* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const MVCCStats& from);
-  void MergeFrom(const MVCCStats& from);
+  void CopyFrom(const StoreIDClaim& from);
+  void MergeFrom(const StoreIDClaim& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2038,2014 +2252,3399 @@ class MVCCStats : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 live_bytes = 1;
-  inline bool has_live_bytes() const;
-  inline void clear_live_bytes();
-  static const int kLiveBytesFieldNumber = 1;
-  inline ::google::protobuf::int64 live_bytes() const;
-  inline void set_live_bytes(::google::protobuf::int64 value);
+  // optional int32 store_id = 1;
+  inline bool has_store_id() const;
+  inline void clear_store_id();
+  static const int kStoreIdFieldNumber = 1;
+  inline ::google::protobuf::int32 store_id() const;
+  inline void set_store_id(::google::protobuf::int32 value);
 
-  // optional int64 key_bytes = 2;
-  inline bool has_key_bytes() const;
-  inline void clear_key_bytes();
-  static const int kKeyBytesFieldNumber = 2;
-  inline ::google::protobuf::int64 key_bytes() const;
-  inline void set_key_bytes(::google::protobuf::int64 value);
+  // optional int32 node_id = 2;
+  inline bool has_node_id() const;
+  inline void clear_node_id();
+  static const int kNodeIdFieldNumber = 2;
+  inline ::google::protobuf::int32 node_id() const;
+  inline void set_node_id(::google::protobuf::int32 value);
 
-  // optional int64 val_bytes = 3;
-  inline bool has_val_bytes() const;
-  inline void clear_val_bytes();
-  static const int kValBytesFieldNumber = 3;
-  inline ::google::protobuf::int64 val_bytes() const;
-  inline void set_val_bytes(::google::protobuf::int64 value);
+  // @@protoc_insertion_point(class_scope:cockroach.proto.StoreIDClaim)
+ private:
+  inline void set_has_store_id();
+  inline void clear_has_store_id();
+  inline void set_has_node_id();
+  inline void clear_has_node_id();
 
-  // optional int64 intent_bytes = 4;
-  inline bool has_intent_bytes() const;
-  inline void clear_intent_bytes();
-  static const int kIntentBytesFieldNumber = 4;
-  inline ::google::protobuf::int64 intent_bytes() const;
-  inline void set_intent_bytes(::google::protobuf::int64 value);
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  // optional int64 live_count = 5;
-  inline bool has_live_count() const;
-  inline void clear_live_count();
-  static const int kLiveCountFieldNumber = 5;
-  inline ::google::protobuf::int64 live_count() const;
-  inline void set_live_count(::google::protobuf::int64 value);
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int32 store_id_;
+  ::google::protobuf::int32 node_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
 
-  // optional int64 key_count = 6;
-  inline bool has_key_count() const;
-  inline void clear_key_count();
-  static const int kKeyCountFieldNumber = 6;
-  inline ::google::protobuf::int64 key_count() const;
-  inline void set_key_count(::google::protobuf::int64 value);
+  void InitAsDefaultInstance();
+  static StoreIDClaim* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional int64 val_count = 7;
-  inline bool has_val_count() const;
-  inline void clear_val_count();
-  static const int kValCountFieldNumber = 7;
-  inline ::google::protobuf::int64 val_count() const;
-  inline void set_val_count(::google::protobuf::int64 value);
+class MVCCMetadata : public ::google::protobuf::Message {
+ public:
+  MVCCMetadata();
+  virtual ~MVCCMetadata();
 
-  // optional int64 intent_count = 8;
-  inline bool has_intent_count() const;
-  inline void clear_intent_count();
-  static const int kIntentCountFieldNumber = 8;
-  inline ::google::protobuf::int64 intent_count() const;
-  inline void set_intent_count(::google::protobuf::int64 value);
+  MVCCMetadata(const MVCCMetadata& from);
 
-  // optional int64 intent_age = 9;
-  inline bool has_intent_age() const;
-  inline void clear_intent_age();
-  static const int kIntentAgeFieldNumber = 9;
-  inline ::google::protobuf::int64 intent_age() const;
-  inline void set_intent_age(::google::protobuf::int64 value);
+  inline MVCCMetadata& operator=(const MVCCMetadata& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional int64 gc_bytes_age = 10;
-  inline bool has_gc_bytes_age() const;
-  inline void clear_gc_bytes_age();
-  static const int kGcBytesAgeFieldNumber = 10;
-  inline ::google::protobuf::int64 gc_bytes_age() const;
-  inline void set_gc_bytes_age(::google::protobuf::int64 value);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
 
-  // optional int64 last_update_nanos = 11;
-  inline bool has_last_update_nanos() const;
-  inline void clear_last_update_nanos();
-  static const int kLastUpdateNanosFieldNumber = 11;
-  inline ::google::protobuf::int64 last_update_nanos() const;
-  inline void set_last_update_nanos(::google::protobuf::int64 value);
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.MVCCStats)
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const MVCCMetadata& default_instance();
+
+  void Swap(MVCCMetadata* other);
+
+  // implements Message ----------------------------------------------
+
+  MVCCMetadata* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const MVCCMetadata& from);
+  void MergeFrom(const MVCCMetadata& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.Transaction txn = 1;
+  inline bool has_txn() const;
+  inline void clear_txn();
+  static const int kTxnFieldNumber = 1;
+  inline const ::cockroach::proto::Transaction& txn() const;
+  inline ::cockroach::proto::Transaction* mutable_txn();
+  inline ::cockroach::proto::Transaction* release_txn();
+  inline void set_allocated_txn(::cockroach::proto::Transaction* txn);
+
+  // optional .cockroach.proto.Timestamp timestamp = 2;
+  inline bool has_timestamp() const;
+  inline void clear_timestamp();
+  static const int kTimestampFieldNumber = 2;
+  inline const ::cockroach::proto::Timestamp& timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_timestamp();
+  inline ::cockroach::proto::Timestamp* release_timestamp();
+  inline void set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp);
+
+  // optional bool deleted = 3;
+  inline bool has_deleted() const;
+  inline void clear_deleted();
+  static const int kDeletedFieldNumber = 3;
+  inline bool deleted() const;
+  inline void set_deleted(bool value);
+
+  // optional int64 key_bytes = 4;
+  inline bool has_key_bytes() const;
+  inline void clear_key_bytes();
+  static const int kKeyBytesFieldNumber = 4;
+  inline ::google::protobuf::int64 key_bytes() const;
+  inline void set_key_bytes(::google::protobuf::int64 value);
+
+  // optional int64 val_bytes = 5;
+  inline bool has_val_bytes() const;
+  inline void clear_val_bytes();
+  static const int kValBytesFieldNumber = 5;
+  inline ::google::protobuf::int64 val_bytes() const;
+  inline void set_val_bytes(::google::protobuf::int64 value);
+
+  // optional .cockroach.proto.Value value = 6;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 6;
+  inline const ::cockroach::proto::Value& value() const;
+  inline ::cockroach::proto::Value* mutable_value();
+  inline ::cockroach::proto::Value* release_value();
+  inline void set_allocated_value(::cockroach::proto::Value* value);
+
+  // repeated .cockroach.proto.SequencedValue intent_history = 7;
+  inline int intent_history_size() const;
+  inline void clear_intent_history();
+  static const int kIntentHistoryFieldNumber = 7;
+  inline const ::cockroach::proto::SequencedValue& intent_history(int index) const;
+  inline ::cockroach::proto::SequencedValue* mutable_intent_history(int index);
+  inline ::cockroach::proto::SequencedValue* add_intent_history();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequencedValue >&
+      intent_history() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequencedValue >*
+      mutable_intent_history();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.MVCCMetadata)
  private:
-  inline void set_has_live_bytes();
-  inline void clear_has_live_bytes();
+  inline void set_has_txn();
+  inline void clear_has_txn();
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_deleted();
+  inline void clear_has_deleted();
   inline void set_has_key_bytes();
   inline void clear_has_key_bytes();
   inline void set_has_val_bytes();
   inline void clear_has_val_bytes();
-  inline void set_has_intent_bytes();
-  inline void clear_has_intent_bytes();
-  inline void set_has_live_count();
-  inline void clear_has_live_count();
-  inline void set_has_key_count();
-  inline void clear_has_key_count();
-  inline void set_has_val_count();
-  inline void clear_has_val_count();
-  inline void set_has_intent_count();
-  inline void clear_has_intent_count();
-  inline void set_has_intent_age();
-  inline void clear_has_intent_age();
-  inline void set_has_gc_bytes_age();
-  inline void clear_has_gc_bytes_age();
-  inline void set_has_last_update_nanos();
-  inline void clear_has_last_update_nanos();
+  inline void set_has_value();
+  inline void clear_has_value();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 live_bytes_;
+  ::cockroach::proto::Transaction* txn_;
+  ::cockroach::proto::Timestamp* timestamp_;
   ::google::protobuf::int64 key_bytes_;
   ::google::protobuf::int64 val_bytes_;
-  ::google::protobuf::int64 intent_bytes_;
-  ::google::protobuf::int64 live_count_;
-  ::google::protobuf::int64 key_count_;
-  ::google::protobuf::int64 val_count_;
-  ::google::protobuf::int64 intent_count_;
-  ::google::protobuf::int64 intent_age_;
-  ::google::protobuf::int64 gc_bytes_age_;
-  ::google::protobuf::int64 last_update_nanos_;
+  ::cockroach::proto::Value* value_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequencedValue > intent_history_;
+  bool deleted_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
 
   void InitAsDefaultInstance();
-  static MVCCStats* default_instance_;
+  static MVCCMetadata* default_instance_;
 };
-// ===================================================================
+// -------------------------------------------------------------------
+
+class SequencedValue : public ::google::protobuf::Message {
+ public:
+  SequencedValue();
+  virtual ~SequencedValue();
+
+  SequencedValue(const SequencedValue& from);
+
+  inline SequencedValue& operator=(const SequencedValue& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const SequencedValue& default_instance();
+
+  void Swap(SequencedValue* other);
+
+  // implements Message ----------------------------------------------
+
+  SequencedValue* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const SequencedValue& from);
+  void MergeFrom(const SequencedValue& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 sequence = 1;
+  inline bool has_sequence() const;
+  inline void clear_sequence();
+  static const int kSequenceFieldNumber = 1;
+  inline ::google::protobuf::int32 sequence() const;
+  inline void set_sequence(::google::protobuf::int32 value);
+
+  // optional .cockroach.proto.MVCCValue value = 2;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 2;
+  inline const ::cockroach::proto::MVCCValue& value() const;
+  inline ::cockroach::proto::MVCCValue* mutable_value();
+  inline ::cockroach::proto::MVCCValue* release_value();
+  inline void set_allocated_value(::cockroach::proto::MVCCValue* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.SequencedValue)
+ private:
+  inline void set_has_sequence();
+  inline void clear_has_sequence();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::MVCCValue* value_;
+  ::google::protobuf::int32 sequence_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static SequencedValue* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class GCMetadata : public ::google::protobuf::Message {
+ public:
+  GCMetadata();
+  virtual ~GCMetadata();
+
+  GCMetadata(const GCMetadata& from);
+
+  inline GCMetadata& operator=(const GCMetadata& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const GCMetadata& default_instance();
+
+  void Swap(GCMetadata* other);
+
+  // implements Message ----------------------------------------------
+
+  GCMetadata* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const GCMetadata& from);
+  void MergeFrom(const GCMetadata& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 last_scan_nanos = 1;
+  inline bool has_last_scan_nanos() const;
+  inline void clear_last_scan_nanos();
+  static const int kLastScanNanosFieldNumber = 1;
+  inline ::google::protobuf::int64 last_scan_nanos() const;
+  inline void set_last_scan_nanos(::google::protobuf::int64 value);
+
+  // optional int64 oldest_intent_nanos = 2;
+  inline bool has_oldest_intent_nanos() const;
+  inline void clear_oldest_intent_nanos();
+  static const int kOldestIntentNanosFieldNumber = 2;
+  inline ::google::protobuf::int64 oldest_intent_nanos() const;
+  inline void set_oldest_intent_nanos(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.GCMetadata)
+ private:
+  inline void set_has_last_scan_nanos();
+  inline void clear_has_last_scan_nanos();
+  inline void set_has_oldest_intent_nanos();
+  inline void clear_has_oldest_intent_nanos();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 last_scan_nanos_;
+  ::google::protobuf::int64 oldest_intent_nanos_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static GCMetadata* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class TimeSeriesDatapoint : public ::google::protobuf::Message {
+ public:
+  TimeSeriesDatapoint();
+  virtual ~TimeSeriesDatapoint();
+
+  TimeSeriesDatapoint(const TimeSeriesDatapoint& from);
+
+  inline TimeSeriesDatapoint& operator=(const TimeSeriesDatapoint& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const TimeSeriesDatapoint& default_instance();
+
+  void Swap(TimeSeriesDatapoint* other);
+
+  // implements Message ----------------------------------------------
+
+  TimeSeriesDatapoint* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const TimeSeriesDatapoint& from);
+  void MergeFrom(const TimeSeriesDatapoint& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 timestamp_nanos = 1;
+  inline bool has_timestamp_nanos() const;
+  inline void clear_timestamp_nanos();
+  static const int kTimestampNanosFieldNumber = 1;
+  inline ::google::protobuf::int64 timestamp_nanos() const;
+  inline void set_timestamp_nanos(::google::protobuf::int64 value);
+
+  // optional int64 int_value = 2;
+  inline bool has_int_value() const;
+  inline void clear_int_value();
+  static const int kIntValueFieldNumber = 2;
+  inline ::google::protobuf::int64 int_value() const;
+  inline void set_int_value(::google::protobuf::int64 value);
+
+  // optional float float_value = 3;
+  inline bool has_float_value() const;
+  inline void clear_float_value();
+  static const int kFloatValueFieldNumber = 3;
+  inline float float_value() const;
+  inline void set_float_value(float value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.TimeSeriesDatapoint)
+ private:
+  inline void set_has_timestamp_nanos();
+  inline void clear_has_timestamp_nanos();
+  inline void set_has_int_value();
+  inline void clear_has_int_value();
+  inline void set_has_float_value();
+  inline void clear_has_float_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 timestamp_nanos_;
+  ::google::protobuf::int64 int_value_;
+  float float_value_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static TimeSeriesDatapoint* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class TimeSeriesData : public ::google::protobuf::Message {
+ public:
+  TimeSeriesData();
+  virtual ~TimeSeriesData();
+
+  TimeSeriesData(const TimeSeriesData& from);
+
+  inline TimeSeriesData& operator=(const TimeSeriesData& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const TimeSeriesData& default_instance();
+
+  void Swap(TimeSeriesData* other);
+
+  // implements Message ----------------------------------------------
+
+  TimeSeriesData* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const TimeSeriesData& from);
+  void MergeFrom(const TimeSeriesData& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string name = 1;
+  inline bool has_name() const;
+  inline void clear_name();
+  static const int kNameFieldNumber = 1;
+  inline const ::std::string& name() const;
+  inline void set_name(const ::std::string& value);
+  inline void set_name(const char* value);
+  inline void set_name(const char* value, size_t size);
+  inline ::std::string* mutable_name();
+  inline ::std::string* release_name();
+  inline void set_allocated_name(::std::string* name);
+
+  // optional string source = 2;
+  inline bool has_source() const;
+  inline void clear_source();
+  static const int kSourceFieldNumber = 2;
+  inline const ::std::string& source() const;
+  inline void set_source(const ::std::string& value);
+  inline void set_source(const char* value);
+  inline void set_source(const char* value, size_t size);
+  inline ::std::string* mutable_source();
+  inline ::std::string* release_source();
+  inline void set_allocated_source(::std::string* source);
+
+  // repeated .cockroach.proto.TimeSeriesDatapoint datapoints = 3;
+  inline int datapoints_size() const;
+  inline void clear_datapoints();
+  static const int kDatapointsFieldNumber = 3;
+  inline const ::cockroach::proto::TimeSeriesDatapoint& datapoints(int index) const;
+  inline ::cockroach::proto::TimeSeriesDatapoint* mutable_datapoints(int index);
+  inline ::cockroach::proto::TimeSeriesDatapoint* add_datapoints();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TimeSeriesDatapoint >&
+      datapoints() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TimeSeriesDatapoint >*
+      mutable_datapoints();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.TimeSeriesData)
+ private:
+  inline void set_has_name();
+  inline void clear_has_name();
+  inline void set_has_source();
+  inline void clear_has_source();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* name_;
+  ::std::string* source_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::TimeSeriesDatapoint > datapoints_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static TimeSeriesData* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class MVCCStats : public ::google::protobuf::Message {
+ public:
+  MVCCStats();
+  virtual ~MVCCStats();
+
+  MVCCStats(const MVCCStats& from);
+
+  inline MVCCStats& operator=(const MVCCStats& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const MVCCStats& default_instance();
+
+  void Swap(MVCCStats* other);
+
+  // implements Message ----------------------------------------------
+
+  MVCCStats* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const MVCCStats& from);
+  void MergeFrom(const MVCCStats& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 live_bytes = 1;
+  inline bool has_live_bytes() const;
+  inline void clear_live_bytes();
+  static const int kLiveBytesFieldNumber = 1;
+  inline ::google::protobuf::int64 live_bytes() const;
+  inline void set_live_bytes(::google::protobuf::int64 value);
+
+  // optional int64 key_bytes = 2;
+  inline bool has_key_bytes() const;
+  inline void clear_key_bytes();
+  static const int kKeyBytesFieldNumber = 2;
+  inline ::google::protobuf::int64 key_bytes() const;
+  inline void set_key_bytes(::google::protobuf::int64 value);
+
+  // optional int64 val_bytes = 3;
+  inline bool has_val_bytes() const;
+  inline void clear_val_bytes();
+  static const int kValBytesFieldNumber = 3;
+  inline ::google::protobuf::int64 val_bytes() const;
+  inline void set_val_bytes(::google::protobuf::int64 value);
+
+  // optional int64 intent_bytes = 4;
+  inline bool has_intent_bytes() const;
+  inline void clear_intent_bytes();
+  static const int kIntentBytesFieldNumber = 4;
+  inline ::google::protobuf::int64 intent_bytes() const;
+  inline void set_intent_bytes(::google::protobuf::int64 value);
+
+  // optional int64 live_count = 5;
+  inline bool has_live_count() const;
+  inline void clear_live_count();
+  static const int kLiveCountFieldNumber = 5;
+  inline ::google::protobuf::int64 live_count() const;
+  inline void set_live_count(::google::protobuf::int64 value);
+
+  // optional int64 key_count = 6;
+  inline bool has_key_count() const;
+  inline void clear_key_count();
+  static const int kKeyCountFieldNumber = 6;
+  inline ::google::protobuf::int64 key_count() const;
+  inline void set_key_count(::google::protobuf::int64 value);
+
+  // optional int64 val_count = 7;
+  inline bool has_val_count() const;
+  inline void clear_val_count();
+  static const int kValCountFieldNumber = 7;
+  inline ::google::protobuf::int64 val_count() const;
+  inline void set_val_count(::google::protobuf::int64 value);
+
+  // optional int64 intent_count = 8;
+  inline bool has_intent_count() const;
+  inline void clear_intent_count();
+  static const int kIntentCountFieldNumber = 8;
+  inline ::google::protobuf::int64 intent_count() const;
+  inline void set_intent_count(::google::protobuf::int64 value);
+
+  // optional int64 intent_age = 9;
+  inline bool has_intent_age() const;
+  inline void clear_intent_age();
+  static const int kIntentAgeFieldNumber = 9;
+  inline ::google::protobuf::int64 intent_age() const;
+  inline void set_intent_age(::google::protobuf::int64 value);
+
+  // optional int64 gc_bytes_age = 10;
+  inline bool has_gc_bytes_age() const;
+  inline void clear_gc_bytes_age();
+  static const int kGcBytesAgeFieldNumber = 10;
+  inline ::google::protobuf::int64 gc_bytes_age() const;
+  inline void set_gc_bytes_age(::google::protobuf::int64 value);
+
+  // optional int64 last_update_nanos = 11;
+  inline bool has_last_update_nanos() const;
+  inline void clear_last_update_nanos();
+  static const int kLastUpdateNanosFieldNumber = 11;
+  inline ::google::protobuf::int64 last_update_nanos() const;
+  inline void set_last_update_nanos(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.MVCCStats)
+ private:
+  inline void set_has_live_bytes();
+  inline void clear_has_live_bytes();
+  inline void set_has_key_bytes();
+  inline void clear_has_key_bytes();
+  inline void set_has_val_bytes();
+  inline void clear_has_val_bytes();
+  inline void set_has_intent_bytes();
+  inline void clear_has_intent_bytes();
+  inline void set_has_live_count();
+  inline void clear_has_live_count();
+  inline void set_has_key_count();
+  inline void clear_has_key_count();
+  inline void set_has_val_count();
+  inline void clear_has_val_count();
+  inline void set_has_intent_count();
+  inline void clear_has_intent_count();
+  inline void set_has_intent_age();
+  inline void clear_has_intent_age();
+  inline void set_has_gc_bytes_age();
+  inline void clear_has_gc_bytes_age();
+  inline void set_has_last_update_nanos();
+  inline void clear_has_last_update_nanos();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 live_bytes_;
+  ::google::protobuf::int64 key_bytes_;
+  ::google::protobuf::int64 val_bytes_;
+  ::google::protobuf::int64 intent_bytes_;
+  ::google::protobuf::int64 live_count_;
+  ::google::protobuf::int64 key_count_;
+  ::google::protobuf::int64 val_count_;
+  ::google::protobuf::int64 intent_count_;
+  ::google::protobuf::int64 intent_age_;
+  ::google::protobuf::int64 gc_bytes_age_;
+  ::google::protobuf::int64 last_update_nanos_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static MVCCStats* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+// Timestamp
+
+// optional int64 wall_time = 1;
+inline bool Timestamp::has_wall_time() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void Timestamp::set_has_wall_time() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void Timestamp::clear_has_wall_time() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void Timestamp::clear_wall_time() {
+  wall_time_ = GOOGLE_LONGLONG(0);
+  clear_has_wall_time();
+}
+inline ::google::protobuf::int64 Timestamp::wall_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Timestamp.wall_time)
+  return wall_time_;
+}
+inline void Timestamp::set_wall_time(::google::protobuf::int64 value) {
+  set_has_wall_time();
+  wall_time_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Timestamp.wall_time)
+}
+
+// optional int32 logical = 2;
+inline bool Timestamp::has_logical() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void Timestamp::set_has_logical() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void Timestamp::clear_has_logical() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void Timestamp::clear_logical() {
+  logical_ = 0;
+  clear_has_logical();
+}
+inline ::google::protobuf::int32 Timestamp::logical() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Timestamp.logical)
+  return logical_;
+}
+inline void Timestamp::set_logical(::google::protobuf::int32 value) {
+  set_has_logical();
+  logical_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Timestamp.logical)
+}
+
+// -------------------------------------------------------------------
+
+// Value
+
+// optional bytes bytes = 1;
+inline bool Value::has_bytes() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void Value::set_has_bytes() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void Value::clear_has_bytes() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void Value::clear_bytes() {
+  if (bytes_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    bytes_->clear();
+  }
+  clear_has_bytes();
+}
+inline const ::std::string& Value::bytes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.bytes)
+  return *bytes_;
+}
+inline void Value::set_bytes(const ::std::string& value) {
+  set_has_bytes();
+  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    bytes_ = new ::std::string;
+  }
+  bytes_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Value.bytes)
+}
+inline void Value::set_bytes(const char* value) {
+  set_has_bytes();
+  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    bytes_ = new ::std::string;
+  }
+  bytes_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Value.bytes)
+}
+inline void Value::set_bytes(const void* value, size_t size) {
+  set_has_bytes();
+  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    bytes_ = new ::std::string;
+  }
+  bytes_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Value.bytes)
+}
+inline ::std::string* Value::mutable_bytes() {
+  set_has_bytes();
+  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    bytes_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.bytes)
+  return bytes_;
+}
+inline ::std::string* Value::release_bytes() {
+  clear_has_bytes();
+  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = bytes_;
+    bytes_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void Value::set_allocated_bytes(::std::string* bytes) {
+  if (bytes_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete bytes_;
+  }
+  if (bytes) {
+    set_has_bytes();
+    bytes_ = bytes;
+  } else {
+    clear_has_bytes();
+    bytes_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.bytes)
+}
+
+// optional int64 integer = 2;
+inline bool Value::has_integer() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void Value::set_has_integer() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void Value::clear_has_integer() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void Value::clear_integer() {
+  integer_ = GOOGLE_LONGLONG(0);
+  clear_has_integer();
+}
+inline ::google::protobuf::int64 Value::integer() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.integer)
+  return integer_;
+}
+inline void Value::set_integer(::google::protobuf::int64 value) {
+  set_has_integer();
+  integer_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Value.integer)
+}
+
+// optional fixed32 checksum = 3;
+inline bool Value::has_checksum() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void Value::set_has_checksum() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void Value::clear_has_checksum() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void Value::clear_checksum() {
+  checksum_ = 0u;
+  clear_has_checksum();
+}
+inline ::google::protobuf::uint32 Value::checksum() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.checksum)
+  return checksum_;
+}
+inline void Value::set_checksum(::google::protobuf::uint32 value) {
+  set_has_checksum();
+  checksum_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Value.checksum)
+}
+
+// optional .cockroach.proto.Timestamp timestamp = 4;
+inline bool Value::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void Value::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void Value::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void Value::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& Value::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* Value::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* Value::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void Value::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.timestamp)
+}
+
+// optional string tag = 5;
+inline bool Value::has_tag() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void Value::set_has_tag() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void Value::clear_has_tag() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void Value::clear_tag() {
+  if (tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    tag_->clear();
+  }
+  clear_has_tag();
+}
+inline const ::std::string& Value::tag() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.tag)
+  return *tag_;
+}
+inline void Value::set_tag(const ::std::string& value) {
+  set_has_tag();
+  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    tag_ = new ::std::string;
+  }
+  tag_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Value.tag)
+}
+inline void Value::set_tag(const char* value) {
+  set_has_tag();
+  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    tag_ = new ::std::string;
+  }
+  tag_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Value.tag)
+}
+inline void Value::set_tag(const char* value, size_t size) {
+  set_has_tag();
+  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    tag_ = new ::std::string;
+  }
+  tag_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Value.tag)
+}
+inline ::std::string* Value::mutable_tag() {
+  set_has_tag();
+  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    tag_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.tag)
+  return tag_;
+}
+inline ::std::string* Value::release_tag() {
+  clear_has_tag();
+  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = tag_;
+    tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void Value::set_allocated_tag(::std::string* tag) {
+  if (tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete tag_;
+  }
+  if (tag) {
+    set_has_tag();
+    tag_ = tag;
+  } else {
+    clear_has_tag();
+    tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.tag)
+}
+
+// optional .cockroach.proto.ValueCompression compression = 6;
+inline bool Value::has_compression() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void Value::set_has_compression() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void Value::clear_has_compression() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void Value::clear_compression() {
+  compression_ = 0;
+  clear_has_compression();
+}
+inline ::cockroach::proto::ValueCompression Value::compression() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.compression)
+  return static_cast< ::cockroach::proto::ValueCompression >(compression_);
+}
+inline void Value::set_compression(::cockroach::proto::ValueCompression value) {
+  assert(::cockroach::proto::ValueCompression_IsValid(value));
+  set_has_compression();
+  compression_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Value.compression)
+}
+
+// -------------------------------------------------------------------
+
+// MVCCValue
+
+// optional bool deleted = 1;
+inline bool MVCCValue::has_deleted() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void MVCCValue::set_has_deleted() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void MVCCValue::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void MVCCValue::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+inline bool MVCCValue::deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCValue.deleted)
+  return deleted_;
+}
+inline void MVCCValue::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.MVCCValue.deleted)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool MVCCValue::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void MVCCValue::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void MVCCValue::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void MVCCValue::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& MVCCValue::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCValue.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* MVCCValue::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MVCCValue.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* MVCCValue::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void MVCCValue::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MVCCValue.value)
+}
+
+// -------------------------------------------------------------------
+
+// KeyValue
+
+// optional bytes key = 1;
+inline bool KeyValue::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void KeyValue::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void KeyValue::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void KeyValue::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& KeyValue::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValue.key)
+  return *key_;
+}
+inline void KeyValue::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.KeyValue.key)
+}
+inline void KeyValue::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.KeyValue.key)
+}
+inline void KeyValue::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.KeyValue.key)
+}
+inline ::std::string* KeyValue::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValue.key)
+  return key_;
+}
+inline ::std::string* KeyValue::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void KeyValue::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValue.key)
+}
 
+// optional .cockroach.proto.Value value = 2;
+inline bool KeyValue::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void KeyValue::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void KeyValue::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void KeyValue::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& KeyValue::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValue.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* KeyValue::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValue.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* KeyValue::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void KeyValue::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValue.value)
+}
 
-// ===================================================================
+// -------------------------------------------------------------------
 
-// Timestamp
+// Span
 
-// optional int64 wall_time = 1;
-inline bool Timestamp::has_wall_time() const {
+// optional bytes key = 1;
+inline bool Span::has_key() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Timestamp::set_has_wall_time() {
+inline void Span::set_has_key() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void Timestamp::clear_has_wall_time() {
+inline void Span::clear_has_key() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void Timestamp::clear_wall_time() {
-  wall_time_ = GOOGLE_LONGLONG(0);
-  clear_has_wall_time();
+inline void Span::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
 }
-inline ::google::protobuf::int64 Timestamp::wall_time() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Timestamp.wall_time)
-  return wall_time_;
+inline const ::std::string& Span::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Span.key)
+  return *key_;
 }
-inline void Timestamp::set_wall_time(::google::protobuf::int64 value) {
-  set_has_wall_time();
-  wall_time_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Timestamp.wall_time)
+inline void Span::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Span.key)
+}
+inline void Span::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Span.key)
+}
+inline void Span::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Span.key)
+}
+inline ::std::string* Span::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Span.key)
+  return key_;
+}
+inline ::std::string* Span::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void Span::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Span.key)
 }
 
-// optional int32 logical = 2;
-inline bool Timestamp::has_logical() const {
+// optional bytes end_key = 2;
+inline bool Span::has_end_key() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Timestamp::set_has_logical() {
+inline void Span::set_has_end_key() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void Timestamp::clear_has_logical() {
+inline void Span::clear_has_end_key() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void Timestamp::clear_logical() {
-  logical_ = 0;
-  clear_has_logical();
+inline void Span::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
 }
-inline ::google::protobuf::int32 Timestamp::logical() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Timestamp.logical)
-  return logical_;
+inline const ::std::string& Span::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Span.end_key)
+  return *end_key_;
 }
-inline void Timestamp::set_logical(::google::protobuf::int32 value) {
-  set_has_logical();
-  logical_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Timestamp.logical)
+inline void Span::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Span.end_key)
+}
+inline void Span::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Span.end_key)
+}
+inline void Span::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Span.end_key)
+}
+inline ::std::string* Span::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Span.end_key)
+  return end_key_;
+}
+inline ::std::string* Span::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void Span::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Span.end_key)
 }
 
 // -------------------------------------------------------------------
 
-// Value
+// RawKeyValue
 
-// optional bytes bytes = 1;
-inline bool Value::has_bytes() const {
+// optional bytes key = 1;
+inline bool RawKeyValue::has_key() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Value::set_has_bytes() {
+inline void RawKeyValue::set_has_key() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void Value::clear_has_bytes() {
+inline void RawKeyValue::clear_has_key() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void Value::clear_bytes() {
-  if (bytes_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    bytes_->clear();
+inline void RawKeyValue::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
   }
-  clear_has_bytes();
+  clear_has_key();
 }
-inline const ::std::string& Value::bytes() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Value.bytes)
-  return *bytes_;
+inline const ::std::string& RawKeyValue::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RawKeyValue.key)
+  return *key_;
 }
-inline void Value::set_bytes(const ::std::string& value) {
-  set_has_bytes();
-  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    bytes_ = new ::std::string;
+inline void RawKeyValue::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  bytes_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.Value.bytes)
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RawKeyValue.key)
 }
-inline void Value::set_bytes(const char* value) {
-  set_has_bytes();
-  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    bytes_ = new ::std::string;
+inline void RawKeyValue::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  bytes_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.Value.bytes)
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RawKeyValue.key)
 }
-inline void Value::set_bytes(const void* value, size_t size) {
-  set_has_bytes();
-  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    bytes_ = new ::std::string;
+inline void RawKeyValue::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  bytes_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Value.bytes)
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RawKeyValue.key)
 }
-inline ::std::string* Value::mutable_bytes() {
-  set_has_bytes();
-  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    bytes_ = new ::std::string;
+inline ::std::string* RawKeyValue::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.bytes)
-  return bytes_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RawKeyValue.key)
+  return key_;
 }
-inline ::std::string* Value::release_bytes() {
-  clear_has_bytes();
-  if (bytes_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* RawKeyValue::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = bytes_;
-    bytes_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void Value::set_allocated_bytes(::std::string* bytes) {
-  if (bytes_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete bytes_;
+inline void RawKeyValue::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
   }
-  if (bytes) {
-    set_has_bytes();
-    bytes_ = bytes;
+  if (key) {
+    set_has_key();
+    key_ = key;
   } else {
-    clear_has_bytes();
-    bytes_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.bytes)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RawKeyValue.key)
 }
 
-// optional int64 integer = 2;
-inline bool Value::has_integer() const {
+// optional bytes value = 2;
+inline bool RawKeyValue::has_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Value::set_has_integer() {
+inline void RawKeyValue::set_has_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void Value::clear_has_integer() {
+inline void RawKeyValue::clear_has_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void Value::clear_integer() {
-  integer_ = GOOGLE_LONGLONG(0);
-  clear_has_integer();
-}
-inline ::google::protobuf::int64 Value::integer() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Value.integer)
-  return integer_;
-}
-inline void Value::set_integer(::google::protobuf::int64 value) {
-  set_has_integer();
-  integer_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Value.integer)
-}
-
-// optional fixed32 checksum = 3;
-inline bool Value::has_checksum() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void Value::set_has_checksum() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void Value::clear_has_checksum() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-inline void Value::clear_checksum() {
-  checksum_ = 0u;
-  clear_has_checksum();
-}
-inline ::google::protobuf::uint32 Value::checksum() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Value.checksum)
-  return checksum_;
-}
-inline void Value::set_checksum(::google::protobuf::uint32 value) {
-  set_has_checksum();
-  checksum_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Value.checksum)
-}
-
-// optional .cockroach.proto.Timestamp timestamp = 4;
-inline bool Value::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
+inline void RawKeyValue::clear_value() {
+  if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    value_->clear();
+  }
+  clear_has_value();
 }
-inline void Value::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000008u;
+inline const ::std::string& RawKeyValue::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RawKeyValue.value)
+  return *value_;
 }
-inline void Value::clear_has_timestamp() {
-  _has_bits_[0] &= ~0x00000008u;
+inline void RawKeyValue::set_value(const ::std::string& value) {
+  set_has_value();
+  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    value_ = new ::std::string;
+  }
+  value_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RawKeyValue.value)
 }
-inline void Value::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void RawKeyValue::set_value(const char* value) {
+  set_has_value();
+  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    value_ = new ::std::string;
+  }
+  value_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RawKeyValue.value)
 }
-inline const ::cockroach::proto::Timestamp& Value::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Value.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline void RawKeyValue::set_value(const void* value, size_t size) {
+  set_has_value();
+  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    value_ = new ::std::string;
+  }
+  value_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RawKeyValue.value)
 }
-inline ::cockroach::proto::Timestamp* Value::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.timestamp)
-  return timestamp_;
+inline ::std::string* RawKeyValue::mutable_value() {
+  set_has_value();
+  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    value_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RawKeyValue.value)
+  return value_;
 }
-inline ::cockroach::proto::Timestamp* Value::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
-  return temp;
+inline ::std::string* RawKeyValue::release_value() {
+  clear_has_value();
+  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = value_;
+    value_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
 }
-inline void Value::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void RawKeyValue::set_allocated_value(::std::string* value) {
+  if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete value_;
+  }
+  if (value) {
+    set_has_value();
+    value_ = value;
   } else {
-    clear_has_timestamp();
+    clear_has_value();
+    value_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RawKeyValue.value)
 }
 
-// optional string tag = 5;
-inline bool Value::has_tag() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
+// -------------------------------------------------------------------
+
+// StoreIdent
+
+// optional string cluster_id = 1;
+inline bool StoreIdent::has_cluster_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Value::set_has_tag() {
-  _has_bits_[0] |= 0x00000010u;
+inline void StoreIdent::set_has_cluster_id() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void Value::clear_has_tag() {
-  _has_bits_[0] &= ~0x00000010u;
+inline void StoreIdent::clear_has_cluster_id() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void Value::clear_tag() {
-  if (tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    tag_->clear();
+inline void StoreIdent::clear_cluster_id() {
+  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_->clear();
   }
-  clear_has_tag();
+  clear_has_cluster_id();
 }
-inline const ::std::string& Value::tag() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Value.tag)
-  return *tag_;
+inline const ::std::string& StoreIdent::cluster_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIdent.cluster_id)
+  return *cluster_id_;
 }
-inline void Value::set_tag(const ::std::string& value) {
-  set_has_tag();
-  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    tag_ = new ::std::string;
+inline void StoreIdent::set_cluster_id(const ::std::string& value) {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
   }
-  tag_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.Value.tag)
+  cluster_id_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIdent.cluster_id)
 }
-inline void Value::set_tag(const char* value) {
-  set_has_tag();
-  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    tag_ = new ::std::string;
+inline void StoreIdent::set_cluster_id(const char* value) {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
   }
-  tag_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.Value.tag)
+  cluster_id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.StoreIdent.cluster_id)
 }
-inline void Value::set_tag(const char* value, size_t size) {
-  set_has_tag();
-  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    tag_ = new ::std::string;
+inline void StoreIdent::set_cluster_id(const char* value, size_t size) {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
   }
-  tag_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Value.tag)
-}
-inline ::std::string* Value::mutable_tag() {
-  set_has_tag();
-  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    tag_ = new ::std::string;
+  cluster_id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.StoreIdent.cluster_id)
+}
+inline ::std::string* StoreIdent::mutable_cluster_id() {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.tag)
-  return tag_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreIdent.cluster_id)
+  return cluster_id_;
 }
-inline ::std::string* Value::release_tag() {
-  clear_has_tag();
-  if (tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* StoreIdent::release_cluster_id() {
+  clear_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = tag_;
-    tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = cluster_id_;
+    cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void Value::set_allocated_tag(::std::string* tag) {
-  if (tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete tag_;
+inline void StoreIdent::set_allocated_cluster_id(::std::string* cluster_id) {
+  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete cluster_id_;
   }
-  if (tag) {
-    set_has_tag();
-    tag_ = tag;
+  if (cluster_id) {
+    set_has_cluster_id();
+    cluster_id_ = cluster_id;
   } else {
-    clear_has_tag();
-    tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_cluster_id();
+    cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.tag)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreIdent.cluster_id)
+}
+
+// optional int32 node_id = 2;
+inline bool StoreIdent::has_node_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void StoreIdent::set_has_node_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void StoreIdent::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void StoreIdent::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
+}
+inline ::google::protobuf::int32 StoreIdent::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIdent.node_id)
+  return node_id_;
+}
+inline void StoreIdent::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIdent.node_id)
+}
+
+// optional int32 store_id = 3;
+inline bool StoreIdent::has_store_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void StoreIdent::set_has_store_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void StoreIdent::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void StoreIdent::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
+}
+inline ::google::protobuf::int32 StoreIdent::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIdent.store_id)
+  return store_id_;
+}
+inline void StoreIdent::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIdent.store_id)
 }
 
 // -------------------------------------------------------------------
 
-// MVCCValue
+// SplitTrigger
 
-// optional bool deleted = 1;
-inline bool MVCCValue::has_deleted() const {
+// optional .cockroach.proto.RangeDescriptor updated_desc = 1;
+inline bool SplitTrigger::has_updated_desc() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void MVCCValue::set_has_deleted() {
+inline void SplitTrigger::set_has_updated_desc() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void MVCCValue::clear_has_deleted() {
+inline void SplitTrigger::clear_has_updated_desc() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void MVCCValue::clear_deleted() {
-  deleted_ = false;
-  clear_has_deleted();
+inline void SplitTrigger::clear_updated_desc() {
+  if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
+  clear_has_updated_desc();
 }
-inline bool MVCCValue::deleted() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCValue.deleted)
-  return deleted_;
+inline const ::cockroach::proto::RangeDescriptor& SplitTrigger::updated_desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SplitTrigger.updated_desc)
+  return updated_desc_ != NULL ? *updated_desc_ : *default_instance_->updated_desc_;
 }
-inline void MVCCValue::set_deleted(bool value) {
-  set_has_deleted();
-  deleted_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.MVCCValue.deleted)
+inline ::cockroach::proto::RangeDescriptor* SplitTrigger::mutable_updated_desc() {
+  set_has_updated_desc();
+  if (updated_desc_ == NULL) updated_desc_ = new ::cockroach::proto::RangeDescriptor;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.SplitTrigger.updated_desc)
+  return updated_desc_;
+}
+inline ::cockroach::proto::RangeDescriptor* SplitTrigger::release_updated_desc() {
+  clear_has_updated_desc();
+  ::cockroach::proto::RangeDescriptor* temp = updated_desc_;
+  updated_desc_ = NULL;
+  return temp;
+}
+inline void SplitTrigger::set_allocated_updated_desc(::cockroach::proto::RangeDescriptor* updated_desc) {
+  delete updated_desc_;
+  updated_desc_ = updated_desc;
+  if (updated_desc) {
+    set_has_updated_desc();
+  } else {
+    clear_has_updated_desc();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.SplitTrigger.updated_desc)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool MVCCValue::has_value() const {
+// optional .cockroach.proto.RangeDescriptor new_desc = 2;
+inline bool SplitTrigger::has_new_desc() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void MVCCValue::set_has_value() {
+inline void SplitTrigger::set_has_new_desc() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void MVCCValue::clear_has_value() {
+inline void SplitTrigger::clear_has_new_desc() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void MVCCValue::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void SplitTrigger::clear_new_desc() {
+  if (new_desc_ != NULL) new_desc_->::cockroach::proto::RangeDescriptor::Clear();
+  clear_has_new_desc();
 }
-inline const ::cockroach::proto::Value& MVCCValue::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCValue.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline const ::cockroach::proto::RangeDescriptor& SplitTrigger::new_desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SplitTrigger.new_desc)
+  return new_desc_ != NULL ? *new_desc_ : *default_instance_->new_desc_;
 }
-inline ::cockroach::proto::Value* MVCCValue::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.MVCCValue.value)
-  return value_;
+inline ::cockroach::proto::RangeDescriptor* SplitTrigger::mutable_new_desc() {
+  set_has_new_desc();
+  if (new_desc_ == NULL) new_desc_ = new ::cockroach::proto::RangeDescriptor;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.SplitTrigger.new_desc)
+  return new_desc_;
 }
-inline ::cockroach::proto::Value* MVCCValue::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
+inline ::cockroach::proto::RangeDescriptor* SplitTrigger::release_new_desc() {
+  clear_has_new_desc();
+  ::cockroach::proto::RangeDescriptor* temp = new_desc_;
+  new_desc_ = NULL;
   return temp;
 }
-inline void MVCCValue::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void SplitTrigger::set_allocated_new_desc(::cockroach::proto::RangeDescriptor* new_desc) {
+  delete new_desc_;
+  new_desc_ = new_desc;
+  if (new_desc) {
+    set_has_new_desc();
   } else {
-    clear_has_value();
+    clear_has_new_desc();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MVCCValue.value)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.SplitTrigger.new_desc)
 }
 
 // -------------------------------------------------------------------
 
-// KeyValue
+// MergeTrigger
 
-// optional bytes key = 1;
-inline bool KeyValue::has_key() const {
+// optional .cockroach.proto.RangeDescriptor updated_desc = 1;
+inline bool MergeTrigger::has_updated_desc() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void KeyValue::set_has_key() {
+inline void MergeTrigger::set_has_updated_desc() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void KeyValue::clear_has_key() {
+inline void MergeTrigger::clear_has_updated_desc() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void KeyValue::clear_key() {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_->clear();
-  }
-  clear_has_key();
-}
-inline const ::std::string& KeyValue::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValue.key)
-  return *key_;
-}
-inline void KeyValue::set_key(const ::std::string& value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.KeyValue.key)
-}
-inline void KeyValue::set_key(const char* value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.KeyValue.key)
+inline void MergeTrigger::clear_updated_desc() {
+  if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
+  clear_has_updated_desc();
 }
-inline void KeyValue::set_key(const void* value, size_t size) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.KeyValue.key)
+inline const ::cockroach::proto::RangeDescriptor& MergeTrigger::updated_desc() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MergeTrigger.updated_desc)
+  return updated_desc_ != NULL ? *updated_desc_ : *default_instance_->updated_desc_;
 }
-inline ::std::string* KeyValue::mutable_key() {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValue.key)
-  return key_;
+inline ::cockroach::proto::RangeDescriptor* MergeTrigger::mutable_updated_desc() {
+  set_has_updated_desc();
+  if (updated_desc_ == NULL) updated_desc_ = new ::cockroach::proto::RangeDescriptor;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MergeTrigger.updated_desc)
+  return updated_desc_;
 }
-inline ::std::string* KeyValue::release_key() {
-  clear_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = key_;
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline ::cockroach::proto::RangeDescriptor* MergeTrigger::release_updated_desc() {
+  clear_has_updated_desc();
+  ::cockroach::proto::RangeDescriptor* temp = updated_desc_;
+  updated_desc_ = NULL;
+  return temp;
 }
-inline void KeyValue::set_allocated_key(::std::string* key) {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
-  }
-  if (key) {
-    set_has_key();
-    key_ = key;
+inline void MergeTrigger::set_allocated_updated_desc(::cockroach::proto::RangeDescriptor* updated_desc) {
+  delete updated_desc_;
+  updated_desc_ = updated_desc;
+  if (updated_desc) {
+    set_has_updated_desc();
   } else {
-    clear_has_key();
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_updated_desc();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValue.key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MergeTrigger.updated_desc)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool KeyValue::has_value() const {
+// optional int64 subsumed_raft_id = 2;
+inline bool MergeTrigger::has_subsumed_raft_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void KeyValue::set_has_value() {
+inline void MergeTrigger::set_has_subsumed_raft_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void KeyValue::clear_has_value() {
+inline void MergeTrigger::clear_has_subsumed_raft_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void KeyValue::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
-}
-inline const ::cockroach::proto::Value& KeyValue::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValue.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
-}
-inline ::cockroach::proto::Value* KeyValue::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValue.value)
-  return value_;
+inline void MergeTrigger::clear_subsumed_raft_id() {
+  subsumed_raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_subsumed_raft_id();
 }
-inline ::cockroach::proto::Value* KeyValue::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 MergeTrigger::subsumed_raft_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MergeTrigger.subsumed_raft_id)
+  return subsumed_raft_id_;
 }
-inline void KeyValue::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValue.value)
+inline void MergeTrigger::set_subsumed_raft_id(::google::protobuf::int64 value) {
+  set_has_subsumed_raft_id();
+  subsumed_raft_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.MergeTrigger.subsumed_raft_id)
 }
 
 // -------------------------------------------------------------------
 
-// RawKeyValue
+// ChangeReplicasTrigger
 
-// optional bytes key = 1;
-inline bool RawKeyValue::has_key() const {
+// optional int32 node_id = 1;
+inline bool ChangeReplicasTrigger::has_node_id() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RawKeyValue::set_has_key() {
+inline void ChangeReplicasTrigger::set_has_node_id() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void RawKeyValue::clear_has_key() {
+inline void ChangeReplicasTrigger::clear_has_node_id() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void RawKeyValue::clear_key() {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_->clear();
-  }
-  clear_has_key();
+inline void ChangeReplicasTrigger::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
 }
-inline const ::std::string& RawKeyValue::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RawKeyValue.key)
-  return *key_;
+inline ::google::protobuf::int32 ChangeReplicasTrigger::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.node_id)
+  return node_id_;
 }
-inline void RawKeyValue::set_key(const ::std::string& value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RawKeyValue.key)
+inline void ChangeReplicasTrigger::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeReplicasTrigger.node_id)
 }
-inline void RawKeyValue::set_key(const char* value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RawKeyValue.key)
+
+// optional int32 store_id = 2;
+inline bool ChangeReplicasTrigger::has_store_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RawKeyValue::set_key(const void* value, size_t size) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RawKeyValue.key)
+inline void ChangeReplicasTrigger::set_has_store_id() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline ::std::string* RawKeyValue::mutable_key() {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RawKeyValue.key)
-  return key_;
+inline void ChangeReplicasTrigger::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::std::string* RawKeyValue::release_key() {
-  clear_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = key_;
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline void ChangeReplicasTrigger::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
 }
-inline void RawKeyValue::set_allocated_key(::std::string* key) {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
-  }
-  if (key) {
-    set_has_key();
-    key_ = key;
-  } else {
-    clear_has_key();
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RawKeyValue.key)
+inline ::google::protobuf::int32 ChangeReplicasTrigger::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.store_id)
+  return store_id_;
+}
+inline void ChangeReplicasTrigger::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeReplicasTrigger.store_id)
 }
 
-// optional bytes value = 2;
-inline bool RawKeyValue::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .cockroach.proto.ReplicaChangeType change_type = 3;
+inline bool ChangeReplicasTrigger::has_change_type() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void RawKeyValue::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ChangeReplicasTrigger::set_has_change_type() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void RawKeyValue::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ChangeReplicasTrigger::clear_has_change_type() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void RawKeyValue::clear_value() {
-  if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    value_->clear();
-  }
-  clear_has_value();
+inline void ChangeReplicasTrigger::clear_change_type() {
+  change_type_ = 0;
+  clear_has_change_type();
 }
-inline const ::std::string& RawKeyValue::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RawKeyValue.value)
-  return *value_;
+inline ::cockroach::proto::ReplicaChangeType ChangeReplicasTrigger::change_type() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.change_type)
+  return static_cast< ::cockroach::proto::ReplicaChangeType >(change_type_);
 }
-inline void RawKeyValue::set_value(const ::std::string& value) {
-  set_has_value();
-  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    value_ = new ::std::string;
-  }
-  value_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RawKeyValue.value)
+inline void ChangeReplicasTrigger::set_change_type(::cockroach::proto::ReplicaChangeType value) {
+  assert(::cockroach::proto::ReplicaChangeType_IsValid(value));
+  set_has_change_type();
+  change_type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeReplicasTrigger.change_type)
 }
-inline void RawKeyValue::set_value(const char* value) {
-  set_has_value();
-  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    value_ = new ::std::string;
-  }
-  value_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RawKeyValue.value)
+
+// repeated .cockroach.proto.Replica updated_replicas = 4;
+inline int ChangeReplicasTrigger::updated_replicas_size() const {
+  return updated_replicas_.size();
 }
-inline void RawKeyValue::set_value(const void* value, size_t size) {
-  set_has_value();
-  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    value_ = new ::std::string;
-  }
-  value_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RawKeyValue.value)
+inline void ChangeReplicasTrigger::clear_updated_replicas() {
+  updated_replicas_.Clear();
 }
-inline ::std::string* RawKeyValue::mutable_value() {
-  set_has_value();
-  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    value_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RawKeyValue.value)
-  return value_;
+inline const ::cockroach::proto::Replica& ChangeReplicasTrigger::updated_replicas(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
+  return updated_replicas_.Get(index);
 }
-inline ::std::string* RawKeyValue::release_value() {
-  clear_has_value();
-  if (value_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = value_;
-    value_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline ::cockroach::proto::Replica* ChangeReplicasTrigger::mutable_updated_replicas(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
+  return updated_replicas_.Mutable(index);
 }
-inline void RawKeyValue::set_allocated_value(::std::string* value) {
-  if (value_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete value_;
-  }
-  if (value) {
-    set_has_value();
-    value_ = value;
-  } else {
-    clear_has_value();
-    value_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RawKeyValue.value)
+inline ::cockroach::proto::Replica* ChangeReplicasTrigger::add_updated_replicas() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
+  return updated_replicas_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Replica >&
+ChangeReplicasTrigger::updated_replicas() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
+  return updated_replicas_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Replica >*
+ChangeReplicasTrigger::mutable_updated_replicas() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
+  return &updated_replicas_;
 }
 
 // -------------------------------------------------------------------
 
-// StoreIdent
+// InternalCommitTrigger
 
-// optional string cluster_id = 1;
-inline bool StoreIdent::has_cluster_id() const {
+// optional .cockroach.proto.SplitTrigger split_trigger = 1;
+inline bool InternalCommitTrigger::has_split_trigger() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void StoreIdent::set_has_cluster_id() {
+inline void InternalCommitTrigger::set_has_split_trigger() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void StoreIdent::clear_has_cluster_id() {
+inline void InternalCommitTrigger::clear_has_split_trigger() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void StoreIdent::clear_cluster_id() {
-  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    cluster_id_->clear();
-  }
-  clear_has_cluster_id();
-}
-inline const ::std::string& StoreIdent::cluster_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIdent.cluster_id)
-  return *cluster_id_;
-}
-inline void StoreIdent::set_cluster_id(const ::std::string& value) {
-  set_has_cluster_id();
-  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    cluster_id_ = new ::std::string;
-  }
-  cluster_id_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIdent.cluster_id)
-}
-inline void StoreIdent::set_cluster_id(const char* value) {
-  set_has_cluster_id();
-  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    cluster_id_ = new ::std::string;
-  }
-  cluster_id_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.StoreIdent.cluster_id)
+inline void InternalCommitTrigger::clear_split_trigger() {
+  if (split_trigger_ != NULL) split_trigger_->::cockroach::proto::SplitTrigger::Clear();
+  clear_has_split_trigger();
 }
-inline void StoreIdent::set_cluster_id(const char* value, size_t size) {
-  set_has_cluster_id();
-  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    cluster_id_ = new ::std::string;
-  }
-  cluster_id_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.StoreIdent.cluster_id)
+inline const ::cockroach::proto::SplitTrigger& InternalCommitTrigger::split_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.split_trigger)
+  return split_trigger_ != NULL ? *split_trigger_ : *default_instance_->split_trigger_;
 }
-inline ::std::string* StoreIdent::mutable_cluster_id() {
-  set_has_cluster_id();
-  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    cluster_id_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreIdent.cluster_id)
-  return cluster_id_;
+inline ::cockroach::proto::SplitTrigger* InternalCommitTrigger::mutable_split_trigger() {
+  set_has_split_trigger();
+  if (split_trigger_ == NULL) split_trigger_ = new ::cockroach::proto::SplitTrigger;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.split_trigger)
+  return split_trigger_;
 }
-inline ::std::string* StoreIdent::release_cluster_id() {
-  clear_has_cluster_id();
-  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = cluster_id_;
-    cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline ::cockroach::proto::SplitTrigger* InternalCommitTrigger::release_split_trigger() {
+  clear_has_split_trigger();
+  ::cockroach::proto::SplitTrigger* temp = split_trigger_;
+  split_trigger_ = NULL;
+  return temp;
 }
-inline void StoreIdent::set_allocated_cluster_id(::std::string* cluster_id) {
-  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete cluster_id_;
-  }
-  if (cluster_id) {
-    set_has_cluster_id();
-    cluster_id_ = cluster_id;
+inline void InternalCommitTrigger::set_allocated_split_trigger(::cockroach::proto::SplitTrigger* split_trigger) {
+  delete split_trigger_;
+  split_trigger_ = split_trigger;
+  if (split_trigger) {
+    set_has_split_trigger();
   } else {
-    clear_has_cluster_id();
-    cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_split_trigger();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreIdent.cluster_id)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCommitTrigger.split_trigger)
 }
 
-// optional int32 node_id = 2;
-inline bool StoreIdent::has_node_id() const {
+// optional .cockroach.proto.MergeTrigger merge_trigger = 2;
+inline bool InternalCommitTrigger::has_merge_trigger() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void StoreIdent::set_has_node_id() {
+inline void InternalCommitTrigger::set_has_merge_trigger() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void StoreIdent::clear_has_node_id() {
+inline void InternalCommitTrigger::clear_has_merge_trigger() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void StoreIdent::clear_node_id() {
-  node_id_ = 0;
-  clear_has_node_id();
+inline void InternalCommitTrigger::clear_merge_trigger() {
+  if (merge_trigger_ != NULL) merge_trigger_->::cockroach::proto::MergeTrigger::Clear();
+  clear_has_merge_trigger();
 }
-inline ::google::protobuf::int32 StoreIdent::node_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIdent.node_id)
-  return node_id_;
+inline const ::cockroach::proto::MergeTrigger& InternalCommitTrigger::merge_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.merge_trigger)
+  return merge_trigger_ != NULL ? *merge_trigger_ : *default_instance_->merge_trigger_;
 }
-inline void StoreIdent::set_node_id(::google::protobuf::int32 value) {
-  set_has_node_id();
-  node_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIdent.node_id)
+inline ::cockroach::proto::MergeTrigger* InternalCommitTrigger::mutable_merge_trigger() {
+  set_has_merge_trigger();
+  if (merge_trigger_ == NULL) merge_trigger_ = new ::cockroach::proto::MergeTrigger;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.merge_trigger)
+  return merge_trigger_;
+}
+inline ::cockroach::proto::MergeTrigger* InternalCommitTrigger::release_merge_trigger() {
+  clear_has_merge_trigger();
+  ::cockroach::proto::MergeTrigger* temp = merge_trigger_;
+  merge_trigger_ = NULL;
+  return temp;
+}
+inline void InternalCommitTrigger::set_allocated_merge_trigger(::cockroach::proto::MergeTrigger* merge_trigger) {
+  delete merge_trigger_;
+  merge_trigger_ = merge_trigger;
+  if (merge_trigger) {
+    set_has_merge_trigger();
+  } else {
+    clear_has_merge_trigger();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCommitTrigger.merge_trigger)
 }
 
-// optional int32 store_id = 3;
-inline bool StoreIdent::has_store_id() const {
+// optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
+inline bool InternalCommitTrigger::has_change_replicas_trigger() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void StoreIdent::set_has_store_id() {
+inline void InternalCommitTrigger::set_has_change_replicas_trigger() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void StoreIdent::clear_has_store_id() {
+inline void InternalCommitTrigger::clear_has_change_replicas_trigger() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void StoreIdent::clear_store_id() {
-  store_id_ = 0;
-  clear_has_store_id();
+inline void InternalCommitTrigger::clear_change_replicas_trigger() {
+  if (change_replicas_trigger_ != NULL) change_replicas_trigger_->::cockroach::proto::ChangeReplicasTrigger::Clear();
+  clear_has_change_replicas_trigger();
+}
+inline const ::cockroach::proto::ChangeReplicasTrigger& InternalCommitTrigger::change_replicas_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.change_replicas_trigger)
+  return change_replicas_trigger_ != NULL ? *change_replicas_trigger_ : *default_instance_->change_replicas_trigger_;
+}
+inline ::cockroach::proto::ChangeReplicasTrigger* InternalCommitTrigger::mutable_change_replicas_trigger() {
+  set_has_change_replicas_trigger();
+  if (change_replicas_trigger_ == NULL) change_replicas_trigger_ = new ::cockroach::proto::ChangeReplicasTrigger;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.change_replicas_trigger)
+  return change_replicas_trigger_;
+}
+inline ::cockroach::proto::ChangeReplicasTrigger* InternalCommitTrigger::release_change_replicas_trigger() {
+  clear_has_change_replicas_trigger();
+  ::cockroach::proto::ChangeReplicasTrigger* temp = change_replicas_trigger_;
+  change_replicas_trigger_ = NULL;
+  return temp;
+}
+inline void InternalCommitTrigger::set_allocated_change_replicas_trigger(::cockroach::proto::ChangeReplicasTrigger* change_replicas_trigger) {
+  delete change_replicas_trigger_;
+  change_replicas_trigger_ = change_replicas_trigger;
+  if (change_replicas_trigger) {
+    set_has_change_replicas_trigger();
+  } else {
+    clear_has_change_replicas_trigger();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCommitTrigger.change_replicas_trigger)
+}
+
+// repeated bytes intents = 4;
+inline int InternalCommitTrigger::intents_size() const {
+  return intents_.size();
+}
+inline void InternalCommitTrigger::clear_intents() {
+  intents_.Clear();
+}
+inline const ::std::string& InternalCommitTrigger::intents(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.intents)
+  return intents_.Get(index);
+}
+inline ::std::string* InternalCommitTrigger::mutable_intents(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.intents)
+  return intents_.Mutable(index);
+}
+inline void InternalCommitTrigger::set_intents(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalCommitTrigger.intents)
+  intents_.Mutable(index)->assign(value);
+}
+inline void InternalCommitTrigger::set_intents(int index, const char* value) {
+  intents_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalCommitTrigger.intents)
+}
+inline void InternalCommitTrigger::set_intents(int index, const void* value, size_t size) {
+  intents_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalCommitTrigger.intents)
+}
+inline ::std::string* InternalCommitTrigger::add_intents() {
+  return intents_.Add();
+}
+inline void InternalCommitTrigger::add_intents(const ::std::string& value) {
+  intents_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.InternalCommitTrigger.intents)
+}
+inline void InternalCommitTrigger::add_intents(const char* value) {
+  intents_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.InternalCommitTrigger.intents)
+}
+inline void InternalCommitTrigger::add_intents(const void* value, size_t size) {
+  intents_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.InternalCommitTrigger.intents)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+InternalCommitTrigger::intents() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.InternalCommitTrigger.intents)
+  return intents_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+InternalCommitTrigger::mutable_intents() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalCommitTrigger.intents)
+  return &intents_;
+}
+
+// -------------------------------------------------------------------
+
+// NodeList
+
+// repeated int32 nodes = 1 [packed = true];
+inline int NodeList::nodes_size() const {
+  return nodes_.size();
+}
+inline void NodeList::clear_nodes() {
+  nodes_.Clear();
+}
+inline ::google::protobuf::int32 NodeList::nodes(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.NodeList.nodes)
+  return nodes_.Get(index);
+}
+inline void NodeList::set_nodes(int index, ::google::protobuf::int32 value) {
+  nodes_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.NodeList.nodes)
 }
-inline ::google::protobuf::int32 StoreIdent::store_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIdent.store_id)
-  return store_id_;
+inline void NodeList::add_nodes(::google::protobuf::int32 value) {
+  nodes_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.NodeList.nodes)
 }
-inline void StoreIdent::set_store_id(::google::protobuf::int32 value) {
-  set_has_store_id();
-  store_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIdent.store_id)
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
+NodeList::nodes() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.NodeList.nodes)
+  return nodes_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
+NodeList::mutable_nodes() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.NodeList.nodes)
+  return &nodes_;
 }
 
 // -------------------------------------------------------------------
 
-// SplitTrigger
+// Transaction
 
-// optional .cockroach.proto.RangeDescriptor updated_desc = 1;
-inline bool SplitTrigger::has_updated_desc() const {
+// optional string name = 1;
+inline bool Transaction::has_name() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void SplitTrigger::set_has_updated_desc() {
+inline void Transaction::set_has_name() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void SplitTrigger::clear_has_updated_desc() {
+inline void Transaction::clear_has_name() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void SplitTrigger::clear_updated_desc() {
-  if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
-  clear_has_updated_desc();
+inline void Transaction::clear_name() {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_->clear();
+  }
+  clear_has_name();
 }
-inline const ::cockroach::proto::RangeDescriptor& SplitTrigger::updated_desc() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.SplitTrigger.updated_desc)
-  return updated_desc_ != NULL ? *updated_desc_ : *default_instance_->updated_desc_;
+inline const ::std::string& Transaction::name() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.name)
+  return *name_;
 }
-inline ::cockroach::proto::RangeDescriptor* SplitTrigger::mutable_updated_desc() {
-  set_has_updated_desc();
-  if (updated_desc_ == NULL) updated_desc_ = new ::cockroach::proto::RangeDescriptor;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.SplitTrigger.updated_desc)
-  return updated_desc_;
+inline void Transaction::set_name(const ::std::string& value) {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
+  }
+  name_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.name)
 }
-inline ::cockroach::proto::RangeDescriptor* SplitTrigger::release_updated_desc() {
-  clear_has_updated_desc();
-  ::cockroach::proto::RangeDescriptor* temp = updated_desc_;
-  updated_desc_ = NULL;
-  return temp;
+inline void Transaction::set_name(const char* value) {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
+  }
+  name_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Transaction.name)
 }
-inline void SplitTrigger::set_allocated_updated_desc(::cockroach::proto::RangeDescriptor* updated_desc) {
-  delete updated_desc_;
-  updated_desc_ = updated_desc;
-  if (updated_desc) {
-    set_has_updated_desc();
+inline void Transaction::set_name(const char* value, size_t size) {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
+  }
+  name_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Transaction.name)
+}
+inline ::std::string* Transaction::mutable_name() {
+  set_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    name_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.name)
+  return name_;
+}
+inline ::std::string* Transaction::release_name() {
+  clear_has_name();
+  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_updated_desc();
+    ::std::string* temp = name_;
+    name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.SplitTrigger.updated_desc)
+}
+inline void Transaction::set_allocated_name(::std::string* name) {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete name_;
+  }
+  if (name) {
+    set_has_name();
+    name_ = name;
+  } else {
+    clear_has_name();
+    name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.name)
 }
 
-// optional .cockroach.proto.RangeDescriptor new_desc = 2;
-inline bool SplitTrigger::has_new_desc() const {
+// optional bytes key = 2;
+inline bool Transaction::has_key() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void SplitTrigger::set_has_new_desc() {
+inline void Transaction::set_has_key() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void SplitTrigger::clear_has_new_desc() {
+inline void Transaction::clear_has_key() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void SplitTrigger::clear_new_desc() {
-  if (new_desc_ != NULL) new_desc_->::cockroach::proto::RangeDescriptor::Clear();
-  clear_has_new_desc();
+inline void Transaction::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
 }
-inline const ::cockroach::proto::RangeDescriptor& SplitTrigger::new_desc() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.SplitTrigger.new_desc)
-  return new_desc_ != NULL ? *new_desc_ : *default_instance_->new_desc_;
+inline const ::std::string& Transaction::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.key)
+  return *key_;
 }
-inline ::cockroach::proto::RangeDescriptor* SplitTrigger::mutable_new_desc() {
-  set_has_new_desc();
-  if (new_desc_ == NULL) new_desc_ = new ::cockroach::proto::RangeDescriptor;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.SplitTrigger.new_desc)
-  return new_desc_;
+inline void Transaction::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.key)
 }
-inline ::cockroach::proto::RangeDescriptor* SplitTrigger::release_new_desc() {
-  clear_has_new_desc();
-  ::cockroach::proto::RangeDescriptor* temp = new_desc_;
-  new_desc_ = NULL;
-  return temp;
+inline void Transaction::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Transaction.key)
 }
-inline void SplitTrigger::set_allocated_new_desc(::cockroach::proto::RangeDescriptor* new_desc) {
-  delete new_desc_;
-  new_desc_ = new_desc;
-  if (new_desc) {
-    set_has_new_desc();
+inline void Transaction::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Transaction.key)
+}
+inline ::std::string* Transaction::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.key)
+  return key_;
+}
+inline ::std::string* Transaction::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_new_desc();
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.SplitTrigger.new_desc)
+}
+inline void Transaction::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.key)
 }
 
-// -------------------------------------------------------------------
-
-// MergeTrigger
-
-// optional .cockroach.proto.RangeDescriptor updated_desc = 1;
-inline bool MergeTrigger::has_updated_desc() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional bytes id = 3;
+inline bool Transaction::has_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void MergeTrigger::set_has_updated_desc() {
-  _has_bits_[0] |= 0x00000001u;
+inline void Transaction::set_has_id() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void MergeTrigger::clear_has_updated_desc() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void Transaction::clear_has_id() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void MergeTrigger::clear_updated_desc() {
-  if (updated_desc_ != NULL) updated_desc_->::cockroach::proto::RangeDescriptor::Clear();
-  clear_has_updated_desc();
+inline void Transaction::clear_id() {
+  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    id_->clear();
+  }
+  clear_has_id();
 }
-inline const ::cockroach::proto::RangeDescriptor& MergeTrigger::updated_desc() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.MergeTrigger.updated_desc)
-  return updated_desc_ != NULL ? *updated_desc_ : *default_instance_->updated_desc_;
+inline const ::std::string& Transaction::id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.id)
+  return *id_;
+}
+inline void Transaction::set_id(const ::std::string& value) {
+  set_has_id();
+  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    id_ = new ::std::string;
+  }
+  id_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.id)
+}
+inline void Transaction::set_id(const char* value) {
+  set_has_id();
+  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    id_ = new ::std::string;
+  }
+  id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Transaction.id)
+}
+inline void Transaction::set_id(const void* value, size_t size) {
+  set_has_id();
+  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    id_ = new ::std::string;
+  }
+  id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Transaction.id)
 }
-inline ::cockroach::proto::RangeDescriptor* MergeTrigger::mutable_updated_desc() {
-  set_has_updated_desc();
-  if (updated_desc_ == NULL) updated_desc_ = new ::cockroach::proto::RangeDescriptor;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.MergeTrigger.updated_desc)
-  return updated_desc_;
+inline ::std::string* Transaction::mutable_id() {
+  set_has_id();
+  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    id_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.id)
+  return id_;
 }
-inline ::cockroach::proto::RangeDescriptor* MergeTrigger::release_updated_desc() {
-  clear_has_updated_desc();
-  ::cockroach::proto::RangeDescriptor* temp = updated_desc_;
-  updated_desc_ = NULL;
-  return temp;
+inline ::std::string* Transaction::release_id() {
+  clear_has_id();
+  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = id_;
+    id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
 }
-inline void MergeTrigger::set_allocated_updated_desc(::cockroach::proto::RangeDescriptor* updated_desc) {
-  delete updated_desc_;
-  updated_desc_ = updated_desc;
-  if (updated_desc) {
-    set_has_updated_desc();
+inline void Transaction::set_allocated_id(::std::string* id) {
+  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete id_;
+  }
+  if (id) {
+    set_has_id();
+    id_ = id;
   } else {
-    clear_has_updated_desc();
+    clear_has_id();
+    id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MergeTrigger.updated_desc)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.id)
 }
 
-// optional int64 subsumed_raft_id = 2;
-inline bool MergeTrigger::has_subsumed_raft_id() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional int32 priority = 4;
+inline bool Transaction::has_priority() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void MergeTrigger::set_has_subsumed_raft_id() {
-  _has_bits_[0] |= 0x00000002u;
+inline void Transaction::set_has_priority() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void MergeTrigger::clear_has_subsumed_raft_id() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void Transaction::clear_has_priority() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline void MergeTrigger::clear_subsumed_raft_id() {
-  subsumed_raft_id_ = GOOGLE_LONGLONG(0);
-  clear_has_subsumed_raft_id();
+inline void Transaction::clear_priority() {
+  priority_ = 0;
+  clear_has_priority();
 }
-inline ::google::protobuf::int64 MergeTrigger::subsumed_raft_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.MergeTrigger.subsumed_raft_id)
-  return subsumed_raft_id_;
+inline ::google::protobuf::int32 Transaction::priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.priority)
+  return priority_;
 }
-inline void MergeTrigger::set_subsumed_raft_id(::google::protobuf::int64 value) {
-  set_has_subsumed_raft_id();
-  subsumed_raft_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.MergeTrigger.subsumed_raft_id)
+inline void Transaction::set_priority(::google::protobuf::int32 value) {
+  set_has_priority();
+  priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.priority)
 }
 
-// -------------------------------------------------------------------
-
-// ChangeReplicasTrigger
-
-// optional int32 node_id = 1;
-inline bool ChangeReplicasTrigger::has_node_id() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.IsolationType isolation = 5;
+inline bool Transaction::has_isolation() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
 }
-inline void ChangeReplicasTrigger::set_has_node_id() {
-  _has_bits_[0] |= 0x00000001u;
+inline void Transaction::set_has_isolation() {
+  _has_bits_[0] |= 0x00000010u;
 }
-inline void ChangeReplicasTrigger::clear_has_node_id() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void Transaction::clear_has_isolation() {
+  _has_bits_[0] &= ~0x00000010u;
 }
-inline void ChangeReplicasTrigger::clear_node_id() {
-  node_id_ = 0;
-  clear_has_node_id();
+inline void Transaction::clear_isolation() {
+  isolation_ = 0;
+  clear_has_isolation();
 }
-inline ::google::protobuf::int32 ChangeReplicasTrigger::node_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.node_id)
-  return node_id_;
+inline ::cockroach::proto::IsolationType Transaction::isolation() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.isolation)
+  return static_cast< ::cockroach::proto::IsolationType >(isolation_);
 }
-inline void ChangeReplicasTrigger::set_node_id(::google::protobuf::int32 value) {
-  set_has_node_id();
-  node_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeReplicasTrigger.node_id)
+inline void Transaction::set_isolation(::cockroach::proto::IsolationType value) {
+  assert(::cockroach::proto::IsolationType_IsValid(value));
+  set_has_isolation();
+  isolation_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.isolation)
 }
 
-// optional int32 store_id = 2;
-inline bool ChangeReplicasTrigger::has_store_id() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .cockroach.proto.TransactionStatus status = 6;
+inline bool Transaction::has_status() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
 }
-inline void ChangeReplicasTrigger::set_has_store_id() {
-  _has_bits_[0] |= 0x00000002u;
+inline void Transaction::set_has_status() {
+  _has_bits_[0] |= 0x00000020u;
 }
-inline void ChangeReplicasTrigger::clear_has_store_id() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void Transaction::clear_has_status() {
+  _has_bits_[0] &= ~0x00000020u;
 }
-inline void ChangeReplicasTrigger::clear_store_id() {
-  store_id_ = 0;
-  clear_has_store_id();
+inline void Transaction::clear_status() {
+  status_ = 0;
+  clear_has_status();
 }
-inline ::google::protobuf::int32 ChangeReplicasTrigger::store_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.store_id)
-  return store_id_;
+inline ::cockroach::proto::TransactionStatus Transaction::status() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.status)
+  return static_cast< ::cockroach::proto::TransactionStatus >(status_);
 }
-inline void ChangeReplicasTrigger::set_store_id(::google::protobuf::int32 value) {
-  set_has_store_id();
-  store_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeReplicasTrigger.store_id)
+inline void Transaction::set_status(::cockroach::proto::TransactionStatus value) {
+  assert(::cockroach::proto::TransactionStatus_IsValid(value));
+  set_has_status();
+  status_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.status)
 }
 
-// optional .cockroach.proto.ReplicaChangeType change_type = 3;
-inline bool ChangeReplicasTrigger::has_change_type() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional int32 epoch = 7;
+inline bool Transaction::has_epoch() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
 }
-inline void ChangeReplicasTrigger::set_has_change_type() {
-  _has_bits_[0] |= 0x00000004u;
+inline void Transaction::set_has_epoch() {
+  _has_bits_[0] |= 0x00000040u;
 }
-inline void ChangeReplicasTrigger::clear_has_change_type() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void Transaction::clear_has_epoch() {
+  _has_bits_[0] &= ~0x00000040u;
 }
-inline void ChangeReplicasTrigger::clear_change_type() {
-  change_type_ = 0;
-  clear_has_change_type();
+inline void Transaction::clear_epoch() {
+  epoch_ = 0;
+  clear_has_epoch();
 }
-inline ::cockroach::proto::ReplicaChangeType ChangeReplicasTrigger::change_type() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.change_type)
-  return static_cast< ::cockroach::proto::ReplicaChangeType >(change_type_);
+inline ::google::protobuf::int32 Transaction::epoch() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.epoch)
+  return epoch_;
 }
-inline void ChangeReplicasTrigger::set_change_type(::cockroach::proto::ReplicaChangeType value) {
-  assert(::cockroach::proto::ReplicaChangeType_IsValid(value));
-  set_has_change_type();
-  change_type_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeReplicasTrigger.change_type)
+inline void Transaction::set_epoch(::google::protobuf::int32 value) {
+  set_has_epoch();
+  epoch_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.epoch)
 }
 
-// repeated .cockroach.proto.Replica updated_replicas = 4;
-inline int ChangeReplicasTrigger::updated_replicas_size() const {
-  return updated_replicas_.size();
+// optional .cockroach.proto.Timestamp last_heartbeat = 8;
+inline bool Transaction::has_last_heartbeat() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
 }
-inline void ChangeReplicasTrigger::clear_updated_replicas() {
-  updated_replicas_.Clear();
+inline void Transaction::set_has_last_heartbeat() {
+  _has_bits_[0] |= 0x00000080u;
 }
-inline const ::cockroach::proto::Replica& ChangeReplicasTrigger::updated_replicas(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
-  return updated_replicas_.Get(index);
+inline void Transaction::clear_has_last_heartbeat() {
+  _has_bits_[0] &= ~0x00000080u;
 }
-inline ::cockroach::proto::Replica* ChangeReplicasTrigger::mutable_updated_replicas(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
-  return updated_replicas_.Mutable(index);
+inline void Transaction::clear_last_heartbeat() {
+  if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
+  clear_has_last_heartbeat();
 }
-inline ::cockroach::proto::Replica* ChangeReplicasTrigger::add_updated_replicas() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
-  return updated_replicas_.Add();
+inline const ::cockroach::proto::Timestamp& Transaction::last_heartbeat() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.last_heartbeat)
+  return last_heartbeat_ != NULL ? *last_heartbeat_ : *default_instance_->last_heartbeat_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Replica >&
-ChangeReplicasTrigger::updated_replicas() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
-  return updated_replicas_;
+inline ::cockroach::proto::Timestamp* Transaction::mutable_last_heartbeat() {
+  set_has_last_heartbeat();
+  if (last_heartbeat_ == NULL) last_heartbeat_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.last_heartbeat)
+  return last_heartbeat_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Replica >*
-ChangeReplicasTrigger::mutable_updated_replicas() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ChangeReplicasTrigger.updated_replicas)
-  return &updated_replicas_;
+inline ::cockroach::proto::Timestamp* Transaction::release_last_heartbeat() {
+  clear_has_last_heartbeat();
+  ::cockroach::proto::Timestamp* temp = last_heartbeat_;
+  last_heartbeat_ = NULL;
+  return temp;
+}
+inline void Transaction::set_allocated_last_heartbeat(::cockroach::proto::Timestamp* last_heartbeat) {
+  delete last_heartbeat_;
+  last_heartbeat_ = last_heartbeat;
+  if (last_heartbeat) {
+    set_has_last_heartbeat();
+  } else {
+    clear_has_last_heartbeat();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.last_heartbeat)
 }
 
-// -------------------------------------------------------------------
-
-// InternalCommitTrigger
-
-// optional .cockroach.proto.SplitTrigger split_trigger = 1;
-inline bool InternalCommitTrigger::has_split_trigger() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.Timestamp timestamp = 9;
+inline bool Transaction::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
 }
-inline void InternalCommitTrigger::set_has_split_trigger() {
-  _has_bits_[0] |= 0x00000001u;
+inline void Transaction::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000100u;
 }
-inline void InternalCommitTrigger::clear_has_split_trigger() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void Transaction::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000100u;
 }
-inline void InternalCommitTrigger::clear_split_trigger() {
-  if (split_trigger_ != NULL) split_trigger_->::cockroach::proto::SplitTrigger::Clear();
-  clear_has_split_trigger();
+inline void Transaction::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
 }
-inline const ::cockroach::proto::SplitTrigger& InternalCommitTrigger::split_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.split_trigger)
-  return split_trigger_ != NULL ? *split_trigger_ : *default_instance_->split_trigger_;
+inline const ::cockroach::proto::Timestamp& Transaction::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
 }
-inline ::cockroach::proto::SplitTrigger* InternalCommitTrigger::mutable_split_trigger() {
-  set_has_split_trigger();
-  if (split_trigger_ == NULL) split_trigger_ = new ::cockroach::proto::SplitTrigger;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.split_trigger)
-  return split_trigger_;
+inline ::cockroach::proto::Timestamp* Transaction::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.timestamp)
+  return timestamp_;
 }
-inline ::cockroach::proto::SplitTrigger* InternalCommitTrigger::release_split_trigger() {
-  clear_has_split_trigger();
-  ::cockroach::proto::SplitTrigger* temp = split_trigger_;
-  split_trigger_ = NULL;
+inline ::cockroach::proto::Timestamp* Transaction::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
   return temp;
 }
-inline void InternalCommitTrigger::set_allocated_split_trigger(::cockroach::proto::SplitTrigger* split_trigger) {
-  delete split_trigger_;
-  split_trigger_ = split_trigger;
-  if (split_trigger) {
-    set_has_split_trigger();
+inline void Transaction::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
   } else {
-    clear_has_split_trigger();
+    clear_has_timestamp();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCommitTrigger.split_trigger)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.timestamp)
 }
 
-// optional .cockroach.proto.MergeTrigger merge_trigger = 2;
-inline bool InternalCommitTrigger::has_merge_trigger() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .cockroach.proto.Timestamp orig_timestamp = 10;
+inline bool Transaction::has_orig_timestamp() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
 }
-inline void InternalCommitTrigger::set_has_merge_trigger() {
-  _has_bits_[0] |= 0x00000002u;
+inline void Transaction::set_has_orig_timestamp() {
+  _has_bits_[0] |= 0x00000200u;
 }
-inline void InternalCommitTrigger::clear_has_merge_trigger() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void Transaction::clear_has_orig_timestamp() {
+  _has_bits_[0] &= ~0x00000200u;
 }
-inline void InternalCommitTrigger::clear_merge_trigger() {
-  if (merge_trigger_ != NULL) merge_trigger_->::cockroach::proto::MergeTrigger::Clear();
-  clear_has_merge_trigger();
+inline void Transaction::clear_orig_timestamp() {
+  if (orig_timestamp_ != NULL) orig_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_orig_timestamp();
 }
-inline const ::cockroach::proto::MergeTrigger& InternalCommitTrigger::merge_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.merge_trigger)
-  return merge_trigger_ != NULL ? *merge_trigger_ : *default_instance_->merge_trigger_;
+inline const ::cockroach::proto::Timestamp& Transaction::orig_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.orig_timestamp)
+  return orig_timestamp_ != NULL ? *orig_timestamp_ : *default_instance_->orig_timestamp_;
 }
-inline ::cockroach::proto::MergeTrigger* InternalCommitTrigger::mutable_merge_trigger() {
-  set_has_merge_trigger();
-  if (merge_trigger_ == NULL) merge_trigger_ = new ::cockroach::proto::MergeTrigger;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.merge_trigger)
-  return merge_trigger_;
+inline ::cockroach::proto::Timestamp* Transaction::mutable_orig_timestamp() {
+  set_has_orig_timestamp();
+  if (orig_timestamp_ == NULL) orig_timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.orig_timestamp)
+  return orig_timestamp_;
 }
-inline ::cockroach::proto::MergeTrigger* InternalCommitTrigger::release_merge_trigger() {
-  clear_has_merge_trigger();
-  ::cockroach::proto::MergeTrigger* temp = merge_trigger_;
-  merge_trigger_ = NULL;
+inline ::cockroach::proto::Timestamp* Transaction::release_orig_timestamp() {
+  clear_has_orig_timestamp();
+  ::cockroach::proto::Timestamp* temp = orig_timestamp_;
+  orig_timestamp_ = NULL;
   return temp;
 }
-inline void InternalCommitTrigger::set_allocated_merge_trigger(::cockroach::proto::MergeTrigger* merge_trigger) {
-  delete merge_trigger_;
-  merge_trigger_ = merge_trigger;
-  if (merge_trigger) {
-    set_has_merge_trigger();
+inline void Transaction::set_allocated_orig_timestamp(::cockroach::proto::Timestamp* orig_timestamp) {
+  delete orig_timestamp_;
+  orig_timestamp_ = orig_timestamp;
+  if (orig_timestamp) {
+    set_has_orig_timestamp();
   } else {
-    clear_has_merge_trigger();
+    clear_has_orig_timestamp();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCommitTrigger.merge_trigger)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.orig_timestamp)
 }
 
-// optional .cockroach.proto.ChangeReplicasTrigger change_replicas_trigger = 3;
-inline bool InternalCommitTrigger::has_change_replicas_trigger() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional .cockroach.proto.Timestamp max_timestamp = 11;
+inline bool Transaction::has_max_timestamp() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
 }
-inline void InternalCommitTrigger::set_has_change_replicas_trigger() {
-  _has_bits_[0] |= 0x00000004u;
+inline void Transaction::set_has_max_timestamp() {
+  _has_bits_[0] |= 0x00000400u;
 }
-inline void InternalCommitTrigger::clear_has_change_replicas_trigger() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void Transaction::clear_has_max_timestamp() {
+  _has_bits_[0] &= ~0x00000400u;
 }
-inline void InternalCommitTrigger::clear_change_replicas_trigger() {
-  if (change_replicas_trigger_ != NULL) change_replicas_trigger_->::cockroach::proto::ChangeReplicasTrigger::Clear();
-  clear_has_change_replicas_trigger();
+inline void Transaction::clear_max_timestamp() {
+  if (max_timestamp_ != NULL) max_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_max_timestamp();
 }
-inline const ::cockroach::proto::ChangeReplicasTrigger& InternalCommitTrigger::change_replicas_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.change_replicas_trigger)
-  return change_replicas_trigger_ != NULL ? *change_replicas_trigger_ : *default_instance_->change_replicas_trigger_;
+inline const ::cockroach::proto::Timestamp& Transaction::max_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.max_timestamp)
+  return max_timestamp_ != NULL ? *max_timestamp_ : *default_instance_->max_timestamp_;
 }
-inline ::cockroach::proto::ChangeReplicasTrigger* InternalCommitTrigger::mutable_change_replicas_trigger() {
-  set_has_change_replicas_trigger();
-  if (change_replicas_trigger_ == NULL) change_replicas_trigger_ = new ::cockroach::proto::ChangeReplicasTrigger;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.change_replicas_trigger)
-  return change_replicas_trigger_;
+inline ::cockroach::proto::Timestamp* Transaction::mutable_max_timestamp() {
+  set_has_max_timestamp();
+  if (max_timestamp_ == NULL) max_timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.max_timestamp)
+  return max_timestamp_;
 }
-inline ::cockroach::proto::ChangeReplicasTrigger* InternalCommitTrigger::release_change_replicas_trigger() {
-  clear_has_change_replicas_trigger();
-  ::cockroach::proto::ChangeReplicasTrigger* temp = change_replicas_trigger_;
-  change_replicas_trigger_ = NULL;
+inline ::cockroach::proto::Timestamp* Transaction::release_max_timestamp() {
+  clear_has_max_timestamp();
+  ::cockroach::proto::Timestamp* temp = max_timestamp_;
+  max_timestamp_ = NULL;
   return temp;
 }
-inline void InternalCommitTrigger::set_allocated_change_replicas_trigger(::cockroach::proto::ChangeReplicasTrigger* change_replicas_trigger) {
-  delete change_replicas_trigger_;
-  change_replicas_trigger_ = change_replicas_trigger;
-  if (change_replicas_trigger) {
-    set_has_change_replicas_trigger();
+inline void Transaction::set_allocated_max_timestamp(::cockroach::proto::Timestamp* max_timestamp) {
+  delete max_timestamp_;
+  max_timestamp_ = max_timestamp;
+  if (max_timestamp) {
+    set_has_max_timestamp();
   } else {
-    clear_has_change_replicas_trigger();
+    clear_has_max_timestamp();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCommitTrigger.change_replicas_trigger)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.max_timestamp)
 }
 
-// repeated bytes intents = 4;
-inline int InternalCommitTrigger::intents_size() const {
-  return intents_.size();
-}
-inline void InternalCommitTrigger::clear_intents() {
-  intents_.Clear();
+// optional .cockroach.proto.NodeList certain_nodes = 12;
+inline bool Transaction::has_certain_nodes() const {
+  return (_has_bits_[0] & 0x00000800u) != 0;
 }
-inline const ::std::string& InternalCommitTrigger::intents(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCommitTrigger.intents)
-  return intents_.Get(index);
+inline void Transaction::set_has_certain_nodes() {
+  _has_bits_[0] |= 0x00000800u;
 }
-inline ::std::string* InternalCommitTrigger::mutable_intents(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCommitTrigger.intents)
-  return intents_.Mutable(index);
+inline void Transaction::clear_has_certain_nodes() {
+  _has_bits_[0] &= ~0x00000800u;
 }
-inline void InternalCommitTrigger::set_intents(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalCommitTrigger.intents)
-  intents_.Mutable(index)->assign(value);
+inline void Transaction::clear_certain_nodes() {
+  if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
+  clear_has_certain_nodes();
 }
-inline void InternalCommitTrigger::set_intents(int index, const char* value) {
-  intents_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalCommitTrigger.intents)
+inline const ::cockroach::proto::NodeList& Transaction::certain_nodes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.certain_nodes)
+  return certain_nodes_ != NULL ? *certain_nodes_ : *default_instance_->certain_nodes_;
 }
-inline void InternalCommitTrigger::set_intents(int index, const void* value, size_t size) {
-  intents_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalCommitTrigger.intents)
+inline ::cockroach::proto::NodeList* Transaction::mutable_certain_nodes() {
+  set_has_certain_nodes();
+  if (certain_nodes_ == NULL) certain_nodes_ = new ::cockroach::proto::NodeList;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.certain_nodes)
+  return certain_nodes_;
 }
-inline ::std::string* InternalCommitTrigger::add_intents() {
-  return intents_.Add();
+inline ::cockroach::proto::NodeList* Transaction::release_certain_nodes() {
+  clear_has_certain_nodes();
+  ::cockroach::proto::NodeList* temp = certain_nodes_;
+  certain_nodes_ = NULL;
+  return temp;
 }
-inline void InternalCommitTrigger::add_intents(const ::std::string& value) {
-  intents_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.InternalCommitTrigger.intents)
+inline void Transaction::set_allocated_certain_nodes(::cockroach::proto::NodeList* certain_nodes) {
+  delete certain_nodes_;
+  certain_nodes_ = certain_nodes;
+  if (certain_nodes) {
+    set_has_certain_nodes();
+  } else {
+    clear_has_certain_nodes();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.certain_nodes)
 }
-inline void InternalCommitTrigger::add_intents(const char* value) {
-  intents_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.proto.InternalCommitTrigger.intents)
+
+// optional int32 sequence = 13;
+inline bool Transaction::has_sequence() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
 }
-inline void InternalCommitTrigger::add_intents(const void* value, size_t size) {
-  intents_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.InternalCommitTrigger.intents)
+inline void Transaction::set_has_sequence() {
+  _has_bits_[0] |= 0x00001000u;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
-InternalCommitTrigger::intents() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.InternalCommitTrigger.intents)
-  return intents_;
+inline void Transaction::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00001000u;
 }
-inline ::google::protobuf::RepeatedPtrField< ::std::string>*
-InternalCommitTrigger::mutable_intents() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalCommitTrigger.intents)
-  return &intents_;
+inline void Transaction::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
 }
-
-// -------------------------------------------------------------------
-
-// NodeList
-
-// repeated int32 nodes = 1 [packed = true];
-inline int NodeList::nodes_size() const {
-  return nodes_.size();
+inline ::google::protobuf::int32 Transaction::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.sequence)
+  return sequence_;
 }
-inline void NodeList::clear_nodes() {
-  nodes_.Clear();
+inline void Transaction::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.sequence)
 }
-inline ::google::protobuf::int32 NodeList::nodes(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.NodeList.nodes)
-  return nodes_.Get(index);
+
+// repeated .cockroach.proto.SequenceRange ignored_sequences = 14;
+inline int Transaction::ignored_sequences_size() const {
+  return ignored_sequences_.size();
 }
-inline void NodeList::set_nodes(int index, ::google::protobuf::int32 value) {
-  nodes_.Set(index, value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.NodeList.nodes)
+inline void Transaction::clear_ignored_sequences() {
+  ignored_sequences_.Clear();
 }
-inline void NodeList::add_nodes(::google::protobuf::int32 value) {
-  nodes_.Add(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.NodeList.nodes)
+inline const ::cockroach::proto::SequenceRange& Transaction::ignored_sequences(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.ignored_sequences)
+  return ignored_sequences_.Get(index);
 }
-inline const ::google::protobuf::RepeatedField< ::google::protobuf::int32 >&
-NodeList::nodes() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.NodeList.nodes)
-  return nodes_;
+inline ::cockroach::proto::SequenceRange* Transaction::mutable_ignored_sequences(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.ignored_sequences)
+  return ignored_sequences_.Mutable(index);
 }
-inline ::google::protobuf::RepeatedField< ::google::protobuf::int32 >*
-NodeList::mutable_nodes() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.NodeList.nodes)
-  return &nodes_;
+inline ::cockroach::proto::SequenceRange* Transaction::add_ignored_sequences() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.Transaction.ignored_sequences)
+  return ignored_sequences_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >&
+Transaction::ignored_sequences() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.Transaction.ignored_sequences)
+  return ignored_sequences_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >*
+Transaction::mutable_ignored_sequences() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.Transaction.ignored_sequences)
+  return &ignored_sequences_;
 }
 
 // -------------------------------------------------------------------
 
-// Transaction
+// SequenceRange
 
-// optional string name = 1;
-inline bool Transaction::has_name() const {
+// optional int32 start = 1;
+inline bool SequenceRange::has_start() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Transaction::set_has_name() {
+inline void SequenceRange::set_has_start() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void Transaction::clear_has_name() {
+inline void SequenceRange::clear_has_start() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void Transaction::clear_name() {
-  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    name_->clear();
-  }
-  clear_has_name();
-}
-inline const ::std::string& Transaction::name() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.name)
-  return *name_;
-}
-inline void Transaction::set_name(const ::std::string& value) {
-  set_has_name();
-  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    name_ = new ::std::string;
-  }
-  name_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.name)
-}
-inline void Transaction::set_name(const char* value) {
-  set_has_name();
-  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    name_ = new ::std::string;
-  }
-  name_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.Transaction.name)
-}
-inline void Transaction::set_name(const char* value, size_t size) {
-  set_has_name();
-  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    name_ = new ::std::string;
-  }
-  name_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Transaction.name)
-}
-inline ::std::string* Transaction::mutable_name() {
-  set_has_name();
-  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    name_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.name)
-  return name_;
+inline void SequenceRange::clear_start() {
+  start_ = 0;
+  clear_has_start();
 }
-inline ::std::string* Transaction::release_name() {
-  clear_has_name();
-  if (name_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = name_;
-    name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline ::google::protobuf::int32 SequenceRange::start() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SequenceRange.start)
+  return start_;
 }
-inline void Transaction::set_allocated_name(::std::string* name) {
-  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete name_;
-  }
-  if (name) {
-    set_has_name();
-    name_ = name;
-  } else {
-    clear_has_name();
-    name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.name)
+inline void SequenceRange::set_start(::google::protobuf::int32 value) {
+  set_has_start();
+  start_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.SequenceRange.start)
 }
 
-// optional bytes key = 2;
-inline bool Transaction::has_key() const {
+// optional int32 end = 2;
+inline bool SequenceRange::has_end() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Transaction::set_has_key() {
+inline void SequenceRange::set_has_end() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void Transaction::clear_has_key() {
+inline void SequenceRange::clear_has_end() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void Transaction::clear_key() {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_->clear();
-  }
-  clear_has_key();
-}
-inline const ::std::string& Transaction::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.key)
-  return *key_;
+inline void SequenceRange::clear_end() {
+  end_ = 0;
+  clear_has_end();
 }
-inline void Transaction::set_key(const ::std::string& value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.key)
+inline ::google::protobuf::int32 SequenceRange::end() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SequenceRange.end)
+  return end_;
 }
-inline void Transaction::set_key(const char* value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.Transaction.key)
+inline void SequenceRange::set_end(::google::protobuf::int32 value) {
+  set_has_end();
+  end_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.SequenceRange.end)
 }
-inline void Transaction::set_key(const void* value, size_t size) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Transaction.key)
+
+// -------------------------------------------------------------------
+
+// Lease
+
+// optional int64 expiration = 1;
+inline bool Lease::has_expiration() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline ::std::string* Transaction::mutable_key() {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.key)
-  return key_;
+inline void Lease::set_has_expiration() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline ::std::string* Transaction::release_key() {
-  clear_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = key_;
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline void Lease::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void Transaction::set_allocated_key(::std::string* key) {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
-  }
-  if (key) {
-    set_has_key();
-    key_ = key;
-  } else {
-    clear_has_key();
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.key)
+inline void Lease::clear_expiration() {
+  expiration_ = GOOGLE_LONGLONG(0);
+  clear_has_expiration();
 }
-
-// optional bytes id = 3;
-inline bool Transaction::has_id() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+inline ::google::protobuf::int64 Lease::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.expiration)
+  return expiration_;
 }
-inline void Transaction::set_has_id() {
-  _has_bits_[0] |= 0x00000004u;
+inline void Lease::set_expiration(::google::protobuf::int64 value) {
+  set_has_expiration();
+  expiration_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.expiration)
 }
-inline void Transaction::clear_has_id() {
-  _has_bits_[0] &= ~0x00000004u;
+
+// optional int64 duration = 2;
+inline bool Lease::has_duration() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Transaction::clear_id() {
-  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    id_->clear();
-  }
-  clear_has_id();
+inline void Lease::set_has_duration() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::std::string& Transaction::id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.id)
-  return *id_;
+inline void Lease::clear_has_duration() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void Transaction::set_id(const ::std::string& value) {
-  set_has_id();
-  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    id_ = new ::std::string;
-  }
-  id_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.id)
+inline void Lease::clear_duration() {
+  duration_ = GOOGLE_LONGLONG(0);
+  clear_has_duration();
 }
-inline void Transaction::set_id(const char* value) {
-  set_has_id();
-  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    id_ = new ::std::string;
-  }
-  id_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.Transaction.id)
+inline ::google::protobuf::int64 Lease::duration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.duration)
+  return duration_;
 }
-inline void Transaction::set_id(const void* value, size_t size) {
-  set_has_id();
-  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    id_ = new ::std::string;
-  }
-  id_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Transaction.id)
+inline void Lease::set_duration(::google::protobuf::int64 value) {
+  set_has_duration();
+  duration_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.duration)
 }
-inline ::std::string* Transaction::mutable_id() {
-  set_has_id();
-  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    id_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.id)
-  return id_;
+
+// optional uint64 term = 3;
+inline bool Lease::has_term() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline ::std::string* Transaction::release_id() {
-  clear_has_id();
-  if (id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = id_;
-    id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline void Lease::set_has_term() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void Transaction::set_allocated_id(::std::string* id) {
-  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete id_;
-  }
-  if (id) {
-    set_has_id();
-    id_ = id;
-  } else {
-    clear_has_id();
-    id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.id)
+inline void Lease::clear_has_term() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void Lease::clear_term() {
+  term_ = GOOGLE_ULONGLONG(0);
+  clear_has_term();
+}
+inline ::google::protobuf::uint64 Lease::term() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.term)
+  return term_;
+}
+inline void Lease::set_term(::google::protobuf::uint64 value) {
+  set_has_term();
+  term_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.term)
 }
 
-// optional int32 priority = 4;
-inline bool Transaction::has_priority() const {
+// optional uint64 raft_node_id = 4;
+inline bool Lease::has_raft_node_id() const {
   return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void Transaction::set_has_priority() {
+inline void Lease::set_has_raft_node_id() {
   _has_bits_[0] |= 0x00000008u;
 }
-inline void Transaction::clear_has_priority() {
+inline void Lease::clear_has_raft_node_id() {
   _has_bits_[0] &= ~0x00000008u;
 }
-inline void Transaction::clear_priority() {
-  priority_ = 0;
-  clear_has_priority();
+inline void Lease::clear_raft_node_id() {
+  raft_node_id_ = GOOGLE_ULONGLONG(0);
+  clear_has_raft_node_id();
 }
-inline ::google::protobuf::int32 Transaction::priority() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.priority)
-  return priority_;
+inline ::google::protobuf::uint64 Lease::raft_node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.raft_node_id)
+  return raft_node_id_;
 }
-inline void Transaction::set_priority(::google::protobuf::int32 value) {
-  set_has_priority();
-  priority_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.priority)
+inline void Lease::set_raft_node_id(::google::protobuf::uint64 value) {
+  set_has_raft_node_id();
+  raft_node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.raft_node_id)
 }
 
-// optional .cockroach.proto.IsolationType isolation = 5;
-inline bool Transaction::has_isolation() const {
+// optional int64 epoch = 5;
+inline bool Lease::has_epoch() const {
   return (_has_bits_[0] & 0x00000010u) != 0;
 }
-inline void Transaction::set_has_isolation() {
+inline void Lease::set_has_epoch() {
   _has_bits_[0] |= 0x00000010u;
 }
-inline void Transaction::clear_has_isolation() {
+inline void Lease::clear_has_epoch() {
   _has_bits_[0] &= ~0x00000010u;
 }
-inline void Transaction::clear_isolation() {
-  isolation_ = 0;
-  clear_has_isolation();
+inline void Lease::clear_epoch() {
+  epoch_ = GOOGLE_LONGLONG(0);
+  clear_has_epoch();
 }
-inline ::cockroach::proto::IsolationType Transaction::isolation() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.isolation)
-  return static_cast< ::cockroach::proto::IsolationType >(isolation_);
+inline ::google::protobuf::int64 Lease::epoch() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.epoch)
+  return epoch_;
 }
-inline void Transaction::set_isolation(::cockroach::proto::IsolationType value) {
-  assert(::cockroach::proto::IsolationType_IsValid(value));
-  set_has_isolation();
-  isolation_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.isolation)
+inline void Lease::set_epoch(::google::protobuf::int64 value) {
+  set_has_epoch();
+  epoch_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.epoch)
 }
 
-// optional .cockroach.proto.TransactionStatus status = 6;
-inline bool Transaction::has_status() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// -------------------------------------------------------------------
+
+// Liveness
+
+// optional int32 node_id = 1;
+inline bool Liveness::has_node_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Transaction::set_has_status() {
-  _has_bits_[0] |= 0x00000020u;
+inline void Liveness::set_has_node_id() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void Transaction::clear_has_status() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void Liveness::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void Transaction::clear_status() {
-  status_ = 0;
-  clear_has_status();
+inline void Liveness::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
 }
-inline ::cockroach::proto::TransactionStatus Transaction::status() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.status)
-  return static_cast< ::cockroach::proto::TransactionStatus >(status_);
+inline ::google::protobuf::int32 Liveness::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Liveness.node_id)
+  return node_id_;
 }
-inline void Transaction::set_status(::cockroach::proto::TransactionStatus value) {
-  assert(::cockroach::proto::TransactionStatus_IsValid(value));
-  set_has_status();
-  status_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.status)
+inline void Liveness::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Liveness.node_id)
 }
 
-// optional int32 epoch = 7;
-inline bool Transaction::has_epoch() const {
-  return (_has_bits_[0] & 0x00000040u) != 0;
+// optional int64 epoch = 2;
+inline bool Liveness::has_epoch() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Transaction::set_has_epoch() {
-  _has_bits_[0] |= 0x00000040u;
+inline void Liveness::set_has_epoch() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void Transaction::clear_has_epoch() {
-  _has_bits_[0] &= ~0x00000040u;
+inline void Liveness::clear_has_epoch() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void Transaction::clear_epoch() {
-  epoch_ = 0;
+inline void Liveness::clear_epoch() {
+  epoch_ = GOOGLE_LONGLONG(0);
   clear_has_epoch();
 }
-inline ::google::protobuf::int32 Transaction::epoch() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.epoch)
+inline ::google::protobuf::int64 Liveness::epoch() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Liveness.epoch)
   return epoch_;
 }
-inline void Transaction::set_epoch(::google::protobuf::int32 value) {
+inline void Liveness::set_epoch(::google::protobuf::int64 value) {
   set_has_epoch();
   epoch_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.epoch)
+  // @@protoc_insertion_point(field_set:cockroach.proto.Liveness.epoch)
 }
 
-// optional .cockroach.proto.Timestamp last_heartbeat = 8;
-inline bool Transaction::has_last_heartbeat() const {
-  return (_has_bits_[0] & 0x00000080u) != 0;
+// optional .cockroach.proto.Timestamp expiration = 3;
+inline bool Liveness::has_expiration() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void Transaction::set_has_last_heartbeat() {
-  _has_bits_[0] |= 0x00000080u;
+inline void Liveness::set_has_expiration() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void Transaction::clear_has_last_heartbeat() {
-  _has_bits_[0] &= ~0x00000080u;
+inline void Liveness::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void Transaction::clear_last_heartbeat() {
-  if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
-  clear_has_last_heartbeat();
+inline void Liveness::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
 }
-inline const ::cockroach::proto::Timestamp& Transaction::last_heartbeat() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.last_heartbeat)
-  return last_heartbeat_ != NULL ? *last_heartbeat_ : *default_instance_->last_heartbeat_;
+inline const ::cockroach::proto::Timestamp& Liveness::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Liveness.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
 }
-inline ::cockroach::proto::Timestamp* Transaction::mutable_last_heartbeat() {
-  set_has_last_heartbeat();
-  if (last_heartbeat_ == NULL) last_heartbeat_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.last_heartbeat)
-  return last_heartbeat_;
+inline ::cockroach::proto::Timestamp* Liveness::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) expiration_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Liveness.expiration)
+  return expiration_;
 }
-inline ::cockroach::proto::Timestamp* Transaction::release_last_heartbeat() {
-  clear_has_last_heartbeat();
-  ::cockroach::proto::Timestamp* temp = last_heartbeat_;
-  last_heartbeat_ = NULL;
+inline ::cockroach::proto::Timestamp* Liveness::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
   return temp;
 }
-inline void Transaction::set_allocated_last_heartbeat(::cockroach::proto::Timestamp* last_heartbeat) {
-  delete last_heartbeat_;
-  last_heartbeat_ = last_heartbeat;
-  if (last_heartbeat) {
-    set_has_last_heartbeat();
+inline void Liveness::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
   } else {
-    clear_has_last_heartbeat();
+    clear_has_expiration();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.last_heartbeat)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Liveness.expiration)
 }
 
-// optional .cockroach.proto.Timestamp timestamp = 9;
-inline bool Transaction::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000100u) != 0;
-}
-inline void Transaction::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000100u;
-}
-inline void Transaction::clear_has_timestamp() {
-  _has_bits_[0] &= ~0x00000100u;
+// optional bool decommissioning = 4;
+inline bool Liveness::has_decommissioning() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void Transaction::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void Liveness::set_has_decommissioning() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline const ::cockroach::proto::Timestamp& Transaction::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline void Liveness::clear_has_decommissioning() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline ::cockroach::proto::Timestamp* Transaction::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.timestamp)
-  return timestamp_;
+inline void Liveness::clear_decommissioning() {
+  decommissioning_ = false;
+  clear_has_decommissioning();
 }
-inline ::cockroach::proto::Timestamp* Transaction::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
-  return temp;
+inline bool Liveness::decommissioning() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Liveness.decommissioning)
+  return decommissioning_;
 }
-inline void Transaction::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
-  } else {
-    clear_has_timestamp();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.timestamp)
+inline void Liveness::set_decommissioning(bool value) {
+  set_has_decommissioning();
+  decommissioning_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Liveness.decommissioning)
 }
 
-// optional .cockroach.proto.Timestamp orig_timestamp = 10;
-inline bool Transaction::has_orig_timestamp() const {
-  return (_has_bits_[0] & 0x00000200u) != 0;
-}
-inline void Transaction::set_has_orig_timestamp() {
-  _has_bits_[0] |= 0x00000200u;
-}
-inline void Transaction::clear_has_orig_timestamp() {
-  _has_bits_[0] &= ~0x00000200u;
+// -------------------------------------------------------------------
+
+// ClusterVersion
+
+// optional int64 version = 1;
+inline bool ClusterVersion::has_version() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Transaction::clear_orig_timestamp() {
-  if (orig_timestamp_ != NULL) orig_timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_orig_timestamp();
+inline void ClusterVersion::set_has_version() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline const ::cockroach::proto::Timestamp& Transaction::orig_timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.orig_timestamp)
-  return orig_timestamp_ != NULL ? *orig_timestamp_ : *default_instance_->orig_timestamp_;
+inline void ClusterVersion::clear_has_version() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline ::cockroach::proto::Timestamp* Transaction::mutable_orig_timestamp() {
-  set_has_orig_timestamp();
-  if (orig_timestamp_ == NULL) orig_timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.orig_timestamp)
-  return orig_timestamp_;
+inline void ClusterVersion::clear_version() {
+  version_ = GOOGLE_LONGLONG(0);
+  clear_has_version();
 }
-inline ::cockroach::proto::Timestamp* Transaction::release_orig_timestamp() {
-  clear_has_orig_timestamp();
-  ::cockroach::proto::Timestamp* temp = orig_timestamp_;
-  orig_timestamp_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 ClusterVersion::version() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClusterVersion.version)
+  return version_;
 }
-inline void Transaction::set_allocated_orig_timestamp(::cockroach::proto::Timestamp* orig_timestamp) {
-  delete orig_timestamp_;
-  orig_timestamp_ = orig_timestamp;
-  if (orig_timestamp) {
-    set_has_orig_timestamp();
-  } else {
-    clear_has_orig_timestamp();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.orig_timestamp)
+inline void ClusterVersion::set_version(::google::protobuf::int64 value) {
+  set_has_version();
+  version_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClusterVersion.version)
 }
 
-// optional .cockroach.proto.Timestamp max_timestamp = 11;
-inline bool Transaction::has_max_timestamp() const {
-  return (_has_bits_[0] & 0x00000400u) != 0;
-}
-inline void Transaction::set_has_max_timestamp() {
-  _has_bits_[0] |= 0x00000400u;
-}
-inline void Transaction::clear_has_max_timestamp() {
-  _has_bits_[0] &= ~0x00000400u;
-}
-inline void Transaction::clear_max_timestamp() {
-  if (max_timestamp_ != NULL) max_timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_max_timestamp();
+// -------------------------------------------------------------------
+
+// MigrationLease
+
+// optional int32 node_id = 1;
+inline bool MigrationLease::has_node_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline const ::cockroach::proto::Timestamp& Transaction::max_timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.max_timestamp)
-  return max_timestamp_ != NULL ? *max_timestamp_ : *default_instance_->max_timestamp_;
+inline void MigrationLease::set_has_node_id() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline ::cockroach::proto::Timestamp* Transaction::mutable_max_timestamp() {
-  set_has_max_timestamp();
-  if (max_timestamp_ == NULL) max_timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.max_timestamp)
-  return max_timestamp_;
+inline void MigrationLease::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline ::cockroach::proto::Timestamp* Transaction::release_max_timestamp() {
-  clear_has_max_timestamp();
-  ::cockroach::proto::Timestamp* temp = max_timestamp_;
-  max_timestamp_ = NULL;
-  return temp;
+inline void MigrationLease::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
 }
-inline void Transaction::set_allocated_max_timestamp(::cockroach::proto::Timestamp* max_timestamp) {
-  delete max_timestamp_;
-  max_timestamp_ = max_timestamp;
-  if (max_timestamp) {
-    set_has_max_timestamp();
-  } else {
-    clear_has_max_timestamp();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.max_timestamp)
+inline ::google::protobuf::int32 MigrationLease::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MigrationLease.node_id)
+  return node_id_;
+}
+inline void MigrationLease::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.MigrationLease.node_id)
 }
 
-// optional .cockroach.proto.NodeList certain_nodes = 12;
-inline bool Transaction::has_certain_nodes() const {
-  return (_has_bits_[0] & 0x00000800u) != 0;
+// optional .cockroach.proto.Timestamp expiration = 2;
+inline bool MigrationLease::has_expiration() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Transaction::set_has_certain_nodes() {
-  _has_bits_[0] |= 0x00000800u;
+inline void MigrationLease::set_has_expiration() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void Transaction::clear_has_certain_nodes() {
-  _has_bits_[0] &= ~0x00000800u;
+inline void MigrationLease::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void Transaction::clear_certain_nodes() {
-  if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
-  clear_has_certain_nodes();
+inline void MigrationLease::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
 }
-inline const ::cockroach::proto::NodeList& Transaction::certain_nodes() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.certain_nodes)
-  return certain_nodes_ != NULL ? *certain_nodes_ : *default_instance_->certain_nodes_;
+inline const ::cockroach::proto::Timestamp& MigrationLease::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MigrationLease.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
 }
-inline ::cockroach::proto::NodeList* Transaction::mutable_certain_nodes() {
-  set_has_certain_nodes();
-  if (certain_nodes_ == NULL) certain_nodes_ = new ::cockroach::proto::NodeList;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.certain_nodes)
-  return certain_nodes_;
+inline ::cockroach::proto::Timestamp* MigrationLease::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) expiration_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MigrationLease.expiration)
+  return expiration_;
 }
-inline ::cockroach::proto::NodeList* Transaction::release_certain_nodes() {
-  clear_has_certain_nodes();
-  ::cockroach::proto::NodeList* temp = certain_nodes_;
-  certain_nodes_ = NULL;
+inline ::cockroach::proto::Timestamp* MigrationLease::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
   return temp;
 }
-inline void Transaction::set_allocated_certain_nodes(::cockroach::proto::NodeList* certain_nodes) {
-  delete certain_nodes_;
-  certain_nodes_ = certain_nodes;
-  if (certain_nodes) {
-    set_has_certain_nodes();
+inline void MigrationLease::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
   } else {
-    clear_has_certain_nodes();
+    clear_has_expiration();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.certain_nodes)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MigrationLease.expiration)
 }
 
 // -------------------------------------------------------------------
 
-// Lease
+// NodeIDClaim
 
-// optional int64 expiration = 1;
-inline bool Lease::has_expiration() const {
+// optional int32 node_id = 1;
+inline bool NodeIDClaim::has_node_id() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Lease::set_has_expiration() {
+inline void NodeIDClaim::set_has_node_id() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void Lease::clear_has_expiration() {
+inline void NodeIDClaim::clear_has_node_id() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void Lease::clear_expiration() {
-  expiration_ = GOOGLE_LONGLONG(0);
-  clear_has_expiration();
+inline void NodeIDClaim::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
 }
-inline ::google::protobuf::int64 Lease::expiration() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.expiration)
-  return expiration_;
+inline ::google::protobuf::int32 NodeIDClaim::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.NodeIDClaim.node_id)
+  return node_id_;
 }
-inline void Lease::set_expiration(::google::protobuf::int64 value) {
-  set_has_expiration();
-  expiration_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.expiration)
+inline void NodeIDClaim::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.NodeIDClaim.node_id)
 }
 
-// optional int64 duration = 2;
-inline bool Lease::has_duration() const {
+// optional string address = 2;
+inline bool NodeIDClaim::has_address() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void Lease::set_has_duration() {
+inline void NodeIDClaim::set_has_address() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void Lease::clear_has_duration() {
+inline void NodeIDClaim::clear_has_address() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void Lease::clear_duration() {
-  duration_ = GOOGLE_LONGLONG(0);
-  clear_has_duration();
+inline void NodeIDClaim::clear_address() {
+  if (address_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    address_->clear();
+  }
+  clear_has_address();
 }
-inline ::google::protobuf::int64 Lease::duration() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.duration)
-  return duration_;
+inline const ::std::string& NodeIDClaim::address() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.NodeIDClaim.address)
+  return *address_;
 }
-inline void Lease::set_duration(::google::protobuf::int64 value) {
-  set_has_duration();
-  duration_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.duration)
+inline void NodeIDClaim::set_address(const ::std::string& value) {
+  set_has_address();
+  if (address_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    address_ = new ::std::string;
+  }
+  address_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.NodeIDClaim.address)
+}
+inline void NodeIDClaim::set_address(const char* value) {
+  set_has_address();
+  if (address_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    address_ = new ::std::string;
+  }
+  address_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.NodeIDClaim.address)
+}
+inline void NodeIDClaim::set_address(const char* value, size_t size) {
+  set_has_address();
+  if (address_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    address_ = new ::std::string;
+  }
+  address_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.NodeIDClaim.address)
+}
+inline ::std::string* NodeIDClaim::mutable_address() {
+  set_has_address();
+  if (address_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    address_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.NodeIDClaim.address)
+  return address_;
+}
+inline ::std::string* NodeIDClaim::release_address() {
+  clear_has_address();
+  if (address_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = address_;
+    address_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void NodeIDClaim::set_allocated_address(::std::string* address) {
+  if (address_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete address_;
+  }
+  if (address) {
+    set_has_address();
+    address_ = address;
+  } else {
+    clear_has_address();
+    address_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.NodeIDClaim.address)
 }
 
-// optional uint64 term = 3;
-inline bool Lease::has_term() const {
+// optional string cluster_id = 3;
+inline bool NodeIDClaim::has_cluster_id() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void Lease::set_has_term() {
+inline void NodeIDClaim::set_has_cluster_id() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void Lease::clear_has_term() {
+inline void NodeIDClaim::clear_has_cluster_id() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void Lease::clear_term() {
-  term_ = GOOGLE_ULONGLONG(0);
-  clear_has_term();
+inline void NodeIDClaim::clear_cluster_id() {
+  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_->clear();
+  }
+  clear_has_cluster_id();
 }
-inline ::google::protobuf::uint64 Lease::term() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.term)
-  return term_;
+inline const ::std::string& NodeIDClaim::cluster_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.NodeIDClaim.cluster_id)
+  return *cluster_id_;
 }
-inline void Lease::set_term(::google::protobuf::uint64 value) {
-  set_has_term();
-  term_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.term)
+inline void NodeIDClaim::set_cluster_id(const ::std::string& value) {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
+  }
+  cluster_id_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.NodeIDClaim.cluster_id)
+}
+inline void NodeIDClaim::set_cluster_id(const char* value) {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
+  }
+  cluster_id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.NodeIDClaim.cluster_id)
+}
+inline void NodeIDClaim::set_cluster_id(const char* value, size_t size) {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
+  }
+  cluster_id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.NodeIDClaim.cluster_id)
+}
+inline ::std::string* NodeIDClaim::mutable_cluster_id() {
+  set_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    cluster_id_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.NodeIDClaim.cluster_id)
+  return cluster_id_;
+}
+inline ::std::string* NodeIDClaim::release_cluster_id() {
+  clear_has_cluster_id();
+  if (cluster_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = cluster_id_;
+    cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void NodeIDClaim::set_allocated_cluster_id(::std::string* cluster_id) {
+  if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete cluster_id_;
+  }
+  if (cluster_id) {
+    set_has_cluster_id();
+    cluster_id_ = cluster_id;
+  } else {
+    clear_has_cluster_id();
+    cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.NodeIDClaim.cluster_id)
 }
 
-// optional uint64 raft_node_id = 4;
-inline bool Lease::has_raft_node_id() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
+// -------------------------------------------------------------------
+
+// StoreIDClaim
+
+// optional int32 store_id = 1;
+inline bool StoreIDClaim::has_store_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void Lease::set_has_raft_node_id() {
-  _has_bits_[0] |= 0x00000008u;
+inline void StoreIDClaim::set_has_store_id() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void Lease::clear_has_raft_node_id() {
-  _has_bits_[0] &= ~0x00000008u;
+inline void StoreIDClaim::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void Lease::clear_raft_node_id() {
-  raft_node_id_ = GOOGLE_ULONGLONG(0);
-  clear_has_raft_node_id();
+inline void StoreIDClaim::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
 }
-inline ::google::protobuf::uint64 Lease::raft_node_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.Lease.raft_node_id)
-  return raft_node_id_;
+inline ::google::protobuf::int32 StoreIDClaim::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIDClaim.store_id)
+  return store_id_;
 }
-inline void Lease::set_raft_node_id(::google::protobuf::uint64 value) {
-  set_has_raft_node_id();
-  raft_node_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.Lease.raft_node_id)
+inline void StoreIDClaim::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIDClaim.store_id)
+}
+
+// optional int32 node_id = 2;
+inline bool StoreIDClaim::has_node_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void StoreIDClaim::set_has_node_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void StoreIDClaim::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void StoreIDClaim::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
+}
+inline ::google::protobuf::int32 StoreIDClaim::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreIDClaim.node_id)
+  return node_id_;
+}
+inline void StoreIDClaim::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreIDClaim.node_id)
 }
 
 // -------------------------------------------------------------------
@@ -4247,6 +5846,105 @@ inline void MVCCMetadata::set_allocated_value(::cockroach::proto::Value* value)
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MVCCMetadata.value)
 }
 
+// repeated .cockroach.proto.SequencedValue intent_history = 7;
+inline int MVCCMetadata::intent_history_size() const {
+  return intent_history_.size();
+}
+inline void MVCCMetadata::clear_intent_history() {
+  intent_history_.Clear();
+}
+inline const ::cockroach::proto::SequencedValue& MVCCMetadata::intent_history(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_.Get(index);
+}
+inline ::cockroach::proto::SequencedValue* MVCCMetadata::mutable_intent_history(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_.Mutable(index);
+}
+inline ::cockroach::proto::SequencedValue* MVCCMetadata::add_intent_history() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequencedValue >&
+MVCCMetadata::intent_history() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequencedValue >*
+MVCCMetadata::mutable_intent_history() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.MVCCMetadata.intent_history)
+  return &intent_history_;
+}
+
+// -------------------------------------------------------------------
+
+// SequencedValue
+
+// optional int32 sequence = 1;
+inline bool SequencedValue::has_sequence() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void SequencedValue::set_has_sequence() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void SequencedValue::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void SequencedValue::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
+}
+inline ::google::protobuf::int32 SequencedValue::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SequencedValue.sequence)
+  return sequence_;
+}
+inline void SequencedValue::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.SequencedValue.sequence)
+}
+
+// optional .cockroach.proto.MVCCValue value = 2;
+inline bool SequencedValue::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void SequencedValue::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void SequencedValue::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void SequencedValue::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::MVCCValue::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::MVCCValue& SequencedValue::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SequencedValue.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::MVCCValue* SequencedValue::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::MVCCValue;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.SequencedValue.value)
+  return value_;
+}
+inline ::cockroach::proto::MVCCValue* SequencedValue::release_value() {
+  clear_has_value();
+  ::cockroach::proto::MVCCValue* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void SequencedValue::set_allocated_value(::cockroach::proto::MVCCValue* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.SequencedValue.value)
+}
+
 // -------------------------------------------------------------------
 
 // GCMetadata
@@ -4839,6 +6537,11 @@ inline void MVCCStats::set_last_update_nanos(::google::protobuf::int64 value) {
 namespace google {
 namespace protobuf {
 
+template <> struct is_proto_enum< ::cockroach::proto::ValueCompression> : ::google::protobuf::internal::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::ValueCompression>() {
+  return ::cockroach::proto::ValueCompression_descriptor();
+}
 template <> struct is_proto_enum< ::cockroach::proto::ReplicaChangeType> : ::google::protobuf::internal::true_type {};
 template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::ReplicaChangeType>() {
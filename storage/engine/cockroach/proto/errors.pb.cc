@@ -57,6 +57,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* ConditionFailedError_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ConditionFailedError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AmbiguousResultError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AmbiguousResultError_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ErrorDetail_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ErrorDetail_reflection_ = NULL;
@@ -73,6 +76,7 @@ struct ErrorDetailOneofInstance {
   const ::cockroach::proto::WriteTooOldError* write_too_old_;
   const ::cockroach::proto::OpRequiresTxnError* op_requires_txn_;
   const ::cockroach::proto::ConditionFailedError* condition_failed_;
+  const ::cockroach::proto::AmbiguousResultError* ambiguous_result_;
 }* ErrorDetail_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* Error_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -275,8 +279,23 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionFailedError));
-  ErrorDetail_descriptor_ = file->message_type(12);
-  static const int ErrorDetail_offsets_[13] = {
+  AmbiguousResultError_descriptor_ = file->message_type(12);
+  static const int AmbiguousResultError_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AmbiguousResultError, message_),
+  };
+  AmbiguousResultError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AmbiguousResultError_descriptor_,
+      AmbiguousResultError::default_instance_,
+      AmbiguousResultError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AmbiguousResultError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AmbiguousResultError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AmbiguousResultError));
+  ErrorDetail_descriptor_ = file->message_type(13);
+  static const int ErrorDetail_offsets_[14] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, not_leader_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, range_not_found_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, range_key_mismatch_),
@@ -289,6 +308,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, write_too_old_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, op_requires_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, condition_failed_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, ambiguous_result_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ErrorDetail, value_),
   };
   ErrorDetail_reflection_ =
@@ -304,7 +324,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ErrorDetail));
-  Error_descriptor_ = file->message_type(13);
+  Error_descriptor_ = file->message_type(14);
   static const int Error_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, message_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, retryable_),
@@ -359,6 +379,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     OpRequiresTxnError_descriptor_, &OpRequiresTxnError::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ConditionFailedError_descriptor_, &ConditionFailedError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AmbiguousResultError_descriptor_, &AmbiguousResultError::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ErrorDetail_descriptor_, &ErrorDetail::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -392,6 +414,8 @@ void protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto() {
   delete OpRequiresTxnError_reflection_;
   delete ConditionFailedError::default_instance_;
   delete ConditionFailedError_reflection_;
+  delete AmbiguousResultError::default_instance_;
+  delete AmbiguousResultError_reflection_;
   delete ErrorDetail::default_instance_;
   delete ErrorDetail_default_oneof_instance_;
   delete ErrorDetail_reflection_;
@@ -440,35 +464,38 @@ void protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto() {
     "xisting_timestamp\030\002 \001(\0132\032.cockroach.prot"
     "o.TimestampB\004\310\336\037\000\"\024\n\022OpRequiresTxnError\""
     "D\n\024ConditionFailedError\022,\n\014actual_value\030"
-    "\001 \001(\0132\026.cockroach.proto.Value\"\314\006\n\013ErrorD"
-    "etail\0225\n\nnot_leader\030\001 \001(\0132\037.cockroach.pr"
-    "oto.NotLeaderErrorH\000\022>\n\017range_not_found\030"
-    "\002 \001(\0132#.cockroach.proto.RangeNotFoundErr"
-    "orH\000\022D\n\022range_key_mismatch\030\003 \001(\0132&.cockr"
-    "oach.proto.RangeKeyMismatchErrorH\000\022_\n re"
-    "ad_within_uncertainty_interval\030\004 \001(\01323.c"
-    "ockroach.proto.ReadWithinUncertaintyInte"
-    "rvalErrorH\000\022G\n\023transaction_aborted\030\005 \001(\013"
-    "2(.cockroach.proto.TransactionAbortedErr"
-    "orH\000\022A\n\020transaction_push\030\006 \001(\0132%.cockroa"
-    "ch.proto.TransactionPushErrorH\000\022C\n\021trans"
-    "action_retry\030\007 \001(\0132&.cockroach.proto.Tra"
-    "nsactionRetryErrorH\000\022E\n\022transaction_stat"
-    "us\030\010 \001(\0132\'.cockroach.proto.TransactionSt"
-    "atusErrorH\000\0229\n\014write_intent\030\t \001(\0132!.cock"
-    "roach.proto.WriteIntentErrorH\000\022:\n\rwrite_"
-    "too_old\030\n \001(\0132!.cockroach.proto.WriteToo"
-    "OldErrorH\000\022>\n\017op_requires_txn\030\013 \001(\0132#.co"
-    "ckroach.proto.OpRequiresTxnErrorH\000\022A\n\020co"
-    "ndition_failed\030\014 \001(\0132%.cockroach.proto.C"
-    "onditionFailedErrorH\000:\004\310\240\037\001B\007\n\005value\"\255\001\n"
-    "\005Error\022\025\n\007message\030\001 \001(\tB\004\310\336\037\000\022\027\n\tretryab"
-    "le\030\002 \001(\010B\004\310\336\037\000\022F\n\023transaction_restart\030\004 "
-    "\001(\0162#.cockroach.proto.TransactionRestart"
-    "B\004\310\336\037\000\022,\n\006detail\030\003 \001(\0132\034.cockroach.proto"
-    ".ErrorDetail*;\n\022TransactionRestart\022\t\n\005AB"
-    "ORT\020\000\022\013\n\007BACKOFF\020\001\022\r\n\tIMMEDIATE\020\002B\023Z\005pro"
-    "to\340\342\036\001\310\342\036\001\320\342\036\001", 2374);
+    "\001 \001(\0132\026.cockroach.proto.Value\"-\n\024Ambiguo"
+    "usResultError\022\025\n\007message\030\001 \001(\tB\004\310\336\037\000\"\217\007\n"
+    "\013ErrorDetail\0225\n\nnot_leader\030\001 \001(\0132\037.cockr"
+    "oach.proto.NotLeaderErrorH\000\022>\n\017range_not"
+    "_found\030\002 \001(\0132#.cockroach.proto.RangeNotF"
+    "oundErrorH\000\022D\n\022range_key_mismatch\030\003 \001(\0132"
+    "&.cockroach.proto.RangeKeyMismatchErrorH"
+    "\000\022_\n read_within_uncertainty_interval\030\004 "
+    "\001(\01323.cockroach.proto.ReadWithinUncertai"
+    "ntyIntervalErrorH\000\022G\n\023transaction_aborte"
+    "d\030\005 \001(\0132(.cockroach.proto.TransactionAbo"
+    "rtedErrorH\000\022A\n\020transaction_push\030\006 \001(\0132%."
+    "cockroach.proto.TransactionPushErrorH\000\022C"
+    "\n\021transaction_retry\030\007 \001(\0132&.cockroach.pr"
+    "oto.TransactionRetryErrorH\000\022E\n\022transacti"
+    "on_status\030\010 \001(\0132\'.cockroach.proto.Transa"
+    "ctionStatusErrorH\000\0229\n\014write_intent\030\t \001(\013"
+    "2!.cockroach.proto.WriteIntentErrorH\000\022:\n"
+    "\rwrite_too_old\030\n \001(\0132!.cockroach.proto.W"
+    "riteTooOldErrorH\000\022>\n\017op_requires_txn\030\013 \001"
+    "(\0132#.cockroach.proto.OpRequiresTxnErrorH"
+    "\000\022A\n\020condition_failed\030\014 \001(\0132%.cockroach."
+    "proto.ConditionFailedErrorH\000\022A\n\020ambiguou"
+    "s_result\030\r \001(\0132%.cockroach.proto.Ambiguo"
+    "usResultErrorH\000:\004\310\240\037\001B\007\n\005value\"\255\001\n\005Error"
+    "\022\025\n\007message\030\001 \001(\tB\004\310\336\037\000\022\027\n\tretryable\030\002 \001"
+    "(\010B\004\310\336\037\000\022F\n\023transaction_restart\030\004 \001(\0162#."
+    "cockroach.proto.TransactionRestartB\004\310\336\037\000"
+    "\022,\n\006detail\030\003 \001(\0132\034.cockroach.proto.Error"
+    "Detail*;\n\022TransactionRestart\022\t\n\005ABORT\020\000\022"
+    "\013\n\007BACKOFF\020\001\022\r\n\tIMMEDIATE\020\002B\023Z\005proto\340\342\036\001"
+    "\310\342\036\001\320\342\036\001", 2488);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/errors.proto", &protobuf_RegisterTypes);
   NotLeaderError::default_instance_ = new NotLeaderError();
@@ -483,6 +510,7 @@ void protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto() {
   WriteTooOldError::default_instance_ = new WriteTooOldError();
   OpRequiresTxnError::default_instance_ = new OpRequiresTxnError();
   ConditionFailedError::default_instance_ = new ConditionFailedError();
+  AmbiguousResultError::default_instance_ = new AmbiguousResultError();
   ErrorDetail::default_instance_ = new ErrorDetail();
   ErrorDetail_default_oneof_instance_ = new ErrorDetailOneofInstance;
   Error::default_instance_ = new Error();
@@ -498,6 +526,7 @@ void protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto() {
   WriteTooOldError::default_instance_->InitAsDefaultInstance();
   OpRequiresTxnError::default_instance_->InitAsDefaultInstance();
   ConditionFailedError::default_instance_->InitAsDefaultInstance();
+  AmbiguousResultError::default_instance_->InitAsDefaultInstance();
   ErrorDetail::default_instance_->InitAsDefaultInstance();
   Error::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto);
@@ -3575,6 +3604,249 @@ void ConditionFailedError::Swap(ConditionFailedError* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AmbiguousResultError::kMessageFieldNumber;
+#endif  // !_MSC_VER
+
+AmbiguousResultError::AmbiguousResultError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.AmbiguousResultError)
+}
+
+void AmbiguousResultError::InitAsDefaultInstance() {
+}
+
+AmbiguousResultError::AmbiguousResultError(const AmbiguousResultError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.AmbiguousResultError)
+}
+
+void AmbiguousResultError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AmbiguousResultError::~AmbiguousResultError() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.AmbiguousResultError)
+  SharedDtor();
+}
+
+void AmbiguousResultError::SharedDtor() {
+  if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete message_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void AmbiguousResultError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AmbiguousResultError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AmbiguousResultError_descriptor_;
+}
+
+const AmbiguousResultError& AmbiguousResultError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
+  return *default_instance_;
+}
+
+AmbiguousResultError* AmbiguousResultError::default_instance_ = NULL;
+
+AmbiguousResultError* AmbiguousResultError::New() const {
+  return new AmbiguousResultError;
+}
+
+void AmbiguousResultError::Clear() {
+  if (has_message()) {
+    if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+      message_->clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AmbiguousResultError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.AmbiguousResultError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string message = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_message()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->message().data(), this->message().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "message");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.AmbiguousResultError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.AmbiguousResultError)
+  return false;
+#undef DO_
+}
+
+void AmbiguousResultError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.AmbiguousResultError)
+  // optional string message = 1;
+  if (has_message()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->message().data(), this->message().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "message");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->message(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.AmbiguousResultError)
+}
+
+::google::protobuf::uint8* AmbiguousResultError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.AmbiguousResultError)
+  // optional string message = 1;
+  if (has_message()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->message().data(), this->message().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "message");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->message(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.AmbiguousResultError)
+  return target;
+}
+
+int AmbiguousResultError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string message = 1;
+    if (has_message()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->message());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AmbiguousResultError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AmbiguousResultError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AmbiguousResultError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AmbiguousResultError::MergeFrom(const AmbiguousResultError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_message()) {
+      set_message(from.message());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AmbiguousResultError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AmbiguousResultError::CopyFrom(const AmbiguousResultError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AmbiguousResultError::IsInitialized() const {
+
+  return true;
+}
+
+void AmbiguousResultError::Swap(AmbiguousResultError* other) {
+  if (other != this) {
+    std::swap(message_, other->message_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AmbiguousResultError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AmbiguousResultError_descriptor_;
+  metadata.reflection = AmbiguousResultError_reflection_;
+  return metadata;
+}
+
+
 // ===================================================================
 
 #ifndef _MSC_VER
@@ -3590,6 +3862,7 @@ const int ErrorDetail::kWriteIntentFieldNumber;
 const int ErrorDetail::kWriteTooOldFieldNumber;
 const int ErrorDetail::kOpRequiresTxnFieldNumber;
 const int ErrorDetail::kConditionFailedFieldNumber;
+const int ErrorDetail::kAmbiguousResultFieldNumber;
 #endif  // !_MSC_VER
 
 ErrorDetail::ErrorDetail()
@@ -3611,6 +3884,7 @@ void ErrorDetail::InitAsDefaultInstance() {
   ErrorDetail_default_oneof_instance_->write_too_old_ = const_cast< ::cockroach::proto::WriteTooOldError*>(&::cockroach::proto::WriteTooOldError::default_instance());
   ErrorDetail_default_oneof_instance_->op_requires_txn_ = const_cast< ::cockroach::proto::OpRequiresTxnError*>(&::cockroach::proto::OpRequiresTxnError::default_instance());
   ErrorDetail_default_oneof_instance_->condition_failed_ = const_cast< ::cockroach::proto::ConditionFailedError*>(&::cockroach::proto::ConditionFailedError::default_instance());
+  ErrorDetail_default_oneof_instance_->ambiguous_result_ = const_cast< ::cockroach::proto::AmbiguousResultError*>(&::cockroach::proto::AmbiguousResultError::default_instance());
 }
 
 ErrorDetail::ErrorDetail(const ErrorDetail& from)
@@ -3710,6 +3984,10 @@ void ErrorDetail::clear_value() {
       delete value_.condition_failed_;
       break;
     }
+    case kAmbiguousResult: {
+      delete value_.ambiguous_result_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -3885,6 +4163,19 @@ bool ErrorDetail::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(106)) goto parse_ambiguous_result;
+        break;
+      }
+
+      // optional .cockroach.proto.AmbiguousResultError ambiguous_result = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_ambiguous_result:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_ambiguous_result()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3986,6 +4277,12 @@ void ErrorDetail::SerializeWithCachedSizes(
       12, this->condition_failed(), output);
   }
 
+  // optional .cockroach.proto.AmbiguousResultError ambiguous_result = 13;
+  if (has_ambiguous_result()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->ambiguous_result(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4080,6 +4377,13 @@ void ErrorDetail::SerializeWithCachedSizes(
         12, this->condition_failed(), target);
   }
 
+  // optional .cockroach.proto.AmbiguousResultError ambiguous_result = 13;
+  if (has_ambiguous_result()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->ambiguous_result(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -4176,6 +4480,13 @@ int ErrorDetail::ByteSize() const {
           this->condition_failed());
       break;
     }
+    // optional .cockroach.proto.AmbiguousResultError ambiguous_result = 13;
+    case kAmbiguousResult: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->ambiguous_result());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -4254,6 +4565,10 @@ void ErrorDetail::MergeFrom(const ErrorDetail& from) {
       mutable_condition_failed()->::cockroach::proto::ConditionFailedError::MergeFrom(from.condition_failed());
       break;
     }
+    case kAmbiguousResult: {
+      mutable_ambiguous_result()->::cockroach::proto::AmbiguousResultError::MergeFrom(from.ambiguous_result());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -50,6 +50,7 @@ class WriteIntentError;
 class WriteTooOldError;
 class OpRequiresTxnError;
 class ConditionFailedError;
+class AmbiguousResultError;
 class ErrorDetail;
 class Error;
 
@@ -1139,6 +1140,90 @@ class ConditionFailedError : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class AmbiguousResultError : public ::google::protobuf::Message {
+ public:
+  AmbiguousResultError();
+  virtual ~AmbiguousResultError();
+
+  AmbiguousResultError(const AmbiguousResultError& from);
+
+  inline AmbiguousResultError& operator=(const AmbiguousResultError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AmbiguousResultError& default_instance();
+
+  void Swap(AmbiguousResultError* other);
+
+  // implements Message ----------------------------------------------
+
+  AmbiguousResultError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AmbiguousResultError& from);
+  void MergeFrom(const AmbiguousResultError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string message = 1;
+  inline bool has_message() const;
+  inline void clear_message();
+  static const int kMessageFieldNumber = 1;
+  inline const ::std::string& message() const;
+  inline void set_message(const ::std::string& value);
+  inline void set_message(const char* value);
+  inline void set_message(const char* value, size_t size);
+  inline ::std::string* mutable_message();
+  inline ::std::string* release_message();
+  inline void set_allocated_message(::std::string* message);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AmbiguousResultError)
+ private:
+  inline void set_has_message();
+  inline void clear_has_message();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* message_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto();
+
+  void InitAsDefaultInstance();
+  static AmbiguousResultError* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class ErrorDetail : public ::google::protobuf::Message {
  public:
   ErrorDetail();
@@ -1175,6 +1260,7 @@ class ErrorDetail : public ::google::protobuf::Message {
     kWriteTooOld = 10,
     kOpRequiresTxn = 11,
     kConditionFailed = 12,
+    kAmbiguousResult = 13,
     VALUE_NOT_SET = 0,
   };
 
@@ -1316,6 +1402,15 @@ class ErrorDetail : public ::google::protobuf::Message {
   inline ::cockroach::proto::ConditionFailedError* release_condition_failed();
   inline void set_allocated_condition_failed(::cockroach::proto::ConditionFailedError* condition_failed);
 
+  // optional .cockroach.proto.AmbiguousResultError ambiguous_result = 13;
+  inline bool has_ambiguous_result() const;
+  inline void clear_ambiguous_result();
+  static const int kAmbiguousResultFieldNumber = 13;
+  inline const ::cockroach::proto::AmbiguousResultError& ambiguous_result() const;
+  inline ::cockroach::proto::AmbiguousResultError* mutable_ambiguous_result();
+  inline ::cockroach::proto::AmbiguousResultError* release_ambiguous_result();
+  inline void set_allocated_ambiguous_result(::cockroach::proto::AmbiguousResultError* ambiguous_result);
+
   inline ValueCase value_case() const;
   // @@protoc_insertion_point(class_scope:cockroach.proto.ErrorDetail)
  private:
@@ -1331,6 +1426,7 @@ class ErrorDetail : public ::google::protobuf::Message {
   inline void set_has_write_too_old();
   inline void set_has_op_requires_txn();
   inline void set_has_condition_failed();
+  inline void set_has_ambiguous_result();
 
   inline bool has_value();
   void clear_value();
@@ -1353,6 +1449,7 @@ class ErrorDetail : public ::google::protobuf::Message {
     ::cockroach::proto::WriteTooOldError* write_too_old_;
     ::cockroach::proto::OpRequiresTxnError* op_requires_txn_;
     ::cockroach::proto::ConditionFailedError* condition_failed_;
+    ::cockroach::proto::AmbiguousResultError* ambiguous_result_;
   } value_;
   ::google::protobuf::uint32 _oneof_case_[1];
 
@@ -2417,6 +2514,86 @@ inline void ConditionFailedError::set_allocated_actual_value(::cockroach::proto:
 
 // -------------------------------------------------------------------
 
+// AmbiguousResultError
+
+// optional string message = 1;
+inline bool AmbiguousResultError::has_message() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void AmbiguousResultError::set_has_message() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void AmbiguousResultError::clear_has_message() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void AmbiguousResultError::clear_message() {
+  if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_->clear();
+  }
+  clear_has_message();
+}
+inline const ::std::string& AmbiguousResultError::message() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AmbiguousResultError.message)
+  return *message_;
+}
+inline void AmbiguousResultError::set_message(const ::std::string& value) {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  message_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AmbiguousResultError.message)
+}
+inline void AmbiguousResultError::set_message(const char* value) {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  message_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AmbiguousResultError.message)
+}
+inline void AmbiguousResultError::set_message(const char* value, size_t size) {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  message_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AmbiguousResultError.message)
+}
+inline ::std::string* AmbiguousResultError::mutable_message() {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AmbiguousResultError.message)
+  return message_;
+}
+inline ::std::string* AmbiguousResultError::release_message() {
+  clear_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = message_;
+    message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AmbiguousResultError::set_allocated_message(::std::string* message) {
+  if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete message_;
+  }
+  if (message) {
+    set_has_message();
+    message_ = message;
+  } else {
+    clear_has_message();
+    message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AmbiguousResultError.message)
+}
+
+// -------------------------------------------------------------------
+
 // ErrorDetail
 
 // optional .cockroach.proto.NotLeaderError not_leader = 1;
@@ -2935,6 +3112,49 @@ inline void ErrorDetail::set_allocated_condition_failed(::cockroach::proto::Cond
   }
 }
 
+// optional .cockroach.proto.AmbiguousResultError ambiguous_result = 13;
+inline bool ErrorDetail::has_ambiguous_result() const {
+  return value_case() == kAmbiguousResult;
+}
+inline void ErrorDetail::set_has_ambiguous_result() {
+  _oneof_case_[0] = kAmbiguousResult;
+}
+inline void ErrorDetail::clear_ambiguous_result() {
+  if (has_ambiguous_result()) {
+    delete value_.ambiguous_result_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::AmbiguousResultError& ErrorDetail::ambiguous_result() const {
+  return has_ambiguous_result() ? *value_.ambiguous_result_
+                      : ::cockroach::proto::AmbiguousResultError::default_instance();
+}
+inline ::cockroach::proto::AmbiguousResultError* ErrorDetail::mutable_ambiguous_result() {
+  if (!has_ambiguous_result()) {
+    clear_value();
+    set_has_ambiguous_result();
+    value_.ambiguous_result_ = new ::cockroach::proto::AmbiguousResultError;
+  }
+  return value_.ambiguous_result_;
+}
+inline ::cockroach::proto::AmbiguousResultError* ErrorDetail::release_ambiguous_result() {
+  if (has_ambiguous_result()) {
+    clear_has_value();
+    ::cockroach::proto::AmbiguousResultError* temp = value_.ambiguous_result_;
+    value_.ambiguous_result_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ErrorDetail::set_allocated_ambiguous_result(::cockroach::proto::AmbiguousResultError* ambiguous_result) {
+  clear_value();
+  if (ambiguous_result) {
+    set_has_ambiguous_result();
+    value_.ambiguous_result_ = ambiguous_result;
+  }
+}
+
 inline bool ErrorDetail::has_value() {
   return value_case() != VALUE_NOT_SET;
 }
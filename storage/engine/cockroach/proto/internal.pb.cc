@@ -72,6 +72,24 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* InternalLeaderLeaseResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalLeaderLeaseResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalCheckConsistencyRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalCheckConsistencyRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalCheckConsistencyResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalCheckConsistencyResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalRecomputeStatsRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalRecomputeStatsRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalRecomputeStatsResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalRecomputeStatsResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalRefreshRangeRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalRefreshRangeRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalRefreshRangeResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalRefreshRangeResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ReadWriteCmdResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ReadWriteCmdResponse_reflection_ = NULL;
@@ -82,12 +100,15 @@ struct ReadWriteCmdResponseOneofInstance {
   const ::cockroach::proto::DeleteResponse* delete__;
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::InitPutResponse* init_put_;
   const ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
   const ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
   const ::cockroach::proto::InternalMergeResponse* internal_merge_;
   const ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
   const ::cockroach::proto::InternalGCResponse* internal_gc_;
+  const ::cockroach::proto::ClearRangeResponse* clear_range_;
+  const ::cockroach::proto::BatchResponse* batch_;
 }* ReadWriteCmdResponse_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommandUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -102,6 +123,8 @@ struct InternalRaftCommandUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::InitPutRequest* init_put_;
+  const ::cockroach::proto::AggregateRequest* aggregate_;
   const ::cockroach::proto::BatchRequest* batch_;
   const ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
   const ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
@@ -111,6 +134,10 @@ struct InternalRaftCommandUnionOneofInstance {
   const ::cockroach::proto::InternalTruncateLogRequest* internal_truncate_log_;
   const ::cockroach::proto::InternalGCRequest* internal_gc_;
   const ::cockroach::proto::InternalLeaderLeaseRequest* internal_lease_;
+  const ::cockroach::proto::InternalCheckConsistencyRequest* internal_check_consistency_;
+  const ::cockroach::proto::InternalRecomputeStatsRequest* internal_recompute_stats_;
+  const ::cockroach::proto::ClearRangeRequest* clear_range_;
+  const ::cockroach::proto::InternalRefreshRangeRequest* internal_refresh_range_;
 }* InternalRaftCommandUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommand_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -118,6 +145,12 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* RaftMessageRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RaftMessageRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReserveSnapshotRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReserveSnapshotRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReserveSnapshotResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReserveSnapshotResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RaftMessageResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RaftMessageResponse_reflection_ = NULL;
@@ -136,6 +169,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* RaftSnapshotData_KeyValue_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RaftSnapshotData_KeyValue_reflection_ = NULL;
+const ::google::protobuf::Descriptor* GossipBootstrapInfo_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  GossipBootstrapInfo_reflection_ = NULL;
 const ::google::protobuf::EnumDescriptor* InternalValueType_descriptor_ = NULL;
 
 }  // namespace
@@ -413,20 +449,118 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalLeaderLeaseResponse));
-  ReadWriteCmdResponse_descriptor_ = file->message_type(16);
-  static const int ReadWriteCmdResponse_offsets_[13] = {
+  InternalCheckConsistencyRequest_descriptor_ = file->message_type(16);
+  static const int InternalCheckConsistencyRequest_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyRequest, checksum_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyRequest, checksum_),
+  };
+  InternalCheckConsistencyRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalCheckConsistencyRequest_descriptor_,
+      InternalCheckConsistencyRequest::default_instance_,
+      InternalCheckConsistencyRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalCheckConsistencyRequest));
+  InternalCheckConsistencyResponse_descriptor_ = file->message_type(17);
+  static const int InternalCheckConsistencyResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyResponse, checksum_),
+  };
+  InternalCheckConsistencyResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalCheckConsistencyResponse_descriptor_,
+      InternalCheckConsistencyResponse::default_instance_,
+      InternalCheckConsistencyResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCheckConsistencyResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalCheckConsistencyResponse));
+  InternalRecomputeStatsRequest_descriptor_ = file->message_type(18);
+  static const int InternalRecomputeStatsRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsRequest, header_),
+  };
+  InternalRecomputeStatsRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalRecomputeStatsRequest_descriptor_,
+      InternalRecomputeStatsRequest::default_instance_,
+      InternalRecomputeStatsRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalRecomputeStatsRequest));
+  InternalRecomputeStatsResponse_descriptor_ = file->message_type(19);
+  static const int InternalRecomputeStatsResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsResponse, delta_),
+  };
+  InternalRecomputeStatsResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalRecomputeStatsResponse_descriptor_,
+      InternalRecomputeStatsResponse::default_instance_,
+      InternalRecomputeStatsResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRecomputeStatsResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalRecomputeStatsResponse));
+  InternalRefreshRangeRequest_descriptor_ = file->message_type(20);
+  static const int InternalRefreshRangeRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeRequest, refresh_from_),
+  };
+  InternalRefreshRangeRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalRefreshRangeRequest_descriptor_,
+      InternalRefreshRangeRequest::default_instance_,
+      InternalRefreshRangeRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalRefreshRangeRequest));
+  InternalRefreshRangeResponse_descriptor_ = file->message_type(21);
+  static const int InternalRefreshRangeResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeResponse, header_),
+  };
+  InternalRefreshRangeResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalRefreshRangeResponse_descriptor_,
+      InternalRefreshRangeResponse::default_instance_,
+      InternalRefreshRangeResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRefreshRangeResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalRefreshRangeResponse));
+  ReadWriteCmdResponse_descriptor_ = file->message_type(22);
+  static const int ReadWriteCmdResponse_offsets_[16] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, conditional_put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, increment_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete__),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, init_put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_heartbeat_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_resolve_intent_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_merge_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_truncate_log_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_gc_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, clear_range_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, batch_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, value_),
   };
   ReadWriteCmdResponse_reflection_ =
@@ -442,8 +576,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ReadWriteCmdResponse));
-  InternalRaftCommandUnion_descriptor_ = file->message_type(17);
-  static const int InternalRaftCommandUnion_offsets_[19] = {
+  InternalRaftCommandUnion_descriptor_ = file->message_type(23);
+  static const int InternalRaftCommandUnion_offsets_[25] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, contains_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, put_),
@@ -453,6 +587,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, init_put_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, aggregate_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, batch_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_range_lookup_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_heartbeat_txn_),
@@ -462,6 +598,10 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_truncate_log_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_gc_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_lease_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_check_consistency_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_recompute_stats_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, clear_range_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_refresh_range_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, value_),
   };
   InternalRaftCommandUnion_reflection_ =
@@ -477,10 +617,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRaftCommandUnion));
-  InternalRaftCommand_descriptor_ = file->message_type(18);
-  static const int InternalRaftCommand_offsets_[2] = {
+  InternalRaftCommand_descriptor_ = file->message_type(24);
+  static const int InternalRaftCommand_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, raft_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, cmd_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, closed_timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, max_lease_index_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, proposer_lease_),
   };
   InternalRaftCommand_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -493,7 +636,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRaftCommand));
-  RaftMessageRequest_descriptor_ = file->message_type(19);
+  RaftMessageRequest_descriptor_ = file->message_type(25);
   static const int RaftMessageRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, group_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, msg_),
@@ -509,7 +652,38 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftMessageRequest));
-  RaftMessageResponse_descriptor_ = file->message_type(20);
+  ReserveSnapshotRequest_descriptor_ = file->message_type(26);
+  static const int ReserveSnapshotRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotRequest, group_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotRequest, node_id_),
+  };
+  ReserveSnapshotRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ReserveSnapshotRequest_descriptor_,
+      ReserveSnapshotRequest::default_instance_,
+      ReserveSnapshotRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ReserveSnapshotRequest));
+  ReserveSnapshotResponse_descriptor_ = file->message_type(27);
+  static const int ReserveSnapshotResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotResponse, reserved_),
+  };
+  ReserveSnapshotResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ReserveSnapshotResponse_descriptor_,
+      ReserveSnapshotResponse::default_instance_,
+      ReserveSnapshotResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReserveSnapshotResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ReserveSnapshotResponse));
+  RaftMessageResponse_descriptor_ = file->message_type(28);
   static const int RaftMessageResponse_offsets_[1] = {
   };
   RaftMessageResponse_reflection_ =
@@ -523,7 +697,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftMessageResponse));
-  InternalTimeSeriesData_descriptor_ = file->message_type(21);
+  InternalTimeSeriesData_descriptor_ = file->message_type(29);
   static const int InternalTimeSeriesData_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, start_timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, sample_duration_nanos_),
@@ -540,7 +714,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTimeSeriesData));
-  InternalTimeSeriesSample_descriptor_ = file->message_type(22);
+  InternalTimeSeriesSample_descriptor_ = file->message_type(30);
   static const int InternalTimeSeriesSample_offsets_[9] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, offset_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, int_count_),
@@ -563,7 +737,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTimeSeriesSample));
-  RaftTruncatedState_descriptor_ = file->message_type(23);
+  RaftTruncatedState_descriptor_ = file->message_type(31);
   static const int RaftTruncatedState_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, index_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, term_),
@@ -579,7 +753,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftTruncatedState));
-  RaftSnapshotData_descriptor_ = file->message_type(24);
+  RaftSnapshotData_descriptor_ = file->message_type(32);
   static const int RaftSnapshotData_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftSnapshotData, kv_),
   };
@@ -610,6 +784,21 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftSnapshotData_KeyValue));
+  GossipBootstrapInfo_descriptor_ = file->message_type(33);
+  static const int GossipBootstrapInfo_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GossipBootstrapInfo, addresses_),
+  };
+  GossipBootstrapInfo_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      GossipBootstrapInfo_descriptor_,
+      GossipBootstrapInfo::default_instance_,
+      GossipBootstrapInfo_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GossipBootstrapInfo, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GossipBootstrapInfo, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(GossipBootstrapInfo));
   InternalValueType_descriptor_ = file->enum_type(0);
 }
 
@@ -657,6 +846,18 @@ void protobuf_RegisterTypes(const ::std::string&) {
     InternalLeaderLeaseRequest_descriptor_, &InternalLeaderLeaseRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     InternalLeaderLeaseResponse_descriptor_, &InternalLeaderLeaseResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalCheckConsistencyRequest_descriptor_, &InternalCheckConsistencyRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalCheckConsistencyResponse_descriptor_, &InternalCheckConsistencyResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalRecomputeStatsRequest_descriptor_, &InternalRecomputeStatsRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalRecomputeStatsResponse_descriptor_, &InternalRecomputeStatsResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalRefreshRangeRequest_descriptor_, &InternalRefreshRangeRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalRefreshRangeResponse_descriptor_, &InternalRefreshRangeResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ReadWriteCmdResponse_descriptor_, &ReadWriteCmdResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -665,6 +866,10 @@ void protobuf_RegisterTypes(const ::std::string&) {
     InternalRaftCommand_descriptor_, &InternalRaftCommand::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RaftMessageRequest_descriptor_, &RaftMessageRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ReserveSnapshotRequest_descriptor_, &ReserveSnapshotRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ReserveSnapshotResponse_descriptor_, &ReserveSnapshotResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RaftMessageResponse_descriptor_, &RaftMessageResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -677,6 +882,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     RaftSnapshotData_descriptor_, &RaftSnapshotData::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RaftSnapshotData_KeyValue_descriptor_, &RaftSnapshotData_KeyValue::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    GossipBootstrapInfo_descriptor_, &GossipBootstrapInfo::default_instance());
 }
 
 }  // namespace
@@ -716,6 +923,18 @@ void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto() {
   delete InternalLeaderLeaseRequest_reflection_;
   delete InternalLeaderLeaseResponse::default_instance_;
   delete InternalLeaderLeaseResponse_reflection_;
+  delete InternalCheckConsistencyRequest::default_instance_;
+  delete InternalCheckConsistencyRequest_reflection_;
+  delete InternalCheckConsistencyResponse::default_instance_;
+  delete InternalCheckConsistencyResponse_reflection_;
+  delete InternalRecomputeStatsRequest::default_instance_;
+  delete InternalRecomputeStatsRequest_reflection_;
+  delete InternalRecomputeStatsResponse::default_instance_;
+  delete InternalRecomputeStatsResponse_reflection_;
+  delete InternalRefreshRangeRequest::default_instance_;
+  delete InternalRefreshRangeRequest_reflection_;
+  delete InternalRefreshRangeResponse::default_instance_;
+  delete InternalRefreshRangeResponse_reflection_;
   delete ReadWriteCmdResponse::default_instance_;
   delete ReadWriteCmdResponse_default_oneof_instance_;
   delete ReadWriteCmdResponse_reflection_;
@@ -726,6 +945,10 @@ void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto() {
   delete InternalRaftCommand_reflection_;
   delete RaftMessageRequest::default_instance_;
   delete RaftMessageRequest_reflection_;
+  delete ReserveSnapshotRequest::default_instance_;
+  delete ReserveSnapshotRequest_reflection_;
+  delete ReserveSnapshotResponse::default_instance_;
+  delete ReserveSnapshotResponse_reflection_;
   delete RaftMessageResponse::default_instance_;
   delete RaftMessageResponse_reflection_;
   delete InternalTimeSeriesData::default_instance_;
@@ -738,6 +961,8 @@ void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto() {
   delete RaftSnapshotData_reflection_;
   delete RaftSnapshotData_KeyValue::default_instance_;
   delete RaftSnapshotData_KeyValue_reflection_;
+  delete GossipBootstrapInfo::default_instance_;
+  delete GossipBootstrapInfo_reflection_;
 }
 
 void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
@@ -803,78 +1028,119 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "\002 \001(\0132\026.cockroach.proto.LeaseB\004\310\336\037\000\"X\n\033I"
     "nternalLeaderLeaseResponse\0229\n\006header\030\001 \001"
     "(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037"
-    "\000\320\336\037\001\"\274\006\n\024ReadWriteCmdResponse\022+\n\003put\030\001 "
-    "\001(\0132\034.cockroach.proto.PutResponseH\000\022B\n\017c"
-    "onditional_put\030\002 \001(\0132\'.cockroach.proto.C"
-    "onditionalPutResponseH\000\0227\n\tincrement\030\003 \001"
-    "(\0132\".cockroach.proto.IncrementResponseH\000"
-    "\0221\n\006delete\030\004 \001(\0132\037.cockroach.proto.Delet"
-    "eResponseH\000\022<\n\014delete_range\030\005 \001(\0132$.cock"
-    "roach.proto.DeleteRangeResponseH\000\022B\n\017end"
-    "_transaction\030\006 \001(\0132\'.cockroach.proto.End"
-    "TransactionResponseH\000\022O\n\026internal_heartb"
-    "eat_txn\030\n \001(\0132-.cockroach.proto.Internal"
-    "HeartbeatTxnResponseH\000\022E\n\021internal_push_"
-    "txn\030\013 \001(\0132(.cockroach.proto.InternalPush"
-    "TxnResponseH\000\022Q\n\027internal_resolve_intent"
-    "\030\014 \001(\0132..cockroach.proto.InternalResolve"
-    "IntentResponseH\000\022@\n\016internal_merge\030\r \001(\013"
-    "2&.cockroach.proto.InternalMergeResponse"
-    "H\000\022M\n\025internal_truncate_log\030\016 \001(\0132,.cock"
-    "roach.proto.InternalTruncateLogResponseH"
-    "\000\022:\n\013internal_gc\030\017 \001(\0132#.cockroach.proto"
-    ".InternalGCResponseH\000:\004\310\240\037\001B\007\n\005value\"\242\t\n"
-    "\030InternalRaftCommandUnion\0224\n\010contains\030\001 "
-    "\001(\0132 .cockroach.proto.ContainsRequestH\000\022"
-    "*\n\003get\030\002 \001(\0132\033.cockroach.proto.GetReques"
-    "tH\000\022*\n\003put\030\003 \001(\0132\033.cockroach.proto.PutRe"
-    "questH\000\022A\n\017conditional_put\030\004 \001(\0132&.cockr"
-    "oach.proto.ConditionalPutRequestH\000\0226\n\tin"
-    "crement\030\005 \001(\0132!.cockroach.proto.Incremen"
-    "tRequestH\000\0220\n\006delete\030\006 \001(\0132\036.cockroach.p"
-    "roto.DeleteRequestH\000\022;\n\014delete_range\030\007 \001"
-    "(\0132#.cockroach.proto.DeleteRangeRequestH"
-    "\000\022,\n\004scan\030\010 \001(\0132\034.cockroach.proto.ScanRe"
-    "questH\000\022A\n\017end_transaction\030\t \001(\0132&.cockr"
-    "oach.proto.EndTransactionRequestH\000\022.\n\005ba"
-    "tch\030\036 \001(\0132\035.cockroach.proto.BatchRequest"
-    "H\000\022L\n\025internal_range_lookup\030\037 \001(\0132+.cock"
-    "roach.proto.InternalRangeLookupRequestH\000"
-    "\022N\n\026internal_heartbeat_txn\030  \001(\0132,.cockr"
-    "oach.proto.InternalHeartbeatTxnRequestH\000"
-    "\022D\n\021internal_push_txn\030! \001(\0132\'.cockroach."
-    "proto.InternalPushTxnRequestH\000\022P\n\027intern"
-    "al_resolve_intent\030\" \001(\0132-.cockroach.prot"
-    "o.InternalResolveIntentRequestH\000\022H\n\027inte"
-    "rnal_merge_response\030# \001(\0132%.cockroach.pr"
-    "oto.InternalMergeRequestH\000\022L\n\025internal_t"
-    "runcate_log\030$ \001(\0132+.cockroach.proto.Inte"
-    "rnalTruncateLogRequestH\000\022I\n\013internal_gc\030"
-    "% \001(\0132\".cockroach.proto.InternalGCReques"
-    "tB\016\342\336\037\nInternalGCH\000\022E\n\016internal_lease\030& "
-    "\001(\0132+.cockroach.proto.InternalLeaderLeas"
-    "eRequestH\000:\004\310\240\037\001B\007\n\005value\"t\n\023InternalRaf"
-    "tCommand\022\037\n\007raft_id\030\002 \001(\003B\016\310\336\037\000\342\336\037\006RaftI"
-    "D\022<\n\003cmd\030\003 \001(\0132).cockroach.proto.Interna"
-    "lRaftCommandUnionB\004\310\336\037\000\"D\n\022RaftMessageRe"
-    "quest\022!\n\010group_id\030\001 \001(\004B\017\310\336\037\000\342\336\037\007GroupID"
-    "\022\013\n\003msg\030\002 \001(\014\"\025\n\023RaftMessageResponse\"\236\001\n"
-    "\026InternalTimeSeriesData\022#\n\025start_timesta"
-    "mp_nanos\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample_duration_"
-    "nanos\030\002 \001(\003B\004\310\336\037\000\022:\n\007samples\030\003 \003(\0132).coc"
-    "kroach.proto.InternalTimeSeriesSample\"\320\001"
-    "\n\030InternalTimeSeriesSample\022\024\n\006offset\030\001 \001"
-    "(\005B\004\310\336\037\000\022\027\n\tint_count\030\002 \001(\rB\004\310\336\037\000\022\017\n\007int"
-    "_sum\030\003 \001(\003\022\017\n\007int_max\030\004 \001(\003\022\017\n\007int_min\030\005"
-    " \001(\003\022\031\n\013float_count\030\006 \001(\rB\004\310\336\037\000\022\021\n\tfloat"
-    "_sum\030\007 \001(\002\022\021\n\tfloat_max\030\010 \001(\002\022\021\n\tfloat_m"
-    "in\030\t \001(\002\"=\n\022RaftTruncatedState\022\023\n\005index\030"
-    "\001 \001(\004B\004\310\336\037\000\022\022\n\004term\030\002 \001(\004B\004\310\336\037\000\"z\n\020RaftS"
-    "napshotData\022>\n\002KV\030\001 \003(\0132*.cockroach.prot"
-    "o.RaftSnapshotData.KeyValueB\006\342\336\037\002KV\032&\n\010K"
-    "eyValue\022\013\n\003key\030\001 \001(\014\022\r\n\005value\030\002 \001(\014*%\n\021I"
-    "nternalValueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000B\023Z\005pr"
-    "oto\340\342\036\001\310\342\036\001\320\342\036\001", 4935);
+    "\000\320\336\037\001\"\222\001\n\037InternalCheckConsistencyReques"
+    "t\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Requ"
+    "estHeaderB\010\310\336\037\000\320\336\037\001\022#\n\013checksum_id\030\002 \001(\014"
+    "B\016\342\336\037\nChecksumID\022\020\n\010checksum\030\003 \001(\014\"o\n In"
+    "ternalCheckConsistencyResponse\0229\n\006header"
+    "\030\001 \001(\0132\037.cockroach.proto.ResponseHeaderB"
+    "\010\310\336\037\000\320\336\037\001\022\020\n\010checksum\030\002 \001(\014\"Y\n\035InternalR"
+    "ecomputeStatsRequest\0228\n\006header\030\001 \001(\0132\036.c"
+    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"\214"
+    "\001\n\036InternalRecomputeStatsResponse\0229\n\006hea"
+    "der\030\001 \001(\0132\037.cockroach.proto.ResponseHead"
+    "erB\010\310\336\037\000\320\336\037\001\022/\n\005delta\030\002 \001(\0132\032.cockroach."
+    "proto.MVCCStatsB\004\310\336\037\000\"\217\001\n\033InternalRefres"
+    "hRangeRequest\0228\n\006header\030\001 \001(\0132\036.cockroac"
+    "h.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\0226\n\014refre"
+    "sh_from\030\002 \001(\0132\032.cockroach.proto.Timestam"
+    "pB\004\310\336\037\000\"Y\n\034InternalRefreshRangeResponse\022"
+    "9\n\006header\030\001 \001(\0132\037.cockroach.proto.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\"\337\007\n\024ReadWriteCmdRespo"
+    "nse\022+\n\003put\030\001 \001(\0132\034.cockroach.proto.PutRe"
+    "sponseH\000\022B\n\017conditional_put\030\002 \001(\0132\'.cock"
+    "roach.proto.ConditionalPutResponseH\000\0227\n\t"
+    "increment\030\003 \001(\0132\".cockroach.proto.Increm"
+    "entResponseH\000\0221\n\006delete\030\004 \001(\0132\037.cockroac"
+    "h.proto.DeleteResponseH\000\022<\n\014delete_range"
+    "\030\005 \001(\0132$.cockroach.proto.DeleteRangeResp"
+    "onseH\000\022B\n\017end_transaction\030\006 \001(\0132\'.cockro"
+    "ach.proto.EndTransactionResponseH\000\0224\n\010in"
+    "it_put\030\007 \001(\0132 .cockroach.proto.InitPutRe"
+    "sponseH\000\022O\n\026internal_heartbeat_txn\030\n \001(\013"
+    "2-.cockroach.proto.InternalHeartbeatTxnR"
+    "esponseH\000\022E\n\021internal_push_txn\030\013 \001(\0132(.c"
+    "ockroach.proto.InternalPushTxnResponseH\000"
+    "\022Q\n\027internal_resolve_intent\030\014 \001(\0132..cock"
+    "roach.proto.InternalResolveIntentRespons"
+    "eH\000\022@\n\016internal_merge\030\r \001(\0132&.cockroach."
+    "proto.InternalMergeResponseH\000\022M\n\025interna"
+    "l_truncate_log\030\016 \001(\0132,.cockroach.proto.I"
+    "nternalTruncateLogResponseH\000\022:\n\013internal"
+    "_gc\030\017 \001(\0132#.cockroach.proto.InternalGCRe"
+    "sponseH\000\022:\n\013clear_range\030\020 \001(\0132#.cockroac"
+    "h.proto.ClearRangeResponseH\000\022/\n\005batch\030\021 "
+    "\001(\0132\036.cockroach.proto.BatchResponseH\000:\004\310"
+    "\240\037\001B\007\n\005value\"\306\014\n\030InternalRaftCommandUnio"
+    "n\0224\n\010contains\030\001 \001(\0132 .cockroach.proto.Co"
+    "ntainsRequestH\000\022*\n\003get\030\002 \001(\0132\033.cockroach"
+    ".proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033.cockr"
+    "oach.proto.PutRequestH\000\022A\n\017conditional_p"
+    "ut\030\004 \001(\0132&.cockroach.proto.ConditionalPu"
+    "tRequestH\000\0226\n\tincrement\030\005 \001(\0132!.cockroac"
+    "h.proto.IncrementRequestH\000\0220\n\006delete\030\006 \001"
+    "(\0132\036.cockroach.proto.DeleteRequestH\000\022;\n\014"
+    "delete_range\030\007 \001(\0132#.cockroach.proto.Del"
+    "eteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034.cockro"
+    "ach.proto.ScanRequestH\000\022A\n\017end_transacti"
+    "on\030\t \001(\0132&.cockroach.proto.EndTransactio"
+    "nRequestH\000\0223\n\010init_put\030\n \001(\0132\037.cockroach"
+    ".proto.InitPutRequestH\000\0226\n\taggregate\030\013 \001"
+    "(\0132!.cockroach.proto.AggregateRequestH\000\022"
+    ".\n\005batch\030\036 \001(\0132\035.cockroach.proto.BatchRe"
+    "questH\000\022L\n\025internal_range_lookup\030\037 \001(\0132+"
+    ".cockroach.proto.InternalRangeLookupRequ"
+    "estH\000\022N\n\026internal_heartbeat_txn\030  \001(\0132,."
+    "cockroach.proto.InternalHeartbeatTxnRequ"
+    "estH\000\022D\n\021internal_push_txn\030! \001(\0132\'.cockr"
+    "oach.proto.InternalPushTxnRequestH\000\022P\n\027i"
+    "nternal_resolve_intent\030\" \001(\0132-.cockroach"
+    ".proto.InternalResolveIntentRequestH\000\022H\n"
+    "\027internal_merge_response\030# \001(\0132%.cockroa"
+    "ch.proto.InternalMergeRequestH\000\022L\n\025inter"
+    "nal_truncate_log\030$ \001(\0132+.cockroach.proto"
+    ".InternalTruncateLogRequestH\000\022I\n\013interna"
+    "l_gc\030% \001(\0132\".cockroach.proto.InternalGCR"
+    "equestB\016\342\336\037\nInternalGCH\000\022E\n\016internal_lea"
+    "se\030& \001(\0132+.cockroach.proto.InternalLeade"
+    "rLeaseRequestH\000\022V\n\032internal_check_consis"
+    "tency\030\' \001(\01320.cockroach.proto.InternalCh"
+    "eckConsistencyRequestH\000\022R\n\030internal_reco"
+    "mpute_stats\030( \001(\0132..cockroach.proto.Inte"
+    "rnalRecomputeStatsRequestH\000\0229\n\013clear_ran"
+    "ge\030) \001(\0132\".cockroach.proto.ClearRangeReq"
+    "uestH\000\022N\n\026internal_refresh_range\030* \001(\0132,"
+    ".cockroach.proto.InternalRefreshRangeReq"
+    "uestH\000:\004\310\240\037\001B\007\n\005value\"\371\001\n\023InternalRaftCo"
+    "mmand\022\037\n\007raft_id\030\002 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\022<"
+    "\n\003cmd\030\003 \001(\0132).cockroach.proto.InternalRa"
+    "ftCommandUnionB\004\310\336\037\000\022:\n\020closed_timestamp"
+    "\030\004 \001(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000"
+    "\022\027\n\017max_lease_index\030\005 \001(\004\022.\n\016proposer_le"
+    "ase\030\006 \001(\0132\026.cockroach.proto.Lease\"D\n\022Raf"
+    "tMessageRequest\022!\n\010group_id\030\001 \001(\004B\017\310\336\037\000\342"
+    "\336\037\007GroupID\022\013\n\003msg\030\002 \001(\014\"\\\n\026ReserveSnapsh"
+    "otRequest\022!\n\010group_id\030\001 \001(\004B\017\310\336\037\000\342\336\037\007Gro"
+    "upID\022\037\n\007node_id\030\002 \001(\004B\016\310\336\037\000\342\336\037\006NodeID\"1\n"
+    "\027ReserveSnapshotResponse\022\026\n\010reserved\030\001 \001"
+    "(\010B\004\310\336\037\000\"\025\n\023RaftMessageResponse\"\236\001\n\026Inte"
+    "rnalTimeSeriesData\022#\n\025start_timestamp_na"
+    "nos\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample_duration_nanos"
+    "\030\002 \001(\003B\004\310\336\037\000\022:\n\007samples\030\003 \003(\0132).cockroac"
+    "h.proto.InternalTimeSeriesSample\"\320\001\n\030Int"
+    "ernalTimeSeriesSample\022\024\n\006offset\030\001 \001(\005B\004\310"
+    "\336\037\000\022\027\n\tint_count\030\002 \001(\rB\004\310\336\037\000\022\017\n\007int_sum\030"
+    "\003 \001(\003\022\017\n\007int_max\030\004 \001(\003\022\017\n\007int_min\030\005 \001(\003\022"
+    "\031\n\013float_count\030\006 \001(\rB\004\310\336\037\000\022\021\n\tfloat_sum\030"
+    "\007 \001(\002\022\021\n\tfloat_max\030\010 \001(\002\022\021\n\tfloat_min\030\t "
+    "\001(\002\"=\n\022RaftTruncatedState\022\023\n\005index\030\001 \001(\004"
+    "B\004\310\336\037\000\022\022\n\004term\030\002 \001(\004B\004\310\336\037\000\"z\n\020RaftSnapsh"
+    "otData\022>\n\002KV\030\001 \003(\0132*.cockroach.proto.Raf"
+    "tSnapshotData.KeyValueB\006\342\336\037\002KV\032&\n\010KeyVal"
+    "ue\022\013\n\003key\030\001 \001(\014\022\r\n\005value\030\002 \001(\014\"(\n\023Gossip"
+    "BootstrapInfo\022\021\n\taddresses\030\001 \003(\t*%\n\021Inte"
+    "rnalValueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000B\023Z\005proto"
+    "\340\342\036\001\310\342\036\001\320\342\036\001", 6572);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/internal.proto", &protobuf_RegisterTypes);
   InternalRangeLookupRequest::default_instance_ = new InternalRangeLookupRequest();
@@ -894,18 +1160,27 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
   InternalTruncateLogResponse::default_instance_ = new InternalTruncateLogResponse();
   InternalLeaderLeaseRequest::default_instance_ = new InternalLeaderLeaseRequest();
   InternalLeaderLeaseResponse::default_instance_ = new InternalLeaderLeaseResponse();
+  InternalCheckConsistencyRequest::default_instance_ = new InternalCheckConsistencyRequest();
+  InternalCheckConsistencyResponse::default_instance_ = new InternalCheckConsistencyResponse();
+  InternalRecomputeStatsRequest::default_instance_ = new InternalRecomputeStatsRequest();
+  InternalRecomputeStatsResponse::default_instance_ = new InternalRecomputeStatsResponse();
+  InternalRefreshRangeRequest::default_instance_ = new InternalRefreshRangeRequest();
+  InternalRefreshRangeResponse::default_instance_ = new InternalRefreshRangeResponse();
   ReadWriteCmdResponse::default_instance_ = new ReadWriteCmdResponse();
   ReadWriteCmdResponse_default_oneof_instance_ = new ReadWriteCmdResponseOneofInstance;
   InternalRaftCommandUnion::default_instance_ = new InternalRaftCommandUnion();
   InternalRaftCommandUnion_default_oneof_instance_ = new InternalRaftCommandUnionOneofInstance;
   InternalRaftCommand::default_instance_ = new InternalRaftCommand();
   RaftMessageRequest::default_instance_ = new RaftMessageRequest();
+  ReserveSnapshotRequest::default_instance_ = new ReserveSnapshotRequest();
+  ReserveSnapshotResponse::default_instance_ = new ReserveSnapshotResponse();
   RaftMessageResponse::default_instance_ = new RaftMessageResponse();
   InternalTimeSeriesData::default_instance_ = new InternalTimeSeriesData();
   InternalTimeSeriesSample::default_instance_ = new InternalTimeSeriesSample();
   RaftTruncatedState::default_instance_ = new RaftTruncatedState();
   RaftSnapshotData::default_instance_ = new RaftSnapshotData();
   RaftSnapshotData_KeyValue::default_instance_ = new RaftSnapshotData_KeyValue();
+  GossipBootstrapInfo::default_instance_ = new GossipBootstrapInfo();
   InternalRangeLookupRequest::default_instance_->InitAsDefaultInstance();
   InternalRangeLookupResponse::default_instance_->InitAsDefaultInstance();
   InternalHeartbeatTxnRequest::default_instance_->InitAsDefaultInstance();
@@ -923,16 +1198,25 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
   InternalTruncateLogResponse::default_instance_->InitAsDefaultInstance();
   InternalLeaderLeaseRequest::default_instance_->InitAsDefaultInstance();
   InternalLeaderLeaseResponse::default_instance_->InitAsDefaultInstance();
+  InternalCheckConsistencyRequest::default_instance_->InitAsDefaultInstance();
+  InternalCheckConsistencyResponse::default_instance_->InitAsDefaultInstance();
+  InternalRecomputeStatsRequest::default_instance_->InitAsDefaultInstance();
+  InternalRecomputeStatsResponse::default_instance_->InitAsDefaultInstance();
+  InternalRefreshRangeRequest::default_instance_->InitAsDefaultInstance();
+  InternalRefreshRangeResponse::default_instance_->InitAsDefaultInstance();
   ReadWriteCmdResponse::default_instance_->InitAsDefaultInstance();
   InternalRaftCommandUnion::default_instance_->InitAsDefaultInstance();
   InternalRaftCommand::default_instance_->InitAsDefaultInstance();
   RaftMessageRequest::default_instance_->InitAsDefaultInstance();
+  ReserveSnapshotRequest::default_instance_->InitAsDefaultInstance();
+  ReserveSnapshotResponse::default_instance_->InitAsDefaultInstance();
   RaftMessageResponse::default_instance_->InitAsDefaultInstance();
   InternalTimeSeriesData::default_instance_->InitAsDefaultInstance();
   InternalTimeSeriesSample::default_instance_->InitAsDefaultInstance();
   RaftTruncatedState::default_instance_->InitAsDefaultInstance();
   RaftSnapshotData::default_instance_->InitAsDefaultInstance();
   RaftSnapshotData_KeyValue::default_instance_->InitAsDefaultInstance();
+  GossipBootstrapInfo::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto);
 }
 
@@ -5295,310 +5579,437 @@ void InternalLeaderLeaseResponse::Swap(InternalLeaderLeaseResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ReadWriteCmdResponse::kPutFieldNumber;
-const int ReadWriteCmdResponse::kConditionalPutFieldNumber;
-const int ReadWriteCmdResponse::kIncrementFieldNumber;
-const int ReadWriteCmdResponse::kDeleteFieldNumber;
-const int ReadWriteCmdResponse::kDeleteRangeFieldNumber;
-const int ReadWriteCmdResponse::kEndTransactionFieldNumber;
-const int ReadWriteCmdResponse::kInternalHeartbeatTxnFieldNumber;
-const int ReadWriteCmdResponse::kInternalPushTxnFieldNumber;
-const int ReadWriteCmdResponse::kInternalResolveIntentFieldNumber;
-const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
-const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
-const int ReadWriteCmdResponse::kInternalGcFieldNumber;
+const int InternalCheckConsistencyRequest::kHeaderFieldNumber;
+const int InternalCheckConsistencyRequest::kChecksumIdFieldNumber;
+const int InternalCheckConsistencyRequest::kChecksumFieldNumber;
 #endif  // !_MSC_VER
 
-ReadWriteCmdResponse::ReadWriteCmdResponse()
+InternalCheckConsistencyRequest::InternalCheckConsistencyRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalCheckConsistencyRequest)
 }
 
-void ReadWriteCmdResponse::InitAsDefaultInstance() {
-  ReadWriteCmdResponse_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutResponse*>(&::cockroach::proto::PutResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutResponse*>(&::cockroach::proto::ConditionalPutResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementResponse*>(&::cockroach::proto::IncrementResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteResponse*>(&::cockroach::proto::DeleteResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnResponse*>(&::cockroach::proto::InternalHeartbeatTxnResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnResponse*>(&::cockroach::proto::InternalPushTxnResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentResponse*>(&::cockroach::proto::InternalResolveIntentResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->internal_merge_ = const_cast< ::cockroach::proto::InternalMergeResponse*>(&::cockroach::proto::InternalMergeResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogResponse*>(&::cockroach::proto::InternalTruncateLogResponse::default_instance());
-  ReadWriteCmdResponse_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCResponse*>(&::cockroach::proto::InternalGCResponse::default_instance());
+void InternalCheckConsistencyRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
+InternalCheckConsistencyRequest::InternalCheckConsistencyRequest(const InternalCheckConsistencyRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalCheckConsistencyRequest)
 }
 
-void ReadWriteCmdResponse::SharedCtor() {
+void InternalCheckConsistencyRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
+  header_ = NULL;
+  checksum_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-ReadWriteCmdResponse::~ReadWriteCmdResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.ReadWriteCmdResponse)
+InternalCheckConsistencyRequest::~InternalCheckConsistencyRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalCheckConsistencyRequest)
   SharedDtor();
 }
 
-void ReadWriteCmdResponse::SharedDtor() {
-  if (has_value()) {
-    clear_value();
+void InternalCheckConsistencyRequest::SharedDtor() {
+  if (checksum_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_id_;
+  }
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
   }
   if (this != default_instance_) {
+    delete header_;
   }
 }
 
-void ReadWriteCmdResponse::SetCachedSize(int size) const {
+void InternalCheckConsistencyRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ReadWriteCmdResponse::descriptor() {
+const ::google::protobuf::Descriptor* InternalCheckConsistencyRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ReadWriteCmdResponse_descriptor_;
+  return InternalCheckConsistencyRequest_descriptor_;
 }
 
-const ReadWriteCmdResponse& ReadWriteCmdResponse::default_instance() {
+const InternalCheckConsistencyRequest& InternalCheckConsistencyRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   return *default_instance_;
 }
 
-ReadWriteCmdResponse* ReadWriteCmdResponse::default_instance_ = NULL;
+InternalCheckConsistencyRequest* InternalCheckConsistencyRequest::default_instance_ = NULL;
 
-ReadWriteCmdResponse* ReadWriteCmdResponse::New() const {
-  return new ReadWriteCmdResponse;
+InternalCheckConsistencyRequest* InternalCheckConsistencyRequest::New() const {
+  return new InternalCheckConsistencyRequest;
 }
 
-void ReadWriteCmdResponse::clear_value() {
-  switch(value_case()) {
-    case kPut: {
-      delete value_.put_;
-      break;
-    }
-    case kConditionalPut: {
-      delete value_.conditional_put_;
-      break;
-    }
-    case kIncrement: {
-      delete value_.increment_;
-      break;
-    }
-    case kDelete: {
-      delete value_.delete__;
-      break;
-    }
-    case kDeleteRange: {
-      delete value_.delete_range_;
-      break;
-    }
-    case kEndTransaction: {
-      delete value_.end_transaction_;
-      break;
-    }
-    case kInternalHeartbeatTxn: {
-      delete value_.internal_heartbeat_txn_;
-      break;
-    }
-    case kInternalPushTxn: {
-      delete value_.internal_push_txn_;
-      break;
-    }
-    case kInternalResolveIntent: {
-      delete value_.internal_resolve_intent_;
-      break;
-    }
-    case kInternalMerge: {
-      delete value_.internal_merge_;
-      break;
-    }
-    case kInternalTruncateLog: {
-      delete value_.internal_truncate_log_;
-      break;
+void InternalCheckConsistencyRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    case kInternalGc: {
-      delete value_.internal_gc_;
-      break;
+    if (has_checksum_id()) {
+      if (checksum_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        checksum_id_->clear();
+      }
     }
-    case VALUE_NOT_SET: {
-      break;
+    if (has_checksum()) {
+      if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        checksum_->clear();
+      }
     }
   }
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
-
-
-void ReadWriteCmdResponse::Clear() {
-  clear_value();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ReadWriteCmdResponse::MergePartialFromCodedStream(
+bool InternalCheckConsistencyRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalCheckConsistencyRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.PutResponse put = 1;
+      // optional .cockroach.proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_put()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_conditional_put;
+        if (input->ExpectTag(18)) goto parse_checksum_id;
         break;
       }
 
-      // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
+      // optional bytes checksum_id = 2;
       case 2: {
         if (tag == 18) {
-         parse_conditional_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
+         parse_checksum_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_checksum_id()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_increment;
+        if (input->ExpectTag(26)) goto parse_checksum;
         break;
       }
 
-      // optional .cockroach.proto.IncrementResponse increment = 3;
+      // optional bytes checksum = 3;
       case 3: {
         if (tag == 26) {
-         parse_increment:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
+         parse_checksum:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_checksum()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(34)) goto parse_delete;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.DeleteResponse delete = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_delete:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(42)) goto parse_delete_range;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalCheckConsistencyRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalCheckConsistencyRequest)
+  return false;
+#undef DO_
+}
 
-      // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_delete_range:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_end_transaction;
-        break;
-      }
+void InternalCheckConsistencyRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalCheckConsistencyRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
 
-      // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_end_transaction:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(82)) goto parse_internal_heartbeat_txn;
-        break;
-      }
+  // optional bytes checksum_id = 2;
+  if (has_checksum_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->checksum_id(), output);
+  }
 
-      // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-      case 10: {
-        if (tag == 82) {
-         parse_internal_heartbeat_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_heartbeat_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(90)) goto parse_internal_push_txn;
-        break;
-      }
+  // optional bytes checksum = 3;
+  if (has_checksum()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->checksum(), output);
+  }
 
-      // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
-      case 11: {
-        if (tag == 90) {
-         parse_internal_push_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_push_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(98)) goto parse_internal_resolve_intent;
-        break;
-      }
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalCheckConsistencyRequest)
+}
 
-      // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-      case 12: {
-        if (tag == 98) {
-         parse_internal_resolve_intent:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_resolve_intent()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(106)) goto parse_internal_merge;
-        break;
-      }
+::google::protobuf::uint8* InternalCheckConsistencyRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalCheckConsistencyRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
 
-      // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
-      case 13: {
-        if (tag == 106) {
-         parse_internal_merge:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_merge()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(114)) goto parse_internal_truncate_log;
-        break;
+  // optional bytes checksum_id = 2;
+  if (has_checksum_id()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->checksum_id(), target);
+  }
+
+  // optional bytes checksum = 3;
+  if (has_checksum()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->checksum(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalCheckConsistencyRequest)
+  return target;
+}
+
+int InternalCheckConsistencyRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes checksum_id = 2;
+    if (has_checksum_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->checksum_id());
+    }
+
+    // optional bytes checksum = 3;
+    if (has_checksum()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->checksum());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalCheckConsistencyRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalCheckConsistencyRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalCheckConsistencyRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalCheckConsistencyRequest::MergeFrom(const InternalCheckConsistencyRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_checksum_id()) {
+      set_checksum_id(from.checksum_id());
+    }
+    if (from.has_checksum()) {
+      set_checksum(from.checksum());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalCheckConsistencyRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalCheckConsistencyRequest::CopyFrom(const InternalCheckConsistencyRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalCheckConsistencyRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalCheckConsistencyRequest::Swap(InternalCheckConsistencyRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(checksum_id_, other->checksum_id_);
+    std::swap(checksum_, other->checksum_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalCheckConsistencyRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalCheckConsistencyRequest_descriptor_;
+  metadata.reflection = InternalCheckConsistencyRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalCheckConsistencyResponse::kHeaderFieldNumber;
+const int InternalCheckConsistencyResponse::kChecksumFieldNumber;
+#endif  // !_MSC_VER
+
+InternalCheckConsistencyResponse::InternalCheckConsistencyResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalCheckConsistencyResponse)
+}
+
+void InternalCheckConsistencyResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+InternalCheckConsistencyResponse::InternalCheckConsistencyResponse(const InternalCheckConsistencyResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalCheckConsistencyResponse)
+}
+
+void InternalCheckConsistencyResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalCheckConsistencyResponse::~InternalCheckConsistencyResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalCheckConsistencyResponse)
+  SharedDtor();
+}
+
+void InternalCheckConsistencyResponse::SharedDtor() {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalCheckConsistencyResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalCheckConsistencyResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalCheckConsistencyResponse_descriptor_;
+}
+
+const InternalCheckConsistencyResponse& InternalCheckConsistencyResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalCheckConsistencyResponse* InternalCheckConsistencyResponse::default_instance_ = NULL;
+
+InternalCheckConsistencyResponse* InternalCheckConsistencyResponse::New() const {
+  return new InternalCheckConsistencyResponse;
+}
+
+void InternalCheckConsistencyResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_checksum()) {
+      if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        checksum_->clear();
       }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
 
-      // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
-      case 14: {
-        if (tag == 114) {
-         parse_internal_truncate_log:
+bool InternalCheckConsistencyResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalCheckConsistencyResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_truncate_log()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(122)) goto parse_internal_gc;
+        if (input->ExpectTag(18)) goto parse_checksum;
         break;
       }
 
-      // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
-      case 15: {
-        if (tag == 122) {
-         parse_internal_gc:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_gc()));
+      // optional bytes checksum = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_checksum:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_checksum()));
         } else {
           goto handle_unusual;
         }
@@ -5620,282 +6031,79 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalCheckConsistencyResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalCheckConsistencyResponse)
   return false;
 #undef DO_
 }
 
-void ReadWriteCmdResponse::SerializeWithCachedSizes(
+void InternalCheckConsistencyResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReadWriteCmdResponse)
-  // optional .cockroach.proto.PutResponse put = 1;
-  if (has_put()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalCheckConsistencyResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->put(), output);
+      1, this->header(), output);
   }
 
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
-  if (has_conditional_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->conditional_put(), output);
+  // optional bytes checksum = 2;
+  if (has_checksum()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->checksum(), output);
   }
 
-  // optional .cockroach.proto.IncrementResponse increment = 3;
-  if (has_increment()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->increment(), output);
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
   }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalCheckConsistencyResponse)
+}
 
-  // optional .cockroach.proto.DeleteResponse delete = 4;
-  if (has_delete_()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, this->delete_(), output);
-  }
-
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
-  if (has_delete_range()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, this->delete_range(), output);
-  }
-
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
-  if (has_end_transaction()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      6, this->end_transaction(), output);
-  }
-
-  // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-  if (has_internal_heartbeat_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      10, this->internal_heartbeat_txn(), output);
-  }
-
-  // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
-  if (has_internal_push_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      11, this->internal_push_txn(), output);
-  }
-
-  // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-  if (has_internal_resolve_intent()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      12, this->internal_resolve_intent(), output);
-  }
-
-  // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
-  if (has_internal_merge()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      13, this->internal_merge(), output);
-  }
-
-  // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
-  if (has_internal_truncate_log()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      14, this->internal_truncate_log(), output);
-  }
-
-  // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
-  if (has_internal_gc()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      15, this->internal_gc(), output);
-  }
-
-  if (!unknown_fields().empty()) {
-    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
-        unknown_fields(), output);
-  }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReadWriteCmdResponse)
-}
-
-::google::protobuf::uint8* ReadWriteCmdResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* InternalCheckConsistencyResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReadWriteCmdResponse)
-  // optional .cockroach.proto.PutResponse put = 1;
-  if (has_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->put(), target);
-  }
-
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
-  if (has_conditional_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->conditional_put(), target);
-  }
-
-  // optional .cockroach.proto.IncrementResponse increment = 3;
-  if (has_increment()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->increment(), target);
-  }
-
-  // optional .cockroach.proto.DeleteResponse delete = 4;
-  if (has_delete_()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        4, this->delete_(), target);
-  }
-
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
-  if (has_delete_range()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        5, this->delete_range(), target);
-  }
-
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
-  if (has_end_transaction()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        6, this->end_transaction(), target);
-  }
-
-  // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-  if (has_internal_heartbeat_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        10, this->internal_heartbeat_txn(), target);
-  }
-
-  // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
-  if (has_internal_push_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        11, this->internal_push_txn(), target);
-  }
-
-  // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-  if (has_internal_resolve_intent()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        12, this->internal_resolve_intent(), target);
-  }
-
-  // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
-  if (has_internal_merge()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        13, this->internal_merge(), target);
-  }
-
-  // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
-  if (has_internal_truncate_log()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalCheckConsistencyResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        14, this->internal_truncate_log(), target);
+        1, this->header(), target);
   }
 
-  // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
-  if (has_internal_gc()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        15, this->internal_gc(), target);
+  // optional bytes checksum = 2;
+  if (has_checksum()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->checksum(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalCheckConsistencyResponse)
   return target;
 }
 
-int ReadWriteCmdResponse::ByteSize() const {
+int InternalCheckConsistencyResponse::ByteSize() const {
   int total_size = 0;
 
-  switch (value_case()) {
-    // optional .cockroach.proto.PutResponse put = 1;
-    case kPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->put());
-      break;
-    }
-    // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
-    case kConditionalPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->conditional_put());
-      break;
-    }
-    // optional .cockroach.proto.IncrementResponse increment = 3;
-    case kIncrement: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->increment());
-      break;
-    }
-    // optional .cockroach.proto.DeleteResponse delete = 4;
-    case kDelete: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_());
-      break;
-    }
-    // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
-    case kDeleteRange: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_range());
-      break;
-    }
-    // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
-    case kEndTransaction: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->end_transaction());
-      break;
-    }
-    // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-    case kInternalHeartbeatTxn: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_heartbeat_txn());
-      break;
-    }
-    // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
-    case kInternalPushTxn: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_push_txn());
-      break;
-    }
-    // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-    case kInternalResolveIntent: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_resolve_intent());
-      break;
-    }
-    // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
-    case kInternalMerge: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_merge());
-      break;
-    }
-    // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
-    case kInternalTruncateLog: {
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_truncate_log());
-      break;
+          this->header());
     }
-    // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
-    case kInternalGc: {
+
+    // optional bytes checksum = 2;
+    if (has_checksum()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_gc());
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->checksum());
     }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -5908,10 +6116,10 @@ int ReadWriteCmdResponse::ByteSize() const {
   return total_size;
 }
 
-void ReadWriteCmdResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void InternalCheckConsistencyResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ReadWriteCmdResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ReadWriteCmdResponse*>(
+  const InternalCheckConsistencyResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalCheckConsistencyResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5920,96 +6128,51 @@ void ReadWriteCmdResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
+void InternalCheckConsistencyResponse::MergeFrom(const InternalCheckConsistencyResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
-  switch (from.value_case()) {
-    case kPut: {
-      mutable_put()->::cockroach::proto::PutResponse::MergeFrom(from.put());
-      break;
-    }
-    case kConditionalPut: {
-      mutable_conditional_put()->::cockroach::proto::ConditionalPutResponse::MergeFrom(from.conditional_put());
-      break;
-    }
-    case kIncrement: {
-      mutable_increment()->::cockroach::proto::IncrementResponse::MergeFrom(from.increment());
-      break;
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
     }
-    case kDelete: {
-      mutable_delete_()->::cockroach::proto::DeleteResponse::MergeFrom(from.delete_());
-      break;
-    }
-    case kDeleteRange: {
-      mutable_delete_range()->::cockroach::proto::DeleteRangeResponse::MergeFrom(from.delete_range());
-      break;
-    }
-    case kEndTransaction: {
-      mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
-      break;
-    }
-    case kInternalHeartbeatTxn: {
-      mutable_internal_heartbeat_txn()->::cockroach::proto::InternalHeartbeatTxnResponse::MergeFrom(from.internal_heartbeat_txn());
-      break;
-    }
-    case kInternalPushTxn: {
-      mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnResponse::MergeFrom(from.internal_push_txn());
-      break;
-    }
-    case kInternalResolveIntent: {
-      mutable_internal_resolve_intent()->::cockroach::proto::InternalResolveIntentResponse::MergeFrom(from.internal_resolve_intent());
-      break;
-    }
-    case kInternalMerge: {
-      mutable_internal_merge()->::cockroach::proto::InternalMergeResponse::MergeFrom(from.internal_merge());
-      break;
-    }
-    case kInternalTruncateLog: {
-      mutable_internal_truncate_log()->::cockroach::proto::InternalTruncateLogResponse::MergeFrom(from.internal_truncate_log());
-      break;
-    }
-    case kInternalGc: {
-      mutable_internal_gc()->::cockroach::proto::InternalGCResponse::MergeFrom(from.internal_gc());
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+    if (from.has_checksum()) {
+      set_checksum(from.checksum());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ReadWriteCmdResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void InternalCheckConsistencyResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ReadWriteCmdResponse::CopyFrom(const ReadWriteCmdResponse& from) {
+void InternalCheckConsistencyResponse::CopyFrom(const InternalCheckConsistencyResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ReadWriteCmdResponse::IsInitialized() const {
+bool InternalCheckConsistencyResponse::IsInitialized() const {
 
   return true;
 }
 
-void ReadWriteCmdResponse::Swap(ReadWriteCmdResponse* other) {
+void InternalCheckConsistencyResponse::Swap(InternalCheckConsistencyResponse* other) {
   if (other != this) {
-    std::swap(value_, other->value_);
-    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(header_, other->header_);
+    std::swap(checksum_, other->checksum_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ReadWriteCmdResponse::GetMetadata() const {
+::google::protobuf::Metadata InternalCheckConsistencyResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ReadWriteCmdResponse_descriptor_;
-  metadata.reflection = ReadWriteCmdResponse_reflection_;
+  metadata.descriptor = InternalCheckConsistencyResponse_descriptor_;
+  metadata.reflection = InternalCheckConsistencyResponse_reflection_;
   return metadata;
 }
 
@@ -6017,424 +6180,3254 @@ void ReadWriteCmdResponse::Swap(ReadWriteCmdResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int InternalRaftCommandUnion::kContainsFieldNumber;
-const int InternalRaftCommandUnion::kGetFieldNumber;
-const int InternalRaftCommandUnion::kPutFieldNumber;
-const int InternalRaftCommandUnion::kConditionalPutFieldNumber;
-const int InternalRaftCommandUnion::kIncrementFieldNumber;
-const int InternalRaftCommandUnion::kDeleteFieldNumber;
-const int InternalRaftCommandUnion::kDeleteRangeFieldNumber;
-const int InternalRaftCommandUnion::kScanFieldNumber;
-const int InternalRaftCommandUnion::kEndTransactionFieldNumber;
-const int InternalRaftCommandUnion::kBatchFieldNumber;
-const int InternalRaftCommandUnion::kInternalRangeLookupFieldNumber;
-const int InternalRaftCommandUnion::kInternalHeartbeatTxnFieldNumber;
-const int InternalRaftCommandUnion::kInternalPushTxnFieldNumber;
-const int InternalRaftCommandUnion::kInternalResolveIntentFieldNumber;
-const int InternalRaftCommandUnion::kInternalMergeResponseFieldNumber;
-const int InternalRaftCommandUnion::kInternalTruncateLogFieldNumber;
-const int InternalRaftCommandUnion::kInternalGcFieldNumber;
-const int InternalRaftCommandUnion::kInternalLeaseFieldNumber;
+const int InternalRecomputeStatsRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-InternalRaftCommandUnion::InternalRaftCommandUnion()
+InternalRecomputeStatsRequest::InternalRecomputeStatsRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRecomputeStatsRequest)
 }
 
-void InternalRaftCommandUnion::InitAsDefaultInstance() {
-  InternalRaftCommandUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsRequest*>(&::cockroach::proto::ContainsRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->batch_ = const_cast< ::cockroach::proto::BatchRequest*>(&::cockroach::proto::BatchRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_range_lookup_ = const_cast< ::cockroach::proto::InternalRangeLookupRequest*>(&::cockroach::proto::InternalRangeLookupRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnRequest*>(&::cockroach::proto::InternalHeartbeatTxnRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnRequest*>(&::cockroach::proto::InternalPushTxnRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentRequest*>(&::cockroach::proto::InternalResolveIntentRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_merge_response_ = const_cast< ::cockroach::proto::InternalMergeRequest*>(&::cockroach::proto::InternalMergeRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogRequest*>(&::cockroach::proto::InternalTruncateLogRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCRequest*>(&::cockroach::proto::InternalGCRequest::default_instance());
-  InternalRaftCommandUnion_default_oneof_instance_->internal_lease_ = const_cast< ::cockroach::proto::InternalLeaderLeaseRequest*>(&::cockroach::proto::InternalLeaderLeaseRequest::default_instance());
+void InternalRecomputeStatsRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
 }
 
-InternalRaftCommandUnion::InternalRaftCommandUnion(const InternalRaftCommandUnion& from)
+InternalRecomputeStatsRequest::InternalRecomputeStatsRequest(const InternalRecomputeStatsRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRecomputeStatsRequest)
 }
 
-void InternalRaftCommandUnion::SharedCtor() {
+void InternalRecomputeStatsRequest::SharedCtor() {
   _cached_size_ = 0;
+  header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-InternalRaftCommandUnion::~InternalRaftCommandUnion() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRaftCommandUnion)
+InternalRecomputeStatsRequest::~InternalRecomputeStatsRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRecomputeStatsRequest)
   SharedDtor();
 }
 
-void InternalRaftCommandUnion::SharedDtor() {
-  if (has_value()) {
-    clear_value();
-  }
+void InternalRecomputeStatsRequest::SharedDtor() {
   if (this != default_instance_) {
+    delete header_;
   }
 }
 
-void InternalRaftCommandUnion::SetCachedSize(int size) const {
+void InternalRecomputeStatsRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* InternalRaftCommandUnion::descriptor() {
+const ::google::protobuf::Descriptor* InternalRecomputeStatsRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return InternalRaftCommandUnion_descriptor_;
+  return InternalRecomputeStatsRequest_descriptor_;
 }
 
-const InternalRaftCommandUnion& InternalRaftCommandUnion::default_instance() {
+const InternalRecomputeStatsRequest& InternalRecomputeStatsRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   return *default_instance_;
 }
 
-InternalRaftCommandUnion* InternalRaftCommandUnion::default_instance_ = NULL;
+InternalRecomputeStatsRequest* InternalRecomputeStatsRequest::default_instance_ = NULL;
 
-InternalRaftCommandUnion* InternalRaftCommandUnion::New() const {
-  return new InternalRaftCommandUnion;
+InternalRecomputeStatsRequest* InternalRecomputeStatsRequest::New() const {
+  return new InternalRecomputeStatsRequest;
 }
 
-void InternalRaftCommandUnion::clear_value() {
-  switch(value_case()) {
-    case kContains: {
-      delete value_.contains_;
-      break;
-    }
-    case kGet: {
-      delete value_.get_;
-      break;
-    }
-    case kPut: {
-      delete value_.put_;
-      break;
-    }
-    case kConditionalPut: {
-      delete value_.conditional_put_;
-      break;
-    }
-    case kIncrement: {
-      delete value_.increment_;
-      break;
-    }
-    case kDelete: {
-      delete value_.delete__;
-      break;
-    }
-    case kDeleteRange: {
-      delete value_.delete_range_;
-      break;
-    }
-    case kScan: {
-      delete value_.scan_;
-      break;
-    }
-    case kEndTransaction: {
-      delete value_.end_transaction_;
-      break;
-    }
-    case kBatch: {
-      delete value_.batch_;
-      break;
-    }
-    case kInternalRangeLookup: {
-      delete value_.internal_range_lookup_;
-      break;
-    }
-    case kInternalHeartbeatTxn: {
-      delete value_.internal_heartbeat_txn_;
-      break;
-    }
-    case kInternalPushTxn: {
-      delete value_.internal_push_txn_;
-      break;
-    }
-    case kInternalResolveIntent: {
-      delete value_.internal_resolve_intent_;
-      break;
-    }
-    case kInternalMergeResponse: {
-      delete value_.internal_merge_response_;
-      break;
-    }
-    case kInternalTruncateLog: {
-      delete value_.internal_truncate_log_;
-      break;
-    }
-    case kInternalGc: {
-      delete value_.internal_gc_;
-      break;
-    }
-    case kInternalLease: {
-      delete value_.internal_lease_;
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
-    }
+void InternalRecomputeStatsRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   }
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
-
-
-void InternalRaftCommandUnion::Clear() {
-  clear_value();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool InternalRaftCommandUnion::MergePartialFromCodedStream(
+bool InternalRecomputeStatsRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRecomputeStatsRequest)
   for (;;) {
-    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.ContainsRequest contains = 1;
+      // optional .cockroach.proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_contains()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_get;
-        break;
-      }
-
-      // optional .cockroach.proto.GetRequest get = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_get:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_get()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_put;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.PutRequest put = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_put()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(34)) goto parse_conditional_put;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRecomputeStatsRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRecomputeStatsRequest)
+  return false;
+#undef DO_
+}
 
-      // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_conditional_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(42)) goto parse_increment;
-        break;
-      }
+void InternalRecomputeStatsRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRecomputeStatsRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
 
-      // optional .cockroach.proto.IncrementRequest increment = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_increment:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_delete;
-        break;
-      }
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRecomputeStatsRequest)
+}
 
-      // optional .cockroach.proto.DeleteRequest delete = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_delete:
+::google::protobuf::uint8* InternalRecomputeStatsRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRecomputeStatsRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRecomputeStatsRequest)
+  return target;
+}
+
+int InternalRecomputeStatsRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRecomputeStatsRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRecomputeStatsRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRecomputeStatsRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRecomputeStatsRequest::MergeFrom(const InternalRecomputeStatsRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRecomputeStatsRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRecomputeStatsRequest::CopyFrom(const InternalRecomputeStatsRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRecomputeStatsRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRecomputeStatsRequest::Swap(InternalRecomputeStatsRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRecomputeStatsRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRecomputeStatsRequest_descriptor_;
+  metadata.reflection = InternalRecomputeStatsRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRecomputeStatsResponse::kHeaderFieldNumber;
+const int InternalRecomputeStatsResponse::kDeltaFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRecomputeStatsResponse::InternalRecomputeStatsResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRecomputeStatsResponse)
+}
+
+void InternalRecomputeStatsResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  delta_ = const_cast< ::cockroach::proto::MVCCStats*>(&::cockroach::proto::MVCCStats::default_instance());
+}
+
+InternalRecomputeStatsResponse::InternalRecomputeStatsResponse(const InternalRecomputeStatsResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRecomputeStatsResponse)
+}
+
+void InternalRecomputeStatsResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  delta_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalRecomputeStatsResponse::~InternalRecomputeStatsResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRecomputeStatsResponse)
+  SharedDtor();
+}
+
+void InternalRecomputeStatsResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete delta_;
+  }
+}
+
+void InternalRecomputeStatsResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRecomputeStatsResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRecomputeStatsResponse_descriptor_;
+}
+
+const InternalRecomputeStatsResponse& InternalRecomputeStatsResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalRecomputeStatsResponse* InternalRecomputeStatsResponse::default_instance_ = NULL;
+
+InternalRecomputeStatsResponse* InternalRecomputeStatsResponse::New() const {
+  return new InternalRecomputeStatsResponse;
+}
+
+void InternalRecomputeStatsResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_delta()) {
+      if (delta_ != NULL) delta_->::cockroach::proto::MVCCStats::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRecomputeStatsResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRecomputeStatsResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(58)) goto parse_delete_range;
+        if (input->ExpectTag(18)) goto parse_delta;
         break;
       }
 
-      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
+      // optional .cockroach.proto.MVCCStats delta = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_delta:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
+               input, mutable_delta()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_scan;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.ScanRequest scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRecomputeStatsResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRecomputeStatsResponse)
+  return false;
+#undef DO_
+}
+
+void InternalRecomputeStatsResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRecomputeStatsResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .cockroach.proto.MVCCStats delta = 2;
+  if (has_delta()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->delta(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRecomputeStatsResponse)
+}
+
+::google::protobuf::uint8* InternalRecomputeStatsResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRecomputeStatsResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .cockroach.proto.MVCCStats delta = 2;
+  if (has_delta()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->delta(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRecomputeStatsResponse)
+  return target;
+}
+
+int InternalRecomputeStatsResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.MVCCStats delta = 2;
+    if (has_delta()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delta());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRecomputeStatsResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRecomputeStatsResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRecomputeStatsResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRecomputeStatsResponse::MergeFrom(const InternalRecomputeStatsResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_delta()) {
+      mutable_delta()->::cockroach::proto::MVCCStats::MergeFrom(from.delta());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRecomputeStatsResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRecomputeStatsResponse::CopyFrom(const InternalRecomputeStatsResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRecomputeStatsResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRecomputeStatsResponse::Swap(InternalRecomputeStatsResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(delta_, other->delta_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRecomputeStatsResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRecomputeStatsResponse_descriptor_;
+  metadata.reflection = InternalRecomputeStatsResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRefreshRangeRequest::kHeaderFieldNumber;
+const int InternalRefreshRangeRequest::kRefreshFromFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRefreshRangeRequest::InternalRefreshRangeRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRefreshRangeRequest)
+}
+
+void InternalRefreshRangeRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  refresh_from_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+InternalRefreshRangeRequest::InternalRefreshRangeRequest(const InternalRefreshRangeRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRefreshRangeRequest)
+}
+
+void InternalRefreshRangeRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  refresh_from_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalRefreshRangeRequest::~InternalRefreshRangeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRefreshRangeRequest)
+  SharedDtor();
+}
+
+void InternalRefreshRangeRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete refresh_from_;
+  }
+}
+
+void InternalRefreshRangeRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRefreshRangeRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRefreshRangeRequest_descriptor_;
+}
+
+const InternalRefreshRangeRequest& InternalRefreshRangeRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalRefreshRangeRequest* InternalRefreshRangeRequest::default_instance_ = NULL;
+
+InternalRefreshRangeRequest* InternalRefreshRangeRequest::New() const {
+  return new InternalRefreshRangeRequest;
+}
+
+void InternalRefreshRangeRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_refresh_from()) {
+      if (refresh_from_ != NULL) refresh_from_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRefreshRangeRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRefreshRangeRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_refresh_from;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp refresh_from = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_refresh_from:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_refresh_from()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRefreshRangeRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRefreshRangeRequest)
+  return false;
+#undef DO_
+}
+
+void InternalRefreshRangeRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRefreshRangeRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp refresh_from = 2;
+  if (has_refresh_from()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->refresh_from(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRefreshRangeRequest)
+}
+
+::google::protobuf::uint8* InternalRefreshRangeRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRefreshRangeRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp refresh_from = 2;
+  if (has_refresh_from()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->refresh_from(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRefreshRangeRequest)
+  return target;
+}
+
+int InternalRefreshRangeRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.Timestamp refresh_from = 2;
+    if (has_refresh_from()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->refresh_from());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRefreshRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRefreshRangeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRefreshRangeRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRefreshRangeRequest::MergeFrom(const InternalRefreshRangeRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_refresh_from()) {
+      mutable_refresh_from()->::cockroach::proto::Timestamp::MergeFrom(from.refresh_from());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRefreshRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRefreshRangeRequest::CopyFrom(const InternalRefreshRangeRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRefreshRangeRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRefreshRangeRequest::Swap(InternalRefreshRangeRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(refresh_from_, other->refresh_from_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRefreshRangeRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRefreshRangeRequest_descriptor_;
+  metadata.reflection = InternalRefreshRangeRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRefreshRangeResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRefreshRangeResponse::InternalRefreshRangeResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRefreshRangeResponse)
+}
+
+void InternalRefreshRangeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+InternalRefreshRangeResponse::InternalRefreshRangeResponse(const InternalRefreshRangeResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRefreshRangeResponse)
+}
+
+void InternalRefreshRangeResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalRefreshRangeResponse::~InternalRefreshRangeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRefreshRangeResponse)
+  SharedDtor();
+}
+
+void InternalRefreshRangeResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalRefreshRangeResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRefreshRangeResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRefreshRangeResponse_descriptor_;
+}
+
+const InternalRefreshRangeResponse& InternalRefreshRangeResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalRefreshRangeResponse* InternalRefreshRangeResponse::default_instance_ = NULL;
+
+InternalRefreshRangeResponse* InternalRefreshRangeResponse::New() const {
+  return new InternalRefreshRangeResponse;
+}
+
+void InternalRefreshRangeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRefreshRangeResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRefreshRangeResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRefreshRangeResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRefreshRangeResponse)
+  return false;
+#undef DO_
+}
+
+void InternalRefreshRangeResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRefreshRangeResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRefreshRangeResponse)
+}
+
+::google::protobuf::uint8* InternalRefreshRangeResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRefreshRangeResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRefreshRangeResponse)
+  return target;
+}
+
+int InternalRefreshRangeResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRefreshRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRefreshRangeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRefreshRangeResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRefreshRangeResponse::MergeFrom(const InternalRefreshRangeResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRefreshRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRefreshRangeResponse::CopyFrom(const InternalRefreshRangeResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRefreshRangeResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRefreshRangeResponse::Swap(InternalRefreshRangeResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRefreshRangeResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRefreshRangeResponse_descriptor_;
+  metadata.reflection = InternalRefreshRangeResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ReadWriteCmdResponse::kPutFieldNumber;
+const int ReadWriteCmdResponse::kConditionalPutFieldNumber;
+const int ReadWriteCmdResponse::kIncrementFieldNumber;
+const int ReadWriteCmdResponse::kDeleteFieldNumber;
+const int ReadWriteCmdResponse::kDeleteRangeFieldNumber;
+const int ReadWriteCmdResponse::kEndTransactionFieldNumber;
+const int ReadWriteCmdResponse::kInitPutFieldNumber;
+const int ReadWriteCmdResponse::kInternalHeartbeatTxnFieldNumber;
+const int ReadWriteCmdResponse::kInternalPushTxnFieldNumber;
+const int ReadWriteCmdResponse::kInternalResolveIntentFieldNumber;
+const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
+const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
+const int ReadWriteCmdResponse::kInternalGcFieldNumber;
+const int ReadWriteCmdResponse::kClearRangeFieldNumber;
+const int ReadWriteCmdResponse::kBatchFieldNumber;
+#endif  // !_MSC_VER
+
+ReadWriteCmdResponse::ReadWriteCmdResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReadWriteCmdResponse)
+}
+
+void ReadWriteCmdResponse::InitAsDefaultInstance() {
+  ReadWriteCmdResponse_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutResponse*>(&::cockroach::proto::PutResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutResponse*>(&::cockroach::proto::ConditionalPutResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementResponse*>(&::cockroach::proto::IncrementResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteResponse*>(&::cockroach::proto::DeleteResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->init_put_ = const_cast< ::cockroach::proto::InitPutResponse*>(&::cockroach::proto::InitPutResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnResponse*>(&::cockroach::proto::InternalHeartbeatTxnResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnResponse*>(&::cockroach::proto::InternalPushTxnResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentResponse*>(&::cockroach::proto::InternalResolveIntentResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_merge_ = const_cast< ::cockroach::proto::InternalMergeResponse*>(&::cockroach::proto::InternalMergeResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogResponse*>(&::cockroach::proto::InternalTruncateLogResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCResponse*>(&::cockroach::proto::InternalGCResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->clear_range_ = const_cast< ::cockroach::proto::ClearRangeResponse*>(&::cockroach::proto::ClearRangeResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->batch_ = const_cast< ::cockroach::proto::BatchResponse*>(&::cockroach::proto::BatchResponse::default_instance());
+}
+
+ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReadWriteCmdResponse)
+}
+
+void ReadWriteCmdResponse::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+ReadWriteCmdResponse::~ReadWriteCmdResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReadWriteCmdResponse)
+  SharedDtor();
+}
+
+void ReadWriteCmdResponse::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void ReadWriteCmdResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ReadWriteCmdResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ReadWriteCmdResponse_descriptor_;
+}
+
+const ReadWriteCmdResponse& ReadWriteCmdResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+ReadWriteCmdResponse* ReadWriteCmdResponse::default_instance_ = NULL;
+
+ReadWriteCmdResponse* ReadWriteCmdResponse::New() const {
+  return new ReadWriteCmdResponse;
+}
+
+void ReadWriteCmdResponse::clear_value() {
+  switch(value_case()) {
+    case kPut: {
+      delete value_.put_;
+      break;
+    }
+    case kConditionalPut: {
+      delete value_.conditional_put_;
+      break;
+    }
+    case kIncrement: {
+      delete value_.increment_;
+      break;
+    }
+    case kDelete: {
+      delete value_.delete__;
+      break;
+    }
+    case kDeleteRange: {
+      delete value_.delete_range_;
+      break;
+    }
+    case kEndTransaction: {
+      delete value_.end_transaction_;
+      break;
+    }
+    case kInitPut: {
+      delete value_.init_put_;
+      break;
+    }
+    case kInternalHeartbeatTxn: {
+      delete value_.internal_heartbeat_txn_;
+      break;
+    }
+    case kInternalPushTxn: {
+      delete value_.internal_push_txn_;
+      break;
+    }
+    case kInternalResolveIntent: {
+      delete value_.internal_resolve_intent_;
+      break;
+    }
+    case kInternalMerge: {
+      delete value_.internal_merge_;
+      break;
+    }
+    case kInternalTruncateLog: {
+      delete value_.internal_truncate_log_;
+      break;
+    }
+    case kInternalGc: {
+      delete value_.internal_gc_;
+      break;
+    }
+    case kClearRange: {
+      delete value_.clear_range_;
+      break;
+    }
+    case kBatch: {
+      delete value_.batch_;
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+
+
+void ReadWriteCmdResponse::Clear() {
+  clear_value();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ReadWriteCmdResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReadWriteCmdResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.PutResponse put = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_conditional_put;
+        break;
+      }
+
+      // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_conditional_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_increment;
+        break;
+      }
+
+      // optional .cockroach.proto.IncrementResponse increment = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteResponse delete = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_init_put;
+        break;
+      }
+
+      // optional .cockroach.proto.InitPutResponse init_put = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_init_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_init_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_internal_heartbeat_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_internal_heartbeat_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_heartbeat_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_internal_push_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_internal_push_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_push_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_internal_resolve_intent;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_internal_resolve_intent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_resolve_intent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_internal_merge;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_internal_merge:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_merge()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_internal_truncate_log;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_internal_truncate_log:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_truncate_log()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_internal_gc;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_internal_gc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_gc()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(130)) goto parse_clear_range;
+        break;
+      }
+
+      // optional .cockroach.proto.ClearRangeResponse clear_range = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_clear_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_clear_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(138)) goto parse_batch;
+        break;
+      }
+
+      // optional .cockroach.proto.BatchResponse batch = 17;
+      case 17: {
+        if (tag == 138) {
+         parse_batch:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_batch()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReadWriteCmdResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReadWriteCmdResponse)
+  return false;
+#undef DO_
+}
+
+void ReadWriteCmdResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReadWriteCmdResponse)
+  // optional .cockroach.proto.PutResponse put = 1;
+  if (has_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->put(), output);
+  }
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
+  if (has_conditional_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->conditional_put(), output);
+  }
+
+  // optional .cockroach.proto.IncrementResponse increment = 3;
+  if (has_increment()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->increment(), output);
+  }
+
+  // optional .cockroach.proto.DeleteResponse delete = 4;
+  if (has_delete_()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->delete_(), output);
+  }
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
+  if (has_delete_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->delete_range(), output);
+  }
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
+  if (has_end_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->end_transaction(), output);
+  }
+
+  // optional .cockroach.proto.InitPutResponse init_put = 7;
+  if (has_init_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->init_put(), output);
+  }
+
+  // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+  if (has_internal_heartbeat_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->internal_heartbeat_txn(), output);
+  }
+
+  // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
+  if (has_internal_push_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->internal_push_txn(), output);
+  }
+
+  // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+  if (has_internal_resolve_intent()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, this->internal_resolve_intent(), output);
+  }
+
+  // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
+  if (has_internal_merge()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->internal_merge(), output);
+  }
+
+  // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
+  if (has_internal_truncate_log()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->internal_truncate_log(), output);
+  }
+
+  // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
+  if (has_internal_gc()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, this->internal_gc(), output);
+  }
+
+  // optional .cockroach.proto.ClearRangeResponse clear_range = 16;
+  if (has_clear_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, this->clear_range(), output);
+  }
+
+  // optional .cockroach.proto.BatchResponse batch = 17;
+  if (has_batch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      17, this->batch(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReadWriteCmdResponse)
+}
+
+::google::protobuf::uint8* ReadWriteCmdResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReadWriteCmdResponse)
+  // optional .cockroach.proto.PutResponse put = 1;
+  if (has_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->put(), target);
+  }
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
+  if (has_conditional_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->conditional_put(), target);
+  }
+
+  // optional .cockroach.proto.IncrementResponse increment = 3;
+  if (has_increment()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->increment(), target);
+  }
+
+  // optional .cockroach.proto.DeleteResponse delete = 4;
+  if (has_delete_()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->delete_(), target);
+  }
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
+  if (has_delete_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->delete_range(), target);
+  }
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
+  if (has_end_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->end_transaction(), target);
+  }
+
+  // optional .cockroach.proto.InitPutResponse init_put = 7;
+  if (has_init_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->init_put(), target);
+  }
+
+  // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+  if (has_internal_heartbeat_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->internal_heartbeat_txn(), target);
+  }
+
+  // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
+  if (has_internal_push_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->internal_push_txn(), target);
+  }
+
+  // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+  if (has_internal_resolve_intent()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, this->internal_resolve_intent(), target);
+  }
+
+  // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
+  if (has_internal_merge()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->internal_merge(), target);
+  }
+
+  // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
+  if (has_internal_truncate_log()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->internal_truncate_log(), target);
+  }
+
+  // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
+  if (has_internal_gc()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, this->internal_gc(), target);
+  }
+
+  // optional .cockroach.proto.ClearRangeResponse clear_range = 16;
+  if (has_clear_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, this->clear_range(), target);
+  }
+
+  // optional .cockroach.proto.BatchResponse batch = 17;
+  if (has_batch()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        17, this->batch(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReadWriteCmdResponse)
+  return target;
+}
+
+int ReadWriteCmdResponse::ByteSize() const {
+  int total_size = 0;
+
+  switch (value_case()) {
+    // optional .cockroach.proto.PutResponse put = 1;
+    case kPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->put());
+      break;
+    }
+    // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
+    case kConditionalPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_put());
+      break;
+    }
+    // optional .cockroach.proto.IncrementResponse increment = 3;
+    case kIncrement: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->increment());
+      break;
+    }
+    // optional .cockroach.proto.DeleteResponse delete = 4;
+    case kDelete: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_());
+      break;
+    }
+    // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
+    case kDeleteRange: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_range());
+      break;
+    }
+    // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
+    case kEndTransaction: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->end_transaction());
+      break;
+    }
+    // optional .cockroach.proto.InitPutResponse init_put = 7;
+    case kInitPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->init_put());
+      break;
+    }
+    // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+    case kInternalHeartbeatTxn: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_heartbeat_txn());
+      break;
+    }
+    // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
+    case kInternalPushTxn: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_push_txn());
+      break;
+    }
+    // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+    case kInternalResolveIntent: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_resolve_intent());
+      break;
+    }
+    // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
+    case kInternalMerge: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_merge());
+      break;
+    }
+    // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
+    case kInternalTruncateLog: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_truncate_log());
+      break;
+    }
+    // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
+    case kInternalGc: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_gc());
+      break;
+    }
+    // optional .cockroach.proto.ClearRangeResponse clear_range = 16;
+    case kClearRange: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->clear_range());
+      break;
+    }
+    // optional .cockroach.proto.BatchResponse batch = 17;
+    case kBatch: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->batch());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ReadWriteCmdResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ReadWriteCmdResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReadWriteCmdResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  switch (from.value_case()) {
+    case kPut: {
+      mutable_put()->::cockroach::proto::PutResponse::MergeFrom(from.put());
+      break;
+    }
+    case kConditionalPut: {
+      mutable_conditional_put()->::cockroach::proto::ConditionalPutResponse::MergeFrom(from.conditional_put());
+      break;
+    }
+    case kIncrement: {
+      mutable_increment()->::cockroach::proto::IncrementResponse::MergeFrom(from.increment());
+      break;
+    }
+    case kDelete: {
+      mutable_delete_()->::cockroach::proto::DeleteResponse::MergeFrom(from.delete_());
+      break;
+    }
+    case kDeleteRange: {
+      mutable_delete_range()->::cockroach::proto::DeleteRangeResponse::MergeFrom(from.delete_range());
+      break;
+    }
+    case kEndTransaction: {
+      mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
+      break;
+    }
+    case kInitPut: {
+      mutable_init_put()->::cockroach::proto::InitPutResponse::MergeFrom(from.init_put());
+      break;
+    }
+    case kInternalHeartbeatTxn: {
+      mutable_internal_heartbeat_txn()->::cockroach::proto::InternalHeartbeatTxnResponse::MergeFrom(from.internal_heartbeat_txn());
+      break;
+    }
+    case kInternalPushTxn: {
+      mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnResponse::MergeFrom(from.internal_push_txn());
+      break;
+    }
+    case kInternalResolveIntent: {
+      mutable_internal_resolve_intent()->::cockroach::proto::InternalResolveIntentResponse::MergeFrom(from.internal_resolve_intent());
+      break;
+    }
+    case kInternalMerge: {
+      mutable_internal_merge()->::cockroach::proto::InternalMergeResponse::MergeFrom(from.internal_merge());
+      break;
+    }
+    case kInternalTruncateLog: {
+      mutable_internal_truncate_log()->::cockroach::proto::InternalTruncateLogResponse::MergeFrom(from.internal_truncate_log());
+      break;
+    }
+    case kInternalGc: {
+      mutable_internal_gc()->::cockroach::proto::InternalGCResponse::MergeFrom(from.internal_gc());
+      break;
+    }
+    case kClearRange: {
+      mutable_clear_range()->::cockroach::proto::ClearRangeResponse::MergeFrom(from.clear_range());
+      break;
+    }
+    case kBatch: {
+      mutable_batch()->::cockroach::proto::BatchResponse::MergeFrom(from.batch());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ReadWriteCmdResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ReadWriteCmdResponse::CopyFrom(const ReadWriteCmdResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ReadWriteCmdResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ReadWriteCmdResponse::Swap(ReadWriteCmdResponse* other) {
+  if (other != this) {
+    std::swap(value_, other->value_);
+    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ReadWriteCmdResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ReadWriteCmdResponse_descriptor_;
+  metadata.reflection = ReadWriteCmdResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRaftCommandUnion::kContainsFieldNumber;
+const int InternalRaftCommandUnion::kGetFieldNumber;
+const int InternalRaftCommandUnion::kPutFieldNumber;
+const int InternalRaftCommandUnion::kConditionalPutFieldNumber;
+const int InternalRaftCommandUnion::kIncrementFieldNumber;
+const int InternalRaftCommandUnion::kDeleteFieldNumber;
+const int InternalRaftCommandUnion::kDeleteRangeFieldNumber;
+const int InternalRaftCommandUnion::kScanFieldNumber;
+const int InternalRaftCommandUnion::kEndTransactionFieldNumber;
+const int InternalRaftCommandUnion::kInitPutFieldNumber;
+const int InternalRaftCommandUnion::kAggregateFieldNumber;
+const int InternalRaftCommandUnion::kBatchFieldNumber;
+const int InternalRaftCommandUnion::kInternalRangeLookupFieldNumber;
+const int InternalRaftCommandUnion::kInternalHeartbeatTxnFieldNumber;
+const int InternalRaftCommandUnion::kInternalPushTxnFieldNumber;
+const int InternalRaftCommandUnion::kInternalResolveIntentFieldNumber;
+const int InternalRaftCommandUnion::kInternalMergeResponseFieldNumber;
+const int InternalRaftCommandUnion::kInternalTruncateLogFieldNumber;
+const int InternalRaftCommandUnion::kInternalGcFieldNumber;
+const int InternalRaftCommandUnion::kInternalLeaseFieldNumber;
+const int InternalRaftCommandUnion::kInternalCheckConsistencyFieldNumber;
+const int InternalRaftCommandUnion::kInternalRecomputeStatsFieldNumber;
+const int InternalRaftCommandUnion::kClearRangeFieldNumber;
+const int InternalRaftCommandUnion::kInternalRefreshRangeFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRaftCommandUnion::InternalRaftCommandUnion()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRaftCommandUnion)
+}
+
+void InternalRaftCommandUnion::InitAsDefaultInstance() {
+  InternalRaftCommandUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsRequest*>(&::cockroach::proto::ContainsRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->init_put_ = const_cast< ::cockroach::proto::InitPutRequest*>(&::cockroach::proto::InitPutRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->aggregate_ = const_cast< ::cockroach::proto::AggregateRequest*>(&::cockroach::proto::AggregateRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->batch_ = const_cast< ::cockroach::proto::BatchRequest*>(&::cockroach::proto::BatchRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_range_lookup_ = const_cast< ::cockroach::proto::InternalRangeLookupRequest*>(&::cockroach::proto::InternalRangeLookupRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnRequest*>(&::cockroach::proto::InternalHeartbeatTxnRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnRequest*>(&::cockroach::proto::InternalPushTxnRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentRequest*>(&::cockroach::proto::InternalResolveIntentRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_merge_response_ = const_cast< ::cockroach::proto::InternalMergeRequest*>(&::cockroach::proto::InternalMergeRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogRequest*>(&::cockroach::proto::InternalTruncateLogRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCRequest*>(&::cockroach::proto::InternalGCRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_lease_ = const_cast< ::cockroach::proto::InternalLeaderLeaseRequest*>(&::cockroach::proto::InternalLeaderLeaseRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_check_consistency_ = const_cast< ::cockroach::proto::InternalCheckConsistencyRequest*>(&::cockroach::proto::InternalCheckConsistencyRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_recompute_stats_ = const_cast< ::cockroach::proto::InternalRecomputeStatsRequest*>(&::cockroach::proto::InternalRecomputeStatsRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->clear_range_ = const_cast< ::cockroach::proto::ClearRangeRequest*>(&::cockroach::proto::ClearRangeRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_refresh_range_ = const_cast< ::cockroach::proto::InternalRefreshRangeRequest*>(&::cockroach::proto::InternalRefreshRangeRequest::default_instance());
+}
+
+InternalRaftCommandUnion::InternalRaftCommandUnion(const InternalRaftCommandUnion& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRaftCommandUnion)
+}
+
+void InternalRaftCommandUnion::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+InternalRaftCommandUnion::~InternalRaftCommandUnion() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRaftCommandUnion)
+  SharedDtor();
+}
+
+void InternalRaftCommandUnion::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void InternalRaftCommandUnion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRaftCommandUnion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRaftCommandUnion_descriptor_;
+}
+
+const InternalRaftCommandUnion& InternalRaftCommandUnion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalRaftCommandUnion* InternalRaftCommandUnion::default_instance_ = NULL;
+
+InternalRaftCommandUnion* InternalRaftCommandUnion::New() const {
+  return new InternalRaftCommandUnion;
+}
+
+void InternalRaftCommandUnion::clear_value() {
+  switch(value_case()) {
+    case kContains: {
+      delete value_.contains_;
+      break;
+    }
+    case kGet: {
+      delete value_.get_;
+      break;
+    }
+    case kPut: {
+      delete value_.put_;
+      break;
+    }
+    case kConditionalPut: {
+      delete value_.conditional_put_;
+      break;
+    }
+    case kIncrement: {
+      delete value_.increment_;
+      break;
+    }
+    case kDelete: {
+      delete value_.delete__;
+      break;
+    }
+    case kDeleteRange: {
+      delete value_.delete_range_;
+      break;
+    }
+    case kScan: {
+      delete value_.scan_;
+      break;
+    }
+    case kEndTransaction: {
+      delete value_.end_transaction_;
+      break;
+    }
+    case kInitPut: {
+      delete value_.init_put_;
+      break;
+    }
+    case kAggregate: {
+      delete value_.aggregate_;
+      break;
+    }
+    case kBatch: {
+      delete value_.batch_;
+      break;
+    }
+    case kInternalRangeLookup: {
+      delete value_.internal_range_lookup_;
+      break;
+    }
+    case kInternalHeartbeatTxn: {
+      delete value_.internal_heartbeat_txn_;
+      break;
+    }
+    case kInternalPushTxn: {
+      delete value_.internal_push_txn_;
+      break;
+    }
+    case kInternalResolveIntent: {
+      delete value_.internal_resolve_intent_;
+      break;
+    }
+    case kInternalMergeResponse: {
+      delete value_.internal_merge_response_;
+      break;
+    }
+    case kInternalTruncateLog: {
+      delete value_.internal_truncate_log_;
+      break;
+    }
+    case kInternalGc: {
+      delete value_.internal_gc_;
+      break;
+    }
+    case kInternalLease: {
+      delete value_.internal_lease_;
+      break;
+    }
+    case kInternalCheckConsistency: {
+      delete value_.internal_check_consistency_;
+      break;
+    }
+    case kInternalRecomputeStats: {
+      delete value_.internal_recompute_stats_;
+      break;
+    }
+    case kClearRange: {
+      delete value_.clear_range_;
+      break;
+    }
+    case kInternalRefreshRange: {
+      delete value_.internal_refresh_range_;
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+
+
+void InternalRaftCommandUnion::Clear() {
+  clear_value();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRaftCommandUnion::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRaftCommandUnion)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ContainsRequest contains = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_contains()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_get;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRequest get = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_get:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_put;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRequest put = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_conditional_put;
+        break;
+      }
+
+      // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_conditional_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_increment;
+        break;
+      }
+
+      // optional .cockroach.proto.IncrementRequest increment = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRequest delete = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRequest scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_init_put;
+        break;
+      }
+
+      // optional .cockroach.proto.InitPutRequest init_put = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_init_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_init_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_aggregate;
+        break;
+      }
+
+      // optional .cockroach.proto.AggregateRequest aggregate = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_aggregate:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_aggregate()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(242)) goto parse_batch;
+        break;
+      }
+
+      // optional .cockroach.proto.BatchRequest batch = 30;
+      case 30: {
+        if (tag == 242) {
+         parse_batch:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_batch()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(250)) goto parse_internal_range_lookup;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
+      case 31: {
+        if (tag == 250) {
+         parse_internal_range_lookup:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_range_lookup()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(258)) goto parse_internal_heartbeat_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
+      case 32: {
+        if (tag == 258) {
+         parse_internal_heartbeat_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_heartbeat_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(266)) goto parse_internal_push_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
+      case 33: {
+        if (tag == 266) {
+         parse_internal_push_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_push_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(274)) goto parse_internal_resolve_intent;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+      case 34: {
+        if (tag == 274) {
+         parse_internal_resolve_intent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_resolve_intent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(282)) goto parse_internal_merge_response;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
+      case 35: {
+        if (tag == 282) {
+         parse_internal_merge_response:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_merge_response()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(290)) goto parse_internal_truncate_log;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
+      case 36: {
+        if (tag == 290) {
+         parse_internal_truncate_log:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_truncate_log()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(298)) goto parse_internal_gc;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
+      case 37: {
+        if (tag == 298) {
+         parse_internal_gc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_gc()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(306)) goto parse_internal_lease;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
+      case 38: {
+        if (tag == 306) {
+         parse_internal_lease:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_lease()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(314)) goto parse_internal_check_consistency;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalCheckConsistencyRequest internal_check_consistency = 39;
+      case 39: {
+        if (tag == 314) {
+         parse_internal_check_consistency:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_check_consistency()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(322)) goto parse_internal_recompute_stats;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalRecomputeStatsRequest internal_recompute_stats = 40;
+      case 40: {
+        if (tag == 322) {
+         parse_internal_recompute_stats:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_recompute_stats()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(330)) goto parse_clear_range;
+        break;
+      }
+
+      // optional .cockroach.proto.ClearRangeRequest clear_range = 41;
+      case 41: {
+        if (tag == 330) {
+         parse_clear_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_clear_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(338)) goto parse_internal_refresh_range;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalRefreshRangeRequest internal_refresh_range = 42;
+      case 42: {
+        if (tag == 338) {
+         parse_internal_refresh_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_refresh_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRaftCommandUnion)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRaftCommandUnion)
+  return false;
+#undef DO_
+}
+
+void InternalRaftCommandUnion::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRaftCommandUnion)
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  if (has_contains()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->contains(), output);
+  }
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  if (has_get()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->get(), output);
+  }
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  if (has_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->put(), output);
+  }
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  if (has_conditional_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->conditional_put(), output);
+  }
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  if (has_increment()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->increment(), output);
+  }
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  if (has_delete_()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->delete_(), output);
+  }
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  if (has_delete_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->delete_range(), output);
+  }
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  if (has_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->scan(), output);
+  }
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  if (has_end_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->end_transaction(), output);
+  }
+
+  // optional .cockroach.proto.InitPutRequest init_put = 10;
+  if (has_init_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->init_put(), output);
+  }
+
+  // optional .cockroach.proto.AggregateRequest aggregate = 11;
+  if (has_aggregate()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->aggregate(), output);
+  }
+
+  // optional .cockroach.proto.BatchRequest batch = 30;
+  if (has_batch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      30, this->batch(), output);
+  }
+
+  // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
+  if (has_internal_range_lookup()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      31, this->internal_range_lookup(), output);
+  }
+
+  // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
+  if (has_internal_heartbeat_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      32, this->internal_heartbeat_txn(), output);
+  }
+
+  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
+  if (has_internal_push_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      33, this->internal_push_txn(), output);
+  }
+
+  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+  if (has_internal_resolve_intent()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      34, this->internal_resolve_intent(), output);
+  }
+
+  // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
+  if (has_internal_merge_response()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      35, this->internal_merge_response(), output);
+  }
+
+  // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
+  if (has_internal_truncate_log()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      36, this->internal_truncate_log(), output);
+  }
+
+  // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
+  if (has_internal_gc()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      37, this->internal_gc(), output);
+  }
+
+  // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
+  if (has_internal_lease()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      38, this->internal_lease(), output);
+  }
+
+  // optional .cockroach.proto.InternalCheckConsistencyRequest internal_check_consistency = 39;
+  if (has_internal_check_consistency()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      39, this->internal_check_consistency(), output);
+  }
+
+  // optional .cockroach.proto.InternalRecomputeStatsRequest internal_recompute_stats = 40;
+  if (has_internal_recompute_stats()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      40, this->internal_recompute_stats(), output);
+  }
+
+  // optional .cockroach.proto.ClearRangeRequest clear_range = 41;
+  if (has_clear_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      41, this->clear_range(), output);
+  }
+
+  // optional .cockroach.proto.InternalRefreshRangeRequest internal_refresh_range = 42;
+  if (has_internal_refresh_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      42, this->internal_refresh_range(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRaftCommandUnion)
+}
+
+::google::protobuf::uint8* InternalRaftCommandUnion::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRaftCommandUnion)
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  if (has_contains()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->contains(), target);
+  }
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  if (has_get()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->get(), target);
+  }
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  if (has_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->put(), target);
+  }
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  if (has_conditional_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->conditional_put(), target);
+  }
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  if (has_increment()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->increment(), target);
+  }
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  if (has_delete_()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->delete_(), target);
+  }
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  if (has_delete_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->delete_range(), target);
+  }
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  if (has_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->scan(), target);
+  }
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  if (has_end_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->end_transaction(), target);
+  }
+
+  // optional .cockroach.proto.InitPutRequest init_put = 10;
+  if (has_init_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->init_put(), target);
+  }
+
+  // optional .cockroach.proto.AggregateRequest aggregate = 11;
+  if (has_aggregate()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->aggregate(), target);
+  }
+
+  // optional .cockroach.proto.BatchRequest batch = 30;
+  if (has_batch()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        30, this->batch(), target);
+  }
+
+  // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
+  if (has_internal_range_lookup()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        31, this->internal_range_lookup(), target);
+  }
+
+  // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
+  if (has_internal_heartbeat_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        32, this->internal_heartbeat_txn(), target);
+  }
+
+  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
+  if (has_internal_push_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        33, this->internal_push_txn(), target);
+  }
+
+  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+  if (has_internal_resolve_intent()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        34, this->internal_resolve_intent(), target);
+  }
+
+  // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
+  if (has_internal_merge_response()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        35, this->internal_merge_response(), target);
+  }
+
+  // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
+  if (has_internal_truncate_log()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        36, this->internal_truncate_log(), target);
+  }
+
+  // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
+  if (has_internal_gc()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        37, this->internal_gc(), target);
+  }
+
+  // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
+  if (has_internal_lease()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        38, this->internal_lease(), target);
+  }
+
+  // optional .cockroach.proto.InternalCheckConsistencyRequest internal_check_consistency = 39;
+  if (has_internal_check_consistency()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        39, this->internal_check_consistency(), target);
+  }
+
+  // optional .cockroach.proto.InternalRecomputeStatsRequest internal_recompute_stats = 40;
+  if (has_internal_recompute_stats()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        40, this->internal_recompute_stats(), target);
+  }
+
+  // optional .cockroach.proto.ClearRangeRequest clear_range = 41;
+  if (has_clear_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        41, this->clear_range(), target);
+  }
+
+  // optional .cockroach.proto.InternalRefreshRangeRequest internal_refresh_range = 42;
+  if (has_internal_refresh_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        42, this->internal_refresh_range(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRaftCommandUnion)
+  return target;
+}
+
+int InternalRaftCommandUnion::ByteSize() const {
+  int total_size = 0;
+
+  switch (value_case()) {
+    // optional .cockroach.proto.ContainsRequest contains = 1;
+    case kContains: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->contains());
+      break;
+    }
+    // optional .cockroach.proto.GetRequest get = 2;
+    case kGet: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->get());
+      break;
+    }
+    // optional .cockroach.proto.PutRequest put = 3;
+    case kPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->put());
+      break;
+    }
+    // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+    case kConditionalPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_put());
+      break;
+    }
+    // optional .cockroach.proto.IncrementRequest increment = 5;
+    case kIncrement: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->increment());
+      break;
+    }
+    // optional .cockroach.proto.DeleteRequest delete = 6;
+    case kDelete: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_());
+      break;
+    }
+    // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+    case kDeleteRange: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_range());
+      break;
+    }
+    // optional .cockroach.proto.ScanRequest scan = 8;
+    case kScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->scan());
+      break;
+    }
+    // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+    case kEndTransaction: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->end_transaction());
+      break;
+    }
+    // optional .cockroach.proto.InitPutRequest init_put = 10;
+    case kInitPut: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->init_put());
+      break;
+    }
+    // optional .cockroach.proto.AggregateRequest aggregate = 11;
+    case kAggregate: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->aggregate());
+      break;
+    }
+    // optional .cockroach.proto.BatchRequest batch = 30;
+    case kBatch: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->batch());
+      break;
+    }
+    // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
+    case kInternalRangeLookup: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_range_lookup());
+      break;
+    }
+    // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
+    case kInternalHeartbeatTxn: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_heartbeat_txn());
+      break;
+    }
+    // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
+    case kInternalPushTxn: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_push_txn());
+      break;
+    }
+    // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+    case kInternalResolveIntent: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_resolve_intent());
+      break;
+    }
+    // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
+    case kInternalMergeResponse: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_merge_response());
+      break;
+    }
+    // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
+    case kInternalTruncateLog: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_truncate_log());
+      break;
+    }
+    // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
+    case kInternalGc: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_gc());
+      break;
+    }
+    // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
+    case kInternalLease: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_lease());
+      break;
+    }
+    // optional .cockroach.proto.InternalCheckConsistencyRequest internal_check_consistency = 39;
+    case kInternalCheckConsistency: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_check_consistency());
+      break;
+    }
+    // optional .cockroach.proto.InternalRecomputeStatsRequest internal_recompute_stats = 40;
+    case kInternalRecomputeStats: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_recompute_stats());
+      break;
+    }
+    // optional .cockroach.proto.ClearRangeRequest clear_range = 41;
+    case kClearRange: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->clear_range());
+      break;
+    }
+    // optional .cockroach.proto.InternalRefreshRangeRequest internal_refresh_range = 42;
+    case kInternalRefreshRange: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_refresh_range());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRaftCommandUnion::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRaftCommandUnion* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRaftCommandUnion*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  switch (from.value_case()) {
+    case kContains: {
+      mutable_contains()->::cockroach::proto::ContainsRequest::MergeFrom(from.contains());
+      break;
+    }
+    case kGet: {
+      mutable_get()->::cockroach::proto::GetRequest::MergeFrom(from.get());
+      break;
+    }
+    case kPut: {
+      mutable_put()->::cockroach::proto::PutRequest::MergeFrom(from.put());
+      break;
+    }
+    case kConditionalPut: {
+      mutable_conditional_put()->::cockroach::proto::ConditionalPutRequest::MergeFrom(from.conditional_put());
+      break;
+    }
+    case kIncrement: {
+      mutable_increment()->::cockroach::proto::IncrementRequest::MergeFrom(from.increment());
+      break;
+    }
+    case kDelete: {
+      mutable_delete_()->::cockroach::proto::DeleteRequest::MergeFrom(from.delete_());
+      break;
+    }
+    case kDeleteRange: {
+      mutable_delete_range()->::cockroach::proto::DeleteRangeRequest::MergeFrom(from.delete_range());
+      break;
+    }
+    case kScan: {
+      mutable_scan()->::cockroach::proto::ScanRequest::MergeFrom(from.scan());
+      break;
+    }
+    case kEndTransaction: {
+      mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
+      break;
+    }
+    case kInitPut: {
+      mutable_init_put()->::cockroach::proto::InitPutRequest::MergeFrom(from.init_put());
+      break;
+    }
+    case kAggregate: {
+      mutable_aggregate()->::cockroach::proto::AggregateRequest::MergeFrom(from.aggregate());
+      break;
+    }
+    case kBatch: {
+      mutable_batch()->::cockroach::proto::BatchRequest::MergeFrom(from.batch());
+      break;
+    }
+    case kInternalRangeLookup: {
+      mutable_internal_range_lookup()->::cockroach::proto::InternalRangeLookupRequest::MergeFrom(from.internal_range_lookup());
+      break;
+    }
+    case kInternalHeartbeatTxn: {
+      mutable_internal_heartbeat_txn()->::cockroach::proto::InternalHeartbeatTxnRequest::MergeFrom(from.internal_heartbeat_txn());
+      break;
+    }
+    case kInternalPushTxn: {
+      mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnRequest::MergeFrom(from.internal_push_txn());
+      break;
+    }
+    case kInternalResolveIntent: {
+      mutable_internal_resolve_intent()->::cockroach::proto::InternalResolveIntentRequest::MergeFrom(from.internal_resolve_intent());
+      break;
+    }
+    case kInternalMergeResponse: {
+      mutable_internal_merge_response()->::cockroach::proto::InternalMergeRequest::MergeFrom(from.internal_merge_response());
+      break;
+    }
+    case kInternalTruncateLog: {
+      mutable_internal_truncate_log()->::cockroach::proto::InternalTruncateLogRequest::MergeFrom(from.internal_truncate_log());
+      break;
+    }
+    case kInternalGc: {
+      mutable_internal_gc()->::cockroach::proto::InternalGCRequest::MergeFrom(from.internal_gc());
+      break;
+    }
+    case kInternalLease: {
+      mutable_internal_lease()->::cockroach::proto::InternalLeaderLeaseRequest::MergeFrom(from.internal_lease());
+      break;
+    }
+    case kInternalCheckConsistency: {
+      mutable_internal_check_consistency()->::cockroach::proto::InternalCheckConsistencyRequest::MergeFrom(from.internal_check_consistency());
+      break;
+    }
+    case kInternalRecomputeStats: {
+      mutable_internal_recompute_stats()->::cockroach::proto::InternalRecomputeStatsRequest::MergeFrom(from.internal_recompute_stats());
+      break;
+    }
+    case kClearRange: {
+      mutable_clear_range()->::cockroach::proto::ClearRangeRequest::MergeFrom(from.clear_range());
+      break;
+    }
+    case kInternalRefreshRange: {
+      mutable_internal_refresh_range()->::cockroach::proto::InternalRefreshRangeRequest::MergeFrom(from.internal_refresh_range());
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRaftCommandUnion::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRaftCommandUnion::CopyFrom(const InternalRaftCommandUnion& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRaftCommandUnion::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRaftCommandUnion::Swap(InternalRaftCommandUnion* other) {
+  if (other != this) {
+    std::swap(value_, other->value_);
+    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRaftCommandUnion::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRaftCommandUnion_descriptor_;
+  metadata.reflection = InternalRaftCommandUnion_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRaftCommand::kRaftIdFieldNumber;
+const int InternalRaftCommand::kCmdFieldNumber;
+const int InternalRaftCommand::kClosedTimestampFieldNumber;
+const int InternalRaftCommand::kMaxLeaseIndexFieldNumber;
+const int InternalRaftCommand::kProposerLeaseFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRaftCommand::InternalRaftCommand()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRaftCommand)
+}
+
+void InternalRaftCommand::InitAsDefaultInstance() {
+  cmd_ = const_cast< ::cockroach::proto::InternalRaftCommandUnion*>(&::cockroach::proto::InternalRaftCommandUnion::default_instance());
+  closed_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  proposer_lease_ = const_cast< ::cockroach::proto::Lease*>(&::cockroach::proto::Lease::default_instance());
+}
+
+InternalRaftCommand::InternalRaftCommand(const InternalRaftCommand& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRaftCommand)
+}
+
+void InternalRaftCommand::SharedCtor() {
+  _cached_size_ = 0;
+  raft_id_ = GOOGLE_LONGLONG(0);
+  cmd_ = NULL;
+  closed_timestamp_ = NULL;
+  max_lease_index_ = GOOGLE_ULONGLONG(0);
+  proposer_lease_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalRaftCommand::~InternalRaftCommand() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRaftCommand)
+  SharedDtor();
+}
 
-      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(242)) goto parse_batch;
-        break;
-      }
+void InternalRaftCommand::SharedDtor() {
+  if (this != default_instance_) {
+    delete cmd_;
+    delete closed_timestamp_;
+    delete proposer_lease_;
+  }
+}
 
-      // optional .cockroach.proto.BatchRequest batch = 30;
-      case 30: {
-        if (tag == 242) {
-         parse_batch:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_batch()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(250)) goto parse_internal_range_lookup;
-        break;
-      }
+void InternalRaftCommand::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRaftCommand::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRaftCommand_descriptor_;
+}
 
-      // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
-      case 31: {
-        if (tag == 250) {
-         parse_internal_range_lookup:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_range_lookup()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(258)) goto parse_internal_heartbeat_txn;
-        break;
-      }
+const InternalRaftCommand& InternalRaftCommand::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
 
-      // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
-      case 32: {
-        if (tag == 258) {
-         parse_internal_heartbeat_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_heartbeat_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(266)) goto parse_internal_push_txn;
-        break;
-      }
+InternalRaftCommand* InternalRaftCommand::default_instance_ = NULL;
 
-      // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
-      case 33: {
-        if (tag == 266) {
-         parse_internal_push_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_push_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(274)) goto parse_internal_resolve_intent;
-        break;
-      }
+InternalRaftCommand* InternalRaftCommand::New() const {
+  return new InternalRaftCommand;
+}
 
-      // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-      case 34: {
-        if (tag == 274) {
-         parse_internal_resolve_intent:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_resolve_intent()));
+void InternalRaftCommand::Clear() {
+  if (_has_bits_[0 / 32] & 31) {
+    raft_id_ = GOOGLE_LONGLONG(0);
+    if (has_cmd()) {
+      if (cmd_ != NULL) cmd_->::cockroach::proto::InternalRaftCommandUnion::Clear();
+    }
+    if (has_closed_timestamp()) {
+      if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    max_lease_index_ = GOOGLE_ULONGLONG(0);
+    if (has_proposer_lease()) {
+      if (proposer_lease_ != NULL) proposer_lease_->::cockroach::proto::Lease::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRaftCommand::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRaftCommand)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 raft_id = 2;
+      case 2: {
+        if (tag == 16) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &raft_id_)));
+          set_has_raft_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(282)) goto parse_internal_merge_response;
+        if (input->ExpectTag(26)) goto parse_cmd;
         break;
       }
 
-      // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
-      case 35: {
-        if (tag == 282) {
-         parse_internal_merge_response:
+      // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_cmd:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_merge_response()));
+               input, mutable_cmd()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(290)) goto parse_internal_truncate_log;
+        if (input->ExpectTag(34)) goto parse_closed_timestamp;
         break;
       }
 
-      // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
-      case 36: {
-        if (tag == 290) {
-         parse_internal_truncate_log:
+      // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_closed_timestamp:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_truncate_log()));
+               input, mutable_closed_timestamp()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(298)) goto parse_internal_gc;
+        if (input->ExpectTag(40)) goto parse_max_lease_index;
         break;
       }
 
-      // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
-      case 37: {
-        if (tag == 298) {
-         parse_internal_gc:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_gc()));
+      // optional uint64 max_lease_index = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_max_lease_index:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &max_lease_index_)));
+          set_has_max_lease_index();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(306)) goto parse_internal_lease;
+        if (input->ExpectTag(50)) goto parse_proposer_lease;
         break;
       }
 
-      // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
-      case 38: {
-        if (tag == 306) {
-         parse_internal_lease:
+      // optional .cockroach.proto.Lease proposer_lease = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_proposer_lease:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_lease()));
+               input, mutable_proposer_lease()));
         } else {
           goto handle_unusual;
         }
@@ -6456,402 +9449,417 @@ bool InternalRaftCommandUnion::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRaftCommand)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRaftCommand)
   return false;
 #undef DO_
 }
 
-void InternalRaftCommandUnion::SerializeWithCachedSizes(
+void InternalRaftCommand::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRaftCommandUnion)
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  if (has_contains()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->contains(), output);
-  }
-
-  // optional .cockroach.proto.GetRequest get = 2;
-  if (has_get()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->get(), output);
-  }
-
-  // optional .cockroach.proto.PutRequest put = 3;
-  if (has_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->put(), output);
-  }
-
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  if (has_conditional_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, this->conditional_put(), output);
-  }
-
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  if (has_increment()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, this->increment(), output);
-  }
-
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  if (has_delete_()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      6, this->delete_(), output);
-  }
-
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  if (has_delete_range()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      7, this->delete_range(), output);
-  }
-
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  if (has_scan()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      8, this->scan(), output);
-  }
-
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  if (has_end_transaction()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      9, this->end_transaction(), output);
-  }
-
-  // optional .cockroach.proto.BatchRequest batch = 30;
-  if (has_batch()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      30, this->batch(), output);
-  }
-
-  // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
-  if (has_internal_range_lookup()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      31, this->internal_range_lookup(), output);
-  }
-
-  // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
-  if (has_internal_heartbeat_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      32, this->internal_heartbeat_txn(), output);
-  }
-
-  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
-  if (has_internal_push_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      33, this->internal_push_txn(), output);
-  }
-
-  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-  if (has_internal_resolve_intent()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      34, this->internal_resolve_intent(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRaftCommand)
+  // optional int64 raft_id = 2;
+  if (has_raft_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->raft_id(), output);
   }
 
-  // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
-  if (has_internal_merge_response()) {
+  // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
+  if (has_cmd()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      35, this->internal_merge_response(), output);
+      3, this->cmd(), output);
   }
 
-  // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
-  if (has_internal_truncate_log()) {
+  // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+  if (has_closed_timestamp()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      36, this->internal_truncate_log(), output);
+      4, this->closed_timestamp(), output);
   }
 
-  // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
-  if (has_internal_gc()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      37, this->internal_gc(), output);
+  // optional uint64 max_lease_index = 5;
+  if (has_max_lease_index()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(5, this->max_lease_index(), output);
   }
 
-  // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
-  if (has_internal_lease()) {
+  // optional .cockroach.proto.Lease proposer_lease = 6;
+  if (has_proposer_lease()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      38, this->internal_lease(), output);
+      6, this->proposer_lease(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRaftCommand)
 }
 
-::google::protobuf::uint8* InternalRaftCommandUnion::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* InternalRaftCommand::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRaftCommandUnion)
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  if (has_contains()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->contains(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRaftCommand)
+  // optional int64 raft_id = 2;
+  if (has_raft_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->raft_id(), target);
   }
 
-  // optional .cockroach.proto.GetRequest get = 2;
-  if (has_get()) {
+  // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
+  if (has_cmd()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->get(), target);
+        3, this->cmd(), target);
   }
 
-  // optional .cockroach.proto.PutRequest put = 3;
-  if (has_put()) {
+  // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+  if (has_closed_timestamp()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        3, this->put(), target);
+        4, this->closed_timestamp(), target);
   }
 
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  if (has_conditional_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        4, this->conditional_put(), target);
+  // optional uint64 max_lease_index = 5;
+  if (has_max_lease_index()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(5, this->max_lease_index(), target);
   }
 
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  if (has_increment()) {
+  // optional .cockroach.proto.Lease proposer_lease = 6;
+  if (has_proposer_lease()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        5, this->increment(), target);
+        6, this->proposer_lease(), target);
   }
 
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  if (has_delete_()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        6, this->delete_(), target);
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
   }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRaftCommand)
+  return target;
+}
 
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  if (has_delete_range()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        7, this->delete_range(), target);
+int InternalRaftCommand::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 raft_id = 2;
+    if (has_raft_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->raft_id());
+    }
+
+    // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
+    if (has_cmd()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->cmd());
+    }
+
+    // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+    if (has_closed_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->closed_timestamp());
+    }
+
+    // optional uint64 max_lease_index = 5;
+    if (has_max_lease_index()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->max_lease_index());
+    }
+
+    // optional .cockroach.proto.Lease proposer_lease = 6;
+    if (has_proposer_lease()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->proposer_lease());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
   }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
 
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  if (has_scan()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        8, this->scan(), target);
+void InternalRaftCommand::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRaftCommand* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRaftCommand*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
   }
+}
 
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  if (has_end_transaction()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        9, this->end_transaction(), target);
+void InternalRaftCommand::MergeFrom(const InternalRaftCommand& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_raft_id()) {
+      set_raft_id(from.raft_id());
+    }
+    if (from.has_cmd()) {
+      mutable_cmd()->::cockroach::proto::InternalRaftCommandUnion::MergeFrom(from.cmd());
+    }
+    if (from.has_closed_timestamp()) {
+      mutable_closed_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.closed_timestamp());
+    }
+    if (from.has_max_lease_index()) {
+      set_max_lease_index(from.max_lease_index());
+    }
+    if (from.has_proposer_lease()) {
+      mutable_proposer_lease()->::cockroach::proto::Lease::MergeFrom(from.proposer_lease());
+    }
   }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
 
-  // optional .cockroach.proto.BatchRequest batch = 30;
-  if (has_batch()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        30, this->batch(), target);
+void InternalRaftCommand::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRaftCommand::CopyFrom(const InternalRaftCommand& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRaftCommand::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRaftCommand::Swap(InternalRaftCommand* other) {
+  if (other != this) {
+    std::swap(raft_id_, other->raft_id_);
+    std::swap(cmd_, other->cmd_);
+    std::swap(closed_timestamp_, other->closed_timestamp_);
+    std::swap(max_lease_index_, other->max_lease_index_);
+    std::swap(proposer_lease_, other->proposer_lease_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
   }
+}
 
-  // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
-  if (has_internal_range_lookup()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        31, this->internal_range_lookup(), target);
+::google::protobuf::Metadata InternalRaftCommand::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRaftCommand_descriptor_;
+  metadata.reflection = InternalRaftCommand_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RaftMessageRequest::kGroupIdFieldNumber;
+const int RaftMessageRequest::kMsgFieldNumber;
+#endif  // !_MSC_VER
+
+RaftMessageRequest::RaftMessageRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RaftMessageRequest)
+}
+
+void RaftMessageRequest::InitAsDefaultInstance() {
+}
+
+RaftMessageRequest::RaftMessageRequest(const RaftMessageRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RaftMessageRequest)
+}
+
+void RaftMessageRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  group_id_ = GOOGLE_ULONGLONG(0);
+  msg_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RaftMessageRequest::~RaftMessageRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RaftMessageRequest)
+  SharedDtor();
+}
+
+void RaftMessageRequest::SharedDtor() {
+  if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete msg_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void RaftMessageRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RaftMessageRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RaftMessageRequest_descriptor_;
+}
+
+const RaftMessageRequest& RaftMessageRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+RaftMessageRequest* RaftMessageRequest::default_instance_ = NULL;
+
+RaftMessageRequest* RaftMessageRequest::New() const {
+  return new RaftMessageRequest;
+}
+
+void RaftMessageRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    group_id_ = GOOGLE_ULONGLONG(0);
+    if (has_msg()) {
+      if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        msg_->clear();
+      }
+    }
   }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool RaftMessageRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RaftMessageRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional uint64 group_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &group_id_)));
+          set_has_group_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_msg;
+        break;
+      }
 
-  // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
-  if (has_internal_heartbeat_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        32, this->internal_heartbeat_txn(), target);
-  }
+      // optional bytes msg = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_msg:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_msg()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
 
-  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
-  if (has_internal_push_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        33, this->internal_push_txn(), target);
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
   }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RaftMessageRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RaftMessageRequest)
+  return false;
+#undef DO_
+}
 
-  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-  if (has_internal_resolve_intent()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        34, this->internal_resolve_intent(), target);
+void RaftMessageRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RaftMessageRequest)
+  // optional uint64 group_id = 1;
+  if (has_group_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(1, this->group_id(), output);
   }
 
-  // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
-  if (has_internal_merge_response()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        35, this->internal_merge_response(), target);
+  // optional bytes msg = 2;
+  if (has_msg()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->msg(), output);
   }
 
-  // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
-  if (has_internal_truncate_log()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        36, this->internal_truncate_log(), target);
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
   }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RaftMessageRequest)
+}
 
-  // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
-  if (has_internal_gc()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        37, this->internal_gc(), target);
+::google::protobuf::uint8* RaftMessageRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RaftMessageRequest)
+  // optional uint64 group_id = 1;
+  if (has_group_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(1, this->group_id(), target);
   }
 
-  // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
-  if (has_internal_lease()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        38, this->internal_lease(), target);
+  // optional bytes msg = 2;
+  if (has_msg()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->msg(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRaftCommandUnion)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RaftMessageRequest)
   return target;
 }
 
-int InternalRaftCommandUnion::ByteSize() const {
+int RaftMessageRequest::ByteSize() const {
   int total_size = 0;
 
-  switch (value_case()) {
-    // optional .cockroach.proto.ContainsRequest contains = 1;
-    case kContains: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->contains());
-      break;
-    }
-    // optional .cockroach.proto.GetRequest get = 2;
-    case kGet: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->get());
-      break;
-    }
-    // optional .cockroach.proto.PutRequest put = 3;
-    case kPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->put());
-      break;
-    }
-    // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-    case kConditionalPut: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->conditional_put());
-      break;
-    }
-    // optional .cockroach.proto.IncrementRequest increment = 5;
-    case kIncrement: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->increment());
-      break;
-    }
-    // optional .cockroach.proto.DeleteRequest delete = 6;
-    case kDelete: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_());
-      break;
-    }
-    // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-    case kDeleteRange: {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_range());
-      break;
-    }
-    // optional .cockroach.proto.ScanRequest scan = 8;
-    case kScan: {
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional uint64 group_id = 1;
+    if (has_group_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->scan());
-      break;
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->group_id());
     }
-    // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-    case kEndTransaction: {
+
+    // optional bytes msg = 2;
+    if (has_msg()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->end_transaction());
-      break;
-    }
-    // optional .cockroach.proto.BatchRequest batch = 30;
-    case kBatch: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->batch());
-      break;
-    }
-    // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
-    case kInternalRangeLookup: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_range_lookup());
-      break;
-    }
-    // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
-    case kInternalHeartbeatTxn: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_heartbeat_txn());
-      break;
-    }
-    // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
-    case kInternalPushTxn: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_push_txn());
-      break;
-    }
-    // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-    case kInternalResolveIntent: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_resolve_intent());
-      break;
-    }
-    // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
-    case kInternalMergeResponse: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_merge_response());
-      break;
-    }
-    // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
-    case kInternalTruncateLog: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_truncate_log());
-      break;
-    }
-    // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
-    case kInternalGc: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_gc());
-      break;
-    }
-    // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
-    case kInternalLease: {
-      total_size += 2 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_lease());
-      break;
-    }
-    case VALUE_NOT_SET: {
-      break;
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->msg());
     }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -6864,10 +9872,10 @@ int InternalRaftCommandUnion::ByteSize() const {
   return total_size;
 }
 
-void InternalRaftCommandUnion::MergeFrom(const ::google::protobuf::Message& from) {
+void RaftMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const InternalRaftCommandUnion* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRaftCommandUnion*>(
+  const RaftMessageRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RaftMessageRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6876,120 +9884,51 @@ void InternalRaftCommandUnion::MergeFrom(const ::google::protobuf::Message& from
   }
 }
 
-void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
+void RaftMessageRequest::MergeFrom(const RaftMessageRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
-  switch (from.value_case()) {
-    case kContains: {
-      mutable_contains()->::cockroach::proto::ContainsRequest::MergeFrom(from.contains());
-      break;
-    }
-    case kGet: {
-      mutable_get()->::cockroach::proto::GetRequest::MergeFrom(from.get());
-      break;
-    }
-    case kPut: {
-      mutable_put()->::cockroach::proto::PutRequest::MergeFrom(from.put());
-      break;
-    }
-    case kConditionalPut: {
-      mutable_conditional_put()->::cockroach::proto::ConditionalPutRequest::MergeFrom(from.conditional_put());
-      break;
-    }
-    case kIncrement: {
-      mutable_increment()->::cockroach::proto::IncrementRequest::MergeFrom(from.increment());
-      break;
-    }
-    case kDelete: {
-      mutable_delete_()->::cockroach::proto::DeleteRequest::MergeFrom(from.delete_());
-      break;
-    }
-    case kDeleteRange: {
-      mutable_delete_range()->::cockroach::proto::DeleteRangeRequest::MergeFrom(from.delete_range());
-      break;
-    }
-    case kScan: {
-      mutable_scan()->::cockroach::proto::ScanRequest::MergeFrom(from.scan());
-      break;
-    }
-    case kEndTransaction: {
-      mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
-      break;
-    }
-    case kBatch: {
-      mutable_batch()->::cockroach::proto::BatchRequest::MergeFrom(from.batch());
-      break;
-    }
-    case kInternalRangeLookup: {
-      mutable_internal_range_lookup()->::cockroach::proto::InternalRangeLookupRequest::MergeFrom(from.internal_range_lookup());
-      break;
-    }
-    case kInternalHeartbeatTxn: {
-      mutable_internal_heartbeat_txn()->::cockroach::proto::InternalHeartbeatTxnRequest::MergeFrom(from.internal_heartbeat_txn());
-      break;
-    }
-    case kInternalPushTxn: {
-      mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnRequest::MergeFrom(from.internal_push_txn());
-      break;
-    }
-    case kInternalResolveIntent: {
-      mutable_internal_resolve_intent()->::cockroach::proto::InternalResolveIntentRequest::MergeFrom(from.internal_resolve_intent());
-      break;
-    }
-    case kInternalMergeResponse: {
-      mutable_internal_merge_response()->::cockroach::proto::InternalMergeRequest::MergeFrom(from.internal_merge_response());
-      break;
-    }
-    case kInternalTruncateLog: {
-      mutable_internal_truncate_log()->::cockroach::proto::InternalTruncateLogRequest::MergeFrom(from.internal_truncate_log());
-      break;
-    }
-    case kInternalGc: {
-      mutable_internal_gc()->::cockroach::proto::InternalGCRequest::MergeFrom(from.internal_gc());
-      break;
-    }
-    case kInternalLease: {
-      mutable_internal_lease()->::cockroach::proto::InternalLeaderLeaseRequest::MergeFrom(from.internal_lease());
-      break;
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_group_id()) {
+      set_group_id(from.group_id());
     }
-    case VALUE_NOT_SET: {
-      break;
+    if (from.has_msg()) {
+      set_msg(from.msg());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void InternalRaftCommandUnion::CopyFrom(const ::google::protobuf::Message& from) {
+void RaftMessageRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void InternalRaftCommandUnion::CopyFrom(const InternalRaftCommandUnion& from) {
+void RaftMessageRequest::CopyFrom(const RaftMessageRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool InternalRaftCommandUnion::IsInitialized() const {
+bool RaftMessageRequest::IsInitialized() const {
 
   return true;
 }
 
-void InternalRaftCommandUnion::Swap(InternalRaftCommandUnion* other) {
+void RaftMessageRequest::Swap(RaftMessageRequest* other) {
   if (other != this) {
-    std::swap(value_, other->value_);
-    std::swap(_oneof_case_[0], other->_oneof_case_[0]);
+    std::swap(group_id_, other->group_id_);
+    std::swap(msg_, other->msg_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata InternalRaftCommandUnion::GetMetadata() const {
+::google::protobuf::Metadata RaftMessageRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = InternalRaftCommandUnion_descriptor_;
-  metadata.reflection = InternalRaftCommandUnion_reflection_;
+  metadata.descriptor = RaftMessageRequest_descriptor_;
+  metadata.reflection = RaftMessageRequest_reflection_;
   return metadata;
 }
 
@@ -6997,107 +9936,116 @@ void InternalRaftCommandUnion::Swap(InternalRaftCommandUnion* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int InternalRaftCommand::kRaftIdFieldNumber;
-const int InternalRaftCommand::kCmdFieldNumber;
+const int ReserveSnapshotRequest::kGroupIdFieldNumber;
+const int ReserveSnapshotRequest::kNodeIdFieldNumber;
 #endif  // !_MSC_VER
 
-InternalRaftCommand::InternalRaftCommand()
+ReserveSnapshotRequest::ReserveSnapshotRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReserveSnapshotRequest)
 }
 
-void InternalRaftCommand::InitAsDefaultInstance() {
-  cmd_ = const_cast< ::cockroach::proto::InternalRaftCommandUnion*>(&::cockroach::proto::InternalRaftCommandUnion::default_instance());
+void ReserveSnapshotRequest::InitAsDefaultInstance() {
 }
 
-InternalRaftCommand::InternalRaftCommand(const InternalRaftCommand& from)
+ReserveSnapshotRequest::ReserveSnapshotRequest(const ReserveSnapshotRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReserveSnapshotRequest)
 }
 
-void InternalRaftCommand::SharedCtor() {
+void ReserveSnapshotRequest::SharedCtor() {
   _cached_size_ = 0;
-  raft_id_ = GOOGLE_LONGLONG(0);
-  cmd_ = NULL;
+  group_id_ = GOOGLE_ULONGLONG(0);
+  node_id_ = GOOGLE_ULONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-InternalRaftCommand::~InternalRaftCommand() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRaftCommand)
+ReserveSnapshotRequest::~ReserveSnapshotRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReserveSnapshotRequest)
   SharedDtor();
 }
 
-void InternalRaftCommand::SharedDtor() {
+void ReserveSnapshotRequest::SharedDtor() {
   if (this != default_instance_) {
-    delete cmd_;
   }
 }
 
-void InternalRaftCommand::SetCachedSize(int size) const {
+void ReserveSnapshotRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* InternalRaftCommand::descriptor() {
+const ::google::protobuf::Descriptor* ReserveSnapshotRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return InternalRaftCommand_descriptor_;
+  return ReserveSnapshotRequest_descriptor_;
 }
 
-const InternalRaftCommand& InternalRaftCommand::default_instance() {
+const ReserveSnapshotRequest& ReserveSnapshotRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   return *default_instance_;
 }
 
-InternalRaftCommand* InternalRaftCommand::default_instance_ = NULL;
+ReserveSnapshotRequest* ReserveSnapshotRequest::default_instance_ = NULL;
 
-InternalRaftCommand* InternalRaftCommand::New() const {
-  return new InternalRaftCommand;
+ReserveSnapshotRequest* ReserveSnapshotRequest::New() const {
+  return new ReserveSnapshotRequest;
 }
 
-void InternalRaftCommand::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    raft_id_ = GOOGLE_LONGLONG(0);
-    if (has_cmd()) {
-      if (cmd_ != NULL) cmd_->::cockroach::proto::InternalRaftCommandUnion::Clear();
-    }
-  }
+void ReserveSnapshotRequest::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<ReserveSnapshotRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  ZR_(group_id_, node_id_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool InternalRaftCommand::MergePartialFromCodedStream(
+bool ReserveSnapshotRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReserveSnapshotRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional int64 raft_id = 2;
-      case 2: {
-        if (tag == 16) {
+      // optional uint64 group_id = 1;
+      case 1: {
+        if (tag == 8) {
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &raft_id_)));
-          set_has_raft_id();
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &group_id_)));
+          set_has_group_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_cmd;
+        if (input->ExpectTag(16)) goto parse_node_id;
         break;
       }
 
-      // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_cmd:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_cmd()));
+      // optional uint64 node_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_node_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &node_id_)));
+          set_has_node_id();
         } else {
           goto handle_unusual;
         }
@@ -7119,74 +10067,71 @@ bool InternalRaftCommand::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReserveSnapshotRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReserveSnapshotRequest)
   return false;
 #undef DO_
 }
 
-void InternalRaftCommand::SerializeWithCachedSizes(
+void ReserveSnapshotRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalRaftCommand)
-  // optional int64 raft_id = 2;
-  if (has_raft_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->raft_id(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReserveSnapshotRequest)
+  // optional uint64 group_id = 1;
+  if (has_group_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(1, this->group_id(), output);
   }
 
-  // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
-  if (has_cmd()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->cmd(), output);
+  // optional uint64 node_id = 2;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(2, this->node_id(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReserveSnapshotRequest)
 }
 
-::google::protobuf::uint8* InternalRaftCommand::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReserveSnapshotRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalRaftCommand)
-  // optional int64 raft_id = 2;
-  if (has_raft_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->raft_id(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReserveSnapshotRequest)
+  // optional uint64 group_id = 1;
+  if (has_group_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(1, this->group_id(), target);
   }
 
-  // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
-  if (has_cmd()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->cmd(), target);
+  // optional uint64 node_id = 2;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(2, this->node_id(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReserveSnapshotRequest)
   return target;
 }
 
-int InternalRaftCommand::ByteSize() const {
+int ReserveSnapshotRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional int64 raft_id = 2;
-    if (has_raft_id()) {
+    // optional uint64 group_id = 1;
+    if (has_group_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->raft_id());
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->group_id());
     }
 
-    // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
-    if (has_cmd()) {
+    // optional uint64 node_id = 2;
+    if (has_node_id()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->cmd());
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->node_id());
     }
 
   }
@@ -7201,10 +10146,10 @@ int InternalRaftCommand::ByteSize() const {
   return total_size;
 }
 
-void InternalRaftCommand::MergeFrom(const ::google::protobuf::Message& from) {
+void ReserveSnapshotRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const InternalRaftCommand* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRaftCommand*>(
+  const ReserveSnapshotRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReserveSnapshotRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7213,51 +10158,51 @@ void InternalRaftCommand::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void InternalRaftCommand::MergeFrom(const InternalRaftCommand& from) {
+void ReserveSnapshotRequest::MergeFrom(const ReserveSnapshotRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_raft_id()) {
-      set_raft_id(from.raft_id());
+    if (from.has_group_id()) {
+      set_group_id(from.group_id());
     }
-    if (from.has_cmd()) {
-      mutable_cmd()->::cockroach::proto::InternalRaftCommandUnion::MergeFrom(from.cmd());
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void InternalRaftCommand::CopyFrom(const ::google::protobuf::Message& from) {
+void ReserveSnapshotRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void InternalRaftCommand::CopyFrom(const InternalRaftCommand& from) {
+void ReserveSnapshotRequest::CopyFrom(const ReserveSnapshotRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool InternalRaftCommand::IsInitialized() const {
+bool ReserveSnapshotRequest::IsInitialized() const {
 
   return true;
 }
 
-void InternalRaftCommand::Swap(InternalRaftCommand* other) {
+void ReserveSnapshotRequest::Swap(ReserveSnapshotRequest* other) {
   if (other != this) {
-    std::swap(raft_id_, other->raft_id_);
-    std::swap(cmd_, other->cmd_);
+    std::swap(group_id_, other->group_id_);
+    std::swap(node_id_, other->node_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata InternalRaftCommand::GetMetadata() const {
+::google::protobuf::Metadata ReserveSnapshotRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = InternalRaftCommand_descriptor_;
-  metadata.reflection = InternalRaftCommand_reflection_;
+  metadata.descriptor = ReserveSnapshotRequest_descriptor_;
+  metadata.reflection = ReserveSnapshotRequest_reflection_;
   return metadata;
 }
 
@@ -7265,111 +10210,85 @@ void InternalRaftCommand::Swap(InternalRaftCommand* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int RaftMessageRequest::kGroupIdFieldNumber;
-const int RaftMessageRequest::kMsgFieldNumber;
+const int ReserveSnapshotResponse::kReservedFieldNumber;
 #endif  // !_MSC_VER
 
-RaftMessageRequest::RaftMessageRequest()
+ReserveSnapshotResponse::ReserveSnapshotResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ReserveSnapshotResponse)
 }
 
-void RaftMessageRequest::InitAsDefaultInstance() {
+void ReserveSnapshotResponse::InitAsDefaultInstance() {
 }
 
-RaftMessageRequest::RaftMessageRequest(const RaftMessageRequest& from)
+ReserveSnapshotResponse::ReserveSnapshotResponse(const ReserveSnapshotResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ReserveSnapshotResponse)
 }
 
-void RaftMessageRequest::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void ReserveSnapshotResponse::SharedCtor() {
   _cached_size_ = 0;
-  group_id_ = GOOGLE_ULONGLONG(0);
-  msg_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  reserved_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-RaftMessageRequest::~RaftMessageRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.RaftMessageRequest)
-  SharedDtor();
-}
-
-void RaftMessageRequest::SharedDtor() {
-  if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete msg_;
-  }
+ReserveSnapshotResponse::~ReserveSnapshotResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ReserveSnapshotResponse)
+  SharedDtor();
+}
+
+void ReserveSnapshotResponse::SharedDtor() {
   if (this != default_instance_) {
   }
 }
 
-void RaftMessageRequest::SetCachedSize(int size) const {
+void ReserveSnapshotResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* RaftMessageRequest::descriptor() {
+const ::google::protobuf::Descriptor* ReserveSnapshotResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return RaftMessageRequest_descriptor_;
+  return ReserveSnapshotResponse_descriptor_;
 }
 
-const RaftMessageRequest& RaftMessageRequest::default_instance() {
+const ReserveSnapshotResponse& ReserveSnapshotResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   return *default_instance_;
 }
 
-RaftMessageRequest* RaftMessageRequest::default_instance_ = NULL;
+ReserveSnapshotResponse* ReserveSnapshotResponse::default_instance_ = NULL;
 
-RaftMessageRequest* RaftMessageRequest::New() const {
-  return new RaftMessageRequest;
+ReserveSnapshotResponse* ReserveSnapshotResponse::New() const {
+  return new ReserveSnapshotResponse;
 }
 
-void RaftMessageRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    group_id_ = GOOGLE_ULONGLONG(0);
-    if (has_msg()) {
-      if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        msg_->clear();
-      }
-    }
-  }
+void ReserveSnapshotResponse::Clear() {
+  reserved_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool RaftMessageRequest::MergePartialFromCodedStream(
+bool ReserveSnapshotResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ReserveSnapshotResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional uint64 group_id = 1;
+      // optional bool reserved = 1;
       case 1: {
         if (tag == 8) {
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
-                 input, &group_id_)));
-          set_has_group_id();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_msg;
-        break;
-      }
-
-      // optional bytes msg = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_msg:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_msg()));
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &reserved_)));
+          set_has_reserved();
         } else {
           goto handle_unusual;
         }
@@ -7391,74 +10310,52 @@ bool RaftMessageRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ReserveSnapshotResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ReserveSnapshotResponse)
   return false;
 #undef DO_
 }
 
-void RaftMessageRequest::SerializeWithCachedSizes(
+void ReserveSnapshotResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.RaftMessageRequest)
-  // optional uint64 group_id = 1;
-  if (has_group_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteUInt64(1, this->group_id(), output);
-  }
-
-  // optional bytes msg = 2;
-  if (has_msg()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      2, this->msg(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ReserveSnapshotResponse)
+  // optional bool reserved = 1;
+  if (has_reserved()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(1, this->reserved(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ReserveSnapshotResponse)
 }
 
-::google::protobuf::uint8* RaftMessageRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReserveSnapshotResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RaftMessageRequest)
-  // optional uint64 group_id = 1;
-  if (has_group_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(1, this->group_id(), target);
-  }
-
-  // optional bytes msg = 2;
-  if (has_msg()) {
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        2, this->msg(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ReserveSnapshotResponse)
+  // optional bool reserved = 1;
+  if (has_reserved()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(1, this->reserved(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ReserveSnapshotResponse)
   return target;
 }
 
-int RaftMessageRequest::ByteSize() const {
+int ReserveSnapshotResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional uint64 group_id = 1;
-    if (has_group_id()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::UInt64Size(
-          this->group_id());
-    }
-
-    // optional bytes msg = 2;
-    if (has_msg()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->msg());
+    // optional bool reserved = 1;
+    if (has_reserved()) {
+      total_size += 1 + 1;
     }
 
   }
@@ -7473,10 +10370,10 @@ int RaftMessageRequest::ByteSize() const {
   return total_size;
 }
 
-void RaftMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ReserveSnapshotResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const RaftMessageRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const RaftMessageRequest*>(
+  const ReserveSnapshotResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReserveSnapshotResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7485,51 +10382,47 @@ void RaftMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void RaftMessageRequest::MergeFrom(const RaftMessageRequest& from) {
+void ReserveSnapshotResponse::MergeFrom(const ReserveSnapshotResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_group_id()) {
-      set_group_id(from.group_id());
-    }
-    if (from.has_msg()) {
-      set_msg(from.msg());
+    if (from.has_reserved()) {
+      set_reserved(from.reserved());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void RaftMessageRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ReserveSnapshotResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void RaftMessageRequest::CopyFrom(const RaftMessageRequest& from) {
+void ReserveSnapshotResponse::CopyFrom(const ReserveSnapshotResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool RaftMessageRequest::IsInitialized() const {
+bool ReserveSnapshotResponse::IsInitialized() const {
 
   return true;
 }
 
-void RaftMessageRequest::Swap(RaftMessageRequest* other) {
+void ReserveSnapshotResponse::Swap(ReserveSnapshotResponse* other) {
   if (other != this) {
-    std::swap(group_id_, other->group_id_);
-    std::swap(msg_, other->msg_);
+    std::swap(reserved_, other->reserved_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata RaftMessageRequest::GetMetadata() const {
+::google::protobuf::Metadata ReserveSnapshotResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = RaftMessageRequest_descriptor_;
-  metadata.reflection = RaftMessageRequest_reflection_;
+  metadata.descriptor = ReserveSnapshotResponse_descriptor_;
+  metadata.reflection = ReserveSnapshotResponse_reflection_;
   return metadata;
 }
 
@@ -9335,6 +12228,237 @@ void RaftSnapshotData::Swap(RaftSnapshotData* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int GossipBootstrapInfo::kAddressesFieldNumber;
+#endif  // !_MSC_VER
+
+GossipBootstrapInfo::GossipBootstrapInfo()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.GossipBootstrapInfo)
+}
+
+void GossipBootstrapInfo::InitAsDefaultInstance() {
+}
+
+GossipBootstrapInfo::GossipBootstrapInfo(const GossipBootstrapInfo& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.GossipBootstrapInfo)
+}
+
+void GossipBootstrapInfo::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+GossipBootstrapInfo::~GossipBootstrapInfo() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.GossipBootstrapInfo)
+  SharedDtor();
+}
+
+void GossipBootstrapInfo::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void GossipBootstrapInfo::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* GossipBootstrapInfo::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return GossipBootstrapInfo_descriptor_;
+}
+
+const GossipBootstrapInfo& GossipBootstrapInfo::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+GossipBootstrapInfo* GossipBootstrapInfo::default_instance_ = NULL;
+
+GossipBootstrapInfo* GossipBootstrapInfo::New() const {
+  return new GossipBootstrapInfo;
+}
+
+void GossipBootstrapInfo::Clear() {
+  addresses_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool GossipBootstrapInfo::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.GossipBootstrapInfo)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // repeated string addresses = 1;
+      case 1: {
+        if (tag == 10) {
+         parse_addresses:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->add_addresses()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->addresses(this->addresses_size() - 1).data(),
+            this->addresses(this->addresses_size() - 1).length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "addresses");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(10)) goto parse_addresses;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.GossipBootstrapInfo)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.GossipBootstrapInfo)
+  return false;
+#undef DO_
+}
+
+void GossipBootstrapInfo::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.GossipBootstrapInfo)
+  // repeated string addresses = 1;
+  for (int i = 0; i < this->addresses_size(); i++) {
+  ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+    this->addresses(i).data(), this->addresses(i).length(),
+    ::google::protobuf::internal::WireFormat::SERIALIZE,
+    "addresses");
+    ::google::protobuf::internal::WireFormatLite::WriteString(
+      1, this->addresses(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.GossipBootstrapInfo)
+}
+
+::google::protobuf::uint8* GossipBootstrapInfo::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.GossipBootstrapInfo)
+  // repeated string addresses = 1;
+  for (int i = 0; i < this->addresses_size(); i++) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->addresses(i).data(), this->addresses(i).length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "addresses");
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteStringToArray(1, this->addresses(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.GossipBootstrapInfo)
+  return target;
+}
+
+int GossipBootstrapInfo::ByteSize() const {
+  int total_size = 0;
+
+  // repeated string addresses = 1;
+  total_size += 1 * this->addresses_size();
+  for (int i = 0; i < this->addresses_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::StringSize(
+      this->addresses(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void GossipBootstrapInfo::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const GossipBootstrapInfo* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const GossipBootstrapInfo*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void GossipBootstrapInfo::MergeFrom(const GossipBootstrapInfo& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  addresses_.MergeFrom(from.addresses_);
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void GossipBootstrapInfo::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void GossipBootstrapInfo::CopyFrom(const GossipBootstrapInfo& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GossipBootstrapInfo::IsInitialized() const {
+
+  return true;
+}
+
+void GossipBootstrapInfo::Swap(GossipBootstrapInfo* other) {
+  if (other != this) {
+    addresses_.Swap(&other->addresses_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata GossipBootstrapInfo::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = GossipBootstrapInfo_descriptor_;
+  metadata.reflection = GossipBootstrapInfo_reflection_;
+  return metadata;
+}
+
+
 // @@protoc_insertion_point(namespace_scope)
 
 }  // namespace proto
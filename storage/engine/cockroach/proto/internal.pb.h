@@ -56,16 +56,25 @@ class InternalTruncateLogRequest;
 class InternalTruncateLogResponse;
 class InternalLeaderLeaseRequest;
 class InternalLeaderLeaseResponse;
+class InternalCheckConsistencyRequest;
+class InternalCheckConsistencyResponse;
+class InternalRecomputeStatsRequest;
+class InternalRecomputeStatsResponse;
+class InternalRefreshRangeRequest;
+class InternalRefreshRangeResponse;
 class ReadWriteCmdResponse;
 class InternalRaftCommandUnion;
 class InternalRaftCommand;
 class RaftMessageRequest;
+class ReserveSnapshotRequest;
+class ReserveSnapshotResponse;
 class RaftMessageResponse;
 class InternalTimeSeriesData;
 class InternalTimeSeriesSample;
 class RaftTruncatedState;
 class RaftSnapshotData;
 class RaftSnapshotData_KeyValue;
+class GossipBootstrapInfo;
 
 enum InternalValueType {
   _CR_TS = 1
@@ -1597,14 +1606,14 @@ class InternalLeaderLeaseResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class ReadWriteCmdResponse : public ::google::protobuf::Message {
+class InternalCheckConsistencyRequest : public ::google::protobuf::Message {
  public:
-  ReadWriteCmdResponse();
-  virtual ~ReadWriteCmdResponse();
+  InternalCheckConsistencyRequest();
+  virtual ~InternalCheckConsistencyRequest();
 
-  ReadWriteCmdResponse(const ReadWriteCmdResponse& from);
+  InternalCheckConsistencyRequest(const InternalCheckConsistencyRequest& from);
 
-  inline ReadWriteCmdResponse& operator=(const ReadWriteCmdResponse& from) {
+  inline InternalCheckConsistencyRequest& operator=(const InternalCheckConsistencyRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1618,33 +1627,17 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ReadWriteCmdResponse& default_instance();
-
-  enum ValueCase {
-    kPut = 1,
-    kConditionalPut = 2,
-    kIncrement = 3,
-    kDelete = 4,
-    kDeleteRange = 5,
-    kEndTransaction = 6,
-    kInternalHeartbeatTxn = 10,
-    kInternalPushTxn = 11,
-    kInternalResolveIntent = 12,
-    kInternalMerge = 13,
-    kInternalTruncateLog = 14,
-    kInternalGc = 15,
-    VALUE_NOT_SET = 0,
-  };
+  static const InternalCheckConsistencyRequest& default_instance();
 
-  void Swap(ReadWriteCmdResponse* other);
+  void Swap(InternalCheckConsistencyRequest* other);
 
   // implements Message ----------------------------------------------
 
-  ReadWriteCmdResponse* New() const;
+  InternalCheckConsistencyRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ReadWriteCmdResponse& from);
-  void MergeFrom(const ReadWriteCmdResponse& from);
+  void CopyFrom(const InternalCheckConsistencyRequest& from);
+  void MergeFrom(const InternalCheckConsistencyRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1666,171 +1659,72 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.PutResponse put = 1;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 1;
-  inline const ::cockroach::proto::PutResponse& put() const;
-  inline ::cockroach::proto::PutResponse* mutable_put();
-  inline ::cockroach::proto::PutResponse* release_put();
-  inline void set_allocated_put(::cockroach::proto::PutResponse* put);
-
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 2;
-  inline const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
-  inline ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
-  inline ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
-  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
-
-  // optional .cockroach.proto.IncrementResponse increment = 3;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 3;
-  inline const ::cockroach::proto::IncrementResponse& increment() const;
-  inline ::cockroach::proto::IncrementResponse* mutable_increment();
-  inline ::cockroach::proto::IncrementResponse* release_increment();
-  inline void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
-
-  // optional .cockroach.proto.DeleteResponse delete = 4;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 4;
-  inline const ::cockroach::proto::DeleteResponse& delete_() const;
-  inline ::cockroach::proto::DeleteResponse* mutable_delete_();
-  inline ::cockroach::proto::DeleteResponse* release_delete_();
-  inline void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
-
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 5;
-  inline const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
-  inline ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
-  inline ::cockroach::proto::DeleteRangeResponse* release_delete_range();
-  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
-
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 6;
-  inline const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
-  inline ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
-  inline ::cockroach::proto::EndTransactionResponse* release_end_transaction();
-  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
-
-  // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-  inline bool has_internal_heartbeat_txn() const;
-  inline void clear_internal_heartbeat_txn();
-  static const int kInternalHeartbeatTxnFieldNumber = 10;
-  inline const ::cockroach::proto::InternalHeartbeatTxnResponse& internal_heartbeat_txn() const;
-  inline ::cockroach::proto::InternalHeartbeatTxnResponse* mutable_internal_heartbeat_txn();
-  inline ::cockroach::proto::InternalHeartbeatTxnResponse* release_internal_heartbeat_txn();
-  inline void set_allocated_internal_heartbeat_txn(::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn);
-
-  // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
-  inline bool has_internal_push_txn() const;
-  inline void clear_internal_push_txn();
-  static const int kInternalPushTxnFieldNumber = 11;
-  inline const ::cockroach::proto::InternalPushTxnResponse& internal_push_txn() const;
-  inline ::cockroach::proto::InternalPushTxnResponse* mutable_internal_push_txn();
-  inline ::cockroach::proto::InternalPushTxnResponse* release_internal_push_txn();
-  inline void set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnResponse* internal_push_txn);
-
-  // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-  inline bool has_internal_resolve_intent() const;
-  inline void clear_internal_resolve_intent();
-  static const int kInternalResolveIntentFieldNumber = 12;
-  inline const ::cockroach::proto::InternalResolveIntentResponse& internal_resolve_intent() const;
-  inline ::cockroach::proto::InternalResolveIntentResponse* mutable_internal_resolve_intent();
-  inline ::cockroach::proto::InternalResolveIntentResponse* release_internal_resolve_intent();
-  inline void set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent);
-
-  // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
-  inline bool has_internal_merge() const;
-  inline void clear_internal_merge();
-  static const int kInternalMergeFieldNumber = 13;
-  inline const ::cockroach::proto::InternalMergeResponse& internal_merge() const;
-  inline ::cockroach::proto::InternalMergeResponse* mutable_internal_merge();
-  inline ::cockroach::proto::InternalMergeResponse* release_internal_merge();
-  inline void set_allocated_internal_merge(::cockroach::proto::InternalMergeResponse* internal_merge);
-
-  // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
-  inline bool has_internal_truncate_log() const;
-  inline void clear_internal_truncate_log();
-  static const int kInternalTruncateLogFieldNumber = 14;
-  inline const ::cockroach::proto::InternalTruncateLogResponse& internal_truncate_log() const;
-  inline ::cockroach::proto::InternalTruncateLogResponse* mutable_internal_truncate_log();
-  inline ::cockroach::proto::InternalTruncateLogResponse* release_internal_truncate_log();
-  inline void set_allocated_internal_truncate_log(::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log);
-
-  // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
-  inline bool has_internal_gc() const;
-  inline void clear_internal_gc();
-  static const int kInternalGcFieldNumber = 15;
-  inline const ::cockroach::proto::InternalGCResponse& internal_gc() const;
-  inline ::cockroach::proto::InternalGCResponse* mutable_internal_gc();
-  inline ::cockroach::proto::InternalGCResponse* release_internal_gc();
-  inline void set_allocated_internal_gc(::cockroach::proto::InternalGCResponse* internal_gc);
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  inline ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.ReadWriteCmdResponse)
+  // optional bytes checksum_id = 2;
+  inline bool has_checksum_id() const;
+  inline void clear_checksum_id();
+  static const int kChecksumIdFieldNumber = 2;
+  inline const ::std::string& checksum_id() const;
+  inline void set_checksum_id(const ::std::string& value);
+  inline void set_checksum_id(const char* value);
+  inline void set_checksum_id(const void* value, size_t size);
+  inline ::std::string* mutable_checksum_id();
+  inline ::std::string* release_checksum_id();
+  inline void set_allocated_checksum_id(::std::string* checksum_id);
+
+  // optional bytes checksum = 3;
+  inline bool has_checksum() const;
+  inline void clear_checksum();
+  static const int kChecksumFieldNumber = 3;
+  inline const ::std::string& checksum() const;
+  inline void set_checksum(const ::std::string& value);
+  inline void set_checksum(const char* value);
+  inline void set_checksum(const void* value, size_t size);
+  inline ::std::string* mutable_checksum();
+  inline ::std::string* release_checksum();
+  inline void set_allocated_checksum(::std::string* checksum);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalCheckConsistencyRequest)
  private:
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_end_transaction();
-  inline void set_has_internal_heartbeat_txn();
-  inline void set_has_internal_push_txn();
-  inline void set_has_internal_resolve_intent();
-  inline void set_has_internal_merge();
-  inline void set_has_internal_truncate_log();
-  inline void set_has_internal_gc();
-
-  inline bool has_value();
-  void clear_value();
-  inline void clear_has_value();
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_checksum_id();
+  inline void clear_has_checksum_id();
+  inline void set_has_checksum();
+  inline void clear_has_checksum();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ::cockroach::proto::PutResponse* put_;
-    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
-    ::cockroach::proto::IncrementResponse* increment_;
-    ::cockroach::proto::DeleteResponse* delete__;
-    ::cockroach::proto::DeleteRangeResponse* delete_range_;
-    ::cockroach::proto::EndTransactionResponse* end_transaction_;
-    ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
-    ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
-    ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
-    ::cockroach::proto::InternalMergeResponse* internal_merge_;
-    ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
-    ::cockroach::proto::InternalGCResponse* internal_gc_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::cockroach::proto::RequestHeader* header_;
+  ::std::string* checksum_id_;
+  ::std::string* checksum_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static ReadWriteCmdResponse* default_instance_;
+  static InternalCheckConsistencyRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalRaftCommandUnion : public ::google::protobuf::Message {
+class InternalCheckConsistencyResponse : public ::google::protobuf::Message {
  public:
-  InternalRaftCommandUnion();
-  virtual ~InternalRaftCommandUnion();
+  InternalCheckConsistencyResponse();
+  virtual ~InternalCheckConsistencyResponse();
 
-  InternalRaftCommandUnion(const InternalRaftCommandUnion& from);
+  InternalCheckConsistencyResponse(const InternalCheckConsistencyResponse& from);
 
-  inline InternalRaftCommandUnion& operator=(const InternalRaftCommandUnion& from) {
+  inline InternalCheckConsistencyResponse& operator=(const InternalCheckConsistencyResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1844,39 +1738,17 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalRaftCommandUnion& default_instance();
-
-  enum ValueCase {
-    kContains = 1,
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    kBatch = 30,
-    kInternalRangeLookup = 31,
-    kInternalHeartbeatTxn = 32,
-    kInternalPushTxn = 33,
-    kInternalResolveIntent = 34,
-    kInternalMergeResponse = 35,
-    kInternalTruncateLog = 36,
-    kInternalGc = 37,
-    kInternalLease = 38,
-    VALUE_NOT_SET = 0,
-  };
+  static const InternalCheckConsistencyResponse& default_instance();
 
-  void Swap(InternalRaftCommandUnion* other);
+  void Swap(InternalCheckConsistencyResponse* other);
 
   // implements Message ----------------------------------------------
 
-  InternalRaftCommandUnion* New() const;
+  InternalCheckConsistencyResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalRaftCommandUnion& from);
-  void MergeFrom(const InternalRaftCommandUnion& from);
+  void CopyFrom(const InternalCheckConsistencyResponse& from);
+  void MergeFrom(const InternalCheckConsistencyResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1898,237 +1770,57 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::cockroach::proto::ContainsRequest& contains() const;
-  inline ::cockroach::proto::ContainsRequest* mutable_contains();
-  inline ::cockroach::proto::ContainsRequest* release_contains();
-  inline void set_allocated_contains(::cockroach::proto::ContainsRequest* contains);
-
-  // optional .cockroach.proto.GetRequest get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
-  static const int kGetFieldNumber = 2;
-  inline const ::cockroach::proto::GetRequest& get() const;
-  inline ::cockroach::proto::GetRequest* mutable_get();
-  inline ::cockroach::proto::GetRequest* release_get();
-  inline void set_allocated_get(::cockroach::proto::GetRequest* get);
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // optional .cockroach.proto.PutRequest put = 3;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 3;
-  inline const ::cockroach::proto::PutRequest& put() const;
-  inline ::cockroach::proto::PutRequest* mutable_put();
-  inline ::cockroach::proto::PutRequest* release_put();
-  inline void set_allocated_put(::cockroach::proto::PutRequest* put);
+  // optional bytes checksum = 2;
+  inline bool has_checksum() const;
+  inline void clear_checksum();
+  static const int kChecksumFieldNumber = 2;
+  inline const ::std::string& checksum() const;
+  inline void set_checksum(const ::std::string& value);
+  inline void set_checksum(const char* value);
+  inline void set_checksum(const void* value, size_t size);
+  inline ::std::string* mutable_checksum();
+  inline ::std::string* release_checksum();
+  inline void set_allocated_checksum(::std::string* checksum);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalCheckConsistencyResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_checksum();
+  inline void clear_has_checksum();
 
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  inline const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
-  inline ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
-  inline ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
-  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
-
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  inline const ::cockroach::proto::IncrementRequest& increment() const;
-  inline ::cockroach::proto::IncrementRequest* mutable_increment();
-  inline ::cockroach::proto::IncrementRequest* release_increment();
-  inline void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
-
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  inline const ::cockroach::proto::DeleteRequest& delete_() const;
-  inline ::cockroach::proto::DeleteRequest* mutable_delete_();
-  inline ::cockroach::proto::DeleteRequest* release_delete_();
-  inline void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
-
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  inline const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
-  inline ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
-  inline ::cockroach::proto::DeleteRangeRequest* release_delete_range();
-  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
-
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  inline bool has_scan() const;
-  inline void clear_scan();
-  static const int kScanFieldNumber = 8;
-  inline const ::cockroach::proto::ScanRequest& scan() const;
-  inline ::cockroach::proto::ScanRequest* mutable_scan();
-  inline ::cockroach::proto::ScanRequest* release_scan();
-  inline void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
-
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  inline const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
-  inline ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
-  inline ::cockroach::proto::EndTransactionRequest* release_end_transaction();
-  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
-
-  // optional .cockroach.proto.BatchRequest batch = 30;
-  inline bool has_batch() const;
-  inline void clear_batch();
-  static const int kBatchFieldNumber = 30;
-  inline const ::cockroach::proto::BatchRequest& batch() const;
-  inline ::cockroach::proto::BatchRequest* mutable_batch();
-  inline ::cockroach::proto::BatchRequest* release_batch();
-  inline void set_allocated_batch(::cockroach::proto::BatchRequest* batch);
-
-  // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
-  inline bool has_internal_range_lookup() const;
-  inline void clear_internal_range_lookup();
-  static const int kInternalRangeLookupFieldNumber = 31;
-  inline const ::cockroach::proto::InternalRangeLookupRequest& internal_range_lookup() const;
-  inline ::cockroach::proto::InternalRangeLookupRequest* mutable_internal_range_lookup();
-  inline ::cockroach::proto::InternalRangeLookupRequest* release_internal_range_lookup();
-  inline void set_allocated_internal_range_lookup(::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup);
-
-  // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
-  inline bool has_internal_heartbeat_txn() const;
-  inline void clear_internal_heartbeat_txn();
-  static const int kInternalHeartbeatTxnFieldNumber = 32;
-  inline const ::cockroach::proto::InternalHeartbeatTxnRequest& internal_heartbeat_txn() const;
-  inline ::cockroach::proto::InternalHeartbeatTxnRequest* mutable_internal_heartbeat_txn();
-  inline ::cockroach::proto::InternalHeartbeatTxnRequest* release_internal_heartbeat_txn();
-  inline void set_allocated_internal_heartbeat_txn(::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn);
-
-  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
-  inline bool has_internal_push_txn() const;
-  inline void clear_internal_push_txn();
-  static const int kInternalPushTxnFieldNumber = 33;
-  inline const ::cockroach::proto::InternalPushTxnRequest& internal_push_txn() const;
-  inline ::cockroach::proto::InternalPushTxnRequest* mutable_internal_push_txn();
-  inline ::cockroach::proto::InternalPushTxnRequest* release_internal_push_txn();
-  inline void set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnRequest* internal_push_txn);
-
-  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-  inline bool has_internal_resolve_intent() const;
-  inline void clear_internal_resolve_intent();
-  static const int kInternalResolveIntentFieldNumber = 34;
-  inline const ::cockroach::proto::InternalResolveIntentRequest& internal_resolve_intent() const;
-  inline ::cockroach::proto::InternalResolveIntentRequest* mutable_internal_resolve_intent();
-  inline ::cockroach::proto::InternalResolveIntentRequest* release_internal_resolve_intent();
-  inline void set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent);
-
-  // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
-  inline bool has_internal_merge_response() const;
-  inline void clear_internal_merge_response();
-  static const int kInternalMergeResponseFieldNumber = 35;
-  inline const ::cockroach::proto::InternalMergeRequest& internal_merge_response() const;
-  inline ::cockroach::proto::InternalMergeRequest* mutable_internal_merge_response();
-  inline ::cockroach::proto::InternalMergeRequest* release_internal_merge_response();
-  inline void set_allocated_internal_merge_response(::cockroach::proto::InternalMergeRequest* internal_merge_response);
-
-  // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
-  inline bool has_internal_truncate_log() const;
-  inline void clear_internal_truncate_log();
-  static const int kInternalTruncateLogFieldNumber = 36;
-  inline const ::cockroach::proto::InternalTruncateLogRequest& internal_truncate_log() const;
-  inline ::cockroach::proto::InternalTruncateLogRequest* mutable_internal_truncate_log();
-  inline ::cockroach::proto::InternalTruncateLogRequest* release_internal_truncate_log();
-  inline void set_allocated_internal_truncate_log(::cockroach::proto::InternalTruncateLogRequest* internal_truncate_log);
-
-  // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
-  inline bool has_internal_gc() const;
-  inline void clear_internal_gc();
-  static const int kInternalGcFieldNumber = 37;
-  inline const ::cockroach::proto::InternalGCRequest& internal_gc() const;
-  inline ::cockroach::proto::InternalGCRequest* mutable_internal_gc();
-  inline ::cockroach::proto::InternalGCRequest* release_internal_gc();
-  inline void set_allocated_internal_gc(::cockroach::proto::InternalGCRequest* internal_gc);
-
-  // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
-  inline bool has_internal_lease() const;
-  inline void clear_internal_lease();
-  static const int kInternalLeaseFieldNumber = 38;
-  inline const ::cockroach::proto::InternalLeaderLeaseRequest& internal_lease() const;
-  inline ::cockroach::proto::InternalLeaderLeaseRequest* mutable_internal_lease();
-  inline ::cockroach::proto::InternalLeaderLeaseRequest* release_internal_lease();
-  inline void set_allocated_internal_lease(::cockroach::proto::InternalLeaderLeaseRequest* internal_lease);
-
-  inline ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRaftCommandUnion)
- private:
-  inline void set_has_contains();
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-  inline void set_has_batch();
-  inline void set_has_internal_range_lookup();
-  inline void set_has_internal_heartbeat_txn();
-  inline void set_has_internal_push_txn();
-  inline void set_has_internal_resolve_intent();
-  inline void set_has_internal_merge_response();
-  inline void set_has_internal_truncate_log();
-  inline void set_has_internal_gc();
-  inline void set_has_internal_lease();
-
-  inline bool has_value();
-  void clear_value();
-  inline void clear_has_value();
-
-  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ::cockroach::proto::ContainsRequest* contains_;
-    ::cockroach::proto::GetRequest* get_;
-    ::cockroach::proto::PutRequest* put_;
-    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
-    ::cockroach::proto::IncrementRequest* increment_;
-    ::cockroach::proto::DeleteRequest* delete__;
-    ::cockroach::proto::DeleteRangeRequest* delete_range_;
-    ::cockroach::proto::ScanRequest* scan_;
-    ::cockroach::proto::EndTransactionRequest* end_transaction_;
-    ::cockroach::proto::BatchRequest* batch_;
-    ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
-    ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
-    ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
-    ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
-    ::cockroach::proto::InternalMergeRequest* internal_merge_response_;
-    ::cockroach::proto::InternalTruncateLogRequest* internal_truncate_log_;
-    ::cockroach::proto::InternalGCRequest* internal_gc_;
-    ::cockroach::proto::InternalLeaderLeaseRequest* internal_lease_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::cockroach::proto::ResponseHeader* header_;
+  ::std::string* checksum_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalRaftCommandUnion* default_instance_;
+  static InternalCheckConsistencyResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalRaftCommand : public ::google::protobuf::Message {
+class InternalRecomputeStatsRequest : public ::google::protobuf::Message {
  public:
-  InternalRaftCommand();
-  virtual ~InternalRaftCommand();
+  InternalRecomputeStatsRequest();
+  virtual ~InternalRecomputeStatsRequest();
 
-  InternalRaftCommand(const InternalRaftCommand& from);
+  InternalRecomputeStatsRequest(const InternalRecomputeStatsRequest& from);
 
-  inline InternalRaftCommand& operator=(const InternalRaftCommand& from) {
+  inline InternalRecomputeStatsRequest& operator=(const InternalRecomputeStatsRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2142,17 +1834,17 @@ class InternalRaftCommand : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalRaftCommand& default_instance();
+  static const InternalRecomputeStatsRequest& default_instance();
 
-  void Swap(InternalRaftCommand* other);
+  void Swap(InternalRecomputeStatsRequest* other);
 
   // implements Message ----------------------------------------------
 
-  InternalRaftCommand* New() const;
+  InternalRecomputeStatsRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalRaftCommand& from);
-  void MergeFrom(const InternalRaftCommand& from);
+  void CopyFrom(const InternalRecomputeStatsRequest& from);
+  void MergeFrom(const InternalRecomputeStatsRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2174,52 +1866,42 @@ class InternalRaftCommand : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 raft_id = 2;
-  inline bool has_raft_id() const;
-  inline void clear_raft_id();
-  static const int kRaftIdFieldNumber = 2;
-  inline ::google::protobuf::int64 raft_id() const;
-  inline void set_raft_id(::google::protobuf::int64 value);
-
-  // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
-  inline bool has_cmd() const;
-  inline void clear_cmd();
-  static const int kCmdFieldNumber = 3;
-  inline const ::cockroach::proto::InternalRaftCommandUnion& cmd() const;
-  inline ::cockroach::proto::InternalRaftCommandUnion* mutable_cmd();
-  inline ::cockroach::proto::InternalRaftCommandUnion* release_cmd();
-  inline void set_allocated_cmd(::cockroach::proto::InternalRaftCommandUnion* cmd);
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRaftCommand)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRecomputeStatsRequest)
  private:
-  inline void set_has_raft_id();
-  inline void clear_has_raft_id();
-  inline void set_has_cmd();
-  inline void clear_has_cmd();
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 raft_id_;
-  ::cockroach::proto::InternalRaftCommandUnion* cmd_;
+  ::cockroach::proto::RequestHeader* header_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalRaftCommand* default_instance_;
+  static InternalRecomputeStatsRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class RaftMessageRequest : public ::google::protobuf::Message {
+class InternalRecomputeStatsResponse : public ::google::protobuf::Message {
  public:
-  RaftMessageRequest();
-  virtual ~RaftMessageRequest();
+  InternalRecomputeStatsResponse();
+  virtual ~InternalRecomputeStatsResponse();
 
-  RaftMessageRequest(const RaftMessageRequest& from);
+  InternalRecomputeStatsResponse(const InternalRecomputeStatsResponse& from);
 
-  inline RaftMessageRequest& operator=(const RaftMessageRequest& from) {
+  inline InternalRecomputeStatsResponse& operator=(const InternalRecomputeStatsResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2233,17 +1915,17 @@ class RaftMessageRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RaftMessageRequest& default_instance();
+  static const InternalRecomputeStatsResponse& default_instance();
 
-  void Swap(RaftMessageRequest* other);
+  void Swap(InternalRecomputeStatsResponse* other);
 
   // implements Message ----------------------------------------------
 
-  RaftMessageRequest* New() const;
+  InternalRecomputeStatsResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RaftMessageRequest& from);
-  void MergeFrom(const RaftMessageRequest& from);
+  void CopyFrom(const InternalRecomputeStatsResponse& from);
+  void MergeFrom(const InternalRecomputeStatsResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2265,55 +1947,54 @@ class RaftMessageRequest : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional uint64 group_id = 1;
-  inline bool has_group_id() const;
-  inline void clear_group_id();
-  static const int kGroupIdFieldNumber = 1;
-  inline ::google::protobuf::uint64 group_id() const;
-  inline void set_group_id(::google::protobuf::uint64 value);
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // optional bytes msg = 2;
-  inline bool has_msg() const;
-  inline void clear_msg();
-  static const int kMsgFieldNumber = 2;
-  inline const ::std::string& msg() const;
-  inline void set_msg(const ::std::string& value);
-  inline void set_msg(const char* value);
-  inline void set_msg(const void* value, size_t size);
-  inline ::std::string* mutable_msg();
-  inline ::std::string* release_msg();
-  inline void set_allocated_msg(::std::string* msg);
+  // optional .cockroach.proto.MVCCStats delta = 2;
+  inline bool has_delta() const;
+  inline void clear_delta();
+  static const int kDeltaFieldNumber = 2;
+  inline const ::cockroach::proto::MVCCStats& delta() const;
+  inline ::cockroach::proto::MVCCStats* mutable_delta();
+  inline ::cockroach::proto::MVCCStats* release_delta();
+  inline void set_allocated_delta(::cockroach::proto::MVCCStats* delta);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftMessageRequest)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRecomputeStatsResponse)
  private:
-  inline void set_has_group_id();
-  inline void clear_has_group_id();
-  inline void set_has_msg();
-  inline void clear_has_msg();
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_delta();
+  inline void clear_has_delta();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::uint64 group_id_;
-  ::std::string* msg_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::MVCCStats* delta_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static RaftMessageRequest* default_instance_;
+  static InternalRecomputeStatsResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class RaftMessageResponse : public ::google::protobuf::Message {
+class InternalRefreshRangeRequest : public ::google::protobuf::Message {
  public:
-  RaftMessageResponse();
-  virtual ~RaftMessageResponse();
+  InternalRefreshRangeRequest();
+  virtual ~InternalRefreshRangeRequest();
 
-  RaftMessageResponse(const RaftMessageResponse& from);
+  InternalRefreshRangeRequest(const InternalRefreshRangeRequest& from);
 
-  inline RaftMessageResponse& operator=(const RaftMessageResponse& from) {
+  inline InternalRefreshRangeRequest& operator=(const InternalRefreshRangeRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2327,17 +2008,17 @@ class RaftMessageResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RaftMessageResponse& default_instance();
+  static const InternalRefreshRangeRequest& default_instance();
 
-  void Swap(RaftMessageResponse* other);
+  void Swap(InternalRefreshRangeRequest* other);
 
   // implements Message ----------------------------------------------
 
-  RaftMessageResponse* New() const;
+  InternalRefreshRangeRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RaftMessageResponse& from);
-  void MergeFrom(const RaftMessageResponse& from);
+  void CopyFrom(const InternalRefreshRangeRequest& from);
+  void MergeFrom(const InternalRefreshRangeRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2359,30 +2040,54 @@ class RaftMessageResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftMessageResponse)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Timestamp refresh_from = 2;
+  inline bool has_refresh_from() const;
+  inline void clear_refresh_from();
+  static const int kRefreshFromFieldNumber = 2;
+  inline const ::cockroach::proto::Timestamp& refresh_from() const;
+  inline ::cockroach::proto::Timestamp* mutable_refresh_from();
+  inline ::cockroach::proto::Timestamp* release_refresh_from();
+  inline void set_allocated_refresh_from(::cockroach::proto::Timestamp* refresh_from);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRefreshRangeRequest)
  private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_refresh_from();
+  inline void clear_has_refresh_from();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Timestamp* refresh_from_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static RaftMessageResponse* default_instance_;
+  static InternalRefreshRangeRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalTimeSeriesData : public ::google::protobuf::Message {
+class InternalRefreshRangeResponse : public ::google::protobuf::Message {
  public:
-  InternalTimeSeriesData();
-  virtual ~InternalTimeSeriesData();
+  InternalRefreshRangeResponse();
+  virtual ~InternalRefreshRangeResponse();
 
-  InternalTimeSeriesData(const InternalTimeSeriesData& from);
+  InternalRefreshRangeResponse(const InternalRefreshRangeResponse& from);
 
-  inline InternalTimeSeriesData& operator=(const InternalTimeSeriesData& from) {
+  inline InternalRefreshRangeResponse& operator=(const InternalRefreshRangeResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2396,17 +2101,17 @@ class InternalTimeSeriesData : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalTimeSeriesData& default_instance();
+  static const InternalRefreshRangeResponse& default_instance();
 
-  void Swap(InternalTimeSeriesData* other);
+  void Swap(InternalRefreshRangeResponse* other);
 
   // implements Message ----------------------------------------------
 
-  InternalTimeSeriesData* New() const;
+  InternalRefreshRangeResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalTimeSeriesData& from);
-  void MergeFrom(const InternalTimeSeriesData& from);
+  void CopyFrom(const InternalRefreshRangeResponse& from);
+  void MergeFrom(const InternalRefreshRangeResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2428,63 +2133,42 @@ class InternalTimeSeriesData : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 start_timestamp_nanos = 1;
-  inline bool has_start_timestamp_nanos() const;
-  inline void clear_start_timestamp_nanos();
-  static const int kStartTimestampNanosFieldNumber = 1;
-  inline ::google::protobuf::int64 start_timestamp_nanos() const;
-  inline void set_start_timestamp_nanos(::google::protobuf::int64 value);
-
-  // optional int64 sample_duration_nanos = 2;
-  inline bool has_sample_duration_nanos() const;
-  inline void clear_sample_duration_nanos();
-  static const int kSampleDurationNanosFieldNumber = 2;
-  inline ::google::protobuf::int64 sample_duration_nanos() const;
-  inline void set_sample_duration_nanos(::google::protobuf::int64 value);
-
-  // repeated .cockroach.proto.InternalTimeSeriesSample samples = 3;
-  inline int samples_size() const;
-  inline void clear_samples();
-  static const int kSamplesFieldNumber = 3;
-  inline const ::cockroach::proto::InternalTimeSeriesSample& samples(int index) const;
-  inline ::cockroach::proto::InternalTimeSeriesSample* mutable_samples(int index);
-  inline ::cockroach::proto::InternalTimeSeriesSample* add_samples();
-  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalTimeSeriesSample >&
-      samples() const;
-  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalTimeSeriesSample >*
-      mutable_samples();
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalTimeSeriesData)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRefreshRangeResponse)
  private:
-  inline void set_has_start_timestamp_nanos();
-  inline void clear_has_start_timestamp_nanos();
-  inline void set_has_sample_duration_nanos();
-  inline void clear_has_sample_duration_nanos();
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 start_timestamp_nanos_;
-  ::google::protobuf::int64 sample_duration_nanos_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalTimeSeriesSample > samples_;
+  ::cockroach::proto::ResponseHeader* header_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalTimeSeriesData* default_instance_;
+  static InternalRefreshRangeResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalTimeSeriesSample : public ::google::protobuf::Message {
+class ReadWriteCmdResponse : public ::google::protobuf::Message {
  public:
-  InternalTimeSeriesSample();
-  virtual ~InternalTimeSeriesSample();
+  ReadWriteCmdResponse();
+  virtual ~ReadWriteCmdResponse();
 
-  InternalTimeSeriesSample(const InternalTimeSeriesSample& from);
+  ReadWriteCmdResponse(const ReadWriteCmdResponse& from);
 
-  inline InternalTimeSeriesSample& operator=(const InternalTimeSeriesSample& from) {
+  inline ReadWriteCmdResponse& operator=(const ReadWriteCmdResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2498,17 +2182,36 @@ class InternalTimeSeriesSample : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalTimeSeriesSample& default_instance();
+  static const ReadWriteCmdResponse& default_instance();
 
-  void Swap(InternalTimeSeriesSample* other);
+  enum ValueCase {
+    kPut = 1,
+    kConditionalPut = 2,
+    kIncrement = 3,
+    kDelete = 4,
+    kDeleteRange = 5,
+    kEndTransaction = 6,
+    kInitPut = 7,
+    kInternalHeartbeatTxn = 10,
+    kInternalPushTxn = 11,
+    kInternalResolveIntent = 12,
+    kInternalMerge = 13,
+    kInternalTruncateLog = 14,
+    kInternalGc = 15,
+    kClearRange = 16,
+    kBatch = 17,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(ReadWriteCmdResponse* other);
 
   // implements Message ----------------------------------------------
 
-  InternalTimeSeriesSample* New() const;
+  ReadWriteCmdResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalTimeSeriesSample& from);
-  void MergeFrom(const InternalTimeSeriesSample& from);
+  void CopyFrom(const ReadWriteCmdResponse& from);
+  void MergeFrom(const ReadWriteCmdResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2530,120 +2233,204 @@ class InternalTimeSeriesSample : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int32 offset = 1;
-  inline bool has_offset() const;
-  inline void clear_offset();
-  static const int kOffsetFieldNumber = 1;
-  inline ::google::protobuf::int32 offset() const;
-  inline void set_offset(::google::protobuf::int32 value);
+  // optional .cockroach.proto.PutResponse put = 1;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 1;
+  inline const ::cockroach::proto::PutResponse& put() const;
+  inline ::cockroach::proto::PutResponse* mutable_put();
+  inline ::cockroach::proto::PutResponse* release_put();
+  inline void set_allocated_put(::cockroach::proto::PutResponse* put);
 
-  // optional uint32 int_count = 2;
-  inline bool has_int_count() const;
-  inline void clear_int_count();
-  static const int kIntCountFieldNumber = 2;
-  inline ::google::protobuf::uint32 int_count() const;
-  inline void set_int_count(::google::protobuf::uint32 value);
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 2;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 2;
+  inline const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
+  inline ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
+  inline ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
+  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
 
-  // optional int64 int_sum = 3;
-  inline bool has_int_sum() const;
-  inline void clear_int_sum();
-  static const int kIntSumFieldNumber = 3;
-  inline ::google::protobuf::int64 int_sum() const;
-  inline void set_int_sum(::google::protobuf::int64 value);
+  // optional .cockroach.proto.IncrementResponse increment = 3;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 3;
+  inline const ::cockroach::proto::IncrementResponse& increment() const;
+  inline ::cockroach::proto::IncrementResponse* mutable_increment();
+  inline ::cockroach::proto::IncrementResponse* release_increment();
+  inline void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
 
-  // optional int64 int_max = 4;
-  inline bool has_int_max() const;
-  inline void clear_int_max();
-  static const int kIntMaxFieldNumber = 4;
-  inline ::google::protobuf::int64 int_max() const;
-  inline void set_int_max(::google::protobuf::int64 value);
+  // optional .cockroach.proto.DeleteResponse delete = 4;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 4;
+  inline const ::cockroach::proto::DeleteResponse& delete_() const;
+  inline ::cockroach::proto::DeleteResponse* mutable_delete_();
+  inline ::cockroach::proto::DeleteResponse* release_delete_();
+  inline void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
 
-  // optional int64 int_min = 5;
-  inline bool has_int_min() const;
-  inline void clear_int_min();
-  static const int kIntMinFieldNumber = 5;
-  inline ::google::protobuf::int64 int_min() const;
-  inline void set_int_min(::google::protobuf::int64 value);
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 5;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 5;
+  inline const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
+  inline ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
+  inline ::cockroach::proto::DeleteRangeResponse* release_delete_range();
+  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
 
-  // optional uint32 float_count = 6;
-  inline bool has_float_count() const;
-  inline void clear_float_count();
-  static const int kFloatCountFieldNumber = 6;
-  inline ::google::protobuf::uint32 float_count() const;
-  inline void set_float_count(::google::protobuf::uint32 value);
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 6;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 6;
+  inline const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
+  inline ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
+  inline ::cockroach::proto::EndTransactionResponse* release_end_transaction();
+  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
 
-  // optional float float_sum = 7;
-  inline bool has_float_sum() const;
-  inline void clear_float_sum();
-  static const int kFloatSumFieldNumber = 7;
-  inline float float_sum() const;
-  inline void set_float_sum(float value);
+  // optional .cockroach.proto.InitPutResponse init_put = 7;
+  inline bool has_init_put() const;
+  inline void clear_init_put();
+  static const int kInitPutFieldNumber = 7;
+  inline const ::cockroach::proto::InitPutResponse& init_put() const;
+  inline ::cockroach::proto::InitPutResponse* mutable_init_put();
+  inline ::cockroach::proto::InitPutResponse* release_init_put();
+  inline void set_allocated_init_put(::cockroach::proto::InitPutResponse* init_put);
 
-  // optional float float_max = 8;
-  inline bool has_float_max() const;
-  inline void clear_float_max();
-  static const int kFloatMaxFieldNumber = 8;
-  inline float float_max() const;
-  inline void set_float_max(float value);
+  // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+  inline bool has_internal_heartbeat_txn() const;
+  inline void clear_internal_heartbeat_txn();
+  static const int kInternalHeartbeatTxnFieldNumber = 10;
+  inline const ::cockroach::proto::InternalHeartbeatTxnResponse& internal_heartbeat_txn() const;
+  inline ::cockroach::proto::InternalHeartbeatTxnResponse* mutable_internal_heartbeat_txn();
+  inline ::cockroach::proto::InternalHeartbeatTxnResponse* release_internal_heartbeat_txn();
+  inline void set_allocated_internal_heartbeat_txn(::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn);
 
-  // optional float float_min = 9;
-  inline bool has_float_min() const;
-  inline void clear_float_min();
-  static const int kFloatMinFieldNumber = 9;
-  inline float float_min() const;
-  inline void set_float_min(float value);
+  // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 11;
+  inline bool has_internal_push_txn() const;
+  inline void clear_internal_push_txn();
+  static const int kInternalPushTxnFieldNumber = 11;
+  inline const ::cockroach::proto::InternalPushTxnResponse& internal_push_txn() const;
+  inline ::cockroach::proto::InternalPushTxnResponse* mutable_internal_push_txn();
+  inline ::cockroach::proto::InternalPushTxnResponse* release_internal_push_txn();
+  inline void set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnResponse* internal_push_txn);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalTimeSeriesSample)
- private:
-  inline void set_has_offset();
-  inline void clear_has_offset();
-  inline void set_has_int_count();
-  inline void clear_has_int_count();
-  inline void set_has_int_sum();
-  inline void clear_has_int_sum();
-  inline void set_has_int_max();
-  inline void clear_has_int_max();
-  inline void set_has_int_min();
-  inline void clear_has_int_min();
-  inline void set_has_float_count();
-  inline void clear_has_float_count();
-  inline void set_has_float_sum();
-  inline void clear_has_float_sum();
-  inline void set_has_float_max();
-  inline void clear_has_float_max();
-  inline void set_has_float_min();
-  inline void clear_has_float_min();
+  // optional .cockroach.proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+  inline bool has_internal_resolve_intent() const;
+  inline void clear_internal_resolve_intent();
+  static const int kInternalResolveIntentFieldNumber = 12;
+  inline const ::cockroach::proto::InternalResolveIntentResponse& internal_resolve_intent() const;
+  inline ::cockroach::proto::InternalResolveIntentResponse* mutable_internal_resolve_intent();
+  inline ::cockroach::proto::InternalResolveIntentResponse* release_internal_resolve_intent();
+  inline void set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent);
+
+  // optional .cockroach.proto.InternalMergeResponse internal_merge = 13;
+  inline bool has_internal_merge() const;
+  inline void clear_internal_merge();
+  static const int kInternalMergeFieldNumber = 13;
+  inline const ::cockroach::proto::InternalMergeResponse& internal_merge() const;
+  inline ::cockroach::proto::InternalMergeResponse* mutable_internal_merge();
+  inline ::cockroach::proto::InternalMergeResponse* release_internal_merge();
+  inline void set_allocated_internal_merge(::cockroach::proto::InternalMergeResponse* internal_merge);
+
+  // optional .cockroach.proto.InternalTruncateLogResponse internal_truncate_log = 14;
+  inline bool has_internal_truncate_log() const;
+  inline void clear_internal_truncate_log();
+  static const int kInternalTruncateLogFieldNumber = 14;
+  inline const ::cockroach::proto::InternalTruncateLogResponse& internal_truncate_log() const;
+  inline ::cockroach::proto::InternalTruncateLogResponse* mutable_internal_truncate_log();
+  inline ::cockroach::proto::InternalTruncateLogResponse* release_internal_truncate_log();
+  inline void set_allocated_internal_truncate_log(::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log);
+
+  // optional .cockroach.proto.InternalGCResponse internal_gc = 15;
+  inline bool has_internal_gc() const;
+  inline void clear_internal_gc();
+  static const int kInternalGcFieldNumber = 15;
+  inline const ::cockroach::proto::InternalGCResponse& internal_gc() const;
+  inline ::cockroach::proto::InternalGCResponse* mutable_internal_gc();
+  inline ::cockroach::proto::InternalGCResponse* release_internal_gc();
+  inline void set_allocated_internal_gc(::cockroach::proto::InternalGCResponse* internal_gc);
+
+  // optional .cockroach.proto.ClearRangeResponse clear_range = 16;
+  inline bool has_clear_range() const;
+  inline void clear_clear_range();
+  static const int kClearRangeFieldNumber = 16;
+  inline const ::cockroach::proto::ClearRangeResponse& clear_range() const;
+  inline ::cockroach::proto::ClearRangeResponse* mutable_clear_range();
+  inline ::cockroach::proto::ClearRangeResponse* release_clear_range();
+  inline void set_allocated_clear_range(::cockroach::proto::ClearRangeResponse* clear_range);
+
+  // optional .cockroach.proto.BatchResponse batch = 17;
+  inline bool has_batch() const;
+  inline void clear_batch();
+  static const int kBatchFieldNumber = 17;
+  inline const ::cockroach::proto::BatchResponse& batch() const;
+  inline ::cockroach::proto::BatchResponse* mutable_batch();
+  inline ::cockroach::proto::BatchResponse* release_batch();
+  inline void set_allocated_batch(::cockroach::proto::BatchResponse* batch);
+
+  inline ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReadWriteCmdResponse)
+ private:
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_end_transaction();
+  inline void set_has_init_put();
+  inline void set_has_internal_heartbeat_txn();
+  inline void set_has_internal_push_txn();
+  inline void set_has_internal_resolve_intent();
+  inline void set_has_internal_merge();
+  inline void set_has_internal_truncate_log();
+  inline void set_has_internal_gc();
+  inline void set_has_clear_range();
+  inline void set_has_batch();
+
+  inline bool has_value();
+  void clear_value();
+  inline void clear_has_value();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int32 offset_;
-  ::google::protobuf::uint32 int_count_;
-  ::google::protobuf::int64 int_sum_;
-  ::google::protobuf::int64 int_max_;
-  ::google::protobuf::int64 int_min_;
-  ::google::protobuf::uint32 float_count_;
-  float float_sum_;
-  float float_max_;
-  float float_min_;
+  union ValueUnion {
+    ::cockroach::proto::PutResponse* put_;
+    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
+    ::cockroach::proto::IncrementResponse* increment_;
+    ::cockroach::proto::DeleteResponse* delete__;
+    ::cockroach::proto::DeleteRangeResponse* delete_range_;
+    ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::InitPutResponse* init_put_;
+    ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
+    ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
+    ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
+    ::cockroach::proto::InternalMergeResponse* internal_merge_;
+    ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
+    ::cockroach::proto::InternalGCResponse* internal_gc_;
+    ::cockroach::proto::ClearRangeResponse* clear_range_;
+    ::cockroach::proto::BatchResponse* batch_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalTimeSeriesSample* default_instance_;
+  static ReadWriteCmdResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class RaftTruncatedState : public ::google::protobuf::Message {
+class InternalRaftCommandUnion : public ::google::protobuf::Message {
  public:
-  RaftTruncatedState();
-  virtual ~RaftTruncatedState();
+  InternalRaftCommandUnion();
+  virtual ~InternalRaftCommandUnion();
 
-  RaftTruncatedState(const RaftTruncatedState& from);
+  InternalRaftCommandUnion(const InternalRaftCommandUnion& from);
 
-  inline RaftTruncatedState& operator=(const RaftTruncatedState& from) {
+  inline InternalRaftCommandUnion& operator=(const InternalRaftCommandUnion& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2657,17 +2444,45 @@ class RaftTruncatedState : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RaftTruncatedState& default_instance();
+  static const InternalRaftCommandUnion& default_instance();
 
-  void Swap(RaftTruncatedState* other);
+  enum ValueCase {
+    kContains = 1,
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kInitPut = 10,
+    kAggregate = 11,
+    kBatch = 30,
+    kInternalRangeLookup = 31,
+    kInternalHeartbeatTxn = 32,
+    kInternalPushTxn = 33,
+    kInternalResolveIntent = 34,
+    kInternalMergeResponse = 35,
+    kInternalTruncateLog = 36,
+    kInternalGc = 37,
+    kInternalLease = 38,
+    kInternalCheckConsistency = 39,
+    kInternalRecomputeStats = 40,
+    kClearRange = 41,
+    kInternalRefreshRange = 42,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(InternalRaftCommandUnion* other);
 
   // implements Message ----------------------------------------------
 
-  RaftTruncatedState* New() const;
+  InternalRaftCommandUnion* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RaftTruncatedState& from);
-  void MergeFrom(const RaftTruncatedState& from);
+  void CopyFrom(const InternalRaftCommandUnion& from);
+  void MergeFrom(const InternalRaftCommandUnion& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2689,74 +2504,327 @@ class RaftTruncatedState : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional uint64 index = 1;
-  inline bool has_index() const;
-  inline void clear_index();
-  static const int kIndexFieldNumber = 1;
-  inline ::google::protobuf::uint64 index() const;
-  inline void set_index(::google::protobuf::uint64 value);
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::cockroach::proto::ContainsRequest& contains() const;
+  inline ::cockroach::proto::ContainsRequest* mutable_contains();
+  inline ::cockroach::proto::ContainsRequest* release_contains();
+  inline void set_allocated_contains(::cockroach::proto::ContainsRequest* contains);
 
-  // optional uint64 term = 2;
-  inline bool has_term() const;
-  inline void clear_term();
-  static const int kTermFieldNumber = 2;
-  inline ::google::protobuf::uint64 term() const;
-  inline void set_term(::google::protobuf::uint64 value);
+  // optional .cockroach.proto.GetRequest get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 2;
+  inline const ::cockroach::proto::GetRequest& get() const;
+  inline ::cockroach::proto::GetRequest* mutable_get();
+  inline ::cockroach::proto::GetRequest* release_get();
+  inline void set_allocated_get(::cockroach::proto::GetRequest* get);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftTruncatedState)
- private:
-  inline void set_has_index();
-  inline void clear_has_index();
-  inline void set_has_term();
-  inline void clear_has_term();
+  // optional .cockroach.proto.PutRequest put = 3;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 3;
+  inline const ::cockroach::proto::PutRequest& put() const;
+  inline ::cockroach::proto::PutRequest* mutable_put();
+  inline ::cockroach::proto::PutRequest* release_put();
+  inline void set_allocated_put(::cockroach::proto::PutRequest* put);
 
-  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  inline const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
+  inline ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
+  inline ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
+  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
 
-  ::google::protobuf::uint32 _has_bits_[1];
-  mutable int _cached_size_;
-  ::google::protobuf::uint64 index_;
-  ::google::protobuf::uint64 term_;
-  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
-  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
-  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  inline const ::cockroach::proto::IncrementRequest& increment() const;
+  inline ::cockroach::proto::IncrementRequest* mutable_increment();
+  inline ::cockroach::proto::IncrementRequest* release_increment();
+  inline void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
 
-  void InitAsDefaultInstance();
-  static RaftTruncatedState* default_instance_;
-};
-// -------------------------------------------------------------------
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  inline const ::cockroach::proto::DeleteRequest& delete_() const;
+  inline ::cockroach::proto::DeleteRequest* mutable_delete_();
+  inline ::cockroach::proto::DeleteRequest* release_delete_();
+  inline void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
 
-class RaftSnapshotData_KeyValue : public ::google::protobuf::Message {
- public:
-  RaftSnapshotData_KeyValue();
-  virtual ~RaftSnapshotData_KeyValue();
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  inline const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
+  inline ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
+  inline ::cockroach::proto::DeleteRangeRequest* release_delete_range();
+  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
 
-  RaftSnapshotData_KeyValue(const RaftSnapshotData_KeyValue& from);
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  inline bool has_scan() const;
+  inline void clear_scan();
+  static const int kScanFieldNumber = 8;
+  inline const ::cockroach::proto::ScanRequest& scan() const;
+  inline ::cockroach::proto::ScanRequest* mutable_scan();
+  inline ::cockroach::proto::ScanRequest* release_scan();
+  inline void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
 
-  inline RaftSnapshotData_KeyValue& operator=(const RaftSnapshotData_KeyValue& from) {
-    CopyFrom(from);
-    return *this;
-  }
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  inline const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
+  inline ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
+  inline ::cockroach::proto::EndTransactionRequest* release_end_transaction();
+  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
 
-  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
-    return _unknown_fields_;
-  }
+  // optional .cockroach.proto.InitPutRequest init_put = 10;
+  inline bool has_init_put() const;
+  inline void clear_init_put();
+  static const int kInitPutFieldNumber = 10;
+  inline const ::cockroach::proto::InitPutRequest& init_put() const;
+  inline ::cockroach::proto::InitPutRequest* mutable_init_put();
+  inline ::cockroach::proto::InitPutRequest* release_init_put();
+  inline void set_allocated_init_put(::cockroach::proto::InitPutRequest* init_put);
+
+  // optional .cockroach.proto.AggregateRequest aggregate = 11;
+  inline bool has_aggregate() const;
+  inline void clear_aggregate();
+  static const int kAggregateFieldNumber = 11;
+  inline const ::cockroach::proto::AggregateRequest& aggregate() const;
+  inline ::cockroach::proto::AggregateRequest* mutable_aggregate();
+  inline ::cockroach::proto::AggregateRequest* release_aggregate();
+  inline void set_allocated_aggregate(::cockroach::proto::AggregateRequest* aggregate);
 
-  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
-    return &_unknown_fields_;
-  }
+  // optional .cockroach.proto.BatchRequest batch = 30;
+  inline bool has_batch() const;
+  inline void clear_batch();
+  static const int kBatchFieldNumber = 30;
+  inline const ::cockroach::proto::BatchRequest& batch() const;
+  inline ::cockroach::proto::BatchRequest* mutable_batch();
+  inline ::cockroach::proto::BatchRequest* release_batch();
+  inline void set_allocated_batch(::cockroach::proto::BatchRequest* batch);
+
+  // optional .cockroach.proto.InternalRangeLookupRequest internal_range_lookup = 31;
+  inline bool has_internal_range_lookup() const;
+  inline void clear_internal_range_lookup();
+  static const int kInternalRangeLookupFieldNumber = 31;
+  inline const ::cockroach::proto::InternalRangeLookupRequest& internal_range_lookup() const;
+  inline ::cockroach::proto::InternalRangeLookupRequest* mutable_internal_range_lookup();
+  inline ::cockroach::proto::InternalRangeLookupRequest* release_internal_range_lookup();
+  inline void set_allocated_internal_range_lookup(::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup);
+
+  // optional .cockroach.proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
+  inline bool has_internal_heartbeat_txn() const;
+  inline void clear_internal_heartbeat_txn();
+  static const int kInternalHeartbeatTxnFieldNumber = 32;
+  inline const ::cockroach::proto::InternalHeartbeatTxnRequest& internal_heartbeat_txn() const;
+  inline ::cockroach::proto::InternalHeartbeatTxnRequest* mutable_internal_heartbeat_txn();
+  inline ::cockroach::proto::InternalHeartbeatTxnRequest* release_internal_heartbeat_txn();
+  inline void set_allocated_internal_heartbeat_txn(::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn);
+
+  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 33;
+  inline bool has_internal_push_txn() const;
+  inline void clear_internal_push_txn();
+  static const int kInternalPushTxnFieldNumber = 33;
+  inline const ::cockroach::proto::InternalPushTxnRequest& internal_push_txn() const;
+  inline ::cockroach::proto::InternalPushTxnRequest* mutable_internal_push_txn();
+  inline ::cockroach::proto::InternalPushTxnRequest* release_internal_push_txn();
+  inline void set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnRequest* internal_push_txn);
+
+  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+  inline bool has_internal_resolve_intent() const;
+  inline void clear_internal_resolve_intent();
+  static const int kInternalResolveIntentFieldNumber = 34;
+  inline const ::cockroach::proto::InternalResolveIntentRequest& internal_resolve_intent() const;
+  inline ::cockroach::proto::InternalResolveIntentRequest* mutable_internal_resolve_intent();
+  inline ::cockroach::proto::InternalResolveIntentRequest* release_internal_resolve_intent();
+  inline void set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent);
+
+  // optional .cockroach.proto.InternalMergeRequest internal_merge_response = 35;
+  inline bool has_internal_merge_response() const;
+  inline void clear_internal_merge_response();
+  static const int kInternalMergeResponseFieldNumber = 35;
+  inline const ::cockroach::proto::InternalMergeRequest& internal_merge_response() const;
+  inline ::cockroach::proto::InternalMergeRequest* mutable_internal_merge_response();
+  inline ::cockroach::proto::InternalMergeRequest* release_internal_merge_response();
+  inline void set_allocated_internal_merge_response(::cockroach::proto::InternalMergeRequest* internal_merge_response);
+
+  // optional .cockroach.proto.InternalTruncateLogRequest internal_truncate_log = 36;
+  inline bool has_internal_truncate_log() const;
+  inline void clear_internal_truncate_log();
+  static const int kInternalTruncateLogFieldNumber = 36;
+  inline const ::cockroach::proto::InternalTruncateLogRequest& internal_truncate_log() const;
+  inline ::cockroach::proto::InternalTruncateLogRequest* mutable_internal_truncate_log();
+  inline ::cockroach::proto::InternalTruncateLogRequest* release_internal_truncate_log();
+  inline void set_allocated_internal_truncate_log(::cockroach::proto::InternalTruncateLogRequest* internal_truncate_log);
+
+  // optional .cockroach.proto.InternalGCRequest internal_gc = 37;
+  inline bool has_internal_gc() const;
+  inline void clear_internal_gc();
+  static const int kInternalGcFieldNumber = 37;
+  inline const ::cockroach::proto::InternalGCRequest& internal_gc() const;
+  inline ::cockroach::proto::InternalGCRequest* mutable_internal_gc();
+  inline ::cockroach::proto::InternalGCRequest* release_internal_gc();
+  inline void set_allocated_internal_gc(::cockroach::proto::InternalGCRequest* internal_gc);
+
+  // optional .cockroach.proto.InternalLeaderLeaseRequest internal_lease = 38;
+  inline bool has_internal_lease() const;
+  inline void clear_internal_lease();
+  static const int kInternalLeaseFieldNumber = 38;
+  inline const ::cockroach::proto::InternalLeaderLeaseRequest& internal_lease() const;
+  inline ::cockroach::proto::InternalLeaderLeaseRequest* mutable_internal_lease();
+  inline ::cockroach::proto::InternalLeaderLeaseRequest* release_internal_lease();
+  inline void set_allocated_internal_lease(::cockroach::proto::InternalLeaderLeaseRequest* internal_lease);
+
+  // optional .cockroach.proto.InternalCheckConsistencyRequest internal_check_consistency = 39;
+  inline bool has_internal_check_consistency() const;
+  inline void clear_internal_check_consistency();
+  static const int kInternalCheckConsistencyFieldNumber = 39;
+  inline const ::cockroach::proto::InternalCheckConsistencyRequest& internal_check_consistency() const;
+  inline ::cockroach::proto::InternalCheckConsistencyRequest* mutable_internal_check_consistency();
+  inline ::cockroach::proto::InternalCheckConsistencyRequest* release_internal_check_consistency();
+  inline void set_allocated_internal_check_consistency(::cockroach::proto::InternalCheckConsistencyRequest* internal_check_consistency);
+
+  // optional .cockroach.proto.InternalRecomputeStatsRequest internal_recompute_stats = 40;
+  inline bool has_internal_recompute_stats() const;
+  inline void clear_internal_recompute_stats();
+  static const int kInternalRecomputeStatsFieldNumber = 40;
+  inline const ::cockroach::proto::InternalRecomputeStatsRequest& internal_recompute_stats() const;
+  inline ::cockroach::proto::InternalRecomputeStatsRequest* mutable_internal_recompute_stats();
+  inline ::cockroach::proto::InternalRecomputeStatsRequest* release_internal_recompute_stats();
+  inline void set_allocated_internal_recompute_stats(::cockroach::proto::InternalRecomputeStatsRequest* internal_recompute_stats);
+
+  // optional .cockroach.proto.ClearRangeRequest clear_range = 41;
+  inline bool has_clear_range() const;
+  inline void clear_clear_range();
+  static const int kClearRangeFieldNumber = 41;
+  inline const ::cockroach::proto::ClearRangeRequest& clear_range() const;
+  inline ::cockroach::proto::ClearRangeRequest* mutable_clear_range();
+  inline ::cockroach::proto::ClearRangeRequest* release_clear_range();
+  inline void set_allocated_clear_range(::cockroach::proto::ClearRangeRequest* clear_range);
+
+  // optional .cockroach.proto.InternalRefreshRangeRequest internal_refresh_range = 42;
+  inline bool has_internal_refresh_range() const;
+  inline void clear_internal_refresh_range();
+  static const int kInternalRefreshRangeFieldNumber = 42;
+  inline const ::cockroach::proto::InternalRefreshRangeRequest& internal_refresh_range() const;
+  inline ::cockroach::proto::InternalRefreshRangeRequest* mutable_internal_refresh_range();
+  inline ::cockroach::proto::InternalRefreshRangeRequest* release_internal_refresh_range();
+  inline void set_allocated_internal_refresh_range(::cockroach::proto::InternalRefreshRangeRequest* internal_refresh_range);
+
+  inline ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRaftCommandUnion)
+ private:
+  inline void set_has_contains();
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_init_put();
+  inline void set_has_aggregate();
+  inline void set_has_batch();
+  inline void set_has_internal_range_lookup();
+  inline void set_has_internal_heartbeat_txn();
+  inline void set_has_internal_push_txn();
+  inline void set_has_internal_resolve_intent();
+  inline void set_has_internal_merge_response();
+  inline void set_has_internal_truncate_log();
+  inline void set_has_internal_gc();
+  inline void set_has_internal_lease();
+  inline void set_has_internal_check_consistency();
+  inline void set_has_internal_recompute_stats();
+  inline void set_has_clear_range();
+  inline void set_has_internal_refresh_range();
+
+  inline bool has_value();
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ::cockroach::proto::ContainsRequest* contains_;
+    ::cockroach::proto::GetRequest* get_;
+    ::cockroach::proto::PutRequest* put_;
+    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
+    ::cockroach::proto::IncrementRequest* increment_;
+    ::cockroach::proto::DeleteRequest* delete__;
+    ::cockroach::proto::DeleteRangeRequest* delete_range_;
+    ::cockroach::proto::ScanRequest* scan_;
+    ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::InitPutRequest* init_put_;
+    ::cockroach::proto::AggregateRequest* aggregate_;
+    ::cockroach::proto::BatchRequest* batch_;
+    ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
+    ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
+    ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
+    ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
+    ::cockroach::proto::InternalMergeRequest* internal_merge_response_;
+    ::cockroach::proto::InternalTruncateLogRequest* internal_truncate_log_;
+    ::cockroach::proto::InternalGCRequest* internal_gc_;
+    ::cockroach::proto::InternalLeaderLeaseRequest* internal_lease_;
+    ::cockroach::proto::InternalCheckConsistencyRequest* internal_check_consistency_;
+    ::cockroach::proto::InternalRecomputeStatsRequest* internal_recompute_stats_;
+    ::cockroach::proto::ClearRangeRequest* clear_range_;
+    ::cockroach::proto::InternalRefreshRangeRequest* internal_refresh_range_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalRaftCommandUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalRaftCommand : public ::google::protobuf::Message {
+ public:
+  InternalRaftCommand();
+  virtual ~InternalRaftCommand();
+
+  InternalRaftCommand(const InternalRaftCommand& from);
+
+  inline InternalRaftCommand& operator=(const InternalRaftCommand& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RaftSnapshotData_KeyValue& default_instance();
+  static const InternalRaftCommand& default_instance();
 
-  void Swap(RaftSnapshotData_KeyValue* other);
+  void Swap(InternalRaftCommand* other);
 
   // implements Message ----------------------------------------------
 
-  RaftSnapshotData_KeyValue* New() const;
+  InternalRaftCommand* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RaftSnapshotData_KeyValue& from);
-  void MergeFrom(const RaftSnapshotData_KeyValue& from);
+  void CopyFrom(const InternalRaftCommand& from);
+  void MergeFrom(const InternalRaftCommand& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2778,60 +2846,86 @@ class RaftSnapshotData_KeyValue : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional bytes key = 1;
-  inline bool has_key() const;
-  inline void clear_key();
-  static const int kKeyFieldNumber = 1;
-  inline const ::std::string& key() const;
-  inline void set_key(const ::std::string& value);
-  inline void set_key(const char* value);
-  inline void set_key(const void* value, size_t size);
-  inline ::std::string* mutable_key();
-  inline ::std::string* release_key();
-  inline void set_allocated_key(::std::string* key);
+  // optional int64 raft_id = 2;
+  inline bool has_raft_id() const;
+  inline void clear_raft_id();
+  static const int kRaftIdFieldNumber = 2;
+  inline ::google::protobuf::int64 raft_id() const;
+  inline void set_raft_id(::google::protobuf::int64 value);
 
-  // optional bytes value = 2;
-  inline bool has_value() const;
-  inline void clear_value();
-  static const int kValueFieldNumber = 2;
-  inline const ::std::string& value() const;
-  inline void set_value(const ::std::string& value);
-  inline void set_value(const char* value);
-  inline void set_value(const void* value, size_t size);
-  inline ::std::string* mutable_value();
-  inline ::std::string* release_value();
-  inline void set_allocated_value(::std::string* value);
+  // optional .cockroach.proto.InternalRaftCommandUnion cmd = 3;
+  inline bool has_cmd() const;
+  inline void clear_cmd();
+  static const int kCmdFieldNumber = 3;
+  inline const ::cockroach::proto::InternalRaftCommandUnion& cmd() const;
+  inline ::cockroach::proto::InternalRaftCommandUnion* mutable_cmd();
+  inline ::cockroach::proto::InternalRaftCommandUnion* release_cmd();
+  inline void set_allocated_cmd(::cockroach::proto::InternalRaftCommandUnion* cmd);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftSnapshotData.KeyValue)
+  // optional .cockroach.proto.Timestamp closed_timestamp = 4;
+  inline bool has_closed_timestamp() const;
+  inline void clear_closed_timestamp();
+  static const int kClosedTimestampFieldNumber = 4;
+  inline const ::cockroach::proto::Timestamp& closed_timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_closed_timestamp();
+  inline ::cockroach::proto::Timestamp* release_closed_timestamp();
+  inline void set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp);
+
+  // optional uint64 max_lease_index = 5;
+  inline bool has_max_lease_index() const;
+  inline void clear_max_lease_index();
+  static const int kMaxLeaseIndexFieldNumber = 5;
+  inline ::google::protobuf::uint64 max_lease_index() const;
+  inline void set_max_lease_index(::google::protobuf::uint64 value);
+
+  // optional .cockroach.proto.Lease proposer_lease = 6;
+  inline bool has_proposer_lease() const;
+  inline void clear_proposer_lease();
+  static const int kProposerLeaseFieldNumber = 6;
+  inline const ::cockroach::proto::Lease& proposer_lease() const;
+  inline ::cockroach::proto::Lease* mutable_proposer_lease();
+  inline ::cockroach::proto::Lease* release_proposer_lease();
+  inline void set_allocated_proposer_lease(::cockroach::proto::Lease* proposer_lease);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRaftCommand)
  private:
-  inline void set_has_key();
-  inline void clear_has_key();
-  inline void set_has_value();
-  inline void clear_has_value();
+  inline void set_has_raft_id();
+  inline void clear_has_raft_id();
+  inline void set_has_cmd();
+  inline void clear_has_cmd();
+  inline void set_has_closed_timestamp();
+  inline void clear_has_closed_timestamp();
+  inline void set_has_max_lease_index();
+  inline void clear_has_max_lease_index();
+  inline void set_has_proposer_lease();
+  inline void clear_has_proposer_lease();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::std::string* key_;
-  ::std::string* value_;
+  ::google::protobuf::int64 raft_id_;
+  ::cockroach::proto::InternalRaftCommandUnion* cmd_;
+  ::cockroach::proto::Timestamp* closed_timestamp_;
+  ::google::protobuf::uint64 max_lease_index_;
+  ::cockroach::proto::Lease* proposer_lease_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static RaftSnapshotData_KeyValue* default_instance_;
+  static InternalRaftCommand* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class RaftSnapshotData : public ::google::protobuf::Message {
+class RaftMessageRequest : public ::google::protobuf::Message {
  public:
-  RaftSnapshotData();
-  virtual ~RaftSnapshotData();
+  RaftMessageRequest();
+  virtual ~RaftMessageRequest();
 
-  RaftSnapshotData(const RaftSnapshotData& from);
+  RaftMessageRequest(const RaftMessageRequest& from);
 
-  inline RaftSnapshotData& operator=(const RaftSnapshotData& from) {
+  inline RaftMessageRequest& operator=(const RaftMessageRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2845,17 +2939,17 @@ class RaftSnapshotData : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RaftSnapshotData& default_instance();
+  static const RaftMessageRequest& default_instance();
 
-  void Swap(RaftSnapshotData* other);
+  void Swap(RaftMessageRequest* other);
 
   // implements Message ----------------------------------------------
 
-  RaftSnapshotData* New() const;
+  RaftMessageRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RaftSnapshotData& from);
-  void MergeFrom(const RaftSnapshotData& from);
+  void CopyFrom(const RaftMessageRequest& from);
+  void MergeFrom(const RaftMessageRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2875,75 +2969,1296 @@ class RaftSnapshotData : public ::google::protobuf::Message {
 
   // nested types ----------------------------------------------------
 
-  typedef RaftSnapshotData_KeyValue KeyValue;
-
   // accessors -------------------------------------------------------
 
-  // repeated .cockroach.proto.RaftSnapshotData.KeyValue KV = 1;
-  inline int kv_size() const;
-  inline void clear_kv();
-  static const int kKVFieldNumber = 1;
-  inline const ::cockroach::proto::RaftSnapshotData_KeyValue& kv(int index) const;
-  inline ::cockroach::proto::RaftSnapshotData_KeyValue* mutable_kv(int index);
-  inline ::cockroach::proto::RaftSnapshotData_KeyValue* add_kv();
-  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RaftSnapshotData_KeyValue >&
-      kv() const;
-  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RaftSnapshotData_KeyValue >*
-      mutable_kv();
+  // optional uint64 group_id = 1;
+  inline bool has_group_id() const;
+  inline void clear_group_id();
+  static const int kGroupIdFieldNumber = 1;
+  inline ::google::protobuf::uint64 group_id() const;
+  inline void set_group_id(::google::protobuf::uint64 value);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftSnapshotData)
+  // optional bytes msg = 2;
+  inline bool has_msg() const;
+  inline void clear_msg();
+  static const int kMsgFieldNumber = 2;
+  inline const ::std::string& msg() const;
+  inline void set_msg(const ::std::string& value);
+  inline void set_msg(const char* value);
+  inline void set_msg(const void* value, size_t size);
+  inline ::std::string* mutable_msg();
+  inline ::std::string* release_msg();
+  inline void set_allocated_msg(::std::string* msg);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftMessageRequest)
  private:
+  inline void set_has_group_id();
+  inline void clear_has_group_id();
+  inline void set_has_msg();
+  inline void clear_has_msg();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RaftSnapshotData_KeyValue > kv_;
+  ::google::protobuf::uint64 group_id_;
+  ::std::string* msg_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static RaftSnapshotData* default_instance_;
+  static RaftMessageRequest* default_instance_;
 };
-// ===================================================================
+// -------------------------------------------------------------------
+
+class ReserveSnapshotRequest : public ::google::protobuf::Message {
+ public:
+  ReserveSnapshotRequest();
+  virtual ~ReserveSnapshotRequest();
+
+  ReserveSnapshotRequest(const ReserveSnapshotRequest& from);
+
+  inline ReserveSnapshotRequest& operator=(const ReserveSnapshotRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ReserveSnapshotRequest& default_instance();
+
+  void Swap(ReserveSnapshotRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  ReserveSnapshotRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ReserveSnapshotRequest& from);
+  void MergeFrom(const ReserveSnapshotRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional uint64 group_id = 1;
+  inline bool has_group_id() const;
+  inline void clear_group_id();
+  static const int kGroupIdFieldNumber = 1;
+  inline ::google::protobuf::uint64 group_id() const;
+  inline void set_group_id(::google::protobuf::uint64 value);
+
+  // optional uint64 node_id = 2;
+  inline bool has_node_id() const;
+  inline void clear_node_id();
+  static const int kNodeIdFieldNumber = 2;
+  inline ::google::protobuf::uint64 node_id() const;
+  inline void set_node_id(::google::protobuf::uint64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReserveSnapshotRequest)
+ private:
+  inline void set_has_group_id();
+  inline void clear_has_group_id();
+  inline void set_has_node_id();
+  inline void clear_has_node_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::uint64 group_id_;
+  ::google::protobuf::uint64 node_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static ReserveSnapshotRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ReserveSnapshotResponse : public ::google::protobuf::Message {
+ public:
+  ReserveSnapshotResponse();
+  virtual ~ReserveSnapshotResponse();
+
+  ReserveSnapshotResponse(const ReserveSnapshotResponse& from);
+
+  inline ReserveSnapshotResponse& operator=(const ReserveSnapshotResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ReserveSnapshotResponse& default_instance();
+
+  void Swap(ReserveSnapshotResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  ReserveSnapshotResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ReserveSnapshotResponse& from);
+  void MergeFrom(const ReserveSnapshotResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bool reserved = 1;
+  inline bool has_reserved() const;
+  inline void clear_reserved();
+  static const int kReservedFieldNumber = 1;
+  inline bool reserved() const;
+  inline void set_reserved(bool value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ReserveSnapshotResponse)
+ private:
+  inline void set_has_reserved();
+  inline void clear_has_reserved();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  bool reserved_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static ReserveSnapshotResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RaftMessageResponse : public ::google::protobuf::Message {
+ public:
+  RaftMessageResponse();
+  virtual ~RaftMessageResponse();
+
+  RaftMessageResponse(const RaftMessageResponse& from);
+
+  inline RaftMessageResponse& operator=(const RaftMessageResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RaftMessageResponse& default_instance();
+
+  void Swap(RaftMessageResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  RaftMessageResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RaftMessageResponse& from);
+  void MergeFrom(const RaftMessageResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftMessageResponse)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static RaftMessageResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalTimeSeriesData : public ::google::protobuf::Message {
+ public:
+  InternalTimeSeriesData();
+  virtual ~InternalTimeSeriesData();
+
+  InternalTimeSeriesData(const InternalTimeSeriesData& from);
+
+  inline InternalTimeSeriesData& operator=(const InternalTimeSeriesData& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalTimeSeriesData& default_instance();
+
+  void Swap(InternalTimeSeriesData* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalTimeSeriesData* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalTimeSeriesData& from);
+  void MergeFrom(const InternalTimeSeriesData& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 start_timestamp_nanos = 1;
+  inline bool has_start_timestamp_nanos() const;
+  inline void clear_start_timestamp_nanos();
+  static const int kStartTimestampNanosFieldNumber = 1;
+  inline ::google::protobuf::int64 start_timestamp_nanos() const;
+  inline void set_start_timestamp_nanos(::google::protobuf::int64 value);
+
+  // optional int64 sample_duration_nanos = 2;
+  inline bool has_sample_duration_nanos() const;
+  inline void clear_sample_duration_nanos();
+  static const int kSampleDurationNanosFieldNumber = 2;
+  inline ::google::protobuf::int64 sample_duration_nanos() const;
+  inline void set_sample_duration_nanos(::google::protobuf::int64 value);
+
+  // repeated .cockroach.proto.InternalTimeSeriesSample samples = 3;
+  inline int samples_size() const;
+  inline void clear_samples();
+  static const int kSamplesFieldNumber = 3;
+  inline const ::cockroach::proto::InternalTimeSeriesSample& samples(int index) const;
+  inline ::cockroach::proto::InternalTimeSeriesSample* mutable_samples(int index);
+  inline ::cockroach::proto::InternalTimeSeriesSample* add_samples();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalTimeSeriesSample >&
+      samples() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalTimeSeriesSample >*
+      mutable_samples();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalTimeSeriesData)
+ private:
+  inline void set_has_start_timestamp_nanos();
+  inline void clear_has_start_timestamp_nanos();
+  inline void set_has_sample_duration_nanos();
+  inline void clear_has_sample_duration_nanos();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 start_timestamp_nanos_;
+  ::google::protobuf::int64 sample_duration_nanos_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalTimeSeriesSample > samples_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalTimeSeriesData* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalTimeSeriesSample : public ::google::protobuf::Message {
+ public:
+  InternalTimeSeriesSample();
+  virtual ~InternalTimeSeriesSample();
+
+  InternalTimeSeriesSample(const InternalTimeSeriesSample& from);
+
+  inline InternalTimeSeriesSample& operator=(const InternalTimeSeriesSample& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalTimeSeriesSample& default_instance();
+
+  void Swap(InternalTimeSeriesSample* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalTimeSeriesSample* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalTimeSeriesSample& from);
+  void MergeFrom(const InternalTimeSeriesSample& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 offset = 1;
+  inline bool has_offset() const;
+  inline void clear_offset();
+  static const int kOffsetFieldNumber = 1;
+  inline ::google::protobuf::int32 offset() const;
+  inline void set_offset(::google::protobuf::int32 value);
+
+  // optional uint32 int_count = 2;
+  inline bool has_int_count() const;
+  inline void clear_int_count();
+  static const int kIntCountFieldNumber = 2;
+  inline ::google::protobuf::uint32 int_count() const;
+  inline void set_int_count(::google::protobuf::uint32 value);
+
+  // optional int64 int_sum = 3;
+  inline bool has_int_sum() const;
+  inline void clear_int_sum();
+  static const int kIntSumFieldNumber = 3;
+  inline ::google::protobuf::int64 int_sum() const;
+  inline void set_int_sum(::google::protobuf::int64 value);
+
+  // optional int64 int_max = 4;
+  inline bool has_int_max() const;
+  inline void clear_int_max();
+  static const int kIntMaxFieldNumber = 4;
+  inline ::google::protobuf::int64 int_max() const;
+  inline void set_int_max(::google::protobuf::int64 value);
+
+  // optional int64 int_min = 5;
+  inline bool has_int_min() const;
+  inline void clear_int_min();
+  static const int kIntMinFieldNumber = 5;
+  inline ::google::protobuf::int64 int_min() const;
+  inline void set_int_min(::google::protobuf::int64 value);
+
+  // optional uint32 float_count = 6;
+  inline bool has_float_count() const;
+  inline void clear_float_count();
+  static const int kFloatCountFieldNumber = 6;
+  inline ::google::protobuf::uint32 float_count() const;
+  inline void set_float_count(::google::protobuf::uint32 value);
+
+  // optional float float_sum = 7;
+  inline bool has_float_sum() const;
+  inline void clear_float_sum();
+  static const int kFloatSumFieldNumber = 7;
+  inline float float_sum() const;
+  inline void set_float_sum(float value);
+
+  // optional float float_max = 8;
+  inline bool has_float_max() const;
+  inline void clear_float_max();
+  static const int kFloatMaxFieldNumber = 8;
+  inline float float_max() const;
+  inline void set_float_max(float value);
+
+  // optional float float_min = 9;
+  inline bool has_float_min() const;
+  inline void clear_float_min();
+  static const int kFloatMinFieldNumber = 9;
+  inline float float_min() const;
+  inline void set_float_min(float value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalTimeSeriesSample)
+ private:
+  inline void set_has_offset();
+  inline void clear_has_offset();
+  inline void set_has_int_count();
+  inline void clear_has_int_count();
+  inline void set_has_int_sum();
+  inline void clear_has_int_sum();
+  inline void set_has_int_max();
+  inline void clear_has_int_max();
+  inline void set_has_int_min();
+  inline void clear_has_int_min();
+  inline void set_has_float_count();
+  inline void clear_has_float_count();
+  inline void set_has_float_sum();
+  inline void clear_has_float_sum();
+  inline void set_has_float_max();
+  inline void clear_has_float_max();
+  inline void set_has_float_min();
+  inline void clear_has_float_min();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int32 offset_;
+  ::google::protobuf::uint32 int_count_;
+  ::google::protobuf::int64 int_sum_;
+  ::google::protobuf::int64 int_max_;
+  ::google::protobuf::int64 int_min_;
+  ::google::protobuf::uint32 float_count_;
+  float float_sum_;
+  float float_max_;
+  float float_min_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalTimeSeriesSample* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RaftTruncatedState : public ::google::protobuf::Message {
+ public:
+  RaftTruncatedState();
+  virtual ~RaftTruncatedState();
+
+  RaftTruncatedState(const RaftTruncatedState& from);
+
+  inline RaftTruncatedState& operator=(const RaftTruncatedState& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RaftTruncatedState& default_instance();
+
+  void Swap(RaftTruncatedState* other);
+
+  // implements Message ----------------------------------------------
+
+  RaftTruncatedState* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RaftTruncatedState& from);
+  void MergeFrom(const RaftTruncatedState& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional uint64 index = 1;
+  inline bool has_index() const;
+  inline void clear_index();
+  static const int kIndexFieldNumber = 1;
+  inline ::google::protobuf::uint64 index() const;
+  inline void set_index(::google::protobuf::uint64 value);
+
+  // optional uint64 term = 2;
+  inline bool has_term() const;
+  inline void clear_term();
+  static const int kTermFieldNumber = 2;
+  inline ::google::protobuf::uint64 term() const;
+  inline void set_term(::google::protobuf::uint64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftTruncatedState)
+ private:
+  inline void set_has_index();
+  inline void clear_has_index();
+  inline void set_has_term();
+  inline void clear_has_term();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::uint64 index_;
+  ::google::protobuf::uint64 term_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static RaftTruncatedState* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RaftSnapshotData_KeyValue : public ::google::protobuf::Message {
+ public:
+  RaftSnapshotData_KeyValue();
+  virtual ~RaftSnapshotData_KeyValue();
+
+  RaftSnapshotData_KeyValue(const RaftSnapshotData_KeyValue& from);
+
+  inline RaftSnapshotData_KeyValue& operator=(const RaftSnapshotData_KeyValue& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RaftSnapshotData_KeyValue& default_instance();
+
+  void Swap(RaftSnapshotData_KeyValue* other);
+
+  // implements Message ----------------------------------------------
+
+  RaftSnapshotData_KeyValue* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RaftSnapshotData_KeyValue& from);
+  void MergeFrom(const RaftSnapshotData_KeyValue& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional bytes value = 2;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 2;
+  inline const ::std::string& value() const;
+  inline void set_value(const ::std::string& value);
+  inline void set_value(const char* value);
+  inline void set_value(const void* value, size_t size);
+  inline ::std::string* mutable_value();
+  inline ::std::string* release_value();
+  inline void set_allocated_value(::std::string* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftSnapshotData.KeyValue)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::std::string* value_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static RaftSnapshotData_KeyValue* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RaftSnapshotData : public ::google::protobuf::Message {
+ public:
+  RaftSnapshotData();
+  virtual ~RaftSnapshotData();
+
+  RaftSnapshotData(const RaftSnapshotData& from);
+
+  inline RaftSnapshotData& operator=(const RaftSnapshotData& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RaftSnapshotData& default_instance();
+
+  void Swap(RaftSnapshotData* other);
+
+  // implements Message ----------------------------------------------
+
+  RaftSnapshotData* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RaftSnapshotData& from);
+  void MergeFrom(const RaftSnapshotData& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  typedef RaftSnapshotData_KeyValue KeyValue;
+
+  // accessors -------------------------------------------------------
+
+  // repeated .cockroach.proto.RaftSnapshotData.KeyValue KV = 1;
+  inline int kv_size() const;
+  inline void clear_kv();
+  static const int kKVFieldNumber = 1;
+  inline const ::cockroach::proto::RaftSnapshotData_KeyValue& kv(int index) const;
+  inline ::cockroach::proto::RaftSnapshotData_KeyValue* mutable_kv(int index);
+  inline ::cockroach::proto::RaftSnapshotData_KeyValue* add_kv();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RaftSnapshotData_KeyValue >&
+      kv() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RaftSnapshotData_KeyValue >*
+      mutable_kv();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RaftSnapshotData)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RaftSnapshotData_KeyValue > kv_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static RaftSnapshotData* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class GossipBootstrapInfo : public ::google::protobuf::Message {
+ public:
+  GossipBootstrapInfo();
+  virtual ~GossipBootstrapInfo();
+
+  GossipBootstrapInfo(const GossipBootstrapInfo& from);
+
+  inline GossipBootstrapInfo& operator=(const GossipBootstrapInfo& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const GossipBootstrapInfo& default_instance();
+
+  void Swap(GossipBootstrapInfo* other);
+
+  // implements Message ----------------------------------------------
+
+  GossipBootstrapInfo* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const GossipBootstrapInfo& from);
+  void MergeFrom(const GossipBootstrapInfo& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated string addresses = 1;
+  inline int addresses_size() const;
+  inline void clear_addresses();
+  static const int kAddressesFieldNumber = 1;
+  inline const ::std::string& addresses(int index) const;
+  inline ::std::string* mutable_addresses(int index);
+  inline void set_addresses(int index, const ::std::string& value);
+  inline void set_addresses(int index, const char* value);
+  inline void set_addresses(int index, const char* value, size_t size);
+  inline ::std::string* add_addresses();
+  inline void add_addresses(const ::std::string& value);
+  inline void add_addresses(const char* value);
+  inline void add_addresses(const char* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& addresses() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_addresses();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.GossipBootstrapInfo)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> addresses_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static GossipBootstrapInfo* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+// InternalRangeLookupRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalRangeLookupRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalRangeLookupRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalRangeLookupRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalRangeLookupRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& InternalRangeLookupRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalRangeLookupRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRangeLookupRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalRangeLookupRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalRangeLookupRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRangeLookupRequest.header)
+}
+
+// optional int32 max_ranges = 2;
+inline bool InternalRangeLookupRequest::has_max_ranges() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalRangeLookupRequest::set_has_max_ranges() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalRangeLookupRequest::clear_has_max_ranges() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalRangeLookupRequest::clear_max_ranges() {
+  max_ranges_ = 0;
+  clear_has_max_ranges();
+}
+inline ::google::protobuf::int32 InternalRangeLookupRequest::max_ranges() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.max_ranges)
+  return max_ranges_;
+}
+inline void InternalRangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
+  set_has_max_ranges();
+  max_ranges_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.max_ranges)
+}
+
+// -------------------------------------------------------------------
+
+// InternalRangeLookupResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InternalRangeLookupResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalRangeLookupResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalRangeLookupResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalRangeLookupResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& InternalRangeLookupResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalRangeLookupResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRangeLookupResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalRangeLookupResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalRangeLookupResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRangeLookupResponse.header)
+}
+
+// repeated .cockroach.proto.RangeDescriptor ranges = 2;
+inline int InternalRangeLookupResponse::ranges_size() const {
+  return ranges_.size();
+}
+inline void InternalRangeLookupResponse::clear_ranges() {
+  ranges_.Clear();
+}
+inline const ::cockroach::proto::RangeDescriptor& InternalRangeLookupResponse::ranges(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupResponse.ranges)
+  return ranges_.Get(index);
+}
+inline ::cockroach::proto::RangeDescriptor* InternalRangeLookupResponse::mutable_ranges(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRangeLookupResponse.ranges)
+  return ranges_.Mutable(index);
+}
+inline ::cockroach::proto::RangeDescriptor* InternalRangeLookupResponse::add_ranges() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.InternalRangeLookupResponse.ranges)
+  return ranges_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RangeDescriptor >&
+InternalRangeLookupResponse::ranges() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.InternalRangeLookupResponse.ranges)
+  return ranges_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RangeDescriptor >*
+InternalRangeLookupResponse::mutable_ranges() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalRangeLookupResponse.ranges)
+  return &ranges_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalHeartbeatTxnRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalHeartbeatTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalHeartbeatTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalHeartbeatTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalHeartbeatTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& InternalHeartbeatTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalHeartbeatTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalHeartbeatTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalHeartbeatTxnRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalHeartbeatTxnRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalHeartbeatTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalHeartbeatTxnRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalHeartbeatTxnResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InternalHeartbeatTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalHeartbeatTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalHeartbeatTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalHeartbeatTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& InternalHeartbeatTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalHeartbeatTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalHeartbeatTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalHeartbeatTxnResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalHeartbeatTxnResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalHeartbeatTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalHeartbeatTxnResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalGCRequest_GCKey
+
+// optional bytes key = 1;
+inline bool InternalGCRequest_GCKey::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalGCRequest_GCKey::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalGCRequest_GCKey::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalGCRequest_GCKey::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& InternalGCRequest_GCKey::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.GCKey.key)
+  return *key_;
+}
+inline void InternalGCRequest_GCKey::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalGCRequest.GCKey.key)
+}
+inline void InternalGCRequest_GCKey::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalGCRequest.GCKey.key)
+}
+inline void InternalGCRequest_GCKey::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalGCRequest.GCKey.key)
+}
+inline ::std::string* InternalGCRequest_GCKey::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.GCKey.key)
+  return key_;
+}
+inline ::std::string* InternalGCRequest_GCKey::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalGCRequest_GCKey::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.GCKey.key)
+}
 
+// optional .cockroach.proto.Timestamp timestamp = 2;
+inline bool InternalGCRequest_GCKey::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalGCRequest_GCKey::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalGCRequest_GCKey::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalGCRequest_GCKey::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& InternalGCRequest_GCKey::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.GCKey.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalGCRequest_GCKey::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.GCKey.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalGCRequest_GCKey::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void InternalGCRequest_GCKey::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.GCKey.timestamp)
+}
 
-// ===================================================================
+// -------------------------------------------------------------------
 
-// InternalRangeLookupRequest
+// InternalGCRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalRangeLookupRequest::has_header() const {
+inline bool InternalGCRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalRangeLookupRequest::set_has_header() {
+inline void InternalGCRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalRangeLookupRequest::clear_has_header() {
+inline void InternalGCRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalRangeLookupRequest::clear_header() {
+inline void InternalGCRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalRangeLookupRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalGCRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalRangeLookupRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalGCRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRangeLookupRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalRangeLookupRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalGCRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalRangeLookupRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalGCRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2951,143 +4266,160 @@ inline void InternalRangeLookupRequest::set_allocated_header(::cockroach::proto:
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRangeLookupRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.header)
 }
 
-// optional int32 max_ranges = 2;
-inline bool InternalRangeLookupRequest::has_max_ranges() const {
+// optional .cockroach.proto.GCMetadata gc_meta = 2;
+inline bool InternalGCRequest::has_gc_meta() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalRangeLookupRequest::set_has_max_ranges() {
+inline void InternalGCRequest::set_has_gc_meta() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalRangeLookupRequest::clear_has_max_ranges() {
+inline void InternalGCRequest::clear_has_gc_meta() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalRangeLookupRequest::clear_max_ranges() {
-  max_ranges_ = 0;
-  clear_has_max_ranges();
+inline void InternalGCRequest::clear_gc_meta() {
+  if (gc_meta_ != NULL) gc_meta_->::cockroach::proto::GCMetadata::Clear();
+  clear_has_gc_meta();
 }
-inline ::google::protobuf::int32 InternalRangeLookupRequest::max_ranges() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.max_ranges)
-  return max_ranges_;
+inline const ::cockroach::proto::GCMetadata& InternalGCRequest::gc_meta() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.gc_meta)
+  return gc_meta_ != NULL ? *gc_meta_ : *default_instance_->gc_meta_;
 }
-inline void InternalRangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
-  set_has_max_ranges();
-  max_ranges_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.max_ranges)
+inline ::cockroach::proto::GCMetadata* InternalGCRequest::mutable_gc_meta() {
+  set_has_gc_meta();
+  if (gc_meta_ == NULL) gc_meta_ = new ::cockroach::proto::GCMetadata;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.gc_meta)
+  return gc_meta_;
+}
+inline ::cockroach::proto::GCMetadata* InternalGCRequest::release_gc_meta() {
+  clear_has_gc_meta();
+  ::cockroach::proto::GCMetadata* temp = gc_meta_;
+  gc_meta_ = NULL;
+  return temp;
+}
+inline void InternalGCRequest::set_allocated_gc_meta(::cockroach::proto::GCMetadata* gc_meta) {
+  delete gc_meta_;
+  gc_meta_ = gc_meta;
+  if (gc_meta) {
+    set_has_gc_meta();
+  } else {
+    clear_has_gc_meta();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.gc_meta)
+}
+
+// repeated .cockroach.proto.InternalGCRequest.GCKey keys = 3;
+inline int InternalGCRequest::keys_size() const {
+  return keys_.size();
+}
+inline void InternalGCRequest::clear_keys() {
+  keys_.Clear();
+}
+inline const ::cockroach::proto::InternalGCRequest_GCKey& InternalGCRequest::keys(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.keys)
+  return keys_.Get(index);
+}
+inline ::cockroach::proto::InternalGCRequest_GCKey* InternalGCRequest::mutable_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.keys)
+  return keys_.Mutable(index);
+}
+inline ::cockroach::proto::InternalGCRequest_GCKey* InternalGCRequest::add_keys() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.InternalGCRequest.keys)
+  return keys_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalGCRequest_GCKey >&
+InternalGCRequest::keys() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.InternalGCRequest.keys)
+  return keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalGCRequest_GCKey >*
+InternalGCRequest::mutable_keys() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalGCRequest.keys)
+  return &keys_;
 }
 
 // -------------------------------------------------------------------
 
-// InternalRangeLookupResponse
+// InternalGCResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalRangeLookupResponse::has_header() const {
+inline bool InternalGCResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalRangeLookupResponse::set_has_header() {
+inline void InternalGCResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalRangeLookupResponse::clear_has_header() {
+inline void InternalGCResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalRangeLookupResponse::clear_header() {
+inline void InternalGCResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalRangeLookupResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalGCResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalRangeLookupResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalGCResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRangeLookupResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalRangeLookupResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalGCResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalRangeLookupResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRangeLookupResponse.header)
-}
-
-// repeated .cockroach.proto.RangeDescriptor ranges = 2;
-inline int InternalRangeLookupResponse::ranges_size() const {
-  return ranges_.size();
-}
-inline void InternalRangeLookupResponse::clear_ranges() {
-  ranges_.Clear();
-}
-inline const ::cockroach::proto::RangeDescriptor& InternalRangeLookupResponse::ranges(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupResponse.ranges)
-  return ranges_.Get(index);
-}
-inline ::cockroach::proto::RangeDescriptor* InternalRangeLookupResponse::mutable_ranges(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRangeLookupResponse.ranges)
-  return ranges_.Mutable(index);
-}
-inline ::cockroach::proto::RangeDescriptor* InternalRangeLookupResponse::add_ranges() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.InternalRangeLookupResponse.ranges)
-  return ranges_.Add();
-}
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RangeDescriptor >&
-InternalRangeLookupResponse::ranges() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.InternalRangeLookupResponse.ranges)
-  return ranges_;
-}
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RangeDescriptor >*
-InternalRangeLookupResponse::mutable_ranges() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalRangeLookupResponse.ranges)
-  return &ranges_;
-}
+inline void InternalGCResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCResponse.header)
+}
 
 // -------------------------------------------------------------------
 
-// InternalHeartbeatTxnRequest
+// InternalPushTxnRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalHeartbeatTxnRequest::has_header() const {
+inline bool InternalPushTxnRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalHeartbeatTxnRequest::set_has_header() {
+inline void InternalPushTxnRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalHeartbeatTxnRequest::clear_has_header() {
+inline void InternalPushTxnRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalHeartbeatTxnRequest::clear_header() {
+inline void InternalPushTxnRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalHeartbeatTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalHeartbeatTxnRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalPushTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalHeartbeatTxnRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalPushTxnRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalHeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalHeartbeatTxnRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalPushTxnRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalHeartbeatTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalPushTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3095,44 +4427,109 @@ inline void InternalHeartbeatTxnRequest::set_allocated_header(::cockroach::proto
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalHeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnRequest.header)
+}
+
+// optional .cockroach.proto.Transaction pushee_txn = 2;
+inline bool InternalPushTxnRequest::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPushTxnRequest::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPushTxnRequest::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+inline const ::cockroach::proto::Transaction& InternalPushTxnRequest::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalPushTxnRequest::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) pushee_txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnRequest.pushee_txn)
+  return pushee_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalPushTxnRequest::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::proto::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnRequest::set_allocated_pushee_txn(::cockroach::proto::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnRequest.pushee_txn)
+}
+
+// optional bool Abort = 3;
+inline bool InternalPushTxnRequest::has_abort() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_abort() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalPushTxnRequest::clear_has_abort() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalPushTxnRequest::clear_abort() {
+  abort_ = false;
+  clear_has_abort();
+}
+inline bool InternalPushTxnRequest::abort() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.Abort)
+  return abort_;
+}
+inline void InternalPushTxnRequest::set_abort(bool value) {
+  set_has_abort();
+  abort_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalPushTxnRequest.Abort)
 }
 
 // -------------------------------------------------------------------
 
-// InternalHeartbeatTxnResponse
+// InternalPushTxnResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalHeartbeatTxnResponse::has_header() const {
+inline bool InternalPushTxnResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalHeartbeatTxnResponse::set_has_header() {
+inline void InternalPushTxnResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalHeartbeatTxnResponse::clear_has_header() {
+inline void InternalPushTxnResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalHeartbeatTxnResponse::clear_header() {
+inline void InternalPushTxnResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalHeartbeatTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalHeartbeatTxnResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalPushTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalHeartbeatTxnResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalHeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalHeartbeatTxnResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalHeartbeatTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalPushTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3140,165 +4537,175 @@ inline void InternalHeartbeatTxnResponse::set_allocated_header(::cockroach::prot
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalHeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.header)
+}
+
+// optional .cockroach.proto.Transaction pushee_txn = 2;
+inline bool InternalPushTxnResponse::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPushTxnResponse::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPushTxnResponse::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPushTxnResponse::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+inline const ::cockroach::proto::Transaction& InternalPushTxnResponse::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalPushTxnResponse::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) pushee_txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+  return pushee_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalPushTxnResponse::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::proto::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnResponse::set_allocated_pushee_txn(::cockroach::proto::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.pushee_txn)
 }
 
 // -------------------------------------------------------------------
 
-// InternalGCRequest_GCKey
+// InternalResolveIntentRequest
 
-// optional bytes key = 1;
-inline bool InternalGCRequest_GCKey::has_key() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalResolveIntentRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCRequest_GCKey::set_has_key() {
+inline void InternalResolveIntentRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCRequest_GCKey::clear_has_key() {
+inline void InternalResolveIntentRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCRequest_GCKey::clear_key() {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_->clear();
-  }
-  clear_has_key();
-}
-inline const ::std::string& InternalGCRequest_GCKey::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.GCKey.key)
-  return *key_;
-}
-inline void InternalGCRequest_GCKey::set_key(const ::std::string& value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalGCRequest.GCKey.key)
-}
-inline void InternalGCRequest_GCKey::set_key(const char* value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalGCRequest.GCKey.key)
+inline void InternalResolveIntentRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline void InternalGCRequest_GCKey::set_key(const void* value, size_t size) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalGCRequest.GCKey.key)
+inline const ::cockroach::proto::RequestHeader& InternalResolveIntentRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::std::string* InternalGCRequest_GCKey::mutable_key() {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.GCKey.key)
-  return key_;
+inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRequest.header)
+  return header_;
 }
-inline ::std::string* InternalGCRequest_GCKey::release_key() {
-  clear_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
-  } else {
-    ::std::string* temp = key_;
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
-  }
+inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline void InternalGCRequest_GCKey::set_allocated_key(::std::string* key) {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
-  }
-  if (key) {
-    set_has_key();
-    key_ = key;
+inline void InternalResolveIntentRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_key();
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.GCKey.key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRequest.header)
 }
 
-// optional .cockroach.proto.Timestamp timestamp = 2;
-inline bool InternalGCRequest_GCKey::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// InternalResolveIntentResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InternalResolveIntentResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCRequest_GCKey::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000002u;
+inline void InternalResolveIntentResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCRequest_GCKey::clear_has_timestamp() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void InternalResolveIntentResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCRequest_GCKey::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void InternalResolveIntentResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Timestamp& InternalGCRequest_GCKey::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.GCKey.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline const ::cockroach::proto::ResponseHeader& InternalResolveIntentResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Timestamp* InternalGCRequest_GCKey::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.GCKey.timestamp)
-  return timestamp_;
+inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Timestamp* InternalGCRequest_GCKey::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void InternalGCRequest_GCKey::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void InternalResolveIntentResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_timestamp();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.GCKey.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalGCRequest
+// InternalMergeRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalGCRequest::has_header() const {
+inline bool InternalMergeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCRequest::set_has_header() {
+inline void InternalMergeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCRequest::clear_has_header() {
+inline void InternalMergeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCRequest::clear_header() {
+inline void InternalMergeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalGCRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalMergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalGCRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalMergeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalGCRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalMergeRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalGCRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3306,115 +4713,85 @@ inline void InternalGCRequest::set_allocated_header(::cockroach::proto::RequestH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.header)
 }
 
-// optional .cockroach.proto.GCMetadata gc_meta = 2;
-inline bool InternalGCRequest::has_gc_meta() const {
+// optional .cockroach.proto.Value value = 2;
+inline bool InternalMergeRequest::has_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalGCRequest::set_has_gc_meta() {
+inline void InternalMergeRequest::set_has_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalGCRequest::clear_has_gc_meta() {
+inline void InternalMergeRequest::clear_has_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalGCRequest::clear_gc_meta() {
-  if (gc_meta_ != NULL) gc_meta_->::cockroach::proto::GCMetadata::Clear();
-  clear_has_gc_meta();
+inline void InternalMergeRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
 }
-inline const ::cockroach::proto::GCMetadata& InternalGCRequest::gc_meta() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.gc_meta)
-  return gc_meta_ != NULL ? *gc_meta_ : *default_instance_->gc_meta_;
+inline const ::cockroach::proto::Value& InternalMergeRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline ::cockroach::proto::GCMetadata* InternalGCRequest::mutable_gc_meta() {
-  set_has_gc_meta();
-  if (gc_meta_ == NULL) gc_meta_ = new ::cockroach::proto::GCMetadata;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.gc_meta)
-  return gc_meta_;
+inline ::cockroach::proto::Value* InternalMergeRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.value)
+  return value_;
 }
-inline ::cockroach::proto::GCMetadata* InternalGCRequest::release_gc_meta() {
-  clear_has_gc_meta();
-  ::cockroach::proto::GCMetadata* temp = gc_meta_;
-  gc_meta_ = NULL;
+inline ::cockroach::proto::Value* InternalMergeRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
   return temp;
 }
-inline void InternalGCRequest::set_allocated_gc_meta(::cockroach::proto::GCMetadata* gc_meta) {
-  delete gc_meta_;
-  gc_meta_ = gc_meta;
-  if (gc_meta) {
-    set_has_gc_meta();
+inline void InternalMergeRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_gc_meta();
+    clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCRequest.gc_meta)
-}
-
-// repeated .cockroach.proto.InternalGCRequest.GCKey keys = 3;
-inline int InternalGCRequest::keys_size() const {
-  return keys_.size();
-}
-inline void InternalGCRequest::clear_keys() {
-  keys_.Clear();
-}
-inline const ::cockroach::proto::InternalGCRequest_GCKey& InternalGCRequest::keys(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCRequest.keys)
-  return keys_.Get(index);
-}
-inline ::cockroach::proto::InternalGCRequest_GCKey* InternalGCRequest::mutable_keys(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCRequest.keys)
-  return keys_.Mutable(index);
-}
-inline ::cockroach::proto::InternalGCRequest_GCKey* InternalGCRequest::add_keys() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.InternalGCRequest.keys)
-  return keys_.Add();
-}
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalGCRequest_GCKey >&
-InternalGCRequest::keys() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.InternalGCRequest.keys)
-  return keys_;
-}
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::InternalGCRequest_GCKey >*
-InternalGCRequest::mutable_keys() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalGCRequest.keys)
-  return &keys_;
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.value)
 }
 
 // -------------------------------------------------------------------
 
-// InternalGCResponse
+// InternalMergeResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalGCResponse::has_header() const {
+inline bool InternalMergeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCResponse::set_has_header() {
+inline void InternalMergeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCResponse::clear_has_header() {
+inline void InternalMergeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCResponse::clear_header() {
+inline void InternalMergeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalGCResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalGCResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalMergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalGCResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalGCResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalGCResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalGCResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3422,154 +4799,113 @@ inline void InternalGCResponse::set_allocated_header(::cockroach::proto::Respons
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalGCResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalPushTxnRequest
+// InternalTruncateLogRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalPushTxnRequest::has_header() const {
+inline bool InternalTruncateLogRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalPushTxnRequest::set_has_header() {
+inline void InternalTruncateLogRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalPushTxnRequest::clear_has_header() {
+inline void InternalTruncateLogRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalPushTxnRequest::clear_header() {
+inline void InternalTruncateLogRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalPushTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalTruncateLogRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalPushTxnRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalPushTxnRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalPushTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalTruncateLogRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnRequest.header)
-}
-
-// optional .cockroach.proto.Transaction pushee_txn = 2;
-inline bool InternalPushTxnRequest::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void InternalPushTxnRequest::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void InternalPushTxnRequest::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void InternalPushTxnRequest::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_pushee_txn();
-}
-inline const ::cockroach::proto::Transaction& InternalPushTxnRequest::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
-}
-inline ::cockroach::proto::Transaction* InternalPushTxnRequest::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) pushee_txn_ = new ::cockroach::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnRequest.pushee_txn)
-  return pushee_txn_;
-}
-inline ::cockroach::proto::Transaction* InternalPushTxnRequest::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::proto::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
-}
-inline void InternalPushTxnRequest::set_allocated_pushee_txn(::cockroach::proto::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_pushee_txn();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnRequest.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogRequest.header)
 }
 
-// optional bool Abort = 3;
-inline bool InternalPushTxnRequest::has_abort() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional uint64 index = 2;
+inline bool InternalTruncateLogRequest::has_index() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalPushTxnRequest::set_has_abort() {
-  _has_bits_[0] |= 0x00000004u;
+inline void InternalTruncateLogRequest::set_has_index() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalPushTxnRequest::clear_has_abort() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void InternalTruncateLogRequest::clear_has_index() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalPushTxnRequest::clear_abort() {
-  abort_ = false;
-  clear_has_abort();
+inline void InternalTruncateLogRequest::clear_index() {
+  index_ = GOOGLE_ULONGLONG(0);
+  clear_has_index();
 }
-inline bool InternalPushTxnRequest::abort() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.Abort)
-  return abort_;
+inline ::google::protobuf::uint64 InternalTruncateLogRequest::index() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.index)
+  return index_;
 }
-inline void InternalPushTxnRequest::set_abort(bool value) {
-  set_has_abort();
-  abort_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalPushTxnRequest.Abort)
+inline void InternalTruncateLogRequest::set_index(::google::protobuf::uint64 value) {
+  set_has_index();
+  index_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalTruncateLogRequest.index)
 }
 
 // -------------------------------------------------------------------
 
-// InternalPushTxnResponse
+// InternalTruncateLogResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalPushTxnResponse::has_header() const {
+inline bool InternalTruncateLogResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalPushTxnResponse::set_has_header() {
+inline void InternalTruncateLogResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalPushTxnResponse::clear_has_header() {
+inline void InternalTruncateLogResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalPushTxnResponse::clear_header() {
+inline void InternalTruncateLogResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalPushTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalTruncateLogResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalPushTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalTruncateLogResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3577,85 +4913,44 @@ inline void InternalPushTxnResponse::set_allocated_header(::cockroach::proto::Re
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.header)
-}
-
-// optional .cockroach.proto.Transaction pushee_txn = 2;
-inline bool InternalPushTxnResponse::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void InternalPushTxnResponse::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void InternalPushTxnResponse::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void InternalPushTxnResponse::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_pushee_txn();
-}
-inline const ::cockroach::proto::Transaction& InternalPushTxnResponse::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
-}
-inline ::cockroach::proto::Transaction* InternalPushTxnResponse::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) pushee_txn_ = new ::cockroach::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.pushee_txn)
-  return pushee_txn_;
-}
-inline ::cockroach::proto::Transaction* InternalPushTxnResponse::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::proto::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
-}
-inline void InternalPushTxnResponse::set_allocated_pushee_txn(::cockroach::proto::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
-  } else {
-    clear_has_pushee_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalResolveIntentRequest
+// InternalLeaderLeaseRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalResolveIntentRequest::has_header() const {
+inline bool InternalLeaderLeaseRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalResolveIntentRequest::set_has_header() {
+inline void InternalLeaderLeaseRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalResolveIntentRequest::clear_has_header() {
+inline void InternalLeaderLeaseRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalResolveIntentRequest::clear_header() {
+inline void InternalLeaderLeaseRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalResolveIntentRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalLeaderLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalResolveIntentRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalLeaderLeaseRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3663,44 +4958,85 @@ inline void InternalResolveIntentRequest::set_allocated_header(::cockroach::prot
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.header)
+}
+
+// optional .cockroach.proto.Lease lease = 2;
+inline bool InternalLeaderLeaseRequest::has_lease() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalLeaderLeaseRequest::set_has_lease() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalLeaderLeaseRequest::clear_has_lease() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalLeaderLeaseRequest::clear_lease() {
+  if (lease_ != NULL) lease_->::cockroach::proto::Lease::Clear();
+  clear_has_lease();
+}
+inline const ::cockroach::proto::Lease& InternalLeaderLeaseRequest::lease() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.lease)
+  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+}
+inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::mutable_lease() {
+  set_has_lease();
+  if (lease_ == NULL) lease_ = new ::cockroach::proto::Lease;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.lease)
+  return lease_;
+}
+inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::release_lease() {
+  clear_has_lease();
+  ::cockroach::proto::Lease* temp = lease_;
+  lease_ = NULL;
+  return temp;
+}
+inline void InternalLeaderLeaseRequest::set_allocated_lease(::cockroach::proto::Lease* lease) {
+  delete lease_;
+  lease_ = lease;
+  if (lease) {
+    set_has_lease();
+  } else {
+    clear_has_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.lease)
 }
 
 // -------------------------------------------------------------------
 
-// InternalResolveIntentResponse
+// InternalLeaderLeaseResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalResolveIntentResponse::has_header() const {
+inline bool InternalLeaderLeaseResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalResolveIntentResponse::set_has_header() {
+inline void InternalLeaderLeaseResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalResolveIntentResponse::clear_has_header() {
+inline void InternalLeaderLeaseResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalResolveIntentResponse::clear_header() {
+inline void InternalLeaderLeaseResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalResolveIntentResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalLeaderLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalResolveIntentResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalLeaderLeaseResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3708,44 +5044,44 @@ inline void InternalResolveIntentResponse::set_allocated_header(::cockroach::pro
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalMergeRequest
+// InternalCheckConsistencyRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalMergeRequest::has_header() const {
+inline bool InternalCheckConsistencyRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalMergeRequest::set_has_header() {
+inline void InternalCheckConsistencyRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalMergeRequest::clear_has_header() {
+inline void InternalCheckConsistencyRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalMergeRequest::clear_header() {
+inline void InternalCheckConsistencyRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalMergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalCheckConsistencyRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCheckConsistencyRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalMergeRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalCheckConsistencyRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCheckConsistencyRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalMergeRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalCheckConsistencyRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalCheckConsistencyRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3753,85 +5089,196 @@ inline void InternalMergeRequest::set_allocated_header(::cockroach::proto::Reque
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCheckConsistencyRequest.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool InternalMergeRequest::has_value() const {
+// optional bytes checksum_id = 2;
+inline bool InternalCheckConsistencyRequest::has_checksum_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalMergeRequest::set_has_value() {
+inline void InternalCheckConsistencyRequest::set_has_checksum_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalMergeRequest::clear_has_value() {
+inline void InternalCheckConsistencyRequest::clear_has_checksum_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalMergeRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void InternalCheckConsistencyRequest::clear_checksum_id() {
+  if (checksum_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_id_->clear();
+  }
+  clear_has_checksum_id();
 }
-inline const ::cockroach::proto::Value& InternalMergeRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline const ::std::string& InternalCheckConsistencyRequest::checksum_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCheckConsistencyRequest.checksum_id)
+  return *checksum_id_;
 }
-inline ::cockroach::proto::Value* InternalMergeRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.value)
-  return value_;
+inline void InternalCheckConsistencyRequest::set_checksum_id(const ::std::string& value) {
+  set_has_checksum_id();
+  if (checksum_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_id_ = new ::std::string;
+  }
+  checksum_id_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalCheckConsistencyRequest.checksum_id)
+}
+inline void InternalCheckConsistencyRequest::set_checksum_id(const char* value) {
+  set_has_checksum_id();
+  if (checksum_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_id_ = new ::std::string;
+  }
+  checksum_id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalCheckConsistencyRequest.checksum_id)
+}
+inline void InternalCheckConsistencyRequest::set_checksum_id(const void* value, size_t size) {
+  set_has_checksum_id();
+  if (checksum_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_id_ = new ::std::string;
+  }
+  checksum_id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalCheckConsistencyRequest.checksum_id)
+}
+inline ::std::string* InternalCheckConsistencyRequest::mutable_checksum_id() {
+  set_has_checksum_id();
+  if (checksum_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_id_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCheckConsistencyRequest.checksum_id)
+  return checksum_id_;
+}
+inline ::std::string* InternalCheckConsistencyRequest::release_checksum_id() {
+  clear_has_checksum_id();
+  if (checksum_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = checksum_id_;
+    checksum_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalCheckConsistencyRequest::set_allocated_checksum_id(::std::string* checksum_id) {
+  if (checksum_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_id_;
+  }
+  if (checksum_id) {
+    set_has_checksum_id();
+    checksum_id_ = checksum_id;
+  } else {
+    clear_has_checksum_id();
+    checksum_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCheckConsistencyRequest.checksum_id)
+}
+
+// optional bytes checksum = 3;
+inline bool InternalCheckConsistencyRequest::has_checksum() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalCheckConsistencyRequest::set_has_checksum() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalCheckConsistencyRequest::clear_has_checksum() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalCheckConsistencyRequest::clear_checksum() {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_->clear();
+  }
+  clear_has_checksum();
+}
+inline const ::std::string& InternalCheckConsistencyRequest::checksum() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCheckConsistencyRequest.checksum)
+  return *checksum_;
+}
+inline void InternalCheckConsistencyRequest::set_checksum(const ::std::string& value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalCheckConsistencyRequest.checksum)
+}
+inline void InternalCheckConsistencyRequest::set_checksum(const char* value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalCheckConsistencyRequest.checksum)
+}
+inline void InternalCheckConsistencyRequest::set_checksum(const void* value, size_t size) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalCheckConsistencyRequest.checksum)
+}
+inline ::std::string* InternalCheckConsistencyRequest::mutable_checksum() {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCheckConsistencyRequest.checksum)
+  return checksum_;
 }
-inline ::cockroach::proto::Value* InternalMergeRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline ::std::string* InternalCheckConsistencyRequest::release_checksum() {
+  clear_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = checksum_;
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
 }
-inline void InternalMergeRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void InternalCheckConsistencyRequest::set_allocated_checksum(::std::string* checksum) {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
+  }
+  if (checksum) {
+    set_has_checksum();
+    checksum_ = checksum;
   } else {
-    clear_has_value();
+    clear_has_checksum();
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.value)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCheckConsistencyRequest.checksum)
 }
 
 // -------------------------------------------------------------------
 
-// InternalMergeResponse
+// InternalCheckConsistencyResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalMergeResponse::has_header() const {
+inline bool InternalCheckConsistencyResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalMergeResponse::set_has_header() {
+inline void InternalCheckConsistencyResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalMergeResponse::clear_has_header() {
+inline void InternalCheckConsistencyResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalMergeResponse::clear_header() {
+inline void InternalCheckConsistencyResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalMergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalCheckConsistencyResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCheckConsistencyResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalCheckConsistencyResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCheckConsistencyResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalCheckConsistencyResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalCheckConsistencyResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3839,44 +5286,120 @@ inline void InternalMergeResponse::set_allocated_header(::cockroach::proto::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCheckConsistencyResponse.header)
+}
+
+// optional bytes checksum = 2;
+inline bool InternalCheckConsistencyResponse::has_checksum() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalCheckConsistencyResponse::set_has_checksum() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalCheckConsistencyResponse::clear_has_checksum() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalCheckConsistencyResponse::clear_checksum() {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_->clear();
+  }
+  clear_has_checksum();
+}
+inline const ::std::string& InternalCheckConsistencyResponse::checksum() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCheckConsistencyResponse.checksum)
+  return *checksum_;
+}
+inline void InternalCheckConsistencyResponse::set_checksum(const ::std::string& value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalCheckConsistencyResponse.checksum)
+}
+inline void InternalCheckConsistencyResponse::set_checksum(const char* value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalCheckConsistencyResponse.checksum)
+}
+inline void InternalCheckConsistencyResponse::set_checksum(const void* value, size_t size) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  checksum_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalCheckConsistencyResponse.checksum)
+}
+inline ::std::string* InternalCheckConsistencyResponse::mutable_checksum() {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCheckConsistencyResponse.checksum)
+  return checksum_;
+}
+inline ::std::string* InternalCheckConsistencyResponse::release_checksum() {
+  clear_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = checksum_;
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalCheckConsistencyResponse::set_allocated_checksum(::std::string* checksum) {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
+  }
+  if (checksum) {
+    set_has_checksum();
+    checksum_ = checksum;
+  } else {
+    clear_has_checksum();
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCheckConsistencyResponse.checksum)
 }
 
 // -------------------------------------------------------------------
 
-// InternalTruncateLogRequest
+// InternalRecomputeStatsRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalTruncateLogRequest::has_header() const {
+inline bool InternalRecomputeStatsRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalTruncateLogRequest::set_has_header() {
+inline void InternalRecomputeStatsRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalTruncateLogRequest::clear_has_header() {
+inline void InternalRecomputeStatsRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalTruncateLogRequest::clear_header() {
+inline void InternalRecomputeStatsRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalTruncateLogRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalRecomputeStatsRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRecomputeStatsRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalRecomputeStatsRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRecomputeStatsRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalRecomputeStatsRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalTruncateLogRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalRecomputeStatsRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3884,68 +5407,44 @@ inline void InternalTruncateLogRequest::set_allocated_header(::cockroach::proto:
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogRequest.header)
-}
-
-// optional uint64 index = 2;
-inline bool InternalTruncateLogRequest::has_index() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void InternalTruncateLogRequest::set_has_index() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void InternalTruncateLogRequest::clear_has_index() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void InternalTruncateLogRequest::clear_index() {
-  index_ = GOOGLE_ULONGLONG(0);
-  clear_has_index();
-}
-inline ::google::protobuf::uint64 InternalTruncateLogRequest::index() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.index)
-  return index_;
-}
-inline void InternalTruncateLogRequest::set_index(::google::protobuf::uint64 value) {
-  set_has_index();
-  index_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalTruncateLogRequest.index)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRecomputeStatsRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalTruncateLogResponse
+// InternalRecomputeStatsResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalTruncateLogResponse::has_header() const {
+inline bool InternalRecomputeStatsResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalTruncateLogResponse::set_has_header() {
+inline void InternalRecomputeStatsResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalTruncateLogResponse::clear_has_header() {
+inline void InternalRecomputeStatsResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalTruncateLogResponse::clear_header() {
+inline void InternalRecomputeStatsResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalTruncateLogResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalRecomputeStatsResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRecomputeStatsResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalRecomputeStatsResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRecomputeStatsResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalRecomputeStatsResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalTruncateLogResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalRecomputeStatsResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3953,44 +5452,85 @@ inline void InternalTruncateLogResponse::set_allocated_header(::cockroach::proto
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRecomputeStatsResponse.header)
+}
+
+// optional .cockroach.proto.MVCCStats delta = 2;
+inline bool InternalRecomputeStatsResponse::has_delta() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalRecomputeStatsResponse::set_has_delta() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalRecomputeStatsResponse::clear_has_delta() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalRecomputeStatsResponse::clear_delta() {
+  if (delta_ != NULL) delta_->::cockroach::proto::MVCCStats::Clear();
+  clear_has_delta();
+}
+inline const ::cockroach::proto::MVCCStats& InternalRecomputeStatsResponse::delta() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRecomputeStatsResponse.delta)
+  return delta_ != NULL ? *delta_ : *default_instance_->delta_;
+}
+inline ::cockroach::proto::MVCCStats* InternalRecomputeStatsResponse::mutable_delta() {
+  set_has_delta();
+  if (delta_ == NULL) delta_ = new ::cockroach::proto::MVCCStats;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRecomputeStatsResponse.delta)
+  return delta_;
+}
+inline ::cockroach::proto::MVCCStats* InternalRecomputeStatsResponse::release_delta() {
+  clear_has_delta();
+  ::cockroach::proto::MVCCStats* temp = delta_;
+  delta_ = NULL;
+  return temp;
+}
+inline void InternalRecomputeStatsResponse::set_allocated_delta(::cockroach::proto::MVCCStats* delta) {
+  delete delta_;
+  delta_ = delta;
+  if (delta) {
+    set_has_delta();
+  } else {
+    clear_has_delta();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRecomputeStatsResponse.delta)
 }
 
 // -------------------------------------------------------------------
 
-// InternalLeaderLeaseRequest
+// InternalRefreshRangeRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalLeaderLeaseRequest::has_header() const {
+inline bool InternalRefreshRangeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalLeaderLeaseRequest::set_has_header() {
+inline void InternalRefreshRangeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalLeaderLeaseRequest::clear_has_header() {
+inline void InternalRefreshRangeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalLeaderLeaseRequest::clear_header() {
+inline void InternalRefreshRangeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalLeaderLeaseRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalRefreshRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRefreshRangeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalRefreshRangeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRefreshRangeRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalRefreshRangeRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalLeaderLeaseRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalRefreshRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3998,85 +5538,85 @@ inline void InternalLeaderLeaseRequest::set_allocated_header(::cockroach::proto:
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRefreshRangeRequest.header)
 }
 
-// optional .cockroach.proto.Lease lease = 2;
-inline bool InternalLeaderLeaseRequest::has_lease() const {
+// optional .cockroach.proto.Timestamp refresh_from = 2;
+inline bool InternalRefreshRangeRequest::has_refresh_from() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalLeaderLeaseRequest::set_has_lease() {
+inline void InternalRefreshRangeRequest::set_has_refresh_from() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalLeaderLeaseRequest::clear_has_lease() {
+inline void InternalRefreshRangeRequest::clear_has_refresh_from() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalLeaderLeaseRequest::clear_lease() {
-  if (lease_ != NULL) lease_->::cockroach::proto::Lease::Clear();
-  clear_has_lease();
+inline void InternalRefreshRangeRequest::clear_refresh_from() {
+  if (refresh_from_ != NULL) refresh_from_->::cockroach::proto::Timestamp::Clear();
+  clear_has_refresh_from();
 }
-inline const ::cockroach::proto::Lease& InternalLeaderLeaseRequest::lease() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.lease)
-  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+inline const ::cockroach::proto::Timestamp& InternalRefreshRangeRequest::refresh_from() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRefreshRangeRequest.refresh_from)
+  return refresh_from_ != NULL ? *refresh_from_ : *default_instance_->refresh_from_;
 }
-inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::mutable_lease() {
-  set_has_lease();
-  if (lease_ == NULL) lease_ = new ::cockroach::proto::Lease;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.lease)
-  return lease_;
+inline ::cockroach::proto::Timestamp* InternalRefreshRangeRequest::mutable_refresh_from() {
+  set_has_refresh_from();
+  if (refresh_from_ == NULL) refresh_from_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRefreshRangeRequest.refresh_from)
+  return refresh_from_;
 }
-inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::release_lease() {
-  clear_has_lease();
-  ::cockroach::proto::Lease* temp = lease_;
-  lease_ = NULL;
+inline ::cockroach::proto::Timestamp* InternalRefreshRangeRequest::release_refresh_from() {
+  clear_has_refresh_from();
+  ::cockroach::proto::Timestamp* temp = refresh_from_;
+  refresh_from_ = NULL;
   return temp;
 }
-inline void InternalLeaderLeaseRequest::set_allocated_lease(::cockroach::proto::Lease* lease) {
-  delete lease_;
-  lease_ = lease;
-  if (lease) {
-    set_has_lease();
+inline void InternalRefreshRangeRequest::set_allocated_refresh_from(::cockroach::proto::Timestamp* refresh_from) {
+  delete refresh_from_;
+  refresh_from_ = refresh_from;
+  if (refresh_from) {
+    set_has_refresh_from();
   } else {
-    clear_has_lease();
+    clear_has_refresh_from();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.lease)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRefreshRangeRequest.refresh_from)
 }
 
 // -------------------------------------------------------------------
 
-// InternalLeaderLeaseResponse
+// InternalRefreshRangeResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalLeaderLeaseResponse::has_header() const {
+inline bool InternalRefreshRangeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalLeaderLeaseResponse::set_has_header() {
+inline void InternalRefreshRangeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalLeaderLeaseResponse::clear_has_header() {
+inline void InternalRefreshRangeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalLeaderLeaseResponse::clear_header() {
+inline void InternalRefreshRangeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalLeaderLeaseResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalRefreshRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRefreshRangeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalRefreshRangeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRefreshRangeResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalRefreshRangeResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalLeaderLeaseResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalRefreshRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4084,7 +5624,7 @@ inline void InternalLeaderLeaseResponse::set_allocated_header(::cockroach::proto
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRefreshRangeResponse.header)
 }
 
 // -------------------------------------------------------------------
@@ -4349,6 +5889,49 @@ inline void ReadWriteCmdResponse::set_allocated_end_transaction(::cockroach::pro
   }
 }
 
+// optional .cockroach.proto.InitPutResponse init_put = 7;
+inline bool ReadWriteCmdResponse::has_init_put() const {
+  return value_case() == kInitPut;
+}
+inline void ReadWriteCmdResponse::set_has_init_put() {
+  _oneof_case_[0] = kInitPut;
+}
+inline void ReadWriteCmdResponse::clear_init_put() {
+  if (has_init_put()) {
+    delete value_.init_put_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InitPutResponse& ReadWriteCmdResponse::init_put() const {
+  return has_init_put() ? *value_.init_put_
+                      : ::cockroach::proto::InitPutResponse::default_instance();
+}
+inline ::cockroach::proto::InitPutResponse* ReadWriteCmdResponse::mutable_init_put() {
+  if (!has_init_put()) {
+    clear_value();
+    set_has_init_put();
+    value_.init_put_ = new ::cockroach::proto::InitPutResponse;
+  }
+  return value_.init_put_;
+}
+inline ::cockroach::proto::InitPutResponse* ReadWriteCmdResponse::release_init_put() {
+  if (has_init_put()) {
+    clear_has_value();
+    ::cockroach::proto::InitPutResponse* temp = value_.init_put_;
+    value_.init_put_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_init_put(::cockroach::proto::InitPutResponse* init_put) {
+  clear_value();
+  if (init_put) {
+    set_has_init_put();
+    value_.init_put_ = init_put;
+  }
+}
+
 // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
 inline bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
   return value_case() == kInternalHeartbeatTxn;
@@ -4549,61 +6132,147 @@ inline ::cockroach::proto::InternalTruncateLogResponse* ReadWriteCmdResponse::mu
 inline ::cockroach::proto::InternalTruncateLogResponse* ReadWriteCmdResponse::release_internal_truncate_log() {
   if (has_internal_truncate_log()) {
     clear_has_value();
-    ::cockroach::proto::InternalTruncateLogResponse* temp = value_.internal_truncate_log_;
-    value_.internal_truncate_log_ = NULL;
+    ::cockroach::proto::InternalTruncateLogResponse* temp = value_.internal_truncate_log_;
+    value_.internal_truncate_log_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_internal_truncate_log(::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log) {
+  clear_value();
+  if (internal_truncate_log) {
+    set_has_internal_truncate_log();
+    value_.internal_truncate_log_ = internal_truncate_log;
+  }
+}
+
+// optional .cockroach.proto.InternalGCResponse internal_gc = 15;
+inline bool ReadWriteCmdResponse::has_internal_gc() const {
+  return value_case() == kInternalGc;
+}
+inline void ReadWriteCmdResponse::set_has_internal_gc() {
+  _oneof_case_[0] = kInternalGc;
+}
+inline void ReadWriteCmdResponse::clear_internal_gc() {
+  if (has_internal_gc()) {
+    delete value_.internal_gc_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalGCResponse& ReadWriteCmdResponse::internal_gc() const {
+  return has_internal_gc() ? *value_.internal_gc_
+                      : ::cockroach::proto::InternalGCResponse::default_instance();
+}
+inline ::cockroach::proto::InternalGCResponse* ReadWriteCmdResponse::mutable_internal_gc() {
+  if (!has_internal_gc()) {
+    clear_value();
+    set_has_internal_gc();
+    value_.internal_gc_ = new ::cockroach::proto::InternalGCResponse;
+  }
+  return value_.internal_gc_;
+}
+inline ::cockroach::proto::InternalGCResponse* ReadWriteCmdResponse::release_internal_gc() {
+  if (has_internal_gc()) {
+    clear_has_value();
+    ::cockroach::proto::InternalGCResponse* temp = value_.internal_gc_;
+    value_.internal_gc_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_internal_gc(::cockroach::proto::InternalGCResponse* internal_gc) {
+  clear_value();
+  if (internal_gc) {
+    set_has_internal_gc();
+    value_.internal_gc_ = internal_gc;
+  }
+}
+
+// optional .cockroach.proto.ClearRangeResponse clear_range = 16;
+inline bool ReadWriteCmdResponse::has_clear_range() const {
+  return value_case() == kClearRange;
+}
+inline void ReadWriteCmdResponse::set_has_clear_range() {
+  _oneof_case_[0] = kClearRange;
+}
+inline void ReadWriteCmdResponse::clear_clear_range() {
+  if (has_clear_range()) {
+    delete value_.clear_range_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ClearRangeResponse& ReadWriteCmdResponse::clear_range() const {
+  return has_clear_range() ? *value_.clear_range_
+                      : ::cockroach::proto::ClearRangeResponse::default_instance();
+}
+inline ::cockroach::proto::ClearRangeResponse* ReadWriteCmdResponse::mutable_clear_range() {
+  if (!has_clear_range()) {
+    clear_value();
+    set_has_clear_range();
+    value_.clear_range_ = new ::cockroach::proto::ClearRangeResponse;
+  }
+  return value_.clear_range_;
+}
+inline ::cockroach::proto::ClearRangeResponse* ReadWriteCmdResponse::release_clear_range() {
+  if (has_clear_range()) {
+    clear_has_value();
+    ::cockroach::proto::ClearRangeResponse* temp = value_.clear_range_;
+    value_.clear_range_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_truncate_log(::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log) {
+inline void ReadWriteCmdResponse::set_allocated_clear_range(::cockroach::proto::ClearRangeResponse* clear_range) {
   clear_value();
-  if (internal_truncate_log) {
-    set_has_internal_truncate_log();
-    value_.internal_truncate_log_ = internal_truncate_log;
+  if (clear_range) {
+    set_has_clear_range();
+    value_.clear_range_ = clear_range;
   }
 }
 
-// optional .cockroach.proto.InternalGCResponse internal_gc = 15;
-inline bool ReadWriteCmdResponse::has_internal_gc() const {
-  return value_case() == kInternalGc;
+// optional .cockroach.proto.BatchResponse batch = 17;
+inline bool ReadWriteCmdResponse::has_batch() const {
+  return value_case() == kBatch;
 }
-inline void ReadWriteCmdResponse::set_has_internal_gc() {
-  _oneof_case_[0] = kInternalGc;
+inline void ReadWriteCmdResponse::set_has_batch() {
+  _oneof_case_[0] = kBatch;
 }
-inline void ReadWriteCmdResponse::clear_internal_gc() {
-  if (has_internal_gc()) {
-    delete value_.internal_gc_;
+inline void ReadWriteCmdResponse::clear_batch() {
+  if (has_batch()) {
+    delete value_.batch_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::InternalGCResponse& ReadWriteCmdResponse::internal_gc() const {
-  return has_internal_gc() ? *value_.internal_gc_
-                      : ::cockroach::proto::InternalGCResponse::default_instance();
+inline const ::cockroach::proto::BatchResponse& ReadWriteCmdResponse::batch() const {
+  return has_batch() ? *value_.batch_
+                      : ::cockroach::proto::BatchResponse::default_instance();
 }
-inline ::cockroach::proto::InternalGCResponse* ReadWriteCmdResponse::mutable_internal_gc() {
-  if (!has_internal_gc()) {
+inline ::cockroach::proto::BatchResponse* ReadWriteCmdResponse::mutable_batch() {
+  if (!has_batch()) {
     clear_value();
-    set_has_internal_gc();
-    value_.internal_gc_ = new ::cockroach::proto::InternalGCResponse;
+    set_has_batch();
+    value_.batch_ = new ::cockroach::proto::BatchResponse;
   }
-  return value_.internal_gc_;
+  return value_.batch_;
 }
-inline ::cockroach::proto::InternalGCResponse* ReadWriteCmdResponse::release_internal_gc() {
-  if (has_internal_gc()) {
+inline ::cockroach::proto::BatchResponse* ReadWriteCmdResponse::release_batch() {
+  if (has_batch()) {
     clear_has_value();
-    ::cockroach::proto::InternalGCResponse* temp = value_.internal_gc_;
-    value_.internal_gc_ = NULL;
+    ::cockroach::proto::BatchResponse* temp = value_.batch_;
+    value_.batch_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_gc(::cockroach::proto::InternalGCResponse* internal_gc) {
+inline void ReadWriteCmdResponse::set_allocated_batch(::cockroach::proto::BatchResponse* batch) {
   clear_value();
-  if (internal_gc) {
-    set_has_internal_gc();
-    value_.internal_gc_ = internal_gc;
+  if (batch) {
+    set_has_batch();
+    value_.batch_ = batch;
   }
 }
 
@@ -5007,6 +6676,92 @@ inline void InternalRaftCommandUnion::set_allocated_end_transaction(::cockroach:
   }
 }
 
+// optional .cockroach.proto.InitPutRequest init_put = 10;
+inline bool InternalRaftCommandUnion::has_init_put() const {
+  return value_case() == kInitPut;
+}
+inline void InternalRaftCommandUnion::set_has_init_put() {
+  _oneof_case_[0] = kInitPut;
+}
+inline void InternalRaftCommandUnion::clear_init_put() {
+  if (has_init_put()) {
+    delete value_.init_put_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InitPutRequest& InternalRaftCommandUnion::init_put() const {
+  return has_init_put() ? *value_.init_put_
+                      : ::cockroach::proto::InitPutRequest::default_instance();
+}
+inline ::cockroach::proto::InitPutRequest* InternalRaftCommandUnion::mutable_init_put() {
+  if (!has_init_put()) {
+    clear_value();
+    set_has_init_put();
+    value_.init_put_ = new ::cockroach::proto::InitPutRequest;
+  }
+  return value_.init_put_;
+}
+inline ::cockroach::proto::InitPutRequest* InternalRaftCommandUnion::release_init_put() {
+  if (has_init_put()) {
+    clear_has_value();
+    ::cockroach::proto::InitPutRequest* temp = value_.init_put_;
+    value_.init_put_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_init_put(::cockroach::proto::InitPutRequest* init_put) {
+  clear_value();
+  if (init_put) {
+    set_has_init_put();
+    value_.init_put_ = init_put;
+  }
+}
+
+// optional .cockroach.proto.AggregateRequest aggregate = 11;
+inline bool InternalRaftCommandUnion::has_aggregate() const {
+  return value_case() == kAggregate;
+}
+inline void InternalRaftCommandUnion::set_has_aggregate() {
+  _oneof_case_[0] = kAggregate;
+}
+inline void InternalRaftCommandUnion::clear_aggregate() {
+  if (has_aggregate()) {
+    delete value_.aggregate_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::AggregateRequest& InternalRaftCommandUnion::aggregate() const {
+  return has_aggregate() ? *value_.aggregate_
+                      : ::cockroach::proto::AggregateRequest::default_instance();
+}
+inline ::cockroach::proto::AggregateRequest* InternalRaftCommandUnion::mutable_aggregate() {
+  if (!has_aggregate()) {
+    clear_value();
+    set_has_aggregate();
+    value_.aggregate_ = new ::cockroach::proto::AggregateRequest;
+  }
+  return value_.aggregate_;
+}
+inline ::cockroach::proto::AggregateRequest* InternalRaftCommandUnion::release_aggregate() {
+  if (has_aggregate()) {
+    clear_has_value();
+    ::cockroach::proto::AggregateRequest* temp = value_.aggregate_;
+    value_.aggregate_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_aggregate(::cockroach::proto::AggregateRequest* aggregate) {
+  clear_value();
+  if (aggregate) {
+    set_has_aggregate();
+    value_.aggregate_ = aggregate;
+  }
+}
+
 // optional .cockroach.proto.BatchRequest batch = 30;
 inline bool InternalRaftCommandUnion::has_batch() const {
   return value_case() == kBatch;
@@ -5394,6 +7149,178 @@ inline void InternalRaftCommandUnion::set_allocated_internal_lease(::cockroach::
   }
 }
 
+// optional .cockroach.proto.InternalCheckConsistencyRequest internal_check_consistency = 39;
+inline bool InternalRaftCommandUnion::has_internal_check_consistency() const {
+  return value_case() == kInternalCheckConsistency;
+}
+inline void InternalRaftCommandUnion::set_has_internal_check_consistency() {
+  _oneof_case_[0] = kInternalCheckConsistency;
+}
+inline void InternalRaftCommandUnion::clear_internal_check_consistency() {
+  if (has_internal_check_consistency()) {
+    delete value_.internal_check_consistency_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalCheckConsistencyRequest& InternalRaftCommandUnion::internal_check_consistency() const {
+  return has_internal_check_consistency() ? *value_.internal_check_consistency_
+                      : ::cockroach::proto::InternalCheckConsistencyRequest::default_instance();
+}
+inline ::cockroach::proto::InternalCheckConsistencyRequest* InternalRaftCommandUnion::mutable_internal_check_consistency() {
+  if (!has_internal_check_consistency()) {
+    clear_value();
+    set_has_internal_check_consistency();
+    value_.internal_check_consistency_ = new ::cockroach::proto::InternalCheckConsistencyRequest;
+  }
+  return value_.internal_check_consistency_;
+}
+inline ::cockroach::proto::InternalCheckConsistencyRequest* InternalRaftCommandUnion::release_internal_check_consistency() {
+  if (has_internal_check_consistency()) {
+    clear_has_value();
+    ::cockroach::proto::InternalCheckConsistencyRequest* temp = value_.internal_check_consistency_;
+    value_.internal_check_consistency_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_check_consistency(::cockroach::proto::InternalCheckConsistencyRequest* internal_check_consistency) {
+  clear_value();
+  if (internal_check_consistency) {
+    set_has_internal_check_consistency();
+    value_.internal_check_consistency_ = internal_check_consistency;
+  }
+}
+
+// optional .cockroach.proto.InternalRecomputeStatsRequest internal_recompute_stats = 40;
+inline bool InternalRaftCommandUnion::has_internal_recompute_stats() const {
+  return value_case() == kInternalRecomputeStats;
+}
+inline void InternalRaftCommandUnion::set_has_internal_recompute_stats() {
+  _oneof_case_[0] = kInternalRecomputeStats;
+}
+inline void InternalRaftCommandUnion::clear_internal_recompute_stats() {
+  if (has_internal_recompute_stats()) {
+    delete value_.internal_recompute_stats_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalRecomputeStatsRequest& InternalRaftCommandUnion::internal_recompute_stats() const {
+  return has_internal_recompute_stats() ? *value_.internal_recompute_stats_
+                      : ::cockroach::proto::InternalRecomputeStatsRequest::default_instance();
+}
+inline ::cockroach::proto::InternalRecomputeStatsRequest* InternalRaftCommandUnion::mutable_internal_recompute_stats() {
+  if (!has_internal_recompute_stats()) {
+    clear_value();
+    set_has_internal_recompute_stats();
+    value_.internal_recompute_stats_ = new ::cockroach::proto::InternalRecomputeStatsRequest;
+  }
+  return value_.internal_recompute_stats_;
+}
+inline ::cockroach::proto::InternalRecomputeStatsRequest* InternalRaftCommandUnion::release_internal_recompute_stats() {
+  if (has_internal_recompute_stats()) {
+    clear_has_value();
+    ::cockroach::proto::InternalRecomputeStatsRequest* temp = value_.internal_recompute_stats_;
+    value_.internal_recompute_stats_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_recompute_stats(::cockroach::proto::InternalRecomputeStatsRequest* internal_recompute_stats) {
+  clear_value();
+  if (internal_recompute_stats) {
+    set_has_internal_recompute_stats();
+    value_.internal_recompute_stats_ = internal_recompute_stats;
+  }
+}
+
+// optional .cockroach.proto.ClearRangeRequest clear_range = 41;
+inline bool InternalRaftCommandUnion::has_clear_range() const {
+  return value_case() == kClearRange;
+}
+inline void InternalRaftCommandUnion::set_has_clear_range() {
+  _oneof_case_[0] = kClearRange;
+}
+inline void InternalRaftCommandUnion::clear_clear_range() {
+  if (has_clear_range()) {
+    delete value_.clear_range_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ClearRangeRequest& InternalRaftCommandUnion::clear_range() const {
+  return has_clear_range() ? *value_.clear_range_
+                      : ::cockroach::proto::ClearRangeRequest::default_instance();
+}
+inline ::cockroach::proto::ClearRangeRequest* InternalRaftCommandUnion::mutable_clear_range() {
+  if (!has_clear_range()) {
+    clear_value();
+    set_has_clear_range();
+    value_.clear_range_ = new ::cockroach::proto::ClearRangeRequest;
+  }
+  return value_.clear_range_;
+}
+inline ::cockroach::proto::ClearRangeRequest* InternalRaftCommandUnion::release_clear_range() {
+  if (has_clear_range()) {
+    clear_has_value();
+    ::cockroach::proto::ClearRangeRequest* temp = value_.clear_range_;
+    value_.clear_range_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_clear_range(::cockroach::proto::ClearRangeRequest* clear_range) {
+  clear_value();
+  if (clear_range) {
+    set_has_clear_range();
+    value_.clear_range_ = clear_range;
+  }
+}
+
+// optional .cockroach.proto.InternalRefreshRangeRequest internal_refresh_range = 42;
+inline bool InternalRaftCommandUnion::has_internal_refresh_range() const {
+  return value_case() == kInternalRefreshRange;
+}
+inline void InternalRaftCommandUnion::set_has_internal_refresh_range() {
+  _oneof_case_[0] = kInternalRefreshRange;
+}
+inline void InternalRaftCommandUnion::clear_internal_refresh_range() {
+  if (has_internal_refresh_range()) {
+    delete value_.internal_refresh_range_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalRefreshRangeRequest& InternalRaftCommandUnion::internal_refresh_range() const {
+  return has_internal_refresh_range() ? *value_.internal_refresh_range_
+                      : ::cockroach::proto::InternalRefreshRangeRequest::default_instance();
+}
+inline ::cockroach::proto::InternalRefreshRangeRequest* InternalRaftCommandUnion::mutable_internal_refresh_range() {
+  if (!has_internal_refresh_range()) {
+    clear_value();
+    set_has_internal_refresh_range();
+    value_.internal_refresh_range_ = new ::cockroach::proto::InternalRefreshRangeRequest;
+  }
+  return value_.internal_refresh_range_;
+}
+inline ::cockroach::proto::InternalRefreshRangeRequest* InternalRaftCommandUnion::release_internal_refresh_range() {
+  if (has_internal_refresh_range()) {
+    clear_has_value();
+    ::cockroach::proto::InternalRefreshRangeRequest* temp = value_.internal_refresh_range_;
+    value_.internal_refresh_range_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_refresh_range(::cockroach::proto::InternalRefreshRangeRequest* internal_refresh_range) {
+  clear_value();
+  if (internal_refresh_range) {
+    set_has_internal_refresh_range();
+    value_.internal_refresh_range_ = internal_refresh_range;
+  }
+}
+
 inline bool InternalRaftCommandUnion::has_value() {
   return value_case() != VALUE_NOT_SET;
 }
@@ -5472,6 +7399,112 @@ inline void InternalRaftCommand::set_allocated_cmd(::cockroach::proto::InternalR
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.cmd)
 }
 
+// optional .cockroach.proto.Timestamp closed_timestamp = 4;
+inline bool InternalRaftCommand::has_closed_timestamp() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalRaftCommand::set_has_closed_timestamp() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalRaftCommand::clear_has_closed_timestamp() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalRaftCommand::clear_closed_timestamp() {
+  if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_closed_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& InternalRaftCommand::closed_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommand.closed_timestamp)
+  return closed_timestamp_ != NULL ? *closed_timestamp_ : *default_instance_->closed_timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalRaftCommand::mutable_closed_timestamp() {
+  set_has_closed_timestamp();
+  if (closed_timestamp_ == NULL) closed_timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommand.closed_timestamp)
+  return closed_timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalRaftCommand::release_closed_timestamp() {
+  clear_has_closed_timestamp();
+  ::cockroach::proto::Timestamp* temp = closed_timestamp_;
+  closed_timestamp_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommand::set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp) {
+  delete closed_timestamp_;
+  closed_timestamp_ = closed_timestamp;
+  if (closed_timestamp) {
+    set_has_closed_timestamp();
+  } else {
+    clear_has_closed_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.closed_timestamp)
+}
+
+// optional uint64 max_lease_index = 5;
+inline bool InternalRaftCommand::has_max_lease_index() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalRaftCommand::set_has_max_lease_index() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalRaftCommand::clear_has_max_lease_index() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalRaftCommand::clear_max_lease_index() {
+  max_lease_index_ = GOOGLE_ULONGLONG(0);
+  clear_has_max_lease_index();
+}
+inline ::google::protobuf::uint64 InternalRaftCommand::max_lease_index() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommand.max_lease_index)
+  return max_lease_index_;
+}
+inline void InternalRaftCommand::set_max_lease_index(::google::protobuf::uint64 value) {
+  set_has_max_lease_index();
+  max_lease_index_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRaftCommand.max_lease_index)
+}
+
+// optional .cockroach.proto.Lease proposer_lease = 6;
+inline bool InternalRaftCommand::has_proposer_lease() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void InternalRaftCommand::set_has_proposer_lease() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void InternalRaftCommand::clear_has_proposer_lease() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void InternalRaftCommand::clear_proposer_lease() {
+  if (proposer_lease_ != NULL) proposer_lease_->::cockroach::proto::Lease::Clear();
+  clear_has_proposer_lease();
+}
+inline const ::cockroach::proto::Lease& InternalRaftCommand::proposer_lease() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommand.proposer_lease)
+  return proposer_lease_ != NULL ? *proposer_lease_ : *default_instance_->proposer_lease_;
+}
+inline ::cockroach::proto::Lease* InternalRaftCommand::mutable_proposer_lease() {
+  set_has_proposer_lease();
+  if (proposer_lease_ == NULL) proposer_lease_ = new ::cockroach::proto::Lease;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommand.proposer_lease)
+  return proposer_lease_;
+}
+inline ::cockroach::proto::Lease* InternalRaftCommand::release_proposer_lease() {
+  clear_has_proposer_lease();
+  ::cockroach::proto::Lease* temp = proposer_lease_;
+  proposer_lease_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommand::set_allocated_proposer_lease(::cockroach::proto::Lease* proposer_lease) {
+  delete proposer_lease_;
+  proposer_lease_ = proposer_lease;
+  if (proposer_lease) {
+    set_has_proposer_lease();
+  } else {
+    clear_has_proposer_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommand.proposer_lease)
+}
+
 // -------------------------------------------------------------------
 
 // RaftMessageRequest
@@ -5578,6 +7611,86 @@ inline void RaftMessageRequest::set_allocated_msg(::std::string* msg) {
 
 // -------------------------------------------------------------------
 
+// ReserveSnapshotRequest
+
+// optional uint64 group_id = 1;
+inline bool ReserveSnapshotRequest::has_group_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ReserveSnapshotRequest::set_has_group_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ReserveSnapshotRequest::clear_has_group_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ReserveSnapshotRequest::clear_group_id() {
+  group_id_ = GOOGLE_ULONGLONG(0);
+  clear_has_group_id();
+}
+inline ::google::protobuf::uint64 ReserveSnapshotRequest::group_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReserveSnapshotRequest.group_id)
+  return group_id_;
+}
+inline void ReserveSnapshotRequest::set_group_id(::google::protobuf::uint64 value) {
+  set_has_group_id();
+  group_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReserveSnapshotRequest.group_id)
+}
+
+// optional uint64 node_id = 2;
+inline bool ReserveSnapshotRequest::has_node_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ReserveSnapshotRequest::set_has_node_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ReserveSnapshotRequest::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ReserveSnapshotRequest::clear_node_id() {
+  node_id_ = GOOGLE_ULONGLONG(0);
+  clear_has_node_id();
+}
+inline ::google::protobuf::uint64 ReserveSnapshotRequest::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReserveSnapshotRequest.node_id)
+  return node_id_;
+}
+inline void ReserveSnapshotRequest::set_node_id(::google::protobuf::uint64 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReserveSnapshotRequest.node_id)
+}
+
+// -------------------------------------------------------------------
+
+// ReserveSnapshotResponse
+
+// optional bool reserved = 1;
+inline bool ReserveSnapshotResponse::has_reserved() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ReserveSnapshotResponse::set_has_reserved() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ReserveSnapshotResponse::clear_has_reserved() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ReserveSnapshotResponse::clear_reserved() {
+  reserved_ = false;
+  clear_has_reserved();
+}
+inline bool ReserveSnapshotResponse::reserved() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReserveSnapshotResponse.reserved)
+  return reserved_;
+}
+inline void ReserveSnapshotResponse::set_reserved(bool value) {
+  set_has_reserved();
+  reserved_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ReserveSnapshotResponse.reserved)
+}
+
+// -------------------------------------------------------------------
+
 // RaftMessageResponse
 
 // -------------------------------------------------------------------
@@ -6124,6 +8237,64 @@ RaftSnapshotData::mutable_kv() {
   return &kv_;
 }
 
+// -------------------------------------------------------------------
+
+// GossipBootstrapInfo
+
+// repeated string addresses = 1;
+inline int GossipBootstrapInfo::addresses_size() const {
+  return addresses_.size();
+}
+inline void GossipBootstrapInfo::clear_addresses() {
+  addresses_.Clear();
+}
+inline const ::std::string& GossipBootstrapInfo::addresses(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GossipBootstrapInfo.addresses)
+  return addresses_.Get(index);
+}
+inline ::std::string* GossipBootstrapInfo::mutable_addresses(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GossipBootstrapInfo.addresses)
+  return addresses_.Mutable(index);
+}
+inline void GossipBootstrapInfo::set_addresses(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.GossipBootstrapInfo.addresses)
+  addresses_.Mutable(index)->assign(value);
+}
+inline void GossipBootstrapInfo::set_addresses(int index, const char* value) {
+  addresses_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.GossipBootstrapInfo.addresses)
+}
+inline void GossipBootstrapInfo::set_addresses(int index, const char* value, size_t size) {
+  addresses_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.GossipBootstrapInfo.addresses)
+}
+inline ::std::string* GossipBootstrapInfo::add_addresses() {
+  return addresses_.Add();
+}
+inline void GossipBootstrapInfo::add_addresses(const ::std::string& value) {
+  addresses_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.GossipBootstrapInfo.addresses)
+}
+inline void GossipBootstrapInfo::add_addresses(const char* value) {
+  addresses_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.GossipBootstrapInfo.addresses)
+}
+inline void GossipBootstrapInfo::add_addresses(const char* value, size_t size) {
+  addresses_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.GossipBootstrapInfo.addresses)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+GossipBootstrapInfo::addresses() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.GossipBootstrapInfo.addresses)
+  return addresses_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+GossipBootstrapInfo::mutable_addresses() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.GossipBootstrapInfo.addresses)
+  return &addresses_;
+}
+
 
 // @@protoc_insertion_point(namespace_scope)
 
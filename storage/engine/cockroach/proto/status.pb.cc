@@ -24,6 +24,27 @@ namespace {
 const ::google::protobuf::Descriptor* StoreStatus_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   StoreStatus_reflection_ = NULL;
+const ::google::protobuf::Descriptor* EngineStats_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  EngineStats_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RequestLatency_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RequestLatency_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RestoreStatus_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RestoreStatus_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StatusDetailsRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StatusDetailsRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StatusDetailsResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StatusDetailsResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StatusStoresRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StatusStoresRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StatusStoresResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StatusStoresResponse_reflection_ = NULL;
 
 }  // namespace
 
@@ -35,13 +56,20 @@ void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto() {
       "cockroach/proto/status.proto");
   GOOGLE_CHECK(file != NULL);
   StoreStatus_descriptor_ = file->message_type(0);
-  static const int StoreStatus_offsets_[6] = {
+  static const int StoreStatus_offsets_[13] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, store_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, node_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, range_count_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, started_at_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, updated_at_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, stats_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, engine_stats_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, corrupt_raft_ids_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, request_latencies_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, capacity_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, available_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, leader_range_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreStatus, raft_log_size_),
   };
   StoreStatus_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -54,6 +82,129 @@ void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(StoreStatus));
+  EngineStats_descriptor_ = file->message_type(1);
+  static const int EngineStats_offsets_[7] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, files_at_level_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, compaction_pending_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, memtable_size_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, block_cache_hits_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, block_cache_misses_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, block_cache_usage_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, value_checksum_mismatches_),
+  };
+  EngineStats_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      EngineStats_descriptor_,
+      EngineStats::default_instance_,
+      EngineStats_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EngineStats, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(EngineStats));
+  RequestLatency_descriptor_ = file->message_type(2);
+  static const int RequestLatency_offsets_[6] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, method_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, p50_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, p95_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, p99_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, max_),
+  };
+  RequestLatency_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      RequestLatency_descriptor_,
+      RequestLatency::default_instance_,
+      RequestLatency_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestLatency, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(RequestLatency));
+  RestoreStatus_descriptor_ = file->message_type(3);
+  static const int RestoreStatus_offsets_[8] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, uri_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, total_files_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, restored_files_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, restored_keys_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, started_at_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, updated_at_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, done_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, error_),
+  };
+  RestoreStatus_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      RestoreStatus_descriptor_,
+      RestoreStatus::default_instance_,
+      RestoreStatus_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RestoreStatus, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(RestoreStatus));
+  StatusDetailsRequest_descriptor_ = file->message_type(4);
+  static const int StatusDetailsRequest_offsets_[1] = {
+  };
+  StatusDetailsRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StatusDetailsRequest_descriptor_,
+      StatusDetailsRequest::default_instance_,
+      StatusDetailsRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StatusDetailsRequest));
+  StatusDetailsResponse_descriptor_ = file->message_type(5);
+  static const int StatusDetailsResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsResponse, node_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsResponse, build_tag_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsResponse, request_latencies_),
+  };
+  StatusDetailsResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StatusDetailsResponse_descriptor_,
+      StatusDetailsResponse::default_instance_,
+      StatusDetailsResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusDetailsResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StatusDetailsResponse));
+  StatusStoresRequest_descriptor_ = file->message_type(6);
+  static const int StatusStoresRequest_offsets_[1] = {
+  };
+  StatusStoresRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StatusStoresRequest_descriptor_,
+      StatusStoresRequest::default_instance_,
+      StatusStoresRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusStoresRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusStoresRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StatusStoresRequest));
+  StatusStoresResponse_descriptor_ = file->message_type(7);
+  static const int StatusStoresResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusStoresResponse, stores_),
+  };
+  StatusStoresResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StatusStoresResponse_descriptor_,
+      StatusStoresResponse::default_instance_,
+      StatusStoresResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusStoresResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StatusStoresResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StatusStoresResponse));
 }
 
 namespace {
@@ -68,6 +219,20 @@ void protobuf_RegisterTypes(const ::std::string&) {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     StoreStatus_descriptor_, &StoreStatus::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    EngineStats_descriptor_, &EngineStats::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    RequestLatency_descriptor_, &RequestLatency::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    RestoreStatus_descriptor_, &RestoreStatus::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StatusDetailsRequest_descriptor_, &StatusDetailsRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StatusDetailsResponse_descriptor_, &StatusDetailsResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StatusStoresRequest_descriptor_, &StatusStoresRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StatusStoresResponse_descriptor_, &StatusStoresResponse::default_instance());
 }
 
 }  // namespace
@@ -75,6 +240,20 @@ void protobuf_RegisterTypes(const ::std::string&) {
 void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto() {
   delete StoreStatus::default_instance_;
   delete StoreStatus_reflection_;
+  delete EngineStats::default_instance_;
+  delete EngineStats_reflection_;
+  delete RequestLatency::default_instance_;
+  delete RequestLatency_reflection_;
+  delete RestoreStatus::default_instance_;
+  delete RestoreStatus_reflection_;
+  delete StatusDetailsRequest::default_instance_;
+  delete StatusDetailsRequest_reflection_;
+  delete StatusDetailsResponse::default_instance_;
+  delete StatusDetailsResponse_reflection_;
+  delete StatusStoresRequest::default_instance_;
+  delete StatusStoresRequest_reflection_;
+  delete StatusStoresResponse::default_instance_;
+  delete StatusStoresResponse_reflection_;
 }
 
 void protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto() {
@@ -88,17 +267,65 @@ void protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto() {
   ::google::protobuf::DescriptorPool::InternalAddGeneratedFile(
     "\n\034cockroach/proto/status.proto\022\017cockroac"
     "h.proto\032\032cockroach/proto/data.proto\032\024gog"
-    "oproto/gogo.proto\"\346\001\n\013StoreStatus\022,\n\010sto"
+    "oproto/gogo.proto\"\374\003\n\013StoreStatus\022,\n\010sto"
     "re_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007StoreID\022"
     ")\n\007node_id\030\002 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006Node"
     "ID\022\031\n\013range_count\030\003 \001(\005B\004\310\336\037\000\022\030\n\nstarted"
     "_at\030\004 \001(\003B\004\310\336\037\000\022\030\n\nupdated_at\030\005 \001(\003B\004\310\336\037"
     "\000\022/\n\005stats\030\006 \001(\0132\032.cockroach.proto.MVCCS"
-    "tatsB\004\310\336\037\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 351);
+    "tatsB\004\310\336\037\000\0222\n\014engine_stats\030\007 \001(\0132\034.cockr"
+    "oach.proto.EngineStats\022.\n\020corrupt_raft_i"
+    "ds\030\010 \003(\003B\024\020\001\342\336\037\016CorruptRaftIDs\022@\n\021reques"
+    "t_latencies\030\t \003(\0132\037.cockroach.proto.Requ"
+    "estLatencyB\004\310\336\037\000\022\026\n\010capacity\030\n \001(\003B\004\310\336\037\000"
+    "\022\027\n\tavailable\030\013 \001(\003B\004\310\336\037\000\022 \n\022leader_rang"
+    "e_count\030\014 \001(\005B\004\310\336\037\000\022\033\n\rraft_log_size\030\r \001"
+    "(\003B\004\310\336\037\000\"\364\001\n\013EngineStats\022\032\n\016files_at_lev"
+    "el\030\001 \003(\003B\002\020\001\022 \n\022compaction_pending\030\002 \001(\010"
+    "B\004\310\336\037\000\022\033\n\rmemtable_size\030\003 \001(\003B\004\310\336\037\000\022\036\n\020b"
+    "lock_cache_hits\030\004 \001(\003B\004\310\336\037\000\022 \n\022block_cac"
+    "he_misses\030\005 \001(\003B\004\310\336\037\000\022\037\n\021block_cache_usa"
+    "ge\030\006 \001(\003B\004\310\336\037\000\022\'\n\031value_checksum_mismatc"
+    "hes\030\007 \001(\003B\004\310\336\037\000\"\207\001\n\016RequestLatency\022\024\n\006me"
+    "thod\030\001 \001(\tB\004\310\336\037\000\022\023\n\005count\030\002 \001(\003B\004\310\336\037\000\022\021\n"
+    "\003p50\030\003 \001(\003B\004\310\336\037\000\022\021\n\003p95\030\004 \001(\003B\004\310\336\037\000\022\021\n\003p"
+    "99\030\005 \001(\003B\004\310\336\037\000\022\021\n\003max\030\006 \001(\003B\004\310\336\037\000\"\334\001\n\rRe"
+    "storeStatus\022\030\n\003uri\030\001 \001(\tB\013\310\336\037\000\342\336\037\003URI\022\031\n"
+    "\013total_files\030\002 \001(\005B\004\310\336\037\000\022\034\n\016restored_fil"
+    "es\030\003 \001(\005B\004\310\336\037\000\022\033\n\rrestored_keys\030\004 \001(\003B\004\310"
+    "\336\037\000\022\030\n\nstarted_at\030\005 \001(\003B\004\310\336\037\000\022\030\n\nupdated"
+    "_at\030\006 \001(\003B\004\310\336\037\000\022\022\n\004done\030\007 \001(\010B\004\310\336\037\000\022\023\n\005e"
+    "rror\030\010 \001(\tB\004\310\336\037\000\"\026\n\024StatusDetailsRequest"
+    "\"\235\001\n\025StatusDetailsResponse\022)\n\007node_id\030\001 "
+    "\001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006NodeID\022\027\n\tbuild_t"
+    "ag\030\002 \001(\tB\004\310\336\037\000\022@\n\021request_latencies\030\003 \003("
+    "\0132\037.cockroach.proto.RequestLatencyB\004\310\336\037\000"
+    "\"\025\n\023StatusStoresRequest\"J\n\024StatusStoresR"
+    "esponse\0222\n\006stores\030\001 \003(\0132\034.cockroach.prot"
+    "o.StoreStatusB\004\310\336\037\0002\271\001\n\006Status\022X\n\007Detail"
+    "s\022%.cockroach.proto.StatusDetailsRequest"
+    "\032&.cockroach.proto.StatusDetailsResponse"
+    "\022U\n\006Stores\022$.cockroach.proto.StatusStore"
+    "sRequest\032%.cockroach.proto.StatusStoresR"
+    "esponseB\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 1708);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/status.proto", &protobuf_RegisterTypes);
   StoreStatus::default_instance_ = new StoreStatus();
+  EngineStats::default_instance_ = new EngineStats();
+  RequestLatency::default_instance_ = new RequestLatency();
+  RestoreStatus::default_instance_ = new RestoreStatus();
+  StatusDetailsRequest::default_instance_ = new StatusDetailsRequest();
+  StatusDetailsResponse::default_instance_ = new StatusDetailsResponse();
+  StatusStoresRequest::default_instance_ = new StatusStoresRequest();
+  StatusStoresResponse::default_instance_ = new StatusStoresResponse();
   StoreStatus::default_instance_->InitAsDefaultInstance();
+  EngineStats::default_instance_->InitAsDefaultInstance();
+  RequestLatency::default_instance_->InitAsDefaultInstance();
+  RestoreStatus::default_instance_->InitAsDefaultInstance();
+  StatusDetailsRequest::default_instance_->InitAsDefaultInstance();
+  StatusDetailsResponse::default_instance_->InitAsDefaultInstance();
+  StatusStoresRequest::default_instance_->InitAsDefaultInstance();
+  StatusStoresResponse::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto);
 }
 
@@ -118,6 +345,13 @@ const int StoreStatus::kRangeCountFieldNumber;
 const int StoreStatus::kStartedAtFieldNumber;
 const int StoreStatus::kUpdatedAtFieldNumber;
 const int StoreStatus::kStatsFieldNumber;
+const int StoreStatus::kEngineStatsFieldNumber;
+const int StoreStatus::kCorruptRaftIdsFieldNumber;
+const int StoreStatus::kRequestLatenciesFieldNumber;
+const int StoreStatus::kCapacityFieldNumber;
+const int StoreStatus::kAvailableFieldNumber;
+const int StoreStatus::kLeaderRangeCountFieldNumber;
+const int StoreStatus::kRaftLogSizeFieldNumber;
 #endif  // !_MSC_VER
 
 StoreStatus::StoreStatus()
@@ -128,6 +362,7 @@ StoreStatus::StoreStatus()
 
 void StoreStatus::InitAsDefaultInstance() {
   stats_ = const_cast< ::cockroach::proto::MVCCStats*>(&::cockroach::proto::MVCCStats::default_instance());
+  engine_stats_ = const_cast< ::cockroach::proto::EngineStats*>(&::cockroach::proto::EngineStats::default_instance());
 }
 
 StoreStatus::StoreStatus(const StoreStatus& from)
@@ -145,6 +380,11 @@ void StoreStatus::SharedCtor() {
   started_at_ = GOOGLE_LONGLONG(0);
   updated_at_ = GOOGLE_LONGLONG(0);
   stats_ = NULL;
+  engine_stats_ = NULL;
+  capacity_ = GOOGLE_LONGLONG(0);
+  available_ = GOOGLE_LONGLONG(0);
+  leader_range_count_ = 0;
+  raft_log_size_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -156,6 +396,7 @@ StoreStatus::~StoreStatus() {
 void StoreStatus::SharedDtor() {
   if (this != default_instance_) {
     delete stats_;
+    delete engine_stats_;
   }
 }
 
@@ -191,17 +432,26 @@ void StoreStatus::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 63) {
+  if (_has_bits_[0 / 32] & 127) {
     ZR_(store_id_, updated_at_);
     range_count_ = 0;
     if (has_stats()) {
       if (stats_ != NULL) stats_->::cockroach::proto::MVCCStats::Clear();
     }
+    if (has_engine_stats()) {
+      if (engine_stats_ != NULL) engine_stats_->::cockroach::proto::EngineStats::Clear();
+    }
+  }
+  if (_has_bits_[8 / 32] & 7680) {
+    ZR_(capacity_, raft_log_size_);
+    leader_range_count_ = 0;
   }
 
 #undef OFFSET_OF_FIELD_
 #undef ZR_
 
+  corrupt_raft_ids_.Clear();
+  request_latencies_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -299,6 +549,111 @@ bool StoreStatus::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(58)) goto parse_engine_stats;
+        break;
+      }
+
+      // optional .cockroach.proto.EngineStats engine_stats = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_engine_stats:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_engine_stats()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_corrupt_raft_ids;
+        break;
+      }
+
+      // repeated int64 corrupt_raft_ids = 8 [packed = true];
+      case 8: {
+        if (tag == 66) {
+         parse_corrupt_raft_ids:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, this->mutable_corrupt_raft_ids())));
+        } else if (tag == 64) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitiveNoInline<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 1, 66, input, this->mutable_corrupt_raft_ids())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_request_latencies;
+        break;
+      }
+
+      // repeated .cockroach.proto.RequestLatency request_latencies = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_request_latencies:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_request_latencies()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_request_latencies;
+        if (input->ExpectTag(80)) goto parse_capacity;
+        break;
+      }
+
+      // optional int64 capacity = 10;
+      case 10: {
+        if (tag == 80) {
+         parse_capacity:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &capacity_)));
+          set_has_capacity();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(88)) goto parse_available;
+        break;
+      }
+
+      // optional int64 available = 11;
+      case 11: {
+        if (tag == 88) {
+         parse_available:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &available_)));
+          set_has_available();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(96)) goto parse_leader_range_count;
+        break;
+      }
+
+      // optional int32 leader_range_count = 12;
+      case 12: {
+        if (tag == 96) {
+         parse_leader_range_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &leader_range_count_)));
+          set_has_leader_range_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(104)) goto parse_raft_log_size;
+        break;
+      }
+
+      // optional int64 raft_log_size = 13;
+      case 13: {
+        if (tag == 104) {
+         parse_raft_log_size:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &raft_log_size_)));
+          set_has_raft_log_size();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -359,6 +714,48 @@ void StoreStatus::SerializeWithCachedSizes(
       6, this->stats(), output);
   }
 
+  // optional .cockroach.proto.EngineStats engine_stats = 7;
+  if (has_engine_stats()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->engine_stats(), output);
+  }
+
+  // repeated int64 corrupt_raft_ids = 8 [packed = true];
+  if (this->corrupt_raft_ids_size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteTag(8, ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED, output);
+    output->WriteVarint32(_corrupt_raft_ids_cached_byte_size_);
+  }
+  for (int i = 0; i < this->corrupt_raft_ids_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64NoTag(
+      this->corrupt_raft_ids(i), output);
+  }
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 9;
+  for (int i = 0; i < this->request_latencies_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->request_latencies(i), output);
+  }
+
+  // optional int64 capacity = 10;
+  if (has_capacity()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(10, this->capacity(), output);
+  }
+
+  // optional int64 available = 11;
+  if (has_available()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(11, this->available(), output);
+  }
+
+  // optional int32 leader_range_count = 12;
+  if (has_leader_range_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(12, this->leader_range_count(), output);
+  }
+
+  // optional int64 raft_log_size = 13;
+  if (has_raft_log_size()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(13, this->raft_log_size(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -401,6 +798,54 @@ void StoreStatus::SerializeWithCachedSizes(
         6, this->stats(), target);
   }
 
+  // optional .cockroach.proto.EngineStats engine_stats = 7;
+  if (has_engine_stats()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->engine_stats(), target);
+  }
+
+  // repeated int64 corrupt_raft_ids = 8 [packed = true];
+  if (this->corrupt_raft_ids_size() > 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteTagToArray(
+      8,
+      ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED,
+      target);
+    target = ::google::protobuf::io::CodedOutputStream::WriteVarint32ToArray(
+      _corrupt_raft_ids_cached_byte_size_, target);
+  }
+  for (int i = 0; i < this->corrupt_raft_ids_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteInt64NoTagToArray(this->corrupt_raft_ids(i), target);
+  }
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 9;
+  for (int i = 0; i < this->request_latencies_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->request_latencies(i), target);
+  }
+
+  // optional int64 capacity = 10;
+  if (has_capacity()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(10, this->capacity(), target);
+  }
+
+  // optional int64 available = 11;
+  if (has_available()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(11, this->available(), target);
+  }
+
+  // optional int32 leader_range_count = 12;
+  if (has_leader_range_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(12, this->leader_range_count(), target);
+  }
+
+  // optional int64 raft_log_size = 13;
+  if (has_raft_log_size()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(13, this->raft_log_size(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -455,7 +900,69 @@ int StoreStatus::ByteSize() const {
           this->stats());
     }
 
+    // optional .cockroach.proto.EngineStats engine_stats = 7;
+    if (has_engine_stats()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->engine_stats());
+    }
+
+  }
+  if (_has_bits_[9 / 32] & (0xffu << (9 % 32))) {
+    // optional int64 capacity = 10;
+    if (has_capacity()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->capacity());
+    }
+
+    // optional int64 available = 11;
+    if (has_available()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->available());
+    }
+
+    // optional int32 leader_range_count = 12;
+    if (has_leader_range_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->leader_range_count());
+    }
+
+    // optional int64 raft_log_size = 13;
+    if (has_raft_log_size()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->raft_log_size());
+    }
+
+  }
+  // repeated int64 corrupt_raft_ids = 8 [packed = true];
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->corrupt_raft_ids_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        Int64Size(this->corrupt_raft_ids(i));
+    }
+    if (data_size > 0) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(data_size);
+    }
+    GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+    _corrupt_raft_ids_cached_byte_size_ = data_size;
+    GOOGLE_SAFE_CONCURRENT_WRITES_END();
+    total_size += data_size;
   }
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 9;
+  total_size += 1 * this->request_latencies_size();
+  for (int i = 0; i < this->request_latencies_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->request_latencies(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -481,6 +988,8 @@ void StoreStatus::MergeFrom(const ::google::protobuf::Message& from) {
 
 void StoreStatus::MergeFrom(const StoreStatus& from) {
   GOOGLE_CHECK_NE(&from, this);
+  corrupt_raft_ids_.MergeFrom(from.corrupt_raft_ids_);
+  request_latencies_.MergeFrom(from.request_latencies_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_store_id()) {
       set_store_id(from.store_id());
@@ -500,6 +1009,23 @@ void StoreStatus::MergeFrom(const StoreStatus& from) {
     if (from.has_stats()) {
       mutable_stats()->::cockroach::proto::MVCCStats::MergeFrom(from.stats());
     }
+    if (from.has_engine_stats()) {
+      mutable_engine_stats()->::cockroach::proto::EngineStats::MergeFrom(from.engine_stats());
+    }
+  }
+  if (from._has_bits_[9 / 32] & (0xffu << (9 % 32))) {
+    if (from.has_capacity()) {
+      set_capacity(from.capacity());
+    }
+    if (from.has_available()) {
+      set_available(from.available());
+    }
+    if (from.has_leader_range_count()) {
+      set_leader_range_count(from.leader_range_count());
+    }
+    if (from.has_raft_log_size()) {
+      set_raft_log_size(from.raft_log_size());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -529,6 +1055,13 @@ void StoreStatus::Swap(StoreStatus* other) {
     std::swap(started_at_, other->started_at_);
     std::swap(updated_at_, other->updated_at_);
     std::swap(stats_, other->stats_);
+    std::swap(engine_stats_, other->engine_stats_);
+    corrupt_raft_ids_.Swap(&other->corrupt_raft_ids_);
+    request_latencies_.Swap(&other->request_latencies_);
+    std::swap(capacity_, other->capacity_);
+    std::swap(available_, other->available_);
+    std::swap(leader_range_count_, other->leader_range_count_);
+    std::swap(raft_log_size_, other->raft_log_size_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -544,6 +1077,2381 @@ void StoreStatus::Swap(StoreStatus* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EngineStats::kFilesAtLevelFieldNumber;
+const int EngineStats::kCompactionPendingFieldNumber;
+const int EngineStats::kMemtableSizeFieldNumber;
+const int EngineStats::kBlockCacheHitsFieldNumber;
+const int EngineStats::kBlockCacheMissesFieldNumber;
+const int EngineStats::kBlockCacheUsageFieldNumber;
+const int EngineStats::kValueChecksumMismatchesFieldNumber;
+#endif  // !_MSC_VER
+
+EngineStats::EngineStats()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.EngineStats)
+}
+
+void EngineStats::InitAsDefaultInstance() {
+}
+
+EngineStats::EngineStats(const EngineStats& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.EngineStats)
+}
+
+void EngineStats::SharedCtor() {
+  _cached_size_ = 0;
+  compaction_pending_ = false;
+  memtable_size_ = GOOGLE_LONGLONG(0);
+  block_cache_hits_ = GOOGLE_LONGLONG(0);
+  block_cache_misses_ = GOOGLE_LONGLONG(0);
+  block_cache_usage_ = GOOGLE_LONGLONG(0);
+  value_checksum_mismatches_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EngineStats::~EngineStats() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.EngineStats)
+  SharedDtor();
+}
+
+void EngineStats::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void EngineStats::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EngineStats::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EngineStats_descriptor_;
+}
+
+const EngineStats& EngineStats::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+EngineStats* EngineStats::default_instance_ = NULL;
+
+EngineStats* EngineStats::New() const {
+  return new EngineStats;
+}
+
+void EngineStats::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<EngineStats*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 126) {
+    ZR_(memtable_size_, compaction_pending_);
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  files_at_level_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool EngineStats::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.EngineStats)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // repeated int64 files_at_level = 1 [packed = true];
+      case 1: {
+        if (tag == 10) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, this->mutable_files_at_level())));
+        } else if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitiveNoInline<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 1, 10, input, this->mutable_files_at_level())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_compaction_pending;
+        break;
+      }
+
+      // optional bool compaction_pending = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_compaction_pending:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &compaction_pending_)));
+          set_has_compaction_pending();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_memtable_size;
+        break;
+      }
+
+      // optional int64 memtable_size = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_memtable_size:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &memtable_size_)));
+          set_has_memtable_size();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_block_cache_hits;
+        break;
+      }
+
+      // optional int64 block_cache_hits = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_block_cache_hits:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &block_cache_hits_)));
+          set_has_block_cache_hits();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_block_cache_misses;
+        break;
+      }
+
+      // optional int64 block_cache_misses = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_block_cache_misses:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &block_cache_misses_)));
+          set_has_block_cache_misses();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_block_cache_usage;
+        break;
+      }
+
+      // optional int64 block_cache_usage = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_block_cache_usage:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &block_cache_usage_)));
+          set_has_block_cache_usage();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_value_checksum_mismatches;
+        break;
+      }
+
+      // optional int64 value_checksum_mismatches = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_value_checksum_mismatches:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &value_checksum_mismatches_)));
+          set_has_value_checksum_mismatches();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.EngineStats)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.EngineStats)
+  return false;
+#undef DO_
+}
+
+void EngineStats::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.EngineStats)
+  // repeated int64 files_at_level = 1 [packed = true];
+  if (this->files_at_level_size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteTag(1, ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED, output);
+    output->WriteVarint32(_files_at_level_cached_byte_size_);
+  }
+  for (int i = 0; i < this->files_at_level_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64NoTag(
+      this->files_at_level(i), output);
+  }
+
+  // optional bool compaction_pending = 2;
+  if (has_compaction_pending()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->compaction_pending(), output);
+  }
+
+  // optional int64 memtable_size = 3;
+  if (has_memtable_size()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->memtable_size(), output);
+  }
+
+  // optional int64 block_cache_hits = 4;
+  if (has_block_cache_hits()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->block_cache_hits(), output);
+  }
+
+  // optional int64 block_cache_misses = 5;
+  if (has_block_cache_misses()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->block_cache_misses(), output);
+  }
+
+  // optional int64 block_cache_usage = 6;
+  if (has_block_cache_usage()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->block_cache_usage(), output);
+  }
+
+  // optional int64 value_checksum_mismatches = 7;
+  if (has_value_checksum_mismatches()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(7, this->value_checksum_mismatches(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.EngineStats)
+}
+
+::google::protobuf::uint8* EngineStats::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.EngineStats)
+  // repeated int64 files_at_level = 1 [packed = true];
+  if (this->files_at_level_size() > 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteTagToArray(
+      1,
+      ::google::protobuf::internal::WireFormatLite::WIRETYPE_LENGTH_DELIMITED,
+      target);
+    target = ::google::protobuf::io::CodedOutputStream::WriteVarint32ToArray(
+      _files_at_level_cached_byte_size_, target);
+  }
+  for (int i = 0; i < this->files_at_level_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteInt64NoTagToArray(this->files_at_level(i), target);
+  }
+
+  // optional bool compaction_pending = 2;
+  if (has_compaction_pending()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->compaction_pending(), target);
+  }
+
+  // optional int64 memtable_size = 3;
+  if (has_memtable_size()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->memtable_size(), target);
+  }
+
+  // optional int64 block_cache_hits = 4;
+  if (has_block_cache_hits()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->block_cache_hits(), target);
+  }
+
+  // optional int64 block_cache_misses = 5;
+  if (has_block_cache_misses()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->block_cache_misses(), target);
+  }
+
+  // optional int64 block_cache_usage = 6;
+  if (has_block_cache_usage()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->block_cache_usage(), target);
+  }
+
+  // optional int64 value_checksum_mismatches = 7;
+  if (has_value_checksum_mismatches()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(7, this->value_checksum_mismatches(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.EngineStats)
+  return target;
+}
+
+int EngineStats::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[1 / 32] & (0xffu << (1 % 32))) {
+    // optional bool compaction_pending = 2;
+    if (has_compaction_pending()) {
+      total_size += 1 + 1;
+    }
+
+    // optional int64 memtable_size = 3;
+    if (has_memtable_size()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->memtable_size());
+    }
+
+    // optional int64 block_cache_hits = 4;
+    if (has_block_cache_hits()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->block_cache_hits());
+    }
+
+    // optional int64 block_cache_misses = 5;
+    if (has_block_cache_misses()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->block_cache_misses());
+    }
+
+    // optional int64 block_cache_usage = 6;
+    if (has_block_cache_usage()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->block_cache_usage());
+    }
+
+    // optional int64 value_checksum_mismatches = 7;
+    if (has_value_checksum_mismatches()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->value_checksum_mismatches());
+    }
+
+  }
+  // repeated int64 files_at_level = 1 [packed = true];
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->files_at_level_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        Int64Size(this->files_at_level(i));
+    }
+    if (data_size > 0) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(data_size);
+    }
+    GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+    _files_at_level_cached_byte_size_ = data_size;
+    GOOGLE_SAFE_CONCURRENT_WRITES_END();
+    total_size += data_size;
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void EngineStats::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const EngineStats* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EngineStats*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void EngineStats::MergeFrom(const EngineStats& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  files_at_level_.MergeFrom(from.files_at_level_);
+  if (from._has_bits_[1 / 32] & (0xffu << (1 % 32))) {
+    if (from.has_compaction_pending()) {
+      set_compaction_pending(from.compaction_pending());
+    }
+    if (from.has_memtable_size()) {
+      set_memtable_size(from.memtable_size());
+    }
+    if (from.has_block_cache_hits()) {
+      set_block_cache_hits(from.block_cache_hits());
+    }
+    if (from.has_block_cache_misses()) {
+      set_block_cache_misses(from.block_cache_misses());
+    }
+    if (from.has_block_cache_usage()) {
+      set_block_cache_usage(from.block_cache_usage());
+    }
+    if (from.has_value_checksum_mismatches()) {
+      set_value_checksum_mismatches(from.value_checksum_mismatches());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void EngineStats::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void EngineStats::CopyFrom(const EngineStats& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EngineStats::IsInitialized() const {
+
+  return true;
+}
+
+void EngineStats::Swap(EngineStats* other) {
+  if (other != this) {
+    files_at_level_.Swap(&other->files_at_level_);
+    std::swap(compaction_pending_, other->compaction_pending_);
+    std::swap(memtable_size_, other->memtable_size_);
+    std::swap(block_cache_hits_, other->block_cache_hits_);
+    std::swap(block_cache_misses_, other->block_cache_misses_);
+    std::swap(block_cache_usage_, other->block_cache_usage_);
+    std::swap(value_checksum_mismatches_, other->value_checksum_mismatches_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata EngineStats::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = EngineStats_descriptor_;
+  metadata.reflection = EngineStats_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RequestLatency::kMethodFieldNumber;
+const int RequestLatency::kCountFieldNumber;
+const int RequestLatency::kP50FieldNumber;
+const int RequestLatency::kP95FieldNumber;
+const int RequestLatency::kP99FieldNumber;
+const int RequestLatency::kMaxFieldNumber;
+#endif  // !_MSC_VER
+
+RequestLatency::RequestLatency()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RequestLatency)
+}
+
+void RequestLatency::InitAsDefaultInstance() {
+}
+
+RequestLatency::RequestLatency(const RequestLatency& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RequestLatency)
+}
+
+void RequestLatency::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  count_ = GOOGLE_LONGLONG(0);
+  p50_ = GOOGLE_LONGLONG(0);
+  p95_ = GOOGLE_LONGLONG(0);
+  p99_ = GOOGLE_LONGLONG(0);
+  max_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RequestLatency::~RequestLatency() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RequestLatency)
+  SharedDtor();
+}
+
+void RequestLatency::SharedDtor() {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete method_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void RequestLatency::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RequestLatency::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RequestLatency_descriptor_;
+}
+
+const RequestLatency& RequestLatency::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+RequestLatency* RequestLatency::default_instance_ = NULL;
+
+RequestLatency* RequestLatency::New() const {
+  return new RequestLatency;
+}
+
+void RequestLatency::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<RequestLatency*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 63) {
+    ZR_(count_, max_);
+    if (has_method()) {
+      if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        method_->clear();
+      }
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool RequestLatency::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RequestLatency)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string method = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_method()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->method().data(), this->method().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "method");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_count;
+        break;
+      }
+
+      // optional int64 count = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &count_)));
+          set_has_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_p50;
+        break;
+      }
+
+      // optional int64 p50 = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_p50:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &p50_)));
+          set_has_p50();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_p95;
+        break;
+      }
+
+      // optional int64 p95 = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_p95:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &p95_)));
+          set_has_p95();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_p99;
+        break;
+      }
+
+      // optional int64 p99 = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_p99:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &p99_)));
+          set_has_p99();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_max;
+        break;
+      }
+
+      // optional int64 max = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_max:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_)));
+          set_has_max();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RequestLatency)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RequestLatency)
+  return false;
+#undef DO_
+}
+
+void RequestLatency::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RequestLatency)
+  // optional string method = 1;
+  if (has_method()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->method().data(), this->method().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "method");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->method(), output);
+  }
+
+  // optional int64 count = 2;
+  if (has_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->count(), output);
+  }
+
+  // optional int64 p50 = 3;
+  if (has_p50()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->p50(), output);
+  }
+
+  // optional int64 p95 = 4;
+  if (has_p95()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->p95(), output);
+  }
+
+  // optional int64 p99 = 5;
+  if (has_p99()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->p99(), output);
+  }
+
+  // optional int64 max = 6;
+  if (has_max()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->max(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RequestLatency)
+}
+
+::google::protobuf::uint8* RequestLatency::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RequestLatency)
+  // optional string method = 1;
+  if (has_method()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->method().data(), this->method().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "method");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->method(), target);
+  }
+
+  // optional int64 count = 2;
+  if (has_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->count(), target);
+  }
+
+  // optional int64 p50 = 3;
+  if (has_p50()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->p50(), target);
+  }
+
+  // optional int64 p95 = 4;
+  if (has_p95()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->p95(), target);
+  }
+
+  // optional int64 p99 = 5;
+  if (has_p99()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->p99(), target);
+  }
+
+  // optional int64 max = 6;
+  if (has_max()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->max(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RequestLatency)
+  return target;
+}
+
+int RequestLatency::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string method = 1;
+    if (has_method()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->method());
+    }
+
+    // optional int64 count = 2;
+    if (has_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->count());
+    }
+
+    // optional int64 p50 = 3;
+    if (has_p50()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->p50());
+    }
+
+    // optional int64 p95 = 4;
+    if (has_p95()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->p95());
+    }
+
+    // optional int64 p99 = 5;
+    if (has_p99()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->p99());
+    }
+
+    // optional int64 max = 6;
+    if (has_max()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RequestLatency::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const RequestLatency* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RequestLatency*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RequestLatency::MergeFrom(const RequestLatency& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_method()) {
+      set_method(from.method());
+    }
+    if (from.has_count()) {
+      set_count(from.count());
+    }
+    if (from.has_p50()) {
+      set_p50(from.p50());
+    }
+    if (from.has_p95()) {
+      set_p95(from.p95());
+    }
+    if (from.has_p99()) {
+      set_p99(from.p99());
+    }
+    if (from.has_max()) {
+      set_max(from.max());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void RequestLatency::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RequestLatency::CopyFrom(const RequestLatency& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RequestLatency::IsInitialized() const {
+
+  return true;
+}
+
+void RequestLatency::Swap(RequestLatency* other) {
+  if (other != this) {
+    std::swap(method_, other->method_);
+    std::swap(count_, other->count_);
+    std::swap(p50_, other->p50_);
+    std::swap(p95_, other->p95_);
+    std::swap(p99_, other->p99_);
+    std::swap(max_, other->max_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata RequestLatency::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RequestLatency_descriptor_;
+  metadata.reflection = RequestLatency_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RestoreStatus::kUriFieldNumber;
+const int RestoreStatus::kTotalFilesFieldNumber;
+const int RestoreStatus::kRestoredFilesFieldNumber;
+const int RestoreStatus::kRestoredKeysFieldNumber;
+const int RestoreStatus::kStartedAtFieldNumber;
+const int RestoreStatus::kUpdatedAtFieldNumber;
+const int RestoreStatus::kDoneFieldNumber;
+const int RestoreStatus::kErrorFieldNumber;
+#endif  // !_MSC_VER
+
+RestoreStatus::RestoreStatus()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RestoreStatus)
+}
+
+void RestoreStatus::InitAsDefaultInstance() {
+}
+
+RestoreStatus::RestoreStatus(const RestoreStatus& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RestoreStatus)
+}
+
+void RestoreStatus::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  total_files_ = 0;
+  restored_files_ = 0;
+  restored_keys_ = GOOGLE_LONGLONG(0);
+  started_at_ = GOOGLE_LONGLONG(0);
+  updated_at_ = GOOGLE_LONGLONG(0);
+  done_ = false;
+  error_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RestoreStatus::~RestoreStatus() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RestoreStatus)
+  SharedDtor();
+}
+
+void RestoreStatus::SharedDtor() {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete uri_;
+  }
+  if (error_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete error_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void RestoreStatus::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RestoreStatus::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RestoreStatus_descriptor_;
+}
+
+const RestoreStatus& RestoreStatus::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+RestoreStatus* RestoreStatus::default_instance_ = NULL;
+
+RestoreStatus* RestoreStatus::New() const {
+  return new RestoreStatus;
+}
+
+void RestoreStatus::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<RestoreStatus*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 255) {
+    ZR_(total_files_, updated_at_);
+    if (has_uri()) {
+      if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        uri_->clear();
+      }
+    }
+    done_ = false;
+    if (has_error()) {
+      if (error_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        error_->clear();
+      }
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool RestoreStatus::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RestoreStatus)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string uri = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_uri()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->uri().data(), this->uri().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "uri");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_total_files;
+        break;
+      }
+
+      // optional int32 total_files = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_total_files:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &total_files_)));
+          set_has_total_files();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_restored_files;
+        break;
+      }
+
+      // optional int32 restored_files = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_restored_files:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &restored_files_)));
+          set_has_restored_files();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_restored_keys;
+        break;
+      }
+
+      // optional int64 restored_keys = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_restored_keys:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &restored_keys_)));
+          set_has_restored_keys();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_started_at;
+        break;
+      }
+
+      // optional int64 started_at = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_started_at:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &started_at_)));
+          set_has_started_at();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_updated_at;
+        break;
+      }
+
+      // optional int64 updated_at = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_updated_at:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &updated_at_)));
+          set_has_updated_at();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_done;
+        break;
+      }
+
+      // optional bool done = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_done:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &done_)));
+          set_has_done();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_error;
+        break;
+      }
+
+      // optional string error = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_error:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_error()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->error().data(), this->error().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "error");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RestoreStatus)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RestoreStatus)
+  return false;
+#undef DO_
+}
+
+void RestoreStatus::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RestoreStatus)
+  // optional string uri = 1;
+  if (has_uri()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->uri().data(), this->uri().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "uri");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->uri(), output);
+  }
+
+  // optional int32 total_files = 2;
+  if (has_total_files()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->total_files(), output);
+  }
+
+  // optional int32 restored_files = 3;
+  if (has_restored_files()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->restored_files(), output);
+  }
+
+  // optional int64 restored_keys = 4;
+  if (has_restored_keys()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->restored_keys(), output);
+  }
+
+  // optional int64 started_at = 5;
+  if (has_started_at()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->started_at(), output);
+  }
+
+  // optional int64 updated_at = 6;
+  if (has_updated_at()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->updated_at(), output);
+  }
+
+  // optional bool done = 7;
+  if (has_done()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(7, this->done(), output);
+  }
+
+  // optional string error = 8;
+  if (has_error()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->error().data(), this->error().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "error");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      8, this->error(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RestoreStatus)
+}
+
+::google::protobuf::uint8* RestoreStatus::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RestoreStatus)
+  // optional string uri = 1;
+  if (has_uri()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->uri().data(), this->uri().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "uri");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->uri(), target);
+  }
+
+  // optional int32 total_files = 2;
+  if (has_total_files()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->total_files(), target);
+  }
+
+  // optional int32 restored_files = 3;
+  if (has_restored_files()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->restored_files(), target);
+  }
+
+  // optional int64 restored_keys = 4;
+  if (has_restored_keys()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->restored_keys(), target);
+  }
+
+  // optional int64 started_at = 5;
+  if (has_started_at()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->started_at(), target);
+  }
+
+  // optional int64 updated_at = 6;
+  if (has_updated_at()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->updated_at(), target);
+  }
+
+  // optional bool done = 7;
+  if (has_done()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(7, this->done(), target);
+  }
+
+  // optional string error = 8;
+  if (has_error()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->error().data(), this->error().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "error");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        8, this->error(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RestoreStatus)
+  return target;
+}
+
+int RestoreStatus::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string uri = 1;
+    if (has_uri()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->uri());
+    }
+
+    // optional int32 total_files = 2;
+    if (has_total_files()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->total_files());
+    }
+
+    // optional int32 restored_files = 3;
+    if (has_restored_files()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->restored_files());
+    }
+
+    // optional int64 restored_keys = 4;
+    if (has_restored_keys()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->restored_keys());
+    }
+
+    // optional int64 started_at = 5;
+    if (has_started_at()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->started_at());
+    }
+
+    // optional int64 updated_at = 6;
+    if (has_updated_at()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->updated_at());
+    }
+
+    // optional bool done = 7;
+    if (has_done()) {
+      total_size += 1 + 1;
+    }
+
+    // optional string error = 8;
+    if (has_error()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->error());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RestoreStatus::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const RestoreStatus* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RestoreStatus*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RestoreStatus::MergeFrom(const RestoreStatus& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_uri()) {
+      set_uri(from.uri());
+    }
+    if (from.has_total_files()) {
+      set_total_files(from.total_files());
+    }
+    if (from.has_restored_files()) {
+      set_restored_files(from.restored_files());
+    }
+    if (from.has_restored_keys()) {
+      set_restored_keys(from.restored_keys());
+    }
+    if (from.has_started_at()) {
+      set_started_at(from.started_at());
+    }
+    if (from.has_updated_at()) {
+      set_updated_at(from.updated_at());
+    }
+    if (from.has_done()) {
+      set_done(from.done());
+    }
+    if (from.has_error()) {
+      set_error(from.error());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void RestoreStatus::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RestoreStatus::CopyFrom(const RestoreStatus& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RestoreStatus::IsInitialized() const {
+
+  return true;
+}
+
+void RestoreStatus::Swap(RestoreStatus* other) {
+  if (other != this) {
+    std::swap(uri_, other->uri_);
+    std::swap(total_files_, other->total_files_);
+    std::swap(restored_files_, other->restored_files_);
+    std::swap(restored_keys_, other->restored_keys_);
+    std::swap(started_at_, other->started_at_);
+    std::swap(updated_at_, other->updated_at_);
+    std::swap(done_, other->done_);
+    std::swap(error_, other->error_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata RestoreStatus::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RestoreStatus_descriptor_;
+  metadata.reflection = RestoreStatus_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+#endif  // !_MSC_VER
+
+StatusDetailsRequest::StatusDetailsRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.StatusDetailsRequest)
+}
+
+void StatusDetailsRequest::InitAsDefaultInstance() {
+}
+
+StatusDetailsRequest::StatusDetailsRequest(const StatusDetailsRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StatusDetailsRequest)
+}
+
+void StatusDetailsRequest::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+StatusDetailsRequest::~StatusDetailsRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StatusDetailsRequest)
+  SharedDtor();
+}
+
+void StatusDetailsRequest::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void StatusDetailsRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* StatusDetailsRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return StatusDetailsRequest_descriptor_;
+}
+
+const StatusDetailsRequest& StatusDetailsRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+StatusDetailsRequest* StatusDetailsRequest::default_instance_ = NULL;
+
+StatusDetailsRequest* StatusDetailsRequest::New() const {
+  return new StatusDetailsRequest;
+}
+
+void StatusDetailsRequest::Clear() {
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool StatusDetailsRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StatusDetailsRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+  handle_unusual:
+    if (tag == 0 ||
+        ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+        ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+      goto success;
+    }
+    DO_(::google::protobuf::internal::WireFormat::SkipField(
+          input, tag, mutable_unknown_fields()));
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StatusDetailsRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StatusDetailsRequest)
+  return false;
+#undef DO_
+}
+
+void StatusDetailsRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StatusDetailsRequest)
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StatusDetailsRequest)
+}
+
+::google::protobuf::uint8* StatusDetailsRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StatusDetailsRequest)
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StatusDetailsRequest)
+  return target;
+}
+
+int StatusDetailsRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void StatusDetailsRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const StatusDetailsRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StatusDetailsRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void StatusDetailsRequest::MergeFrom(const StatusDetailsRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void StatusDetailsRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void StatusDetailsRequest::CopyFrom(const StatusDetailsRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool StatusDetailsRequest::IsInitialized() const {
+
+  return true;
+}
+
+void StatusDetailsRequest::Swap(StatusDetailsRequest* other) {
+  if (other != this) {
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata StatusDetailsRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = StatusDetailsRequest_descriptor_;
+  metadata.reflection = StatusDetailsRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int StatusDetailsResponse::kNodeIdFieldNumber;
+const int StatusDetailsResponse::kBuildTagFieldNumber;
+const int StatusDetailsResponse::kRequestLatenciesFieldNumber;
+#endif  // !_MSC_VER
+
+StatusDetailsResponse::StatusDetailsResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.StatusDetailsResponse)
+}
+
+void StatusDetailsResponse::InitAsDefaultInstance() {
+}
+
+StatusDetailsResponse::StatusDetailsResponse(const StatusDetailsResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StatusDetailsResponse)
+}
+
+void StatusDetailsResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  node_id_ = 0;
+  build_tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+StatusDetailsResponse::~StatusDetailsResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StatusDetailsResponse)
+  SharedDtor();
+}
+
+void StatusDetailsResponse::SharedDtor() {
+  if (build_tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete build_tag_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void StatusDetailsResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* StatusDetailsResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return StatusDetailsResponse_descriptor_;
+}
+
+const StatusDetailsResponse& StatusDetailsResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+StatusDetailsResponse* StatusDetailsResponse::default_instance_ = NULL;
+
+StatusDetailsResponse* StatusDetailsResponse::New() const {
+  return new StatusDetailsResponse;
+}
+
+void StatusDetailsResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    node_id_ = 0;
+    if (has_build_tag()) {
+      if (build_tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        build_tag_->clear();
+      }
+    }
+  }
+  request_latencies_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool StatusDetailsResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StatusDetailsResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 node_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &node_id_)));
+          set_has_node_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_build_tag;
+        break;
+      }
+
+      // optional string build_tag = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_build_tag:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_build_tag()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->build_tag().data(), this->build_tag().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "build_tag");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_request_latencies;
+        break;
+      }
+
+      // repeated .cockroach.proto.RequestLatency request_latencies = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_request_latencies:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_request_latencies()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_request_latencies;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StatusDetailsResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StatusDetailsResponse)
+  return false;
+#undef DO_
+}
+
+void StatusDetailsResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StatusDetailsResponse)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->node_id(), output);
+  }
+
+  // optional string build_tag = 2;
+  if (has_build_tag()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->build_tag().data(), this->build_tag().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "build_tag");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->build_tag(), output);
+  }
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 3;
+  for (int i = 0; i < this->request_latencies_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->request_latencies(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StatusDetailsResponse)
+}
+
+::google::protobuf::uint8* StatusDetailsResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StatusDetailsResponse)
+  // optional int32 node_id = 1;
+  if (has_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->node_id(), target);
+  }
+
+  // optional string build_tag = 2;
+  if (has_build_tag()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->build_tag().data(), this->build_tag().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "build_tag");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->build_tag(), target);
+  }
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 3;
+  for (int i = 0; i < this->request_latencies_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->request_latencies(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StatusDetailsResponse)
+  return target;
+}
+
+int StatusDetailsResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 node_id = 1;
+    if (has_node_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->node_id());
+    }
+
+    // optional string build_tag = 2;
+    if (has_build_tag()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->build_tag());
+    }
+
+  }
+  // repeated .cockroach.proto.RequestLatency request_latencies = 3;
+  total_size += 1 * this->request_latencies_size();
+  for (int i = 0; i < this->request_latencies_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->request_latencies(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void StatusDetailsResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const StatusDetailsResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StatusDetailsResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void StatusDetailsResponse::MergeFrom(const StatusDetailsResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  request_latencies_.MergeFrom(from.request_latencies_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_node_id()) {
+      set_node_id(from.node_id());
+    }
+    if (from.has_build_tag()) {
+      set_build_tag(from.build_tag());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void StatusDetailsResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void StatusDetailsResponse::CopyFrom(const StatusDetailsResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool StatusDetailsResponse::IsInitialized() const {
+
+  return true;
+}
+
+void StatusDetailsResponse::Swap(StatusDetailsResponse* other) {
+  if (other != this) {
+    std::swap(node_id_, other->node_id_);
+    std::swap(build_tag_, other->build_tag_);
+    request_latencies_.Swap(&other->request_latencies_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata StatusDetailsResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = StatusDetailsResponse_descriptor_;
+  metadata.reflection = StatusDetailsResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+#endif  // !_MSC_VER
+
+StatusStoresRequest::StatusStoresRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.StatusStoresRequest)
+}
+
+void StatusStoresRequest::InitAsDefaultInstance() {
+}
+
+StatusStoresRequest::StatusStoresRequest(const StatusStoresRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StatusStoresRequest)
+}
+
+void StatusStoresRequest::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+StatusStoresRequest::~StatusStoresRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StatusStoresRequest)
+  SharedDtor();
+}
+
+void StatusStoresRequest::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void StatusStoresRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* StatusStoresRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return StatusStoresRequest_descriptor_;
+}
+
+const StatusStoresRequest& StatusStoresRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+StatusStoresRequest* StatusStoresRequest::default_instance_ = NULL;
+
+StatusStoresRequest* StatusStoresRequest::New() const {
+  return new StatusStoresRequest;
+}
+
+void StatusStoresRequest::Clear() {
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool StatusStoresRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StatusStoresRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+  handle_unusual:
+    if (tag == 0 ||
+        ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+        ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+      goto success;
+    }
+    DO_(::google::protobuf::internal::WireFormat::SkipField(
+          input, tag, mutable_unknown_fields()));
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StatusStoresRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StatusStoresRequest)
+  return false;
+#undef DO_
+}
+
+void StatusStoresRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StatusStoresRequest)
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StatusStoresRequest)
+}
+
+::google::protobuf::uint8* StatusStoresRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StatusStoresRequest)
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StatusStoresRequest)
+  return target;
+}
+
+int StatusStoresRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void StatusStoresRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const StatusStoresRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StatusStoresRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void StatusStoresRequest::MergeFrom(const StatusStoresRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void StatusStoresRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void StatusStoresRequest::CopyFrom(const StatusStoresRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool StatusStoresRequest::IsInitialized() const {
+
+  return true;
+}
+
+void StatusStoresRequest::Swap(StatusStoresRequest* other) {
+  if (other != this) {
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata StatusStoresRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = StatusStoresRequest_descriptor_;
+  metadata.reflection = StatusStoresRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int StatusStoresResponse::kStoresFieldNumber;
+#endif  // !_MSC_VER
+
+StatusStoresResponse::StatusStoresResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.StatusStoresResponse)
+}
+
+void StatusStoresResponse::InitAsDefaultInstance() {
+}
+
+StatusStoresResponse::StatusStoresResponse(const StatusStoresResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StatusStoresResponse)
+}
+
+void StatusStoresResponse::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+StatusStoresResponse::~StatusStoresResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StatusStoresResponse)
+  SharedDtor();
+}
+
+void StatusStoresResponse::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void StatusStoresResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* StatusStoresResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return StatusStoresResponse_descriptor_;
+}
+
+const StatusStoresResponse& StatusStoresResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  return *default_instance_;
+}
+
+StatusStoresResponse* StatusStoresResponse::default_instance_ = NULL;
+
+StatusStoresResponse* StatusStoresResponse::New() const {
+  return new StatusStoresResponse;
+}
+
+void StatusStoresResponse::Clear() {
+  stores_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool StatusStoresResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StatusStoresResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // repeated .cockroach.proto.StoreStatus stores = 1;
+      case 1: {
+        if (tag == 10) {
+         parse_stores:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_stores()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(10)) goto parse_stores;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StatusStoresResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StatusStoresResponse)
+  return false;
+#undef DO_
+}
+
+void StatusStoresResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StatusStoresResponse)
+  // repeated .cockroach.proto.StoreStatus stores = 1;
+  for (int i = 0; i < this->stores_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->stores(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StatusStoresResponse)
+}
+
+::google::protobuf::uint8* StatusStoresResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StatusStoresResponse)
+  // repeated .cockroach.proto.StoreStatus stores = 1;
+  for (int i = 0; i < this->stores_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->stores(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StatusStoresResponse)
+  return target;
+}
+
+int StatusStoresResponse::ByteSize() const {
+  int total_size = 0;
+
+  // repeated .cockroach.proto.StoreStatus stores = 1;
+  total_size += 1 * this->stores_size();
+  for (int i = 0; i < this->stores_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->stores(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void StatusStoresResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const StatusStoresResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StatusStoresResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void StatusStoresResponse::MergeFrom(const StatusStoresResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  stores_.MergeFrom(from.stores_);
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void StatusStoresResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void StatusStoresResponse::CopyFrom(const StatusStoresResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool StatusStoresResponse::IsInitialized() const {
+
+  return true;
+}
+
+void StatusStoresResponse::Swap(StatusStoresResponse* other) {
+  if (other != this) {
+    stores_.Swap(&other->stores_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata StatusStoresResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = StatusStoresResponse_descriptor_;
+  metadata.reflection = StatusStoresResponse_reflection_;
+  return metadata;
+}
+
+
 // @@protoc_insertion_point(namespace_scope)
 
 }  // namespace proto
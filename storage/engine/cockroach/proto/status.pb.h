@@ -37,6 +37,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
 void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
 
 class StoreStatus;
+class EngineStats;
+class RequestLatency;
+class RestoreStatus;
+class StatusDetailsRequest;
+class StatusDetailsResponse;
+class StatusStoresRequest;
+class StatusStoresResponse;
 
 // ===================================================================
 
@@ -137,6 +144,67 @@ class StoreStatus : public ::google::protobuf::Message {
   inline ::cockroach::proto::MVCCStats* release_stats();
   inline void set_allocated_stats(::cockroach::proto::MVCCStats* stats);
 
+  // optional .cockroach.proto.EngineStats engine_stats = 7;
+  inline bool has_engine_stats() const;
+  inline void clear_engine_stats();
+  static const int kEngineStatsFieldNumber = 7;
+  inline const ::cockroach::proto::EngineStats& engine_stats() const;
+  inline ::cockroach::proto::EngineStats* mutable_engine_stats();
+  inline ::cockroach::proto::EngineStats* release_engine_stats();
+  inline void set_allocated_engine_stats(::cockroach::proto::EngineStats* engine_stats);
+
+  // repeated int64 corrupt_raft_ids = 8 [packed = true];
+  inline int corrupt_raft_ids_size() const;
+  inline void clear_corrupt_raft_ids();
+  static const int kCorruptRaftIdsFieldNumber = 8;
+  inline ::google::protobuf::int64 corrupt_raft_ids(int index) const;
+  inline void set_corrupt_raft_ids(int index, ::google::protobuf::int64 value);
+  inline void add_corrupt_raft_ids(::google::protobuf::int64 value);
+  inline const ::google::protobuf::RepeatedField< ::google::protobuf::int64 >&
+      corrupt_raft_ids() const;
+  inline ::google::protobuf::RepeatedField< ::google::protobuf::int64 >*
+      mutable_corrupt_raft_ids();
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 9;
+  inline int request_latencies_size() const;
+  inline void clear_request_latencies();
+  static const int kRequestLatenciesFieldNumber = 9;
+  inline const ::cockroach::proto::RequestLatency& request_latencies(int index) const;
+  inline ::cockroach::proto::RequestLatency* mutable_request_latencies(int index);
+  inline ::cockroach::proto::RequestLatency* add_request_latencies();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >&
+      request_latencies() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >*
+      mutable_request_latencies();
+
+  // optional int64 capacity = 10;
+  inline bool has_capacity() const;
+  inline void clear_capacity();
+  static const int kCapacityFieldNumber = 10;
+  inline ::google::protobuf::int64 capacity() const;
+  inline void set_capacity(::google::protobuf::int64 value);
+
+  // optional int64 available = 11;
+  inline bool has_available() const;
+  inline void clear_available();
+  static const int kAvailableFieldNumber = 11;
+  inline ::google::protobuf::int64 available() const;
+  inline void set_available(::google::protobuf::int64 value);
+
+  // optional int32 leader_range_count = 12;
+  inline bool has_leader_range_count() const;
+  inline void clear_leader_range_count();
+  static const int kLeaderRangeCountFieldNumber = 12;
+  inline ::google::protobuf::int32 leader_range_count() const;
+  inline void set_leader_range_count(::google::protobuf::int32 value);
+
+  // optional int64 raft_log_size = 13;
+  inline bool has_raft_log_size() const;
+  inline void clear_raft_log_size();
+  static const int kRaftLogSizeFieldNumber = 13;
+  inline ::google::protobuf::int64 raft_log_size() const;
+  inline void set_raft_log_size(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.StoreStatus)
  private:
   inline void set_has_store_id();
@@ -151,190 +219,2022 @@ class StoreStatus : public ::google::protobuf::Message {
   inline void clear_has_updated_at();
   inline void set_has_stats();
   inline void clear_has_stats();
+  inline void set_has_engine_stats();
+  inline void clear_has_engine_stats();
+  inline void set_has_capacity();
+  inline void clear_has_capacity();
+  inline void set_has_available();
+  inline void clear_has_available();
+  inline void set_has_leader_range_count();
+  inline void clear_has_leader_range_count();
+  inline void set_has_raft_log_size();
+  inline void clear_has_raft_log_size();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int32 store_id_;
+  ::google::protobuf::int32 node_id_;
+  ::google::protobuf::int64 started_at_;
+  ::google::protobuf::int64 updated_at_;
+  ::cockroach::proto::MVCCStats* stats_;
+  ::cockroach::proto::EngineStats* engine_stats_;
+  ::google::protobuf::int32 range_count_;
+  ::google::protobuf::int32 leader_range_count_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::int64 > corrupt_raft_ids_;
+  mutable int _corrupt_raft_ids_cached_byte_size_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency > request_latencies_;
+  ::google::protobuf::int64 capacity_;
+  ::google::protobuf::int64 available_;
+  ::google::protobuf::int64 raft_log_size_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static StoreStatus* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class EngineStats : public ::google::protobuf::Message {
+ public:
+  EngineStats();
+  virtual ~EngineStats();
+
+  EngineStats(const EngineStats& from);
+
+  inline EngineStats& operator=(const EngineStats& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const EngineStats& default_instance();
+
+  void Swap(EngineStats* other);
+
+  // implements Message ----------------------------------------------
+
+  EngineStats* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const EngineStats& from);
+  void MergeFrom(const EngineStats& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated int64 files_at_level = 1 [packed = true];
+  inline int files_at_level_size() const;
+  inline void clear_files_at_level();
+  static const int kFilesAtLevelFieldNumber = 1;
+  inline ::google::protobuf::int64 files_at_level(int index) const;
+  inline void set_files_at_level(int index, ::google::protobuf::int64 value);
+  inline void add_files_at_level(::google::protobuf::int64 value);
+  inline const ::google::protobuf::RepeatedField< ::google::protobuf::int64 >&
+      files_at_level() const;
+  inline ::google::protobuf::RepeatedField< ::google::protobuf::int64 >*
+      mutable_files_at_level();
+
+  // optional bool compaction_pending = 2;
+  inline bool has_compaction_pending() const;
+  inline void clear_compaction_pending();
+  static const int kCompactionPendingFieldNumber = 2;
+  inline bool compaction_pending() const;
+  inline void set_compaction_pending(bool value);
+
+  // optional int64 memtable_size = 3;
+  inline bool has_memtable_size() const;
+  inline void clear_memtable_size();
+  static const int kMemtableSizeFieldNumber = 3;
+  inline ::google::protobuf::int64 memtable_size() const;
+  inline void set_memtable_size(::google::protobuf::int64 value);
+
+  // optional int64 block_cache_hits = 4;
+  inline bool has_block_cache_hits() const;
+  inline void clear_block_cache_hits();
+  static const int kBlockCacheHitsFieldNumber = 4;
+  inline ::google::protobuf::int64 block_cache_hits() const;
+  inline void set_block_cache_hits(::google::protobuf::int64 value);
+
+  // optional int64 block_cache_misses = 5;
+  inline bool has_block_cache_misses() const;
+  inline void clear_block_cache_misses();
+  static const int kBlockCacheMissesFieldNumber = 5;
+  inline ::google::protobuf::int64 block_cache_misses() const;
+  inline void set_block_cache_misses(::google::protobuf::int64 value);
+
+  // optional int64 block_cache_usage = 6;
+  inline bool has_block_cache_usage() const;
+  inline void clear_block_cache_usage();
+  static const int kBlockCacheUsageFieldNumber = 6;
+  inline ::google::protobuf::int64 block_cache_usage() const;
+  inline void set_block_cache_usage(::google::protobuf::int64 value);
+
+  // optional int64 value_checksum_mismatches = 7;
+  inline bool has_value_checksum_mismatches() const;
+  inline void clear_value_checksum_mismatches();
+  static const int kValueChecksumMismatchesFieldNumber = 7;
+  inline ::google::protobuf::int64 value_checksum_mismatches() const;
+  inline void set_value_checksum_mismatches(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.EngineStats)
+ private:
+  inline void set_has_compaction_pending();
+  inline void clear_has_compaction_pending();
+  inline void set_has_memtable_size();
+  inline void clear_has_memtable_size();
+  inline void set_has_block_cache_hits();
+  inline void clear_has_block_cache_hits();
+  inline void set_has_block_cache_misses();
+  inline void clear_has_block_cache_misses();
+  inline void set_has_block_cache_usage();
+  inline void clear_has_block_cache_usage();
+  inline void set_has_value_checksum_mismatches();
+  inline void clear_has_value_checksum_mismatches();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::int64 > files_at_level_;
+  mutable int _files_at_level_cached_byte_size_;
+  ::google::protobuf::int64 memtable_size_;
+  ::google::protobuf::int64 block_cache_hits_;
+  ::google::protobuf::int64 block_cache_misses_;
+  ::google::protobuf::int64 block_cache_usage_;
+  ::google::protobuf::int64 value_checksum_mismatches_;
+  bool compaction_pending_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static EngineStats* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RequestLatency : public ::google::protobuf::Message {
+ public:
+  RequestLatency();
+  virtual ~RequestLatency();
+
+  RequestLatency(const RequestLatency& from);
+
+  inline RequestLatency& operator=(const RequestLatency& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RequestLatency& default_instance();
+
+  void Swap(RequestLatency* other);
+
+  // implements Message ----------------------------------------------
+
+  RequestLatency* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RequestLatency& from);
+  void MergeFrom(const RequestLatency& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string method = 1;
+  inline bool has_method() const;
+  inline void clear_method();
+  static const int kMethodFieldNumber = 1;
+  inline const ::std::string& method() const;
+  inline void set_method(const ::std::string& value);
+  inline void set_method(const char* value);
+  inline void set_method(const char* value, size_t size);
+  inline ::std::string* mutable_method();
+  inline ::std::string* release_method();
+  inline void set_allocated_method(::std::string* method);
+
+  // optional int64 count = 2;
+  inline bool has_count() const;
+  inline void clear_count();
+  static const int kCountFieldNumber = 2;
+  inline ::google::protobuf::int64 count() const;
+  inline void set_count(::google::protobuf::int64 value);
+
+  // optional int64 p50 = 3;
+  inline bool has_p50() const;
+  inline void clear_p50();
+  static const int kP50FieldNumber = 3;
+  inline ::google::protobuf::int64 p50() const;
+  inline void set_p50(::google::protobuf::int64 value);
+
+  // optional int64 p95 = 4;
+  inline bool has_p95() const;
+  inline void clear_p95();
+  static const int kP95FieldNumber = 4;
+  inline ::google::protobuf::int64 p95() const;
+  inline void set_p95(::google::protobuf::int64 value);
+
+  // optional int64 p99 = 5;
+  inline bool has_p99() const;
+  inline void clear_p99();
+  static const int kP99FieldNumber = 5;
+  inline ::google::protobuf::int64 p99() const;
+  inline void set_p99(::google::protobuf::int64 value);
+
+  // optional int64 max = 6;
+  inline bool has_max() const;
+  inline void clear_max();
+  static const int kMaxFieldNumber = 6;
+  inline ::google::protobuf::int64 max() const;
+  inline void set_max(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestLatency)
+ private:
+  inline void set_has_method();
+  inline void clear_has_method();
+  inline void set_has_count();
+  inline void clear_has_count();
+  inline void set_has_p50();
+  inline void clear_has_p50();
+  inline void set_has_p95();
+  inline void clear_has_p95();
+  inline void set_has_p99();
+  inline void clear_has_p99();
+  inline void set_has_max();
+  inline void clear_has_max();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* method_;
+  ::google::protobuf::int64 count_;
+  ::google::protobuf::int64 p50_;
+  ::google::protobuf::int64 p95_;
+  ::google::protobuf::int64 p99_;
+  ::google::protobuf::int64 max_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static RequestLatency* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RestoreStatus : public ::google::protobuf::Message {
+ public:
+  RestoreStatus();
+  virtual ~RestoreStatus();
+
+  RestoreStatus(const RestoreStatus& from);
+
+  inline RestoreStatus& operator=(const RestoreStatus& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RestoreStatus& default_instance();
+
+  void Swap(RestoreStatus* other);
+
+  // implements Message ----------------------------------------------
+
+  RestoreStatus* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RestoreStatus& from);
+  void MergeFrom(const RestoreStatus& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string uri = 1;
+  inline bool has_uri() const;
+  inline void clear_uri();
+  static const int kUriFieldNumber = 1;
+  inline const ::std::string& uri() const;
+  inline void set_uri(const ::std::string& value);
+  inline void set_uri(const char* value);
+  inline void set_uri(const char* value, size_t size);
+  inline ::std::string* mutable_uri();
+  inline ::std::string* release_uri();
+  inline void set_allocated_uri(::std::string* uri);
+
+  // optional int32 total_files = 2;
+  inline bool has_total_files() const;
+  inline void clear_total_files();
+  static const int kTotalFilesFieldNumber = 2;
+  inline ::google::protobuf::int32 total_files() const;
+  inline void set_total_files(::google::protobuf::int32 value);
+
+  // optional int32 restored_files = 3;
+  inline bool has_restored_files() const;
+  inline void clear_restored_files();
+  static const int kRestoredFilesFieldNumber = 3;
+  inline ::google::protobuf::int32 restored_files() const;
+  inline void set_restored_files(::google::protobuf::int32 value);
+
+  // optional int64 restored_keys = 4;
+  inline bool has_restored_keys() const;
+  inline void clear_restored_keys();
+  static const int kRestoredKeysFieldNumber = 4;
+  inline ::google::protobuf::int64 restored_keys() const;
+  inline void set_restored_keys(::google::protobuf::int64 value);
+
+  // optional int64 started_at = 5;
+  inline bool has_started_at() const;
+  inline void clear_started_at();
+  static const int kStartedAtFieldNumber = 5;
+  inline ::google::protobuf::int64 started_at() const;
+  inline void set_started_at(::google::protobuf::int64 value);
+
+  // optional int64 updated_at = 6;
+  inline bool has_updated_at() const;
+  inline void clear_updated_at();
+  static const int kUpdatedAtFieldNumber = 6;
+  inline ::google::protobuf::int64 updated_at() const;
+  inline void set_updated_at(::google::protobuf::int64 value);
+
+  // optional bool done = 7;
+  inline bool has_done() const;
+  inline void clear_done();
+  static const int kDoneFieldNumber = 7;
+  inline bool done() const;
+  inline void set_done(bool value);
+
+  // optional string error = 8;
+  inline bool has_error() const;
+  inline void clear_error();
+  static const int kErrorFieldNumber = 8;
+  inline const ::std::string& error() const;
+  inline void set_error(const ::std::string& value);
+  inline void set_error(const char* value);
+  inline void set_error(const char* value, size_t size);
+  inline ::std::string* mutable_error();
+  inline ::std::string* release_error();
+  inline void set_allocated_error(::std::string* error);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RestoreStatus)
+ private:
+  inline void set_has_uri();
+  inline void clear_has_uri();
+  inline void set_has_total_files();
+  inline void clear_has_total_files();
+  inline void set_has_restored_files();
+  inline void clear_has_restored_files();
+  inline void set_has_restored_keys();
+  inline void clear_has_restored_keys();
+  inline void set_has_started_at();
+  inline void clear_has_started_at();
+  inline void set_has_updated_at();
+  inline void clear_has_updated_at();
+  inline void set_has_done();
+  inline void clear_has_done();
+  inline void set_has_error();
+  inline void clear_has_error();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  ::google::protobuf::uint32 _has_bits_[1];
-  mutable int _cached_size_;
-  ::google::protobuf::int32 store_id_;
-  ::google::protobuf::int32 node_id_;
-  ::google::protobuf::int64 started_at_;
-  ::google::protobuf::int64 updated_at_;
-  ::cockroach::proto::MVCCStats* stats_;
-  ::google::protobuf::int32 range_count_;
-  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
-  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
-  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* uri_;
+  ::google::protobuf::int32 total_files_;
+  ::google::protobuf::int32 restored_files_;
+  ::google::protobuf::int64 restored_keys_;
+  ::google::protobuf::int64 started_at_;
+  ::google::protobuf::int64 updated_at_;
+  ::std::string* error_;
+  bool done_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static RestoreStatus* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class StatusDetailsRequest : public ::google::protobuf::Message {
+ public:
+  StatusDetailsRequest();
+  virtual ~StatusDetailsRequest();
+
+  StatusDetailsRequest(const StatusDetailsRequest& from);
+
+  inline StatusDetailsRequest& operator=(const StatusDetailsRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const StatusDetailsRequest& default_instance();
+
+  void Swap(StatusDetailsRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  StatusDetailsRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const StatusDetailsRequest& from);
+  void MergeFrom(const StatusDetailsRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.StatusDetailsRequest)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static StatusDetailsRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class StatusDetailsResponse : public ::google::protobuf::Message {
+ public:
+  StatusDetailsResponse();
+  virtual ~StatusDetailsResponse();
+
+  StatusDetailsResponse(const StatusDetailsResponse& from);
+
+  inline StatusDetailsResponse& operator=(const StatusDetailsResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const StatusDetailsResponse& default_instance();
+
+  void Swap(StatusDetailsResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  StatusDetailsResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const StatusDetailsResponse& from);
+  void MergeFrom(const StatusDetailsResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 node_id = 1;
+  inline bool has_node_id() const;
+  inline void clear_node_id();
+  static const int kNodeIdFieldNumber = 1;
+  inline ::google::protobuf::int32 node_id() const;
+  inline void set_node_id(::google::protobuf::int32 value);
+
+  // optional string build_tag = 2;
+  inline bool has_build_tag() const;
+  inline void clear_build_tag();
+  static const int kBuildTagFieldNumber = 2;
+  inline const ::std::string& build_tag() const;
+  inline void set_build_tag(const ::std::string& value);
+  inline void set_build_tag(const char* value);
+  inline void set_build_tag(const char* value, size_t size);
+  inline ::std::string* mutable_build_tag();
+  inline ::std::string* release_build_tag();
+  inline void set_allocated_build_tag(::std::string* build_tag);
+
+  // repeated .cockroach.proto.RequestLatency request_latencies = 3;
+  inline int request_latencies_size() const;
+  inline void clear_request_latencies();
+  static const int kRequestLatenciesFieldNumber = 3;
+  inline const ::cockroach::proto::RequestLatency& request_latencies(int index) const;
+  inline ::cockroach::proto::RequestLatency* mutable_request_latencies(int index);
+  inline ::cockroach::proto::RequestLatency* add_request_latencies();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >&
+      request_latencies() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >*
+      mutable_request_latencies();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.StatusDetailsResponse)
+ private:
+  inline void set_has_node_id();
+  inline void clear_has_node_id();
+  inline void set_has_build_tag();
+  inline void clear_has_build_tag();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* build_tag_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency > request_latencies_;
+  ::google::protobuf::int32 node_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static StatusDetailsResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class StatusStoresRequest : public ::google::protobuf::Message {
+ public:
+  StatusStoresRequest();
+  virtual ~StatusStoresRequest();
+
+  StatusStoresRequest(const StatusStoresRequest& from);
+
+  inline StatusStoresRequest& operator=(const StatusStoresRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const StatusStoresRequest& default_instance();
+
+  void Swap(StatusStoresRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  StatusStoresRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const StatusStoresRequest& from);
+  void MergeFrom(const StatusStoresRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.StatusStoresRequest)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static StatusStoresRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class StatusStoresResponse : public ::google::protobuf::Message {
+ public:
+  StatusStoresResponse();
+  virtual ~StatusStoresResponse();
+
+  StatusStoresResponse(const StatusStoresResponse& from);
+
+  inline StatusStoresResponse& operator=(const StatusStoresResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const StatusStoresResponse& default_instance();
+
+  void Swap(StatusStoresResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  StatusStoresResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const StatusStoresResponse& from);
+  void MergeFrom(const StatusStoresResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated .cockroach.proto.StoreStatus stores = 1;
+  inline int stores_size() const;
+  inline void clear_stores();
+  static const int kStoresFieldNumber = 1;
+  inline const ::cockroach::proto::StoreStatus& stores(int index) const;
+  inline ::cockroach::proto::StoreStatus* mutable_stores(int index);
+  inline ::cockroach::proto::StoreStatus* add_stores();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::StoreStatus >&
+      stores() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::StoreStatus >*
+      mutable_stores();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.StatusStoresResponse)
+ private:
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::StoreStatus > stores_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fstatus_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fstatus_2eproto();
+
+  void InitAsDefaultInstance();
+  static StatusStoresResponse* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+// StoreStatus
+
+// optional int32 store_id = 1;
+inline bool StoreStatus::has_store_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void StoreStatus::set_has_store_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void StoreStatus::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void StoreStatus::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
+}
+inline ::google::protobuf::int32 StoreStatus::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.store_id)
+  return store_id_;
+}
+inline void StoreStatus::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.store_id)
+}
+
+// optional int32 node_id = 2;
+inline bool StoreStatus::has_node_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void StoreStatus::set_has_node_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void StoreStatus::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void StoreStatus::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
+}
+inline ::google::protobuf::int32 StoreStatus::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.node_id)
+  return node_id_;
+}
+inline void StoreStatus::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.node_id)
+}
+
+// optional int32 range_count = 3;
+inline bool StoreStatus::has_range_count() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void StoreStatus::set_has_range_count() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void StoreStatus::clear_has_range_count() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void StoreStatus::clear_range_count() {
+  range_count_ = 0;
+  clear_has_range_count();
+}
+inline ::google::protobuf::int32 StoreStatus::range_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.range_count)
+  return range_count_;
+}
+inline void StoreStatus::set_range_count(::google::protobuf::int32 value) {
+  set_has_range_count();
+  range_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.range_count)
+}
+
+// optional int64 started_at = 4;
+inline bool StoreStatus::has_started_at() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void StoreStatus::set_has_started_at() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void StoreStatus::clear_has_started_at() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void StoreStatus::clear_started_at() {
+  started_at_ = GOOGLE_LONGLONG(0);
+  clear_has_started_at();
+}
+inline ::google::protobuf::int64 StoreStatus::started_at() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.started_at)
+  return started_at_;
+}
+inline void StoreStatus::set_started_at(::google::protobuf::int64 value) {
+  set_has_started_at();
+  started_at_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.started_at)
+}
+
+// optional int64 updated_at = 5;
+inline bool StoreStatus::has_updated_at() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void StoreStatus::set_has_updated_at() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void StoreStatus::clear_has_updated_at() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void StoreStatus::clear_updated_at() {
+  updated_at_ = GOOGLE_LONGLONG(0);
+  clear_has_updated_at();
+}
+inline ::google::protobuf::int64 StoreStatus::updated_at() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.updated_at)
+  return updated_at_;
+}
+inline void StoreStatus::set_updated_at(::google::protobuf::int64 value) {
+  set_has_updated_at();
+  updated_at_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.updated_at)
+}
+
+// optional .cockroach.proto.MVCCStats stats = 6;
+inline bool StoreStatus::has_stats() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void StoreStatus::set_has_stats() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void StoreStatus::clear_has_stats() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void StoreStatus::clear_stats() {
+  if (stats_ != NULL) stats_->::cockroach::proto::MVCCStats::Clear();
+  clear_has_stats();
+}
+inline const ::cockroach::proto::MVCCStats& StoreStatus::stats() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.stats)
+  return stats_ != NULL ? *stats_ : *default_instance_->stats_;
+}
+inline ::cockroach::proto::MVCCStats* StoreStatus::mutable_stats() {
+  set_has_stats();
+  if (stats_ == NULL) stats_ = new ::cockroach::proto::MVCCStats;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreStatus.stats)
+  return stats_;
+}
+inline ::cockroach::proto::MVCCStats* StoreStatus::release_stats() {
+  clear_has_stats();
+  ::cockroach::proto::MVCCStats* temp = stats_;
+  stats_ = NULL;
+  return temp;
+}
+inline void StoreStatus::set_allocated_stats(::cockroach::proto::MVCCStats* stats) {
+  delete stats_;
+  stats_ = stats;
+  if (stats) {
+    set_has_stats();
+  } else {
+    clear_has_stats();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreStatus.stats)
+}
+
+// optional .cockroach.proto.EngineStats engine_stats = 7;
+inline bool StoreStatus::has_engine_stats() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void StoreStatus::set_has_engine_stats() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void StoreStatus::clear_has_engine_stats() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void StoreStatus::clear_engine_stats() {
+  if (engine_stats_ != NULL) engine_stats_->::cockroach::proto::EngineStats::Clear();
+  clear_has_engine_stats();
+}
+inline const ::cockroach::proto::EngineStats& StoreStatus::engine_stats() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.engine_stats)
+  return engine_stats_ != NULL ? *engine_stats_ : *default_instance_->engine_stats_;
+}
+inline ::cockroach::proto::EngineStats* StoreStatus::mutable_engine_stats() {
+  set_has_engine_stats();
+  if (engine_stats_ == NULL) engine_stats_ = new ::cockroach::proto::EngineStats;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreStatus.engine_stats)
+  return engine_stats_;
+}
+inline ::cockroach::proto::EngineStats* StoreStatus::release_engine_stats() {
+  clear_has_engine_stats();
+  ::cockroach::proto::EngineStats* temp = engine_stats_;
+  engine_stats_ = NULL;
+  return temp;
+}
+inline void StoreStatus::set_allocated_engine_stats(::cockroach::proto::EngineStats* engine_stats) {
+  delete engine_stats_;
+  engine_stats_ = engine_stats;
+  if (engine_stats) {
+    set_has_engine_stats();
+  } else {
+    clear_has_engine_stats();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreStatus.engine_stats)
+}
+
+// repeated int64 corrupt_raft_ids = 8 [packed = true];
+inline int StoreStatus::corrupt_raft_ids_size() const {
+  return corrupt_raft_ids_.size();
+}
+inline void StoreStatus::clear_corrupt_raft_ids() {
+  corrupt_raft_ids_.Clear();
+}
+inline ::google::protobuf::int64 StoreStatus::corrupt_raft_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.corrupt_raft_ids)
+  return corrupt_raft_ids_.Get(index);
+}
+inline void StoreStatus::set_corrupt_raft_ids(int index, ::google::protobuf::int64 value) {
+  corrupt_raft_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.corrupt_raft_ids)
+}
+inline void StoreStatus::add_corrupt_raft_ids(::google::protobuf::int64 value) {
+  corrupt_raft_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.StoreStatus.corrupt_raft_ids)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::int64 >&
+StoreStatus::corrupt_raft_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.StoreStatus.corrupt_raft_ids)
+  return corrupt_raft_ids_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::int64 >*
+StoreStatus::mutable_corrupt_raft_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.StoreStatus.corrupt_raft_ids)
+  return &corrupt_raft_ids_;
+}
+
+// repeated .cockroach.proto.RequestLatency request_latencies = 9;
+inline int StoreStatus::request_latencies_size() const {
+  return request_latencies_.size();
+}
+inline void StoreStatus::clear_request_latencies() {
+  request_latencies_.Clear();
+}
+inline const ::cockroach::proto::RequestLatency& StoreStatus::request_latencies(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.request_latencies)
+  return request_latencies_.Get(index);
+}
+inline ::cockroach::proto::RequestLatency* StoreStatus::mutable_request_latencies(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreStatus.request_latencies)
+  return request_latencies_.Mutable(index);
+}
+inline ::cockroach::proto::RequestLatency* StoreStatus::add_request_latencies() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.StoreStatus.request_latencies)
+  return request_latencies_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >&
+StoreStatus::request_latencies() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.StoreStatus.request_latencies)
+  return request_latencies_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >*
+StoreStatus::mutable_request_latencies() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.StoreStatus.request_latencies)
+  return &request_latencies_;
+}
+
+// optional int64 capacity = 10;
+inline bool StoreStatus::has_capacity() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
+}
+inline void StoreStatus::set_has_capacity() {
+  _has_bits_[0] |= 0x00000200u;
+}
+inline void StoreStatus::clear_has_capacity() {
+  _has_bits_[0] &= ~0x00000200u;
+}
+inline void StoreStatus::clear_capacity() {
+  capacity_ = GOOGLE_LONGLONG(0);
+  clear_has_capacity();
+}
+inline ::google::protobuf::int64 StoreStatus::capacity() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.capacity)
+  return capacity_;
+}
+inline void StoreStatus::set_capacity(::google::protobuf::int64 value) {
+  set_has_capacity();
+  capacity_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.capacity)
+}
+
+// optional int64 available = 11;
+inline bool StoreStatus::has_available() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
+}
+inline void StoreStatus::set_has_available() {
+  _has_bits_[0] |= 0x00000400u;
+}
+inline void StoreStatus::clear_has_available() {
+  _has_bits_[0] &= ~0x00000400u;
+}
+inline void StoreStatus::clear_available() {
+  available_ = GOOGLE_LONGLONG(0);
+  clear_has_available();
+}
+inline ::google::protobuf::int64 StoreStatus::available() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.available)
+  return available_;
+}
+inline void StoreStatus::set_available(::google::protobuf::int64 value) {
+  set_has_available();
+  available_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.available)
+}
+
+// optional int32 leader_range_count = 12;
+inline bool StoreStatus::has_leader_range_count() const {
+  return (_has_bits_[0] & 0x00000800u) != 0;
+}
+inline void StoreStatus::set_has_leader_range_count() {
+  _has_bits_[0] |= 0x00000800u;
+}
+inline void StoreStatus::clear_has_leader_range_count() {
+  _has_bits_[0] &= ~0x00000800u;
+}
+inline void StoreStatus::clear_leader_range_count() {
+  leader_range_count_ = 0;
+  clear_has_leader_range_count();
+}
+inline ::google::protobuf::int32 StoreStatus::leader_range_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.leader_range_count)
+  return leader_range_count_;
+}
+inline void StoreStatus::set_leader_range_count(::google::protobuf::int32 value) {
+  set_has_leader_range_count();
+  leader_range_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.leader_range_count)
+}
+
+// optional int64 raft_log_size = 13;
+inline bool StoreStatus::has_raft_log_size() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void StoreStatus::set_has_raft_log_size() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void StoreStatus::clear_has_raft_log_size() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void StoreStatus::clear_raft_log_size() {
+  raft_log_size_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_log_size();
+}
+inline ::google::protobuf::int64 StoreStatus::raft_log_size() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.raft_log_size)
+  return raft_log_size_;
+}
+inline void StoreStatus::set_raft_log_size(::google::protobuf::int64 value) {
+  set_has_raft_log_size();
+  raft_log_size_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.raft_log_size)
+}
+
+// -------------------------------------------------------------------
+
+// EngineStats
+
+// repeated int64 files_at_level = 1 [packed = true];
+inline int EngineStats::files_at_level_size() const {
+  return files_at_level_.size();
+}
+inline void EngineStats::clear_files_at_level() {
+  files_at_level_.Clear();
+}
+inline ::google::protobuf::int64 EngineStats::files_at_level(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.files_at_level)
+  return files_at_level_.Get(index);
+}
+inline void EngineStats::set_files_at_level(int index, ::google::protobuf::int64 value) {
+  files_at_level_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.files_at_level)
+}
+inline void EngineStats::add_files_at_level(::google::protobuf::int64 value) {
+  files_at_level_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.EngineStats.files_at_level)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::int64 >&
+EngineStats::files_at_level() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.EngineStats.files_at_level)
+  return files_at_level_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::int64 >*
+EngineStats::mutable_files_at_level() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EngineStats.files_at_level)
+  return &files_at_level_;
+}
+
+// optional bool compaction_pending = 2;
+inline bool EngineStats::has_compaction_pending() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void EngineStats::set_has_compaction_pending() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void EngineStats::clear_has_compaction_pending() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void EngineStats::clear_compaction_pending() {
+  compaction_pending_ = false;
+  clear_has_compaction_pending();
+}
+inline bool EngineStats::compaction_pending() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.compaction_pending)
+  return compaction_pending_;
+}
+inline void EngineStats::set_compaction_pending(bool value) {
+  set_has_compaction_pending();
+  compaction_pending_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.compaction_pending)
+}
+
+// optional int64 memtable_size = 3;
+inline bool EngineStats::has_memtable_size() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void EngineStats::set_has_memtable_size() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void EngineStats::clear_has_memtable_size() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void EngineStats::clear_memtable_size() {
+  memtable_size_ = GOOGLE_LONGLONG(0);
+  clear_has_memtable_size();
+}
+inline ::google::protobuf::int64 EngineStats::memtable_size() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.memtable_size)
+  return memtable_size_;
+}
+inline void EngineStats::set_memtable_size(::google::protobuf::int64 value) {
+  set_has_memtable_size();
+  memtable_size_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.memtable_size)
+}
+
+// optional int64 block_cache_hits = 4;
+inline bool EngineStats::has_block_cache_hits() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void EngineStats::set_has_block_cache_hits() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void EngineStats::clear_has_block_cache_hits() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void EngineStats::clear_block_cache_hits() {
+  block_cache_hits_ = GOOGLE_LONGLONG(0);
+  clear_has_block_cache_hits();
+}
+inline ::google::protobuf::int64 EngineStats::block_cache_hits() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.block_cache_hits)
+  return block_cache_hits_;
+}
+inline void EngineStats::set_block_cache_hits(::google::protobuf::int64 value) {
+  set_has_block_cache_hits();
+  block_cache_hits_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.block_cache_hits)
+}
+
+// optional int64 block_cache_misses = 5;
+inline bool EngineStats::has_block_cache_misses() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void EngineStats::set_has_block_cache_misses() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void EngineStats::clear_has_block_cache_misses() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void EngineStats::clear_block_cache_misses() {
+  block_cache_misses_ = GOOGLE_LONGLONG(0);
+  clear_has_block_cache_misses();
+}
+inline ::google::protobuf::int64 EngineStats::block_cache_misses() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.block_cache_misses)
+  return block_cache_misses_;
+}
+inline void EngineStats::set_block_cache_misses(::google::protobuf::int64 value) {
+  set_has_block_cache_misses();
+  block_cache_misses_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.block_cache_misses)
+}
+
+// optional int64 block_cache_usage = 6;
+inline bool EngineStats::has_block_cache_usage() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void EngineStats::set_has_block_cache_usage() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void EngineStats::clear_has_block_cache_usage() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void EngineStats::clear_block_cache_usage() {
+  block_cache_usage_ = GOOGLE_LONGLONG(0);
+  clear_has_block_cache_usage();
+}
+inline ::google::protobuf::int64 EngineStats::block_cache_usage() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.block_cache_usage)
+  return block_cache_usage_;
+}
+inline void EngineStats::set_block_cache_usage(::google::protobuf::int64 value) {
+  set_has_block_cache_usage();
+  block_cache_usage_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.block_cache_usage)
+}
+
+// optional int64 value_checksum_mismatches = 7;
+inline bool EngineStats::has_value_checksum_mismatches() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void EngineStats::set_has_value_checksum_mismatches() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void EngineStats::clear_has_value_checksum_mismatches() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void EngineStats::clear_value_checksum_mismatches() {
+  value_checksum_mismatches_ = GOOGLE_LONGLONG(0);
+  clear_has_value_checksum_mismatches();
+}
+inline ::google::protobuf::int64 EngineStats::value_checksum_mismatches() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EngineStats.value_checksum_mismatches)
+  return value_checksum_mismatches_;
+}
+inline void EngineStats::set_value_checksum_mismatches(::google::protobuf::int64 value) {
+  set_has_value_checksum_mismatches();
+  value_checksum_mismatches_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EngineStats.value_checksum_mismatches)
+}
+
+// -------------------------------------------------------------------
+
+// RequestLatency
+
+// optional string method = 1;
+inline bool RequestLatency::has_method() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RequestLatency::set_has_method() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RequestLatency::clear_has_method() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RequestLatency::clear_method() {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_->clear();
+  }
+  clear_has_method();
+}
+inline const ::std::string& RequestLatency::method() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestLatency.method)
+  return *method_;
+}
+inline void RequestLatency::set_method(const ::std::string& value) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestLatency.method)
+}
+inline void RequestLatency::set_method(const char* value) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestLatency.method)
+}
+inline void RequestLatency::set_method(const char* value, size_t size) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestLatency.method)
+}
+inline ::std::string* RequestLatency::mutable_method() {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestLatency.method)
+  return method_;
+}
+inline ::std::string* RequestLatency::release_method() {
+  clear_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = method_;
+    method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RequestLatency::set_allocated_method(::std::string* method) {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete method_;
+  }
+  if (method) {
+    set_has_method();
+    method_ = method;
+  } else {
+    clear_has_method();
+    method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestLatency.method)
+}
+
+// optional int64 count = 2;
+inline bool RequestLatency::has_count() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RequestLatency::set_has_count() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RequestLatency::clear_has_count() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RequestLatency::clear_count() {
+  count_ = GOOGLE_LONGLONG(0);
+  clear_has_count();
+}
+inline ::google::protobuf::int64 RequestLatency::count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestLatency.count)
+  return count_;
+}
+inline void RequestLatency::set_count(::google::protobuf::int64 value) {
+  set_has_count();
+  count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestLatency.count)
+}
+
+// optional int64 p50 = 3;
+inline bool RequestLatency::has_p50() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RequestLatency::set_has_p50() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RequestLatency::clear_has_p50() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RequestLatency::clear_p50() {
+  p50_ = GOOGLE_LONGLONG(0);
+  clear_has_p50();
+}
+inline ::google::protobuf::int64 RequestLatency::p50() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestLatency.p50)
+  return p50_;
+}
+inline void RequestLatency::set_p50(::google::protobuf::int64 value) {
+  set_has_p50();
+  p50_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestLatency.p50)
+}
+
+// optional int64 p95 = 4;
+inline bool RequestLatency::has_p95() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RequestLatency::set_has_p95() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RequestLatency::clear_has_p95() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RequestLatency::clear_p95() {
+  p95_ = GOOGLE_LONGLONG(0);
+  clear_has_p95();
+}
+inline ::google::protobuf::int64 RequestLatency::p95() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestLatency.p95)
+  return p95_;
+}
+inline void RequestLatency::set_p95(::google::protobuf::int64 value) {
+  set_has_p95();
+  p95_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestLatency.p95)
+}
 
-  void InitAsDefaultInstance();
-  static StoreStatus* default_instance_;
-};
-// ===================================================================
+// optional int64 p99 = 5;
+inline bool RequestLatency::has_p99() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void RequestLatency::set_has_p99() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void RequestLatency::clear_has_p99() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void RequestLatency::clear_p99() {
+  p99_ = GOOGLE_LONGLONG(0);
+  clear_has_p99();
+}
+inline ::google::protobuf::int64 RequestLatency::p99() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestLatency.p99)
+  return p99_;
+}
+inline void RequestLatency::set_p99(::google::protobuf::int64 value) {
+  set_has_p99();
+  p99_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestLatency.p99)
+}
 
+// optional int64 max = 6;
+inline bool RequestLatency::has_max() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void RequestLatency::set_has_max() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void RequestLatency::clear_has_max() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void RequestLatency::clear_max() {
+  max_ = GOOGLE_LONGLONG(0);
+  clear_has_max();
+}
+inline ::google::protobuf::int64 RequestLatency::max() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestLatency.max)
+  return max_;
+}
+inline void RequestLatency::set_max(::google::protobuf::int64 value) {
+  set_has_max();
+  max_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestLatency.max)
+}
 
-// ===================================================================
+// -------------------------------------------------------------------
 
-// StoreStatus
+// RestoreStatus
 
-// optional int32 store_id = 1;
-inline bool StoreStatus::has_store_id() const {
+// optional string uri = 1;
+inline bool RestoreStatus::has_uri() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void StoreStatus::set_has_store_id() {
+inline void RestoreStatus::set_has_uri() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void StoreStatus::clear_has_store_id() {
+inline void RestoreStatus::clear_has_uri() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void StoreStatus::clear_store_id() {
-  store_id_ = 0;
-  clear_has_store_id();
+inline void RestoreStatus::clear_uri() {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_->clear();
+  }
+  clear_has_uri();
 }
-inline ::google::protobuf::int32 StoreStatus::store_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.store_id)
-  return store_id_;
+inline const ::std::string& RestoreStatus::uri() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.uri)
+  return *uri_;
 }
-inline void StoreStatus::set_store_id(::google::protobuf::int32 value) {
-  set_has_store_id();
-  store_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.store_id)
+inline void RestoreStatus::set_uri(const ::std::string& value) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  uri_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.uri)
+}
+inline void RestoreStatus::set_uri(const char* value) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  uri_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RestoreStatus.uri)
+}
+inline void RestoreStatus::set_uri(const char* value, size_t size) {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  uri_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RestoreStatus.uri)
+}
+inline ::std::string* RestoreStatus::mutable_uri() {
+  set_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    uri_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RestoreStatus.uri)
+  return uri_;
+}
+inline ::std::string* RestoreStatus::release_uri() {
+  clear_has_uri();
+  if (uri_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = uri_;
+    uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RestoreStatus::set_allocated_uri(::std::string* uri) {
+  if (uri_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete uri_;
+  }
+  if (uri) {
+    set_has_uri();
+    uri_ = uri;
+  } else {
+    clear_has_uri();
+    uri_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RestoreStatus.uri)
 }
 
-// optional int32 node_id = 2;
-inline bool StoreStatus::has_node_id() const {
+// optional int32 total_files = 2;
+inline bool RestoreStatus::has_total_files() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void StoreStatus::set_has_node_id() {
+inline void RestoreStatus::set_has_total_files() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void StoreStatus::clear_has_node_id() {
+inline void RestoreStatus::clear_has_total_files() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void StoreStatus::clear_node_id() {
-  node_id_ = 0;
-  clear_has_node_id();
+inline void RestoreStatus::clear_total_files() {
+  total_files_ = 0;
+  clear_has_total_files();
 }
-inline ::google::protobuf::int32 StoreStatus::node_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.node_id)
-  return node_id_;
+inline ::google::protobuf::int32 RestoreStatus::total_files() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.total_files)
+  return total_files_;
 }
-inline void StoreStatus::set_node_id(::google::protobuf::int32 value) {
-  set_has_node_id();
-  node_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.node_id)
+inline void RestoreStatus::set_total_files(::google::protobuf::int32 value) {
+  set_has_total_files();
+  total_files_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.total_files)
 }
 
-// optional int32 range_count = 3;
-inline bool StoreStatus::has_range_count() const {
+// optional int32 restored_files = 3;
+inline bool RestoreStatus::has_restored_files() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void StoreStatus::set_has_range_count() {
+inline void RestoreStatus::set_has_restored_files() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void StoreStatus::clear_has_range_count() {
+inline void RestoreStatus::clear_has_restored_files() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void StoreStatus::clear_range_count() {
-  range_count_ = 0;
-  clear_has_range_count();
+inline void RestoreStatus::clear_restored_files() {
+  restored_files_ = 0;
+  clear_has_restored_files();
 }
-inline ::google::protobuf::int32 StoreStatus::range_count() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.range_count)
-  return range_count_;
+inline ::google::protobuf::int32 RestoreStatus::restored_files() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.restored_files)
+  return restored_files_;
 }
-inline void StoreStatus::set_range_count(::google::protobuf::int32 value) {
-  set_has_range_count();
-  range_count_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.range_count)
+inline void RestoreStatus::set_restored_files(::google::protobuf::int32 value) {
+  set_has_restored_files();
+  restored_files_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.restored_files)
 }
 
-// optional int64 started_at = 4;
-inline bool StoreStatus::has_started_at() const {
+// optional int64 restored_keys = 4;
+inline bool RestoreStatus::has_restored_keys() const {
   return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void StoreStatus::set_has_started_at() {
+inline void RestoreStatus::set_has_restored_keys() {
   _has_bits_[0] |= 0x00000008u;
 }
-inline void StoreStatus::clear_has_started_at() {
+inline void RestoreStatus::clear_has_restored_keys() {
   _has_bits_[0] &= ~0x00000008u;
 }
-inline void StoreStatus::clear_started_at() {
+inline void RestoreStatus::clear_restored_keys() {
+  restored_keys_ = GOOGLE_LONGLONG(0);
+  clear_has_restored_keys();
+}
+inline ::google::protobuf::int64 RestoreStatus::restored_keys() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.restored_keys)
+  return restored_keys_;
+}
+inline void RestoreStatus::set_restored_keys(::google::protobuf::int64 value) {
+  set_has_restored_keys();
+  restored_keys_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.restored_keys)
+}
+
+// optional int64 started_at = 5;
+inline bool RestoreStatus::has_started_at() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void RestoreStatus::set_has_started_at() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void RestoreStatus::clear_has_started_at() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void RestoreStatus::clear_started_at() {
   started_at_ = GOOGLE_LONGLONG(0);
   clear_has_started_at();
 }
-inline ::google::protobuf::int64 StoreStatus::started_at() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.started_at)
+inline ::google::protobuf::int64 RestoreStatus::started_at() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.started_at)
   return started_at_;
 }
-inline void StoreStatus::set_started_at(::google::protobuf::int64 value) {
+inline void RestoreStatus::set_started_at(::google::protobuf::int64 value) {
   set_has_started_at();
   started_at_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.started_at)
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.started_at)
 }
 
-// optional int64 updated_at = 5;
-inline bool StoreStatus::has_updated_at() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
+// optional int64 updated_at = 6;
+inline bool RestoreStatus::has_updated_at() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
 }
-inline void StoreStatus::set_has_updated_at() {
-  _has_bits_[0] |= 0x00000010u;
+inline void RestoreStatus::set_has_updated_at() {
+  _has_bits_[0] |= 0x00000020u;
 }
-inline void StoreStatus::clear_has_updated_at() {
-  _has_bits_[0] &= ~0x00000010u;
+inline void RestoreStatus::clear_has_updated_at() {
+  _has_bits_[0] &= ~0x00000020u;
 }
-inline void StoreStatus::clear_updated_at() {
+inline void RestoreStatus::clear_updated_at() {
   updated_at_ = GOOGLE_LONGLONG(0);
   clear_has_updated_at();
 }
-inline ::google::protobuf::int64 StoreStatus::updated_at() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.updated_at)
+inline ::google::protobuf::int64 RestoreStatus::updated_at() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.updated_at)
   return updated_at_;
 }
-inline void StoreStatus::set_updated_at(::google::protobuf::int64 value) {
+inline void RestoreStatus::set_updated_at(::google::protobuf::int64 value) {
   set_has_updated_at();
   updated_at_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.StoreStatus.updated_at)
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.updated_at)
 }
 
-// optional .cockroach.proto.MVCCStats stats = 6;
-inline bool StoreStatus::has_stats() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// optional bool done = 7;
+inline bool RestoreStatus::has_done() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
 }
-inline void StoreStatus::set_has_stats() {
-  _has_bits_[0] |= 0x00000020u;
+inline void RestoreStatus::set_has_done() {
+  _has_bits_[0] |= 0x00000040u;
 }
-inline void StoreStatus::clear_has_stats() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void RestoreStatus::clear_has_done() {
+  _has_bits_[0] &= ~0x00000040u;
 }
-inline void StoreStatus::clear_stats() {
-  if (stats_ != NULL) stats_->::cockroach::proto::MVCCStats::Clear();
-  clear_has_stats();
+inline void RestoreStatus::clear_done() {
+  done_ = false;
+  clear_has_done();
 }
-inline const ::cockroach::proto::MVCCStats& StoreStatus::stats() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.StoreStatus.stats)
-  return stats_ != NULL ? *stats_ : *default_instance_->stats_;
+inline bool RestoreStatus::done() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.done)
+  return done_;
 }
-inline ::cockroach::proto::MVCCStats* StoreStatus::mutable_stats() {
-  set_has_stats();
-  if (stats_ == NULL) stats_ = new ::cockroach::proto::MVCCStats;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreStatus.stats)
-  return stats_;
+inline void RestoreStatus::set_done(bool value) {
+  set_has_done();
+  done_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.done)
 }
-inline ::cockroach::proto::MVCCStats* StoreStatus::release_stats() {
-  clear_has_stats();
-  ::cockroach::proto::MVCCStats* temp = stats_;
-  stats_ = NULL;
-  return temp;
+
+// optional string error = 8;
+inline bool RestoreStatus::has_error() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
 }
-inline void StoreStatus::set_allocated_stats(::cockroach::proto::MVCCStats* stats) {
-  delete stats_;
-  stats_ = stats;
-  if (stats) {
-    set_has_stats();
+inline void RestoreStatus::set_has_error() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void RestoreStatus::clear_has_error() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void RestoreStatus::clear_error() {
+  if (error_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    error_->clear();
+  }
+  clear_has_error();
+}
+inline const ::std::string& RestoreStatus::error() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RestoreStatus.error)
+  return *error_;
+}
+inline void RestoreStatus::set_error(const ::std::string& value) {
+  set_has_error();
+  if (error_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    error_ = new ::std::string;
+  }
+  error_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RestoreStatus.error)
+}
+inline void RestoreStatus::set_error(const char* value) {
+  set_has_error();
+  if (error_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    error_ = new ::std::string;
+  }
+  error_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RestoreStatus.error)
+}
+inline void RestoreStatus::set_error(const char* value, size_t size) {
+  set_has_error();
+  if (error_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    error_ = new ::std::string;
+  }
+  error_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RestoreStatus.error)
+}
+inline ::std::string* RestoreStatus::mutable_error() {
+  set_has_error();
+  if (error_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    error_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RestoreStatus.error)
+  return error_;
+}
+inline ::std::string* RestoreStatus::release_error() {
+  clear_has_error();
+  if (error_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_stats();
+    ::std::string* temp = error_;
+    error_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreStatus.stats)
+}
+inline void RestoreStatus::set_allocated_error(::std::string* error) {
+  if (error_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete error_;
+  }
+  if (error) {
+    set_has_error();
+    error_ = error;
+  } else {
+    clear_has_error();
+    error_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RestoreStatus.error)
+}
+
+// -------------------------------------------------------------------
+
+// StatusDetailsRequest
+
+// -------------------------------------------------------------------
+
+// StatusDetailsResponse
+
+// optional int32 node_id = 1;
+inline bool StatusDetailsResponse::has_node_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void StatusDetailsResponse::set_has_node_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void StatusDetailsResponse::clear_has_node_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void StatusDetailsResponse::clear_node_id() {
+  node_id_ = 0;
+  clear_has_node_id();
+}
+inline ::google::protobuf::int32 StatusDetailsResponse::node_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StatusDetailsResponse.node_id)
+  return node_id_;
+}
+inline void StatusDetailsResponse::set_node_id(::google::protobuf::int32 value) {
+  set_has_node_id();
+  node_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StatusDetailsResponse.node_id)
+}
+
+// optional string build_tag = 2;
+inline bool StatusDetailsResponse::has_build_tag() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void StatusDetailsResponse::set_has_build_tag() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void StatusDetailsResponse::clear_has_build_tag() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void StatusDetailsResponse::clear_build_tag() {
+  if (build_tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    build_tag_->clear();
+  }
+  clear_has_build_tag();
+}
+inline const ::std::string& StatusDetailsResponse::build_tag() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StatusDetailsResponse.build_tag)
+  return *build_tag_;
+}
+inline void StatusDetailsResponse::set_build_tag(const ::std::string& value) {
+  set_has_build_tag();
+  if (build_tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    build_tag_ = new ::std::string;
+  }
+  build_tag_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.StatusDetailsResponse.build_tag)
+}
+inline void StatusDetailsResponse::set_build_tag(const char* value) {
+  set_has_build_tag();
+  if (build_tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    build_tag_ = new ::std::string;
+  }
+  build_tag_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.StatusDetailsResponse.build_tag)
+}
+inline void StatusDetailsResponse::set_build_tag(const char* value, size_t size) {
+  set_has_build_tag();
+  if (build_tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    build_tag_ = new ::std::string;
+  }
+  build_tag_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.StatusDetailsResponse.build_tag)
+}
+inline ::std::string* StatusDetailsResponse::mutable_build_tag() {
+  set_has_build_tag();
+  if (build_tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    build_tag_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StatusDetailsResponse.build_tag)
+  return build_tag_;
+}
+inline ::std::string* StatusDetailsResponse::release_build_tag() {
+  clear_has_build_tag();
+  if (build_tag_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = build_tag_;
+    build_tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void StatusDetailsResponse::set_allocated_build_tag(::std::string* build_tag) {
+  if (build_tag_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete build_tag_;
+  }
+  if (build_tag) {
+    set_has_build_tag();
+    build_tag_ = build_tag;
+  } else {
+    clear_has_build_tag();
+    build_tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StatusDetailsResponse.build_tag)
+}
+
+// repeated .cockroach.proto.RequestLatency request_latencies = 3;
+inline int StatusDetailsResponse::request_latencies_size() const {
+  return request_latencies_.size();
+}
+inline void StatusDetailsResponse::clear_request_latencies() {
+  request_latencies_.Clear();
+}
+inline const ::cockroach::proto::RequestLatency& StatusDetailsResponse::request_latencies(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StatusDetailsResponse.request_latencies)
+  return request_latencies_.Get(index);
+}
+inline ::cockroach::proto::RequestLatency* StatusDetailsResponse::mutable_request_latencies(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StatusDetailsResponse.request_latencies)
+  return request_latencies_.Mutable(index);
+}
+inline ::cockroach::proto::RequestLatency* StatusDetailsResponse::add_request_latencies() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.StatusDetailsResponse.request_latencies)
+  return request_latencies_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >&
+StatusDetailsResponse::request_latencies() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.StatusDetailsResponse.request_latencies)
+  return request_latencies_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestLatency >*
+StatusDetailsResponse::mutable_request_latencies() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.StatusDetailsResponse.request_latencies)
+  return &request_latencies_;
+}
+
+// -------------------------------------------------------------------
+
+// StatusStoresRequest
+
+// -------------------------------------------------------------------
+
+// StatusStoresResponse
+
+// repeated .cockroach.proto.StoreStatus stores = 1;
+inline int StatusStoresResponse::stores_size() const {
+  return stores_.size();
+}
+inline void StatusStoresResponse::clear_stores() {
+  stores_.Clear();
+}
+inline const ::cockroach::proto::StoreStatus& StatusStoresResponse::stores(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StatusStoresResponse.stores)
+  return stores_.Get(index);
+}
+inline ::cockroach::proto::StoreStatus* StatusStoresResponse::mutable_stores(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StatusStoresResponse.stores)
+  return stores_.Mutable(index);
+}
+inline ::cockroach::proto::StoreStatus* StatusStoresResponse::add_stores() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.StatusStoresResponse.stores)
+  return stores_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::StoreStatus >&
+StatusStoresResponse::stores() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.StatusStoresResponse.stores)
+  return stores_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::StoreStatus >*
+StatusStoresResponse::mutable_stores() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.StatusStoresResponse.stores)
+  return &stores_;
 }
 
 
@@ -18,13 +18,17 @@
 
 #include <algorithm>
 #include <limits>
+#include <stdio.h>
+#include <stdlib.h>
 #include <google/protobuf/repeated_field.h>
 #include "rocksdb/cache.h"
 #include "rocksdb/compaction_filter.h"
 #include "rocksdb/db.h"
 #include "rocksdb/env.h"
+#include "rocksdb/filter_policy.h"
 #include "rocksdb/merge_operator.h"
 #include "rocksdb/options.h"
+#include "rocksdb/statistics.h"
 #include "rocksdb/table.h"
 #include "cockroach/proto/api.pb.h"
 #include "cockroach/proto/data.pb.h"
@@ -45,6 +49,8 @@ struct DBBatch {
 struct DBEngine {
   rocksdb::DB* rep;
   rocksdb::Env* memenv;
+  std::shared_ptr<rocksdb::Cache> block_cache;
+  std::shared_ptr<rocksdb::Statistics> statistics;
 };
 
 struct DBIterator {
@@ -61,7 +67,7 @@ struct DBSnapshot {
 namespace {
 
 // NOTE: these constants must be kept in sync with the values in
-// storage/engine/keys.go. Both kKeyLocalRangeIDPrefix and
+// keys/constants.go. Both kKeyLocalRangeIDPrefix and
 // kKeyLocalRangeKeyPrefix are the mvcc-encoded prefixes.
 const int kKeyLocalRangePrefixSize = 4;
 const rocksdb::Slice kKeyLocalRangeIDPrefix("\x00\xff\x00\xff\x00\xffi", 7);
@@ -103,6 +109,9 @@ DBStatus ToDBStatus(const rocksdb::Status& status) {
 
 rocksdb::ReadOptions MakeReadOptions(DBSnapshot* snap) {
   rocksdb::ReadOptions options;
+  // Detect corrupted blocks on every read rather than returning their
+  // contents.
+  options.verify_checksums = true;
   if (snap != NULL) {
     options.snapshot = snap->rep;
   }
@@ -116,6 +125,8 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.put().header();
   } else if (rwResp.has_conditional_put()) {
     return &rwResp.conditional_put().header();
+  } else if (rwResp.has_init_put()) {
+    return &rwResp.init_put().header();
   } else if (rwResp.has_increment()) {
     return &rwResp.increment().header();
   } else if (rwResp.has_delete_()) {
@@ -136,6 +147,10 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.internal_merge().header();
   } else if (rwResp.has_internal_truncate_log()) {
     return &rwResp.internal_truncate_log().header();
+  } else if (rwResp.has_clear_range()) {
+    return &rwResp.clear_range().header();
+  } else if (rwResp.has_batch()) {
+    return &rwResp.batch().header();
   }
   return NULL;
 }
@@ -745,16 +760,37 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   rocksdb::BlockBasedTableOptions table_options;
   table_options.block_cache = rocksdb::NewLRUCache(
       db_opts.cache_size, 4 /* num-shard-bits */);
+  if (db_opts.bloom_bits_per_key > 0) {
+    table_options.filter_policy.reset(
+        rocksdb::NewBloomFilterPolicy(db_opts.bloom_bits_per_key));
+  }
 
   rocksdb::Options options;
   options.allow_os_buffer = db_opts.allow_os_buffer;
-  options.compression = rocksdb::kSnappyCompression;
+  switch (db_opts.compression) {
+    case 1:
+      options.compression = rocksdb::kNoCompression;
+      break;
+    case 2:
+      options.compression = rocksdb::kZlibCompression;
+      break;
+    case 3:
+      options.compression = rocksdb::kLZ4Compression;
+      break;
+    default:
+      options.compression = rocksdb::kSnappyCompression;
+      break;
+  }
   options.compaction_filter_factory.reset(new DBCompactionFilterFactory());
-  options.create_if_missing = true;
+  options.create_if_missing = !db_opts.read_only;
   options.info_log.reset(new DBLogger(db_opts.logging_enabled));
   options.merge_operator.reset(new DBMergeOperator);
+  options.statistics = rocksdb::CreateDBStatistics();
   options.table_factory.reset(rocksdb::NewBlockBasedTableFactory(table_options));
-  options.write_buffer_size = 64 << 20;           // 64 MB
+  options.write_buffer_size = db_opts.write_buffer_size;
+  if (db_opts.max_open_files != 0) {
+    options.max_open_files = db_opts.max_open_files;
+  }
   options.target_file_size_base = 64 << 20;       // 64 MB
   options.max_bytes_for_level_base = 512 << 20;   // 512 MB
 
@@ -765,13 +801,20 @@ DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
   }
 
   rocksdb::DB *db_ptr;
-  rocksdb::Status status = rocksdb::DB::Open(options, ToString(dir), &db_ptr);
+  rocksdb::Status status;
+  if (db_opts.read_only) {
+    status = rocksdb::DB::OpenForReadOnly(options, ToString(dir), &db_ptr);
+  } else {
+    status = rocksdb::DB::Open(options, ToString(dir), &db_ptr);
+  }
   if (!status.ok()) {
     return ToDBStatus(status);
   }
   *db = new DBEngine;
   (*db)->rep = db_ptr;
   (*db)->memenv = memenv;
+  (*db)->block_cache = table_options.block_cache;
+  (*db)->statistics = options.statistics;
   return kSuccess;
 }
 
@@ -814,6 +857,49 @@ DBStatus DBCompactRange(DBEngine* db, DBSlice* start, DBSlice* end) {
   return ToDBStatus(db->rep->CompactRange(sPtr, ePtr));
 }
 
+DBStatus DBGetStats(DBEngine* db, DBStatsResult* stats) {
+  stats->block_cache_hits = (int64_t)db->statistics->getTickerCount(
+      rocksdb::BLOCK_CACHE_HIT);
+  stats->block_cache_misses = (int64_t)db->statistics->getTickerCount(
+      rocksdb::BLOCK_CACHE_MISS);
+  stats->block_cache_usage = (int64_t)db->block_cache->GetUsage();
+
+  std::string value;
+  if (!db->rep->GetProperty("rocksdb.cur-size-all-mem-tables", &value)) {
+    return ToDBString("unable to get memtable size");
+  }
+  stats->memtable_size = strtoll(value.c_str(), NULL, 10);
+  if (!db->rep->GetProperty("rocksdb.compaction-pending", &value)) {
+    return ToDBString("unable to get compaction pending");
+  }
+  stats->compaction_pending = strtoll(value.c_str(), NULL, 10) != 0;
+
+  stats->num_levels = std::min(db->rep->NumberLevels(), DB_MAX_LEVELS);
+  for (int i = 0; i < stats->num_levels; i++) {
+    char property[64];
+    snprintf(property, sizeof(property), "rocksdb.num-files-at-level%d", i);
+    if (!db->rep->GetProperty(property, &value)) {
+      return ToDBString("unable to get number of files at level");
+    }
+    stats->files_at_level[i] = strtoll(value.c_str(), NULL, 10);
+  }
+  return kSuccess;
+}
+
+DBStatus DBVerifyChecksums(DBEngine* db, DBSlice start, DBSlice end) {
+  rocksdb::ReadOptions options;
+  options.verify_checksums = true;
+  // Don't evict the working set from the block cache while scrubbing.
+  options.fill_cache = false;
+  const rocksdb::Slice end_key = ToSlice(end);
+  std::unique_ptr<rocksdb::Iterator> iter(db->rep->NewIterator(options));
+  for (iter->Seek(ToSlice(start));
+       iter->Valid() && iter->key().compare(end_key) < 0;
+       iter->Next()) {
+  }
+  return ToDBStatus(iter->status());
+}
+
 uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end) {
   const rocksdb::Range r(ToSlice(start), ToSlice(end));
   uint64_t result;
@@ -853,8 +939,9 @@ DBStatus DBDelete(DBEngine* db, DBSlice key) {
   return ToDBStatus(db->rep->Delete(options, ToSlice(key)));
 }
 
-DBStatus DBWrite(DBEngine* db, DBBatch *batch) {
+DBStatus DBWrite(DBEngine* db, DBBatch *batch, bool sync) {
   rocksdb::WriteOptions options;
+  options.sync = sync;
   return ToDBStatus(db->rep->Write(options, &batch->rep));
 }
 
@@ -921,6 +1008,10 @@ void DBBatchDestroy(DBBatch* batch) {
   delete batch;
 }
 
+void DBBatchClear(DBBatch* batch) {
+  batch->rep.Clear();
+}
+
 void DBBatchPut(DBBatch* batch, DBSlice key, DBSlice value) {
   batch->rep.Put(ToSlice(key), ToSlice(value));
 }
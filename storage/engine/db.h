@@ -48,15 +48,38 @@ typedef struct DBEngine DBEngine;
 typedef struct DBIterator DBIterator;
 typedef struct DBSnapshot DBSnapshot;
 
-// DBOptions contains local database options.
+// DBOptions contains local database options. The compression values
+// must be kept in sync with CompressionType in
+// storage/engine/rocksdb.go.
 typedef struct {
   int64_t cache_size;
+  int64_t write_buffer_size;
+  int bloom_bits_per_key;
+  int compression;
+  int max_open_files;
   bool allow_os_buffer;
   bool logging_enabled;
+  bool read_only;
 } DBOptions;
 
+// DB_MAX_LEVELS is the maximum number of levels for which file
+// counts are reported in DBStatsResult.
+#define DB_MAX_LEVELS 8
+
+// DBStatsResult contains RocksDB property and statistics values.
+typedef struct {
+  int64_t block_cache_hits;
+  int64_t block_cache_misses;
+  int64_t block_cache_usage;
+  int64_t memtable_size;
+  bool compaction_pending;
+  int num_levels;
+  int64_t files_at_level[DB_MAX_LEVELS];
+} DBStatsResult;
+
 // Opens the database located in "dir", creating it if it doesn't
-// exist.
+// exist. A database opened read-only must already exist, and all
+// writes to it fail.
 DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions options);
 
 // Destroys the database located in "dir". As the name implies, this
@@ -80,6 +103,13 @@ void DBSetGCTimeouts(DBEngine * db, int64_t min_txn_ts, int64_t min_rcache_ts);
 // database.
 DBStatus DBCompactRange(DBEngine* db, DBSlice* start, DBSlice* end);
 
+// Retrieves property and statistics values for the database.
+DBStatus DBGetStats(DBEngine* db, DBStatsResult* stats);
+
+// Reads every key in the range [start,end), verifying the checksum of
+// each block read. Returns an error if any block is corrupt.
+DBStatus DBVerifyChecksums(DBEngine* db, DBSlice start, DBSlice end);
+
 // Returns the approximate file system spaced used by keys in the
 // range [start,end].
 uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end);
@@ -99,8 +129,9 @@ DBStatus DBGet(DBEngine* db, DBSnapshot* snapshot, DBSlice key, DBString* value)
 DBStatus DBDelete(DBEngine* db, DBSlice key);
 
 // Applies a batch of operations (puts, merges and deletes) to the
-// database atomically.
-DBStatus DBWrite(DBEngine* db, DBBatch *batch);
+// database atomically. If sync is true, the write is synced to
+// stable storage before returning.
+DBStatus DBWrite(DBEngine* db, DBBatch *batch, bool sync);
 
 // Creates a new snapshot of the database for use in DBGet() and
 // DBNewIter(). It is the callers responsibility to call
@@ -155,6 +186,9 @@ DBBatch* DBNewBatch();
 // Destroys a batch, freeing any associated memory.
 void DBBatchDestroy(DBBatch* batch);
 
+// Removes all the operations from a batch, allowing it to be reused.
+void DBBatchClear(DBBatch* batch);
+
 // Sets the database entry for "key" to "value".
 void DBBatchPut(DBBatch* batch, DBSlice key, DBSlice value);
 
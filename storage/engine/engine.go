@@ -99,6 +99,9 @@ type Engine interface {
 	// merges. The list passed to WriteBatch must only contain elements
 	// of type Batch{Put,Merge,Delete}.
 	WriteBatch([]interface{}) error
+	// WriteSyncBatch is like WriteBatch, but does not return until the
+	// writes have been synced to stable storage.
+	WriteSyncBatch([]interface{}) error
 	// Merge is a high-performance write operation used for values which are
 	// accumulated over several writes. Multiple values can be merged
 	// sequentially into a single key; a subsequent read will return a "merged"
@@ -115,6 +118,13 @@ type Engine interface {
 	Merge(key proto.EncodedKey, value []byte) error
 	// Capacity returns capacity details for the engine's available storage.
 	Capacity() (StoreCapacity, error)
+	// GetStats returns internal statistics of the engine, such as the
+	// number of files per level and block cache hits and misses.
+	GetStats() (*proto.EngineStats, error)
+	// VerifyChecksums reads all keys in the range [start,end),
+	// verifying the checksums of the underlying storage blocks. Returns
+	// an error if corruption is detected.
+	VerifyChecksums(start, end proto.EncodedKey) error
 	// SetGCTimeouts sets a function which yields timeout values for GC
 	// compaction of transaction and response cache entries. The return
 	// values are in unix nanoseconds for the minimum transaction row
@@ -132,6 +142,11 @@ type Engine interface {
 	// engine. The caller must invoke Iterator.Close() when finished with
 	// the iterator to free resources.
 	NewIterator() Iterator
+	// NewTimeBoundIterator is like NewIterator, but may skip data
+	// which is known to hold no MVCC versions with timestamps in
+	// [start, end]. The iterator yields every such version, but may
+	// also yield any other keys, which callers must filter out.
+	NewTimeBoundIterator(start, end proto.Timestamp) Iterator
 	// NewSnapshot returns a new instance of a read-only snapshot
 	// engine. Snapshots are instantaneous and, as long as they're
 	// released relatively quickly, inexpensive. Snapshots are released
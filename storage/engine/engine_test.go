@@ -28,6 +28,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
@@ -356,7 +357,7 @@ func TestEngineScan1(t *testing.T) {
 		// a special case in engine.scan, that's why we test it here.
 		startKeys := []proto.EncodedKey{proto.EncodedKey("cat"), proto.EncodedKey("")}
 		for _, startKey := range startKeys {
-			keyvals, err := Scan(engine, startKey, proto.EncodedKey(KeyMax), 0)
+			keyvals, err := Scan(engine, startKey, proto.EncodedKey(keys.KeyMax), 0)
 			if err != nil {
 				t.Fatalf("could not run scan: %v", err)
 			}
@@ -432,50 +433,50 @@ func TestEngineScan2(t *testing.T) {
 	// TODO(Tobias): Merge this with TestEngineScan1 and remove
 	// either verifyScan or the other helper function.
 	runWithAllEngines(func(engine Engine, t *testing.T) {
-		keys := []proto.EncodedKey{
+		testKeys := []proto.EncodedKey{
 			proto.EncodedKey("a"),
 			proto.EncodedKey("aa"),
 			proto.EncodedKey("aaa"),
 			proto.EncodedKey("ab"),
 			proto.EncodedKey("abc"),
-			proto.EncodedKey(KeyMax),
+			proto.EncodedKey(keys.KeyMax),
 		}
 
-		insertKeys(keys, engine, t)
+		insertKeys(testKeys, engine, t)
 
 		// Scan all keys (non-inclusive of final key).
-		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 10, keys[0:5], engine, t)
-		verifyScan(proto.EncodedKey("a"), proto.EncodedKey(KeyMax), 10, keys[0:5], engine, t)
+		verifyScan(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 10, testKeys[0:5], engine, t)
+		verifyScan(proto.EncodedKey("a"), proto.EncodedKey(keys.KeyMax), 10, testKeys[0:5], engine, t)
 
 		// Scan sub range.
-		verifyScan(proto.EncodedKey("aab"), proto.EncodedKey("abcc"), 10, keys[3:5], engine, t)
-		verifyScan(proto.EncodedKey("aa0"), proto.EncodedKey("abcc"), 10, keys[2:5], engine, t)
+		verifyScan(proto.EncodedKey("aab"), proto.EncodedKey("abcc"), 10, testKeys[3:5], engine, t)
+		verifyScan(proto.EncodedKey("aa0"), proto.EncodedKey("abcc"), 10, testKeys[2:5], engine, t)
 
 		// Scan with max values.
-		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 3, keys[0:3], engine, t)
-		verifyScan(proto.EncodedKey("a0"), proto.EncodedKey(KeyMax), 3, keys[1:4], engine, t)
+		verifyScan(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 3, testKeys[0:3], engine, t)
+		verifyScan(proto.EncodedKey("a0"), proto.EncodedKey(keys.KeyMax), 3, testKeys[1:4], engine, t)
 
 		// Scan with max value 0 gets all values.
-		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0, keys[0:5], engine, t)
+		verifyScan(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0, testKeys[0:5], engine, t)
 	}, t)
 }
 
 func TestEngineDeleteRange(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	runWithAllEngines(func(engine Engine, t *testing.T) {
-		keys := []proto.EncodedKey{
+		testKeys := []proto.EncodedKey{
 			proto.EncodedKey("a"),
 			proto.EncodedKey("aa"),
 			proto.EncodedKey("aaa"),
 			proto.EncodedKey("ab"),
 			proto.EncodedKey("abc"),
-			proto.EncodedKey(KeyMax),
+			proto.EncodedKey(keys.KeyMax),
 		}
 
-		insertKeys(keys, engine, t)
+		insertKeys(testKeys, engine, t)
 
 		// Scan all keys (non-inclusive of final key).
-		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 10, keys[0:5], engine, t)
+		verifyScan(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 10, testKeys[0:5], engine, t)
 
 		// Delete a range of keys
 		numDeleted, err := ClearRange(engine, proto.EncodedKey("aa"), proto.EncodedKey("abc"))
@@ -487,7 +488,7 @@ func TestEngineDeleteRange(t *testing.T) {
 			t.Errorf("Expected to delete 3 entries; was %v", numDeleted)
 		}
 		// Verify what's left
-		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 10,
+		verifyScan(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 10,
 			[]proto.EncodedKey{proto.EncodedKey("a"), proto.EncodedKey("abc")}, engine, t)
 	}, t)
 }
@@ -523,8 +524,8 @@ func TestSnapshot(t *testing.T) {
 				valSnapshot, val1)
 		}
 
-		keyvals, _ := Scan(engine, key, proto.EncodedKey(KeyMax), 0)
-		keyvalsSnapshot, error := Scan(snap, key, proto.EncodedKey(KeyMax), 0)
+		keyvals, _ := Scan(engine, key, proto.EncodedKey(keys.KeyMax), 0)
+		keyvalsSnapshot, error := Scan(snap, key, proto.EncodedKey(keys.KeyMax), 0)
 		if error != nil {
 			t.Fatalf("error : %s", error)
 		}
@@ -544,10 +545,10 @@ func TestSnapshot(t *testing.T) {
 func TestSnapshotMethods(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	runWithAllEngines(func(engine Engine, t *testing.T) {
-		keys := [][]byte{[]byte("a"), []byte("b")}
+		testKeys := [][]byte{[]byte("a"), []byte("b")}
 		vals := [][]byte{[]byte("1"), []byte("2")}
-		for i := range keys {
-			engine.Put(keys[i], vals[i])
+		for i := range testKeys {
+			engine.Put(testKeys[i], vals[i])
 		}
 		snap := engine.NewSnapshot()
 		defer snap.Close()
@@ -568,7 +569,7 @@ func TestSnapshotMethods(t *testing.T) {
 		}
 
 		// Verify Get.
-		valSnapshot, err := snap.Get(keys[0])
+		valSnapshot, err := snap.Get(testKeys[0])
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -578,8 +579,8 @@ func TestSnapshotMethods(t *testing.T) {
 		}
 
 		// Verify Scan.
-		keyvals, _ := Scan(engine, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
-		keyvalsSnapshot, err := Scan(snap, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
+		keyvals, _ := Scan(engine, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
+		keyvalsSnapshot, err := Scan(snap, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -590,10 +591,10 @@ func TestSnapshotMethods(t *testing.T) {
 
 		// Verify Iterate.
 		index := 0
-		if err := snap.Iterate(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), func(kv proto.RawKeyValue) (bool, error) {
-			if !bytes.Equal(kv.Key, keys[index]) || !bytes.Equal(kv.Value, vals[index]) {
+		if err := snap.Iterate(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), func(kv proto.RawKeyValue) (bool, error) {
+			if !bytes.Equal(kv.Key, testKeys[index]) || !bytes.Equal(kv.Value, vals[index]) {
 				t.Errorf("%d: key/value not equal between expected and snapshot: %s/%s, %s/%s",
-					index, keys[index], vals[index], kv.Key, kv.Value)
+					index, testKeys[index], vals[index], kv.Key, kv.Value)
 			}
 			index++
 			return false, nil
@@ -602,12 +603,12 @@ func TestSnapshotMethods(t *testing.T) {
 		}
 
 		// Verify Clear is error.
-		if err := snap.Clear(keys[0]); err == nil {
+		if err := snap.Clear(testKeys[0]); err == nil {
 			t.Error("expected error on Clear to snapshot")
 		}
 
 		// Verify WriteBatch is error.
-		if err := snap.WriteBatch([]interface{}{BatchDelete{proto.RawKeyValue{Key: keys[0]}}}); err == nil {
+		if err := snap.WriteBatch([]interface{}{BatchDelete{proto.RawKeyValue{Key: testKeys[0]}}}); err == nil {
 			t.Error("expected error on WriteBatch to snapshot")
 		}
 
@@ -632,11 +633,11 @@ func TestSnapshotMethods(t *testing.T) {
 		}
 
 		// Verify ApproximateSize.
-		approx, err := engine.ApproximateSize(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax))
+		approx, err := engine.ApproximateSize(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax))
 		if err != nil {
 			t.Fatal(err)
 		}
-		approxSnapshot, err := snap.ApproximateSize(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax))
+		approxSnapshot, err := snap.ApproximateSize(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax))
 		if err != nil {
 			t.Fatal(err)
 		}
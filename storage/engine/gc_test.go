@@ -29,12 +29,12 @@ var (
 	aKey  = proto.Key("a")
 	bKey  = proto.Key("b")
 	aKeys = []proto.EncodedKey{
-		MVCCEncodeVersionKey(aKey, makeTS(2E9, 0)),
+		MVCCEncodeVersionKey(aKey, makeTS(2e9, 0)),
 		MVCCEncodeVersionKey(aKey, makeTS(1E9, 1)),
 		MVCCEncodeVersionKey(aKey, makeTS(1E9, 0)),
 	}
 	bKeys = []proto.EncodedKey{
-		MVCCEncodeVersionKey(bKey, makeTS(2E9, 0)),
+		MVCCEncodeVersionKey(bKey, makeTS(2e9, 0)),
 		MVCCEncodeVersionKey(bKey, makeTS(1E9, 0)),
 	}
 )
@@ -63,22 +63,22 @@ func TestGarbageCollectorFilter(t *testing.T) {
 		expDelTS proto.Timestamp
 	}{
 		{gcA, makeTS(0, 0), aKeys, [][]byte{n, n, n}, proto.ZeroTimestamp},
-		{gcA, makeTS(0, 0), aKeys, [][]byte{d, d, d}, makeTS(2E9, 0)},
+		{gcA, makeTS(0, 0), aKeys, [][]byte{d, d, d}, makeTS(2e9, 0)},
 		{gcB, makeTS(0, 0), bKeys, [][]byte{n, n}, proto.ZeroTimestamp},
-		{gcB, makeTS(0, 0), bKeys, [][]byte{d, d}, makeTS(2E9, 0)},
+		{gcB, makeTS(0, 0), bKeys, [][]byte{d, d}, makeTS(2e9, 0)},
 		{gcA, makeTS(1E9, 0), aKeys, [][]byte{n, n, n}, proto.ZeroTimestamp},
 		{gcB, makeTS(1E9, 0), bKeys, [][]byte{n, n}, proto.ZeroTimestamp},
-		{gcA, makeTS(2E9, 0), aKeys, [][]byte{n, n, n}, proto.ZeroTimestamp},
-		{gcB, makeTS(2E9, 0), bKeys, [][]byte{n, n}, proto.ZeroTimestamp},
+		{gcA, makeTS(2e9, 0), aKeys, [][]byte{n, n, n}, proto.ZeroTimestamp},
+		{gcB, makeTS(2e9, 0), bKeys, [][]byte{n, n}, proto.ZeroTimestamp},
 		{gcA, makeTS(3E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
-		{gcA, makeTS(3E9, 0), aKeys, [][]byte{d, n, n}, makeTS(2E9, 0)},
+		{gcA, makeTS(3e9, 0), aKeys, [][]byte{d, n, n}, makeTS(2e9, 0)},
 		{gcB, makeTS(3E9, 0), bKeys, [][]byte{n, n}, proto.ZeroTimestamp},
 		{gcA, makeTS(4E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
 		{gcB, makeTS(4E9, 0), bKeys, [][]byte{n, n}, makeTS(1E9, 0)},
-		{gcB, makeTS(4E9, 0), bKeys, [][]byte{d, n}, makeTS(2E9, 0)},
+		{gcB, makeTS(4e9, 0), bKeys, [][]byte{d, n}, makeTS(2e9, 0)},
 		{gcA, makeTS(5E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{n, n}, makeTS(1E9, 0)},
-		{gcB, makeTS(5E9, 0), bKeys, [][]byte{d, n}, makeTS(2E9, 0)},
+		{gcB, makeTS(5e9, 0), bKeys, [][]byte{d, n}, makeTS(2e9, 0)},
 	}
 	for i, test := range testData {
 		test.gc.expiration = test.time
@@ -24,6 +24,7 @@ import (
 	"math"
 	"sync"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
@@ -38,34 +39,38 @@ const (
 	mvccVersionTimestampSize int64 = 12
 )
 
+// MVCCKeyMax is a maximum mvcc-encoded key value which sorts after
+// all other keys.
+var MVCCKeyMax = MVCCEncodeKey(keys.KeyMax)
+
 // MergeStats merges accumulated stats to stat counters for specified range.
 func MergeStats(ms *proto.MVCCStats, engine Engine, raftID int64) {
-	MVCCMergeRangeStat(engine, raftID, StatLiveBytes, ms.LiveBytes)
-	MVCCMergeRangeStat(engine, raftID, StatKeyBytes, ms.KeyBytes)
-	MVCCMergeRangeStat(engine, raftID, StatValBytes, ms.ValBytes)
-	MVCCMergeRangeStat(engine, raftID, StatIntentBytes, ms.IntentBytes)
-	MVCCMergeRangeStat(engine, raftID, StatLiveCount, ms.LiveCount)
-	MVCCMergeRangeStat(engine, raftID, StatKeyCount, ms.KeyCount)
-	MVCCMergeRangeStat(engine, raftID, StatValCount, ms.ValCount)
-	MVCCMergeRangeStat(engine, raftID, StatIntentCount, ms.IntentCount)
-	MVCCMergeRangeStat(engine, raftID, StatIntentAge, ms.IntentAge)
-	MVCCMergeRangeStat(engine, raftID, StatGCBytesAge, ms.GCBytesAge)
-	MVCCMergeRangeStat(engine, raftID, StatLastUpdateNanos, ms.LastUpdateNanos)
+	MVCCMergeRangeStat(engine, raftID, keys.StatLiveBytes, ms.LiveBytes)
+	MVCCMergeRangeStat(engine, raftID, keys.StatKeyBytes, ms.KeyBytes)
+	MVCCMergeRangeStat(engine, raftID, keys.StatValBytes, ms.ValBytes)
+	MVCCMergeRangeStat(engine, raftID, keys.StatIntentBytes, ms.IntentBytes)
+	MVCCMergeRangeStat(engine, raftID, keys.StatLiveCount, ms.LiveCount)
+	MVCCMergeRangeStat(engine, raftID, keys.StatKeyCount, ms.KeyCount)
+	MVCCMergeRangeStat(engine, raftID, keys.StatValCount, ms.ValCount)
+	MVCCMergeRangeStat(engine, raftID, keys.StatIntentCount, ms.IntentCount)
+	MVCCMergeRangeStat(engine, raftID, keys.StatIntentAge, ms.IntentAge)
+	MVCCMergeRangeStat(engine, raftID, keys.StatGCBytesAge, ms.GCBytesAge)
+	MVCCMergeRangeStat(engine, raftID, keys.StatLastUpdateNanos, ms.LastUpdateNanos)
 }
 
 // SetStats sets stat counters for specified range.
 func SetStats(ms *proto.MVCCStats, engine Engine, raftID int64) {
-	MVCCSetRangeStat(engine, raftID, StatLiveBytes, ms.LiveBytes)
-	MVCCSetRangeStat(engine, raftID, StatKeyBytes, ms.KeyBytes)
-	MVCCSetRangeStat(engine, raftID, StatValBytes, ms.ValBytes)
-	MVCCSetRangeStat(engine, raftID, StatIntentBytes, ms.IntentBytes)
-	MVCCSetRangeStat(engine, raftID, StatLiveCount, ms.LiveCount)
-	MVCCSetRangeStat(engine, raftID, StatKeyCount, ms.KeyCount)
-	MVCCSetRangeStat(engine, raftID, StatValCount, ms.ValCount)
-	MVCCSetRangeStat(engine, raftID, StatIntentCount, ms.IntentCount)
-	MVCCSetRangeStat(engine, raftID, StatIntentAge, ms.IntentAge)
-	MVCCSetRangeStat(engine, raftID, StatGCBytesAge, ms.GCBytesAge)
-	MVCCSetRangeStat(engine, raftID, StatLastUpdateNanos, ms.LastUpdateNanos)
+	MVCCSetRangeStat(engine, raftID, keys.StatLiveBytes, ms.LiveBytes)
+	MVCCSetRangeStat(engine, raftID, keys.StatKeyBytes, ms.KeyBytes)
+	MVCCSetRangeStat(engine, raftID, keys.StatValBytes, ms.ValBytes)
+	MVCCSetRangeStat(engine, raftID, keys.StatIntentBytes, ms.IntentBytes)
+	MVCCSetRangeStat(engine, raftID, keys.StatLiveCount, ms.LiveCount)
+	MVCCSetRangeStat(engine, raftID, keys.StatKeyCount, ms.KeyCount)
+	MVCCSetRangeStat(engine, raftID, keys.StatValCount, ms.ValCount)
+	MVCCSetRangeStat(engine, raftID, keys.StatIntentCount, ms.IntentCount)
+	MVCCSetRangeStat(engine, raftID, keys.StatIntentAge, ms.IntentAge)
+	MVCCSetRangeStat(engine, raftID, keys.StatGCBytesAge, ms.GCBytesAge)
+	MVCCSetRangeStat(engine, raftID, keys.StatLastUpdateNanos, ms.LastUpdateNanos)
 }
 
 // Accumulate adds values from oms to ms.
@@ -83,10 +88,25 @@ func Accumulate(ms *proto.MVCCStats, oms proto.MVCCStats) {
 	ms.LastUpdateNanos += oms.LastUpdateNanos
 }
 
+// Subtract subtracts values in oms from ms.
+func Subtract(ms *proto.MVCCStats, oms proto.MVCCStats) {
+	ms.LiveBytes -= oms.LiveBytes
+	ms.KeyBytes -= oms.KeyBytes
+	ms.ValBytes -= oms.ValBytes
+	ms.IntentBytes -= oms.IntentBytes
+	ms.LiveCount -= oms.LiveCount
+	ms.KeyCount -= oms.KeyCount
+	ms.ValCount -= oms.ValCount
+	ms.IntentCount -= oms.IntentCount
+	ms.IntentAge -= oms.IntentAge
+	ms.GCBytesAge -= oms.GCBytesAge
+	ms.LastUpdateNanos -= oms.LastUpdateNanos
+}
+
 // updateStatsForKey returns whether or not the bytes and counts for
 // the specified key should be tracked. Local keys are excluded.
 func updateStatsForKey(ms *proto.MVCCStats, key proto.Key) bool {
-	return ms != nil && !key.Less(KeyLocalMax)
+	return ms != nil && !key.Less(keys.KeyLocalMax)
 }
 
 // updateStatsForInline updates stat counters for an inline value.
@@ -287,7 +307,7 @@ func MVCCComputeGCBytesAge(bytes, ageSeconds int64) int64 {
 // MVCCGetRangeStat returns the value for the specified range stat, by
 // Raft ID and stat name.
 func MVCCGetRangeStat(engine Engine, raftID int64, stat proto.Key) (int64, error) {
-	val, err := MVCCGet(engine, RangeStatKey(raftID, stat), proto.ZeroTimestamp, true, nil)
+	val, err := MVCCGet(engine, keys.RangeStatKey(raftID, stat), proto.ZeroTimestamp, true, nil)
 	if err != nil || val == nil {
 		return 0, err
 	}
@@ -298,7 +318,7 @@ func MVCCGetRangeStat(engine Engine, raftID int64, stat proto.Key) (int64, error
 // Raft ID and stat name.
 func MVCCSetRangeStat(engine Engine, raftID int64, stat proto.Key, statVal int64) error {
 	value := proto.Value{Integer: gogoproto.Int64(statVal)}
-	if err := MVCCPut(engine, nil, RangeStatKey(raftID, stat), proto.ZeroTimestamp, value, nil); err != nil {
+	if err := MVCCPut(engine, nil, keys.RangeStatKey(raftID, stat), proto.ZeroTimestamp, value, nil); err != nil {
 		return err
 	}
 	return nil
@@ -311,7 +331,7 @@ func MVCCMergeRangeStat(engine Engine, raftID int64, stat proto.Key, statVal int
 		return nil
 	}
 	value := proto.Value{Integer: gogoproto.Int64(statVal)}
-	if err := MVCCMerge(engine, nil, RangeStatKey(raftID, stat), value); err != nil {
+	if err := MVCCMerge(engine, nil, keys.RangeStatKey(raftID, stat), value); err != nil {
 		return err
 	}
 	return nil
@@ -320,11 +340,11 @@ func MVCCMergeRangeStat(engine Engine, raftID int64, stat proto.Key, statVal int
 // MVCCGetRangeSize returns the size of the range, equal to the sum of
 // the key and value stats.
 func MVCCGetRangeSize(engine Engine, raftID int64) (int64, error) {
-	keyBytes, err := MVCCGetRangeStat(engine, raftID, StatKeyBytes)
+	keyBytes, err := MVCCGetRangeStat(engine, raftID, keys.StatKeyBytes)
 	if err != nil {
 		return 0, err
 	}
-	valBytes, err := MVCCGetRangeStat(engine, raftID, StatValBytes)
+	valBytes, err := MVCCGetRangeStat(engine, raftID, keys.StatValBytes)
 	if err != nil {
 		return 0, err
 	}
@@ -335,37 +355,37 @@ func MVCCGetRangeSize(engine Engine, raftID int64) (int64, error) {
 // sets the values in the supplied MVCCStats struct.
 func MVCCGetRangeStats(engine Engine, raftID int64, ms *proto.MVCCStats) error {
 	var err error
-	if ms.LiveBytes, err = MVCCGetRangeStat(engine, raftID, StatLiveBytes); err != nil {
+	if ms.LiveBytes, err = MVCCGetRangeStat(engine, raftID, keys.StatLiveBytes); err != nil {
 		return err
 	}
-	if ms.KeyBytes, err = MVCCGetRangeStat(engine, raftID, StatKeyBytes); err != nil {
+	if ms.KeyBytes, err = MVCCGetRangeStat(engine, raftID, keys.StatKeyBytes); err != nil {
 		return err
 	}
-	if ms.ValBytes, err = MVCCGetRangeStat(engine, raftID, StatValBytes); err != nil {
+	if ms.ValBytes, err = MVCCGetRangeStat(engine, raftID, keys.StatValBytes); err != nil {
 		return err
 	}
-	if ms.IntentBytes, err = MVCCGetRangeStat(engine, raftID, StatIntentBytes); err != nil {
+	if ms.IntentBytes, err = MVCCGetRangeStat(engine, raftID, keys.StatIntentBytes); err != nil {
 		return err
 	}
-	if ms.LiveCount, err = MVCCGetRangeStat(engine, raftID, StatLiveCount); err != nil {
+	if ms.LiveCount, err = MVCCGetRangeStat(engine, raftID, keys.StatLiveCount); err != nil {
 		return err
 	}
-	if ms.KeyCount, err = MVCCGetRangeStat(engine, raftID, StatKeyCount); err != nil {
+	if ms.KeyCount, err = MVCCGetRangeStat(engine, raftID, keys.StatKeyCount); err != nil {
 		return err
 	}
-	if ms.ValCount, err = MVCCGetRangeStat(engine, raftID, StatValCount); err != nil {
+	if ms.ValCount, err = MVCCGetRangeStat(engine, raftID, keys.StatValCount); err != nil {
 		return err
 	}
-	if ms.IntentCount, err = MVCCGetRangeStat(engine, raftID, StatIntentCount); err != nil {
+	if ms.IntentCount, err = MVCCGetRangeStat(engine, raftID, keys.StatIntentCount); err != nil {
 		return err
 	}
-	if ms.IntentAge, err = MVCCGetRangeStat(engine, raftID, StatIntentAge); err != nil {
+	if ms.IntentAge, err = MVCCGetRangeStat(engine, raftID, keys.StatIntentAge); err != nil {
 		return err
 	}
-	if ms.GCBytesAge, err = MVCCGetRangeStat(engine, raftID, StatGCBytesAge); err != nil {
+	if ms.GCBytesAge, err = MVCCGetRangeStat(engine, raftID, keys.StatGCBytesAge); err != nil {
 		return err
 	}
-	if ms.LastUpdateNanos, err = MVCCGetRangeStat(engine, raftID, StatLastUpdateNanos); err != nil {
+	if ms.LastUpdateNanos, err = MVCCGetRangeStat(engine, raftID, keys.StatLastUpdateNanos); err != nil {
 		return err
 	}
 	return nil
@@ -393,13 +413,19 @@ func MVCCGetProto(engine Engine, key proto.Key, timestamp proto.Timestamp, consi
 // MVCCPutProto sets the given key to the protobuf-serialized byte
 // string of msg and the provided timestamp.
 func MVCCPutProto(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction, msg gogoproto.Message) error {
-	data, err := gogoproto.Marshal(msg)
-	if err != nil {
+	// The marshaled bytes are copied by the engine, so a pooled buffer
+	// may be used for them.
+	buf := bufferPool.Get().(*gogoproto.Buffer)
+	buf.Reset()
+	if err := buf.Marshal(msg); err != nil {
+		bufferPool.Put(buf)
 		return err
 	}
-	value := proto.Value{Bytes: data}
+	value := proto.Value{Bytes: buf.Bytes()}
 	value.InitChecksum(key)
-	return MVCCPut(engine, ms, key, timestamp, value, txn)
+	err := MVCCPut(engine, ms, key, timestamp, value, txn)
+	bufferPool.Put(buf)
+	return err
 }
 
 type getBuffer struct {
@@ -488,7 +514,7 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, tim
 
 	// If value is inline, return immediately; txn & timestamp are irrelevant.
 	if meta.IsInline() {
-		if err := meta.Value.Verify(key); err != nil {
+		if err := verifyValue(engine, key, meta.Value); err != nil {
 			return nil, err
 		}
 		return meta.Value, nil
@@ -522,6 +548,16 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, tim
 		// we're now reading. In this case, we skip the intent.
 		if meta.Txn != nil && txn.Epoch != meta.Txn.Epoch {
 			valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+		} else if meta.Txn != nil && txn.IsSequenceIgnored(meta.Txn.GetSequence()) {
+			// The intent's write was rolled back to a savepoint. Read the
+			// transaction's latest earlier write which wasn't or, if there
+			// is none, the value beneath the intent.
+			if sv := latestIntentHistory(meta, txn); sv != nil {
+				*value = sv.Value
+				valueKey = latestKey
+			} else {
+				valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+			}
 		} else {
 			var ok bool
 			ok, _, _, err = engine.GetProto(latestKey, value)
@@ -590,7 +626,10 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, tim
 	// Set the timestamp if the value is not nil (i.e. not a deletion tombstone).
 	if value.Value != nil {
 		value.Value.Timestamp = &ts
-		if err := value.Value.Verify(key); err != nil {
+		if err := decompressValue(value.Value); err != nil {
+			return nil, err
+		}
+		if err := verifyValue(engine, key, value.Value); err != nil {
 			return nil, err
 		}
 	} else if !value.Deleted {
@@ -601,6 +640,80 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, tim
 	return value.Value, nil
 }
 
+// checksumMismatchReporter is implemented by engines which keep track
+// of the values read with mismatched checksums.
+type checksumMismatchReporter interface {
+	// reportChecksumMismatch records the checksum mismatch err and
+	// returns the error to fail the read with, or nil to allow the
+	// read to proceed.
+	reportChecksumMismatch(err error) error
+}
+
+// reportChecksumMismatch reports the checksum mismatch err to engine
+// if it keeps track of mismatches; otherwise err fails the read.
+func reportChecksumMismatch(engine Engine, err error) error {
+	if r, ok := engine.(checksumMismatchReporter); ok {
+		return r.reportChecksumMismatch(err)
+	}
+	return err
+}
+
+// verifyValue verifies the checksum and contents of the value read at
+// key. Checksum mismatches are reported to the engine, which decides
+// whether they fail the read.
+func verifyValue(engine Engine, key proto.Key, value *proto.Value) error {
+	if err := value.VerifyChecksum(key); err != nil {
+		if err := reportChecksumMismatch(engine, err); err != nil {
+			return err
+		}
+	}
+	if value.Bytes != nil && value.Integer != nil {
+		return util.Errorf("both the value byte slice and integer fields are set for key %s: [% x]",
+			key, value)
+	}
+	return nil
+}
+
+// intentHistory returns the intent history to record when txn
+// overwrites its own intent described by meta: the intent's existing
+// history followed by the intent's value, omitting writes which were
+// rolled back to a savepoint. The intent's value is also omitted if
+// it has the same sequence as txn, as the two writes can't be rolled
+// back separately.
+func intentHistory(engine Engine, metaKey proto.EncodedKey, meta *proto.MVCCMetadata,
+	txn *proto.Transaction) ([]proto.SequencedValue, error) {
+	var history []proto.SequencedValue
+	for _, sv := range meta.IntentHistory {
+		if !txn.IsSequenceIgnored(sv.Sequence) {
+			history = append(history, sv)
+		}
+	}
+	seq := meta.Txn.GetSequence()
+	if seq == 0 || seq == txn.GetSequence() || txn.IsSequenceIgnored(seq) {
+		return history, nil
+	}
+	sv := proto.SequencedValue{Sequence: seq}
+	versionKey := mvccEncodeTimestamp(metaKey, meta.Timestamp)
+	if ok, _, _, err := engine.GetProto(versionKey, &sv.Value); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, util.Errorf("missing intent value at %q", versionKey)
+	}
+	return append(history, sv), nil
+}
+
+// latestIntentHistory returns the latest write in the intent history
+// of meta which txn hasn't rolled back to a savepoint, or nil if there
+// is none.
+func latestIntentHistory(meta *proto.MVCCMetadata, txn *proto.Transaction) *proto.SequencedValue {
+	for i := len(meta.IntentHistory) - 1; i >= 0; i-- {
+		if !txn.IsSequenceIgnored(meta.IntentHistory[i].Sequence) {
+			return &meta.IntentHistory[i]
+		}
+	}
+	return nil
+}
+
 // putBuffer holds pointer data needed by mvccPutInternal. Bundling
 // this data into a single structure reduces memory
 // allocations. Managing this temporary buffer using a sync.Pool
@@ -716,6 +829,17 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 			return &proto.WriteIntentError{Key: key, Txn: *meta.Txn}
 		}
 
+		// A SNAPSHOT transaction reads at its original timestamp and so
+		// can't overwrite a value committed after that timestamp
+		// without losing the update; the first committer wins and the
+		// transaction restarts with a snapshot including the value.
+		if meta.Txn == nil && txn != nil && txn.Isolation == proto.SNAPSHOT &&
+			txn.OrigTimestamp.Less(meta.Timestamp) {
+			retryTxn := gogoproto.Clone(txn).(*proto.Transaction)
+			retryTxn.Timestamp.Forward(meta.Timestamp.Next())
+			return proto.NewTransactionRetryError(retryTxn)
+		}
+
 		// We can update the current metadata only if both the timestamp
 		// and epoch of the new intent are greater than or equal to
 		// existing. If either of these conditions doesn't hold, it's
@@ -725,6 +849,15 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 		// returned above.
 		if !timestamp.Less(meta.Timestamp) &&
 			(meta.Txn == nil || txn.Epoch >= meta.Txn.Epoch) {
+			// If the transaction is overwriting its own intent, keep the
+			// intent's value so it can be restored on a rollback to a
+			// savepoint.
+			var history []proto.SequencedValue
+			if meta.Txn != nil && txn.Epoch == meta.Txn.Epoch {
+				if history, err = intentHistory(engine, metaKey, meta, txn); err != nil {
+					return err
+				}
+			}
 			// If this is an intent and timestamps have changed,
 			// need to remove old version.
 			if meta.Txn != nil && !timestamp.Equal(meta.Timestamp) {
@@ -732,7 +865,7 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 				engine.Clear(versionKey)
 			}
 			newMeta = &buf.newMeta
-			*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp}
+			*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp, IntentHistory: history}
 		} else if timestamp.Less(meta.Timestamp) && meta.Txn == nil {
 			// If we receive a Put request to write before an already-
 			// committed version, send write tool old error.
@@ -753,7 +886,9 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 	}
 
 	// Make sure to zero the redundant timestamp (timestamp is encoded
-	// into the key, so don't need it in both places).
+	// into the key, so don't need it in both places). Values compressed
+	// by CompressValue are stored as is; mvccGetInternal decompresses
+	// them on the way out.
 	if value.Value != nil {
 		value.Value.Timestamp = nil
 	}
@@ -820,9 +955,11 @@ func MVCCIncrement(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp
 
 // MVCCConditionalPut sets the value for a specified key only if the
 // expected value matches. If not, the return a ConditionFailedError
-// containing the actual value.
+// containing the actual value. If allowIfDoesNotExist is true, a key
+// which doesn't exist or whose most recent version is a deletion
+// tombstone also matches.
 func MVCCConditionalPut(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp, value proto.Value,
-	expValue *proto.Value, txn *proto.Transaction) error {
+	expValue *proto.Value, allowIfDoesNotExist bool, txn *proto.Transaction) error {
 	// Handle check for non-existence of key. In order to detect
 	// the potential write intent by another concurrent transaction
 	// with a newer timestamp, we need to use the max timestamp
@@ -836,7 +973,7 @@ func MVCCConditionalPut(engine Engine, ms *proto.MVCCStats, key proto.Key, times
 		return &proto.ConditionFailedError{
 			ActualValue: existVal,
 		}
-	} else if expValue != nil {
+	} else if expValue != nil && (existVal != nil || !allowIfDoesNotExist) {
 		// Handle check for existence when there is no key.
 		if existVal == nil {
 			return &proto.ConditionFailedError{}
@@ -854,6 +991,31 @@ func MVCCConditionalPut(engine Engine, ms *proto.MVCCStats, key proto.Key, times
 	return MVCCPut(engine, ms, key, timestamp, value, txn)
 }
 
+// MVCCInitPut sets the value for a specified key only if the key
+// doesn't exist or its most recent version is a deletion tombstone.
+// If the key exists with a value equal to the one being put, nothing
+// is written. Otherwise, a ConditionFailedError containing the actual
+// value is returned.
+func MVCCInitPut(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp, value proto.Value,
+	txn *proto.Transaction) error {
+	// As with MVCCConditionalPut, read at the max timestamp in order to
+	// detect the potential write intent of a concurrent transaction.
+	existVal, err := MVCCGet(engine, key, proto.MaxTimestamp, true, txn)
+	if err != nil {
+		return err
+	}
+	if existVal != nil {
+		if bytes.Equal(value.Bytes, existVal.Bytes) &&
+			(value.Integer == nil) == (existVal.Integer == nil) && value.GetInteger() == existVal.GetInteger() {
+			return nil
+		}
+		return &proto.ConditionFailedError{
+			ActualValue: existVal,
+		}
+	}
+	return MVCCPut(engine, ms, key, timestamp, value, txn)
+}
+
 // MVCCMerge implements a merge operation. Merge adds integer values,
 // concatenates undifferentiated byte slice values, and efficiently
 // combines time series observations if the proto.Value tag value
@@ -898,6 +1060,33 @@ func MVCCDeleteRange(engine Engine, ms *proto.MVCCStats, key, endKey proto.Key,
 	return num, nil
 }
 
+// MVCCClearRange removes all MVCC data for the keys from key
+// (inclusive) to endKey (exclusive): the metadata and every version
+// of each key, including any intents. Unlike MVCCDeleteRange, no
+// deletion tombstones are written, so nothing is left for the GC
+// queue to collect. The stats of the removed data, computed as of
+// nowNanos, are subtracted from ms.
+//
+// The entries are cleared individually, as the RocksDB we build
+// against predates range deletions; the batch holding them is
+// nonetheless much cheaper than the MVCC writes of MVCCDeleteRange.
+func MVCCClearRange(engine Engine, ms *proto.MVCCStats, key, endKey proto.Key, nowNanos int64) error {
+	cleared, err := MVCCComputeStats(engine, key, endKey, nowNanos)
+	if err != nil {
+		return err
+	}
+	if _, err := ClearRange(engine, MVCCEncodeKey(key), MVCCEncodeKey(endKey)); err != nil {
+		return err
+	}
+	if ms != nil {
+		// The remaining stats are aged to nowNanos when ms is merged, so
+		// the ages of the cleared data are subtracted as computed.
+		cleared.LastUpdateNanos = 0
+		Subtract(ms, cleared)
+	}
+	return nil
+}
+
 // MVCCScan scans the key range specified by start key through end key
 // up to some maximum number of results. Specify max=0 for unbounded
 // scans.
@@ -984,6 +1173,95 @@ func MVCCIterate(engine Engine, key, endKey proto.Key, timestamp proto.Timestamp
 	}
 }
 
+// MVCCIterateChanges iterates over the keys in the range specified by
+// start and end keys which have MVCC versions with timestamps in
+// (startTime, endTime]. At each step of the iteration, f() is invoked
+// with the key and its consistent value as of endTime; a nil value
+// indicates that the key was deleted. If f returns true (done) or an
+// error, the iteration stops and the error is propagated.
+//
+// The candidate keys are found using a time-bound iterator, which may
+// skip data written entirely outside the time span, and the versions
+// it yields are filtered by timestamp.
+func MVCCIterateChanges(engine Engine, key, endKey proto.Key, startTime, endTime proto.Timestamp,
+	f func(proto.Key, *proto.Value) (bool, error)) error {
+	if len(endKey) == 0 {
+		return emptyKeyError()
+	}
+	if !startTime.Less(endTime) {
+		return nil
+	}
+
+	encEndKey := MVCCEncodeKey(endKey)
+	iter := engine.NewTimeBoundIterator(startTime, endTime)
+	defer iter.Close()
+
+	iter.Seek(MVCCEncodeKey(key))
+	for iter.Valid() && bytes.Compare(iter.Key(), encEndKey) < 0 {
+		key, ts, isValue := MVCCDecodeKey(iter.Key())
+		if !isValue || !startTime.Less(ts) || endTime.Less(ts) {
+			iter.Next()
+			continue
+		}
+		value, err := MVCCGet(engine, key, endTime, true /* consistent */, nil)
+		if err != nil {
+			return err
+		}
+		if done, err := f(key, value); done || err != nil {
+			return err
+		}
+		// Skip the remaining versions of this key.
+		iter.Seek(MVCCEncodeKey(key.Next()))
+	}
+	return iter.Error()
+}
+
+// MVCCRefreshRange verifies that no MVCC versions other than intents
+// of txn were written to the range specified by start and end keys
+// with timestamps in (startTime, endTime]. If so, reads of the range
+// by txn at startTime see the same values as they would at endTime;
+// otherwise an error is returned for the first key written in the
+// interval. As with MVCCIterateChanges, the versions are found using a
+// time-bound iterator.
+//
+// Intents of other transactions at or below startTime need not be
+// considered: those written before txn's reads were encountered by
+// them, and the timestamp cache forced those written after the reads
+// above startTime.
+func MVCCRefreshRange(engine Engine, key, endKey proto.Key, startTime, endTime proto.Timestamp,
+	txn *proto.Transaction) error {
+	if len(endKey) == 0 {
+		return emptyKeyError()
+	}
+	if !startTime.Less(endTime) {
+		return nil
+	}
+
+	encEndKey := MVCCEncodeKey(endKey)
+	iter := engine.NewTimeBoundIterator(startTime, endTime)
+	defer iter.Close()
+
+	meta := &proto.MVCCMetadata{}
+	iter.Seek(MVCCEncodeKey(key))
+	for iter.Valid() && bytes.Compare(iter.Key(), encEndKey) < 0 {
+		key, ts, isValue := MVCCDecodeKey(iter.Key())
+		if !isValue || !startTime.Less(ts) || endTime.Less(ts) {
+			iter.Next()
+			continue
+		}
+		// The version is only allowed if it's txn's own intent.
+		ok, _, _, err := engine.GetProto(MVCCEncodeKey(key), meta)
+		if err != nil {
+			return err
+		}
+		if !ok || meta.Txn == nil || txn == nil || !bytes.Equal(meta.Txn.ID, txn.ID) || !meta.Timestamp.Equal(ts) {
+			return util.Errorf("unable to refresh reads of %q to %s: written at %s", key, endTime, ts)
+		}
+		iter.Next()
+	}
+	return iter.Error()
+}
+
 // MVCCResolveWriteIntent either commits or aborts (rolls back) an
 // extant write intent for a given txn according to commit parameter.
 // ResolveWriteIntent will skip write intents of other txns.
@@ -1033,14 +1311,36 @@ func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, t
 	// timestamp-encoded key) if timestamp changed.
 	commit := txn.Status == proto.COMMITTED
 	pushed := txn.Status == proto.PENDING && meta.Txn.Timestamp.Less(txn.Timestamp)
-	if (commit || pushed) && meta.Txn.Epoch == txn.Epoch {
+
+	// If the intent's write was rolled back to a savepoint, the
+	// transaction's latest earlier write which wasn't is committed in
+	// its place. If there is none, the intent is removed below as if
+	// it had been aborted.
+	rolledBack := false
+	if commit && meta.Txn.Epoch == txn.Epoch && txn.IsSequenceIgnored(meta.Txn.GetSequence()) {
+		if sv := latestIntentHistory(meta, txn); sv != nil {
+			if origMetaKeySize, origMetaValSize, err = restoreIntent(engine, ms, key, metaKey,
+				meta, sv, origMetaKeySize, origMetaValSize); err != nil {
+				return err
+			}
+		} else {
+			rolledBack = true
+		}
+	}
+
+	if (commit || pushed) && meta.Txn.Epoch == txn.Epoch && !rolledBack {
 		origTimestamp := meta.Timestamp
 		newMeta := *meta
 		newMeta.Timestamp = txn.Timestamp
 		if pushed { // keep intent if we're pushing timestamp
-			newMeta.Txn = txn
+			// The pushed txn is read from the txn record, which doesn't
+			// know which of the txn's writes made the intent.
+			pushedTxn := *txn
+			pushedTxn.Sequence = meta.Txn.Sequence
+			newMeta.Txn = &pushedTxn
 		} else {
 			newMeta.Txn = nil
+			newMeta.IntentHistory = nil
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, &newMeta)
 		if err != nil {
@@ -1126,6 +1426,28 @@ func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, t
 	return nil
 }
 
+// restoreIntent replaces the value of the intent described by meta
+// with the earlier write sv by the intent's transaction, updating meta
+// and returning the new size of the metadata key and value.
+func restoreIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, metaKey proto.EncodedKey,
+	meta *proto.MVCCMetadata, sv *proto.SequencedValue, origMetaKeySize, origMetaValSize int64) (int64, int64, error) {
+	_, valueSize, err := PutProto(engine, MVCCEncodeVersionKey(key, meta.Timestamp), &sv.Value)
+	if err != nil {
+		return 0, 0, err
+	}
+	orig := *meta
+	meta.Deleted = sv.Value.Deleted
+	meta.ValBytes = valueSize
+	meta.IntentHistory = nil
+	metaKeySize, metaValSize, err := PutProto(engine, metaKey, meta)
+	if err != nil {
+		return 0, 0, err
+	}
+	// This is accounted for as the txn overwriting its own intent.
+	updateStatsOnPut(ms, key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, &orig, meta, 0)
+	return metaKeySize, metaValSize, nil
+}
+
 // MVCCResolveWriteIntentRange commits or aborts (rolls back) the
 // range of write intents specified by start and end keys for a given
 // txn. ResolveWriteIntentRange will skip write intents of other
@@ -1183,8 +1505,14 @@ func MVCCGarbageCollect(engine Engine, ms *proto.MVCCStats, keys []proto.Interna
 	for _, gcKey := range keys {
 		encKey := MVCCEncodeKey(gcKey.Key)
 		iter.Seek(encKey)
-		if !iter.Valid() {
-			return util.Errorf("could not seek to key %q", gcKey.Key)
+		// The key may have been removed since the GC request was
+		// computed, for example by a ClearRange; there's nothing to
+		// collect for it then.
+		if !iter.Valid() || !bytes.Equal(iter.Key(), encKey) {
+			if err := iter.Error(); err != nil {
+				return err
+			}
+			continue
 		}
 		// First, check whether all values of the key are being deleted.
 		meta := &proto.MVCCMetadata{}
@@ -1227,9 +1555,7 @@ func MVCCGarbageCollect(engine Engine, ms *proto.MVCCStats, keys []proto.Interna
 // any of these ranges are considered invalid.
 //
 //   - \x00\x00meta1 < SplitKey < \x00\x00meta2
-//   - \x00acct < SplitKey < \x00accu
-//   - \x00perm < SplitKey < \x00pern
-//   - \x00zone < SplitKey < \x00zonf
+//   - \x00config- < SplitKey < \x00config.
 func IsValidSplitKey(key proto.Key) bool {
 	return isValidEncodedSplitKey(MVCCEncodeKey(key))
 }
@@ -1240,20 +1566,12 @@ var illegalSplitKeyRanges = []struct {
 	start, end proto.EncodedKey
 }{
 	{
-		start: MVCCEncodeKey(KeyMin),
-		end:   MVCCEncodeKey(KeyMeta2Prefix),
-	},
-	{
-		start: MVCCEncodeKey(KeyConfigAccountingPrefix),
-		end:   MVCCEncodeKey(KeyConfigAccountingPrefix.PrefixEnd()),
-	},
-	{
-		start: MVCCEncodeKey(KeyConfigPermissionPrefix),
-		end:   MVCCEncodeKey(KeyConfigPermissionPrefix.PrefixEnd()),
+		start: MVCCEncodeKey(keys.KeyMin),
+		end:   MVCCEncodeKey(keys.KeyMeta2Prefix),
 	},
 	{
-		start: MVCCEncodeKey(KeyConfigZonePrefix),
-		end:   MVCCEncodeKey(KeyConfigZonePrefix.PrefixEnd()),
+		start: MVCCEncodeKey(keys.KeySystemConfigPrefix),
+		end:   MVCCEncodeKey(keys.KeySystemConfigMax),
 	},
 }
 
@@ -1276,8 +1594,8 @@ func isValidEncodedSplitKey(key proto.EncodedKey) bool {
 // The split key will never be chosen from the key ranges listed in
 // illegalSplitKeyRanges.
 func MVCCFindSplitKey(engine Engine, raftID int64, key, endKey proto.Key) (proto.Key, error) {
-	if key.Less(KeyLocalMax) {
-		key = KeyLocalMax
+	if key.Less(keys.KeyLocalMax) {
+		key = keys.KeyLocalMax
 	}
 	encStartKey := MVCCEncodeKey(key)
 	encEndKey := MVCCEncodeKey(endKey)
@@ -1342,8 +1660,8 @@ func MVCCFindSplitKey(engine Engine, raftID int64, key, endKey proto.Key) (proto
 // the wall time in nanoseconds since the epoch and is used to compute
 // the total age of all intents.
 func MVCCComputeStats(engine Engine, key, endKey proto.Key, nowNanos int64) (proto.MVCCStats, error) {
-	if key.Less(KeyLocalMax) {
-		key = KeyLocalMax
+	if key.Less(keys.KeyLocalMax) {
+		key = keys.KeyLocalMax
 	}
 	encStartKey := MVCCEncodeKey(key)
 	encEndKey := MVCCEncodeKey(endKey)
@@ -28,6 +28,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
@@ -593,7 +594,7 @@ func TestMVCCScan(t *testing.T) {
 		t.Fatal("the value should not be empty")
 	}
 
-	kvs, err = MVCCScan(engine, testKey4, KeyMax, 0, makeTS(1, 0), true, nil)
+	kvs, err = MVCCScan(engine, testKey4, keys.KeyMax, 0, makeTS(1, 0), true, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -604,7 +605,7 @@ func TestMVCCScan(t *testing.T) {
 	}
 
 	_, err = MVCCGet(engine, testKey1, makeTS(1, 0), true, txn2)
-	kvs, err = MVCCScan(engine, KeyMin, testKey2, 0, makeTS(1, 0), true, nil)
+	kvs, err = MVCCScan(engine, keys.KeyMin, testKey2, 0, makeTS(1, 0), true, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -700,7 +701,7 @@ func TestMVCCScanInconsistent(t *testing.T) {
 	engine := createTestEngine()
 
 	// A scan with consistent=false should fail in a txn.
-	if _, err := MVCCScan(engine, KeyMin, KeyMax, 0, makeTS(1, 0), false, txn1); err == nil {
+	if _, err := MVCCScan(engine, keys.KeyMin, keys.KeyMax, 0, makeTS(1, 0), false, txn1); err == nil {
 		t.Error("expected an error scanning with consistent=false in txn")
 	}
 
@@ -772,7 +773,7 @@ func TestMVCCDeleteRange(t *testing.T) {
 	if num != 2 {
 		t.Fatal("the value should not be empty")
 	}
-	kvs, _ := MVCCScan(engine, KeyMin, KeyMax, 0, makeTS(2, 0), true, nil)
+	kvs, _ := MVCCScan(engine, keys.KeyMin, keys.KeyMax, 0, makeTS(2, 0), true, nil)
 	if len(kvs) != 2 ||
 		!bytes.Equal(kvs[0].Key, testKey1) ||
 		!bytes.Equal(kvs[1].Key, testKey4) ||
@@ -781,28 +782,28 @@ func TestMVCCDeleteRange(t *testing.T) {
 		t.Fatal("the value should not be empty")
 	}
 
-	num, err = MVCCDeleteRange(engine, nil, testKey4, KeyMax, 0, makeTS(2, 0), nil)
+	num, err = MVCCDeleteRange(engine, nil, testKey4, keys.KeyMax, 0, makeTS(2, 0), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if num != 1 {
 		t.Fatal("the value should not be empty")
 	}
-	kvs, _ = MVCCScan(engine, KeyMin, KeyMax, 0, makeTS(2, 0), true, nil)
+	kvs, _ = MVCCScan(engine, keys.KeyMin, keys.KeyMax, 0, makeTS(2, 0), true, nil)
 	if len(kvs) != 1 ||
 		!bytes.Equal(kvs[0].Key, testKey1) ||
 		!bytes.Equal(kvs[0].Value.Bytes, value1.Bytes) {
 		t.Fatal("the value should not be empty")
 	}
 
-	num, err = MVCCDeleteRange(engine, nil, KeyMin, testKey2, 0, makeTS(2, 0), nil)
+	num, err = MVCCDeleteRange(engine, nil, keys.KeyMin, testKey2, 0, makeTS(2, 0), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if num != 1 {
 		t.Fatal("the value should not be empty")
 	}
-	kvs, _ = MVCCScan(engine, KeyMin, KeyMax, 0, makeTS(2, 0), true, nil)
+	kvs, _ = MVCCScan(engine, keys.KeyMin, keys.KeyMax, 0, makeTS(2, 0), true, nil)
 	if len(kvs) != 0 {
 		t.Fatal("the value should be empty")
 	}
@@ -841,10 +842,211 @@ func TestMVCCDeleteRangeConcurrentTxn(t *testing.T) {
 	}
 }
 
+// TestMVCCClearRange verifies that all versions of the keys in the
+// span, including intents and deletion tombstones, are removed and
+// that the stats are adjusted to match a recomputation.
+func TestMVCCClearRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	ts1 := makeTS(1E9, 0)
+	ts2 := makeTS(2e9, 0)
+	ts3 := makeTS(3E9, 0)
+	for _, key := range []proto.Key{testKey1, testKey2, testKey3, testKey4} {
+		if err := MVCCPut(engine, nil, key, ts1, value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := MVCCPut(engine, nil, testKey2, ts2, value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey3, ts2, nil); err != nil {
+		t.Fatal(err)
+	}
+	txn := &proto.Transaction{ID: []byte("txn"), Timestamp: ts3}
+	if err := MVCCPut(engine, nil, testKey2, ts3, value3, txn); err != nil {
+		t.Fatal(err)
+	}
+
+	ms, err := MVCCComputeStats(engine, keys.KeyMin, keys.KeyMax, 4e9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCClearRange(engine, &ms, testKey2, testKey4, 4e9); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := Scan(engine, MVCCEncodeKey(keys.KeyMin), MVCCEncodeKey(keys.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expEncKeys := []proto.EncodedKey{
+		MVCCEncodeKey(testKey1),
+		MVCCEncodeVersionKey(testKey1, ts1),
+		MVCCEncodeKey(testKey4),
+		MVCCEncodeVersionKey(testKey4, ts1),
+	}
+	if len(kvs) != len(expEncKeys) {
+		t.Fatalf("number of kvs %d != expected %d", len(kvs), len(expEncKeys))
+	}
+	for i, kv := range kvs {
+		if !kv.Key.Equal(expEncKeys[i]) {
+			t.Errorf("%d: expected key %q; got %q", i, expEncKeys[i], kv.Key)
+		}
+	}
+
+	expMS, err := MVCCComputeStats(engine, keys.KeyMin, keys.KeyMax, 4e9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyStats("clear range", &ms, &expMS, t)
+}
+
+// TestMVCCGarbageCollectClearedKey verifies that garbage collecting
+// keys which have been removed by a ClearRange is a no-op, even when
+// the GC seeks past the end of the data.
+func TestMVCCGarbageCollectClearedKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	ts1 := makeTS(1E9, 0)
+	ts2 := makeTS(2e9, 0)
+	for _, key := range []proto.Key{testKey1, testKey2, testKey3} {
+		if err := MVCCPut(engine, nil, key, ts1, value1, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := MVCCPut(engine, nil, key, ts2, value2, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := MVCCClearRange(engine, nil, testKey2, keys.KeyMax, ts2.WallTime); err != nil {
+		t.Fatal(err)
+	}
+	gcKeys := []proto.InternalGCRequest_GCKey{
+		{Key: testKey2, Timestamp: ts1},
+		{Key: testKey3, Timestamp: ts1},
+	}
+	if err := MVCCGarbageCollect(engine, nil, gcKeys, ts2); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := Scan(engine, MVCCEncodeKey(keys.KeyMin), MVCCEncodeKey(keys.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 3 {
+		t.Errorf("expected the 3 entries of %q to remain; got %d", testKey1, len(kvs))
+	}
+}
+
+// TestMVCCIterateChanges verifies that the keys changed within a time
+// span are found, including deletions, whether or not the versions
+// written before the span have been flushed to SSTables.
+func TestMVCCIterateChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := NewInMem(proto.Attributes{}, 1<<20)
+	defer engine.Close()
+
+	for _, key := range []proto.Key{testKey1, testKey2, testKey3} {
+		if err := MVCCPut(engine, nil, key, makeTS(1, 0), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := engine.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(3, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey3, makeTS(4, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey4, makeTS(6, 0), value4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		start, end proto.Timestamp
+		expKeys    []proto.Key
+		expValues  []*proto.Value
+	}{
+		{makeTS(0, 0), makeTS(1, 0), []proto.Key{testKey1, testKey2, testKey3}, []*proto.Value{&value1, &value1, &value1}},
+		{makeTS(1, 0), makeTS(5, 0), []proto.Key{testKey2, testKey3}, []*proto.Value{&value2, nil}},
+		{makeTS(3, 0), makeTS(4, 0), []proto.Key{testKey3}, []*proto.Value{nil}},
+		{makeTS(4, 0), makeTS(6, 0), []proto.Key{testKey4}, []*proto.Value{&value4}},
+		{makeTS(0, 0), makeTS(6, 0), []proto.Key{testKey1, testKey2, testKey3, testKey4}, []*proto.Value{&value1, &value2, nil, &value4}},
+		{makeTS(6, 0), makeTS(7, 0), nil, nil},
+	}
+	for i, test := range testCases {
+		var testKeys []proto.Key
+		var values []*proto.Value
+		if err := MVCCIterateChanges(engine, keys.KeyMin, keys.KeyMax, test.start, test.end,
+			func(key proto.Key, value *proto.Value) (bool, error) {
+				testKeys = append(testKeys, key)
+				values = append(values, value)
+				return false, nil
+			}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(testKeys, test.expKeys) {
+			t.Errorf("%d: expected keys %q; got %q", i, test.expKeys, testKeys)
+			continue
+		}
+		for j, value := range values {
+			if (value == nil) != (test.expValues[j] == nil) ||
+				(value != nil && !bytes.Equal(value.Bytes, test.expValues[j].Bytes)) {
+				t.Errorf("%d: expected value %+v for key %q; got %+v", i, test.expValues[j], testKeys[j], value)
+			}
+		}
+	}
+}
+
+// TestMVCCRefreshRange verifies that refreshing reads fails only if
+// values other than the refreshing transaction's own intents were
+// written within the refreshed interval.
+func TestMVCCRefreshRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := NewInMem(proto.Attributes{}, 1<<20)
+	defer engine.Close()
+
+	txn := &proto.Transaction{ID: []byte("Txn"), Timestamp: makeTS(4, 0)}
+	otherTxn := &proto.Transaction{ID: []byte("Other"), Timestamp: makeTS(5, 0)}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(3, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, txn.Timestamp, value3, txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, otherTxn.Timestamp, value4, otherTxn); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		key, endKey proto.Key
+		start, end  proto.Timestamp
+		expErr      bool
+	}{
+		{testKey1, testKey1.Next(), makeTS(1, 0), makeTS(2, 0), false},
+		{testKey1, testKey1.Next(), makeTS(1, 0), makeTS(3, 0), true},
+		{testKey1, testKey1.Next(), makeTS(3, 0), makeTS(9, 0), false},
+		{testKey2, testKey3, makeTS(1, 0), makeTS(9, 0), false},
+		{testKey2, testKey4, makeTS(1, 0), makeTS(4, 0), false},
+		{testKey2, testKey4, makeTS(1, 0), makeTS(5, 0), true},
+	}
+	for i, test := range testCases {
+		err := MVCCRefreshRange(engine, test.key, test.endKey, test.start, test.end, txn)
+		if (err != nil) != test.expErr {
+			t.Errorf("%d: expected error=%t; got %v", i, test.expErr, err)
+		}
+	}
+}
+
 func TestMVCCConditionalPut(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := createTestEngine()
-	err := MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, &value2, nil)
+	err := MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, &value2, false, nil)
 	if err == nil {
 		t.Fatal("expected error on key not exists")
 	}
@@ -858,7 +1060,7 @@ func TestMVCCConditionalPut(t *testing.T) {
 	}
 
 	// Verify the difference between missing value and empty value.
-	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, &valueEmpty, nil)
+	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, &valueEmpty, false, nil)
 	if err == nil {
 		t.Fatal("expected error on key not exists")
 	}
@@ -872,13 +1074,13 @@ func TestMVCCConditionalPut(t *testing.T) {
 	}
 
 	// Do a conditional put with expectation that the value is completely missing; will succeed.
-	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, nil, nil)
+	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, nil, false, nil)
 	if err != nil {
 		t.Fatalf("expected success with condition that key doesn't yet exist: %v", err)
 	}
 
 	// Another conditional put expecting value missing will fail, now that value1 is written.
-	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, nil, nil)
+	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, nil, false, nil)
 	if err == nil {
 		t.Fatal("expected error on key already exists")
 	}
@@ -893,7 +1095,7 @@ func TestMVCCConditionalPut(t *testing.T) {
 	}
 
 	// Conditional put expecting wrong value2, will fail.
-	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, &value2, nil)
+	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value1, &value2, false, nil)
 	if err == nil {
 		t.Fatal("expected error on key does not match")
 	}
@@ -908,12 +1110,12 @@ func TestMVCCConditionalPut(t *testing.T) {
 	}
 
 	// Move to a empty value. Will succeed.
-	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), valueEmpty, &value1, nil)
+	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), valueEmpty, &value1, false, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
 	// Now move to value2 from expected empty value.
-	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value2, &valueEmpty, nil)
+	err = MVCCConditionalPut(engine, nil, testKey1, makeTS(0, 1), value2, &valueEmpty, false, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -925,6 +1127,78 @@ func TestMVCCConditionalPut(t *testing.T) {
 	}
 }
 
+// TestMVCCConditionalPutAllowIfDoesNotExist verifies that a deletion
+// tombstone is treated as an absent key when so requested.
+func TestMVCCConditionalPutAllowIfDoesNotExist(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+
+	// Expecting value1 on a missing key succeeds only when allowed.
+	if err := MVCCConditionalPut(engine, nil, testKey1, makeTS(1, 0), value1, &value1, false, nil); err == nil {
+		t.Fatal("expected error on key not exists")
+	}
+	if err := MVCCConditionalPut(engine, nil, testKey1, makeTS(1, 0), value1, &value1, true, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Delete the key; expecting value2 fails unless the tombstone is
+	// treated as absent.
+	if err := MVCCDelete(engine, nil, testKey1, makeTS(2, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCConditionalPut(engine, nil, testKey1, makeTS(3, 0), value2, &value2, false, nil); err == nil {
+		t.Fatal("expected error on deleted key")
+	}
+	if err := MVCCConditionalPut(engine, nil, testKey1, makeTS(3, 0), value2, &value2, true, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// An existing, mismatched value still fails.
+	err := MVCCConditionalPut(engine, nil, testKey1, makeTS(4, 0), value3, &value1, true, nil)
+	if cErr, ok := err.(*proto.ConditionFailedError); !ok {
+		t.Fatalf("expected ConditionFailedError; got %v", err)
+	} else if !bytes.Equal(cErr.ActualValue.Bytes, value2.Bytes) {
+		t.Fatalf("expected actual value %q; got %q", value2.Bytes, cErr.ActualValue.Bytes)
+	}
+}
+
+func TestMVCCInitPut(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+
+	if err := MVCCInitPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Putting the same value again is a no-op.
+	if err := MVCCInitPut(engine, nil, testKey1, makeTS(2, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := MVCCGet(engine, testKey1, makeTS(3, 0), true, nil); err != nil {
+		t.Fatal(err)
+	} else if !value.Timestamp.Equal(makeTS(1, 0)) {
+		t.Fatalf("expected value written at %s; got %s", makeTS(1, 0), value.Timestamp)
+	}
+	// A different value fails with the actual value.
+	err := MVCCInitPut(engine, nil, testKey1, makeTS(2, 0), value2, nil)
+	if cErr, ok := err.(*proto.ConditionFailedError); !ok {
+		t.Fatalf("expected ConditionFailedError; got %v", err)
+	} else if !bytes.Equal(cErr.ActualValue.Bytes, value1.Bytes) {
+		t.Fatalf("expected actual value %q; got %q", value1.Bytes, cErr.ActualValue.Bytes)
+	}
+	// Once deleted, the key may be initialized to a different value.
+	if err := MVCCDelete(engine, nil, testKey1, makeTS(3, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCInitPut(engine, nil, testKey1, makeTS(4, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := MVCCGet(engine, testKey1, makeTS(5, 0), true, nil); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(value.Bytes, value2.Bytes) {
+		t.Fatalf("expected value %q; got %q", value2.Bytes, value.Bytes)
+	}
+}
+
 func TestMVCCResolveTxn(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := createTestEngine()
@@ -1292,21 +1566,14 @@ func TestValidSplitKeys(t *testing.T) {
 		{proto.Key("\x00\x00meta2\xff"), true},
 		{proto.Key("\x00\x00meta3"), true},
 		{proto.Key("\x00\x01"), true},
-		{proto.Key("\x00accs\xff"), true},
-		{proto.Key("\x00acct"), true},
-		{proto.Key("\x00acct\x00"), false},
-		{proto.Key("\x00acct\xff"), false},
-		{proto.Key("\x00accu"), true},
-		{proto.Key("\x00perl"), true},
-		{proto.Key("\x00perm"), true},
-		{proto.Key("\x00perm\x00"), false},
-		{proto.Key("\x00perm\xff"), false},
-		{proto.Key("\x00pern"), true},
-		{proto.Key("\x00zond"), true},
-		{proto.Key("\x00zone"), true},
-		{proto.Key("\x00zone\x00"), false},
-		{proto.Key("\x00zone\xff"), false},
-		{proto.Key("\x00zonf"), true},
+		{proto.Key("\x00config,\xff"), true},
+		{proto.Key("\x00config-"), true},
+		{proto.Key("\x00config-acct"), false},
+		{proto.Key("\x00config-acct\xff"), false},
+		{proto.Key("\x00config-perm"), false},
+		{proto.Key("\x00config-zone"), false},
+		{proto.Key("\x00config-zone\xff"), false},
+		{proto.Key("\x00config."), true},
 		{proto.Key("\x01"), true},
 		{proto.Key("a"), true},
 		{proto.Key("\xff"), true},
@@ -1343,7 +1610,7 @@ func TestFindSplitKey(t *testing.T) {
 	MergeStats(ms, engine, raftID) // write stats
 	snap := engine.NewSnapshot()
 	defer snap.Close()
-	humanSplitKey, err := MVCCFindSplitKey(snap, raftID, KeyMin, KeyMax)
+	humanSplitKey, err := MVCCFindSplitKey(snap, raftID, keys.KeyMin, keys.KeyMax)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1373,12 +1640,13 @@ func TestFindValidSplitKeys(t *testing.T) {
 			expSplit: nil,
 			expError: true,
 		},
-		// All zone cannot be split.
+		// All system config cannot be split.
 		{
 			keys: []proto.Key{
-				proto.Key("\x00zone"),
-				proto.Key("\x00zone\x00"),
-				proto.Key("\x00zone\xff"),
+				proto.Key("\x00config-acct"),
+				proto.Key("\x00config-perm"),
+				proto.Key("\x00config-zone"),
+				proto.Key("\x00config-zone\xff"),
 			},
 			expSplit: nil,
 			expError: true,
@@ -1407,26 +1675,26 @@ func TestFindValidSplitKeys(t *testing.T) {
 			expSplit: proto.Key("\x00\x00meta2"),
 			expError: false,
 		},
-		// Lopsided, truncate non-zone prefix.
+		// Lopsided, truncate non-config prefix.
 		{
 			keys: []proto.Key{
-				proto.Key("\x00zond"),
-				proto.Key("\x00zone"),
-				proto.Key("\x00zone\x00"),
-				proto.Key("\x00zone\xff"),
+				proto.Key("\x00config,"),
+				proto.Key("\x00config-"),
+				proto.Key("\x00config-acct"),
+				proto.Key("\x00config-zone"),
 			},
-			expSplit: proto.Key("\x00zone"),
+			expSplit: proto.Key("\x00config-"),
 			expError: false,
 		},
-		// Lopsided, truncate non-zone suffix.
+		// Lopsided, truncate non-config suffix.
 		{
 			keys: []proto.Key{
-				proto.Key("\x00zone"),
-				proto.Key("\x00zone\x00"),
-				proto.Key("\x00zone\xff"),
-				proto.Key("\x00zonf"),
+				proto.Key("\x00config-acct"),
+				proto.Key("\x00config-perm"),
+				proto.Key("\x00config-zone"),
+				proto.Key("\x00config."),
 			},
-			expSplit: proto.Key("\x00zonf"),
+			expSplit: proto.Key("\x00config."),
 			expError: false,
 		},
 	}
@@ -1734,7 +2002,7 @@ func TestMVCCStatsWithRandomRuns(t *testing.T) {
 	// Now, generate a random sequence of puts, deletes and resolves.
 	// Each put and delete may or may not involve a txn. Resolves may
 	// either commit or abort.
-	keys := map[int32][]byte{}
+	testKeys := map[int32][]byte{}
 	for i := int32(0); i < int32(1000); i++ {
 		// Manually advance aggregate intent age based on one extra second of simulation.
 		ms.IntentAge += ms.IntentCount
@@ -1742,7 +2010,7 @@ func TestMVCCStatsWithRandomRuns(t *testing.T) {
 		ms.GCBytesAge += ms.KeyBytes + ms.ValBytes - ms.LiveBytes
 
 		key := []byte(fmt.Sprintf("%s-%d", util.RandBytes(rng, int(rng.Int31n(32))), i))
-		keys[i] = key
+		testKeys[i] = key
 		var txn *proto.Transaction
 		if rng.Int31n(2) == 0 { // create a txn with 50% prob
 			txn = &proto.Transaction{ID: []byte(fmt.Sprintf("txn-%d", i)), Timestamp: makeTS(int64(i+1)*1E9, 0)}
@@ -1755,17 +2023,17 @@ func TestMVCCStatsWithRandomRuns(t *testing.T) {
 		if i > 0 && isDelete {
 			idx := rng.Int31n(i)
 			log.V(1).Infof("*** DELETE index %d", idx)
-			if err := MVCCDelete(engine, ms, keys[idx], makeTS(int64(i+1)*1E9, 0), txn); err != nil {
+			if err := MVCCDelete(engine, ms, testKeys[idx], makeTS(int64(i+1)*1E9, 0), txn); err != nil {
 				// Abort any write intent on an earlier, unresolved txn.
 				if wiErr, ok := err.(*proto.WriteIntentError); ok {
 					wiErr.Txn.Status = proto.ABORTED
 					log.V(1).Infof("*** ABORT index %d", idx)
-					if err := MVCCResolveWriteIntent(engine, ms, keys[idx], makeTS(int64(i+1)*1E9, 0), &wiErr.Txn); err != nil {
+					if err := MVCCResolveWriteIntent(engine, ms, testKeys[idx], makeTS(int64(i+1)*1E9, 0), &wiErr.Txn); err != nil {
 						t.Fatal(err)
 					}
 					// Now, re-delete.
 					log.V(1).Infof("*** RE-DELETE index %d", idx)
-					if err := MVCCDelete(engine, ms, keys[idx], makeTS(int64(i+1)*1E9, 0), txn); err != nil {
+					if err := MVCCDelete(engine, ms, testKeys[idx], makeTS(int64(i+1)*1E9, 0), txn); err != nil {
 						t.Fatal(err)
 					}
 				} else {
@@ -1793,7 +2061,7 @@ func TestMVCCStatsWithRandomRuns(t *testing.T) {
 		// Every 10th step, verify the stats via manual engine scan.
 		if i%10 == 0 {
 			// Compute the stats manually.
-			expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, int64(i+1)*1E9)
+			expMS, err := MVCCComputeStats(engine, keys.KeyMin, keys.KeyMax, int64(i+1)*1E9)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1812,7 +2080,7 @@ func TestMVCCGarbageCollect(t *testing.T) {
 
 	bytes := []byte("value")
 	ts1 := makeTS(1E9, 0)
-	ts2 := makeTS(2E9, 0)
+	ts2 := makeTS(2e9, 0)
 	ts3 := makeTS(3E9, 0)
 	val1 := proto.Value{Bytes: bytes, Timestamp: &ts1}
 	val2 := proto.Value{Bytes: bytes, Timestamp: &ts2}
@@ -1850,7 +2118,7 @@ func TestMVCCGarbageCollect(t *testing.T) {
 			}
 		}
 	}
-	kvsn, err := Scan(engine, MVCCEncodeKey(KeyMin), MVCCEncodeKey(KeyMax), 0)
+	kvsn, err := Scan(engine, MVCCEncodeKey(keys.KeyMin), MVCCEncodeKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1859,13 +2127,13 @@ func TestMVCCGarbageCollect(t *testing.T) {
 		log.V(1).Infof("%d: %q, ts=%s", i, key, ts)
 	}
 
-	keys := []proto.InternalGCRequest_GCKey{
+	testKeys := []proto.InternalGCRequest_GCKey{
 		{Key: proto.Key("a"), Timestamp: ts1},
 		{Key: proto.Key("a-del"), Timestamp: ts2},
 		{Key: proto.Key("b"), Timestamp: ts1},
 		{Key: proto.Key("b-del"), Timestamp: ts2},
 	}
-	if err := MVCCGarbageCollect(engine, ms, keys, ts3); err != nil {
+	if err := MVCCGarbageCollect(engine, ms, testKeys, ts3); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1878,7 +2146,7 @@ func TestMVCCGarbageCollect(t *testing.T) {
 		MVCCEncodeKey(proto.Key("b-del")),
 		MVCCEncodeVersionKey(proto.Key("b-del"), ts3),
 	}
-	kvs, err := Scan(engine, MVCCEncodeKey(KeyMin), MVCCEncodeKey(KeyMax), 0)
+	kvs, err := Scan(engine, MVCCEncodeKey(keys.KeyMin), MVCCEncodeKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1894,7 +2162,7 @@ func TestMVCCGarbageCollect(t *testing.T) {
 	}
 
 	// Verify aggregated stats match computed stats after GC.
-	expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, ts3.WallTime)
+	expMS, err := MVCCComputeStats(engine, keys.KeyMin, keys.KeyMax, ts3.WallTime)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1908,7 +2176,7 @@ func TestMVCCGarbageCollectNonDeleted(t *testing.T) {
 	engine := createTestEngine()
 	bytes := []byte("value")
 	ts1 := makeTS(1E9, 0)
-	ts2 := makeTS(2E9, 0)
+	ts2 := makeTS(2e9, 0)
 	val1 := proto.Value{Bytes: bytes, Timestamp: &ts1}
 	val2 := proto.Value{Bytes: bytes, Timestamp: &ts2}
 	key := proto.Key("a")
@@ -1932,7 +2200,7 @@ func TestMVCCGarbageCollectIntent(t *testing.T) {
 	engine := createTestEngine()
 	bytes := []byte("value")
 	ts1 := makeTS(1E9, 0)
-	ts2 := makeTS(2E9, 0)
+	ts2 := makeTS(2e9, 0)
 	val1 := proto.Value{Bytes: bytes, Timestamp: &ts1}
 	key := proto.Key("a")
 	if err := MVCCPut(engine, nil, key, ts1, val1, nil); err != nil {
@@ -1949,3 +2217,208 @@ func TestMVCCGarbageCollectIntent(t *testing.T) {
 		t.Fatal("expected error garbage collecting an intent")
 	}
 }
+
+// TestMVCCValueCompression verifies that values larger than the
+// compression threshold are compressed, stored as is and read back
+// transparently, while smaller values are left uncompressed.
+func TestMVCCValueCompression(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	large := proto.Value{Bytes: bytes.Repeat([]byte(`{"name":"value"}`), 64)}
+	large.InitChecksum(testKey1)
+	small := proto.Value{Bytes: []byte("small")}
+	small.InitChecksum(testKey2)
+	for _, test := range []struct {
+		key   proto.Key
+		value proto.Value
+	}{
+		{testKey1, large},
+		{testKey2, small},
+	} {
+		value := test.value
+		if err := CompressValue(&value, 64); err != nil {
+			t.Fatal(err)
+		}
+		if err := MVCCPut(engine, nil, test.key, makeTS(1, 0), value, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Verify the raw versioned values.
+	for _, test := range []struct {
+		key        proto.Key
+		value      proto.Value
+		compressed bool
+	}{
+		{testKey1, large, true},
+		{testKey2, small, false},
+	} {
+		mvccVal := proto.MVCCValue{}
+		ok, _, _, err := engine.GetProto(MVCCEncodeVersionKey(test.key, makeTS(1, 0)), &mvccVal)
+		if !ok || err != nil {
+			t.Fatalf("%q: unable to read versioned value (%t): %v", test.key, ok, err)
+		}
+		if compressed := mvccVal.Value.Compression != nil; compressed != test.compressed {
+			t.Errorf("%q: expected compressed=%t; got %t", test.key, test.compressed, compressed)
+		}
+		if test.compressed && len(mvccVal.Value.Bytes) >= len(test.value.Bytes) {
+			t.Errorf("%q: expected compressed bytes to be smaller than %d; got %d",
+				test.key, len(test.value.Bytes), len(mvccVal.Value.Bytes))
+		}
+	}
+
+	value, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value.Bytes, large.Bytes) || value.Compression != nil {
+		t.Errorf("expected uncompressed value %q; got %+v", large.Bytes, value)
+	}
+
+	kvs, err := MVCCScan(engine, testKey1, keys.KeyMax, 0, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 || !bytes.Equal(kvs[0].Value.Bytes, large.Bytes) ||
+		!bytes.Equal(kvs[1].Value.Bytes, small.Bytes) {
+		t.Errorf("unexpected scan results: %+v", kvs)
+	}
+}
+
+// TestMVCCValueChecksumMismatch verifies that reads of values whose
+// checksum doesn't match their contents are counted in the engine
+// stats and fail or succeed according to the engine's checksum mode.
+func TestMVCCValueChecksumMismatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rocksdb := NewInMem(proto.Attributes{}, 1<<20)
+	defer rocksdb.Close()
+
+	value := proto.Value{Bytes: []byte("value"), Checksum: gogoproto.Uint32(1)}
+	if err := MVCCPut(rocksdb, nil, testKey1, makeTS(1, 0), value, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, mode := range []ValueChecksumMode{ChecksumModeError, ChecksumModeLog} {
+		rocksdb.opts.ValueChecksumMode = mode
+		// Read through a batch, as ranges do, to verify mismatches are
+		// reported to the underlying engine.
+		batch := rocksdb.NewBatch()
+		val, err := MVCCGet(batch, testKey1, makeTS(2, 0), true, nil)
+		batch.Close()
+		switch mode {
+		case ChecksumModeError:
+			if err == nil {
+				t.Errorf("%s: expected checksum mismatch error", mode)
+			}
+		case ChecksumModeLog:
+			if err != nil || val == nil || !bytes.Equal(val.Bytes, value.Bytes) {
+				t.Errorf("%s: expected value %q; got %+v, %v", mode, value.Bytes, val, err)
+			}
+		}
+		stats, err := rocksdb.GetStats()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.ValueChecksumMismatches != int64(i+1) {
+			t.Errorf("%s: expected %d mismatches; got %d", mode, i+1, stats.ValueChecksumMismatches)
+		}
+	}
+}
+
+// TestMVCCSnapshotIsolationWriteConflict verifies that a SNAPSHOT
+// transaction can't overwrite a value committed after its original
+// timestamp, while SERIALIZABLE transactions and SNAPSHOT transactions
+// whose snapshot includes the value can.
+func TestMVCCSnapshotIsolationWriteConflict(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		isolation proto.IsolationType
+		origTS    proto.Timestamp
+		expRetry  bool
+	}{
+		{proto.SNAPSHOT, makeTS(1, 0), true},
+		{proto.SNAPSHOT, makeTS(2, 0), false},
+		{proto.SERIALIZABLE, makeTS(1, 0), false},
+	}
+	for i, test := range testCases {
+		engine := createTestEngine()
+		// Commit a value at time 2.
+		if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value1, nil); err != nil {
+			t.Fatal(err)
+		}
+		txn := &proto.Transaction{
+			ID:            []byte("Txn"),
+			Isolation:     test.isolation,
+			Timestamp:     makeTS(3, 0),
+			OrigTimestamp: test.origTS,
+		}
+		err := MVCCPut(engine, nil, testKey1, txn.Timestamp, value2, txn)
+		if !test.expRetry {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+			continue
+		}
+		retryErr, ok := err.(*proto.TransactionRetryError)
+		if !ok {
+			t.Errorf("%d: expected transaction retry error; got %v", i, err)
+			continue
+		}
+		if !makeTS(2, 0).Less(retryErr.Txn.Timestamp) {
+			t.Errorf("%d: expected retry timestamp after the committed value; got %s", i, retryErr.Txn.Timestamp)
+		}
+	}
+}
+
+// TestMVCCSavepointRollback verifies that writes by a transaction
+// which are rolled back to a savepoint are invisible to the
+// transaction's reads and aren't committed.
+func TestMVCCSavepointRollback(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	txn := &proto.Transaction{ID: []byte("Txn"), Timestamp: makeTS(1, 0), Sequence: gogoproto.Int32(1)}
+	if err := MVCCPut(engine, nil, testKey1, txn.Timestamp, value1, txn); err != nil {
+		t.Fatal(err)
+	}
+	// Overwrite the first write and make a new one after a savepoint.
+	txn.Sequence = gogoproto.Int32(2)
+	if err := MVCCPut(engine, nil, testKey1, txn.Timestamp, value2, txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, txn.Timestamp, value3, txn); err != nil {
+		t.Fatal(err)
+	}
+	// Roll back to the savepoint.
+	txn.Sequence = gogoproto.Int32(3)
+	txn.IgnoredSequences = []proto.SequenceRange{{Start: 2, End: 2}}
+
+	value, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value.Bytes, value1.Bytes) {
+		t.Fatalf("expected %q; got %+v", value1.Bytes, value)
+	}
+	if value, err = MVCCGet(engine, testKey2, makeTS(2, 0), true, txn); err != nil {
+		t.Fatal(err)
+	} else if value != nil {
+		t.Fatalf("expected rolled back write to be invisible; got %q", value.Bytes)
+	}
+
+	txn.Status = proto.COMMITTED
+	for _, key := range []proto.Key{testKey1, testKey2} {
+		if err := MVCCResolveWriteIntent(engine, nil, key, makeTS(2, 0), txn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if value, err = MVCCGet(engine, testKey1, makeTS(2, 0), true, nil); err != nil {
+		t.Fatal(err)
+	} else if value == nil || !bytes.Equal(value.Bytes, value1.Bytes) {
+		t.Fatalf("expected %q to be committed; got %+v", value1.Bytes, value)
+	}
+	if value, err = MVCCGet(engine, testKey2, makeTS(2, 0), true, nil); err != nil {
+		t.Fatal(err)
+	} else if value != nil {
+		t.Fatalf("expected rolled back write not to be committed; got %q", value.Bytes)
+	}
+}
@@ -27,8 +27,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"runtime"
+	"sync"
 	"sync/atomic"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -37,24 +40,139 @@ import (
 	gogoproto "github.com/gogo/protobuf/proto"
 )
 
+// defaultWriteBufferSize is the default size of a RocksDB memtable.
+const defaultWriteBufferSize = 64 << 20 // 64 MB
+
+// CompressionType specifies the compression applied to RocksDB
+// blocks.
+type CompressionType int
+
+// The supported compression types. The values must be kept in sync
+// with DBOpen in storage/engine/db.cc.
+const (
+	// SnappyCompression compresses blocks using snappy. This is the
+	// default.
+	SnappyCompression CompressionType = iota
+	// NoCompression disables compression.
+	NoCompression
+	// ZlibCompression compresses blocks using zlib.
+	ZlibCompression
+	// LZ4Compression compresses blocks using LZ4.
+	LZ4Compression
+)
+
+var compressionTypeNames = map[CompressionType]string{
+	SnappyCompression: "snappy",
+	NoCompression:     "none",
+	ZlibCompression:   "zlib",
+	LZ4Compression:    "lz4",
+}
+
+// String returns the name of the compression type.
+func (c CompressionType) String() string {
+	if name, ok := compressionTypeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("CompressionType(%d)", c)
+}
+
+// ParseCompressionType returns the compression type with the given
+// name, one of "snappy", "none", "zlib" or "lz4".
+func ParseCompressionType(name string) (CompressionType, error) {
+	for c, n := range compressionTypeNames {
+		if n == name {
+			return c, nil
+		}
+	}
+	return 0, util.Errorf("unknown compression type %q", name)
+}
+
+// ValueChecksumMode specifies how a value whose checksum doesn't
+// match its contents is handled when read.
+type ValueChecksumMode int
+
+const (
+	// ChecksumModeError fails reads of values with mismatched checksums.
+	// This is the default.
+	ChecksumModeError ValueChecksumMode = iota
+	// ChecksumModeLog logs mismatched checksums and returns the values
+	// regardless.
+	ChecksumModeLog
+)
+
+var valueChecksumModeNames = map[ValueChecksumMode]string{
+	ChecksumModeError: "error",
+	ChecksumModeLog:   "log",
+}
+
+// String returns the name of the value checksum mode.
+func (m ValueChecksumMode) String() string {
+	if name, ok := valueChecksumModeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("ValueChecksumMode(%d)", m)
+}
+
+// ParseValueChecksumMode returns the value checksum mode with the
+// given name, one of "error" or "log".
+func ParseValueChecksumMode(name string) (ValueChecksumMode, error) {
+	for m, n := range valueChecksumModeNames {
+		if n == name {
+			return m, nil
+		}
+	}
+	return 0, util.Errorf("unknown value checksum mode %q", name)
+}
+
+// RocksDBOptions holds the tuning parameters for a RocksDB instance.
+// Zero values select the defaults described for each field.
+type RocksDBOptions struct {
+	// CacheSize is the size in bytes of the block cache.
+	CacheSize int64
+	// WriteBufferSize is the size in bytes of each memtable. Defaults
+	// to 64 MB.
+	WriteBufferSize int64
+	// BloomFilterBitsPerKey is the number of bits per key used by the
+	// bloom filter on each table. Zero disables bloom filters.
+	BloomFilterBitsPerKey int
+	// Compression is the block compression type. Defaults to snappy.
+	Compression CompressionType
+	// MaxOpenFiles is the maximum number of files kept open by
+	// RocksDB; -1 leaves the number unlimited. Defaults to the RocksDB
+	// default.
+	MaxOpenFiles int
+	// ReadOnly opens an existing database without allowing writes,
+	// for the offline inspection of a store.
+	ReadOnly bool
+	// ValueChecksumMode specifies how reads of values with mismatched
+	// checksums are handled. Defaults to failing the read.
+	ValueChecksumMode ValueChecksumMode
+}
+
 // RocksDB is a wrapper around a RocksDB database instance.
 type RocksDB struct {
-	rdb       *C.DBEngine
-	refcount  int32
-	attrs     proto.Attributes // Attributes for this engine
-	dir       string           // The data directory
-	cacheSize int64            // Memory to use to cache values.
+	rdb      *C.DBEngine
+	refcount int32
+	attrs    proto.Attributes // Attributes for this engine
+	dir      string           // The data directory
+	opts     RocksDBOptions   // Tuning options
+	// writeDelay is the time in nanoseconds by which each write is
+	// delayed; accessed atomically.
+	writeDelay int64
+	// checksumMismatches counts the values read with mismatched
+	// checksums; accessed atomically.
+	checksumMismatches int64
 }
 
 // NewRocksDB allocates and returns a new RocksDB object.
-func NewRocksDB(attrs proto.Attributes, dir string, cacheSize int64) *RocksDB {
+func NewRocksDB(attrs proto.Attributes, dir string, opts RocksDBOptions) *RocksDB {
 	if dir == "" {
 		panic(util.Errorf("dir must be non-empty"))
 	}
 	return &RocksDB{
-		attrs:     attrs,
-		dir:       dir,
-		cacheSize: cacheSize,
+		attrs: attrs,
+		dir:   dir,
+		opts:  opts,
 	}
 }
 
@@ -62,7 +180,7 @@ func newMemRocksDB(attrs proto.Attributes, cacheSize int64) *RocksDB {
 	return &RocksDB{
 		attrs: attrs,
 		// dir: empty dir == "mem" RocksDB instance.
-		cacheSize: cacheSize,
+		opts: RocksDBOptions{CacheSize: cacheSize},
 	}
 }
 
@@ -84,12 +202,22 @@ func (r *RocksDB) Open() error {
 		return nil
 	}
 
+	writeBufferSize := r.opts.WriteBufferSize
+	if writeBufferSize == 0 {
+		writeBufferSize = defaultWriteBufferSize
+	}
+
 	log.Infof("opening rocksdb instance at %q", r.dir)
 	status := C.DBOpen(&r.rdb, goToCSlice([]byte(r.dir)),
 		C.DBOptions{
-			cache_size:      C.int64_t(r.cacheSize),
-			allow_os_buffer: C.bool(true),
-			logging_enabled: C.bool(log.V(1)),
+			cache_size:         C.int64_t(r.opts.CacheSize),
+			write_buffer_size:  C.int64_t(writeBufferSize),
+			bloom_bits_per_key: C.int(r.opts.BloomFilterBitsPerKey),
+			compression:        C.int(r.opts.Compression),
+			max_open_files:     C.int(r.opts.MaxOpenFiles),
+			allow_os_buffer:    C.bool(true),
+			logging_enabled:    C.bool(log.V(1)),
+			read_only:          C.bool(r.opts.ReadOnly),
 		})
 	err := statusToError(status)
 	if err != nil {
@@ -124,6 +252,19 @@ func emptyKeyError() error {
 	return util.ErrorSkipFrames(1, "attempted access to empty key")
 }
 
+// SetWriteDelay delays each subsequent write to the engine by the given
+// duration, simulating a slow disk. It is intended for testing only.
+func (r *RocksDB) SetWriteDelay(d time.Duration) {
+	atomic.StoreInt64(&r.writeDelay, int64(d))
+}
+
+// delayWrite sleeps for the duration set by SetWriteDelay.
+func (r *RocksDB) delayWrite() {
+	if d := atomic.LoadInt64(&r.writeDelay); d > 0 {
+		time.Sleep(time.Duration(d))
+	}
+}
+
 // Put sets the given key to the value provided.
 //
 // The key and value byte slices may be reused safely. put takes a copy of
@@ -132,6 +273,7 @@ func (r *RocksDB) Put(key proto.EncodedKey, value []byte) error {
 	if len(key) == 0 {
 		return emptyKeyError()
 	}
+	r.delayWrite()
 
 	// *Put, *Get, and *Delete call memcpy() (by way of MemTable::Add)
 	// when called, so we do not need to worry about these byte slices
@@ -249,31 +391,78 @@ func (r *RocksDB) iterateInternal(start, end proto.EncodedKey, f func(proto.RawK
 // the RocksDB write batch facility. The list must only contain
 // elements of type Batch{Put,Merge,Delete}.
 func (r *RocksDB) WriteBatch(cmds []interface{}) error {
+	return r.writeBatch(cmds, false)
+}
+
+// WriteSyncBatch is like WriteBatch, but syncs the RocksDB write
+// ahead log before returning.
+func (r *RocksDB) WriteSyncBatch(cmds []interface{}) error {
+	return r.writeBatch(cmds, true)
+}
+
+// maxPooledWriteBatchSize is the size in bytes of the keys and values
+// above which a RocksDB write batch is not returned to writeBatchPool,
+// so that the pool doesn't pin the memory of outsized batches, such
+// as those applying snapshots.
+const maxPooledWriteBatchSize = 1 << 20 // 1 MB
+
+// A rocksDBWriteBatch holds a RocksDB write batch for reuse across
+// calls to writeBatch. The write batch is destroyed by a finalizer
+// once the holder is dropped from writeBatchPool.
+type rocksDBWriteBatch struct {
+	batch *C.DBBatch
+}
+
+var writeBatchPool = sync.Pool{
+	New: func() interface{} {
+		wb := &rocksDBWriteBatch{batch: C.DBNewBatch()}
+		runtime.SetFinalizer(wb, func(wb *rocksDBWriteBatch) {
+			C.DBBatchDestroy(wb.batch)
+		})
+		return wb
+	},
+}
+
+func (r *RocksDB) writeBatch(cmds []interface{}, sync bool) error {
 	if len(cmds) == 0 {
 		return nil
 	}
-	batch := C.DBNewBatch()
-	defer C.DBBatchDestroy(batch)
+	r.delayWrite()
+	wb := writeBatchPool.Get().(*rocksDBWriteBatch)
+	size, err := r.writeBatchInternal(wb.batch, cmds, sync)
+	if size <= maxPooledWriteBatchSize {
+		C.DBBatchClear(wb.batch)
+		writeBatchPool.Put(wb)
+	}
+	return err
+}
 
+// writeBatchInternal adds cmds to the supplied write batch and applies
+// it, returning the size of the keys and values added.
+func (r *RocksDB) writeBatchInternal(batch *C.DBBatch, cmds []interface{}, sync bool) (int, error) {
+	var size int
 	for i, e := range cmds {
 		switch v := e.(type) {
 		case BatchDelete:
 			if len(v.Key) == 0 {
-				return emptyKeyError()
+				return size, emptyKeyError()
 			}
 			C.DBBatchDelete(batch, goToCSlice(v.Key))
+			size += len(v.Key)
 		case BatchPut:
 			// We write the batch before returning from this method, so we
 			// don't need to worry about the GC reclaiming the data stored.
 			C.DBBatchPut(batch, goToCSlice(v.Key), goToCSlice(v.Value))
+			size += len(v.Key) + len(v.Value)
 		case BatchMerge:
 			C.DBBatchMerge(batch, goToCSlice(v.Key), goToCSlice(v.Value))
+			size += len(v.Key) + len(v.Value)
 		default:
 			panic(fmt.Sprintf("illegal operation #%d passed to writeBatch: %T", i, v))
 		}
 	}
 
-	return statusToError(C.DBWrite(r.rdb, batch))
+	return size, statusToError(C.DBWrite(r.rdb, batch, C.bool(sync)))
 }
 
 // Capacity queries the underlying file system for disk capacity
@@ -293,6 +482,46 @@ func (r *RocksDB) Capacity() (StoreCapacity, error) {
 	return capacity, nil
 }
 
+// GetStats retrieves RocksDB property and statistics values.
+func (r *RocksDB) GetStats() (*proto.EngineStats, error) {
+	var s C.DBStatsResult
+	if err := statusToError(C.DBGetStats(r.rdb, &s)); err != nil {
+		return nil, err
+	}
+	stats := &proto.EngineStats{
+		BlockCacheHits:    int64(s.block_cache_hits),
+		BlockCacheMisses:  int64(s.block_cache_misses),
+		BlockCacheUsage:   int64(s.block_cache_usage),
+		MemtableSize:      int64(s.memtable_size),
+		CompactionPending: bool(s.compaction_pending),
+
+		ValueChecksumMismatches: atomic.LoadInt64(&r.checksumMismatches),
+	}
+	for i := 0; i < int(s.num_levels); i++ {
+		stats.FilesAtLevel = append(stats.FilesAtLevel, int64(s.files_at_level[i]))
+	}
+	return stats, nil
+}
+
+// reportChecksumMismatch counts a value read with a mismatched
+// checksum and, depending on the engine's ValueChecksumMode, either
+// returns the error or logs it and allows the read to proceed.
+func (r *RocksDB) reportChecksumMismatch(err error) error {
+	atomic.AddInt64(&r.checksumMismatches, 1)
+	if r.opts.ValueChecksumMode == ChecksumModeLog {
+		log.Errorf("%s: %s", r, err)
+		return nil
+	}
+	return err
+}
+
+// VerifyChecksums reads all keys in the range [start,end) without
+// filling the block cache and returns an error if any block fails
+// checksum verification.
+func (r *RocksDB) VerifyChecksums(start, end proto.EncodedKey) error {
+	return statusToError(C.DBVerifyChecksums(r.rdb, goToCSlice(start), goToCSlice(end)))
+}
+
 // SetGCTimeouts calls through to the DBEngine's SetGCTimeouts method.
 func (r *RocksDB) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 	C.DBSetGCTimeouts(r.rdb, C.int64_t(minTxnTS), C.int64_t(minRCacheTS))
@@ -418,6 +647,17 @@ func (r *RocksDB) NewIterator() Iterator {
 	return newRocksDBIterator(r.rdb, nil)
 }
 
+// NewTimeBoundIterator returns an iterator over this rocksdb engine.
+// The time bounds aren't used to skip any data.
+//
+// TODO(pmattis): skipping the SSTables which hold no MVCC versions in
+// [start, end] requires table properties collected per SSTable and
+// ReadOptions::table_filter, neither of which the RocksDB version we
+// build against provides.
+func (r *RocksDB) NewTimeBoundIterator(start, end proto.Timestamp) Iterator {
+	return newRocksDBIterator(r.rdb, nil)
+}
+
 // NewSnapshot creates a snapshot handle from engine and returns a
 // read-only rocksDBSnapshot engine.
 func (r *RocksDB) NewSnapshot() Engine {
@@ -432,7 +672,7 @@ func (r *RocksDB) NewSnapshot() Engine {
 
 // NewBatch returns a new Batch wrapping this rocksdb engine.
 func (r *RocksDB) NewBatch() Engine {
-	return &Batch{engine: r}
+	return NewBatch(r)
 }
 
 // Commit is a noop for RocksDB engine.
@@ -493,6 +733,11 @@ func (r *rocksDBSnapshot) WriteBatch([]interface{}) error {
 	return util.Errorf("cannot WriteBatch to a snapshot")
 }
 
+// WriteSyncBatch is illegal for snapshot and returns an error.
+func (r *rocksDBSnapshot) WriteSyncBatch([]interface{}) error {
+	return util.Errorf("cannot WriteSyncBatch to a snapshot")
+}
+
 // Merge is illegal for snapshot and returns an error.
 func (r *rocksDBSnapshot) Merge(key proto.EncodedKey, value []byte) error {
 	return util.Errorf("cannot Merge to a snapshot")
@@ -503,6 +748,24 @@ func (r *rocksDBSnapshot) Capacity() (StoreCapacity, error) {
 	return r.parent.Capacity()
 }
 
+// GetStats returns the stats of the engine from which the snapshot
+// was taken.
+func (r *rocksDBSnapshot) GetStats() (*proto.EngineStats, error) {
+	return r.parent.GetStats()
+}
+
+// reportChecksumMismatch reports to the engine from which the
+// snapshot was taken.
+func (r *rocksDBSnapshot) reportChecksumMismatch(err error) error {
+	return r.parent.reportChecksumMismatch(err)
+}
+
+// VerifyChecksums verifies the checksums of the engine from which the
+// snapshot was taken.
+func (r *rocksDBSnapshot) VerifyChecksums(start, end proto.EncodedKey) error {
+	return r.parent.VerifyChecksums(start, end)
+}
+
 // SetGCTimeouts is a noop for a snapshot.
 func (r *rocksDBSnapshot) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 }
@@ -524,6 +787,12 @@ func (r *rocksDBSnapshot) NewIterator() Iterator {
 	return newRocksDBIterator(r.parent.rdb, r.handle)
 }
 
+// NewTimeBoundIterator returns a new instance of an Iterator over the
+// engine using the snapshot handle. See RocksDB.NewTimeBoundIterator.
+func (r *rocksDBSnapshot) NewTimeBoundIterator(start, end proto.Timestamp) Iterator {
+	return newRocksDBIterator(r.parent.rdb, r.handle)
+}
+
 // NewSnapshot is illegal for snapshot and returns nil.
 func (r *rocksDBSnapshot) NewSnapshot() Engine {
 	panic("cannot create a NewSnapshot from a snapshot")
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"code.google.com/p/go-uuid/uuid"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
@@ -87,19 +88,19 @@ func TestRocksDBCompaction(t *testing.T) {
 	// that exactly one of each should be GC'd based on our GC timeouts.
 	kvs := []proto.KeyValue{
 		{
-			Key:   ResponseCacheKey(1, cmdID),
+			Key:   keys.ResponseCacheKey(1, cmdID),
 			Value: proto.Value{Bytes: encodePutResponse(makeTS(2, 0), t)},
 		},
 		{
-			Key:   ResponseCacheKey(2, cmdID),
+			Key:   keys.ResponseCacheKey(2, cmdID),
 			Value: proto.Value{Bytes: encodePutResponse(makeTS(3, 0), t)},
 		},
 		{
-			Key:   TransactionKey(proto.Key("a"), proto.Key(uuid.New())),
+			Key:   keys.TransactionKey(proto.Key("a"), proto.Key(uuid.New())),
 			Value: proto.Value{Bytes: encodeTransaction(makeTS(1, 0), t)},
 		},
 		{
-			Key:   TransactionKey(proto.Key("b"), proto.Key(uuid.New())),
+			Key:   keys.TransactionKey(proto.Key("b"), proto.Key(uuid.New())),
 			Value: proto.Value{Bytes: encodeTransaction(makeTS(2, 0), t)},
 		},
 	}
@@ -111,7 +112,7 @@ func TestRocksDBCompaction(t *testing.T) {
 
 	// Compact range and scan remaining values to compare.
 	rocksdb.CompactRange(nil, nil)
-	actualKVs, err := MVCCScan(rocksdb, KeyMin, KeyMax, 0, proto.ZeroTimestamp, true, nil)
+	actualKVs, err := MVCCScan(rocksdb, keys.KeyMin, keys.KeyMax, 0, proto.ZeroTimestamp, true, nil)
 	if err != nil {
 		t.Fatalf("could not run scan: %v", err)
 	}
@@ -128,6 +129,90 @@ func TestRocksDBCompaction(t *testing.T) {
 	}
 }
 
+// TestParseCompressionType verifies that each compression type
+// round-trips through its name and that unknown names are rejected.
+func TestParseCompressionType(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	for _, c := range []CompressionType{SnappyCompression, NoCompression, ZlibCompression, LZ4Compression} {
+		parsed, err := ParseCompressionType(c.String())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c, err)
+		} else if parsed != c {
+			t.Errorf("expected %s; got %s", c, parsed)
+		}
+	}
+	if _, err := ParseCompressionType("bzip2"); err == nil {
+		t.Error("expected error parsing unknown compression type")
+	}
+}
+
+// TestRocksDBGetStats verifies that engine statistics reflect reads
+// and flushed writes.
+func TestRocksDBGetStats(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rocksdb := newMemRocksDB(proto.Attributes{Attrs: []string{"ssd"}}, testCacheSize)
+	if err := rocksdb.Open(); err != nil {
+		t.Fatalf("could not create new in-memory rocksdb db instance: %v", err)
+	}
+	defer rocksdb.Close()
+
+	key := proto.EncodedKey("a")
+	if err := rocksdb.Put(key, []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := rocksdb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := rocksdb.Get(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := rocksdb.GetStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.FilesAtLevel) == 0 {
+		t.Fatalf("expected file counts per level; got %+v", stats)
+	}
+	var files int64
+	for _, n := range stats.FilesAtLevel {
+		files += n
+	}
+	if files == 0 {
+		t.Errorf("expected at least one file after flush; got %+v", stats)
+	}
+	if stats.BlockCacheHits+stats.BlockCacheMisses == 0 {
+		t.Errorf("expected block cache lookups; got %+v", stats)
+	}
+}
+
+// TestRocksDBVerifyChecksums verifies that checksum verification of
+// intact data, both in memtables and flushed tables, succeeds.
+func TestRocksDBVerifyChecksums(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rocksdb := newMemRocksDB(proto.Attributes{Attrs: []string{"ssd"}}, testCacheSize)
+	if err := rocksdb.Open(); err != nil {
+		t.Fatalf("could not create new in-memory rocksdb db instance: %v", err)
+	}
+	defer rocksdb.Close()
+
+	for _, key := range []string{"a", "b", "c"} {
+		if err := rocksdb.Put(proto.EncodedKey(key), []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+		if key == "b" {
+			if err := rocksdb.Flush(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := rocksdb.VerifyChecksums(proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax)); err != nil {
+		t.Errorf("unexpected error verifying checksums: %s", err)
+	}
+}
+
 // setupMVCCData writes up to numVersions values at each of numKeys
 // keys. The number of versions written for each key is chosen
 // randomly according to a uniform distribution. Each successive
@@ -153,7 +238,7 @@ func setupMVCCScanData(numVersions, numKeys int, b *testing.B) *RocksDB {
 
 	log.Infof("creating mvcc data: %s", loc)
 	const cacheSize = 8 << 30 // 8 GB
-	rocksdb := NewRocksDB(proto.Attributes{Attrs: []string{"ssd"}}, loc, cacheSize)
+	rocksdb := NewRocksDB(proto.Attributes{Attrs: []string{"ssd"}}, loc, RocksDBOptions{CacheSize: cacheSize})
 	if err := rocksdb.Open(); err != nil {
 		b.Fatalf("could not create new rocksdb db instance at %s: %v", loc, err)
 	}
@@ -231,7 +316,7 @@ func runMVCCScan(numRows, numVersions int, b *testing.B) {
 			startKey := proto.Key(encoding.EncodeUvarint(keyBuf[0:4], uint64(keyIdx)))
 			walltime := int64(5 * (rand.Int31n(int32(numVersions)) + 1))
 			ts := makeTS(walltime, 0)
-			kvs, err := MVCCScan(rocksdb, startKey, KeyMax, int64(numRows), ts, true, nil)
+			kvs, err := MVCCScan(rocksdb, startKey, keys.KeyMax, int64(numRows), ts, true, nil)
 			if err != nil {
 				b.Fatalf("failed scan: %s", err)
 			}
@@ -432,6 +517,93 @@ func BenchmarkMVCCBatch100000Put10(b *testing.B) {
 	runMVCCBatchPut(10, 100000, b)
 }
 
+// runMVCCBatchApply writes numPuts values of valueSize bytes per
+// iteration through a batch nested in another, the way a range
+// applies a Raft command, committing and closing both batches.
+func runMVCCBatchApply(valueSize, numPuts int, b *testing.B) {
+	rng, _ := util.NewPseudoRand()
+	value := proto.Value{Bytes: util.RandBytes(rng, valueSize)}
+	keyBuf := append(make([]byte, 0, 64), []byte("key-")...)
+
+	rocksdb := NewInMem(proto.Attributes{Attrs: []string{"ssd"}}, testCacheSize)
+	defer rocksdb.Close()
+
+	b.SetBytes(int64(valueSize * numPuts))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		outer := NewBatch(rocksdb)
+		batch := NewBatch(outer)
+		for j := 0; j < numPuts; j++ {
+			key := proto.Key(encoding.EncodeUvarint(keyBuf[0:4], uint64(i*numPuts+j)))
+			ts := makeTS(time.Now().UnixNano(), 0)
+			if err := MVCCPut(batch, nil, key, ts, value, nil); err != nil {
+				b.Fatalf("failed put: %s", err)
+			}
+		}
+		if err := batch.Commit(); err != nil {
+			b.Fatal(err)
+		}
+		batch.Close()
+		if err := outer.Commit(); err != nil {
+			b.Fatal(err)
+		}
+		outer.Close()
+	}
+
+	b.StopTimer()
+}
+
+func BenchmarkMVCCBatchApply1Put10(b *testing.B) {
+	runMVCCBatchApply(10, 1, b)
+}
+
+func BenchmarkMVCCBatchApply10Put10(b *testing.B) {
+	runMVCCBatchApply(10, 10, b)
+}
+
+func BenchmarkMVCCBatchApply100Put10(b *testing.B) {
+	runMVCCBatchApply(10, 100, b)
+}
+
+// runMVCCBatchGet reads numKeys keys through a batch holding an
+// update to each, creating and closing a batch iterator per read.
+func runMVCCBatchGet(numKeys int, b *testing.B) {
+	rocksdb := NewInMem(proto.Attributes{Attrs: []string{"ssd"}}, testCacheSize)
+	defer rocksdb.Close()
+
+	batch := NewBatch(rocksdb)
+	defer batch.Close()
+	testKeys := make([]proto.Key, numKeys)
+	ts := makeTS(1, 0)
+	for i := range testKeys {
+		testKeys[i] = proto.Key(fmt.Sprintf("key-%05d", i))
+		if err := MVCCPut(batch, nil, testKeys[i], ts, value1, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := MVCCGet(batch, testKeys[i%numKeys], ts, true, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.StopTimer()
+}
+
+func BenchmarkMVCCBatchGet100Keys(b *testing.B) {
+	runMVCCBatchGet(100, b)
+}
+
+func BenchmarkMVCCBatchGet10000Keys(b *testing.B) {
+	runMVCCBatchGet(10000, b)
+}
+
 // runMVCCMerge merges value into numKeys separate keys.
 func runMVCCMerge(value *proto.Value, numKeys int, b *testing.B) {
 	rocksdb := NewInMem(proto.Attributes{Attrs: []string{"ssd"}}, testCacheSize)
@@ -0,0 +1,116 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package engine
+
+import (
+	"unsafe"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// #cgo CPPFLAGS: -I ../../../c-snappy/internal
+//
+// #include <stdlib.h>
+// #include <snappy-c.h>
+//
+// static snappy_status value_snappy_encode(const char* input,
+//                                          size_t input_length,
+//                                          void** compressed,
+//                                          size_t* compressed_length) {
+//   *compressed_length = snappy_max_compressed_length(input_length);
+//   *compressed = malloc(*compressed_length);
+//   snappy_status status = snappy_compress(input, input_length, *compressed, compressed_length);
+//   if (status != SNAPPY_OK) {
+//     free(*compressed);
+//   }
+//   return status;
+// }
+//
+// static snappy_status value_snappy_decode(const char* compressed,
+//                                          size_t compressed_length,
+//                                          void** uncompressed,
+//                                          size_t* uncompressed_length) {
+//   snappy_status status = snappy_uncompressed_length(compressed, compressed_length, uncompressed_length);
+//   if (status != SNAPPY_OK) {
+//     return status;
+//   }
+//   *uncompressed = malloc(*uncompressed_length);
+//   status = snappy_uncompress(compressed, compressed_length, *uncompressed, uncompressed_length);
+//   if (status != SNAPPY_OK) {
+//     free(*uncompressed);
+//   }
+//   return status;
+// }
+import "C"
+
+// CompressValue snappy compresses the bytes of value in place if they
+// exceed threshold and compression actually shrinks them. A threshold
+// of zero disables compression. The checksum, which covers the
+// uncompressed bytes, is left untouched. The engine stores values as
+// they're written and decompresses them transparently when read, so
+// the decision to compress must be made before a write is replicated
+// for all replicas to store the same bytes.
+func CompressValue(value *proto.Value, threshold int) error {
+	if threshold <= 0 || len(value.Bytes) <= threshold || value.Compression != nil {
+		return nil
+	}
+
+	var dLen C.size_t
+	var dst unsafe.Pointer
+
+	status := C.value_snappy_encode((*C.char)(unsafe.Pointer(&value.Bytes[0])),
+		C.size_t(len(value.Bytes)), &dst, &dLen)
+	if status != C.SNAPPY_OK {
+		return util.Errorf("unable to compress value: snappy status %d", status)
+	}
+	if int(dLen) < len(value.Bytes) {
+		value.Bytes = C.GoBytes(dst, C.int(dLen))
+		value.Compression = proto.VALUE_SNAPPY.Enum()
+	}
+	C.free(dst)
+	return nil
+}
+
+// decompressValue reverses CompressValue, replacing the bytes of a
+// compressed value with the uncompressed bytes.
+func decompressValue(value *proto.Value) error {
+	switch value.GetCompression() {
+	case proto.VALUE_UNCOMPRESSED:
+		return nil
+	case proto.VALUE_SNAPPY:
+	default:
+		return util.Errorf("unknown value compression %s", value.GetCompression())
+	}
+	value.Compression = nil
+	if len(value.Bytes) == 0 {
+		return nil
+	}
+
+	var dLen C.size_t
+	var dst unsafe.Pointer
+
+	status := C.value_snappy_decode((*C.char)(unsafe.Pointer(&value.Bytes[0])),
+		C.size_t(len(value.Bytes)), &dst, &dLen)
+	if status != C.SNAPPY_OK {
+		return util.Errorf("unable to decompress value: snappy status %d", status)
+	}
+	value.Bytes = C.GoBytes(dst, C.int(dLen))
+	C.free(dst)
+	return nil
+}
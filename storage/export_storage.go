@@ -0,0 +1,142 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// ExportStorage is a destination for, and source of, the files of a
+// backup.
+type ExportStorage interface {
+	// WriteFile writes the content of r to the named file, replacing
+	// any existing file of that name.
+	WriteFile(name string, r io.Reader) error
+	// ReadFile opens the named file for reading. The caller must close
+	// the returned reader.
+	ReadFile(name string) (io.ReadCloser, error)
+}
+
+// NewExportStorage returns the ExportStorage named by uri: a directory
+// on local disk, given as a path with an optional file:// scheme, or
+// an http:// or https:// location to which files are written with PUT
+// and from which they are read with GET requests. The latter includes
+// S3 buckets addressed by their REST endpoints; requests are not
+// signed, so the bucket's policy must grant access.
+func NewExportStorage(uri string) (ExportStorage, error) {
+	if uri == "" {
+		return nil, util.Errorf("no export storage specified")
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, util.Errorf("invalid export storage %q: %s", uri, err)
+	}
+	switch u.Scheme {
+	case "", "file":
+		return localStorage(u.Path), nil
+	case "http", "https":
+		return &httpStorage{base: strings.TrimSuffix(uri, "/"), client: http.DefaultClient}, nil
+	}
+	return nil, util.Errorf("unsupported export storage scheme %q", u.Scheme)
+}
+
+// localStorage stores files in a directory on local disk.
+type localStorage string
+
+// WriteFile writes the file to a temporary name in the directory and
+// renames it once complete, so that partially written files are never
+// visible under their final names.
+func (l localStorage) WriteFile(name string, r io.Reader) error {
+	if err := os.MkdirAll(string(l), 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(string(l), name)
+	f, err := ioutil.TempFile(string(l), name+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// ReadFile opens the named file in the directory.
+func (l localStorage) ReadFile(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(l), name))
+}
+
+// httpStorage stores files at locations below a base URL.
+type httpStorage struct {
+	base   string
+	client *http.Client
+}
+
+// WriteFile PUTs the file's content at its location.
+func (h *httpStorage) WriteFile(name string, r io.Reader) error {
+	req, err := http.NewRequest("PUT", h.url(name), r)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return util.Errorf("PUT %s failed: %s", h.url(name), resp.Status)
+	}
+	return nil
+}
+
+// ReadFile GETs the file from its location.
+func (h *httpStorage) ReadFile(name string) (io.ReadCloser, error) {
+	resp, err := h.client.Get(h.url(name))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		resp.Body.Close()
+		return nil, util.Errorf("GET %s failed: %s", h.url(name), resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func (h *httpStorage) url(name string) string {
+	return fmt.Sprintf("%s/%s", h.base, url.QueryEscape(name))
+}
@@ -23,12 +23,13 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
-	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -79,7 +80,7 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 		return
 	}
 	// Lookup GC policy for this range.
-	policy, err := gcq.lookupGCPolicy(rng)
+	policy, err := lookupGCPolicy(rng)
 	if err != nil {
 		log.Errorf("GC policy: %s", err)
 		return
@@ -119,7 +120,7 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	defer snap.Close()
 
 	// Lookup the GC policy for the zone containing this key range.
-	policy, err := gcq.lookupGCPolicy(rng)
+	policy, err := lookupGCPolicy(rng)
 	if err != nil {
 		return err
 	}
@@ -136,6 +137,7 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 			Key:       rng.Desc().StartKey,
 			Timestamp: now,
 			RaftID:    rng.Desc().RaftID,
+			Priority:  proto.BACKGROUND,
 		},
 	}
 	var mu sync.Mutex
@@ -221,7 +223,7 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 
 	// Send GC request through range.
 	gcArgs.GCMeta = *gcMeta
-	if err := rng.AddCmd(gcArgs, &proto.InternalGCResponse{}, true); err != nil {
+	if err := rng.AddCmd(context.Background(), gcArgs, &proto.InternalGCResponse{}, true); err != nil {
 		return err
 	}
 
@@ -249,7 +251,7 @@ func (gcq *gcQueue) resolveIntent(rng *Range, key proto.Key, meta *proto.MVCCMet
 	updateOldestIntent func(int64), wg *sync.WaitGroup) {
 	defer wg.Done() // signal wait group always on completion
 
-	log.Infof("resolving intent at %q ts=%s", key, meta.Timestamp)
+	log.Infof("resolving intent at %s ts=%s", keys.PrettyPrint(key), meta.Timestamp)
 
 	// Attempt to push the transaction which created the intent.
 	now := rng.rm.Clock().Now()
@@ -260,6 +262,7 @@ func (gcq *gcQueue) resolveIntent(rng *Range, key proto.Key, meta *proto.MVCCMet
 			User:         UserRoot,
 			UserPriority: gogoproto.Int32(proto.MaxPriority),
 			Txn:          nil,
+			Priority:     proto.BACKGROUND,
 		},
 		PusheeTxn: *meta.Txn,
 		Abort:     true,
@@ -278,10 +281,11 @@ func (gcq *gcQueue) resolveIntent(rng *Range, key proto.Key, meta *proto.MVCCMet
 			Key:       key,
 			User:      UserRoot,
 			Txn:       pushReply.PusheeTxn,
+			Priority:  proto.BACKGROUND,
 		},
 	}
-	if err := rng.AddCmd(resolveArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
-		log.Warningf("resolve of key %q failed: %s", key, err)
+	if err := rng.AddCmd(context.Background(), resolveArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
+		log.Warningf("resolve of key %s failed: %s", keys.PrettyPrint(key), err)
 		updateOldestIntent(meta.Timestamp.WallTime)
 	}
 }
@@ -290,14 +294,14 @@ func (gcq *gcQueue) resolveIntent(rng *Range, key proto.Key, meta *proto.MVCCMet
 // supplied range's start key. It queries all matching config prefixes
 // and then iterates from most specific to least, returning the first
 // non-nil GC policy.
-func (gcq *gcQueue) lookupGCPolicy(rng *Range) (proto.GCPolicy, error) {
-	info, err := rng.rm.Gossip().GetInfo(gossip.KeyConfigZone)
+func lookupGCPolicy(rng *Range) (proto.GCPolicy, error) {
+	cfg, err := GetSystemConfig(rng.rm.Gossip())
 	if err != nil {
 		return proto.GCPolicy{}, util.Errorf("unable to fetch zone config from gossip: %s", err)
 	}
-	configMap, ok := info.(PrefixConfigMap)
-	if !ok {
-		return proto.GCPolicy{}, util.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+	configMap := cfg.Zones
+	if len(configMap) == 0 {
+		return proto.GCPolicy{}, util.Errorf("no zone configs in gossiped system config")
 	}
 
 	// Verify that the range doesn't cross over the zone config prefix.
@@ -20,13 +20,13 @@ package storage
 import (
 	"math"
 	"testing"
-	"time"
 
-	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
 )
 
 // makeTS creates a new hybrid logical timestamp.
@@ -48,7 +48,7 @@ func TestGCQueueShouldQueue(t *testing.T) {
 	defer tc.Stop()
 
 	// Put an empty GC metadata; all that's read from it is last scan nanos.
-	key := engine.RangeGCMetadataKey(tc.rng.Desc().RaftID)
+	key := keys.RangeGCMetadataKey(tc.rng.Desc().RaftID)
 	if err := engine.MVCCPutProto(tc.rng.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &proto.GCMetadata{}); err != nil {
 		t.Fatal(err)
 	}
@@ -185,7 +185,7 @@ func TestGCQueueProcess(t *testing.T) {
 				dArgs.Txn = newTransaction("test", datum.key, 1, proto.SERIALIZABLE, tc.clock)
 				dArgs.Txn.Timestamp = datum.ts
 			}
-			if err := tc.rng.AddCmd(dArgs, dReply, true); err != nil {
+			if err := tc.rng.AddCmd(context.Background(), dArgs, dReply, true); err != nil {
 				t.Fatal(err)
 			}
 		} else {
@@ -195,7 +195,7 @@ func TestGCQueueProcess(t *testing.T) {
 				pArgs.Txn = newTransaction("test", datum.key, 1, proto.SERIALIZABLE, tc.clock)
 				pArgs.Txn.Timestamp = datum.ts
 			}
-			if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+			if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
 				t.Fatal(err)
 			}
 		}
@@ -228,7 +228,7 @@ func TestGCQueueProcess(t *testing.T) {
 		{key8, ts2},
 	}
 	// Read data directly from engine to avoid intent errors from MVCC.
-	kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(key1), engine.MVCCEncodeKey(engine.KeyMax), 0)
+	kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(key1), engine.MVCCEncodeKey(keys.KeyMax), 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -300,7 +300,7 @@ func TestGCQueueLookupGCPolicy(t *testing.T) {
 		// hierarchical parent's GC policy; in this case, zoneConfig1.
 	}
 	configs := []*PrefixConfig{
-		{engine.KeyMin, nil, &zoneConfig1},
+		{keys.KeyMin, nil, &zoneConfig1},
 		{proto.Key("/db1"), nil, &zoneConfig2},
 	}
 	pcc, err := NewPrefixConfigMap(configs)
@@ -314,9 +314,7 @@ func TestGCQueueLookupGCPolicy(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
-	if err := tc.rng.rm.Gossip().AddInfo(gossip.KeyConfigZone, pcc, 0*time.Second); err != nil {
-		t.Fatal(err)
-	}
+	updateTestSystemConfig(t, tc.gossip, func(cfg *SystemConfig) { cfg.Zones = pcc })
 
 	// Create a new range within "/db1" and verify that lookup of
 	// zone config results in the
@@ -325,8 +323,7 @@ func TestGCQueueLookupGCPolicy(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	gcQ := newGCQueue()
-	gcPolicy, err := gcQ.lookupGCPolicy(rng2)
+	gcPolicy, err := lookupGCPolicy(rng2)
 	if err != nil {
 		t.Fatal(err)
 	}
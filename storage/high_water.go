@@ -0,0 +1,109 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// highWaterPollInterval bounds the interval at which the clock is
+// checked while waiting for it to pass the high water, so that
+// adjustments of the clock are noticed.
+const highWaterPollInterval = 100 * time.Millisecond
+
+// waitForHighWater blocks until the store's clock exceeds the high
+// water persisted before the store was restarted by more than the
+// clock's maximum offset. Timestamps issued before the restart were
+// bounded by the high water, so this prevents a node restarting with a
+// regressed wall clock from issuing smaller ones.
+func (s *Store) waitForHighWater() error {
+	if s.ctx.HighWaterInterval < 0 {
+		return nil
+	}
+	var highWater proto.Timestamp
+	ok, err := engine.MVCCGetProto(s.engine, keys.StoreHighWaterKey(), proto.ZeroTimestamp, true, nil, &highWater)
+	if err != nil || !ok {
+		return err
+	}
+	target := highWater.WallTime + s.ctx.Clock.MaxOffset().Nanoseconds()
+	logged := false
+	for {
+		now := s.ctx.Clock.PhysicalNow()
+		if now > target {
+			return nil
+		}
+		wait := time.Duration(target - now + 1)
+		if !logged {
+			log.Warningf("store %d: clock is behind its persisted high water %s; waiting %s before starting",
+				s.Ident.StoreID, highWater, wait)
+			logged = true
+		}
+		if wait > highWaterPollInterval {
+			wait = highWaterPollInterval
+		}
+		select {
+		case <-time.After(wait):
+		case <-s.stopper.ShouldStop():
+			return util.Errorf("store %d stopped while waiting for its clock to pass %s",
+				s.Ident.StoreID, highWater)
+		}
+	}
+}
+
+// persistHighWater persists a timestamp exceeding those the clock
+// will issue until the next persistence, an interval later. The high
+// water leads the clock by twice the interval, so that a delayed
+// persistence doesn't let the clock overtake it.
+func (s *Store) persistHighWater() error {
+	highWater := s.ctx.Clock.Now()
+	highWater.WallTime += 2 * s.ctx.HighWaterInterval.Nanoseconds()
+	highWater.Logical = 0
+	return engine.MVCCPutProto(s.engine, nil, keys.StoreHighWaterKey(), proto.ZeroTimestamp, nil, &highWater)
+}
+
+// startHighWater persists the high water and starts a worker which
+// persists it again every HighWaterInterval.
+func (s *Store) startHighWater() error {
+	if s.ctx.HighWaterInterval < 0 {
+		return nil
+	}
+	if err := s.persistHighWater(); err != nil {
+		return err
+	}
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(s.ctx.HighWaterInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.persistHighWater(); err != nil {
+					log.Errorf("store %d: unable to persist clock high water: %s", s.Ident.StoreID, err)
+				}
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+	return nil
+}
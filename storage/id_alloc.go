@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -107,7 +108,7 @@ func (ia *IDAllocator) allocateBlock(incr int64) {
 		call := client.IncrementCall(idKey, incr)
 		ir = call.Reply.(*proto.IncrementResponse)
 		if err := ia.db.Run(call); err != nil {
-			log.Warningf("unable to allocate %d ids from %s: %s", incr, ia.idKey, err)
+			log.Warningf("unable to allocate %d ids from %s: %s", incr, keys.PrettyPrint(idKey), err)
 			ia.stopper.FinishTask()
 			return util.RetryContinue, err
 		}
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/leaktest"
@@ -38,7 +39,7 @@ func TestIDAllocator(t *testing.T) {
 	store, _, stopper := createTestStore(t)
 	defer stopper.Stop()
 	allocd := make(chan int, 100)
-	idAlloc, err := NewIDAllocator(engine.KeyRaftIDGenerator, store.ctx.DB,
+	idAlloc, err := NewIDAllocator(keys.KeyRaftIDGenerator, store.ctx.DB,
 		2, 10, stopper)
 	if err != nil {
 		t.Errorf("failed to create IDAllocator: %v", err)
@@ -83,14 +84,14 @@ func TestIDAllocatorNegativeValue(t *testing.T) {
 	defer stopper.Stop()
 
 	// Increment our key to a negative value.
-	newValue, err := engine.MVCCIncrement(store.Engine(), nil, engine.KeyRaftIDGenerator, store.ctx.Clock.Now(), nil, -1024)
+	newValue, err := engine.MVCCIncrement(store.Engine(), nil, keys.KeyRaftIDGenerator, store.ctx.Clock.Now(), nil, -1024)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if newValue != -1024 {
 		t.Errorf("expected new value to be -1024; got %d", newValue)
 	}
-	idAlloc, err := NewIDAllocator(engine.KeyRaftIDGenerator, store.ctx.DB, 2, 10, stopper)
+	idAlloc, err := NewIDAllocator(keys.KeyRaftIDGenerator, store.ctx.DB, 2, 10, stopper)
 	if err != nil {
 		t.Errorf("failed to create IDAllocator: %v", err)
 	}
@@ -127,7 +128,7 @@ func TestAllocateErrorAndRecovery(t *testing.T) {
 	allocd := make(chan int, 10)
 
 	// Firstly create a valid IDAllocator to get some ID.
-	idAlloc, err := NewIDAllocator(engine.KeyRaftIDGenerator, store.ctx.DB,
+	idAlloc, err := NewIDAllocator(keys.KeyRaftIDGenerator, store.ctx.DB,
 		2, 10, stopper)
 	if err != nil {
 		t.Errorf("failed to create IDAllocator: %v", err)
@@ -162,7 +163,7 @@ func TestAllocateErrorAndRecovery(t *testing.T) {
 	}
 
 	// Make the IDAllocator valid again.
-	idAlloc.idKey.Store(engine.KeyRaftIDGenerator)
+	idAlloc.idKey.Store(keys.KeyRaftIDGenerator)
 	// Check if the blocked allocations return expected ID.
 	ids := make([]int, 10)
 	for i := 0; i < 10; i++ {
@@ -0,0 +1,282 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"crypto/md5"
+	"math/rand"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+const (
+	// sklMaxLevel is the maximum height of an intervalSkl node.
+	sklMaxLevel = 16
+	// sklBranching is the inverse of the probability with which an
+	// intervalSkl node is promoted to the next level.
+	sklBranching = 4
+)
+
+// An sklEntry is a timestamp along with the MD5 of the ID of the
+// transaction which read or wrote at it. The MD5 is NoTxnMD5 for
+// non-transactional commands.
+type sklEntry struct {
+	timestamp proto.Timestamp
+	txnMD5    [md5.Size]byte
+}
+
+// An sklPair holds the maximum entry seen for a span along with the
+// maximum entry seen from any transaction other than the one which
+// owns max. This is sufficient to answer the maximum timestamp seen
+// by any transaction excluding the entries it added itself.
+type sklPair struct {
+	max, other sklEntry
+}
+
+// ratchet folds the entry into the pair.
+func (p *sklPair) ratchet(e sklEntry) {
+	if p.max.timestamp.Less(e.timestamp) {
+		if !proto.MD5Equal(p.max.txnMD5, e.txnMD5) {
+			p.other = p.max
+		}
+		p.max = e
+	} else if !proto.MD5Equal(p.max.txnMD5, e.txnMD5) && p.other.timestamp.Less(e.timestamp) {
+		p.other = e
+	}
+}
+
+// get returns the maximum timestamp in the pair, ignoring entries
+// added by the transaction with the supplied MD5 unless it is
+// NoTxnMD5.
+func (p sklPair) get(txnMD5 [md5.Size]byte) proto.Timestamp {
+	if !proto.MD5Equal(txnMD5, proto.NoTxnMD5) && proto.MD5Equal(p.max.txnMD5, txnMD5) {
+		return p.other.timestamp
+	}
+	return p.max.timestamp
+}
+
+func (p sklPair) equal(o sklPair) bool {
+	return p.max.timestamp.Equal(o.max.timestamp) && proto.MD5Equal(p.max.txnMD5, o.max.txnMD5) &&
+		p.other.timestamp.Equal(o.other.timestamp) && proto.MD5Equal(p.other.txnMD5, o.other.txnMD5)
+}
+
+// An sklValue holds the read and write pairs for a span.
+type sklValue struct {
+	read, write sklPair
+}
+
+// merge folds the entries of o into v.
+func (v *sklValue) merge(o sklValue) {
+	v.read.ratchet(o.read.max)
+	v.read.ratchet(o.read.other)
+	v.write.ratchet(o.write.max)
+	v.write.ratchet(o.write.other)
+}
+
+func (v sklValue) equal(o sklValue) bool {
+	return v.read.equal(o.read) && v.write.equal(o.write)
+}
+
+// An sklNode covers the key span [start, end).
+type sklNode struct {
+	start, end proto.Key
+	value      sklValue
+	next       []*sklNode
+}
+
+// An intervalSkl is a skiplist of non-overlapping key spans ordered by
+// start key. Adding a span splits the spans it partially overlaps and
+// fills the gaps between them, so that both reads and updates of a
+// span take O(log n) time to locate its first segment plus time
+// linear in the number of segments it covers. Adjacent segments left
+// with equal values are coalesced to keep the list compact.
+//
+// intervalSkl is not thread safe.
+type intervalSkl struct {
+	head         sklNode
+	level        int
+	len          int
+	minTS, maxTS proto.Timestamp // Bounds of timestamps added
+}
+
+func newIntervalSkl() *intervalSkl {
+	return &intervalSkl{
+		head:  sklNode{next: make([]*sklNode, sklMaxLevel)},
+		level: 1,
+	}
+}
+
+// find returns the last node whose start key is less than key, or
+// less than or equal to key if inclusive is true. The head node is
+// returned if there is no such node. If update is not nil, it is
+// filled with the rightmost node at each level preceding the result's
+// successor.
+func (s *intervalSkl) find(key proto.Key, inclusive bool, update []*sklNode) *sklNode {
+	x := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n := x.next[i]; n != nil && (n.start.Less(key) || (inclusive && n.start.Equal(key))); n = x.next[i] {
+			x = n
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x
+}
+
+// insert adds a node for [start, end). The caller must ensure no
+// existing node overlaps the span.
+func (s *intervalSkl) insert(start, end proto.Key, value sklValue) *sklNode {
+	update := make([]*sklNode, sklMaxLevel)
+	s.find(start, false, update)
+	level := 1
+	for level < sklMaxLevel && rand.Intn(sklBranching) == 0 {
+		level++
+	}
+	for ; s.level < level; s.level++ {
+		update[s.level] = &s.head
+	}
+	n := &sklNode{start: start, end: end, value: value, next: make([]*sklNode, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	s.len++
+	return n
+}
+
+// remove unlinks the supplied node.
+func (s *intervalSkl) remove(n *sklNode) {
+	update := make([]*sklNode, sklMaxLevel)
+	s.find(n.start, false, update)
+	for i := 0; i < len(n.next); i++ {
+		if update[i].next[i] == n {
+			update[i].next[i] = n.next[i]
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.len--
+}
+
+// split divides the node containing key, if any, so that a node
+// begins at key.
+func (s *intervalSkl) split(key proto.Key) {
+	n := s.find(key, true, nil)
+	if n != &s.head && n.start.Less(key) && key.Less(n.end) {
+		s.insert(key, n.end, n.value)
+		n.end = key
+	}
+}
+
+// update invokes fn on the value of every segment in [start, end),
+// creating segments to cover any part of the span not yet present.
+func (s *intervalSkl) update(start, end proto.Key, fn func(*sklValue)) {
+	s.split(start)
+	s.split(end)
+	prev := s.find(start, false, nil)
+	for n, pos := prev.next[0], start; pos.Less(end); {
+		if n != nil && n.start.Equal(pos) {
+			fn(&n.value)
+			pos, n = n.end, n.next[0]
+			continue
+		}
+		gapEnd := end
+		if n != nil && n.start.Less(end) {
+			gapEnd = n.start
+		}
+		fn(&s.insert(pos, gapEnd, sklValue{}).value)
+		pos = gapEnd
+	}
+	s.coalesce(prev, end)
+}
+
+// coalesce merges contiguous segments with equal values, starting at
+// x and ending with the segment beginning at end.
+func (s *intervalSkl) coalesce(x *sklNode, end proto.Key) {
+	if x == &s.head {
+		x = s.head.next[0]
+	}
+	for x != nil {
+		n := x.next[0]
+		if n == nil || end.Less(n.start) {
+			return
+		}
+		if x.end.Equal(n.start) && x.value.equal(n.value) {
+			x.end = n.end
+			s.remove(n)
+			continue
+		}
+		x = n
+	}
+}
+
+// observe widens the bounds of timestamps added to the list.
+func (s *intervalSkl) observe(ts proto.Timestamp) {
+	if ts.Equal(proto.ZeroTimestamp) {
+		return
+	}
+	if s.minTS.Equal(proto.ZeroTimestamp) || ts.Less(s.minTS) {
+		s.minTS = ts
+	}
+	if s.maxTS.Less(ts) {
+		s.maxTS = ts
+	}
+}
+
+// add records the entry as a read or write of [start, end).
+func (s *intervalSkl) add(start, end proto.Key, e sklEntry, readOnly bool) {
+	s.observe(e.timestamp)
+	s.update(start, end, func(v *sklValue) {
+		if readOnly {
+			v.read.ratchet(e)
+		} else {
+			v.write.ratchet(e)
+		}
+	})
+}
+
+// mergeInto merges all segments of s into dest.
+func (s *intervalSkl) mergeInto(dest *intervalSkl) {
+	dest.observe(s.minTS)
+	dest.observe(s.maxTS)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		value := n.value
+		dest.update(n.start, n.end, func(v *sklValue) { v.merge(value) })
+	}
+}
+
+// getMax returns the maximum read and write timestamps of segments
+// overlapping [start, end), ignoring entries added by the transaction
+// with the supplied MD5.
+func (s *intervalSkl) getMax(start, end proto.Key, txnMD5 [md5.Size]byte) (maxR, maxW proto.Timestamp) {
+	n := s.find(start, true, nil)
+	if n == &s.head || !start.Less(n.end) {
+		n = n.next[0]
+	}
+	for ; n != nil && n.start.Less(end); n = n.next[0] {
+		if r := n.value.read.get(txnMD5); maxR.Less(r) {
+			maxR = r
+		}
+		if w := n.value.write.get(txnMD5); maxW.Less(w) {
+			maxW = w
+		}
+	}
+	return
+}
@@ -0,0 +1,132 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"crypto/md5"
+	"math/rand"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// verifySkl checks that the segments of the list are ordered,
+// non-overlapping and that no two contiguous segments have equal
+// values.
+func verifySkl(t *testing.T, s *intervalSkl) {
+	count := 0
+	var prev *sklNode
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		if !n.start.Less(n.end) {
+			t.Fatalf("empty segment [%s, %s)", n.start, n.end)
+		}
+		if prev != nil {
+			if n.start.Less(prev.end) {
+				t.Fatalf("segment [%s, %s) overlaps [%s, %s)", prev.start, prev.end, n.start, n.end)
+			}
+			if prev.end.Equal(n.start) && prev.value.equal(n.value) {
+				t.Fatalf("segments [%s, %s) and [%s, %s) weren't coalesced", prev.start, prev.end, n.start, n.end)
+			}
+		}
+		prev = n
+		count++
+	}
+	if count != s.len {
+		t.Fatalf("expected %d segments; got %d", s.len, count)
+	}
+}
+
+func TestIntervalSklCoalesce(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := newIntervalSkl()
+	ts := proto.Timestamp{WallTime: 1}
+	s.add(proto.Key("a"), proto.Key("b"), sklEntry{timestamp: ts}, true)
+	s.add(proto.Key("c"), proto.Key("d"), sklEntry{timestamp: ts}, true)
+	s.add(proto.Key("b"), proto.Key("c"), sklEntry{timestamp: ts}, true)
+	verifySkl(t, s)
+	if s.len != 1 {
+		t.Fatalf("expected a single segment; got %d", s.len)
+	}
+	// Splitting the segment and writing the same value back leaves it whole.
+	s.add(proto.Key("b"), proto.Key("bb"), sklEntry{timestamp: ts}, true)
+	verifySkl(t, s)
+	if s.len != 1 {
+		t.Fatalf("expected a single segment; got %d", s.len)
+	}
+	s.add(proto.Key("b"), proto.Key("bb"), sklEntry{timestamp: ts.Add(1, 0)}, true)
+	verifySkl(t, s)
+	if s.len != 3 {
+		t.Fatalf("expected 3 segments; got %d", s.len)
+	}
+}
+
+// TestIntervalSklRandom compares the maximum timestamps returned by
+// the list against a brute force computation over all added entries.
+func TestIntervalSklRandom(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	type entry struct {
+		start, end proto.Key
+		e          sklEntry
+		readOnly   bool
+	}
+	rng := rand.New(rand.NewSource(0))
+	txns := [][md5.Size]byte{proto.NoTxnMD5, md5.Sum([]byte("txn1")), md5.Sum([]byte("txn2"))}
+	randSpan := func() (proto.Key, proto.Key) {
+		a, b := rng.Intn(26), rng.Intn(26)
+		if a > b {
+			a, b = b, a
+		}
+		return proto.Key{byte('a' + a)}, proto.Key{byte('a' + b + 1)}
+	}
+
+	s := newIntervalSkl()
+	var entries []entry
+	for i := 0; i < 1000; i++ {
+		start, end := randSpan()
+		e := entry{
+			start:    start,
+			end:      end,
+			e:        sklEntry{timestamp: proto.Timestamp{WallTime: rng.Int63n(100)}, txnMD5: txns[rng.Intn(len(txns))]},
+			readOnly: rng.Intn(2) == 0,
+		}
+		entries = append(entries, e)
+		s.add(e.start, e.end, e.e, e.readOnly)
+		verifySkl(t, s)
+
+		start, end = randSpan()
+		txnMD5 := txns[rng.Intn(len(txns))]
+		var expR, expW proto.Timestamp
+		for _, o := range entries {
+			if !o.start.Less(end) || !start.Less(o.end) {
+				continue
+			}
+			if !proto.MD5Equal(txnMD5, proto.NoTxnMD5) && proto.MD5Equal(txnMD5, o.e.txnMD5) {
+				continue
+			}
+			if o.readOnly && expR.Less(o.e.timestamp) {
+				expR = o.e.timestamp
+			} else if !o.readOnly && expW.Less(o.e.timestamp) {
+				expW = o.e.timestamp
+			}
+		}
+		if r, w := s.getMax(start, end, txnMD5); !r.Equal(expR) || !w.Equal(expW) {
+			t.Fatalf("%d: [%s, %s) expected %s %s; got %s %s", i, start, end, expR, expW, r, w)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+const (
+	// DefaultLivenessThreshold is the duration for which a heartbeat
+	// keeps a node live.
+	DefaultLivenessThreshold = 9 * time.Second
+
+	// maxNodes bounds the number of liveness records read at once.
+	maxNodes = 10000
+)
+
+// NodeLiveness heartbeats the liveness record of the local node and
+// caches the liveness records of all nodes, which are refreshed after
+// each heartbeat. Unlike the node descriptors in gossip, which expire
+// long after a node has stopped and whose propagation is unbounded,
+// the records bound the time until which each node was known to be up
+// by the expiration of its last heartbeat.
+type NodeLiveness struct {
+	db        *client.KV
+	clock     *hlc.Clock
+	threshold time.Duration // Duration for which a heartbeat keeps a node live
+	interval  time.Duration // Interval between heartbeats
+	mu        sync.Mutex
+	nodeID    proto.NodeID
+	nodes     map[proto.NodeID]proto.Liveness
+}
+
+// NewNodeLiveness returns a new instance of NodeLiveness whose
+// heartbeats keep a node live for the supplied threshold. Heartbeats
+// are sent at half the threshold, so that a single delayed heartbeat
+// doesn't let the node's liveness expire.
+func NewNodeLiveness(db *client.KV, clock *hlc.Clock, threshold time.Duration) *NodeLiveness {
+	return &NodeLiveness{
+		db:        db,
+		clock:     clock,
+		threshold: threshold,
+		interval:  threshold / 2,
+		nodes:     map[proto.NodeID]proto.Liveness{},
+	}
+}
+
+// Start begins heartbeating the liveness record of the specified local
+// node, refreshing the cached records after each heartbeat.
+func (nl *NodeLiveness) Start(nodeID proto.NodeID, stopper *util.Stopper) {
+	nl.mu.Lock()
+	nl.nodeID = nodeID
+	nl.mu.Unlock()
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(nl.interval)
+		defer ticker.Stop()
+		for {
+			if err := nl.Heartbeat(); err != nil {
+				log.Warningf("failed to heartbeat liveness of node %d: %s", nodeID, err)
+			}
+			if err := nl.Refresh(); err != nil {
+				log.Warningf("failed to refresh node liveness: %s", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// Heartbeat extends the expiration of the local node's liveness record
+// by the liveness threshold, creating the record at epoch 1 if none
+// exists. The epoch, which may have been incremented by another node,
+// is left unchanged.
+func (nl *NodeLiveness) Heartbeat() error {
+	nl.mu.Lock()
+	nodeID := nl.nodeID
+	nl.mu.Unlock()
+	if nodeID == 0 {
+		return util.Errorf("node liveness has not been started")
+	}
+	return nl.update(nodeID, func(l *proto.Liveness) {
+		if l.Epoch == 0 {
+			l.Epoch = 1
+		}
+		l.Expiration = nl.clock.Now().Add(nl.threshold.Nanoseconds(), 0)
+	})
+}
+
+// IncrementEpoch increments the epoch of the supplied liveness record
+// of another node, which must have expired. It fails if the record was
+// changed since it was read, for instance by a heartbeat.
+func (nl *NodeLiveness) IncrementEpoch(l proto.Liveness) error {
+	if nl.isLive(l) {
+		return util.Errorf("cannot increment epoch of node %d, which is live", l.NodeID)
+	}
+	newL := l
+	newL.Epoch++
+	return nl.cput(l.NodeID, &l, &newL)
+}
+
+// SetDecommissioning sets or clears the decommissioning flag of the
+// specified node's liveness record, preventing or again allowing the
+// allocation of replicas to the node.
+func (nl *NodeLiveness) SetDecommissioning(nodeID proto.NodeID, decommissioning bool) error {
+	return nl.update(nodeID, func(l *proto.Liveness) {
+		l.Decommissioning = decommissioning
+	})
+}
+
+// update applies the supplied change to the specified node's liveness
+// record, starting from the cached record and retrying against the
+// stored one if the two differ.
+func (nl *NodeLiveness) update(nodeID proto.NodeID, change func(*proto.Liveness)) error {
+	nl.mu.Lock()
+	cached, ok := nl.nodes[nodeID]
+	nl.mu.Unlock()
+	var old *proto.Liveness
+	if ok {
+		old = &cached
+	}
+	for retries := 0; ; retries++ {
+		newL := proto.Liveness{NodeID: nodeID}
+		if old != nil {
+			newL = *old
+		}
+		change(&newL)
+		err := nl.cput(nodeID, old, &newL)
+		cErr, ok := err.(*proto.ConditionFailedError)
+		if !ok || retries > 0 {
+			return err
+		}
+		// The cached record was stale; retry against the stored one.
+		old = nil
+		if cErr.ActualValue != nil {
+			actual := &proto.Liveness{}
+			if err := gogoproto.Unmarshal(cErr.ActualValue.Bytes, actual); err != nil {
+				return err
+			}
+			old = actual
+		}
+	}
+}
+
+// cput writes the new liveness record of the specified node, provided
+// the stored record equals old, or doesn't exist if old is nil. The
+// cache is updated with the stored record on success and failure.
+func (nl *NodeLiveness) cput(nodeID proto.NodeID, old, newL *proto.Liveness) error {
+	newBytes, err := gogoproto.Marshal(newL)
+	if err != nil {
+		return err
+	}
+	var expValue *proto.Value
+	if old != nil {
+		oldBytes, err := gogoproto.Marshal(old)
+		if err != nil {
+			return err
+		}
+		expValue = &proto.Value{Bytes: oldBytes}
+	}
+	key := keys.NodeLivenessKey(int32(nodeID))
+	value := proto.Value{Bytes: newBytes}
+	value.InitChecksum(key)
+	err = nl.db.Run(client.Call{
+		Args: &proto.ConditionalPutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:      key,
+				User:     UserRoot,
+				Priority: proto.SYSTEM,
+			},
+			Value:    value,
+			ExpValue: expValue,
+		},
+		Reply: &proto.ConditionalPutResponse{},
+	})
+	if err == nil {
+		nl.setCached(*newL)
+	} else if cErr, ok := err.(*proto.ConditionFailedError); ok && cErr.ActualValue != nil {
+		var actual proto.Liveness
+		if uErr := gogoproto.Unmarshal(cErr.ActualValue.Bytes, &actual); uErr == nil {
+			nl.setCached(actual)
+		}
+	}
+	return err
+}
+
+// Refresh replaces the cached liveness records with those stored.
+func (nl *NodeLiveness) Refresh() error {
+	call := client.ScanCall(keys.KeyNodeLivenessPrefix, keys.KeyNodeLivenessPrefix.PrefixEnd(), maxNodes)
+	call.Args.Header().Priority = proto.SYSTEM
+	if err := nl.db.Run(call); err != nil {
+		return err
+	}
+	nodes := map[proto.NodeID]proto.Liveness{}
+	for _, kv := range call.Reply.(*proto.ScanResponse).Rows {
+		var l proto.Liveness
+		if err := gogoproto.Unmarshal(kv.Value.Bytes, &l); err != nil {
+			return util.Errorf("unable to unmarshal liveness record %s: %s", kv.Key, err)
+		}
+		nodes[l.NodeID] = l
+	}
+	nl.mu.Lock()
+	nl.nodes = nodes
+	nl.mu.Unlock()
+	return nil
+}
+
+func (nl *NodeLiveness) setCached(l proto.Liveness) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+	nl.nodes[l.NodeID] = l
+}
+
+// GetLiveness returns the cached liveness record of the specified
+// node, or false if none is cached.
+func (nl *NodeLiveness) GetLiveness(nodeID proto.NodeID) (proto.Liveness, bool) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+	l, ok := nl.nodes[nodeID]
+	return l, ok
+}
+
+// IsLive returns whether the specified node's cached liveness record
+// has yet to expire. Nodes without a cached record are not live.
+func (nl *NodeLiveness) IsLive(nodeID proto.NodeID) bool {
+	l, ok := nl.GetLiveness(nodeID)
+	return ok && nl.isLive(l)
+}
+
+// isLive returns whether the liveness record has yet to expire.
+func (nl *NodeLiveness) isLive(l proto.Liveness) bool {
+	return nl.clock.Now().Less(l.Expiration)
+}
+
+// allowsAllocation returns whether replicas may be allocated to the
+// specified node. Nodes whose cached record has expired or which are
+// decommissioning are excluded; nodes without a cached record, whose
+// first heartbeat may not have been seen yet, are not. A nil
+// NodeLiveness allows all nodes.
+func (nl *NodeLiveness) allowsAllocation(nodeID proto.NodeID) bool {
+	if nl == nil {
+		return true
+	}
+	l, ok := nl.GetLiveness(nodeID)
+	return !ok || (nl.isLive(l) && !l.Decommissioning)
+}
@@ -0,0 +1,234 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestNodeLivenessHeartbeat verifies that heartbeats keep a node
+// live, that the epoch of an expired node may be incremented by
+// another node, and that the node's next heartbeat retains it.
+func TestNodeLivenessHeartbeat(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	nl := NewNodeLiveness(store.DB(), store.ctx.Clock, time.Second)
+	if err := nl.Heartbeat(); err == nil {
+		t.Fatal("expected heartbeat to fail before the node ID is known")
+	}
+	nl.nodeID = 1
+	if err := nl.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if !nl.IsLive(1) {
+		t.Fatal("expected node 1 to be live after heartbeat")
+	}
+	if nl.IsLive(2) {
+		t.Fatal("expected node 2 without liveness record not to be live")
+	}
+	l, _ := nl.GetLiveness(1)
+	if l.Epoch != 1 {
+		t.Errorf("expected epoch 1; got %d", l.Epoch)
+	}
+	if err := nl.IncrementEpoch(l); err == nil {
+		t.Error("expected failure incrementing the epoch of a live node")
+	}
+
+	// Let the liveness expire and have another node increment its
+	// epoch, using its own view of the record.
+	manual.Set(int64(2 * time.Second))
+	if nl.IsLive(1) {
+		t.Fatal("expected node 1 not to be live after its liveness expired")
+	}
+	other := NewNodeLiveness(store.DB(), store.ctx.Clock, time.Second)
+	if err := other.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	otherL, ok := other.GetLiveness(1)
+	if !ok || !otherL.Expiration.Equal(l.Expiration) {
+		t.Fatalf("expected refreshed record %+v; got %+v", l, otherL)
+	}
+	if err := other.IncrementEpoch(otherL); err != nil {
+		t.Fatal(err)
+	}
+	// A second increment based on the stale record fails.
+	if _, ok := other.IncrementEpoch(otherL).(*proto.ConditionFailedError); !ok {
+		t.Error("expected condition failure incrementing the epoch from a stale record")
+	}
+
+	// The node's heartbeat, based on its stale cached record, retries
+	// against the stored record and retains the incremented epoch.
+	if err := nl.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if l, _ = nl.GetLiveness(1); l.Epoch != 2 || !nl.IsLive(1) {
+		t.Errorf("expected node 1 to be live with epoch 2; got %+v", l)
+	}
+}
+
+// TestNodeLivenessAllowsAllocation verifies that replicas aren't
+// allocated to nodes which are dead or decommissioning.
+func TestNodeLivenessAllowsAllocation(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	var nilLiveness *NodeLiveness
+	if !nilLiveness.allowsAllocation(1) {
+		t.Error("expected nil liveness to allow allocation")
+	}
+	nl := NewNodeLiveness(store.DB(), store.ctx.Clock, time.Second)
+	if !nl.allowsAllocation(1) {
+		t.Error("expected allocation to node without liveness record to be allowed")
+	}
+	nl.nodeID = 1
+	if err := nl.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if !nl.allowsAllocation(1) {
+		t.Error("expected allocation to live node to be allowed")
+	}
+	if err := nl.SetDecommissioning(1, true); err != nil {
+		t.Fatal(err)
+	}
+	if nl.allowsAllocation(1) {
+		t.Error("expected allocation to decommissioning node to be refused")
+	}
+	// Heartbeats leave the decommissioning flag set.
+	if err := nl.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if nl.allowsAllocation(1) {
+		t.Error("expected allocation to decommissioning node to be refused after heartbeat")
+	}
+	if err := nl.SetDecommissioning(1, false); err != nil {
+		t.Fatal(err)
+	}
+	if !nl.allowsAllocation(1) {
+		t.Error("expected allocation to recommissioned node to be allowed")
+	}
+	manual.Set(int64(2 * time.Second))
+	if nl.allowsAllocation(1) {
+		t.Error("expected allocation to dead node to be refused")
+	}
+}
+
+// TestEpochLeaderLease verifies that leader leases are epoch-based
+// once the node is live, that commands are redirected to the holder of
+// a valid lease, that taking over the lease of a node whose liveness
+// expired increments its epoch once the lease is granted, and that a
+// lapsed lease is acquired anew by the next command.
+func TestEpochLeaderLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+	rng := store.LookupRange(keys.KeyMin, nil)
+
+	// Without node liveness, leases are expiration-based.
+	lease := rng.newLeaderLease(1)
+	if lease.Epoch != 0 || lease.Expiration == 0 {
+		t.Fatalf("expected expiration-based lease; got %+v", lease)
+	}
+	if !rng.leaseValid(&lease) {
+		t.Error("expected unexpired lease to be valid")
+	}
+
+	nl := NewNodeLiveness(store.DB(), store.ctx.Clock, time.Second)
+	nl.nodeID = 1
+	store.ctx.NodeLiveness = nl
+	other := NewNodeLiveness(store.DB(), store.ctx.Clock, time.Second)
+	other.nodeID = 2
+	if err := other.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Let node 2's liveness expire while node 1 keeps heartbeating.
+	manual.Set(int64(2 * time.Second))
+	if err := nl.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if err := nl.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Commands are redirected while another node holds a valid lease.
+	nodeID2 := uint64(MakeRaftNodeID(2, 2))
+	rng.setLease(&proto.Lease{Term: 1, RaftNodeID: nodeID2, Expiration: store.ctx.Clock.PhysicalNow() + int64(time.Second)})
+	if _, ok := rng.redirectOnOrAcquireLeaderLease().(*proto.NotLeaderError); !ok {
+		t.Error("expected commands to be redirected to the lease holder")
+	}
+
+	prev := &proto.Lease{Term: 1, RaftNodeID: nodeID2, Epoch: 1}
+	rng.setLease(prev)
+	if rng.leaseValid(prev) {
+		t.Error("expected lease of node with expired liveness to be invalid")
+	}
+	lease = rng.newLeaderLease(2)
+	if lease.Epoch != 1 || lease.Expiration != 0 || lease.RaftNodeID != uint64(store.RaftNodeID()) {
+		t.Fatalf("expected epoch-based lease for node 1; got %+v", lease)
+	}
+	// Computing the lease leaves the previous holder's epoch alone;
+	// taking the lease over increments it once the lease is granted.
+	if l, _ := nl.GetLiveness(2); l.Epoch != 1 {
+		t.Errorf("expected epoch 1 for node 2; got %d", l.Epoch)
+	}
+	<-rng.requestLeaderLease(2)
+	util.SucceedsWithin(t, time.Second, func() error {
+		if l := rng.getLease(); l.RaftNodeID != uint64(store.RaftNodeID()) || !rng.leaseValid(l) {
+			return util.Errorf("expected valid lease for node 1; got %+v", l)
+		}
+		if l, _ := nl.GetLiveness(2); l.Epoch != 2 {
+			return util.Errorf("expected epoch 2 for node 2; got %d", l.Epoch)
+		}
+		return nil
+	})
+
+	// The lease lapses along with the node's liveness, and is not
+	// revived by a heartbeat once the epoch has been incremented.
+	manual.Set(int64(4 * time.Second))
+	lease = *rng.getLease()
+	if rng.leaseValid(&lease) {
+		t.Error("expected epoch-based lease to be invalid after liveness expired")
+	}
+	// The next command acquires a lease anew, expiration-based while
+	// the node isn't live.
+	if err := rng.redirectOnOrAcquireLeaderLease(); err != nil {
+		t.Fatal(err)
+	}
+	if l := rng.getLease(); l.Epoch != 0 || !rng.leaseValid(l) {
+		t.Errorf("expected valid expiration-based lease; got %+v", l)
+	}
+	l, _ := nl.GetLiveness(1)
+	if err := other.IncrementEpoch(l); err != nil {
+		t.Fatal(err)
+	}
+	if err := nl.Heartbeat(); err != nil {
+		t.Fatal(err)
+	}
+	if rng.leaseValid(&lease) {
+		t.Error("expected epoch-based lease to be invalid after epoch increment")
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/audit"
+)
+
+// VerifyPermissions verifies that the requesting user (header.User)
+// has permission to read/write (capabilities depend on method
+// name). In the event that multiple permission configs apply to the
+// key range implicated by the command, the lowest common denominator
+// for permission. For example, if a scan crosses two permission
+// configs, both configs must allow read permissions or the entire
+// scan will fail. The root user may execute any request; only root
+// may execute admin requests. Denied requests are audited.
+func VerifyPermissions(args proto.Request, permMap PrefixConfigMap) error {
+	err := verifyPermissions(args, permMap)
+	if err != nil {
+		audit.LogRequest(audit.EventPermissionDenied, args, err)
+	}
+	return err
+}
+
+func verifyPermissions(args proto.Request, permMap PrefixConfigMap) error {
+	// The root user can always proceed.
+	header := args.Header()
+	if header.User == UserRoot {
+		return nil
+	}
+	// Check for admin methods.
+	if proto.IsAdmin(args) {
+		if header.User != UserRoot {
+			return util.Errorf("user %q cannot invoke admin command %s", header.User, args.Method())
+		}
+		return nil
+	}
+	if permMap == nil {
+		return util.Errorf("perm configs not available via gossip; cannot execute %s", args.Method())
+	}
+	headerEnd := header.EndKey
+	if headerEnd == nil {
+		headerEnd = header.Key
+	}
+	// Visit PermConfig(s) which apply to the method's key range.
+	//   - For each perm config which the range covers, verify read or writes
+	//     are allowed as method requires.
+	//   - Verify the permissions hierarchically; that is, if permissions aren't
+	//     granted at the longest prefix, try next longest, then next, etc., up
+	//     to and including the default prefix.
+	//
+	// TODO(spencer): it might make sense to visit prefixes from the
+	//   shortest to longest instead for performance. Keep an eye on profiling
+	//   for this code path as permission sets grow large.
+	return permMap.VisitPrefixes(header.Key, headerEnd,
+		func(start, end proto.Key, config interface{}) (bool, error) {
+			hasPerm := false
+			permMap.VisitPrefixesHierarchically(start, func(start, end proto.Key, config interface{}) (bool, error) {
+				perm := config.(*proto.PermConfig)
+				if proto.IsRead(args) && !perm.CanRead(header.User) {
+					return false, nil
+				}
+				if proto.IsWrite(args) && !perm.CanWrite(header.User) {
+					return false, nil
+				}
+				// Return done = true, as permissions have been granted by this config.
+				hasPerm = true
+				return true, nil
+			})
+			if !hasPerm {
+				return false, util.Errorf("user %q cannot invoke %s at %q-%q",
+					header.User, args.Method(), start, end)
+			}
+			return false, nil
+		})
+}
+
+// checkPermissions verifies the permissions of the requesting user
+// against the permission configs last received via gossip. Nodes
+// reject requests which don't specify a user, so those without one
+// are issued by the cluster itself and execute as the root user.
+func (r *Range) checkPermissions(args proto.Request) error {
+	if args.Header().User == "" {
+		args.Header().User = UserRoot
+	}
+	if r.rm.Gossip() == nil {
+		return nil
+	}
+	var permMap PrefixConfigMap
+	if cfg, err := GetSystemConfig(r.rm.Gossip()); err == nil {
+		permMap = cfg.Permissions
+	}
+	return VerifyPermissions(args, permMap)
+}
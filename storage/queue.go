@@ -26,12 +26,14 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metrics"
 )
 
 // A rangeItem holds a range and its priority for use with a priority queue.
 type rangeItem struct {
 	value    *Range
 	priority float64
+	added    time.Time // The time at which the range was queued
 	// The index is needed by update and is maintained by the heap.Interface methods.
 	index int // The index of the item in the heap.
 }
@@ -100,6 +102,8 @@ type baseQueue struct {
 	sync.Mutex                      // Mutex protects priorityQ and ranges
 	priorityQ  priorityQueue        // The priority queue
 	ranges     map[int64]*rangeItem // Map from RaftID to rangeItem (for updating priority)
+	pacer      *queuePacer          // Paces processing; nil if unpaced
+	activity   *queueActivity       // Records processed ranges, if set
 }
 
 // newBaseQueue returns a new instance of baseQueue with the
@@ -125,6 +129,53 @@ func (bq *baseQueue) Length() int {
 	return bq.priorityQ.Len()
 }
 
+// OldestAge returns how long the range queued longest has been in the
+// queue, or zero if the queue is empty.
+func (bq *baseQueue) OldestAge(now time.Time) time.Duration {
+	bq.Lock()
+	defer bq.Unlock()
+	var oldest time.Time
+	for _, item := range bq.priorityQ {
+		if oldest.IsZero() || item.added.Before(oldest) {
+			oldest = item.added
+		}
+	}
+	if oldest.IsZero() {
+		return 0
+	}
+	return now.Sub(oldest)
+}
+
+// setPacer sets the pacer shared by the queue with the store's other
+// queues. This method may only be called before Start().
+func (bq *baseQueue) setPacer(pacer *queuePacer) {
+	bq.pacer = pacer
+}
+
+// setActivity sets the activity in which the queue records each range
+// it processes. This method may only be called before Start().
+func (bq *baseQueue) setActivity(activity *queueActivity) {
+	bq.activity = activity
+}
+
+// registerMetrics registers gauges of the queue's depth and the age of
+// its oldest range in seconds with the supplied metric system, named
+// with the given prefix. They are deregistered when the stopper stops.
+func (bq *baseQueue) registerMetrics(ms *metrics.MetricSystem, prefix string, stopper *util.Stopper) {
+	depthName, ageName := prefix+".depth", prefix+".age"
+	ms.RegisterGaugeFunc(depthName, func() float64 {
+		return float64(bq.Length())
+	})
+	ms.RegisterGaugeFunc(ageName, func() float64 {
+		return bq.OldestAge(time.Now()).Seconds()
+	})
+	stopper.RunWorker(func() {
+		<-stopper.ShouldStop()
+		ms.DeregisterGaugeFunc(depthName)
+		ms.DeregisterGaugeFunc(ageName)
+	})
+}
+
 // Start launches a goroutine to process entries in the queue. The
 // provided stopper is used to finish processing.
 func (bq *baseQueue) Start(clock *hlc.Clock, stopper *util.Stopper) {
@@ -152,7 +203,7 @@ func (bq *baseQueue) MaybeAdd(rng *Range, now proto.Timestamp) {
 	}
 
 	log.Infof("adding range %s to %s queue", rng, bq.name)
-	item = &rangeItem{value: rng, priority: priority}
+	item = &rangeItem{value: rng, priority: priority, added: time.Now()}
 	heap.Push(&bq.priorityQ, item)
 	bq.ranges[rng.Desc().RaftID] = item
 
@@ -176,7 +227,8 @@ func (bq *baseQueue) MaybeRemove(rng *Range) {
 }
 
 // process processes the entries in the queue until the provided
-// stopper signals exit.
+// stopper signals exit. Before each range is processed, the queue
+// waits as long as its pacer requires.
 //
 // TODO(spencer): current load should factor into range processing timer.
 func (bq *baseQueue) processLoop(clock *hlc.Clock, stopper *util.Stopper) {
@@ -186,6 +238,8 @@ func (bq *baseQueue) processLoop(clock *hlc.Clock, stopper *util.Stopper) {
 		// returns a short duration but the priority queue is empty.
 		emptyQueue := true
 		nextTime := time.Now().Add(24 * time.Hour)
+		// paced is set once the pacer has admitted the next range.
+		paced := false
 
 		for {
 			select {
@@ -202,16 +256,31 @@ func (bq *baseQueue) processLoop(clock *hlc.Clock, stopper *util.Stopper) {
 					continue
 				}
 				start := time.Now()
+				if !paced && bq.Length() > 0 {
+					if wait := bq.pacer.reserve(bq.name, start); wait > 0 {
+						// Hold the range back until the pacer admits it,
+						// while still accepting incoming ranges.
+						paced = true
+						nextTime = start.Add(wait)
+						stopper.FinishTask()
+						continue
+					}
+				}
+				paced = false
 				nextTime = start.Add(bq.impl.timer())
 				bq.Lock()
 				rng := bq.pop()
 				bq.Unlock()
 				if rng != nil {
 					log.Infof("processing range %s from %s queue...", rng, bq.name)
+					event := QueueEvent{Queue: bq.name, Time: start}
 					if err := bq.impl.process(clock.Now(), rng); err != nil {
 						log.Errorf("failure processing range %s from %s queue: %s", rng, bq.name, err)
+						event.Error = err.Error()
 					}
-					log.Infof("processed range %s from %s queue in %s", rng, bq.name, time.Now().Sub(start))
+					event.Duration = time.Now().Sub(start)
+					bq.activity.record(rng.Desc().RaftID, event)
+					log.Infof("processed range %s from %s queue in %s", rng, bq.name, event.Duration)
 				}
 				if bq.Length() == 0 {
 					emptyQueue = true
@@ -240,6 +309,7 @@ func (bq *baseQueue) pop() *Range {
 	}
 	item := heap.Pop(&bq.priorityQ).(*rangeItem)
 	delete(bq.ranges, item.value.Desc().RaftID)
+	metrics.Metrics.Histogram("storage.queue."+bq.name+".wait", float64(time.Since(item.added)))
 	return item.value
 }
 
@@ -0,0 +1,83 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"sync"
+	"time"
+)
+
+// queueActivitySize is the number of a store's most recent queue
+// events retained for inspection.
+const queueActivitySize = 1000
+
+// A QueueEvent describes the processing of a replica by one of the
+// store's queues.
+type QueueEvent struct {
+	Queue    string        `json:"queue"`
+	Time     time.Time     `json:"time"`
+	Duration time.Duration `json:"duration"`
+	// Error describes why processing failed, if it did.
+	Error string `json:"error,omitempty"`
+}
+
+// queueActivity retains the most recent events of a store's queues.
+// A nil queueActivity records nothing.
+type queueActivity struct {
+	mu     sync.Mutex
+	events [queueActivitySize]struct {
+		raftID int64
+		QueueEvent
+	}
+	next  int // Index at which the next event is recorded
+	count int // Number of events recorded, up to queueActivitySize
+}
+
+// record records the event of the range with the specified Raft ID,
+// replacing the oldest event once the activity is full.
+func (qa *queueActivity) record(raftID int64, event QueueEvent) {
+	if qa == nil {
+		return
+	}
+	qa.mu.Lock()
+	defer qa.mu.Unlock()
+	qa.events[qa.next].raftID = raftID
+	qa.events[qa.next].QueueEvent = event
+	qa.next = (qa.next + 1) % queueActivitySize
+	if qa.count < queueActivitySize {
+		qa.count++
+	}
+}
+
+// forRange returns the retained events of the range with the specified
+// Raft ID, oldest first.
+func (qa *queueActivity) forRange(raftID int64) []QueueEvent {
+	if qa == nil {
+		return nil
+	}
+	qa.mu.Lock()
+	defer qa.mu.Unlock()
+	var events []QueueEvent
+	for i := 0; i < qa.count; i++ {
+		e := &qa.events[(qa.next-qa.count+i+queueActivitySize)%queueActivitySize]
+		if e.raftID == raftID {
+			events = append(events, e.QueueEvent)
+		}
+	}
+	return events
+}
@@ -0,0 +1,62 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestQueueActivity verifies that the events of each range are
+// returned oldest first and that the oldest events are replaced once
+// the activity is full.
+func TestQueueActivity(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var nilActivity *queueActivity
+	nilActivity.record(1, QueueEvent{Queue: "gc"})
+	if events := nilActivity.forRange(1); len(events) != 0 {
+		t.Errorf("expected no events of a nil activity; got %+v", events)
+	}
+
+	qa := &queueActivity{}
+	start := time.Unix(0, 0)
+	for i := 0; i < queueActivitySize+10; i++ {
+		qa.record(int64(i%2+1), QueueEvent{Queue: "gc", Time: start.Add(time.Duration(i))})
+	}
+	for raftID := int64(1); raftID <= 2; raftID++ {
+		events := qa.forRange(raftID)
+		if len(events) != queueActivitySize/2 {
+			t.Fatalf("range %d: expected %d events; got %d", raftID, queueActivitySize/2, len(events))
+		}
+		// The first 10 events were replaced.
+		if first := events[0].Time.Sub(start); first != time.Duration(10+raftID-1) {
+			t.Errorf("range %d: expected oldest event at %d; got %d", raftID, 10+raftID-1, first)
+		}
+		for i := 1; i < len(events); i++ {
+			if !events[i-1].Time.Before(events[i].Time) {
+				t.Errorf("range %d: events out of order at %d: %+v", raftID, i, events[i-1:i+1])
+				break
+			}
+		}
+	}
+	if events := qa.forRange(3); len(events) != 0 {
+		t.Errorf("expected no events of range 3; got %+v", events)
+	}
+}
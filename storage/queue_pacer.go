@@ -0,0 +1,112 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"sync"
+	"time"
+)
+
+// Priorities of the store's queues for the processing rate they
+// share. A queue only waits for ranges processed by queues of an equal
+// or higher priority, so that background work such as verification
+// yields to the work keeping the store's ranges replicated and sized.
+const (
+	queuePriorityLow = iota
+	queuePriorityNormal
+	queuePriorityHigh
+	numQueuePriorities
+)
+
+// A queuePacing configures the pacing of a queue by a queuePacer.
+type queuePacing struct {
+	priority        int
+	rangesPerSecond int64 // Limit of the queue's own rate; zero means none
+}
+
+// queuePacings holds the pacing of the store's queues by name. Queues
+// which aren't listed run at low priority without a limit of their
+// own.
+var queuePacings = map[string]queuePacing{
+	"replicate":   {priority: queuePriorityHigh},
+	"split":       {priority: queuePriorityHigh},
+	"gc":          {priority: queuePriorityNormal, rangesPerSecond: 10},
+	"stats":       {priority: queuePriorityLow, rangesPerSecond: 5},
+	"verify":      {priority: queuePriorityLow, rangesPerSecond: 5},
+	"consistency": {priority: queuePriorityLow, rangesPerSecond: 5},
+}
+
+// A queuePacer is shared by the queues of a store to limit the total
+// rate at which they process ranges, so that background processing on
+// a store with many ranges can't consume all of its I/O. Each queue is
+// additionally limited to the rate of its queuePacing. A nil
+// queuePacer doesn't limit the queues.
+type queuePacer struct {
+	rangesPerSecond int64 // Limit of the total rate; zero means none
+
+	mu sync.Mutex
+	// next holds the time at which the pacer next admits a range of
+	// each priority, following the ranges admitted so far at that
+	// priority and above. queueNext holds the time at which each queue
+	// by name next admits a range.
+	next      [numQueuePriorities]time.Time
+	queueNext map[string]time.Time
+}
+
+// newQueuePacer returns a queuePacer limiting the queues to the given
+// total rate. Zero disables the limit of the total rate, leaving only
+// those of the individual queues.
+func newQueuePacer(rangesPerSecond int64) *queuePacer {
+	return &queuePacer{
+		rangesPerSecond: rangesPerSecond,
+		queueNext:       map[string]time.Time{},
+	}
+}
+
+// reserve accounts for the processing of a range by the named queue
+// and returns how long the queue must wait before processing it.
+func (p *queuePacer) reserve(name string, now time.Time) time.Duration {
+	if p == nil {
+		return 0
+	}
+	pacing := queuePacings[name]
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	start := now
+	if next := p.queueNext[name]; next.After(start) {
+		start = next
+	}
+	if p.rangesPerSecond > 0 {
+		if next := p.next[pacing.priority]; next.After(start) {
+			start = next
+		}
+		// Ranges processed at this priority delay those of lower
+		// priorities as well.
+		interval := time.Second / time.Duration(p.rangesPerSecond)
+		for i := 0; i <= pacing.priority; i++ {
+			if p.next[i].Before(start) {
+				p.next[i] = start
+			}
+			p.next[i] = p.next[i].Add(interval)
+		}
+	}
+	if pacing.rangesPerSecond > 0 {
+		p.queueNext[name] = start.Add(time.Second / time.Duration(pacing.rangesPerSecond))
+	}
+	return start.Sub(now)
+}
@@ -0,0 +1,70 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestQueuePacerReserve verifies that the pacer spaces out the ranges
+// of each priority according to the total rate, that lower priorities
+// yield to higher ones, and that queues are held to their own rates.
+func TestQueuePacerReserve(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	now := time.Unix(0, 0)
+
+	var nilPacer *queuePacer
+	if wait := nilPacer.reserve("gc", now); wait != 0 {
+		t.Errorf("expected a nil pacer not to wait; got %s", wait)
+	}
+
+	p := newQueuePacer(10)
+	testCases := []struct {
+		name string
+		wait time.Duration
+	}{
+		{"split", 0},
+		{"split", 100 * time.Millisecond},
+		// Low priority queues wait for all ranges reserved before them.
+		{"verify", 200 * time.Millisecond},
+		// High priority queues don't wait for lower priority ones.
+		{"replicate", 200 * time.Millisecond},
+		// Normal priority queues wait for high priority ones only.
+		{"gc", 300 * time.Millisecond},
+		{"verify", 500 * time.Millisecond},
+	}
+	for i, test := range testCases {
+		if wait := p.reserve(test.name, now); wait != test.wait {
+			t.Errorf("%d: expected %s queue to wait %s; got %s", i, test.name, test.wait, wait)
+		}
+	}
+
+	// Without a total rate, only the queues' own rates apply.
+	p = newQueuePacer(0)
+	for i, expWait := range []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond} {
+		if wait := p.reserve("gc", now); wait != expWait {
+			t.Errorf("%d: expected gc queue to wait %s; got %s", i, expWait, wait)
+		}
+		if wait := p.reserve("split", now); wait != 0 {
+			t.Errorf("%d: expected split queue not to wait; got %s", i, wait)
+		}
+	}
+}
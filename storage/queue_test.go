@@ -243,3 +243,44 @@ func TestBaseQueueAddRemove(t *testing.T) {
 		t.Errorf("expected processed count of 0; got %d", pc)
 	}
 }
+
+// TestBaseQueuePacing verifies that a queue waits for its pacer before
+// processing each range, and reports the age of its oldest range.
+func TestBaseQueuePacing(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testQueue := &testQueueImpl{
+		shouldQueueFn: func(now proto.Timestamp, r *Range) (shouldQueue bool, priority float64) {
+			return true, float64(r.Desc().RaftID)
+		},
+	}
+	bq := newBaseQueue("test", testQueue, 10)
+	bq.setPacer(newQueuePacer(20))
+	if age := bq.OldestAge(time.Now()); age != 0 {
+		t.Errorf("expected empty queue to report no age; got %s", age)
+	}
+	stopper := util.NewStopper()
+	mc := hlc.NewManualClock(0)
+	clock := hlc.NewClock(mc.UnixNano)
+	bq.Start(clock, stopper)
+	defer stopper.Stop()
+
+	const count = 3
+	start := time.Now()
+	for i := 1; i <= count; i++ {
+		r := &Range{}
+		r.SetDesc(&proto.RangeDescriptor{RaftID: int64(i)})
+		bq.MaybeAdd(r, proto.ZeroTimestamp)
+	}
+	if age := bq.OldestAge(time.Now()); age == 0 {
+		t.Error("expected queued ranges to report their age")
+	}
+	if err := util.IsTrueWithin(func() bool {
+		return atomic.LoadInt32(&testQueue.processed) == count
+	}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	// At 20 ranges per second, the last range waits 100ms for the pacer.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected pacing to take at least 100ms; took %s", elapsed)
+	}
+}
@@ -22,37 +22,47 @@ package storage
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/gob"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"code.google.com/p/go-uuid/uuid"
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/audit"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/trace"
 	"github.com/coreos/etcd/raft"
 	"github.com/coreos/etcd/raft/raftpb"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 // init pre-registers RangeDescriptor, PrefixConfigMap types and Transaction.
 func init() {
 	gob.Register(StoreDescriptor{})
+	gob.Register([]StoreDescriptor{})
 	gob.Register(PrefixConfigMap{})
 	gob.Register(&proto.AcctConfig{})
 	gob.Register(&proto.PermConfig{})
 	gob.Register(&proto.ZoneConfig{})
 	gob.Register(proto.RangeDescriptor{})
+	gob.Register(FirstRangeDescriptor{})
 	gob.Register(proto.Transaction{})
 }
 
@@ -71,8 +81,27 @@ var (
 	// continually re-gossiped. The replica which is the raft leader of
 	// the first range gossips it.
 	ttlClusterIDGossip = 30 * time.Second
+
+	// ttlFirstRangeReplicaGossip is the time-to-live for the first range
+	// descriptor gossiped by each of its replicas. It's re-gossiped
+	// along with the cluster ID, so infos from replicas which have been
+	// removed eventually expire.
+	ttlFirstRangeReplicaGossip = ttlClusterIDGossip
 )
 
+// FirstRangeDescriptor is the first range descriptor as gossiped by
+// each replica of the first range. Unlike the leader's gossip, it
+// survives the leader going away, but a lagging replica may gossip
+// a stale descriptor. Receivers should keep the descriptor with the
+// highest sequence.
+type FirstRangeDescriptor struct {
+	Desc proto.RangeDescriptor
+	// Sequence is the replica's applied raft index at the time the
+	// descriptor was gossiped. As all replicas share a raft log, it
+	// orders descriptors gossiped by different replicas.
+	Sequence uint64
+}
+
 // TestingCommandFilter may be set in tests to intercept the handling
 // of commands and artificially generate errors. Return true to
 // terminate processing with the filled-in response, or false to
@@ -88,25 +117,32 @@ var TestingCommandFilter func(proto.Request, proto.Response) bool
 // discarded prefix to the log, so we must begin the log at an arbitrary
 // index greater than 1.
 const (
-	raftInitialLogIndex        = 10
-	raftInitialLogTerm         = 5
-	defaultLeaderLeaseDuration = time.Second
+	raftInitialLogIndex = 10
+	raftInitialLogTerm  = 5
+
+	// DefaultLeaderLeaseDuration is the duration of a leader lease.
+	// Tests with a manual clock advance it past the duration to expire
+	// the leases of all ranges.
+	DefaultLeaderLeaseDuration = time.Second
 )
 
 // configDescriptor describes administrative configuration maps
 // affecting ranges of the key-value map by key prefix.
 type configDescriptor struct {
-	keyPrefix proto.Key   // Range key prefix
-	gossipKey string      // Gossip key
-	configI   interface{} // Config struct interface
+	keyPrefix proto.Key                            // Range key prefix
+	configI   interface{}                          // Config struct interface
+	configMap func(*SystemConfig) *PrefixConfigMap // Map within the system config
 }
 
 // configDescriptors is a slice containing the accounting, permissions
 // and zone configuration descriptors.
 var configDescriptors = []*configDescriptor{
-	{engine.KeyConfigAccountingPrefix, gossip.KeyConfigAccounting, proto.AcctConfig{}},
-	{engine.KeyConfigPermissionPrefix, gossip.KeyConfigPermission, proto.PermConfig{}},
-	{engine.KeyConfigZonePrefix, gossip.KeyConfigZone, proto.ZoneConfig{}},
+	{keys.KeyConfigAccountingPrefix, proto.AcctConfig{},
+		func(cfg *SystemConfig) *PrefixConfigMap { return &cfg.Accounting }},
+	{keys.KeyConfigPermissionPrefix, proto.PermConfig{},
+		func(cfg *SystemConfig) *PrefixConfigMap { return &cfg.Permissions }},
+	{keys.KeyConfigZonePrefix, proto.ZoneConfig{},
+		func(cfg *SystemConfig) *PrefixConfigMap { return &cfg.Zones }},
 }
 
 // tsCacheMethods specifies the set of methods which affect the
@@ -116,11 +152,16 @@ var tsCacheMethods = [...]bool{
 	proto.Get:                   true,
 	proto.Put:                   true,
 	proto.ConditionalPut:        true,
+	proto.InitPut:               true,
 	proto.Increment:             true,
 	proto.Scan:                  true,
+	proto.Aggregate:             true,
+	proto.Watch:                 true,
 	proto.Delete:                true,
 	proto.DeleteRange:           true,
 	proto.InternalResolveIntent: true,
+	proto.InternalRefreshRange:  true,
+	proto.Batch:                 true,
 }
 
 // usesTimestampCache returns true if the request affects or is
@@ -133,12 +174,30 @@ func usesTimestampCache(r proto.Request) bool {
 	return tsCacheMethods[m]
 }
 
+// timestampCacheSpans returns the key spans for which the request
+// consults and updates the timestamp cache. A batch uses the spans of
+// its constituent requests.
+func timestampCacheSpans(r proto.Request) []proto.Span {
+	if batch, ok := r.(*proto.BatchRequest); ok {
+		var spans []proto.Span
+		for i := range batch.Requests {
+			if args := batch.Requests[i].GetValue().(proto.Request); usesTimestampCache(args) {
+				spans = append(spans, timestampCacheSpans(args)...)
+			}
+		}
+		return spans
+	}
+	header := r.Header()
+	return []proto.Span{{Key: header.Key, EndKey: header.EndKey}}
+}
+
 // A pendingCmd holds the reply buffer and a done channel for a command
 // sent to Raft. Once committed to the Raft log, the command is
 // executed and the result returned via the done channel.
 type pendingCmd struct {
-	Reply proto.Response
-	done  chan error // Used to signal waiting RPC handler
+	Reply   proto.Response
+	raftCmd proto.InternalRaftCommand // Retained for reproposal
+	done    chan error                // Used to signal waiting RPC handler
 }
 
 // A RangeManager is an interface satisfied by Store through which ranges
@@ -150,10 +209,15 @@ type RangeManager interface {
 	RaftNodeID() multiraft.NodeID
 	Clock() *hlc.Clock
 	Engine() engine.Engine
+	RaftEngine() engine.Engine
 	DB() *client.KV
 	Allocator() *allocator
 	Gossip() *gossip.Gossip
+	AcctUsage() AcctUsage
 	SplitQueue() *splitQueue
+	StoreContext() *StoreContext
+	Stopper() *util.Stopper
+	ReportCorruption(rng *Range, err error)
 
 	// Range manipulation methods.
 	AddRange(rng *Range) error
@@ -162,6 +226,8 @@ type RangeManager interface {
 	NewRangeDescriptor(start, end proto.Key, replicas []proto.Replica) (*proto.RangeDescriptor, error)
 	NewSnapshot() engine.Engine
 	ProposeRaftCommand(cmdIDKey, proto.InternalRaftCommand) <-chan error
+	TransferRaftLeadership(raftID int64, nodeID multiraft.NodeID) error
+	LearnerCaughtUp(raftID int64, nodeID multiraft.NodeID) (bool, error)
 	RemoveRange(rng *Range) error
 	SplitRange(origRng, newRng *Range) error
 
@@ -179,8 +245,13 @@ type Range struct {
 	rm       RangeManager   // Makes some store methods available
 	stats    *rangeStats    // Range statistics
 	maxBytes int64          // Max bytes before split.
+	// Size beyond which writes are held back until split.
+	backpressureBytes int64
 	// Held while a split, merge, or replica change is underway.
 	metaLock sync.Mutex
+	// Held while assigning a lease index and proposing the command, so
+	// that commands reach the Raft log in lease index order.
+	proposeLock sync.Mutex
 	// Last index persisted to the raft log (not necessarily committed).
 	// Updated atomically.
 	lastIndex uint64
@@ -188,14 +259,32 @@ type Range struct {
 	appliedIndex uint64
 	lease        unsafe.Pointer // Information for leader lease
 	stopper      *util.Stopper
+	// Closed once the leader lease request in flight, if any, has
+	// been committed or has failed.
+	pendingLease chan struct{}
 	// TODO(tschottdorf)
 	election chan struct{}
 
-	sync.RWMutex                 // Protects the following fields (and Desc)
-	cmdQ         *CommandQueue   // Enforce at most one command is running per key(s)
-	tsCache      *TimestampCache // Most recent timestamps for keys / key ranges
-	respCache    *ResponseCache  // Provides idempotence for retries
-	pendingCmds  map[cmdIDKey]*pendingCmd
+	sync.RWMutex                  // Protects the following fields (and Desc)
+	cmdQ          *CommandQueue   // Enforce at most one command is running per key(s)
+	tsCache       *TimestampCache // Most recent timestamps for keys / key ranges
+	respCache     *ResponseCache  // Provides idempotence for retries
+	pendingCmds   map[cmdIDKey]*pendingCmd
+	closedTracker *closedTimestampTracker    // Timestamps closed by this replica's proposals
+	closedTS      proto.Timestamp            // Highest closed timestamp applied
+	checksum      rangeChecksum              // Retained between consistency check phases
+	watchers      map[*rangeWatcher]struct{} // Watch commands awaiting writes
+	// Highest lease index of the commands applied, and of those proposed.
+	leaseAppliedIndex      uint64
+	lastAssignedLeaseIndex uint64
+}
+
+// rangeChecksum is a checksum of a replica's range data, computed in
+// the first phase of a consistency check and identified by the check's
+// ChecksumID.
+type rangeChecksum struct {
+	id  []byte
+	sum []byte
 }
 
 var _ multiraft.WriteableGroupStorage = &Range{}
@@ -203,12 +292,14 @@ var _ multiraft.WriteableGroupStorage = &Range{}
 // NewRange initializes the range using the given metadata.
 func NewRange(desc *proto.RangeDescriptor, rm RangeManager) (*Range, error) {
 	r := &Range{
-		rm:          rm,
-		cmdQ:        NewCommandQueue(),
-		tsCache:     NewTimestampCache(rm.Clock()),
-		respCache:   NewResponseCache(desc.RaftID, rm.Engine()),
-		pendingCmds: map[cmdIDKey]*pendingCmd{},
-		election:    make(chan struct{}, 100),
+		rm:            rm,
+		cmdQ:          NewCommandQueue(),
+		tsCache:       NewTimestampCache(rm.Clock()),
+		respCache:     NewResponseCache(desc.RaftID, rm.Engine()),
+		pendingCmds:   map[cmdIDKey]*pendingCmd{},
+		closedTracker: newClosedTimestampTracker(),
+		election:      make(chan struct{}, 100),
+		watchers:      map[*rangeWatcher]struct{}{},
 	}
 	r.SetDesc(desc)
 
@@ -223,6 +314,15 @@ func NewRange(desc *proto.RangeDescriptor, rm RangeManager) (*Range, error) {
 	}
 	atomic.StoreUint64(&r.appliedIndex, appliedIndex)
 
+	if r.leaseAppliedIndex, err = loadLeaseAppliedIndex(r.rm.Engine(), desc.RaftID); err != nil {
+		return nil, err
+	}
+	lease, err := loadLeaderLease(r.rm.Engine(), desc.RaftID)
+	if err != nil {
+		return nil, err
+	}
+	r.setLease(lease)
+
 	if r.stats, err = newRangeStats(desc.RaftID, rm.Engine()); err != nil {
 		return nil, err
 	}
@@ -236,8 +336,8 @@ func (r *Range) String() string {
 }
 
 // start begins gossiping loop in the event this is the first
-// range in the map and gossips config information if the range
-// contains any of the configuration maps.
+// range in the map and gossips the system config if the range
+// contains the system config span.
 func (r *Range) start(stopper *util.Stopper) {
 	r.stopper = stopper
 	// TODO(spencer): gossiping should only commence when the range gains
@@ -245,7 +345,7 @@ func (r *Range) start(stopper *util.Stopper) {
 	// the leader lease.
 	r.maybeGossipClusterID()
 	r.maybeGossipFirstRange()
-	r.maybeGossipConfigs(configDescriptors...)
+	r.maybeGossipSystemConfig()
 	// Only start gossiping if this range is the first range.
 	if r.IsFirstRange() {
 		r.startGossip()
@@ -254,13 +354,23 @@ func (r *Range) start(stopper *util.Stopper) {
 
 // Destroy cleans up all data associated with this range.
 func (r *Range) Destroy() error {
-	var deletes []interface{}
-	iter := newRangeDataIterator(r, r.rm.Engine())
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		deletes = append(deletes, engine.BatchDelete{RawKeyValue: proto.RawKeyValue{Key: iter.Key()}})
-	}
-	return r.rm.Engine().WriteBatch(deletes)
+	return DestroyReplicaData(r.rm.Engine(), r.rm.RaftEngine(), r.Desc())
+}
+
+// hasSeparateRaftEngine returns whether the range's Raft log and
+// HardState are kept in a different engine than its data.
+func (r *Range) hasSeparateRaftEngine() bool {
+	return r.rm.RaftEngine() != r.rm.Engine()
+}
+
+// isRaftLogKey returns whether the MVCC-encoded key belongs to the
+// Raft log or Raft state of the specified range, which are kept in
+// the store's Raft log engine.
+func isRaftLogKey(raftID int64, encKey proto.EncodedKey) bool {
+	key, _, _ := engine.MVCCDecodeKey(encKey)
+	return bytes.HasPrefix(key, keys.RaftLogPrefix(raftID)) ||
+		key.Equal(keys.RaftHardStateKey(raftID)) ||
+		key.Equal(keys.RaftTruncatedStateKey(raftID))
 }
 
 // GetMaxBytes atomically gets the range maximum byte limit.
@@ -276,7 +386,7 @@ func (r *Range) SetMaxBytes(maxBytes int64) {
 
 // IsFirstRange returns true if this is the first range.
 func (r *Range) IsFirstRange() bool {
-	return bytes.Equal(r.Desc().StartKey, engine.KeyMin)
+	return bytes.Equal(r.Desc().StartKey, keys.KeyMin)
 }
 
 // IsLeader returns true if this range replica is the raft leader.
@@ -295,14 +405,18 @@ func (r *Range) getLease() *proto.Lease {
 
 // canServiceCmd returns an error in the event that the range replica
 // cannot service the command as specified. This is of the case in
-// the event that the replica is not the leader.
+// the event that the replica is not the leader or doesn't hold a valid
+// leader lease.
 func (r *Range) canServiceCmd(args proto.Request) error {
 	header := args.Header()
-	if !r.IsLeader() {
-		if !proto.IsReadOnly(args) || header.ReadConsistency == proto.CONSISTENT {
+	if !proto.IsReadOnly(args) || (header.ReadConsistency == proto.CONSISTENT && !r.canServeFollowerRead(header)) {
+		if !r.IsLeader() {
 			// TODO(spencer): when we happen to know the leader, fill it in here via replica.
 			return &proto.NotLeaderError{}
 		}
+		if err := r.redirectOnOrAcquireLeaderLease(); err != nil {
+			return err
+		}
 	}
 	if proto.IsReadOnly(args) {
 		if header.ReadConsistency == proto.CONSENSUS {
@@ -310,6 +424,9 @@ func (r *Range) canServiceCmd(args proto.Request) error {
 		} else if header.ReadConsistency == proto.INCONSISTENT && header.Txn != nil {
 			return util.Errorf("cannot allow inconsistent reads within a transaction")
 		}
+		if err := r.checkGCThreshold(header.Timestamp); err != nil {
+			return err
+		}
 	}
 	if !r.ContainsKeyRange(header.Key, header.EndKey) {
 		return proto.NewRangeKeyMismatchError(header.Key, header.EndKey, r.Desc())
@@ -317,6 +434,46 @@ func (r *Range) canServiceCmd(args proto.Request) error {
 	return nil
 }
 
+// closedTimestamp returns the highest closed timestamp the replica
+// has applied. No writes at or below it are still to be applied.
+func (r *Range) closedTimestamp() proto.Timestamp {
+	r.RLock()
+	defer r.RUnlock()
+	return r.closedTS
+}
+
+// canServeFollowerRead returns whether a consistent read with the
+// given header can be served without being the leader. That's the
+// case for non-transactional reads at a timestamp given by the client
+// which the replica has closed.
+func (r *Range) canServeFollowerRead(header *proto.RequestHeader) bool {
+	return header.Txn == nil && !header.Timestamp.Equal(proto.ZeroTimestamp) &&
+		!r.closedTimestamp().Less(header.Timestamp)
+}
+
+// checkGCThreshold returns an error if a read at the given timestamp
+// may miss values already garbage collected, i.e. if it's further in
+// the past than the GC TTL of the range's zone. Only historical reads,
+// with timestamps older than the clock's max offset, are checked.
+func (r *Range) checkGCThreshold(timestamp proto.Timestamp) error {
+	now := r.rm.Clock().Now()
+	if now.WallTime-timestamp.WallTime <= r.rm.Clock().MaxOffset().Nanoseconds() || r.rm.Gossip() == nil {
+		return nil
+	}
+	policy, err := lookupGCPolicy(r)
+	if err != nil {
+		log.V(1).Infof("unable to check GC threshold of range %d: %s", r.Desc().RaftID, err)
+		return nil
+	}
+	threshold := now
+	threshold.WallTime -= (time.Duration(policy.TTLSeconds) * time.Second).Nanoseconds()
+	if timestamp.Less(threshold) {
+		return util.Errorf("read at %s is below the GC threshold %s of range %d",
+			timestamp, threshold, r.Desc().RaftID)
+	}
+	return nil
+}
+
 // isInitialized is true if we know the metadata of this range, either
 // because we created it or we have received an initial snapshot from
 // another node. It is false when a range has been created in response
@@ -351,18 +508,18 @@ func (r *Range) GetReplica() *proto.Replica {
 // Read-lock the mutex to protect access to Desc, which might be changed
 // concurrently via range split.
 func (r *Range) ContainsKey(key proto.Key) bool {
-	return r.Desc().ContainsKey(engine.KeyAddress(key))
+	return r.Desc().ContainsKey(keys.KeyAddress(key))
 }
 
 // ContainsKeyRange returns whether this range contains the specified
 // key range from start to end.
 func (r *Range) ContainsKeyRange(start, end proto.Key) bool {
-	return r.Desc().ContainsKeyRange(engine.KeyAddress(start), engine.KeyAddress(end))
+	return r.Desc().ContainsKeyRange(keys.KeyAddress(start), keys.KeyAddress(end))
 }
 
 // GetGCMetadata reads the latest GC metadata for this range.
 func (r *Range) GetGCMetadata() (*proto.GCMetadata, error) {
-	key := engine.RangeGCMetadataKey(r.Desc().RaftID)
+	key := keys.RangeGCMetadataKey(r.Desc().RaftID)
 	gcMeta := &proto.GCMetadata{}
 	_, err := engine.MVCCGetProto(r.rm.Engine(), key, proto.ZeroTimestamp, true, nil, gcMeta)
 	if err != nil {
@@ -374,7 +531,7 @@ func (r *Range) GetGCMetadata() (*proto.GCMetadata, error) {
 // GetLastVerificationTimestamp reads the timestamp at which the range's
 // data was last verified.
 func (r *Range) GetLastVerificationTimestamp() (proto.Timestamp, error) {
-	key := engine.RangeLastVerificationTimestampKey(r.Desc().RaftID)
+	key := keys.RangeLastVerificationTimestampKey(r.Desc().RaftID)
 	timestamp := proto.Timestamp{}
 	_, err := engine.MVCCGetProto(r.rm.Engine(), key, proto.ZeroTimestamp, true, nil, &timestamp)
 	if err != nil {
@@ -386,7 +543,26 @@ func (r *Range) GetLastVerificationTimestamp() (proto.Timestamp, error) {
 // SetLastVerificationTimestamp writes the timestamp at which the range's
 // data was last verified.
 func (r *Range) SetLastVerificationTimestamp(timestamp proto.Timestamp) error {
-	key := engine.RangeLastVerificationTimestampKey(r.Desc().RaftID)
+	key := keys.RangeLastVerificationTimestampKey(r.Desc().RaftID)
+	return engine.MVCCPutProto(r.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &timestamp)
+}
+
+// GetLastConsistencyCheckTimestamp reads the timestamp at which the
+// range's replicas were last checked for consistency.
+func (r *Range) GetLastConsistencyCheckTimestamp() (proto.Timestamp, error) {
+	key := keys.RangeLastConsistencyCheckTimestampKey(r.Desc().RaftID)
+	timestamp := proto.Timestamp{}
+	_, err := engine.MVCCGetProto(r.rm.Engine(), key, proto.ZeroTimestamp, true, nil, &timestamp)
+	if err != nil {
+		return proto.ZeroTimestamp, err
+	}
+	return timestamp, nil
+}
+
+// SetLastConsistencyCheckTimestamp writes the timestamp at which the
+// range's replicas were last checked for consistency.
+func (r *Range) SetLastConsistencyCheckTimestamp(timestamp proto.Timestamp) error {
+	key := keys.RangeLastConsistencyCheckTimestampKey(r.Desc().RaftID)
 	return engine.MVCCPutProto(r.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &timestamp)
 }
 
@@ -394,21 +570,54 @@ func (r *Range) SetLastVerificationTimestamp(timestamp proto.Timestamp) error {
 // affected keys are verified to be contained within the range and the
 // range's leadership is confirmed. The command is then dispatched
 // either along the read-only execution path or the read-write Raft
-// command queue. If wait is false, read-write commands are added to
-// Raft without waiting for their completion.
-func (r *Range) AddCmd(args proto.Request, reply proto.Response, wait bool) error {
+// command queue, provided the requesting user has permission and,
+// for writes, the accounting prefix is within its quota. If wait
+// is false, read-write commands are added to Raft without waiting for
+// their completion.
+//
+// Once ctx is done, a command still waiting in the command queue is
+// abandoned and its slot freed, returning ctx.Err(), and a waiting
+// caller stops waiting on a proposed command; as the proposal may
+// still commit, it continues to gate overlapping commands until
+// applied, and a proto.AmbiguousResultError is returned.
+func (r *Range) AddCmd(ctx context.Context, args proto.Request, reply proto.Response, wait bool) error {
+	ctx = r.context(ctx, args)
 	if err := r.canServiceCmd(args); err != nil {
 		reply.Header().SetGoError(err)
 		return err
 	}
+	if err := r.checkPermissions(args); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+	if err := r.checkQuota(args); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
 
 	// Differentiate between read-only and read-write.
 	if proto.IsAdmin(args) {
-		return r.addAdminCmd(args, reply)
+		err := r.addAdminCmd(args, reply)
+		audit.LogRequest(audit.EventAdmin, args, err)
+		return err
+	} else if wArgs, ok := args.(*proto.WatchRequest); ok {
+		return r.addWatchCmd(ctx, wArgs, reply.(*proto.WatchResponse))
 	} else if proto.IsReadOnly(args) {
-		return r.addReadOnlyCmd(args, reply)
+		return r.addReadOnlyCmd(ctx, args, reply)
+	}
+	r.auditWrite(args)
+	return r.addReadWriteCmd(ctx, args, reply, wait)
+}
+
+// context returns ctx tagged with the range's Raft ID and the method
+// and transaction of args for logging.
+func (r *Range) context(ctx context.Context, args proto.Request) context.Context {
+	ctx = context.WithValue(ctx, log.RaftID, r.Desc().RaftID)
+	ctx = context.WithValue(ctx, log.Method, args.Method())
+	if txn := args.Header().Txn; txn != nil {
+		ctx = context.WithValue(ctx, log.TxnID, txn.ID)
 	}
-	return r.addReadWriteCmd(args, reply, wait)
+	return ctx
 }
 
 // beginCmd waits for any overlapping, already-executing commands via
@@ -416,15 +625,60 @@ func (r *Range) AddCmd(args proto.Request, reply proto.Response, wait bool) erro
 // commands which overlap its key range. This method will block if
 // there are any overlapping commands already in the queue. Returns
 // the command queue insertion key, to be supplied to subsequent
-// invocation of cmdQ.Remove().
-func (r *Range) beginCmd(start, end proto.Key, readOnly bool) interface{} {
+// invocation of cmdQ.Remove(). If ctx is done before the overlapping
+// commands complete, the command is removed from the queue and
+// ctx.Err() is returned. Commands of a priority below normal first
+// wait for any overlapping commands of higher priority, and again for
+// those queued meanwhile, before adding themselves to the queue.
+func (r *Range) beginCmd(ctx context.Context, start, end proto.Key, readOnly bool,
+	priority proto.RequestPriority) (interface{}, error) {
 	r.Lock()
+	for priority.Less(proto.NORMAL) {
+		var wg sync.WaitGroup
+		if r.cmdQ.GetHigherPriorityWait(start, end, readOnly, priority, &wg) == 0 {
+			break
+		}
+		r.Unlock()
+		ready := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(ready)
+		}()
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		r.Lock()
+	}
 	var wg sync.WaitGroup
 	r.cmdQ.GetWait(start, end, readOnly, &wg)
-	cmdKey := r.cmdQ.Add(start, end, readOnly)
+	cmdKey := r.cmdQ.AddWithPriority(start, end, readOnly, priority)
 	r.Unlock()
-	wg.Wait()
-	return cmdKey
+	sp := trace.FromContext(ctx)
+	if ctx.Done() == nil {
+		wg.Wait()
+		sp.EndPhasef(trace.PhaseCommandQueue, "waited in command queue")
+		return cmdKey, nil
+	}
+	ready := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(ready)
+	}()
+	select {
+	case <-ready:
+		sp.EndPhasef(trace.PhaseCommandQueue, "waited in command queue")
+		return cmdKey, nil
+	case <-ctx.Done():
+		// Commands which queued behind this one also wait on any of the
+		// commands ahead of it which they overlap, so it's safe to
+		// remove it before those complete.
+		r.Lock()
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		return nil, ctx.Err()
+	}
 }
 
 // addAdminCmd executes the command directly. There is no interaction
@@ -436,6 +690,14 @@ func (r *Range) addAdminCmd(args proto.Request, reply proto.Response) error {
 		r.AdminSplit(args.(*proto.AdminSplitRequest), reply.(*proto.AdminSplitResponse))
 	case *proto.AdminMergeRequest:
 		r.AdminMerge(args.(*proto.AdminMergeRequest), reply.(*proto.AdminMergeResponse))
+	case *proto.AdminBulkLoadRequest:
+		r.AdminBulkLoad(args.(*proto.AdminBulkLoadRequest), reply.(*proto.AdminBulkLoadResponse))
+	case *proto.AdminTransferLeaseRequest:
+		r.AdminTransferLease(args.(*proto.AdminTransferLeaseRequest), reply.(*proto.AdminTransferLeaseResponse))
+	case *proto.AdminBackupRequest:
+		r.AdminBackup(args.(*proto.AdminBackupRequest), reply.(*proto.AdminBackupResponse))
+	case *proto.AdminRestoreRequest:
+		r.AdminRestore(args.(*proto.AdminRestoreRequest), reply.(*proto.AdminRestoreResponse))
 	default:
 		return util.Errorf("unrecognized admin command type: %s", args.Method())
 	}
@@ -445,7 +707,7 @@ func (r *Range) addAdminCmd(args proto.Request, reply proto.Response) error {
 // addReadOnlyCmd updates the read timestamp cache and waits for any
 // overlapping writes currently processing through Raft ahead of us to
 // clear via the read queue.
-func (r *Range) addReadOnlyCmd(args proto.Request, reply proto.Response) error {
+func (r *Range) addReadOnlyCmd(ctx context.Context, args proto.Request, reply proto.Response) error {
 	header := args.Header()
 
 	// If read-consistency is set to INCONSISTENT, run directly.
@@ -455,7 +717,11 @@ func (r *Range) addReadOnlyCmd(args proto.Request, reply proto.Response) error {
 
 	// Add the read to the command queue to gate subsequent
 	// overlapping, commands until this command completes.
-	cmdKey := r.beginCmd(header.Key, header.EndKey, true)
+	cmdKey, err := r.beginCmd(ctx, header.Key, header.EndKey, true, header.Priority)
+	if err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
 
 	// It's possible that arbitrary delays (e.g. major GC, VM
 	// de-prioritization, etc.) could cause the execution of this read
@@ -475,7 +741,8 @@ func (r *Range) addReadOnlyCmd(args proto.Request, reply proto.Response) error {
 	if err := r.canServiceCmd(args); err != nil {
 		return err
 	}
-	err := r.executeCmd(0, args, reply)
+	err = r.executeCmd(0, args, reply)
+	trace.FromContext(ctx).EndPhasef(trace.PhaseEngine, "executed read")
 
 	// Only update the timestamp cache if the command succeeded.
 	r.Lock()
@@ -512,8 +779,9 @@ func (r *Range) getCmdID(args proto.Request) (cmdID proto.ClientCmdID) {
 // command are added as pending writes to the read queue and the
 // command is submitted to Raft. Upon completion, the write is removed
 // from the read queue and the reply is added to the response cache.
-// If wait is true, will block until the command is complete.
-func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait bool) error {
+// If wait is true, will block until the command is complete or ctx is
+// done.
+func (r *Range) addReadWriteCmd(ctx context.Context, args proto.Request, reply proto.Response, wait bool) error {
 	// Check the response cache in case this is a replay. This call
 	// may block if the same command is already underway.
 	header := args.Header()
@@ -526,7 +794,7 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 		// cache. Instead of failing the request just because we can't
 		// decode the reply in the response cache, we proceed as though
 		// idempotence has expired.
-		log.Errorf("unable to read result for %+v from the response cache: %s", args, err)
+		log.Errorc(ctx, "unable to read result for %+v from the response cache: %s", args, err)
 	}
 
 	// Add the write to the command queue to gate subsequent overlapping
@@ -534,7 +802,12 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 	// done before getting the max timestamp for the key(s), as
 	// timestamp cache is only updated after preceding commands have
 	// been run to successful completion.
-	cmdKey := r.beginCmd(header.Key, header.EndKey, false)
+	cmdKey, err := r.beginCmd(ctx, header.Key, header.EndKey, false, header.Priority)
+	if err != nil {
+		r.respCache.RemoveInflight(header.CmdID)
+		reply.Header().SetGoError(err)
+		return err
+	}
 
 	// Two important invariants of Cockroach: 1) encountering a more
 	// recently written value means transaction restart. 2) values must
@@ -544,53 +817,111 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 	// writes, send WriteTooOldError; for reads, update the write's
 	// timestamp. When the write returns, the updated timestamp will
 	// inform the final commit timestamp.
+	//
+	// The write's final timestamp is tracked until it's proposed, so
+	// that no timestamp at or above it is closed in the meantime.
+	var closedTrackID int64
 	if usesTimestampCache(args) {
 		r.Lock()
-		rTS, wTS := r.tsCache.GetMax(header.Key, header.EndKey, txnMD5)
-		r.Unlock()
+		for _, span := range timestampCacheSpans(args) {
+			rTS, wTS := r.tsCache.GetMax(span.Key, span.EndKey, txnMD5)
 
-		// Always push the timestamp forward if there's been a read which
-		// occurred after our txn timestamp.
-		if !rTS.Less(header.Timestamp) {
-			header.Timestamp = rTS.Next()
-		}
-		// If there's a newer write timestamp...
-		if !wTS.Less(header.Timestamp) {
-			// If we're in a txn, set a write too old error in reply. We
-			// still go ahead and try the write because we want to avoid
-			// restarting the transaction in the event that there isn't an
-			// intent or the intent can be pushed by us.
-			if header.Txn != nil {
-				err := &proto.WriteTooOldError{Timestamp: header.Timestamp, ExistingTimestamp: wTS}
-				reply.Header().SetGoError(err)
-			} else {
-				// Otherwise, make sure we advance the request's timestamp.
-				header.Timestamp = wTS.Next()
+			// Always push the timestamp forward if there's been a read which
+			// occurred after our txn timestamp.
+			if !rTS.Less(header.Timestamp) {
+				header.Timestamp = rTS.Next()
+			}
+			// If there's a newer write timestamp...
+			if !wTS.Less(header.Timestamp) {
+				// If we're in a txn, set a write too old error in reply. We
+				// still go ahead and try the write because we want to avoid
+				// restarting the transaction in the event that there isn't an
+				// intent or the intent can be pushed by us. A one-phase
+				// commit can't be pushed without failing, so its timestamp
+				// is advanced to fail it with a retry error instead.
+				if _, ok := args.(*proto.BatchRequest); header.Txn != nil && !ok {
+					err := &proto.WriteTooOldError{Timestamp: header.Timestamp, ExistingTimestamp: wTS}
+					reply.Header().SetGoError(err)
+				} else {
+					// Otherwise, make sure we advance the request's timestamp.
+					header.Timestamp = wTS.Next()
+				}
 			}
 		}
+		// The timestamp cache reflects only the timestamps closed by this
+		// replica's own proposals. Push the write above those closed by
+		// previous leaders too, at which followers may already serve reads.
+		if !r.closedTS.Less(header.Timestamp) {
+			header.Timestamp = r.closedTS.Next()
+		}
+		closedTrackID = r.closedTracker.track(header.Timestamp)
+		r.Unlock()
+	}
+
+	// Until the command is proposed, it can still be abandoned.
+	if err := ctx.Err(); err != nil {
+		r.Lock()
+		r.closedTracker.untrack(closedTrackID)
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		r.respCache.RemoveInflight(header.CmdID)
+		reply.Header().SetGoError(err)
+		return err
 	}
 
-	// Create command and enqueue for Raft.
+	// Create command and enqueue for Raft. If the caller may stop
+	// waiting on the command, it executes into a copy of the reply so
+	// that the caller's reply isn't written to after AddCmd returns.
+	cmdReply := reply
+	if wait && ctx.Done() != nil {
+		cmdReply = gogoproto.Clone(reply).(proto.Response)
+	}
 	pendingCmd := &pendingCmd{
-		Reply: reply,
+		Reply: cmdReply,
 		done:  make(chan error, 1),
 	}
 	raftCmd := proto.InternalRaftCommand{
 		RaftID: r.Desc().RaftID,
 	}
 	cmdID := r.getCmdID(args)
-	ok := raftCmd.Cmd.SetValue(args)
+	cmdArgs, err := compressValues(args, r.rm.StoreContext().ValueCompressionThreshold)
+	if err != nil {
+		r.Lock()
+		r.closedTracker.untrack(closedTrackID)
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		r.respCache.RemoveInflight(header.CmdID)
+		reply.Header().SetGoError(err)
+		return err
+	}
+	ok := raftCmd.Cmd.SetValue(cmdArgs)
 	if !ok {
 		log.Fatalf("unknown command type %T", args)
 	}
 	idKey := makeCmdIDKey(cmdID)
+	r.proposeLock.Lock()
 	r.Lock()
 	r.pendingCmds[idKey] = pendingCmd
+	r.closeTimestampLocked(&raftCmd)
+	r.assignLeaseIndexLocked(&raftCmd)
+	raftCmd.ProposerLease = r.getLease()
+	pendingCmd.raftCmd = raftCmd
 	r.Unlock()
 	// TODO(bdarnell): In certain raft failover scenarios, proposed
 	// commands may be abandoned. We need to re-propose the command
 	// if too much time passes with no response on the done channel.
+	// Commands which reach the log out of order are reproposed by
+	// processRaftCommand.
 	raftChan := r.rm.ProposeRaftCommand(idKey, raftCmd)
+	r.proposeLock.Unlock()
+	// Proposals are appended to the raft log in the order in which
+	// they're made, so timestamps closed by subsequent proposals need
+	// no longer stay below this write.
+	r.Lock()
+	r.closedTracker.untrack(closedTrackID)
+	r.Unlock()
+	sp := trace.FromContext(ctx)
+	sp.Eventf("proposed to raft")
 
 	// Create a completion func for mandatory cleanups which we either
 	// run synchronously if we're waiting or in a goroutine otherwise.
@@ -598,8 +929,10 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 		// First wait for raft to commit or abort the command.
 		var err error
 		if err = <-raftChan; err == nil {
+			sp.EndPhasef(trace.PhaseRaft, "committed by raft")
 			// Next if the command was commited, wait for the range to apply it.
 			err = <-pendingCmd.done
+			sp.EndPhasef(trace.PhaseEngine, "applied")
 		}
 
 		// As for reads, update timestamp cache with the timestamp
@@ -607,7 +940,9 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 		// timestamp for successive writes to the same key or key range.
 		r.Lock()
 		if err == nil && usesTimestampCache(args) {
-			r.tsCache.Add(header.Key, header.EndKey, header.Timestamp, txnMD5, false /* !readOnly */)
+			for _, span := range timestampCacheSpans(args) {
+				r.tsCache.Add(span.Key, span.EndKey, header.Timestamp, txnMD5, false /* !readOnly */)
+			}
 		}
 		r.cmdQ.Remove(cmdKey)
 		r.Unlock()
@@ -615,20 +950,163 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 		// If the original client didn't wait (e.g. resolve write intent),
 		// log execution errors so they're surfaced somewhere.
 		if !wait && err != nil {
-			log.Warningf("non-synchronous execution of %s with %+v failed: %s",
+			log.Warningc(ctx, "non-synchronous execution of %s with %+v failed: %s",
 				args.Method(), args, err)
 		}
 		return err
 	}
 
-	if wait {
+	if !wait {
+		go completionFunc()
+		return nil
+	}
+	if cmdReply == reply {
 		return completionFunc()
 	}
-	go completionFunc()
+	done := make(chan error, 1)
+	go func() {
+		done <- completionFunc()
+	}()
+	select {
+	case err := <-done:
+		reply.Reset()
+		gogoproto.Merge(reply, cmdReply)
+		return err
+	case <-ctx.Done():
+		// The command may still be applied.
+		err := proto.NewAmbiguousResultError(ctx.Err())
+		reply.Header().SetGoError(err)
+		return err
+	}
+}
+
+// compressValues returns the request to propose for args, in which the
+// values written which exceed threshold are compressed. Compression is
+// decided on the proposer so that all replicas apply the same bytes;
+// the engine stores values as they're proposed. args is shared with
+// the caller, so it's cloned rather than modified if any value is
+// compressed. Values which arrive compressed are rejected, as their
+// compression can't be verified until they're read.
+func compressValues(args proto.Request, threshold int) (proto.Request, error) {
+	compress := false
+	for _, value := range writtenValues(args) {
+		if value.Compression != nil {
+			return nil, util.Errorf("%s of key %q specifies value compression", args.Method(), args.Header().Key)
+		}
+		if threshold > 0 && len(value.Bytes) > threshold {
+			compress = true
+		}
+	}
+	if !compress {
+		return args, nil
+	}
+	args = gogoproto.Clone(args).(proto.Request)
+	for _, value := range writtenValues(args) {
+		if err := engine.CompressValue(value, threshold); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// writtenValues returns the values written by the request, including
+// those of the requests of a batch.
+func writtenValues(args proto.Request) []*proto.Value {
+	switch t := args.(type) {
+	case *proto.PutRequest:
+		return []*proto.Value{&t.Value}
+	case *proto.ConditionalPutRequest:
+		return []*proto.Value{&t.Value}
+	case *proto.InitPutRequest:
+		return []*proto.Value{&t.Value}
+	case *proto.BatchRequest:
+		var values []*proto.Value
+		for i := range t.Requests {
+			values = append(values, writtenValues(t.Requests[i].GetValue().(proto.Request))...)
+		}
+		return values
+	}
+	return nil
+}
+
+// closeTimestampLocked sets the timestamp closed by the given command,
+// the store's ClosedTimestampLag behind the current time, and pushes
+// subsequent writes above it via the timestamp cache. The range lock
+// must be held.
+func (r *Range) closeTimestampLocked(raftCmd *proto.InternalRaftCommand) {
+	lag := r.rm.StoreContext().ClosedTimestampLag
+	if lag <= 0 {
+		return
+	}
+	target := r.rm.Clock().Now()
+	target.WallTime -= lag.Nanoseconds()
+	target.Logical = 0
+	closed := r.closedTracker.close(target)
+	if !closed.Equal(proto.ZeroTimestamp) {
+		desc := r.Desc()
+		r.tsCache.Add(desc.StartKey, desc.EndKey, closed, proto.NoTxnMD5, true /* readOnly */)
+	}
+	raftCmd.ClosedTimestamp = closed
+}
+
+// assignLeaseIndexLocked sets the lease index of the given command,
+// one above that of the commands proposed or applied before it. The
+// range lock must be held.
+func (r *Range) assignLeaseIndexLocked(raftCmd *proto.InternalRaftCommand) {
+	if r.lastAssignedLeaseIndex < r.leaseAppliedIndex {
+		r.lastAssignedLeaseIndex = r.leaseAppliedIndex
+	}
+	r.lastAssignedLeaseIndex++
+	raftCmd.MaxLeaseIndex = gogoproto.Uint64(r.lastAssignedLeaseIndex)
+}
+
+// isProposerLeaseLocked returns whether a command proposed under the
+// given lease may be applied under the range's current lease. The
+// range lock must be held.
+func (r *Range) isProposerLeaseLocked(pl *proto.Lease) bool {
+	lease := r.getLease()
+	return lease == nil || (pl != nil && pl.RaftNodeID == lease.RaftNodeID && pl.Term == lease.Term)
+}
+
+// A leaseIndexError indicates that a command reached the Raft log
+// after a command with the same or a higher lease index was applied.
+type leaseIndexError struct {
+	maxLeaseIndex, leaseAppliedIndex uint64
+}
+
+// Error implements the error interface.
+func (e *leaseIndexError) Error() string {
+	return fmt.Sprintf("command lease index %d is not above lease applied index %d",
+		e.maxLeaseIndex, e.leaseAppliedIndex)
+}
+
+// checkProposalLocked returns an error if the command must not be
+// applied, either because the range's leader lease has passed to
+// another holder since the command was proposed, or because it is
+// ordered before a command already applied. Since the check depends
+// only on the state resulting from the commands applied before, all
+// replicas reject the same commands. Commands without a lease index
+// are exempt. The range lock must be held.
+func (r *Range) checkProposalLocked(raftCmd proto.InternalRaftCommand) error {
+	if raftCmd.MaxLeaseIndex == nil {
+		return nil
+	}
+	if !r.isProposerLeaseLocked(raftCmd.ProposerLease) {
+		return &proto.NotLeaderError{}
+	}
+	if maxLeaseIndex := *raftCmd.MaxLeaseIndex; maxLeaseIndex <= r.leaseAppliedIndex {
+		return &leaseIndexError{maxLeaseIndex: maxLeaseIndex, leaseAppliedIndex: r.leaseAppliedIndex}
+	}
 	return nil
 }
 
-func (r *Range) processRaftCommand(idKey cmdIDKey, index uint64,
+// processRaftCommand applies a committed Raft command, adding its
+// writes to the supplied applyBatch. The command's proposer, if
+// local, is signaled once the batch has been committed. A command
+// rejected by checkProposalLocked only advances the applied index; if
+// it was proposed locally and merely arrived out of order, it is
+// proposed again with a new lease index.
+func (r *Range) processRaftCommand(ab *applyBatch, idKey cmdIDKey, index uint64,
 	raftCmd proto.InternalRaftCommand) error {
 	if index == 0 {
 		log.Fatal("processRaftCommand requires a non-zero index")
@@ -636,6 +1114,10 @@ func (r *Range) processRaftCommand(idKey cmdIDKey, index uint64,
 	r.Lock()
 	cmd := r.pendingCmds[idKey]
 	delete(r.pendingCmds, idKey)
+	rejectErr := r.checkProposalLocked(raftCmd)
+	if rejectErr == nil && raftCmd.MaxLeaseIndex != nil {
+		r.leaseAppliedIndex = *raftCmd.MaxLeaseIndex
+	}
 	r.Unlock()
 
 	args := raftCmd.Cmd.GetValue().(proto.Request)
@@ -649,15 +1131,119 @@ func (r *Range) processRaftCommand(idKey cmdIDKey, index uint64,
 		// This command originated elsewhere so we must create a new reply buffer.
 		reply = args.CreateReply()
 	}
-	err := r.executeCmd(index, args, reply)
-	if cmd != nil {
-		cmd.done <- err
-	} else if err != nil {
-		log.Errorf("error executing raft command %s: %s", method, err)
+	if rejectErr != nil {
+		r.rejectCmd(ab, index, reply, rejectErr)
+		if _, ok := rejectErr.(*leaseIndexError); ok && cmd != nil {
+			// The command remains in flight until the reproposal applies.
+			ab.deferUntilCommit(func() { r.reproposeCmd(idKey, cmd) })
+			return rejectErr
+		}
+		cmdID := args.Header().CmdID
+		ab.deferUntilCommit(func() { r.respCache.RemoveInflight(cmdID) })
+	} else {
+		if raftCmd.MaxLeaseIndex != nil {
+			if err := engine.MVCCPut(ab.batch, nil, keys.RaftLeaseAppliedIndexKey(r.Desc().RaftID), proto.ZeroTimestamp,
+				proto.Value{Bytes: encoding.EncodeUint64(nil, *raftCmd.MaxLeaseIndex)}, nil); err != nil {
+				log.Errorf("failed to advance lease applied index: %s", err)
+			}
+		}
+		r.applyCmd(ab, index, args, reply)
 	}
+	err := reply.Header().GoError()
+	ab.deferUntilCommit(func() {
+		// The closed timestamp holds whether or not the command itself
+		// succeeded.
+		r.Lock()
+		if r.closedTS.Less(raftCmd.ClosedTimestamp) {
+			r.closedTS = raftCmd.ClosedTimestamp
+		}
+		r.Unlock()
+		if cmd != nil {
+			cmd.done <- err
+		} else if rejectErr != nil {
+			log.V(1).Infof("rejected raft command %s: %s", method, err)
+		} else if err != nil {
+			log.Errorf("error executing raft command %s: %s", method, err)
+		}
+	})
 	return err
 }
 
+// rejectCmd sets the error of a command rejected without execution and
+// advances the applied index past it. Nothing is written to the
+// response cache, so that the command may be proposed again.
+func (r *Range) rejectCmd(ab *applyBatch, index uint64, reply proto.Response, err error) {
+	ab.count++
+	reply.Header().SetGoError(err)
+	atomic.StoreUint64(&r.appliedIndex, index)
+	if err := engine.MVCCPut(ab.batch, nil, keys.RaftAppliedIndexKey(r.Desc().RaftID),
+		proto.ZeroTimestamp, proto.Value{Bytes: encoding.EncodeUint64(nil, index)}, nil); err != nil {
+		log.Errorf("failed to advance applied index: %s", err)
+	}
+}
+
+// reproposeCmd assigns the pending command a new lease index and
+// proposes it again. The proposer keeps waiting on the command's done
+// channel, which receives the error if the command can't be proposed.
+// The command keeps the lease under which it was first proposed, so it
+// fails with a NotLeaderError if the lease has moved since. It also
+// fails with a WriteTooOldError, on which the store retries it at a
+// higher timestamp, if its timestamp is no longer above the closed
+// timestamp: it was untracked once first proposed, so the proposals
+// made since may have closed timestamps above it. The proposal runs as
+// a stopper task in its own goroutine, since the caller is applying
+// committed commands and proposing blocks on the raft processing
+// goroutine.
+func (r *Range) reproposeCmd(idKey cmdIDKey, cmd *pendingCmd) {
+	// The rejected application set the lease index error on the reply,
+	// which is reused by the reproposal.
+	cmd.Reply.Header().SetGoError(nil)
+	stopper := r.rm.Stopper()
+	if !stopper.StartTask() {
+		err := util.Errorf("range %d stopped before reproposing command", r.Desc().RaftID)
+		cmd.Reply.Header().SetGoError(err)
+		cmd.done <- err
+		return
+	}
+	go func() {
+		defer stopper.FinishTask()
+		r.proposeLock.Lock()
+		r.Lock()
+		header := cmd.raftCmd.Cmd.GetValue().(proto.Request).Header()
+		closed := r.closedTS
+		if closed.Less(r.closedTracker.closed) {
+			closed = r.closedTracker.closed
+		}
+		var err error
+		if !r.isProposerLeaseLocked(cmd.raftCmd.ProposerLease) {
+			err = &proto.NotLeaderError{}
+		} else if !closed.Less(header.Timestamp) {
+			err = &proto.WriteTooOldError{Timestamp: header.Timestamp, ExistingTimestamp: closed}
+		}
+		if err != nil {
+			r.Unlock()
+			r.proposeLock.Unlock()
+			r.respCache.RemoveInflight(header.CmdID)
+			cmd.Reply.Header().SetGoError(err)
+			cmd.done <- err
+			return
+		}
+		r.pendingCmds[idKey] = cmd
+		r.assignLeaseIndexLocked(&cmd.raftCmd)
+		raftCmd := cmd.raftCmd
+		r.Unlock()
+		raftChan := r.rm.ProposeRaftCommand(idKey, raftCmd)
+		r.proposeLock.Unlock()
+		if err := <-raftChan; err != nil {
+			r.Lock()
+			delete(r.pendingCmds, idKey)
+			r.Unlock()
+			cmd.Reply.Header().SetGoError(err)
+			cmd.done <- err
+		}
+	}()
+}
+
 // startGossip periodically gossips the cluster ID if it's the
 // first range and the raft leader.
 func (r *Range) startGossip() {
@@ -686,41 +1272,71 @@ func (r *Range) maybeGossipClusterID() {
 }
 
 // maybeGossipFirstRange gossips the range locations if this range is
-// the start of the key space and the raft leader.
+// the start of the key space. Every replica gossips the descriptor
+// along with its applied index under a key of its own; the raft leader
+// additionally gossips it under gossip.KeyFirstRangeDescriptor.
 func (r *Range) maybeGossipFirstRange() {
-	if r.rm.Gossip() != nil && r.IsFirstRange() && r.IsLeader() {
-		if err := r.rm.Gossip().AddInfo(gossip.KeyFirstRangeDescriptor, *r.Desc(), 0*time.Second); err != nil {
+	if r.rm.Gossip() == nil || !r.IsFirstRange() {
+		return
+	}
+	frd := FirstRangeDescriptor{
+		Desc:     *r.Desc(),
+		Sequence: atomic.LoadUint64(&r.appliedIndex),
+	}
+	key := gossip.MakeFirstRangeReplicaKey(r.rm.StoreID())
+	if err := r.rm.Gossip().AddInfo(key, frd, ttlFirstRangeReplicaGossip); err != nil {
+		log.Errorf("failed to gossip first range replica metadata: %s", err)
+	}
+	if r.IsLeader() {
+		if err := r.rm.Gossip().AddInfo(gossip.KeyFirstRangeDescriptor, frd, 0*time.Second); err != nil {
 			log.Errorf("failed to gossip first range metadata: %s", err)
 		}
 	}
 }
 
-// maybeGossipConfigs gossips configuration maps if their data falls
-// within the range, this replica is the raft leader, and their
-// contents are marked dirty. Configuration maps include accounting,
-// permissions, and zones.
-func (r *Range) maybeGossipConfigs(dirtyConfigs ...*configDescriptor) {
-	if r.rm.Gossip() != nil && r.IsLeader() {
-		for _, cd := range dirtyConfigs {
-			if r.ContainsKey(cd.keyPrefix) {
-				// Check for a bad range split. This should never happen as ranges
-				// cannot be split mid-config.
-				if !r.ContainsKey(cd.keyPrefix.PrefixEnd()) {
-					log.Fatalf("range splits configuration values for %s", cd.keyPrefix)
-				}
-				configMap, err := r.loadConfigMap(cd.keyPrefix, cd.configI)
-				if err != nil {
-					log.Errorf("failed loading %s config map: %s", cd.gossipKey, err)
-					continue
-				} else {
-					if err := r.rm.Gossip().AddInfo(cd.gossipKey, configMap, 0*time.Second); err != nil {
-						log.Errorf("failed to gossip %s configMap: %s", cd.gossipKey, err)
-						continue
-					}
-				}
-			}
+// maybeGossipSystemConfig gossips the system config if the system
+// config span falls within the range and this replica is the raft
+// leader. All configuration maps (accounting, permissions, and zones)
+// are loaded and gossiped together.
+func (r *Range) maybeGossipSystemConfig() {
+	if r.rm.Gossip() == nil || !r.IsLeader() || !r.ContainsKey(keys.KeySystemConfigPrefix) {
+		return
+	}
+	// Check for a bad range split. This should never happen as ranges
+	// cannot be split within the system config span.
+	if !r.ContainsKeyRange(keys.KeySystemConfigPrefix, keys.KeySystemConfigMax) {
+		log.Fatalf("range splits system config span %s", keys.KeySystemConfigPrefix)
+	}
+	cfg, err := r.loadSystemConfig()
+	if err != nil {
+		log.Errorf("failed loading system config: %s", err)
+		return
+	}
+	if err := r.rm.Gossip().AddInfo(gossip.KeySystemConfig, *cfg, 0*time.Second); err != nil {
+		log.Errorf("failed to gossip system config: %s", err)
+	}
+}
+
+// loadSystemConfig loads each of the configuration maps and the
+// cluster settings in the system config span.
+func (r *Range) loadSystemConfig() (*SystemConfig, error) {
+	cfg := &SystemConfig{}
+	for _, cd := range configDescriptors {
+		configMap, err := r.loadConfigMap(cd.keyPrefix, cd.configI)
+		if err != nil {
+			return nil, util.Errorf("failed loading config map %s: %s", cd.keyPrefix, err)
 		}
+		*cd.configMap(cfg) = configMap
+	}
+	kvs, err := engine.MVCCScan(r.rm.Engine(), keys.KeySettingPrefix, keys.KeySettingPrefix.PrefixEnd(), 0, proto.MaxTimestamp, true, nil)
+	if err != nil {
+		return nil, util.Errorf("failed loading settings: %s", err)
+	}
+	cfg.Settings = make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		cfg.Settings[string(bytes.TrimPrefix(kv.Key, keys.KeySettingPrefix))] = string(kv.Value.Bytes)
 	}
+	return cfg, nil
 }
 
 // loadConfigMap scans the config entries under keyPrefix and
@@ -744,14 +1360,27 @@ func (r *Range) loadConfigMap(keyPrefix proto.Key, configI interface{}) (PrefixC
 	return NewPrefixConfigMap(configs)
 }
 
-// maybeUpdateGossipConfigs is used to update gossip configs.
-func (r *Range) maybeUpdateGossipConfigs(key proto.Key) {
-	// Check whether this put has modified a configuration map.
-	for _, cd := range configDescriptors {
-		if bytes.HasPrefix(key, cd.keyPrefix) {
-			r.maybeGossipConfigs(cd)
-			break
+// auditWrite records writes to configuration maps, and those under
+// the audited write prefixes, in the audit log.
+func (r *Range) auditWrite(args proto.Request) {
+	if batch, ok := args.(*proto.BatchRequest); ok {
+		for i := range batch.Requests {
+			r.auditWrite(batch.Requests[i].GetValue().(proto.Request))
 		}
+		return
+	}
+	if bytes.HasPrefix(args.Header().Key, keys.KeySystemConfigPrefix) {
+		audit.LogRequest(audit.EventConfigChange, args, nil)
+		return
+	}
+	audit.LogWrite(args)
+}
+
+// maybeUpdateGossipConfigs re-gossips the system config if the
+// supplied key modified a configuration map.
+func (r *Range) maybeUpdateGossipConfigs(key proto.Key) {
+	if bytes.HasPrefix(key, keys.KeySystemConfigPrefix) {
+		r.maybeGossipSystemConfig()
 	}
 }
 
@@ -769,7 +1398,22 @@ func (r *Range) maybeSplit() {
 }
 
 // executeCmd switches over the method and multiplexes to execute the
-// appropriate storage API command.
+// appropriate storage API command. The command's writes are committed
+// to the engine in a write batch of their own.
+func (r *Range) executeCmd(index uint64, args proto.Request,
+	reply proto.Response) error {
+	ab := newApplyBatch(r.rm.Engine(), false)
+	r.applyCmd(ab, index, args, reply)
+	if err := ab.commit(); err != nil {
+		reply.Header().SetGoError(err)
+	}
+	return reply.Header().GoError()
+}
+
+// applyCmd executes the command, adding its writes to the supplied
+// applyBatch. The result is set in the reply. Work which depends on
+// the writes being visible in the engine is deferred until the batch
+// is committed.
 //
 // TODO(Spencer): Differentiate between errors caused by the normal culprits --
 // bad inputs from clients, stale information, etc. and errors which might
@@ -778,24 +1422,28 @@ func (r *Range) maybeSplit() {
 // errors which should be classified as a ReplicaCorruptionError--when those
 // bubble up to the point where we've just tried to execute a Raft command, the
 // Raft replica would need to stall itself.
-func (r *Range) executeCmd(index uint64, args proto.Request,
-	reply proto.Response) error {
+func (r *Range) applyCmd(ab *applyBatch, index uint64, args proto.Request,
+	reply proto.Response) {
+	ab.count++
+
 	// Verify key is contained within range here to catch any range split
 	// or merge activity.
 	header := args.Header()
 	if !r.ContainsKeyRange(header.Key, header.EndKey) {
-		err := proto.NewRangeKeyMismatchError(header.Key, header.EndKey, r.Desc())
-		reply.Header().SetGoError(err)
-		return err
+		reply.Header().SetGoError(proto.NewRangeKeyMismatchError(header.Key, header.EndKey, r.Desc()))
+		return
 	}
 
 	// If a unittest filter was installed, check for an injected error; otherwise, continue.
 	if TestingCommandFilter != nil && TestingCommandFilter(args, reply) {
-		return reply.Header().GoError()
+		return
 	}
 
-	// Create a new batch for the command to ensure all or nothing semantics.
-	batch := r.rm.Engine().NewBatch()
+	// Create a new batch for the command to ensure all or nothing
+	// semantics. It wraps the applyBatch so that the command reads
+	// the writes of the commands applied before it.
+	batch := engine.NewBatch(ab.batch)
+	defer batch.Close()
 	// Create an proto.MVCCStats instance.
 	ms := proto.MVCCStats{}
 
@@ -808,16 +1456,26 @@ func (r *Range) executeCmd(index uint64, args proto.Request,
 		r.Put(batch, &ms, args.(*proto.PutRequest), reply.(*proto.PutResponse))
 	case *proto.ConditionalPutRequest:
 		r.ConditionalPut(batch, &ms, args.(*proto.ConditionalPutRequest), reply.(*proto.ConditionalPutResponse))
+	case *proto.InitPutRequest:
+		r.InitPut(batch, &ms, args.(*proto.InitPutRequest), reply.(*proto.InitPutResponse))
 	case *proto.IncrementRequest:
 		r.Increment(batch, &ms, args.(*proto.IncrementRequest), reply.(*proto.IncrementResponse))
 	case *proto.DeleteRequest:
 		r.Delete(batch, &ms, args.(*proto.DeleteRequest), reply.(*proto.DeleteResponse))
 	case *proto.DeleteRangeRequest:
 		r.DeleteRange(batch, &ms, args.(*proto.DeleteRangeRequest), reply.(*proto.DeleteRangeResponse))
+	case *proto.ClearRangeRequest:
+		r.ClearRange(batch, &ms, args.(*proto.ClearRangeRequest), reply.(*proto.ClearRangeResponse))
 	case *proto.ScanRequest:
 		r.Scan(batch, args.(*proto.ScanRequest), reply.(*proto.ScanResponse))
+	case *proto.AggregateRequest:
+		r.Aggregate(batch, args.(*proto.AggregateRequest), reply.(*proto.AggregateResponse))
+	case *proto.WatchRequest:
+		r.Watch(batch, args.(*proto.WatchRequest), reply.(*proto.WatchResponse))
 	case *proto.EndTransactionRequest:
 		r.EndTransaction(batch, &ms, args.(*proto.EndTransactionRequest), reply.(*proto.EndTransactionResponse))
+	case *proto.BatchRequest:
+		r.Batch(batch, &ms, args.(*proto.BatchRequest), reply.(*proto.BatchResponse))
 	case *proto.InternalRangeLookupRequest:
 		r.InternalRangeLookup(batch, args.(*proto.InternalRangeLookupRequest), reply.(*proto.InternalRangeLookupResponse))
 	case *proto.InternalHeartbeatTxnRequest:
@@ -831,11 +1489,36 @@ func (r *Range) executeCmd(index uint64, args proto.Request,
 	case *proto.InternalMergeRequest:
 		r.InternalMerge(batch, &ms, args.(*proto.InternalMergeRequest), reply.(*proto.InternalMergeResponse))
 	case *proto.InternalTruncateLogRequest:
-		r.InternalTruncateLog(batch, &ms, args.(*proto.InternalTruncateLogRequest), reply.(*proto.InternalTruncateLogResponse))
+		if r.hasSeparateRaftEngine() {
+			// Truncate the log in the Raft log engine, but not before the
+			// commands preceding the truncation have been committed. Keys
+			// in the log engine are not accounted in the range's stats.
+			logBatch := engine.NewBatch(r.rm.RaftEngine())
+			r.InternalTruncateLog(logBatch, nil, args.(*proto.InternalTruncateLogRequest), reply.(*proto.InternalTruncateLogResponse))
+			if reply.Header().GoError() == nil {
+				ab.deferUntilCommit(func() {
+					if err := r.commitRaftLogBatch(logBatch); err != nil {
+						log.Errorf("range %d: unable to truncate raft log: %s", r.Desc().RaftID, err)
+					}
+					logBatch.Close()
+				})
+			} else {
+				logBatch.Close()
+			}
+		} else {
+			r.InternalTruncateLog(batch, &ms, args.(*proto.InternalTruncateLogRequest), reply.(*proto.InternalTruncateLogResponse))
+		}
 	case *proto.InternalLeaderLeaseRequest:
-		r.InternalLeaderLease(args.(*proto.InternalLeaderLeaseRequest), reply.(*proto.InternalLeaderLeaseResponse))
+		r.InternalLeaderLease(batch, &ms, args.(*proto.InternalLeaderLeaseRequest), reply.(*proto.InternalLeaderLeaseResponse))
+	case *proto.InternalCheckConsistencyRequest:
+		r.InternalCheckConsistency(batch, args.(*proto.InternalCheckConsistencyRequest), reply.(*proto.InternalCheckConsistencyResponse))
+	case *proto.InternalRecomputeStatsRequest:
+		r.InternalRecomputeStats(batch, &ms, args.(*proto.InternalRecomputeStatsRequest), reply.(*proto.InternalRecomputeStatsResponse))
+	case *proto.InternalRefreshRangeRequest:
+		r.InternalRefreshRange(batch, args.(*proto.InternalRefreshRangeRequest), reply.(*proto.InternalRefreshRangeResponse))
 	default:
-		return util.Errorf("unrecognized command %s", args.Method())
+		reply.Header().SetGoError(util.Errorf("unrecognized command %s", args.Method()))
+		return
 	}
 
 	// On success, flush the MVCC stats to the batch and commit.
@@ -846,7 +1529,7 @@ func (r *Range) executeCmd(index uint64, args proto.Request,
 				log.Fatalf("applied index moved backwards: %d >= %d", oldIndex, index)
 			}
 			atomic.StoreUint64(&r.appliedIndex, index)
-			err := engine.MVCCPut(batch, &ms, engine.RaftAppliedIndexKey(r.Desc().RaftID),
+			err := engine.MVCCPut(batch, &ms, keys.RaftAppliedIndexKey(r.Desc().RaftID),
 				proto.ZeroTimestamp, proto.Value{Bytes: encoding.EncodeUint64(nil, index)}, nil)
 			if err != nil {
 				reply.Header().SetGoError(err)
@@ -858,17 +1541,33 @@ func (r *Range) executeCmd(index uint64, args proto.Request,
 			if err := batch.Commit(); err != nil {
 				reply.Header().SetGoError(err)
 			} else {
-				// After successful commit, update cached stats values.
+				// Update cached stats values right away, as the stats
+				// merged by later commands in the batch are based on them.
 				r.stats.Update(ms)
-				// If the commit succeeded, potentially add range to split queue.
-				r.maybeSplit()
-				// Maybe update gossip configs on a put.
-				switch args.(type) {
-				case *proto.PutRequest, *proto.ConditionalPutRequest:
-					if header.Key.Less(engine.KeySystemMax) {
-						r.maybeUpdateGossipConfigs(header.Key)
+				ab.deferUntilCommit(func() {
+					// Wake the Watch commands waiting on the keys written.
+					r.notifyWatchers(header.Key, header.EndKey)
+					if etReply, ok := reply.(*proto.EndTransactionResponse); ok {
+						for _, key := range etReply.Resolved {
+							r.notifyWatchers(key, nil)
+						}
 					}
-				}
+					// Potentially add range to split queue.
+					r.maybeSplit()
+					// Maybe update gossip configs on a put or delete.
+					switch t := args.(type) {
+					case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.InitPutRequest, *proto.DeleteRequest:
+						if header.Key.Less(keys.KeySystemMax) {
+							r.maybeUpdateGossipConfigs(header.Key)
+						}
+					case *proto.BatchRequest:
+						for i := range t.Requests {
+							if key := t.Requests[i].GetValue().(proto.Request).Header().Key; key.Less(keys.KeySystemMax) {
+								r.maybeUpdateGossipConfigs(key)
+							}
+						}
+					}
+				})
 			}
 		}
 	} else {
@@ -876,7 +1575,7 @@ func (r *Range) executeCmd(index uint64, args proto.Request,
 			atomic.StoreUint64(&r.appliedIndex, index)
 			// On failure, abandon the batch we've built up, but still update the
 			// applied index so we won't retry this command on restart.
-			if err := engine.MVCCPut(r.rm.Engine(), nil, engine.RaftAppliedIndexKey(r.Desc().RaftID),
+			if err := engine.MVCCPut(ab.batch, nil, keys.RaftAppliedIndexKey(r.Desc().RaftID),
 				proto.ZeroTimestamp, proto.Value{Bytes: encoding.EncodeUint64(nil, index)}, nil); err != nil {
 				// The reply header already contains an error which is going to be more useful
 				// to the caller than this one, so just log it.
@@ -908,14 +1607,13 @@ func (r *Range) executeCmd(index uint64, args proto.Request,
 	// raft commands so that every replica maintains the same responses
 	// to continue request idempotence when leadership changes.
 	if proto.IsWrite(args) {
-		if putErr := r.respCache.PutResponse(args.Header().CmdID, reply); putErr != nil {
+		cmdID := args.Header().CmdID
+		if putErr := r.respCache.WriteResponse(ab.batch, cmdID, reply); putErr != nil {
 			log.Errorf("unable to write result of %+v: %+v to the response cache: %s",
 				args, reply, putErr)
 		}
+		ab.deferUntilCommit(func() { r.respCache.RemoveInflight(cmdID) })
 	}
-
-	// Return the error (if any) set in the reply.
-	return reply.Header().GoError()
 }
 
 // Contains verifies the existence of a key in the key value store.
@@ -947,7 +1645,16 @@ func (r *Range) Put(batch engine.Engine, ms *proto.MVCCStats, args *proto.PutReq
 // the expected value matches. If not, the return value contains
 // the actual value.
 func (r *Range) ConditionalPut(batch engine.Engine, ms *proto.MVCCStats, args *proto.ConditionalPutRequest, reply *proto.ConditionalPutResponse) {
-	err := engine.MVCCConditionalPut(batch, ms, args.Key, args.Timestamp, args.Value, args.ExpValue, args.Txn)
+	err := engine.MVCCConditionalPut(batch, ms, args.Key, args.Timestamp, args.Value, args.ExpValue,
+		args.AllowIfDoesNotExist, args.Txn)
+	reply.SetGoError(err)
+}
+
+// InitPut sets the value for a specified key only if it doesn't
+// exist or already holds the same value. If not, the return value
+// contains the actual value.
+func (r *Range) InitPut(batch engine.Engine, ms *proto.MVCCStats, args *proto.InitPutRequest, reply *proto.InitPutResponse) {
+	err := engine.MVCCInitPut(batch, ms, args.Key, args.Timestamp, args.Value, args.Txn)
 	reply.SetGoError(err)
 }
 
@@ -957,6 +1664,10 @@ func (r *Range) ConditionalPut(batch engine.Engine, ms *proto.MVCCStats, args *p
 func (r *Range) Increment(batch engine.Engine, ms *proto.MVCCStats, args *proto.IncrementRequest, reply *proto.IncrementResponse) {
 	val, err := engine.MVCCIncrement(batch, ms, args.Key, args.Timestamp, args.Txn, args.Increment)
 	reply.NewValue = val
+	if err == nil && args.ReturnPrevious {
+		// MVCCIncrement guards against overflow, so this is exact.
+		reply.PreviousValue = val - args.Increment
+	}
 	reply.SetGoError(err)
 }
 
@@ -973,25 +1684,96 @@ func (r *Range) DeleteRange(batch engine.Engine, ms *proto.MVCCStats, args *prot
 	reply.SetGoError(err)
 }
 
-// Scan scans the key range specified by start key through end key up
-// to some maximum number of results. The last key of the iteration is
-// returned with the reply.
-func (r *Range) Scan(batch engine.Engine, args *proto.ScanRequest, reply *proto.ScanResponse) {
-	kvs, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
-	reply.Rows = kvs
-	reply.SetGoError(err)
-}
-
-// EndTransaction either commits or aborts (rolls back) an extant
-// transaction according to the args.Commit parameter.
-func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *proto.EndTransactionRequest, reply *proto.EndTransactionResponse) {
-	if args.Txn == nil {
-		reply.SetGoError(util.Errorf("no transaction specified to EndTransaction"))
+// ClearRange removes all versions of the key/value pairs specified by
+// start and end keys, without leaving deletion tombstones behind. The
+// data is gone for reads at any timestamp, so ClearRange may not be
+// part of a transaction and is refused for system keys.
+func (r *Range) ClearRange(batch engine.Engine, ms *proto.MVCCStats, args *proto.ClearRangeRequest, reply *proto.ClearRangeResponse) {
+	if args.Txn != nil {
+		reply.SetGoError(util.Errorf("clear range cannot be part of a transaction"))
 		return
 	}
-	key := engine.TransactionKey(args.Txn.Key, args.Txn.ID)
-
-	// Fetch existing transaction if possible.
+	// As with InternalRecomputeStats, the timestamp must be supplied
+	// by the leader so that all replicas compute identical stats.
+	if args.Timestamp.Equal(proto.ZeroTimestamp) {
+		reply.SetGoError(util.Errorf("clear range requires a timestamp"))
+		return
+	}
+	if len(args.EndKey) == 0 {
+		reply.SetGoError(util.Errorf("clear range requires an end key"))
+		return
+	}
+	if args.Key.Less(keys.KeySystemMax) {
+		reply.SetGoError(util.Errorf("cannot clear range %s-%s overlapping system keys",
+			keys.PrettyPrint(args.Key), keys.PrettyPrint(args.EndKey)))
+		return
+	}
+	reply.SetGoError(engine.MVCCClearRange(batch, ms, args.Key, args.EndKey, args.Timestamp.WallTime))
+}
+
+// Scan scans the key range specified by start key through end key up
+// to args.MaxResults rows or args.TargetBytes bytes of them. If a
+// limit stops the scan short of the end key, the rest of the span is
+// returned with the reply as its resume span.
+func (r *Range) Scan(batch engine.Engine, args *proto.ScanRequest, reply *proto.ScanResponse) {
+	var numBytes int64
+	err := engine.MVCCIterate(batch, args.Key, args.EndKey, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn,
+		func(kv proto.KeyValue) (bool, error) {
+			reply.Rows = append(reply.Rows, kv)
+			numBytes += int64(kv.Size())
+			if (args.MaxResults != 0 && int64(len(reply.Rows)) == args.MaxResults) ||
+				(args.TargetBytes != 0 && numBytes >= args.TargetBytes) {
+				if next := kv.Key.Next(); next.Less(args.EndKey) {
+					reply.ResumeSpan = &proto.Span{Key: next, EndKey: args.EndKey}
+				}
+				return true, nil
+			}
+			return false, nil
+		})
+	if err != nil {
+		reply.Rows = nil
+	}
+	reply.SetGoError(err)
+}
+
+// Aggregate counts the keys in the range specified by start key
+// through end key, up to args.MaxResults of them, and sums their
+// integer values. Keys holding non-integer values result in an error.
+func (r *Range) Aggregate(batch engine.Engine, args *proto.AggregateRequest, reply *proto.AggregateResponse) {
+	err := engine.MVCCIterate(batch, args.Key, args.EndKey, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn,
+		func(kv proto.KeyValue) (bool, error) {
+			if kv.Value.Bytes != nil || kv.Value.Integer == nil {
+				return true, util.Errorf("cannot aggregate key %q which has a non-integer value: %+v", kv.Key, kv.Value)
+			}
+			val := kv.Value.GetInteger()
+			if encoding.WillOverflow(reply.Sum, val) {
+				return true, util.Errorf("sum %d of keys up to %q overflows with value %d", reply.Sum, kv.Key, val)
+			}
+			reply.Sum += val
+			reply.NumKeys++
+			return args.MaxResults != 0 && reply.NumKeys == args.MaxResults, nil
+		})
+	reply.SetGoError(err)
+}
+
+// EndTransaction either commits or aborts (rolls back) an extant
+// transaction according to the args.Commit parameter.
+func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *proto.EndTransactionRequest, reply *proto.EndTransactionResponse) {
+	r.endTransaction(batch, ms, args, reply, false, nil)
+}
+
+// endTransaction implements EndTransaction. A one-phase commit
+// resolves the intents it wrote as part of the same command and
+// persists the transaction record only if it already exists.
+func (r *Range) endTransaction(batch engine.Engine, ms *proto.MVCCStats, args *proto.EndTransactionRequest,
+	reply *proto.EndTransactionResponse, onePhase bool, intents []proto.Key) {
+	if args.Txn == nil {
+		reply.SetGoError(util.Errorf("no transaction specified to EndTransaction"))
+		return
+	}
+	key := keys.TransactionKey(args.Txn.Key, args.Txn.ID)
+
+	// Fetch existing transaction if possible.
 	existTxn := &proto.Transaction{}
 	ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, true, nil, existTxn)
 	if err != nil {
@@ -1041,14 +1823,23 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 	if reply.Txn.Timestamp.Less(args.Timestamp) {
 		reply.Txn.Timestamp = args.Timestamp
 	}
+	// Record the writes rolled back to savepoints, which only the
+	// requester knows about, so that intents resolved using the txn
+	// record discard them too.
+	reply.Txn.IgnoredSequences = args.Txn.IgnoredSequences
 
 	// Set transaction status to COMMITTED or ABORTED as per the
 	// args.Commit parameter.
 	if args.Commit {
 		// If the isolation level is SERIALIZABLE, return a transaction
 		// retry error if the commit timestamp isn't equal to the txn
-		// timestamp.
-		if args.Txn.Isolation == proto.SERIALIZABLE && !reply.Txn.Timestamp.Equal(args.Txn.OrigTimestamp) {
+		// timestamp or, if the coordinator refreshed the txn's reads,
+		// to the timestamp they were refreshed to.
+		readTS := args.Txn.OrigTimestamp
+		if args.RefreshedTimestamp != nil {
+			readTS.Forward(*args.RefreshedTimestamp)
+		}
+		if args.Txn.Isolation == proto.SERIALIZABLE && !reply.Txn.Timestamp.Equal(readTS) {
 			reply.SetGoError(proto.NewTransactionRetryError(reply.Txn))
 			return
 		}
@@ -1058,23 +1849,30 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 	}
 
 	// Persist the transaction record with updated status (& possibly timestamp).
-	if err := engine.MVCCPutProto(batch, nil, key, proto.ZeroTimestamp, nil, reply.Txn); err != nil {
-		reply.SetGoError(err)
-		return
+	if ok || !onePhase {
+		if err := engine.MVCCPutProto(batch, nil, key, proto.ZeroTimestamp, nil, reply.Txn); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	}
+
+	// Resolve any explicit intents.
+	ct := args.InternalCommitTrigger
+	if ct != nil {
+		intents = append(intents, ct.Intents...)
+	}
+	for _, key := range intents {
+		log.V(1).Infof("resolving intent at %s on end transaction [%s]", key, reply.Txn.Status)
+		if err := engine.MVCCResolveWriteIntent(batch, ms, key, reply.Txn.Timestamp, reply.Txn); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		reply.Resolved = append(reply.Resolved, key)
 	}
 
 	// Run triggers if successfully committed. Any failures running
 	// triggers will set an error and prevent the batch from committing.
-	if ct := args.InternalCommitTrigger; ct != nil {
-		// Resolve any explicit intents.
-		for _, key := range ct.Intents {
-			log.V(1).Infof("resolving intent at %s on end transaction [%s]", key, reply.Txn.Status)
-			if err := engine.MVCCResolveWriteIntent(batch, ms, key, reply.Txn.Timestamp, reply.Txn); err != nil {
-				reply.SetGoError(err)
-				return
-			}
-			reply.Resolved = append(reply.Resolved, key)
-		}
+	if ct != nil {
 		// Run appropriate trigger.
 		if reply.Txn.Status == proto.COMMITTED {
 			if ct.SplitTrigger != nil {
@@ -1088,6 +1886,48 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 	}
 }
 
+// Batch executes a one-phase commit of a transaction whose writes all
+// fall within this range: the writes and the EndTransaction committing
+// them are executed in order, at the batch's timestamp, as a single
+// command. The intents written are resolved by the commit, so the
+// transaction record needn't be written. The first error encountered
+// fails the batch.
+func (r *Range) Batch(batch engine.Engine, ms *proto.MVCCStats, args *proto.BatchRequest, reply *proto.BatchResponse) {
+	if !args.IsOnePhaseCommit() {
+		reply.SetGoError(util.Errorf("batch of %d requests is not a one-phase commit", len(args.Requests)))
+		return
+	}
+	var intents []proto.Key
+	for i := range args.Requests {
+		req := args.Requests[i].GetValue().(proto.Request)
+		req.Header().Timestamp = args.Timestamp
+		req.Header().Txn = args.Txn
+		resp := req.CreateReply()
+		reply.Add(resp)
+		switch t := req.(type) {
+		case *proto.PutRequest:
+			r.Put(batch, ms, t, resp.(*proto.PutResponse))
+		case *proto.ConditionalPutRequest:
+			r.ConditionalPut(batch, ms, t, resp.(*proto.ConditionalPutResponse))
+		case *proto.InitPutRequest:
+			r.InitPut(batch, ms, t, resp.(*proto.InitPutResponse))
+		case *proto.IncrementRequest:
+			r.Increment(batch, ms, t, resp.(*proto.IncrementResponse))
+		case *proto.DeleteRequest:
+			r.Delete(batch, ms, t, resp.(*proto.DeleteResponse))
+		case *proto.EndTransactionRequest:
+			etReply := resp.(*proto.EndTransactionResponse)
+			r.endTransaction(batch, ms, t, etReply, true, intents)
+			reply.Txn = etReply.Txn
+		}
+		if err := resp.Header().GoError(); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		intents = append(intents, req.Header().Key)
+	}
+}
+
 // InternalRangeLookup is used to look up RangeDescriptors - a RangeDescriptor
 // is a metadata structure which describes the key range and replica locations
 // of a distinct range in the cluster.
@@ -1095,7 +1935,7 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 // RangeDescriptors are stored as values in the cockroach cluster's key-value
 // store. However, they are always stored using special "Range Metadata keys",
 // which are "ordinary" keys with a special prefix prepended. The Range Metadata
-// Key for an ordinary key can be generated with the `engine.RangeMetaKey(key)`
+// Key for an ordinary key can be generated with the `keys.RangeMetaKey(key)`
 // function. The RangeDescriptor for the range which contains a given key can be
 // retrieved by generating its Range Metadata Key and dispatching it to
 // InternalRangeLookup.
@@ -1121,7 +1961,7 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 // nodes can aggressively cache RangeDescriptors which are likely to be desired
 // by their current workload.
 func (r *Range) InternalRangeLookup(batch engine.Engine, args *proto.InternalRangeLookupRequest, reply *proto.InternalRangeLookupResponse) {
-	if err := engine.ValidateRangeMetaKey(args.Key); err != nil {
+	if err := keys.ValidateRangeMetaKey(args.Key); err != nil {
 		reply.SetGoError(err)
 		return
 	}
@@ -1136,7 +1976,7 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args *proto.InternalRan
 	// We want to search for the metadata key just greater than args.Key. Scan
 	// for both the requested key and the keys immediately afterwards, up to
 	// MaxRanges.
-	metaPrefix := proto.Key(args.Key[:len(engine.KeyMeta1Prefix)])
+	metaPrefix := proto.Key(args.Key[:len(keys.KeyMeta1Prefix)])
 	nextKey := proto.Key(args.Key).Next()
 	// Always false, at least when called from the DistSender.
 	consistent := args.ReadConsistency != proto.INCONSISTENT
@@ -1176,7 +2016,7 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args *proto.InternalRan
 // timestamp after receiving transaction heartbeat messages from
 // coordinator. Returns the updated transaction.
 func (r *Range) InternalHeartbeatTxn(batch engine.Engine, args *proto.InternalHeartbeatTxnRequest, reply *proto.InternalHeartbeatTxnResponse) {
-	key := engine.TransactionKey(args.Txn.Key, args.Txn.ID)
+	key := keys.TransactionKey(args.Txn.Key, args.Txn.ID)
 
 	var txn proto.Transaction
 	ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, true, nil, &txn)
@@ -1216,7 +2056,7 @@ func (r *Range) InternalGC(batch engine.Engine, ms *proto.MVCCStats, args *proto
 	}
 
 	// Store the GC metadata for this range.
-	key := engine.RangeGCMetadataKey(r.Desc().RaftID)
+	key := keys.RangeGCMetadataKey(r.Desc().RaftID)
 	err := engine.MVCCPutProto(batch, ms, key, proto.ZeroTimestamp, nil, &args.GCMeta)
 	reply.SetGoError(err)
 }
@@ -1259,7 +2099,7 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 		reply.SetGoError(util.Errorf("request key %s should match pushee's txn key %s", args.Key, args.PusheeTxn.Key))
 		return
 	}
-	key := engine.TransactionKey(args.PusheeTxn.Key, args.PusheeTxn.ID)
+	key := keys.TransactionKey(args.PusheeTxn.Key, args.PusheeTxn.ID)
 
 	// Fetch existing transaction if possible.
 	existTxn := &proto.Transaction{}
@@ -1315,8 +2155,10 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 	// non-transactional. We make a random priority, biased by
 	// specified args.Header().UserPriority in this case.
 	var priority int32
+	pusherTS := args.Timestamp
 	if args.Txn != nil {
 		priority = args.Txn.Priority
+		pusherTS = args.Txn.Timestamp
 	} else {
 		priority = proto.MakePriority(args.GetUserPriority())
 	}
@@ -1336,7 +2178,7 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 		log.V(1).Infof("pushing intent from previous epoch for txn %s", reply.PusheeTxn)
 		pusherWins = true
 	} else if reply.PusheeTxn.Priority < priority ||
-		(reply.PusheeTxn.Priority == priority && args.Txn.Timestamp.Less(reply.PusheeTxn.Timestamp)) {
+		(reply.PusheeTxn.Priority == priority && pusherTS.Less(reply.PusheeTxn.Timestamp)) {
 		// Finally, choose based on priority; if priorities are equal, order by lower txn timestamp.
 		log.V(1).Infof("pushing intent from txn with lower priority %s vs %d", reply.PusheeTxn, priority)
 		pusherWins = true
@@ -1405,8 +2247,8 @@ func (r *Range) InternalTruncateLog(batch engine.Engine, ms *proto.MVCCStats, ar
 		reply.SetGoError(err)
 		return
 	}
-	start := engine.RaftLogKey(r.Desc().RaftID, args.Index).Next()
-	end := engine.RaftLogKey(r.Desc().RaftID, 0)
+	start := keys.RaftLogKey(r.Desc().RaftID, args.Index).Next()
+	end := keys.RaftLogKey(r.Desc().RaftID, 0)
 	err = batch.Iterate(engine.MVCCEncodeKey(start), engine.MVCCEncodeKey(end),
 		func(kv proto.RawKeyValue) (bool, error) {
 			err := batch.Clear(kv.Key)
@@ -1420,34 +2262,258 @@ func (r *Range) InternalTruncateLog(batch engine.Engine, ms *proto.MVCCStats, ar
 		Index: args.Index - 1,
 		Term:  term,
 	}
-	err = engine.MVCCPutProto(batch, ms, engine.RaftTruncatedStateKey(r.Desc().RaftID),
+	err = engine.MVCCPutProto(batch, ms, keys.RaftTruncatedStateKey(r.Desc().RaftID),
 		proto.ZeroTimestamp, nil, &ts)
 	reply.SetGoError(err)
 }
 
 // InternalLeaderLease evaluates and responds to a request to grant a leader lease.
-func (r *Range) InternalLeaderLease(args *proto.InternalLeaderLeaseRequest, reply *proto.InternalLeaderLeaseResponse) {
-	// TODO(tschottdorf) stub for now to get tests working.
+// The lease is persisted so that every replica checks the commands it
+// applies against the same lease, regardless of restarts.
+func (r *Range) InternalLeaderLease(batch engine.Engine, ms *proto.MVCCStats, args *proto.InternalLeaderLeaseRequest, reply *proto.InternalLeaderLeaseResponse) {
+	if err := engine.MVCCPutProto(batch, ms, keys.RaftLeaderLeaseKey(r.Desc().RaftID),
+		proto.ZeroTimestamp, nil, &args.Lease); err != nil {
+		reply.SetGoError(err)
+		return
+	}
 	r.setLease(&args.Lease)
-	// r.grantLeaderLease(args.Lease)
+}
+
+// InternalCheckConsistency executes one phase of a consistency check.
+// In the first phase, the replica computes a checksum of its range
+// data and retains it. In the second phase, the retained checksum is
+// compared with the leader's; on divergence, the replica is reported
+// corrupt so that it is replaced or, if the store is so configured,
+// the process exits.
+func (r *Range) InternalCheckConsistency(batch engine.Engine, args *proto.InternalCheckConsistencyRequest, reply *proto.InternalCheckConsistencyResponse) {
+	if args.Checksum == nil {
+		sum, err := r.computeChecksum(batch)
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		r.Lock()
+		r.checksum = rangeChecksum{id: args.ChecksumID, sum: sum}
+		r.Unlock()
+		reply.Checksum = sum
+		return
+	}
+
+	r.Lock()
+	checksum := r.checksum
+	r.checksum = rangeChecksum{}
+	r.Unlock()
+	if !bytes.Equal(checksum.id, args.ChecksumID) {
+		// The first phase was not applied by this replica; for example,
+		// it was applied as part of a snapshot.
+		log.Warningf("range %d: no checksum to verify for consistency check %x", r.Desc().RaftID, args.ChecksumID)
+		return
+	}
+	if !bytes.Equal(checksum.sum, args.Checksum) {
+		msg := fmt.Sprintf("range %d on store %d is inconsistent with its leader: checksum %x != %x",
+			r.Desc().RaftID, r.rm.StoreID(), checksum.sum, args.Checksum)
+		if r.rm.StoreContext().FatalOnInconsistency {
+			log.Fatal(msg)
+		}
+		r.rm.ReportCorruption(r, util.Error(msg))
+	}
+}
+
+// InternalRecomputeStats rescans the range's data to compute its MVCC
+// stats as of the request timestamp and adds the difference from the
+// range's current stats to ms, correcting any accumulated drift.
+func (r *Range) InternalRecomputeStats(batch engine.Engine, ms *proto.MVCCStats, args *proto.InternalRecomputeStatsRequest, reply *proto.InternalRecomputeStatsResponse) {
+	// The timestamp must be supplied by the leader so that all replicas
+	// compute identical ages.
+	if args.Timestamp.Equal(proto.ZeroTimestamp) {
+		reply.SetGoError(util.Errorf("recomputing stats of range %d requires a timestamp", r.Desc().RaftID))
+		return
+	}
+	nowNanos := args.Timestamp.WallTime
+	actual, err := engine.MVCCComputeStats(batch, r.Desc().StartKey, r.Desc().EndKey, nowNanos)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+
+	// Age the current stats to nowNanos before taking the difference, as
+	// the same aging is applied again when ms is merged into the stats.
+	current := r.stats.GetMVCC()
+	diffSeconds := nowNanos/1e9 - current.LastUpdateNanos/1e9
+	current.IntentAge += current.IntentCount * diffSeconds
+	current.GCBytesAge += engine.MVCCComputeGCBytesAge(current.KeyBytes+current.ValBytes-current.LiveBytes, diffSeconds)
+	current.LastUpdateNanos = nowNanos
+
+	delta := actual
+	engine.Subtract(&delta, current)
+	engine.Accumulate(ms, delta)
+	reply.Delta = delta
+}
+
+// InternalRefreshRange verifies that no values in the key range were
+// written by transactions other than args.Txn between the time the
+// transaction read them, args.RefreshFrom, and args.Timestamp. An
+// error is returned if any were; the transaction can't then commit at
+// args.Timestamp without restarting. On success, the range is added to
+// the timestamp cache at args.Timestamp like any other read.
+func (r *Range) InternalRefreshRange(batch engine.Engine, args *proto.InternalRefreshRangeRequest, reply *proto.InternalRefreshRangeResponse) {
+	if args.Txn == nil {
+		reply.SetGoError(util.Errorf("cannot refresh reads outside of a transaction"))
+		return
+	}
+	endKey := args.EndKey
+	if len(endKey) == 0 {
+		endKey = args.Key.Next()
+	}
+	reply.SetGoError(engine.MVCCRefreshRange(batch, args.Key, endKey, args.RefreshFrom, args.Timestamp, args.Txn))
+}
+
+// computeChecksum returns a SHA-256 checksum of the range's
+// replicated data, as read from the supplied engine.
+func (r *Range) computeChecksum(e engine.Engine) ([]byte, error) {
+	iter := newReplicatedRangeDataIterator(r, e)
+	defer iter.Close()
+	hash := sha256.New()
+	var buf [binary.MaxVarintLen64]byte
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		// Length-prefix keys and values so that distinct sequences of
+		// key/value pairs can't produce the same input to the hash.
+		hash.Write(buf[:binary.PutUvarint(buf[:], uint64(len(key)))])
+		hash.Write(key)
+		hash.Write(buf[:binary.PutUvarint(buf[:], uint64(len(value)))])
+		hash.Write(value)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
+// CheckConsistency verifies that all replicas of the range hold the
+// same data. Each replica computes a checksum at the same point in the
+// Raft log, after which the leader's checksum is sent via Raft for
+// comparison, with any divergence reported by the diverging replicas.
+// Must be invoked on the range leader.
+func (r *Range) CheckConsistency() error {
+	args := &proto.InternalCheckConsistencyRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       r.Desc().StartKey,
+			Timestamp: r.rm.Clock().Now(),
+			RaftID:    r.Desc().RaftID,
+			Priority:  proto.BACKGROUND,
+		},
+		ChecksumID: []byte(uuid.New()),
+	}
+	reply := &proto.InternalCheckConsistencyResponse{}
+	if err := r.AddCmd(context.Background(), args, reply, true); err != nil {
+		return err
+	}
+
+	args.Timestamp = r.rm.Clock().Now()
+	args.Checksum = reply.Checksum
+	return r.AddCmd(context.Background(), args, &proto.InternalCheckConsistencyResponse{}, true)
+}
+
+// newLeaderLease returns a leader lease for this replica in the given
+// term. If node liveness is available and the local node is live, the
+// lease is epoch-based and needs no renewal for as long as the node
+// heartbeats its liveness record. Otherwise, an expiration-based lease
+// is returned.
+func (r *Range) newLeaderLease(term uint64) proto.Lease {
+	lease := proto.Lease{
+		Term:       term,
+		RaftNodeID: uint64(r.rm.RaftNodeID()),
+	}
+	if nl := r.rm.StoreContext().NodeLiveness; nl != nil {
+		nodeID, _ := DecodeRaftNodeID(r.rm.RaftNodeID())
+		if l, ok := nl.GetLiveness(nodeID); ok && nl.isLive(l) {
+			lease.Epoch = l.Epoch
+			return lease
+		}
+	}
+	// TODO: get this from configuration, either as a config flag
+	// or, later, dynamically adjusted.
+	lease.Duration = int64(DefaultLeaderLeaseDuration)
+	lease.Expiration = r.rm.Clock().PhysicalNow() + lease.Duration
+	return lease
+}
+
+// leaseValid returns whether the supplied leader lease is currently
+// valid. An epoch-based lease is valid while the liveness record of
+// the holder's node carries the lease's epoch and is live; an
+// expiration-based lease is valid until its expiration.
+func (r *Range) leaseValid(l *proto.Lease) bool {
+	if l.Epoch == 0 {
+		return r.rm.Clock().PhysicalNow() < l.Expiration
+	}
+	nl := r.rm.StoreContext().NodeLiveness
+	if nl == nil {
+		return false
+	}
+	nodeID, _ := DecodeRaftNodeID(multiraft.NodeID(l.RaftNodeID))
+	liveness, ok := nl.GetLiveness(nodeID)
+	return ok && liveness.Epoch == l.Epoch && nl.isLive(liveness)
+}
+
+// redirectOnOrAcquireLeaderLease returns a NotLeaderError naming the
+// holder of the range's leader lease if it is held by another replica.
+// If this replica's own lease has lapsed, a new lease is requested
+// under the same term and waited for, so that the command can proceed
+// once it's granted. Ranges without a lease, which is the case without
+// node liveness, are exempt.
+func (r *Range) redirectOnOrAcquireLeaderLease() error {
+	lease := r.getLease()
+	if lease == nil {
+		return nil
+	}
+	if lease.RaftNodeID == uint64(r.rm.RaftNodeID()) {
+		if r.leaseValid(lease) {
+			return nil
+		}
+		<-r.requestLeaderLease(lease.Term)
+		if lease = r.getLease(); lease.RaftNodeID == uint64(r.rm.RaftNodeID()) && r.leaseValid(lease) {
+			return nil
+		}
+	}
+	err := &proto.NotLeaderError{}
+	_, storeID := DecodeRaftNodeID(multiraft.NodeID(lease.RaftNodeID))
+	if _, replica := r.Desc().FindReplica(storeID); replica != nil {
+		err.Leader = *replica
+	}
+	return err
 }
 
 // requestLeaderLease sends a request to obtain or extend a leader lease for
-// this replica. Being a first mover, it registers itself as a task with the
+// this replica. The returned channel is closed once the request has been
+// applied or has failed; concurrent callers share the request in flight.
+// Being a first mover, the request registers itself as a task with the
 // stopper.
-func (r *Range) requestLeaderLease(term uint64) {
+//
+// An epoch-based lease held by another node whose liveness has expired
+// is invalidated by incrementing that node's epoch, so that the node
+// can't resume serving under it by heartbeating again. The increment is
+// a KV write, possibly to this very range, and is issued only once the
+// new lease has been applied.
+func (r *Range) requestLeaderLease(term uint64) <-chan struct{} {
+	r.Lock()
+	if pending := r.pendingLease; pending != nil {
+		r.Unlock()
+		return pending
+	}
+	done := make(chan struct{})
 	if !r.stopper.StartTask() {
-		return
+		r.Unlock()
+		close(done)
+		return done
 	}
-	defer r.stopper.FinishTask()
+	r.pendingLease = done
+	r.Unlock()
+
 	// Prepare a Raft command to get a leader lease for the replica
 	// of that group that lives in our store.
-	wallTime := r.rm.Clock().PhysicalNow()
-	// TODO: get this from configuration, either as a config flag
-	// or, later, dynamically adjusted.
-	duration := int64(defaultLeaderLeaseDuration)
 	idKey := makeCmdIDKey(proto.ClientCmdID{
-		WallTime: wallTime,
+		WallTime: r.rm.Clock().PhysicalNow(),
 		Random:   rand.Int63(),
 	})
 	cmd := proto.InternalRaftCommand{
@@ -1455,32 +2521,68 @@ func (r *Range) requestLeaderLease(term uint64) {
 	}
 	args := &proto.InternalLeaderLeaseRequest{
 		RequestHeader: proto.RequestHeader{Key: r.Desc().StartKey},
-		Lease: proto.Lease{
-			Expiration: wallTime + duration,
-			Duration:   duration,
-			Term:       term,
-			RaftNodeID: uint64(r.rm.RaftNodeID()),
-		},
+		Lease:         r.newLeaderLease(term),
 	}
 
 	if !cmd.Cmd.SetValue(args) {
 		log.Fatalf("%T is not a raft command", args)
 	}
 
-	// Propose the Raft command.
+	var prevLiveness *proto.Liveness
+	nl := r.rm.StoreContext().NodeLiveness
+	if prev := r.getLease(); nl != nil && prev != nil && prev.Epoch != 0 && prev.RaftNodeID != args.Lease.RaftNodeID {
+		prevNodeID, _ := DecodeRaftNodeID(multiraft.NodeID(prev.RaftNodeID))
+		if pl, ok := nl.GetLiveness(prevNodeID); ok && pl.Epoch == prev.Epoch && !nl.isLive(pl) {
+			prevLiveness = &pl
+		}
+	}
+
+	// Propose the Raft command, registering it as pending so that its
+	// application is signaled.
+	pending := &pendingCmd{
+		Reply:   args.CreateReply(),
+		raftCmd: cmd,
+		done:    make(chan error, 1),
+	}
+	r.Lock()
+	r.pendingCmds[idKey] = pending
+	r.Unlock()
 	errCh := r.rm.ProposeRaftCommand(idKey, cmd)
 
-	// Make sure we log a potential error from Raft.
-	r.stopper.RunWorker(func() {
+	go func() {
+		defer r.stopper.FinishTask()
+		var err error
 		select {
-		case err := <-errCh:
-			if err != nil {
-				log.Warning(err)
+		case err = <-errCh:
+			if err == nil {
+				select {
+				case err = <-pending.done:
+				case <-r.stopper.ShouldStop():
+					err = util.Errorf("range %d stopped before its leader lease was granted", r.Desc().RaftID)
+				}
 			}
 		case <-r.stopper.ShouldStop():
-			return
+			err = util.Errorf("range %d stopped before its leader lease was granted", r.Desc().RaftID)
 		}
-	})
+		// Make sure we log a potential error from Raft.
+		if err != nil {
+			log.Warning(err)
+		}
+		r.Lock()
+		if err != nil {
+			delete(r.pendingCmds, idKey)
+		}
+		r.pendingLease = nil
+		r.Unlock()
+		close(done)
+
+		if err == nil && prevLiveness != nil {
+			if err := nl.IncrementEpoch(*prevLiveness); err != nil {
+				log.Warningf("unable to increment epoch of node %d: %s", prevLiveness.NodeID, err)
+			}
+		}
+	}()
+	return done
 }
 
 // splitTrigger is called on a successful commit of an AdminSplit
@@ -1499,7 +2601,7 @@ func (r *Range) splitTrigger(batch engine.Engine, split *proto.SplitTrigger) err
 	if err != nil {
 		return util.Errorf("unable to fetch GC metadata: %s", err)
 	}
-	if err := engine.MVCCPutProto(batch, nil, engine.RangeGCMetadataKey(split.NewDesc.RaftID), proto.ZeroTimestamp, nil, gcMeta); err != nil {
+	if err := engine.MVCCPutProto(batch, nil, keys.RangeGCMetadataKey(split.NewDesc.RaftID), proto.ZeroTimestamp, nil, gcMeta); err != nil {
 		return util.Errorf("unable to copy GC metadata: %s", err)
 	}
 
@@ -1508,7 +2610,7 @@ func (r *Range) splitTrigger(batch engine.Engine, split *proto.SplitTrigger) err
 	if err != nil {
 		return util.Errorf("unable to fetch last verification timestamp: %s", err)
 	}
-	if err := engine.MVCCPutProto(batch, nil, engine.RangeLastVerificationTimestampKey(split.NewDesc.RaftID), proto.ZeroTimestamp, nil, &verifyTS); err != nil {
+	if err := engine.MVCCPutProto(batch, nil, keys.RangeLastVerificationTimestampKey(split.NewDesc.RaftID), proto.ZeroTimestamp, nil, &verifyTS); err != nil {
 		return util.Errorf("unable to copy last verification timestamp: %s", err)
 	}
 
@@ -1538,6 +2640,9 @@ func (r *Range) splitTrigger(batch engine.Engine, split *proto.SplitTrigger) err
 		return util.Errorf("unable to compute stats for new range after split: %s", err)
 	}
 	newRng.stats.SetMVCCStats(batch, ms)
+	// Writes to the new range are held back like those to the original
+	// until the zone configs are next applied.
+	newRng.SetBackpressureBytes(r.GetBackpressureBytes())
 
 	// Copy the timestamp cache into the new range.
 	r.Lock()
@@ -1595,10 +2700,22 @@ func (r *Range) changeReplicasTrigger(change *proto.ChangeReplicasTrigger) error
 	return nil
 }
 
+// confState returns the Raft configuration of the range with the given
+// descriptor. Learners are not part of the configuration.
+func confState(desc *proto.RangeDescriptor) raftpb.ConfState {
+	var cs raftpb.ConfState
+	for _, rep := range desc.Replicas {
+		if !rep.Learner {
+			cs.Nodes = append(cs.Nodes, uint64(MakeRaftNodeID(rep.NodeID, rep.StoreID)))
+		}
+	}
+	return cs
+}
+
 // InitialState implements the raft.Storage interface.
 func (r *Range) InitialState() (raftpb.HardState, raftpb.ConfState, error) {
 	var hs raftpb.HardState
-	found, err := engine.MVCCGetProto(r.rm.Engine(), engine.RaftHardStateKey(r.Desc().RaftID),
+	found, err := engine.MVCCGetProto(r.rm.RaftEngine(), keys.RaftHardStateKey(r.Desc().RaftID),
 		proto.ZeroTimestamp, true, nil, &hs)
 	if err != nil {
 		return raftpb.HardState{}, raftpb.ConfState{}, err
@@ -1621,9 +2738,7 @@ func (r *Range) InitialState() (raftpb.HardState, raftpb.ConfState, error) {
 	var cs raftpb.ConfState
 	// For uninitalized ranges, membership is unknown at this point.
 	if found || r.isInitialized() {
-		for _, rep := range r.Desc().Replicas {
-			cs.Nodes = append(cs.Nodes, uint64(MakeRaftNodeID(rep.NodeID, rep.StoreID)))
-		}
+		cs = confState(r.Desc())
 	}
 
 	return hs, cs, nil
@@ -1631,10 +2746,10 @@ func (r *Range) InitialState() (raftpb.HardState, raftpb.ConfState, error) {
 
 // loadLastIndex looks in the engine to find the last log index.
 func (r *Range) loadLastIndex() error {
-	logKey := engine.RaftLogPrefix(r.Desc().RaftID)
+	logKey := keys.RaftLogPrefix(r.Desc().RaftID)
 	// The log keys are encoded in descending order, so the first log
 	// entry in the database is the last one that was written.
-	kvs, err := engine.MVCCScan(r.rm.Engine(),
+	kvs, err := engine.MVCCScan(r.rm.RaftEngine(),
 		logKey, logKey.PrefixEnd(),
 		1, proto.ZeroTimestamp, true, nil)
 	if err != nil {
@@ -1672,9 +2787,9 @@ func (r *Range) Entries(lo, hi, maxBytes uint64) ([]raftpb.Entry, error) {
 	// requested entries. Reversing [lo, hi) gives us (hi, lo]; since
 	// MVCCScan is inclusive in the other direction we must increment both the
 	// start and end keys.
-	kvs, err := engine.MVCCScan(r.rm.Engine(),
-		engine.RaftLogKey(r.Desc().RaftID, hi).Next(),
-		engine.RaftLogKey(r.Desc().RaftID, lo).Next(),
+	kvs, err := engine.MVCCScan(r.rm.RaftEngine(),
+		keys.RaftLogKey(r.Desc().RaftID, hi).Next(),
+		keys.RaftLogKey(r.Desc().RaftID, lo).Next(),
 		0, proto.ZeroTimestamp, true, nil)
 	if err != nil {
 		return nil, err
@@ -1739,7 +2854,7 @@ func (r *Range) LastIndex() (uint64, error) {
 // and the dummy entries that make up the starting point of an empty log.
 func (r *Range) raftTruncatedState() (proto.RaftTruncatedState, error) {
 	ts := proto.RaftTruncatedState{}
-	ok, err := engine.MVCCGetProto(r.rm.Engine(), engine.RaftTruncatedStateKey(r.Desc().RaftID),
+	ok, err := engine.MVCCGetProto(r.rm.RaftEngine(), keys.RaftTruncatedStateKey(r.Desc().RaftID),
 		proto.ZeroTimestamp, true, nil, &ts)
 	if err != nil {
 		return ts, err
@@ -1770,7 +2885,7 @@ func (r *Range) FirstIndex() (uint64, error) {
 
 func loadAppliedIndex(eng engine.Engine, raftID int64) (uint64, error) {
 	appliedIndex := uint64(0)
-	v, err := engine.MVCCGet(eng, engine.RaftAppliedIndexKey(raftID),
+	v, err := engine.MVCCGet(eng, keys.RaftAppliedIndexKey(raftID),
 		proto.ZeroTimestamp, true, nil)
 	if err != nil {
 		return 0, err
@@ -1781,6 +2896,31 @@ func loadAppliedIndex(eng engine.Engine, raftID int64) (uint64, error) {
 	return appliedIndex, nil
 }
 
+func loadLeaseAppliedIndex(eng engine.Engine, raftID int64) (uint64, error) {
+	leaseAppliedIndex := uint64(0)
+	v, err := engine.MVCCGet(eng, keys.RaftLeaseAppliedIndexKey(raftID),
+		proto.ZeroTimestamp, true, nil)
+	if err != nil {
+		return 0, err
+	}
+	if v != nil {
+		_, leaseAppliedIndex = encoding.DecodeUint64(v.Bytes)
+	}
+	return leaseAppliedIndex, nil
+}
+
+// loadLeaderLease returns the range's persisted leader lease, or nil
+// if none was ever granted.
+func loadLeaderLease(eng engine.Engine, raftID int64) (*proto.Lease, error) {
+	lease := &proto.Lease{}
+	ok, err := engine.MVCCGetProto(eng, keys.RaftLeaderLeaseKey(raftID),
+		proto.ZeroTimestamp, true, nil, lease)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return lease, nil
+}
+
 // Snapshot implements the raft.Storage interface.
 func (r *Range) Snapshot() (raftpb.Snapshot, error) {
 	// Copy all the data from a consistent RocksDB snapshot into a RaftSnapshotData.
@@ -1798,7 +2938,7 @@ func (r *Range) Snapshot() (raftpb.Snapshot, error) {
 	// We ignore intents on the range descriptor (consistent=false) because we know
 	// they cannot be committed yet. (operations that modify range descriptors resolve their
 	// own intents when they commit).
-	ok, err := engine.MVCCGetProto(snap, engine.RangeDescriptorKey(r.Desc().StartKey),
+	ok, err := engine.MVCCGetProto(snap, keys.RangeDescriptorKey(r.Desc().StartKey),
 		r.rm.Clock().Now(), false, nil, &desc)
 	if err != nil {
 		return raftpb.Snapshot{}, util.Errorf("failed to get desc: %s", err)
@@ -1819,10 +2959,7 @@ func (r *Range) Snapshot() (raftpb.Snapshot, error) {
 	}
 
 	// Synthesize our raftpb.ConfState from desc.
-	var cs raftpb.ConfState
-	for _, rep := range desc.Replicas {
-		cs.Nodes = append(cs.Nodes, uint64(MakeRaftNodeID(rep.NodeID, rep.StoreID)))
-	}
+	cs := confState(&desc)
 
 	term, err := r.Term(appliedIndex)
 	if err != nil {
@@ -1841,16 +2978,17 @@ func (r *Range) Snapshot() (raftpb.Snapshot, error) {
 
 // Append implements the multiraft.WriteableGroupStorage interface.
 func (r *Range) Append(entries []raftpb.Entry) error {
-	batch := r.rm.Engine().NewBatch()
+	batch := engine.NewBatch(r.rm.RaftEngine())
+	defer batch.Close()
 	for _, ent := range entries {
-		err := engine.MVCCPutProto(batch, nil, engine.RaftLogKey(r.Desc().RaftID, ent.Index),
+		err := engine.MVCCPutProto(batch, nil, keys.RaftLogKey(r.Desc().RaftID, ent.Index),
 			proto.ZeroTimestamp, nil, &ent)
 		if err != nil {
 			return err
 		}
 	}
 	// TODO(bdarnell): if the last entry's index < lastIndex, delete any remaining old entries.
-	err := batch.Commit()
+	err := r.commitRaftLogBatch(batch)
 	if err != nil {
 		return err
 	}
@@ -1866,12 +3004,19 @@ func (r *Range) ApplySnapshot(snap raftpb.Snapshot) error {
 		return nil
 	}
 
+	raftID := r.Desc().RaftID
+	separateLog := r.hasSeparateRaftEngine()
+
 	// First, save the HardState.  The HardState must not be changed
-	// because it may record a previous vote cast by this node.
-	hardStateKey := engine.RaftHardStateKey(r.Desc().RaftID)
-	hardState, err := engine.MVCCGet(r.rm.Engine(), hardStateKey, proto.ZeroTimestamp, true, nil)
-	if err != nil {
-		return nil
+	// because it may record a previous vote cast by this node. If the
+	// Raft log is kept in a separate engine, it is left untouched.
+	hardStateKey := keys.RaftHardStateKey(raftID)
+	var hardState *proto.Value
+	if !separateLog {
+		hardState, err = engine.MVCCGet(r.rm.Engine(), hardStateKey, proto.ZeroTimestamp, true, nil)
+		if err != nil {
+			return nil
+		}
 	}
 
 	batch := engine.NewBatch(r.rm.Engine())
@@ -1883,29 +3028,55 @@ func (r *Range) ApplySnapshot(snap raftpb.Snapshot) error {
 		}
 	}
 
-	// Write the snapshot into the range.
+	// Write the snapshot into the range. The sender's Raft log is only
+	// included if it shares an engine with the sender's data; it is
+	// never written to the data engine if the log is kept separately.
+	var hasTruncatedState bool
 	for _, kv := range snapData.KV {
+		if isRaftLogKey(raftID, kv.Key) {
+			if separateLog {
+				continue
+			}
+			key, _, _ := engine.MVCCDecodeKey(kv.Key)
+			if key.Equal(keys.RaftTruncatedStateKey(raftID)) {
+				hasTruncatedState = true
+			}
+		}
 		if err := batch.Put(kv.Key, kv.Value); err != nil {
 			return err
 		}
 	}
 
-	// Restore the saved HardState.
-	if hardState == nil {
-		err := engine.MVCCDelete(batch, nil, hardStateKey, proto.ZeroTimestamp, nil)
-		if err != nil {
-			return err
+	// The log begins after the snapshot if the snapshot didn't carry one.
+	truncState := proto.RaftTruncatedState{
+		Index: snap.Metadata.Index,
+		Term:  snap.Metadata.Term,
+	}
+	if !separateLog {
+		if !hasTruncatedState {
+			if err := engine.MVCCPutProto(batch, nil, keys.RaftTruncatedStateKey(raftID),
+				proto.ZeroTimestamp, nil, &truncState); err != nil {
+				return err
+			}
 		}
-	} else {
-		err := engine.MVCCPut(batch, nil, hardStateKey, proto.ZeroTimestamp, *hardState, nil)
-		if err != nil {
-			return err
+
+		// Restore the saved HardState.
+		if hardState == nil {
+			err := engine.MVCCDelete(batch, nil, hardStateKey, proto.ZeroTimestamp, nil)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := engine.MVCCPut(batch, nil, hardStateKey, proto.ZeroTimestamp, *hardState, nil)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	// Read the updated range descriptor.
 	var desc proto.RangeDescriptor
-	if _, err := engine.MVCCGetProto(batch, engine.RangeDescriptorKey(r.Desc().StartKey),
+	if _, err := engine.MVCCGetProto(batch, keys.RangeDescriptorKey(r.Desc().StartKey),
 		r.rm.Clock().Now(), false, nil, &desc); err != nil {
 		return err
 	}
@@ -1914,9 +3085,41 @@ func (r *Range) ApplySnapshot(snap raftpb.Snapshot) error {
 		return err
 	}
 
+	// Discard the existing log in the separate Raft log engine; the log
+	// now begins after the snapshot.
+	if separateLog {
+		logBatch := engine.NewBatch(r.rm.RaftEngine())
+		logKey := keys.RaftLogPrefix(raftID)
+		if err := logBatch.Iterate(engine.MVCCEncodeKey(logKey), engine.MVCCEncodeKey(logKey.PrefixEnd()),
+			func(kv proto.RawKeyValue) (bool, error) {
+				return false, logBatch.Clear(kv.Key)
+			}); err != nil {
+			return err
+		}
+		if err := engine.MVCCPutProto(logBatch, nil, keys.RaftTruncatedStateKey(raftID),
+			proto.ZeroTimestamp, nil, &truncState); err != nil {
+			return err
+		}
+		if err := r.commitRaftLogBatch(logBatch); err != nil {
+			return err
+		}
+	}
+
 	// Save the descriptor and applied index to our member variables.
 	r.SetDesc(&desc)
 	atomic.StoreUint64(&r.appliedIndex, snap.Metadata.Index)
+	leaseAppliedIndex, err := loadLeaseAppliedIndex(r.rm.Engine(), raftID)
+	if err != nil {
+		return err
+	}
+	lease, err := loadLeaderLease(r.rm.Engine(), raftID)
+	if err != nil {
+		return err
+	}
+	r.Lock()
+	r.leaseAppliedIndex = leaseAppliedIndex
+	r.Unlock()
+	r.setLease(lease)
 
 	// TODO(bdarnell): extract the real last index.
 	// snap.Metadata.Index is the last applied index, but our snapshot may have given us
@@ -1928,8 +3131,23 @@ func (r *Range) ApplySnapshot(snap raftpb.Snapshot) error {
 
 // SetHardState implements the multiraft.WriteableGroupStorage interface.
 func (r *Range) SetHardState(st raftpb.HardState) error {
-	return engine.MVCCPutProto(r.rm.Engine(), nil, engine.RaftHardStateKey(r.Desc().RaftID),
-		proto.ZeroTimestamp, nil, &st)
+	batch := engine.NewBatch(r.rm.RaftEngine())
+	defer batch.Close()
+	if err := engine.MVCCPutProto(batch, nil, keys.RaftHardStateKey(r.Desc().RaftID),
+		proto.ZeroTimestamp, nil, &st); err != nil {
+		return err
+	}
+	return r.commitRaftLogBatch(batch)
+}
+
+// commitRaftLogBatch commits a batch of writes to the Raft log or
+// HardState, syncing it to stable storage if the store is configured
+// to sync Raft log appends.
+func (r *Range) commitRaftLogBatch(batch *engine.Batch) error {
+	if r.rm.StoreContext().SyncRaftLog {
+		return batch.CommitSync()
+	}
+	return batch.Commit()
 }
 
 // AdminSplit divides the range into into two ranges, using either
@@ -1985,7 +3203,7 @@ func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSpli
 	updatedDesc.EndKey = splitKey
 
 	log.Infof("initiating a split of range %d %s-%s at key %s", desc.RaftID,
-		proto.Key(desc.StartKey), proto.Key(desc.EndKey), splitKey)
+		keys.PrettyPrint(desc.StartKey), keys.PrettyPrint(desc.EndKey), keys.PrettyPrint(splitKey))
 
 	txnOpts := &client.TransactionOptions{
 		Name: fmt.Sprintf("split range %d at %s", desc.RaftID, splitKey),
@@ -1994,10 +3212,10 @@ func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSpli
 		// Create range descriptor for second half of split.
 		// Note that this put must go first in order to locate the
 		// transaction record on the correct range.
-		desc1Key := engine.RangeDescriptorKey(newDesc.StartKey)
+		desc1Key := keys.RangeDescriptorKey(newDesc.StartKey)
 		txn.Prepare(client.PutProtoCall(desc1Key, newDesc))
 		// Update existing range descriptor for first half of split.
-		desc2Key := engine.RangeDescriptorKey(updatedDesc.StartKey)
+		desc2Key := keys.RangeDescriptorKey(updatedDesc.StartKey)
 		txn.Prepare(client.PutProtoCall(desc2Key, &updatedDesc))
 		// Update range descriptor addressing record(s).
 		calls, err := SplitRangeAddressing(newDesc, &updatedDesc)
@@ -2114,11 +3332,11 @@ func (r *Range) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMerg
 	}
 	if err := r.rm.DB().RunTransaction(txnOpts, func(txn *client.Txn) error {
 		// Update the range descriptor for the receiving range.
-		desc1Key := engine.RangeDescriptorKey(updatedDesc.StartKey)
+		desc1Key := keys.RangeDescriptorKey(updatedDesc.StartKey)
 		txn.Prepare(client.PutProtoCall(desc1Key, &updatedDesc))
 
 		// Remove the range descriptor for the deleted range.
-		desc2Key := engine.RangeDescriptorKey(subsumedDesc.StartKey)
+		desc2Key := keys.RangeDescriptorKey(subsumedDesc.StartKey)
 		txn.Prepare(client.DeleteCall(desc2Key))
 
 		calls, err := MergeRangeAddressing(desc, &updatedDesc)
@@ -2150,14 +3368,241 @@ func (r *Range) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMerg
 	}
 }
 
+// AdminBulkLoad writes the supplied key/value pairs directly to the
+// range's engine in a single batch, avoiding a Raft proposal per key.
+// Since the data bypasses Raft, the range must have a single replica;
+// the replica lock is held throughout so that no replica can be added
+// until the load has completed, after which new replicas receive the
+// data via snapshot. The keys being loaded must not exist.
+// The range's stats are brought up to date afterwards via an
+// InternalRecomputeStats command.
+func (r *Range) AdminBulkLoad(args *proto.AdminBulkLoadRequest, reply *proto.AdminBulkLoadResponse) {
+	// Prevent replica changes for the duration of the load.
+	r.metaLock.Lock()
+	defer r.metaLock.Unlock()
+
+	desc := r.Desc()
+	if len(desc.Replicas) != 1 {
+		reply.SetGoError(util.Errorf("bulk load into range %d requires a single replica; range has %d",
+			desc.RaftID, len(desc.Replicas)))
+		return
+	}
+	if len(args.Rows) == 0 {
+		return
+	}
+
+	kvs := append([]proto.KeyValue(nil), args.Rows...)
+	sort.Sort(proto.KeyValueSlice(kvs))
+	for i, kv := range kvs {
+		if kv.Key.Less(keys.KeyLocalMax) {
+			reply.SetGoError(util.Errorf("cannot bulk load local key %q", kv.Key))
+			return
+		}
+		if !r.ContainsKey(kv.Key) {
+			reply.SetGoError(proto.NewRangeKeyMismatchError(kv.Key, kv.Key, desc))
+			return
+		}
+		if i > 0 && kv.Key.Equal(kvs[i-1].Key) {
+			reply.SetGoError(util.Errorf("duplicate key %q in bulk load", kv.Key))
+			return
+		}
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	}
+	start, end := kvs[0].Key, kvs[len(kvs)-1].Key.Next()
+
+	// Gate overlapping commands until the batch is written and write
+	// at a timestamp newer than any previous access to the span.
+	cmdKey, err := r.beginCmd(context.Background(), start, end, false, args.Header().Priority)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	defer func() {
+		r.Lock()
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+	}()
+	timestamp := r.rm.Clock().Now()
+	r.Lock()
+	rTS, wTS := r.tsCache.GetMax(start, end, proto.NoTxnMD5)
+	r.Unlock()
+	if !rTS.Less(timestamp) {
+		timestamp = rTS.Next()
+	}
+	if !wTS.Less(timestamp) {
+		timestamp = wTS.Next()
+	}
+
+	e := r.rm.Engine()
+	iter := e.NewIterator()
+	iter.Seek(engine.MVCCEncodeKey(start))
+	var existingKey proto.EncodedKey
+	if iter.Valid() && bytes.Compare(iter.Key(), engine.MVCCEncodeKey(end)) < 0 {
+		existingKey = iter.Key()
+	}
+	iter.Close()
+	if existingKey != nil {
+		reply.SetGoError(util.Errorf("bulk load into range %d overlaps existing key %q",
+			desc.RaftID, existingKey))
+		return
+	}
+
+	if err := writeBulkLoad(e, kvs, timestamp); err != nil {
+		reply.SetGoError(util.Errorf("bulk load into range %d failed: %s", desc.RaftID, err))
+		return
+	}
+	r.Lock()
+	r.tsCache.Add(start, end, timestamp, proto.NoTxnMD5, false /* !readOnly */)
+	r.Unlock()
+	reply.LoadTimestamp = timestamp
+
+	// Fold the loaded data into the range's stats.
+	statsArgs := &proto.InternalRecomputeStatsRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       desc.StartKey,
+			Timestamp: r.rm.Clock().Now(),
+			RaftID:    desc.RaftID,
+		},
+	}
+	if err := r.AddCmd(context.Background(), statsArgs, &proto.InternalRecomputeStatsResponse{}, true); err != nil {
+		reply.SetGoError(util.Errorf("unable to recompute stats of range %d after bulk load: %s",
+			desc.RaftID, err))
+	}
+}
+
+// AdminTransferLease hands the range's Raft leadership over to its
+// replica on the store given in the request. The new leader requests a
+// leader lease upon its election. Transferring leadership to the local
+// replica is a no-op, and transferring it to a node whose liveness
+// record has expired is refused.
+func (r *Range) AdminTransferLease(args *proto.AdminTransferLeaseRequest, reply *proto.AdminTransferLeaseResponse) {
+	desc := r.Desc()
+	var target *proto.Replica
+	for i := range desc.Replicas {
+		if desc.Replicas[i].StoreID == args.StoreID {
+			target = &desc.Replicas[i]
+			break
+		}
+	}
+	if target == nil {
+		reply.SetGoError(util.Errorf("store %d has no replica of range %d", args.StoreID, desc.RaftID))
+		return
+	}
+	if target.Learner {
+		reply.SetGoError(util.Errorf("replica of range %d on store %d is a learner", desc.RaftID, args.StoreID))
+		return
+	}
+	if target.StoreID == r.rm.StoreID() {
+		return
+	}
+	// Leadership handed to a node known to be dead would leave the range
+	// without a leader until an election times out.
+	if nl := r.rm.StoreContext().NodeLiveness; nl != nil {
+		if l, ok := nl.GetLiveness(target.NodeID); ok && !nl.isLive(l) {
+			reply.SetGoError(util.Errorf("node %d of store %d is not live", target.NodeID, args.StoreID))
+			return
+		}
+	}
+	if err := r.rm.TransferRaftLeadership(desc.RaftID, MakeRaftNodeID(target.NodeID, target.StoreID)); err != nil {
+		reply.SetGoError(err)
+	}
+}
+
+// writeBulkLoad writes kvs as MVCC values at the given timestamp to a
+// batch of the engine, which is committed in a single write so that
+// the loaded keys become visible atomically.
+func writeBulkLoad(e engine.Engine, kvs []proto.KeyValue, timestamp proto.Timestamp) error {
+	batch := e.NewBatch()
+	defer batch.Close()
+	for i := range kvs {
+		value := kvs[i].Value
+		// MVCCPut encodes the timestamp into the key.
+		value.Timestamp = nil
+		if err := engine.MVCCPut(batch, nil, kvs[i].Key, timestamp, value, nil); err != nil {
+			return err
+		}
+	}
+	return batch.Commit()
+}
+
+// learnerRetryOptions bound the wait for a new replica to catch up
+// with its range before it is promoted to a voter.
+var learnerRetryOptions = util.RetryOptions{
+	Backoff:     10 * time.Millisecond,
+	MaxBackoff:  time.Second,
+	Constant:    2,
+	MaxAttempts: 30,
+	UseV1Info:   true,
+}
+
 // ChangeReplicas adds or removes a replica of a range. The change is performed
 // in a distributed transaction and takes effect when that transaction is committed.
 // When removing a replica, only the NodeID and StoreID fields of the Replica are used.
-func (r *Range) ChangeReplicas(changeType proto.ReplicaChangeType, replica proto.Replica) error {
+//
+// A replica is added in two steps, so that it does not count toward
+// the range's quorum before it holds the range's data: it first joins
+// as a learner, which receives a snapshot and the Raft log from the
+// leader, and is promoted to a voter once it has caught up. Adding a
+// replica which is already a learner resumes at the first step.
+func (r *Range) ChangeReplicas(changeType proto.ReplicaChangeType, replica proto.Replica) (err error) {
 	// Only allow a single change per range at a time.
 	r.metaLock.Lock()
 	defer r.metaLock.Unlock()
+	defer func() { r.auditChangeReplicas(changeType, replica, err) }()
 
+	if changeType == proto.ADD_REPLICA {
+		if err := r.changeReplicas(proto.ADD_LEARNER, replica); err != nil {
+			return err
+		}
+		if err := r.waitForLearner(replica); err != nil {
+			return err
+		}
+	}
+	return r.changeReplicas(changeType, replica)
+}
+
+// auditChangeReplicas records a replica change and its outcome in the
+// audit log.
+func (r *Range) auditChangeReplicas(changeType proto.ReplicaChangeType, replica proto.Replica, err error) {
+	desc := r.Desc()
+	e := audit.Entry{
+		Event:  audit.EventChangeReplicas,
+		Key:    desc.StartKey.String(),
+		EndKey: desc.EndKey.String(),
+		Detail: fmt.Sprintf("%s %v", changeType, replica),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	audit.Log(e)
+}
+
+// waitForLearner waits until the learner replica has caught up with
+// the range's Raft log.
+func (r *Range) waitForLearner(replica proto.Replica) error {
+	raftID := r.Desc().RaftID
+	nodeID := MakeRaftNodeID(replica.NodeID, replica.StoreID)
+	retryOpts := learnerRetryOptions
+	retryOpts.Tag = fmt.Sprintf("range %d: wait for learner %d", raftID, nodeID)
+	err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
+		caughtUp, err := r.rm.LearnerCaughtUp(raftID, nodeID)
+		if err != nil || !caughtUp {
+			return util.RetryContinue, err
+		}
+		return util.RetryBreak, nil
+	})
+	if err != nil {
+		return util.Errorf("learner %v of range %d did not catch up: %s", replica, raftID, err)
+	}
+	return nil
+}
+
+// changeReplicas performs a single replica change; see ChangeReplicas.
+// ADD_REPLICA promotes a learner. The range's metaLock must be held.
+func (r *Range) changeReplicas(changeType proto.ReplicaChangeType, replica proto.Replica) error {
 	// Validate the request and prepare the new descriptor.
 	desc := r.Desc()
 	updatedDesc := *desc
@@ -2172,15 +3617,28 @@ func (r *Range) ChangeReplicas(changeType proto.ReplicaChangeType, replica proto
 			break
 		}
 	}
-	if changeType == proto.ADD_REPLICA {
+	switch changeType {
+	case proto.ADD_LEARNER:
+		if found != -1 && desc.Replicas[found].Learner {
+			// Re-adding a learner re-registers it with the Raft leader,
+			// which may have lost track of it.
+			break
+		}
 		// If the replica exists on the remote node, no matter in which store,
 		// abort the replica add.
 		if nodeUsed {
 			return util.Errorf("adding replica %v which is already present in range %d",
 				replica, desc.RaftID)
 		}
+		replica.Learner = true
 		updatedDesc.Replicas = append(updatedDesc.Replicas, replica)
-	} else if changeType == proto.REMOVE_REPLICA {
+	case proto.ADD_REPLICA:
+		if found == -1 || !desc.Replicas[found].Learner {
+			return util.Errorf("promoting replica %v which is not a learner of range %d",
+				replica, desc.RaftID)
+		}
+		updatedDesc.Replicas[found].Learner = false
+	case proto.REMOVE_REPLICA:
 		// If that exact node-store combination does not have the replica,
 		// abort the removal.
 		if found == -1 {
@@ -2197,7 +3655,7 @@ func (r *Range) ChangeReplicas(changeType proto.ReplicaChangeType, replica proto
 	err := r.rm.DB().RunTransaction(txnOpts, func(txn *client.Txn) error {
 		// Important: the range descriptor must be the first thing touched in the transaction
 		// so the transaction record is co-located with the range being modified.
-		descKey := engine.RangeDescriptorKey(updatedDesc.StartKey)
+		descKey := keys.RangeDescriptorKey(updatedDesc.StartKey)
 		txn.Prepare(client.PutProtoCall(descKey, &updatedDesc))
 
 		// TODO(bdarnell): call UpdateRangeAddressing
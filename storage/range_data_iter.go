@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/encoding"
@@ -42,33 +43,61 @@ type rangeDataIterator struct {
 }
 
 func newRangeDataIterator(r *Range, e engine.Engine) *rangeDataIterator {
+	return newKeyRangeDataIterator(rangeDataKeyRanges(r), e)
+}
+
+// newReplicatedRangeDataIterator returns an iterator like
+// newRangeDataIterator, but which omits the range-ID local keys, such
+// as the Raft log and hard state, whose contents may legitimately
+// differ between replicas of a range.
+func newReplicatedRangeDataIterator(r *Range, e engine.Engine) *rangeDataIterator {
+	return newKeyRangeDataIterator(rangeDataKeyRanges(r)[1:], e)
+}
+
+// rangeDataKeyRanges returns the key ranges which comprise all of the
+// range's data, beginning with the range-ID local keys.
+func rangeDataKeyRanges(r *Range) []keyRange {
 	r.RLock()
-	startKey := r.Desc().StartKey
-	endKey := r.Desc().EndKey
+	desc := r.Desc()
 	r.RUnlock()
+	return descDataKeyRanges(desc)
+}
+
+// descDataKeyRanges returns the key ranges which comprise all of the
+// data of the range with the supplied descriptor, beginning with the
+// range-ID local keys.
+func descDataKeyRanges(desc *proto.RangeDescriptor) []keyRange {
+	startKey := desc.StartKey
+	endKey := desc.EndKey
 	// The first range in the keyspace starts at KeyMin, which includes the node-local
 	// space. We need the original StartKey to find the range metadata, but the
 	// actual data starts at KeyLocalMax.
 	dataStartKey := startKey
-	if startKey.Equal(engine.KeyMin) {
-		dataStartKey = engine.KeyLocalMax
+	if startKey.Equal(keys.KeyMin) {
+		dataStartKey = keys.KeyLocalMax
 	}
-	ri := &rangeDataIterator{
-		ranges: []keyRange{
-			{
-				start: engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(r.Desc().RaftID)))),
-				end:   engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(r.Desc().RaftID+1)))),
-			},
-			{
-				start: engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, startKey))),
-				end:   engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, endKey))),
-			},
-			{
-				start: engine.MVCCEncodeKey(dataStartKey),
-				end:   engine.MVCCEncodeKey(endKey),
-			},
+	return []keyRange{
+		{
+			start: engine.MVCCEncodeKey(keys.MakeKey(keys.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(desc.RaftID)))),
+			end:   engine.MVCCEncodeKey(keys.MakeKey(keys.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(desc.RaftID+1)))),
 		},
-		iter: e.NewIterator(),
+		{
+			start: engine.MVCCEncodeKey(keys.MakeKey(keys.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, startKey))),
+			end:   engine.MVCCEncodeKey(keys.MakeKey(keys.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, endKey))),
+		},
+		{
+			start: engine.MVCCEncodeKey(dataStartKey),
+			end:   engine.MVCCEncodeKey(endKey),
+		},
+	}
+}
+
+// newKeyRangeDataIterator returns an iterator over the supplied key
+// ranges, which must be sorted and non-overlapping.
+func newKeyRangeDataIterator(ranges []keyRange, e engine.Engine) *rangeDataIterator {
+	ri := &rangeDataIterator{
+		ranges: ranges,
+		iter:   e.NewIterator(),
 	}
 	ri.iter.Seek(ri.ranges[ri.curIndex].start)
 	ri.advance()
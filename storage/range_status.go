@@ -0,0 +1,174 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/multiraft"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/coreos/etcd/raft"
+)
+
+// A RangeStatus describes the state of a store's replica of a range,
+// for use in diagnosing ranges which fail to make progress.
+type RangeStatus struct {
+	StoreID proto.StoreID         `json:"storeID"`
+	Desc    proto.RangeDescriptor `json:"desc"`
+	// Span renders the start and end keys of the range for display.
+	Span string `json:"span"`
+	// Initialized is false for a replica awaiting its initial snapshot.
+	Initialized bool `json:"initialized"`
+	// Lease is the most recent leader lease known to the replica, if any.
+	Lease *proto.Lease `json:"lease,omitempty"`
+	Raft  RaftStatus   `json:"raft"`
+	// PendingCmds is the number of the replica's commands awaiting
+	// their Raft commit.
+	PendingCmds int             `json:"pendingCmds"`
+	Stats       proto.MVCCStats `json:"stats"`
+	// QueueActivity lists the store's recent processing of the replica
+	// by its queues, oldest first.
+	QueueActivity []QueueEvent `json:"queueActivity,omitempty"`
+}
+
+// A RaftStatus describes the Raft state of a replica. Progress is
+// only known to the group's leader.
+type RaftStatus struct {
+	// State is the replica's Raft role, or empty if its Raft group has
+	// not been created.
+	State         string                `json:"state"`
+	LeaderNodeID  proto.NodeID          `json:"leaderNodeID"`
+	LeaderStoreID proto.StoreID         `json:"leaderStoreID"`
+	Term          uint64                `json:"term"`
+	Commit        uint64                `json:"commit"`
+	Applied       uint64                `json:"applied"`
+	LastIndex     uint64                `json:"lastIndex"`
+	Proposals     int                   `json:"proposals"`
+	Quiescent     bool                  `json:"quiescent"`
+	Progress      []RaftReplicaProgress `json:"progress,omitempty"`
+	// Error describes why the Raft state could not be determined.
+	Error string `json:"error,omitempty"`
+}
+
+// RaftReplicaProgress is the leader's view of the log replicated to a
+// member of the group.
+type RaftReplicaProgress struct {
+	NodeID  proto.NodeID  `json:"nodeID"`
+	StoreID proto.StoreID `json:"storeID"`
+	Match   uint64        `json:"match"`
+	Next    uint64        `json:"next"`
+	// Snapshot is true while the leader is sending the member a snapshot.
+	Snapshot bool `json:"snapshot"`
+}
+
+// raftStates names the Raft roles.
+var raftStates = map[raft.StateType]string{
+	raft.StateFollower:  "follower",
+	raft.StateCandidate: "candidate",
+	raft.StateLeader:    "leader",
+}
+
+// RangeStatuses returns the status of each of the store's replicas,
+// ordered by Raft ID. Replicas awaiting their initial snapshot are
+// included.
+func (s *Store) RangeStatuses() []RangeStatus {
+	s.mu.RLock()
+	rngs := make([]*Range, 0, len(s.ranges))
+	for _, rng := range s.ranges {
+		rngs = append(rngs, rng)
+	}
+	s.mu.RUnlock()
+	sort.Sort(rangesByRaftID(rngs))
+
+	statuses := make([]RangeStatus, len(rngs))
+	for i, rng := range rngs {
+		statuses[i] = s.rangeStatus(rng)
+	}
+	return statuses
+}
+
+// RangeStatus returns the status of the store's replica of the range
+// with the given Raft ID.
+func (s *Store) RangeStatus(raftID int64) (RangeStatus, error) {
+	rng, err := s.GetRange(raftID)
+	if err != nil {
+		return RangeStatus{}, err
+	}
+	return s.rangeStatus(rng), nil
+}
+
+func (s *Store) rangeStatus(rng *Range) RangeStatus {
+	desc := rng.Desc()
+	rng.RLock()
+	pendingCmds := len(rng.pendingCmds)
+	rng.RUnlock()
+	status := RangeStatus{
+		StoreID:       s.StoreID(),
+		Desc:          *desc,
+		Span:          keys.PrettyPrint(desc.StartKey) + "-" + keys.PrettyPrint(desc.EndKey),
+		Initialized:   rng.isInitialized(),
+		Lease:         rng.getLease(),
+		PendingCmds:   pendingCmds,
+		Stats:         rng.stats.GetMVCC(),
+		QueueActivity: s.queueActivity.forRange(desc.RaftID),
+		Raft: RaftStatus{
+			LastIndex: atomic.LoadUint64(&rng.lastIndex),
+		},
+	}
+
+	groupStatus, err := s.multiraft.GroupStatus(uint64(desc.RaftID))
+	if err != nil {
+		status.Raft.Error = err.Error()
+		return status
+	}
+	status.Raft.State = raftStates[groupStatus.RaftState]
+	status.Raft.LeaderNodeID, status.Raft.LeaderStoreID = DecodeRaftNodeID(groupStatus.Leader)
+	status.Raft.Term = groupStatus.Term
+	status.Raft.Commit = groupStatus.Commit
+	status.Raft.Applied = atomic.LoadUint64(&rng.appliedIndex)
+	status.Raft.Proposals = groupStatus.PendingCommands
+	status.Raft.Quiescent = groupStatus.Quiescent
+	for id, pr := range groupStatus.Progress {
+		nodeID, storeID := DecodeRaftNodeID(multiraft.NodeID(id))
+		status.Raft.Progress = append(status.Raft.Progress, RaftReplicaProgress{
+			NodeID:   nodeID,
+			StoreID:  storeID,
+			Match:    pr.Match,
+			Next:     pr.Next,
+			Snapshot: pr.State == raft.ProgressStateSnapshot,
+		})
+	}
+	sort.Sort(progressByStoreID(status.Raft.Progress))
+	return status
+}
+
+// rangesByRaftID implements sort.Interface, ordering by Raft ID.
+type rangesByRaftID []*Range
+
+func (r rangesByRaftID) Len() int           { return len(r) }
+func (r rangesByRaftID) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r rangesByRaftID) Less(i, j int) bool { return r[i].Desc().RaftID < r[j].Desc().RaftID }
+
+// progressByStoreID implements sort.Interface, ordering by store ID.
+type progressByStoreID []RaftReplicaProgress
+
+func (p progressByStoreID) Len() int           { return len(p) }
+func (p progressByStoreID) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p progressByStoreID) Less(i, j int) bool { return p[i].StoreID < p[j].StoreID }
@@ -30,6 +30,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/multiraft/storagetest"
 	"github.com/cockroachdb/cockroach/proto"
@@ -42,6 +43,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/log"
 	"github.com/coreos/etcd/raft"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 var (
@@ -66,8 +68,8 @@ var (
 func testRangeDescriptor() *proto.RangeDescriptor {
 	return &proto.RangeDescriptor{
 		RaftID:   1,
-		StartKey: engine.KeyMin,
-		EndKey:   engine.KeyMax,
+		StartKey: keys.KeyMin,
+		EndKey:   keys.KeyMax,
 		Replicas: []proto.Replica{
 			{
 				NodeID:  1,
@@ -193,20 +195,33 @@ func (tc *testContext) Stop() {
 
 // initConfigs creates default configuration entries.
 func initConfigs(e engine.Engine, t *testing.T) {
-	if err := engine.MVCCPutProto(e, nil, engine.KeyConfigAccountingPrefix, proto.MinTimestamp, nil, &testDefaultAcctConfig); err != nil {
+	if err := engine.MVCCPutProto(e, nil, keys.KeyConfigAccountingPrefix, proto.MinTimestamp, nil, &testDefaultAcctConfig); err != nil {
 		t.Fatal(err)
 	}
-	if err := engine.MVCCPutProto(e, nil, engine.KeyConfigPermissionPrefix, proto.MinTimestamp, nil, &testDefaultPermConfig); err != nil {
+	if err := engine.MVCCPutProto(e, nil, keys.KeyConfigPermissionPrefix, proto.MinTimestamp, nil, &testDefaultPermConfig); err != nil {
 		t.Fatal(err)
 	}
-	if err := engine.MVCCPutProto(e, nil, engine.KeyConfigZonePrefix, proto.MinTimestamp, nil, &testDefaultZoneConfig); err != nil {
+	if err := engine.MVCCPutProto(e, nil, keys.KeyConfigZonePrefix, proto.MinTimestamp, nil, &testDefaultZoneConfig); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// updateTestSystemConfig applies update to the system config most
+// recently gossiped and gossips the result.
+func updateTestSystemConfig(t *testing.T, g *gossip.Gossip, update func(*SystemConfig)) {
+	cfg, err := GetSystemConfig(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	update(cfg)
+	if err := g.AddInfo(gossip.KeySystemConfig, *cfg, time.Hour); err != nil {
 		t.Fatal(err)
 	}
 }
 
 func newTransaction(name string, baseKey proto.Key, userPriority int32,
 	isolation proto.IsolationType, clock *hlc.Clock) *proto.Transaction {
-	return proto.NewTransaction(name, engine.KeyAddress(baseKey), userPriority,
+	return proto.NewTransaction(name, keys.KeyAddress(baseKey), userPriority,
 		isolation, clock.Now(), clock.MaxOffset().Nanoseconds())
 }
 
@@ -248,14 +263,14 @@ func TestRangeContains(t *testing.T) {
 	if !r.ContainsKey(proto.Key("aa")) {
 		t.Errorf("expected range to contain key \"aa\"")
 	}
-	if !r.ContainsKey(engine.RangeDescriptorKey([]byte("aa"))) {
+	if !r.ContainsKey(keys.RangeDescriptorKey([]byte("aa"))) {
 		t.Errorf("expected range to contain range descriptor key for \"aa\"")
 	}
 	if !r.ContainsKeyRange(proto.Key("aa"), proto.Key("b")) {
 		t.Errorf("expected range to contain key range \"aa\"-\"b\"")
 	}
-	if !r.ContainsKeyRange(engine.RangeDescriptorKey([]byte("aa")),
-		engine.RangeDescriptorKey([]byte("b"))) {
+	if !r.ContainsKeyRange(keys.RangeDescriptorKey([]byte("aa")),
+		keys.RangeDescriptorKey([]byte("b"))) {
 		t.Errorf("expected range to contain key transaction range \"aa\"-\"b\"")
 	}
 }
@@ -271,14 +286,14 @@ func TestRangeCanService(t *testing.T) {
 
 	// Try a consensus read and verify error.
 	gArgs.ReadConsistency = proto.CONSENSUS
-	if err := tc.rng.AddCmd(gArgs, gReply, true); err == nil {
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err == nil {
 		t.Errorf("expected error on consensus read")
 	}
 
 	// Try an inconsistent read within a transaction.
 	gArgs.ReadConsistency = proto.INCONSISTENT
 	gArgs.Txn = newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
-	if err := tc.rng.AddCmd(gArgs, gReply, true); err == nil {
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err == nil {
 		t.Errorf("expected error on inconsistent read within a txn")
 	}
 
@@ -288,7 +303,7 @@ func TestRangeCanService(t *testing.T) {
 	splitTestRange(tc.store, proto.Key("a"), proto.Key("a"), t)
 	gArgs.Key = proto.Key("b")
 	gArgs.Txn = nil
-	err := tc.rng.AddCmd(gArgs, gReply, true)
+	err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true)
 	if _, ok := err.(*proto.RangeKeyMismatchError); !ok {
 		t.Errorf("expected range key mismatch error: %s", err)
 	}
@@ -311,13 +326,26 @@ func TestRangeGossipFirstRange(t *testing.T) {
 				return false
 			}
 			if key == gossip.KeyFirstRangeDescriptor &&
-				!reflect.DeepEqual(info.(proto.RangeDescriptor), *testRangeDescriptor()) {
-				t.Errorf("expected gossiped range locations to be equal: %+v vs %+v", info.(proto.RangeDescriptor), *testRangeDescriptor())
+				!reflect.DeepEqual(info.(FirstRangeDescriptor).Desc, *testRangeDescriptor()) {
+				t.Errorf("expected gossiped range locations to be equal: %+v vs %+v", info.(FirstRangeDescriptor).Desc, *testRangeDescriptor())
 			}
 			if key == gossip.KeyClusterID && info.(string) != tc.store.Ident.ClusterID {
 				t.Errorf("expected gossiped cluster ID %s; got %s", tc.store.Ident.ClusterID, info.(string))
 			}
 		}
+		key := gossip.MakeFirstRangeReplicaKey(tc.store.StoreID())
+		info, err := tc.gossip.GetInfo(key)
+		if err != nil {
+			log.Warningf("still waiting for first range gossip of key %s...", key)
+			return false
+		}
+		frd := info.(FirstRangeDescriptor)
+		if !reflect.DeepEqual(frd.Desc, *testRangeDescriptor()) {
+			t.Errorf("expected gossiped replica range locations to be equal: %+v vs %+v", frd.Desc, *testRangeDescriptor())
+		}
+		if appliedIndex := atomic.LoadUint64(&tc.rng.appliedIndex); frd.Sequence == 0 || frd.Sequence > appliedIndex {
+			t.Errorf("expected gossiped sequence in (0, %d]; got %d", appliedIndex, frd.Sequence)
+		}
 		return true
 	}, 500*time.Millisecond); err != nil {
 		t.Error(err)
@@ -325,7 +353,7 @@ func TestRangeGossipFirstRange(t *testing.T) {
 }
 
 // TestRangeGossipAllConfigs verifies that all config types are
-// gossiped.
+// gossiped together in the system config.
 func TestRangeGossipAllConfigs(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{
@@ -333,23 +361,21 @@ func TestRangeGossipAllConfigs(t *testing.T) {
 	}
 	tc.Start(t)
 	defer tc.Stop()
+	cfg, err := GetSystemConfig(tc.gossip)
+	if err != nil {
+		t.Fatal(err)
+	}
 	testData := []struct {
-		gossipKey string
+		configMap PrefixConfigMap
 		configs   []*PrefixConfig
 	}{
-		{gossip.KeyConfigAccounting, []*PrefixConfig{{engine.KeyMin, nil, &testDefaultAcctConfig}}},
-		{gossip.KeyConfigPermission, []*PrefixConfig{{engine.KeyMin, nil, &testDefaultPermConfig}}},
-		{gossip.KeyConfigZone, []*PrefixConfig{{engine.KeyMin, nil, &testDefaultZoneConfig}}},
+		{cfg.Accounting, []*PrefixConfig{{keys.KeyMin, nil, &testDefaultAcctConfig}}},
+		{cfg.Permissions, []*PrefixConfig{{keys.KeyMin, nil, &testDefaultPermConfig}}},
+		{cfg.Zones, []*PrefixConfig{{keys.KeyMin, nil, &testDefaultZoneConfig}}},
 	}
 	for _, test := range testData {
-		info, err := tc.gossip.GetInfo(test.gossipKey)
-		if err != nil {
-			t.Fatal(err)
-		}
-		configMap := info.(PrefixConfigMap)
-		expConfigs := []*PrefixConfig{test.configs[0]}
-		if !reflect.DeepEqual([]*PrefixConfig(configMap), expConfigs) {
-			t.Errorf("expected gossiped configs to be equal %s vs %s", configMap, expConfigs)
+		if !reflect.DeepEqual([]*PrefixConfig(test.configMap), test.configs) {
+			t.Errorf("expected gossiped configs to be equal %s vs %s", test.configMap, test.configs)
 		}
 	}
 }
@@ -366,7 +392,7 @@ func TestRangeGossipConfigWithMultipleKeyPrefixes(t *testing.T) {
 		Read:  []string{"spencer", "foo", "bar", "baz"},
 		Write: []string{"spencer"},
 	}
-	key := engine.MakeKey(engine.KeyConfigPermissionPrefix, proto.Key("/db1"))
+	key := keys.MakeKey(keys.KeyConfigPermissionPrefix, proto.Key("/db1"))
 	data, err := gogoproto.Marshal(db1Perm)
 	if err != nil {
 		t.Fatal(err)
@@ -381,15 +407,15 @@ func TestRangeGossipConfigWithMultipleKeyPrefixes(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	info, err := tc.gossip.GetInfo(gossip.KeyConfigPermission)
+	cfg, err := GetSystemConfig(tc.gossip)
 	if err != nil {
 		t.Fatal(err)
 	}
-	configMap := info.(PrefixConfigMap)
+	configMap := cfg.Permissions
 	expConfigs := []*PrefixConfig{
-		{engine.KeyMin, nil, &testDefaultPermConfig},
+		{keys.KeyMin, nil, &testDefaultPermConfig},
 		{proto.Key("/db1"), nil, db1Perm},
-		{proto.Key("/db2"), engine.KeyMin, &testDefaultPermConfig},
+		{proto.Key("/db2"), keys.KeyMin, &testDefaultPermConfig},
 	}
 	if !reflect.DeepEqual([]*PrefixConfig(configMap), expConfigs) {
 		t.Errorf("expected gossiped configs to be equal %s vs %s", configMap, expConfigs)
@@ -408,7 +434,7 @@ func TestRangeGossipConfigUpdates(t *testing.T) {
 		Read:  []string{"spencer"},
 		Write: []string{"spencer"},
 	}
-	key := engine.MakeKey(engine.KeyConfigPermissionPrefix, proto.Key("/db1"))
+	key := keys.MakeKey(keys.KeyConfigPermissionPrefix, proto.Key("/db1"))
 	data, err := gogoproto.Marshal(db1Perm)
 	if err != nil {
 		t.Fatal(err)
@@ -423,21 +449,55 @@ func TestRangeGossipConfigUpdates(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	info, err := tc.gossip.GetInfo(gossip.KeyConfigPermission)
+	cfg, err := GetSystemConfig(tc.gossip)
 	if err != nil {
 		t.Fatal(err)
 	}
-	configMap := info.(PrefixConfigMap)
+	configMap := cfg.Permissions
 	expConfigs := []*PrefixConfig{
-		{engine.KeyMin, nil, &testDefaultPermConfig},
+		{keys.KeyMin, nil, &testDefaultPermConfig},
 		{proto.Key("/db1"), nil, db1Perm},
-		{proto.Key("/db2"), engine.KeyMin, &testDefaultPermConfig},
+		{proto.Key("/db2"), keys.KeyMin, &testDefaultPermConfig},
 	}
 	if !reflect.DeepEqual([]*PrefixConfig(configMap), expConfigs) {
 		t.Errorf("expected gossiped configs to be equal %s vs %s", configMap, expConfigs)
 	}
 }
 
+// TestRangePermissions verifies that commands are checked against the
+// gossiped permission configs of the requesting user.
+func TestRangePermissions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	testCases := []struct {
+		user   string
+		read   bool
+		expErr bool
+	}{
+		// Internally issued commands execute as root.
+		{"", false, false},
+		{UserRoot, false, false},
+		{UserRoot, true, false},
+		// The default perm config grants only root permissions.
+		{"spencer", false, true},
+		{"spencer", true, true},
+	}
+	for i, test := range testCases {
+		args, reply := readOrWriteArgs(proto.Key(fmt.Sprintf("key-%d", i)), test.read, tc.rng.Desc().RaftID, tc.store.StoreID())
+		args.Header().User = test.user
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
+		if (err != nil) != test.expErr {
+			t.Errorf("%d: expected error %t; got %v", i, test.expErr, err)
+		}
+		if test.user == "" && args.Header().User != UserRoot {
+			t.Errorf("%d: expected command without a user to execute as %q; got %q", i, UserRoot, args.Header().User)
+		}
+	}
+}
+
 // getArgs returns a GetRequest and GetResponse pair addressed to
 // the default replica for the specified key.
 func getArgs(key []byte, raftID int64, storeID proto.StoreID) (*proto.GetRequest, *proto.GetResponse) {
@@ -645,7 +705,7 @@ func TestRangeUpdateTSCache(t *testing.T) {
 	tc.manualClock.Set(t0.Nanoseconds())
 	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
 	gArgs.Timestamp = tc.clock.Now()
-	err := tc.rng.AddCmd(gArgs, gReply, true)
+	err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true)
 	if err != nil {
 		t.Error(err)
 	}
@@ -654,7 +714,7 @@ func TestRangeUpdateTSCache(t *testing.T) {
 	tc.manualClock.Set(t1.Nanoseconds())
 	pArgs, pReply := putArgs([]byte("b"), []byte("1"), 1, tc.store.StoreID())
 	pArgs.Timestamp = tc.clock.Now()
-	err = tc.rng.AddCmd(pArgs, pReply, true)
+	err = tc.rng.AddCmd(context.Background(), pArgs, pReply, true)
 	if err != nil {
 		t.Error(err)
 	}
@@ -685,6 +745,14 @@ func TestRangeCommandQueue(t *testing.T) {
 	defer tc.Stop()
 	defer func() { TestingCommandFilter = nil }()
 
+	// Grant the user of the blocked commands permission to execute them.
+	fooPerm := &proto.PermConfig{Read: []string{"Foo"}, Write: []string{"Foo"}}
+	configMap, err := NewPrefixConfigMap([]*PrefixConfig{{keys.KeyMin, nil, fooPerm}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updateTestSystemConfig(t, tc.gossip, func(cfg *SystemConfig) { cfg.Permissions = configMap })
+
 	// Intercept commands with matching command IDs and block them.
 	blockingDone := make(chan struct{}, 1)
 	TestingCommandFilter = func(args proto.Request, reply proto.Response) bool {
@@ -715,7 +783,7 @@ func TestRangeCommandQueue(t *testing.T) {
 		go func() {
 			args, reply := readOrWriteArgs(key1, test.cmd1Read, tc.rng.Desc().RaftID, tc.store.StoreID())
 			args.Header().User = "Foo"
-			err := tc.rng.AddCmd(args, reply, true)
+			err := tc.rng.AddCmd(context.Background(), args, reply, true)
 			if err != nil {
 				t.Fatalf("test %d: %s", i, err)
 			}
@@ -726,7 +794,7 @@ func TestRangeCommandQueue(t *testing.T) {
 		cmd2Done := make(chan struct{})
 		go func() {
 			args, reply := readOrWriteArgs(key1, test.cmd2Read, tc.rng.Desc().RaftID, tc.store.StoreID())
-			err := tc.rng.AddCmd(args, reply, true)
+			err := tc.rng.AddCmd(context.Background(), args, reply, true)
 			if err != nil {
 				t.Fatalf("test %d: %s", i, err)
 			}
@@ -737,7 +805,7 @@ func TestRangeCommandQueue(t *testing.T) {
 		cmd3Done := make(chan struct{})
 		go func() {
 			args, reply := readOrWriteArgs(key2, true, tc.rng.Desc().RaftID, tc.store.StoreID())
-			err := tc.rng.AddCmd(args, reply, true)
+			err := tc.rng.AddCmd(context.Background(), args, reply, true)
 			if err != nil {
 				t.Fatalf("test %d: %s", i, err)
 			}
@@ -799,7 +867,7 @@ func TestRangeCommandQueueInconsistent(t *testing.T) {
 	go func() {
 		args, reply := putArgs(key, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
 		args.CmdID.Random = 1
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -811,7 +879,7 @@ func TestRangeCommandQueueInconsistent(t *testing.T) {
 	go func() {
 		args, reply := getArgs(key, tc.rng.Desc().RaftID, tc.store.StoreID())
 		args.ReadConsistency = proto.INCONSISTENT
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -836,6 +904,263 @@ func TestRangeCommandQueueInconsistent(t *testing.T) {
 	}
 }
 
+// TestRangeCommandQueueCancel verifies that a command whose context
+// expires while waiting in the command queue is abandoned without
+// being proposed, and that an expired caller stops waiting on a
+// proposed command, which still applies, with an ambiguous result.
+func TestRangeCommandQueueCancel(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer func() { TestingCommandFilter = nil }()
+	defer tc.Stop()
+	tc.manualClock.Set((1 * time.Second).Nanoseconds())
+
+	key := proto.Key("key1")
+	blockingDone := make(chan struct{})
+	TestingCommandFilter = func(args proto.Request, reply proto.Response) bool {
+		if args.Header().CmdID.Random == 1 {
+			<-blockingDone
+		}
+		return false
+	}
+
+	// The first put blocks while being applied; its caller gives up.
+	ctx1, cancel1 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel1()
+	args1, reply1 := putArgs(key, []byte("value1"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	args1.CmdID.Random = 1
+	if _, ok := tc.rng.AddCmd(ctx1, args1, reply1, true).(*proto.AmbiguousResultError); !ok {
+		t.Fatalf("expected ambiguous result for proposed command; got %v", reply1.GoError())
+	}
+
+	// The second put waits behind the first in the command queue.
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	args2, reply2 := putArgs(key, []byte("value2"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	args2.CmdID.Random = 2
+	if err := tc.rng.AddCmd(ctx2, args2, reply2, true); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded for queued command; got %v", err)
+	}
+
+	// Unblock the first put; a read waits for it to apply and must not
+	// see the abandoned second put.
+	close(blockingDone)
+	gArgs, gReply := getArgs(key, tc.rng.Desc().RaftID, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value1")) {
+		t.Errorf("expected value1; got %+v", gReply.Value)
+	}
+
+	// The abandoned put released its response cache entry and can be
+	// retried with the same command ID.
+	args2.Timestamp = tc.clock.Now()
+	reply2.Reset()
+	if err := tc.rng.AddCmd(context.Background(), args2, reply2, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRangeLeaseIndex verifies that a raft command is applied only if
+// its lease index exceeds the range's lease applied index and it was
+// proposed under the range's current leader lease, and that rejected
+// commands still advance the applied index.
+func TestRangeLeaseIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{dormantRaft: true}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	index := atomic.LoadUint64(&tc.rng.appliedIndex)
+	tc.rng.RLock()
+	leaseIndex := tc.rng.leaseAppliedIndex
+	tc.rng.RUnlock()
+	lease := &proto.Lease{Term: 1, RaftNodeID: uint64(tc.store.RaftNodeID())}
+	otherLease := &proto.Lease{Term: 1, RaftNodeID: uint64(MakeRaftNodeID(2, 2))}
+
+	testCases := []struct {
+		maxLeaseIndex uint64
+		proposerLease *proto.Lease
+		setLease      bool
+		expErr        interface{}
+	}{
+		// Lease indexes may be skipped.
+		{leaseIndex + 2, nil, false, nil},
+		// Commands at or below the lease applied index are rejected.
+		{leaseIndex + 2, nil, false, &leaseIndexError{}},
+		{leaseIndex + 1, nil, false, &leaseIndexError{}},
+		// Once a lease is granted, commands proposed without it or
+		// under another holder's lease are rejected.
+		{leaseIndex + 3, nil, true, &proto.NotLeaderError{}},
+		{leaseIndex + 3, otherLease, false, &proto.NotLeaderError{}},
+		{leaseIndex + 3, lease, false, nil},
+	}
+	for i, test := range testCases {
+		if test.setLease {
+			tc.rng.setLease(lease)
+		}
+		args, _ := putArgs(key, []byte(fmt.Sprintf("value%d", i)), 1, tc.store.StoreID())
+		args.Timestamp = tc.clock.Now()
+		args.CmdID = proto.ClientCmdID{WallTime: 1, Random: int64(i + 1)}
+		raftCmd := proto.InternalRaftCommand{
+			RaftID:        1,
+			MaxLeaseIndex: gogoproto.Uint64(test.maxLeaseIndex),
+			ProposerLease: test.proposerLease,
+		}
+		if !raftCmd.Cmd.SetValue(args) {
+			t.Fatalf("%d: unable to set raft command to %T", i, args)
+		}
+		index++
+		ab := newApplyBatch(tc.engine, false)
+		err := tc.rng.processRaftCommand(ab, makeCmdIDKey(args.CmdID), index, raftCmd)
+		if cErr := ab.commit(); cErr != nil {
+			t.Fatal(cErr)
+		}
+		if test.expErr == nil {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err)
+			}
+		} else if reflect.TypeOf(err) != reflect.TypeOf(test.expErr) {
+			t.Errorf("%d: expected %T; got %v", i, test.expErr, err)
+		}
+		if applied := atomic.LoadUint64(&tc.rng.appliedIndex); applied != index {
+			t.Errorf("%d: expected applied index %d; got %d", i, index, applied)
+		}
+	}
+
+	// Only the first and last commands were applied.
+	v, err := engine.MVCCGet(tc.engine, key, tc.clock.Now(), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil || string(v.Bytes) != "value5" {
+		t.Errorf("expected value5; got %+v", v)
+	}
+	if applied, err := loadLeaseAppliedIndex(tc.engine, 1); err != nil || applied != leaseIndex+3 {
+		t.Errorf("expected lease applied index %d; got %d, %v", leaseIndex+3, applied, err)
+	}
+	if applied, err := loadAppliedIndex(tc.engine, 1); err != nil || applied != index {
+		t.Errorf("expected applied index %d; got %d, %v", index, applied, err)
+	}
+}
+
+// TestRangeReproposeCmd verifies that a locally pending command which
+// reaches the Raft log below the lease applied index is proposed again
+// with a new lease index, and its proposer signaled once it applies.
+func TestRangeReproposeCmd(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	args, reply := putArgs(key, []byte("value1"), 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Propose a command carrying the lease index of the put above, as
+	// if it had been proposed before it and reached the log after it.
+	args, reply = putArgs(key, []byte("value2"), 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	args.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+	raftCmd := proto.InternalRaftCommand{
+		RaftID:        1,
+		MaxLeaseIndex: gogoproto.Uint64(1),
+	}
+	if !raftCmd.Cmd.SetValue(args) {
+		t.Fatalf("unable to set raft command to %T", args)
+	}
+	idKey := makeCmdIDKey(args.CmdID)
+	cmd := &pendingCmd{Reply: reply, raftCmd: raftCmd, done: make(chan error, 1)}
+	tc.rng.Lock()
+	tc.rng.pendingCmds[idKey] = cmd
+	tc.rng.Unlock()
+	if err := <-tc.store.ProposeRaftCommand(idKey, raftCmd); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-cmd.done; err != nil {
+		t.Fatalf("expected reproposed command to apply; got %s", err)
+	}
+	if err := reply.GoError(); err != nil {
+		t.Errorf("expected a successful reply after reproposal; got %s", err)
+	}
+	if lai := cmd.raftCmd.GetMaxLeaseIndex(); lai <= 1 {
+		t.Errorf("expected a new lease index above 1; got %d", lai)
+	}
+	v, err := engine.MVCCGet(tc.engine, key, tc.clock.Now(), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil || string(v.Bytes) != "value2" {
+		t.Errorf("expected value2; got %+v", v)
+	}
+}
+
+// TestRangeReproposeCmdRejected verifies that a command isn't proposed
+// again if the lease under which it was proposed has moved, or if its
+// timestamp has been closed since.
+func TestRangeReproposeCmdRejected(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{dormantRaft: true}
+	tc.Start(t)
+	defer tc.Stop()
+
+	lease := &proto.Lease{Term: 1, RaftNodeID: uint64(tc.store.RaftNodeID())}
+	otherLease := &proto.Lease{Term: 2, RaftNodeID: uint64(MakeRaftNodeID(2, 2))}
+	now := tc.clock.Now()
+
+	testCases := []struct {
+		proposerLease *proto.Lease
+		lease         *proto.Lease
+		closed        proto.Timestamp
+		expErr        interface{}
+	}{
+		// The lease moved while the command was in flight.
+		{lease, otherLease, proto.ZeroTimestamp, &proto.NotLeaderError{}},
+		// The command's timestamp was closed by later proposals.
+		{lease, lease, now, &proto.WriteTooOldError{}},
+	}
+	for i, test := range testCases {
+		tc.rng.setLease(test.lease)
+		tc.rng.Lock()
+		tc.rng.closedTS = test.closed
+		tc.rng.Unlock()
+
+		args, reply := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+		args.Timestamp = now
+		args.CmdID = proto.ClientCmdID{WallTime: 1, Random: int64(i + 1)}
+		raftCmd := proto.InternalRaftCommand{
+			RaftID:        1,
+			MaxLeaseIndex: gogoproto.Uint64(1),
+			ProposerLease: test.proposerLease,
+		}
+		if !raftCmd.Cmd.SetValue(args) {
+			t.Fatalf("%d: unable to set raft command to %T", i, args)
+		}
+		idKey := makeCmdIDKey(args.CmdID)
+		cmd := &pendingCmd{Reply: reply, raftCmd: raftCmd, done: make(chan error, 1)}
+		tc.rng.reproposeCmd(idKey, cmd)
+		if err := <-cmd.done; reflect.TypeOf(err) != reflect.TypeOf(test.expErr) {
+			t.Errorf("%d: expected %T; got %v", i, test.expErr, err)
+		}
+		if pl := cmd.raftCmd.ProposerLease; pl != test.proposerLease {
+			t.Errorf("%d: expected the proposer lease to be kept; got %+v", i, pl)
+		}
+		tc.rng.RLock()
+		_, pending := tc.rng.pendingCmds[idKey]
+		tc.rng.RUnlock()
+		if pending {
+			t.Errorf("%d: expected rejected command not to be pending", i)
+		}
+	}
+}
+
 // TestRangeUseTSCache verifies that write timestamps are upgraded
 // based on the read timestamp cache.
 func TestRangeUseTSCache(t *testing.T) {
@@ -848,12 +1173,12 @@ func TestRangeUseTSCache(t *testing.T) {
 	tc.manualClock.Set(t0.Nanoseconds())
 	args, reply := getArgs([]byte("a"), 1, tc.store.StoreID())
 	args.Timestamp = tc.clock.Now()
-	err := tc.rng.AddCmd(args, reply, true)
+	err := tc.rng.AddCmd(context.Background(), args, reply, true)
 	if err != nil {
 		t.Error(err)
 	}
 	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
-	err = tc.rng.AddCmd(pArgs, pReply, true)
+	err = tc.rng.AddCmd(context.Background(), pArgs, pReply, true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -875,12 +1200,12 @@ func TestRangeNoTSCacheInconsistent(t *testing.T) {
 	args, reply := getArgs([]byte("a"), 1, tc.store.StoreID())
 	args.Timestamp = tc.clock.Now()
 	args.ReadConsistency = proto.INCONSISTENT
-	err := tc.rng.AddCmd(args, reply, true)
+	err := tc.rng.AddCmd(context.Background(), args, reply, true)
 	if err != nil {
 		t.Error(err)
 	}
 	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
-	err = tc.rng.AddCmd(pArgs, pReply, true)
+	err = tc.rng.AddCmd(context.Background(), pArgs, pReply, true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -906,19 +1231,19 @@ func TestRangeNoTSCacheUpdateOnFailure(t *testing.T) {
 		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
 		pArgs.Txn = newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
 		pArgs.Timestamp = pArgs.Txn.Timestamp
-		if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
 			t.Fatalf("test %d: %s", i, err)
 		}
 
 		// Now attempt read or write.
 		args, reply := readOrWriteArgs(key, read, tc.rng.Desc().RaftID, tc.store.StoreID())
 		args.Header().Timestamp = tc.clock.Now() // later timestamp
-		if err := tc.rng.AddCmd(args, reply, true); err == nil {
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err == nil {
 			t.Errorf("test %d: expected failure", i)
 		}
 
 		// Write the intent again -- should not have its timestamp upgraded!
-		if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
 			t.Fatalf("test %d: %s", i, err)
 		}
 		if !pReply.Timestamp.Equal(pArgs.Timestamp) {
@@ -944,7 +1269,7 @@ func TestRangeNoTimestampIncrementWithinTxn(t *testing.T) {
 	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
 	gArgs.Txn = txn
 	gArgs.Timestamp = txn.Timestamp
-	if err := tc.rng.AddCmd(gArgs, gReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err != nil {
 		t.Fatal(err)
 	}
 
@@ -952,7 +1277,7 @@ func TestRangeNoTimestampIncrementWithinTxn(t *testing.T) {
 	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
 	pArgs.Txn = txn
 	pArgs.Timestamp = pArgs.Txn.Timestamp
-	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
 		t.Fatal(err)
 	}
 	if !pReply.Timestamp.Equal(pArgs.Timestamp) {
@@ -963,7 +1288,7 @@ func TestRangeNoTimestampIncrementWithinTxn(t *testing.T) {
 	pArgs.Txn = nil
 	expTS := pArgs.Timestamp
 	expTS.Logical++
-	if err := tc.rng.AddCmd(pArgs, pReply, true); err == nil {
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err == nil {
 		t.Errorf("expected write intent error")
 	}
 	if !pReply.Timestamp.Equal(expTS) {
@@ -997,7 +1322,7 @@ func TestRangeIdempotence(t *testing.T) {
 			} else {
 				args.CmdID = proto.ClientCmdID{WallTime: 1, Random: int64(idx + 100)}
 			}
-			err := tc.rng.AddCmd(&args, &reply, true)
+			err := tc.rng.AddCmd(context.Background(), &args, &reply, true)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1038,7 +1363,7 @@ func TestEndTransactionBeforeHeartbeat(t *testing.T) {
 		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
 		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
 		args.Timestamp = txn.Timestamp
-		if err := tc.rng.AddCmd(args, reply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 			t.Error(err)
 		}
 		expStatus := proto.COMMITTED
@@ -1052,7 +1377,7 @@ func TestEndTransactionBeforeHeartbeat(t *testing.T) {
 		// Try a heartbeat to the already-committed transaction; should get
 		// committed txn back, but without last heartbeat timestamp set.
 		hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
-		if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), hbArgs, hbReply, true); err != nil {
 			t.Error(err)
 		}
 		if hbReply.Txn.Status != expStatus || hbReply.Txn.LastHeartbeat != nil {
@@ -1076,7 +1401,7 @@ func TestEndTransactionAfterHeartbeat(t *testing.T) {
 		// Start out with a heartbeat to the transaction.
 		hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
 		hbArgs.Timestamp = txn.Timestamp
-		if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), hbArgs, hbReply, true); err != nil {
 			t.Error(err)
 		}
 		if hbReply.Txn.Status != proto.PENDING || hbReply.Txn.LastHeartbeat == nil {
@@ -1085,7 +1410,7 @@ func TestEndTransactionAfterHeartbeat(t *testing.T) {
 
 		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
 		args.Timestamp = txn.Timestamp
-		if err := tc.rng.AddCmd(args, reply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 			t.Error(err)
 		}
 		expStatus := proto.COMMITTED
@@ -1102,6 +1427,71 @@ func TestEndTransactionAfterHeartbeat(t *testing.T) {
 	}
 }
 
+// TestRangeOnePhaseCommit verifies that a batch of a transaction's
+// writes and its commit executes as a single command which leaves
+// neither intents nor a transaction record, and which writes nothing
+// if any of its commands fails.
+func TestRangeOnePhaseCommit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i, expValue := range []string{"", "value"} {
+		key := proto.Key(fmt.Sprintf("a%d", i))
+		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+		bArgs := &proto.BatchRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				EndKey:    key.Next().Next(),
+				Timestamp: txn.Timestamp,
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+				Txn:       txn,
+			},
+		}
+		pArgs, _ := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		bArgs.Add(pArgs)
+		cpArgs, _ := putArgs(key.Next(), []byte("value"), 1, tc.store.StoreID())
+		cput := &proto.ConditionalPutRequest{RequestHeader: cpArgs.RequestHeader, Value: cpArgs.Value}
+		if expValue == "" {
+			// The condition fails, failing the batch.
+			cput.ExpValue = &proto.Value{Bytes: []byte("missing")}
+		}
+		bArgs.Add(cput)
+		etArgs, _ := endTxnArgs(txn, true, 1, tc.store.StoreID())
+		bArgs.Add(etArgs)
+
+		bReply := &proto.BatchResponse{}
+		err := tc.rng.AddCmd(context.Background(), bArgs, bReply, true)
+		if expValue == "" {
+			if _, ok := err.(*proto.ConditionFailedError); !ok {
+				t.Errorf("%d: expected condition failed error; got %v", i, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		} else if bReply.Txn == nil || bReply.Txn.Status != proto.COMMITTED || len(bReply.Responses) != 3 {
+			t.Errorf("%d: expected committed txn and 3 responses; got %+v", i, bReply)
+		}
+
+		// Read the keys outside of a transaction, which fails on intents.
+		for _, k := range []proto.Key{key, key.Next()} {
+			gArgs, gReply := getArgs(k, 1, tc.store.StoreID())
+			gArgs.Timestamp = tc.clock.Now()
+			if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err != nil {
+				t.Fatalf("%d: unexpected error reading %q: %s", i, k, err)
+			}
+			if value := string(gReply.Value.GetBytes()); value != expValue {
+				t.Errorf("%d: expected %q at %q; got %q", i, expValue, k, value)
+			}
+		}
+		if ok, err := engine.MVCCGetProto(tc.engine, keys.TransactionKey(txn.Key, txn.ID),
+			proto.ZeroTimestamp, true, nil, &proto.Transaction{}); ok || err != nil {
+			t.Errorf("%d: expected no transaction record; got ok=%t, err=%v", i, ok, err)
+		}
+	}
+}
+
 // TestEndTransactionWithPushedTimestamp verifies that txn can be
 // ended (both commit or abort) correctly when the commit timestamp is
 // greater than the transaction timestamp, depending on the isolation
@@ -1129,7 +1519,7 @@ func TestEndTransactionWithPushedTimestamp(t *testing.T) {
 		args, reply := endTxnArgs(txn, test.commit, 1, tc.store.StoreID())
 		tc.manualClock.Set(1)
 		args.Timestamp = tc.clock.Now()
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if test.expErr {
 			if err == nil {
 				t.Errorf("expected error")
@@ -1166,7 +1556,7 @@ func TestEndTransactionWithIncrementedEpoch(t *testing.T) {
 	// Start out with a heartbeat to the transaction.
 	hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
 	hbArgs.Timestamp = txn.Timestamp
-	if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), hbArgs, hbReply, true); err != nil {
 		t.Error(err)
 	}
 
@@ -1175,7 +1565,7 @@ func TestEndTransactionWithIncrementedEpoch(t *testing.T) {
 	args.Timestamp = txn.Timestamp
 	args.Txn.Epoch = txn.Epoch + 1
 	args.Txn.Priority = txn.Priority + 1
-	if err := tc.rng.AddCmd(args, reply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 		t.Error(err)
 	}
 	if reply.Txn.Status != proto.COMMITTED {
@@ -1222,7 +1612,7 @@ func TestEndTransactionWithErrors(t *testing.T) {
 		existTxn.Status = test.existStatus
 		existTxn.Epoch = test.existEpoch
 		existTxn.Timestamp = test.existTS
-		txnKey := engine.TransactionKey(test.key, txn.ID)
+		txnKey := keys.TransactionKey(test.key, txn.ID)
 		if err := engine.MVCCPutProto(tc.rng.rm.Engine(), nil, txnKey, proto.ZeroTimestamp,
 			nil, &existTxn); err != nil {
 			t.Fatal(err)
@@ -1232,7 +1622,7 @@ func TestEndTransactionWithErrors(t *testing.T) {
 		txn.Key = test.key
 		args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
 		args.Timestamp = txn.Timestamp
-		verifyErrorMatches(tc.rng.AddCmd(args, reply, true), test.expErrRegexp, t)
+		verifyErrorMatches(tc.rng.AddCmd(context.Background(), args, reply, true), test.expErrRegexp, t)
 	}
 }
 
@@ -1248,7 +1638,7 @@ func TestInternalPushTxnBadKey(t *testing.T) {
 
 	args, reply := pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
 	args.Key = pusher.Key
-	verifyErrorMatches(tc.rng.AddCmd(args, reply, true), ".*should match pushee.*", t)
+	verifyErrorMatches(tc.rng.AddCmd(context.Background(), args, reply, true), ".*should match pushee.*", t)
 }
 
 // TestInternalPushTxnAlreadyCommittedOrAborted verifies success
@@ -1269,13 +1659,13 @@ func TestInternalPushTxnAlreadyCommittedOrAborted(t *testing.T) {
 		// End the pushee's transaction.
 		etArgs, etReply := endTxnArgs(pushee, status == proto.COMMITTED, 1, tc.store.StoreID())
 		etArgs.Timestamp = pushee.Timestamp
-		if err := tc.rng.AddCmd(etArgs, etReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), etArgs, etReply, true); err != nil {
 			t.Fatal(err)
 		}
 
 		// Now try to push what's already committed or aborted.
 		args, reply := pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
-		if err := tc.rng.AddCmd(args, reply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 			t.Fatal(err)
 		}
 		if reply.PusheeTxn.Status != status {
@@ -1326,7 +1716,7 @@ func TestInternalPushTxnUpgradeExistingTxn(t *testing.T) {
 		pushee.Timestamp = test.startTS
 		hbArgs, hbReply := heartbeatArgs(pushee, 1, tc.store.StoreID())
 		hbArgs.Timestamp = pushee.Timestamp
-		if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), hbArgs, hbReply, true); err != nil {
 			t.Fatal(err)
 		}
 
@@ -1334,7 +1724,7 @@ func TestInternalPushTxnUpgradeExistingTxn(t *testing.T) {
 		pushee.Epoch = test.epoch
 		pushee.Timestamp = test.ts
 		args, reply := pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
-		if err := tc.rng.AddCmd(args, reply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 			t.Fatal(err)
 		}
 		expTxn := gogoproto.Clone(pushee).(*proto.Transaction)
@@ -1384,7 +1774,7 @@ func TestInternalPushTxnHeartbeatTimeout(t *testing.T) {
 		if test.heartbeat != nil {
 			hbArgs, hbReply := heartbeatArgs(pushee, 1, tc.store.StoreID())
 			hbArgs.Timestamp = *test.heartbeat
-			if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+			if err := tc.rng.AddCmd(context.Background(), hbArgs, hbReply, true); err != nil {
 				t.Fatal(err)
 			}
 		}
@@ -1392,7 +1782,7 @@ func TestInternalPushTxnHeartbeatTimeout(t *testing.T) {
 		// Now, attempt to push the transaction with clock set to "currentTime".
 		tc.manualClock.Set(test.currentTime)
 		args, reply := pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if test.expSuccess != (err == nil) {
 			t.Errorf("expected success on trial %d? %t; got err %s", i, test.expSuccess, err)
 		}
@@ -1435,14 +1825,14 @@ func TestInternalPushTxnOldEpoch(t *testing.T) {
 		pushee.Epoch = test.curEpoch
 		hbArgs, hbReply := heartbeatArgs(pushee, 1, tc.store.StoreID())
 		hbArgs.Timestamp = pushee.Timestamp
-		if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), hbArgs, hbReply, true); err != nil {
 			t.Fatal(err)
 		}
 
 		// Now, attempt to push the transaction with intent epoch set appropriately.
 		pushee.Epoch = test.intentEpoch
 		args, reply := pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if test.expSuccess != (err == nil) {
 			t.Errorf("expected success on trial %d? %t; got err %s", i, test.expSuccess, err)
 		}
@@ -1489,6 +1879,11 @@ func TestInternalPushTxnPriorities(t *testing.T) {
 		// With same priorities, newer txn timestamp fails.
 		{1, 1, ts2, ts1, true, false},
 		{1, 1, ts2, ts1, false, false},
+		// MaxPriority pusher always succeeds against a lower priority.
+		{proto.MaxPriority, proto.MaxPriority - 1, ts2, ts1, true, true},
+		// MaxPriority pushes are decided by txn timestamp.
+		{proto.MaxPriority, proto.MaxPriority, ts1, ts2, true, true},
+		{proto.MaxPriority, proto.MaxPriority, ts2, ts1, true, false},
 	}
 
 	for i, test := range testCases {
@@ -1502,7 +1897,7 @@ func TestInternalPushTxnPriorities(t *testing.T) {
 
 		// Now, attempt to push the transaction with intent epoch set appropriately.
 		args, reply := pushTxnArgs(pusher, pushee, test.abort, 1, tc.store.StoreID())
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if test.expSuccess != (err == nil) {
 			t.Errorf("expected success on trial %d? %t; got err %s", i, test.expSuccess, err)
 		}
@@ -1533,7 +1928,7 @@ func TestInternalPushTxnPushTimestamp(t *testing.T) {
 
 	// Now, push the transaction with args.Abort=false.
 	args, reply := pushTxnArgs(pusher, pushee, false /* abort */, 1, tc.store.StoreID())
-	if err := tc.rng.AddCmd(args, reply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 		t.Errorf("unexpected error on push: %s", err)
 	}
 	expTS := pusher.Timestamp
@@ -1565,7 +1960,7 @@ func TestInternalPushTxnPushTimestampAlreadyPushed(t *testing.T) {
 
 	// Now, push the transaction with args.Abort=false.
 	args, reply := pushTxnArgs(pusher, pushee, false /* abort */, 1, tc.store.StoreID())
-	if err := tc.rng.AddCmd(args, reply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
 		t.Errorf("unexpected error on push: %s", err)
 	}
 	if !reply.PusheeTxn.Timestamp.Equal(pushee.Timestamp) {
@@ -1610,7 +2005,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	// Put a value.
 	pArgs, pReply := putArgs([]byte("a"), []byte("value1"), 1, tc.store.StoreID())
 	pArgs.Timestamp = tc.clock.Now()
-	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
 		t.Fatal(err)
 	}
 	expMS := proto.MVCCStats{LiveBytes: 39, KeyBytes: 15, ValBytes: 24, IntentBytes: 0, LiveCount: 1, KeyCount: 1, ValCount: 1, IntentCount: 0}
@@ -1620,7 +2015,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	pArgs, pReply = putArgs([]byte("b"), []byte("value2"), 1, tc.store.StoreID())
 	pArgs.Timestamp = tc.clock.Now()
 	pArgs.Txn = &proto.Transaction{ID: []byte("txn1"), Timestamp: pArgs.Timestamp}
-	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
 		t.Fatal(err)
 	}
 	expMS = proto.MVCCStats{LiveBytes: 118, KeyBytes: 30, ValBytes: 88, IntentBytes: 24, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 1}
@@ -1638,7 +2033,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	}
 	rArgs.Txn.Status = proto.COMMITTED
 	rReply := &proto.InternalResolveIntentResponse{}
-	if err := tc.rng.AddCmd(rArgs, rReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), rArgs, rReply, true); err != nil {
 		t.Fatal(err)
 	}
 	expMS = proto.MVCCStats{LiveBytes: 78, KeyBytes: 30, ValBytes: 48, IntentBytes: 0, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 0}
@@ -1647,13 +2042,78 @@ func TestRangeStatsComputation(t *testing.T) {
 	// Delete the 1st value.
 	dArgs, dReply := deleteArgs([]byte("a"), 1, tc.store.StoreID())
 	dArgs.Timestamp = tc.clock.Now()
-	if err := tc.rng.AddCmd(dArgs, dReply, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), dArgs, dReply, true); err != nil {
 		t.Fatal(err)
 	}
 	expMS = proto.MVCCStats{LiveBytes: 39, KeyBytes: 42, ValBytes: 50, IntentBytes: 0, LiveCount: 1, KeyCount: 2, ValCount: 3, IntentCount: 0}
 	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
 }
 
+// TestRangeClearRange verifies that ClearRange removes all versions of
+// the keys in its span, adjusting the range stats accordingly, and
+// refuses transactional requests and spans overlapping system keys.
+func TestRangeClearRange(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{
+		bootstrapMode: bootstrapRangeOnly,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, key := range []string{"a", "b", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value1"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	newClearRangeArgs := func(key, endKey proto.Key) (*proto.ClearRangeRequest, *proto.ClearRangeResponse) {
+		return &proto.ClearRangeRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				EndKey:    endKey,
+				Timestamp: tc.clock.Now(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+		}, &proto.ClearRangeResponse{}
+	}
+
+	testCases := []struct {
+		key, endKey proto.Key
+		txn         *proto.Transaction
+		expErr      string
+	}{
+		{proto.Key("a"), proto.Key("c"), &proto.Transaction{ID: []byte("txn")}, "cannot be part of a transaction"},
+		{keys.KeyMin, proto.Key("c"), nil, "overlapping system keys"},
+		{proto.Key("a"), nil, nil, "requires an end key"},
+	}
+	for i, test := range testCases {
+		cArgs, cReply := newClearRangeArgs(test.key, test.endKey)
+		cArgs.Txn = test.txn
+		err := tc.rng.AddCmd(context.Background(), cArgs, cReply, true)
+		if err == nil || !strings.Contains(err.Error(), test.expErr) {
+			t.Errorf("%d: expected error %q; got %v", i, test.expErr, err)
+		}
+	}
+
+	cArgs, cReply := newClearRangeArgs(proto.Key("a"), proto.Key("c"))
+	if err := tc.rng.AddCmd(context.Background(), cArgs, cReply, true); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := engine.Scan(tc.engine, engine.MVCCEncodeKey(proto.Key("a")), engine.MVCCEncodeKey(proto.Key("c")), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 0 {
+		t.Errorf("expected the span to be empty; got %d entries", len(kvs))
+	}
+	// Only the value of "c" remains.
+	expMS := proto.MVCCStats{LiveBytes: 39, KeyBytes: 15, ValBytes: 24, IntentBytes: 0, LiveCount: 1, KeyCount: 1, ValCount: 1, IntentCount: 0}
+	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+}
+
 // TestInternalMerge verifies that the InternalMerge command is behaving as
 // expected. Merge semantics for different data types are tested more robustly
 // at the engine level; this test is intended only to show that values passed to
@@ -1671,13 +2131,13 @@ func TestInternalMerge(t *testing.T) {
 	for _, str := range stringArgs {
 		mergeArgs, resp := internalMergeArgs(key, proto.Value{Bytes: []byte(str)}, 1,
 			tc.store.StoreID())
-		if err := tc.rng.AddCmd(mergeArgs, resp, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), mergeArgs, resp, true); err != nil {
 			t.Fatalf("unexpected error from InternalMerge: %s", err.Error())
 		}
 	}
 
 	getArgs, resp := getArgs(key, 1, tc.store.StoreID())
-	if err := tc.rng.AddCmd(getArgs, resp, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), getArgs, resp, true); err != nil {
 		t.Fatalf("unexpected error from Get: %s", err.Error())
 	}
 	if resp.Value == nil {
@@ -1701,7 +2161,7 @@ func TestInternalTruncateLog(t *testing.T) {
 	var indexes []uint64
 	for i := 0; i < 10; i++ {
 		args, resp := incrementArgs([]byte("a"), int64(i), 1, tc.store.StoreID())
-		if err := tc.rng.AddCmd(args, resp, true); err != nil {
+		if err := tc.rng.AddCmd(context.Background(), args, resp, true); err != nil {
 			t.Fatal(err)
 		}
 		idx, err := tc.rng.LastIndex()
@@ -1713,7 +2173,7 @@ func TestInternalTruncateLog(t *testing.T) {
 
 	// Discard the first half of the log
 	truncateArgs, truncateResp := internalTruncateLogArgs(indexes[5], 1, tc.store.StoreID())
-	if err := tc.rng.AddCmd(truncateArgs, truncateResp, true); err != nil {
+	if err := tc.rng.AddCmd(context.Background(), truncateArgs, truncateResp, true); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1757,6 +2217,69 @@ func TestInternalTruncateLog(t *testing.T) {
 	}
 }
 
+// TestInternalCheckConsistency verifies that the first phase of a
+// consistency check returns and retains a checksum of the range's data
+// which reflects subsequent writes, and that the second phase consumes
+// the retained checksum.
+func TestInternalCheckConsistency(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	computeArgs := func(id string) (*proto.InternalCheckConsistencyRequest, *proto.InternalCheckConsistencyResponse) {
+		return &proto.InternalCheckConsistencyRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:     tc.rng.Desc().StartKey,
+				RaftID:  1,
+				Replica: proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			ChecksumID: []byte(id),
+		}, &proto.InternalCheckConsistencyResponse{}
+	}
+
+	args, resp := computeArgs("a")
+	if err := tc.rng.AddCmd(context.Background(), args, resp, true); err != nil {
+		t.Fatal(err)
+	}
+	sumA := resp.Checksum
+	if len(sumA) == 0 {
+		t.Fatal("expected a checksum")
+	}
+	if !bytes.Equal(tc.rng.checksum.id, []byte("a")) || !bytes.Equal(tc.rng.checksum.sum, sumA) {
+		t.Errorf("expected checksum %x to be retained; got %+v", sumA, tc.rng.checksum)
+	}
+
+	// A write changes the checksum.
+	pArgs, pResp := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pResp, true); err != nil {
+		t.Fatal(err)
+	}
+	args, resp = computeArgs("b")
+	if err := tc.rng.AddCmd(context.Background(), args, resp, true); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(resp.Checksum, sumA) {
+		t.Errorf("expected checksum to change after write")
+	}
+
+	// Verifying a mismatched checksum is logged, not returned as an
+	// error, and clears the retained checksum.
+	args, _ = computeArgs("b")
+	args.Checksum = sumA
+	if err := tc.rng.AddCmd(context.Background(), args, &proto.InternalCheckConsistencyResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	if tc.rng.checksum.id != nil {
+		t.Errorf("expected retained checksum to be cleared; got %+v", tc.rng.checksum)
+	}
+
+	// A full check on a consistent range succeeds.
+	if err := tc.rng.CheckConsistency(); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestRaftStorage(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	var tc testContext
@@ -1812,6 +2335,183 @@ func TestConditionFailedError(t *testing.T) {
 	}
 }
 
+// TestRangeIncrementReturnPrevious verifies that Increment returns
+// the value prior to the increment when requested.
+func TestRangeIncrementReturnPrevious(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i, expPrev := range []int64{0, 5, 10} {
+		args, reply := incrementArgs([]byte("a"), 5, 1, tc.store.StoreID())
+		args.ReturnPrevious = true
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if reply.PreviousValue != expPrev || reply.NewValue != expPrev+5 {
+			t.Errorf("%d: expected previous %d and new %d; got %d and %d",
+				i, expPrev, expPrev+5, reply.PreviousValue, reply.NewValue)
+		}
+	}
+}
+
+// TestRangeScanLimits verifies that Scan stops at its row and byte
+// limits and returns the remainder of the span as its resume span.
+func TestRangeScanLimits(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		args, reply := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		args.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// All rows have the same size, including the timestamps of their
+	// values.
+	args, reply := scanArgs([]byte("a"), []byte("b"), 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil || len(reply.Rows) != 1 {
+		t.Fatalf("unexpected scan result %+v: %v", reply.Rows, err)
+	}
+	rowSize := int64(reply.Rows[0].Size())
+
+	testCases := []struct {
+		end                     string
+		maxResults, targetBytes int64
+		expRows                 int
+		expResume               *proto.Span
+	}{
+		{"e", 0, 0, 4, nil},
+		{"e", 2, 0, 2, &proto.Span{Key: proto.Key("b").Next(), EndKey: proto.Key("e")}},
+		{"e", 4, 0, 4, &proto.Span{Key: proto.Key("d").Next(), EndKey: proto.Key("e")}},
+		{"e", 5, 0, 4, nil},
+		// No resume span if nothing of the span remains.
+		{"d\x00", 4, 0, 4, nil},
+		{"e", 0, 1, 1, &proto.Span{Key: proto.Key("a").Next(), EndKey: proto.Key("e")}},
+		{"e", 0, 2*rowSize + 1, 3, &proto.Span{Key: proto.Key("c").Next(), EndKey: proto.Key("e")}},
+		{"e", 2, 3 * rowSize, 2, &proto.Span{Key: proto.Key("b").Next(), EndKey: proto.Key("e")}},
+	}
+	for i, test := range testCases {
+		args, reply := scanArgs([]byte("a"), []byte(test.end), 1, tc.store.StoreID())
+		args.Timestamp = tc.clock.Now()
+		args.MaxResults = test.maxResults
+		args.TargetBytes = test.targetBytes
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if len(reply.Rows) != test.expRows {
+			t.Errorf("%d: expected %d rows; got %d", i, test.expRows, len(reply.Rows))
+		}
+		if !reflect.DeepEqual(reply.ResumeSpan, test.expResume) {
+			t.Errorf("%d: expected resume span %+v; got %+v", i, test.expResume, reply.ResumeSpan)
+		}
+	}
+}
+
+// TestRangeAggregate verifies that Aggregate counts keys and sums
+// their integer values up to the requested bound, and fails on
+// non-integer values.
+func TestRangeAggregate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i, key := range []string{"a", "b", "c", "d"} {
+		args, reply := incrementArgs([]byte(key), int64(i+1), 1, tc.store.StoreID())
+		args.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	aggregateArgs := func(start, end string, max int64) (*proto.AggregateRequest, *proto.AggregateResponse) {
+		return &proto.AggregateRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       proto.Key(start),
+				EndKey:    proto.Key(end),
+				Timestamp: tc.clock.Now(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			MaxResults: max,
+		}, &proto.AggregateResponse{}
+	}
+
+	testCases := []struct {
+		start, end   string
+		max          int64
+		expNum, expS int64
+	}{
+		{"a", "e", 0, 4, 10},
+		{"b", "d", 0, 2, 5},
+		{"a", "e", 3, 3, 6},
+		{"e", "z", 0, 0, 0},
+	}
+	for i, test := range testCases {
+		args, reply := aggregateArgs(test.start, test.end, test.max)
+		if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if reply.NumKeys != test.expNum || reply.Sum != test.expS {
+			t.Errorf("%d: expected %d keys summing to %d; got %d and %d",
+				i, test.expNum, test.expS, reply.NumKeys, reply.Sum)
+		}
+	}
+
+	pArgs, pReply := putArgs([]byte("bb"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	args, reply := aggregateArgs("a", "e", 0)
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err == nil {
+		t.Error("expected error aggregating a non-integer value")
+	}
+}
+
+// TestRangeInitPut verifies that InitPut succeeds for a missing key or
+// an identical value and otherwise bubbles up a ConditionFailedError.
+func TestRangeInitPut(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("k")
+	initPutArgs := func(value []byte) (*proto.InitPutRequest, *proto.InitPutResponse) {
+		return &proto.InitPutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				Timestamp: proto.MinTimestamp,
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			Value: proto.Value{
+				Bytes: value,
+			},
+		}, &proto.InitPutResponse{}
+	}
+
+	for i := 0; i < 2; i++ {
+		args, reply := initPutArgs([]byte("quack"))
+		if err := tc.rng.executeCmd(0, args, reply); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+	}
+	args, reply := initPutArgs([]byte("moo"))
+	err := tc.rng.executeCmd(0, args, reply)
+	if cErr, ok := err.(*proto.ConditionFailedError); !ok {
+		t.Fatalf("expected ConditionFailedError, got %T with content %+v", err, err)
+	} else if v := cErr.ActualValue; v == nil || !bytes.Equal(v.Bytes, []byte("quack")) {
+		t.Errorf("ConditionFailedError with bytes %q expected, but got %+v", "quack", v)
+	}
+}
+
 // TestReplicaSetsEqual tests to ensure that intersectReplicaSets
 // returns the correct responses.
 func TestReplicaSetsEqual(t *testing.T) {
@@ -1851,7 +2551,7 @@ func TestAppliedIndex(t *testing.T) {
 	var sum int64
 	for i := int64(1); i <= 10; i++ {
 		args, reply := incrementArgs([]byte("a"), i, 1, tc.store.StoreID())
-		err := tc.rng.AddCmd(args, reply, true)
+		err := tc.rng.AddCmd(context.Background(), args, reply, true)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1886,3 +2586,242 @@ func TestChangeReplicasDuplicateError(t *testing.T) {
 			err)
 	}
 }
+
+// TestAdminBulkLoad verifies that bulk loaded key/value pairs are
+// readable, are reflected in the range's stats and may not overwrite
+// existing keys.
+func TestAdminBulkLoad(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	bulkLoadArgs := func(keys ...string) (*proto.AdminBulkLoadRequest, *proto.AdminBulkLoadResponse) {
+		args := &proto.AdminBulkLoadRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:     proto.Key(keys[0]),
+				RaftID:  1,
+				Replica: proto.Replica{StoreID: tc.store.StoreID()},
+			},
+		}
+		for _, key := range keys {
+			value := proto.Value{Bytes: []byte("value-" + key)}
+			value.InitChecksum([]byte(key))
+			args.Rows = append(args.Rows, proto.KeyValue{Key: proto.Key(key), Value: value})
+		}
+		return args, &proto.AdminBulkLoadResponse{}
+	}
+
+	before := tc.rng.stats.GetMVCC()
+	// Keys needn't be supplied in order.
+	args, reply := bulkLoadArgs("c", "a", "b")
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if reply.LoadTimestamp.Equal(proto.ZeroTimestamp) {
+		t.Error("expected a load timestamp")
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		gArgs, gReply := getArgs([]byte(key), 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err != nil {
+			t.Fatal(err)
+		}
+		if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value-"+key)) {
+			t.Errorf("expected %q to be bulk loaded; got %+v", key, gReply.Value)
+		}
+	}
+	if after := tc.rng.stats.GetMVCC(); after.LiveCount != before.LiveCount+3 {
+		t.Errorf("expected live count to increase by 3; got %d -> %d", before.LiveCount, after.LiveCount)
+	}
+
+	// Loading over an existing key fails.
+	args, reply = bulkLoadArgs("b", "d")
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err == nil || !strings.Contains(err.Error(), "overlaps existing key") {
+		t.Errorf("expected overlap error; got %v", err)
+	}
+	// As does loading a duplicate key.
+	args, reply = bulkLoadArgs("e", "e")
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err == nil || !strings.Contains(err.Error(), "duplicate key") {
+		t.Errorf("expected duplicate key error; got %v", err)
+	}
+}
+
+// TestAdminTransferLease verifies that leadership can't be transferred
+// to a store without a replica of the range, and that a transfer to
+// the local replica is a no-op.
+func TestAdminTransferLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	transferArgs := func(storeID proto.StoreID) (*proto.AdminTransferLeaseRequest, *proto.AdminTransferLeaseResponse) {
+		return &proto.AdminTransferLeaseRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:     proto.Key("a"),
+				RaftID:  1,
+				Replica: proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			StoreID: storeID,
+		}, &proto.AdminTransferLeaseResponse{}
+	}
+
+	args, reply := transferArgs(tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	args, reply = transferArgs(tc.store.StoreID() + 1)
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err == nil || !strings.Contains(err.Error(), "has no replica") {
+		t.Errorf("expected missing replica error; got %v", err)
+	}
+}
+
+// TestRangeClosedTimestamp verifies that proposals close timestamps
+// lagging the current time, that writes below the closed timestamp are
+// pushed above it, and that reads at closed timestamps can be served
+// by followers.
+func TestRangeClosedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.store.ctx.ClosedTimestampLag = 10 * time.Second
+	tc.manualClock.Set(int64(time.Minute))
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	expClosed := proto.Timestamp{WallTime: int64(50 * time.Second)}
+	if closed := tc.rng.closedTimestamp(); !closed.Equal(expClosed) {
+		t.Errorf("expected closed timestamp %s; got %s", expClosed, closed)
+	}
+
+	// A write below the closed timestamp is pushed above it.
+	pArgs, pReply = putArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = proto.Timestamp{WallTime: int64(40 * time.Second)}
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !expClosed.Less(pReply.Timestamp) {
+		t.Errorf("expected write to be pushed above %s; got %s", expClosed, pReply.Timestamp)
+	}
+
+	testCases := []struct {
+		timestamp proto.Timestamp
+		txn       *proto.Transaction
+		expected  bool
+	}{
+		{proto.Timestamp{WallTime: int64(45 * time.Second)}, nil, true},
+		{expClosed, nil, true},
+		{expClosed.Next(), nil, false},
+		{proto.ZeroTimestamp, nil, false},
+		{proto.Timestamp{WallTime: int64(45 * time.Second)}, &proto.Transaction{}, false},
+	}
+	for i, test := range testCases {
+		header := &proto.RequestHeader{Timestamp: test.timestamp, Txn: test.txn}
+		if ok := tc.rng.canServeFollowerRead(header); ok != test.expected {
+			t.Errorf("%d: expected follower read %t; got %t", i, test.expected, ok)
+		}
+	}
+}
+
+// TestRangeClosedTimestampDisabled verifies that no timestamps are
+// closed without a closed timestamp lag.
+func TestRangeClosedTimestampDisabled(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.manualClock.Set(int64(time.Minute))
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if closed := tc.rng.closedTimestamp(); !closed.Equal(proto.ZeroTimestamp) {
+		t.Errorf("expected no closed timestamp; got %s", closed)
+	}
+}
+
+// TestRangeHistoricalReadGCThreshold verifies that reads at historical
+// timestamps are served within the zone's GC TTL and rejected beyond.
+func TestRangeHistoricalReadGCThreshold(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	ttl := time.Duration(testDefaultZoneConfig.GC.TTLSeconds) * time.Second
+	tc.manualClock.Set(int64(ttl + time.Hour))
+
+	// A read within the TTL sees the value.
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = proto.Timestamp{WallTime: int64(2 * time.Hour)}
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value")) {
+		t.Errorf("expected value; got %+v", gReply.Value)
+	}
+
+	// A read beyond the TTL fails.
+	gArgs, gReply = getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = proto.Timestamp{WallTime: int64(time.Minute)}
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err == nil {
+		t.Error("expected read below the GC threshold to fail")
+	}
+}
+
+// TestRangeCompressesProposedValues verifies that values exceeding the
+// store's compression threshold are compressed in the proposed
+// command, without modifying the request, and that values which
+// arrive compressed are rejected.
+func TestRangeCompressesProposedValues(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.store.ctx.ValueCompressionThreshold = 64
+
+	large := bytes.Repeat([]byte(`{"name":"value"}`), 64)
+	pArgs, pReply := putArgs(proto.Key("a"), large, tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pArgs.Value.Bytes, large) || pArgs.Value.Compression != nil {
+		t.Errorf("expected request to be left unmodified; got %+v", pArgs.Value)
+	}
+
+	mvccVal := proto.MVCCValue{}
+	ok, _, _, err := tc.engine.GetProto(engine.MVCCEncodeVersionKey(proto.Key("a"), pArgs.Timestamp), &mvccVal)
+	if !ok || err != nil {
+		t.Fatalf("unable to read versioned value (%t): %v", ok, err)
+	}
+	if mvccVal.Value.Compression == nil || len(mvccVal.Value.Bytes) >= len(large) {
+		t.Errorf("expected stored value to be compressed; got %d bytes", len(mvccVal.Value.Bytes))
+	}
+
+	gArgs, gReply := getArgs(proto.Key("a"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(context.Background(), gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gReply.Value.Bytes, large) {
+		t.Errorf("expected uncompressed value; got %+v", gReply.Value)
+	}
+
+	pArgs, pReply = putArgs(proto.Key("b"), []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	pArgs.Value.Compression = proto.VALUE_SNAPPY.Enum()
+	if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err == nil {
+		t.Error("expected put of a compressed value to fail")
+	}
+}
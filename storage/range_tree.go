@@ -19,6 +19,7 @@ package storage
 
 import (
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
@@ -54,10 +55,10 @@ func SetupRangeTree(batch engine.Engine, ms *proto.MVCCStats, timestamp proto.Ti
 		Key:   startKey,
 		Black: true,
 	}
-	if err := engine.MVCCPutProto(batch, ms, engine.KeyRangeTreeRoot, timestamp, nil, tree); err != nil {
+	if err := engine.MVCCPutProto(batch, ms, keys.KeyRangeTreeRoot, timestamp, nil, tree); err != nil {
 		return err
 	}
-	if err := engine.MVCCPutProto(batch, ms, engine.RangeTreeNodeKey(startKey), timestamp, nil, node); err != nil {
+	if err := engine.MVCCPutProto(batch, ms, keys.RangeTreeNodeKey(startKey), timestamp, nil, node); err != nil {
 		return err
 	}
 	return nil
@@ -66,11 +67,11 @@ func SetupRangeTree(batch engine.Engine, ms *proto.MVCCStats, timestamp proto.Ti
 // flush writes all dirty nodes and the tree to the transaction.
 func (tc *treeContext) flush() error {
 	if tc.dirty {
-		tc.txn.Prepare(client.PutProtoCall(engine.KeyRangeTreeRoot, tc.tree))
+		tc.txn.Prepare(client.PutProtoCall(keys.KeyRangeTreeRoot, tc.tree))
 	}
 	for _, cachedNode := range tc.nodes {
 		if cachedNode.dirty {
-			call := client.PutProtoCall(engine.RangeTreeNodeKey(cachedNode.node.Key), cachedNode.node)
+			call := client.PutProtoCall(keys.RangeTreeNodeKey(cachedNode.node.Key), cachedNode.node)
 			tc.txn.Prepare(call)
 		}
 	}
@@ -95,7 +96,7 @@ func getProto(txn *client.Txn, key proto.Key, msg gogoproto.Message) error {
 // GetRangeTree fetches the RangeTree proto and sets up the range tree context.
 func getRangeTree(txn *client.Txn) (*treeContext, error) {
 	tree := &proto.RangeTree{}
-	if err := getProto(txn, engine.KeyRangeTreeRoot, tree); err != nil {
+	if err := getProto(txn, keys.KeyRangeTreeRoot, tree); err != nil {
 		return nil, err
 	}
 	return &treeContext{
@@ -138,7 +139,7 @@ func (tc *treeContext) getNode(key *proto.Key) (*proto.RangeTreeNode, error) {
 
 	// We don't have it cached so fetch it and add it to the cache.
 	node := &proto.RangeTreeNode{}
-	if err := getProto(tc.txn, engine.RangeTreeNodeKey(*key), node); err != nil {
+	if err := getProto(tc.txn, keys.RangeTreeNodeKey(*key), node); err != nil {
 		return nil, err
 	}
 	tc.nodes[keyString] = cachedNode{
@@ -0,0 +1,103 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/coreos/etcd/raft/raftpb"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// ReplicaState is the persisted state of a replica, as read from the
+// engines of a stopped store for debugging.
+type ReplicaState struct {
+	Desc              proto.RangeDescriptor
+	HardState         raftpb.HardState
+	Truncated         proto.RaftTruncatedState
+	AppliedIndex      uint64
+	LeaseAppliedIndex uint64
+}
+
+// LoadReplicaStates returns the state of every replica whose range
+// descriptor is stored in eng, in order of start key. raftEng holds
+// the Raft state of the replicas and is eng itself unless the store
+// keeps its Raft log apart. Missing Raft state is returned as zero.
+func LoadReplicaStates(eng, raftEng engine.Engine) ([]ReplicaState, error) {
+	var states []ReplicaState
+	start := keys.RangeDescriptorKey(keys.KeyMin)
+	end := keys.RangeDescriptorKey(keys.KeyMax)
+	if err := engine.MVCCIterate(eng, start, end, proto.MaxTimestamp, false, nil, func(kv proto.KeyValue) (bool, error) {
+		_, suffix, _ := keys.DecodeRangeKey(kv.Key)
+		if !suffix.Equal(keys.KeyLocalRangeDescriptorSuffix) {
+			return false, nil
+		}
+		var state ReplicaState
+		if err := gogoproto.Unmarshal(kv.Value.Bytes, &state.Desc); err != nil {
+			return false, err
+		}
+		raftID := state.Desc.RaftID
+		if _, err := engine.MVCCGetProto(raftEng, keys.RaftHardStateKey(raftID),
+			proto.ZeroTimestamp, true, nil, &state.HardState); err != nil {
+			return false, err
+		}
+		if _, err := engine.MVCCGetProto(raftEng, keys.RaftTruncatedStateKey(raftID),
+			proto.ZeroTimestamp, true, nil, &state.Truncated); err != nil {
+			return false, err
+		}
+		var err error
+		if state.AppliedIndex, err = loadAppliedIndex(eng, raftID); err != nil {
+			return false, err
+		}
+		if state.LeaseAppliedIndex, err = loadLeaseAppliedIndex(eng, raftID); err != nil {
+			return false, err
+		}
+		states = append(states, state)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+// DestroyReplicaData deletes all data of the replica of the range with
+// the supplied descriptor from eng, and its Raft log and state from
+// raftEng, which may be eng itself.
+func DestroyReplicaData(eng, raftEng engine.Engine, desc *proto.RangeDescriptor) error {
+	var deletes []interface{}
+	iter := newKeyRangeDataIterator(descDataKeyRanges(desc), eng)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		deletes = append(deletes, engine.BatchDelete{RawKeyValue: proto.RawKeyValue{Key: iter.Key()}})
+	}
+	if err := eng.WriteBatch(deletes); err != nil {
+		return err
+	}
+	if raftEng == eng {
+		return nil
+	}
+	// Clean up the Raft log and state kept in the separate engine.
+	deletes = nil
+	logIter := newKeyRangeDataIterator(descDataKeyRanges(desc)[:1], raftEng)
+	defer logIter.Close()
+	for ; logIter.Valid(); logIter.Next() {
+		deletes = append(deletes, engine.BatchDelete{RawKeyValue: proto.RawKeyValue{Key: logIter.Key()}})
+	}
+	return raftEng.WriteBatch(deletes)
+}
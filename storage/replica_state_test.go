@@ -0,0 +1,103 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/coreos/etcd/raft/raftpb"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// TestLoadAndDestroyReplicaStates writes the state of two replicas,
+// with the Raft state kept in a separate engine, and verifies that it
+// is loaded and that destroying one replica leaves the other intact.
+func TestLoadAndDestroyReplicaStates(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	eng := engine.NewInMem(proto.Attributes{}, 1<<20)
+	defer eng.Close()
+	raftEng := engine.NewInMem(proto.Attributes{}, 1<<20)
+	defer raftEng.Close()
+
+	var expected []ReplicaState
+	for i, span := range [][2]proto.Key{{proto.Key("a"), proto.Key("m")}, {proto.Key("m"), proto.Key("z")}} {
+		state := ReplicaState{
+			Desc: proto.RangeDescriptor{
+				RaftID:   int64(i + 1),
+				StartKey: span[0],
+				EndKey:   span[1],
+				Replicas: []proto.Replica{{NodeID: 1, StoreID: 1}},
+			},
+			HardState:         raftpb.HardState{Term: 5, Commit: uint64(10 + i)},
+			Truncated:         proto.RaftTruncatedState{Index: uint64(8 + i), Term: 4},
+			AppliedIndex:      uint64(10 + i),
+			LeaseAppliedIndex: uint64(7 + i),
+		}
+		raftID := state.Desc.RaftID
+		for _, put := range []struct {
+			eng engine.Engine
+			key proto.Key
+			msg gogoproto.Message
+		}{
+			{eng, keys.RangeDescriptorKey(span[0]), &state.Desc},
+			{raftEng, keys.RaftHardStateKey(raftID), &state.HardState},
+			{raftEng, keys.RaftTruncatedStateKey(raftID), &state.Truncated},
+		} {
+			if err := engine.MVCCPutProto(put.eng, nil, put.key, proto.ZeroTimestamp, nil, put.msg); err != nil {
+				t.Fatal(err)
+			}
+		}
+		applied := proto.Value{Bytes: encoding.EncodeUint64(nil, state.AppliedIndex)}
+		if err := engine.MVCCPut(eng, nil, keys.RaftAppliedIndexKey(raftID), proto.ZeroTimestamp, applied, nil); err != nil {
+			t.Fatal(err)
+		}
+		leaseApplied := proto.Value{Bytes: encoding.EncodeUint64(nil, state.LeaseAppliedIndex)}
+		if err := engine.MVCCPut(eng, nil, keys.RaftLeaseAppliedIndexKey(raftID), proto.ZeroTimestamp, leaseApplied, nil); err != nil {
+			t.Fatal(err)
+		}
+		expected = append(expected, state)
+	}
+
+	states, err := LoadReplicaStates(eng, raftEng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(states, expected) {
+		t.Fatalf("expected %+v; got %+v", expected, states)
+	}
+
+	if err := DestroyReplicaData(eng, raftEng, &expected[0].Desc); err != nil {
+		t.Fatal(err)
+	}
+	if states, err = LoadReplicaStates(eng, raftEng); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(states, expected[1:]) {
+		t.Errorf("expected %+v; got %+v", expected[1:], states)
+	}
+	if ok, err := engine.MVCCGetProto(raftEng, keys.RaftHardStateKey(1), proto.ZeroTimestamp,
+		true, nil, &raftpb.HardState{}); err != nil || ok {
+		t.Errorf("expected Raft state of destroyed replica to be removed: %t, %v", ok, err)
+	}
+}
@@ -22,35 +22,45 @@ import (
 
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
 const (
-	// replicateQueueMaxSize is the max size of the split queue.
+	// replicateQueueMaxSize is the max size of the replicate queue.
 	replicateQueueMaxSize = 100
 
-	// replicateQueueTimerDuration is the duration between replication of queued ranges.
-	replicateQueueTimerDuration = 0 * time.Second // zero duration to process replication greedily
+	// removeReplicaPriority is the priority assigned to over-replicated
+	// ranges. Replicas are always added before any are removed, so that
+	// under-replicated ranges are repaired first.
+	removeReplicaPriority = 0.5
 )
 
 // replicateQueue manages a queue of ranges to have their replicas
-// change to match the zone config.
+// change to match the zone config. Ranges with fewer replicas than
+// the zone's replication factor have replicas added; ranges with more
+// have replicas removed. At most one replica change is made per
+// interval, which paces the data movement resulting from a change to
+// a zone's replication factor.
 type replicateQueue struct {
 	*baseQueue
 	gossip    *gossip.Gossip
 	allocator *allocator
 	clock     *hlc.Clock
+	interval  time.Duration
 	disabled  bool
 }
 
-// newReplicateQueue returns a new instance of replicateQueue.
+// newReplicateQueue returns a new instance of replicateQueue which
+// processes at most one range per interval.
 func newReplicateQueue(gossip *gossip.Gossip, allocator *allocator,
-	clock *hlc.Clock) *replicateQueue {
+	clock *hlc.Clock, interval time.Duration) *replicateQueue {
 	rq := &replicateQueue{
 		gossip:    gossip,
 		allocator: allocator,
 		clock:     clock,
+		interval:  interval,
 	}
 	rq.baseQueue = newBaseQueue("replicate", rq, replicateQueueMaxSize)
 	return rq
@@ -78,12 +88,22 @@ func (rq *replicateQueue) shouldQueue(now proto.Timestamp, rng *Range) (
 	return rq.needsReplication(zone, rng)
 }
 
+// needsReplication returns whether the range's voting replica count
+// differs from the zone's replication factor, along with a priority.
+// Ranges missing replicas are prioritized by the number missing and
+// always ahead of over-replicated ranges.
 func (rq *replicateQueue) needsReplication(zone proto.ZoneConfig, rng *Range) (bool, float64) {
-	// TODO(bdarnell): handle non-empty ReplicaAttrs.
 	need := len(zone.ReplicaAttrs)
-	have := len(rng.Desc().Replicas)
+	have := 0
+	for _, replica := range rng.Desc().Replicas {
+		if !replica.Learner {
+			have++
+		}
+	}
 	if need > have {
 		return true, float64(need - have)
+	} else if need > 0 && have > need {
+		return true, removeReplicaPriority
 	}
 
 	return false, 0
@@ -100,8 +120,26 @@ func (rq *replicateQueue) process(now proto.Timestamp, rng *Range) error {
 		return nil
 	}
 
-	// TODO(bdarnell): handle non-homogenous ReplicaAttrs.
-	newReplica, err := rq.allocator.allocate(zone.ReplicaAttrs[0], rng.Desc().Replicas)
+	if len(rng.Desc().Replicas) > len(zone.ReplicaAttrs) {
+		return rq.removeReplica(zone, rng)
+	}
+
+	// A learner left behind by an earlier addition which failed to
+	// promote it is retried before a new replica is allocated.
+	for _, replica := range rng.Desc().Replicas {
+		if replica.Learner {
+			err := rng.ChangeReplicas(proto.ADD_REPLICA, replica)
+			go rq.MaybeAdd(rng, rq.clock.Now())
+			return err
+		}
+	}
+
+	var prohibited proto.Attributes
+	if zone.ProhibitedAttrs != nil {
+		prohibited = *zone.ProhibitedAttrs
+	}
+	required := nextReplicaAttrs(zone, rng.Desc().Replicas)
+	newReplica, err := rq.allocator.allocate(required, prohibited, rng.Desc().Replicas)
 	if err != nil {
 		return err
 	}
@@ -110,7 +148,7 @@ func (rq *replicateQueue) process(now proto.Timestamp, rng *Range) error {
 		proto.Replica{
 			NodeID:  newReplica.Node.NodeID,
 			StoreID: newReplica.StoreID,
-			Attrs:   newReplica.Attrs,
+			Attrs:   *newReplica.CombinedAttrs(),
 		})
 
 	// Enqueue this range again to see if there are more changes to be made.
@@ -119,6 +157,97 @@ func (rq *replicateQueue) process(now proto.Timestamp, rng *Range) error {
 	return err
 }
 
+// removeReplica removes one surplus replica from an over-replicated
+// range. The range is re-queued afterwards so that the remaining
+// surplus is removed on subsequent intervals.
+func (rq *replicateQueue) removeReplica(zone proto.ZoneConfig, rng *Range) error {
+	replica, ok := surplusReplica(zone, rng.Desc().Replicas, rng.rm.StoreID())
+	if !ok {
+		return util.Errorf("unable to find a replica of range %d to remove", rng.Desc().RaftID)
+	}
+
+	err := rng.ChangeReplicas(proto.REMOVE_REPLICA, replica)
+
+	// Enqueue this range again to see if there are more changes to be made.
+	go rq.MaybeAdd(rng, rq.clock.Now())
+
+	return err
+}
+
+// surplusReplica chooses a replica to remove from an over-replicated
+// range. The replica on the local store is never chosen, as it is the
+// range leader. Replicas placed on prohibited attributes are removed
+// first, followed by those not needed to satisfy any entry of the
+// zone's ReplicaAttrs. Returns false if no replica may be removed.
+func surplusReplica(zone proto.ZoneConfig, replicas []proto.Replica,
+	localStoreID proto.StoreID) (proto.Replica, bool) {
+	if zone.ProhibitedAttrs != nil {
+		for _, replica := range replicas {
+			if replica.StoreID != localStoreID && !zone.ProhibitedAttrs.IsDisjoint(replica.Attrs) {
+				return replica, true
+			}
+		}
+	}
+
+	// Match replicas to the zone's ReplicaAttrs, considering the local
+	// replica first so that it is never the one left unmatched.
+	order := make([]int, 0, len(replicas))
+	for i, replica := range replicas {
+		if replica.StoreID == localStoreID {
+			order = append([]int{i}, order...)
+		} else {
+			order = append(order, i)
+		}
+	}
+	used := make([]bool, len(replicas))
+	for _, attrs := range zone.ReplicaAttrs {
+		for _, i := range order {
+			if !used[i] && attrs.IsSubset(replicas[i].Attrs) {
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, replica := range replicas {
+		if !used[i] && replica.StoreID != localStoreID {
+			return replica, true
+		}
+	}
+	// Every non-local replica satisfies some entry; fall back to the last one.
+	for i := len(replicas) - 1; i >= 0; i-- {
+		if replicas[i].StoreID != localStoreID {
+			return replicas[i], true
+		}
+	}
+	return proto.Replica{}, false
+}
+
+// nextReplicaAttrs returns the attributes required of the next replica
+// to be added to a range with the supplied replicas. Each existing
+// replica is matched against at most one entry in the zone's
+// ReplicaAttrs; the first entry left unmatched is returned.
+func nextReplicaAttrs(zone proto.ZoneConfig, replicas []proto.Replica) proto.Attributes {
+	used := make([]bool, len(replicas))
+	for _, attrs := range zone.ReplicaAttrs {
+		matched := false
+		for i, replica := range replicas {
+			if !used[i] && attrs.IsSubset(replica.Attrs) {
+				used[i], matched = true, true
+				break
+			}
+		}
+		if !matched {
+			return attrs
+		}
+	}
+	// All entries are satisfied; fall back to the next entry by count.
+	if len(replicas) < len(zone.ReplicaAttrs) {
+		return zone.ReplicaAttrs[len(replicas)]
+	}
+	return proto.Attributes{}
+}
+
+// timer returns the replicate queue's pacing interval.
 func (rq *replicateQueue) timer() time.Duration {
-	return replicateQueueTimerDuration
+	return rq.interval
 }
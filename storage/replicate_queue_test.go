@@ -0,0 +1,91 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestNextReplicaAttrs verifies that existing replicas are matched
+// against the zone's replica attributes and the first unsatisfied
+// entry is chosen for the next replica.
+func TestNextReplicaAttrs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ssd := proto.Attributes{Attrs: []string{"a", "ssd"}}
+	hdd := proto.Attributes{Attrs: []string{"a", "hdd"}}
+	mem := proto.Attributes{Attrs: []string{"a", "mem"}}
+	zone := proto.ZoneConfig{ReplicaAttrs: []proto.Attributes{ssd, hdd, mem}}
+
+	testCases := []struct {
+		replicas []proto.Replica
+		expected proto.Attributes
+	}{
+		{nil, ssd},
+		{[]proto.Replica{{Attrs: ssd}}, hdd},
+		{[]proto.Replica{{Attrs: hdd}}, ssd},
+		{[]proto.Replica{{Attrs: mem}, {Attrs: ssd}}, hdd},
+		// A replica with a superset of attributes satisfies an entry.
+		{[]proto.Replica{{Attrs: proto.Attributes{Attrs: []string{"a", "ssd", "dc1"}}}}, hdd},
+		// Each replica satisfies at most one entry.
+		{[]proto.Replica{{Attrs: ssd}, {Attrs: hdd}, {Attrs: hdd}}, mem},
+	}
+	for i, test := range testCases {
+		if attrs := nextReplicaAttrs(zone, test.replicas); !reflect.DeepEqual(attrs, test.expected) {
+			t.Errorf("%d: expected %+v; got %+v", i, test.expected, attrs)
+		}
+	}
+}
+
+// TestSurplusReplica verifies the choice of replica to remove from an
+// over-replicated range.
+func TestSurplusReplica(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ssd := proto.Attributes{Attrs: []string{"ssd"}}
+	hdd := proto.Attributes{Attrs: []string{"hdd"}}
+	zone := proto.ZoneConfig{ReplicaAttrs: []proto.Attributes{ssd, ssd}}
+	prohibitedZone := zone
+	prohibitedZone.ProhibitedAttrs = &proto.Attributes{Attrs: []string{"hdd"}}
+
+	testCases := []struct {
+		zone     proto.ZoneConfig
+		replicas []proto.Replica
+		expected proto.StoreID
+		ok       bool
+	}{
+		// The replica not needed by any entry is removed.
+		{zone, []proto.Replica{{StoreID: 1, Attrs: ssd}, {StoreID: 2, Attrs: hdd}, {StoreID: 3, Attrs: ssd}}, 2, true},
+		// The local replica is never removed, even if unneeded.
+		{zone, []proto.Replica{{StoreID: 1, Attrs: hdd}, {StoreID: 2, Attrs: ssd}, {StoreID: 3, Attrs: ssd}}, 3, true},
+		// The local replica is matched first.
+		{zone, []proto.Replica{{StoreID: 2, Attrs: ssd}, {StoreID: 3, Attrs: ssd}, {StoreID: 1, Attrs: ssd}}, 3, true},
+		// Replicas on prohibited attributes are removed first.
+		{prohibitedZone, []proto.Replica{{StoreID: 1, Attrs: ssd}, {StoreID: 2, Attrs: hdd}, {StoreID: 3, Attrs: ssd}}, 2, true},
+		// Only the local replica remains.
+		{zone, []proto.Replica{{StoreID: 1, Attrs: ssd}}, 0, false},
+	}
+	for i, test := range testCases {
+		replica, ok := surplusReplica(test.zone, test.replicas, 1)
+		if ok != test.ok || replica.StoreID != test.expected {
+			t.Errorf("%d: expected store %d (%t); got %d (%t)", i, test.expected, test.ok, replica.StoreID, ok)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/metrics"
+)
+
+// RequestLatencies tracks the latencies of requests by method, both in
+// histograms of its own, which are summarized in status protos, and in
+// the histograms of metrics.Metrics, named "<prefix>.<method>.latency".
+type RequestLatencies struct {
+	prefix string
+	mu     sync.Mutex
+	byName map[string]*metrics.LatencyHistogram
+}
+
+// NewRequestLatencies returns a RequestLatencies whose metrics are
+// named with the specified prefix.
+func NewRequestLatencies(prefix string) *RequestLatencies {
+	return &RequestLatencies{
+		prefix: prefix,
+		byName: map[string]*metrics.LatencyHistogram{},
+	}
+}
+
+// Record records the latency of a request of the specified method.
+func (rl *RequestLatencies) Record(method proto.Method, latency time.Duration) {
+	name := method.String()
+	rl.mu.Lock()
+	h, ok := rl.byName[name]
+	if !ok {
+		h = &metrics.LatencyHistogram{}
+		rl.byName[name] = h
+	}
+	h.Record(latency)
+	rl.mu.Unlock()
+	metrics.Metrics.Histogram(rl.prefix+"."+name+".latency", float64(latency))
+}
+
+// Summaries returns a summary of the latencies of each method with
+// recorded requests, ordered by method name.
+func (rl *RequestLatencies) Summaries() []proto.RequestLatency {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	var names []string
+	for name := range rl.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	summaries := make([]proto.RequestLatency, len(names))
+	for i, name := range names {
+		h := rl.byName[name]
+		summaries[i] = proto.RequestLatency{
+			Method: name,
+			Count:  h.Count(),
+			P50:    int64(h.Quantile(.5)),
+			P95:    int64(h.Quantile(.95)),
+			P99:    int64(h.Quantile(.99)),
+			Max:    int64(h.Max()),
+		}
+	}
+	return summaries
+}
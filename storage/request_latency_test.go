@@ -0,0 +1,59 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestRequestLatencies verifies that latencies are summarized by
+// method, in order of method name.
+func TestRequestLatencies(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rl := NewRequestLatencies("test")
+	for i := 1; i <= 100; i++ {
+		rl.Record(proto.Put, time.Duration(i)*time.Millisecond)
+	}
+	rl.Record(proto.Get, time.Second)
+
+	expected := []proto.RequestLatency{
+		{Method: "Get", Count: 1, P50: int64(time.Second), P95: int64(time.Second),
+			P99: int64(time.Second), Max: int64(time.Second)},
+		{Method: "Put", Count: 100, P50: int64(50 * time.Millisecond), P95: int64(95 * time.Millisecond),
+			P99: int64(99 * time.Millisecond), Max: int64(100 * time.Millisecond)},
+	}
+	summaries := rl.Summaries()
+	if len(summaries) != len(expected) {
+		t.Fatalf("expected %d summaries; got %+v", len(expected), summaries)
+	}
+	// Quantiles are accurate to within 1%.
+	within := func(actual, expected int64) bool {
+		return actual >= expected-expected/100 && actual <= expected+expected/100
+	}
+	for i, s := range summaries {
+		e := expected[i]
+		if s.Method != e.Method || s.Count != e.Count || s.Max != e.Max ||
+			!within(s.P50, e.P50) || !within(s.P95, e.P95) || !within(s.P99, e.P99) {
+			t.Errorf("%d: expected %+v; got %+v", i, e, s)
+		}
+	}
+}
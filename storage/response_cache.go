@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
@@ -82,7 +83,7 @@ func (rc *ResponseCache) ClearInflight() {
 // ClearData removes all items stored in the persistent cache. It does not alter
 // the inflight map.
 func (rc *ResponseCache) ClearData() error {
-	p := engine.ResponseCacheKey(rc.raftID, nil) // prefix for all response cache entries with this raft ID
+	p := keys.ResponseCacheKey(rc.raftID, nil) // prefix for all response cache entries with this raft ID
 	end := p.PrefixEnd()
 	_, err := engine.ClearRange(rc.engine, engine.MVCCEncodeKey(p), engine.MVCCEncodeKey(end))
 	return err
@@ -119,7 +120,7 @@ func (rc *ResponseCache) GetResponse(cmdID proto.ClientCmdID, reply proto.Respon
 
 	// If the response is in the cache or we experienced an error, return.
 	rwResp := proto.ReadWriteCmdResponse{}
-	key := engine.ResponseCacheKey(rc.raftID, &cmdID)
+	key := keys.ResponseCacheKey(rc.raftID, &cmdID)
 	if ok, err := engine.MVCCGetProto(rc.engine, key, proto.ZeroTimestamp,
 		true, nil, &rwResp); ok || err != nil {
 		rc.Lock() // Take lock after fetching response from cache.
@@ -143,7 +144,7 @@ func (rc *ResponseCache) CopyInto(e engine.Engine, destRaftID int64) error {
 	rc.Lock()
 	defer rc.Unlock()
 
-	prefix := engine.ResponseCacheKey(rc.raftID, nil) // response cache prefix
+	prefix := keys.ResponseCacheKey(rc.raftID, nil) // response cache prefix
 	start := engine.MVCCEncodeKey(prefix)
 	end := engine.MVCCEncodeKey(prefix.PrefixEnd())
 
@@ -155,7 +156,7 @@ func (rc *ResponseCache) CopyInto(e engine.Engine, destRaftID int64) error {
 			return false, util.Errorf("could not decode a response cache key %s: %s",
 				proto.Key(kv.Key), err)
 		}
-		key := engine.ResponseCacheKey(destRaftID, &cmdID)
+		key := keys.ResponseCacheKey(destRaftID, &cmdID)
 		encKey := engine.MVCCEncodeKey(key)
 		// Decode the value, update the checksum and re-encode.
 		meta := &proto.MVCCMetadata{}
@@ -176,7 +177,7 @@ func (rc *ResponseCache) CopyInto(e engine.Engine, destRaftID int64) error {
 // error. The copy is done directly using the engine instead of interpreting
 // values through MVCC for efficiency.
 func (rc *ResponseCache) CopyFrom(e engine.Engine, originRaftID int64) error {
-	prefix := engine.ResponseCacheKey(originRaftID, nil) // response cache prefix
+	prefix := keys.ResponseCacheKey(originRaftID, nil) // response cache prefix
 	start := engine.MVCCEncodeKey(prefix)
 	end := engine.MVCCEncodeKey(prefix.PrefixEnd())
 
@@ -188,7 +189,7 @@ func (rc *ResponseCache) CopyFrom(e engine.Engine, originRaftID int64) error {
 			return false, util.Errorf("could not decode a response cache key %s: %s",
 				proto.Key(kv.Key), err)
 		}
-		key := engine.ResponseCacheKey(rc.raftID, &cmdID)
+		key := keys.ResponseCacheKey(rc.raftID, &cmdID)
 		encKey := engine.MVCCEncodeKey(key)
 		// Decode the value, update the checksum and re-encode.
 		meta := &proto.MVCCMetadata{}
@@ -209,29 +210,42 @@ func (rc *ResponseCache) CopyFrom(e engine.Engine, originRaftID int64) error {
 // command will be signaled to wakeup and read the command response
 // from the cache.
 func (rc *ResponseCache) PutResponse(cmdID proto.ClientCmdID, reply proto.Response) error {
+	err := rc.WriteResponse(rc.engine, cmdID, reply)
+	// Even on error, we remove the entry from the inflight map.
+	rc.RemoveInflight(cmdID)
+	return err
+}
+
+// WriteResponse writes a response for the specified cmdID to the
+// supplied engine, which is typically a batch. Unlike PutResponse,
+// the inflight entry is left in place; the caller must invoke
+// RemoveInflight once the batch has been committed so that waiters
+// are not woken before they can read the response.
+func (rc *ResponseCache) WriteResponse(e engine.Engine, cmdID proto.ClientCmdID, reply proto.Response) error {
 	// Do nothing if command ID is empty.
-	if cmdID.IsEmpty() {
+	if cmdID.IsEmpty() || !rc.shouldCacheResponse(reply) {
 		return nil
 	}
-	// Write the response value to the engine.
-	var err error
-	if rc.shouldCacheResponse(reply) {
-		key := engine.ResponseCacheKey(rc.raftID, &cmdID)
-		rwResp := &proto.ReadWriteCmdResponse{}
-		if !rwResp.SetValue(reply) {
-			log.Fatalf("attempt to add invalid item to response cache: %+v",
-				reply)
-		}
-		err = engine.MVCCPutProto(rc.engine, nil, key, proto.ZeroTimestamp, nil, rwResp)
+	key := keys.ResponseCacheKey(rc.raftID, &cmdID)
+	rwResp := &proto.ReadWriteCmdResponse{}
+	if !rwResp.SetValue(reply) {
+		log.Fatalf("attempt to add invalid item to response cache: %+v",
+			reply)
 	}
+	return engine.MVCCPutProto(e, nil, key, proto.ZeroTimestamp, nil, rwResp)
+}
 
+// RemoveInflight removes the inflight entry corresponding to cmdID,
+// signaling any requests waiting on the outcome of the command to
+// wakeup and read the command response from the cache.
+func (rc *ResponseCache) RemoveInflight(cmdID proto.ClientCmdID) {
+	if cmdID.IsEmpty() {
+		return
+	}
 	// Take lock after writing response to cache!
 	rc.Lock()
 	defer rc.Unlock()
-	// Even on error, we remove the entry from the inflight map.
 	rc.removeInflightLocked(cmdID)
-
-	return err
 }
 
 // shouldCacheResponse returns whether the response should be cached.
@@ -275,18 +289,18 @@ func (rc *ResponseCache) decodeResponseCacheKey(encKey proto.EncodedKey) (proto.
 	if isValue {
 		return ret, util.Errorf("key %s is not a raw MVCC value", encKey)
 	}
-	if !bytes.HasPrefix(key, engine.KeyLocalRangeIDPrefix) {
-		return ret, util.Errorf("key %s does not have %s prefix", key, engine.KeyLocalRangeIDPrefix)
+	if !bytes.HasPrefix(key, keys.KeyLocalRangeIDPrefix) {
+		return ret, util.Errorf("key %s does not have %s prefix", key, keys.KeyLocalRangeIDPrefix)
 	}
 	// Cut the prefix and the Raft ID.
-	b := key[len(engine.KeyLocalRangeIDPrefix):]
+	b := key[len(keys.KeyLocalRangeIDPrefix):]
 	b, _ = encoding.DecodeUvarint(b)
-	if !bytes.HasPrefix(b, engine.KeyLocalResponseCacheSuffix) {
+	if !bytes.HasPrefix(b, keys.KeyLocalResponseCacheSuffix) {
 		return ret, util.Errorf("key %s does not contain the response cache suffix %s",
-			key, engine.KeyLocalResponseCacheSuffix)
+			key, keys.KeyLocalResponseCacheSuffix)
 	}
 	// Cut the response cache suffix.
-	b = b[len(engine.KeyLocalResponseCacheSuffix):]
+	b = b[len(keys.KeyLocalResponseCacheSuffix):]
 	// Now, decode the command ID.
 	b, wt := encoding.DecodeUvarint(b)
 	b, rd := encoding.DecodeUint64(b)
@@ -0,0 +1,300 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+
+	"code.google.com/p/go-uuid/uuid"
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// rangeLookupBatchSize is the number of range descriptors scanned at
+// a time when looking up the ranges spanned by a backup file.
+const rangeLookupBatchSize = 100
+
+// AdminRestore reads the manifest of the backup at the request's URI
+// and starts a job restoring it, which runs until complete or the
+// store is stopped. The job's ID is returned in the reply and its
+// progress is recorded at keys.RestoreStatusKey(jobID).
+func (r *Range) AdminRestore(args *proto.AdminRestoreRequest, reply *proto.AdminRestoreResponse) {
+	es, err := NewExportStorage(args.URI)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	manifest, err := ReadBackupManifest(es)
+	if err != nil {
+		reply.SetGoError(util.Errorf("unable to read backup manifest at %q: %s", args.URI, err))
+		return
+	}
+	if !manifest.StartTime.Equal(proto.ZeroTimestamp) {
+		// The keys of an incremental backup overlap those of its base,
+		// which bulk loading requires to be empty.
+		reply.SetGoError(util.Errorf("cannot restore incremental backup at %q", args.URI))
+		return
+	}
+	jobID := args.JobID
+	if jobID == "" {
+		jobID = uuid.New()
+	}
+	now := r.rm.Clock().PhysicalNow()
+	job := &restoreJob{
+		db:       r.rm.DB(),
+		clock:    r.rm.Clock(),
+		es:       es,
+		manifest: manifest,
+		key:      keys.RestoreStatusKey(jobID),
+		status: proto.RestoreStatus{
+			URI:        args.URI,
+			TotalFiles: int32(len(manifest.Files)),
+			StartedAt:  now,
+			UpdatedAt:  now,
+		},
+	}
+	if err := job.putStatus(); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	reply.JobID = jobID
+	stopper := r.rm.Stopper()
+	stopper.RunWorker(func() {
+		job.run(stopper.ShouldStop())
+	})
+}
+
+// ReadBackupManifest reads the manifest of the backup in es.
+func ReadBackupManifest(es ExportStorage) (*proto.BackupManifest, error) {
+	r, err := es.ReadFile(BackupManifestName)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &proto.BackupManifest{}
+	if err := gogoproto.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// A restoreJob restores the files of a backup one at a time, in key
+// order, recording its progress in a RestoreStatus.
+type restoreJob struct {
+	db       *client.KV
+	clock    *hlc.Clock
+	es       ExportStorage
+	manifest *proto.BackupManifest
+	key      proto.Key
+	status   proto.RestoreStatus
+}
+
+// run restores the backup's system keys and then its files until all
+// have been restored, one fails to be, or stop is closed.
+func (j *restoreJob) run(stop <-chan struct{}) {
+	err := func() error {
+		if err := j.restoreSystemKeys(); err != nil {
+			return util.Errorf("unable to restore system keys: %s", err)
+		}
+		for _, file := range j.manifest.Files {
+			select {
+			case <-stop:
+				return util.Errorf("restore interrupted")
+			default:
+			}
+			count, err := j.restoreFile(file)
+			if err != nil {
+				return util.Errorf("unable to restore [%q, %q): %s", file.StartKey, file.EndKey, err)
+			}
+			j.status.RestoredFiles++
+			j.status.RestoredKeys += count
+			j.status.UpdatedAt = j.clock.PhysicalNow()
+			if err := j.putStatus(); err != nil {
+				log.Warningf("unable to update status of restore from %q: %s", j.status.URI, err)
+			}
+		}
+		// Split off the keys following the backup's span, as they were
+		// when it was taken.
+		if j.manifest.EndKey.Less(keys.KeyMax) {
+			return j.ensureSplit(j.manifest.EndKey)
+		}
+		return nil
+	}()
+	j.status.Done = true
+	if err != nil {
+		j.status.Error = err.Error()
+		log.Errorf("restore from %q failed: %s", j.status.URI, err)
+	}
+	j.status.UpdatedAt = j.clock.PhysicalNow()
+	if err := j.putStatus(); err != nil {
+		log.Errorf("unable to update status of restore from %q: %s", j.status.URI, err)
+	}
+}
+
+func (j *restoreJob) putStatus() error {
+	return j.db.Run(client.PutProtoCall(j.key, &j.status))
+}
+
+// restoreSystemKeys writes the system keys of the backup, such as its
+// schema definitions, users and zone configs, over those of the
+// cluster in a single transaction.
+func (j *restoreJob) restoreSystemKeys() error {
+	if len(j.manifest.SystemKeys) == 0 {
+		return nil
+	}
+	return j.db.RunTransaction(&client.TransactionOptions{Name: "restore system keys"}, func(txn *client.Txn) error {
+		for _, kv := range j.manifest.SystemKeys {
+			value := kv.Value
+			value.Timestamp = nil
+			txn.Prepare(client.Call{
+				Args: &proto.PutRequest{
+					RequestHeader: proto.RequestHeader{Key: kv.Key},
+					Value:         value,
+				},
+				Reply: &proto.PutResponse{},
+			})
+		}
+		return nil
+	})
+}
+
+// restoreFile splits the keyspace at the start of the file's span and
+// bulk loads the file's key/value pairs, one range at a time. Returns
+// the number of key/value pairs loaded.
+func (j *restoreJob) restoreFile(file proto.BackupFile) (int64, error) {
+	if err := j.ensureSplit(file.StartKey); err != nil {
+		return 0, err
+	}
+	if file.Name == "" {
+		return 0, nil
+	}
+	kvs, err := j.readFile(file)
+	if err != nil {
+		return 0, err
+	}
+	count := int64(len(kvs))
+	// Ranges may have been split within the file's span since the
+	// backup was taken.
+	descs, err := lookupRangeDescriptors(j.db, file.StartKey, file.EndKey)
+	if err != nil {
+		return 0, err
+	}
+	for _, desc := range descs {
+		var rows []proto.KeyValue
+		for len(kvs) > 0 && kvs[0].Key.Less(desc.EndKey) {
+			rows = append(rows, kvs[0])
+			kvs = kvs[1:]
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		args := &proto.AdminBulkLoadRequest{
+			RequestHeader: proto.RequestHeader{Key: rows[0].Key},
+			Rows:          rows,
+		}
+		if err := j.db.Run(client.Call{Args: args, Reply: &proto.AdminBulkLoadResponse{}}); err != nil {
+			return 0, err
+		}
+	}
+	if len(kvs) > 0 {
+		return 0, util.Errorf("key %q lies outside of the file's span", kvs[0].Key)
+	}
+	return count, nil
+}
+
+// ensureSplit splits the range containing key at key, unless key is
+// already the start of a range.
+func (j *restoreJob) ensureSplit(key proto.Key) error {
+	descs, err := lookupRangeDescriptors(j.db, key, key.Next())
+	if err != nil {
+		return err
+	}
+	if descs[0].StartKey.Equal(key) {
+		return nil
+	}
+	args := &proto.AdminSplitRequest{
+		RequestHeader: proto.RequestHeader{Key: key},
+		SplitKey:      key,
+	}
+	return j.db.Run(client.Call{Args: args, Reply: &proto.AdminSplitResponse{}})
+}
+
+// readFile reads the named backup file, verifies its size and
+// checksum and decodes its key/value pairs.
+func (j *restoreJob) readFile(file proto.BackupFile) ([]proto.KeyValue, error) {
+	r, err := j.es.ReadFile(file.Name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != file.Bytes {
+		return nil, util.Errorf("backup file %s has %d bytes; expected %d", file.Name, len(data), file.Bytes)
+	}
+	if sum := sha256.Sum256(data); !bytes.Equal(sum[:], file.Checksum) {
+		return nil, util.Errorf("backup file %s has a checksum mismatch", file.Name)
+	}
+	switch j.manifest.Format {
+	case proto.PROTO:
+		return readBackupProto(bytes.NewReader(data))
+	}
+	return nil, util.Errorf("unknown backup format %s", j.manifest.Format)
+}
+
+// lookupRangeDescriptors returns the descriptors of the ranges
+// spanning [start, end), in key order. Range descriptors are
+// addressed by the meta2 key of their end key.
+func lookupRangeDescriptors(db *client.KV, start, end proto.Key) ([]proto.RangeDescriptor, error) {
+	var descs []proto.RangeDescriptor
+	key := keys.RangeMetaKey(start.Next())
+	for {
+		call := client.ScanCall(key, keys.KeyMeta2Prefix.PrefixEnd(), rangeLookupBatchSize)
+		if err := db.Run(call); err != nil {
+			return nil, err
+		}
+		rows := call.Reply.(*proto.ScanResponse).Rows
+		for _, row := range rows {
+			var desc proto.RangeDescriptor
+			if err := gogoproto.Unmarshal(row.Value.Bytes, &desc); err != nil {
+				return nil, err
+			}
+			descs = append(descs, desc)
+			if !desc.EndKey.Less(end) {
+				return descs, nil
+			}
+		}
+		if len(rows) < rangeLookupBatchSize {
+			return nil, util.Errorf("no range descriptor found for key %q", end)
+		}
+		key = rows[len(rows)-1].Key.Next()
+	}
+}
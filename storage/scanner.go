@@ -19,12 +19,9 @@ package storage
 
 import (
 	"sync"
-	"sync/atomic"
 	"time"
-	"unsafe"
 
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -61,23 +58,15 @@ type rangeIterator interface {
 	Reset()
 }
 
-// A storeStats holds statistics over the entire store. Stats is an
-// aggregation of MVCC stats across all ranges in the store.
-type storeStats struct {
-	RangeCount int
-	MVCC       proto.MVCCStats
-}
-
 // A rangeScanner iterates over ranges at a measured pace in order to
 // complete approximately one full scan per interval. Each range is
 // tested for inclusion in a sequence of prioritized range queues.
 type rangeScanner struct {
-	interval time.Duration  // Duration interval for scan loop
-	iter     rangeIterator  // Iterator to implement scan of ranges
-	queues   []rangeQueue   // Range queues managed by this scanner
-	removed  chan *Range    // Ranges to remove from queues
-	stats    unsafe.Pointer // Latest store stats object; updated atomically
-	scanFn   func()         // Function called at each complete scan iteration
+	interval time.Duration // Duration interval for scan loop
+	iter     rangeIterator // Iterator to implement scan of ranges
+	queues   []rangeQueue  // Range queues managed by this scanner
+	removed  chan *Range   // Ranges to remove from queues
+	scanFn   func()        // Function called at each complete scan iteration
 	// Count of times through the scanning loop but locked by the completedScan
 	// mutex.
 	count         int64
@@ -92,7 +81,6 @@ func newRangeScanner(interval time.Duration, iter rangeIterator, scanFn func())
 		interval:      interval,
 		iter:          iter,
 		removed:       make(chan *Range, 10),
-		stats:         unsafe.Pointer(&storeStats{RangeCount: iter.EstimatedCount()}),
 		scanFn:        scanFn,
 		completedScan: sync.NewCond(&sync.Mutex{}),
 	}
@@ -112,14 +100,6 @@ func (rs *rangeScanner) Start(clock *hlc.Clock, stopper *util.Stopper) {
 	rs.scanLoop(clock, stopper)
 }
 
-// Stats returns store stats from the most recently completed scan of
-// all ranges. A scanner which hasn't fully scanned the ranges will
-// return a stats object with MVCC stats empty and only an estimate
-// for RangeCount.
-func (rs *rangeScanner) Stats() storeStats {
-	return *(*storeStats)(atomic.LoadPointer(&rs.stats))
-}
-
 // Count returns the number of times the scanner has cycled through
 // all ranges.
 func (rs *rangeScanner) Count() int64 {
@@ -169,7 +149,6 @@ func (rs *rangeScanner) paceInterval(start, now time.Time) time.Duration {
 func (rs *rangeScanner) scanLoop(clock *hlc.Clock, stopper *util.Stopper) {
 	stopper.RunWorker(func() {
 		start := time.Now()
-		stats := &storeStats{}
 
 		for {
 			waitInterval := rs.paceInterval(start, time.Now())
@@ -185,15 +164,10 @@ func (rs *rangeScanner) scanLoop(clock *hlc.Clock, stopper *util.Stopper) {
 					for _, q := range rs.queues {
 						q.MaybeAdd(rng, clock.Now())
 					}
-					stats.RangeCount++
-					engine.Accumulate(&stats.MVCC, rng.stats.GetMVCC())
 				} else {
 					// Otherwise, we're done with the iteration. Reset iteration and start time.
 					rs.iter.Reset()
 					start = time.Now()
-					// Store the most recent scan results in the scanner's stats.
-					atomic.StorePointer(&rs.stats, unsafe.Pointer(stats))
-					stats = &storeStats{}
 					if rs.scanFn != nil {
 						rs.scanFn()
 					}
@@ -45,18 +45,6 @@ func newTestIterator(count int) *testIterator {
 		start: time.Now(),
 	}
 	ti.ranges = make([]Range, count)
-	// Initialize range stats for each range so the scanner can use them.
-	for i := range ti.ranges {
-		ti.ranges[i].stats = &rangeStats{
-			raftID: int64(i),
-			MVCCStats: proto.MVCCStats{
-				KeyBytes:  1,
-				ValBytes:  2,
-				KeyCount:  1,
-				LiveCount: 1,
-			},
-		}
-	}
 	return ti
 }
 
@@ -304,37 +292,3 @@ func TestScannerEmptyIterator(t *testing.T) {
 		t.Errorf("expected three loops; got %d", count)
 	}
 }
-
-// TestScannerStats verifies that stats accumulate from all ranges.
-func TestScannerStats(t *testing.T) {
-	defer leaktest.AfterTest(t)
-	const count = 3
-	iter := newTestIterator(count)
-	q := &testQueue{}
-	stopper := util.NewStopper()
-	defer stopper.Stop()
-	s := newRangeScanner(1*time.Millisecond, iter, nil)
-	s.AddQueues(q)
-	mc := hlc.NewManualClock(0)
-	clock := hlc.NewClock(mc.UnixNano)
-	// At start, scanner stats should be blank for MVCC, but have accurate number of ranges.
-	if rc := s.Stats().RangeCount; rc != count {
-		t.Errorf("range count expected %d; got %d", count, rc)
-	}
-	if vb := s.Stats().MVCC.ValBytes; vb != 0 {
-		t.Errorf("value bytes expected %d; got %d", 0, vb)
-	}
-	s.Start(clock, stopper)
-	// We expect a full run to accumulate stats from all ranges.
-	if err := util.IsTrueWithin(func() bool {
-		if rc := s.Stats().RangeCount; rc != count {
-			return false
-		}
-		if vb := s.Stats().MVCC.ValBytes; vb != count*2 {
-			return false
-		}
-		return true
-	}, 100*time.Millisecond); err != nil {
-		t.Error(err)
-	}
-}
@@ -0,0 +1,114 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// startScrubber starts a worker which, every ScrubInterval, reads all
+// of the store's range data to verify the checksums of the underlying
+// storage blocks. Ranges found to hold corrupt data are reported via
+// ReportCorruption.
+func (s *Store) startScrubber() {
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(s.ctx.ScrubInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.scrub()
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// scrub verifies the checksums of the data of each range in the
+// store.
+func (s *Store) scrub() {
+	s.mu.RLock()
+	ranges := append(RangeSlice(nil), s.rangesByKey...)
+	s.mu.RUnlock()
+
+	for _, rng := range ranges {
+		if !s.stopper.StartTask() {
+			return
+		}
+		err := rng.verifyChecksums()
+		s.stopper.FinishTask()
+		if err != nil {
+			s.ReportCorruption(rng, err)
+		}
+	}
+	log.V(1).Infof("store %d: scrubbed %d ranges", s.StoreID(), len(ranges))
+}
+
+// ReportCorruption records that the store's replica of the range holds
+// corrupt data. The first report for a range logs an error and
+// removes the replica, so that the range is re-replicated from its
+// healthy replicas by the leader's replicate queue.
+func (s *Store) ReportCorruption(rng *Range, err error) {
+	raftID := rng.Desc().RaftID
+	log.Errorf("store %d: replica of range %d is corrupt: %s", s.StoreID(), raftID, err)
+
+	s.mu.Lock()
+	reported := s.corruptRanges[raftID]
+	s.corruptRanges[raftID] = true
+	s.mu.Unlock()
+	if reported || !s.stopper.StartTask() {
+		return
+	}
+	go func() {
+		defer s.stopper.FinishTask()
+		if err := rng.removeCorruptReplica(); err != nil {
+			log.Errorf("store %d: unable to remove corrupt replica of range %d: %s", s.StoreID(), raftID, err)
+		}
+	}()
+}
+
+// verifyChecksums verifies the checksums of all storage blocks holding
+// the range's data.
+func (r *Range) verifyChecksums() error {
+	for _, kr := range rangeDataKeyRanges(r) {
+		if err := r.rm.Engine().VerifyChecksums(kr.start, kr.end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeCorruptReplica removes the local replica from the range. The
+// leader's replicate queue then restores the range's replication
+// factor from the remaining replicas. The leader's own replica can't
+// be removed this way and is left in place until leadership moves.
+func (r *Range) removeCorruptReplica() error {
+	if r.IsLeader() {
+		return util.Errorf("range %d: the leader's replica cannot remove itself", r.Desc().RaftID)
+	}
+	_, replica := r.Desc().FindReplica(r.rm.StoreID())
+	if replica == nil {
+		return nil
+	}
+	return r.ChangeReplicas(proto.REMOVE_REPLICA, *replica)
+}
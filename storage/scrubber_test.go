@@ -0,0 +1,71 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"golang.org/x/net/context"
+)
+
+// TestRangeVerifyChecksums verifies that the checksums of an intact
+// range's data verify successfully.
+func TestRangeVerifyChecksums(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	rng := store.LookupRange([]byte("a"), nil)
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), rng.Desc().RaftID, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.verifyChecksums(); err != nil {
+		t.Errorf("unexpected error verifying checksums: %s", err)
+	}
+}
+
+// TestStoreReportCorruption verifies that a corrupt replica is
+// recorded in the store's status and that the sole replica of a range,
+// being its leader, is not removed.
+func TestStoreReportCorruption(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	rng := store.LookupRange([]byte("a"), nil)
+	raftID := rng.Desc().RaftID
+	store.ReportCorruption(rng, util.Errorf("injected corruption"))
+	// A second report is ignored.
+	store.ReportCorruption(rng, util.Errorf("injected corruption"))
+
+	if err := rng.removeCorruptReplica(); err == nil {
+		t.Error("expected the leader's replica not to be removed")
+	}
+	store.mu.RLock()
+	corrupt := store.corruptRanges[raftID]
+	store.mu.RUnlock()
+	if !corrupt {
+		t.Errorf("expected range %d to be recorded as corrupt", raftID)
+	}
+	if len(rng.Desc().Replicas) != 1 {
+		t.Errorf("expected the range's replica to remain; got %+v", rng.Desc().Replicas)
+	}
+}
@@ -23,9 +23,11 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -36,7 +38,8 @@ const (
 )
 
 // splitQueue manages a queue of ranges slated to be split due to size
-// or along intersecting accounting or zone config boundaries.
+// or along intersecting accounting, permission or zone config
+// boundaries.
 type splitQueue struct {
 	*baseQueue
 	db     *client.KV
@@ -57,15 +60,15 @@ func newSplitQueue(db *client.KV, gossip *gossip.Gossip) *splitQueue {
 
 // shouldQueue determines whether a range should be queued for
 // splitting. This is true if the range is intersected by any
-// accounting or zone config prefix or if the range's size in
-// bytes exceeds the limit for the zone.
+// accounting, permission or zone config prefix or if the range's
+// size in bytes exceeds the limit for the zone.
 func (sq *splitQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool, priority float64) {
 	// Only queue for Split if this replica is leader.
 	if !rng.IsLeader() || sq.disabled {
 		return
 	}
 
-	// Set priority to 1 in the event the range is split by acct or zone configs.
+	// Set priority to 1 in the event the range is split by configs.
 	if len(computeSplitKeys(sq.gossip, rng)) > 0 {
 		priority = 1
 		shouldQ = true
@@ -91,10 +94,11 @@ func (sq *splitQueue) process(now proto.Timestamp, rng *Range) error {
 		log.Infof("not leader of range %s; skipping split", rng)
 		return nil
 	}
-	// First handle case of splitting due to accounting and zone config maps.
+	// First handle case of splitting due to config maps.
 	splitKeys := computeSplitKeys(sq.gossip, rng)
 	if len(splitKeys) > 0 {
-		log.Infof("splitting range %q-%q at keys %v", rng.Desc().StartKey, rng.Desc().EndKey, splitKeys)
+		log.Infof("splitting range %s-%s at keys %v", keys.PrettyPrint(rng.Desc().StartKey),
+			keys.PrettyPrint(rng.Desc().EndKey), splitKeys)
 		for _, splitKey := range splitKeys {
 			req := &proto.AdminSplitRequest{
 				RequestHeader: proto.RequestHeader{Key: splitKey},
@@ -114,7 +118,7 @@ func (sq *splitQueue) process(now proto.Timestamp, rng *Range) error {
 		return err
 	}
 	if float64(rng.stats.GetSize())/float64(zone.RangeMaxBytes) > 1 {
-		rng.AddCmd(&proto.AdminSplitRequest{
+		rng.AddCmd(context.Background(), &proto.AdminSplitRequest{
 			RequestHeader: proto.RequestHeader{Key: rng.Desc().StartKey},
 		}, &proto.AdminSplitResponse{}, true)
 	}
@@ -128,18 +132,21 @@ func (sq *splitQueue) timer() time.Duration {
 
 // computeSplitKeys returns an array of keys at which the supplied
 // range should be split, as computed by intersecting the range with
-// accounting and zone config map boundaries.
+// accounting, permission and zone config map boundaries.
 func computeSplitKeys(g *gossip.Gossip, rng *Range) []proto.Key {
 	// Now split the range into pieces by intersecting it with the
 	// boundaries of the config map.
+	cfg, err := GetSystemConfig(g)
+	if err != nil {
+		log.Errorf("unable to fetch system config from gossip: %s", err)
+		return nil
+	}
 	splitKeys := proto.KeySlice{}
-	for _, configKey := range []string{gossip.KeyConfigAccounting, gossip.KeyConfigZone} {
-		info, err := g.GetInfo(configKey)
-		if err != nil {
-			log.Errorf("unable to fetch %s config from gossip: %s", configKey, err)
+	for _, cd := range configDescriptors {
+		configMap := *cd.configMap(cfg)
+		if len(configMap) == 0 {
 			continue
 		}
-		configMap := info.(PrefixConfigMap)
 		splits, err := configMap.SplitRangeByPrefixes(rng.Desc().StartKey, rng.Desc().EndKey)
 		if err != nil {
 			log.Errorf("unable to split range %q-%q by prefix map %s", rng.Desc().StartKey, rng.Desc().EndKey, configMap)
@@ -154,7 +161,7 @@ func computeSplitKeys(g *gossip.Gossip, rng *Range) []proto.Key {
 	}
 
 	// Sort and unique the combined split keys from intersections with
-	// both the accounting and zone config maps.
+	// all of the config maps.
 	sort.Sort(splitKeys)
 	var unique []proto.Key
 	for i, key := range splitKeys {
@@ -167,10 +174,10 @@ func computeSplitKeys(g *gossip.Gossip, rng *Range) []proto.Key {
 
 // lookupZoneConfig returns the zone config matching the range.
 func lookupZoneConfig(g *gossip.Gossip, rng *Range) (proto.ZoneConfig, error) {
-	zoneMap, err := g.GetInfo(gossip.KeyConfigZone)
-	if err != nil || zoneMap == nil {
-		return proto.ZoneConfig{}, util.Errorf("unable to lookup zone config for range %s: %s", rng, err)
+	cfg, err := GetSystemConfig(g)
+	if err != nil || len(cfg.Zones) == 0 {
+		return proto.ZoneConfig{}, util.Errorf("unable to lookup zone config for range %s: %v", rng, err)
 	}
-	prefixConfig := zoneMap.(PrefixConfigMap).MatchByPrefix(rng.Desc().StartKey)
+	prefixConfig := cfg.Zones.MatchByPrefix(rng.Desc().StartKey)
 	return *prefixConfig.Config.(*proto.ZoneConfig), nil
 }
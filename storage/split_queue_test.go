@@ -23,40 +23,47 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
 // TestSplitQueueShouldQueue verifies shouldQueue method correctly
-// combines splits in accounting and zone configs with the size of
-// the range.
+// combines splits in accounting, permission and zone configs with
+// the size of the range.
 func TestSplitQueueShouldQueue(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
 
-	// Set accounting and zone configs.
+	// Set accounting, permission and zone configs.
 	acctMap, err := NewPrefixConfigMap([]*PrefixConfig{
-		{engine.KeyMin, nil, 1},
+		{keys.KeyMin, nil, 1},
 		{proto.Key("/dbA"), nil, 2},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := tc.gossip.AddInfo(gossip.KeyConfigAccounting, acctMap, 0*time.Second); err != nil {
+	permMap, err := NewPrefixConfigMap([]*PrefixConfig{
+		{keys.KeyMin, nil, 1},
+		{proto.Key("/dbD"), nil, 2},
+	})
+	if err != nil {
 		t.Fatal(err)
 	}
-
 	zoneMap, err := NewPrefixConfigMap([]*PrefixConfig{
-		{engine.KeyMin, nil, &proto.ZoneConfig{RangeMaxBytes: 64 << 20}},
+		{keys.KeyMin, nil, &proto.ZoneConfig{RangeMaxBytes: 64 << 20}},
 		{proto.Key("/dbB"), nil, &proto.ZoneConfig{RangeMaxBytes: 64 << 20}},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := tc.gossip.AddInfo(gossip.KeyConfigZone, zoneMap, 0*time.Second); err != nil {
+	if err := tc.gossip.AddInfo(gossip.KeySystemConfig, SystemConfig{
+		Accounting:  acctMap,
+		Permissions: permMap,
+		Zones:       zoneMap,
+	}, 0*time.Second); err != nil {
 		t.Fatal(err)
 	}
 
@@ -72,6 +79,10 @@ func TestSplitQueueShouldQueue(t *testing.T) {
 		{proto.Key("/"), proto.Key("/dbA1"), 0, true, 1},
 		// Intersection in zone, no bytes.
 		{proto.Key("/dbA"), proto.Key("/dbC"), 0, true, 1},
+		// Intersection in permissions, no bytes.
+		{proto.Key("/dbC"), proto.Key("/dbD1"), 0, true, 1},
+		// Starts at permission prefix, no intersection, no bytes.
+		{proto.Key("/dbD"), proto.Key("/dbD1"), 0, false, 0},
 		// Multiple intersections, no bytes.
 		{proto.KeyMin, proto.KeyMax, 0, true, 1},
 		// No intersection, max bytes.
@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"reflect"
 	"sync"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -34,8 +35,10 @@ import (
 // Update() instead. For access from other goroutines, use GetMVCC().
 type rangeStats struct {
 	raftID          int64
-	sync.Mutex      // Protects MVCCStats
-	proto.MVCCStats // embedded, cached version of stat values
+	sync.Mutex                         // Protects MVCCStats, store and forwarded
+	proto.MVCCStats                    // embedded, cached version of stat values
+	store           *storeStatsTracker // Receives stat changes, if attached
+	forwarded       proto.MVCCStats    // Sum of stats forwarded to store
 }
 
 // newRangeStats creates a new instance of rangeStats using the
@@ -85,6 +88,10 @@ func (rs *rangeStats) MergeMVCCStats(e engine.Engine, ms *proto.MVCCStats, nowNa
 func (rs *rangeStats) SetMVCCStats(e engine.Engine, ms proto.MVCCStats) {
 	rs.Lock()
 	defer rs.Unlock()
+	if rs.store != nil {
+		rs.store.update(0, ms.Difference(&rs.forwarded))
+		rs.forwarded = ms
+	}
 	rs.MVCCStats = ms
 	engine.SetStats(&ms, e, rs.raftID)
 }
@@ -96,6 +103,10 @@ func (rs *rangeStats) Update(ms proto.MVCCStats) {
 	rs.Lock()
 	defer rs.Unlock()
 	engine.Accumulate(&rs.MVCCStats, ms)
+	if rs.store != nil {
+		rs.store.update(0, ms)
+		engine.Accumulate(&rs.forwarded, ms)
+	}
 }
 
 // GetAvgIntentAge returns the average age of outstanding intents,
@@ -125,3 +136,121 @@ func (rs *rangeStats) GetGCBytesAge(nowNanos int64) int64 {
 	elapsedSeconds := nowNanos/1E9 - rs.LastUpdateNanos/1E9
 	return rs.GCBytesAge + engine.MVCCComputeGCBytesAge(gcBytes, elapsedSeconds)
 }
+
+// A storeStats holds statistics over the entire store. Stats is an
+// aggregation of MVCC stats across all ranges in the store.
+type storeStats struct {
+	RangeCount int
+	MVCC       proto.MVCCStats
+}
+
+// isZero returns true if the range count and all MVCC stats are zero.
+func (ss storeStats) isZero() bool {
+	return ss.RangeCount == 0 && reflect.DeepEqual(ss.MVCC, proto.MVCCStats{})
+}
+
+// A storeStatsTracker maintains the stats of a store incrementally.
+// The rangeStats of each range attached to the tracker forward their
+// changes to it as they are applied, and ranges are attached and
+// detached as they are added to and removed from the store, so the
+// aggregate is current without scanning the store's ranges.
+//
+// Locks are acquired in the order Store.mu, rangeStats, tracker.
+type storeStatsTracker struct {
+	sync.Mutex
+	stats   storeStats
+	changed chan struct{} // Signaled after each change to stats
+}
+
+// newStoreStatsTracker returns a tracker with no ranges attached.
+func newStoreStatsTracker() *storeStatsTracker {
+	return &storeStatsTracker{changed: make(chan struct{}, 1)}
+}
+
+// Stats returns a copy of the store's current stats.
+func (t *storeStatsTracker) Stats() storeStats {
+	t.Lock()
+	defer t.Unlock()
+	return t.stats
+}
+
+// Changed returns a channel which receives a value after the store's
+// stats have changed. Changes are coalesced: any number of changes
+// since the last receive leave a single value in the channel.
+func (t *storeStatsTracker) Changed() <-chan struct{} {
+	return t.changed
+}
+
+// attach adds the range's stats to the store's, and forwards the
+// range's subsequent changes to the tracker until detached.
+func (t *storeStatsTracker) attach(rs *rangeStats) {
+	rs.Lock()
+	defer rs.Unlock()
+	if rs.store == t {
+		return
+	}
+	rs.store = t
+	rs.forwarded = rs.MVCCStats
+	t.update(1, rs.forwarded)
+}
+
+// detach subtracts the range's forwarded stats from the store's and stops
+// forwarding the range's changes to the tracker.
+func (t *storeStatsTracker) detach(rs *rangeStats) {
+	rs.Lock()
+	defer rs.Unlock()
+	if rs.store != t {
+		return
+	}
+	rs.store = nil
+	var ms proto.MVCCStats
+	engine.Subtract(&ms, rs.forwarded)
+	rs.forwarded = proto.MVCCStats{}
+	t.update(-1, ms)
+}
+
+// update applies a change to the range count and MVCC stats.
+func (t *storeStatsTracker) update(rangeDelta int, ms proto.MVCCStats) {
+	t.Lock()
+	t.stats.RangeCount += rangeDelta
+	engine.Accumulate(&t.stats.MVCC, ms)
+	t.Unlock()
+	select {
+	case t.changed <- struct{}{}:
+	default:
+	}
+}
+
+// reconcile corrects the tracked stats for any change to the stats
+// of the supplied ranges which wasn't forwarded to the tracker, and
+// returns the total drift corrected. Ranges are reconciled one at a
+// time: each range's stats are compared with the sum forwarded from
+// it while only that range is locked, and the difference is then
+// applied to the tracker. Ranges which aren't attached to the tracker
+// are skipped, so the caller needn't prevent ranges from being added
+// or removed concurrently. Since attach and detach always adjust the
+// range count, the drift's range count is zero.
+func (t *storeStatsTracker) reconcile(ranges []*rangeStats) storeStats {
+	var drift storeStats
+	for _, rs := range ranges {
+		engine.Accumulate(&drift.MVCC, t.reconcileRange(rs))
+	}
+	return drift
+}
+
+// reconcileRange corrects the tracked stats for any drift of a single
+// attached range and returns the drift.
+func (t *storeStatsTracker) reconcileRange(rs *rangeStats) proto.MVCCStats {
+	rs.Lock()
+	if rs.store != t {
+		rs.Unlock()
+		return proto.MVCCStats{}
+	}
+	drift := rs.MVCCStats.Difference(&rs.forwarded)
+	rs.forwarded = rs.MVCCStats
+	rs.Unlock()
+	if !reflect.DeepEqual(drift, proto.MVCCStats{}) {
+		t.update(0, drift)
+	}
+	return drift
+}
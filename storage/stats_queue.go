@@ -0,0 +1,96 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	// statsQueueMaxSize is the max size of the stats queue.
+	statsQueueMaxSize = 100
+	// statsQueueTimerDuration is the duration between stats
+	// recomputations of queued ranges. Each recomputation is a full
+	// scan of the range, so they are spaced out.
+	statsQueueTimerDuration = 1 * time.Second
+)
+
+// statsQueue recomputes the MVCC stats of ranges whose stats are
+// suspected to have drifted, as indicated by values which can't be
+// reached through correct accounting (e.g. negative counts). Stats
+// drift is otherwise permanent, as stats are only ever updated
+// incrementally.
+type statsQueue struct {
+	*baseQueue
+}
+
+// newStatsQueue returns a new instance of statsQueue.
+func newStatsQueue() *statsQueue {
+	sq := &statsQueue{}
+	sq.baseQueue = newBaseQueue("stats", sq, statsQueueMaxSize)
+	return sq
+}
+
+// shouldQueue determines whether a range should be queued for stats
+// recomputation. Only the range leader queues, as the recomputation
+// is sent via Raft on behalf of all replicas.
+func (sq *statsQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool, priority float64) {
+	if !rng.IsLeader() {
+		return
+	}
+	if statsSuspect(rng.stats.GetMVCC()) {
+		return true, 1
+	}
+	return
+}
+
+// process sends an InternalRecomputeStats command for the range.
+func (sq *statsQueue) process(now proto.Timestamp, rng *Range) error {
+	args := &proto.InternalRecomputeStatsRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       rng.Desc().StartKey,
+			Timestamp: now,
+			RaftID:    rng.Desc().RaftID,
+			Priority:  proto.BACKGROUND,
+		},
+	}
+	return rng.AddCmd(context.Background(), args, &proto.InternalRecomputeStatsResponse{}, true)
+}
+
+// timer returns the duration between processing of queued ranges.
+func (sq *statsQueue) timer() time.Duration {
+	return statsQueueTimerDuration
+}
+
+// statsSuspect returns whether the supplied stats violate invariants
+// which hold for any correctly maintained stats.
+func statsSuspect(ms proto.MVCCStats) bool {
+	for _, v := range []int64{ms.LiveBytes, ms.KeyBytes, ms.ValBytes, ms.IntentBytes,
+		ms.LiveCount, ms.KeyCount, ms.ValCount, ms.IntentCount, ms.IntentAge, ms.GCBytesAge} {
+		if v < 0 {
+			return true
+		}
+	}
+	return ms.LiveBytes > ms.KeyBytes+ms.ValBytes ||
+		ms.IntentBytes > ms.KeyBytes+ms.ValBytes ||
+		ms.LiveCount > ms.KeyCount ||
+		ms.IntentCount > ms.KeyCount
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"golang.org/x/net/context"
+)
+
+// TestStatsSuspect verifies detection of stats which can't result
+// from correct accounting.
+func TestStatsSuspect(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	valid := proto.MVCCStats{LiveBytes: 10, KeyBytes: 8, ValBytes: 4, LiveCount: 1, KeyCount: 1, ValCount: 1}
+	testCases := []struct {
+		mutate  func(ms *proto.MVCCStats)
+		suspect bool
+	}{
+		{func(ms *proto.MVCCStats) {}, false},
+		{func(ms *proto.MVCCStats) { *ms = proto.MVCCStats{} }, false},
+		{func(ms *proto.MVCCStats) { ms.KeyCount = -1 }, true},
+		{func(ms *proto.MVCCStats) { ms.GCBytesAge = -1 }, true},
+		{func(ms *proto.MVCCStats) { ms.LiveBytes = 13 }, true},
+		{func(ms *proto.MVCCStats) { ms.LiveCount = 2 }, true},
+		{func(ms *proto.MVCCStats) { ms.IntentCount = 2 }, true},
+	}
+	for i, test := range testCases {
+		ms := valid
+		test.mutate(&ms)
+		if suspect := statsSuspect(ms); suspect != test.suspect {
+			t.Errorf("%d: expected suspect %t for %+v; got %t", i, test.suspect, ms, suspect)
+		}
+	}
+}
+
+// TestStatsQueueProcess verifies that the stats queue queues a range
+// with corrupted stats and that processing it restores the stats
+// computed by a full scan of the range.
+func TestStatsQueueProcess(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, key := range []string{"a", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sq := newStatsQueue()
+	now := tc.clock.Now()
+	if shouldQ, _ := sq.shouldQueue(now, tc.rng); shouldQ {
+		t.Fatal("expected range with correct stats not to be queued")
+	}
+
+	// Corrupt the range's stats.
+	corrupt := tc.rng.stats.GetMVCC()
+	corrupt.KeyCount = -2
+	corrupt.LiveBytes += 100
+	tc.rng.stats.SetMVCCStats(tc.rng.rm.Engine(), corrupt)
+	if shouldQ, _ := sq.shouldQueue(now, tc.rng); !shouldQ {
+		t.Fatal("expected range with corrupt stats to be queued")
+	}
+
+	now = tc.clock.Now()
+	if err := sq.process(now, tc.rng); err != nil {
+		t.Fatal(err)
+	}
+	expMS, err := engine.MVCCComputeStats(tc.rng.rm.Engine(), tc.rng.Desc().StartKey, tc.rng.Desc().EndKey, now.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms := tc.rng.stats.GetMVCC(); !reflect.DeepEqual(ms, expMS) {
+		t.Errorf("expected stats %+v; got %+v", expMS, ms)
+	}
+	if shouldQ, _ := sq.shouldQueue(now, tc.rng); shouldQ {
+		t.Error("expected range with recomputed stats not to be queued")
+	}
+}
@@ -137,3 +137,56 @@ func TestRangeStatsMerge(t *testing.T) {
 		t.Errorf("expected %+v; got %+v", expMS, tc.rng.stats.MVCCStats)
 	}
 }
+
+// TestStoreStatsTracker verifies that the stats of attached ranges
+// are forwarded to the store's stats tracker, and that reconciliation
+// corrects changes which weren't.
+func TestStoreStatsTracker(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	tracker := newStoreStatsTracker()
+	rs1 := &rangeStats{raftID: 1, MVCCStats: proto.MVCCStats{KeyBytes: 1, KeyCount: 1}}
+	rs2 := &rangeStats{raftID: 2, MVCCStats: proto.MVCCStats{KeyBytes: 2, KeyCount: 2}}
+
+	expect := func(rangeCount int, keyBytes, keyCount int64) {
+		stats := tracker.Stats()
+		if stats.RangeCount != rangeCount || stats.MVCC.KeyBytes != keyBytes || stats.MVCC.KeyCount != keyCount {
+			t.Errorf("expected %d ranges, %d key bytes and %d keys; got %+v", rangeCount, keyBytes, keyCount, stats)
+		}
+	}
+
+	tracker.attach(rs1)
+	tracker.attach(rs2)
+	// Attaching a range twice counts it once.
+	tracker.attach(rs2)
+	expect(2, 3, 3)
+	select {
+	case <-tracker.Changed():
+	default:
+		t.Error("expected a notification of the changed stats")
+	}
+
+	rs1.Update(proto.MVCCStats{KeyBytes: 10, KeyCount: 1})
+	expect(2, 13, 4)
+	rs2.SetMVCCStats(e, proto.MVCCStats{KeyBytes: 5, KeyCount: 1})
+	expect(2, 16, 3)
+	tracker.detach(rs1)
+	expect(1, 5, 1)
+	// Changes to detached ranges aren't forwarded.
+	rs1.Update(proto.MVCCStats{KeyBytes: 10, KeyCount: 1})
+	expect(1, 5, 1)
+
+	if drift := tracker.reconcile([]*rangeStats{rs2}); !drift.isZero() {
+		t.Errorf("expected no drift; got %+v", drift)
+	}
+	rs2.MVCCStats.KeyBytes += 7
+	// Detached ranges are skipped, so rs1's changes since it was
+	// detached aren't counted as drift.
+	if drift := tracker.reconcile([]*rangeStats{rs1, rs2}); drift.MVCC.KeyBytes != 7 {
+		t.Errorf("expected drift of 7 key bytes; got %+v", drift)
+	}
+	expect(1, 12, 1)
+	// Detaching the range subtracts its corrected stats.
+	tracker.detach(rs2)
+	expect(0, 0, 0)
+}
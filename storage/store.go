@@ -20,6 +20,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -34,6 +36,9 @@ import (
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/metrics"
+	"github.com/cockroachdb/cockroach/util/trace"
+	"github.com/coreos/etcd/raft"
 	"github.com/coreos/etcd/raft/raftpb"
 	gogoproto "github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
@@ -50,6 +55,18 @@ const (
 	defaultRaftTickInterval         = 10 * time.Millisecond
 	defaultHeartbeatIntervalTicks   = 3
 	defaultRaftElectionTimeoutTicks = 15
+	// defaultReplicateQueueInterval paces replica additions and removals
+	// made by each store's replicate queue.
+	defaultReplicateQueueInterval = 1 * time.Second
+	// defaultScrubInterval is the default interval between verifications
+	// of the checksums of all of a store's data.
+	defaultScrubInterval = 24 * time.Hour
+	// defaultStatusInterval is the default minimum interval between
+	// writes of the store's status.
+	defaultStatusInterval = 10 * time.Second
+	// defaultHighWaterInterval is the default interval at which the
+	// high-water timestamp of the store's clock is persisted.
+	defaultHighWaterInterval = 1 * time.Second
 	// ttlCapacityGossip is time-to-live for capacity-related info.
 	ttlCapacityGossip = 2 * time.Minute
 )
@@ -71,18 +88,24 @@ var (
 		RaftHeartbeatIntervalTicks: 1,
 		RaftElectionTimeoutTicks:   2,
 		ScanInterval:               10 * time.Minute,
-		Context:                    context.Background(),
+		ReplicateQueueInterval:     10 * time.Millisecond,
+		// Tests mostly use manual clocks, which don't advance past the
+		// high water on restart.
+		HighWaterInterval: -1,
+		Context:           context.Background(),
 	}
 )
 
 var (
 	changeTypeRaftToInternal = map[raftpb.ConfChangeType]proto.ReplicaChangeType{
-		raftpb.ConfChangeAddNode:    proto.ADD_REPLICA,
-		raftpb.ConfChangeRemoveNode: proto.REMOVE_REPLICA,
+		raftpb.ConfChangeAddNode:           proto.ADD_REPLICA,
+		raftpb.ConfChangeRemoveNode:        proto.REMOVE_REPLICA,
+		multiraft.ConfChangeAddLearnerNode: proto.ADD_LEARNER,
 	}
 	changeTypeInternalToRaft = map[proto.ReplicaChangeType]raftpb.ConfChangeType{
 		proto.ADD_REPLICA:    raftpb.ConfChangeAddNode,
 		proto.REMOVE_REPLICA: raftpb.ConfChangeRemoveNode,
+		proto.ADD_LEARNER:    multiraft.ConfChangeAddLearnerNode,
 	}
 )
 
@@ -91,13 +114,13 @@ var (
 // keys prefixed with the meta1 or meta2 addressing prefixes. There is a
 // special case for both key-local AND meta1 or meta2 addressing prefixes.
 func verifyKeyLength(key proto.Key) error {
-	maxLength := engine.KeyMaxLength
-	if bytes.HasPrefix(key, engine.KeyLocalRangeKeyPrefix) {
-		key = key[len(engine.KeyLocalRangeKeyPrefix):]
+	maxLength := keys.KeyMaxLength
+	if bytes.HasPrefix(key, keys.KeyLocalRangeKeyPrefix) {
+		key = key[len(keys.KeyLocalRangeKeyPrefix):]
 		_, key = encoding.DecodeBytes(key)
 	}
-	if bytes.HasPrefix(key, engine.KeyMetaPrefix) {
-		key = key[len(engine.KeyMeta1Prefix):]
+	if bytes.HasPrefix(key, keys.KeyMetaPrefix) {
+		key = key[len(keys.KeyMeta1Prefix):]
 	}
 	if len(key) > maxLength {
 		return util.Errorf("maximum key length exceeded for %q", key)
@@ -112,14 +135,14 @@ func verifyKeys(start, end proto.Key) error {
 	if err := verifyKeyLength(start); err != nil {
 		return err
 	}
-	if !start.Less(engine.KeyMax) {
+	if !start.Less(keys.KeyMax) {
 		return util.Errorf("start key %q must be less than KeyMax", start)
 	}
 	if len(end) > 0 {
 		if err := verifyKeyLength(end); err != nil {
 			return err
 		}
-		if engine.KeyMax.Less(end) {
+		if keys.KeyMax.Less(end) {
 			return util.Errorf("end key %q must be less than or equal to KeyMax", end)
 		}
 		if end.Less(start) {
@@ -182,10 +205,11 @@ func (e *NotBootstrappedError) Error() string {
 // StoreDescriptor holds store information including store attributes,
 // node descriptor and store capacity.
 type StoreDescriptor struct {
-	StoreID  proto.StoreID
-	Attrs    proto.Attributes // store specific attributes (e.g. ssd, hdd, mem)
-	Node     gossip.NodeDescriptor
-	Capacity engine.StoreCapacity
+	StoreID    proto.StoreID
+	Attrs      proto.Attributes // store specific attributes (e.g. ssd, hdd, mem)
+	Node       gossip.NodeDescriptor
+	Capacity   engine.StoreCapacity
+	RangeCount int
 }
 
 // CombinedAttrs returns the full list of attributes for the store,
@@ -245,24 +269,34 @@ func (si *storeRangeIterator) Reset() {
 type Store struct {
 	*StoreFinder
 
-	Ident          proto.StoreIdent
-	ctx            StoreContext
-	engine         engine.Engine   // The underlying key-value store
-	allocator      *allocator      // Makes allocation decisions
-	raftIDAlloc    *IDAllocator    // Raft ID allocator
-	gcQueue        *gcQueue        // Garbage collection queue
-	splitQueue     *splitQueue     // Range splitting queue
-	verifyQueue    *verifyQueue    // Checksum verification queue
-	replicateQueue *replicateQueue // Replication queue
-	scanner        *rangeScanner   // Range scanner
-	multiraft      *multiraft.MultiRaft
-	started        int32
-	stopper        *util.Stopper
-	startedAt      int64
-
-	mu          sync.RWMutex     // Protects variables below...
-	ranges      map[int64]*Range // Map of ranges by Raft ID
-	rangesByKey RangeSlice       // Sorted slice of ranges by StartKey
+	Ident            proto.StoreIdent
+	ctx              StoreContext
+	engine           engine.Engine        // The underlying key-value store
+	raftEngine       engine.Engine        // Holds the Raft logs; may be engine
+	allocator        *allocator           // Makes allocation decisions
+	admission        *admissionController // Pushes back on writes under load
+	raftIDAlloc      *IDAllocator         // Raft ID allocator
+	gcQueue          *gcQueue             // Garbage collection queue
+	splitQueue       *splitQueue          // Range splitting queue
+	verifyQueue      *verifyQueue         // Checksum verification queue
+	replicateQueue   *replicateQueue      // Replication queue
+	consistencyQueue *consistencyQueue    // Replica consistency checking queue
+	statsQueue       *statsQueue          // MVCC stats recomputation queue
+	scanner          *rangeScanner        // Range scanner
+	stats            *storeStatsTracker   // Stats of the store's ranges
+	queueActivity    *queueActivity       // Recent events of the queues
+	multiraft        *multiraft.MultiRaft
+	started          int32
+	stopper          *util.Stopper
+	startedAt        int64
+	latencies        *RequestLatencies // Latencies of executed requests
+	acctUsage        acctUsageCache    // Cached cluster accounting usage
+
+	mu            sync.RWMutex     // Protects variables below...
+	ranges        map[int64]*Range // Map of ranges by Raft ID
+	rangesByKey   RangeSlice       // Sorted slice of ranges by StartKey
+	corruptRanges map[int64]bool   // Raft IDs of ranges with corrupt replicas
+	systemConfig  *SystemConfig    // Last system config received via gossip
 }
 
 var _ multiraft.Storage = &Store{}
@@ -277,6 +311,10 @@ type StoreContext struct {
 	Gossip    *gossip.Gossip
 	Transport multiraft.Transport
 	Context   context.Context
+	// Tracer collects the spans of requests executed by the node, if set.
+	Tracer *trace.Tracer
+	// NodeLiveness supplies the liveness of the cluster's nodes, if set.
+	NodeLiveness *NodeLiveness
 
 	// RangeRetryOptions are the retry options for ranges.
 	// TODO(tschottdorf) improve comment once I figure out what this is.
@@ -295,8 +333,113 @@ type StoreContext struct {
 	// for local networks.
 	RaftElectionTimeoutTicks int
 
-	// ScanInterval is the default value for the scan interval
+	// RaftManualScheduling runs all of the store's Raft groups from the
+	// Scheduler returned by RaftScheduler rather than a goroutine and a
+	// ticker of their own, so that tests can replay an interleaving of
+	// Raft events exactly (see multiraft.Config.ManualScheduling). The
+	// stores must share a multiraft.NewLocalDirectTransport, and every
+	// command must be issued from the goroutine stepping the scheduler.
+	RaftManualScheduling bool
+
+	// ScanInterval is the default value for the scan interval. Each scan
+	// also reconciles the store's stats with those of its ranges.
 	ScanInterval time.Duration
+
+	// StatusInterval is the minimum interval between writes of the
+	// store's status, which are triggered by changes to the stats of the
+	// store's ranges and to its set of ranges.
+	StatusInterval time.Duration
+
+	// ReplicateQueueInterval is the minimum duration between replica
+	// changes made by the store's replicate queue. It bounds the rate
+	// at which data is moved when a zone's replication factor changes.
+	ReplicateQueueInterval time.Duration
+
+	// QueueRangesPerSecond limits the total rate at which the store's
+	// queues process ranges, in addition to the limits of the individual
+	// queues. Zero means no limit.
+	QueueRangesPerSecond int64
+
+	// FatalOnInconsistency causes the process to exit if a consistency
+	// check finds that a replica's data has diverged from the leader's.
+	// Otherwise the replica is reported corrupt and replaced.
+	FatalOnInconsistency bool
+
+	// ScrubInterval is the interval between background verifications of
+	// the checksums of all data held by the store.
+	ScrubInterval time.Duration
+
+	// HighWaterInterval is the interval at which a timestamp exceeding
+	// those issued by the clock is persisted to the store. On restart,
+	// the store doesn't start until its clock exceeds the persisted
+	// timestamp plus the clock's maximum offset, so that a regressed
+	// wall clock doesn't issue timestamps below those issued before.
+	// A negative interval disables the high water.
+	HighWaterInterval time.Duration
+
+	// SyncRaftLog causes appends to the Raft log and updates to the Raft
+	// HardState to be synced to stable storage before they are
+	// acknowledged.
+	SyncRaftLog bool
+
+	// CapacityGossipAvailableDelta and CapacityGossipRangeDelta are the
+	// changes of a store's available space, as a fraction of its
+	// capacity, and of its range count since the capacities of the
+	// node's stores were last gossiped which cause them to be gossiped
+	// again. Zero causes any change to be gossiped.
+	CapacityGossipAvailableDelta float64
+	CapacityGossipRangeDelta     int
+
+	// CapacityGossipMaxStaleness is the interval after which the
+	// capacities of the node's stores are gossiped regardless of their
+	// changes. It is capped at half the time-to-live of the gossip.
+	CapacityGossipMaxStaleness time.Duration
+
+	// ClosedTimestampLag is how far behind the current time ranges close
+	// timestamps with their proposals. Replicas which have applied a
+	// proposal can serve non-transactional reads at or below the
+	// timestamp it closed, even if they're not the leader. Writes at
+	// older timestamps are pushed above the closed timestamp, so the lag
+	// trades off the staleness of such follower reads against restarts
+	// of long-running transactions. Zero disables closing timestamps.
+	ClosedTimestampLag time.Duration
+
+	// ValueCompressionThreshold is the size in bytes beyond which the
+	// values written by commands proposed by the store's ranges are
+	// snappy compressed. The proposed command carries the compressed
+	// values, so replicas store the same bytes regardless of their own
+	// threshold. Zero disables value compression.
+	ValueCompressionThreshold int
+
+	// SyncApply causes the write batches of applied Raft commands to be
+	// synced to stable storage. Committed commands are replayed from the
+	// Raft log on restart, so this is unnecessary if SyncRaftLog is set.
+	SyncApply bool
+
+	// MaxIncomingSnapshots and MaxOutgoingSnapshots limit the number of
+	// Raft snapshots the store receives and sends concurrently. Zero
+	// means no limit.
+	MaxIncomingSnapshots int
+	MaxOutgoingSnapshots int
+
+	// SnapshotBytesPerSecond throttles the rate at which the store sends
+	// Raft snapshots. Zero means no limit.
+	SnapshotBytesPerSecond int64
+
+	// AdmissionL0Files, AdmissionMemtableBytes and AdmissionRaftLogEntries
+	// are the thresholds of compaction debt (files in level 0 of the LSM
+	// tree), memtable size and Raft log growth (entries in the longest
+	// Raft log of the store's ranges) at which the store is overloaded
+	// and begins to push back on incoming writes. At twice the threshold
+	// the store is saturated. Zero disables the respective measure.
+	AdmissionL0Files        int64
+	AdmissionMemtableBytes  int64
+	AdmissionRaftLogEntries int64
+
+	// AdmissionWritesPerSecond is the rate to which writes are limited
+	// while the store pushes back on them. Zero means no limit, leaving
+	// only the rejections of writes of low priority.
+	AdmissionWritesPerSecond int64
 }
 
 // Valid returns true if the StoreContext is populated correctly.
@@ -321,10 +464,30 @@ func (sc *StoreContext) setDefaults() {
 	if sc.RaftElectionTimeoutTicks == 0 {
 		sc.RaftElectionTimeoutTicks = defaultRaftElectionTimeoutTicks
 	}
+	if sc.ReplicateQueueInterval == 0 {
+		sc.ReplicateQueueInterval = defaultReplicateQueueInterval
+	}
+	if sc.ScrubInterval == 0 {
+		sc.ScrubInterval = defaultScrubInterval
+	}
+	if sc.HighWaterInterval == 0 {
+		sc.HighWaterInterval = defaultHighWaterInterval
+	}
+	if sc.StatusInterval == 0 {
+		sc.StatusInterval = defaultStatusInterval
+	}
 }
 
-// NewStore returns a new instance of a store.
+// NewStore returns a new instance of a store which keeps the Raft
+// logs of its ranges in the same engine as their data.
 func NewStore(ctx StoreContext, eng engine.Engine) *Store {
+	return NewStoreWithRaftEngine(ctx, eng, eng)
+}
+
+// NewStoreWithRaftEngine returns a new instance of a store which
+// keeps the Raft logs and Raft state of its ranges in raftEng, which
+// may reside on a different device than eng.
+func NewStoreWithRaftEngine(ctx StoreContext, eng, raftEng engine.Engine) *Store {
 	// TODO(tschottdorf) find better place to set these defaults.
 	ctx.setDefaults()
 
@@ -334,20 +497,33 @@ func NewStore(ctx StoreContext, eng engine.Engine) *Store {
 
 	sf := newStoreFinder(ctx.Gossip)
 	s := &Store{
-		ctx:         ctx,
-		StoreFinder: sf,
-		engine:      eng,
-		allocator:   newAllocator(sf.findStores),
-		ranges:      map[int64]*Range{},
+		ctx:           ctx,
+		StoreFinder:   sf,
+		engine:        eng,
+		raftEngine:    raftEng,
+		allocator:     newAllocator(sf.findStores, ctx.NodeLiveness),
+		admission:     newAdmissionController(&ctx),
+		ranges:        map[int64]*Range{},
+		corruptRanges: map[int64]bool{},
+		latencies:     NewRequestLatencies("store.request"),
+		stats:         newStoreStatsTracker(),
+		queueActivity: &queueActivity{},
 	}
 
 	// Add range scanner and configure with queues.
-	s.scanner = newRangeScanner(ctx.ScanInterval, newStoreRangeIterator(s), s.updateStoreStatus)
+	s.scanner = newRangeScanner(ctx.ScanInterval, newStoreRangeIterator(s), s.reconcileStoreStatus)
 	s.gcQueue = newGCQueue()
 	s.splitQueue = newSplitQueue(s.ctx.DB, s.ctx.Gossip)
-	s.verifyQueue = newVerifyQueue(s.scanner.Stats)
-	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator, s.ctx.Clock)
-	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue)
+	s.verifyQueue = newVerifyQueue(s.stats.Stats)
+	s.consistencyQueue = newConsistencyQueue(s.stats.Stats)
+	s.statsQueue = newStatsQueue()
+	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator, s.ctx.Clock, s.ctx.ReplicateQueueInterval)
+	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue, s.consistencyQueue, s.statsQueue)
+	pacer := newQueuePacer(ctx.QueueRangesPerSecond)
+	for _, q := range s.baseQueues() {
+		q.setPacer(pacer)
+		q.setActivity(s.queueActivity)
+	}
 
 	return s
 }
@@ -369,13 +545,12 @@ func (s *Store) Start(stopper *util.Stopper) error {
 	if s.Ident.NodeID == 0 {
 		// Open engine (i.e. initialize RocksDB database). "NodeID != 0"
 		// implies the engine has already been opened.
-		if err := s.engine.Open(); err != nil {
+		if err := s.openEngines(s.stopper); err != nil {
 			return err
 		}
-		s.stopper.AddCloser(s.engine)
 
 		// Read store ident and return a not-bootstrapped error if necessary.
-		ok, err := engine.MVCCGetProto(s.engine, engine.StoreIdentKey(), proto.ZeroTimestamp, true,
+		ok, err := engine.MVCCGetProto(s.engine, keys.StoreIdentKey(), proto.ZeroTimestamp, true,
 			nil, &s.Ident)
 		if err != nil {
 			return err
@@ -384,8 +559,17 @@ func (s *Store) Start(stopper *util.Stopper) error {
 		}
 	}
 
+	// Wait out the clock's high water from before the restart, then
+	// begin persisting it anew.
+	if err := s.waitForHighWater(); err != nil {
+		return err
+	}
+	if err := s.startHighWater(); err != nil {
+		return err
+	}
+
 	// Create ID allocators.
-	idAlloc, err := NewIDAllocator(engine.KeyRaftIDGenerator, s.ctx.DB, 2 /* min ID */, raftIDAllocCount, s.stopper)
+	idAlloc, err := NewIDAllocator(keys.KeyRaftIDGenerator, s.ctx.DB, 2 /* min ID */, raftIDAllocCount, s.stopper)
 	if err != nil {
 		return err
 	}
@@ -402,8 +586,8 @@ func (s *Store) Start(stopper *util.Stopper) error {
 	s.engine.SetGCTimeouts(minTxnTS, minRCacheTS)
 
 	// Iterator over all range-local key-based data.
-	start := engine.RangeDescriptorKey(engine.KeyMin)
-	end := engine.RangeDescriptorKey(engine.KeyMax)
+	start := keys.RangeDescriptorKey(keys.KeyMin)
+	end := keys.RangeDescriptorKey(keys.KeyMax)
 
 	if s.multiraft, err = multiraft.NewMultiRaft(s.RaftNodeID(), &multiraft.Config{
 		Transport:              s.ctx.Transport,
@@ -412,6 +596,13 @@ func (s *Store) Start(stopper *util.Stopper) error {
 		TickInterval:           s.ctx.RaftTickInterval,
 		ElectionTimeoutTicks:   s.ctx.RaftElectionTimeoutTicks,
 		HeartbeatIntervalTicks: s.ctx.RaftHeartbeatIntervalTicks,
+		PreVote:                true,
+		CheckQuorum:            true,
+		Quiesce:                true,
+		MaxIncomingSnapshots:   s.ctx.MaxIncomingSnapshots,
+		MaxOutgoingSnapshots:   s.ctx.MaxOutgoingSnapshots,
+		SnapshotBytesPerSecond: s.ctx.SnapshotBytesPerSecond,
+		ManualScheduling:       s.ctx.RaftManualScheduling,
 		EntryFormatter:         raftEntryFormatter,
 	}); err != nil {
 		return err
@@ -423,8 +614,8 @@ func (s *Store) Start(stopper *util.Stopper) error {
 	// next split attempt. They can otherwise be ignored.
 	if err := engine.MVCCIterate(s.engine, start, end, now, false, nil, func(kv proto.KeyValue) (bool, error) {
 		// Only consider range metadata entries; ignore others.
-		_, suffix, _ := engine.DecodeRangeKey(kv.Key)
-		if !suffix.Equal(engine.KeyLocalRangeDescriptorSuffix) {
+		_, suffix, _ := keys.DecodeRangeKey(kv.Key)
+		if !suffix.Equal(keys.KeyLocalRangeDescriptorSuffix) {
 			return false, nil
 		}
 		var desc proto.RangeDescriptor
@@ -462,20 +653,31 @@ func (s *Store) Start(stopper *util.Stopper) error {
 
 	// Start the scanner.
 	s.scanner.Start(s.ctx.Clock, s.stopper)
+	for _, q := range s.baseQueues() {
+		q.registerMetrics(metrics.Metrics, fmt.Sprintf("storage.store.%d.queue.%s", s.StoreID(), q.name), s.stopper)
+	}
 
-	// Register callbacks for any changes to accounting and zone
-	// configurations; we split ranges along prefix boundaries to
-	// avoid having a range that has two different accounting/zone
-	// configs. (We don't need a callback for permissions since
-	// permissions don't have such a requirement.)
+	// Start writing the store's status as its stats change.
+	s.startStatusUpdater()
+
+	// Start the background checksum verification of the store's data.
+	s.startScrubber()
+
+	// Start reassessing the store's load for admission control.
+	s.startAdmission()
+
+	// Register a callback for any changes to the system config; we
+	// split ranges along the prefix boundaries of its accounting,
+	// permission and zone configs to avoid having a range that has
+	// two different configs of the same type.
 	//
 	// Gossip is only ever nil for unittests.
 	if s.ctx.Gossip != nil {
-		s.ctx.Gossip.RegisterCallback(gossip.KeyConfigAccounting, s.configGossipUpdate)
-		s.ctx.Gossip.RegisterCallback(gossip.KeyConfigZone, s.configGossipUpdate)
+		s.ctx.Gossip.RegisterCallback(gossip.KeySystemConfig, s.systemConfigGossipUpdate)
 		// Callback triggers on capacity gossip from all stores.
 		capacityRegex := gossip.MakePrefixPattern(gossip.KeyMaxAvailCapacityPrefix)
 		s.ctx.Gossip.RegisterCallback(capacityRegex, s.capacityGossipUpdate)
+		s.ctx.Gossip.RegisterExpiryCallback(capacityRegex, s.capacityGossipExpired)
 	}
 
 	// Set the started flag (for unittests).
@@ -484,41 +686,49 @@ func (s *Store) Start(stopper *util.Stopper) error {
 	return nil
 }
 
-// configGossipUpdate is a callback for gossip updates to
-// configuration maps which affect range split boundaries.
-func (s *Store) configGossipUpdate(key string, contentsChanged bool) {
-	if !contentsChanged {
-		return // Skip update if it's just a newer timestamp or fewer hops to info
-	}
-	info, err := s.ctx.Gossip.GetInfo(key)
-	if err != nil {
-		log.Errorf("unable to fetch %s config from gossip: %s", key, err)
-		return
-	}
-	configMap, ok := info.(PrefixConfigMap)
+// systemConfigGossipUpdate is a callback for gossip updates to the
+// system config. Only the configuration maps which changed since the
+// last update are acted upon: ranges intersected by the new prefix
+// boundaries are queued for splitting and, if the zone configs
+// changed, each range's max bytes are updated and the range is
+// offered to the replicate and GC queues.
+func (s *Store) systemConfigGossipUpdate(_ string, val interface{}) {
+	cfg, ok := val.(SystemConfig)
 	if !ok {
-		log.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+		log.Errorf("gossiped info is not a system config: %+v", val)
 		return
 	}
-	s.maybeSplitRangesByConfigs(configMap)
+	s.mu.Lock()
+	prev := s.systemConfig
+	s.systemConfig = &cfg
+	s.mu.Unlock()
+
+	for _, cd := range configDescriptors {
+		configMap := *cd.configMap(&cfg)
+		if prev != nil && reflect.DeepEqual(configMap, *cd.configMap(prev)) {
+			continue
+		}
+		s.maybeSplitRangesByConfigs(configMap)
+	}
 
-	// If the zone configs changed, run through ranges and set max bytes.
-	if key == gossip.KeyConfigZone {
-		s.setRangesMaxBytes(configMap)
+	if len(cfg.Zones) > 0 && (prev == nil || !reflect.DeepEqual(cfg.Zones, prev.Zones)) {
+		s.setRangesMaxBytes(cfg.Zones)
+		s.ForceReplicationScan()
+		s.ForceGCScan()
 	}
 }
 
-// GossipCapacity broadcasts the node's capacity on the gossip network.
+// GossipCapacity broadcasts the store's capacity on the gossip network
+// as that of the only store of its node. Nodes with several stores
+// gossip their capacities through a CapacityGossiper instead.
 func (s *Store) GossipCapacity(n *gossip.NodeDescriptor) {
 	storeDesc, err := s.Descriptor(n)
 	if err != nil {
 		log.Warningf("problem getting store descriptor for store %+v: %v", s.Ident, err)
 		return
 	}
-	// Unique gossip key per store.
-	keyMaxCapacity := gossip.MakeMaxAvailCapacityKey(storeDesc.Node.NodeID, storeDesc.StoreID)
-	// Gossip store descriptor.
-	s.ctx.Gossip.AddInfo(keyMaxCapacity, *storeDesc, ttlCapacityGossip)
+	keyMaxCapacity := gossip.MakeMaxAvailCapacityKey(storeDesc.Node.NodeID)
+	s.ctx.Gossip.AddInfo(keyMaxCapacity, []StoreDescriptor{*storeDesc}, ttlCapacityGossip)
 }
 
 // maybeSplitRangesByConfigs determines ranges which should be
@@ -542,7 +752,7 @@ func (s *Store) maybeSplitRangesByConfigs(configMap PrefixConfigMap) {
 }
 
 // ForceReplicationScan iterates over all ranges and enqueues any that need to be
-// replicated. Exposed only for testing.
+// replicated. It is invoked on zone config changes and exposed for testing.
 func (s *Store) ForceReplicationScan() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -552,6 +762,18 @@ func (s *Store) ForceReplicationScan() {
 	}
 }
 
+// ForceGCScan iterates over all ranges and enqueues any that need
+// garbage collection. It is invoked on zone config changes, which may
+// alter the GC policies of ranges, and exposed for testing.
+func (s *Store) ForceGCScan() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.ranges {
+		s.gcQueue.MaybeAdd(r, s.ctx.Clock.Now())
+	}
+}
+
 // setRangesMaxBytes sets the max bytes for every range according
 // to the zone configs.
 //
@@ -570,6 +792,7 @@ func (s *Store) setRangesMaxBytes(zoneMap PrefixConfigMap) {
 			zone = zoneMap[idx].Config.(*proto.ZoneConfig)
 		}
 		rng.SetMaxBytes(zone.RangeMaxBytes)
+		rng.SetBackpressureBytes(backpressureBytes(zone))
 	}
 }
 
@@ -582,17 +805,16 @@ func (s *Store) Bootstrap(ident proto.StoreIdent, stopper *util.Stopper) error {
 	if s.Ident.NodeID != 0 {
 		return util.Errorf("engine already bootstrapped")
 	}
-	if err := s.engine.Open(); err != nil {
+	if err := s.openEngines(stopper); err != nil {
 		return err
 	}
-	stopper.AddCloser(s.engine)
 	s.Ident = ident
-	kvs, err := engine.Scan(s.engine, proto.EncodedKey(engine.KeyMin), proto.EncodedKey(engine.KeyMax), 1)
+	kvs, err := engine.Scan(s.engine, proto.EncodedKey(keys.KeyMin), proto.EncodedKey(keys.KeyMax), 1)
 	if err != nil {
 		return util.Errorf("store %s: unable to access: %s", s.engine, err)
 	} else if len(kvs) > 0 {
 		// See if this is an already-bootstrapped store.
-		ok, err := engine.MVCCGetProto(s.engine, engine.StoreIdentKey(), proto.ZeroTimestamp, true, nil, &s.Ident)
+		ok, err := engine.MVCCGetProto(s.engine, keys.StoreIdentKey(), proto.ZeroTimestamp, true, nil, &s.Ident)
 		if err != nil {
 			return util.Errorf("store %s is non-empty but cluster ID could not be determined: %s", s.engine, err)
 		}
@@ -601,7 +823,7 @@ func (s *Store) Bootstrap(ident proto.StoreIdent, stopper *util.Stopper) error {
 		}
 		return util.Errorf("store %s is not-empty and has invalid contents (first key: %q)", s.engine, kvs[0].Key)
 	}
-	err = engine.MVCCPutProto(s.engine, nil, engine.StoreIdentKey(), proto.ZeroTimestamp, nil, &s.Ident)
+	err = engine.MVCCPutProto(s.engine, nil, keys.StoreIdentKey(), proto.ZeroTimestamp, nil, &s.Ident)
 	return err
 }
 
@@ -612,7 +834,7 @@ func (s *Store) GetRange(raftID int64) (*Range, error) {
 	if rng, ok := s.ranges[raftID]; ok {
 		return rng, nil
 	}
-	log.Infof("couldn't find range %d in %s", raftID, s.ranges)
+	log.Infof("couldn't find range %d in %v", raftID, s.ranges)
 	return nil, proto.NewRangeNotFoundError(raftID)
 }
 
@@ -624,8 +846,8 @@ func (s *Store) GetRange(raftID int64) (*Range, error) {
 func (s *Store) LookupRange(start, end proto.Key) *Range {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	startAddr := engine.KeyAddress(start)
-	endAddr := engine.KeyAddress(end)
+	startAddr := keys.KeyAddress(start)
+	endAddr := keys.KeyAddress(end)
 	n := sort.Search(len(s.rangesByKey), func(i int) bool {
 		return startAddr.Less(s.rangesByKey[i].Desc().EndKey)
 	})
@@ -646,8 +868,8 @@ func (s *Store) LookupRange(start, end proto.Key) *Range {
 func (s *Store) BootstrapRange() error {
 	desc := &proto.RangeDescriptor{
 		RaftID:   1,
-		StartKey: engine.KeyMin,
-		EndKey:   engine.KeyMax,
+		StartKey: keys.KeyMin,
+		EndKey:   keys.KeyMax,
 		Replicas: []proto.Replica{
 			{
 				NodeID:  1,
@@ -660,31 +882,31 @@ func (s *Store) BootstrapRange() error {
 	now := s.ctx.Clock.Now()
 
 	// Range descriptor.
-	if err := engine.MVCCPutProto(batch, ms, engine.RangeDescriptorKey(desc.StartKey), now, nil, desc); err != nil {
+	if err := engine.MVCCPutProto(batch, ms, keys.RangeDescriptorKey(desc.StartKey), now, nil, desc); err != nil {
 		return err
 	}
 	// GC Metadata.
 	gcMeta := proto.NewGCMetadata(now.WallTime)
-	if err := engine.MVCCPutProto(batch, ms, engine.RangeGCMetadataKey(desc.RaftID), proto.ZeroTimestamp, nil, gcMeta); err != nil {
+	if err := engine.MVCCPutProto(batch, ms, keys.RangeGCMetadataKey(desc.RaftID), proto.ZeroTimestamp, nil, gcMeta); err != nil {
 		return err
 	}
 	// Verification timestamp.
-	if err := engine.MVCCPutProto(batch, ms, engine.RangeLastVerificationTimestampKey(desc.RaftID), proto.ZeroTimestamp, nil, &now); err != nil {
+	if err := engine.MVCCPutProto(batch, ms, keys.RangeLastVerificationTimestampKey(desc.RaftID), proto.ZeroTimestamp, nil, &now); err != nil {
 		return err
 	}
 	// Range addressing for meta2.
-	meta2Key := engine.RangeMetaKey(engine.KeyMax)
+	meta2Key := keys.RangeMetaKey(keys.KeyMax)
 	if err := engine.MVCCPutProto(batch, ms, meta2Key, now, nil, desc); err != nil {
 		return err
 	}
 	// Range addressing for meta1.
-	meta1Key := engine.RangeMetaKey(meta2Key)
+	meta1Key := keys.RangeMetaKey(meta2Key)
 	if err := engine.MVCCPutProto(batch, ms, meta1Key, now, nil, desc); err != nil {
 		return err
 	}
 	// Accounting config.
 	acctConfig := &proto.AcctConfig{}
-	key := engine.MakeKey(engine.KeyConfigAccountingPrefix, engine.KeyMin)
+	key := keys.MakeKey(keys.KeyConfigAccountingPrefix, keys.KeyMin)
 	if err := engine.MVCCPutProto(batch, ms, key, now, nil, acctConfig); err != nil {
 		return err
 	}
@@ -693,7 +915,7 @@ func (s *Store) BootstrapRange() error {
 		Read:  []string{UserRoot}, // root user
 		Write: []string{UserRoot}, // root user
 	}
-	key = engine.MakeKey(engine.KeyConfigPermissionPrefix, engine.KeyMin)
+	key = keys.MakeKey(keys.KeyConfigPermissionPrefix, keys.KeyMin)
 	if err := engine.MVCCPutProto(batch, ms, key, now, nil, permConfig); err != nil {
 		return err
 	}
@@ -710,7 +932,7 @@ func (s *Store) BootstrapRange() error {
 			TTLSeconds: 24 * 60 * 60, // 1 day
 		},
 	}
-	key = engine.MakeKey(engine.KeyConfigZonePrefix, engine.KeyMin)
+	key = keys.MakeKey(keys.KeyConfigZonePrefix, keys.KeyMin)
 	if err := engine.MVCCPutProto(batch, ms, key, now, nil, zoneConfig); err != nil {
 		return err
 	}
@@ -726,6 +948,20 @@ func (s *Store) BootstrapRange() error {
 	return nil
 }
 
+// ReadBootstrapInfo implements the gossip.Storage interface. Read
+// attempts to read gossip bootstrap info from the store's engine,
+// returning an empty info if none has been written.
+func (s *Store) ReadBootstrapInfo(bi *proto.GossipBootstrapInfo) error {
+	_, err := engine.MVCCGetProto(s.engine, keys.StoreGossipKey(), proto.ZeroTimestamp, true, nil, bi)
+	return err
+}
+
+// WriteBootstrapInfo implements the gossip.Storage interface. Write
+// persists the supplied bootstrap info to the store's engine.
+func (s *Store) WriteBootstrapInfo(bi *proto.GossipBootstrapInfo) error {
+	return engine.MVCCPutProto(s.engine, nil, keys.StoreGossipKey(), proto.ZeroTimestamp, nil, bi)
+}
+
 // The following methods implement the RangeManager interface.
 
 // ClusterID accessor.
@@ -745,6 +981,25 @@ func (s *Store) Clock() *hlc.Clock { return s.ctx.Clock }
 // Engine accessor.
 func (s *Store) Engine() engine.Engine { return s.engine }
 
+// RaftEngine accessor.
+func (s *Store) RaftEngine() engine.Engine { return s.raftEngine }
+
+// openEngines opens the store's engine and, if separate, its Raft log
+// engine, arranging for both to be closed when stopper stops.
+func (s *Store) openEngines(stopper *util.Stopper) error {
+	if err := s.engine.Open(); err != nil {
+		return err
+	}
+	stopper.AddCloser(s.engine)
+	if s.raftEngine != s.engine {
+		if err := s.raftEngine.Open(); err != nil {
+			return err
+		}
+		stopper.AddCloser(s.raftEngine)
+	}
+	return nil
+}
+
 // DB accessor.
 func (s *Store) DB() *client.KV { return s.ctx.DB }
 
@@ -754,9 +1009,19 @@ func (s *Store) Allocator() *allocator { return s.allocator }
 // Gossip accessor.
 func (s *Store) Gossip() *gossip.Gossip { return s.ctx.Gossip }
 
+// StoreContext accessor.
+func (s *Store) StoreContext() *StoreContext { return &s.ctx }
+
 // SplitQueue accessor.
 func (s *Store) SplitQueue() *splitQueue { return s.splitQueue }
 
+// Stopper accessor.
+func (s *Store) Stopper() *util.Stopper { return s.stopper }
+
+// RaftScheduler returns the scheduler of a started store which uses
+// RaftManualScheduling, or nil otherwise.
+func (s *Store) RaftScheduler() *multiraft.Scheduler { return s.multiraft.Scheduler() }
+
 // NewRangeDescriptor creates a new descriptor based on start and end
 // keys and the supplied proto.Replicas slice. It allocates new Raft
 // and range IDs to fill out the supplied replicas.
@@ -860,6 +1125,7 @@ func (s *Store) addRangeInternal(rng *Range, resort bool) error {
 	}
 	s.ranges[rng.Desc().RaftID] = rng
 	s.rangesByKey = append(s.rangesByKey, rng)
+	s.stats.attach(rng.stats)
 	if resort {
 		sort.Sort(s.rangesByKey)
 	}
@@ -878,6 +1144,8 @@ func (s *Store) RemoveRange(rng *Range) error {
 	defer s.mu.Unlock()
 
 	delete(s.ranges, rng.Desc().RaftID)
+	delete(s.corruptRanges, rng.Desc().RaftID)
+	s.stats.detach(rng.stats)
 	// Find the range in rangesByKey slice and swap it to end of slice
 	// and truncate.
 	n := sort.Search(len(s.rangesByKey), func(i int) bool {
@@ -914,17 +1182,25 @@ func (s *Store) Descriptor(nodeDesc *gossip.NodeDescriptor) (*StoreDescriptor, e
 	}
 	// Initialize the store descriptor.
 	return &StoreDescriptor{
-		StoreID:  s.Ident.StoreID,
-		Attrs:    s.Attrs(),
-		Node:     *nodeDesc,
-		Capacity: capacity,
+		StoreID:    s.Ident.StoreID,
+		Attrs:      s.Attrs(),
+		Node:       *nodeDesc,
+		Capacity:   capacity,
+		RangeCount: s.stats.Stats().RangeCount,
 	}, nil
 }
 
 // ExecuteCmd fetches a range based on the header's replica, assembles
 // method, args & reply into a Raft Cmd struct and executes the
-// command using the fetched range.
-func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
+// command using the fetched range. Execution is abandoned, returning
+// ctx.Err(), if ctx is done before the command completes; see
+// Range.AddCmd.
+func (s *Store) ExecuteCmd(ctx context.Context, args proto.Request, reply proto.Response) error {
+	ctx = s.Context(ctx)
+	ctx, sp := trace.StartSpan(ctx, "Store."+args.Method().String())
+	defer sp.Finish()
+	start := time.Now()
+	defer func() { s.latencies.Record(args.Method(), time.Since(start)) }()
 	// If the request has a zero timestamp, initialize to this node's clock.
 	header := args.Header()
 	if err := verifyKeys(header.Key, header.EndKey); err != nil {
@@ -946,12 +1222,23 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 		}
 	}
 
+	if proto.IsTransactionWrite(args) {
+		if err := s.admitWrite(ctx, args); err != nil {
+			reply.Header().SetGoError(err)
+			return err
+		}
+	}
+
 	// Backoff and retry loop for handling errors.
 	retryOpts := s.ctx.RangeRetryOptions
 	retryOpts.Tag = fmt.Sprintf("store: %s", args.Method())
 	err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
 		// Add the command to the range for execution; exit retry loop on success.
 		reply.Reset()
+		if err := ctx.Err(); err != nil {
+			reply.Header().SetGoError(err)
+			return util.RetryBreak, err
+		}
 
 		// Get range and add command to the range for execution.
 		rng, err := s.GetRange(header.RaftID)
@@ -960,7 +1247,14 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 			return util.RetryBreak, err
 		}
 
-		if err = rng.AddCmd(args, reply, true); err == nil {
+		// Hold back writes to a range grown too large while waiting to
+		// be split.
+		if err := s.maybeBackpressure(ctx, rng, args); err != nil {
+			reply.Header().SetGoError(err)
+			return util.RetryBreak, err
+		}
+
+		if err = rng.AddCmd(ctx, args, reply, true); err == nil {
 			return util.RetryBreak, nil
 		}
 
@@ -968,7 +1262,8 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 		// because this is the code path with the requesting client
 		// waiting. We don't want every replica to attempt to resolve the
 		// intent independently, so we can't do it in Range.executeCmd.
-		err = s.maybeResolveWriteIntentError(rng, args, reply)
+		err = s.maybeResolveWriteIntentError(ctx, rng, args, reply)
+		sp.Eventf("command failed: %s", err)
 
 		switch t := err.(type) {
 		case *proto.WriteTooOldError:
@@ -1002,6 +1297,16 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 	return reply.Header().GoError()
 }
 
+// Context returns a context derived from ctx, which may be nil, and
+// tagged with the IDs of the store and its node for logging.
+func (s *Store) Context(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = context.WithValue(ctx, log.NodeID, s.Ident.NodeID)
+	return context.WithValue(ctx, log.StoreID, s.Ident.StoreID)
+}
+
 // maybeResolveWriteIntentError checks the reply's error. If the error
 // is a writeIntentError, it tries to push the conflicting
 // transaction: either move its timestamp forward on a read/write
@@ -1010,7 +1315,7 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 // error's Resolved flag to true so the client retries the command
 // immediately. If the push fails, we set the error's Resolved flag to
 // false so that the client backs off before reissuing the command.
-func (s *Store) maybeResolveWriteIntentError(rng *Range, args proto.Request, reply proto.Response) error {
+func (s *Store) maybeResolveWriteIntentError(ctx context.Context, rng *Range, args proto.Request, reply proto.Response) error {
 	err := reply.Header().GoError()
 	wiErr, ok := err.(*proto.WriteIntentError)
 	if !ok {
@@ -1027,12 +1332,13 @@ func (s *Store) maybeResolveWriteIntentError(rng *Range, args proto.Request, rep
 			User:         args.Header().User,
 			UserPriority: args.Header().UserPriority,
 			Txn:          args.Header().Txn,
+			Priority:     args.Header().Priority,
 		},
 		PusheeTxn: wiErr.Txn,
 		Abort:     proto.IsWrite(args), // abort if cmd is read/write
 	}
 	pushReply := &proto.InternalPushTxnResponse{}
-	s.ctx.DB.Run(client.Call{Args: pushArgs, Reply: pushReply})
+	s.ctx.DB.RunWithContext(ctx, client.Call{Args: pushArgs, Reply: pushReply})
 	if pushErr := pushReply.GoError(); pushErr != nil {
 		log.V(1).Infof("push %q failed: %s", pushArgs.Header().Key, pushErr)
 
@@ -1061,12 +1367,13 @@ func (s *Store) maybeResolveWriteIntentError(rng *Range, args proto.Request, rep
 			Key:       wiErr.Key,
 			User:      UserRoot,
 			Txn:       pushReply.PusheeTxn,
+			Priority:  args.Header().Priority,
 		},
 	}
 	resolveReply := &proto.InternalResolveIntentResponse{}
 	// Add resolve command with wait=false to add to Raft but not wait for completion.
-	if resolveErr := rng.AddCmd(resolveArgs, resolveReply, false); resolveErr != nil {
-		log.Warningf("resolve of key %q failed: %s", wiErr.Key, resolveErr)
+	if resolveErr := rng.AddCmd(context.Background(), resolveArgs, resolveReply, false); resolveErr != nil {
+		log.Warningc(ctx, "resolve of key %q failed: %s", wiErr.Key, resolveErr)
 	}
 
 	return wiErr
@@ -1112,9 +1419,28 @@ func (s *Store) ProposeRaftCommand(idKey cmdIDKey, cmd proto.InternalRaftCommand
 			MakeRaftNodeID(crt.NodeID, crt.StoreID),
 			data)
 	}
+	// Commands below normal priority yield to the others waiting to be
+	// proposed.
+	if value.(proto.Request).Header().Priority.Less(proto.NORMAL) {
+		return s.multiraft.SubmitBackgroundCommand(uint64(cmd.RaftID), string(idKey), data)
+	}
 	return s.multiraft.SubmitCommand(uint64(cmd.RaftID), string(idKey), data)
 }
 
+// TransferRaftLeadership hands the Raft leadership of the given range
+// over to the replica with the given Raft node ID. This store must hold
+// the current leader.
+func (s *Store) TransferRaftLeadership(raftID int64, nodeID multiraft.NodeID) error {
+	return s.multiraft.TransferLeadership(uint64(raftID), nodeID)
+}
+
+// LearnerCaughtUp returns whether the learner replica of the given range
+// with the given Raft node ID has caught up with the range's Raft log.
+// This store must hold the range's Raft leader.
+func (s *Store) LearnerCaughtUp(raftID int64, nodeID multiraft.NodeID) (bool, error) {
+	return s.multiraft.LearnerCaughtUp(uint64(raftID), nodeID)
+}
+
 // processRaft processes read/write commands that have been committed
 // by the raft consensus algorithm, dispatching them to the
 // appropriate range. This method starts a goroutine to process Raft
@@ -1136,80 +1462,23 @@ func (s *Store) ProposeRaftCommand(idKey cmdIDKey, cmd proto.InternalRaftCommand
 //   the overlapping writes are applied.
 func (s *Store) processRaft() {
 	s.stopper.RunWorker(func() {
+		ab := newApplyBatch(s.engine, s.ctx.SyncApply)
 		for {
 			select {
 			case e := <-s.multiraft.Events:
-				var cmd proto.InternalRaftCommand
-				var groupID int64
-				var commandID string
-				var index uint64
-				var callback func(error)
-
-				switch e := e.(type) {
-				case *multiraft.EventCommandCommitted:
-					groupID = int64(e.GroupID)
-					commandID = e.CommandID
-					index = e.Index
-					err := gogoproto.Unmarshal(e.Command, &cmd)
-					if err != nil {
-						log.Fatal(err)
+				s.processRaftEvent(ab, e)
+				// Apply the commands of all events which are ready (typically
+				// those of one Raft ready cycle) in the same write batch.
+			drain:
+				for !ab.full() {
+					select {
+					case e := <-s.multiraft.Events:
+						s.processRaftEvent(ab, e)
+					default:
+						break drain
 					}
-
-				case *multiraft.EventMembershipChangeCommitted:
-					groupID = int64(e.GroupID)
-					commandID = e.CommandID
-					index = e.Index
-					callback = e.Callback
-					err := gogoproto.Unmarshal(e.Payload, &cmd)
-					if err != nil {
-						log.Fatal(err)
-					}
-
-				case *multiraft.EventLeaderElection:
-					if e.NodeID == 0 {
-						// Election in progress.
-						continue
-					}
-					// Only the store housing the election winner gets to
-					// proceed.
-					groupID = int64(e.GroupID)
-					r, err := s.GetRange(groupID)
-					if err != nil {
-						log.Warning(err)
-						continue
-					}
-					// TODO(tschottdorf): remove this once we have the whole
-					// range lazily start up and the response cache moved to
-					// the correct location to deduplicate multiraft
-					// reproposals (at the time of writing commands can be
-					// executed multiple times if issued during an election).
-					r.election <- struct{}{}
-
-					// Done with this event, go back to listening on the channel.
-					continue
-
-				default:
-					continue
-				}
-
-				if groupID != cmd.RaftID {
-					log.Fatalf("e.GroupID (%d) should == cmd.RaftID (%d)", groupID, cmd.RaftID)
-				}
-
-				s.mu.RLock()
-				r, ok := s.ranges[groupID]
-				s.mu.RUnlock()
-				var err error
-				if !ok {
-					err = util.Errorf("got committed raft command for %d but have no range with that ID: %+v",
-						groupID, cmd)
-					log.Error(err)
-				} else {
-					err = r.processRaftCommand(cmdIDKey(commandID), index, cmd)
-				}
-				if callback != nil {
-					callback(err)
 				}
+				s.commitApplyBatch(ab)
 
 			case <-s.stopper.ShouldStop():
 				return
@@ -1218,6 +1487,109 @@ func (s *Store) processRaft() {
 	})
 }
 
+// processRaftEvent handles a single event from multiraft. The writes
+// of committed commands are added to the supplied applyBatch.
+func (s *Store) processRaftEvent(ab *applyBatch, e interface{}) {
+	var cmd proto.InternalRaftCommand
+	var groupID int64
+	var commandID string
+	var index uint64
+	var callback func(error)
+
+	switch e := e.(type) {
+	case *multiraft.EventCommandCommitted:
+		groupID = int64(e.GroupID)
+		commandID = e.CommandID
+		index = e.Index
+		err := gogoproto.Unmarshal(e.Command, &cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+	case *multiraft.EventMembershipChangeCommitted:
+		groupID = int64(e.GroupID)
+		commandID = e.CommandID
+		index = e.Index
+		callback = e.Callback
+		err := gogoproto.Unmarshal(e.Payload, &cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+	case *multiraft.EventLeaderElection:
+		if e.NodeID == 0 {
+			// Election in progress.
+			return
+		}
+		// The new leader must not serve reads before all previously
+		// committed commands are visible in the engine.
+		s.commitApplyBatch(ab)
+		// Only the store housing the election winner gets to
+		// proceed.
+		groupID = int64(e.GroupID)
+		r, err := s.GetRange(groupID)
+		if err != nil {
+			log.Warning(err)
+			return
+		}
+		// With node liveness, the winner acquires an epoch-based leader
+		// lease which its node's heartbeats keep valid, so the lease is
+		// requested once per election instead of being renewed.
+		if e.NodeID == s.RaftNodeID() && s.ctx.NodeLiveness != nil {
+			go r.requestLeaderLease(e.Term)
+		}
+		// TODO(tschottdorf): remove this once we have the whole
+		// range lazily start up and the response cache moved to
+		// the correct location to deduplicate multiraft
+		// reproposals (at the time of writing commands can be
+		// executed multiple times if issued during an election).
+		r.election <- struct{}{}
+
+		// Done with this event.
+		return
+
+	default:
+		return
+	}
+
+	if groupID != cmd.RaftID {
+		log.Fatalf("e.GroupID (%d) should == cmd.RaftID (%d)", groupID, cmd.RaftID)
+	}
+
+	isolated := requiresIsolatedApply(cmd.Cmd.GetValue().(proto.Request))
+	if isolated {
+		s.commitApplyBatch(ab)
+	}
+
+	s.mu.RLock()
+	r, ok := s.ranges[groupID]
+	s.mu.RUnlock()
+	var err error
+	if !ok {
+		err = util.Errorf("got committed raft command for %d but have no range with that ID: %+v",
+			groupID, cmd)
+		log.Error(err)
+	} else {
+		err = r.processRaftCommand(ab, cmdIDKey(commandID), index, cmd)
+	}
+	if callback != nil {
+		ab.deferUntilCommit(func() { callback(err) })
+	}
+
+	if isolated {
+		s.commitApplyBatch(ab)
+	}
+}
+
+// commitApplyBatch commits the writes of the Raft commands applied
+// to the batch. A failure leaves the ranges' in-memory state ahead of
+// the engine, so it is fatal.
+func (s *Store) commitApplyBatch(ab *applyBatch) {
+	if err := ab.commit(); err != nil {
+		log.Fatalf("%s: unable to commit applied raft commands: %s", s, err)
+	}
+}
+
 // GroupStorage implements the multiraft.Storage interface.
 func (s *Store) GroupStorage(groupID uint64) multiraft.WriteableGroupStorage {
 	s.mu.Lock()
@@ -1274,24 +1646,126 @@ func (s *Store) WaitForRangeScanCompletion() int64 {
 	return s.scanner.WaitForScanCompletion()
 }
 
+// baseQueues returns the queues managed by the store's range scanner.
+func (s *Store) baseQueues() []*baseQueue {
+	return []*baseQueue{s.gcQueue.baseQueue, s.splitQueue.baseQueue, s.verifyQueue.baseQueue,
+		s.replicateQueue.baseQueue, s.consistencyQueue.baseQueue, s.statsQueue.baseQueue}
+}
+
+// startStatusUpdater launches a goroutine which writes the store's
+// status after changes to the store's stats, at most once per status
+// interval. The first write follows the store's start by at least
+// one interval.
+func (s *Store) startStatusUpdater() {
+	s.stopper.RunWorker(func() {
+		last := time.Now()
+		for {
+			select {
+			case <-s.stats.Changed():
+			case <-s.stopper.ShouldStop():
+				return
+			}
+			select {
+			case <-time.After(last.Add(s.ctx.StatusInterval).Sub(time.Now())):
+			case <-s.stopper.ShouldStop():
+				return
+			}
+			if !s.stopper.StartTask() {
+				continue
+			}
+			s.updateStoreStatus()
+			s.stopper.FinishTask()
+			last = time.Now()
+		}
+	})
+}
+
+// reconcileStoreStatus corrects any drift of the store's stats from
+// the sum of the stats of its ranges, and writes the store's status.
+// It is called by the range scanner at the end of each scan.
+func (s *Store) reconcileStoreStatus() {
+	s.mu.RLock()
+	ranges := make([]*rangeStats, 0, len(s.ranges))
+	for _, rng := range s.ranges {
+		ranges = append(ranges, rng.stats)
+	}
+	s.mu.RUnlock()
+	drift := s.stats.reconcile(ranges)
+	if !drift.isZero() {
+		log.Warningf("store %d: corrected drift of store stats: %+v", s.Ident.StoreID, drift)
+	}
+	s.updateStoreStatus()
+}
+
 // updateStoreStatus updates the store's status proto.
 func (s *Store) updateStoreStatus() {
 	now := s.ctx.Clock.Now().WallTime
-	scannerStats := s.scanner.Stats()
+	stats := s.stats.Stats()
 	status := &proto.StoreStatus{
-		StoreID:    s.Ident.StoreID,
-		NodeID:     s.Ident.NodeID,
-		UpdatedAt:  now,
-		StartedAt:  s.startedAt,
-		RangeCount: int32(scannerStats.RangeCount),
-		Stats:      proto.MVCCStats(scannerStats.MVCC),
-	}
-	key := engine.StoreStatusKey(int32(s.Ident.StoreID))
-	if err := s.ctx.DB.Run(client.PutProtoCall(key, status)); err != nil {
+		StoreID:          s.Ident.StoreID,
+		NodeID:           s.Ident.NodeID,
+		UpdatedAt:        now,
+		StartedAt:        s.startedAt,
+		RangeCount:       int32(stats.RangeCount),
+		Stats:            stats.MVCC,
+		RequestLatencies: s.latencies.Summaries(),
+	}
+	s.mu.RLock()
+	for raftID := range s.corruptRanges {
+		status.CorruptRaftIDs = append(status.CorruptRaftIDs, raftID)
+	}
+	rngs := make([]*Range, 0, len(s.ranges))
+	for _, rng := range s.ranges {
+		rngs = append(rngs, rng)
+	}
+	s.mu.RUnlock()
+	for _, rng := range rngs {
+		raftID := rng.Desc().RaftID
+		if groupStatus, err := s.multiraft.GroupStatus(uint64(raftID)); err == nil &&
+			groupStatus.RaftState == raft.StateLeader {
+			status.LeaderRangeCount++
+		}
+		size, err := s.raftLogSize(raftID)
+		if err != nil {
+			log.Warningf("unable to compute the raft log size of range %d: %s", raftID, err)
+			continue
+		}
+		status.RaftLogSize += size
+	}
+	if capacity, err := s.Capacity(); err != nil {
+		log.Warningf("unable to get capacity of store %d: %s", s.Ident.StoreID, err)
+	} else {
+		status.Capacity, status.Available = capacity.Capacity, capacity.Available
+	}
+	if engineStats, err := s.engine.GetStats(); err != nil {
+		log.Warningf("unable to get engine stats for store %d: %s", s.Ident.StoreID, err)
+	} else {
+		status.EngineStats = engineStats
+	}
+	key := keys.StoreStatusKey(int32(s.Ident.StoreID))
+	// The status is written by a background job.
+	call := client.PutProtoCall(key, status)
+	if call.Args != nil {
+		call.Args.Header().Priority = proto.BACKGROUND
+	}
+	if err := s.ctx.DB.Run(call); err != nil {
 		log.Error(err)
 	}
 }
 
+// raftLogSize returns the size in bytes of the keys and values of the
+// entries of the range's Raft log.
+func (s *Store) raftLogSize(raftID int64) (int64, error) {
+	var size int64
+	logKey := keys.RaftLogPrefix(raftID)
+	err := s.raftEngine.Iterate(engine.MVCCEncodeKey(logKey), engine.MVCCEncodeKey(logKey.PrefixEnd()),
+		func(kv proto.RawKeyValue) (bool, error) {
+			size += int64(len(kv.Key) + len(kv.Value))
+			return false, nil
+		})
+	return size, err
+}
+
 // SetRangeRetryOptions sets the retry options used for this store.
 func (s *Store) SetRangeRetryOptions(ro util.RetryOptions) {
 	s.ctx.RangeRetryOptions = ro
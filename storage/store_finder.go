@@ -18,26 +18,26 @@
 package storage
 
 import (
-	"fmt"
 	"sync"
 
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
 )
 
 // FindStoreFunc finds the disks in a datacenter that have the requested
 // attributes.
 type FindStoreFunc func(proto.Attributes) ([]*StoreDescriptor, error)
 
-type stringSet map[string]struct{}
-
 // StoreFinder provides the data necessary to find stores with particular
-// attributes.
+// attributes. It caches the store descriptors gossiped by all nodes,
+// which are updated by gossip callbacks as the stores' capacities
+// change and removed once their gossip expires.
 type StoreFinder struct {
-	finderMu     sync.Mutex
-	cond         *sync.Cond
-	capacityKeys stringSet // Tracks gosisp keys used for capacity
-	gossip       *gossip.Gossip
+	finderMu   sync.Mutex
+	cond       *sync.Cond
+	capacities map[string][]StoreDescriptor // Descriptors of a node's stores by capacity gossip key
+	gossip     *gossip.Gossip
 }
 
 // newStoreFinder creates a StoreFinder.
@@ -48,68 +48,61 @@ func newStoreFinder(g *gossip.Gossip) *StoreFinder {
 }
 
 // capacityGossipUpdate is a gossip callback triggered whenever capacity
-// information is gossiped. It just tracks keys used for capacity gossip.
-func (sf *StoreFinder) capacityGossipUpdate(key string, contentsChanged bool) {
+// gossip for a node's stores changes.
+func (sf *StoreFinder) capacityGossipUpdate(key string, val interface{}) {
+	storeDescs, ok := val.([]StoreDescriptor)
+	if !ok {
+		log.Errorf("gossiped info is not a slice of StoreDescriptors: %+v", val)
+		return
+	}
 	sf.finderMu.Lock()
 	defer sf.finderMu.Unlock()
 
-	if sf.capacityKeys == nil {
-		sf.capacityKeys = stringSet{}
+	if sf.capacities == nil {
+		sf.capacities = map[string][]StoreDescriptor{}
 	}
-	sf.capacityKeys[key] = struct{}{}
+	sf.capacities[key] = storeDescs
 	sf.cond.Broadcast()
 }
 
+// capacityGossipExpired is a gossip expiry callback triggered whenever
+// capacity gossip for a node's stores expires or is deleted, as
+// happens when the node dies. The stores are no longer considered for
+// allocation.
+func (sf *StoreFinder) capacityGossipExpired(key string) {
+	sf.finderMu.Lock()
+	defer sf.finderMu.Unlock()
+	delete(sf.capacities, key)
+}
+
 // WaitForNodes blocks until at least the given number of nodes are present in the
 // capacity map. Used for tests.
 func (sf *StoreFinder) WaitForNodes(n int) {
 	sf.finderMu.Lock()
 	defer sf.finderMu.Unlock()
 
-	for len(sf.capacityKeys) < n {
+	for len(sf.capacities) < n {
 		sf.cond.Wait()
 	}
 }
 
 // findStores is the Store's implementation of a StoreFinder. It returns a list
-// of stores with attributes that are a superset of the required attributes. It
-// never returns an error.
-//
-// If it cannot retrieve a StoreDescriptor from the Store's gossip, it garbage
-// collects the failed key.
+// of stores with combined node and store attributes that are a superset of the
+// required attributes. It never returns an error.
 //
 // TODO(embark, spencer): consider using a reverse index map from Attr->stores,
-// for efficiency.  Ensure that entries in this map still have an opportunity
-// to be garbage collected.
+// for efficiency.
 func (sf *StoreFinder) findStores(required proto.Attributes) ([]*StoreDescriptor, error) {
 	sf.finderMu.Lock()
 	defer sf.finderMu.Unlock()
 	var stores []*StoreDescriptor
-	for key := range sf.capacityKeys {
-		storeDesc, err := storeDescFromGossip(key, sf.gossip)
-		if err != nil {
-			// We can no longer retrieve this key from the gossip store,
-			// perhaps it expired.
-			delete(sf.capacityKeys, key)
-		} else if required.IsSubset(storeDesc.Attrs) {
-			stores = append(stores, storeDesc)
+	for _, storeDescs := range sf.capacities {
+		for _, storeDesc := range storeDescs {
+			if required.IsSubset(*storeDesc.CombinedAttrs()) {
+				storeDesc := storeDesc
+				stores = append(stores, &storeDesc)
+			}
 		}
 	}
 	return stores, nil
 }
-
-// storeDescFromGossip retrieves a StoreDescriptor from the specified capacity
-// gossip key. Returns an error if the gossip doesn't exist or is not
-// a StoreDescriptor.
-func storeDescFromGossip(key string, g *gossip.Gossip) (*StoreDescriptor, error) {
-	info, err := g.GetInfo(key)
-
-	if err != nil {
-		return nil, err
-	}
-	storeDesc, ok := info.(StoreDescriptor)
-	if !ok {
-		return nil, fmt.Errorf("gossiped info is not a StoreDescriptor: %+v", info)
-	}
-	return &storeDesc, nil
-}
@@ -23,7 +23,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -32,17 +34,37 @@ func TestCapacityGossipUpdate(t *testing.T) {
 	sf := newStoreFinder(nil)
 	key := "testkey"
 
-	// Order and value of contentsChanged shouldn't matter.
-	sf.capacityGossipUpdate(key, true)
-	sf.capacityGossipUpdate(key, false)
+	// The most recent descriptors are kept; other values are ignored.
+	sf.capacityGossipUpdate(key, []StoreDescriptor{{StoreID: 1}})
+	sf.capacityGossipUpdate(key, []StoreDescriptor{{StoreID: 2}})
+	sf.capacityGossipUpdate("other", "not a store descriptor")
 
-	expectedKeys := stringSet{key: struct{}{}}
+	expected := map[string][]StoreDescriptor{key: {{StoreID: 2}}}
 	sf.finderMu.Lock()
-	actualKeys := sf.capacityKeys
+	actual := sf.capacities
 	sf.finderMu.Unlock()
 
-	if !reflect.DeepEqual(expectedKeys, actualKeys) {
-		t.Errorf("expected to fetch %+v, instead %+v", expectedKeys, actualKeys)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected to fetch %+v, instead %+v", expected, actual)
+	}
+}
+
+func TestCapacityGossipExpired(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	sf := newStoreFinder(nil)
+	sf.capacityGossipUpdate("k1", []StoreDescriptor{{StoreID: 1}})
+	sf.capacityGossipUpdate("k2", []StoreDescriptor{{StoreID: 2}})
+	sf.capacityGossipExpired("k1")
+	// Expiry of an unknown key is a noop.
+	sf.capacityGossipExpired("k3")
+
+	expected := map[string][]StoreDescriptor{"k2": {{StoreID: 2}}}
+	sf.finderMu.Lock()
+	actual := sf.capacities
+	sf.finderMu.Unlock()
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected to fetch %+v, instead %+v", expected, actual)
 	}
 }
 
@@ -66,21 +88,20 @@ func TestStoreFinder(t *testing.T) {
 		Attrs: proto.Attributes{Attrs: []string{"ssd", "otherdc"}},
 	}
 	emptyStore := StoreDescriptor{Attrs: proto.Attributes{}}
+	// Node attributes count towards the required attributes too.
+	nodeAttrsStore := StoreDescriptor{
+		Attrs: proto.Attributes{Attrs: []string{"ssd"}},
+		Node:  gossip.NodeDescriptor{Attrs: proto.Attributes{Attrs: []string{"dc"}}},
+	}
 
-	// Explicitly add keys rather than registering a gossip callback to avoid
+	// Explicitly invoke the callback rather than gossiping to avoid
 	// waiting for the goroutine callback to finish.
-	s.capacityKeys = stringSet{
-		"k1": struct{}{},
-		"k2": struct{}{},
-		"k3": struct{}{},
-		"k4": struct{}{},
-	}
-	s.gossip.AddInfo("k1", matchingStore, time.Hour)
-	s.gossip.AddInfo("k2", supersetStore, time.Hour)
-	s.gossip.AddInfo("k3", unmatchingStore, time.Hour)
-	s.gossip.AddInfo("k4", emptyStore, time.Hour)
+	s.capacityGossipUpdate("k1", []StoreDescriptor{matchingStore, supersetStore})
+	s.capacityGossipUpdate("k2", []StoreDescriptor{unmatchingStore})
+	s.capacityGossipUpdate("k3", []StoreDescriptor{emptyStore, nodeAttrsStore})
 
-	expected := []string{matchingStore.Attrs.SortedString(), supersetStore.Attrs.SortedString()}
+	expected := []string{matchingStore.Attrs.SortedString(), supersetStore.Attrs.SortedString(),
+		nodeAttrsStore.Attrs.SortedString()}
 	stores, err := s.findStores(proto.Attributes{Attrs: required})
 	if err != nil {
 		t.Errorf("expected no err, got %s", err)
@@ -96,29 +117,31 @@ func TestStoreFinder(t *testing.T) {
 	}
 }
 
-// TestStoreFinderGarbageCollection ensures removal of capacity gossip keys in
-// the map, if their gossip does not exist when we try to retrieve them.
-func TestStoreFinderGarbageCollection(t *testing.T) {
+// TestStoreFinderGossipExpiry ensures that stores are found once their
+// capacity is gossiped and no longer found once the gossip is deleted.
+func TestStoreFinderGossipExpiry(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, _, stopper := createTestStore(t)
 	defer stopper.Stop()
 
-	s.capacityKeys = stringSet{
-		"key0": struct{}{},
-		"key1": struct{}{},
-	}
-	required := []string{}
-
-	// No gossip added for either key, so they should be removed.
-	stores, err := s.findStores(proto.Attributes{Attrs: required})
-	if err != nil {
-		t.Errorf("unexpected error retrieving stores %s", err)
-	} else if len(stores) != 0 {
-		t.Errorf("expected no stores found, instead %+v", stores)
+	key := gossip.MakeMaxAvailCapacityKey(2)
+	if err := s.ctx.Gossip.AddInfo(key, []StoreDescriptor{{StoreID: 2}}, time.Hour); err != nil {
+		t.Fatal(err)
 	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if stores, _ := s.findStores(proto.Attributes{}); len(stores) != 1 {
+			return util.Errorf("expected one store found, instead %+v", stores)
+		}
+		return nil
+	})
 
-	if len(s.capacityKeys) != 0 {
-		t.Errorf("expected keys to be cleared, instead are %+v",
-			s.capacityKeys)
+	if err := s.ctx.Gossip.DeleteInfo(key); err != nil {
+		t.Fatal(err)
 	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if stores, _ := s.findStores(proto.Attributes{}); len(stores) != 0 {
+			return util.Errorf("expected no stores found, instead %+v", stores)
+		}
+		return nil
+	})
 }
@@ -23,6 +23,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"reflect"
 	"sort"
 	"testing"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"code.google.com/p/go-uuid/uuid"
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc"
@@ -40,6 +42,7 @@ import (
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
 )
 
 var testIdent = proto.StoreIdent{
@@ -71,7 +74,7 @@ type testSender struct {
 // tests in this package. Batches and transactions are not
 // supported. Since kv/ depends on storage/, we can't get access to a
 // coordinator sender from here.
-func (db *testSender) Send(call client.Call) {
+func (db *testSender) Send(ctx context.Context, call client.Call) {
 	switch call.Args.(type) {
 	case *proto.EndTransactionRequest, *proto.BatchRequest:
 		call.Reply.Header().SetGoError(util.Errorf("%s method not supported", call.Method()))
@@ -82,7 +85,7 @@ func (db *testSender) Send(call client.Call) {
 	if rng := db.store.LookupRange(header.Key, header.EndKey); rng != nil {
 		header.RaftID = rng.Desc().RaftID
 		header.Replica = *rng.GetReplica()
-		db.store.ExecuteCmd(call.Args, call.Reply)
+		db.store.ExecuteCmd(ctx, call.Args, call.Reply)
 	} else {
 		call.Reply.Header().SetGoError(proto.NewRangeKeyMismatchError(header.Key, header.EndKey, nil))
 	}
@@ -160,6 +163,32 @@ func TestStoreInitAndBootstrap(t *testing.T) {
 	}
 }
 
+// TestStoreGossipBootstrapInfo verifies that gossip bootstrap info
+// written to a store can be read back and is empty if never written.
+func TestStoreGossipBootstrapInfo(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	var info proto.GossipBootstrapInfo
+	if err := store.ReadBootstrapInfo(&info); err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Addresses) != 0 {
+		t.Errorf("expected empty bootstrap info; got %+v", info)
+	}
+	expAddrs := []string{"tcp=127.0.0.1:9001", "tcp=127.0.0.1:9002"}
+	if err := store.WriteBootstrapInfo(&proto.GossipBootstrapInfo{Addresses: expAddrs}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ReadBootstrapInfo(&info); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(info.Addresses, expAddrs) {
+		t.Errorf("expected bootstrap addresses %s; got %s", expAddrs, info.Addresses)
+	}
+}
+
 // TestBootstrapOfNonEmptyStore verifies bootstrap failure if engine
 // is not empty.
 func TestBootstrapOfNonEmptyStore(t *testing.T) {
@@ -190,6 +219,59 @@ func TestBootstrapOfNonEmptyStore(t *testing.T) {
 	}
 }
 
+// TestStoreHighWater verifies that a restarted store doesn't start
+// until its clock passes the persisted high water plus the maximum
+// clock offset, and that the high water is then persisted anew.
+func TestStoreHighWater(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := TestStoreContext
+	ctx.HighWaterInterval = time.Hour
+	manual := hlc.NewManualClock(0)
+	ctx.Clock = hlc.NewClock(manual.UnixNano)
+	ctx.Clock.SetMaxOffset(100 * time.Millisecond)
+	eng := engine.NewInMem(proto.Attributes{}, 1<<20)
+	ctx.Transport = multiraft.NewLocalRPCTransport()
+	stopper := util.NewStopper()
+	stopper.AddCloser(ctx.Transport)
+	defer stopper.Stop()
+	store := NewStore(ctx, eng)
+	if err := store.Bootstrap(testIdent, stopper); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.BootstrapRange(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a restart with a clock behind the previous high water.
+	highWater := proto.Timestamp{WallTime: time.Minute.Nanoseconds()}
+	if err := engine.MVCCPutProto(eng, nil, keys.StoreHighWaterKey(), proto.ZeroTimestamp, nil, &highWater); err != nil {
+		t.Fatal(err)
+	}
+	manual.Set(highWater.WallTime)
+	started := make(chan error, 1)
+	go func() {
+		started <- store.Start(stopper)
+	}()
+	select {
+	case err := <-started:
+		t.Fatalf("expected store to wait for the high water; got %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// Once the clock passes the high water plus the max offset, the store starts.
+	manual.Set(highWater.WallTime + ctx.Clock.MaxOffset().Nanoseconds() + 1)
+	if err := <-started; err != nil {
+		t.Fatal(err)
+	}
+	var persisted proto.Timestamp
+	if _, err := engine.MVCCGetProto(eng, keys.StoreHighWaterKey(), proto.ZeroTimestamp, true, nil, &persisted); err != nil {
+		t.Fatal(err)
+	}
+	if expected := manual.UnixNano() + 2*ctx.HighWaterInterval.Nanoseconds(); persisted.WallTime != expected {
+		t.Errorf("expected high water of %d; got %d", expected, persisted.WallTime)
+	}
+}
+
 func TestRangeSliceSort(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	var rs RangeSlice
@@ -372,13 +454,68 @@ func TestStoreExecuteCmd(t *testing.T) {
 	gArgs, gReply := getArgs([]byte("a"), 1, store.StoreID())
 
 	// Try a successful get request.
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil {
+		t.Fatal(err)
+	}
+	pArgs, pReply := putArgs([]byte("a"), []byte("aaa"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestStoreSeparateRaftEngine verifies that a store configured with a
+// separate Raft log engine writes its ranges' Raft logs there and
+// keeps them out of its data engine.
+func TestStoreSeparateRaftEngine(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+	ctx := TestStoreContext
+	ctx.Clock = hlc.NewClock(hlc.NewManualClock(0).UnixNano)
+	ctx.Transport = multiraft.NewLocalRPCTransport()
+	stopper.AddCloser(ctx.Transport)
+	eng := engine.NewInMem(proto.Attributes{}, 10<<20)
+	raftEng := engine.NewInMem(proto.Attributes{}, 10<<20)
+	store := NewStoreWithRaftEngine(ctx, eng, raftEng)
+	if err := store.Bootstrap(proto.StoreIdent{NodeID: 1, StoreID: 1}, stopper); err != nil {
+		t.Fatal(err)
+	}
+	store.ctx.DB = client.NewKV(nil, &testSender{store: store})
+	if err := store.BootstrapRange(); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Start(stopper); err != nil {
 		t.Fatal(err)
 	}
+
 	pArgs, pReply := putArgs([]byte("a"), []byte("aaa"), 1, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
+
+	logKey := keys.RaftLogPrefix(1)
+	for _, test := range []struct {
+		eng    engine.Engine
+		expLog bool
+	}{
+		{eng, false},
+		{raftEng, true},
+	} {
+		kvs, err := engine.MVCCScan(test.eng, logKey, logKey.PrefixEnd(), 0, proto.ZeroTimestamp, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hasLog := len(kvs) > 0; hasLog != test.expLog {
+			t.Errorf("expected raft log in engine %s: %t; got %d entries", test.eng, test.expLog, len(kvs))
+		}
+	}
+	// The data itself is only written to the data engine.
+	if v, err := engine.MVCCGet(raftEng, proto.Key("a"), store.Clock().Now(), true, nil); err != nil || v != nil {
+		t.Errorf("expected no data in raft log engine; got %+v, %v", v, err)
+	}
+	if v, err := engine.MVCCGet(eng, proto.Key("a"), store.Clock().Now(), true, nil); err != nil || v == nil {
+		t.Errorf("expected data in data engine; got %+v, %v", v, err)
+	}
 }
 
 // TestStoreVerifyKeys checks that key length is enforced and
@@ -387,50 +524,50 @@ func TestStoreVerifyKeys(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	store, _, stopper := createTestStore(t)
 	defer stopper.Stop()
-	tooLongKey := engine.MakeKey(engine.KeyMax, []byte{0})
+	tooLongKey := keys.MakeKey(keys.KeyMax, []byte{0})
 
 	// Start with a too-long key on a get.
 	gArgs, gReply := getArgs(tooLongKey, 1, store.StoreID())
-	if err := store.ExecuteCmd(gArgs, gReply); err == nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err == nil {
 		t.Fatal("expected error for key too long")
 	}
 	// Try a start key == KeyMax.
-	gArgs.Key = engine.KeyMax
-	if err := store.ExecuteCmd(gArgs, gReply); err == nil {
+	gArgs.Key = keys.KeyMax
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err == nil {
 		t.Fatal("expected error for start key == KeyMax")
 	}
 	// Try a scan with too-long EndKey.
-	sArgs, sReply := scanArgs(engine.KeyMin, tooLongKey, 1, store.StoreID())
-	if err := store.ExecuteCmd(sArgs, sReply); err == nil {
+	sArgs, sReply := scanArgs(keys.KeyMin, tooLongKey, 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), sArgs, sReply); err == nil {
 		t.Fatal("expected error for end key too long")
 	}
 	// Try a scan with end key < start key.
 	sArgs.Key = []byte("b")
 	sArgs.EndKey = []byte("a")
-	if err := store.ExecuteCmd(sArgs, sReply); err == nil {
+	if err := store.ExecuteCmd(context.Background(), sArgs, sReply); err == nil {
 		t.Fatal("expected error for end key < start")
 	}
 	// Try a put to meta2 key which would otherwise exceed maximum key
 	// length, but is accepted because of the meta prefix.
-	meta2KeyMax := engine.MakeKey(engine.KeyMeta2Prefix, engine.KeyMax)
+	meta2KeyMax := keys.MakeKey(keys.KeyMeta2Prefix, keys.KeyMax)
 	pArgs, pReply := putArgs(meta2KeyMax, []byte("value"), 1, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatalf("unexpected error on put to meta2 value: %s", err)
 	}
 	// Try to put a range descriptor record for a start key which is
 	// maximum length.
-	key := append([]byte{}, engine.KeyMax...)
+	key := append([]byte{}, keys.KeyMax...)
 	key[len(key)-1] = 0x01
-	pArgs, pReply = putArgs(engine.RangeDescriptorKey(key), []byte("value"), 1, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	pArgs, pReply = putArgs(keys.RangeDescriptorKey(key), []byte("value"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatalf("unexpected error on put to range descriptor for KeyMax value: %s", err)
 	}
 	// Try a put to txn record for a meta2 key (note that this doesn't
 	// actually happen in practice, as txn records are not put directly,
 	// but are instead manipulated only through txn methods).
-	pArgs, pReply = putArgs(engine.TransactionKey(meta2KeyMax, []byte(uuid.New())),
+	pArgs, pReply = putArgs(keys.TransactionKey(meta2KeyMax, []byte(uuid.New())),
 		[]byte("value"), 1, store.StoreID())
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatalf("unexpected error on put to txn meta2 value: %s", err)
 	}
 }
@@ -443,7 +580,7 @@ func TestStoreExecuteCmdUpdateTime(t *testing.T) {
 	args, reply := getArgs([]byte("a"), 1, store.StoreID())
 	args.Timestamp = store.ctx.Clock.Now()
 	args.Timestamp.WallTime += (100 * time.Millisecond).Nanoseconds()
-	err := store.ExecuteCmd(args, reply)
+	err := store.ExecuteCmd(context.Background(), args, reply)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -463,7 +600,7 @@ func TestStoreExecuteCmdWithZeroTime(t *testing.T) {
 
 	// Set clock to time 1.
 	mc.Set(1)
-	err := store.ExecuteCmd(args, reply)
+	err := store.ExecuteCmd(context.Background(), args, reply)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -492,7 +629,7 @@ func TestStoreExecuteCmdWithClockOffset(t *testing.T) {
 	// Set args timestamp to exceed max offset.
 	args.Timestamp = store.ctx.Clock.Now()
 	args.Timestamp.WallTime += maxOffset.Nanoseconds() + 1
-	err := store.ExecuteCmd(args, reply)
+	err := store.ExecuteCmd(context.Background(), args, reply)
 	if err == nil {
 		t.Error("expected max offset clock error")
 	}
@@ -504,7 +641,7 @@ func TestStoreExecuteCmdBadRange(t *testing.T) {
 	store, _, stopper := createTestStore(t)
 	defer stopper.Stop()
 	args, reply := getArgs([]byte("0"), 2, store.StoreID()) // no range ID 2
-	err := store.ExecuteCmd(args, reply)
+	err := store.ExecuteCmd(context.Background(), args, reply)
 	if err == nil {
 		t.Error("expected invalid range")
 	}
@@ -536,7 +673,7 @@ func TestStoreExecuteCmdOutOfRange(t *testing.T) {
 	store, _, stopper := createTestStore(t)
 	defer stopper.Stop()
 	// Split the range and then remove the second half to clear up some space.
-	rng := splitTestRange(store, engine.KeyMin, proto.Key("a"), t)
+	rng := splitTestRange(store, keys.KeyMin, proto.Key("a"), t)
 	// This shouldn't be necessary, but without it, the range sometimes
 	// gets removed before the election is finished, and then Raft panics.
 	// See #702.
@@ -547,12 +684,62 @@ func TestStoreExecuteCmdOutOfRange(t *testing.T) {
 	// Range is from KeyMin to "a", so reading "a" should fail because
 	// it's just outside the range boundary.
 	args, reply := getArgs([]byte("a"), 1, store.StoreID())
-	err := store.ExecuteCmd(args, reply)
+	err := store.ExecuteCmd(context.Background(), args, reply)
 	if err == nil {
 		t.Error("expected key to be out of range")
 	}
 }
 
+// TestStoreStatsIncremental verifies that the store's stats follow
+// writes to its ranges and the addition and removal of ranges without
+// a scan of the ranges.
+func TestStoreStatsIncremental(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	verify := func(rangeCount int) {
+		stats := store.stats.Stats()
+		if stats.RangeCount != rangeCount {
+			t.Errorf("expected %d ranges; got %d", rangeCount, stats.RangeCount)
+		}
+		store.mu.RLock()
+		var ranges []*rangeStats
+		for _, rng := range store.ranges {
+			ranges = append(ranges, rng.stats)
+		}
+		store.mu.RUnlock()
+		drift := store.stats.reconcile(ranges)
+		if !drift.isZero() {
+			t.Errorf("expected store stats to match those of its ranges; got drift %+v", drift)
+		}
+	}
+	verify(1)
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+	if keyCount := store.stats.Stats().MVCC.KeyCount; keyCount == 0 {
+		t.Errorf("expected write to be reflected in store stats")
+	}
+	verify(1)
+
+	rng := splitTestRange(store, keys.KeyMin, proto.Key("b"), t)
+	verify(2)
+	pArgs, pReply = putArgs([]byte("c"), []byte("value"), rng.Desc().RaftID, store.StoreID())
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+	verify(2)
+
+	rng.WaitForElection()
+	if err := store.RemoveRange(rng); err != nil {
+		t.Fatal(err)
+	}
+	verify(1)
+}
+
 // TestStoreRaftIDAllocation verifies that raft IDs are
 // allocated in successive blocks.
 func TestStoreRaftIDAllocation(t *testing.T) {
@@ -581,8 +768,8 @@ func TestStoreRangesByKey(t *testing.T) {
 	store, _, stopper := createTestStore(t)
 	defer stopper.Stop()
 
-	r0 := store.LookupRange(engine.KeyMin, engine.KeyMin)
-	r1 := splitTestRange(store, engine.KeyMin, proto.Key("A"), t)
+	r0 := store.LookupRange(keys.KeyMin, keys.KeyMin)
+	r1 := splitTestRange(store, keys.KeyMin, proto.Key("A"), t)
 	r2 := splitTestRange(store, proto.Key("A"), proto.Key("C"), t)
 	r3 := splitTestRange(store, proto.Key("C"), proto.Key("X"), t)
 	r4 := splitTestRange(store, proto.Key("X"), proto.Key("ZZ"), t)
@@ -611,8 +798,8 @@ func TestStoreRangesByKey(t *testing.T) {
 	if r := store.LookupRange(proto.Key("\xff\x00"), nil); r != r4 {
 		t.Errorf("mismatched range %+v != %+v", r.Desc(), r4.Desc())
 	}
-	if store.LookupRange(engine.KeyMax, nil) != nil {
-		t.Errorf("expected engine.KeyMax to not have an associated range")
+	if store.LookupRange(keys.KeyMax, nil) != nil {
+		t.Errorf("expected keys.KeyMax to not have an associated range")
 	}
 }
 
@@ -628,8 +815,8 @@ func TestStoreSetRangesMaxBytes(t *testing.T) {
 		rng         *Range
 		expMaxBytes int64
 	}{
-		{store.LookupRange(engine.KeyMin, engine.KeyMin), 64 << 20},
-		{splitTestRange(store, engine.KeyMin, proto.Key("a"), t), 1 << 20},
+		{store.LookupRange(keys.KeyMin, keys.KeyMin), 64 << 20},
+		{splitTestRange(store, keys.KeyMin, proto.Key("a"), t), 1 << 20},
 		{splitTestRange(store, proto.Key("a"), proto.Key("aa"), t), 1 << 20},
 		{splitTestRange(store, proto.Key("aa"), proto.Key("b"), t), 64 << 20},
 	}
@@ -644,10 +831,10 @@ func TestStoreSetRangesMaxBytes(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	key := engine.MakeKey(engine.KeyConfigZonePrefix, proto.Key("a"))
+	key := keys.MakeKey(keys.KeyConfigZonePrefix, proto.Key("a"))
 	pArgs, pReply := putArgs(key, data, 1, store.StoreID())
 	pArgs.Timestamp = store.ctx.Clock.Now()
-	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -688,19 +875,19 @@ func TestStoreResolveWriteIntent(t *testing.T) {
 		pArgs, pReply := putArgs(key, []byte("value"), 1, store.StoreID())
 		pArgs.Timestamp = store.ctx.Clock.Now()
 		pArgs.Txn = pushee
-		if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), pArgs, pReply); err != nil {
 			t.Fatal(err)
 		}
 
 		// Now, try a put using the pusher's txn.
 		pArgs.Timestamp = store.ctx.Clock.Now()
 		pArgs.Txn = pusher
-		err := store.ExecuteCmd(pArgs, pReply)
+		err := store.ExecuteCmd(context.Background(), pArgs, pReply)
 		if resolvable {
 			if err != nil {
 				t.Errorf("expected intent resolved; got unexpected error: %s", err)
 			}
-			txnKey := engine.TransactionKey(pushee.Key, pushee.ID)
+			txnKey := keys.TransactionKey(pushee.Key, pushee.ID)
 			var txn proto.Transaction
 			ok, err := engine.MVCCGetProto(store.Engine(), txnKey, proto.ZeroTimestamp, true, nil, &txn)
 			if !ok || err != nil {
@@ -718,7 +905,7 @@ func TestStoreResolveWriteIntent(t *testing.T) {
 				t.Errorf("expected txn to match pushee %q; got %s", pushee.ID, rErr)
 			}
 			// Trying again should fail again.
-			if err = store.ExecuteCmd(pArgs, pReply); err == nil {
+			if err = store.ExecuteCmd(context.Background(), pArgs, pReply); err == nil {
 				t.Errorf("expected another error on latent write intent but succeeded")
 			}
 		}
@@ -742,7 +929,7 @@ func TestStoreResolveWriteIntentRollback(t *testing.T) {
 	args, reply := incrementArgs(key, 1, 1, store.StoreID())
 	args.Timestamp = store.ctx.Clock.Now()
 	args.Txn = pushee
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -750,7 +937,7 @@ func TestStoreResolveWriteIntentRollback(t *testing.T) {
 	args.Timestamp = store.ctx.Clock.Now()
 	args.Txn = pusher
 	args.Increment = 2
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Errorf("expected increment to succeed: %s", err)
 	}
 	if reply.NewValue != 2 {
@@ -795,7 +982,7 @@ func TestStoreResolveWriteIntentPushOnRead(t *testing.T) {
 		// First, write original value.
 		args, reply := putArgs(key, []byte("value1"), 1, store.StoreID())
 		args.Timestamp = store.ctx.Clock.Now()
-		if err := store.ExecuteCmd(args, reply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 			t.Fatal(err)
 		}
 
@@ -803,7 +990,7 @@ func TestStoreResolveWriteIntentPushOnRead(t *testing.T) {
 		args.Timestamp = store.ctx.Clock.Now()
 		args.Txn = pushee
 		args.Value.Bytes = []byte("value2")
-		if err := store.ExecuteCmd(args, reply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 			t.Fatal(err)
 		}
 
@@ -811,7 +998,7 @@ func TestStoreResolveWriteIntentPushOnRead(t *testing.T) {
 		gArgs, gReply := getArgs(key, 1, store.StoreID())
 		gArgs.Timestamp = store.ctx.Clock.Now()
 		gArgs.Txn = pusher
-		err := store.ExecuteCmd(gArgs, gReply)
+		err := store.ExecuteCmd(context.Background(), gArgs, gReply)
 		if test.resolvable {
 			if err != nil {
 				t.Errorf("%d: expected read to succeed: %s", i, err)
@@ -825,7 +1012,7 @@ func TestStoreResolveWriteIntentPushOnRead(t *testing.T) {
 			// verify commit fails with TransactionRetryError.
 			etArgs, etReply := endTxnArgs(pushee, true, 1, store.StoreID())
 			etArgs.Timestamp = pushee.Timestamp
-			err := store.ExecuteCmd(etArgs, etReply)
+			err := store.ExecuteCmd(context.Background(), etArgs, etReply)
 
 			expTimestamp := gArgs.Timestamp
 			expTimestamp.Logical++
@@ -880,7 +1067,7 @@ func TestStoreResolveWriteIntentSnapshotIsolation(t *testing.T) {
 	// First, write original value.
 	args, reply := putArgs(key, []byte("value1"), 1, store.StoreID())
 	args.Timestamp = store.ctx.Clock.Now()
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -888,7 +1075,7 @@ func TestStoreResolveWriteIntentSnapshotIsolation(t *testing.T) {
 	args.Timestamp = store.ctx.Clock.Now()
 	args.Txn = pushee
 	args.Value.Bytes = []byte("value2")
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -896,7 +1083,7 @@ func TestStoreResolveWriteIntentSnapshotIsolation(t *testing.T) {
 	gArgs, gReply := getArgs(key, 1, store.StoreID())
 	gArgs.Timestamp = store.ctx.Clock.Now()
 	gArgs.Txn = pusher
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil {
 		t.Errorf("expected read to succeed: %s", err)
 	} else if !bytes.Equal(gReply.Value.Bytes, []byte("value1")) {
 		t.Errorf("expected bytes to be %q, got %q", "value1", gReply.Value.Bytes)
@@ -907,7 +1094,7 @@ func TestStoreResolveWriteIntentSnapshotIsolation(t *testing.T) {
 	// commit timestamp is equal to gArgs.Timestamp + 1.
 	etArgs, etReply := endTxnArgs(pushee, true, 1, store.StoreID())
 	etArgs.Timestamp = pushee.Timestamp
-	if err := store.ExecuteCmd(etArgs, etReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), etArgs, etReply); err != nil {
 		t.Fatal(err)
 	}
 	expTimestamp := gArgs.Timestamp
@@ -933,7 +1120,7 @@ func TestStoreResolveWriteIntentNoTxn(t *testing.T) {
 	args, reply := putArgs(key, []byte("value1"), 1, store.StoreID())
 	args.Timestamp = pushee.Timestamp
 	args.Txn = pushee
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -941,7 +1128,7 @@ func TestStoreResolveWriteIntentNoTxn(t *testing.T) {
 	gArgs, gReply := getArgs(key, 1, store.StoreID())
 	gArgs.Timestamp = store.ctx.Clock.Now()
 	gArgs.UserPriority = gogoproto.Int32(math.MaxInt32)
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil {
 		t.Errorf("expected read to succeed: %s", err)
 	} else if gReply.Value != nil {
 		t.Errorf("expected value to be nil, got %+v", gReply.Value)
@@ -952,12 +1139,12 @@ func TestStoreResolveWriteIntentNoTxn(t *testing.T) {
 	args.Value.Bytes = []byte("value2")
 	args.Txn = nil
 	args.UserPriority = gogoproto.Int32(math.MaxInt32)
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Errorf("expected success aborting pushee's txn; got %s", err)
 	}
 
 	// Read pushee's txn.
-	txnKey := engine.TransactionKey(pushee.Key, pushee.ID)
+	txnKey := keys.TransactionKey(pushee.Key, pushee.ID)
 	var txn proto.Transaction
 	ok, err := engine.MVCCGetProto(store.Engine(), txnKey, proto.ZeroTimestamp, true, nil, &txn)
 	if !ok || err != nil {
@@ -984,7 +1171,7 @@ func TestStoreResolveWriteIntentNoTxn(t *testing.T) {
 	// been aborted.
 	etArgs, etReply := endTxnArgs(pushee, true, 1, store.StoreID())
 	etArgs.Timestamp = pushee.Timestamp
-	err = store.ExecuteCmd(etArgs, etReply)
+	err = store.ExecuteCmd(context.Background(), etArgs, etReply)
 	if err == nil {
 		t.Errorf("unexpected success committing transaction")
 	}
@@ -1005,7 +1192,7 @@ func TestStoreReadInconsistent(t *testing.T) {
 
 	// First, write keyA.
 	args, reply := putArgs(keyA, []byte("value1"), 1, store.StoreID())
-	if err := store.ExecuteCmd(args, reply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1017,7 +1204,7 @@ func TestStoreReadInconsistent(t *testing.T) {
 		args.Key = txn.Key
 		args.Timestamp = txn.Timestamp
 		args.Txn = txn
-		if err := store.ExecuteCmd(args, reply); err != nil {
+		if err := store.ExecuteCmd(context.Background(), args, reply); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1025,13 +1212,13 @@ func TestStoreReadInconsistent(t *testing.T) {
 	// Now, get from both keys and verify.
 	gArgs, gReply := getArgs(keyA, 1, store.StoreID())
 	gArgs.ReadConsistency = proto.INCONSISTENT
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil {
 		t.Errorf("expected read to succeed: %s", err)
 	} else if !bytes.Equal(gReply.Value.Bytes, []byte("value1")) {
 		t.Errorf("expected value %q, got %q", []byte("value1"), gReply.Value.Bytes)
 	}
 	gArgs.Key = keyB
-	if err := store.ExecuteCmd(gArgs, gReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), gArgs, gReply); err != nil {
 		t.Errorf("expected read to succeed: %s", err)
 	} else if gReply.Value != nil {
 		t.Errorf("expected value to be nil, got %+v", gReply.Value)
@@ -1040,7 +1227,7 @@ func TestStoreReadInconsistent(t *testing.T) {
 	// Scan keys and verify results.
 	sArgs, sReply := scanArgs(keyA, proto.KeyMax, 1, store.StoreID())
 	sArgs.ReadConsistency = proto.INCONSISTENT
-	if err := store.ExecuteCmd(sArgs, sReply); err != nil {
+	if err := store.ExecuteCmd(context.Background(), sArgs, sReply); err != nil {
 		t.Errorf("expected scan to succeed: %s", err)
 	}
 	if l := len(sReply.Rows); l != 1 {
@@ -0,0 +1,58 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"encoding/gob"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+func init() {
+	gob.Register(SystemConfig{})
+}
+
+// SystemConfig is a snapshot of the accounting, permission and zone
+// configuration maps and the cluster settings stored in the system
+// config span. The span is never split, so the leader of the range
+// containing it loads all of them together and gossips them as a unit
+// whenever any config changes. Every node thereby holds a consistent
+// local copy.
+type SystemConfig struct {
+	Accounting  PrefixConfigMap
+	Permissions PrefixConfigMap
+	Zones       PrefixConfigMap
+	// Settings maps the names of the cluster settings which have been
+	// set to their values. See package util/settings.
+	Settings map[string]string
+}
+
+// GetSystemConfig returns the system config most recently received
+// via gossip.
+func GetSystemConfig(g *gossip.Gossip) (*SystemConfig, error) {
+	info, err := g.GetInfo(gossip.KeySystemConfig)
+	if err != nil {
+		return nil, err
+	}
+	cfg, ok := info.(SystemConfig)
+	if !ok {
+		return nil, util.Errorf("gossiped info is not a system config: %+v", info)
+	}
+	return &cfg, nil
+}
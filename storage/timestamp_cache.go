@@ -18,13 +18,12 @@
 package storage
 
 import (
-	"time"
-
 	"crypto/md5"
+	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/metrics"
 )
 
 const (
@@ -34,53 +33,66 @@ const (
 	// than minCacheWindow will necessarily have to advance their commit
 	// timestamp.
 	MinTSCacheWindow = 10 * time.Second
+
+	// tsCacheEvictionsMetric counts the timestamp cache segments
+	// discarded when a generation ages out of the cache window.
+	tsCacheEvictionsMetric = "storage.tscache.evictions"
+	// tsCacheRotationsMetric counts timestamp cache generation rotations.
+	tsCacheRotationsMetric = "storage.tscache.rotations"
 )
 
-// A TimestampCache maintains an interval tree FIFO cache of keys or
-// key ranges and the timestamps at which they were most recently read
-// or written. If a timestamp was read or written by a transaction, an
+// A TimestampCache maintains an interval skiplist of keys or key
+// ranges and the timestamps at which they were most recently read or
+// written. If a timestamp was read or written by a transaction, an
 // MD5 of the txn ID is stored with the timestamp to avoid advancing
 // timestamps on successive requests from the same transaction. We use
 // the MD5 of the txn ID to conserve memory as txn IDs are expected to
 // be fairly large ~100 bytes.
 //
-// The cache also maintains a low-water mark which is the most
-// recently evicted entry's timestamp. This value always ratchets
-// with monotonic increases. The low water mark is initialized to
-// the current system time plus the maximum clock offset.
+// Entries are added to the current of two generations. Once the
+// oldest entry of the current generation falls outside of the
+// MinTSCacheWindow, the generation is rotated to become the previous
+// one, and the previous generation is discarded as soon as its newest
+// entry falls outside of the window. Discarding a generation ratchets
+// the low-water mark to the newest timestamp it contained, bounding
+// memory to roughly two windows worth of entries.
+//
+// The low-water mark always ratchets with monotonic increases. It is
+// initialized to the current system time plus the maximum clock
+// offset.
 type TimestampCache struct {
-	cache            *util.IntervalCache
+	cur, prev        *intervalSkl
 	lowWater, latest proto.Timestamp
-}
-
-// A cacheEntry combines the timestamp with an optional MD5 of the
-// transaction ID.
-type cacheEntry struct {
-	timestamp proto.Timestamp
-	txnMD5    [md5.Size]byte // Empty for no transaction
-	readOnly  bool           // Command is read-only
+	evictions        int64 // Segments discarded with aged generations
+	rotations        int64 // Generation rotations
 }
 
 // NewTimestampCache returns a new timestamp cache with supplied
 // hybrid clock.
 func NewTimestampCache(clock *hlc.Clock) *TimestampCache {
-	tc := &TimestampCache{
-		cache: util.NewIntervalCache(util.CacheConfig{Policy: util.CacheFIFO}),
-	}
+	tc := &TimestampCache{}
 	tc.Clear(clock)
-	tc.cache.CacheConfig.ShouldEvict = tc.shouldEvict
 	return tc
 }
 
 // Clear clears the cache and resets the low water mark to the
 // current time plus the maximum clock offset.
 func (tc *TimestampCache) Clear(clock *hlc.Clock) {
-	tc.cache.Clear()
+	tc.cur, tc.prev = newIntervalSkl(), nil
 	tc.lowWater = clock.Now()
 	tc.lowWater.WallTime += clock.MaxOffset().Nanoseconds()
 	tc.latest = tc.lowWater
 }
 
+// len returns the number of segments in the cache.
+func (tc *TimestampCache) len() int {
+	n := tc.cur.len
+	if tc.prev != nil {
+		n += tc.prev.len
+	}
+	return n
+}
+
 // Add the specified timestamp to the cache as covering the range of
 // keys from start to end. If end is nil, the range covers the start
 // key only. txnMD5 is empty for no transaction. readOnly specifies
@@ -93,26 +105,12 @@ func (tc *TimestampCache) Add(start, end proto.Key, timestamp proto.Timestamp, t
 	}
 	if tc.latest.Less(timestamp) {
 		tc.latest = timestamp
+		tc.rotate()
 	}
 	// Only add to the cache if the timestamp is more recent than the
 	// low water mark.
 	if tc.lowWater.Less(timestamp) {
-		// Check existing, overlapping entries. Remove superseded
-		// entries or return without adding this entry if necessary.
-		key := tc.cache.NewKey(start, end)
-		for _, o := range tc.cache.GetOverlaps(start, end) {
-			ce := o.Value.(cacheEntry)
-			if ce.readOnly != readOnly {
-				continue
-			}
-			if o.Key.Contains(key) && !ce.timestamp.Less(timestamp) {
-				return // don't add this key; there's already a cache entry with >= timestamp.
-			} else if key.Contains(o.Key) && !timestamp.Less(ce.timestamp) {
-				tc.cache.Del(o.Key) // delete existing key; this cache entry supersedes.
-			}
-		}
-		ce := cacheEntry{timestamp: timestamp, txnMD5: txnMD5, readOnly: readOnly}
-		tc.cache.Add(key, ce)
+		tc.cur.add(start, end, sklEntry{timestamp: timestamp, txnMD5: txnMD5}, readOnly)
 	}
 }
 
@@ -133,14 +131,16 @@ func (tc *TimestampCache) GetMax(start, end proto.Key, txnMD5 [md5.Size]byte) (p
 	}
 	maxR := tc.lowWater
 	maxW := tc.lowWater
-	for _, o := range tc.cache.GetOverlaps(start, end) {
-		ce := o.Value.(cacheEntry)
-		if proto.MD5Equal(ce.txnMD5, proto.NoTxnMD5) || proto.MD5Equal(txnMD5, proto.NoTxnMD5) || !proto.MD5Equal(txnMD5, ce.txnMD5) {
-			if ce.readOnly && maxR.Less(ce.timestamp) {
-				maxR = ce.timestamp
-			} else if !ce.readOnly && maxW.Less(ce.timestamp) {
-				maxW = ce.timestamp
-			}
+	for _, s := range []*intervalSkl{tc.prev, tc.cur} {
+		if s == nil {
+			continue
+		}
+		r, w := s.getMax(start, end, txnMD5)
+		if maxR.Less(r) {
+			maxR = r
+		}
+		if maxW.Less(w) {
+			maxW = w
 		}
 	}
 	return maxR, maxW
@@ -152,34 +152,52 @@ func (tc *TimestampCache) GetMax(start, end proto.Key, txnMD5 [md5.Size]byte) (p
 // before merging in the source.
 func (tc *TimestampCache) MergeInto(dest *TimestampCache, clear bool) {
 	if clear {
-		dest.cache.Clear()
+		dest.cur, dest.prev = newIntervalSkl(), nil
+		if tc.prev != nil {
+			dest.prev = newIntervalSkl()
+			tc.prev.mergeInto(dest.prev)
+		}
+		tc.cur.mergeInto(dest.cur)
 		dest.lowWater = tc.lowWater
 		dest.latest = tc.latest
-	} else {
-		if dest.lowWater.Less(tc.lowWater) {
-			dest.lowWater = tc.lowWater
-		}
-		if dest.latest.Less(tc.latest) {
-			tc.latest = dest.latest
-		}
+		return
+	}
+	if dest.lowWater.Less(tc.lowWater) {
+		dest.lowWater = tc.lowWater
+	}
+	if dest.latest.Less(tc.latest) {
+		dest.latest = tc.latest
+	}
+	if tc.prev != nil {
+		tc.prev.mergeInto(dest.cur)
 	}
-	tc.cache.Do(func(k, v interface{}) {
-		dest.cache.Add(k, v)
-	})
+	tc.cur.mergeInto(dest.cur)
+	dest.rotate()
 }
 
-// shouldEvict returns true if the cache entry's timestamp is no
-// longer within the MinTSCacheWindow.
-func (tc *TimestampCache) shouldEvict(size int, key, value interface{}) bool {
-	ce := value.(cacheEntry)
+// rotate discards the previous generation once all of its entries
+// have fallen outside of the MinTSCacheWindow, ratcheting the low
+// water mark to its newest timestamp, and rotates the current
+// generation into its place once its oldest entry falls outside of
+// the window.
+func (tc *TimestampCache) rotate() {
 	// Compute the edge of the cache window.
 	edge := tc.latest
 	edge.WallTime -= MinTSCacheWindow.Nanoseconds()
-	// We evict and update the low water mark if the proposed evictee's
-	// timestamp is <= than the edge of the window.
-	if !edge.Less(ce.timestamp) {
-		tc.lowWater = ce.timestamp
-		return true
+	for {
+		if tc.prev != nil && !edge.Less(tc.prev.maxTS) {
+			if tc.lowWater.Less(tc.prev.maxTS) {
+				tc.lowWater = tc.prev.maxTS
+			}
+			tc.evictions += int64(tc.prev.len)
+			metrics.Metrics.Counter(tsCacheEvictionsMetric, uint64(tc.prev.len))
+			tc.prev = nil
+		}
+		if tc.prev != nil || tc.cur.len == 0 || edge.Less(tc.cur.minTS) {
+			return
+		}
+		tc.prev, tc.cur = tc.cur, newIntervalSkl()
+		tc.rotations++
+		metrics.Metrics.Counter(tsCacheRotationsMetric, 1)
 	}
-	return false
 }
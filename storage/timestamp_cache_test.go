@@ -42,8 +42,8 @@ func TestTimestampCache(t *testing.T) {
 	tc.Add(proto.Key("a"), nil, clock.Now(), proto.NoTxnMD5, true)
 	// Although we added "a" at time 0, the internal cache should still
 	// be empty because the t=0 < lowWater.
-	if tc.cache.Len() > 0 {
-		t.Errorf("expected cache to be empty, but contains %d elements", tc.cache.Len())
+	if tc.len() > 0 {
+		t.Errorf("expected cache to be empty, but contains %d elements", tc.len())
 	}
 	// Verify GetMax returns the lowWater mark which is maxClockOffset.
 	if rTS, _ := tc.GetMax(proto.Key("a"), nil, proto.NoTxnMD5); rTS.WallTime != maxClockOffset.Nanoseconds() {
@@ -126,6 +126,58 @@ func TestTimestampCacheEviction(t *testing.T) {
 	}
 }
 
+// TestTimestampCacheRotation verifies that generations are rotated
+// and discarded as they age out of the MinTSCacheWindow, ratcheting
+// the low water mark and counting evictions.
+func TestTimestampCacheRotation(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	clock.SetMaxOffset(maxClockOffset)
+	tc := NewTimestampCache(clock)
+
+	manual.Set(maxClockOffset.Nanoseconds() + 1)
+	aTS := clock.Now()
+	tc.Add(proto.Key("a"), proto.Key("c"), aTS, proto.NoTxnMD5, true)
+
+	// Half a window later, "b" still lands in the current generation.
+	manual.Increment(MinTSCacheWindow.Nanoseconds() / 2)
+	bTS := clock.Now()
+	tc.Add(proto.Key("b"), nil, bTS, proto.NoTxnMD5, false)
+	if tc.rotations != 0 || tc.len() != 3 {
+		t.Fatalf("expected no rotations and 3 segments; got %d, %d", tc.rotations, tc.len())
+	}
+
+	// Once "a" falls out of the window the current generation rotates,
+	// but it isn't discarded while "b" is still within the window.
+	manual.Increment(MinTSCacheWindow.Nanoseconds() / 2)
+	cTS := clock.Now()
+	tc.Add(proto.Key("c"), nil, cTS, proto.NoTxnMD5, true)
+	if tc.rotations != 1 || tc.evictions != 0 {
+		t.Fatalf("expected 1 rotation and no evictions; got %d, %d", tc.rotations, tc.evictions)
+	}
+	if rTS, wTS := tc.GetMax(proto.Key("a"), proto.Key("d"), proto.NoTxnMD5); !rTS.Equal(cTS) || !wTS.Equal(bTS) {
+		t.Errorf("expected %s %s; got %s %s", cTS, bTS, rTS, wTS)
+	}
+
+	// When "b" falls out of the window, the previous generation is
+	// discarded and the low water mark ratchets to bTS.
+	manual.Increment(MinTSCacheWindow.Nanoseconds() / 2)
+	tc.Add(proto.Key("d"), nil, clock.Now(), proto.NoTxnMD5, true)
+	if tc.evictions != 3 {
+		t.Errorf("expected 3 evictions; got %d", tc.evictions)
+	}
+	if !tc.lowWater.Equal(bTS) {
+		t.Errorf("expected low water mark %s; got %s", bTS, tc.lowWater)
+	}
+	if rTS, wTS := tc.GetMax(proto.Key("a"), nil, proto.NoTxnMD5); !rTS.Equal(bTS) || !wTS.Equal(bTS) {
+		t.Errorf("expected low water mark for \"a\"; got %s %s", rTS, wTS)
+	}
+	if rTS, _ := tc.GetMax(proto.Key("c"), nil, proto.NoTxnMD5); !rTS.Equal(cTS) {
+		t.Errorf("expected %s for \"c\"; got %s", cTS, rTS)
+	}
+}
+
 func TestTimestampCacheMergeInto(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	manual := hlc.NewManualClock(0)
@@ -135,8 +187,8 @@ func TestTimestampCacheMergeInto(t *testing.T) {
 		useClear bool
 		expLen   int
 	}{
-		{true, 3},
-		{false, 5},
+		{true, 4},
+		{false, 7},
 	}
 	for _, test := range testCases {
 		tc1 := NewTimestampCache(clock)
@@ -159,8 +211,8 @@ func TestTimestampCacheMergeInto(t *testing.T) {
 
 		tc1.MergeInto(tc2, test.useClear)
 
-		if tc2.cache.Len() != test.expLen {
-			t.Errorf("expected merged length of %d; got %d", test.expLen, tc2.cache.Len())
+		if tc2.len() != test.expLen {
+			t.Errorf("expected merged length of %d; got %d", test.expLen, tc2.len())
 		}
 		if !tc2.latest.Equal(tc1.latest) {
 			t.Errorf("expected latest to be updated to %s; got %s", tc1.latest, tc2.latest)
@@ -264,9 +316,9 @@ func TestTimestampCacheClear(t *testing.T) {
 	}
 }
 
-// TestTimestampCacheReplacements verifies that a newer entry
-// in the timestamp cache which completely "covers" an older
-// entry will replace it.
+// TestTimestampCacheReplacements verifies that a newer entry in the
+// timestamp cache shadows older entries for all transactions other
+// than the one which added it.
 func TestTimestampCacheReplacements(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	manual := hlc.NewManualClock(0)
@@ -282,17 +334,21 @@ func TestTimestampCacheReplacements(t *testing.T) {
 		t.Errorf("expected %s; got %s", ts1, ts)
 	}
 	// Write overlapping value with txn1 and verify with txn1--we should get
-	// low water mark, not ts1.
+	// ts1, the most recent timestamp not written by txn1.
 	ts2 := clock.Now()
 	tc.Add(proto.Key("a"), nil, ts2, txn1MD5, true)
-	if ts, _ := tc.GetMax(proto.Key("a"), nil, txn1MD5); !ts.Equal(tc.lowWater) {
-		t.Errorf("expected low water (empty) time; got %s", ts)
+	if ts, _ := tc.GetMax(proto.Key("a"), nil, txn1MD5); !ts.Equal(ts1) {
+		t.Errorf("expected %s; got %s", ts1, ts)
 	}
 	// Write range which overlaps "a" with txn2 and verify with txn2--we should
-	// get low water mark, not ts2.
+	// get ts2 for "a", not ts3, and the low water mark for "b", which only
+	// txn2 has read.
 	ts3 := clock.Now()
 	tc.Add(proto.Key("a"), proto.Key("c"), ts3, txn2MD5, true)
-	if ts, _ := tc.GetMax(proto.Key("a"), nil, txn2MD5); !ts.Equal(tc.lowWater) {
+	if ts, _ := tc.GetMax(proto.Key("a"), nil, txn2MD5); !ts.Equal(ts2) {
+		t.Errorf("expected %s; got %s", ts2, ts)
+	}
+	if ts, _ := tc.GetMax(proto.Key("b"), nil, txn2MD5); !ts.Equal(tc.lowWater) {
 		t.Errorf("expected low water (empty) time; got %s", ts)
 	}
 	// Also, verify txn1 sees ts3.
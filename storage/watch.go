@@ -0,0 +1,141 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"golang.org/x/net/context"
+)
+
+// maxWatchWait bounds the time a Watch command waits for changes, so
+// that watchers idle on a range tie up its leader for at most as long.
+const maxWatchWait = 30 * time.Second
+
+// A rangeWatcher is a Watch command waiting for writes to the span
+// [start, end) of its range. Its channel receives a value once such a
+// write has been applied.
+type rangeWatcher struct {
+	start, end proto.Key
+	c          chan struct{}
+}
+
+// addWatcher registers a watcher for writes to [start, end).
+func (r *Range) addWatcher(start, end proto.Key) *rangeWatcher {
+	w := &rangeWatcher{start: start, end: end, c: make(chan struct{}, 1)}
+	r.Lock()
+	r.watchers[w] = struct{}{}
+	r.Unlock()
+	return w
+}
+
+// removeWatcher unregisters a watcher.
+func (r *Range) removeWatcher(w *rangeWatcher) {
+	r.Lock()
+	delete(r.watchers, w)
+	r.Unlock()
+}
+
+// notifyWatchers signals the watchers whose spans overlap the keys
+// written by a command. It is called once the command's writes have
+// been committed to the engine. An empty end key denotes the single
+// key start.
+func (r *Range) notifyWatchers(start, end proto.Key) {
+	if len(end) == 0 {
+		end = start.Next()
+	}
+	r.RLock()
+	defer r.RUnlock()
+	for w := range r.watchers {
+		if start.Less(w.end) && w.start.Less(end) {
+			select {
+			case w.c <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// addWatchCmd executes a Watch command as a read, waiting for and
+// retrying on writes to the watched span if it found no changes. The
+// span is restricted to the range, and the read repeated at the
+// current time, each time a write to it is applied. A watcher is
+// registered before each read so that no write between the read and
+// the wait is missed.
+func (r *Range) addWatchCmd(ctx context.Context, args *proto.WatchRequest, reply *proto.WatchResponse) error {
+	args.EndKey = r.Desc().EndKey
+	if len(args.LimitKey) > 0 && args.LimitKey.Less(args.EndKey) {
+		args.EndKey = args.LimitKey
+	}
+	if !args.Key.Less(args.EndKey) {
+		err := util.Errorf("empty watch span [%q, %q)", args.Key, args.EndKey)
+		reply.SetGoError(err)
+		return err
+	}
+	if err := r.checkPermissions(args); err != nil {
+		reply.SetGoError(err)
+		return err
+	}
+
+	wait := time.Duration(args.MaxWait)
+	if wait > maxWatchWait {
+		wait = maxWatchWait
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		w := r.addWatcher(args.Key, args.EndKey)
+		err := r.addReadOnlyCmd(ctx, args, reply)
+		if err != nil || len(reply.Events) > 0 {
+			r.removeWatcher(w)
+			return err
+		}
+		select {
+		case <-w.c:
+		case <-timer.C:
+			r.removeWatcher(w)
+			return nil
+		case <-ctx.Done():
+			r.removeWatcher(w)
+			reply.SetGoError(ctx.Err())
+			return ctx.Err()
+		case <-r.rm.Stopper().ShouldStop():
+			r.removeWatcher(w)
+			return nil
+		}
+		r.removeWatcher(w)
+		args.Timestamp = r.rm.Clock().Now()
+		reply.Reset()
+	}
+}
+
+// Watch returns the changes to the keys in [args.Key, args.EndKey)
+// with timestamps in (args.StartTime, args.Timestamp], each at its
+// value as of args.Timestamp.
+func (r *Range) Watch(batch engine.Engine, args *proto.WatchRequest, reply *proto.WatchResponse) {
+	reply.EndKey = args.EndKey
+	err := engine.MVCCIterateChanges(batch, args.Key, args.EndKey, args.StartTime, args.Timestamp,
+		func(key proto.Key, value *proto.Value) (bool, error) {
+			reply.Events = append(reply.Events, proto.WatchEvent{Key: key, Value: value})
+			return false, nil
+		})
+	reply.SetGoError(err)
+}
@@ -0,0 +1,105 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package storage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"golang.org/x/net/context"
+)
+
+func watchArgs(key, limitKey proto.Key, startTime proto.Timestamp, maxWait time.Duration,
+	raftID int64, storeID proto.StoreID) (*proto.WatchRequest, *proto.WatchResponse) {
+	args := &proto.WatchRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     key,
+			RaftID:  raftID,
+			Replica: proto.Replica{StoreID: storeID},
+		},
+		LimitKey:  limitKey,
+		StartTime: startTime,
+		MaxWait:   int64(maxWait),
+	}
+	return args, &proto.WatchResponse{}
+}
+
+// TestRangeWatch verifies that a Watch command returns the changes
+// since its start time and otherwise waits for a write to its span.
+func TestRangeWatch(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	put := func(key, value string) {
+		pArgs, pReply := putArgs([]byte(key), []byte(value), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(context.Background(), pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("a", "1")
+	put("c", "1")
+
+	// The catch-up finds the existing keys of the span immediately.
+	args, reply := watchArgs(proto.Key("a"), proto.Key("b"), proto.ZeroTimestamp, 0, 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.Events) != 1 || !reply.Events[0].Key.Equal(proto.Key("a")) {
+		t.Fatalf("expected an event for a; got %+v", reply.Events)
+	}
+	if !reply.EndKey.Equal(proto.Key("b")) {
+		t.Errorf("expected end key b; got %q", reply.EndKey)
+	}
+
+	// Resuming from the reply's timestamp waits for the next write to
+	// the span, ignoring writes outside of it.
+	args, reply2 := watchArgs(proto.Key("a"), proto.Key("b"), reply.Timestamp, 5*time.Second, 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	errC := make(chan error)
+	go func() {
+		errC <- tc.rng.AddCmd(context.Background(), args, reply2, true)
+	}()
+	put("c", "2")
+	time.Sleep(10 * time.Millisecond)
+	put("a", "2")
+	if err := <-errC; err != nil {
+		t.Fatal(err)
+	}
+	if len(reply2.Events) != 1 || !reply2.Events[0].Key.Equal(proto.Key("a")) ||
+		!bytes.Equal(reply2.Events[0].Value.Bytes, []byte("2")) {
+		t.Errorf("expected an event for the new value of a; got %+v", reply2.Events)
+	}
+
+	// Without changes, the command returns once its wait expires.
+	args, reply3 := watchArgs(proto.Key("a"), proto.Key("b"), reply2.Timestamp, 10*time.Millisecond,
+		1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(context.Background(), args, reply3, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(reply3.Events) != 0 {
+		t.Errorf("expected no events; got %+v", reply3.Events)
+	}
+}
@@ -23,8 +23,8 @@ import (
 	"encoding/gob"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 )
 
@@ -34,6 +34,9 @@ type DB interface {
 	PutSchema(*Schema) error
 	DeleteSchema(*Schema) error
 	GetSchema(string) (*Schema, error)
+	CreateTable(string, *Table) error
+	Insert(schemaKey, table string, row Row) error
+	Select(schemaKey, table string, key Row) (Row, error)
 }
 
 // A structuredDB satisfies the DB interface using the
@@ -55,7 +58,7 @@ func (db *structuredDB) PutSchema(s *Schema) error {
 	if err := s.Validate(); err != nil {
 		return err
 	}
-	k := engine.MakeKey(engine.KeySchemaPrefix, proto.Key(s.Key))
+	k := keys.MakeKey(keys.KeySchemaPrefix, proto.Key(s.Key))
 	// TODO(pmattis): This is an inappropriate use of gob. Replace with
 	// something else.
 	var buf bytes.Buffer
@@ -70,7 +73,7 @@ func (db *structuredDB) DeleteSchema(s *Schema) error {
 	return db.kvDB.Run(client.Call{
 		Args: &proto.DeleteRequest{
 			RequestHeader: proto.RequestHeader{
-				Key: engine.MakeKey(engine.KeySchemaPrefix, proto.Key(s.Key)),
+				Key: keys.MakeKey(keys.KeySchemaPrefix, proto.Key(s.Key)),
 			},
 		},
 		Reply: &proto.DeleteResponse{}})
@@ -80,10 +83,16 @@ func (db *structuredDB) DeleteSchema(s *Schema) error {
 // one does not exist. A nil error is returned when a schema
 // with the given key cannot be found.
 func (db *structuredDB) GetSchema(key string) (*Schema, error) {
+	return getSchema(db.kvDB.Run, key)
+}
+
+// getSchema returns the Schema with the given key, or nil if one does
+// not exist, reading it via run.
+func getSchema(run func(...client.Call) error, key string) (*Schema, error) {
 	s := &Schema{}
-	k := engine.MakeKey(engine.KeySchemaPrefix, proto.Key(key))
+	k := keys.MakeKey(keys.KeySchemaPrefix, proto.Key(key))
 	call := client.GetCall(k)
-	if err := db.kvDB.Run(call); err != nil {
+	if err := run(call); err != nil {
 		return nil, err
 	}
 	reply := call.Reply.(*proto.GetResponse)
@@ -101,3 +110,120 @@ func (db *structuredDB) GetSchema(key string) (*Schema, error) {
 	}
 	return s, nil
 }
+
+// getTable returns the validated schema with the given key and its
+// table with the given name, reading them via run.
+func getTable(run func(...client.Call) error, schemaKey, table string) (*Schema, *Table, error) {
+	s, err := getSchema(run, schemaKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s == nil {
+		return nil, nil, util.Errorf("schema %q not found", schemaKey)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, nil, err
+	}
+	t, ok := s.byName[table]
+	if !ok {
+		return nil, nil, util.Errorf("schema %q: table %q not found", schemaKey, table)
+	}
+	return s, t, nil
+}
+
+// CreateTable adds t to the schema with the given key. An error is
+// returned if the schema doesn't exist, already has a table with the
+// same name or key, or fails validation with the table added.
+func (db *structuredDB) CreateTable(schemaKey string, t *Table) error {
+	return db.kvDB.RunTransaction(&client.TransactionOptions{Name: "create table"}, func(txn *client.Txn) error {
+		s, err := getSchema(txn.Run, schemaKey)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			return util.Errorf("schema %q not found", schemaKey)
+		}
+		s.Tables = append(s.Tables, t)
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		// TODO(pmattis): This is an inappropriate use of gob. Replace with
+		// something else.
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+			return err
+		}
+		k := keys.MakeKey(keys.KeySchemaPrefix, proto.Key(s.Key))
+		return txn.Run(client.PutCall(k, buf.Bytes()))
+	})
+}
+
+// Insert adds the row to the named table of the schema with the given
+// key. The row must specify all of the table's primary key columns and
+// no row with the same primary key may exist. Each column family of
+// the row is stored under its own key; see the package documentation.
+func (db *structuredDB) Insert(schemaKey, table string, row Row) error {
+	return db.kvDB.RunTransaction(&client.TransactionOptions{Name: "insert"}, func(txn *client.Txn) error {
+		s, t, err := getTable(txn.Run, schemaKey, table)
+		if err != nil {
+			return err
+		}
+		key, err := s.rowKey(t, row)
+		if err != nil {
+			return err
+		}
+		families, err := t.encodeFamilies(row)
+		if err != nil {
+			return err
+		}
+		call := client.GetCall(key)
+		if err := txn.Run(call); err != nil {
+			return err
+		}
+		if call.Reply.(*proto.GetResponse).Value != nil {
+			return util.Errorf("table %q: a row with key %q already exists", t.Name, key)
+		}
+		calls := make([]client.Call, 0, len(families))
+		for family, value := range families {
+			calls = append(calls, client.PutCall(familyKey(key, family), value))
+		}
+		return txn.Run(calls...)
+	})
+}
+
+// Select returns the row of the named table of the schema with the
+// given key whose primary key columns match those of key, or nil if
+// there is no such row.
+func (db *structuredDB) Select(schemaKey, table string, key Row) (Row, error) {
+	s, t, err := getTable(db.kvDB.Run, schemaKey, table)
+	if err != nil {
+		return nil, err
+	}
+	rowKey, err := s.rowKey(t, key)
+	if err != nil {
+		return nil, err
+	}
+	families := t.families()
+	calls := make([]client.Call, len(families))
+	for i, family := range families {
+		calls[i] = client.GetCall(familyKey(rowKey, family))
+	}
+	if err := db.kvDB.Run(calls...); err != nil {
+		return nil, err
+	}
+	if calls[0].Reply.(*proto.GetResponse).Value == nil {
+		return nil, nil
+	}
+	row := Row{}
+	for _, c := range t.primaryKey {
+		row[c.Name], _ = normalizeColumnValue(c, key[c.Name])
+	}
+	for _, call := range calls {
+		if value := call.Reply.(*proto.GetResponse).Value; value != nil {
+			if err := t.decodeFamily(value.Bytes, row); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return row, nil
+}
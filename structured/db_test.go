@@ -18,6 +18,7 @@
 package structured_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -130,3 +131,69 @@ func createTestSchema() (*structured.Schema, error) {
 	}
 	return s, nil
 }
+
+// TestInsertSelect verifies that rows inserted into a table created
+// in an existing schema are selected by primary key, including the
+// columns of non-default column families.
+func TestInsertSelect(t *testing.T) {
+	stopper := util.NewStopper()
+	defer stopper.Stop()
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	localDB, err := server.BootstrapCluster("test-cluster", e, stopper)
+	if err != nil {
+		t.Fatalf("unable to boostrap cluster: %v", err)
+	}
+	db := structured.NewDB(localDB)
+	if err := db.PutSchema(&structured.Schema{Name: "PhotoDB", Key: "pdb"}); err != nil {
+		t.Fatal(err)
+	}
+	table := &structured.Table{
+		Name: "User",
+		Key:  "us",
+		Columns: []*structured.Column{
+			{Name: "ID", Key: "id", Type: "integer", PrimaryKey: true, Scatter: true},
+			{Name: "Name", Key: "na", Type: "string"},
+			{Name: "Bio", Key: "bi", Type: "blob", Family: "b"},
+		},
+	}
+	if err := db.CreateTable("pdb", table); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable("pdb", table); err == nil {
+		t.Error("expected creating a duplicate table to fail")
+	}
+
+	if err := db.Insert("pdb", "User", structured.Row{"ID": 531, "Name": "Spencer", "Bio": []byte("...")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Insert("pdb", "User", structured.Row{"ID": 532}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Insert("pdb", "User", structured.Row{"ID": 531, "Name": "Peter"}); err == nil {
+		t.Error("expected inserting a duplicate row to fail")
+	}
+	if err := db.Insert("pdb", "User", structured.Row{"Name": "Peter"}); err == nil {
+		t.Error("expected inserting a row without a primary key to fail")
+	}
+	if err := db.Insert("pdb", "User", structured.Row{"ID": 533, "Name": 5}); err == nil {
+		t.Error("expected inserting a row with a mistyped column to fail")
+	}
+
+	testCases := []struct {
+		id  int
+		row structured.Row
+	}{
+		{531, structured.Row{"ID": int64(531), "Name": "Spencer", "Bio": []byte("...")}},
+		{532, structured.Row{"ID": int64(532)}},
+		{533, nil},
+	}
+	for _, test := range testCases {
+		row, err := db.Select("pdb", "User", structured.Row{"ID": test.id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(row, test.row) {
+			t.Errorf("%d: expected %v; got %v", test.id, test.row, row)
+		}
+	}
+}
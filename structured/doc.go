@@ -88,6 +88,7 @@ Columns are specified as follows:
                         integermap |
                         stringmap)>
       auto_increment:  <start-value>
+      family:          <Family Key>
       foreign_key:     <Table>.<Column>
       index:           (secondary |
                         unique |
@@ -192,6 +193,9 @@ Tag Specifications
 
 "roach" tag specifications are as follows:
 
+  family=<family-key>: stores the column in the named column family
+  rather than the default family. See "Column Families" below.
+
   fk=<table[.column]>: (Foreign Key) specifies the field is a foreign
   key. <table.column> specifies which table and column the foreign key
   references. If a foreign key references an object with a composite
@@ -377,6 +381,22 @@ look like:
   pdb/us/<E(600)>: <data for user 600>
   ...
 
+Column Families
+
+Columns may be grouped into column families, each stored under its
+own key. The row's key holds the default family: all columns that
+don't specify one. Each other family is stored at the row's key
+suffixed with ":" and the family key. Grouping columns which are
+large, or which are updated frequently, into their own family avoids
+rewriting the rest of the row when they change. If User had a Bio
+column in family "b":
+
+  pdb/us/<E(531)>:   {na: "Spencer", em: "spencer.kimball@gmail.com"}
+  pdb/us/<E(531)>:b: {bi: "..."}
+
+The default family is written even when it holds no columns, as it
+marks the existence of the row.
+
 Indexes
 
 Indexes provide efficient access to rows in the database by columnar
@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
 )
 
 var (
@@ -65,6 +66,20 @@ func (db *testDB) GetSchema(key string) (*Schema, error) {
 	return nil, nil
 }
 
+// The REST server doesn't serve row data, so the testDB doesn't
+// support tables.
+func (db *testDB) CreateTable(schemaKey string, t *Table) error {
+	return util.Errorf("not supported")
+}
+
+func (db *testDB) Insert(schemaKey, table string, row Row) error {
+	return util.Errorf("not supported")
+}
+
+func (db *testDB) Select(schemaKey, table string, key Row) (Row, error) {
+	return nil, util.Errorf("not supported")
+}
+
 func newTestDB() *testDB {
 	return &testDB{kv: map[string]interface{}{}}
 }
@@ -0,0 +1,230 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package structured
+
+import (
+	"bytes"
+	"encoding/gob"
+	"hash/fnv"
+	"reflect"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+func init() {
+	// Register the column value types which gob doesn't know about so
+	// that they may be encoded within the interface values of a column
+	// family. LatLong has no exported fields and isn't supported.
+	gob.Register(time.Time{})
+	gob.Register(IntegerSet{})
+	gob.Register(StringSet{})
+	gob.Register(IntegerMap{})
+	gob.Register(StringMap{})
+}
+
+// A Row is a table row as a map from column name to value. Values
+// must be of the Go type corresponding to the column's type (see
+// Column.Type); integer and float values may be of any size. Absent
+// columns are null.
+type Row map[string]interface{}
+
+// rowFamilySeparator separates the row key from the family key in the
+// keys of column families other than the default.
+const rowFamilySeparator = ":"
+
+// tablePrefix returns the prefix of the keys of all rows of the table.
+func (s *Schema) tablePrefix(t *Table) proto.Key {
+	return proto.Key(s.Key + "/" + t.Key + "/")
+}
+
+// rowKey returns the key of the row's default column family, formed
+// from the table prefix and the ordered encoding of the row's
+// primary key columns. If the first primary key column specifies
+// scatter, the encoded primary key is prefixed by two bytes of its
+// hash. Row keys of interleaved tables aren't yet supported.
+func (s *Schema) rowKey(t *Table, row Row) (proto.Key, error) {
+	var key []byte
+	for _, c := range t.primaryKey {
+		if c.Interleave {
+			return nil, util.Errorf("table %q: rows of interleaved tables are not yet supported", t.Name)
+		}
+		v, ok := row[c.Name]
+		if !ok || v == nil {
+			return nil, util.Errorf("table %q: missing primary key column %q", t.Name, c.Name)
+		}
+		nv, err := normalizeColumnValue(c, v)
+		if err != nil {
+			return nil, err
+		}
+		switch c.Type {
+		case columnTypeInteger:
+			key = encoding.EncodeVarint(key, nv.(int64))
+		case columnTypeFloat:
+			key = encoding.EncodeNumericFloat(key, nv.(float64))
+		case columnTypeString:
+			key = encoding.EncodeBytes(key, []byte(nv.(string)))
+		case columnTypeBlob:
+			key = encoding.EncodeBytes(key, nv.([]byte))
+		case columnTypeTime:
+			key = encoding.EncodeVarint(key, nv.(time.Time).UnixNano())
+		default:
+			return nil, util.Errorf("table %q, column %q: type %q cannot be part of a primary key",
+				t.Name, c.Name, c.Type)
+		}
+	}
+	if t.primaryKey[0].Scatter {
+		h := fnv.New32a()
+		h.Write(key)
+		sum := h.Sum32()
+		key = append([]byte{byte(sum >> 24), byte(sum >> 16)}, key...)
+	}
+	return append(s.tablePrefix(t), key...), nil
+}
+
+// familyKey returns the key of the row's column family given the key
+// of its default family.
+func familyKey(rowKey proto.Key, family string) proto.Key {
+	if family == "" {
+		return rowKey
+	}
+	return proto.Key(string(rowKey) + rowFamilySeparator + family)
+}
+
+// families returns the keys of the table's column families, the
+// default family first.
+func (t *Table) families() []string {
+	families := []string{""}
+	seen := map[string]struct{}{"": {}}
+	for _, c := range t.Columns {
+		if _, ok := seen[c.Family]; !ok {
+			seen[c.Family] = struct{}{}
+			families = append(families, c.Family)
+		}
+	}
+	return families
+}
+
+// encodeFamilies returns the gob-encoded values of the row's column
+// families, keyed by family key. Each value is a map from column key
+// to column value holding the non-null, non-primary key columns of the
+// family. The default family is always present, so that it marks the
+// existence of the row.
+func (t *Table) encodeFamilies(row Row) (map[string][]byte, error) {
+	for name := range row {
+		if _, ok := t.byName[name]; !ok {
+			return nil, util.Errorf("table %q: unknown column %q", t.Name, name)
+		}
+	}
+	values := map[string]map[string]interface{}{}
+	for _, family := range t.families() {
+		values[family] = map[string]interface{}{}
+	}
+	for _, c := range t.Columns {
+		v, ok := row[c.Name]
+		if c.PrimaryKey || !ok || v == nil {
+			continue
+		}
+		nv, err := normalizeColumnValue(c, v)
+		if err != nil {
+			return nil, err
+		}
+		values[c.Family][c.Key] = nv
+	}
+	encoded := map[string][]byte{}
+	for family, v := range values {
+		// TODO(pmattis): This is an inappropriate use of gob. Replace with
+		// something else.
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+			return nil, err
+		}
+		encoded[family] = buf.Bytes()
+	}
+	return encoded, nil
+}
+
+// decodeFamily adds the columns of the encoded column family to row.
+func (t *Table) decodeFamily(b []byte, row Row) error {
+	var v map[string]interface{}
+	if err := gob.NewDecoder(bytes.NewBuffer(b)).Decode(&v); err != nil {
+		return err
+	}
+	for key, value := range v {
+		c, ok := t.byKey[key]
+		if !ok {
+			// The column was dropped from the schema.
+			continue
+		}
+		row[c.Name] = value
+	}
+	return nil
+}
+
+// normalizeColumnValue converts v to the canonical Go type of the
+// column's type, returning an error if it's not of a matching type.
+func normalizeColumnValue(c *Column, v interface{}) (interface{}, error) {
+	rv := reflect.ValueOf(v)
+	switch c.Type {
+	case columnTypeInteger:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return rv.Int(), nil
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			return int64(rv.Uint()), nil
+		}
+	case columnTypeFloat:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return rv.Float(), nil
+		}
+	case columnTypeString:
+		if rv.Kind() == reflect.String {
+			return rv.String(), nil
+		}
+	case columnTypeBlob:
+		if b, ok := v.([]byte); ok {
+			return b, nil
+		}
+	case columnTypeTime:
+		if t, ok := v.(time.Time); ok {
+			return t, nil
+		}
+	case columnTypeIntegerSet:
+		if s, ok := v.(IntegerSet); ok {
+			return s, nil
+		}
+	case columnTypeStringSet:
+		if s, ok := v.(StringSet); ok {
+			return s, nil
+		}
+	case columnTypeIntegerMap:
+		if m, ok := v.(IntegerMap); ok {
+			return m, nil
+		}
+	case columnTypeStringMap:
+		if m, ok := v.(StringMap); ok {
+			return m, nil
+		}
+	case columnTypeLatLong:
+		return nil, util.Errorf("column %q: values of type %q are not yet supported", c.Name, c.Type)
+	}
+	return nil, util.Errorf("column %q: %v (%T) is not a valid value of type %q", c.Name, v, v, c.Type)
+}
@@ -115,6 +115,16 @@ type Column struct {
 	// a monotonically-increasing sequence starting at this field's
 	// value. If Auto is nil, the column does not auto-increment.
 	Auto *int64 `yaml:"auto_increment,omitempty"`
+
+	// Family is the key of the column family in which the column's
+	// values are stored. The values of all columns of a row sharing a
+	// family are stored together under a single key, so that columns
+	// which are frequently updated or large may be stored apart from
+	// the rest of the row. Like other keys, family keys are limited to
+	// 1-3 characters. Columns without a family belong to the default
+	// family. Primary key columns are encoded within the row key and
+	// have no family.
+	Family string `yaml:"family,omitempty"`
 }
 
 // Table contains the schema for a table. The Key should be a
@@ -383,6 +393,22 @@ func (s *Schema) validateColumn(c *Column, t *Table) error {
 		}
 	}
 
+	// Primary key columns are encoded in row keys and must be of an
+	// encodable type.
+	if c.PrimaryKey {
+		switch c.Type {
+		case columnTypeInteger, columnTypeFloat, columnTypeString, columnTypeBlob, columnTypeTime:
+		default:
+			return fmt.Errorf("type %q may not be part of a primary key", c.Type)
+		}
+		if c.Family != "" {
+			return fmt.Errorf("family may not be specified for primary key columns")
+		}
+	}
+	if len(c.Family) > maxKeyLength {
+		return fmt.Errorf("family %q is limited to 1-3 characters", c.Family)
+	}
+
 	// Auto-increment columns must be type integer!
 	if c.Auto != nil && c.Type != columnTypeInteger {
 		return fmt.Errorf("auto may only be specified with columns of type integer")
@@ -641,6 +667,7 @@ const (
 	columnOptionPrimaryKey     = "pk"
 	columnOptionForeignKey     = "fk"
 	columnOptionAutoIncrement  = "auto"
+	columnOptionFamily         = "family"
 	columnOptionFullTextIndex  = "fulltextindex"
 	columnOptionInterleave     = "interleave"
 	columnOptionLocationIndex  = "locationindex"
@@ -668,6 +695,11 @@ func setColumnOption(c *Column, key, value string) error {
 		} else {
 			*c.Auto = 1
 		}
+	case columnOptionFamily:
+		if len(value) == 0 {
+			return util.Errorf("column option %q must specify a family key", key)
+		}
+		c.Family = value
 	case columnOptionForeignKey:
 		if len(value) == 0 {
 			return util.Errorf("foreign key must specify reference as <Table>[.<Column>]")
@@ -29,6 +29,7 @@ import (
 func NewTestBaseContext() *base.Context {
 	return &base.Context{
 		Certs: security.EmbeddedCertsDir,
+		User:  security.NodeUser,
 	}
 }
 
@@ -18,10 +18,17 @@
 package ts
 
 import (
+	"sort"
+
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
+	gogoproto "github.com/gogo/protobuf/proto"
 )
 
+// queryBatchSize is the maximum number of keys scanned at once by a
+// query.
+const queryBatchSize = 1000
+
 // DB provides Cockroach's Time Series API.
 type DB struct {
 	kv *client.KV
@@ -34,6 +41,17 @@ func NewDB(kv *client.KV) *DB {
 	}
 }
 
+// StoreData stores each of the supplied time series on the server,
+// sampled at the supplied resolution.
+func (db *DB) StoreData(r Resolution, data []proto.TimeSeriesData) error {
+	for _, d := range data {
+		if err := db.storeData(r, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // storeData attempts to store the supplied time series data on the server.
 // Data will be sampled at the supplied resolution.
 func (db *DB) storeData(r Resolution, data proto.TimeSeriesData) error {
@@ -65,3 +83,80 @@ func (db *DB) storeData(r Resolution, data proto.TimeSeriesData) error {
 
 	return nil
 }
+
+// Query returns the datapoints recorded for the named series at the
+// supplied resolution between the start and end timestamps, expressed
+// in nanoseconds since the epoch. The value of each datapoint is the
+// average of the measurements in its sample. Samples from different
+// sources at the same timestamp are summed, so that the result
+// describes the series across all of its sources. Datapoints are
+// returned in timestamp order.
+func (db *DB) Query(name string, r Resolution, start, end int64) ([]*proto.TimeSeriesDatapoint, error) {
+	sums := map[int64]float64{}
+	startKey := MakeDataKey(name, "", r, start)
+	endKey := MakeDataKey(name, "", r, end+r.KeyDuration())
+	for {
+		call := client.ScanCall(startKey, endKey, queryBatchSize)
+		if err := db.kv.Run(call); err != nil {
+			return nil, err
+		}
+		rows := call.Reply.(*proto.ScanResponse).Rows
+		for i := range rows {
+			data, err := proto.InternalTimeSeriesDataFromValue(&rows[i].Value)
+			if err != nil {
+				return nil, err
+			}
+			for _, sample := range data.Samples {
+				ts := data.StartTimestampNanos + int64(sample.Offset)*data.SampleDurationNanos
+				if ts < start || ts > end {
+					continue
+				}
+				sums[ts] += sampleAverage(sample)
+			}
+		}
+		if len(rows) < queryBatchSize {
+			break
+		}
+		startKey = rows[len(rows)-1].Key.Next()
+	}
+
+	timestamps := make([]int64, 0, len(sums))
+	for ts := range sums {
+		timestamps = append(timestamps, ts)
+	}
+	sort.Sort(int64Slice(timestamps))
+	datapoints := make([]*proto.TimeSeriesDatapoint, len(timestamps))
+	for i, ts := range timestamps {
+		datapoints[i] = &proto.TimeSeriesDatapoint{
+			TimestampNanos: ts,
+			FloatValue:     gogoproto.Float32(float32(sums[ts])),
+		}
+	}
+	return datapoints, nil
+}
+
+// sampleAverage returns the average of the measurements aggregated in
+// the supplied sample.
+func sampleAverage(sample *proto.InternalTimeSeriesSample) float64 {
+	var sum float64
+	var count uint32
+	if sample.IntCount > 0 {
+		sum += float64(sample.GetIntSum())
+		count += sample.IntCount
+	}
+	if sample.FloatCount > 0 {
+		sum += float64(sample.GetFloatSum())
+		count += sample.FloatCount
+	}
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
+
+// int64Slice implements sort.Interface.
+type int64Slice []int64
+
+func (s int64Slice) Len() int           { return len(s) }
+func (s int64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int64Slice) Less(i, j int) bool { return s[i] < s[j] }
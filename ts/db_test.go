@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -71,8 +72,8 @@ func (tm *testModel) Start() {
 // underlying engine. Data is returned as a map of strings to proto.Values.
 func (tm *testModel) getActualData() map[string]*proto.Value {
 	// Scan over all TS Keys stored in the engine
-	startKey := keyDataPrefix
-	endKey := keyDataPrefix.PrefixEnd()
+	startKey := keys.KeyTimeseriesPrefix
+	endKey := keys.KeyTimeseriesPrefix.PrefixEnd()
 	keyValues, err := engine.MVCCScan(tm.Eng, startKey, endKey, 0, tm.Clock.Now(), true, nil)
 	if err != nil {
 		tm.t.Fatalf("error scanning TS data from engine: %s", err.Error())
@@ -253,3 +254,60 @@ func TestStoreTimeSeries(t *testing.T) {
 	tm.assertKeyCount(5)
 	tm.assertModelCorrect()
 }
+
+// TestQuery verifies that queries return the averages of the samples of
+// a series within the queried span, summed across sources.
+func TestQuery(t *testing.T) {
+	tm := newTestModel(t)
+	tm.Start()
+	defer tm.Stop()
+
+	sample := Resolution10s.SampleDuration()
+	start := int64(1428713840000000000)
+	tm.storeTimeSeriesData(Resolution10s, proto.TimeSeriesData{
+		Name:   "test.query",
+		Source: "a",
+		Datapoints: []*proto.TimeSeriesDatapoint{
+			intDatapoint(start, 10),
+			intDatapoint(start+1, 20),
+			intDatapoint(start+sample, 30),
+			intDatapoint(start+2*Resolution10s.KeyDuration(), 99),
+		},
+	})
+	tm.storeTimeSeriesData(Resolution10s, proto.TimeSeriesData{
+		Name:   "test.query",
+		Source: "b",
+		Datapoints: []*proto.TimeSeriesDatapoint{
+			floatDatapoint(start, 5),
+		},
+	})
+	tm.storeTimeSeriesData(Resolution10s, proto.TimeSeriesData{
+		Name: "test.query.other",
+		Datapoints: []*proto.TimeSeriesDatapoint{
+			intDatapoint(start, 1000),
+		},
+	})
+
+	testCases := []struct {
+		start, end int64
+		expected   []*proto.TimeSeriesDatapoint
+	}{
+		{start, start + sample, []*proto.TimeSeriesDatapoint{
+			floatDatapoint(start, 20),
+			floatDatapoint(start+sample, 30),
+		}},
+		{start + 1, start + sample, []*proto.TimeSeriesDatapoint{
+			floatDatapoint(start+sample, 30),
+		}},
+		{start - sample, start - 1, []*proto.TimeSeriesDatapoint{}},
+	}
+	for i, c := range testCases {
+		datapoints, err := tm.DB.Query("test.query", Resolution10s, c.start, c.end)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(datapoints, c.expected) {
+			t.Errorf("%d: expected %v; got %v", i, c.expected, datapoints)
+		}
+	}
+}
@@ -21,8 +21,8 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util/encoding"
 )
 
@@ -56,10 +56,6 @@ import (
 // the key duration:
 //
 // 		slot := (timestamp / keyDuration) // integer division
-var (
-	// keyDataPrefix is the key prefix for time series data keys.
-	keyDataPrefix = proto.MakeKey(engine.KeySystemPrefix, proto.Key("tsd"))
-)
 
 // MakeDataKey creates a time series data key for the given series name, source,
 // Resolution and timestamp. The timestamp is expressed in nanoseconds since the
@@ -69,7 +65,7 @@ func MakeDataKey(name string, source string, r Resolution, timestamp int64) prot
 	// Normalize timestamp into a timeslot before recording.
 	timeslot := timestamp / r.KeyDuration()
 
-	k := append(proto.Key(nil), keyDataPrefix...)
+	k := append(proto.Key(nil), keys.KeyTimeseriesPrefix...)
 	k = encoding.EncodeBytes(k, []byte(name))
 	k = encoding.EncodeVarint(k, int64(r))
 	k = encoding.EncodeVarint(k, timeslot)
@@ -88,10 +84,10 @@ func DecodeDataKey(key proto.Key) (string, string, Resolution, int64) {
 	)
 
 	// Detect and remove prefix.
-	if !bytes.HasPrefix(remainder, keyDataPrefix) {
+	if !bytes.HasPrefix(remainder, keys.KeyTimeseriesPrefix) {
 		panic(fmt.Sprintf("malformed time series data key %v: improper prefix", key))
 	}
-	remainder = remainder[len(keyDataPrefix):]
+	remainder = remainder[len(keys.KeyTimeseriesPrefix):]
 
 	// Decode series name.
 	remainder, name = encoding.DecodeBytes(remainder)
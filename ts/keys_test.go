@@ -21,6 +21,8 @@ import (
 	"bytes"
 	"reflect"
 	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
 )
 
 func TestDataKeys(t *testing.T) {
@@ -56,7 +58,7 @@ func TestDataKeys(t *testing.T) {
 
 	for i, tc := range testCases {
 		encoded := MakeDataKey(tc.name, tc.source, tc.resolution, tc.timestamp)
-		if !bytes.HasPrefix(encoded, keyDataPrefix) {
+		if !bytes.HasPrefix(encoded, keys.KeyTimeseriesPrefix) {
 			t.Errorf("case %d, encoded key %v did not have time series data prefix", i, encoded)
 		}
 		if a, e := len(encoded), tc.expectedLen; a != e {
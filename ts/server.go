@@ -0,0 +1,104 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package ts
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// URLPrefix is the prefix for all time series endpoints hosted by
+	// the server.
+	URLPrefix = "/ts/"
+	// URLQuery is the endpoint for querying time series data. The
+	// series is named by the "name" parameter; the optional "start"
+	// and "end" parameters bound the query in nanoseconds since the
+	// epoch and default to the last hour.
+	URLQuery = URLPrefix + "query"
+
+	// defaultQueryDuration is the span of a query which does not
+	// specify its start.
+	defaultQueryDuration = time.Hour
+)
+
+// Server handles HTTP requests for time series data.
+type Server struct {
+	db *DB
+}
+
+// NewServer instantiates a new Server which services requests with
+// data from the supplied DB.
+func NewServer(db *DB) *Server {
+	return &Server{db: db}
+}
+
+// ServeHTTP implements the http.Handler interface. Queries return a
+// TimeSeriesData message holding the datapoints of the series.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != URLQuery {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	name := r.FormValue("name")
+	if name == "" {
+		http.Error(w, "no series name specified", http.StatusBadRequest)
+		return
+	}
+	end := time.Now().UnixNano()
+	if v := r.FormValue("end"); v != "" {
+		var err error
+		if end, err = strconv.ParseInt(v, 10, 64); err != nil {
+			http.Error(w, "invalid end: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	start := end - int64(defaultQueryDuration)
+	if v := r.FormValue("start"); v != "" {
+		var err error
+		if start, err = strconv.ParseInt(v, 10, 64); err != nil {
+			http.Error(w, "invalid start: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if start > end {
+		http.Error(w, "start must not follow end", http.StatusBadRequest)
+		return
+	}
+
+	datapoints, err := s.db.Query(name, Resolution10s, start, end)
+	if err != nil {
+		log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := &proto.TimeSeriesData{Name: name, Datapoints: datapoints}
+	b, contentType, err := util.MarshalResponse(r, data, []util.EncodingType{util.JSONEncoding, util.ProtoEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, contentType)
+	w.Write(b)
+}
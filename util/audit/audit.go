@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// Package audit records administrative operations, configuration
+// changes and denied requests to an audit log. Entries are written as
+// JSON objects, one per line. The audit log is disabled until an
+// output is set with SetOutput.
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// Audited events.
+const (
+	// EventAdmin is an admin command, such as AdminSplit or AdminMerge.
+	EventAdmin = "admin"
+	// EventChangeReplicas is the addition or removal of a replica.
+	EventChangeReplicas = "change_replicas"
+	// EventConfigChange is a write to accounting, permission or zone
+	// configs.
+	EventConfigChange = "config_change"
+	// EventAuthFailed is a request which failed to authenticate.
+	EventAuthFailed = "auth_failed"
+	// EventPermissionDenied is a request denied by the permission configs.
+	EventPermissionDenied = "permission_denied"
+	// EventWrite is a write under one of the prefixes set with
+	// SetWritePrefixes.
+	EventWrite = "write"
+)
+
+// An Entry is a single record of the audit log.
+type Entry struct {
+	Time   time.Time    `json:"time"`
+	NodeID proto.NodeID `json:"node_id,omitempty"`
+	User   string       `json:"user,omitempty"`
+	Event  string       `json:"event"`
+	Method string       `json:"method,omitempty"`
+	Key    string       `json:"key,omitempty"`
+	EndKey string       `json:"end_key,omitempty"`
+	Detail string       `json:"detail,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+var logger struct {
+	sync.Mutex
+	w             io.Writer
+	nodeID        proto.NodeID
+	writePrefixes []proto.Key
+}
+
+// SetOutput sets the writer the audit log is written to. A nil
+// writer disables the audit log.
+func SetOutput(w io.Writer) {
+	logger.Lock()
+	defer logger.Unlock()
+	logger.w = w
+}
+
+// SetNodeID sets the node ID recorded in entries.
+func SetNodeID(nodeID proto.NodeID) {
+	logger.Lock()
+	defer logger.Unlock()
+	logger.nodeID = nodeID
+}
+
+// SetWritePrefixes sets the key prefixes under which all writes are
+// audited.
+func SetWritePrefixes(prefixes []proto.Key) {
+	logger.Lock()
+	defer logger.Unlock()
+	logger.writePrefixes = prefixes
+}
+
+// Log records the entry, setting its time and node ID.
+func Log(e Entry) {
+	logger.Lock()
+	defer logger.Unlock()
+	if logger.w == nil {
+		return
+	}
+	e.Time = time.Now().UTC()
+	e.NodeID = logger.nodeID
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.Errorf("unable to encode audit log entry %+v: %s", e, err)
+		return
+	}
+	if _, err := logger.w.Write(append(b, '\n')); err != nil {
+		log.Errorf("unable to write audit log entry: %s", err)
+	}
+}
+
+// LogRequest records an entry for the request, with the outcome
+// given by err.
+func LogRequest(event string, args proto.Request, err error) {
+	header := args.Header()
+	e := Entry{
+		User:   header.User,
+		Event:  event,
+		Method: args.Method().String(),
+		Key:    header.Key.String(),
+	}
+	if header.EndKey != nil {
+		e.EndKey = header.EndKey.String()
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	Log(e)
+}
+
+// LogWrite records an entry for the write request if its key falls
+// under one of the write prefixes.
+func LogWrite(args proto.Request) {
+	logger.Lock()
+	audited := false
+	if logger.w != nil {
+		for _, prefix := range logger.writePrefixes {
+			if bytes.HasPrefix(args.Header().Key, prefix) {
+				audited = true
+				break
+			}
+		}
+	}
+	logger.Unlock()
+	if audited {
+		LogRequest(EventWrite, args, nil)
+	}
+}
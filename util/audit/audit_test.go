@@ -0,0 +1,96 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// readEntries decodes the entries written to buf.
+func readEntries(t *testing.T, buf *bytes.Buffer) []Entry {
+	var entries []Entry
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("unable to decode entry %q: %s", line, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestLogRequest(t *testing.T) {
+	buf := &bytes.Buffer{}
+	SetOutput(buf)
+	SetNodeID(3)
+	defer func() {
+		SetOutput(nil)
+		SetNodeID(0)
+	}()
+
+	args := &proto.AdminSplitRequest{}
+	args.User = "root"
+	args.Key = proto.Key("a")
+	err := util.Errorf("split failed")
+	LogRequest(EventAdmin, args, err)
+
+	entries := readEntries(t, buf)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry; got %d", len(entries))
+	}
+	e := entries[0]
+	if e.NodeID != 3 || e.User != "root" || e.Event != EventAdmin || e.Method != proto.AdminSplit.String() ||
+		e.Key != proto.Key("a").String() || e.Error != err.Error() || e.Time.IsZero() {
+		t.Errorf("unexpected entry %+v", e)
+	}
+}
+
+func TestLogWrite(t *testing.T) {
+	buf := &bytes.Buffer{}
+	SetOutput(buf)
+	SetWritePrefixes([]proto.Key{proto.Key("audited/")})
+	defer func() {
+		SetOutput(nil)
+		SetWritePrefixes(nil)
+	}()
+
+	for _, key := range []string{"audited/a", "other/a", "audited/b"} {
+		args := &proto.PutRequest{}
+		args.Key = proto.Key(key)
+		LogWrite(args)
+	}
+
+	entries := readEntries(t, buf)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries; got %d", len(entries))
+	}
+	for i, key := range []string{"audited/a", "audited/b"} {
+		if entries[i].Event != EventWrite || entries[i].Key != proto.Key(key).String() {
+			t.Errorf("%d: unexpected entry %+v", i, entries[i])
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// Package fault injects faults into messages sent between pairs of
+// endpoints, so that tests may deterministically drop, delay, reorder
+// or partition the traffic of a transport. A transport consults its
+// Injector before delivering each message and applies the returned
+// Action.
+package fault
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Any matches every endpoint when supplied as either endpoint of a
+// rule.
+const Any = "*"
+
+// A Rule describes the faults injected into the messages sent from one
+// endpoint to another.
+type Rule struct {
+	// DropRate is the probability with which each message is dropped.
+	// A rate of 1 drops all messages, partitioning the endpoints.
+	DropRate float64
+	// Delay is the delay with which each message is delivered.
+	Delay time.Duration
+	// Jitter is the upper bound of a random delay added to Delay.
+	// Messages sent with jitter may be delivered out of order.
+	Jitter time.Duration
+}
+
+// An Action is the fault injected into a single message.
+type Action struct {
+	Drop  bool          // Drop the message
+	Delay time.Duration // Deliver the message after the delay
+}
+
+type pair struct {
+	from, to string
+}
+
+// An Injector holds the rules for faults injected into messages
+// between pairs of endpoints. A nil Injector injects no faults. It is
+// safe for concurrent use.
+type Injector struct {
+	mu    sync.Mutex
+	rand  *rand.Rand
+	rules map[pair]Rule
+}
+
+// NewInjector returns a new Injector without any rules, whose random
+// faults are drawn from a source with the specified seed.
+func NewInjector(seed int64) *Injector {
+	return &Injector{
+		rand:  rand.New(rand.NewSource(seed)),
+		rules: map[pair]Rule{},
+	}
+}
+
+// Set sets the rule for messages sent from one endpoint to another.
+// Either endpoint may be Any. A rule for a specific pair takes
+// precedence over one for Any.
+func (in *Injector) Set(from, to string, r Rule) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.rules[pair{from, to}] = r
+}
+
+// Clear removes the rule for messages sent from one endpoint to
+// another.
+func (in *Injector) Clear(from, to string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	delete(in.rules, pair{from, to})
+}
+
+// Partition drops all messages sent in either direction between each
+// endpoint in a and each endpoint in b.
+func (in *Injector) Partition(a, b []string) {
+	for _, x := range a {
+		for _, y := range b {
+			in.Set(x, y, Rule{DropRate: 1})
+			in.Set(y, x, Rule{DropRate: 1})
+		}
+	}
+}
+
+// Isolate drops all messages sent to or from the endpoint.
+func (in *Injector) Isolate(endpoint string) {
+	in.Partition([]string{endpoint}, []string{Any})
+}
+
+// Heal removes all rules.
+func (in *Injector) Heal() {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.rules = map[pair]Rule{}
+}
+
+// Action returns the fault to inject into a message sent from one
+// endpoint to another.
+func (in *Injector) Action(from, to string) Action {
+	if in == nil {
+		return Action{}
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	r, ok := in.rules[pair{from, to}]
+	if !ok {
+		if r, ok = in.rules[pair{from, Any}]; !ok {
+			if r, ok = in.rules[pair{Any, to}]; !ok {
+				return Action{}
+			}
+		}
+	}
+	if r.DropRate >= 1 || (r.DropRate > 0 && in.rand.Float64() < r.DropRate) {
+		return Action{Drop: true}
+	}
+	a := Action{Delay: r.Delay}
+	if r.Jitter > 0 {
+		a.Delay += time.Duration(in.rand.Int63n(int64(r.Jitter)))
+	}
+	return a
+}
@@ -0,0 +1,98 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package fault
+
+import (
+	"testing"
+	"time"
+)
+
+// TestInjectorRules verifies the precedence of rules and the effect
+// of partitioning, isolating and healing.
+func TestInjectorRules(t *testing.T) {
+	var nilInjector *Injector
+	if a := nilInjector.Action("a", "b"); a != (Action{}) {
+		t.Errorf("expected nil injector to inject no faults; got %+v", a)
+	}
+
+	in := NewInjector(0)
+	in.Set("a", Any, Rule{Delay: time.Second})
+	in.Set("a", "b", Rule{Delay: time.Millisecond})
+	in.Set(Any, "c", Rule{DropRate: 1})
+	testCases := []struct {
+		from, to string
+		expected Action
+	}{
+		{"a", "b", Action{Delay: time.Millisecond}},
+		{"a", "c", Action{Delay: time.Second}},
+		{"b", "c", Action{Drop: true}},
+		{"b", "a", Action{}},
+	}
+	for i, test := range testCases {
+		if a := in.Action(test.from, test.to); a != test.expected {
+			t.Errorf("%d: expected %+v from %s to %s; got %+v", i, test.expected, test.from, test.to, a)
+		}
+	}
+
+	in.Heal()
+	in.Partition([]string{"a"}, []string{"b", "c"})
+	in.Isolate("d")
+	for i, test := range []struct {
+		from, to string
+		drop     bool
+	}{
+		{"a", "b", true},
+		{"c", "a", true},
+		{"b", "c", false},
+		{"d", "b", true},
+		{"b", "d", true},
+	} {
+		if a := in.Action(test.from, test.to); a.Drop != test.drop {
+			t.Errorf("%d: expected drop %t from %s to %s; got %+v", i, test.drop, test.from, test.to, a)
+		}
+	}
+
+	in.Clear("a", "b")
+	if a := in.Action("a", "b"); a.Drop {
+		t.Errorf("expected cleared rule to inject no faults; got %+v", a)
+	}
+}
+
+// TestInjectorRandomFaults verifies that random faults are
+// deterministic for a seed and bounded by their rule.
+func TestInjectorRandomFaults(t *testing.T) {
+	r := Rule{DropRate: 0.5, Delay: time.Millisecond, Jitter: time.Millisecond}
+	in1, in2 := NewInjector(1), NewInjector(1)
+	in1.Set("a", "b", r)
+	in2.Set("a", "b", r)
+	var drops int
+	for i := 0; i < 1000; i++ {
+		a1, a2 := in1.Action("a", "b"), in2.Action("a", "b")
+		if a1 != a2 {
+			t.Fatalf("%d: expected identical faults for identical seeds; got %+v and %+v", i, a1, a2)
+		}
+		if a1.Drop {
+			drops++
+		} else if a1.Delay < r.Delay || a1.Delay >= r.Delay+r.Jitter {
+			t.Errorf("%d: expected delay in [%s, %s); got %s", i, r.Delay, r.Delay+r.Jitter, a1.Delay)
+		}
+	}
+	if drops < 400 || drops > 600 {
+		t.Errorf("expected about half of the messages dropped; got %d of 1000", drops)
+	}
+}
@@ -19,6 +19,7 @@ package hlc
 
 import (
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -202,3 +203,42 @@ func ExampleManualClock() {
 		log.Fatalf("manual clock error")
 	}
 }
+
+func TestDetectJumps(t *testing.T) {
+	var offset, samples int64
+	c := NewClock(func() int64 {
+		atomic.AddInt64(&samples, 1)
+		return time.Now().UnixNano() + atomic.LoadInt64(&offset)
+	})
+	jumps := make(chan time.Duration, 10)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		c.DetectJumps(time.Millisecond, time.Minute, stop, func(jump time.Duration) {
+			jumps <- jump
+		})
+		close(done)
+	}()
+	defer func() {
+		close(stop)
+		<-done
+	}()
+
+	// Wait for the initial sample to be taken so that the first jump
+	// happens in between samples.
+	for atomic.LoadInt64(&samples) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for _, jump := range []time.Duration{time.Hour, -time.Hour} {
+		atomic.AddInt64(&offset, int64(jump))
+		detected := <-jumps
+		if detected < jump-time.Minute || detected > jump+time.Minute {
+			t.Errorf("expected a jump of about %s; got %s", jump, detected)
+		}
+	}
+	select {
+	case jump := <-jumps:
+		t.Errorf("unexpected jump %s", jump)
+	default:
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package hlc
+
+import "time"
+
+// DetectJumps samples the clock's physical time, waiting interval
+// after each sample, and calls onJump whenever the clock has moved by
+// more than tolerance from the interval since the previous sample. A
+// positive jump is forward, a negative one backward. Small
+// adjustments, e.g. those slewed by NTP, go unreported. As there is no
+// clock to measure the wait by other than the wall clock, a sample
+// which is delayed by more than tolerance, e.g. by a stalled process,
+// is reported as a forward jump. DetectJumps returns once stop is
+// closed.
+func (c *Clock) DetectJumps(interval, tolerance time.Duration, stop <-chan struct{},
+	onJump func(jump time.Duration)) {
+	last := c.PhysicalNow()
+	for {
+		select {
+		case <-time.After(interval):
+			now := c.PhysicalNow()
+			jump := time.Duration(now-last) - interval
+			if jump > tolerance || jump < -tolerance {
+				onJump(jump)
+			}
+			last = now
+		case <-stop:
+			return
+		}
+	}
+}
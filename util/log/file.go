@@ -0,0 +1,121 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package log
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// program is the name prefixed to structured log files.
+var program = filepath.Base(os.Args[0])
+
+// structuredSuffix is the suffix of structured log file names.
+const structuredSuffix = ".json.log"
+
+// A rotatingFile writes to a series of files in dir, starting a new
+// file once the current one exceeds maxSize bytes or maxAge in age.
+// Only the newest retain files are kept. Files are named by program
+// and creation time, so that their names sort by age.
+type rotatingFile struct {
+	dir     string
+	maxSize uint64
+	maxAge  time.Duration
+	retain  int
+
+	file    *os.File
+	nbytes  uint64
+	created time.Time
+}
+
+// Write implements io.Writer, rotating the file first if required.
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	if f.file == nil || f.needsRotation(uint64(len(p)), time.Now()) {
+		if err := f.rotate(time.Now()); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.nbytes += uint64(n)
+	return n, err
+}
+
+// needsRotation returns whether writing n more bytes at time now
+// should start a new file.
+func (f *rotatingFile) needsRotation(n uint64, now time.Time) bool {
+	if f.maxSize > 0 && f.nbytes > 0 && f.nbytes+n > f.maxSize {
+		return true
+	}
+	return f.maxAge > 0 && now.Sub(f.created) >= f.maxAge
+}
+
+// rotate closes the current file, if any, and creates a new one,
+// removing the oldest files beyond the retention limit.
+func (f *rotatingFile) rotate(now time.Time) error {
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+	if err := os.MkdirAll(f.dir, 0755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s.%s.%d%s", program, now.Format("20060102-150405.000000"), os.Getpid(), structuredSuffix)
+	file, err := os.OpenFile(filepath.Join(f.dir, name), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	f.file, f.nbytes, f.created = file, 0, now
+	return f.prune()
+}
+
+// prune removes all but the newest retain structured log files.
+func (f *rotatingFile) prune() error {
+	if f.retain <= 0 {
+		return nil
+	}
+	infos, err := ioutil.ReadDir(f.dir)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, info := range infos {
+		if name := info.Name(); strings.HasPrefix(name, program+".") && strings.HasSuffix(name, structuredSuffix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for len(names) > f.retain {
+		if err := os.Remove(filepath.Join(f.dir, names[0])); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// Sync commits the current file to stable storage.
+func (f *rotatingFile) Sync() {
+	if f.file != nil {
+		f.file.Sync()
+	}
+}
@@ -17,7 +17,11 @@
 
 package log
 
-import "github.com/golang/glog"
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+)
 
 func init() {
 	glog.CopyStandardLogTo("INFO")
@@ -35,68 +39,103 @@ func FatalOnPanic() {
 
 // Info logs to the INFO log.
 // Arguments are handled in the manner of fmt.Print; a newline is appended if missing.
-var Info = glog.Info
+func Info(args ...interface{}) {
+	logDepth(nil, 1, infoLog, fmt.Sprint(args...))
+}
 
 // Infof logs to the INFO log.
 // Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
-var Infof = glog.Infof
+func Infof(format string, args ...interface{}) {
+	logDepth(nil, 1, infoLog, fmt.Sprintf(format, args...))
+}
 
 // Infoln logs to the INFO log.
 // Arguments are handled in the manner of fmt.Println; a newline is appended if missing.
-var Infoln = glog.Infoln
+func Infoln(args ...interface{}) {
+	logDepth(nil, 1, infoLog, fmt.Sprintln(args...))
+}
 
 // InfoDepth logs to the INFO log, ofsetting the caller's stack frame by 'depth'
-var InfoDepth = glog.InfoDepth
+func InfoDepth(depth int, args ...interface{}) {
+	logDepth(nil, depth+1, infoLog, fmt.Sprint(args...))
+}
 
 // Warning logs to the INFO and WARNING logs.
 // Arguments are handled in the manner of fmt.Print; a newline is appended if missing.
-var Warning = glog.Warning
+func Warning(args ...interface{}) {
+	logDepth(nil, 1, warningLog, fmt.Sprint(args...))
+}
 
 // Warningf logs to the INFO and WARNING logs.
 // Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
-var Warningf = glog.Warningf
+func Warningf(format string, args ...interface{}) {
+	logDepth(nil, 1, warningLog, fmt.Sprintf(format, args...))
+}
 
 // Warningln logs to the INFO and WARNING logs.
 // Arguments are handled in the manner of fmt.Println; a newline is appended if missing.
-var Warningln = glog.Warningln
+func Warningln(args ...interface{}) {
+	logDepth(nil, 1, warningLog, fmt.Sprintln(args...))
+}
 
 // WarningDepth logs to the INFO and WARNING logs, ofsetting the caller's stack frame by 'depth'
-var WarningDepth = glog.WarningDepth
+func WarningDepth(depth int, args ...interface{}) {
+	logDepth(nil, depth+1, warningLog, fmt.Sprint(args...))
+}
 
 // Error logs to the INFO, WARNING, and ERROR logs.
 // Arguments are handled in the manner of fmt.Print; a newline is appended if missing.
-var Error = glog.Error
+func Error(args ...interface{}) {
+	logDepth(nil, 1, errorLog, fmt.Sprint(args...))
+}
 
 // Errorf logs to the INFO, WARNING, and ERROR logs.
 // Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
-var Errorf = glog.Errorf
+func Errorf(format string, args ...interface{}) {
+	logDepth(nil, 1, errorLog, fmt.Sprintf(format, args...))
+}
 
 // Errorln logs to the INFO, WARNING, and ERROR logs.
 // Arguments are handled in the manner of fmt.Println; a newline is appended if missing.
-var Errorln = glog.Errorln
+func Errorln(args ...interface{}) {
+	logDepth(nil, 1, errorLog, fmt.Sprintln(args...))
+}
 
 // ErrorDepth logs to the INFO, WARNING, and ERROR logs, ofsetting the caller's stack
 // frame by 'depth'
-var ErrorDepth = glog.ErrorDepth
+func ErrorDepth(depth int, args ...interface{}) {
+	logDepth(nil, depth+1, errorLog, fmt.Sprint(args...))
+}
 
 // Fatal logs to the INFO, WARNING, ERROR, and FATAL logs,
 // including a stack trace of all running goroutines, then calls os.Exit(255).
 // Arguments are handled in the manner of fmt.Print; a newline is appended if missing.
-var Fatal = glog.Fatal
+func Fatal(args ...interface{}) {
+	logDepth(nil, 1, fatalLog, fmt.Sprint(args...))
+}
 
 // Fatalf logs to the INFO, WARNING, ERROR, and FATAL logs,
 // including a stack trace of all running goroutines, then calls os.Exit(255).
 // Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
-var Fatalf = glog.Fatalf
+func Fatalf(format string, args ...interface{}) {
+	logDepth(nil, 1, fatalLog, fmt.Sprintf(format, args...))
+}
 
 // Fatalln logs to the INFO, WARNING, ERROR, and FATAL logs,
 // including a stack trace of all running goroutines, then calls os.Exit(255).
 // Arguments are handled in the manner of fmt.Println; a newline is appended if missing.
-var Fatalln = glog.Fatalln
+func Fatalln(args ...interface{}) {
+	logDepth(nil, 1, fatalLog, fmt.Sprintln(args...))
+}
 
 // FatalDepth logs to the INFO, WARNING, and ERROR, and FATAL logs, ofsetting the caller's stack
 // frame by 'depth', then calls os.Exit(255).
-var FatalDepth = glog.FatalDepth
+func FatalDepth(depth int, args ...interface{}) {
+	logDepth(nil, depth+1, fatalLog, fmt.Sprint(args...))
+}
 
-// V wraps glog.V. See that documentation for details.
-var V = glog.V
+// Flush flushes all pending log I/O.
+func Flush() {
+	glog.Flush()
+	flushStructured()
+}
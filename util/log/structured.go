@@ -0,0 +1,368 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// A Field is the type of the context keys under which structured log
+// tags are stored. Tags are attached to a context with
+// context.WithValue, e.g. context.WithValue(ctx, log.NodeID, id), and
+// included in every entry logged with that context via Infoc et al.
+type Field int
+
+// The tags which may be attached to log entries.
+const (
+	NodeID  Field = iota // the ID of the node
+	StoreID              // the ID of the store
+	RaftID               // the Raft ID of the range
+	TxnID                // the ID of the transaction
+	Method               // the method of the request
+	Key                  // the key of the request
+	maxField
+)
+
+var fieldNames = [...]string{
+	NodeID:  "node",
+	StoreID: "store",
+	RaftID:  "raft",
+	TxnID:   "txn",
+	Method:  "method",
+	Key:     "key",
+}
+
+// String returns the name under which the field is logged.
+func (f Field) String() string {
+	return fieldNames[f]
+}
+
+type severity int
+
+const (
+	infoLog severity = iota
+	warningLog
+	errorLog
+	fatalLog
+)
+
+var severityNames = [...]string{
+	infoLog:    "INFO",
+	warningLog: "WARNING",
+	errorLog:   "ERROR",
+	fatalLog:   "FATAL",
+}
+
+// An Entry is a single structured log entry, as written one JSON
+// object per line when the log format is "json".
+type Entry struct {
+	Time     time.Time   `json:"time"`
+	Severity string      `json:"severity"`
+	File     string      `json:"file"`
+	Line     int         `json:"line"`
+	NodeID   interface{} `json:"node_id,omitempty"`
+	StoreID  interface{} `json:"store_id,omitempty"`
+	RaftID   interface{} `json:"raft_id,omitempty"`
+	TxnID    interface{} `json:"txn_id,omitempty"`
+	Method   interface{} `json:"method,omitempty"`
+	Key      interface{} `json:"key,omitempty"`
+	Message  string      `json:"message"`
+	Stack    string      `json:"stack,omitempty"`
+}
+
+// tag returns a pointer to the entry's value for field f.
+func (e *Entry) tag(f Field) *interface{} {
+	switch f {
+	case NodeID:
+		return &e.NodeID
+	case StoreID:
+		return &e.StoreID
+	case RaftID:
+		return &e.RaftID
+	case TxnID:
+		return &e.TxnID
+	case Method:
+		return &e.Method
+	default:
+		return &e.Key
+	}
+}
+
+// tagValue returns the value to log for field f. Transaction IDs,
+// methods and keys are formatted as strings so that they are
+// readable in the JSON output; IDs are logged as is.
+func tagValue(f Field, v interface{}) interface{} {
+	if f < TxnID {
+		return v
+	}
+	if b, ok := v.([]byte); ok {
+		return fmt.Sprintf("%x", b)
+	}
+	return fmt.Sprint(v)
+}
+
+// Format is the format in which log entries are written.
+type Format int
+
+const (
+	// TextFormat writes entries as glog-style plaintext lines, with
+	// tags inserted ahead of the message.
+	TextFormat Format = iota
+	// JSONFormat writes entries as JSON objects, one per line.
+	JSONFormat
+)
+
+// String implements flag.Value.
+func (f *Format) String() string {
+	if *f == JSONFormat {
+		return "json"
+	}
+	return "text"
+}
+
+// Set implements flag.Value.
+func (f *Format) Set(value string) error {
+	switch value {
+	case "text":
+		*f = TextFormat
+	case "json":
+		*f = JSONFormat
+	default:
+		return fmt.Errorf("unknown log format %q; expected \"text\" or \"json\"", value)
+	}
+	return nil
+}
+
+// maxSizeFlag sets the size at which both glog's files and structured
+// log files are rotated.
+type maxSizeFlag struct{}
+
+func (maxSizeFlag) String() string {
+	return fmt.Sprint(glog.MaxSize)
+}
+
+func (maxSizeFlag) Set(value string) error {
+	var size uint64
+	if _, err := fmt.Sscan(value, &size); err != nil {
+		return err
+	}
+	glog.MaxSize = size
+	structured.Lock()
+	structured.file.maxSize = size
+	structured.Unlock()
+	return nil
+}
+
+// structured holds the output of JSON formatted entries.
+var structured struct {
+	sync.Mutex
+	format Format
+	file   rotatingFile
+	out    io.Writer // if set, overrides the file and stderr outputs
+}
+
+func init() {
+	structured.file.maxSize = glog.MaxSize
+	structured.file.maxAge = 24 * time.Hour
+	structured.file.retain = 10
+	flag.Var(&structured.format, "log-format", "format of log entries: \"text\" for glog-style "+
+		"lines or \"json\" for structured entries, one JSON object per line")
+	flag.Var(maxSizeFlag{}, "log-file-max-size", "size in bytes at which log files are rotated")
+	flag.DurationVar(&structured.file.maxAge, "log-file-max-age", structured.file.maxAge,
+		"age at which structured log files are rotated; 0 disables time-based rotation")
+	flag.IntVar(&structured.file.retain, "log-file-retention", structured.file.retain,
+		"number of structured log files retained in the log directory; 0 retains all")
+}
+
+// SetFormat sets the format in which entries are logged.
+func SetFormat(f Format) {
+	structured.Lock()
+	structured.format = f
+	structured.Unlock()
+}
+
+// boolFlag returns the value of the named boolean flag, as registered
+// by glog.
+func boolFlag(name string) bool {
+	f := flag.Lookup(name)
+	return f != nil && f.Value.String() == "true"
+}
+
+// logDir returns the directory structured log files are written to,
+// which is the same as glog's.
+func logDir() string {
+	if f := flag.Lookup("log_dir"); f != nil && f.Value.String() != "" {
+		return f.Value.String()
+	}
+	return os.TempDir()
+}
+
+// logDepth logs msg at severity s with the tags of ctx, which may be
+// nil. The caller's location is offset by depth stack frames.
+func logDepth(ctx context.Context, depth int, s severity, msg string) {
+	structured.Lock()
+	format := structured.format
+	structured.Unlock()
+	if format == JSONFormat {
+		logJSON(ctx, depth+1, s, msg)
+		return
+	}
+	if ctx != nil {
+		msg = tagPrefix(ctx) + msg
+	}
+	switch s {
+	case infoLog:
+		glog.InfoDepth(depth+1, msg)
+	case warningLog:
+		glog.WarningDepth(depth+1, msg)
+	case errorLog:
+		glog.ErrorDepth(depth+1, msg)
+	default:
+		glog.FatalDepth(depth+1, msg)
+	}
+}
+
+// tagPrefix returns the tags of ctx formatted for plaintext entries,
+// e.g. "[node=1,store=2] ".
+func tagPrefix(ctx context.Context) string {
+	var buf bytes.Buffer
+	for f := Field(0); f < maxField; f++ {
+		v := ctx.Value(f)
+		if v == nil {
+			continue
+		}
+		if buf.Len() == 0 {
+			buf.WriteByte('[')
+		} else {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, "%s=%v", f, tagValue(f, v))
+	}
+	if buf.Len() == 0 {
+		return ""
+	}
+	buf.WriteString("] ")
+	return buf.String()
+}
+
+// makeEntry creates an entry for msg, logged at the location offset by
+// depth stack frames.
+func makeEntry(ctx context.Context, depth int, s severity, msg string) Entry {
+	e := Entry{
+		Time:     time.Now(),
+		Severity: severityNames[s],
+		Message:  strings.TrimSuffix(msg, "\n"),
+	}
+	if _, file, line, ok := runtime.Caller(depth + 1); ok {
+		e.File, e.Line = filepath.Base(file), line
+	} else {
+		e.File, e.Line = "???", 1
+	}
+	if ctx != nil {
+		for f := Field(0); f < maxField; f++ {
+			if v := ctx.Value(f); v != nil {
+				*e.tag(f) = tagValue(f, v)
+			}
+		}
+	}
+	return e
+}
+
+// logJSON writes msg as a JSON entry. Fatal entries include the stacks
+// of all goroutines and exit the process.
+func logJSON(ctx context.Context, depth int, s severity, msg string) {
+	e := makeEntry(ctx, depth+1, s, msg)
+	if s == fatalLog {
+		buf := make([]byte, 1<<20)
+		e.Stack = string(buf[:runtime.Stack(buf, true)])
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		b, _ = json.Marshal(Entry{Time: e.Time, Severity: e.Severity, File: e.File, Line: e.Line,
+			Message: fmt.Sprintf("unable to encode log entry %q: %s", e.Message, err)})
+	}
+	b = append(b, '\n')
+
+	structured.Lock()
+	defer structured.Unlock()
+	if structured.out != nil {
+		structured.out.Write(b)
+	} else {
+		toStderr := boolFlag("logtostderr")
+		if toStderr || boolFlag("alsologtostderr") || s >= errorLog {
+			os.Stderr.Write(b)
+		}
+		if !toStderr {
+			if structured.file.dir == "" {
+				structured.file.dir = logDir()
+			}
+			if _, err := structured.file.Write(b); err != nil {
+				fmt.Fprintf(os.Stderr, "log: unable to write structured log: %s\n", err)
+			}
+		}
+	}
+	if s == fatalLog {
+		structured.file.Sync()
+		os.Exit(255)
+	}
+}
+
+// flushStructured syncs the structured log file to disk.
+func flushStructured() {
+	structured.Lock()
+	defer structured.Unlock()
+	structured.file.Sync()
+}
+
+// Infoc logs to the INFO log, including the tags of ctx.
+// Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
+func Infoc(ctx context.Context, format string, args ...interface{}) {
+	logDepth(ctx, 1, infoLog, fmt.Sprintf(format, args...))
+}
+
+// Warningc logs to the INFO and WARNING logs, including the tags of ctx.
+// Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
+func Warningc(ctx context.Context, format string, args ...interface{}) {
+	logDepth(ctx, 1, warningLog, fmt.Sprintf(format, args...))
+}
+
+// Errorc logs to the INFO, WARNING, and ERROR logs, including the tags of ctx.
+// Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
+func Errorc(ctx context.Context, format string, args ...interface{}) {
+	logDepth(ctx, 1, errorLog, fmt.Sprintf(format, args...))
+}
+
+// Fatalc logs to the INFO, WARNING, ERROR, and FATAL logs, including
+// the tags of ctx, then calls os.Exit(255).
+// Arguments are handled in the manner of fmt.Printf; a newline is appended if missing.
+func Fatalc(ctx context.Context, format string, args ...interface{}) {
+	logDepth(ctx, 1, fatalLog, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,186 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// captureJSON directs JSON formatted entries to a buffer for the
+// duration of fn and returns them.
+func captureJSON(fn func()) []Entry {
+	var buf bytes.Buffer
+	structured.Lock()
+	structured.out, structured.format = &buf, JSONFormat
+	structured.Unlock()
+	defer func() {
+		structured.Lock()
+		structured.out, structured.format = nil, TextFormat
+		structured.Unlock()
+	}()
+	fn()
+
+	var entries []Entry
+	dec := json.NewDecoder(&buf)
+	for {
+		var e Entry
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestTagPrefix(t *testing.T) {
+	ctx := context.Background()
+	if p := tagPrefix(ctx); p != "" {
+		t.Errorf("expected no prefix for untagged context; got %q", p)
+	}
+	ctx = context.WithValue(ctx, StoreID, 2)
+	ctx = context.WithValue(ctx, NodeID, 1)
+	ctx = context.WithValue(ctx, TxnID, []byte{0xab, 0xcd})
+	if p, exp := tagPrefix(ctx), "[node=1,store=2,txn=abcd] "; p != exp {
+		t.Errorf("expected prefix %q; got %q", exp, p)
+	}
+}
+
+func TestJSONEntries(t *testing.T) {
+	ctx := context.WithValue(context.Background(), RaftID, 3)
+	ctx = context.WithValue(ctx, Key, "a")
+	entries := captureJSON(func() {
+		Infof("plain %d", 1)
+		Warningc(ctx, "tagged %s", "entry")
+	})
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries; got %+v", entries)
+	}
+	if e := entries[0]; e.Severity != "INFO" || e.Message != "plain 1" || e.File != "structured_test.go" ||
+		e.RaftID != nil {
+		t.Errorf("unexpected entry %+v", e)
+	}
+	// Numbers are decoded from JSON as float64s.
+	if e := entries[1]; e.Severity != "WARNING" || e.Message != "tagged entry" ||
+		e.RaftID != float64(3) || e.Key != "a" {
+		t.Errorf("unexpected entry %+v", e)
+	}
+}
+
+func TestPackageVerbosity(t *testing.T) {
+	defer SetPackageVerbosity("")
+	if V(1) {
+		t.Fatal("expected default verbosity to exclude level 1")
+	}
+	if err := SetPackageVerbosity("log=2"); err != nil {
+		t.Fatal(err)
+	}
+	if !V(2) || V(3) {
+		t.Errorf("expected package verbosity of 2")
+	}
+	if err := SetPackageVerbosity("github.com/cockroachdb/cockroach/util/log=1,storage=3"); err != nil {
+		t.Fatal(err)
+	}
+	if !V(1) || V(2) {
+		t.Errorf("expected package verbosity of 1")
+	}
+	if PackageVerbosity() != "github.com/cockroachdb/cockroach/util/log=1,storage=3" {
+		t.Errorf("unexpected spec %q", PackageVerbosity())
+	}
+	for _, spec := range []string{"log", "log=a", "=1", "log=-1"} {
+		if err := SetPackageVerbosity(spec); err == nil {
+			t.Errorf("expected error for spec %q", spec)
+		}
+	}
+
+	entries := captureJSON(func() {
+		if err := SetPackageVerbosity("log=1"); err != nil {
+			t.Fatal(err)
+		}
+		V(1).Infof("logged")
+		V(2).Infof("not logged")
+	})
+	if len(entries) != 1 || entries[0].Message != "logged" {
+		t.Errorf("expected only the level 1 entry; got %+v", entries)
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	testCases := []struct {
+		funcName, pkg string
+	}{
+		{"github.com/cockroachdb/cockroach/storage.(*Range).AddCmd", "github.com/cockroachdb/cockroach/storage"},
+		{"github.com/cockroachdb/cockroach/util/log.V", "github.com/cockroachdb/cockroach/util/log"},
+		{"main.main", "main"},
+	}
+	for i, test := range testCases {
+		if pkg := packageName(test.funcName); pkg != test.pkg {
+			t.Errorf("%d: expected package %q; got %q", i, test.pkg, pkg)
+		}
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := &rotatingFile{dir: dir, maxSize: 10, retain: 2}
+	countFiles := func() int {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(infos)
+	}
+	// Each write exceeds the size of the current file, so rotates it.
+	for i := 1; i <= 3; i++ {
+		if _, err := f.Write([]byte("0123456789")); err != nil {
+			t.Fatal(err)
+		}
+		exp := i
+		if exp > f.retain {
+			exp = f.retain
+		}
+		if n := countFiles(); n != exp {
+			t.Errorf("%d: expected %d files; got %d", i, exp, n)
+		}
+		// File names have microsecond resolution.
+		time.Sleep(time.Millisecond)
+	}
+
+	// Old files are rotated by age.
+	f.maxSize, f.maxAge = 0, time.Hour
+	if f.needsRotation(1, f.created.Add(time.Minute)) {
+		t.Error("expected file within max age not to need rotation")
+	}
+	if !f.needsRotation(1, f.created.Add(time.Hour)) {
+		t.Error("expected file past max age to need rotation")
+	}
+}
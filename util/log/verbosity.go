@@ -0,0 +1,301 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package log
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+)
+
+// Level is a verbosity level, as passed to V.
+type Level int32
+
+// Verbose is a boolean type that implements Info, Infof and Infoln,
+// which log only if the verbosity check made by V succeeded.
+type Verbose bool
+
+// V reports whether verbosity at the call site is at least the
+// requested level. A level set for the caller's package via
+// SetPackageVerbosity takes precedence over the global verbosity
+// given by the -v flag. The returned value may be used directly as a
+// boolean or to log, as in log.V(2).Infof(...).
+//
+// glog would match the -vmodule flag against this file rather than the
+// caller's, so the file levels it sets are checked here as well.
+func V(level Level) Verbose {
+	if atomic.LoadInt32(&packages.active) != 0 {
+		if l, ok := packageLevel(1); ok {
+			return Verbose(l >= level)
+		}
+	}
+	if atomic.LoadInt32(&modules.active) != 0 {
+		l, ok := moduleLevel(1)
+		return Verbose(Verbosity() >= level || (ok && l >= level))
+	}
+	return Verbose(glog.V(glog.Level(level)))
+}
+
+// Info is equivalent to log.Info, guarded by the value of v.
+func (v Verbose) Info(args ...interface{}) {
+	if v {
+		logDepth(nil, 1, infoLog, fmt.Sprint(args...))
+	}
+}
+
+// Infof is equivalent to log.Infof, guarded by the value of v.
+func (v Verbose) Infof(format string, args ...interface{}) {
+	if v {
+		logDepth(nil, 1, infoLog, fmt.Sprintf(format, args...))
+	}
+}
+
+// Infoln is equivalent to log.Infoln, guarded by the value of v.
+func (v Verbose) Infoln(args ...interface{}) {
+	if v {
+		logDepth(nil, 1, infoLog, fmt.Sprintln(args...))
+	}
+}
+
+// packages holds the per-package verbosity levels.
+var packages struct {
+	active int32 // non-zero if any levels are set; accessed atomically
+	sync.RWMutex
+	spec   string
+	levels map[string]Level // by package import path or its last element
+	byPC   map[uintptr]packageResult
+	gen    int // incremented on each change of levels
+}
+
+type packageResult struct {
+	level Level
+	ok    bool
+}
+
+// modules holds the per-file verbosity levels of the -vmodule flag.
+var modules struct {
+	active int32 // non-zero if any levels are set; accessed atomically
+	sync.RWMutex
+	patterns []modulePattern
+	byPC     map[uintptr]packageResult
+	gen      int // incremented on each change of patterns
+}
+
+// modulePattern is a file=N setting of the -vmodule flag.
+type modulePattern struct {
+	pattern string
+	level   Level
+}
+
+func init() {
+	flag.Var(packageSpec{}, "vpackage", "comma-separated list of package=N settings for "+
+		"package-scoped verbosity, overriding -v; packages are given by import path "+
+		"or its last element, e.g. storage=2,multiraft=1")
+	if f := flag.Lookup("vmodule"); f != nil {
+		f.Value = &moduleSpec{f.Value}
+	}
+}
+
+// moduleSpec wraps glog's flag.Value for the -vmodule flag to keep a
+// copy of the file levels for V.
+type moduleSpec struct {
+	flag.Value
+}
+
+// String implements flag.Value.
+func (m *moduleSpec) String() string {
+	if m.Value == nil {
+		return ""
+	}
+	return m.Value.String()
+}
+
+// Set implements flag.Value. The syntax is that of glog, which
+// validates it first.
+func (m *moduleSpec) Set(value string) error {
+	if err := m.Value.Set(value); err != nil {
+		return err
+	}
+	var patterns []modulePattern
+	for _, setting := range strings.Split(value, ",") {
+		parts := strings.Split(setting, "=")
+		if len(parts) != 2 || parts[0] == "" {
+			continue
+		}
+		l, err := strconv.Atoi(parts[1])
+		if err != nil || l == 0 {
+			continue
+		}
+		patterns = append(patterns, modulePattern{parts[0], Level(l)})
+	}
+	modules.Lock()
+	defer modules.Unlock()
+	modules.patterns = patterns
+	modules.byPC = map[uintptr]packageResult{}
+	modules.gen++
+	if len(patterns) > 0 {
+		atomic.StoreInt32(&modules.active, 1)
+	} else {
+		atomic.StoreInt32(&modules.active, 0)
+	}
+	return nil
+}
+
+// packageSpec is the flag.Value for the -vpackage flag.
+type packageSpec struct{}
+
+func (packageSpec) String() string         { return PackageVerbosity() }
+func (packageSpec) Set(value string) error { return SetPackageVerbosity(value) }
+
+// SetPackageVerbosity sets the verbosity of packages from spec, a
+// comma-separated list of package=N settings. An empty spec clears
+// all package levels.
+func SetPackageVerbosity(spec string) error {
+	levels := map[string]Level{}
+	for _, setting := range strings.Split(spec, ",") {
+		if setting = strings.TrimSpace(setting); setting == "" {
+			continue
+		}
+		parts := strings.Split(setting, "=")
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid package verbosity %q; expected package=N", setting)
+		}
+		l, err := strconv.Atoi(parts[1])
+		if err != nil || l < 0 {
+			return fmt.Errorf("invalid verbosity level in %q", setting)
+		}
+		levels[parts[0]] = Level(l)
+	}
+	packages.Lock()
+	defer packages.Unlock()
+	packages.spec = spec
+	packages.levels = levels
+	packages.byPC = map[uintptr]packageResult{}
+	packages.gen++
+	if len(levels) > 0 {
+		atomic.StoreInt32(&packages.active, 1)
+	} else {
+		atomic.StoreInt32(&packages.active, 0)
+	}
+	return nil
+}
+
+// PackageVerbosity returns the spec last passed to SetPackageVerbosity.
+func PackageVerbosity() string {
+	packages.RLock()
+	defer packages.RUnlock()
+	return packages.spec
+}
+
+// SetVerbosity sets the global verbosity level, as given by the -v flag.
+func SetVerbosity(level Level) error {
+	f := flag.Lookup("v")
+	if f == nil {
+		return fmt.Errorf("verbosity flag is not registered")
+	}
+	return f.Value.Set(strconv.Itoa(int(level)))
+}
+
+// Verbosity returns the global verbosity level.
+func Verbosity() Level {
+	if f := flag.Lookup("v"); f != nil {
+		if l, err := strconv.Atoi(f.Value.String()); err == nil {
+			return Level(l)
+		}
+	}
+	return 0
+}
+
+// packageLevel returns the level set for the package of the function
+// depth stack frames above the caller, and whether one is set.
+func packageLevel(depth int) (Level, bool) {
+	pc, _, _, ok := runtime.Caller(depth + 1)
+	if !ok {
+		return 0, false
+	}
+	packages.RLock()
+	r, cached := packages.byPC[pc]
+	levels, gen := packages.levels, packages.gen
+	packages.RUnlock()
+	if cached {
+		return r.level, r.ok
+	}
+
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		pkg := packageName(fn.Name())
+		if r.level, r.ok = levels[pkg]; !r.ok {
+			r.level, r.ok = levels[pkg[strings.LastIndex(pkg, "/")+1:]]
+		}
+	}
+	packages.Lock()
+	if packages.gen == gen {
+		packages.byPC[pc] = r
+	}
+	packages.Unlock()
+	return r.level, r.ok
+}
+
+// moduleLevel returns the -vmodule level of the file of the function
+// depth stack frames above the caller, and whether one is set. As in
+// glog, the first pattern which matches the file's base name without
+// its ".go" extension applies.
+func moduleLevel(depth int) (Level, bool) {
+	pc, file, _, ok := runtime.Caller(depth + 1)
+	if !ok {
+		return 0, false
+	}
+	modules.RLock()
+	r, cached := modules.byPC[pc]
+	patterns, gen := modules.patterns, modules.gen
+	modules.RUnlock()
+	if cached {
+		return r.level, r.ok
+	}
+
+	file = strings.TrimSuffix(filepath.Base(file), ".go")
+	for _, p := range patterns {
+		if match, _ := filepath.Match(p.pattern, file); match {
+			r = packageResult{p.level, true}
+			break
+		}
+	}
+	modules.Lock()
+	if modules.gen == gen {
+		modules.byPC[pc] = r
+	}
+	modules.Unlock()
+	return r.level, r.ok
+}
+
+// packageName returns the import path of the package of the named
+// function, e.g. "github.com/cockroachdb/cockroach/storage" for
+// "github.com/cockroachdb/cockroach/storage.(*Range).AddCmd".
+func packageName(funcName string) string {
+	slash := strings.LastIndex(funcName, "/")
+	if dot := strings.Index(funcName[slash+1:], "."); dot >= 0 {
+		return funcName[:slash+1+dot]
+	}
+	return funcName
+}
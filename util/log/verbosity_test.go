@@ -0,0 +1,51 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package log
+
+import (
+	"flag"
+	"testing"
+)
+
+// TestVModule verifies that the -vmodule flag is matched against the
+// file V is called from.
+func TestVModule(t *testing.T) {
+	defer func() {
+		if err := flag.Set("vmodule", ""); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	testCases := []struct {
+		spec   string
+		expect bool
+	}{
+		{"verbosity_test=2", true},
+		{"verbosity_t*=3", true},
+		{"verbosity=2", false},
+		{"verbosity_test=1", false},
+		{"", false},
+	}
+	for i, test := range testCases {
+		if err := flag.Set("vmodule", test.spec); err != nil {
+			t.Fatal(err)
+		}
+		if v := bool(V(2)); v != test.expect {
+			t.Errorf("%d: expected V(2) with -vmodule=%s to be %t; got %t", i, test.spec, test.expect, v)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package metrics
+
+import (
+	"math"
+	"time"
+)
+
+// latencyBuckets suffices for latencies, in microseconds, of up to
+// about half an hour.
+const latencyBuckets = 2200
+
+// A LatencyHistogram counts latencies in buckets of exponentially
+// growing width, as histograms of a MetricSystem do, so that its
+// quantiles are accurate to within 1%. A LatencyHistogram is not safe
+// for concurrent use.
+type LatencyHistogram struct {
+	counts [latencyBuckets]int64
+	count  int64
+	sum    time.Duration
+	min    time.Duration
+	max    time.Duration
+}
+
+func latencyBucket(d time.Duration) int {
+	b := int(compress(math.Max(float64(d)/float64(time.Microsecond), 0)))
+	if b >= latencyBuckets {
+		b = latencyBuckets - 1
+	}
+	return b
+}
+
+func latencyValue(b int) time.Duration {
+	return time.Duration(decompress(int16(b)) * float64(time.Microsecond))
+}
+
+// Record adds the latency to the histogram.
+func (h *LatencyHistogram) Record(d time.Duration) {
+	h.counts[latencyBucket(d)]++
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	if d > h.max {
+		h.max = d
+	}
+	h.count++
+	h.sum += d
+}
+
+// Merge adds the latencies of o to the histogram.
+func (h *LatencyHistogram) Merge(o *LatencyHistogram) {
+	if o.count == 0 {
+		return
+	}
+	for b, c := range o.counts {
+		h.counts[b] += c
+	}
+	if h.count == 0 || o.min < h.min {
+		h.min = o.min
+	}
+	if o.max > h.max {
+		h.max = o.max
+	}
+	h.count += o.count
+	h.sum += o.sum
+}
+
+// Count returns the number of latencies recorded.
+func (h *LatencyHistogram) Count() int64 {
+	return h.count
+}
+
+// Min returns the least latency recorded.
+func (h *LatencyHistogram) Min() time.Duration {
+	return h.min
+}
+
+// Max returns the greatest latency recorded.
+func (h *LatencyHistogram) Max() time.Duration {
+	return h.max
+}
+
+// Mean returns the mean latency.
+func (h *LatencyHistogram) Mean() time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.count)
+}
+
+// Quantile returns the latency below which a fraction q of the
+// latencies fall.
+func (h *LatencyHistogram) Quantile(q float64) time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q * float64(h.count)))
+	var seen int64
+	for b, c := range h.counts {
+		if seen += c; seen >= rank && c > 0 {
+			// Clamp the approximation to the exact extremes.
+			d := latencyValue(b)
+			if d < h.min {
+				d = h.min
+			} else if d > h.max {
+				d = h.max
+			}
+			return d
+		}
+	}
+	return h.max
+}
+
+// VisitBuckets calls visitor with the approximate latency and count of
+// each non-empty bucket, in increasing order of latency.
+func (h *LatencyHistogram) VisitBuckets(visitor func(d time.Duration, count int64)) {
+	for b, c := range h.counts {
+		if c > 0 {
+			visitor(latencyValue(b), c)
+		}
+	}
+}
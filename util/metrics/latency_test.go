@@ -0,0 +1,71 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package metrics
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLatencyHistogram verifies that the quantiles of a histogram are
+// accurate to within its precision, and that histograms merge.
+func TestLatencyHistogram(t *testing.T) {
+	var a, b LatencyHistogram
+	for i := 1; i <= 1000; i++ {
+		if i%2 == 0 {
+			a.Record(time.Duration(i) * time.Millisecond)
+		} else {
+			b.Record(time.Duration(i) * time.Millisecond)
+		}
+	}
+	a.Merge(&b)
+	if a.Count() != 1000 || a.Min() != time.Millisecond || a.Max() != time.Second {
+		t.Fatalf("unexpected count %d, min %s, max %s", a.Count(), a.Min(), a.Max())
+	}
+	if mean := a.Mean(); mean != 500500*time.Microsecond {
+		t.Errorf("expected mean of 500.5ms; got %s", mean)
+	}
+	for _, test := range []struct {
+		q        float64
+		expected time.Duration
+	}{
+		{0, time.Millisecond},
+		{.5, 500 * time.Millisecond},
+		{.99, 990 * time.Millisecond},
+		{1, time.Second},
+	} {
+		d := a.Quantile(test.q)
+		if diff := d - test.expected; diff < -test.expected/100 || diff > test.expected/100 {
+			t.Errorf("expected quantile %.2f to be within 1%% of %s; got %s", test.q, test.expected, d)
+		}
+	}
+
+	var buckets, count int64
+	var last time.Duration
+	a.VisitBuckets(func(d time.Duration, c int64) {
+		if d <= last {
+			t.Errorf("bucket %s visited after %s", d, last)
+		}
+		last = d
+		buckets++
+		count += c
+	})
+	if count != a.Count() || buckets < 100 {
+		t.Errorf("visited %d latencies in %d buckets", count, buckets)
+	}
+}
@@ -65,6 +65,7 @@ type RetryOptions struct {
 	MaxAttempts int           // Maximum number of attempts (0 for infinite)
 	UseV1Info   bool          // Use verbose V(1) level for log messages
 	Stopper     *Stopper      // Optionally end retry loop on stopper signal
+	Timeout     time.Duration // Maximum duration of the retry loop (0 for none)
 }
 
 // RetryWithBackoff implements retry with exponential backoff using
@@ -73,9 +74,14 @@ type RetryOptions struct {
 // retried. When fn returns RetryBreak, retry ends. As a special case,
 // if fn returns RetryReset, the backoff and retry count are reset to
 // starting values and the next retry occurs immediately. Returns an
-// error if the maximum number of retries is exceeded or if the fn
-// returns an error.
+// error if the maximum number of retries is exceeded, if the next
+// retry would begin after the timeout expires, or if the fn returns
+// an error.
 func RetryWithBackoff(opts RetryOptions, fn func() (RetryStatus, error)) error {
+	var deadline time.Time
+	if opts.Timeout > 0 {
+		deadline = time.Now().Add(opts.Timeout)
+	}
 	backoff := opts.Backoff
 	for count := 1; true; count++ {
 		status, err := fn()
@@ -107,6 +113,9 @@ func RetryWithBackoff(opts RetryOptions, fn func() (RetryStatus, error)) error {
 				backoff = opts.MaxBackoff
 			}
 		}
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			return Errorf("%s retry loop timed out after %s", opts.Tag, opts.Timeout)
+		}
 		// Wait before retry.
 		select {
 		case <-time.After(wait):
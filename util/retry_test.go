@@ -24,7 +24,7 @@ import (
 )
 
 func TestRetry(t *testing.T) {
-	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 10, false, nil}
+	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 10, false, nil, 0}
 	var retries int
 	err := RetryWithBackoff(opts, func() (RetryStatus, error) {
 		retries++
@@ -42,7 +42,7 @@ func TestRetryExceedsMaxBackoff(t *testing.T) {
 	timer := time.AfterFunc(time.Second, func() {
 		t.Error("max backoff not respected")
 	})
-	opts := RetryOptions{"test", time.Microsecond * 10, time.Microsecond * 10, 1000, 3, false, nil}
+	opts := RetryOptions{"test", time.Microsecond * 10, time.Microsecond * 10, 1000, 3, false, nil, 0}
 	err := RetryWithBackoff(opts, func() (RetryStatus, error) {
 		return RetryContinue, nil
 	})
@@ -54,7 +54,7 @@ func TestRetryExceedsMaxBackoff(t *testing.T) {
 
 func TestRetryExceedsMaxAttempts(t *testing.T) {
 	var retries int
-	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 3, false, nil}
+	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 3, false, nil, 0}
 	err := RetryWithBackoff(opts, func() (RetryStatus, error) {
 		retries++
 		return RetryContinue, nil
@@ -68,7 +68,7 @@ func TestRetryExceedsMaxAttempts(t *testing.T) {
 }
 
 func TestRetryFunctionReturnsError(t *testing.T) {
-	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 0 /* indefinite */, false, nil}
+	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 0 /* indefinite */, false, nil, 0}
 	err := RetryWithBackoff(opts, func() (RetryStatus, error) {
 		return RetryBreak, fmt.Errorf("something went wrong")
 	})
@@ -78,7 +78,7 @@ func TestRetryFunctionReturnsError(t *testing.T) {
 }
 
 func TestRetryReset(t *testing.T) {
-	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 1, false, nil}
+	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 1, false, nil, 0}
 	var count int
 	// Backoff loop has 1 allowed retry; we always return RetryReset, so
 	// just make sure we get to 2 retries and then break.
@@ -99,7 +99,7 @@ func TestRetryReset(t *testing.T) {
 func TestRetryStop(t *testing.T) {
 	stopper := NewStopper()
 	// Create a retry loop which will never stop without stopper.
-	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 0, false, stopper}
+	opts := RetryOptions{"test", time.Microsecond * 10, time.Second, 2, 0, false, stopper, 0}
 	if err := RetryWithBackoff(opts, func() (RetryStatus, error) {
 		go stopper.Stop()
 		return RetryContinue, nil
@@ -107,3 +107,23 @@ func TestRetryStop(t *testing.T) {
 		t.Errorf("expected retry loop to exit from being stopped")
 	}
 }
+
+func TestRetryTimeout(t *testing.T) {
+	opts := RetryOptions{
+		Backoff:    10 * time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+		Constant:   1,
+		Timeout:    35 * time.Millisecond,
+	}
+	count := 0
+	err := RetryWithBackoff(opts, func() (RetryStatus, error) {
+		count++
+		return RetryContinue, nil
+	})
+	if err == nil {
+		t.Error("expected retry loop to time out")
+	}
+	if count < 2 || count > 4 {
+		t.Errorf("expected 2 to 4 attempts before timing out; got %d", count)
+	}
+}
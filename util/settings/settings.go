@@ -0,0 +1,330 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// Package settings provides a registry of named, typed cluster
+// settings. Packages register the settings they consume, typically in
+// package-level variables, and read the current value with Get. The
+// values set for the cluster are stored in the system config span and
+// gossiped with the system config; each node applies them with Update,
+// so settings may be changed without restarting the cluster. Settings
+// which have not been set hold their registered default.
+package settings
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// A Setting is a named, typed cluster setting.
+type Setting interface {
+	// Name returns the name under which the setting is registered.
+	Name() string
+	// Description returns a short description of the setting.
+	Description() string
+	// Typ returns the name of the setting's type.
+	Typ() string
+	// String returns the setting's current value, formatted as it is
+	// parsed.
+	String() string
+
+	// set parses and applies the supplied value.
+	set(value string) error
+	// validate returns an error if the supplied value can't be parsed.
+	validate(value string) error
+	// reset restores the setting's default value.
+	reset()
+}
+
+// common holds the fields shared by all setting types.
+type common struct {
+	name, description string
+}
+
+// Name implements the Setting interface.
+func (c *common) Name() string { return c.name }
+
+// Description implements the Setting interface.
+func (c *common) Description() string { return c.description }
+
+// A DurationSetting is a setting holding a time.Duration, parsed with
+// time.ParseDuration, e.g. "1m30s".
+type DurationSetting struct {
+	common
+	defaultValue time.Duration
+	v            int64
+}
+
+// Get returns the current value.
+func (s *DurationSetting) Get() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.v))
+}
+
+// Typ implements the Setting interface.
+func (s *DurationSetting) Typ() string { return "duration" }
+
+func (s *DurationSetting) String() string { return s.Get().String() }
+
+func (s *DurationSetting) validate(value string) error {
+	_, err := time.ParseDuration(value)
+	return err
+}
+
+func (s *DurationSetting) set(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&s.v, int64(d))
+	return nil
+}
+
+func (s *DurationSetting) reset() { atomic.StoreInt64(&s.v, int64(s.defaultValue)) }
+
+// A ByteSizeSetting is a setting holding a number of bytes, parsed by
+// ParseByteSize, e.g. "64MiB".
+type ByteSizeSetting struct {
+	common
+	defaultValue int64
+	v            int64
+}
+
+// Get returns the current value.
+func (s *ByteSizeSetting) Get() int64 {
+	return atomic.LoadInt64(&s.v)
+}
+
+// Typ implements the Setting interface.
+func (s *ByteSizeSetting) Typ() string { return "byte size" }
+
+func (s *ByteSizeSetting) String() string { return strconv.FormatInt(s.Get(), 10) }
+
+func (s *ByteSizeSetting) validate(value string) error {
+	_, err := ParseByteSize(value)
+	return err
+}
+
+func (s *ByteSizeSetting) set(value string) error {
+	n, err := ParseByteSize(value)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&s.v, n)
+	return nil
+}
+
+func (s *ByteSizeSetting) reset() { atomic.StoreInt64(&s.v, s.defaultValue) }
+
+// A BoolSetting is a setting holding a bool, parsed with
+// strconv.ParseBool.
+type BoolSetting struct {
+	common
+	defaultValue bool
+	v            int32
+}
+
+// Get returns the current value.
+func (s *BoolSetting) Get() bool {
+	return atomic.LoadInt32(&s.v) != 0
+}
+
+// Typ implements the Setting interface.
+func (s *BoolSetting) Typ() string { return "bool" }
+
+func (s *BoolSetting) String() string { return strconv.FormatBool(s.Get()) }
+
+func (s *BoolSetting) validate(value string) error {
+	_, err := strconv.ParseBool(value)
+	return err
+}
+
+func (s *BoolSetting) set(value string) error {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	s.store(b)
+	return nil
+}
+
+func (s *BoolSetting) reset() { s.store(s.defaultValue) }
+
+func (s *BoolSetting) store(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&s.v, v)
+}
+
+// byteSizeUnits maps the suffixes accepted by ParseByteSize to their
+// multipliers. Both decimal and binary units are accepted.
+var byteSizeUnits = map[string]int64{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"TB":  1000 * 1000 * 1000 * 1000,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+	"TIB": 1 << 40,
+}
+
+var byteSizeRE = regexp.MustCompile(`^([0-9]+)\s*([a-zA-Z]*)$`)
+
+// ParseByteSize parses a non-negative number of bytes with an optional
+// unit suffix, e.g. "512", "10KB" or "64MiB". Units are case
+// insensitive.
+func ParseByteSize(value string) (int64, error) {
+	m := byteSizeRE.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, util.Errorf("invalid byte size %q", value)
+	}
+	unit, ok := byteSizeUnits[strings.ToUpper(m[2])]
+	if !ok {
+		return 0, util.Errorf("invalid byte size unit %q", m[2])
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, util.Errorf("invalid byte size %q: %s", value, err)
+	}
+	return n * unit, nil
+}
+
+// nameRE matches valid setting names: dot-separated components of
+// lower case letters, digits and underscores, e.g.
+// "storage.backpressure.timeout".
+var nameRE = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+
+var (
+	mu       sync.Mutex
+	registry = map[string]Setting{}
+)
+
+// register adds the setting to the registry. Registering an invalid
+// or duplicate name panics, as settings are registered on package
+// initialization.
+func register(s Setting) {
+	if !nameRE.MatchString(s.Name()) {
+		panic("invalid setting name: " + s.Name())
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := registry[s.Name()]; ok {
+		panic("setting already registered: " + s.Name())
+	}
+	s.reset()
+	registry[s.Name()] = s
+}
+
+// RegisterDurationSetting registers and returns a duration setting.
+func RegisterDurationSetting(name, description string, defaultValue time.Duration) *DurationSetting {
+	s := &DurationSetting{common: common{name, description}, defaultValue: defaultValue}
+	register(s)
+	return s
+}
+
+// RegisterByteSizeSetting registers and returns a byte size setting.
+func RegisterByteSizeSetting(name, description string, defaultValue int64) *ByteSizeSetting {
+	s := &ByteSizeSetting{common: common{name, description}, defaultValue: defaultValue}
+	register(s)
+	return s
+}
+
+// RegisterBoolSetting registers and returns a bool setting.
+func RegisterBoolSetting(name, description string, defaultValue bool) *BoolSetting {
+	s := &BoolSetting{common: common{name, description}, defaultValue: defaultValue}
+	register(s)
+	return s
+}
+
+// Lookup returns the setting registered under name.
+func Lookup(name string) (Setting, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	s, ok := registry[name]
+	return s, ok
+}
+
+// All returns the registered settings, sorted by name.
+func All() []Setting {
+	mu.Lock()
+	defer mu.Unlock()
+	all := make([]Setting, 0, len(registry))
+	for _, s := range registry {
+		all = append(all, s)
+	}
+	sort.Sort(byName(all))
+	return all
+}
+
+type byName []Setting
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byName) Less(i, j int) bool { return b[i].Name() < b[j].Name() }
+
+// Validate returns an error unless name is a registered setting and
+// value may be parsed as its type.
+func Validate(name, value string) error {
+	s, ok := Lookup(name)
+	if !ok {
+		return util.Errorf("unknown setting %q", name)
+	}
+	if err := s.validate(value); err != nil {
+		return util.Errorf("invalid value %q for %s setting %q: %s", value, s.Typ(), name, err)
+	}
+	return nil
+}
+
+// Update applies the supplied values, keyed by setting name, to the
+// registered settings. Settings without a value are reset to their
+// defaults. Values for unknown settings, which may have been set by a
+// newer version, and invalid values are returned as an error after the
+// remaining values have been applied.
+func Update(values map[string]string) error {
+	var errs []string
+	for _, s := range All() {
+		value, ok := values[s.Name()]
+		if !ok {
+			s.reset()
+			continue
+		}
+		if err := s.set(value); err != nil {
+			s.reset()
+			errs = append(errs, util.Errorf("invalid value %q for setting %q: %s", value, s.Name(), err).Error())
+		}
+	}
+	for name := range values {
+		if _, ok := Lookup(name); !ok {
+			errs = append(errs, util.Errorf("unknown setting %q", name).Error())
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return util.Errorf("failed to apply settings: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package settings
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	testDuration = RegisterDurationSetting("test.duration", "a duration", time.Second)
+	testByteSize = RegisterByteSizeSetting("test.byte_size", "a byte size", 1<<10)
+	testBool     = RegisterBoolSetting("test.bool", "a bool", true)
+)
+
+func TestParseByteSize(t *testing.T) {
+	testCases := []struct {
+		value string
+		bytes int64
+		ok    bool
+	}{
+		{"0", 0, true},
+		{"512", 512, true},
+		{"512B", 512, true},
+		{"10KB", 10000, true},
+		{"10 kb", 10000, true},
+		{"64MiB", 64 << 20, true},
+		{"2GiB", 2 << 30, true},
+		{"1TB", 1000 * 1000 * 1000 * 1000, true},
+		{"", 0, false},
+		{"-1", 0, false},
+		{"1.5MB", 0, false},
+		{"10XB", 0, false},
+	}
+	for i, test := range testCases {
+		bytes, err := ParseByteSize(test.value)
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("%d: expected ok %t for %q; got %v", i, test.ok, test.value, err)
+			continue
+		}
+		if test.ok && bytes != test.bytes {
+			t.Errorf("%d: expected %q to parse as %d; got %d", i, test.value, test.bytes, bytes)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name, value string
+		ok          bool
+	}{
+		{"test.duration", "10s", true},
+		{"test.duration", "10", false},
+		{"test.byte_size", "1MiB", true},
+		{"test.byte_size", "big", false},
+		{"test.bool", "false", true},
+		{"test.bool", "maybe", false},
+		{"test.unknown", "1", false},
+	}
+	for i, test := range testCases {
+		if err := Validate(test.name, test.value); (err == nil) != test.ok {
+			t.Errorf("%d: expected ok %t for %s=%q; got %v", i, test.ok, test.name, test.value, err)
+		}
+	}
+}
+
+// TestUpdate verifies that updates apply the supplied values and reset
+// settings without a value to their defaults.
+func TestUpdate(t *testing.T) {
+	defer Update(nil)
+
+	if err := Update(map[string]string{
+		"test.duration":  "1m",
+		"test.byte_size": "2KiB",
+		"test.bool":      "false",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if d := testDuration.Get(); d != time.Minute {
+		t.Errorf("expected duration %s; got %s", time.Minute, d)
+	}
+	if b := testByteSize.Get(); b != 2<<10 {
+		t.Errorf("expected byte size %d; got %d", 2<<10, b)
+	}
+	if testBool.Get() {
+		t.Error("expected bool to be false")
+	}
+
+	// An invalid value and an unknown setting are reported, while the
+	// remaining values are still applied.
+	if err := Update(map[string]string{
+		"test.duration": "soon",
+		"test.bool":     "false",
+		"test.unknown":  "1",
+	}); err == nil {
+		t.Error("expected error updating invalid settings")
+	}
+	if d := testDuration.Get(); d != time.Second {
+		t.Errorf("expected default duration %s; got %s", time.Second, d)
+	}
+	if b := testByteSize.Get(); b != 1<<10 {
+		t.Errorf("expected default byte size %d; got %d", 1<<10, b)
+	}
+	if testBool.Get() {
+		t.Error("expected bool to be false")
+	}
+}
+
+func TestAll(t *testing.T) {
+	var names []string
+	for _, s := range All() {
+		names = append(names, s.Name())
+	}
+	expNames := []string{"test.bool", "test.byte_size", "test.duration"}
+	if len(names) != len(expNames) {
+		t.Fatalf("expected settings %v; got %v", expNames, names)
+	}
+	for i := range names {
+		if names[i] != expNames[i] {
+			t.Errorf("expected settings %v; got %v", expNames, names)
+		}
+	}
+}
+
+func TestRegisterInvalidName(t *testing.T) {
+	for _, name := range []string{"", "Test", "test.", ".test", "test..duration", "test.duration"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected registering %q to panic", name)
+				}
+			}()
+			RegisterBoolSetting(name, "", false)
+		}()
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+// Package trace records the execution of requests as trees of spans,
+// which may cross nodes. Each node collects the spans it finishes in a
+// Tracer, from which the recent traces can be retrieved and slow ones
+// are logged.
+package trace
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+	"golang.org/x/net/context"
+)
+
+// defaultCapacity is the number of finished spans retained by a
+// Tracer created without an explicit capacity.
+const defaultCapacity = 10000
+
+type contextKey int
+
+// spanKey is the context key of the span of the current operation.
+const spanKey contextKey = 0
+
+// A Phase is a stage of request execution whose duration is reported
+// in the breakdown of slow requests.
+type Phase string
+
+// The phases of request execution, in the order of their breakdown.
+const (
+	PhaseLookup       Phase = "range lookup"
+	PhaseRPC          Phase = "rpc"
+	PhaseCommandQueue Phase = "command queue"
+	PhaseRaft         Phase = "raft"
+	PhaseEngine       Phase = "engine"
+)
+
+var phases = []Phase{PhaseLookup, PhaseRPC, PhaseCommandQueue, PhaseRaft, PhaseEngine}
+
+// An Event is a timestamped annotation of a span. An event ending a
+// phase records the phase; the phase is taken to have begun at the
+// span's previous event or, failing that, its start.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+	Phase   Phase     `json:"phase,omitempty"`
+}
+
+// A Span records one stage of the execution of a traced request. The
+// methods of a nil Span are no-ops, so that untraced operations need
+// not check whether they are being traced. A finished span is not
+// modified further.
+type Span struct {
+	TraceID  int64         `json:"trace_id"`
+	SpanID   int64         `json:"span_id"`
+	ParentID int64         `json:"parent_id,omitempty"` // zero for the root span
+	Remote   bool          `json:"remote,omitempty"`    // whether the parent was sent over RPC
+	NodeID   proto.NodeID  `json:"node_id"`
+	Name     string        `json:"name"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Events   []Event       `json:"events,omitempty"`
+
+	tracer *Tracer
+	mu     sync.Mutex
+	done   bool
+}
+
+// Eventf annotates the span with a formatted message. Events added
+// once the span has finished are dropped.
+func (s *Span) Eventf(format string, args ...interface{}) {
+	s.EndPhasef("", format, args...)
+}
+
+// EndPhasef annotates the span with a formatted message ending the
+// supplied phase.
+func (s *Span) EndPhasef(phase Phase, format string, args ...interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.done {
+		s.Events = append(s.Events, Event{
+			Time:    time.Now(),
+			Message: fmt.Sprintf(format, args...),
+			Phase:   phase,
+		})
+	}
+}
+
+// Finish records the duration of the span and adds it to its tracer.
+func (s *Span) Finish() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.done {
+		s.mu.Unlock()
+		return
+	}
+	s.done = true
+	s.Duration = time.Since(s.Start)
+	s.mu.Unlock()
+	s.tracer.record(s)
+}
+
+// TraceContext returns the context under which spans on other nodes
+// are recorded as children of s, or nil if s is nil.
+func (s *Span) TraceContext() *proto.TraceContext {
+	if s == nil {
+		return nil
+	}
+	return &proto.TraceContext{TraceID: s.TraceID, SpanID: s.SpanID}
+}
+
+// FromContext returns the span of ctx, or nil if it is untraced.
+func FromContext(ctx context.Context) *Span {
+	sp, _ := ctx.Value(spanKey).(*Span)
+	return sp
+}
+
+// StartSpan starts a span as a child of the span of ctx, returning a
+// context carrying the new span. If ctx is untraced, ctx and a nil
+// span are returned.
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	parent := FromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+	return parent.tracer.start(ctx, name, parent.TraceID, parent.SpanID, false)
+}
+
+// A Tracer collects the spans finished on a node, retaining the most
+// recent ones.
+type Tracer struct {
+	mu            sync.Mutex
+	nodeID        proto.NodeID
+	slowThreshold time.Duration
+	spans         []*Span // ring buffer of finished spans
+	next          int     // index of the next span to record
+}
+
+// NewTracer returns a Tracer which retains up to capacity spans, or a
+// default number if capacity is not positive.
+func NewTracer(capacity int) *Tracer {
+	if capacity <= 0 {
+		capacity = defaultCapacity
+	}
+	return &Tracer{spans: make([]*Span, 0, capacity)}
+}
+
+// SetNodeID sets the node ID recorded in the tracer's spans.
+func (t *Tracer) SetNodeID(id proto.NodeID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nodeID = id
+}
+
+// SetSlowThreshold sets the duration beyond which a request's spans
+// on this node are logged once its local root span finishes. Zero
+// disables logging.
+func (t *Tracer) SetSlowThreshold(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.slowThreshold = d
+}
+
+// StartSpan starts a span as a child of the span of ctx or, if ctx is
+// untraced, as the root span of a new trace. The returned context
+// carries the new span. A nil Tracer only starts child spans.
+func (t *Tracer) StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	if parent := FromContext(ctx); parent != nil || t == nil {
+		return StartSpan(ctx, name)
+	}
+	return t.start(ctx, name, rand.Int63(), 0, false)
+}
+
+// JoinSpan starts a span as a child of the span on another node
+// identified by tc or, if tc is nil, as the root span of a new trace.
+// The returned context carries the new span. A nil Tracer returns ctx
+// and a nil span.
+func (t *Tracer) JoinSpan(ctx context.Context, tc *proto.TraceContext, name string) (context.Context, *Span) {
+	if tc == nil {
+		return t.StartSpan(ctx, name)
+	}
+	return t.start(ctx, name, tc.TraceID, tc.SpanID, true)
+}
+
+// start starts a span with the supplied trace and parent IDs.
+func (t *Tracer) start(ctx context.Context, name string, traceID, parentID int64, remote bool) (
+	context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	t.mu.Lock()
+	nodeID := t.nodeID
+	t.mu.Unlock()
+	sp := &Span{
+		TraceID:  traceID,
+		SpanID:   rand.Int63(),
+		ParentID: parentID,
+		Remote:   remote,
+		NodeID:   nodeID,
+		Name:     name,
+		Start:    time.Now(),
+		tracer:   t,
+	}
+	return context.WithValue(ctx, spanKey, sp), sp
+}
+
+// record adds a finished span, logging its trace if it is the local
+// root of a slow request.
+func (t *Tracer) record(s *Span) {
+	t.mu.Lock()
+	if len(t.spans) < cap(t.spans) {
+		t.spans = append(t.spans, s)
+	} else {
+		t.spans[t.next] = s
+	}
+	t.next = (t.next + 1) % cap(t.spans)
+	threshold := t.slowThreshold
+	t.mu.Unlock()
+
+	if threshold > 0 && (s.ParentID == 0 || s.Remote) && s.Duration >= threshold {
+		spans := t.Trace(s.TraceID)
+		log.Warningf("slow request %d: %s took %s (%s)\n%s", s.TraceID, s.Name, s.Duration,
+			FormatBreakdown(Breakdown(spans)), Format(spans))
+	}
+}
+
+// Breakdown returns the time spent in each phase by the supplied spans.
+// Phases of nested spans are not subtracted from those enclosing them;
+// e.g. when the receiving node of an RPC is the sending node, the
+// command queue, Raft and engine phases are also part of the RPC.
+func Breakdown(spans []*Span) map[Phase]time.Duration {
+	breakdown := map[Phase]time.Duration{}
+	for _, s := range spans {
+		prev := s.Start
+		for _, e := range s.Events {
+			if e.Phase != "" {
+				breakdown[e.Phase] += e.Time.Sub(prev)
+			}
+			prev = e.Time
+		}
+	}
+	return breakdown
+}
+
+// FormatBreakdown renders a breakdown of phases in order of execution,
+// e.g. "range lookup 1ms, rpc 1.2s". Phases without time are omitted.
+func FormatBreakdown(breakdown map[Phase]time.Duration) string {
+	var parts []string
+	for _, phase := range phases {
+		if d, ok := breakdown[phase]; ok {
+			parts = append(parts, fmt.Sprintf("%s %s", phase, d))
+		}
+	}
+	if len(parts) == 0 {
+		return "no phases recorded"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Trace returns the retained spans of the trace with the supplied ID,
+// ordered by start time.
+func (t *Tracer) Trace(traceID int64) []*Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var spans []*Span
+	for _, s := range t.spans {
+		if s.TraceID == traceID {
+			spans = append(spans, s)
+		}
+	}
+	sort.Sort(byStart(spans))
+	return spans
+}
+
+// Recent returns the local root spans of up to n retained traces, the
+// most recently finished first.
+func (t *Tracer) Recent(n int) []*Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var spans []*Span
+	for i := 1; i <= len(t.spans) && len(spans) < n; i++ {
+		s := t.spans[(t.next-i+len(t.spans))%len(t.spans)]
+		if s.ParentID == 0 || s.Remote {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+type byStart []*Span
+
+func (s byStart) Len() int           { return len(s) }
+func (s byStart) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byStart) Less(i, j int) bool { return s[i].Start.Before(s[j].Start) }
+
+// Format returns a human readable rendering of the supplied spans of
+// a trace, with each span indented beneath its parent and events
+// timed relative to the start of the span.
+func Format(spans []*Span) string {
+	children := map[int64][]*Span{}
+	ids := map[int64]bool{}
+	for _, s := range spans {
+		ids[s.SpanID] = true
+	}
+	var roots []*Span
+	for _, s := range spans {
+		if ids[s.ParentID] {
+			children[s.ParentID] = append(children[s.ParentID], s)
+		} else {
+			roots = append(roots, s)
+		}
+	}
+	var buf bytes.Buffer
+	var format func(s *Span, depth int)
+	format = func(s *Span, depth int) {
+		indent := strings.Repeat("  ", depth)
+		fmt.Fprintf(&buf, "%s%s (node %d): %s\n", indent, s.Name, s.NodeID, s.Duration)
+		for _, e := range s.Events {
+			if e.Phase != "" {
+				fmt.Fprintf(&buf, "%s  %8s %s [%s]\n", indent, e.Time.Sub(s.Start), e.Message, e.Phase)
+			} else {
+				fmt.Fprintf(&buf, "%s  %8s %s\n", indent, e.Time.Sub(s.Start), e.Message)
+			}
+		}
+		for _, c := range children[s.SpanID] {
+			format(c, depth+1)
+		}
+	}
+	for _, s := range roots {
+		format(s, 0)
+	}
+	return buf.String()
+}
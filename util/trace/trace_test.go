@@ -0,0 +1,140 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+//
+// Author: agent (agent@local)
+
+package trace
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
+)
+
+func TestUntracedSpans(t *testing.T) {
+	ctx, sp := StartSpan(context.Background(), "untraced")
+	if sp != nil || FromContext(ctx) != nil {
+		t.Fatalf("expected no span for untraced context; got %+v", sp)
+	}
+	// The methods of nil spans are no-ops.
+	sp.Eventf("event")
+	sp.Finish()
+	if tc := sp.TraceContext(); tc != nil {
+		t.Errorf("expected nil trace context; got %+v", tc)
+	}
+	var tracer *Tracer
+	if _, sp := tracer.JoinSpan(ctx, &proto.TraceContext{TraceID: 1, SpanID: 2}, "nil tracer"); sp != nil {
+		t.Errorf("expected nil tracer to start no span; got %+v", sp)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	tracer := NewTracer(0)
+	tracer.SetNodeID(1)
+	ctx, root := tracer.StartSpan(context.Background(), "root")
+	root.Eventf("event %d", 1)
+	_, child := StartSpan(ctx, "child")
+	child.Finish()
+
+	// A span joined from another node's trace context shares its trace.
+	remoteTracer := NewTracer(0)
+	remoteTracer.SetNodeID(2)
+	_, remote := remoteTracer.JoinSpan(context.Background(), root.TraceContext(), "remote")
+	remote.Finish()
+	root.Finish()
+	root.Eventf("dropped")
+
+	if child.TraceID != root.TraceID || child.ParentID != root.SpanID || child.Remote {
+		t.Errorf("expected child of root; got %+v", child)
+	}
+	if remote.TraceID != root.TraceID || remote.ParentID != root.SpanID || !remote.Remote || remote.NodeID != 2 {
+		t.Errorf("expected remote child of root; got %+v", remote)
+	}
+	if len(root.Events) != 1 || root.Events[0].Message != "event 1" {
+		t.Errorf("expected a single event; got %+v", root.Events)
+	}
+
+	spans := tracer.Trace(root.TraceID)
+	if len(spans) != 2 || spans[0] != root || spans[1] != child {
+		t.Errorf("expected root and child spans; got %+v", spans)
+	}
+	if recent := tracer.Recent(10); len(recent) != 1 || recent[0] != root {
+		t.Errorf("expected root as the only recent trace; got %+v", recent)
+	}
+	text := Format(append(spans, remote))
+	for _, expected := range []string{"root (node 1)", "\n  child (node 1)", "\n  remote (node 2)", "event 1"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("expected %q in formatted trace:\n%s", expected, text)
+		}
+	}
+}
+
+func TestTracerCapacity(t *testing.T) {
+	tracer := NewTracer(2)
+	var spans []*Span
+	for i := 0; i < 3; i++ {
+		_, sp := tracer.StartSpan(context.Background(), "span")
+		sp.Finish()
+		spans = append(spans, sp)
+	}
+	if len(tracer.Trace(spans[0].TraceID)) != 0 {
+		t.Error("expected oldest span to be evicted")
+	}
+	if recent := tracer.Recent(10); len(recent) != 2 || recent[0] != spans[2] || recent[1] != spans[1] {
+		t.Errorf("expected the two newest spans, newest first; got %+v", recent)
+	}
+}
+
+func TestBreakdown(t *testing.T) {
+	start := time.Unix(0, 0)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	spans := []*Span{
+		{Start: start, Events: []Event{
+			{Time: at(1), Phase: PhaseLookup},
+			{Time: at(2)},
+			{Time: at(10), Phase: PhaseRPC},
+			{Time: at(11), Phase: PhaseLookup},
+		}},
+		{Start: at(3), Events: []Event{
+			{Time: at(4), Phase: PhaseCommandQueue},
+			{Time: at(5)},
+			{Time: at(8), Phase: PhaseRaft},
+		}},
+	}
+	breakdown := Breakdown(spans)
+	expected := map[Phase]time.Duration{
+		PhaseLookup:       2 * time.Millisecond,
+		PhaseRPC:          8 * time.Millisecond,
+		PhaseCommandQueue: time.Millisecond,
+		PhaseRaft:         3 * time.Millisecond,
+	}
+	if len(breakdown) != len(expected) {
+		t.Errorf("expected breakdown %v; got %v", expected, breakdown)
+	}
+	for phase, d := range expected {
+		if breakdown[phase] != d {
+			t.Errorf("expected %s for phase %s; got %s", d, phase, breakdown[phase])
+		}
+	}
+	if s, exp := FormatBreakdown(breakdown), "range lookup 2ms, rpc 8ms, command queue 1ms, raft 3ms"; s != exp {
+		t.Errorf("expected %q; got %q", exp, s)
+	}
+	if s := FormatBreakdown(nil); s != "no phases recorded" {
+		t.Errorf("unexpected empty breakdown %q", s)
+	}
+}